@@ -0,0 +1,87 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/r-scheele/zero/pkg/log"
+	"github.com/r-scheele/zero/pkg/services"
+)
+
+// main copies every stored file from one storage backend to another and updates the database to
+// refer to the copies. It can be run again to resume after being interrupted.
+func main() {
+	if err := run(); err != nil {
+		fmt.Printf("[ERROR] %s\n", err)
+		os.Exit(1)
+	}
+}
+
+// run performs the migration, returning once the container has shut down so that exiting with an
+// error doesn't skip it
+func run() error {
+	var from, to string
+	var dryRun bool
+	flag.StringVar(&from, "from", "", "storage backend to copy files from (local, s3, gcs or azure)")
+	flag.StringVar(&to, "to", "", "storage backend to copy files to (local, s3, gcs or azure)")
+	flag.BoolVar(&dryRun, "dry-run", false, "report what would be copied without copying anything")
+	flag.Parse()
+
+	if from == "" || to == "" {
+		return errors.New("both -from and -to are required")
+	}
+	if from == to {
+		return errors.New("-from and -to must be different backends")
+	}
+
+	// Start a new container.
+	c := services.NewContainer()
+	defer func() {
+		// Gracefully shutdown all services.
+		if err := c.Shutdown(); err != nil {
+			log.Default().Error("shutdown failed", "error", err)
+		}
+	}()
+
+	source, err := services.NewStorageBackend(c.Config, from, c.Files)
+	if err != nil {
+		return err
+	}
+	target, err := services.NewStorageBackend(c.Config, to, c.Files)
+	if err != nil {
+		return err
+	}
+
+	migration := services.NewStorageMigration(c.ORM, c.Files, source, target)
+	migration.DryRun = dryRun
+	migration.Out = os.Stdout
+
+	report, err := migration.Run(context.Background())
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("")
+	if dryRun {
+		fmt.Println("-- DRY RUN: NOTHING WAS COPIED --")
+		fmt.Printf("Objects to copy: %d\n", report.Objects)
+		fmt.Printf("Bytes to copy: %d (%s)\n", report.Bytes, services.FormatFileSize(report.Bytes))
+	} else {
+		fmt.Println("-- STORAGE MIGRATED --")
+		fmt.Printf("Objects copied: %d\n", report.Objects)
+		fmt.Printf("Bytes copied: %d (%s)\n", report.Bytes, services.FormatFileSize(report.Bytes))
+	}
+	fmt.Printf("Already migrated: %d\n", report.Skipped)
+	fmt.Printf("Failed: %d\n", report.Failed)
+	fmt.Println("----")
+	fmt.Println("")
+
+	if report.Failed > 0 {
+		return errors.New("some files failed to copy; run the command again to retry them")
+	}
+
+	return nil
+}
@@ -53,6 +53,7 @@ type (
 		WhatsApp   WhatsAppConfig
 		AI         AIConfig
 		FileUpload FileUploadConfig
+		Notes      NotesConfig
 		Security   SecurityConfig
 		Monitoring MonitoringConfig
 	}
@@ -137,11 +138,16 @@ type (
 		Local LocalStorageConfig
 	}
 
-	// AWSStorageConfig stores AWS S3 configuration.
+	// AWSStorageConfig stores configuration for AWS S3 or an S3-compatible service such as MinIO.
 	AWSStorageConfig struct {
-		Enabled bool
-		Bucket  string
-		Region  string
+		Enabled         bool
+		Bucket          string
+		Region          string
+		Endpoint        string
+		PathStyle       bool   `mapstructure:"pathStyle"`
+		AccessKeyID     string `mapstructure:"accessKeyId"`
+		SecretAccessKey string `mapstructure:"secretAccessKey"`
+		PartSize        string `mapstructure:"partSize"`
 	}
 
 	// GCSStorageConfig stores Google Cloud Storage configuration.
@@ -205,6 +211,25 @@ type (
 		MaxTotalSize string   `mapstructure:"maxTotalSize"`
 		MaxFiles     int      `mapstructure:"maxFiles"`
 		AllowedTypes []string `mapstructure:"allowedTypes"`
+		UserQuota    string   `mapstructure:"userQuota"`
+		Scanner      ScannerConfig
+	}
+
+	// ScannerConfig stores the configuration of the virus scanner uploads are checked with.
+	ScannerConfig struct {
+		Clamd   string
+		Timeout time.Duration
+	}
+
+	// NotesConfig stores note configuration.
+	NotesConfig struct {
+		Revisions RevisionsConfig
+	}
+
+	// RevisionsConfig stores how long the revision history of notes is kept.
+	RevisionsConfig struct {
+		Keep   int
+		MaxAge time.Duration `mapstructure:"maxAge"`
 	}
 
 	// SecurityConfig stores security-related configuration.
@@ -0,0 +1,318 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/r-scheele/zero/ent/blob"
+	"github.com/r-scheele/zero/ent/blobreference"
+)
+
+// BlobCreate is the builder for creating a Blob entity.
+type BlobCreate struct {
+	config
+	mutation *BlobMutation
+	hooks    []Hook
+}
+
+// SetHash sets the "hash" field.
+func (bc *BlobCreate) SetHash(s string) *BlobCreate {
+	bc.mutation.SetHash(s)
+	return bc
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (bc *BlobCreate) SetStorageKey(s string) *BlobCreate {
+	bc.mutation.SetStorageKey(s)
+	return bc
+}
+
+// SetMimeType sets the "mime_type" field.
+func (bc *BlobCreate) SetMimeType(s string) *BlobCreate {
+	bc.mutation.SetMimeType(s)
+	return bc
+}
+
+// SetNillableMimeType sets the "mime_type" field if the given value is not nil.
+func (bc *BlobCreate) SetNillableMimeType(s *string) *BlobCreate {
+	if s != nil {
+		bc.SetMimeType(*s)
+	}
+	return bc
+}
+
+// SetSize sets the "size" field.
+func (bc *BlobCreate) SetSize(i int64) *BlobCreate {
+	bc.mutation.SetSize(i)
+	return bc
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (bc *BlobCreate) SetNillableSize(i *int64) *BlobCreate {
+	if i != nil {
+		bc.SetSize(*i)
+	}
+	return bc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (bc *BlobCreate) SetCreatedAt(t time.Time) *BlobCreate {
+	bc.mutation.SetCreatedAt(t)
+	return bc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (bc *BlobCreate) SetNillableCreatedAt(t *time.Time) *BlobCreate {
+	if t != nil {
+		bc.SetCreatedAt(*t)
+	}
+	return bc
+}
+
+// AddReferenceIDs adds the "references" edge to the BlobReference entity by IDs.
+func (bc *BlobCreate) AddReferenceIDs(ids ...int) *BlobCreate {
+	bc.mutation.AddReferenceIDs(ids...)
+	return bc
+}
+
+// AddReferences adds the "references" edges to the BlobReference entity.
+func (bc *BlobCreate) AddReferences(b ...*BlobReference) *BlobCreate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return bc.AddReferenceIDs(ids...)
+}
+
+// Mutation returns the BlobMutation object of the builder.
+func (bc *BlobCreate) Mutation() *BlobMutation {
+	return bc.mutation
+}
+
+// Save creates the Blob in the database.
+func (bc *BlobCreate) Save(ctx context.Context) (*Blob, error) {
+	bc.defaults()
+	return withHooks(ctx, bc.sqlSave, bc.mutation, bc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (bc *BlobCreate) SaveX(ctx context.Context) *Blob {
+	v, err := bc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bc *BlobCreate) Exec(ctx context.Context) error {
+	_, err := bc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bc *BlobCreate) ExecX(ctx context.Context) {
+	if err := bc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (bc *BlobCreate) defaults() {
+	if _, ok := bc.mutation.Size(); !ok {
+		v := blob.DefaultSize
+		bc.mutation.SetSize(v)
+	}
+	if _, ok := bc.mutation.CreatedAt(); !ok {
+		v := blob.DefaultCreatedAt()
+		bc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bc *BlobCreate) check() error {
+	if _, ok := bc.mutation.Hash(); !ok {
+		return &ValidationError{Name: "hash", err: errors.New(`ent: missing required field "Blob.hash"`)}
+	}
+	if v, ok := bc.mutation.Hash(); ok {
+		if err := blob.HashValidator(v); err != nil {
+			return &ValidationError{Name: "hash", err: fmt.Errorf(`ent: validator failed for field "Blob.hash": %w`, err)}
+		}
+	}
+	if _, ok := bc.mutation.StorageKey(); !ok {
+		return &ValidationError{Name: "storage_key", err: errors.New(`ent: missing required field "Blob.storage_key"`)}
+	}
+	if v, ok := bc.mutation.StorageKey(); ok {
+		if err := blob.StorageKeyValidator(v); err != nil {
+			return &ValidationError{Name: "storage_key", err: fmt.Errorf(`ent: validator failed for field "Blob.storage_key": %w`, err)}
+		}
+	}
+	if _, ok := bc.mutation.Size(); !ok {
+		return &ValidationError{Name: "size", err: errors.New(`ent: missing required field "Blob.size"`)}
+	}
+	if v, ok := bc.mutation.Size(); ok {
+		if err := blob.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "Blob.size": %w`, err)}
+		}
+	}
+	if _, ok := bc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Blob.created_at"`)}
+	}
+	return nil
+}
+
+func (bc *BlobCreate) sqlSave(ctx context.Context) (*Blob, error) {
+	if err := bc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := bc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, bc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	bc.mutation.id = &_node.ID
+	bc.mutation.done = true
+	return _node, nil
+}
+
+func (bc *BlobCreate) createSpec() (*Blob, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Blob{config: bc.config}
+		_spec = sqlgraph.NewCreateSpec(blob.Table, sqlgraph.NewFieldSpec(blob.FieldID, field.TypeInt))
+	)
+	if value, ok := bc.mutation.Hash(); ok {
+		_spec.SetField(blob.FieldHash, field.TypeString, value)
+		_node.Hash = value
+	}
+	if value, ok := bc.mutation.StorageKey(); ok {
+		_spec.SetField(blob.FieldStorageKey, field.TypeString, value)
+		_node.StorageKey = value
+	}
+	if value, ok := bc.mutation.MimeType(); ok {
+		_spec.SetField(blob.FieldMimeType, field.TypeString, value)
+		_node.MimeType = value
+	}
+	if value, ok := bc.mutation.Size(); ok {
+		_spec.SetField(blob.FieldSize, field.TypeInt64, value)
+		_node.Size = value
+	}
+	if value, ok := bc.mutation.CreatedAt(); ok {
+		_spec.SetField(blob.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := bc.mutation.ReferencesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   blob.ReferencesTable,
+			Columns: []string{blob.ReferencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blobreference.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// BlobCreateBulk is the builder for creating many Blob entities in bulk.
+type BlobCreateBulk struct {
+	config
+	err      error
+	builders []*BlobCreate
+}
+
+// Save creates the Blob entities in the database.
+func (bcb *BlobCreateBulk) Save(ctx context.Context) ([]*Blob, error) {
+	if bcb.err != nil {
+		return nil, bcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(bcb.builders))
+	nodes := make([]*Blob, len(bcb.builders))
+	mutators := make([]Mutator, len(bcb.builders))
+	for i := range bcb.builders {
+		func(i int, root context.Context) {
+			builder := bcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BlobMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, bcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, bcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, bcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bcb *BlobCreateBulk) SaveX(ctx context.Context) []*Blob {
+	v, err := bcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bcb *BlobCreateBulk) Exec(ctx context.Context) error {
+	_, err := bcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bcb *BlobCreateBulk) ExecX(ctx context.Context) {
+	if err := bcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,470 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/r-scheele/zero/ent/blob"
+	"github.com/r-scheele/zero/ent/blobreference"
+	"github.com/r-scheele/zero/ent/predicate"
+)
+
+// BlobUpdate is the builder for updating Blob entities.
+type BlobUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BlobMutation
+}
+
+// Where appends a list predicates to the BlobUpdate builder.
+func (bu *BlobUpdate) Where(ps ...predicate.Blob) *BlobUpdate {
+	bu.mutation.Where(ps...)
+	return bu
+}
+
+// SetMimeType sets the "mime_type" field.
+func (bu *BlobUpdate) SetMimeType(s string) *BlobUpdate {
+	bu.mutation.SetMimeType(s)
+	return bu
+}
+
+// SetNillableMimeType sets the "mime_type" field if the given value is not nil.
+func (bu *BlobUpdate) SetNillableMimeType(s *string) *BlobUpdate {
+	if s != nil {
+		bu.SetMimeType(*s)
+	}
+	return bu
+}
+
+// ClearMimeType clears the value of the "mime_type" field.
+func (bu *BlobUpdate) ClearMimeType() *BlobUpdate {
+	bu.mutation.ClearMimeType()
+	return bu
+}
+
+// SetSize sets the "size" field.
+func (bu *BlobUpdate) SetSize(i int64) *BlobUpdate {
+	bu.mutation.ResetSize()
+	bu.mutation.SetSize(i)
+	return bu
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (bu *BlobUpdate) SetNillableSize(i *int64) *BlobUpdate {
+	if i != nil {
+		bu.SetSize(*i)
+	}
+	return bu
+}
+
+// AddSize adds i to the "size" field.
+func (bu *BlobUpdate) AddSize(i int64) *BlobUpdate {
+	bu.mutation.AddSize(i)
+	return bu
+}
+
+// AddReferenceIDs adds the "references" edge to the BlobReference entity by IDs.
+func (bu *BlobUpdate) AddReferenceIDs(ids ...int) *BlobUpdate {
+	bu.mutation.AddReferenceIDs(ids...)
+	return bu
+}
+
+// AddReferences adds the "references" edges to the BlobReference entity.
+func (bu *BlobUpdate) AddReferences(b ...*BlobReference) *BlobUpdate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return bu.AddReferenceIDs(ids...)
+}
+
+// Mutation returns the BlobMutation object of the builder.
+func (bu *BlobUpdate) Mutation() *BlobMutation {
+	return bu.mutation
+}
+
+// ClearReferences clears all "references" edges to the BlobReference entity.
+func (bu *BlobUpdate) ClearReferences() *BlobUpdate {
+	bu.mutation.ClearReferences()
+	return bu
+}
+
+// RemoveReferenceIDs removes the "references" edge to BlobReference entities by IDs.
+func (bu *BlobUpdate) RemoveReferenceIDs(ids ...int) *BlobUpdate {
+	bu.mutation.RemoveReferenceIDs(ids...)
+	return bu
+}
+
+// RemoveReferences removes "references" edges to BlobReference entities.
+func (bu *BlobUpdate) RemoveReferences(b ...*BlobReference) *BlobUpdate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return bu.RemoveReferenceIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (bu *BlobUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, bu.sqlSave, bu.mutation, bu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bu *BlobUpdate) SaveX(ctx context.Context) int {
+	affected, err := bu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (bu *BlobUpdate) Exec(ctx context.Context) error {
+	_, err := bu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bu *BlobUpdate) ExecX(ctx context.Context) {
+	if err := bu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bu *BlobUpdate) check() error {
+	if v, ok := bu.mutation.Size(); ok {
+		if err := blob.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "Blob.size": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (bu *BlobUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := bu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(blob.Table, blob.Columns, sqlgraph.NewFieldSpec(blob.FieldID, field.TypeInt))
+	if ps := bu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := bu.mutation.MimeType(); ok {
+		_spec.SetField(blob.FieldMimeType, field.TypeString, value)
+	}
+	if bu.mutation.MimeTypeCleared() {
+		_spec.ClearField(blob.FieldMimeType, field.TypeString)
+	}
+	if value, ok := bu.mutation.Size(); ok {
+		_spec.SetField(blob.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := bu.mutation.AddedSize(); ok {
+		_spec.AddField(blob.FieldSize, field.TypeInt64, value)
+	}
+	if bu.mutation.ReferencesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   blob.ReferencesTable,
+			Columns: []string{blob.ReferencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blobreference.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.RemovedReferencesIDs(); len(nodes) > 0 && !bu.mutation.ReferencesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   blob.ReferencesTable,
+			Columns: []string{blob.ReferencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blobreference.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.ReferencesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   blob.ReferencesTable,
+			Columns: []string{blob.ReferencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blobreference.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, bu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{blob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	bu.mutation.done = true
+	return n, nil
+}
+
+// BlobUpdateOne is the builder for updating a single Blob entity.
+type BlobUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BlobMutation
+}
+
+// SetMimeType sets the "mime_type" field.
+func (buo *BlobUpdateOne) SetMimeType(s string) *BlobUpdateOne {
+	buo.mutation.SetMimeType(s)
+	return buo
+}
+
+// SetNillableMimeType sets the "mime_type" field if the given value is not nil.
+func (buo *BlobUpdateOne) SetNillableMimeType(s *string) *BlobUpdateOne {
+	if s != nil {
+		buo.SetMimeType(*s)
+	}
+	return buo
+}
+
+// ClearMimeType clears the value of the "mime_type" field.
+func (buo *BlobUpdateOne) ClearMimeType() *BlobUpdateOne {
+	buo.mutation.ClearMimeType()
+	return buo
+}
+
+// SetSize sets the "size" field.
+func (buo *BlobUpdateOne) SetSize(i int64) *BlobUpdateOne {
+	buo.mutation.ResetSize()
+	buo.mutation.SetSize(i)
+	return buo
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (buo *BlobUpdateOne) SetNillableSize(i *int64) *BlobUpdateOne {
+	if i != nil {
+		buo.SetSize(*i)
+	}
+	return buo
+}
+
+// AddSize adds i to the "size" field.
+func (buo *BlobUpdateOne) AddSize(i int64) *BlobUpdateOne {
+	buo.mutation.AddSize(i)
+	return buo
+}
+
+// AddReferenceIDs adds the "references" edge to the BlobReference entity by IDs.
+func (buo *BlobUpdateOne) AddReferenceIDs(ids ...int) *BlobUpdateOne {
+	buo.mutation.AddReferenceIDs(ids...)
+	return buo
+}
+
+// AddReferences adds the "references" edges to the BlobReference entity.
+func (buo *BlobUpdateOne) AddReferences(b ...*BlobReference) *BlobUpdateOne {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return buo.AddReferenceIDs(ids...)
+}
+
+// Mutation returns the BlobMutation object of the builder.
+func (buo *BlobUpdateOne) Mutation() *BlobMutation {
+	return buo.mutation
+}
+
+// ClearReferences clears all "references" edges to the BlobReference entity.
+func (buo *BlobUpdateOne) ClearReferences() *BlobUpdateOne {
+	buo.mutation.ClearReferences()
+	return buo
+}
+
+// RemoveReferenceIDs removes the "references" edge to BlobReference entities by IDs.
+func (buo *BlobUpdateOne) RemoveReferenceIDs(ids ...int) *BlobUpdateOne {
+	buo.mutation.RemoveReferenceIDs(ids...)
+	return buo
+}
+
+// RemoveReferences removes "references" edges to BlobReference entities.
+func (buo *BlobUpdateOne) RemoveReferences(b ...*BlobReference) *BlobUpdateOne {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return buo.RemoveReferenceIDs(ids...)
+}
+
+// Where appends a list predicates to the BlobUpdate builder.
+func (buo *BlobUpdateOne) Where(ps ...predicate.Blob) *BlobUpdateOne {
+	buo.mutation.Where(ps...)
+	return buo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (buo *BlobUpdateOne) Select(field string, fields ...string) *BlobUpdateOne {
+	buo.fields = append([]string{field}, fields...)
+	return buo
+}
+
+// Save executes the query and returns the updated Blob entity.
+func (buo *BlobUpdateOne) Save(ctx context.Context) (*Blob, error) {
+	return withHooks(ctx, buo.sqlSave, buo.mutation, buo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (buo *BlobUpdateOne) SaveX(ctx context.Context) *Blob {
+	node, err := buo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (buo *BlobUpdateOne) Exec(ctx context.Context) error {
+	_, err := buo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (buo *BlobUpdateOne) ExecX(ctx context.Context) {
+	if err := buo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (buo *BlobUpdateOne) check() error {
+	if v, ok := buo.mutation.Size(); ok {
+		if err := blob.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "Blob.size": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (buo *BlobUpdateOne) sqlSave(ctx context.Context) (_node *Blob, err error) {
+	if err := buo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(blob.Table, blob.Columns, sqlgraph.NewFieldSpec(blob.FieldID, field.TypeInt))
+	id, ok := buo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Blob.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := buo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, blob.FieldID)
+		for _, f := range fields {
+			if !blob.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != blob.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := buo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := buo.mutation.MimeType(); ok {
+		_spec.SetField(blob.FieldMimeType, field.TypeString, value)
+	}
+	if buo.mutation.MimeTypeCleared() {
+		_spec.ClearField(blob.FieldMimeType, field.TypeString)
+	}
+	if value, ok := buo.mutation.Size(); ok {
+		_spec.SetField(blob.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := buo.mutation.AddedSize(); ok {
+		_spec.AddField(blob.FieldSize, field.TypeInt64, value)
+	}
+	if buo.mutation.ReferencesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   blob.ReferencesTable,
+			Columns: []string{blob.ReferencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blobreference.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.RemovedReferencesIDs(); len(nodes) > 0 && !buo.mutation.ReferencesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   blob.ReferencesTable,
+			Columns: []string{blob.ReferencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blobreference.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.ReferencesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   blob.ReferencesTable,
+			Columns: []string{blob.ReferencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blobreference.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Blob{config: buo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, buo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{blob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	buo.mutation.done = true
+	return _node, nil
+}
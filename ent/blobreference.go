@@ -0,0 +1,207 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/r-scheele/zero/ent/blob"
+	"github.com/r-scheele/zero/ent/blobreference"
+	"github.com/r-scheele/zero/ent/user"
+)
+
+// BlobReference is the model entity for the BlobReference schema.
+type BlobReference struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// What kind of thing uses the file
+	Kind blobreference.Kind `json:"kind,omitempty"`
+	// ID of the note, document or user using the file
+	TargetID int `json:"target_id,omitempty"`
+	// Bytes charged to the uploader for this use of the file
+	Size int64 `json:"size,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BlobReferenceQuery when eager-loading is set.
+	Edges                BlobReferenceEdges `json:"edges"`
+	blob_references      *int
+	user_blob_references *int
+	selectValues         sql.SelectValues
+}
+
+// BlobReferenceEdges holds the relations/edges for other nodes in the graph.
+type BlobReferenceEdges struct {
+	// Blob holds the value of the blob edge.
+	Blob *Blob `json:"blob,omitempty"`
+	// User who uploaded the file
+	Owner *User `json:"owner,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// BlobOrErr returns the Blob value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BlobReferenceEdges) BlobOrErr() (*Blob, error) {
+	if e.Blob != nil {
+		return e.Blob, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: blob.Label}
+	}
+	return nil, &NotLoadedError{edge: "blob"}
+}
+
+// OwnerOrErr returns the Owner value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BlobReferenceEdges) OwnerOrErr() (*User, error) {
+	if e.Owner != nil {
+		return e.Owner, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "owner"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BlobReference) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case blobreference.FieldID, blobreference.FieldTargetID, blobreference.FieldSize:
+			values[i] = new(sql.NullInt64)
+		case blobreference.FieldKind:
+			values[i] = new(sql.NullString)
+		case blobreference.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case blobreference.ForeignKeys[0]: // blob_references
+			values[i] = new(sql.NullInt64)
+		case blobreference.ForeignKeys[1]: // user_blob_references
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BlobReference fields.
+func (br *BlobReference) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case blobreference.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			br.ID = int(value.Int64)
+		case blobreference.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				br.Kind = blobreference.Kind(value.String)
+			}
+		case blobreference.FieldTargetID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field target_id", values[i])
+			} else if value.Valid {
+				br.TargetID = int(value.Int64)
+			}
+		case blobreference.FieldSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field size", values[i])
+			} else if value.Valid {
+				br.Size = value.Int64
+			}
+		case blobreference.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				br.CreatedAt = value.Time
+			}
+		case blobreference.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field blob_references", value)
+			} else if value.Valid {
+				br.blob_references = new(int)
+				*br.blob_references = int(value.Int64)
+			}
+		case blobreference.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_blob_references", value)
+			} else if value.Valid {
+				br.user_blob_references = new(int)
+				*br.user_blob_references = int(value.Int64)
+			}
+		default:
+			br.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BlobReference.
+// This includes values selected through modifiers, order, etc.
+func (br *BlobReference) Value(name string) (ent.Value, error) {
+	return br.selectValues.Get(name)
+}
+
+// QueryBlob queries the "blob" edge of the BlobReference entity.
+func (br *BlobReference) QueryBlob() *BlobQuery {
+	return NewBlobReferenceClient(br.config).QueryBlob(br)
+}
+
+// QueryOwner queries the "owner" edge of the BlobReference entity.
+func (br *BlobReference) QueryOwner() *UserQuery {
+	return NewBlobReferenceClient(br.config).QueryOwner(br)
+}
+
+// Update returns a builder for updating this BlobReference.
+// Note that you need to call BlobReference.Unwrap() before calling this method if this BlobReference
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (br *BlobReference) Update() *BlobReferenceUpdateOne {
+	return NewBlobReferenceClient(br.config).UpdateOne(br)
+}
+
+// Unwrap unwraps the BlobReference entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (br *BlobReference) Unwrap() *BlobReference {
+	_tx, ok := br.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BlobReference is not a transactional entity")
+	}
+	br.config.driver = _tx.drv
+	return br
+}
+
+// String implements the fmt.Stringer.
+func (br *BlobReference) String() string {
+	var builder strings.Builder
+	builder.WriteString("BlobReference(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", br.ID))
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", br.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("target_id=")
+	builder.WriteString(fmt.Sprintf("%v", br.TargetID))
+	builder.WriteString(", ")
+	builder.WriteString("size=")
+	builder.WriteString(fmt.Sprintf("%v", br.Size))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(br.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BlobReferences is a parsable slice of BlobReference.
+type BlobReferences []*BlobReference
@@ -0,0 +1,327 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/r-scheele/zero/ent/blob"
+	"github.com/r-scheele/zero/ent/blobreference"
+	"github.com/r-scheele/zero/ent/user"
+)
+
+// BlobReferenceCreate is the builder for creating a BlobReference entity.
+type BlobReferenceCreate struct {
+	config
+	mutation *BlobReferenceMutation
+	hooks    []Hook
+}
+
+// SetKind sets the "kind" field.
+func (brc *BlobReferenceCreate) SetKind(b blobreference.Kind) *BlobReferenceCreate {
+	brc.mutation.SetKind(b)
+	return brc
+}
+
+// SetTargetID sets the "target_id" field.
+func (brc *BlobReferenceCreate) SetTargetID(i int) *BlobReferenceCreate {
+	brc.mutation.SetTargetID(i)
+	return brc
+}
+
+// SetSize sets the "size" field.
+func (brc *BlobReferenceCreate) SetSize(i int64) *BlobReferenceCreate {
+	brc.mutation.SetSize(i)
+	return brc
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (brc *BlobReferenceCreate) SetNillableSize(i *int64) *BlobReferenceCreate {
+	if i != nil {
+		brc.SetSize(*i)
+	}
+	return brc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (brc *BlobReferenceCreate) SetCreatedAt(t time.Time) *BlobReferenceCreate {
+	brc.mutation.SetCreatedAt(t)
+	return brc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (brc *BlobReferenceCreate) SetNillableCreatedAt(t *time.Time) *BlobReferenceCreate {
+	if t != nil {
+		brc.SetCreatedAt(*t)
+	}
+	return brc
+}
+
+// SetBlobID sets the "blob" edge to the Blob entity by ID.
+func (brc *BlobReferenceCreate) SetBlobID(id int) *BlobReferenceCreate {
+	brc.mutation.SetBlobID(id)
+	return brc
+}
+
+// SetBlob sets the "blob" edge to the Blob entity.
+func (brc *BlobReferenceCreate) SetBlob(b *Blob) *BlobReferenceCreate {
+	return brc.SetBlobID(b.ID)
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (brc *BlobReferenceCreate) SetOwnerID(id int) *BlobReferenceCreate {
+	brc.mutation.SetOwnerID(id)
+	return brc
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (brc *BlobReferenceCreate) SetOwner(u *User) *BlobReferenceCreate {
+	return brc.SetOwnerID(u.ID)
+}
+
+// Mutation returns the BlobReferenceMutation object of the builder.
+func (brc *BlobReferenceCreate) Mutation() *BlobReferenceMutation {
+	return brc.mutation
+}
+
+// Save creates the BlobReference in the database.
+func (brc *BlobReferenceCreate) Save(ctx context.Context) (*BlobReference, error) {
+	brc.defaults()
+	return withHooks(ctx, brc.sqlSave, brc.mutation, brc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (brc *BlobReferenceCreate) SaveX(ctx context.Context) *BlobReference {
+	v, err := brc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (brc *BlobReferenceCreate) Exec(ctx context.Context) error {
+	_, err := brc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (brc *BlobReferenceCreate) ExecX(ctx context.Context) {
+	if err := brc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (brc *BlobReferenceCreate) defaults() {
+	if _, ok := brc.mutation.Size(); !ok {
+		v := blobreference.DefaultSize
+		brc.mutation.SetSize(v)
+	}
+	if _, ok := brc.mutation.CreatedAt(); !ok {
+		v := blobreference.DefaultCreatedAt()
+		brc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (brc *BlobReferenceCreate) check() error {
+	if _, ok := brc.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "BlobReference.kind"`)}
+	}
+	if v, ok := brc.mutation.Kind(); ok {
+		if err := blobreference.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "BlobReference.kind": %w`, err)}
+		}
+	}
+	if _, ok := brc.mutation.TargetID(); !ok {
+		return &ValidationError{Name: "target_id", err: errors.New(`ent: missing required field "BlobReference.target_id"`)}
+	}
+	if _, ok := brc.mutation.Size(); !ok {
+		return &ValidationError{Name: "size", err: errors.New(`ent: missing required field "BlobReference.size"`)}
+	}
+	if v, ok := brc.mutation.Size(); ok {
+		if err := blobreference.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "BlobReference.size": %w`, err)}
+		}
+	}
+	if _, ok := brc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "BlobReference.created_at"`)}
+	}
+	if len(brc.mutation.BlobIDs()) == 0 {
+		return &ValidationError{Name: "blob", err: errors.New(`ent: missing required edge "BlobReference.blob"`)}
+	}
+	if len(brc.mutation.OwnerIDs()) == 0 {
+		return &ValidationError{Name: "owner", err: errors.New(`ent: missing required edge "BlobReference.owner"`)}
+	}
+	return nil
+}
+
+func (brc *BlobReferenceCreate) sqlSave(ctx context.Context) (*BlobReference, error) {
+	if err := brc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := brc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, brc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	brc.mutation.id = &_node.ID
+	brc.mutation.done = true
+	return _node, nil
+}
+
+func (brc *BlobReferenceCreate) createSpec() (*BlobReference, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BlobReference{config: brc.config}
+		_spec = sqlgraph.NewCreateSpec(blobreference.Table, sqlgraph.NewFieldSpec(blobreference.FieldID, field.TypeInt))
+	)
+	if value, ok := brc.mutation.Kind(); ok {
+		_spec.SetField(blobreference.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := brc.mutation.TargetID(); ok {
+		_spec.SetField(blobreference.FieldTargetID, field.TypeInt, value)
+		_node.TargetID = value
+	}
+	if value, ok := brc.mutation.Size(); ok {
+		_spec.SetField(blobreference.FieldSize, field.TypeInt64, value)
+		_node.Size = value
+	}
+	if value, ok := brc.mutation.CreatedAt(); ok {
+		_spec.SetField(blobreference.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := brc.mutation.BlobIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blobreference.BlobTable,
+			Columns: []string{blobreference.BlobColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blob.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.blob_references = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := brc.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blobreference.OwnerTable,
+			Columns: []string{blobreference.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_blob_references = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// BlobReferenceCreateBulk is the builder for creating many BlobReference entities in bulk.
+type BlobReferenceCreateBulk struct {
+	config
+	err      error
+	builders []*BlobReferenceCreate
+}
+
+// Save creates the BlobReference entities in the database.
+func (brcb *BlobReferenceCreateBulk) Save(ctx context.Context) ([]*BlobReference, error) {
+	if brcb.err != nil {
+		return nil, brcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(brcb.builders))
+	nodes := make([]*BlobReference, len(brcb.builders))
+	mutators := make([]Mutator, len(brcb.builders))
+	for i := range brcb.builders {
+		func(i int, root context.Context) {
+			builder := brcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BlobReferenceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, brcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, brcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, brcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (brcb *BlobReferenceCreateBulk) SaveX(ctx context.Context) []*BlobReference {
+	v, err := brcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (brcb *BlobReferenceCreateBulk) Exec(ctx context.Context) error {
+	_, err := brcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (brcb *BlobReferenceCreateBulk) ExecX(ctx context.Context) {
+	if err := brcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
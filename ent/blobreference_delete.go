@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/r-scheele/zero/ent/blobreference"
+	"github.com/r-scheele/zero/ent/predicate"
+)
+
+// BlobReferenceDelete is the builder for deleting a BlobReference entity.
+type BlobReferenceDelete struct {
+	config
+	hooks    []Hook
+	mutation *BlobReferenceMutation
+}
+
+// Where appends a list predicates to the BlobReferenceDelete builder.
+func (brd *BlobReferenceDelete) Where(ps ...predicate.BlobReference) *BlobReferenceDelete {
+	brd.mutation.Where(ps...)
+	return brd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (brd *BlobReferenceDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, brd.sqlExec, brd.mutation, brd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (brd *BlobReferenceDelete) ExecX(ctx context.Context) int {
+	n, err := brd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (brd *BlobReferenceDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(blobreference.Table, sqlgraph.NewFieldSpec(blobreference.FieldID, field.TypeInt))
+	if ps := brd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, brd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	brd.mutation.done = true
+	return affected, err
+}
+
+// BlobReferenceDeleteOne is the builder for deleting a single BlobReference entity.
+type BlobReferenceDeleteOne struct {
+	brd *BlobReferenceDelete
+}
+
+// Where appends a list predicates to the BlobReferenceDelete builder.
+func (brdo *BlobReferenceDeleteOne) Where(ps ...predicate.BlobReference) *BlobReferenceDeleteOne {
+	brdo.brd.mutation.Where(ps...)
+	return brdo
+}
+
+// Exec executes the deletion query.
+func (brdo *BlobReferenceDeleteOne) Exec(ctx context.Context) error {
+	n, err := brdo.brd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{blobreference.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (brdo *BlobReferenceDeleteOne) ExecX(ctx context.Context) {
+	if err := brdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,689 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/r-scheele/zero/ent/blob"
+	"github.com/r-scheele/zero/ent/blobreference"
+	"github.com/r-scheele/zero/ent/predicate"
+	"github.com/r-scheele/zero/ent/user"
+)
+
+// BlobReferenceQuery is the builder for querying BlobReference entities.
+type BlobReferenceQuery struct {
+	config
+	ctx        *QueryContext
+	order      []blobreference.OrderOption
+	inters     []Interceptor
+	predicates []predicate.BlobReference
+	withBlob   *BlobQuery
+	withOwner  *UserQuery
+	withFKs    bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the BlobReferenceQuery builder.
+func (brq *BlobReferenceQuery) Where(ps ...predicate.BlobReference) *BlobReferenceQuery {
+	brq.predicates = append(brq.predicates, ps...)
+	return brq
+}
+
+// Limit the number of records to be returned by this query.
+func (brq *BlobReferenceQuery) Limit(limit int) *BlobReferenceQuery {
+	brq.ctx.Limit = &limit
+	return brq
+}
+
+// Offset to start from.
+func (brq *BlobReferenceQuery) Offset(offset int) *BlobReferenceQuery {
+	brq.ctx.Offset = &offset
+	return brq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (brq *BlobReferenceQuery) Unique(unique bool) *BlobReferenceQuery {
+	brq.ctx.Unique = &unique
+	return brq
+}
+
+// Order specifies how the records should be ordered.
+func (brq *BlobReferenceQuery) Order(o ...blobreference.OrderOption) *BlobReferenceQuery {
+	brq.order = append(brq.order, o...)
+	return brq
+}
+
+// QueryBlob chains the current query on the "blob" edge.
+func (brq *BlobReferenceQuery) QueryBlob() *BlobQuery {
+	query := (&BlobClient{config: brq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := brq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := brq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(blobreference.Table, blobreference.FieldID, selector),
+			sqlgraph.To(blob.Table, blob.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, blobreference.BlobTable, blobreference.BlobColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(brq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryOwner chains the current query on the "owner" edge.
+func (brq *BlobReferenceQuery) QueryOwner() *UserQuery {
+	query := (&UserClient{config: brq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := brq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := brq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(blobreference.Table, blobreference.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, blobreference.OwnerTable, blobreference.OwnerColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(brq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first BlobReference entity from the query.
+// Returns a *NotFoundError when no BlobReference was found.
+func (brq *BlobReferenceQuery) First(ctx context.Context) (*BlobReference, error) {
+	nodes, err := brq.Limit(1).All(setContextOp(ctx, brq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{blobreference.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (brq *BlobReferenceQuery) FirstX(ctx context.Context) *BlobReference {
+	node, err := brq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first BlobReference ID from the query.
+// Returns a *NotFoundError when no BlobReference ID was found.
+func (brq *BlobReferenceQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = brq.Limit(1).IDs(setContextOp(ctx, brq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{blobreference.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (brq *BlobReferenceQuery) FirstIDX(ctx context.Context) int {
+	id, err := brq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single BlobReference entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one BlobReference entity is found.
+// Returns a *NotFoundError when no BlobReference entities are found.
+func (brq *BlobReferenceQuery) Only(ctx context.Context) (*BlobReference, error) {
+	nodes, err := brq.Limit(2).All(setContextOp(ctx, brq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{blobreference.Label}
+	default:
+		return nil, &NotSingularError{blobreference.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (brq *BlobReferenceQuery) OnlyX(ctx context.Context) *BlobReference {
+	node, err := brq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only BlobReference ID in the query.
+// Returns a *NotSingularError when more than one BlobReference ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (brq *BlobReferenceQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = brq.Limit(2).IDs(setContextOp(ctx, brq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{blobreference.Label}
+	default:
+		err = &NotSingularError{blobreference.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (brq *BlobReferenceQuery) OnlyIDX(ctx context.Context) int {
+	id, err := brq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of BlobReferences.
+func (brq *BlobReferenceQuery) All(ctx context.Context) ([]*BlobReference, error) {
+	ctx = setContextOp(ctx, brq.ctx, ent.OpQueryAll)
+	if err := brq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*BlobReference, *BlobReferenceQuery]()
+	return withInterceptors[[]*BlobReference](ctx, brq, qr, brq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (brq *BlobReferenceQuery) AllX(ctx context.Context) []*BlobReference {
+	nodes, err := brq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of BlobReference IDs.
+func (brq *BlobReferenceQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if brq.ctx.Unique == nil && brq.path != nil {
+		brq.Unique(true)
+	}
+	ctx = setContextOp(ctx, brq.ctx, ent.OpQueryIDs)
+	if err = brq.Select(blobreference.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (brq *BlobReferenceQuery) IDsX(ctx context.Context) []int {
+	ids, err := brq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (brq *BlobReferenceQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, brq.ctx, ent.OpQueryCount)
+	if err := brq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, brq, querierCount[*BlobReferenceQuery](), brq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (brq *BlobReferenceQuery) CountX(ctx context.Context) int {
+	count, err := brq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (brq *BlobReferenceQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, brq.ctx, ent.OpQueryExist)
+	switch _, err := brq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (brq *BlobReferenceQuery) ExistX(ctx context.Context) bool {
+	exist, err := brq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the BlobReferenceQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (brq *BlobReferenceQuery) Clone() *BlobReferenceQuery {
+	if brq == nil {
+		return nil
+	}
+	return &BlobReferenceQuery{
+		config:     brq.config,
+		ctx:        brq.ctx.Clone(),
+		order:      append([]blobreference.OrderOption{}, brq.order...),
+		inters:     append([]Interceptor{}, brq.inters...),
+		predicates: append([]predicate.BlobReference{}, brq.predicates...),
+		withBlob:   brq.withBlob.Clone(),
+		withOwner:  brq.withOwner.Clone(),
+		// clone intermediate query.
+		sql:  brq.sql.Clone(),
+		path: brq.path,
+	}
+}
+
+// WithBlob tells the query-builder to eager-load the nodes that are connected to
+// the "blob" edge. The optional arguments are used to configure the query builder of the edge.
+func (brq *BlobReferenceQuery) WithBlob(opts ...func(*BlobQuery)) *BlobReferenceQuery {
+	query := (&BlobClient{config: brq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	brq.withBlob = query
+	return brq
+}
+
+// WithOwner tells the query-builder to eager-load the nodes that are connected to
+// the "owner" edge. The optional arguments are used to configure the query builder of the edge.
+func (brq *BlobReferenceQuery) WithOwner(opts ...func(*UserQuery)) *BlobReferenceQuery {
+	query := (&UserClient{config: brq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	brq.withOwner = query
+	return brq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Kind blobreference.Kind `json:"kind,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.BlobReference.Query().
+//		GroupBy(blobreference.FieldKind).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (brq *BlobReferenceQuery) GroupBy(field string, fields ...string) *BlobReferenceGroupBy {
+	brq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &BlobReferenceGroupBy{build: brq}
+	grbuild.flds = &brq.ctx.Fields
+	grbuild.label = blobreference.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Kind blobreference.Kind `json:"kind,omitempty"`
+//	}
+//
+//	client.BlobReference.Query().
+//		Select(blobreference.FieldKind).
+//		Scan(ctx, &v)
+func (brq *BlobReferenceQuery) Select(fields ...string) *BlobReferenceSelect {
+	brq.ctx.Fields = append(brq.ctx.Fields, fields...)
+	sbuild := &BlobReferenceSelect{BlobReferenceQuery: brq}
+	sbuild.label = blobreference.Label
+	sbuild.flds, sbuild.scan = &brq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a BlobReferenceSelect configured with the given aggregations.
+func (brq *BlobReferenceQuery) Aggregate(fns ...AggregateFunc) *BlobReferenceSelect {
+	return brq.Select().Aggregate(fns...)
+}
+
+func (brq *BlobReferenceQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range brq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, brq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range brq.ctx.Fields {
+		if !blobreference.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if brq.path != nil {
+		prev, err := brq.path(ctx)
+		if err != nil {
+			return err
+		}
+		brq.sql = prev
+	}
+	return nil
+}
+
+func (brq *BlobReferenceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*BlobReference, error) {
+	var (
+		nodes       = []*BlobReference{}
+		withFKs     = brq.withFKs
+		_spec       = brq.querySpec()
+		loadedTypes = [2]bool{
+			brq.withBlob != nil,
+			brq.withOwner != nil,
+		}
+	)
+	if brq.withBlob != nil || brq.withOwner != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, blobreference.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*BlobReference).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &BlobReference{config: brq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, brq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := brq.withBlob; query != nil {
+		if err := brq.loadBlob(ctx, query, nodes, nil,
+			func(n *BlobReference, e *Blob) { n.Edges.Blob = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := brq.withOwner; query != nil {
+		if err := brq.loadOwner(ctx, query, nodes, nil,
+			func(n *BlobReference, e *User) { n.Edges.Owner = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (brq *BlobReferenceQuery) loadBlob(ctx context.Context, query *BlobQuery, nodes []*BlobReference, init func(*BlobReference), assign func(*BlobReference, *Blob)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*BlobReference)
+	for i := range nodes {
+		if nodes[i].blob_references == nil {
+			continue
+		}
+		fk := *nodes[i].blob_references
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(blob.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "blob_references" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (brq *BlobReferenceQuery) loadOwner(ctx context.Context, query *UserQuery, nodes []*BlobReference, init func(*BlobReference), assign func(*BlobReference, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*BlobReference)
+	for i := range nodes {
+		if nodes[i].user_blob_references == nil {
+			continue
+		}
+		fk := *nodes[i].user_blob_references
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_blob_references" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (brq *BlobReferenceQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := brq.querySpec()
+	_spec.Node.Columns = brq.ctx.Fields
+	if len(brq.ctx.Fields) > 0 {
+		_spec.Unique = brq.ctx.Unique != nil && *brq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, brq.driver, _spec)
+}
+
+func (brq *BlobReferenceQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(blobreference.Table, blobreference.Columns, sqlgraph.NewFieldSpec(blobreference.FieldID, field.TypeInt))
+	_spec.From = brq.sql
+	if unique := brq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if brq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := brq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, blobreference.FieldID)
+		for i := range fields {
+			if fields[i] != blobreference.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := brq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := brq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := brq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := brq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (brq *BlobReferenceQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(brq.driver.Dialect())
+	t1 := builder.Table(blobreference.Table)
+	columns := brq.ctx.Fields
+	if len(columns) == 0 {
+		columns = blobreference.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if brq.sql != nil {
+		selector = brq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if brq.ctx.Unique != nil && *brq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range brq.predicates {
+		p(selector)
+	}
+	for _, p := range brq.order {
+		p(selector)
+	}
+	if offset := brq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := brq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// BlobReferenceGroupBy is the group-by builder for BlobReference entities.
+type BlobReferenceGroupBy struct {
+	selector
+	build *BlobReferenceQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (brgb *BlobReferenceGroupBy) Aggregate(fns ...AggregateFunc) *BlobReferenceGroupBy {
+	brgb.fns = append(brgb.fns, fns...)
+	return brgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (brgb *BlobReferenceGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, brgb.build.ctx, ent.OpQueryGroupBy)
+	if err := brgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*BlobReferenceQuery, *BlobReferenceGroupBy](ctx, brgb.build, brgb, brgb.build.inters, v)
+}
+
+func (brgb *BlobReferenceGroupBy) sqlScan(ctx context.Context, root *BlobReferenceQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(brgb.fns))
+	for _, fn := range brgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*brgb.flds)+len(brgb.fns))
+		for _, f := range *brgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*brgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := brgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// BlobReferenceSelect is the builder for selecting fields of BlobReference entities.
+type BlobReferenceSelect struct {
+	*BlobReferenceQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (brs *BlobReferenceSelect) Aggregate(fns ...AggregateFunc) *BlobReferenceSelect {
+	brs.fns = append(brs.fns, fns...)
+	return brs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (brs *BlobReferenceSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, brs.ctx, ent.OpQuerySelect)
+	if err := brs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*BlobReferenceQuery, *BlobReferenceSelect](ctx, brs.BlobReferenceQuery, brs, brs.inters, v)
+}
+
+func (brs *BlobReferenceSelect) sqlScan(ctx context.Context, root *BlobReferenceQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(brs.fns))
+	for _, fn := range brs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*brs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := brs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,551 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/r-scheele/zero/ent/blob"
+	"github.com/r-scheele/zero/ent/blobreference"
+	"github.com/r-scheele/zero/ent/predicate"
+	"github.com/r-scheele/zero/ent/user"
+)
+
+// BlobReferenceUpdate is the builder for updating BlobReference entities.
+type BlobReferenceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BlobReferenceMutation
+}
+
+// Where appends a list predicates to the BlobReferenceUpdate builder.
+func (bru *BlobReferenceUpdate) Where(ps ...predicate.BlobReference) *BlobReferenceUpdate {
+	bru.mutation.Where(ps...)
+	return bru
+}
+
+// SetKind sets the "kind" field.
+func (bru *BlobReferenceUpdate) SetKind(b blobreference.Kind) *BlobReferenceUpdate {
+	bru.mutation.SetKind(b)
+	return bru
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (bru *BlobReferenceUpdate) SetNillableKind(b *blobreference.Kind) *BlobReferenceUpdate {
+	if b != nil {
+		bru.SetKind(*b)
+	}
+	return bru
+}
+
+// SetTargetID sets the "target_id" field.
+func (bru *BlobReferenceUpdate) SetTargetID(i int) *BlobReferenceUpdate {
+	bru.mutation.ResetTargetID()
+	bru.mutation.SetTargetID(i)
+	return bru
+}
+
+// SetNillableTargetID sets the "target_id" field if the given value is not nil.
+func (bru *BlobReferenceUpdate) SetNillableTargetID(i *int) *BlobReferenceUpdate {
+	if i != nil {
+		bru.SetTargetID(*i)
+	}
+	return bru
+}
+
+// AddTargetID adds i to the "target_id" field.
+func (bru *BlobReferenceUpdate) AddTargetID(i int) *BlobReferenceUpdate {
+	bru.mutation.AddTargetID(i)
+	return bru
+}
+
+// SetSize sets the "size" field.
+func (bru *BlobReferenceUpdate) SetSize(i int64) *BlobReferenceUpdate {
+	bru.mutation.ResetSize()
+	bru.mutation.SetSize(i)
+	return bru
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (bru *BlobReferenceUpdate) SetNillableSize(i *int64) *BlobReferenceUpdate {
+	if i != nil {
+		bru.SetSize(*i)
+	}
+	return bru
+}
+
+// AddSize adds i to the "size" field.
+func (bru *BlobReferenceUpdate) AddSize(i int64) *BlobReferenceUpdate {
+	bru.mutation.AddSize(i)
+	return bru
+}
+
+// SetBlobID sets the "blob" edge to the Blob entity by ID.
+func (bru *BlobReferenceUpdate) SetBlobID(id int) *BlobReferenceUpdate {
+	bru.mutation.SetBlobID(id)
+	return bru
+}
+
+// SetBlob sets the "blob" edge to the Blob entity.
+func (bru *BlobReferenceUpdate) SetBlob(b *Blob) *BlobReferenceUpdate {
+	return bru.SetBlobID(b.ID)
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (bru *BlobReferenceUpdate) SetOwnerID(id int) *BlobReferenceUpdate {
+	bru.mutation.SetOwnerID(id)
+	return bru
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (bru *BlobReferenceUpdate) SetOwner(u *User) *BlobReferenceUpdate {
+	return bru.SetOwnerID(u.ID)
+}
+
+// Mutation returns the BlobReferenceMutation object of the builder.
+func (bru *BlobReferenceUpdate) Mutation() *BlobReferenceMutation {
+	return bru.mutation
+}
+
+// ClearBlob clears the "blob" edge to the Blob entity.
+func (bru *BlobReferenceUpdate) ClearBlob() *BlobReferenceUpdate {
+	bru.mutation.ClearBlob()
+	return bru
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (bru *BlobReferenceUpdate) ClearOwner() *BlobReferenceUpdate {
+	bru.mutation.ClearOwner()
+	return bru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (bru *BlobReferenceUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, bru.sqlSave, bru.mutation, bru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bru *BlobReferenceUpdate) SaveX(ctx context.Context) int {
+	affected, err := bru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (bru *BlobReferenceUpdate) Exec(ctx context.Context) error {
+	_, err := bru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bru *BlobReferenceUpdate) ExecX(ctx context.Context) {
+	if err := bru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bru *BlobReferenceUpdate) check() error {
+	if v, ok := bru.mutation.Kind(); ok {
+		if err := blobreference.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "BlobReference.kind": %w`, err)}
+		}
+	}
+	if v, ok := bru.mutation.Size(); ok {
+		if err := blobreference.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "BlobReference.size": %w`, err)}
+		}
+	}
+	if bru.mutation.BlobCleared() && len(bru.mutation.BlobIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BlobReference.blob"`)
+	}
+	if bru.mutation.OwnerCleared() && len(bru.mutation.OwnerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BlobReference.owner"`)
+	}
+	return nil
+}
+
+func (bru *BlobReferenceUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := bru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(blobreference.Table, blobreference.Columns, sqlgraph.NewFieldSpec(blobreference.FieldID, field.TypeInt))
+	if ps := bru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := bru.mutation.Kind(); ok {
+		_spec.SetField(blobreference.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := bru.mutation.TargetID(); ok {
+		_spec.SetField(blobreference.FieldTargetID, field.TypeInt, value)
+	}
+	if value, ok := bru.mutation.AddedTargetID(); ok {
+		_spec.AddField(blobreference.FieldTargetID, field.TypeInt, value)
+	}
+	if value, ok := bru.mutation.Size(); ok {
+		_spec.SetField(blobreference.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := bru.mutation.AddedSize(); ok {
+		_spec.AddField(blobreference.FieldSize, field.TypeInt64, value)
+	}
+	if bru.mutation.BlobCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blobreference.BlobTable,
+			Columns: []string{blobreference.BlobColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blob.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bru.mutation.BlobIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blobreference.BlobTable,
+			Columns: []string{blobreference.BlobColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blob.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bru.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blobreference.OwnerTable,
+			Columns: []string{blobreference.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bru.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blobreference.OwnerTable,
+			Columns: []string{blobreference.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, bru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{blobreference.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	bru.mutation.done = true
+	return n, nil
+}
+
+// BlobReferenceUpdateOne is the builder for updating a single BlobReference entity.
+type BlobReferenceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BlobReferenceMutation
+}
+
+// SetKind sets the "kind" field.
+func (bruo *BlobReferenceUpdateOne) SetKind(b blobreference.Kind) *BlobReferenceUpdateOne {
+	bruo.mutation.SetKind(b)
+	return bruo
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (bruo *BlobReferenceUpdateOne) SetNillableKind(b *blobreference.Kind) *BlobReferenceUpdateOne {
+	if b != nil {
+		bruo.SetKind(*b)
+	}
+	return bruo
+}
+
+// SetTargetID sets the "target_id" field.
+func (bruo *BlobReferenceUpdateOne) SetTargetID(i int) *BlobReferenceUpdateOne {
+	bruo.mutation.ResetTargetID()
+	bruo.mutation.SetTargetID(i)
+	return bruo
+}
+
+// SetNillableTargetID sets the "target_id" field if the given value is not nil.
+func (bruo *BlobReferenceUpdateOne) SetNillableTargetID(i *int) *BlobReferenceUpdateOne {
+	if i != nil {
+		bruo.SetTargetID(*i)
+	}
+	return bruo
+}
+
+// AddTargetID adds i to the "target_id" field.
+func (bruo *BlobReferenceUpdateOne) AddTargetID(i int) *BlobReferenceUpdateOne {
+	bruo.mutation.AddTargetID(i)
+	return bruo
+}
+
+// SetSize sets the "size" field.
+func (bruo *BlobReferenceUpdateOne) SetSize(i int64) *BlobReferenceUpdateOne {
+	bruo.mutation.ResetSize()
+	bruo.mutation.SetSize(i)
+	return bruo
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (bruo *BlobReferenceUpdateOne) SetNillableSize(i *int64) *BlobReferenceUpdateOne {
+	if i != nil {
+		bruo.SetSize(*i)
+	}
+	return bruo
+}
+
+// AddSize adds i to the "size" field.
+func (bruo *BlobReferenceUpdateOne) AddSize(i int64) *BlobReferenceUpdateOne {
+	bruo.mutation.AddSize(i)
+	return bruo
+}
+
+// SetBlobID sets the "blob" edge to the Blob entity by ID.
+func (bruo *BlobReferenceUpdateOne) SetBlobID(id int) *BlobReferenceUpdateOne {
+	bruo.mutation.SetBlobID(id)
+	return bruo
+}
+
+// SetBlob sets the "blob" edge to the Blob entity.
+func (bruo *BlobReferenceUpdateOne) SetBlob(b *Blob) *BlobReferenceUpdateOne {
+	return bruo.SetBlobID(b.ID)
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (bruo *BlobReferenceUpdateOne) SetOwnerID(id int) *BlobReferenceUpdateOne {
+	bruo.mutation.SetOwnerID(id)
+	return bruo
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (bruo *BlobReferenceUpdateOne) SetOwner(u *User) *BlobReferenceUpdateOne {
+	return bruo.SetOwnerID(u.ID)
+}
+
+// Mutation returns the BlobReferenceMutation object of the builder.
+func (bruo *BlobReferenceUpdateOne) Mutation() *BlobReferenceMutation {
+	return bruo.mutation
+}
+
+// ClearBlob clears the "blob" edge to the Blob entity.
+func (bruo *BlobReferenceUpdateOne) ClearBlob() *BlobReferenceUpdateOne {
+	bruo.mutation.ClearBlob()
+	return bruo
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (bruo *BlobReferenceUpdateOne) ClearOwner() *BlobReferenceUpdateOne {
+	bruo.mutation.ClearOwner()
+	return bruo
+}
+
+// Where appends a list predicates to the BlobReferenceUpdate builder.
+func (bruo *BlobReferenceUpdateOne) Where(ps ...predicate.BlobReference) *BlobReferenceUpdateOne {
+	bruo.mutation.Where(ps...)
+	return bruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (bruo *BlobReferenceUpdateOne) Select(field string, fields ...string) *BlobReferenceUpdateOne {
+	bruo.fields = append([]string{field}, fields...)
+	return bruo
+}
+
+// Save executes the query and returns the updated BlobReference entity.
+func (bruo *BlobReferenceUpdateOne) Save(ctx context.Context) (*BlobReference, error) {
+	return withHooks(ctx, bruo.sqlSave, bruo.mutation, bruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bruo *BlobReferenceUpdateOne) SaveX(ctx context.Context) *BlobReference {
+	node, err := bruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (bruo *BlobReferenceUpdateOne) Exec(ctx context.Context) error {
+	_, err := bruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bruo *BlobReferenceUpdateOne) ExecX(ctx context.Context) {
+	if err := bruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bruo *BlobReferenceUpdateOne) check() error {
+	if v, ok := bruo.mutation.Kind(); ok {
+		if err := blobreference.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "BlobReference.kind": %w`, err)}
+		}
+	}
+	if v, ok := bruo.mutation.Size(); ok {
+		if err := blobreference.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "BlobReference.size": %w`, err)}
+		}
+	}
+	if bruo.mutation.BlobCleared() && len(bruo.mutation.BlobIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BlobReference.blob"`)
+	}
+	if bruo.mutation.OwnerCleared() && len(bruo.mutation.OwnerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BlobReference.owner"`)
+	}
+	return nil
+}
+
+func (bruo *BlobReferenceUpdateOne) sqlSave(ctx context.Context) (_node *BlobReference, err error) {
+	if err := bruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(blobreference.Table, blobreference.Columns, sqlgraph.NewFieldSpec(blobreference.FieldID, field.TypeInt))
+	id, ok := bruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BlobReference.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := bruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, blobreference.FieldID)
+		for _, f := range fields {
+			if !blobreference.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != blobreference.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := bruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := bruo.mutation.Kind(); ok {
+		_spec.SetField(blobreference.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := bruo.mutation.TargetID(); ok {
+		_spec.SetField(blobreference.FieldTargetID, field.TypeInt, value)
+	}
+	if value, ok := bruo.mutation.AddedTargetID(); ok {
+		_spec.AddField(blobreference.FieldTargetID, field.TypeInt, value)
+	}
+	if value, ok := bruo.mutation.Size(); ok {
+		_spec.SetField(blobreference.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := bruo.mutation.AddedSize(); ok {
+		_spec.AddField(blobreference.FieldSize, field.TypeInt64, value)
+	}
+	if bruo.mutation.BlobCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blobreference.BlobTable,
+			Columns: []string{blobreference.BlobColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blob.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bruo.mutation.BlobIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blobreference.BlobTable,
+			Columns: []string{blobreference.BlobColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blob.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bruo.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blobreference.OwnerTable,
+			Columns: []string{blobreference.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bruo.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blobreference.OwnerTable,
+			Columns: []string{blobreference.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &BlobReference{config: bruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, bruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{blobreference.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	bruo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,166 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/r-scheele/zero/ent/choice"
+	"github.com/r-scheele/zero/ent/question"
+)
+
+// Choice is the model entity for the Choice schema.
+type Choice struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Text holds the value of the "text" field.
+	Text string `json:"text,omitempty"`
+	// Correct holds the value of the "correct" field.
+	Correct bool `json:"correct,omitempty"`
+	// Order of the choice within the question
+	Position int `json:"position,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ChoiceQuery when eager-loading is set.
+	Edges            ChoiceEdges `json:"edges"`
+	question_choices *int
+	selectValues     sql.SelectValues
+}
+
+// ChoiceEdges holds the relations/edges for other nodes in the graph.
+type ChoiceEdges struct {
+	// Question holds the value of the question edge.
+	Question *Question `json:"question,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// QuestionOrErr returns the Question value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ChoiceEdges) QuestionOrErr() (*Question, error) {
+	if e.Question != nil {
+		return e.Question, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: question.Label}
+	}
+	return nil, &NotLoadedError{edge: "question"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Choice) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case choice.FieldCorrect:
+			values[i] = new(sql.NullBool)
+		case choice.FieldID, choice.FieldPosition:
+			values[i] = new(sql.NullInt64)
+		case choice.FieldText:
+			values[i] = new(sql.NullString)
+		case choice.ForeignKeys[0]: // question_choices
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Choice fields.
+func (c *Choice) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case choice.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			c.ID = int(value.Int64)
+		case choice.FieldText:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field text", values[i])
+			} else if value.Valid {
+				c.Text = value.String
+			}
+		case choice.FieldCorrect:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field correct", values[i])
+			} else if value.Valid {
+				c.Correct = value.Bool
+			}
+		case choice.FieldPosition:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field position", values[i])
+			} else if value.Valid {
+				c.Position = int(value.Int64)
+			}
+		case choice.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field question_choices", value)
+			} else if value.Valid {
+				c.question_choices = new(int)
+				*c.question_choices = int(value.Int64)
+			}
+		default:
+			c.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Choice.
+// This includes values selected through modifiers, order, etc.
+func (c *Choice) Value(name string) (ent.Value, error) {
+	return c.selectValues.Get(name)
+}
+
+// QueryQuestion queries the "question" edge of the Choice entity.
+func (c *Choice) QueryQuestion() *QuestionQuery {
+	return NewChoiceClient(c.config).QueryQuestion(c)
+}
+
+// Update returns a builder for updating this Choice.
+// Note that you need to call Choice.Unwrap() before calling this method if this Choice
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (c *Choice) Update() *ChoiceUpdateOne {
+	return NewChoiceClient(c.config).UpdateOne(c)
+}
+
+// Unwrap unwraps the Choice entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (c *Choice) Unwrap() *Choice {
+	_tx, ok := c.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Choice is not a transactional entity")
+	}
+	c.config.driver = _tx.drv
+	return c
+}
+
+// String implements the fmt.Stringer.
+func (c *Choice) String() string {
+	var builder strings.Builder
+	builder.WriteString("Choice(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", c.ID))
+	builder.WriteString("text=")
+	builder.WriteString(c.Text)
+	builder.WriteString(", ")
+	builder.WriteString("correct=")
+	builder.WriteString(fmt.Sprintf("%v", c.Correct))
+	builder.WriteString(", ")
+	builder.WriteString("position=")
+	builder.WriteString(fmt.Sprintf("%v", c.Position))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Choices is a parsable slice of Choice.
+type Choices []*Choice
@@ -0,0 +1,107 @@
+// Code generated by ent, DO NOT EDIT.
+
+package choice
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the choice type in the database.
+	Label = "choice"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldText holds the string denoting the text field in the database.
+	FieldText = "text"
+	// FieldCorrect holds the string denoting the correct field in the database.
+	FieldCorrect = "correct"
+	// FieldPosition holds the string denoting the position field in the database.
+	FieldPosition = "position"
+	// EdgeQuestion holds the string denoting the question edge name in mutations.
+	EdgeQuestion = "question"
+	// Table holds the table name of the choice in the database.
+	Table = "choices"
+	// QuestionTable is the table that holds the question relation/edge.
+	QuestionTable = "choices"
+	// QuestionInverseTable is the table name for the Question entity.
+	// It exists in this package in order to avoid circular dependency with the "question" package.
+	QuestionInverseTable = "questions"
+	// QuestionColumn is the table column denoting the question relation/edge.
+	QuestionColumn = "question_choices"
+)
+
+// Columns holds all SQL columns for choice fields.
+var Columns = []string{
+	FieldID,
+	FieldText,
+	FieldCorrect,
+	FieldPosition,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "choices"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"question_choices",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// TextValidator is a validator for the "text" field. It is called by the builders before save.
+	TextValidator func(string) error
+	// DefaultCorrect holds the default value on creation for the "correct" field.
+	DefaultCorrect bool
+	// DefaultPosition holds the default value on creation for the "position" field.
+	DefaultPosition int
+)
+
+// OrderOption defines the ordering options for the Choice queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByText orders the results by the text field.
+func ByText(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldText, opts...).ToFunc()
+}
+
+// ByCorrect orders the results by the correct field.
+func ByCorrect(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCorrect, opts...).ToFunc()
+}
+
+// ByPosition orders the results by the position field.
+func ByPosition(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPosition, opts...).ToFunc()
+}
+
+// ByQuestionField orders the results by question field.
+func ByQuestionField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newQuestionStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newQuestionStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(QuestionInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, QuestionTable, QuestionColumn),
+	)
+}
@@ -15,10 +15,29 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/r-scheele/zero/ent/blob"
+	"github.com/r-scheele/zero/ent/blobreference"
+	"github.com/r-scheele/zero/ent/choice"
+	"github.com/r-scheele/zero/ent/document"
+	"github.com/r-scheele/zero/ent/folder"
+	"github.com/r-scheele/zero/ent/groupinvite"
+	"github.com/r-scheele/zero/ent/groupjoinrequest"
+	"github.com/r-scheele/zero/ent/groupmembership"
 	"github.com/r-scheele/zero/ent/note"
+	"github.com/r-scheele/zero/ent/notebook"
 	"github.com/r-scheele/zero/ent/notelike"
+	"github.com/r-scheele/zero/ent/notelink"
+	"github.com/r-scheele/zero/ent/noteproposal"
 	"github.com/r-scheele/zero/ent/noterepost"
+	"github.com/r-scheele/zero/ent/noterevision"
 	"github.com/r-scheele/zero/ent/passwordtoken"
+	"github.com/r-scheele/zero/ent/question"
+	"github.com/r-scheele/zero/ent/quiz"
+	"github.com/r-scheele/zero/ent/quizattempt"
+	"github.com/r-scheele/zero/ent/resumableupload"
+	"github.com/r-scheele/zero/ent/studyevent"
+	"github.com/r-scheele/zero/ent/studygroup"
+	"github.com/r-scheele/zero/ent/tag"
 	"github.com/r-scheele/zero/ent/user"
 )
 
@@ -27,14 +46,52 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// Blob is the client for interacting with the Blob builders.
+	Blob *BlobClient
+	// BlobReference is the client for interacting with the BlobReference builders.
+	BlobReference *BlobReferenceClient
+	// Choice is the client for interacting with the Choice builders.
+	Choice *ChoiceClient
+	// Document is the client for interacting with the Document builders.
+	Document *DocumentClient
+	// Folder is the client for interacting with the Folder builders.
+	Folder *FolderClient
+	// GroupInvite is the client for interacting with the GroupInvite builders.
+	GroupInvite *GroupInviteClient
+	// GroupJoinRequest is the client for interacting with the GroupJoinRequest builders.
+	GroupJoinRequest *GroupJoinRequestClient
+	// GroupMembership is the client for interacting with the GroupMembership builders.
+	GroupMembership *GroupMembershipClient
 	// Note is the client for interacting with the Note builders.
 	Note *NoteClient
 	// NoteLike is the client for interacting with the NoteLike builders.
 	NoteLike *NoteLikeClient
+	// NoteLink is the client for interacting with the NoteLink builders.
+	NoteLink *NoteLinkClient
+	// NoteProposal is the client for interacting with the NoteProposal builders.
+	NoteProposal *NoteProposalClient
 	// NoteRepost is the client for interacting with the NoteRepost builders.
 	NoteRepost *NoteRepostClient
+	// NoteRevision is the client for interacting with the NoteRevision builders.
+	NoteRevision *NoteRevisionClient
+	// Notebook is the client for interacting with the Notebook builders.
+	Notebook *NotebookClient
 	// PasswordToken is the client for interacting with the PasswordToken builders.
 	PasswordToken *PasswordTokenClient
+	// Question is the client for interacting with the Question builders.
+	Question *QuestionClient
+	// Quiz is the client for interacting with the Quiz builders.
+	Quiz *QuizClient
+	// QuizAttempt is the client for interacting with the QuizAttempt builders.
+	QuizAttempt *QuizAttemptClient
+	// ResumableUpload is the client for interacting with the ResumableUpload builders.
+	ResumableUpload *ResumableUploadClient
+	// StudyEvent is the client for interacting with the StudyEvent builders.
+	StudyEvent *StudyEventClient
+	// StudyGroup is the client for interacting with the StudyGroup builders.
+	StudyGroup *StudyGroupClient
+	// Tag is the client for interacting with the Tag builders.
+	Tag *TagClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 }
@@ -48,10 +105,29 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.Blob = NewBlobClient(c.config)
+	c.BlobReference = NewBlobReferenceClient(c.config)
+	c.Choice = NewChoiceClient(c.config)
+	c.Document = NewDocumentClient(c.config)
+	c.Folder = NewFolderClient(c.config)
+	c.GroupInvite = NewGroupInviteClient(c.config)
+	c.GroupJoinRequest = NewGroupJoinRequestClient(c.config)
+	c.GroupMembership = NewGroupMembershipClient(c.config)
 	c.Note = NewNoteClient(c.config)
 	c.NoteLike = NewNoteLikeClient(c.config)
+	c.NoteLink = NewNoteLinkClient(c.config)
+	c.NoteProposal = NewNoteProposalClient(c.config)
 	c.NoteRepost = NewNoteRepostClient(c.config)
+	c.NoteRevision = NewNoteRevisionClient(c.config)
+	c.Notebook = NewNotebookClient(c.config)
 	c.PasswordToken = NewPasswordTokenClient(c.config)
+	c.Question = NewQuestionClient(c.config)
+	c.Quiz = NewQuizClient(c.config)
+	c.QuizAttempt = NewQuizAttemptClient(c.config)
+	c.ResumableUpload = NewResumableUploadClient(c.config)
+	c.StudyEvent = NewStudyEventClient(c.config)
+	c.StudyGroup = NewStudyGroupClient(c.config)
+	c.Tag = NewTagClient(c.config)
 	c.User = NewUserClient(c.config)
 }
 
@@ -143,13 +219,32 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:           ctx,
-		config:        cfg,
-		Note:          NewNoteClient(cfg),
-		NoteLike:      NewNoteLikeClient(cfg),
-		NoteRepost:    NewNoteRepostClient(cfg),
-		PasswordToken: NewPasswordTokenClient(cfg),
-		User:          NewUserClient(cfg),
+		ctx:              ctx,
+		config:           cfg,
+		Blob:             NewBlobClient(cfg),
+		BlobReference:    NewBlobReferenceClient(cfg),
+		Choice:           NewChoiceClient(cfg),
+		Document:         NewDocumentClient(cfg),
+		Folder:           NewFolderClient(cfg),
+		GroupInvite:      NewGroupInviteClient(cfg),
+		GroupJoinRequest: NewGroupJoinRequestClient(cfg),
+		GroupMembership:  NewGroupMembershipClient(cfg),
+		Note:             NewNoteClient(cfg),
+		NoteLike:         NewNoteLikeClient(cfg),
+		NoteLink:         NewNoteLinkClient(cfg),
+		NoteProposal:     NewNoteProposalClient(cfg),
+		NoteRepost:       NewNoteRepostClient(cfg),
+		NoteRevision:     NewNoteRevisionClient(cfg),
+		Notebook:         NewNotebookClient(cfg),
+		PasswordToken:    NewPasswordTokenClient(cfg),
+		Question:         NewQuestionClient(cfg),
+		Quiz:             NewQuizClient(cfg),
+		QuizAttempt:      NewQuizAttemptClient(cfg),
+		ResumableUpload:  NewResumableUploadClient(cfg),
+		StudyEvent:       NewStudyEventClient(cfg),
+		StudyGroup:       NewStudyGroupClient(cfg),
+		Tag:              NewTagClient(cfg),
+		User:             NewUserClient(cfg),
 	}, nil
 }
 
@@ -167,20 +262,39 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:           ctx,
-		config:        cfg,
-		Note:          NewNoteClient(cfg),
-		NoteLike:      NewNoteLikeClient(cfg),
-		NoteRepost:    NewNoteRepostClient(cfg),
-		PasswordToken: NewPasswordTokenClient(cfg),
-		User:          NewUserClient(cfg),
+		ctx:              ctx,
+		config:           cfg,
+		Blob:             NewBlobClient(cfg),
+		BlobReference:    NewBlobReferenceClient(cfg),
+		Choice:           NewChoiceClient(cfg),
+		Document:         NewDocumentClient(cfg),
+		Folder:           NewFolderClient(cfg),
+		GroupInvite:      NewGroupInviteClient(cfg),
+		GroupJoinRequest: NewGroupJoinRequestClient(cfg),
+		GroupMembership:  NewGroupMembershipClient(cfg),
+		Note:             NewNoteClient(cfg),
+		NoteLike:         NewNoteLikeClient(cfg),
+		NoteLink:         NewNoteLinkClient(cfg),
+		NoteProposal:     NewNoteProposalClient(cfg),
+		NoteRepost:       NewNoteRepostClient(cfg),
+		NoteRevision:     NewNoteRevisionClient(cfg),
+		Notebook:         NewNotebookClient(cfg),
+		PasswordToken:    NewPasswordTokenClient(cfg),
+		Question:         NewQuestionClient(cfg),
+		Quiz:             NewQuizClient(cfg),
+		QuizAttempt:      NewQuizAttemptClient(cfg),
+		ResumableUpload:  NewResumableUploadClient(cfg),
+		StudyEvent:       NewStudyEventClient(cfg),
+		StudyGroup:       NewStudyGroupClient(cfg),
+		Tag:              NewTagClient(cfg),
+		User:             NewUserClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Note.
+//		Blob.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -202,34 +316,80 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.Note.Use(hooks...)
-	c.NoteLike.Use(hooks...)
-	c.NoteRepost.Use(hooks...)
-	c.PasswordToken.Use(hooks...)
-	c.User.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.Blob, c.BlobReference, c.Choice, c.Document, c.Folder, c.GroupInvite,
+		c.GroupJoinRequest, c.GroupMembership, c.Note, c.NoteLike, c.NoteLink,
+		c.NoteProposal, c.NoteRepost, c.NoteRevision, c.Notebook, c.PasswordToken,
+		c.Question, c.Quiz, c.QuizAttempt, c.ResumableUpload, c.StudyEvent,
+		c.StudyGroup, c.Tag, c.User,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.Note.Intercept(interceptors...)
-	c.NoteLike.Intercept(interceptors...)
-	c.NoteRepost.Intercept(interceptors...)
-	c.PasswordToken.Intercept(interceptors...)
-	c.User.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.Blob, c.BlobReference, c.Choice, c.Document, c.Folder, c.GroupInvite,
+		c.GroupJoinRequest, c.GroupMembership, c.Note, c.NoteLike, c.NoteLink,
+		c.NoteProposal, c.NoteRepost, c.NoteRevision, c.Notebook, c.PasswordToken,
+		c.Question, c.Quiz, c.QuizAttempt, c.ResumableUpload, c.StudyEvent,
+		c.StudyGroup, c.Tag, c.User,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *BlobMutation:
+		return c.Blob.mutate(ctx, m)
+	case *BlobReferenceMutation:
+		return c.BlobReference.mutate(ctx, m)
+	case *ChoiceMutation:
+		return c.Choice.mutate(ctx, m)
+	case *DocumentMutation:
+		return c.Document.mutate(ctx, m)
+	case *FolderMutation:
+		return c.Folder.mutate(ctx, m)
+	case *GroupInviteMutation:
+		return c.GroupInvite.mutate(ctx, m)
+	case *GroupJoinRequestMutation:
+		return c.GroupJoinRequest.mutate(ctx, m)
+	case *GroupMembershipMutation:
+		return c.GroupMembership.mutate(ctx, m)
 	case *NoteMutation:
 		return c.Note.mutate(ctx, m)
 	case *NoteLikeMutation:
 		return c.NoteLike.mutate(ctx, m)
+	case *NoteLinkMutation:
+		return c.NoteLink.mutate(ctx, m)
+	case *NoteProposalMutation:
+		return c.NoteProposal.mutate(ctx, m)
 	case *NoteRepostMutation:
 		return c.NoteRepost.mutate(ctx, m)
+	case *NoteRevisionMutation:
+		return c.NoteRevision.mutate(ctx, m)
+	case *NotebookMutation:
+		return c.Notebook.mutate(ctx, m)
 	case *PasswordTokenMutation:
 		return c.PasswordToken.mutate(ctx, m)
+	case *QuestionMutation:
+		return c.Question.mutate(ctx, m)
+	case *QuizMutation:
+		return c.Quiz.mutate(ctx, m)
+	case *QuizAttemptMutation:
+		return c.QuizAttempt.mutate(ctx, m)
+	case *ResumableUploadMutation:
+		return c.ResumableUpload.mutate(ctx, m)
+	case *StudyEventMutation:
+		return c.StudyEvent.mutate(ctx, m)
+	case *StudyGroupMutation:
+		return c.StudyGroup.mutate(ctx, m)
+	case *TagMutation:
+		return c.Tag.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
 	default:
@@ -237,107 +397,107 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	}
 }
 
-// NoteClient is a client for the Note schema.
-type NoteClient struct {
+// BlobClient is a client for the Blob schema.
+type BlobClient struct {
 	config
 }
 
-// NewNoteClient returns a client for the Note from the given config.
-func NewNoteClient(c config) *NoteClient {
-	return &NoteClient{config: c}
+// NewBlobClient returns a client for the Blob from the given config.
+func NewBlobClient(c config) *BlobClient {
+	return &BlobClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `note.Hooks(f(g(h())))`.
-func (c *NoteClient) Use(hooks ...Hook) {
-	c.hooks.Note = append(c.hooks.Note, hooks...)
+// A call to `Use(f, g, h)` equals to `blob.Hooks(f(g(h())))`.
+func (c *BlobClient) Use(hooks ...Hook) {
+	c.hooks.Blob = append(c.hooks.Blob, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `note.Intercept(f(g(h())))`.
-func (c *NoteClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Note = append(c.inters.Note, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `blob.Intercept(f(g(h())))`.
+func (c *BlobClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Blob = append(c.inters.Blob, interceptors...)
 }
 
-// Create returns a builder for creating a Note entity.
-func (c *NoteClient) Create() *NoteCreate {
-	mutation := newNoteMutation(c.config, OpCreate)
-	return &NoteCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Blob entity.
+func (c *BlobClient) Create() *BlobCreate {
+	mutation := newBlobMutation(c.config, OpCreate)
+	return &BlobCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Note entities.
-func (c *NoteClient) CreateBulk(builders ...*NoteCreate) *NoteCreateBulk {
-	return &NoteCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Blob entities.
+func (c *BlobClient) CreateBulk(builders ...*BlobCreate) *BlobCreateBulk {
+	return &BlobCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *NoteClient) MapCreateBulk(slice any, setFunc func(*NoteCreate, int)) *NoteCreateBulk {
+func (c *BlobClient) MapCreateBulk(slice any, setFunc func(*BlobCreate, int)) *BlobCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &NoteCreateBulk{err: fmt.Errorf("calling to NoteClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &BlobCreateBulk{err: fmt.Errorf("calling to BlobClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*NoteCreate, rv.Len())
+	builders := make([]*BlobCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &NoteCreateBulk{config: c.config, builders: builders}
+	return &BlobCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Note.
-func (c *NoteClient) Update() *NoteUpdate {
-	mutation := newNoteMutation(c.config, OpUpdate)
-	return &NoteUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Blob.
+func (c *BlobClient) Update() *BlobUpdate {
+	mutation := newBlobMutation(c.config, OpUpdate)
+	return &BlobUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *NoteClient) UpdateOne(n *Note) *NoteUpdateOne {
-	mutation := newNoteMutation(c.config, OpUpdateOne, withNote(n))
-	return &NoteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BlobClient) UpdateOne(b *Blob) *BlobUpdateOne {
+	mutation := newBlobMutation(c.config, OpUpdateOne, withBlob(b))
+	return &BlobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *NoteClient) UpdateOneID(id int) *NoteUpdateOne {
-	mutation := newNoteMutation(c.config, OpUpdateOne, withNoteID(id))
-	return &NoteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BlobClient) UpdateOneID(id int) *BlobUpdateOne {
+	mutation := newBlobMutation(c.config, OpUpdateOne, withBlobID(id))
+	return &BlobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Note.
-func (c *NoteClient) Delete() *NoteDelete {
-	mutation := newNoteMutation(c.config, OpDelete)
-	return &NoteDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Blob.
+func (c *BlobClient) Delete() *BlobDelete {
+	mutation := newBlobMutation(c.config, OpDelete)
+	return &BlobDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *NoteClient) DeleteOne(n *Note) *NoteDeleteOne {
-	return c.DeleteOneID(n.ID)
+func (c *BlobClient) DeleteOne(b *Blob) *BlobDeleteOne {
+	return c.DeleteOneID(b.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *NoteClient) DeleteOneID(id int) *NoteDeleteOne {
-	builder := c.Delete().Where(note.ID(id))
+func (c *BlobClient) DeleteOneID(id int) *BlobDeleteOne {
+	builder := c.Delete().Where(blob.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &NoteDeleteOne{builder}
+	return &BlobDeleteOne{builder}
 }
 
-// Query returns a query builder for Note.
-func (c *NoteClient) Query() *NoteQuery {
-	return &NoteQuery{
+// Query returns a query builder for Blob.
+func (c *BlobClient) Query() *BlobQuery {
+	return &BlobQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeNote},
+		ctx:    &QueryContext{Type: TypeBlob},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Note entity by its id.
-func (c *NoteClient) Get(ctx context.Context, id int) (*Note, error) {
-	return c.Query().Where(note.ID(id)).Only(ctx)
+// Get returns a Blob entity by its id.
+func (c *BlobClient) Get(ctx context.Context, id int) (*Blob, error) {
+	return c.Query().Where(blob.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *NoteClient) GetX(ctx context.Context, id int) *Note {
+func (c *BlobClient) GetX(ctx context.Context, id int) *Blob {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -345,180 +505,148 @@ func (c *NoteClient) GetX(ctx context.Context, id int) *Note {
 	return obj
 }
 
-// QueryOwner queries the owner edge of a Note.
-func (c *NoteClient) QueryOwner(n *Note) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := n.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(note.Table, note.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, note.OwnerTable, note.OwnerColumn),
-		)
-		fromV = sqlgraph.Neighbors(n.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryLikes queries the likes edge of a Note.
-func (c *NoteClient) QueryLikes(n *Note) *NoteLikeQuery {
-	query := (&NoteLikeClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := n.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(note.Table, note.FieldID, id),
-			sqlgraph.To(notelike.Table, notelike.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, note.LikesTable, note.LikesColumn),
-		)
-		fromV = sqlgraph.Neighbors(n.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryReposts queries the reposts edge of a Note.
-func (c *NoteClient) QueryReposts(n *Note) *NoteRepostQuery {
-	query := (&NoteRepostClient{config: c.config}).Query()
+// QueryReferences queries the references edge of a Blob.
+func (c *BlobClient) QueryReferences(b *Blob) *BlobReferenceQuery {
+	query := (&BlobReferenceClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := n.ID
+		id := b.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(note.Table, note.FieldID, id),
-			sqlgraph.To(noterepost.Table, noterepost.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, note.RepostsTable, note.RepostsColumn),
+			sqlgraph.From(blob.Table, blob.FieldID, id),
+			sqlgraph.To(blobreference.Table, blobreference.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, blob.ReferencesTable, blob.ReferencesColumn),
 		)
-		fromV = sqlgraph.Neighbors(n.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(b.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *NoteClient) Hooks() []Hook {
-	return c.hooks.Note
+func (c *BlobClient) Hooks() []Hook {
+	return c.hooks.Blob
 }
 
 // Interceptors returns the client interceptors.
-func (c *NoteClient) Interceptors() []Interceptor {
-	return c.inters.Note
+func (c *BlobClient) Interceptors() []Interceptor {
+	return c.inters.Blob
 }
 
-func (c *NoteClient) mutate(ctx context.Context, m *NoteMutation) (Value, error) {
+func (c *BlobClient) mutate(ctx context.Context, m *BlobMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&NoteCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BlobCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&NoteUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BlobUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&NoteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BlobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&NoteDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&BlobDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Note mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Blob mutation op: %q", m.Op())
 	}
 }
 
-// NoteLikeClient is a client for the NoteLike schema.
-type NoteLikeClient struct {
+// BlobReferenceClient is a client for the BlobReference schema.
+type BlobReferenceClient struct {
 	config
 }
 
-// NewNoteLikeClient returns a client for the NoteLike from the given config.
-func NewNoteLikeClient(c config) *NoteLikeClient {
-	return &NoteLikeClient{config: c}
+// NewBlobReferenceClient returns a client for the BlobReference from the given config.
+func NewBlobReferenceClient(c config) *BlobReferenceClient {
+	return &BlobReferenceClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `notelike.Hooks(f(g(h())))`.
-func (c *NoteLikeClient) Use(hooks ...Hook) {
-	c.hooks.NoteLike = append(c.hooks.NoteLike, hooks...)
+// A call to `Use(f, g, h)` equals to `blobreference.Hooks(f(g(h())))`.
+func (c *BlobReferenceClient) Use(hooks ...Hook) {
+	c.hooks.BlobReference = append(c.hooks.BlobReference, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `notelike.Intercept(f(g(h())))`.
-func (c *NoteLikeClient) Intercept(interceptors ...Interceptor) {
-	c.inters.NoteLike = append(c.inters.NoteLike, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `blobreference.Intercept(f(g(h())))`.
+func (c *BlobReferenceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BlobReference = append(c.inters.BlobReference, interceptors...)
 }
 
-// Create returns a builder for creating a NoteLike entity.
-func (c *NoteLikeClient) Create() *NoteLikeCreate {
-	mutation := newNoteLikeMutation(c.config, OpCreate)
-	return &NoteLikeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a BlobReference entity.
+func (c *BlobReferenceClient) Create() *BlobReferenceCreate {
+	mutation := newBlobReferenceMutation(c.config, OpCreate)
+	return &BlobReferenceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of NoteLike entities.
-func (c *NoteLikeClient) CreateBulk(builders ...*NoteLikeCreate) *NoteLikeCreateBulk {
-	return &NoteLikeCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of BlobReference entities.
+func (c *BlobReferenceClient) CreateBulk(builders ...*BlobReferenceCreate) *BlobReferenceCreateBulk {
+	return &BlobReferenceCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *NoteLikeClient) MapCreateBulk(slice any, setFunc func(*NoteLikeCreate, int)) *NoteLikeCreateBulk {
+func (c *BlobReferenceClient) MapCreateBulk(slice any, setFunc func(*BlobReferenceCreate, int)) *BlobReferenceCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &NoteLikeCreateBulk{err: fmt.Errorf("calling to NoteLikeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &BlobReferenceCreateBulk{err: fmt.Errorf("calling to BlobReferenceClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*NoteLikeCreate, rv.Len())
+	builders := make([]*BlobReferenceCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &NoteLikeCreateBulk{config: c.config, builders: builders}
+	return &BlobReferenceCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for NoteLike.
-func (c *NoteLikeClient) Update() *NoteLikeUpdate {
-	mutation := newNoteLikeMutation(c.config, OpUpdate)
-	return &NoteLikeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for BlobReference.
+func (c *BlobReferenceClient) Update() *BlobReferenceUpdate {
+	mutation := newBlobReferenceMutation(c.config, OpUpdate)
+	return &BlobReferenceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *NoteLikeClient) UpdateOne(nl *NoteLike) *NoteLikeUpdateOne {
-	mutation := newNoteLikeMutation(c.config, OpUpdateOne, withNoteLike(nl))
-	return &NoteLikeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BlobReferenceClient) UpdateOne(br *BlobReference) *BlobReferenceUpdateOne {
+	mutation := newBlobReferenceMutation(c.config, OpUpdateOne, withBlobReference(br))
+	return &BlobReferenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *NoteLikeClient) UpdateOneID(id int) *NoteLikeUpdateOne {
-	mutation := newNoteLikeMutation(c.config, OpUpdateOne, withNoteLikeID(id))
-	return &NoteLikeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BlobReferenceClient) UpdateOneID(id int) *BlobReferenceUpdateOne {
+	mutation := newBlobReferenceMutation(c.config, OpUpdateOne, withBlobReferenceID(id))
+	return &BlobReferenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for NoteLike.
-func (c *NoteLikeClient) Delete() *NoteLikeDelete {
-	mutation := newNoteLikeMutation(c.config, OpDelete)
-	return &NoteLikeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for BlobReference.
+func (c *BlobReferenceClient) Delete() *BlobReferenceDelete {
+	mutation := newBlobReferenceMutation(c.config, OpDelete)
+	return &BlobReferenceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *NoteLikeClient) DeleteOne(nl *NoteLike) *NoteLikeDeleteOne {
-	return c.DeleteOneID(nl.ID)
+func (c *BlobReferenceClient) DeleteOne(br *BlobReference) *BlobReferenceDeleteOne {
+	return c.DeleteOneID(br.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *NoteLikeClient) DeleteOneID(id int) *NoteLikeDeleteOne {
-	builder := c.Delete().Where(notelike.ID(id))
+func (c *BlobReferenceClient) DeleteOneID(id int) *BlobReferenceDeleteOne {
+	builder := c.Delete().Where(blobreference.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &NoteLikeDeleteOne{builder}
+	return &BlobReferenceDeleteOne{builder}
 }
 
-// Query returns a query builder for NoteLike.
-func (c *NoteLikeClient) Query() *NoteLikeQuery {
-	return &NoteLikeQuery{
+// Query returns a query builder for BlobReference.
+func (c *BlobReferenceClient) Query() *BlobReferenceQuery {
+	return &BlobReferenceQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeNoteLike},
+		ctx:    &QueryContext{Type: TypeBlobReference},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a NoteLike entity by its id.
-func (c *NoteLikeClient) Get(ctx context.Context, id int) (*NoteLike, error) {
-	return c.Query().Where(notelike.ID(id)).Only(ctx)
+// Get returns a BlobReference entity by its id.
+func (c *BlobReferenceClient) Get(ctx context.Context, id int) (*BlobReference, error) {
+	return c.Query().Where(blobreference.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *NoteLikeClient) GetX(ctx context.Context, id int) *NoteLike {
+func (c *BlobReferenceClient) GetX(ctx context.Context, id int) *BlobReference {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -526,329 +654,3800 @@ func (c *NoteLikeClient) GetX(ctx context.Context, id int) *NoteLike {
 	return obj
 }
 
-// QueryUser queries the user edge of a NoteLike.
-func (c *NoteLikeClient) QueryUser(nl *NoteLike) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
+// QueryBlob queries the blob edge of a BlobReference.
+func (c *BlobReferenceClient) QueryBlob(br *BlobReference) *BlobQuery {
+	query := (&BlobClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := nl.ID
+		id := br.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(notelike.Table, notelike.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, notelike.UserTable, notelike.UserColumn),
+			sqlgraph.From(blobreference.Table, blobreference.FieldID, id),
+			sqlgraph.To(blob.Table, blob.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, blobreference.BlobTable, blobreference.BlobColumn),
 		)
-		fromV = sqlgraph.Neighbors(nl.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(br.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryNote queries the note edge of a NoteLike.
-func (c *NoteLikeClient) QueryNote(nl *NoteLike) *NoteQuery {
-	query := (&NoteClient{config: c.config}).Query()
+// QueryOwner queries the owner edge of a BlobReference.
+func (c *BlobReferenceClient) QueryOwner(br *BlobReference) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := nl.ID
+		id := br.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(notelike.Table, notelike.FieldID, id),
-			sqlgraph.To(note.Table, note.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, notelike.NoteTable, notelike.NoteColumn),
+			sqlgraph.From(blobreference.Table, blobreference.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, blobreference.OwnerTable, blobreference.OwnerColumn),
 		)
-		fromV = sqlgraph.Neighbors(nl.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(br.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *NoteLikeClient) Hooks() []Hook {
-	return c.hooks.NoteLike
+func (c *BlobReferenceClient) Hooks() []Hook {
+	return c.hooks.BlobReference
 }
 
 // Interceptors returns the client interceptors.
-func (c *NoteLikeClient) Interceptors() []Interceptor {
-	return c.inters.NoteLike
+func (c *BlobReferenceClient) Interceptors() []Interceptor {
+	return c.inters.BlobReference
 }
 
-func (c *NoteLikeClient) mutate(ctx context.Context, m *NoteLikeMutation) (Value, error) {
+func (c *BlobReferenceClient) mutate(ctx context.Context, m *BlobReferenceMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&NoteLikeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BlobReferenceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&NoteLikeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BlobReferenceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&NoteLikeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BlobReferenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&NoteLikeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&BlobReferenceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown NoteLike mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown BlobReference mutation op: %q", m.Op())
 	}
 }
 
-// NoteRepostClient is a client for the NoteRepost schema.
-type NoteRepostClient struct {
+// ChoiceClient is a client for the Choice schema.
+type ChoiceClient struct {
 	config
 }
 
-// NewNoteRepostClient returns a client for the NoteRepost from the given config.
-func NewNoteRepostClient(c config) *NoteRepostClient {
-	return &NoteRepostClient{config: c}
+// NewChoiceClient returns a client for the Choice from the given config.
+func NewChoiceClient(c config) *ChoiceClient {
+	return &ChoiceClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `noterepost.Hooks(f(g(h())))`.
-func (c *NoteRepostClient) Use(hooks ...Hook) {
-	c.hooks.NoteRepost = append(c.hooks.NoteRepost, hooks...)
+// A call to `Use(f, g, h)` equals to `choice.Hooks(f(g(h())))`.
+func (c *ChoiceClient) Use(hooks ...Hook) {
+	c.hooks.Choice = append(c.hooks.Choice, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `noterepost.Intercept(f(g(h())))`.
-func (c *NoteRepostClient) Intercept(interceptors ...Interceptor) {
-	c.inters.NoteRepost = append(c.inters.NoteRepost, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `choice.Intercept(f(g(h())))`.
+func (c *ChoiceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Choice = append(c.inters.Choice, interceptors...)
 }
 
-// Create returns a builder for creating a NoteRepost entity.
-func (c *NoteRepostClient) Create() *NoteRepostCreate {
-	mutation := newNoteRepostMutation(c.config, OpCreate)
-	return &NoteRepostCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Choice entity.
+func (c *ChoiceClient) Create() *ChoiceCreate {
+	mutation := newChoiceMutation(c.config, OpCreate)
+	return &ChoiceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of NoteRepost entities.
-func (c *NoteRepostClient) CreateBulk(builders ...*NoteRepostCreate) *NoteRepostCreateBulk {
-	return &NoteRepostCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Choice entities.
+func (c *ChoiceClient) CreateBulk(builders ...*ChoiceCreate) *ChoiceCreateBulk {
+	return &ChoiceCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *NoteRepostClient) MapCreateBulk(slice any, setFunc func(*NoteRepostCreate, int)) *NoteRepostCreateBulk {
+func (c *ChoiceClient) MapCreateBulk(slice any, setFunc func(*ChoiceCreate, int)) *ChoiceCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &NoteRepostCreateBulk{err: fmt.Errorf("calling to NoteRepostClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ChoiceCreateBulk{err: fmt.Errorf("calling to ChoiceClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*NoteRepostCreate, rv.Len())
+	builders := make([]*ChoiceCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &NoteRepostCreateBulk{config: c.config, builders: builders}
+	return &ChoiceCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for NoteRepost.
-func (c *NoteRepostClient) Update() *NoteRepostUpdate {
-	mutation := newNoteRepostMutation(c.config, OpUpdate)
-	return &NoteRepostUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Choice.
+func (c *ChoiceClient) Update() *ChoiceUpdate {
+	mutation := newChoiceMutation(c.config, OpUpdate)
+	return &ChoiceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *NoteRepostClient) UpdateOne(nr *NoteRepost) *NoteRepostUpdateOne {
-	mutation := newNoteRepostMutation(c.config, OpUpdateOne, withNoteRepost(nr))
-	return &NoteRepostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ChoiceClient) UpdateOne(ch *Choice) *ChoiceUpdateOne {
+	mutation := newChoiceMutation(c.config, OpUpdateOne, withChoice(ch))
+	return &ChoiceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *NoteRepostClient) UpdateOneID(id int) *NoteRepostUpdateOne {
-	mutation := newNoteRepostMutation(c.config, OpUpdateOne, withNoteRepostID(id))
-	return &NoteRepostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ChoiceClient) UpdateOneID(id int) *ChoiceUpdateOne {
+	mutation := newChoiceMutation(c.config, OpUpdateOne, withChoiceID(id))
+	return &ChoiceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for NoteRepost.
-func (c *NoteRepostClient) Delete() *NoteRepostDelete {
-	mutation := newNoteRepostMutation(c.config, OpDelete)
-	return &NoteRepostDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Choice.
+func (c *ChoiceClient) Delete() *ChoiceDelete {
+	mutation := newChoiceMutation(c.config, OpDelete)
+	return &ChoiceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *NoteRepostClient) DeleteOne(nr *NoteRepost) *NoteRepostDeleteOne {
-	return c.DeleteOneID(nr.ID)
+func (c *ChoiceClient) DeleteOne(ch *Choice) *ChoiceDeleteOne {
+	return c.DeleteOneID(ch.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *NoteRepostClient) DeleteOneID(id int) *NoteRepostDeleteOne {
-	builder := c.Delete().Where(noterepost.ID(id))
+func (c *ChoiceClient) DeleteOneID(id int) *ChoiceDeleteOne {
+	builder := c.Delete().Where(choice.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &NoteRepostDeleteOne{builder}
+	return &ChoiceDeleteOne{builder}
 }
 
-// Query returns a query builder for NoteRepost.
-func (c *NoteRepostClient) Query() *NoteRepostQuery {
-	return &NoteRepostQuery{
+// Query returns a query builder for Choice.
+func (c *ChoiceClient) Query() *ChoiceQuery {
+	return &ChoiceQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeNoteRepost},
+		ctx:    &QueryContext{Type: TypeChoice},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a NoteRepost entity by its id.
-func (c *NoteRepostClient) Get(ctx context.Context, id int) (*NoteRepost, error) {
-	return c.Query().Where(noterepost.ID(id)).Only(ctx)
+// Get returns a Choice entity by its id.
+func (c *ChoiceClient) Get(ctx context.Context, id int) (*Choice, error) {
+	return c.Query().Where(choice.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *NoteRepostClient) GetX(ctx context.Context, id int) *NoteRepost {
+func (c *ChoiceClient) GetX(ctx context.Context, id int) *Choice {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
 	}
-	return obj
+	return obj
+}
+
+// QueryQuestion queries the question edge of a Choice.
+func (c *ChoiceClient) QueryQuestion(ch *Choice) *QuestionQuery {
+	query := (&QuestionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ch.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(choice.Table, choice.FieldID, id),
+			sqlgraph.To(question.Table, question.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, choice.QuestionTable, choice.QuestionColumn),
+		)
+		fromV = sqlgraph.Neighbors(ch.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ChoiceClient) Hooks() []Hook {
+	return c.hooks.Choice
+}
+
+// Interceptors returns the client interceptors.
+func (c *ChoiceClient) Interceptors() []Interceptor {
+	return c.inters.Choice
+}
+
+func (c *ChoiceClient) mutate(ctx context.Context, m *ChoiceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ChoiceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ChoiceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ChoiceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ChoiceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Choice mutation op: %q", m.Op())
+	}
+}
+
+// DocumentClient is a client for the Document schema.
+type DocumentClient struct {
+	config
+}
+
+// NewDocumentClient returns a client for the Document from the given config.
+func NewDocumentClient(c config) *DocumentClient {
+	return &DocumentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `document.Hooks(f(g(h())))`.
+func (c *DocumentClient) Use(hooks ...Hook) {
+	c.hooks.Document = append(c.hooks.Document, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `document.Intercept(f(g(h())))`.
+func (c *DocumentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Document = append(c.inters.Document, interceptors...)
+}
+
+// Create returns a builder for creating a Document entity.
+func (c *DocumentClient) Create() *DocumentCreate {
+	mutation := newDocumentMutation(c.config, OpCreate)
+	return &DocumentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Document entities.
+func (c *DocumentClient) CreateBulk(builders ...*DocumentCreate) *DocumentCreateBulk {
+	return &DocumentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DocumentClient) MapCreateBulk(slice any, setFunc func(*DocumentCreate, int)) *DocumentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DocumentCreateBulk{err: fmt.Errorf("calling to DocumentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DocumentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DocumentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Document.
+func (c *DocumentClient) Update() *DocumentUpdate {
+	mutation := newDocumentMutation(c.config, OpUpdate)
+	return &DocumentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DocumentClient) UpdateOne(d *Document) *DocumentUpdateOne {
+	mutation := newDocumentMutation(c.config, OpUpdateOne, withDocument(d))
+	return &DocumentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DocumentClient) UpdateOneID(id int) *DocumentUpdateOne {
+	mutation := newDocumentMutation(c.config, OpUpdateOne, withDocumentID(id))
+	return &DocumentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Document.
+func (c *DocumentClient) Delete() *DocumentDelete {
+	mutation := newDocumentMutation(c.config, OpDelete)
+	return &DocumentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DocumentClient) DeleteOne(d *Document) *DocumentDeleteOne {
+	return c.DeleteOneID(d.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DocumentClient) DeleteOneID(id int) *DocumentDeleteOne {
+	builder := c.Delete().Where(document.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DocumentDeleteOne{builder}
+}
+
+// Query returns a query builder for Document.
+func (c *DocumentClient) Query() *DocumentQuery {
+	return &DocumentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDocument},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Document entity by its id.
+func (c *DocumentClient) Get(ctx context.Context, id int) (*Document, error) {
+	return c.Query().Where(document.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DocumentClient) GetX(ctx context.Context, id int) *Document {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOwner queries the owner edge of a Document.
+func (c *DocumentClient) QueryOwner(d *Document) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := d.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(document.Table, document.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, document.OwnerTable, document.OwnerColumn),
+		)
+		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFolder queries the folder edge of a Document.
+func (c *DocumentClient) QueryFolder(d *Document) *FolderQuery {
+	query := (&FolderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := d.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(document.Table, document.FieldID, id),
+			sqlgraph.To(folder.Table, folder.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, document.FolderTable, document.FolderColumn),
+		)
+		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryNotes queries the notes edge of a Document.
+func (c *DocumentClient) QueryNotes(d *Document) *NoteQuery {
+	query := (&NoteClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := d.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(document.Table, document.FieldID, id),
+			sqlgraph.To(note.Table, note.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, document.NotesTable, document.NotesPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DocumentClient) Hooks() []Hook {
+	return c.hooks.Document
+}
+
+// Interceptors returns the client interceptors.
+func (c *DocumentClient) Interceptors() []Interceptor {
+	return c.inters.Document
+}
+
+func (c *DocumentClient) mutate(ctx context.Context, m *DocumentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DocumentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DocumentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DocumentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DocumentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Document mutation op: %q", m.Op())
+	}
+}
+
+// FolderClient is a client for the Folder schema.
+type FolderClient struct {
+	config
+}
+
+// NewFolderClient returns a client for the Folder from the given config.
+func NewFolderClient(c config) *FolderClient {
+	return &FolderClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `folder.Hooks(f(g(h())))`.
+func (c *FolderClient) Use(hooks ...Hook) {
+	c.hooks.Folder = append(c.hooks.Folder, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `folder.Intercept(f(g(h())))`.
+func (c *FolderClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Folder = append(c.inters.Folder, interceptors...)
+}
+
+// Create returns a builder for creating a Folder entity.
+func (c *FolderClient) Create() *FolderCreate {
+	mutation := newFolderMutation(c.config, OpCreate)
+	return &FolderCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Folder entities.
+func (c *FolderClient) CreateBulk(builders ...*FolderCreate) *FolderCreateBulk {
+	return &FolderCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FolderClient) MapCreateBulk(slice any, setFunc func(*FolderCreate, int)) *FolderCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FolderCreateBulk{err: fmt.Errorf("calling to FolderClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FolderCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FolderCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Folder.
+func (c *FolderClient) Update() *FolderUpdate {
+	mutation := newFolderMutation(c.config, OpUpdate)
+	return &FolderUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FolderClient) UpdateOne(f *Folder) *FolderUpdateOne {
+	mutation := newFolderMutation(c.config, OpUpdateOne, withFolder(f))
+	return &FolderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FolderClient) UpdateOneID(id int) *FolderUpdateOne {
+	mutation := newFolderMutation(c.config, OpUpdateOne, withFolderID(id))
+	return &FolderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Folder.
+func (c *FolderClient) Delete() *FolderDelete {
+	mutation := newFolderMutation(c.config, OpDelete)
+	return &FolderDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FolderClient) DeleteOne(f *Folder) *FolderDeleteOne {
+	return c.DeleteOneID(f.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *FolderClient) DeleteOneID(id int) *FolderDeleteOne {
+	builder := c.Delete().Where(folder.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FolderDeleteOne{builder}
+}
+
+// Query returns a query builder for Folder.
+func (c *FolderClient) Query() *FolderQuery {
+	return &FolderQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeFolder},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Folder entity by its id.
+func (c *FolderClient) Get(ctx context.Context, id int) (*Folder, error) {
+	return c.Query().Where(folder.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FolderClient) GetX(ctx context.Context, id int) *Folder {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOwner queries the owner edge of a Folder.
+func (c *FolderClient) QueryOwner(f *Folder) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := f.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(folder.Table, folder.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, folder.OwnerTable, folder.OwnerColumn),
+		)
+		fromV = sqlgraph.Neighbors(f.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryParent queries the parent edge of a Folder.
+func (c *FolderClient) QueryParent(f *Folder) *FolderQuery {
+	query := (&FolderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := f.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(folder.Table, folder.FieldID, id),
+			sqlgraph.To(folder.Table, folder.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, folder.ParentTable, folder.ParentColumn),
+		)
+		fromV = sqlgraph.Neighbors(f.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChildren queries the children edge of a Folder.
+func (c *FolderClient) QueryChildren(f *Folder) *FolderQuery {
+	query := (&FolderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := f.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(folder.Table, folder.FieldID, id),
+			sqlgraph.To(folder.Table, folder.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, folder.ChildrenTable, folder.ChildrenColumn),
+		)
+		fromV = sqlgraph.Neighbors(f.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDocuments queries the documents edge of a Folder.
+func (c *FolderClient) QueryDocuments(f *Folder) *DocumentQuery {
+	query := (&DocumentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := f.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(folder.Table, folder.FieldID, id),
+			sqlgraph.To(document.Table, document.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, folder.DocumentsTable, folder.DocumentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(f.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *FolderClient) Hooks() []Hook {
+	return c.hooks.Folder
+}
+
+// Interceptors returns the client interceptors.
+func (c *FolderClient) Interceptors() []Interceptor {
+	return c.inters.Folder
+}
+
+func (c *FolderClient) mutate(ctx context.Context, m *FolderMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FolderCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FolderUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FolderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FolderDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Folder mutation op: %q", m.Op())
+	}
+}
+
+// GroupInviteClient is a client for the GroupInvite schema.
+type GroupInviteClient struct {
+	config
+}
+
+// NewGroupInviteClient returns a client for the GroupInvite from the given config.
+func NewGroupInviteClient(c config) *GroupInviteClient {
+	return &GroupInviteClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `groupinvite.Hooks(f(g(h())))`.
+func (c *GroupInviteClient) Use(hooks ...Hook) {
+	c.hooks.GroupInvite = append(c.hooks.GroupInvite, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `groupinvite.Intercept(f(g(h())))`.
+func (c *GroupInviteClient) Intercept(interceptors ...Interceptor) {
+	c.inters.GroupInvite = append(c.inters.GroupInvite, interceptors...)
+}
+
+// Create returns a builder for creating a GroupInvite entity.
+func (c *GroupInviteClient) Create() *GroupInviteCreate {
+	mutation := newGroupInviteMutation(c.config, OpCreate)
+	return &GroupInviteCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of GroupInvite entities.
+func (c *GroupInviteClient) CreateBulk(builders ...*GroupInviteCreate) *GroupInviteCreateBulk {
+	return &GroupInviteCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *GroupInviteClient) MapCreateBulk(slice any, setFunc func(*GroupInviteCreate, int)) *GroupInviteCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &GroupInviteCreateBulk{err: fmt.Errorf("calling to GroupInviteClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*GroupInviteCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &GroupInviteCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for GroupInvite.
+func (c *GroupInviteClient) Update() *GroupInviteUpdate {
+	mutation := newGroupInviteMutation(c.config, OpUpdate)
+	return &GroupInviteUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *GroupInviteClient) UpdateOne(gi *GroupInvite) *GroupInviteUpdateOne {
+	mutation := newGroupInviteMutation(c.config, OpUpdateOne, withGroupInvite(gi))
+	return &GroupInviteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *GroupInviteClient) UpdateOneID(id int) *GroupInviteUpdateOne {
+	mutation := newGroupInviteMutation(c.config, OpUpdateOne, withGroupInviteID(id))
+	return &GroupInviteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for GroupInvite.
+func (c *GroupInviteClient) Delete() *GroupInviteDelete {
+	mutation := newGroupInviteMutation(c.config, OpDelete)
+	return &GroupInviteDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *GroupInviteClient) DeleteOne(gi *GroupInvite) *GroupInviteDeleteOne {
+	return c.DeleteOneID(gi.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *GroupInviteClient) DeleteOneID(id int) *GroupInviteDeleteOne {
+	builder := c.Delete().Where(groupinvite.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &GroupInviteDeleteOne{builder}
+}
+
+// Query returns a query builder for GroupInvite.
+func (c *GroupInviteClient) Query() *GroupInviteQuery {
+	return &GroupInviteQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeGroupInvite},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a GroupInvite entity by its id.
+func (c *GroupInviteClient) Get(ctx context.Context, id int) (*GroupInvite, error) {
+	return c.Query().Where(groupinvite.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *GroupInviteClient) GetX(ctx context.Context, id int) *GroupInvite {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryGroup queries the group edge of a GroupInvite.
+func (c *GroupInviteClient) QueryGroup(gi *GroupInvite) *StudyGroupQuery {
+	query := (&StudyGroupClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := gi.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(groupinvite.Table, groupinvite.FieldID, id),
+			sqlgraph.To(studygroup.Table, studygroup.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, groupinvite.GroupTable, groupinvite.GroupColumn),
+		)
+		fromV = sqlgraph.Neighbors(gi.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCreator queries the creator edge of a GroupInvite.
+func (c *GroupInviteClient) QueryCreator(gi *GroupInvite) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := gi.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(groupinvite.Table, groupinvite.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, groupinvite.CreatorTable, groupinvite.CreatorColumn),
+		)
+		fromV = sqlgraph.Neighbors(gi.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *GroupInviteClient) Hooks() []Hook {
+	return c.hooks.GroupInvite
+}
+
+// Interceptors returns the client interceptors.
+func (c *GroupInviteClient) Interceptors() []Interceptor {
+	return c.inters.GroupInvite
+}
+
+func (c *GroupInviteClient) mutate(ctx context.Context, m *GroupInviteMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&GroupInviteCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&GroupInviteUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&GroupInviteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&GroupInviteDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown GroupInvite mutation op: %q", m.Op())
+	}
+}
+
+// GroupJoinRequestClient is a client for the GroupJoinRequest schema.
+type GroupJoinRequestClient struct {
+	config
+}
+
+// NewGroupJoinRequestClient returns a client for the GroupJoinRequest from the given config.
+func NewGroupJoinRequestClient(c config) *GroupJoinRequestClient {
+	return &GroupJoinRequestClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `groupjoinrequest.Hooks(f(g(h())))`.
+func (c *GroupJoinRequestClient) Use(hooks ...Hook) {
+	c.hooks.GroupJoinRequest = append(c.hooks.GroupJoinRequest, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `groupjoinrequest.Intercept(f(g(h())))`.
+func (c *GroupJoinRequestClient) Intercept(interceptors ...Interceptor) {
+	c.inters.GroupJoinRequest = append(c.inters.GroupJoinRequest, interceptors...)
+}
+
+// Create returns a builder for creating a GroupJoinRequest entity.
+func (c *GroupJoinRequestClient) Create() *GroupJoinRequestCreate {
+	mutation := newGroupJoinRequestMutation(c.config, OpCreate)
+	return &GroupJoinRequestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of GroupJoinRequest entities.
+func (c *GroupJoinRequestClient) CreateBulk(builders ...*GroupJoinRequestCreate) *GroupJoinRequestCreateBulk {
+	return &GroupJoinRequestCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *GroupJoinRequestClient) MapCreateBulk(slice any, setFunc func(*GroupJoinRequestCreate, int)) *GroupJoinRequestCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &GroupJoinRequestCreateBulk{err: fmt.Errorf("calling to GroupJoinRequestClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*GroupJoinRequestCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &GroupJoinRequestCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for GroupJoinRequest.
+func (c *GroupJoinRequestClient) Update() *GroupJoinRequestUpdate {
+	mutation := newGroupJoinRequestMutation(c.config, OpUpdate)
+	return &GroupJoinRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *GroupJoinRequestClient) UpdateOne(gjr *GroupJoinRequest) *GroupJoinRequestUpdateOne {
+	mutation := newGroupJoinRequestMutation(c.config, OpUpdateOne, withGroupJoinRequest(gjr))
+	return &GroupJoinRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *GroupJoinRequestClient) UpdateOneID(id int) *GroupJoinRequestUpdateOne {
+	mutation := newGroupJoinRequestMutation(c.config, OpUpdateOne, withGroupJoinRequestID(id))
+	return &GroupJoinRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for GroupJoinRequest.
+func (c *GroupJoinRequestClient) Delete() *GroupJoinRequestDelete {
+	mutation := newGroupJoinRequestMutation(c.config, OpDelete)
+	return &GroupJoinRequestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *GroupJoinRequestClient) DeleteOne(gjr *GroupJoinRequest) *GroupJoinRequestDeleteOne {
+	return c.DeleteOneID(gjr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *GroupJoinRequestClient) DeleteOneID(id int) *GroupJoinRequestDeleteOne {
+	builder := c.Delete().Where(groupjoinrequest.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &GroupJoinRequestDeleteOne{builder}
+}
+
+// Query returns a query builder for GroupJoinRequest.
+func (c *GroupJoinRequestClient) Query() *GroupJoinRequestQuery {
+	return &GroupJoinRequestQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeGroupJoinRequest},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a GroupJoinRequest entity by its id.
+func (c *GroupJoinRequestClient) Get(ctx context.Context, id int) (*GroupJoinRequest, error) {
+	return c.Query().Where(groupjoinrequest.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *GroupJoinRequestClient) GetX(ctx context.Context, id int) *GroupJoinRequest {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a GroupJoinRequest.
+func (c *GroupJoinRequestClient) QueryUser(gjr *GroupJoinRequest) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := gjr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(groupjoinrequest.Table, groupjoinrequest.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, groupjoinrequest.UserTable, groupjoinrequest.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(gjr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryGroup queries the group edge of a GroupJoinRequest.
+func (c *GroupJoinRequestClient) QueryGroup(gjr *GroupJoinRequest) *StudyGroupQuery {
+	query := (&StudyGroupClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := gjr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(groupjoinrequest.Table, groupjoinrequest.FieldID, id),
+			sqlgraph.To(studygroup.Table, studygroup.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, groupjoinrequest.GroupTable, groupjoinrequest.GroupColumn),
+		)
+		fromV = sqlgraph.Neighbors(gjr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *GroupJoinRequestClient) Hooks() []Hook {
+	return c.hooks.GroupJoinRequest
+}
+
+// Interceptors returns the client interceptors.
+func (c *GroupJoinRequestClient) Interceptors() []Interceptor {
+	return c.inters.GroupJoinRequest
+}
+
+func (c *GroupJoinRequestClient) mutate(ctx context.Context, m *GroupJoinRequestMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&GroupJoinRequestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&GroupJoinRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&GroupJoinRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&GroupJoinRequestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown GroupJoinRequest mutation op: %q", m.Op())
+	}
+}
+
+// GroupMembershipClient is a client for the GroupMembership schema.
+type GroupMembershipClient struct {
+	config
+}
+
+// NewGroupMembershipClient returns a client for the GroupMembership from the given config.
+func NewGroupMembershipClient(c config) *GroupMembershipClient {
+	return &GroupMembershipClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `groupmembership.Hooks(f(g(h())))`.
+func (c *GroupMembershipClient) Use(hooks ...Hook) {
+	c.hooks.GroupMembership = append(c.hooks.GroupMembership, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `groupmembership.Intercept(f(g(h())))`.
+func (c *GroupMembershipClient) Intercept(interceptors ...Interceptor) {
+	c.inters.GroupMembership = append(c.inters.GroupMembership, interceptors...)
+}
+
+// Create returns a builder for creating a GroupMembership entity.
+func (c *GroupMembershipClient) Create() *GroupMembershipCreate {
+	mutation := newGroupMembershipMutation(c.config, OpCreate)
+	return &GroupMembershipCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of GroupMembership entities.
+func (c *GroupMembershipClient) CreateBulk(builders ...*GroupMembershipCreate) *GroupMembershipCreateBulk {
+	return &GroupMembershipCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *GroupMembershipClient) MapCreateBulk(slice any, setFunc func(*GroupMembershipCreate, int)) *GroupMembershipCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &GroupMembershipCreateBulk{err: fmt.Errorf("calling to GroupMembershipClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*GroupMembershipCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &GroupMembershipCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for GroupMembership.
+func (c *GroupMembershipClient) Update() *GroupMembershipUpdate {
+	mutation := newGroupMembershipMutation(c.config, OpUpdate)
+	return &GroupMembershipUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *GroupMembershipClient) UpdateOne(gm *GroupMembership) *GroupMembershipUpdateOne {
+	mutation := newGroupMembershipMutation(c.config, OpUpdateOne, withGroupMembership(gm))
+	return &GroupMembershipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *GroupMembershipClient) UpdateOneID(id int) *GroupMembershipUpdateOne {
+	mutation := newGroupMembershipMutation(c.config, OpUpdateOne, withGroupMembershipID(id))
+	return &GroupMembershipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for GroupMembership.
+func (c *GroupMembershipClient) Delete() *GroupMembershipDelete {
+	mutation := newGroupMembershipMutation(c.config, OpDelete)
+	return &GroupMembershipDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *GroupMembershipClient) DeleteOne(gm *GroupMembership) *GroupMembershipDeleteOne {
+	return c.DeleteOneID(gm.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *GroupMembershipClient) DeleteOneID(id int) *GroupMembershipDeleteOne {
+	builder := c.Delete().Where(groupmembership.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &GroupMembershipDeleteOne{builder}
+}
+
+// Query returns a query builder for GroupMembership.
+func (c *GroupMembershipClient) Query() *GroupMembershipQuery {
+	return &GroupMembershipQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeGroupMembership},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a GroupMembership entity by its id.
+func (c *GroupMembershipClient) Get(ctx context.Context, id int) (*GroupMembership, error) {
+	return c.Query().Where(groupmembership.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *GroupMembershipClient) GetX(ctx context.Context, id int) *GroupMembership {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a GroupMembership.
+func (c *GroupMembershipClient) QueryUser(gm *GroupMembership) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := gm.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(groupmembership.Table, groupmembership.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, groupmembership.UserTable, groupmembership.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(gm.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryGroup queries the group edge of a GroupMembership.
+func (c *GroupMembershipClient) QueryGroup(gm *GroupMembership) *StudyGroupQuery {
+	query := (&StudyGroupClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := gm.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(groupmembership.Table, groupmembership.FieldID, id),
+			sqlgraph.To(studygroup.Table, studygroup.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, groupmembership.GroupTable, groupmembership.GroupColumn),
+		)
+		fromV = sqlgraph.Neighbors(gm.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *GroupMembershipClient) Hooks() []Hook {
+	return c.hooks.GroupMembership
+}
+
+// Interceptors returns the client interceptors.
+func (c *GroupMembershipClient) Interceptors() []Interceptor {
+	return c.inters.GroupMembership
+}
+
+func (c *GroupMembershipClient) mutate(ctx context.Context, m *GroupMembershipMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&GroupMembershipCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&GroupMembershipUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&GroupMembershipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&GroupMembershipDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown GroupMembership mutation op: %q", m.Op())
+	}
+}
+
+// NoteClient is a client for the Note schema.
+type NoteClient struct {
+	config
+}
+
+// NewNoteClient returns a client for the Note from the given config.
+func NewNoteClient(c config) *NoteClient {
+	return &NoteClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `note.Hooks(f(g(h())))`.
+func (c *NoteClient) Use(hooks ...Hook) {
+	c.hooks.Note = append(c.hooks.Note, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `note.Intercept(f(g(h())))`.
+func (c *NoteClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Note = append(c.inters.Note, interceptors...)
+}
+
+// Create returns a builder for creating a Note entity.
+func (c *NoteClient) Create() *NoteCreate {
+	mutation := newNoteMutation(c.config, OpCreate)
+	return &NoteCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Note entities.
+func (c *NoteClient) CreateBulk(builders ...*NoteCreate) *NoteCreateBulk {
+	return &NoteCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NoteClient) MapCreateBulk(slice any, setFunc func(*NoteCreate, int)) *NoteCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NoteCreateBulk{err: fmt.Errorf("calling to NoteClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NoteCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NoteCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Note.
+func (c *NoteClient) Update() *NoteUpdate {
+	mutation := newNoteMutation(c.config, OpUpdate)
+	return &NoteUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NoteClient) UpdateOne(n *Note) *NoteUpdateOne {
+	mutation := newNoteMutation(c.config, OpUpdateOne, withNote(n))
+	return &NoteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NoteClient) UpdateOneID(id int) *NoteUpdateOne {
+	mutation := newNoteMutation(c.config, OpUpdateOne, withNoteID(id))
+	return &NoteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Note.
+func (c *NoteClient) Delete() *NoteDelete {
+	mutation := newNoteMutation(c.config, OpDelete)
+	return &NoteDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NoteClient) DeleteOne(n *Note) *NoteDeleteOne {
+	return c.DeleteOneID(n.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NoteClient) DeleteOneID(id int) *NoteDeleteOne {
+	builder := c.Delete().Where(note.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NoteDeleteOne{builder}
+}
+
+// Query returns a query builder for Note.
+func (c *NoteClient) Query() *NoteQuery {
+	return &NoteQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNote},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Note entity by its id.
+func (c *NoteClient) Get(ctx context.Context, id int) (*Note, error) {
+	return c.Query().Where(note.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NoteClient) GetX(ctx context.Context, id int) *Note {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOwner queries the owner edge of a Note.
+func (c *NoteClient) QueryOwner(n *Note) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := n.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(note.Table, note.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, note.OwnerTable, note.OwnerColumn),
+		)
+		fromV = sqlgraph.Neighbors(n.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLikes queries the likes edge of a Note.
+func (c *NoteClient) QueryLikes(n *Note) *NoteLikeQuery {
+	query := (&NoteLikeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := n.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(note.Table, note.FieldID, id),
+			sqlgraph.To(notelike.Table, notelike.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, note.LikesTable, note.LikesColumn),
+		)
+		fromV = sqlgraph.Neighbors(n.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReposts queries the reposts edge of a Note.
+func (c *NoteClient) QueryReposts(n *Note) *NoteRepostQuery {
+	query := (&NoteRepostClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := n.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(note.Table, note.FieldID, id),
+			sqlgraph.To(noterepost.Table, noterepost.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, note.RepostsTable, note.RepostsColumn),
+		)
+		fromV = sqlgraph.Neighbors(n.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPracticeSets queries the practice_sets edge of a Note.
+func (c *NoteClient) QueryPracticeSets(n *Note) *QuizQuery {
+	query := (&QuizClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := n.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(note.Table, note.FieldID, id),
+			sqlgraph.To(quiz.Table, quiz.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, note.PracticeSetsTable, note.PracticeSetsColumn),
+		)
+		fromV = sqlgraph.Neighbors(n.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryStudyEvents queries the study_events edge of a Note.
+func (c *NoteClient) QueryStudyEvents(n *Note) *StudyEventQuery {
+	query := (&StudyEventClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := n.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(note.Table, note.FieldID, id),
+			sqlgraph.To(studyevent.Table, studyevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, note.StudyEventsTable, note.StudyEventsColumn),
+		)
+		fromV = sqlgraph.Neighbors(n.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryGroups queries the groups edge of a Note.
+func (c *NoteClient) QueryGroups(n *Note) *StudyGroupQuery {
+	query := (&StudyGroupClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := n.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(note.Table, note.FieldID, id),
+			sqlgraph.To(studygroup.Table, studygroup.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, note.GroupsTable, note.GroupsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(n.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDocuments queries the documents edge of a Note.
+func (c *NoteClient) QueryDocuments(n *Note) *DocumentQuery {
+	query := (&DocumentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := n.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(note.Table, note.FieldID, id),
+			sqlgraph.To(document.Table, document.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, note.DocumentsTable, note.DocumentsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(n.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryProposals queries the proposals edge of a Note.
+func (c *NoteClient) QueryProposals(n *Note) *NoteProposalQuery {
+	query := (&NoteProposalClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := n.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(note.Table, note.FieldID, id),
+			sqlgraph.To(noteproposal.Table, noteproposal.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, note.ProposalsTable, note.ProposalsColumn),
+		)
+		fromV = sqlgraph.Neighbors(n.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRevisions queries the revisions edge of a Note.
+func (c *NoteClient) QueryRevisions(n *Note) *NoteRevisionQuery {
+	query := (&NoteRevisionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := n.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(note.Table, note.FieldID, id),
+			sqlgraph.To(noterevision.Table, noterevision.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, note.RevisionsTable, note.RevisionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(n.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryNotebooks queries the notebooks edge of a Note.
+func (c *NoteClient) QueryNotebooks(n *Note) *NotebookQuery {
+	query := (&NotebookClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := n.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(note.Table, note.FieldID, id),
+			sqlgraph.To(notebook.Table, notebook.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, note.NotebooksTable, note.NotebooksPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(n.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTags queries the tags edge of a Note.
+func (c *NoteClient) QueryTags(n *Note) *TagQuery {
+	query := (&TagClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := n.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(note.Table, note.FieldID, id),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, note.TagsTable, note.TagsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(n.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLinks queries the links edge of a Note.
+func (c *NoteClient) QueryLinks(n *Note) *NoteLinkQuery {
+	query := (&NoteLinkClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := n.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(note.Table, note.FieldID, id),
+			sqlgraph.To(notelink.Table, notelink.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, note.LinksTable, note.LinksColumn),
+		)
+		fromV = sqlgraph.Neighbors(n.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBacklinks queries the backlinks edge of a Note.
+func (c *NoteClient) QueryBacklinks(n *Note) *NoteLinkQuery {
+	query := (&NoteLinkClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := n.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(note.Table, note.FieldID, id),
+			sqlgraph.To(notelink.Table, notelink.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, note.BacklinksTable, note.BacklinksColumn),
+		)
+		fromV = sqlgraph.Neighbors(n.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *NoteClient) Hooks() []Hook {
+	return c.hooks.Note
+}
+
+// Interceptors returns the client interceptors.
+func (c *NoteClient) Interceptors() []Interceptor {
+	return c.inters.Note
+}
+
+func (c *NoteClient) mutate(ctx context.Context, m *NoteMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NoteCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NoteUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NoteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NoteDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Note mutation op: %q", m.Op())
+	}
+}
+
+// NoteLikeClient is a client for the NoteLike schema.
+type NoteLikeClient struct {
+	config
+}
+
+// NewNoteLikeClient returns a client for the NoteLike from the given config.
+func NewNoteLikeClient(c config) *NoteLikeClient {
+	return &NoteLikeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `notelike.Hooks(f(g(h())))`.
+func (c *NoteLikeClient) Use(hooks ...Hook) {
+	c.hooks.NoteLike = append(c.hooks.NoteLike, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `notelike.Intercept(f(g(h())))`.
+func (c *NoteLikeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.NoteLike = append(c.inters.NoteLike, interceptors...)
+}
+
+// Create returns a builder for creating a NoteLike entity.
+func (c *NoteLikeClient) Create() *NoteLikeCreate {
+	mutation := newNoteLikeMutation(c.config, OpCreate)
+	return &NoteLikeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of NoteLike entities.
+func (c *NoteLikeClient) CreateBulk(builders ...*NoteLikeCreate) *NoteLikeCreateBulk {
+	return &NoteLikeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NoteLikeClient) MapCreateBulk(slice any, setFunc func(*NoteLikeCreate, int)) *NoteLikeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NoteLikeCreateBulk{err: fmt.Errorf("calling to NoteLikeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NoteLikeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NoteLikeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for NoteLike.
+func (c *NoteLikeClient) Update() *NoteLikeUpdate {
+	mutation := newNoteLikeMutation(c.config, OpUpdate)
+	return &NoteLikeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NoteLikeClient) UpdateOne(nl *NoteLike) *NoteLikeUpdateOne {
+	mutation := newNoteLikeMutation(c.config, OpUpdateOne, withNoteLike(nl))
+	return &NoteLikeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NoteLikeClient) UpdateOneID(id int) *NoteLikeUpdateOne {
+	mutation := newNoteLikeMutation(c.config, OpUpdateOne, withNoteLikeID(id))
+	return &NoteLikeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for NoteLike.
+func (c *NoteLikeClient) Delete() *NoteLikeDelete {
+	mutation := newNoteLikeMutation(c.config, OpDelete)
+	return &NoteLikeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NoteLikeClient) DeleteOne(nl *NoteLike) *NoteLikeDeleteOne {
+	return c.DeleteOneID(nl.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NoteLikeClient) DeleteOneID(id int) *NoteLikeDeleteOne {
+	builder := c.Delete().Where(notelike.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NoteLikeDeleteOne{builder}
+}
+
+// Query returns a query builder for NoteLike.
+func (c *NoteLikeClient) Query() *NoteLikeQuery {
+	return &NoteLikeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNoteLike},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a NoteLike entity by its id.
+func (c *NoteLikeClient) Get(ctx context.Context, id int) (*NoteLike, error) {
+	return c.Query().Where(notelike.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NoteLikeClient) GetX(ctx context.Context, id int) *NoteLike {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a NoteLike.
+func (c *NoteLikeClient) QueryUser(nl *NoteLike) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := nl.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(notelike.Table, notelike.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, notelike.UserTable, notelike.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(nl.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryNote queries the note edge of a NoteLike.
+func (c *NoteLikeClient) QueryNote(nl *NoteLike) *NoteQuery {
+	query := (&NoteClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := nl.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(notelike.Table, notelike.FieldID, id),
+			sqlgraph.To(note.Table, note.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, notelike.NoteTable, notelike.NoteColumn),
+		)
+		fromV = sqlgraph.Neighbors(nl.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *NoteLikeClient) Hooks() []Hook {
+	return c.hooks.NoteLike
+}
+
+// Interceptors returns the client interceptors.
+func (c *NoteLikeClient) Interceptors() []Interceptor {
+	return c.inters.NoteLike
+}
+
+func (c *NoteLikeClient) mutate(ctx context.Context, m *NoteLikeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NoteLikeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NoteLikeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NoteLikeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NoteLikeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown NoteLike mutation op: %q", m.Op())
+	}
+}
+
+// NoteLinkClient is a client for the NoteLink schema.
+type NoteLinkClient struct {
+	config
+}
+
+// NewNoteLinkClient returns a client for the NoteLink from the given config.
+func NewNoteLinkClient(c config) *NoteLinkClient {
+	return &NoteLinkClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `notelink.Hooks(f(g(h())))`.
+func (c *NoteLinkClient) Use(hooks ...Hook) {
+	c.hooks.NoteLink = append(c.hooks.NoteLink, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `notelink.Intercept(f(g(h())))`.
+func (c *NoteLinkClient) Intercept(interceptors ...Interceptor) {
+	c.inters.NoteLink = append(c.inters.NoteLink, interceptors...)
+}
+
+// Create returns a builder for creating a NoteLink entity.
+func (c *NoteLinkClient) Create() *NoteLinkCreate {
+	mutation := newNoteLinkMutation(c.config, OpCreate)
+	return &NoteLinkCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of NoteLink entities.
+func (c *NoteLinkClient) CreateBulk(builders ...*NoteLinkCreate) *NoteLinkCreateBulk {
+	return &NoteLinkCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NoteLinkClient) MapCreateBulk(slice any, setFunc func(*NoteLinkCreate, int)) *NoteLinkCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NoteLinkCreateBulk{err: fmt.Errorf("calling to NoteLinkClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NoteLinkCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NoteLinkCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for NoteLink.
+func (c *NoteLinkClient) Update() *NoteLinkUpdate {
+	mutation := newNoteLinkMutation(c.config, OpUpdate)
+	return &NoteLinkUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NoteLinkClient) UpdateOne(nl *NoteLink) *NoteLinkUpdateOne {
+	mutation := newNoteLinkMutation(c.config, OpUpdateOne, withNoteLink(nl))
+	return &NoteLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NoteLinkClient) UpdateOneID(id int) *NoteLinkUpdateOne {
+	mutation := newNoteLinkMutation(c.config, OpUpdateOne, withNoteLinkID(id))
+	return &NoteLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for NoteLink.
+func (c *NoteLinkClient) Delete() *NoteLinkDelete {
+	mutation := newNoteLinkMutation(c.config, OpDelete)
+	return &NoteLinkDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NoteLinkClient) DeleteOne(nl *NoteLink) *NoteLinkDeleteOne {
+	return c.DeleteOneID(nl.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NoteLinkClient) DeleteOneID(id int) *NoteLinkDeleteOne {
+	builder := c.Delete().Where(notelink.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NoteLinkDeleteOne{builder}
+}
+
+// Query returns a query builder for NoteLink.
+func (c *NoteLinkClient) Query() *NoteLinkQuery {
+	return &NoteLinkQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNoteLink},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a NoteLink entity by its id.
+func (c *NoteLinkClient) Get(ctx context.Context, id int) (*NoteLink, error) {
+	return c.Query().Where(notelink.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NoteLinkClient) GetX(ctx context.Context, id int) *NoteLink {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySource queries the source edge of a NoteLink.
+func (c *NoteLinkClient) QuerySource(nl *NoteLink) *NoteQuery {
+	query := (&NoteClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := nl.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(notelink.Table, notelink.FieldID, id),
+			sqlgraph.To(note.Table, note.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, notelink.SourceTable, notelink.SourceColumn),
+		)
+		fromV = sqlgraph.Neighbors(nl.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryNote queries the note edge of a NoteLink.
+func (c *NoteLinkClient) QueryNote(nl *NoteLink) *NoteQuery {
+	query := (&NoteClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := nl.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(notelink.Table, notelink.FieldID, id),
+			sqlgraph.To(note.Table, note.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, notelink.NoteTable, notelink.NoteColumn),
+		)
+		fromV = sqlgraph.Neighbors(nl.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *NoteLinkClient) Hooks() []Hook {
+	return c.hooks.NoteLink
+}
+
+// Interceptors returns the client interceptors.
+func (c *NoteLinkClient) Interceptors() []Interceptor {
+	return c.inters.NoteLink
+}
+
+func (c *NoteLinkClient) mutate(ctx context.Context, m *NoteLinkMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NoteLinkCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NoteLinkUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NoteLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NoteLinkDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown NoteLink mutation op: %q", m.Op())
+	}
+}
+
+// NoteProposalClient is a client for the NoteProposal schema.
+type NoteProposalClient struct {
+	config
+}
+
+// NewNoteProposalClient returns a client for the NoteProposal from the given config.
+func NewNoteProposalClient(c config) *NoteProposalClient {
+	return &NoteProposalClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `noteproposal.Hooks(f(g(h())))`.
+func (c *NoteProposalClient) Use(hooks ...Hook) {
+	c.hooks.NoteProposal = append(c.hooks.NoteProposal, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `noteproposal.Intercept(f(g(h())))`.
+func (c *NoteProposalClient) Intercept(interceptors ...Interceptor) {
+	c.inters.NoteProposal = append(c.inters.NoteProposal, interceptors...)
+}
+
+// Create returns a builder for creating a NoteProposal entity.
+func (c *NoteProposalClient) Create() *NoteProposalCreate {
+	mutation := newNoteProposalMutation(c.config, OpCreate)
+	return &NoteProposalCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of NoteProposal entities.
+func (c *NoteProposalClient) CreateBulk(builders ...*NoteProposalCreate) *NoteProposalCreateBulk {
+	return &NoteProposalCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NoteProposalClient) MapCreateBulk(slice any, setFunc func(*NoteProposalCreate, int)) *NoteProposalCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NoteProposalCreateBulk{err: fmt.Errorf("calling to NoteProposalClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NoteProposalCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NoteProposalCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for NoteProposal.
+func (c *NoteProposalClient) Update() *NoteProposalUpdate {
+	mutation := newNoteProposalMutation(c.config, OpUpdate)
+	return &NoteProposalUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NoteProposalClient) UpdateOne(np *NoteProposal) *NoteProposalUpdateOne {
+	mutation := newNoteProposalMutation(c.config, OpUpdateOne, withNoteProposal(np))
+	return &NoteProposalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NoteProposalClient) UpdateOneID(id int) *NoteProposalUpdateOne {
+	mutation := newNoteProposalMutation(c.config, OpUpdateOne, withNoteProposalID(id))
+	return &NoteProposalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for NoteProposal.
+func (c *NoteProposalClient) Delete() *NoteProposalDelete {
+	mutation := newNoteProposalMutation(c.config, OpDelete)
+	return &NoteProposalDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NoteProposalClient) DeleteOne(np *NoteProposal) *NoteProposalDeleteOne {
+	return c.DeleteOneID(np.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NoteProposalClient) DeleteOneID(id int) *NoteProposalDeleteOne {
+	builder := c.Delete().Where(noteproposal.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NoteProposalDeleteOne{builder}
+}
+
+// Query returns a query builder for NoteProposal.
+func (c *NoteProposalClient) Query() *NoteProposalQuery {
+	return &NoteProposalQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNoteProposal},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a NoteProposal entity by its id.
+func (c *NoteProposalClient) Get(ctx context.Context, id int) (*NoteProposal, error) {
+	return c.Query().Where(noteproposal.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NoteProposalClient) GetX(ctx context.Context, id int) *NoteProposal {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryNote queries the note edge of a NoteProposal.
+func (c *NoteProposalClient) QueryNote(np *NoteProposal) *NoteQuery {
+	query := (&NoteClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := np.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(noteproposal.Table, noteproposal.FieldID, id),
+			sqlgraph.To(note.Table, note.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, noteproposal.NoteTable, noteproposal.NoteColumn),
+		)
+		fromV = sqlgraph.Neighbors(np.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryProposer queries the proposer edge of a NoteProposal.
+func (c *NoteProposalClient) QueryProposer(np *NoteProposal) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := np.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(noteproposal.Table, noteproposal.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, noteproposal.ProposerTable, noteproposal.ProposerColumn),
+		)
+		fromV = sqlgraph.Neighbors(np.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *NoteProposalClient) Hooks() []Hook {
+	return c.hooks.NoteProposal
+}
+
+// Interceptors returns the client interceptors.
+func (c *NoteProposalClient) Interceptors() []Interceptor {
+	return c.inters.NoteProposal
+}
+
+func (c *NoteProposalClient) mutate(ctx context.Context, m *NoteProposalMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NoteProposalCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NoteProposalUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NoteProposalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NoteProposalDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown NoteProposal mutation op: %q", m.Op())
+	}
+}
+
+// NoteRepostClient is a client for the NoteRepost schema.
+type NoteRepostClient struct {
+	config
+}
+
+// NewNoteRepostClient returns a client for the NoteRepost from the given config.
+func NewNoteRepostClient(c config) *NoteRepostClient {
+	return &NoteRepostClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `noterepost.Hooks(f(g(h())))`.
+func (c *NoteRepostClient) Use(hooks ...Hook) {
+	c.hooks.NoteRepost = append(c.hooks.NoteRepost, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `noterepost.Intercept(f(g(h())))`.
+func (c *NoteRepostClient) Intercept(interceptors ...Interceptor) {
+	c.inters.NoteRepost = append(c.inters.NoteRepost, interceptors...)
+}
+
+// Create returns a builder for creating a NoteRepost entity.
+func (c *NoteRepostClient) Create() *NoteRepostCreate {
+	mutation := newNoteRepostMutation(c.config, OpCreate)
+	return &NoteRepostCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of NoteRepost entities.
+func (c *NoteRepostClient) CreateBulk(builders ...*NoteRepostCreate) *NoteRepostCreateBulk {
+	return &NoteRepostCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NoteRepostClient) MapCreateBulk(slice any, setFunc func(*NoteRepostCreate, int)) *NoteRepostCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NoteRepostCreateBulk{err: fmt.Errorf("calling to NoteRepostClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NoteRepostCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NoteRepostCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for NoteRepost.
+func (c *NoteRepostClient) Update() *NoteRepostUpdate {
+	mutation := newNoteRepostMutation(c.config, OpUpdate)
+	return &NoteRepostUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NoteRepostClient) UpdateOne(nr *NoteRepost) *NoteRepostUpdateOne {
+	mutation := newNoteRepostMutation(c.config, OpUpdateOne, withNoteRepost(nr))
+	return &NoteRepostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NoteRepostClient) UpdateOneID(id int) *NoteRepostUpdateOne {
+	mutation := newNoteRepostMutation(c.config, OpUpdateOne, withNoteRepostID(id))
+	return &NoteRepostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for NoteRepost.
+func (c *NoteRepostClient) Delete() *NoteRepostDelete {
+	mutation := newNoteRepostMutation(c.config, OpDelete)
+	return &NoteRepostDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NoteRepostClient) DeleteOne(nr *NoteRepost) *NoteRepostDeleteOne {
+	return c.DeleteOneID(nr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NoteRepostClient) DeleteOneID(id int) *NoteRepostDeleteOne {
+	builder := c.Delete().Where(noterepost.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NoteRepostDeleteOne{builder}
+}
+
+// Query returns a query builder for NoteRepost.
+func (c *NoteRepostClient) Query() *NoteRepostQuery {
+	return &NoteRepostQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNoteRepost},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a NoteRepost entity by its id.
+func (c *NoteRepostClient) Get(ctx context.Context, id int) (*NoteRepost, error) {
+	return c.Query().Where(noterepost.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NoteRepostClient) GetX(ctx context.Context, id int) *NoteRepost {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a NoteRepost.
+func (c *NoteRepostClient) QueryUser(nr *NoteRepost) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := nr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(noterepost.Table, noterepost.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, noterepost.UserTable, noterepost.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(nr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryNote queries the note edge of a NoteRepost.
+func (c *NoteRepostClient) QueryNote(nr *NoteRepost) *NoteQuery {
+	query := (&NoteClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := nr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(noterepost.Table, noterepost.FieldID, id),
+			sqlgraph.To(note.Table, note.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, noterepost.NoteTable, noterepost.NoteColumn),
+		)
+		fromV = sqlgraph.Neighbors(nr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *NoteRepostClient) Hooks() []Hook {
+	return c.hooks.NoteRepost
+}
+
+// Interceptors returns the client interceptors.
+func (c *NoteRepostClient) Interceptors() []Interceptor {
+	return c.inters.NoteRepost
+}
+
+func (c *NoteRepostClient) mutate(ctx context.Context, m *NoteRepostMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NoteRepostCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NoteRepostUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NoteRepostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NoteRepostDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown NoteRepost mutation op: %q", m.Op())
+	}
+}
+
+// NoteRevisionClient is a client for the NoteRevision schema.
+type NoteRevisionClient struct {
+	config
+}
+
+// NewNoteRevisionClient returns a client for the NoteRevision from the given config.
+func NewNoteRevisionClient(c config) *NoteRevisionClient {
+	return &NoteRevisionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `noterevision.Hooks(f(g(h())))`.
+func (c *NoteRevisionClient) Use(hooks ...Hook) {
+	c.hooks.NoteRevision = append(c.hooks.NoteRevision, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `noterevision.Intercept(f(g(h())))`.
+func (c *NoteRevisionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.NoteRevision = append(c.inters.NoteRevision, interceptors...)
+}
+
+// Create returns a builder for creating a NoteRevision entity.
+func (c *NoteRevisionClient) Create() *NoteRevisionCreate {
+	mutation := newNoteRevisionMutation(c.config, OpCreate)
+	return &NoteRevisionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of NoteRevision entities.
+func (c *NoteRevisionClient) CreateBulk(builders ...*NoteRevisionCreate) *NoteRevisionCreateBulk {
+	return &NoteRevisionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NoteRevisionClient) MapCreateBulk(slice any, setFunc func(*NoteRevisionCreate, int)) *NoteRevisionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NoteRevisionCreateBulk{err: fmt.Errorf("calling to NoteRevisionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NoteRevisionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NoteRevisionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for NoteRevision.
+func (c *NoteRevisionClient) Update() *NoteRevisionUpdate {
+	mutation := newNoteRevisionMutation(c.config, OpUpdate)
+	return &NoteRevisionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NoteRevisionClient) UpdateOne(nr *NoteRevision) *NoteRevisionUpdateOne {
+	mutation := newNoteRevisionMutation(c.config, OpUpdateOne, withNoteRevision(nr))
+	return &NoteRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NoteRevisionClient) UpdateOneID(id int) *NoteRevisionUpdateOne {
+	mutation := newNoteRevisionMutation(c.config, OpUpdateOne, withNoteRevisionID(id))
+	return &NoteRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for NoteRevision.
+func (c *NoteRevisionClient) Delete() *NoteRevisionDelete {
+	mutation := newNoteRevisionMutation(c.config, OpDelete)
+	return &NoteRevisionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NoteRevisionClient) DeleteOne(nr *NoteRevision) *NoteRevisionDeleteOne {
+	return c.DeleteOneID(nr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NoteRevisionClient) DeleteOneID(id int) *NoteRevisionDeleteOne {
+	builder := c.Delete().Where(noterevision.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NoteRevisionDeleteOne{builder}
+}
+
+// Query returns a query builder for NoteRevision.
+func (c *NoteRevisionClient) Query() *NoteRevisionQuery {
+	return &NoteRevisionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNoteRevision},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a NoteRevision entity by its id.
+func (c *NoteRevisionClient) Get(ctx context.Context, id int) (*NoteRevision, error) {
+	return c.Query().Where(noterevision.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NoteRevisionClient) GetX(ctx context.Context, id int) *NoteRevision {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryNote queries the note edge of a NoteRevision.
+func (c *NoteRevisionClient) QueryNote(nr *NoteRevision) *NoteQuery {
+	query := (&NoteClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := nr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(noterevision.Table, noterevision.FieldID, id),
+			sqlgraph.To(note.Table, note.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, noterevision.NoteTable, noterevision.NoteColumn),
+		)
+		fromV = sqlgraph.Neighbors(nr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAuthor queries the author edge of a NoteRevision.
+func (c *NoteRevisionClient) QueryAuthor(nr *NoteRevision) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := nr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(noterevision.Table, noterevision.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, noterevision.AuthorTable, noterevision.AuthorColumn),
+		)
+		fromV = sqlgraph.Neighbors(nr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *NoteRevisionClient) Hooks() []Hook {
+	return c.hooks.NoteRevision
+}
+
+// Interceptors returns the client interceptors.
+func (c *NoteRevisionClient) Interceptors() []Interceptor {
+	return c.inters.NoteRevision
+}
+
+func (c *NoteRevisionClient) mutate(ctx context.Context, m *NoteRevisionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NoteRevisionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NoteRevisionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NoteRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NoteRevisionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown NoteRevision mutation op: %q", m.Op())
+	}
+}
+
+// NotebookClient is a client for the Notebook schema.
+type NotebookClient struct {
+	config
+}
+
+// NewNotebookClient returns a client for the Notebook from the given config.
+func NewNotebookClient(c config) *NotebookClient {
+	return &NotebookClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `notebook.Hooks(f(g(h())))`.
+func (c *NotebookClient) Use(hooks ...Hook) {
+	c.hooks.Notebook = append(c.hooks.Notebook, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `notebook.Intercept(f(g(h())))`.
+func (c *NotebookClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Notebook = append(c.inters.Notebook, interceptors...)
+}
+
+// Create returns a builder for creating a Notebook entity.
+func (c *NotebookClient) Create() *NotebookCreate {
+	mutation := newNotebookMutation(c.config, OpCreate)
+	return &NotebookCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Notebook entities.
+func (c *NotebookClient) CreateBulk(builders ...*NotebookCreate) *NotebookCreateBulk {
+	return &NotebookCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NotebookClient) MapCreateBulk(slice any, setFunc func(*NotebookCreate, int)) *NotebookCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NotebookCreateBulk{err: fmt.Errorf("calling to NotebookClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NotebookCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NotebookCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Notebook.
+func (c *NotebookClient) Update() *NotebookUpdate {
+	mutation := newNotebookMutation(c.config, OpUpdate)
+	return &NotebookUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NotebookClient) UpdateOne(n *Notebook) *NotebookUpdateOne {
+	mutation := newNotebookMutation(c.config, OpUpdateOne, withNotebook(n))
+	return &NotebookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NotebookClient) UpdateOneID(id int) *NotebookUpdateOne {
+	mutation := newNotebookMutation(c.config, OpUpdateOne, withNotebookID(id))
+	return &NotebookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Notebook.
+func (c *NotebookClient) Delete() *NotebookDelete {
+	mutation := newNotebookMutation(c.config, OpDelete)
+	return &NotebookDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NotebookClient) DeleteOne(n *Notebook) *NotebookDeleteOne {
+	return c.DeleteOneID(n.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NotebookClient) DeleteOneID(id int) *NotebookDeleteOne {
+	builder := c.Delete().Where(notebook.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NotebookDeleteOne{builder}
+}
+
+// Query returns a query builder for Notebook.
+func (c *NotebookClient) Query() *NotebookQuery {
+	return &NotebookQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNotebook},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Notebook entity by its id.
+func (c *NotebookClient) Get(ctx context.Context, id int) (*Notebook, error) {
+	return c.Query().Where(notebook.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NotebookClient) GetX(ctx context.Context, id int) *Notebook {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOwner queries the owner edge of a Notebook.
+func (c *NotebookClient) QueryOwner(n *Notebook) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := n.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(notebook.Table, notebook.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, notebook.OwnerTable, notebook.OwnerColumn),
+		)
+		fromV = sqlgraph.Neighbors(n.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryParent queries the parent edge of a Notebook.
+func (c *NotebookClient) QueryParent(n *Notebook) *NotebookQuery {
+	query := (&NotebookClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := n.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(notebook.Table, notebook.FieldID, id),
+			sqlgraph.To(notebook.Table, notebook.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, notebook.ParentTable, notebook.ParentColumn),
+		)
+		fromV = sqlgraph.Neighbors(n.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChildren queries the children edge of a Notebook.
+func (c *NotebookClient) QueryChildren(n *Notebook) *NotebookQuery {
+	query := (&NotebookClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := n.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(notebook.Table, notebook.FieldID, id),
+			sqlgraph.To(notebook.Table, notebook.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, notebook.ChildrenTable, notebook.ChildrenColumn),
+		)
+		fromV = sqlgraph.Neighbors(n.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryNotes queries the notes edge of a Notebook.
+func (c *NotebookClient) QueryNotes(n *Notebook) *NoteQuery {
+	query := (&NoteClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := n.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(notebook.Table, notebook.FieldID, id),
+			sqlgraph.To(note.Table, note.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, notebook.NotesTable, notebook.NotesPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(n.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *NotebookClient) Hooks() []Hook {
+	return c.hooks.Notebook
+}
+
+// Interceptors returns the client interceptors.
+func (c *NotebookClient) Interceptors() []Interceptor {
+	return c.inters.Notebook
+}
+
+func (c *NotebookClient) mutate(ctx context.Context, m *NotebookMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NotebookCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NotebookUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NotebookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NotebookDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Notebook mutation op: %q", m.Op())
+	}
+}
+
+// PasswordTokenClient is a client for the PasswordToken schema.
+type PasswordTokenClient struct {
+	config
+}
+
+// NewPasswordTokenClient returns a client for the PasswordToken from the given config.
+func NewPasswordTokenClient(c config) *PasswordTokenClient {
+	return &PasswordTokenClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `passwordtoken.Hooks(f(g(h())))`.
+func (c *PasswordTokenClient) Use(hooks ...Hook) {
+	c.hooks.PasswordToken = append(c.hooks.PasswordToken, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `passwordtoken.Intercept(f(g(h())))`.
+func (c *PasswordTokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PasswordToken = append(c.inters.PasswordToken, interceptors...)
+}
+
+// Create returns a builder for creating a PasswordToken entity.
+func (c *PasswordTokenClient) Create() *PasswordTokenCreate {
+	mutation := newPasswordTokenMutation(c.config, OpCreate)
+	return &PasswordTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PasswordToken entities.
+func (c *PasswordTokenClient) CreateBulk(builders ...*PasswordTokenCreate) *PasswordTokenCreateBulk {
+	return &PasswordTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PasswordTokenClient) MapCreateBulk(slice any, setFunc func(*PasswordTokenCreate, int)) *PasswordTokenCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PasswordTokenCreateBulk{err: fmt.Errorf("calling to PasswordTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PasswordTokenCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PasswordTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PasswordToken.
+func (c *PasswordTokenClient) Update() *PasswordTokenUpdate {
+	mutation := newPasswordTokenMutation(c.config, OpUpdate)
+	return &PasswordTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PasswordTokenClient) UpdateOne(pt *PasswordToken) *PasswordTokenUpdateOne {
+	mutation := newPasswordTokenMutation(c.config, OpUpdateOne, withPasswordToken(pt))
+	return &PasswordTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PasswordTokenClient) UpdateOneID(id int) *PasswordTokenUpdateOne {
+	mutation := newPasswordTokenMutation(c.config, OpUpdateOne, withPasswordTokenID(id))
+	return &PasswordTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PasswordToken.
+func (c *PasswordTokenClient) Delete() *PasswordTokenDelete {
+	mutation := newPasswordTokenMutation(c.config, OpDelete)
+	return &PasswordTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PasswordTokenClient) DeleteOne(pt *PasswordToken) *PasswordTokenDeleteOne {
+	return c.DeleteOneID(pt.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PasswordTokenClient) DeleteOneID(id int) *PasswordTokenDeleteOne {
+	builder := c.Delete().Where(passwordtoken.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PasswordTokenDeleteOne{builder}
+}
+
+// Query returns a query builder for PasswordToken.
+func (c *PasswordTokenClient) Query() *PasswordTokenQuery {
+	return &PasswordTokenQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePasswordToken},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PasswordToken entity by its id.
+func (c *PasswordTokenClient) Get(ctx context.Context, id int) (*PasswordToken, error) {
+	return c.Query().Where(passwordtoken.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PasswordTokenClient) GetX(ctx context.Context, id int) *PasswordToken {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a PasswordToken.
+func (c *PasswordTokenClient) QueryUser(pt *PasswordToken) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pt.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(passwordtoken.Table, passwordtoken.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, passwordtoken.UserTable, passwordtoken.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(pt.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PasswordTokenClient) Hooks() []Hook {
+	return c.hooks.PasswordToken
+}
+
+// Interceptors returns the client interceptors.
+func (c *PasswordTokenClient) Interceptors() []Interceptor {
+	return c.inters.PasswordToken
+}
+
+func (c *PasswordTokenClient) mutate(ctx context.Context, m *PasswordTokenMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PasswordTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PasswordTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PasswordTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PasswordTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PasswordToken mutation op: %q", m.Op())
+	}
+}
+
+// QuestionClient is a client for the Question schema.
+type QuestionClient struct {
+	config
+}
+
+// NewQuestionClient returns a client for the Question from the given config.
+func NewQuestionClient(c config) *QuestionClient {
+	return &QuestionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `question.Hooks(f(g(h())))`.
+func (c *QuestionClient) Use(hooks ...Hook) {
+	c.hooks.Question = append(c.hooks.Question, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `question.Intercept(f(g(h())))`.
+func (c *QuestionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Question = append(c.inters.Question, interceptors...)
+}
+
+// Create returns a builder for creating a Question entity.
+func (c *QuestionClient) Create() *QuestionCreate {
+	mutation := newQuestionMutation(c.config, OpCreate)
+	return &QuestionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Question entities.
+func (c *QuestionClient) CreateBulk(builders ...*QuestionCreate) *QuestionCreateBulk {
+	return &QuestionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *QuestionClient) MapCreateBulk(slice any, setFunc func(*QuestionCreate, int)) *QuestionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &QuestionCreateBulk{err: fmt.Errorf("calling to QuestionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*QuestionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &QuestionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Question.
+func (c *QuestionClient) Update() *QuestionUpdate {
+	mutation := newQuestionMutation(c.config, OpUpdate)
+	return &QuestionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *QuestionClient) UpdateOne(q *Question) *QuestionUpdateOne {
+	mutation := newQuestionMutation(c.config, OpUpdateOne, withQuestion(q))
+	return &QuestionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *QuestionClient) UpdateOneID(id int) *QuestionUpdateOne {
+	mutation := newQuestionMutation(c.config, OpUpdateOne, withQuestionID(id))
+	return &QuestionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Question.
+func (c *QuestionClient) Delete() *QuestionDelete {
+	mutation := newQuestionMutation(c.config, OpDelete)
+	return &QuestionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *QuestionClient) DeleteOne(q *Question) *QuestionDeleteOne {
+	return c.DeleteOneID(q.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *QuestionClient) DeleteOneID(id int) *QuestionDeleteOne {
+	builder := c.Delete().Where(question.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &QuestionDeleteOne{builder}
+}
+
+// Query returns a query builder for Question.
+func (c *QuestionClient) Query() *QuestionQuery {
+	return &QuestionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeQuestion},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Question entity by its id.
+func (c *QuestionClient) Get(ctx context.Context, id int) (*Question, error) {
+	return c.Query().Where(question.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *QuestionClient) GetX(ctx context.Context, id int) *Question {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryQuiz queries the quiz edge of a Question.
+func (c *QuestionClient) QueryQuiz(q *Question) *QuizQuery {
+	query := (&QuizClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := q.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(question.Table, question.FieldID, id),
+			sqlgraph.To(quiz.Table, quiz.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, question.QuizTable, question.QuizColumn),
+		)
+		fromV = sqlgraph.Neighbors(q.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChoices queries the choices edge of a Question.
+func (c *QuestionClient) QueryChoices(q *Question) *ChoiceQuery {
+	query := (&ChoiceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := q.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(question.Table, question.FieldID, id),
+			sqlgraph.To(choice.Table, choice.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, question.ChoicesTable, question.ChoicesColumn),
+		)
+		fromV = sqlgraph.Neighbors(q.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *QuestionClient) Hooks() []Hook {
+	return c.hooks.Question
+}
+
+// Interceptors returns the client interceptors.
+func (c *QuestionClient) Interceptors() []Interceptor {
+	return c.inters.Question
+}
+
+func (c *QuestionClient) mutate(ctx context.Context, m *QuestionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&QuestionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&QuestionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&QuestionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&QuestionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Question mutation op: %q", m.Op())
+	}
+}
+
+// QuizClient is a client for the Quiz schema.
+type QuizClient struct {
+	config
+}
+
+// NewQuizClient returns a client for the Quiz from the given config.
+func NewQuizClient(c config) *QuizClient {
+	return &QuizClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `quiz.Hooks(f(g(h())))`.
+func (c *QuizClient) Use(hooks ...Hook) {
+	c.hooks.Quiz = append(c.hooks.Quiz, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `quiz.Intercept(f(g(h())))`.
+func (c *QuizClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Quiz = append(c.inters.Quiz, interceptors...)
+}
+
+// Create returns a builder for creating a Quiz entity.
+func (c *QuizClient) Create() *QuizCreate {
+	mutation := newQuizMutation(c.config, OpCreate)
+	return &QuizCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Quiz entities.
+func (c *QuizClient) CreateBulk(builders ...*QuizCreate) *QuizCreateBulk {
+	return &QuizCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *QuizClient) MapCreateBulk(slice any, setFunc func(*QuizCreate, int)) *QuizCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &QuizCreateBulk{err: fmt.Errorf("calling to QuizClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*QuizCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &QuizCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Quiz.
+func (c *QuizClient) Update() *QuizUpdate {
+	mutation := newQuizMutation(c.config, OpUpdate)
+	return &QuizUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *QuizClient) UpdateOne(q *Quiz) *QuizUpdateOne {
+	mutation := newQuizMutation(c.config, OpUpdateOne, withQuiz(q))
+	return &QuizUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *QuizClient) UpdateOneID(id int) *QuizUpdateOne {
+	mutation := newQuizMutation(c.config, OpUpdateOne, withQuizID(id))
+	return &QuizUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Quiz.
+func (c *QuizClient) Delete() *QuizDelete {
+	mutation := newQuizMutation(c.config, OpDelete)
+	return &QuizDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *QuizClient) DeleteOne(q *Quiz) *QuizDeleteOne {
+	return c.DeleteOneID(q.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *QuizClient) DeleteOneID(id int) *QuizDeleteOne {
+	builder := c.Delete().Where(quiz.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &QuizDeleteOne{builder}
+}
+
+// Query returns a query builder for Quiz.
+func (c *QuizClient) Query() *QuizQuery {
+	return &QuizQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeQuiz},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Quiz entity by its id.
+func (c *QuizClient) Get(ctx context.Context, id int) (*Quiz, error) {
+	return c.Query().Where(quiz.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *QuizClient) GetX(ctx context.Context, id int) *Quiz {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOwner queries the owner edge of a Quiz.
+func (c *QuizClient) QueryOwner(q *Quiz) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := q.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(quiz.Table, quiz.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, quiz.OwnerTable, quiz.OwnerColumn),
+		)
+		fromV = sqlgraph.Neighbors(q.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryNote queries the note edge of a Quiz.
+func (c *QuizClient) QueryNote(q *Quiz) *NoteQuery {
+	query := (&NoteClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := q.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(quiz.Table, quiz.FieldID, id),
+			sqlgraph.To(note.Table, note.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, quiz.NoteTable, quiz.NoteColumn),
+		)
+		fromV = sqlgraph.Neighbors(q.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryQuestions queries the questions edge of a Quiz.
+func (c *QuizClient) QueryQuestions(q *Quiz) *QuestionQuery {
+	query := (&QuestionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := q.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(quiz.Table, quiz.FieldID, id),
+			sqlgraph.To(question.Table, question.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, quiz.QuestionsTable, quiz.QuestionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(q.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAttempts queries the attempts edge of a Quiz.
+func (c *QuizClient) QueryAttempts(q *Quiz) *QuizAttemptQuery {
+	query := (&QuizAttemptClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := q.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(quiz.Table, quiz.FieldID, id),
+			sqlgraph.To(quizattempt.Table, quizattempt.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, quiz.AttemptsTable, quiz.AttemptsColumn),
+		)
+		fromV = sqlgraph.Neighbors(q.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryStudyEvents queries the study_events edge of a Quiz.
+func (c *QuizClient) QueryStudyEvents(q *Quiz) *StudyEventQuery {
+	query := (&StudyEventClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := q.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(quiz.Table, quiz.FieldID, id),
+			sqlgraph.To(studyevent.Table, studyevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, quiz.StudyEventsTable, quiz.StudyEventsColumn),
+		)
+		fromV = sqlgraph.Neighbors(q.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *QuizClient) Hooks() []Hook {
+	return c.hooks.Quiz
+}
+
+// Interceptors returns the client interceptors.
+func (c *QuizClient) Interceptors() []Interceptor {
+	return c.inters.Quiz
+}
+
+func (c *QuizClient) mutate(ctx context.Context, m *QuizMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&QuizCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&QuizUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&QuizUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&QuizDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Quiz mutation op: %q", m.Op())
+	}
+}
+
+// QuizAttemptClient is a client for the QuizAttempt schema.
+type QuizAttemptClient struct {
+	config
+}
+
+// NewQuizAttemptClient returns a client for the QuizAttempt from the given config.
+func NewQuizAttemptClient(c config) *QuizAttemptClient {
+	return &QuizAttemptClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `quizattempt.Hooks(f(g(h())))`.
+func (c *QuizAttemptClient) Use(hooks ...Hook) {
+	c.hooks.QuizAttempt = append(c.hooks.QuizAttempt, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `quizattempt.Intercept(f(g(h())))`.
+func (c *QuizAttemptClient) Intercept(interceptors ...Interceptor) {
+	c.inters.QuizAttempt = append(c.inters.QuizAttempt, interceptors...)
+}
+
+// Create returns a builder for creating a QuizAttempt entity.
+func (c *QuizAttemptClient) Create() *QuizAttemptCreate {
+	mutation := newQuizAttemptMutation(c.config, OpCreate)
+	return &QuizAttemptCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of QuizAttempt entities.
+func (c *QuizAttemptClient) CreateBulk(builders ...*QuizAttemptCreate) *QuizAttemptCreateBulk {
+	return &QuizAttemptCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *QuizAttemptClient) MapCreateBulk(slice any, setFunc func(*QuizAttemptCreate, int)) *QuizAttemptCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &QuizAttemptCreateBulk{err: fmt.Errorf("calling to QuizAttemptClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*QuizAttemptCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &QuizAttemptCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for QuizAttempt.
+func (c *QuizAttemptClient) Update() *QuizAttemptUpdate {
+	mutation := newQuizAttemptMutation(c.config, OpUpdate)
+	return &QuizAttemptUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *QuizAttemptClient) UpdateOne(qa *QuizAttempt) *QuizAttemptUpdateOne {
+	mutation := newQuizAttemptMutation(c.config, OpUpdateOne, withQuizAttempt(qa))
+	return &QuizAttemptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *QuizAttemptClient) UpdateOneID(id int) *QuizAttemptUpdateOne {
+	mutation := newQuizAttemptMutation(c.config, OpUpdateOne, withQuizAttemptID(id))
+	return &QuizAttemptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for QuizAttempt.
+func (c *QuizAttemptClient) Delete() *QuizAttemptDelete {
+	mutation := newQuizAttemptMutation(c.config, OpDelete)
+	return &QuizAttemptDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *QuizAttemptClient) DeleteOne(qa *QuizAttempt) *QuizAttemptDeleteOne {
+	return c.DeleteOneID(qa.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *QuizAttemptClient) DeleteOneID(id int) *QuizAttemptDeleteOne {
+	builder := c.Delete().Where(quizattempt.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &QuizAttemptDeleteOne{builder}
+}
+
+// Query returns a query builder for QuizAttempt.
+func (c *QuizAttemptClient) Query() *QuizAttemptQuery {
+	return &QuizAttemptQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeQuizAttempt},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a QuizAttempt entity by its id.
+func (c *QuizAttemptClient) Get(ctx context.Context, id int) (*QuizAttempt, error) {
+	return c.Query().Where(quizattempt.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *QuizAttemptClient) GetX(ctx context.Context, id int) *QuizAttempt {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryQuiz queries the quiz edge of a QuizAttempt.
+func (c *QuizAttemptClient) QueryQuiz(qa *QuizAttempt) *QuizQuery {
+	query := (&QuizClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := qa.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(quizattempt.Table, quizattempt.FieldID, id),
+			sqlgraph.To(quiz.Table, quiz.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, quizattempt.QuizTable, quizattempt.QuizColumn),
+		)
+		fromV = sqlgraph.Neighbors(qa.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a QuizAttempt.
+func (c *QuizAttemptClient) QueryUser(qa *QuizAttempt) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := qa.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(quizattempt.Table, quizattempt.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, quizattempt.UserTable, quizattempt.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(qa.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryStudyEvent queries the study_event edge of a QuizAttempt.
+func (c *QuizAttemptClient) QueryStudyEvent(qa *QuizAttempt) *StudyEventQuery {
+	query := (&StudyEventClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := qa.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(quizattempt.Table, quizattempt.FieldID, id),
+			sqlgraph.To(studyevent.Table, studyevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, quizattempt.StudyEventTable, quizattempt.StudyEventColumn),
+		)
+		fromV = sqlgraph.Neighbors(qa.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *QuizAttemptClient) Hooks() []Hook {
+	return c.hooks.QuizAttempt
+}
+
+// Interceptors returns the client interceptors.
+func (c *QuizAttemptClient) Interceptors() []Interceptor {
+	return c.inters.QuizAttempt
+}
+
+func (c *QuizAttemptClient) mutate(ctx context.Context, m *QuizAttemptMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&QuizAttemptCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&QuizAttemptUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&QuizAttemptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&QuizAttemptDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown QuizAttempt mutation op: %q", m.Op())
+	}
+}
+
+// ResumableUploadClient is a client for the ResumableUpload schema.
+type ResumableUploadClient struct {
+	config
+}
+
+// NewResumableUploadClient returns a client for the ResumableUpload from the given config.
+func NewResumableUploadClient(c config) *ResumableUploadClient {
+	return &ResumableUploadClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `resumableupload.Hooks(f(g(h())))`.
+func (c *ResumableUploadClient) Use(hooks ...Hook) {
+	c.hooks.ResumableUpload = append(c.hooks.ResumableUpload, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `resumableupload.Intercept(f(g(h())))`.
+func (c *ResumableUploadClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ResumableUpload = append(c.inters.ResumableUpload, interceptors...)
+}
+
+// Create returns a builder for creating a ResumableUpload entity.
+func (c *ResumableUploadClient) Create() *ResumableUploadCreate {
+	mutation := newResumableUploadMutation(c.config, OpCreate)
+	return &ResumableUploadCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ResumableUpload entities.
+func (c *ResumableUploadClient) CreateBulk(builders ...*ResumableUploadCreate) *ResumableUploadCreateBulk {
+	return &ResumableUploadCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ResumableUploadClient) MapCreateBulk(slice any, setFunc func(*ResumableUploadCreate, int)) *ResumableUploadCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ResumableUploadCreateBulk{err: fmt.Errorf("calling to ResumableUploadClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ResumableUploadCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ResumableUploadCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ResumableUpload.
+func (c *ResumableUploadClient) Update() *ResumableUploadUpdate {
+	mutation := newResumableUploadMutation(c.config, OpUpdate)
+	return &ResumableUploadUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ResumableUploadClient) UpdateOne(ru *ResumableUpload) *ResumableUploadUpdateOne {
+	mutation := newResumableUploadMutation(c.config, OpUpdateOne, withResumableUpload(ru))
+	return &ResumableUploadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ResumableUploadClient) UpdateOneID(id int) *ResumableUploadUpdateOne {
+	mutation := newResumableUploadMutation(c.config, OpUpdateOne, withResumableUploadID(id))
+	return &ResumableUploadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ResumableUpload.
+func (c *ResumableUploadClient) Delete() *ResumableUploadDelete {
+	mutation := newResumableUploadMutation(c.config, OpDelete)
+	return &ResumableUploadDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ResumableUploadClient) DeleteOne(ru *ResumableUpload) *ResumableUploadDeleteOne {
+	return c.DeleteOneID(ru.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ResumableUploadClient) DeleteOneID(id int) *ResumableUploadDeleteOne {
+	builder := c.Delete().Where(resumableupload.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ResumableUploadDeleteOne{builder}
+}
+
+// Query returns a query builder for ResumableUpload.
+func (c *ResumableUploadClient) Query() *ResumableUploadQuery {
+	return &ResumableUploadQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeResumableUpload},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ResumableUpload entity by its id.
+func (c *ResumableUploadClient) Get(ctx context.Context, id int) (*ResumableUpload, error) {
+	return c.Query().Where(resumableupload.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ResumableUploadClient) GetX(ctx context.Context, id int) *ResumableUpload {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOwner queries the owner edge of a ResumableUpload.
+func (c *ResumableUploadClient) QueryOwner(ru *ResumableUpload) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ru.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resumableupload.Table, resumableupload.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, resumableupload.OwnerTable, resumableupload.OwnerColumn),
+		)
+		fromV = sqlgraph.Neighbors(ru.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ResumableUploadClient) Hooks() []Hook {
+	return c.hooks.ResumableUpload
+}
+
+// Interceptors returns the client interceptors.
+func (c *ResumableUploadClient) Interceptors() []Interceptor {
+	return c.inters.ResumableUpload
+}
+
+func (c *ResumableUploadClient) mutate(ctx context.Context, m *ResumableUploadMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ResumableUploadCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ResumableUploadUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ResumableUploadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ResumableUploadDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ResumableUpload mutation op: %q", m.Op())
+	}
+}
+
+// StudyEventClient is a client for the StudyEvent schema.
+type StudyEventClient struct {
+	config
+}
+
+// NewStudyEventClient returns a client for the StudyEvent from the given config.
+func NewStudyEventClient(c config) *StudyEventClient {
+	return &StudyEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `studyevent.Hooks(f(g(h())))`.
+func (c *StudyEventClient) Use(hooks ...Hook) {
+	c.hooks.StudyEvent = append(c.hooks.StudyEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `studyevent.Intercept(f(g(h())))`.
+func (c *StudyEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.StudyEvent = append(c.inters.StudyEvent, interceptors...)
+}
+
+// Create returns a builder for creating a StudyEvent entity.
+func (c *StudyEventClient) Create() *StudyEventCreate {
+	mutation := newStudyEventMutation(c.config, OpCreate)
+	return &StudyEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of StudyEvent entities.
+func (c *StudyEventClient) CreateBulk(builders ...*StudyEventCreate) *StudyEventCreateBulk {
+	return &StudyEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *StudyEventClient) MapCreateBulk(slice any, setFunc func(*StudyEventCreate, int)) *StudyEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &StudyEventCreateBulk{err: fmt.Errorf("calling to StudyEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*StudyEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &StudyEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for StudyEvent.
+func (c *StudyEventClient) Update() *StudyEventUpdate {
+	mutation := newStudyEventMutation(c.config, OpUpdate)
+	return &StudyEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *StudyEventClient) UpdateOne(se *StudyEvent) *StudyEventUpdateOne {
+	mutation := newStudyEventMutation(c.config, OpUpdateOne, withStudyEvent(se))
+	return &StudyEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *StudyEventClient) UpdateOneID(id int) *StudyEventUpdateOne {
+	mutation := newStudyEventMutation(c.config, OpUpdateOne, withStudyEventID(id))
+	return &StudyEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for StudyEvent.
+func (c *StudyEventClient) Delete() *StudyEventDelete {
+	mutation := newStudyEventMutation(c.config, OpDelete)
+	return &StudyEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *StudyEventClient) DeleteOne(se *StudyEvent) *StudyEventDeleteOne {
+	return c.DeleteOneID(se.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *StudyEventClient) DeleteOneID(id int) *StudyEventDeleteOne {
+	builder := c.Delete().Where(studyevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &StudyEventDeleteOne{builder}
+}
+
+// Query returns a query builder for StudyEvent.
+func (c *StudyEventClient) Query() *StudyEventQuery {
+	return &StudyEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeStudyEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a StudyEvent entity by its id.
+func (c *StudyEventClient) Get(ctx context.Context, id int) (*StudyEvent, error) {
+	return c.Query().Where(studyevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *StudyEventClient) GetX(ctx context.Context, id int) *StudyEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a StudyEvent.
+func (c *StudyEventClient) QueryUser(se *StudyEvent) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := se.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(studyevent.Table, studyevent.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, studyevent.UserTable, studyevent.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(se.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryNote queries the note edge of a StudyEvent.
+func (c *StudyEventClient) QueryNote(se *StudyEvent) *NoteQuery {
+	query := (&NoteClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := se.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(studyevent.Table, studyevent.FieldID, id),
+			sqlgraph.To(note.Table, note.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, studyevent.NoteTable, studyevent.NoteColumn),
+		)
+		fromV = sqlgraph.Neighbors(se.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryQuiz queries the quiz edge of a StudyEvent.
+func (c *StudyEventClient) QueryQuiz(se *StudyEvent) *QuizQuery {
+	query := (&QuizClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := se.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(studyevent.Table, studyevent.FieldID, id),
+			sqlgraph.To(quiz.Table, quiz.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, studyevent.QuizTable, studyevent.QuizColumn),
+		)
+		fromV = sqlgraph.Neighbors(se.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAttempt queries the attempt edge of a StudyEvent.
+func (c *StudyEventClient) QueryAttempt(se *StudyEvent) *QuizAttemptQuery {
+	query := (&QuizAttemptClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := se.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(studyevent.Table, studyevent.FieldID, id),
+			sqlgraph.To(quizattempt.Table, quizattempt.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, studyevent.AttemptTable, studyevent.AttemptColumn),
+		)
+		fromV = sqlgraph.Neighbors(se.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *StudyEventClient) Hooks() []Hook {
+	return c.hooks.StudyEvent
+}
+
+// Interceptors returns the client interceptors.
+func (c *StudyEventClient) Interceptors() []Interceptor {
+	return c.inters.StudyEvent
+}
+
+func (c *StudyEventClient) mutate(ctx context.Context, m *StudyEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&StudyEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&StudyEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&StudyEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&StudyEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown StudyEvent mutation op: %q", m.Op())
+	}
+}
+
+// StudyGroupClient is a client for the StudyGroup schema.
+type StudyGroupClient struct {
+	config
+}
+
+// NewStudyGroupClient returns a client for the StudyGroup from the given config.
+func NewStudyGroupClient(c config) *StudyGroupClient {
+	return &StudyGroupClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `studygroup.Hooks(f(g(h())))`.
+func (c *StudyGroupClient) Use(hooks ...Hook) {
+	c.hooks.StudyGroup = append(c.hooks.StudyGroup, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `studygroup.Intercept(f(g(h())))`.
+func (c *StudyGroupClient) Intercept(interceptors ...Interceptor) {
+	c.inters.StudyGroup = append(c.inters.StudyGroup, interceptors...)
+}
+
+// Create returns a builder for creating a StudyGroup entity.
+func (c *StudyGroupClient) Create() *StudyGroupCreate {
+	mutation := newStudyGroupMutation(c.config, OpCreate)
+	return &StudyGroupCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of StudyGroup entities.
+func (c *StudyGroupClient) CreateBulk(builders ...*StudyGroupCreate) *StudyGroupCreateBulk {
+	return &StudyGroupCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *StudyGroupClient) MapCreateBulk(slice any, setFunc func(*StudyGroupCreate, int)) *StudyGroupCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &StudyGroupCreateBulk{err: fmt.Errorf("calling to StudyGroupClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*StudyGroupCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &StudyGroupCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for StudyGroup.
+func (c *StudyGroupClient) Update() *StudyGroupUpdate {
+	mutation := newStudyGroupMutation(c.config, OpUpdate)
+	return &StudyGroupUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *StudyGroupClient) UpdateOne(sg *StudyGroup) *StudyGroupUpdateOne {
+	mutation := newStudyGroupMutation(c.config, OpUpdateOne, withStudyGroup(sg))
+	return &StudyGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *StudyGroupClient) UpdateOneID(id int) *StudyGroupUpdateOne {
+	mutation := newStudyGroupMutation(c.config, OpUpdateOne, withStudyGroupID(id))
+	return &StudyGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for StudyGroup.
+func (c *StudyGroupClient) Delete() *StudyGroupDelete {
+	mutation := newStudyGroupMutation(c.config, OpDelete)
+	return &StudyGroupDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *StudyGroupClient) DeleteOne(sg *StudyGroup) *StudyGroupDeleteOne {
+	return c.DeleteOneID(sg.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *StudyGroupClient) DeleteOneID(id int) *StudyGroupDeleteOne {
+	builder := c.Delete().Where(studygroup.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &StudyGroupDeleteOne{builder}
+}
+
+// Query returns a query builder for StudyGroup.
+func (c *StudyGroupClient) Query() *StudyGroupQuery {
+	return &StudyGroupQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeStudyGroup},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a StudyGroup entity by its id.
+func (c *StudyGroupClient) Get(ctx context.Context, id int) (*StudyGroup, error) {
+	return c.Query().Where(studygroup.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *StudyGroupClient) GetX(ctx context.Context, id int) *StudyGroup {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMemberships queries the memberships edge of a StudyGroup.
+func (c *StudyGroupClient) QueryMemberships(sg *StudyGroup) *GroupMembershipQuery {
+	query := (&GroupMembershipClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sg.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(studygroup.Table, studygroup.FieldID, id),
+			sqlgraph.To(groupmembership.Table, groupmembership.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, studygroup.MembershipsTable, studygroup.MembershipsColumn),
+		)
+		fromV = sqlgraph.Neighbors(sg.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryJoinRequests queries the join_requests edge of a StudyGroup.
+func (c *StudyGroupClient) QueryJoinRequests(sg *StudyGroup) *GroupJoinRequestQuery {
+	query := (&GroupJoinRequestClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sg.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(studygroup.Table, studygroup.FieldID, id),
+			sqlgraph.To(groupjoinrequest.Table, groupjoinrequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, studygroup.JoinRequestsTable, studygroup.JoinRequestsColumn),
+		)
+		fromV = sqlgraph.Neighbors(sg.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
 }
 
-// QueryUser queries the user edge of a NoteRepost.
-func (c *NoteRepostClient) QueryUser(nr *NoteRepost) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
+// QueryInvites queries the invites edge of a StudyGroup.
+func (c *StudyGroupClient) QueryInvites(sg *StudyGroup) *GroupInviteQuery {
+	query := (&GroupInviteClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := nr.ID
+		id := sg.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(noterepost.Table, noterepost.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, noterepost.UserTable, noterepost.UserColumn),
+			sqlgraph.From(studygroup.Table, studygroup.FieldID, id),
+			sqlgraph.To(groupinvite.Table, groupinvite.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, studygroup.InvitesTable, studygroup.InvitesColumn),
 		)
-		fromV = sqlgraph.Neighbors(nr.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(sg.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryNote queries the note edge of a NoteRepost.
-func (c *NoteRepostClient) QueryNote(nr *NoteRepost) *NoteQuery {
+// QuerySharedNotes queries the shared_notes edge of a StudyGroup.
+func (c *StudyGroupClient) QuerySharedNotes(sg *StudyGroup) *NoteQuery {
 	query := (&NoteClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := nr.ID
+		id := sg.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(noterepost.Table, noterepost.FieldID, id),
+			sqlgraph.From(studygroup.Table, studygroup.FieldID, id),
 			sqlgraph.To(note.Table, note.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, noterepost.NoteTable, noterepost.NoteColumn),
+			sqlgraph.Edge(sqlgraph.M2M, false, studygroup.SharedNotesTable, studygroup.SharedNotesPrimaryKey...),
 		)
-		fromV = sqlgraph.Neighbors(nr.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(sg.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *NoteRepostClient) Hooks() []Hook {
-	return c.hooks.NoteRepost
+func (c *StudyGroupClient) Hooks() []Hook {
+	return c.hooks.StudyGroup
 }
 
 // Interceptors returns the client interceptors.
-func (c *NoteRepostClient) Interceptors() []Interceptor {
-	return c.inters.NoteRepost
+func (c *StudyGroupClient) Interceptors() []Interceptor {
+	return c.inters.StudyGroup
 }
 
-func (c *NoteRepostClient) mutate(ctx context.Context, m *NoteRepostMutation) (Value, error) {
+func (c *StudyGroupClient) mutate(ctx context.Context, m *StudyGroupMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&NoteRepostCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&StudyGroupCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&NoteRepostUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&StudyGroupUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&NoteRepostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&StudyGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&NoteRepostDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&StudyGroupDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown NoteRepost mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown StudyGroup mutation op: %q", m.Op())
 	}
 }
 
-// PasswordTokenClient is a client for the PasswordToken schema.
-type PasswordTokenClient struct {
+// TagClient is a client for the Tag schema.
+type TagClient struct {
 	config
 }
 
-// NewPasswordTokenClient returns a client for the PasswordToken from the given config.
-func NewPasswordTokenClient(c config) *PasswordTokenClient {
-	return &PasswordTokenClient{config: c}
+// NewTagClient returns a client for the Tag from the given config.
+func NewTagClient(c config) *TagClient {
+	return &TagClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `passwordtoken.Hooks(f(g(h())))`.
-func (c *PasswordTokenClient) Use(hooks ...Hook) {
-	c.hooks.PasswordToken = append(c.hooks.PasswordToken, hooks...)
+// A call to `Use(f, g, h)` equals to `tag.Hooks(f(g(h())))`.
+func (c *TagClient) Use(hooks ...Hook) {
+	c.hooks.Tag = append(c.hooks.Tag, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `passwordtoken.Intercept(f(g(h())))`.
-func (c *PasswordTokenClient) Intercept(interceptors ...Interceptor) {
-	c.inters.PasswordToken = append(c.inters.PasswordToken, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `tag.Intercept(f(g(h())))`.
+func (c *TagClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Tag = append(c.inters.Tag, interceptors...)
 }
 
-// Create returns a builder for creating a PasswordToken entity.
-func (c *PasswordTokenClient) Create() *PasswordTokenCreate {
-	mutation := newPasswordTokenMutation(c.config, OpCreate)
-	return &PasswordTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Tag entity.
+func (c *TagClient) Create() *TagCreate {
+	mutation := newTagMutation(c.config, OpCreate)
+	return &TagCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of PasswordToken entities.
-func (c *PasswordTokenClient) CreateBulk(builders ...*PasswordTokenCreate) *PasswordTokenCreateBulk {
-	return &PasswordTokenCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Tag entities.
+func (c *TagClient) CreateBulk(builders ...*TagCreate) *TagCreateBulk {
+	return &TagCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PasswordTokenClient) MapCreateBulk(slice any, setFunc func(*PasswordTokenCreate, int)) *PasswordTokenCreateBulk {
+func (c *TagClient) MapCreateBulk(slice any, setFunc func(*TagCreate, int)) *TagCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PasswordTokenCreateBulk{err: fmt.Errorf("calling to PasswordTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &TagCreateBulk{err: fmt.Errorf("calling to TagClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PasswordTokenCreate, rv.Len())
+	builders := make([]*TagCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PasswordTokenCreateBulk{config: c.config, builders: builders}
+	return &TagCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for PasswordToken.
-func (c *PasswordTokenClient) Update() *PasswordTokenUpdate {
-	mutation := newPasswordTokenMutation(c.config, OpUpdate)
-	return &PasswordTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Tag.
+func (c *TagClient) Update() *TagUpdate {
+	mutation := newTagMutation(c.config, OpUpdate)
+	return &TagUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PasswordTokenClient) UpdateOne(pt *PasswordToken) *PasswordTokenUpdateOne {
-	mutation := newPasswordTokenMutation(c.config, OpUpdateOne, withPasswordToken(pt))
-	return &PasswordTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TagClient) UpdateOne(t *Tag) *TagUpdateOne {
+	mutation := newTagMutation(c.config, OpUpdateOne, withTag(t))
+	return &TagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PasswordTokenClient) UpdateOneID(id int) *PasswordTokenUpdateOne {
-	mutation := newPasswordTokenMutation(c.config, OpUpdateOne, withPasswordTokenID(id))
-	return &PasswordTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TagClient) UpdateOneID(id int) *TagUpdateOne {
+	mutation := newTagMutation(c.config, OpUpdateOne, withTagID(id))
+	return &TagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for PasswordToken.
-func (c *PasswordTokenClient) Delete() *PasswordTokenDelete {
-	mutation := newPasswordTokenMutation(c.config, OpDelete)
-	return &PasswordTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Tag.
+func (c *TagClient) Delete() *TagDelete {
+	mutation := newTagMutation(c.config, OpDelete)
+	return &TagDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PasswordTokenClient) DeleteOne(pt *PasswordToken) *PasswordTokenDeleteOne {
-	return c.DeleteOneID(pt.ID)
+func (c *TagClient) DeleteOne(t *Tag) *TagDeleteOne {
+	return c.DeleteOneID(t.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PasswordTokenClient) DeleteOneID(id int) *PasswordTokenDeleteOne {
-	builder := c.Delete().Where(passwordtoken.ID(id))
+func (c *TagClient) DeleteOneID(id int) *TagDeleteOne {
+	builder := c.Delete().Where(tag.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PasswordTokenDeleteOne{builder}
+	return &TagDeleteOne{builder}
 }
 
-// Query returns a query builder for PasswordToken.
-func (c *PasswordTokenClient) Query() *PasswordTokenQuery {
-	return &PasswordTokenQuery{
+// Query returns a query builder for Tag.
+func (c *TagClient) Query() *TagQuery {
+	return &TagQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePasswordToken},
+		ctx:    &QueryContext{Type: TypeTag},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a PasswordToken entity by its id.
-func (c *PasswordTokenClient) Get(ctx context.Context, id int) (*PasswordToken, error) {
-	return c.Query().Where(passwordtoken.ID(id)).Only(ctx)
+// Get returns a Tag entity by its id.
+func (c *TagClient) Get(ctx context.Context, id int) (*Tag, error) {
+	return c.Query().Where(tag.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PasswordTokenClient) GetX(ctx context.Context, id int) *PasswordToken {
+func (c *TagClient) GetX(ctx context.Context, id int) *Tag {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -856,44 +4455,60 @@ func (c *PasswordTokenClient) GetX(ctx context.Context, id int) *PasswordToken {
 	return obj
 }
 
-// QueryUser queries the user edge of a PasswordToken.
-func (c *PasswordTokenClient) QueryUser(pt *PasswordToken) *UserQuery {
+// QueryOwner queries the owner edge of a Tag.
+func (c *TagClient) QueryOwner(t *Tag) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pt.ID
+		id := t.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(passwordtoken.Table, passwordtoken.FieldID, id),
+			sqlgraph.From(tag.Table, tag.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, passwordtoken.UserTable, passwordtoken.UserColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, tag.OwnerTable, tag.OwnerColumn),
 		)
-		fromV = sqlgraph.Neighbors(pt.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryNotes queries the notes edge of a Tag.
+func (c *TagClient) QueryNotes(t *Tag) *NoteQuery {
+	query := (&NoteClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tag.Table, tag.FieldID, id),
+			sqlgraph.To(note.Table, note.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, tag.NotesTable, tag.NotesPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *PasswordTokenClient) Hooks() []Hook {
-	return c.hooks.PasswordToken
+func (c *TagClient) Hooks() []Hook {
+	return c.hooks.Tag
 }
 
 // Interceptors returns the client interceptors.
-func (c *PasswordTokenClient) Interceptors() []Interceptor {
-	return c.inters.PasswordToken
+func (c *TagClient) Interceptors() []Interceptor {
+	return c.inters.Tag
 }
 
-func (c *PasswordTokenClient) mutate(ctx context.Context, m *PasswordTokenMutation) (Value, error) {
+func (c *TagClient) mutate(ctx context.Context, m *TagMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PasswordTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TagCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PasswordTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TagUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PasswordTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PasswordTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&TagDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown PasswordToken mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Tag mutation op: %q", m.Op())
 	}
 }
 
@@ -1069,6 +4684,230 @@ func (c *UserClient) QueryNoteReposts(u *User) *NoteRepostQuery {
 	return query
 }
 
+// QueryQuizzes queries the quizzes edge of a User.
+func (c *UserClient) QueryQuizzes(u *User) *QuizQuery {
+	query := (&QuizClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(quiz.Table, quiz.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.QuizzesTable, user.QuizzesColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryQuizAttempts queries the quiz_attempts edge of a User.
+func (c *UserClient) QueryQuizAttempts(u *User) *QuizAttemptQuery {
+	query := (&QuizAttemptClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(quizattempt.Table, quizattempt.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.QuizAttemptsTable, user.QuizAttemptsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryGroupMemberships queries the group_memberships edge of a User.
+func (c *UserClient) QueryGroupMemberships(u *User) *GroupMembershipQuery {
+	query := (&GroupMembershipClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(groupmembership.Table, groupmembership.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.GroupMembershipsTable, user.GroupMembershipsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryGroupJoinRequests queries the group_join_requests edge of a User.
+func (c *UserClient) QueryGroupJoinRequests(u *User) *GroupJoinRequestQuery {
+	query := (&GroupJoinRequestClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(groupjoinrequest.Table, groupjoinrequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.GroupJoinRequestsTable, user.GroupJoinRequestsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryGroupInvites queries the group_invites edge of a User.
+func (c *UserClient) QueryGroupInvites(u *User) *GroupInviteQuery {
+	query := (&GroupInviteClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(groupinvite.Table, groupinvite.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.GroupInvitesTable, user.GroupInvitesColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryStudyEvents queries the study_events edge of a User.
+func (c *UserClient) QueryStudyEvents(u *User) *StudyEventQuery {
+	query := (&StudyEventClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(studyevent.Table, studyevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.StudyEventsTable, user.StudyEventsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDocuments queries the documents edge of a User.
+func (c *UserClient) QueryDocuments(u *User) *DocumentQuery {
+	query := (&DocumentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(document.Table, document.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.DocumentsTable, user.DocumentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFolders queries the folders edge of a User.
+func (c *UserClient) QueryFolders(u *User) *FolderQuery {
+	query := (&FolderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(folder.Table, folder.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.FoldersTable, user.FoldersColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBlobReferences queries the blob_references edge of a User.
+func (c *UserClient) QueryBlobReferences(u *User) *BlobReferenceQuery {
+	query := (&BlobReferenceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(blobreference.Table, blobreference.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.BlobReferencesTable, user.BlobReferencesColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryResumableUploads queries the resumable_uploads edge of a User.
+func (c *UserClient) QueryResumableUploads(u *User) *ResumableUploadQuery {
+	query := (&ResumableUploadClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(resumableupload.Table, resumableupload.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ResumableUploadsTable, user.ResumableUploadsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryNoteProposals queries the note_proposals edge of a User.
+func (c *UserClient) QueryNoteProposals(u *User) *NoteProposalQuery {
+	query := (&NoteProposalClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(noteproposal.Table, noteproposal.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.NoteProposalsTable, user.NoteProposalsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryNoteRevisions queries the note_revisions edge of a User.
+func (c *UserClient) QueryNoteRevisions(u *User) *NoteRevisionQuery {
+	query := (&NoteRevisionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(noterevision.Table, noterevision.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.NoteRevisionsTable, user.NoteRevisionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryNotebooks queries the notebooks edge of a User.
+func (c *UserClient) QueryNotebooks(u *User) *NotebookQuery {
+	query := (&NotebookClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(notebook.Table, notebook.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.NotebooksTable, user.NotebooksColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTags queries the tags edge of a User.
+func (c *UserClient) QueryTags(u *User) *TagQuery {
+	query := (&TagClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.TagsTable, user.TagsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
 	return c.hooks.User
@@ -1097,9 +4936,15 @@ func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error)
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Note, NoteLike, NoteRepost, PasswordToken, User []ent.Hook
+		Blob, BlobReference, Choice, Document, Folder, GroupInvite, GroupJoinRequest,
+		GroupMembership, Note, NoteLike, NoteLink, NoteProposal, NoteRepost,
+		NoteRevision, Notebook, PasswordToken, Question, Quiz, QuizAttempt,
+		ResumableUpload, StudyEvent, StudyGroup, Tag, User []ent.Hook
 	}
 	inters struct {
-		Note, NoteLike, NoteRepost, PasswordToken, User []ent.Interceptor
+		Blob, BlobReference, Choice, Document, Folder, GroupInvite, GroupJoinRequest,
+		GroupMembership, Note, NoteLike, NoteLink, NoteProposal, NoteRepost,
+		NoteRevision, Notebook, PasswordToken, Question, Quiz, QuizAttempt,
+		ResumableUpload, StudyEvent, StudyGroup, Tag, User []ent.Interceptor
 	}
 )
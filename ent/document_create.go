@@ -0,0 +1,513 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/r-scheele/zero/ent/document"
+	"github.com/r-scheele/zero/ent/folder"
+	"github.com/r-scheele/zero/ent/note"
+	"github.com/r-scheele/zero/ent/user"
+)
+
+// DocumentCreate is the builder for creating a Document entity.
+type DocumentCreate struct {
+	config
+	mutation *DocumentMutation
+	hooks    []Hook
+}
+
+// SetTitle sets the "title" field.
+func (dc *DocumentCreate) SetTitle(s string) *DocumentCreate {
+	dc.mutation.SetTitle(s)
+	return dc
+}
+
+// SetDescription sets the "description" field.
+func (dc *DocumentCreate) SetDescription(s string) *DocumentCreate {
+	dc.mutation.SetDescription(s)
+	return dc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (dc *DocumentCreate) SetNillableDescription(s *string) *DocumentCreate {
+	if s != nil {
+		dc.SetDescription(*s)
+	}
+	return dc
+}
+
+// SetFileName sets the "file_name" field.
+func (dc *DocumentCreate) SetFileName(s string) *DocumentCreate {
+	dc.mutation.SetFileName(s)
+	return dc
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (dc *DocumentCreate) SetStorageKey(s string) *DocumentCreate {
+	dc.mutation.SetStorageKey(s)
+	return dc
+}
+
+// SetMimeType sets the "mime_type" field.
+func (dc *DocumentCreate) SetMimeType(s string) *DocumentCreate {
+	dc.mutation.SetMimeType(s)
+	return dc
+}
+
+// SetNillableMimeType sets the "mime_type" field if the given value is not nil.
+func (dc *DocumentCreate) SetNillableMimeType(s *string) *DocumentCreate {
+	if s != nil {
+		dc.SetMimeType(*s)
+	}
+	return dc
+}
+
+// SetSize sets the "size" field.
+func (dc *DocumentCreate) SetSize(i int64) *DocumentCreate {
+	dc.mutation.SetSize(i)
+	return dc
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (dc *DocumentCreate) SetNillableSize(i *int64) *DocumentCreate {
+	if i != nil {
+		dc.SetSize(*i)
+	}
+	return dc
+}
+
+// SetResourceType sets the "resource_type" field.
+func (dc *DocumentCreate) SetResourceType(s string) *DocumentCreate {
+	dc.mutation.SetResourceType(s)
+	return dc
+}
+
+// SetNillableResourceType sets the "resource_type" field if the given value is not nil.
+func (dc *DocumentCreate) SetNillableResourceType(s *string) *DocumentCreate {
+	if s != nil {
+		dc.SetResourceType(*s)
+	}
+	return dc
+}
+
+// SetTags sets the "tags" field.
+func (dc *DocumentCreate) SetTags(s []string) *DocumentCreate {
+	dc.mutation.SetTags(s)
+	return dc
+}
+
+// SetVisibility sets the "visibility" field.
+func (dc *DocumentCreate) SetVisibility(d document.Visibility) *DocumentCreate {
+	dc.mutation.SetVisibility(d)
+	return dc
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (dc *DocumentCreate) SetNillableVisibility(d *document.Visibility) *DocumentCreate {
+	if d != nil {
+		dc.SetVisibility(*d)
+	}
+	return dc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (dc *DocumentCreate) SetCreatedAt(t time.Time) *DocumentCreate {
+	dc.mutation.SetCreatedAt(t)
+	return dc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (dc *DocumentCreate) SetNillableCreatedAt(t *time.Time) *DocumentCreate {
+	if t != nil {
+		dc.SetCreatedAt(*t)
+	}
+	return dc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (dc *DocumentCreate) SetUpdatedAt(t time.Time) *DocumentCreate {
+	dc.mutation.SetUpdatedAt(t)
+	return dc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (dc *DocumentCreate) SetNillableUpdatedAt(t *time.Time) *DocumentCreate {
+	if t != nil {
+		dc.SetUpdatedAt(*t)
+	}
+	return dc
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (dc *DocumentCreate) SetOwnerID(id int) *DocumentCreate {
+	dc.mutation.SetOwnerID(id)
+	return dc
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (dc *DocumentCreate) SetOwner(u *User) *DocumentCreate {
+	return dc.SetOwnerID(u.ID)
+}
+
+// SetFolderID sets the "folder" edge to the Folder entity by ID.
+func (dc *DocumentCreate) SetFolderID(id int) *DocumentCreate {
+	dc.mutation.SetFolderID(id)
+	return dc
+}
+
+// SetNillableFolderID sets the "folder" edge to the Folder entity by ID if the given value is not nil.
+func (dc *DocumentCreate) SetNillableFolderID(id *int) *DocumentCreate {
+	if id != nil {
+		dc = dc.SetFolderID(*id)
+	}
+	return dc
+}
+
+// SetFolder sets the "folder" edge to the Folder entity.
+func (dc *DocumentCreate) SetFolder(f *Folder) *DocumentCreate {
+	return dc.SetFolderID(f.ID)
+}
+
+// AddNoteIDs adds the "notes" edge to the Note entity by IDs.
+func (dc *DocumentCreate) AddNoteIDs(ids ...int) *DocumentCreate {
+	dc.mutation.AddNoteIDs(ids...)
+	return dc
+}
+
+// AddNotes adds the "notes" edges to the Note entity.
+func (dc *DocumentCreate) AddNotes(n ...*Note) *DocumentCreate {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return dc.AddNoteIDs(ids...)
+}
+
+// Mutation returns the DocumentMutation object of the builder.
+func (dc *DocumentCreate) Mutation() *DocumentMutation {
+	return dc.mutation
+}
+
+// Save creates the Document in the database.
+func (dc *DocumentCreate) Save(ctx context.Context) (*Document, error) {
+	dc.defaults()
+	return withHooks(ctx, dc.sqlSave, dc.mutation, dc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (dc *DocumentCreate) SaveX(ctx context.Context) *Document {
+	v, err := dc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dc *DocumentCreate) Exec(ctx context.Context) error {
+	_, err := dc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dc *DocumentCreate) ExecX(ctx context.Context) {
+	if err := dc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dc *DocumentCreate) defaults() {
+	if _, ok := dc.mutation.Size(); !ok {
+		v := document.DefaultSize
+		dc.mutation.SetSize(v)
+	}
+	if _, ok := dc.mutation.ResourceType(); !ok {
+		v := document.DefaultResourceType
+		dc.mutation.SetResourceType(v)
+	}
+	if _, ok := dc.mutation.Visibility(); !ok {
+		v := document.DefaultVisibility
+		dc.mutation.SetVisibility(v)
+	}
+	if _, ok := dc.mutation.CreatedAt(); !ok {
+		v := document.DefaultCreatedAt()
+		dc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := dc.mutation.UpdatedAt(); !ok {
+		v := document.DefaultUpdatedAt()
+		dc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dc *DocumentCreate) check() error {
+	if _, ok := dc.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "Document.title"`)}
+	}
+	if v, ok := dc.mutation.Title(); ok {
+		if err := document.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Document.title": %w`, err)}
+		}
+	}
+	if _, ok := dc.mutation.FileName(); !ok {
+		return &ValidationError{Name: "file_name", err: errors.New(`ent: missing required field "Document.file_name"`)}
+	}
+	if v, ok := dc.mutation.FileName(); ok {
+		if err := document.FileNameValidator(v); err != nil {
+			return &ValidationError{Name: "file_name", err: fmt.Errorf(`ent: validator failed for field "Document.file_name": %w`, err)}
+		}
+	}
+	if _, ok := dc.mutation.StorageKey(); !ok {
+		return &ValidationError{Name: "storage_key", err: errors.New(`ent: missing required field "Document.storage_key"`)}
+	}
+	if v, ok := dc.mutation.StorageKey(); ok {
+		if err := document.StorageKeyValidator(v); err != nil {
+			return &ValidationError{Name: "storage_key", err: fmt.Errorf(`ent: validator failed for field "Document.storage_key": %w`, err)}
+		}
+	}
+	if _, ok := dc.mutation.Size(); !ok {
+		return &ValidationError{Name: "size", err: errors.New(`ent: missing required field "Document.size"`)}
+	}
+	if v, ok := dc.mutation.Size(); ok {
+		if err := document.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "Document.size": %w`, err)}
+		}
+	}
+	if _, ok := dc.mutation.ResourceType(); !ok {
+		return &ValidationError{Name: "resource_type", err: errors.New(`ent: missing required field "Document.resource_type"`)}
+	}
+	if _, ok := dc.mutation.Visibility(); !ok {
+		return &ValidationError{Name: "visibility", err: errors.New(`ent: missing required field "Document.visibility"`)}
+	}
+	if v, ok := dc.mutation.Visibility(); ok {
+		if err := document.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "Document.visibility": %w`, err)}
+		}
+	}
+	if _, ok := dc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Document.created_at"`)}
+	}
+	if _, ok := dc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Document.updated_at"`)}
+	}
+	if len(dc.mutation.OwnerIDs()) == 0 {
+		return &ValidationError{Name: "owner", err: errors.New(`ent: missing required edge "Document.owner"`)}
+	}
+	return nil
+}
+
+func (dc *DocumentCreate) sqlSave(ctx context.Context) (*Document, error) {
+	if err := dc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := dc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, dc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	dc.mutation.id = &_node.ID
+	dc.mutation.done = true
+	return _node, nil
+}
+
+func (dc *DocumentCreate) createSpec() (*Document, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Document{config: dc.config}
+		_spec = sqlgraph.NewCreateSpec(document.Table, sqlgraph.NewFieldSpec(document.FieldID, field.TypeInt))
+	)
+	if value, ok := dc.mutation.Title(); ok {
+		_spec.SetField(document.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := dc.mutation.Description(); ok {
+		_spec.SetField(document.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := dc.mutation.FileName(); ok {
+		_spec.SetField(document.FieldFileName, field.TypeString, value)
+		_node.FileName = value
+	}
+	if value, ok := dc.mutation.StorageKey(); ok {
+		_spec.SetField(document.FieldStorageKey, field.TypeString, value)
+		_node.StorageKey = value
+	}
+	if value, ok := dc.mutation.MimeType(); ok {
+		_spec.SetField(document.FieldMimeType, field.TypeString, value)
+		_node.MimeType = value
+	}
+	if value, ok := dc.mutation.Size(); ok {
+		_spec.SetField(document.FieldSize, field.TypeInt64, value)
+		_node.Size = value
+	}
+	if value, ok := dc.mutation.ResourceType(); ok {
+		_spec.SetField(document.FieldResourceType, field.TypeString, value)
+		_node.ResourceType = value
+	}
+	if value, ok := dc.mutation.Tags(); ok {
+		_spec.SetField(document.FieldTags, field.TypeJSON, value)
+		_node.Tags = value
+	}
+	if value, ok := dc.mutation.Visibility(); ok {
+		_spec.SetField(document.FieldVisibility, field.TypeEnum, value)
+		_node.Visibility = value
+	}
+	if value, ok := dc.mutation.CreatedAt(); ok {
+		_spec.SetField(document.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := dc.mutation.UpdatedAt(); ok {
+		_spec.SetField(document.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := dc.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   document.OwnerTable,
+			Columns: []string{document.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_documents = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := dc.mutation.FolderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   document.FolderTable,
+			Columns: []string{document.FolderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(folder.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.folder_documents = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := dc.mutation.NotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   document.NotesTable,
+			Columns: document.NotesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// DocumentCreateBulk is the builder for creating many Document entities in bulk.
+type DocumentCreateBulk struct {
+	config
+	err      error
+	builders []*DocumentCreate
+}
+
+// Save creates the Document entities in the database.
+func (dcb *DocumentCreateBulk) Save(ctx context.Context) ([]*Document, error) {
+	if dcb.err != nil {
+		return nil, dcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(dcb.builders))
+	nodes := make([]*Document, len(dcb.builders))
+	mutators := make([]Mutator, len(dcb.builders))
+	for i := range dcb.builders {
+		func(i int, root context.Context) {
+			builder := dcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DocumentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, dcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, dcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, dcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dcb *DocumentCreateBulk) SaveX(ctx context.Context) []*Document {
+	v, err := dcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dcb *DocumentCreateBulk) Exec(ctx context.Context) error {
+	_, err := dcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dcb *DocumentCreateBulk) ExecX(ctx context.Context) {
+	if err := dcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1036 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/r-scheele/zero/ent/document"
+	"github.com/r-scheele/zero/ent/folder"
+	"github.com/r-scheele/zero/ent/note"
+	"github.com/r-scheele/zero/ent/predicate"
+	"github.com/r-scheele/zero/ent/user"
+)
+
+// DocumentUpdate is the builder for updating Document entities.
+type DocumentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DocumentMutation
+}
+
+// Where appends a list predicates to the DocumentUpdate builder.
+func (du *DocumentUpdate) Where(ps ...predicate.Document) *DocumentUpdate {
+	du.mutation.Where(ps...)
+	return du
+}
+
+// SetTitle sets the "title" field.
+func (du *DocumentUpdate) SetTitle(s string) *DocumentUpdate {
+	du.mutation.SetTitle(s)
+	return du
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (du *DocumentUpdate) SetNillableTitle(s *string) *DocumentUpdate {
+	if s != nil {
+		du.SetTitle(*s)
+	}
+	return du
+}
+
+// SetDescription sets the "description" field.
+func (du *DocumentUpdate) SetDescription(s string) *DocumentUpdate {
+	du.mutation.SetDescription(s)
+	return du
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (du *DocumentUpdate) SetNillableDescription(s *string) *DocumentUpdate {
+	if s != nil {
+		du.SetDescription(*s)
+	}
+	return du
+}
+
+// ClearDescription clears the value of the "description" field.
+func (du *DocumentUpdate) ClearDescription() *DocumentUpdate {
+	du.mutation.ClearDescription()
+	return du
+}
+
+// SetFileName sets the "file_name" field.
+func (du *DocumentUpdate) SetFileName(s string) *DocumentUpdate {
+	du.mutation.SetFileName(s)
+	return du
+}
+
+// SetNillableFileName sets the "file_name" field if the given value is not nil.
+func (du *DocumentUpdate) SetNillableFileName(s *string) *DocumentUpdate {
+	if s != nil {
+		du.SetFileName(*s)
+	}
+	return du
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (du *DocumentUpdate) SetStorageKey(s string) *DocumentUpdate {
+	du.mutation.SetStorageKey(s)
+	return du
+}
+
+// SetNillableStorageKey sets the "storage_key" field if the given value is not nil.
+func (du *DocumentUpdate) SetNillableStorageKey(s *string) *DocumentUpdate {
+	if s != nil {
+		du.SetStorageKey(*s)
+	}
+	return du
+}
+
+// SetMimeType sets the "mime_type" field.
+func (du *DocumentUpdate) SetMimeType(s string) *DocumentUpdate {
+	du.mutation.SetMimeType(s)
+	return du
+}
+
+// SetNillableMimeType sets the "mime_type" field if the given value is not nil.
+func (du *DocumentUpdate) SetNillableMimeType(s *string) *DocumentUpdate {
+	if s != nil {
+		du.SetMimeType(*s)
+	}
+	return du
+}
+
+// ClearMimeType clears the value of the "mime_type" field.
+func (du *DocumentUpdate) ClearMimeType() *DocumentUpdate {
+	du.mutation.ClearMimeType()
+	return du
+}
+
+// SetSize sets the "size" field.
+func (du *DocumentUpdate) SetSize(i int64) *DocumentUpdate {
+	du.mutation.ResetSize()
+	du.mutation.SetSize(i)
+	return du
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (du *DocumentUpdate) SetNillableSize(i *int64) *DocumentUpdate {
+	if i != nil {
+		du.SetSize(*i)
+	}
+	return du
+}
+
+// AddSize adds i to the "size" field.
+func (du *DocumentUpdate) AddSize(i int64) *DocumentUpdate {
+	du.mutation.AddSize(i)
+	return du
+}
+
+// SetResourceType sets the "resource_type" field.
+func (du *DocumentUpdate) SetResourceType(s string) *DocumentUpdate {
+	du.mutation.SetResourceType(s)
+	return du
+}
+
+// SetNillableResourceType sets the "resource_type" field if the given value is not nil.
+func (du *DocumentUpdate) SetNillableResourceType(s *string) *DocumentUpdate {
+	if s != nil {
+		du.SetResourceType(*s)
+	}
+	return du
+}
+
+// SetTags sets the "tags" field.
+func (du *DocumentUpdate) SetTags(s []string) *DocumentUpdate {
+	du.mutation.SetTags(s)
+	return du
+}
+
+// AppendTags appends s to the "tags" field.
+func (du *DocumentUpdate) AppendTags(s []string) *DocumentUpdate {
+	du.mutation.AppendTags(s)
+	return du
+}
+
+// ClearTags clears the value of the "tags" field.
+func (du *DocumentUpdate) ClearTags() *DocumentUpdate {
+	du.mutation.ClearTags()
+	return du
+}
+
+// SetVisibility sets the "visibility" field.
+func (du *DocumentUpdate) SetVisibility(d document.Visibility) *DocumentUpdate {
+	du.mutation.SetVisibility(d)
+	return du
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (du *DocumentUpdate) SetNillableVisibility(d *document.Visibility) *DocumentUpdate {
+	if d != nil {
+		du.SetVisibility(*d)
+	}
+	return du
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (du *DocumentUpdate) SetUpdatedAt(t time.Time) *DocumentUpdate {
+	du.mutation.SetUpdatedAt(t)
+	return du
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (du *DocumentUpdate) SetOwnerID(id int) *DocumentUpdate {
+	du.mutation.SetOwnerID(id)
+	return du
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (du *DocumentUpdate) SetOwner(u *User) *DocumentUpdate {
+	return du.SetOwnerID(u.ID)
+}
+
+// SetFolderID sets the "folder" edge to the Folder entity by ID.
+func (du *DocumentUpdate) SetFolderID(id int) *DocumentUpdate {
+	du.mutation.SetFolderID(id)
+	return du
+}
+
+// SetNillableFolderID sets the "folder" edge to the Folder entity by ID if the given value is not nil.
+func (du *DocumentUpdate) SetNillableFolderID(id *int) *DocumentUpdate {
+	if id != nil {
+		du = du.SetFolderID(*id)
+	}
+	return du
+}
+
+// SetFolder sets the "folder" edge to the Folder entity.
+func (du *DocumentUpdate) SetFolder(f *Folder) *DocumentUpdate {
+	return du.SetFolderID(f.ID)
+}
+
+// AddNoteIDs adds the "notes" edge to the Note entity by IDs.
+func (du *DocumentUpdate) AddNoteIDs(ids ...int) *DocumentUpdate {
+	du.mutation.AddNoteIDs(ids...)
+	return du
+}
+
+// AddNotes adds the "notes" edges to the Note entity.
+func (du *DocumentUpdate) AddNotes(n ...*Note) *DocumentUpdate {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return du.AddNoteIDs(ids...)
+}
+
+// Mutation returns the DocumentMutation object of the builder.
+func (du *DocumentUpdate) Mutation() *DocumentMutation {
+	return du.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (du *DocumentUpdate) ClearOwner() *DocumentUpdate {
+	du.mutation.ClearOwner()
+	return du
+}
+
+// ClearFolder clears the "folder" edge to the Folder entity.
+func (du *DocumentUpdate) ClearFolder() *DocumentUpdate {
+	du.mutation.ClearFolder()
+	return du
+}
+
+// ClearNotes clears all "notes" edges to the Note entity.
+func (du *DocumentUpdate) ClearNotes() *DocumentUpdate {
+	du.mutation.ClearNotes()
+	return du
+}
+
+// RemoveNoteIDs removes the "notes" edge to Note entities by IDs.
+func (du *DocumentUpdate) RemoveNoteIDs(ids ...int) *DocumentUpdate {
+	du.mutation.RemoveNoteIDs(ids...)
+	return du
+}
+
+// RemoveNotes removes "notes" edges to Note entities.
+func (du *DocumentUpdate) RemoveNotes(n ...*Note) *DocumentUpdate {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return du.RemoveNoteIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (du *DocumentUpdate) Save(ctx context.Context) (int, error) {
+	du.defaults()
+	return withHooks(ctx, du.sqlSave, du.mutation, du.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (du *DocumentUpdate) SaveX(ctx context.Context) int {
+	affected, err := du.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (du *DocumentUpdate) Exec(ctx context.Context) error {
+	_, err := du.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (du *DocumentUpdate) ExecX(ctx context.Context) {
+	if err := du.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (du *DocumentUpdate) defaults() {
+	if _, ok := du.mutation.UpdatedAt(); !ok {
+		v := document.UpdateDefaultUpdatedAt()
+		du.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (du *DocumentUpdate) check() error {
+	if v, ok := du.mutation.Title(); ok {
+		if err := document.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Document.title": %w`, err)}
+		}
+	}
+	if v, ok := du.mutation.FileName(); ok {
+		if err := document.FileNameValidator(v); err != nil {
+			return &ValidationError{Name: "file_name", err: fmt.Errorf(`ent: validator failed for field "Document.file_name": %w`, err)}
+		}
+	}
+	if v, ok := du.mutation.StorageKey(); ok {
+		if err := document.StorageKeyValidator(v); err != nil {
+			return &ValidationError{Name: "storage_key", err: fmt.Errorf(`ent: validator failed for field "Document.storage_key": %w`, err)}
+		}
+	}
+	if v, ok := du.mutation.Size(); ok {
+		if err := document.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "Document.size": %w`, err)}
+		}
+	}
+	if v, ok := du.mutation.Visibility(); ok {
+		if err := document.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "Document.visibility": %w`, err)}
+		}
+	}
+	if du.mutation.OwnerCleared() && len(du.mutation.OwnerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Document.owner"`)
+	}
+	return nil
+}
+
+func (du *DocumentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := du.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(document.Table, document.Columns, sqlgraph.NewFieldSpec(document.FieldID, field.TypeInt))
+	if ps := du.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := du.mutation.Title(); ok {
+		_spec.SetField(document.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := du.mutation.Description(); ok {
+		_spec.SetField(document.FieldDescription, field.TypeString, value)
+	}
+	if du.mutation.DescriptionCleared() {
+		_spec.ClearField(document.FieldDescription, field.TypeString)
+	}
+	if value, ok := du.mutation.FileName(); ok {
+		_spec.SetField(document.FieldFileName, field.TypeString, value)
+	}
+	if value, ok := du.mutation.StorageKey(); ok {
+		_spec.SetField(document.FieldStorageKey, field.TypeString, value)
+	}
+	if value, ok := du.mutation.MimeType(); ok {
+		_spec.SetField(document.FieldMimeType, field.TypeString, value)
+	}
+	if du.mutation.MimeTypeCleared() {
+		_spec.ClearField(document.FieldMimeType, field.TypeString)
+	}
+	if value, ok := du.mutation.Size(); ok {
+		_spec.SetField(document.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := du.mutation.AddedSize(); ok {
+		_spec.AddField(document.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := du.mutation.ResourceType(); ok {
+		_spec.SetField(document.FieldResourceType, field.TypeString, value)
+	}
+	if value, ok := du.mutation.Tags(); ok {
+		_spec.SetField(document.FieldTags, field.TypeJSON, value)
+	}
+	if value, ok := du.mutation.AppendedTags(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, document.FieldTags, value)
+		})
+	}
+	if du.mutation.TagsCleared() {
+		_spec.ClearField(document.FieldTags, field.TypeJSON)
+	}
+	if value, ok := du.mutation.Visibility(); ok {
+		_spec.SetField(document.FieldVisibility, field.TypeEnum, value)
+	}
+	if value, ok := du.mutation.UpdatedAt(); ok {
+		_spec.SetField(document.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if du.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   document.OwnerTable,
+			Columns: []string{document.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   document.OwnerTable,
+			Columns: []string{document.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if du.mutation.FolderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   document.FolderTable,
+			Columns: []string{document.FolderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(folder.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.FolderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   document.FolderTable,
+			Columns: []string{document.FolderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(folder.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if du.mutation.NotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   document.NotesTable,
+			Columns: document.NotesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.RemovedNotesIDs(); len(nodes) > 0 && !du.mutation.NotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   document.NotesTable,
+			Columns: document.NotesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.NotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   document.NotesTable,
+			Columns: document.NotesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, du.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{document.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	du.mutation.done = true
+	return n, nil
+}
+
+// DocumentUpdateOne is the builder for updating a single Document entity.
+type DocumentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DocumentMutation
+}
+
+// SetTitle sets the "title" field.
+func (duo *DocumentUpdateOne) SetTitle(s string) *DocumentUpdateOne {
+	duo.mutation.SetTitle(s)
+	return duo
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (duo *DocumentUpdateOne) SetNillableTitle(s *string) *DocumentUpdateOne {
+	if s != nil {
+		duo.SetTitle(*s)
+	}
+	return duo
+}
+
+// SetDescription sets the "description" field.
+func (duo *DocumentUpdateOne) SetDescription(s string) *DocumentUpdateOne {
+	duo.mutation.SetDescription(s)
+	return duo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (duo *DocumentUpdateOne) SetNillableDescription(s *string) *DocumentUpdateOne {
+	if s != nil {
+		duo.SetDescription(*s)
+	}
+	return duo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (duo *DocumentUpdateOne) ClearDescription() *DocumentUpdateOne {
+	duo.mutation.ClearDescription()
+	return duo
+}
+
+// SetFileName sets the "file_name" field.
+func (duo *DocumentUpdateOne) SetFileName(s string) *DocumentUpdateOne {
+	duo.mutation.SetFileName(s)
+	return duo
+}
+
+// SetNillableFileName sets the "file_name" field if the given value is not nil.
+func (duo *DocumentUpdateOne) SetNillableFileName(s *string) *DocumentUpdateOne {
+	if s != nil {
+		duo.SetFileName(*s)
+	}
+	return duo
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (duo *DocumentUpdateOne) SetStorageKey(s string) *DocumentUpdateOne {
+	duo.mutation.SetStorageKey(s)
+	return duo
+}
+
+// SetNillableStorageKey sets the "storage_key" field if the given value is not nil.
+func (duo *DocumentUpdateOne) SetNillableStorageKey(s *string) *DocumentUpdateOne {
+	if s != nil {
+		duo.SetStorageKey(*s)
+	}
+	return duo
+}
+
+// SetMimeType sets the "mime_type" field.
+func (duo *DocumentUpdateOne) SetMimeType(s string) *DocumentUpdateOne {
+	duo.mutation.SetMimeType(s)
+	return duo
+}
+
+// SetNillableMimeType sets the "mime_type" field if the given value is not nil.
+func (duo *DocumentUpdateOne) SetNillableMimeType(s *string) *DocumentUpdateOne {
+	if s != nil {
+		duo.SetMimeType(*s)
+	}
+	return duo
+}
+
+// ClearMimeType clears the value of the "mime_type" field.
+func (duo *DocumentUpdateOne) ClearMimeType() *DocumentUpdateOne {
+	duo.mutation.ClearMimeType()
+	return duo
+}
+
+// SetSize sets the "size" field.
+func (duo *DocumentUpdateOne) SetSize(i int64) *DocumentUpdateOne {
+	duo.mutation.ResetSize()
+	duo.mutation.SetSize(i)
+	return duo
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (duo *DocumentUpdateOne) SetNillableSize(i *int64) *DocumentUpdateOne {
+	if i != nil {
+		duo.SetSize(*i)
+	}
+	return duo
+}
+
+// AddSize adds i to the "size" field.
+func (duo *DocumentUpdateOne) AddSize(i int64) *DocumentUpdateOne {
+	duo.mutation.AddSize(i)
+	return duo
+}
+
+// SetResourceType sets the "resource_type" field.
+func (duo *DocumentUpdateOne) SetResourceType(s string) *DocumentUpdateOne {
+	duo.mutation.SetResourceType(s)
+	return duo
+}
+
+// SetNillableResourceType sets the "resource_type" field if the given value is not nil.
+func (duo *DocumentUpdateOne) SetNillableResourceType(s *string) *DocumentUpdateOne {
+	if s != nil {
+		duo.SetResourceType(*s)
+	}
+	return duo
+}
+
+// SetTags sets the "tags" field.
+func (duo *DocumentUpdateOne) SetTags(s []string) *DocumentUpdateOne {
+	duo.mutation.SetTags(s)
+	return duo
+}
+
+// AppendTags appends s to the "tags" field.
+func (duo *DocumentUpdateOne) AppendTags(s []string) *DocumentUpdateOne {
+	duo.mutation.AppendTags(s)
+	return duo
+}
+
+// ClearTags clears the value of the "tags" field.
+func (duo *DocumentUpdateOne) ClearTags() *DocumentUpdateOne {
+	duo.mutation.ClearTags()
+	return duo
+}
+
+// SetVisibility sets the "visibility" field.
+func (duo *DocumentUpdateOne) SetVisibility(d document.Visibility) *DocumentUpdateOne {
+	duo.mutation.SetVisibility(d)
+	return duo
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (duo *DocumentUpdateOne) SetNillableVisibility(d *document.Visibility) *DocumentUpdateOne {
+	if d != nil {
+		duo.SetVisibility(*d)
+	}
+	return duo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (duo *DocumentUpdateOne) SetUpdatedAt(t time.Time) *DocumentUpdateOne {
+	duo.mutation.SetUpdatedAt(t)
+	return duo
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (duo *DocumentUpdateOne) SetOwnerID(id int) *DocumentUpdateOne {
+	duo.mutation.SetOwnerID(id)
+	return duo
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (duo *DocumentUpdateOne) SetOwner(u *User) *DocumentUpdateOne {
+	return duo.SetOwnerID(u.ID)
+}
+
+// SetFolderID sets the "folder" edge to the Folder entity by ID.
+func (duo *DocumentUpdateOne) SetFolderID(id int) *DocumentUpdateOne {
+	duo.mutation.SetFolderID(id)
+	return duo
+}
+
+// SetNillableFolderID sets the "folder" edge to the Folder entity by ID if the given value is not nil.
+func (duo *DocumentUpdateOne) SetNillableFolderID(id *int) *DocumentUpdateOne {
+	if id != nil {
+		duo = duo.SetFolderID(*id)
+	}
+	return duo
+}
+
+// SetFolder sets the "folder" edge to the Folder entity.
+func (duo *DocumentUpdateOne) SetFolder(f *Folder) *DocumentUpdateOne {
+	return duo.SetFolderID(f.ID)
+}
+
+// AddNoteIDs adds the "notes" edge to the Note entity by IDs.
+func (duo *DocumentUpdateOne) AddNoteIDs(ids ...int) *DocumentUpdateOne {
+	duo.mutation.AddNoteIDs(ids...)
+	return duo
+}
+
+// AddNotes adds the "notes" edges to the Note entity.
+func (duo *DocumentUpdateOne) AddNotes(n ...*Note) *DocumentUpdateOne {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return duo.AddNoteIDs(ids...)
+}
+
+// Mutation returns the DocumentMutation object of the builder.
+func (duo *DocumentUpdateOne) Mutation() *DocumentMutation {
+	return duo.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (duo *DocumentUpdateOne) ClearOwner() *DocumentUpdateOne {
+	duo.mutation.ClearOwner()
+	return duo
+}
+
+// ClearFolder clears the "folder" edge to the Folder entity.
+func (duo *DocumentUpdateOne) ClearFolder() *DocumentUpdateOne {
+	duo.mutation.ClearFolder()
+	return duo
+}
+
+// ClearNotes clears all "notes" edges to the Note entity.
+func (duo *DocumentUpdateOne) ClearNotes() *DocumentUpdateOne {
+	duo.mutation.ClearNotes()
+	return duo
+}
+
+// RemoveNoteIDs removes the "notes" edge to Note entities by IDs.
+func (duo *DocumentUpdateOne) RemoveNoteIDs(ids ...int) *DocumentUpdateOne {
+	duo.mutation.RemoveNoteIDs(ids...)
+	return duo
+}
+
+// RemoveNotes removes "notes" edges to Note entities.
+func (duo *DocumentUpdateOne) RemoveNotes(n ...*Note) *DocumentUpdateOne {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return duo.RemoveNoteIDs(ids...)
+}
+
+// Where appends a list predicates to the DocumentUpdate builder.
+func (duo *DocumentUpdateOne) Where(ps ...predicate.Document) *DocumentUpdateOne {
+	duo.mutation.Where(ps...)
+	return duo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (duo *DocumentUpdateOne) Select(field string, fields ...string) *DocumentUpdateOne {
+	duo.fields = append([]string{field}, fields...)
+	return duo
+}
+
+// Save executes the query and returns the updated Document entity.
+func (duo *DocumentUpdateOne) Save(ctx context.Context) (*Document, error) {
+	duo.defaults()
+	return withHooks(ctx, duo.sqlSave, duo.mutation, duo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (duo *DocumentUpdateOne) SaveX(ctx context.Context) *Document {
+	node, err := duo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (duo *DocumentUpdateOne) Exec(ctx context.Context) error {
+	_, err := duo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (duo *DocumentUpdateOne) ExecX(ctx context.Context) {
+	if err := duo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (duo *DocumentUpdateOne) defaults() {
+	if _, ok := duo.mutation.UpdatedAt(); !ok {
+		v := document.UpdateDefaultUpdatedAt()
+		duo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (duo *DocumentUpdateOne) check() error {
+	if v, ok := duo.mutation.Title(); ok {
+		if err := document.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Document.title": %w`, err)}
+		}
+	}
+	if v, ok := duo.mutation.FileName(); ok {
+		if err := document.FileNameValidator(v); err != nil {
+			return &ValidationError{Name: "file_name", err: fmt.Errorf(`ent: validator failed for field "Document.file_name": %w`, err)}
+		}
+	}
+	if v, ok := duo.mutation.StorageKey(); ok {
+		if err := document.StorageKeyValidator(v); err != nil {
+			return &ValidationError{Name: "storage_key", err: fmt.Errorf(`ent: validator failed for field "Document.storage_key": %w`, err)}
+		}
+	}
+	if v, ok := duo.mutation.Size(); ok {
+		if err := document.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "Document.size": %w`, err)}
+		}
+	}
+	if v, ok := duo.mutation.Visibility(); ok {
+		if err := document.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "Document.visibility": %w`, err)}
+		}
+	}
+	if duo.mutation.OwnerCleared() && len(duo.mutation.OwnerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Document.owner"`)
+	}
+	return nil
+}
+
+func (duo *DocumentUpdateOne) sqlSave(ctx context.Context) (_node *Document, err error) {
+	if err := duo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(document.Table, document.Columns, sqlgraph.NewFieldSpec(document.FieldID, field.TypeInt))
+	id, ok := duo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Document.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := duo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, document.FieldID)
+		for _, f := range fields {
+			if !document.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != document.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := duo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := duo.mutation.Title(); ok {
+		_spec.SetField(document.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := duo.mutation.Description(); ok {
+		_spec.SetField(document.FieldDescription, field.TypeString, value)
+	}
+	if duo.mutation.DescriptionCleared() {
+		_spec.ClearField(document.FieldDescription, field.TypeString)
+	}
+	if value, ok := duo.mutation.FileName(); ok {
+		_spec.SetField(document.FieldFileName, field.TypeString, value)
+	}
+	if value, ok := duo.mutation.StorageKey(); ok {
+		_spec.SetField(document.FieldStorageKey, field.TypeString, value)
+	}
+	if value, ok := duo.mutation.MimeType(); ok {
+		_spec.SetField(document.FieldMimeType, field.TypeString, value)
+	}
+	if duo.mutation.MimeTypeCleared() {
+		_spec.ClearField(document.FieldMimeType, field.TypeString)
+	}
+	if value, ok := duo.mutation.Size(); ok {
+		_spec.SetField(document.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := duo.mutation.AddedSize(); ok {
+		_spec.AddField(document.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := duo.mutation.ResourceType(); ok {
+		_spec.SetField(document.FieldResourceType, field.TypeString, value)
+	}
+	if value, ok := duo.mutation.Tags(); ok {
+		_spec.SetField(document.FieldTags, field.TypeJSON, value)
+	}
+	if value, ok := duo.mutation.AppendedTags(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, document.FieldTags, value)
+		})
+	}
+	if duo.mutation.TagsCleared() {
+		_spec.ClearField(document.FieldTags, field.TypeJSON)
+	}
+	if value, ok := duo.mutation.Visibility(); ok {
+		_spec.SetField(document.FieldVisibility, field.TypeEnum, value)
+	}
+	if value, ok := duo.mutation.UpdatedAt(); ok {
+		_spec.SetField(document.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if duo.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   document.OwnerTable,
+			Columns: []string{document.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   document.OwnerTable,
+			Columns: []string{document.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if duo.mutation.FolderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   document.FolderTable,
+			Columns: []string{document.FolderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(folder.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.FolderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   document.FolderTable,
+			Columns: []string{document.FolderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(folder.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if duo.mutation.NotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   document.NotesTable,
+			Columns: document.NotesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.RemovedNotesIDs(); len(nodes) > 0 && !duo.mutation.NotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   document.NotesTable,
+			Columns: document.NotesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.NotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   document.NotesTable,
+			Columns: document.NotesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Document{config: duo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, duo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{document.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	duo.mutation.done = true
+	return _node, nil
+}
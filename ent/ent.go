@@ -12,10 +12,29 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/r-scheele/zero/ent/blob"
+	"github.com/r-scheele/zero/ent/blobreference"
+	"github.com/r-scheele/zero/ent/choice"
+	"github.com/r-scheele/zero/ent/document"
+	"github.com/r-scheele/zero/ent/folder"
+	"github.com/r-scheele/zero/ent/groupinvite"
+	"github.com/r-scheele/zero/ent/groupjoinrequest"
+	"github.com/r-scheele/zero/ent/groupmembership"
 	"github.com/r-scheele/zero/ent/note"
+	"github.com/r-scheele/zero/ent/notebook"
 	"github.com/r-scheele/zero/ent/notelike"
+	"github.com/r-scheele/zero/ent/notelink"
+	"github.com/r-scheele/zero/ent/noteproposal"
 	"github.com/r-scheele/zero/ent/noterepost"
+	"github.com/r-scheele/zero/ent/noterevision"
 	"github.com/r-scheele/zero/ent/passwordtoken"
+	"github.com/r-scheele/zero/ent/question"
+	"github.com/r-scheele/zero/ent/quiz"
+	"github.com/r-scheele/zero/ent/quizattempt"
+	"github.com/r-scheele/zero/ent/resumableupload"
+	"github.com/r-scheele/zero/ent/studyevent"
+	"github.com/r-scheele/zero/ent/studygroup"
+	"github.com/r-scheele/zero/ent/tag"
 	"github.com/r-scheele/zero/ent/user"
 )
 
@@ -77,11 +96,30 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			note.Table:          note.ValidColumn,
-			notelike.Table:      notelike.ValidColumn,
-			noterepost.Table:    noterepost.ValidColumn,
-			passwordtoken.Table: passwordtoken.ValidColumn,
-			user.Table:          user.ValidColumn,
+			blob.Table:             blob.ValidColumn,
+			blobreference.Table:    blobreference.ValidColumn,
+			choice.Table:           choice.ValidColumn,
+			document.Table:         document.ValidColumn,
+			folder.Table:           folder.ValidColumn,
+			groupinvite.Table:      groupinvite.ValidColumn,
+			groupjoinrequest.Table: groupjoinrequest.ValidColumn,
+			groupmembership.Table:  groupmembership.ValidColumn,
+			note.Table:             note.ValidColumn,
+			notelike.Table:         notelike.ValidColumn,
+			notelink.Table:         notelink.ValidColumn,
+			noteproposal.Table:     noteproposal.ValidColumn,
+			noterepost.Table:       noterepost.ValidColumn,
+			noterevision.Table:     noterevision.ValidColumn,
+			notebook.Table:         notebook.ValidColumn,
+			passwordtoken.Table:    passwordtoken.ValidColumn,
+			question.Table:         question.ValidColumn,
+			quiz.Table:             quiz.ValidColumn,
+			quizattempt.Table:      quizattempt.ValidColumn,
+			resumableupload.Table:  resumableupload.ValidColumn,
+			studyevent.Table:       studyevent.ValidColumn,
+			studygroup.Table:       studygroup.ValidColumn,
+			tag.Table:              tag.ValidColumn,
+			user.Table:             user.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
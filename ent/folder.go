@@ -0,0 +1,216 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/r-scheele/zero/ent/folder"
+	"github.com/r-scheele/zero/ent/user"
+)
+
+// Folder is the model entity for the Folder schema.
+type Folder struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the FolderQuery when eager-loading is set.
+	Edges           FolderEdges `json:"edges"`
+	folder_children *int
+	user_folders    *int
+	selectValues    sql.SelectValues
+}
+
+// FolderEdges holds the relations/edges for other nodes in the graph.
+type FolderEdges struct {
+	// Owner holds the value of the owner edge.
+	Owner *User `json:"owner,omitempty"`
+	// Folders nest, with no parent at the top level
+	Parent *Folder `json:"parent,omitempty"`
+	// Children holds the value of the children edge.
+	Children []*Folder `json:"children,omitempty"`
+	// Documents holds the value of the documents edge.
+	Documents []*Document `json:"documents,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// OwnerOrErr returns the Owner value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FolderEdges) OwnerOrErr() (*User, error) {
+	if e.Owner != nil {
+		return e.Owner, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "owner"}
+}
+
+// ParentOrErr returns the Parent value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FolderEdges) ParentOrErr() (*Folder, error) {
+	if e.Parent != nil {
+		return e.Parent, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: folder.Label}
+	}
+	return nil, &NotLoadedError{edge: "parent"}
+}
+
+// ChildrenOrErr returns the Children value or an error if the edge
+// was not loaded in eager-loading.
+func (e FolderEdges) ChildrenOrErr() ([]*Folder, error) {
+	if e.loadedTypes[2] {
+		return e.Children, nil
+	}
+	return nil, &NotLoadedError{edge: "children"}
+}
+
+// DocumentsOrErr returns the Documents value or an error if the edge
+// was not loaded in eager-loading.
+func (e FolderEdges) DocumentsOrErr() ([]*Document, error) {
+	if e.loadedTypes[3] {
+		return e.Documents, nil
+	}
+	return nil, &NotLoadedError{edge: "documents"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Folder) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case folder.FieldID:
+			values[i] = new(sql.NullInt64)
+		case folder.FieldName:
+			values[i] = new(sql.NullString)
+		case folder.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case folder.ForeignKeys[0]: // folder_children
+			values[i] = new(sql.NullInt64)
+		case folder.ForeignKeys[1]: // user_folders
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Folder fields.
+func (f *Folder) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case folder.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			f.ID = int(value.Int64)
+		case folder.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				f.Name = value.String
+			}
+		case folder.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				f.CreatedAt = value.Time
+			}
+		case folder.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field folder_children", value)
+			} else if value.Valid {
+				f.folder_children = new(int)
+				*f.folder_children = int(value.Int64)
+			}
+		case folder.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_folders", value)
+			} else if value.Valid {
+				f.user_folders = new(int)
+				*f.user_folders = int(value.Int64)
+			}
+		default:
+			f.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Folder.
+// This includes values selected through modifiers, order, etc.
+func (f *Folder) Value(name string) (ent.Value, error) {
+	return f.selectValues.Get(name)
+}
+
+// QueryOwner queries the "owner" edge of the Folder entity.
+func (f *Folder) QueryOwner() *UserQuery {
+	return NewFolderClient(f.config).QueryOwner(f)
+}
+
+// QueryParent queries the "parent" edge of the Folder entity.
+func (f *Folder) QueryParent() *FolderQuery {
+	return NewFolderClient(f.config).QueryParent(f)
+}
+
+// QueryChildren queries the "children" edge of the Folder entity.
+func (f *Folder) QueryChildren() *FolderQuery {
+	return NewFolderClient(f.config).QueryChildren(f)
+}
+
+// QueryDocuments queries the "documents" edge of the Folder entity.
+func (f *Folder) QueryDocuments() *DocumentQuery {
+	return NewFolderClient(f.config).QueryDocuments(f)
+}
+
+// Update returns a builder for updating this Folder.
+// Note that you need to call Folder.Unwrap() before calling this method if this Folder
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (f *Folder) Update() *FolderUpdateOne {
+	return NewFolderClient(f.config).UpdateOne(f)
+}
+
+// Unwrap unwraps the Folder entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (f *Folder) Unwrap() *Folder {
+	_tx, ok := f.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Folder is not a transactional entity")
+	}
+	f.config.driver = _tx.drv
+	return f
+}
+
+// String implements the fmt.Stringer.
+func (f *Folder) String() string {
+	var builder strings.Builder
+	builder.WriteString("Folder(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", f.ID))
+	builder.WriteString("name=")
+	builder.WriteString(f.Name)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(f.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Folders is a parsable slice of Folder.
+type Folders []*Folder
@@ -0,0 +1,351 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/r-scheele/zero/ent/document"
+	"github.com/r-scheele/zero/ent/folder"
+	"github.com/r-scheele/zero/ent/user"
+)
+
+// FolderCreate is the builder for creating a Folder entity.
+type FolderCreate struct {
+	config
+	mutation *FolderMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (fc *FolderCreate) SetName(s string) *FolderCreate {
+	fc.mutation.SetName(s)
+	return fc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (fc *FolderCreate) SetCreatedAt(t time.Time) *FolderCreate {
+	fc.mutation.SetCreatedAt(t)
+	return fc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (fc *FolderCreate) SetNillableCreatedAt(t *time.Time) *FolderCreate {
+	if t != nil {
+		fc.SetCreatedAt(*t)
+	}
+	return fc
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (fc *FolderCreate) SetOwnerID(id int) *FolderCreate {
+	fc.mutation.SetOwnerID(id)
+	return fc
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (fc *FolderCreate) SetOwner(u *User) *FolderCreate {
+	return fc.SetOwnerID(u.ID)
+}
+
+// SetParentID sets the "parent" edge to the Folder entity by ID.
+func (fc *FolderCreate) SetParentID(id int) *FolderCreate {
+	fc.mutation.SetParentID(id)
+	return fc
+}
+
+// SetNillableParentID sets the "parent" edge to the Folder entity by ID if the given value is not nil.
+func (fc *FolderCreate) SetNillableParentID(id *int) *FolderCreate {
+	if id != nil {
+		fc = fc.SetParentID(*id)
+	}
+	return fc
+}
+
+// SetParent sets the "parent" edge to the Folder entity.
+func (fc *FolderCreate) SetParent(f *Folder) *FolderCreate {
+	return fc.SetParentID(f.ID)
+}
+
+// AddChildIDs adds the "children" edge to the Folder entity by IDs.
+func (fc *FolderCreate) AddChildIDs(ids ...int) *FolderCreate {
+	fc.mutation.AddChildIDs(ids...)
+	return fc
+}
+
+// AddChildren adds the "children" edges to the Folder entity.
+func (fc *FolderCreate) AddChildren(f ...*Folder) *FolderCreate {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return fc.AddChildIDs(ids...)
+}
+
+// AddDocumentIDs adds the "documents" edge to the Document entity by IDs.
+func (fc *FolderCreate) AddDocumentIDs(ids ...int) *FolderCreate {
+	fc.mutation.AddDocumentIDs(ids...)
+	return fc
+}
+
+// AddDocuments adds the "documents" edges to the Document entity.
+func (fc *FolderCreate) AddDocuments(d ...*Document) *FolderCreate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return fc.AddDocumentIDs(ids...)
+}
+
+// Mutation returns the FolderMutation object of the builder.
+func (fc *FolderCreate) Mutation() *FolderMutation {
+	return fc.mutation
+}
+
+// Save creates the Folder in the database.
+func (fc *FolderCreate) Save(ctx context.Context) (*Folder, error) {
+	fc.defaults()
+	return withHooks(ctx, fc.sqlSave, fc.mutation, fc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (fc *FolderCreate) SaveX(ctx context.Context) *Folder {
+	v, err := fc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (fc *FolderCreate) Exec(ctx context.Context) error {
+	_, err := fc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fc *FolderCreate) ExecX(ctx context.Context) {
+	if err := fc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (fc *FolderCreate) defaults() {
+	if _, ok := fc.mutation.CreatedAt(); !ok {
+		v := folder.DefaultCreatedAt()
+		fc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (fc *FolderCreate) check() error {
+	if _, ok := fc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Folder.name"`)}
+	}
+	if v, ok := fc.mutation.Name(); ok {
+		if err := folder.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Folder.name": %w`, err)}
+		}
+	}
+	if _, ok := fc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Folder.created_at"`)}
+	}
+	if len(fc.mutation.OwnerIDs()) == 0 {
+		return &ValidationError{Name: "owner", err: errors.New(`ent: missing required edge "Folder.owner"`)}
+	}
+	return nil
+}
+
+func (fc *FolderCreate) sqlSave(ctx context.Context) (*Folder, error) {
+	if err := fc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := fc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, fc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	fc.mutation.id = &_node.ID
+	fc.mutation.done = true
+	return _node, nil
+}
+
+func (fc *FolderCreate) createSpec() (*Folder, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Folder{config: fc.config}
+		_spec = sqlgraph.NewCreateSpec(folder.Table, sqlgraph.NewFieldSpec(folder.FieldID, field.TypeInt))
+	)
+	if value, ok := fc.mutation.Name(); ok {
+		_spec.SetField(folder.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := fc.mutation.CreatedAt(); ok {
+		_spec.SetField(folder.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := fc.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   folder.OwnerTable,
+			Columns: []string{folder.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_folders = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := fc.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   folder.ParentTable,
+			Columns: []string{folder.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(folder.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.folder_children = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := fc.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   folder.ChildrenTable,
+			Columns: []string{folder.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(folder.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := fc.mutation.DocumentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   folder.DocumentsTable,
+			Columns: []string{folder.DocumentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(document.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// FolderCreateBulk is the builder for creating many Folder entities in bulk.
+type FolderCreateBulk struct {
+	config
+	err      error
+	builders []*FolderCreate
+}
+
+// Save creates the Folder entities in the database.
+func (fcb *FolderCreateBulk) Save(ctx context.Context) ([]*Folder, error) {
+	if fcb.err != nil {
+		return nil, fcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(fcb.builders))
+	nodes := make([]*Folder, len(fcb.builders))
+	mutators := make([]Mutator, len(fcb.builders))
+	for i := range fcb.builders {
+		func(i int, root context.Context) {
+			builder := fcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*FolderMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, fcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, fcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, fcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fcb *FolderCreateBulk) SaveX(ctx context.Context) []*Folder {
+	v, err := fcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (fcb *FolderCreateBulk) Exec(ctx context.Context) error {
+	_, err := fcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fcb *FolderCreateBulk) ExecX(ctx context.Context) {
+	if err := fcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
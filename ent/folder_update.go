@@ -0,0 +1,767 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/r-scheele/zero/ent/document"
+	"github.com/r-scheele/zero/ent/folder"
+	"github.com/r-scheele/zero/ent/predicate"
+	"github.com/r-scheele/zero/ent/user"
+)
+
+// FolderUpdate is the builder for updating Folder entities.
+type FolderUpdate struct {
+	config
+	hooks    []Hook
+	mutation *FolderMutation
+}
+
+// Where appends a list predicates to the FolderUpdate builder.
+func (fu *FolderUpdate) Where(ps ...predicate.Folder) *FolderUpdate {
+	fu.mutation.Where(ps...)
+	return fu
+}
+
+// SetName sets the "name" field.
+func (fu *FolderUpdate) SetName(s string) *FolderUpdate {
+	fu.mutation.SetName(s)
+	return fu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (fu *FolderUpdate) SetNillableName(s *string) *FolderUpdate {
+	if s != nil {
+		fu.SetName(*s)
+	}
+	return fu
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (fu *FolderUpdate) SetOwnerID(id int) *FolderUpdate {
+	fu.mutation.SetOwnerID(id)
+	return fu
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (fu *FolderUpdate) SetOwner(u *User) *FolderUpdate {
+	return fu.SetOwnerID(u.ID)
+}
+
+// SetParentID sets the "parent" edge to the Folder entity by ID.
+func (fu *FolderUpdate) SetParentID(id int) *FolderUpdate {
+	fu.mutation.SetParentID(id)
+	return fu
+}
+
+// SetNillableParentID sets the "parent" edge to the Folder entity by ID if the given value is not nil.
+func (fu *FolderUpdate) SetNillableParentID(id *int) *FolderUpdate {
+	if id != nil {
+		fu = fu.SetParentID(*id)
+	}
+	return fu
+}
+
+// SetParent sets the "parent" edge to the Folder entity.
+func (fu *FolderUpdate) SetParent(f *Folder) *FolderUpdate {
+	return fu.SetParentID(f.ID)
+}
+
+// AddChildIDs adds the "children" edge to the Folder entity by IDs.
+func (fu *FolderUpdate) AddChildIDs(ids ...int) *FolderUpdate {
+	fu.mutation.AddChildIDs(ids...)
+	return fu
+}
+
+// AddChildren adds the "children" edges to the Folder entity.
+func (fu *FolderUpdate) AddChildren(f ...*Folder) *FolderUpdate {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return fu.AddChildIDs(ids...)
+}
+
+// AddDocumentIDs adds the "documents" edge to the Document entity by IDs.
+func (fu *FolderUpdate) AddDocumentIDs(ids ...int) *FolderUpdate {
+	fu.mutation.AddDocumentIDs(ids...)
+	return fu
+}
+
+// AddDocuments adds the "documents" edges to the Document entity.
+func (fu *FolderUpdate) AddDocuments(d ...*Document) *FolderUpdate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return fu.AddDocumentIDs(ids...)
+}
+
+// Mutation returns the FolderMutation object of the builder.
+func (fu *FolderUpdate) Mutation() *FolderMutation {
+	return fu.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (fu *FolderUpdate) ClearOwner() *FolderUpdate {
+	fu.mutation.ClearOwner()
+	return fu
+}
+
+// ClearParent clears the "parent" edge to the Folder entity.
+func (fu *FolderUpdate) ClearParent() *FolderUpdate {
+	fu.mutation.ClearParent()
+	return fu
+}
+
+// ClearChildren clears all "children" edges to the Folder entity.
+func (fu *FolderUpdate) ClearChildren() *FolderUpdate {
+	fu.mutation.ClearChildren()
+	return fu
+}
+
+// RemoveChildIDs removes the "children" edge to Folder entities by IDs.
+func (fu *FolderUpdate) RemoveChildIDs(ids ...int) *FolderUpdate {
+	fu.mutation.RemoveChildIDs(ids...)
+	return fu
+}
+
+// RemoveChildren removes "children" edges to Folder entities.
+func (fu *FolderUpdate) RemoveChildren(f ...*Folder) *FolderUpdate {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return fu.RemoveChildIDs(ids...)
+}
+
+// ClearDocuments clears all "documents" edges to the Document entity.
+func (fu *FolderUpdate) ClearDocuments() *FolderUpdate {
+	fu.mutation.ClearDocuments()
+	return fu
+}
+
+// RemoveDocumentIDs removes the "documents" edge to Document entities by IDs.
+func (fu *FolderUpdate) RemoveDocumentIDs(ids ...int) *FolderUpdate {
+	fu.mutation.RemoveDocumentIDs(ids...)
+	return fu
+}
+
+// RemoveDocuments removes "documents" edges to Document entities.
+func (fu *FolderUpdate) RemoveDocuments(d ...*Document) *FolderUpdate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return fu.RemoveDocumentIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (fu *FolderUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, fu.sqlSave, fu.mutation, fu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fu *FolderUpdate) SaveX(ctx context.Context) int {
+	affected, err := fu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (fu *FolderUpdate) Exec(ctx context.Context) error {
+	_, err := fu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fu *FolderUpdate) ExecX(ctx context.Context) {
+	if err := fu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (fu *FolderUpdate) check() error {
+	if v, ok := fu.mutation.Name(); ok {
+		if err := folder.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Folder.name": %w`, err)}
+		}
+	}
+	if fu.mutation.OwnerCleared() && len(fu.mutation.OwnerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Folder.owner"`)
+	}
+	return nil
+}
+
+func (fu *FolderUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := fu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(folder.Table, folder.Columns, sqlgraph.NewFieldSpec(folder.FieldID, field.TypeInt))
+	if ps := fu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := fu.mutation.Name(); ok {
+		_spec.SetField(folder.FieldName, field.TypeString, value)
+	}
+	if fu.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   folder.OwnerTable,
+			Columns: []string{folder.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fu.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   folder.OwnerTable,
+			Columns: []string{folder.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if fu.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   folder.ParentTable,
+			Columns: []string{folder.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(folder.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fu.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   folder.ParentTable,
+			Columns: []string{folder.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(folder.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if fu.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   folder.ChildrenTable,
+			Columns: []string{folder.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(folder.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fu.mutation.RemovedChildrenIDs(); len(nodes) > 0 && !fu.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   folder.ChildrenTable,
+			Columns: []string{folder.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(folder.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fu.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   folder.ChildrenTable,
+			Columns: []string{folder.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(folder.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if fu.mutation.DocumentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   folder.DocumentsTable,
+			Columns: []string{folder.DocumentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(document.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fu.mutation.RemovedDocumentsIDs(); len(nodes) > 0 && !fu.mutation.DocumentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   folder.DocumentsTable,
+			Columns: []string{folder.DocumentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(document.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fu.mutation.DocumentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   folder.DocumentsTable,
+			Columns: []string{folder.DocumentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(document.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, fu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{folder.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	fu.mutation.done = true
+	return n, nil
+}
+
+// FolderUpdateOne is the builder for updating a single Folder entity.
+type FolderUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *FolderMutation
+}
+
+// SetName sets the "name" field.
+func (fuo *FolderUpdateOne) SetName(s string) *FolderUpdateOne {
+	fuo.mutation.SetName(s)
+	return fuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (fuo *FolderUpdateOne) SetNillableName(s *string) *FolderUpdateOne {
+	if s != nil {
+		fuo.SetName(*s)
+	}
+	return fuo
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (fuo *FolderUpdateOne) SetOwnerID(id int) *FolderUpdateOne {
+	fuo.mutation.SetOwnerID(id)
+	return fuo
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (fuo *FolderUpdateOne) SetOwner(u *User) *FolderUpdateOne {
+	return fuo.SetOwnerID(u.ID)
+}
+
+// SetParentID sets the "parent" edge to the Folder entity by ID.
+func (fuo *FolderUpdateOne) SetParentID(id int) *FolderUpdateOne {
+	fuo.mutation.SetParentID(id)
+	return fuo
+}
+
+// SetNillableParentID sets the "parent" edge to the Folder entity by ID if the given value is not nil.
+func (fuo *FolderUpdateOne) SetNillableParentID(id *int) *FolderUpdateOne {
+	if id != nil {
+		fuo = fuo.SetParentID(*id)
+	}
+	return fuo
+}
+
+// SetParent sets the "parent" edge to the Folder entity.
+func (fuo *FolderUpdateOne) SetParent(f *Folder) *FolderUpdateOne {
+	return fuo.SetParentID(f.ID)
+}
+
+// AddChildIDs adds the "children" edge to the Folder entity by IDs.
+func (fuo *FolderUpdateOne) AddChildIDs(ids ...int) *FolderUpdateOne {
+	fuo.mutation.AddChildIDs(ids...)
+	return fuo
+}
+
+// AddChildren adds the "children" edges to the Folder entity.
+func (fuo *FolderUpdateOne) AddChildren(f ...*Folder) *FolderUpdateOne {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return fuo.AddChildIDs(ids...)
+}
+
+// AddDocumentIDs adds the "documents" edge to the Document entity by IDs.
+func (fuo *FolderUpdateOne) AddDocumentIDs(ids ...int) *FolderUpdateOne {
+	fuo.mutation.AddDocumentIDs(ids...)
+	return fuo
+}
+
+// AddDocuments adds the "documents" edges to the Document entity.
+func (fuo *FolderUpdateOne) AddDocuments(d ...*Document) *FolderUpdateOne {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return fuo.AddDocumentIDs(ids...)
+}
+
+// Mutation returns the FolderMutation object of the builder.
+func (fuo *FolderUpdateOne) Mutation() *FolderMutation {
+	return fuo.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (fuo *FolderUpdateOne) ClearOwner() *FolderUpdateOne {
+	fuo.mutation.ClearOwner()
+	return fuo
+}
+
+// ClearParent clears the "parent" edge to the Folder entity.
+func (fuo *FolderUpdateOne) ClearParent() *FolderUpdateOne {
+	fuo.mutation.ClearParent()
+	return fuo
+}
+
+// ClearChildren clears all "children" edges to the Folder entity.
+func (fuo *FolderUpdateOne) ClearChildren() *FolderUpdateOne {
+	fuo.mutation.ClearChildren()
+	return fuo
+}
+
+// RemoveChildIDs removes the "children" edge to Folder entities by IDs.
+func (fuo *FolderUpdateOne) RemoveChildIDs(ids ...int) *FolderUpdateOne {
+	fuo.mutation.RemoveChildIDs(ids...)
+	return fuo
+}
+
+// RemoveChildren removes "children" edges to Folder entities.
+func (fuo *FolderUpdateOne) RemoveChildren(f ...*Folder) *FolderUpdateOne {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return fuo.RemoveChildIDs(ids...)
+}
+
+// ClearDocuments clears all "documents" edges to the Document entity.
+func (fuo *FolderUpdateOne) ClearDocuments() *FolderUpdateOne {
+	fuo.mutation.ClearDocuments()
+	return fuo
+}
+
+// RemoveDocumentIDs removes the "documents" edge to Document entities by IDs.
+func (fuo *FolderUpdateOne) RemoveDocumentIDs(ids ...int) *FolderUpdateOne {
+	fuo.mutation.RemoveDocumentIDs(ids...)
+	return fuo
+}
+
+// RemoveDocuments removes "documents" edges to Document entities.
+func (fuo *FolderUpdateOne) RemoveDocuments(d ...*Document) *FolderUpdateOne {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return fuo.RemoveDocumentIDs(ids...)
+}
+
+// Where appends a list predicates to the FolderUpdate builder.
+func (fuo *FolderUpdateOne) Where(ps ...predicate.Folder) *FolderUpdateOne {
+	fuo.mutation.Where(ps...)
+	return fuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (fuo *FolderUpdateOne) Select(field string, fields ...string) *FolderUpdateOne {
+	fuo.fields = append([]string{field}, fields...)
+	return fuo
+}
+
+// Save executes the query and returns the updated Folder entity.
+func (fuo *FolderUpdateOne) Save(ctx context.Context) (*Folder, error) {
+	return withHooks(ctx, fuo.sqlSave, fuo.mutation, fuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fuo *FolderUpdateOne) SaveX(ctx context.Context) *Folder {
+	node, err := fuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (fuo *FolderUpdateOne) Exec(ctx context.Context) error {
+	_, err := fuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fuo *FolderUpdateOne) ExecX(ctx context.Context) {
+	if err := fuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (fuo *FolderUpdateOne) check() error {
+	if v, ok := fuo.mutation.Name(); ok {
+		if err := folder.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Folder.name": %w`, err)}
+		}
+	}
+	if fuo.mutation.OwnerCleared() && len(fuo.mutation.OwnerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Folder.owner"`)
+	}
+	return nil
+}
+
+func (fuo *FolderUpdateOne) sqlSave(ctx context.Context) (_node *Folder, err error) {
+	if err := fuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(folder.Table, folder.Columns, sqlgraph.NewFieldSpec(folder.FieldID, field.TypeInt))
+	id, ok := fuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Folder.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := fuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, folder.FieldID)
+		for _, f := range fields {
+			if !folder.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != folder.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := fuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := fuo.mutation.Name(); ok {
+		_spec.SetField(folder.FieldName, field.TypeString, value)
+	}
+	if fuo.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   folder.OwnerTable,
+			Columns: []string{folder.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fuo.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   folder.OwnerTable,
+			Columns: []string{folder.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if fuo.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   folder.ParentTable,
+			Columns: []string{folder.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(folder.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fuo.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   folder.ParentTable,
+			Columns: []string{folder.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(folder.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if fuo.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   folder.ChildrenTable,
+			Columns: []string{folder.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(folder.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fuo.mutation.RemovedChildrenIDs(); len(nodes) > 0 && !fuo.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   folder.ChildrenTable,
+			Columns: []string{folder.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(folder.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fuo.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   folder.ChildrenTable,
+			Columns: []string{folder.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(folder.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if fuo.mutation.DocumentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   folder.DocumentsTable,
+			Columns: []string{folder.DocumentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(document.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fuo.mutation.RemovedDocumentsIDs(); len(nodes) > 0 && !fuo.mutation.DocumentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   folder.DocumentsTable,
+			Columns: []string{folder.DocumentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(document.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fuo.mutation.DocumentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   folder.DocumentsTable,
+			Columns: []string{folder.DocumentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(document.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Folder{config: fuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, fuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{folder.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	fuo.mutation.done = true
+	return _node, nil
+}
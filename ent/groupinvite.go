@@ -0,0 +1,242 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/r-scheele/zero/ent/groupinvite"
+	"github.com/r-scheele/zero/ent/studygroup"
+	"github.com/r-scheele/zero/ent/user"
+)
+
+// GroupInvite is the model entity for the GroupInvite schema.
+type GroupInvite struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Unique token used in the invite link and WhatsApp reply buttons
+	Token string `json:"-"`
+	// If set, only the user with this phone number can accept the invite
+	PhoneNumber string `json:"phone_number,omitempty"`
+	// Maximum number of times the invite can be accepted, 0 for unlimited
+	MaxUses int `json:"max_uses,omitempty"`
+	// Uses holds the value of the "uses" field.
+	Uses int `json:"uses,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// RevokedAt holds the value of the "revoked_at" field.
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the GroupInviteQuery when eager-loading is set.
+	Edges               GroupInviteEdges `json:"edges"`
+	study_group_invites *int
+	user_group_invites  *int
+	selectValues        sql.SelectValues
+}
+
+// GroupInviteEdges holds the relations/edges for other nodes in the graph.
+type GroupInviteEdges struct {
+	// Group holds the value of the group edge.
+	Group *StudyGroup `json:"group,omitempty"`
+	// Creator holds the value of the creator edge.
+	Creator *User `json:"creator,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// GroupOrErr returns the Group value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e GroupInviteEdges) GroupOrErr() (*StudyGroup, error) {
+	if e.Group != nil {
+		return e.Group, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: studygroup.Label}
+	}
+	return nil, &NotLoadedError{edge: "group"}
+}
+
+// CreatorOrErr returns the Creator value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e GroupInviteEdges) CreatorOrErr() (*User, error) {
+	if e.Creator != nil {
+		return e.Creator, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "creator"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*GroupInvite) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case groupinvite.FieldID, groupinvite.FieldMaxUses, groupinvite.FieldUses:
+			values[i] = new(sql.NullInt64)
+		case groupinvite.FieldToken, groupinvite.FieldPhoneNumber:
+			values[i] = new(sql.NullString)
+		case groupinvite.FieldExpiresAt, groupinvite.FieldRevokedAt, groupinvite.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case groupinvite.ForeignKeys[0]: // study_group_invites
+			values[i] = new(sql.NullInt64)
+		case groupinvite.ForeignKeys[1]: // user_group_invites
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the GroupInvite fields.
+func (gi *GroupInvite) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case groupinvite.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			gi.ID = int(value.Int64)
+		case groupinvite.FieldToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token", values[i])
+			} else if value.Valid {
+				gi.Token = value.String
+			}
+		case groupinvite.FieldPhoneNumber:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field phone_number", values[i])
+			} else if value.Valid {
+				gi.PhoneNumber = value.String
+			}
+		case groupinvite.FieldMaxUses:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_uses", values[i])
+			} else if value.Valid {
+				gi.MaxUses = int(value.Int64)
+			}
+		case groupinvite.FieldUses:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field uses", values[i])
+			} else if value.Valid {
+				gi.Uses = int(value.Int64)
+			}
+		case groupinvite.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				gi.ExpiresAt = value.Time
+			}
+		case groupinvite.FieldRevokedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field revoked_at", values[i])
+			} else if value.Valid {
+				gi.RevokedAt = new(time.Time)
+				*gi.RevokedAt = value.Time
+			}
+		case groupinvite.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				gi.CreatedAt = value.Time
+			}
+		case groupinvite.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field study_group_invites", value)
+			} else if value.Valid {
+				gi.study_group_invites = new(int)
+				*gi.study_group_invites = int(value.Int64)
+			}
+		case groupinvite.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_group_invites", value)
+			} else if value.Valid {
+				gi.user_group_invites = new(int)
+				*gi.user_group_invites = int(value.Int64)
+			}
+		default:
+			gi.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the GroupInvite.
+// This includes values selected through modifiers, order, etc.
+func (gi *GroupInvite) Value(name string) (ent.Value, error) {
+	return gi.selectValues.Get(name)
+}
+
+// QueryGroup queries the "group" edge of the GroupInvite entity.
+func (gi *GroupInvite) QueryGroup() *StudyGroupQuery {
+	return NewGroupInviteClient(gi.config).QueryGroup(gi)
+}
+
+// QueryCreator queries the "creator" edge of the GroupInvite entity.
+func (gi *GroupInvite) QueryCreator() *UserQuery {
+	return NewGroupInviteClient(gi.config).QueryCreator(gi)
+}
+
+// Update returns a builder for updating this GroupInvite.
+// Note that you need to call GroupInvite.Unwrap() before calling this method if this GroupInvite
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (gi *GroupInvite) Update() *GroupInviteUpdateOne {
+	return NewGroupInviteClient(gi.config).UpdateOne(gi)
+}
+
+// Unwrap unwraps the GroupInvite entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (gi *GroupInvite) Unwrap() *GroupInvite {
+	_tx, ok := gi.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: GroupInvite is not a transactional entity")
+	}
+	gi.config.driver = _tx.drv
+	return gi
+}
+
+// String implements the fmt.Stringer.
+func (gi *GroupInvite) String() string {
+	var builder strings.Builder
+	builder.WriteString("GroupInvite(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", gi.ID))
+	builder.WriteString("token=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("phone_number=")
+	builder.WriteString(gi.PhoneNumber)
+	builder.WriteString(", ")
+	builder.WriteString("max_uses=")
+	builder.WriteString(fmt.Sprintf("%v", gi.MaxUses))
+	builder.WriteString(", ")
+	builder.WriteString("uses=")
+	builder.WriteString(fmt.Sprintf("%v", gi.Uses))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(gi.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := gi.RevokedAt; v != nil {
+		builder.WriteString("revoked_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(gi.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// GroupInvites is a parsable slice of GroupInvite.
+type GroupInvites []*GroupInvite
@@ -0,0 +1,393 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/r-scheele/zero/ent/groupinvite"
+	"github.com/r-scheele/zero/ent/studygroup"
+	"github.com/r-scheele/zero/ent/user"
+)
+
+// GroupInviteCreate is the builder for creating a GroupInvite entity.
+type GroupInviteCreate struct {
+	config
+	mutation *GroupInviteMutation
+	hooks    []Hook
+}
+
+// SetToken sets the "token" field.
+func (gic *GroupInviteCreate) SetToken(s string) *GroupInviteCreate {
+	gic.mutation.SetToken(s)
+	return gic
+}
+
+// SetPhoneNumber sets the "phone_number" field.
+func (gic *GroupInviteCreate) SetPhoneNumber(s string) *GroupInviteCreate {
+	gic.mutation.SetPhoneNumber(s)
+	return gic
+}
+
+// SetNillablePhoneNumber sets the "phone_number" field if the given value is not nil.
+func (gic *GroupInviteCreate) SetNillablePhoneNumber(s *string) *GroupInviteCreate {
+	if s != nil {
+		gic.SetPhoneNumber(*s)
+	}
+	return gic
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (gic *GroupInviteCreate) SetMaxUses(i int) *GroupInviteCreate {
+	gic.mutation.SetMaxUses(i)
+	return gic
+}
+
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (gic *GroupInviteCreate) SetNillableMaxUses(i *int) *GroupInviteCreate {
+	if i != nil {
+		gic.SetMaxUses(*i)
+	}
+	return gic
+}
+
+// SetUses sets the "uses" field.
+func (gic *GroupInviteCreate) SetUses(i int) *GroupInviteCreate {
+	gic.mutation.SetUses(i)
+	return gic
+}
+
+// SetNillableUses sets the "uses" field if the given value is not nil.
+func (gic *GroupInviteCreate) SetNillableUses(i *int) *GroupInviteCreate {
+	if i != nil {
+		gic.SetUses(*i)
+	}
+	return gic
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (gic *GroupInviteCreate) SetExpiresAt(t time.Time) *GroupInviteCreate {
+	gic.mutation.SetExpiresAt(t)
+	return gic
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (gic *GroupInviteCreate) SetRevokedAt(t time.Time) *GroupInviteCreate {
+	gic.mutation.SetRevokedAt(t)
+	return gic
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (gic *GroupInviteCreate) SetNillableRevokedAt(t *time.Time) *GroupInviteCreate {
+	if t != nil {
+		gic.SetRevokedAt(*t)
+	}
+	return gic
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (gic *GroupInviteCreate) SetCreatedAt(t time.Time) *GroupInviteCreate {
+	gic.mutation.SetCreatedAt(t)
+	return gic
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (gic *GroupInviteCreate) SetNillableCreatedAt(t *time.Time) *GroupInviteCreate {
+	if t != nil {
+		gic.SetCreatedAt(*t)
+	}
+	return gic
+}
+
+// SetGroupID sets the "group" edge to the StudyGroup entity by ID.
+func (gic *GroupInviteCreate) SetGroupID(id int) *GroupInviteCreate {
+	gic.mutation.SetGroupID(id)
+	return gic
+}
+
+// SetGroup sets the "group" edge to the StudyGroup entity.
+func (gic *GroupInviteCreate) SetGroup(s *StudyGroup) *GroupInviteCreate {
+	return gic.SetGroupID(s.ID)
+}
+
+// SetCreatorID sets the "creator" edge to the User entity by ID.
+func (gic *GroupInviteCreate) SetCreatorID(id int) *GroupInviteCreate {
+	gic.mutation.SetCreatorID(id)
+	return gic
+}
+
+// SetCreator sets the "creator" edge to the User entity.
+func (gic *GroupInviteCreate) SetCreator(u *User) *GroupInviteCreate {
+	return gic.SetCreatorID(u.ID)
+}
+
+// Mutation returns the GroupInviteMutation object of the builder.
+func (gic *GroupInviteCreate) Mutation() *GroupInviteMutation {
+	return gic.mutation
+}
+
+// Save creates the GroupInvite in the database.
+func (gic *GroupInviteCreate) Save(ctx context.Context) (*GroupInvite, error) {
+	gic.defaults()
+	return withHooks(ctx, gic.sqlSave, gic.mutation, gic.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (gic *GroupInviteCreate) SaveX(ctx context.Context) *GroupInvite {
+	v, err := gic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (gic *GroupInviteCreate) Exec(ctx context.Context) error {
+	_, err := gic.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gic *GroupInviteCreate) ExecX(ctx context.Context) {
+	if err := gic.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (gic *GroupInviteCreate) defaults() {
+	if _, ok := gic.mutation.MaxUses(); !ok {
+		v := groupinvite.DefaultMaxUses
+		gic.mutation.SetMaxUses(v)
+	}
+	if _, ok := gic.mutation.Uses(); !ok {
+		v := groupinvite.DefaultUses
+		gic.mutation.SetUses(v)
+	}
+	if _, ok := gic.mutation.CreatedAt(); !ok {
+		v := groupinvite.DefaultCreatedAt()
+		gic.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gic *GroupInviteCreate) check() error {
+	if _, ok := gic.mutation.Token(); !ok {
+		return &ValidationError{Name: "token", err: errors.New(`ent: missing required field "GroupInvite.token"`)}
+	}
+	if v, ok := gic.mutation.Token(); ok {
+		if err := groupinvite.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "GroupInvite.token": %w`, err)}
+		}
+	}
+	if _, ok := gic.mutation.MaxUses(); !ok {
+		return &ValidationError{Name: "max_uses", err: errors.New(`ent: missing required field "GroupInvite.max_uses"`)}
+	}
+	if v, ok := gic.mutation.MaxUses(); ok {
+		if err := groupinvite.MaxUsesValidator(v); err != nil {
+			return &ValidationError{Name: "max_uses", err: fmt.Errorf(`ent: validator failed for field "GroupInvite.max_uses": %w`, err)}
+		}
+	}
+	if _, ok := gic.mutation.Uses(); !ok {
+		return &ValidationError{Name: "uses", err: errors.New(`ent: missing required field "GroupInvite.uses"`)}
+	}
+	if v, ok := gic.mutation.Uses(); ok {
+		if err := groupinvite.UsesValidator(v); err != nil {
+			return &ValidationError{Name: "uses", err: fmt.Errorf(`ent: validator failed for field "GroupInvite.uses": %w`, err)}
+		}
+	}
+	if _, ok := gic.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "GroupInvite.expires_at"`)}
+	}
+	if _, ok := gic.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "GroupInvite.created_at"`)}
+	}
+	if len(gic.mutation.GroupIDs()) == 0 {
+		return &ValidationError{Name: "group", err: errors.New(`ent: missing required edge "GroupInvite.group"`)}
+	}
+	if len(gic.mutation.CreatorIDs()) == 0 {
+		return &ValidationError{Name: "creator", err: errors.New(`ent: missing required edge "GroupInvite.creator"`)}
+	}
+	return nil
+}
+
+func (gic *GroupInviteCreate) sqlSave(ctx context.Context) (*GroupInvite, error) {
+	if err := gic.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := gic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, gic.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	gic.mutation.id = &_node.ID
+	gic.mutation.done = true
+	return _node, nil
+}
+
+func (gic *GroupInviteCreate) createSpec() (*GroupInvite, *sqlgraph.CreateSpec) {
+	var (
+		_node = &GroupInvite{config: gic.config}
+		_spec = sqlgraph.NewCreateSpec(groupinvite.Table, sqlgraph.NewFieldSpec(groupinvite.FieldID, field.TypeInt))
+	)
+	if value, ok := gic.mutation.Token(); ok {
+		_spec.SetField(groupinvite.FieldToken, field.TypeString, value)
+		_node.Token = value
+	}
+	if value, ok := gic.mutation.PhoneNumber(); ok {
+		_spec.SetField(groupinvite.FieldPhoneNumber, field.TypeString, value)
+		_node.PhoneNumber = value
+	}
+	if value, ok := gic.mutation.MaxUses(); ok {
+		_spec.SetField(groupinvite.FieldMaxUses, field.TypeInt, value)
+		_node.MaxUses = value
+	}
+	if value, ok := gic.mutation.Uses(); ok {
+		_spec.SetField(groupinvite.FieldUses, field.TypeInt, value)
+		_node.Uses = value
+	}
+	if value, ok := gic.mutation.ExpiresAt(); ok {
+		_spec.SetField(groupinvite.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := gic.mutation.RevokedAt(); ok {
+		_spec.SetField(groupinvite.FieldRevokedAt, field.TypeTime, value)
+		_node.RevokedAt = &value
+	}
+	if value, ok := gic.mutation.CreatedAt(); ok {
+		_spec.SetField(groupinvite.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := gic.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   groupinvite.GroupTable,
+			Columns: []string{groupinvite.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(studygroup.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.study_group_invites = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := gic.mutation.CreatorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   groupinvite.CreatorTable,
+			Columns: []string{groupinvite.CreatorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_group_invites = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// GroupInviteCreateBulk is the builder for creating many GroupInvite entities in bulk.
+type GroupInviteCreateBulk struct {
+	config
+	err      error
+	builders []*GroupInviteCreate
+}
+
+// Save creates the GroupInvite entities in the database.
+func (gicb *GroupInviteCreateBulk) Save(ctx context.Context) ([]*GroupInvite, error) {
+	if gicb.err != nil {
+		return nil, gicb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(gicb.builders))
+	nodes := make([]*GroupInvite, len(gicb.builders))
+	mutators := make([]Mutator, len(gicb.builders))
+	for i := range gicb.builders {
+		func(i int, root context.Context) {
+			builder := gicb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GroupInviteMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, gicb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, gicb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, gicb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gicb *GroupInviteCreateBulk) SaveX(ctx context.Context) []*GroupInvite {
+	v, err := gicb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (gicb *GroupInviteCreateBulk) Exec(ctx context.Context) error {
+	_, err := gicb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gicb *GroupInviteCreateBulk) ExecX(ctx context.Context) {
+	if err := gicb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
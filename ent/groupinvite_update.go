@@ -0,0 +1,656 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/r-scheele/zero/ent/groupinvite"
+	"github.com/r-scheele/zero/ent/predicate"
+	"github.com/r-scheele/zero/ent/studygroup"
+	"github.com/r-scheele/zero/ent/user"
+)
+
+// GroupInviteUpdate is the builder for updating GroupInvite entities.
+type GroupInviteUpdate struct {
+	config
+	hooks    []Hook
+	mutation *GroupInviteMutation
+}
+
+// Where appends a list predicates to the GroupInviteUpdate builder.
+func (giu *GroupInviteUpdate) Where(ps ...predicate.GroupInvite) *GroupInviteUpdate {
+	giu.mutation.Where(ps...)
+	return giu
+}
+
+// SetPhoneNumber sets the "phone_number" field.
+func (giu *GroupInviteUpdate) SetPhoneNumber(s string) *GroupInviteUpdate {
+	giu.mutation.SetPhoneNumber(s)
+	return giu
+}
+
+// SetNillablePhoneNumber sets the "phone_number" field if the given value is not nil.
+func (giu *GroupInviteUpdate) SetNillablePhoneNumber(s *string) *GroupInviteUpdate {
+	if s != nil {
+		giu.SetPhoneNumber(*s)
+	}
+	return giu
+}
+
+// ClearPhoneNumber clears the value of the "phone_number" field.
+func (giu *GroupInviteUpdate) ClearPhoneNumber() *GroupInviteUpdate {
+	giu.mutation.ClearPhoneNumber()
+	return giu
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (giu *GroupInviteUpdate) SetMaxUses(i int) *GroupInviteUpdate {
+	giu.mutation.ResetMaxUses()
+	giu.mutation.SetMaxUses(i)
+	return giu
+}
+
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (giu *GroupInviteUpdate) SetNillableMaxUses(i *int) *GroupInviteUpdate {
+	if i != nil {
+		giu.SetMaxUses(*i)
+	}
+	return giu
+}
+
+// AddMaxUses adds i to the "max_uses" field.
+func (giu *GroupInviteUpdate) AddMaxUses(i int) *GroupInviteUpdate {
+	giu.mutation.AddMaxUses(i)
+	return giu
+}
+
+// SetUses sets the "uses" field.
+func (giu *GroupInviteUpdate) SetUses(i int) *GroupInviteUpdate {
+	giu.mutation.ResetUses()
+	giu.mutation.SetUses(i)
+	return giu
+}
+
+// SetNillableUses sets the "uses" field if the given value is not nil.
+func (giu *GroupInviteUpdate) SetNillableUses(i *int) *GroupInviteUpdate {
+	if i != nil {
+		giu.SetUses(*i)
+	}
+	return giu
+}
+
+// AddUses adds i to the "uses" field.
+func (giu *GroupInviteUpdate) AddUses(i int) *GroupInviteUpdate {
+	giu.mutation.AddUses(i)
+	return giu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (giu *GroupInviteUpdate) SetExpiresAt(t time.Time) *GroupInviteUpdate {
+	giu.mutation.SetExpiresAt(t)
+	return giu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (giu *GroupInviteUpdate) SetNillableExpiresAt(t *time.Time) *GroupInviteUpdate {
+	if t != nil {
+		giu.SetExpiresAt(*t)
+	}
+	return giu
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (giu *GroupInviteUpdate) SetRevokedAt(t time.Time) *GroupInviteUpdate {
+	giu.mutation.SetRevokedAt(t)
+	return giu
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (giu *GroupInviteUpdate) SetNillableRevokedAt(t *time.Time) *GroupInviteUpdate {
+	if t != nil {
+		giu.SetRevokedAt(*t)
+	}
+	return giu
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (giu *GroupInviteUpdate) ClearRevokedAt() *GroupInviteUpdate {
+	giu.mutation.ClearRevokedAt()
+	return giu
+}
+
+// SetGroupID sets the "group" edge to the StudyGroup entity by ID.
+func (giu *GroupInviteUpdate) SetGroupID(id int) *GroupInviteUpdate {
+	giu.mutation.SetGroupID(id)
+	return giu
+}
+
+// SetGroup sets the "group" edge to the StudyGroup entity.
+func (giu *GroupInviteUpdate) SetGroup(s *StudyGroup) *GroupInviteUpdate {
+	return giu.SetGroupID(s.ID)
+}
+
+// SetCreatorID sets the "creator" edge to the User entity by ID.
+func (giu *GroupInviteUpdate) SetCreatorID(id int) *GroupInviteUpdate {
+	giu.mutation.SetCreatorID(id)
+	return giu
+}
+
+// SetCreator sets the "creator" edge to the User entity.
+func (giu *GroupInviteUpdate) SetCreator(u *User) *GroupInviteUpdate {
+	return giu.SetCreatorID(u.ID)
+}
+
+// Mutation returns the GroupInviteMutation object of the builder.
+func (giu *GroupInviteUpdate) Mutation() *GroupInviteMutation {
+	return giu.mutation
+}
+
+// ClearGroup clears the "group" edge to the StudyGroup entity.
+func (giu *GroupInviteUpdate) ClearGroup() *GroupInviteUpdate {
+	giu.mutation.ClearGroup()
+	return giu
+}
+
+// ClearCreator clears the "creator" edge to the User entity.
+func (giu *GroupInviteUpdate) ClearCreator() *GroupInviteUpdate {
+	giu.mutation.ClearCreator()
+	return giu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (giu *GroupInviteUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, giu.sqlSave, giu.mutation, giu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (giu *GroupInviteUpdate) SaveX(ctx context.Context) int {
+	affected, err := giu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (giu *GroupInviteUpdate) Exec(ctx context.Context) error {
+	_, err := giu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (giu *GroupInviteUpdate) ExecX(ctx context.Context) {
+	if err := giu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (giu *GroupInviteUpdate) check() error {
+	if v, ok := giu.mutation.MaxUses(); ok {
+		if err := groupinvite.MaxUsesValidator(v); err != nil {
+			return &ValidationError{Name: "max_uses", err: fmt.Errorf(`ent: validator failed for field "GroupInvite.max_uses": %w`, err)}
+		}
+	}
+	if v, ok := giu.mutation.Uses(); ok {
+		if err := groupinvite.UsesValidator(v); err != nil {
+			return &ValidationError{Name: "uses", err: fmt.Errorf(`ent: validator failed for field "GroupInvite.uses": %w`, err)}
+		}
+	}
+	if giu.mutation.GroupCleared() && len(giu.mutation.GroupIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "GroupInvite.group"`)
+	}
+	if giu.mutation.CreatorCleared() && len(giu.mutation.CreatorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "GroupInvite.creator"`)
+	}
+	return nil
+}
+
+func (giu *GroupInviteUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := giu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(groupinvite.Table, groupinvite.Columns, sqlgraph.NewFieldSpec(groupinvite.FieldID, field.TypeInt))
+	if ps := giu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := giu.mutation.PhoneNumber(); ok {
+		_spec.SetField(groupinvite.FieldPhoneNumber, field.TypeString, value)
+	}
+	if giu.mutation.PhoneNumberCleared() {
+		_spec.ClearField(groupinvite.FieldPhoneNumber, field.TypeString)
+	}
+	if value, ok := giu.mutation.MaxUses(); ok {
+		_spec.SetField(groupinvite.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := giu.mutation.AddedMaxUses(); ok {
+		_spec.AddField(groupinvite.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := giu.mutation.Uses(); ok {
+		_spec.SetField(groupinvite.FieldUses, field.TypeInt, value)
+	}
+	if value, ok := giu.mutation.AddedUses(); ok {
+		_spec.AddField(groupinvite.FieldUses, field.TypeInt, value)
+	}
+	if value, ok := giu.mutation.ExpiresAt(); ok {
+		_spec.SetField(groupinvite.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := giu.mutation.RevokedAt(); ok {
+		_spec.SetField(groupinvite.FieldRevokedAt, field.TypeTime, value)
+	}
+	if giu.mutation.RevokedAtCleared() {
+		_spec.ClearField(groupinvite.FieldRevokedAt, field.TypeTime)
+	}
+	if giu.mutation.GroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   groupinvite.GroupTable,
+			Columns: []string{groupinvite.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(studygroup.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := giu.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   groupinvite.GroupTable,
+			Columns: []string{groupinvite.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(studygroup.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if giu.mutation.CreatorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   groupinvite.CreatorTable,
+			Columns: []string{groupinvite.CreatorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := giu.mutation.CreatorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   groupinvite.CreatorTable,
+			Columns: []string{groupinvite.CreatorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, giu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{groupinvite.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	giu.mutation.done = true
+	return n, nil
+}
+
+// GroupInviteUpdateOne is the builder for updating a single GroupInvite entity.
+type GroupInviteUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *GroupInviteMutation
+}
+
+// SetPhoneNumber sets the "phone_number" field.
+func (giuo *GroupInviteUpdateOne) SetPhoneNumber(s string) *GroupInviteUpdateOne {
+	giuo.mutation.SetPhoneNumber(s)
+	return giuo
+}
+
+// SetNillablePhoneNumber sets the "phone_number" field if the given value is not nil.
+func (giuo *GroupInviteUpdateOne) SetNillablePhoneNumber(s *string) *GroupInviteUpdateOne {
+	if s != nil {
+		giuo.SetPhoneNumber(*s)
+	}
+	return giuo
+}
+
+// ClearPhoneNumber clears the value of the "phone_number" field.
+func (giuo *GroupInviteUpdateOne) ClearPhoneNumber() *GroupInviteUpdateOne {
+	giuo.mutation.ClearPhoneNumber()
+	return giuo
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (giuo *GroupInviteUpdateOne) SetMaxUses(i int) *GroupInviteUpdateOne {
+	giuo.mutation.ResetMaxUses()
+	giuo.mutation.SetMaxUses(i)
+	return giuo
+}
+
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (giuo *GroupInviteUpdateOne) SetNillableMaxUses(i *int) *GroupInviteUpdateOne {
+	if i != nil {
+		giuo.SetMaxUses(*i)
+	}
+	return giuo
+}
+
+// AddMaxUses adds i to the "max_uses" field.
+func (giuo *GroupInviteUpdateOne) AddMaxUses(i int) *GroupInviteUpdateOne {
+	giuo.mutation.AddMaxUses(i)
+	return giuo
+}
+
+// SetUses sets the "uses" field.
+func (giuo *GroupInviteUpdateOne) SetUses(i int) *GroupInviteUpdateOne {
+	giuo.mutation.ResetUses()
+	giuo.mutation.SetUses(i)
+	return giuo
+}
+
+// SetNillableUses sets the "uses" field if the given value is not nil.
+func (giuo *GroupInviteUpdateOne) SetNillableUses(i *int) *GroupInviteUpdateOne {
+	if i != nil {
+		giuo.SetUses(*i)
+	}
+	return giuo
+}
+
+// AddUses adds i to the "uses" field.
+func (giuo *GroupInviteUpdateOne) AddUses(i int) *GroupInviteUpdateOne {
+	giuo.mutation.AddUses(i)
+	return giuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (giuo *GroupInviteUpdateOne) SetExpiresAt(t time.Time) *GroupInviteUpdateOne {
+	giuo.mutation.SetExpiresAt(t)
+	return giuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (giuo *GroupInviteUpdateOne) SetNillableExpiresAt(t *time.Time) *GroupInviteUpdateOne {
+	if t != nil {
+		giuo.SetExpiresAt(*t)
+	}
+	return giuo
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (giuo *GroupInviteUpdateOne) SetRevokedAt(t time.Time) *GroupInviteUpdateOne {
+	giuo.mutation.SetRevokedAt(t)
+	return giuo
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (giuo *GroupInviteUpdateOne) SetNillableRevokedAt(t *time.Time) *GroupInviteUpdateOne {
+	if t != nil {
+		giuo.SetRevokedAt(*t)
+	}
+	return giuo
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (giuo *GroupInviteUpdateOne) ClearRevokedAt() *GroupInviteUpdateOne {
+	giuo.mutation.ClearRevokedAt()
+	return giuo
+}
+
+// SetGroupID sets the "group" edge to the StudyGroup entity by ID.
+func (giuo *GroupInviteUpdateOne) SetGroupID(id int) *GroupInviteUpdateOne {
+	giuo.mutation.SetGroupID(id)
+	return giuo
+}
+
+// SetGroup sets the "group" edge to the StudyGroup entity.
+func (giuo *GroupInviteUpdateOne) SetGroup(s *StudyGroup) *GroupInviteUpdateOne {
+	return giuo.SetGroupID(s.ID)
+}
+
+// SetCreatorID sets the "creator" edge to the User entity by ID.
+func (giuo *GroupInviteUpdateOne) SetCreatorID(id int) *GroupInviteUpdateOne {
+	giuo.mutation.SetCreatorID(id)
+	return giuo
+}
+
+// SetCreator sets the "creator" edge to the User entity.
+func (giuo *GroupInviteUpdateOne) SetCreator(u *User) *GroupInviteUpdateOne {
+	return giuo.SetCreatorID(u.ID)
+}
+
+// Mutation returns the GroupInviteMutation object of the builder.
+func (giuo *GroupInviteUpdateOne) Mutation() *GroupInviteMutation {
+	return giuo.mutation
+}
+
+// ClearGroup clears the "group" edge to the StudyGroup entity.
+func (giuo *GroupInviteUpdateOne) ClearGroup() *GroupInviteUpdateOne {
+	giuo.mutation.ClearGroup()
+	return giuo
+}
+
+// ClearCreator clears the "creator" edge to the User entity.
+func (giuo *GroupInviteUpdateOne) ClearCreator() *GroupInviteUpdateOne {
+	giuo.mutation.ClearCreator()
+	return giuo
+}
+
+// Where appends a list predicates to the GroupInviteUpdate builder.
+func (giuo *GroupInviteUpdateOne) Where(ps ...predicate.GroupInvite) *GroupInviteUpdateOne {
+	giuo.mutation.Where(ps...)
+	return giuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (giuo *GroupInviteUpdateOne) Select(field string, fields ...string) *GroupInviteUpdateOne {
+	giuo.fields = append([]string{field}, fields...)
+	return giuo
+}
+
+// Save executes the query and returns the updated GroupInvite entity.
+func (giuo *GroupInviteUpdateOne) Save(ctx context.Context) (*GroupInvite, error) {
+	return withHooks(ctx, giuo.sqlSave, giuo.mutation, giuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (giuo *GroupInviteUpdateOne) SaveX(ctx context.Context) *GroupInvite {
+	node, err := giuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (giuo *GroupInviteUpdateOne) Exec(ctx context.Context) error {
+	_, err := giuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (giuo *GroupInviteUpdateOne) ExecX(ctx context.Context) {
+	if err := giuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (giuo *GroupInviteUpdateOne) check() error {
+	if v, ok := giuo.mutation.MaxUses(); ok {
+		if err := groupinvite.MaxUsesValidator(v); err != nil {
+			return &ValidationError{Name: "max_uses", err: fmt.Errorf(`ent: validator failed for field "GroupInvite.max_uses": %w`, err)}
+		}
+	}
+	if v, ok := giuo.mutation.Uses(); ok {
+		if err := groupinvite.UsesValidator(v); err != nil {
+			return &ValidationError{Name: "uses", err: fmt.Errorf(`ent: validator failed for field "GroupInvite.uses": %w`, err)}
+		}
+	}
+	if giuo.mutation.GroupCleared() && len(giuo.mutation.GroupIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "GroupInvite.group"`)
+	}
+	if giuo.mutation.CreatorCleared() && len(giuo.mutation.CreatorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "GroupInvite.creator"`)
+	}
+	return nil
+}
+
+func (giuo *GroupInviteUpdateOne) sqlSave(ctx context.Context) (_node *GroupInvite, err error) {
+	if err := giuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(groupinvite.Table, groupinvite.Columns, sqlgraph.NewFieldSpec(groupinvite.FieldID, field.TypeInt))
+	id, ok := giuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "GroupInvite.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := giuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, groupinvite.FieldID)
+		for _, f := range fields {
+			if !groupinvite.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != groupinvite.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := giuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := giuo.mutation.PhoneNumber(); ok {
+		_spec.SetField(groupinvite.FieldPhoneNumber, field.TypeString, value)
+	}
+	if giuo.mutation.PhoneNumberCleared() {
+		_spec.ClearField(groupinvite.FieldPhoneNumber, field.TypeString)
+	}
+	if value, ok := giuo.mutation.MaxUses(); ok {
+		_spec.SetField(groupinvite.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := giuo.mutation.AddedMaxUses(); ok {
+		_spec.AddField(groupinvite.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := giuo.mutation.Uses(); ok {
+		_spec.SetField(groupinvite.FieldUses, field.TypeInt, value)
+	}
+	if value, ok := giuo.mutation.AddedUses(); ok {
+		_spec.AddField(groupinvite.FieldUses, field.TypeInt, value)
+	}
+	if value, ok := giuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(groupinvite.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := giuo.mutation.RevokedAt(); ok {
+		_spec.SetField(groupinvite.FieldRevokedAt, field.TypeTime, value)
+	}
+	if giuo.mutation.RevokedAtCleared() {
+		_spec.ClearField(groupinvite.FieldRevokedAt, field.TypeTime)
+	}
+	if giuo.mutation.GroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   groupinvite.GroupTable,
+			Columns: []string{groupinvite.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(studygroup.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := giuo.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   groupinvite.GroupTable,
+			Columns: []string{groupinvite.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(studygroup.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if giuo.mutation.CreatorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   groupinvite.CreatorTable,
+			Columns: []string{groupinvite.CreatorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := giuo.mutation.CreatorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   groupinvite.CreatorTable,
+			Columns: []string{groupinvite.CreatorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &GroupInvite{config: giuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, giuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{groupinvite.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	giuo.mutation.done = true
+	return _node, nil
+}
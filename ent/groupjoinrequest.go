@@ -0,0 +1,185 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/r-scheele/zero/ent/groupjoinrequest"
+	"github.com/r-scheele/zero/ent/studygroup"
+	"github.com/r-scheele/zero/ent/user"
+)
+
+// GroupJoinRequest is the model entity for the GroupJoinRequest schema.
+type GroupJoinRequest struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Optional message to the group's owners
+	Message string `json:"message,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the GroupJoinRequestQuery when eager-loading is set.
+	Edges                     GroupJoinRequestEdges `json:"edges"`
+	study_group_join_requests *int
+	user_group_join_requests  *int
+	selectValues              sql.SelectValues
+}
+
+// GroupJoinRequestEdges holds the relations/edges for other nodes in the graph.
+type GroupJoinRequestEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Group holds the value of the group edge.
+	Group *StudyGroup `json:"group,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e GroupJoinRequestEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// GroupOrErr returns the Group value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e GroupJoinRequestEdges) GroupOrErr() (*StudyGroup, error) {
+	if e.Group != nil {
+		return e.Group, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: studygroup.Label}
+	}
+	return nil, &NotLoadedError{edge: "group"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*GroupJoinRequest) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case groupjoinrequest.FieldID:
+			values[i] = new(sql.NullInt64)
+		case groupjoinrequest.FieldMessage:
+			values[i] = new(sql.NullString)
+		case groupjoinrequest.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case groupjoinrequest.ForeignKeys[0]: // study_group_join_requests
+			values[i] = new(sql.NullInt64)
+		case groupjoinrequest.ForeignKeys[1]: // user_group_join_requests
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the GroupJoinRequest fields.
+func (gjr *GroupJoinRequest) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case groupjoinrequest.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			gjr.ID = int(value.Int64)
+		case groupjoinrequest.FieldMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field message", values[i])
+			} else if value.Valid {
+				gjr.Message = value.String
+			}
+		case groupjoinrequest.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				gjr.CreatedAt = value.Time
+			}
+		case groupjoinrequest.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field study_group_join_requests", value)
+			} else if value.Valid {
+				gjr.study_group_join_requests = new(int)
+				*gjr.study_group_join_requests = int(value.Int64)
+			}
+		case groupjoinrequest.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_group_join_requests", value)
+			} else if value.Valid {
+				gjr.user_group_join_requests = new(int)
+				*gjr.user_group_join_requests = int(value.Int64)
+			}
+		default:
+			gjr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the GroupJoinRequest.
+// This includes values selected through modifiers, order, etc.
+func (gjr *GroupJoinRequest) Value(name string) (ent.Value, error) {
+	return gjr.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the GroupJoinRequest entity.
+func (gjr *GroupJoinRequest) QueryUser() *UserQuery {
+	return NewGroupJoinRequestClient(gjr.config).QueryUser(gjr)
+}
+
+// QueryGroup queries the "group" edge of the GroupJoinRequest entity.
+func (gjr *GroupJoinRequest) QueryGroup() *StudyGroupQuery {
+	return NewGroupJoinRequestClient(gjr.config).QueryGroup(gjr)
+}
+
+// Update returns a builder for updating this GroupJoinRequest.
+// Note that you need to call GroupJoinRequest.Unwrap() before calling this method if this GroupJoinRequest
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (gjr *GroupJoinRequest) Update() *GroupJoinRequestUpdateOne {
+	return NewGroupJoinRequestClient(gjr.config).UpdateOne(gjr)
+}
+
+// Unwrap unwraps the GroupJoinRequest entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (gjr *GroupJoinRequest) Unwrap() *GroupJoinRequest {
+	_tx, ok := gjr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: GroupJoinRequest is not a transactional entity")
+	}
+	gjr.config.driver = _tx.drv
+	return gjr
+}
+
+// String implements the fmt.Stringer.
+func (gjr *GroupJoinRequest) String() string {
+	var builder strings.Builder
+	builder.WriteString("GroupJoinRequest(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", gjr.ID))
+	builder.WriteString("message=")
+	builder.WriteString(gjr.Message)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(gjr.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// GroupJoinRequests is a parsable slice of GroupJoinRequest.
+type GroupJoinRequests []*GroupJoinRequest
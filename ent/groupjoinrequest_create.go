@@ -0,0 +1,284 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/r-scheele/zero/ent/groupjoinrequest"
+	"github.com/r-scheele/zero/ent/studygroup"
+	"github.com/r-scheele/zero/ent/user"
+)
+
+// GroupJoinRequestCreate is the builder for creating a GroupJoinRequest entity.
+type GroupJoinRequestCreate struct {
+	config
+	mutation *GroupJoinRequestMutation
+	hooks    []Hook
+}
+
+// SetMessage sets the "message" field.
+func (gjrc *GroupJoinRequestCreate) SetMessage(s string) *GroupJoinRequestCreate {
+	gjrc.mutation.SetMessage(s)
+	return gjrc
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (gjrc *GroupJoinRequestCreate) SetNillableMessage(s *string) *GroupJoinRequestCreate {
+	if s != nil {
+		gjrc.SetMessage(*s)
+	}
+	return gjrc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (gjrc *GroupJoinRequestCreate) SetCreatedAt(t time.Time) *GroupJoinRequestCreate {
+	gjrc.mutation.SetCreatedAt(t)
+	return gjrc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (gjrc *GroupJoinRequestCreate) SetNillableCreatedAt(t *time.Time) *GroupJoinRequestCreate {
+	if t != nil {
+		gjrc.SetCreatedAt(*t)
+	}
+	return gjrc
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (gjrc *GroupJoinRequestCreate) SetUserID(id int) *GroupJoinRequestCreate {
+	gjrc.mutation.SetUserID(id)
+	return gjrc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (gjrc *GroupJoinRequestCreate) SetUser(u *User) *GroupJoinRequestCreate {
+	return gjrc.SetUserID(u.ID)
+}
+
+// SetGroupID sets the "group" edge to the StudyGroup entity by ID.
+func (gjrc *GroupJoinRequestCreate) SetGroupID(id int) *GroupJoinRequestCreate {
+	gjrc.mutation.SetGroupID(id)
+	return gjrc
+}
+
+// SetGroup sets the "group" edge to the StudyGroup entity.
+func (gjrc *GroupJoinRequestCreate) SetGroup(s *StudyGroup) *GroupJoinRequestCreate {
+	return gjrc.SetGroupID(s.ID)
+}
+
+// Mutation returns the GroupJoinRequestMutation object of the builder.
+func (gjrc *GroupJoinRequestCreate) Mutation() *GroupJoinRequestMutation {
+	return gjrc.mutation
+}
+
+// Save creates the GroupJoinRequest in the database.
+func (gjrc *GroupJoinRequestCreate) Save(ctx context.Context) (*GroupJoinRequest, error) {
+	gjrc.defaults()
+	return withHooks(ctx, gjrc.sqlSave, gjrc.mutation, gjrc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (gjrc *GroupJoinRequestCreate) SaveX(ctx context.Context) *GroupJoinRequest {
+	v, err := gjrc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (gjrc *GroupJoinRequestCreate) Exec(ctx context.Context) error {
+	_, err := gjrc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gjrc *GroupJoinRequestCreate) ExecX(ctx context.Context) {
+	if err := gjrc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (gjrc *GroupJoinRequestCreate) defaults() {
+	if _, ok := gjrc.mutation.CreatedAt(); !ok {
+		v := groupjoinrequest.DefaultCreatedAt()
+		gjrc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gjrc *GroupJoinRequestCreate) check() error {
+	if _, ok := gjrc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "GroupJoinRequest.created_at"`)}
+	}
+	if len(gjrc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "GroupJoinRequest.user"`)}
+	}
+	if len(gjrc.mutation.GroupIDs()) == 0 {
+		return &ValidationError{Name: "group", err: errors.New(`ent: missing required edge "GroupJoinRequest.group"`)}
+	}
+	return nil
+}
+
+func (gjrc *GroupJoinRequestCreate) sqlSave(ctx context.Context) (*GroupJoinRequest, error) {
+	if err := gjrc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := gjrc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, gjrc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	gjrc.mutation.id = &_node.ID
+	gjrc.mutation.done = true
+	return _node, nil
+}
+
+func (gjrc *GroupJoinRequestCreate) createSpec() (*GroupJoinRequest, *sqlgraph.CreateSpec) {
+	var (
+		_node = &GroupJoinRequest{config: gjrc.config}
+		_spec = sqlgraph.NewCreateSpec(groupjoinrequest.Table, sqlgraph.NewFieldSpec(groupjoinrequest.FieldID, field.TypeInt))
+	)
+	if value, ok := gjrc.mutation.Message(); ok {
+		_spec.SetField(groupjoinrequest.FieldMessage, field.TypeString, value)
+		_node.Message = value
+	}
+	if value, ok := gjrc.mutation.CreatedAt(); ok {
+		_spec.SetField(groupjoinrequest.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := gjrc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   groupjoinrequest.UserTable,
+			Columns: []string{groupjoinrequest.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_group_join_requests = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := gjrc.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   groupjoinrequest.GroupTable,
+			Columns: []string{groupjoinrequest.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(studygroup.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.study_group_join_requests = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// GroupJoinRequestCreateBulk is the builder for creating many GroupJoinRequest entities in bulk.
+type GroupJoinRequestCreateBulk struct {
+	config
+	err      error
+	builders []*GroupJoinRequestCreate
+}
+
+// Save creates the GroupJoinRequest entities in the database.
+func (gjrcb *GroupJoinRequestCreateBulk) Save(ctx context.Context) ([]*GroupJoinRequest, error) {
+	if gjrcb.err != nil {
+		return nil, gjrcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(gjrcb.builders))
+	nodes := make([]*GroupJoinRequest, len(gjrcb.builders))
+	mutators := make([]Mutator, len(gjrcb.builders))
+	for i := range gjrcb.builders {
+		func(i int, root context.Context) {
+			builder := gjrcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GroupJoinRequestMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, gjrcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, gjrcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, gjrcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gjrcb *GroupJoinRequestCreateBulk) SaveX(ctx context.Context) []*GroupJoinRequest {
+	v, err := gjrcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (gjrcb *GroupJoinRequestCreateBulk) Exec(ctx context.Context) error {
+	_, err := gjrcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gjrcb *GroupJoinRequestCreateBulk) ExecX(ctx context.Context) {
+	if err := gjrcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/r-scheele/zero/ent/groupjoinrequest"
+	"github.com/r-scheele/zero/ent/predicate"
+)
+
+// GroupJoinRequestDelete is the builder for deleting a GroupJoinRequest entity.
+type GroupJoinRequestDelete struct {
+	config
+	hooks    []Hook
+	mutation *GroupJoinRequestMutation
+}
+
+// Where appends a list predicates to the GroupJoinRequestDelete builder.
+func (gjrd *GroupJoinRequestDelete) Where(ps ...predicate.GroupJoinRequest) *GroupJoinRequestDelete {
+	gjrd.mutation.Where(ps...)
+	return gjrd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (gjrd *GroupJoinRequestDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, gjrd.sqlExec, gjrd.mutation, gjrd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gjrd *GroupJoinRequestDelete) ExecX(ctx context.Context) int {
+	n, err := gjrd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (gjrd *GroupJoinRequestDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(groupjoinrequest.Table, sqlgraph.NewFieldSpec(groupjoinrequest.FieldID, field.TypeInt))
+	if ps := gjrd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, gjrd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	gjrd.mutation.done = true
+	return affected, err
+}
+
+// GroupJoinRequestDeleteOne is the builder for deleting a single GroupJoinRequest entity.
+type GroupJoinRequestDeleteOne struct {
+	gjrd *GroupJoinRequestDelete
+}
+
+// Where appends a list predicates to the GroupJoinRequestDelete builder.
+func (gjrdo *GroupJoinRequestDeleteOne) Where(ps ...predicate.GroupJoinRequest) *GroupJoinRequestDeleteOne {
+	gjrdo.gjrd.mutation.Where(ps...)
+	return gjrdo
+}
+
+// Exec executes the deletion query.
+func (gjrdo *GroupJoinRequestDeleteOne) Exec(ctx context.Context) error {
+	n, err := gjrdo.gjrd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{groupjoinrequest.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gjrdo *GroupJoinRequestDeleteOne) ExecX(ctx context.Context) {
+	if err := gjrdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
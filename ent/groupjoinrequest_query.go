@@ -0,0 +1,689 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/r-scheele/zero/ent/groupjoinrequest"
+	"github.com/r-scheele/zero/ent/predicate"
+	"github.com/r-scheele/zero/ent/studygroup"
+	"github.com/r-scheele/zero/ent/user"
+)
+
+// GroupJoinRequestQuery is the builder for querying GroupJoinRequest entities.
+type GroupJoinRequestQuery struct {
+	config
+	ctx        *QueryContext
+	order      []groupjoinrequest.OrderOption
+	inters     []Interceptor
+	predicates []predicate.GroupJoinRequest
+	withUser   *UserQuery
+	withGroup  *StudyGroupQuery
+	withFKs    bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the GroupJoinRequestQuery builder.
+func (gjrq *GroupJoinRequestQuery) Where(ps ...predicate.GroupJoinRequest) *GroupJoinRequestQuery {
+	gjrq.predicates = append(gjrq.predicates, ps...)
+	return gjrq
+}
+
+// Limit the number of records to be returned by this query.
+func (gjrq *GroupJoinRequestQuery) Limit(limit int) *GroupJoinRequestQuery {
+	gjrq.ctx.Limit = &limit
+	return gjrq
+}
+
+// Offset to start from.
+func (gjrq *GroupJoinRequestQuery) Offset(offset int) *GroupJoinRequestQuery {
+	gjrq.ctx.Offset = &offset
+	return gjrq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (gjrq *GroupJoinRequestQuery) Unique(unique bool) *GroupJoinRequestQuery {
+	gjrq.ctx.Unique = &unique
+	return gjrq
+}
+
+// Order specifies how the records should be ordered.
+func (gjrq *GroupJoinRequestQuery) Order(o ...groupjoinrequest.OrderOption) *GroupJoinRequestQuery {
+	gjrq.order = append(gjrq.order, o...)
+	return gjrq
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (gjrq *GroupJoinRequestQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: gjrq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := gjrq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := gjrq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(groupjoinrequest.Table, groupjoinrequest.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, groupjoinrequest.UserTable, groupjoinrequest.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(gjrq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryGroup chains the current query on the "group" edge.
+func (gjrq *GroupJoinRequestQuery) QueryGroup() *StudyGroupQuery {
+	query := (&StudyGroupClient{config: gjrq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := gjrq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := gjrq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(groupjoinrequest.Table, groupjoinrequest.FieldID, selector),
+			sqlgraph.To(studygroup.Table, studygroup.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, groupjoinrequest.GroupTable, groupjoinrequest.GroupColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(gjrq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first GroupJoinRequest entity from the query.
+// Returns a *NotFoundError when no GroupJoinRequest was found.
+func (gjrq *GroupJoinRequestQuery) First(ctx context.Context) (*GroupJoinRequest, error) {
+	nodes, err := gjrq.Limit(1).All(setContextOp(ctx, gjrq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{groupjoinrequest.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (gjrq *GroupJoinRequestQuery) FirstX(ctx context.Context) *GroupJoinRequest {
+	node, err := gjrq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first GroupJoinRequest ID from the query.
+// Returns a *NotFoundError when no GroupJoinRequest ID was found.
+func (gjrq *GroupJoinRequestQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = gjrq.Limit(1).IDs(setContextOp(ctx, gjrq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{groupjoinrequest.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (gjrq *GroupJoinRequestQuery) FirstIDX(ctx context.Context) int {
+	id, err := gjrq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single GroupJoinRequest entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one GroupJoinRequest entity is found.
+// Returns a *NotFoundError when no GroupJoinRequest entities are found.
+func (gjrq *GroupJoinRequestQuery) Only(ctx context.Context) (*GroupJoinRequest, error) {
+	nodes, err := gjrq.Limit(2).All(setContextOp(ctx, gjrq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{groupjoinrequest.Label}
+	default:
+		return nil, &NotSingularError{groupjoinrequest.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (gjrq *GroupJoinRequestQuery) OnlyX(ctx context.Context) *GroupJoinRequest {
+	node, err := gjrq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only GroupJoinRequest ID in the query.
+// Returns a *NotSingularError when more than one GroupJoinRequest ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (gjrq *GroupJoinRequestQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = gjrq.Limit(2).IDs(setContextOp(ctx, gjrq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{groupjoinrequest.Label}
+	default:
+		err = &NotSingularError{groupjoinrequest.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (gjrq *GroupJoinRequestQuery) OnlyIDX(ctx context.Context) int {
+	id, err := gjrq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of GroupJoinRequests.
+func (gjrq *GroupJoinRequestQuery) All(ctx context.Context) ([]*GroupJoinRequest, error) {
+	ctx = setContextOp(ctx, gjrq.ctx, ent.OpQueryAll)
+	if err := gjrq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*GroupJoinRequest, *GroupJoinRequestQuery]()
+	return withInterceptors[[]*GroupJoinRequest](ctx, gjrq, qr, gjrq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (gjrq *GroupJoinRequestQuery) AllX(ctx context.Context) []*GroupJoinRequest {
+	nodes, err := gjrq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of GroupJoinRequest IDs.
+func (gjrq *GroupJoinRequestQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if gjrq.ctx.Unique == nil && gjrq.path != nil {
+		gjrq.Unique(true)
+	}
+	ctx = setContextOp(ctx, gjrq.ctx, ent.OpQueryIDs)
+	if err = gjrq.Select(groupjoinrequest.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (gjrq *GroupJoinRequestQuery) IDsX(ctx context.Context) []int {
+	ids, err := gjrq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (gjrq *GroupJoinRequestQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, gjrq.ctx, ent.OpQueryCount)
+	if err := gjrq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, gjrq, querierCount[*GroupJoinRequestQuery](), gjrq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (gjrq *GroupJoinRequestQuery) CountX(ctx context.Context) int {
+	count, err := gjrq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (gjrq *GroupJoinRequestQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, gjrq.ctx, ent.OpQueryExist)
+	switch _, err := gjrq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (gjrq *GroupJoinRequestQuery) ExistX(ctx context.Context) bool {
+	exist, err := gjrq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the GroupJoinRequestQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (gjrq *GroupJoinRequestQuery) Clone() *GroupJoinRequestQuery {
+	if gjrq == nil {
+		return nil
+	}
+	return &GroupJoinRequestQuery{
+		config:     gjrq.config,
+		ctx:        gjrq.ctx.Clone(),
+		order:      append([]groupjoinrequest.OrderOption{}, gjrq.order...),
+		inters:     append([]Interceptor{}, gjrq.inters...),
+		predicates: append([]predicate.GroupJoinRequest{}, gjrq.predicates...),
+		withUser:   gjrq.withUser.Clone(),
+		withGroup:  gjrq.withGroup.Clone(),
+		// clone intermediate query.
+		sql:  gjrq.sql.Clone(),
+		path: gjrq.path,
+	}
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (gjrq *GroupJoinRequestQuery) WithUser(opts ...func(*UserQuery)) *GroupJoinRequestQuery {
+	query := (&UserClient{config: gjrq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	gjrq.withUser = query
+	return gjrq
+}
+
+// WithGroup tells the query-builder to eager-load the nodes that are connected to
+// the "group" edge. The optional arguments are used to configure the query builder of the edge.
+func (gjrq *GroupJoinRequestQuery) WithGroup(opts ...func(*StudyGroupQuery)) *GroupJoinRequestQuery {
+	query := (&StudyGroupClient{config: gjrq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	gjrq.withGroup = query
+	return gjrq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Message string `json:"message,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.GroupJoinRequest.Query().
+//		GroupBy(groupjoinrequest.FieldMessage).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (gjrq *GroupJoinRequestQuery) GroupBy(field string, fields ...string) *GroupJoinRequestGroupBy {
+	gjrq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &GroupJoinRequestGroupBy{build: gjrq}
+	grbuild.flds = &gjrq.ctx.Fields
+	grbuild.label = groupjoinrequest.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Message string `json:"message,omitempty"`
+//	}
+//
+//	client.GroupJoinRequest.Query().
+//		Select(groupjoinrequest.FieldMessage).
+//		Scan(ctx, &v)
+func (gjrq *GroupJoinRequestQuery) Select(fields ...string) *GroupJoinRequestSelect {
+	gjrq.ctx.Fields = append(gjrq.ctx.Fields, fields...)
+	sbuild := &GroupJoinRequestSelect{GroupJoinRequestQuery: gjrq}
+	sbuild.label = groupjoinrequest.Label
+	sbuild.flds, sbuild.scan = &gjrq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a GroupJoinRequestSelect configured with the given aggregations.
+func (gjrq *GroupJoinRequestQuery) Aggregate(fns ...AggregateFunc) *GroupJoinRequestSelect {
+	return gjrq.Select().Aggregate(fns...)
+}
+
+func (gjrq *GroupJoinRequestQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range gjrq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, gjrq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range gjrq.ctx.Fields {
+		if !groupjoinrequest.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if gjrq.path != nil {
+		prev, err := gjrq.path(ctx)
+		if err != nil {
+			return err
+		}
+		gjrq.sql = prev
+	}
+	return nil
+}
+
+func (gjrq *GroupJoinRequestQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*GroupJoinRequest, error) {
+	var (
+		nodes       = []*GroupJoinRequest{}
+		withFKs     = gjrq.withFKs
+		_spec       = gjrq.querySpec()
+		loadedTypes = [2]bool{
+			gjrq.withUser != nil,
+			gjrq.withGroup != nil,
+		}
+	)
+	if gjrq.withUser != nil || gjrq.withGroup != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, groupjoinrequest.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*GroupJoinRequest).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &GroupJoinRequest{config: gjrq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, gjrq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := gjrq.withUser; query != nil {
+		if err := gjrq.loadUser(ctx, query, nodes, nil,
+			func(n *GroupJoinRequest, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := gjrq.withGroup; query != nil {
+		if err := gjrq.loadGroup(ctx, query, nodes, nil,
+			func(n *GroupJoinRequest, e *StudyGroup) { n.Edges.Group = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (gjrq *GroupJoinRequestQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*GroupJoinRequest, init func(*GroupJoinRequest), assign func(*GroupJoinRequest, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*GroupJoinRequest)
+	for i := range nodes {
+		if nodes[i].user_group_join_requests == nil {
+			continue
+		}
+		fk := *nodes[i].user_group_join_requests
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_group_join_requests" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (gjrq *GroupJoinRequestQuery) loadGroup(ctx context.Context, query *StudyGroupQuery, nodes []*GroupJoinRequest, init func(*GroupJoinRequest), assign func(*GroupJoinRequest, *StudyGroup)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*GroupJoinRequest)
+	for i := range nodes {
+		if nodes[i].study_group_join_requests == nil {
+			continue
+		}
+		fk := *nodes[i].study_group_join_requests
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(studygroup.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "study_group_join_requests" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (gjrq *GroupJoinRequestQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := gjrq.querySpec()
+	_spec.Node.Columns = gjrq.ctx.Fields
+	if len(gjrq.ctx.Fields) > 0 {
+		_spec.Unique = gjrq.ctx.Unique != nil && *gjrq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, gjrq.driver, _spec)
+}
+
+func (gjrq *GroupJoinRequestQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(groupjoinrequest.Table, groupjoinrequest.Columns, sqlgraph.NewFieldSpec(groupjoinrequest.FieldID, field.TypeInt))
+	_spec.From = gjrq.sql
+	if unique := gjrq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if gjrq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := gjrq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, groupjoinrequest.FieldID)
+		for i := range fields {
+			if fields[i] != groupjoinrequest.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := gjrq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := gjrq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := gjrq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := gjrq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (gjrq *GroupJoinRequestQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(gjrq.driver.Dialect())
+	t1 := builder.Table(groupjoinrequest.Table)
+	columns := gjrq.ctx.Fields
+	if len(columns) == 0 {
+		columns = groupjoinrequest.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if gjrq.sql != nil {
+		selector = gjrq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if gjrq.ctx.Unique != nil && *gjrq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range gjrq.predicates {
+		p(selector)
+	}
+	for _, p := range gjrq.order {
+		p(selector)
+	}
+	if offset := gjrq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := gjrq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// GroupJoinRequestGroupBy is the group-by builder for GroupJoinRequest entities.
+type GroupJoinRequestGroupBy struct {
+	selector
+	build *GroupJoinRequestQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (gjrgb *GroupJoinRequestGroupBy) Aggregate(fns ...AggregateFunc) *GroupJoinRequestGroupBy {
+	gjrgb.fns = append(gjrgb.fns, fns...)
+	return gjrgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (gjrgb *GroupJoinRequestGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, gjrgb.build.ctx, ent.OpQueryGroupBy)
+	if err := gjrgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*GroupJoinRequestQuery, *GroupJoinRequestGroupBy](ctx, gjrgb.build, gjrgb, gjrgb.build.inters, v)
+}
+
+func (gjrgb *GroupJoinRequestGroupBy) sqlScan(ctx context.Context, root *GroupJoinRequestQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(gjrgb.fns))
+	for _, fn := range gjrgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*gjrgb.flds)+len(gjrgb.fns))
+		for _, f := range *gjrgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*gjrgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := gjrgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// GroupJoinRequestSelect is the builder for selecting fields of GroupJoinRequest entities.
+type GroupJoinRequestSelect struct {
+	*GroupJoinRequestQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (gjrs *GroupJoinRequestSelect) Aggregate(fns ...AggregateFunc) *GroupJoinRequestSelect {
+	gjrs.fns = append(gjrs.fns, fns...)
+	return gjrs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (gjrs *GroupJoinRequestSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, gjrs.ctx, ent.OpQuerySelect)
+	if err := gjrs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*GroupJoinRequestQuery, *GroupJoinRequestSelect](ctx, gjrs.GroupJoinRequestQuery, gjrs, gjrs.inters, v)
+}
+
+func (gjrs *GroupJoinRequestSelect) sqlScan(ctx context.Context, root *GroupJoinRequestQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(gjrs.fns))
+	for _, fn := range gjrs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*gjrs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := gjrs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
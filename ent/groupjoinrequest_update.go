@@ -0,0 +1,441 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/r-scheele/zero/ent/groupjoinrequest"
+	"github.com/r-scheele/zero/ent/predicate"
+	"github.com/r-scheele/zero/ent/studygroup"
+	"github.com/r-scheele/zero/ent/user"
+)
+
+// GroupJoinRequestUpdate is the builder for updating GroupJoinRequest entities.
+type GroupJoinRequestUpdate struct {
+	config
+	hooks    []Hook
+	mutation *GroupJoinRequestMutation
+}
+
+// Where appends a list predicates to the GroupJoinRequestUpdate builder.
+func (gjru *GroupJoinRequestUpdate) Where(ps ...predicate.GroupJoinRequest) *GroupJoinRequestUpdate {
+	gjru.mutation.Where(ps...)
+	return gjru
+}
+
+// SetMessage sets the "message" field.
+func (gjru *GroupJoinRequestUpdate) SetMessage(s string) *GroupJoinRequestUpdate {
+	gjru.mutation.SetMessage(s)
+	return gjru
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (gjru *GroupJoinRequestUpdate) SetNillableMessage(s *string) *GroupJoinRequestUpdate {
+	if s != nil {
+		gjru.SetMessage(*s)
+	}
+	return gjru
+}
+
+// ClearMessage clears the value of the "message" field.
+func (gjru *GroupJoinRequestUpdate) ClearMessage() *GroupJoinRequestUpdate {
+	gjru.mutation.ClearMessage()
+	return gjru
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (gjru *GroupJoinRequestUpdate) SetUserID(id int) *GroupJoinRequestUpdate {
+	gjru.mutation.SetUserID(id)
+	return gjru
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (gjru *GroupJoinRequestUpdate) SetUser(u *User) *GroupJoinRequestUpdate {
+	return gjru.SetUserID(u.ID)
+}
+
+// SetGroupID sets the "group" edge to the StudyGroup entity by ID.
+func (gjru *GroupJoinRequestUpdate) SetGroupID(id int) *GroupJoinRequestUpdate {
+	gjru.mutation.SetGroupID(id)
+	return gjru
+}
+
+// SetGroup sets the "group" edge to the StudyGroup entity.
+func (gjru *GroupJoinRequestUpdate) SetGroup(s *StudyGroup) *GroupJoinRequestUpdate {
+	return gjru.SetGroupID(s.ID)
+}
+
+// Mutation returns the GroupJoinRequestMutation object of the builder.
+func (gjru *GroupJoinRequestUpdate) Mutation() *GroupJoinRequestMutation {
+	return gjru.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (gjru *GroupJoinRequestUpdate) ClearUser() *GroupJoinRequestUpdate {
+	gjru.mutation.ClearUser()
+	return gjru
+}
+
+// ClearGroup clears the "group" edge to the StudyGroup entity.
+func (gjru *GroupJoinRequestUpdate) ClearGroup() *GroupJoinRequestUpdate {
+	gjru.mutation.ClearGroup()
+	return gjru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (gjru *GroupJoinRequestUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, gjru.sqlSave, gjru.mutation, gjru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gjru *GroupJoinRequestUpdate) SaveX(ctx context.Context) int {
+	affected, err := gjru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (gjru *GroupJoinRequestUpdate) Exec(ctx context.Context) error {
+	_, err := gjru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gjru *GroupJoinRequestUpdate) ExecX(ctx context.Context) {
+	if err := gjru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gjru *GroupJoinRequestUpdate) check() error {
+	if gjru.mutation.UserCleared() && len(gjru.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "GroupJoinRequest.user"`)
+	}
+	if gjru.mutation.GroupCleared() && len(gjru.mutation.GroupIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "GroupJoinRequest.group"`)
+	}
+	return nil
+}
+
+func (gjru *GroupJoinRequestUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := gjru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(groupjoinrequest.Table, groupjoinrequest.Columns, sqlgraph.NewFieldSpec(groupjoinrequest.FieldID, field.TypeInt))
+	if ps := gjru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := gjru.mutation.Message(); ok {
+		_spec.SetField(groupjoinrequest.FieldMessage, field.TypeString, value)
+	}
+	if gjru.mutation.MessageCleared() {
+		_spec.ClearField(groupjoinrequest.FieldMessage, field.TypeString)
+	}
+	if gjru.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   groupjoinrequest.UserTable,
+			Columns: []string{groupjoinrequest.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gjru.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   groupjoinrequest.UserTable,
+			Columns: []string{groupjoinrequest.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if gjru.mutation.GroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   groupjoinrequest.GroupTable,
+			Columns: []string{groupjoinrequest.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(studygroup.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gjru.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   groupjoinrequest.GroupTable,
+			Columns: []string{groupjoinrequest.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(studygroup.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, gjru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{groupjoinrequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	gjru.mutation.done = true
+	return n, nil
+}
+
+// GroupJoinRequestUpdateOne is the builder for updating a single GroupJoinRequest entity.
+type GroupJoinRequestUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *GroupJoinRequestMutation
+}
+
+// SetMessage sets the "message" field.
+func (gjruo *GroupJoinRequestUpdateOne) SetMessage(s string) *GroupJoinRequestUpdateOne {
+	gjruo.mutation.SetMessage(s)
+	return gjruo
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (gjruo *GroupJoinRequestUpdateOne) SetNillableMessage(s *string) *GroupJoinRequestUpdateOne {
+	if s != nil {
+		gjruo.SetMessage(*s)
+	}
+	return gjruo
+}
+
+// ClearMessage clears the value of the "message" field.
+func (gjruo *GroupJoinRequestUpdateOne) ClearMessage() *GroupJoinRequestUpdateOne {
+	gjruo.mutation.ClearMessage()
+	return gjruo
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (gjruo *GroupJoinRequestUpdateOne) SetUserID(id int) *GroupJoinRequestUpdateOne {
+	gjruo.mutation.SetUserID(id)
+	return gjruo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (gjruo *GroupJoinRequestUpdateOne) SetUser(u *User) *GroupJoinRequestUpdateOne {
+	return gjruo.SetUserID(u.ID)
+}
+
+// SetGroupID sets the "group" edge to the StudyGroup entity by ID.
+func (gjruo *GroupJoinRequestUpdateOne) SetGroupID(id int) *GroupJoinRequestUpdateOne {
+	gjruo.mutation.SetGroupID(id)
+	return gjruo
+}
+
+// SetGroup sets the "group" edge to the StudyGroup entity.
+func (gjruo *GroupJoinRequestUpdateOne) SetGroup(s *StudyGroup) *GroupJoinRequestUpdateOne {
+	return gjruo.SetGroupID(s.ID)
+}
+
+// Mutation returns the GroupJoinRequestMutation object of the builder.
+func (gjruo *GroupJoinRequestUpdateOne) Mutation() *GroupJoinRequestMutation {
+	return gjruo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (gjruo *GroupJoinRequestUpdateOne) ClearUser() *GroupJoinRequestUpdateOne {
+	gjruo.mutation.ClearUser()
+	return gjruo
+}
+
+// ClearGroup clears the "group" edge to the StudyGroup entity.
+func (gjruo *GroupJoinRequestUpdateOne) ClearGroup() *GroupJoinRequestUpdateOne {
+	gjruo.mutation.ClearGroup()
+	return gjruo
+}
+
+// Where appends a list predicates to the GroupJoinRequestUpdate builder.
+func (gjruo *GroupJoinRequestUpdateOne) Where(ps ...predicate.GroupJoinRequest) *GroupJoinRequestUpdateOne {
+	gjruo.mutation.Where(ps...)
+	return gjruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (gjruo *GroupJoinRequestUpdateOne) Select(field string, fields ...string) *GroupJoinRequestUpdateOne {
+	gjruo.fields = append([]string{field}, fields...)
+	return gjruo
+}
+
+// Save executes the query and returns the updated GroupJoinRequest entity.
+func (gjruo *GroupJoinRequestUpdateOne) Save(ctx context.Context) (*GroupJoinRequest, error) {
+	return withHooks(ctx, gjruo.sqlSave, gjruo.mutation, gjruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gjruo *GroupJoinRequestUpdateOne) SaveX(ctx context.Context) *GroupJoinRequest {
+	node, err := gjruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (gjruo *GroupJoinRequestUpdateOne) Exec(ctx context.Context) error {
+	_, err := gjruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gjruo *GroupJoinRequestUpdateOne) ExecX(ctx context.Context) {
+	if err := gjruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gjruo *GroupJoinRequestUpdateOne) check() error {
+	if gjruo.mutation.UserCleared() && len(gjruo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "GroupJoinRequest.user"`)
+	}
+	if gjruo.mutation.GroupCleared() && len(gjruo.mutation.GroupIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "GroupJoinRequest.group"`)
+	}
+	return nil
+}
+
+func (gjruo *GroupJoinRequestUpdateOne) sqlSave(ctx context.Context) (_node *GroupJoinRequest, err error) {
+	if err := gjruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(groupjoinrequest.Table, groupjoinrequest.Columns, sqlgraph.NewFieldSpec(groupjoinrequest.FieldID, field.TypeInt))
+	id, ok := gjruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "GroupJoinRequest.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := gjruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, groupjoinrequest.FieldID)
+		for _, f := range fields {
+			if !groupjoinrequest.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != groupjoinrequest.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := gjruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := gjruo.mutation.Message(); ok {
+		_spec.SetField(groupjoinrequest.FieldMessage, field.TypeString, value)
+	}
+	if gjruo.mutation.MessageCleared() {
+		_spec.ClearField(groupjoinrequest.FieldMessage, field.TypeString)
+	}
+	if gjruo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   groupjoinrequest.UserTable,
+			Columns: []string{groupjoinrequest.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gjruo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   groupjoinrequest.UserTable,
+			Columns: []string{groupjoinrequest.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if gjruo.mutation.GroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   groupjoinrequest.GroupTable,
+			Columns: []string{groupjoinrequest.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(studygroup.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gjruo.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   groupjoinrequest.GroupTable,
+			Columns: []string{groupjoinrequest.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(studygroup.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &GroupJoinRequest{config: gjruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, gjruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{groupjoinrequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	gjruo.mutation.done = true
+	return _node, nil
+}
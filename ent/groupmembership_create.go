@@ -0,0 +1,296 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/r-scheele/zero/ent/groupmembership"
+	"github.com/r-scheele/zero/ent/studygroup"
+	"github.com/r-scheele/zero/ent/user"
+)
+
+// GroupMembershipCreate is the builder for creating a GroupMembership entity.
+type GroupMembershipCreate struct {
+	config
+	mutation *GroupMembershipMutation
+	hooks    []Hook
+}
+
+// SetRole sets the "role" field.
+func (gmc *GroupMembershipCreate) SetRole(gr groupmembership.Role) *GroupMembershipCreate {
+	gmc.mutation.SetRole(gr)
+	return gmc
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (gmc *GroupMembershipCreate) SetNillableRole(gr *groupmembership.Role) *GroupMembershipCreate {
+	if gr != nil {
+		gmc.SetRole(*gr)
+	}
+	return gmc
+}
+
+// SetJoinedAt sets the "joined_at" field.
+func (gmc *GroupMembershipCreate) SetJoinedAt(t time.Time) *GroupMembershipCreate {
+	gmc.mutation.SetJoinedAt(t)
+	return gmc
+}
+
+// SetNillableJoinedAt sets the "joined_at" field if the given value is not nil.
+func (gmc *GroupMembershipCreate) SetNillableJoinedAt(t *time.Time) *GroupMembershipCreate {
+	if t != nil {
+		gmc.SetJoinedAt(*t)
+	}
+	return gmc
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (gmc *GroupMembershipCreate) SetUserID(id int) *GroupMembershipCreate {
+	gmc.mutation.SetUserID(id)
+	return gmc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (gmc *GroupMembershipCreate) SetUser(u *User) *GroupMembershipCreate {
+	return gmc.SetUserID(u.ID)
+}
+
+// SetGroupID sets the "group" edge to the StudyGroup entity by ID.
+func (gmc *GroupMembershipCreate) SetGroupID(id int) *GroupMembershipCreate {
+	gmc.mutation.SetGroupID(id)
+	return gmc
+}
+
+// SetGroup sets the "group" edge to the StudyGroup entity.
+func (gmc *GroupMembershipCreate) SetGroup(s *StudyGroup) *GroupMembershipCreate {
+	return gmc.SetGroupID(s.ID)
+}
+
+// Mutation returns the GroupMembershipMutation object of the builder.
+func (gmc *GroupMembershipCreate) Mutation() *GroupMembershipMutation {
+	return gmc.mutation
+}
+
+// Save creates the GroupMembership in the database.
+func (gmc *GroupMembershipCreate) Save(ctx context.Context) (*GroupMembership, error) {
+	gmc.defaults()
+	return withHooks(ctx, gmc.sqlSave, gmc.mutation, gmc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (gmc *GroupMembershipCreate) SaveX(ctx context.Context) *GroupMembership {
+	v, err := gmc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (gmc *GroupMembershipCreate) Exec(ctx context.Context) error {
+	_, err := gmc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gmc *GroupMembershipCreate) ExecX(ctx context.Context) {
+	if err := gmc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (gmc *GroupMembershipCreate) defaults() {
+	if _, ok := gmc.mutation.Role(); !ok {
+		v := groupmembership.DefaultRole
+		gmc.mutation.SetRole(v)
+	}
+	if _, ok := gmc.mutation.JoinedAt(); !ok {
+		v := groupmembership.DefaultJoinedAt()
+		gmc.mutation.SetJoinedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gmc *GroupMembershipCreate) check() error {
+	if _, ok := gmc.mutation.Role(); !ok {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "GroupMembership.role"`)}
+	}
+	if v, ok := gmc.mutation.Role(); ok {
+		if err := groupmembership.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "GroupMembership.role": %w`, err)}
+		}
+	}
+	if _, ok := gmc.mutation.JoinedAt(); !ok {
+		return &ValidationError{Name: "joined_at", err: errors.New(`ent: missing required field "GroupMembership.joined_at"`)}
+	}
+	if len(gmc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "GroupMembership.user"`)}
+	}
+	if len(gmc.mutation.GroupIDs()) == 0 {
+		return &ValidationError{Name: "group", err: errors.New(`ent: missing required edge "GroupMembership.group"`)}
+	}
+	return nil
+}
+
+func (gmc *GroupMembershipCreate) sqlSave(ctx context.Context) (*GroupMembership, error) {
+	if err := gmc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := gmc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, gmc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	gmc.mutation.id = &_node.ID
+	gmc.mutation.done = true
+	return _node, nil
+}
+
+func (gmc *GroupMembershipCreate) createSpec() (*GroupMembership, *sqlgraph.CreateSpec) {
+	var (
+		_node = &GroupMembership{config: gmc.config}
+		_spec = sqlgraph.NewCreateSpec(groupmembership.Table, sqlgraph.NewFieldSpec(groupmembership.FieldID, field.TypeInt))
+	)
+	if value, ok := gmc.mutation.Role(); ok {
+		_spec.SetField(groupmembership.FieldRole, field.TypeEnum, value)
+		_node.Role = value
+	}
+	if value, ok := gmc.mutation.JoinedAt(); ok {
+		_spec.SetField(groupmembership.FieldJoinedAt, field.TypeTime, value)
+		_node.JoinedAt = value
+	}
+	if nodes := gmc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   groupmembership.UserTable,
+			Columns: []string{groupmembership.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_group_memberships = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := gmc.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   groupmembership.GroupTable,
+			Columns: []string{groupmembership.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(studygroup.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.study_group_memberships = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// GroupMembershipCreateBulk is the builder for creating many GroupMembership entities in bulk.
+type GroupMembershipCreateBulk struct {
+	config
+	err      error
+	builders []*GroupMembershipCreate
+}
+
+// Save creates the GroupMembership entities in the database.
+func (gmcb *GroupMembershipCreateBulk) Save(ctx context.Context) ([]*GroupMembership, error) {
+	if gmcb.err != nil {
+		return nil, gmcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(gmcb.builders))
+	nodes := make([]*GroupMembership, len(gmcb.builders))
+	mutators := make([]Mutator, len(gmcb.builders))
+	for i := range gmcb.builders {
+		func(i int, root context.Context) {
+			builder := gmcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GroupMembershipMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, gmcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, gmcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, gmcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gmcb *GroupMembershipCreateBulk) SaveX(ctx context.Context) []*GroupMembership {
+	v, err := gmcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (gmcb *GroupMembershipCreateBulk) Exec(ctx context.Context) error {
+	_, err := gmcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gmcb *GroupMembershipCreateBulk) ExecX(ctx context.Context) {
+	if err := gmcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -9,6 +9,102 @@ import (
 	"github.com/r-scheele/zero/ent"
 )
 
+// The BlobFunc type is an adapter to allow the use of ordinary
+// function as Blob mutator.
+type BlobFunc func(context.Context, *ent.BlobMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BlobFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.BlobMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BlobMutation", m)
+}
+
+// The BlobReferenceFunc type is an adapter to allow the use of ordinary
+// function as BlobReference mutator.
+type BlobReferenceFunc func(context.Context, *ent.BlobReferenceMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BlobReferenceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.BlobReferenceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BlobReferenceMutation", m)
+}
+
+// The ChoiceFunc type is an adapter to allow the use of ordinary
+// function as Choice mutator.
+type ChoiceFunc func(context.Context, *ent.ChoiceMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ChoiceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ChoiceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ChoiceMutation", m)
+}
+
+// The DocumentFunc type is an adapter to allow the use of ordinary
+// function as Document mutator.
+type DocumentFunc func(context.Context, *ent.DocumentMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DocumentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DocumentMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DocumentMutation", m)
+}
+
+// The FolderFunc type is an adapter to allow the use of ordinary
+// function as Folder mutator.
+type FolderFunc func(context.Context, *ent.FolderMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f FolderFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.FolderMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.FolderMutation", m)
+}
+
+// The GroupInviteFunc type is an adapter to allow the use of ordinary
+// function as GroupInvite mutator.
+type GroupInviteFunc func(context.Context, *ent.GroupInviteMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f GroupInviteFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.GroupInviteMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.GroupInviteMutation", m)
+}
+
+// The GroupJoinRequestFunc type is an adapter to allow the use of ordinary
+// function as GroupJoinRequest mutator.
+type GroupJoinRequestFunc func(context.Context, *ent.GroupJoinRequestMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f GroupJoinRequestFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.GroupJoinRequestMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.GroupJoinRequestMutation", m)
+}
+
+// The GroupMembershipFunc type is an adapter to allow the use of ordinary
+// function as GroupMembership mutator.
+type GroupMembershipFunc func(context.Context, *ent.GroupMembershipMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f GroupMembershipFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.GroupMembershipMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.GroupMembershipMutation", m)
+}
+
 // The NoteFunc type is an adapter to allow the use of ordinary
 // function as Note mutator.
 type NoteFunc func(context.Context, *ent.NoteMutation) (ent.Value, error)
@@ -33,6 +129,30 @@ func (f NoteLikeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.NoteLikeMutation", m)
 }
 
+// The NoteLinkFunc type is an adapter to allow the use of ordinary
+// function as NoteLink mutator.
+type NoteLinkFunc func(context.Context, *ent.NoteLinkMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f NoteLinkFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.NoteLinkMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.NoteLinkMutation", m)
+}
+
+// The NoteProposalFunc type is an adapter to allow the use of ordinary
+// function as NoteProposal mutator.
+type NoteProposalFunc func(context.Context, *ent.NoteProposalMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f NoteProposalFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.NoteProposalMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.NoteProposalMutation", m)
+}
+
 // The NoteRepostFunc type is an adapter to allow the use of ordinary
 // function as NoteRepost mutator.
 type NoteRepostFunc func(context.Context, *ent.NoteRepostMutation) (ent.Value, error)
@@ -45,6 +165,30 @@ func (f NoteRepostFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.NoteRepostMutation", m)
 }
 
+// The NoteRevisionFunc type is an adapter to allow the use of ordinary
+// function as NoteRevision mutator.
+type NoteRevisionFunc func(context.Context, *ent.NoteRevisionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f NoteRevisionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.NoteRevisionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.NoteRevisionMutation", m)
+}
+
+// The NotebookFunc type is an adapter to allow the use of ordinary
+// function as Notebook mutator.
+type NotebookFunc func(context.Context, *ent.NotebookMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f NotebookFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.NotebookMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.NotebookMutation", m)
+}
+
 // The PasswordTokenFunc type is an adapter to allow the use of ordinary
 // function as PasswordToken mutator.
 type PasswordTokenFunc func(context.Context, *ent.PasswordTokenMutation) (ent.Value, error)
@@ -57,6 +201,90 @@ func (f PasswordTokenFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Valu
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PasswordTokenMutation", m)
 }
 
+// The QuestionFunc type is an adapter to allow the use of ordinary
+// function as Question mutator.
+type QuestionFunc func(context.Context, *ent.QuestionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f QuestionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.QuestionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.QuestionMutation", m)
+}
+
+// The QuizFunc type is an adapter to allow the use of ordinary
+// function as Quiz mutator.
+type QuizFunc func(context.Context, *ent.QuizMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f QuizFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.QuizMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.QuizMutation", m)
+}
+
+// The QuizAttemptFunc type is an adapter to allow the use of ordinary
+// function as QuizAttempt mutator.
+type QuizAttemptFunc func(context.Context, *ent.QuizAttemptMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f QuizAttemptFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.QuizAttemptMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.QuizAttemptMutation", m)
+}
+
+// The ResumableUploadFunc type is an adapter to allow the use of ordinary
+// function as ResumableUpload mutator.
+type ResumableUploadFunc func(context.Context, *ent.ResumableUploadMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ResumableUploadFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ResumableUploadMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ResumableUploadMutation", m)
+}
+
+// The StudyEventFunc type is an adapter to allow the use of ordinary
+// function as StudyEvent mutator.
+type StudyEventFunc func(context.Context, *ent.StudyEventMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f StudyEventFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.StudyEventMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.StudyEventMutation", m)
+}
+
+// The StudyGroupFunc type is an adapter to allow the use of ordinary
+// function as StudyGroup mutator.
+type StudyGroupFunc func(context.Context, *ent.StudyGroupMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f StudyGroupFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.StudyGroupMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.StudyGroupMutation", m)
+}
+
+// The TagFunc type is an adapter to allow the use of ordinary
+// function as Tag mutator.
+type TagFunc func(context.Context, *ent.TagMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TagFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TagMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TagMutation", m)
+}
+
 // The UserFunc type is an adapter to allow the use of ordinary
 // function as User mutator.
 type UserFunc func(context.Context, *ent.UserMutation) (ent.Value, error)
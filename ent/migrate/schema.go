@@ -3,11 +3,268 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
 
 var (
+	// BlobsColumns holds the columns for the "blobs" table.
+	BlobsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "hash", Type: field.TypeString, Unique: true},
+		{Name: "storage_key", Type: field.TypeString, Unique: true},
+		{Name: "mime_type", Type: field.TypeString, Nullable: true},
+		{Name: "size", Type: field.TypeInt64, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// BlobsTable holds the schema information for the "blobs" table.
+	BlobsTable = &schema.Table{
+		Name:       "blobs",
+		Columns:    BlobsColumns,
+		PrimaryKey: []*schema.Column{BlobsColumns[0]},
+	}
+	// BlobReferencesColumns holds the columns for the "blob_references" table.
+	BlobReferencesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"note", "document", "profile"}},
+		{Name: "target_id", Type: field.TypeInt},
+		{Name: "size", Type: field.TypeInt64, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "blob_references", Type: field.TypeInt},
+		{Name: "user_blob_references", Type: field.TypeInt},
+	}
+	// BlobReferencesTable holds the schema information for the "blob_references" table.
+	BlobReferencesTable = &schema.Table{
+		Name:       "blob_references",
+		Columns:    BlobReferencesColumns,
+		PrimaryKey: []*schema.Column{BlobReferencesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "blob_references_blobs_references",
+				Columns:    []*schema.Column{BlobReferencesColumns[5]},
+				RefColumns: []*schema.Column{BlobsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "blob_references_users_blob_references",
+				Columns:    []*schema.Column{BlobReferencesColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "blobreference_kind_target_id",
+				Unique:  false,
+				Columns: []*schema.Column{BlobReferencesColumns[1], BlobReferencesColumns[2]},
+			},
+		},
+	}
+	// ChoicesColumns holds the columns for the "choices" table.
+	ChoicesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "text", Type: field.TypeString},
+		{Name: "correct", Type: field.TypeBool, Default: false},
+		{Name: "position", Type: field.TypeInt, Default: 0},
+		{Name: "question_choices", Type: field.TypeInt},
+	}
+	// ChoicesTable holds the schema information for the "choices" table.
+	ChoicesTable = &schema.Table{
+		Name:       "choices",
+		Columns:    ChoicesColumns,
+		PrimaryKey: []*schema.Column{ChoicesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "choices_questions_choices",
+				Columns:    []*schema.Column{ChoicesColumns[4]},
+				RefColumns: []*schema.Column{QuestionsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// DocumentsColumns holds the columns for the "documents" table.
+	DocumentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "title", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "file_name", Type: field.TypeString},
+		{Name: "storage_key", Type: field.TypeString},
+		{Name: "mime_type", Type: field.TypeString, Nullable: true},
+		{Name: "size", Type: field.TypeInt64, Default: 0},
+		{Name: "resource_type", Type: field.TypeString, Default: "file"},
+		{Name: "tags", Type: field.TypeJSON, Nullable: true},
+		{Name: "visibility", Type: field.TypeEnum, Enums: []string{"private", "public"}, Default: "private"},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "folder_documents", Type: field.TypeInt, Nullable: true},
+		{Name: "user_documents", Type: field.TypeInt},
+	}
+	// DocumentsTable holds the schema information for the "documents" table.
+	DocumentsTable = &schema.Table{
+		Name:       "documents",
+		Columns:    DocumentsColumns,
+		PrimaryKey: []*schema.Column{DocumentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "documents_folders_documents",
+				Columns:    []*schema.Column{DocumentsColumns[12]},
+				RefColumns: []*schema.Column{FoldersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "documents_users_documents",
+				Columns:    []*schema.Column{DocumentsColumns[13]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "document_created_at_user_documents",
+				Unique:  false,
+				Columns: []*schema.Column{DocumentsColumns[10], DocumentsColumns[13]},
+			},
+		},
+	}
+	// FoldersColumns holds the columns for the "folders" table.
+	FoldersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Size: 100},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "folder_children", Type: field.TypeInt, Nullable: true},
+		{Name: "user_folders", Type: field.TypeInt},
+	}
+	// FoldersTable holds the schema information for the "folders" table.
+	FoldersTable = &schema.Table{
+		Name:       "folders",
+		Columns:    FoldersColumns,
+		PrimaryKey: []*schema.Column{FoldersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "folders_folders_children",
+				Columns:    []*schema.Column{FoldersColumns[3]},
+				RefColumns: []*schema.Column{FoldersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "folders_users_folders",
+				Columns:    []*schema.Column{FoldersColumns[4]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// GroupInvitesColumns holds the columns for the "group_invites" table.
+	GroupInvitesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "token", Type: field.TypeString, Unique: true},
+		{Name: "phone_number", Type: field.TypeString, Nullable: true},
+		{Name: "max_uses", Type: field.TypeInt, Default: 0},
+		{Name: "uses", Type: field.TypeInt, Default: 0},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "study_group_invites", Type: field.TypeInt},
+		{Name: "user_group_invites", Type: field.TypeInt},
+	}
+	// GroupInvitesTable holds the schema information for the "group_invites" table.
+	GroupInvitesTable = &schema.Table{
+		Name:       "group_invites",
+		Columns:    GroupInvitesColumns,
+		PrimaryKey: []*schema.Column{GroupInvitesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "group_invites_study_groups_invites",
+				Columns:    []*schema.Column{GroupInvitesColumns[8]},
+				RefColumns: []*schema.Column{StudyGroupsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "group_invites_users_group_invites",
+				Columns:    []*schema.Column{GroupInvitesColumns[9]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "groupinvite_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{GroupInvitesColumns[5]},
+			},
+		},
+	}
+	// GroupJoinRequestsColumns holds the columns for the "group_join_requests" table.
+	GroupJoinRequestsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "message", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "study_group_join_requests", Type: field.TypeInt},
+		{Name: "user_group_join_requests", Type: field.TypeInt},
+	}
+	// GroupJoinRequestsTable holds the schema information for the "group_join_requests" table.
+	GroupJoinRequestsTable = &schema.Table{
+		Name:       "group_join_requests",
+		Columns:    GroupJoinRequestsColumns,
+		PrimaryKey: []*schema.Column{GroupJoinRequestsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "group_join_requests_study_groups_join_requests",
+				Columns:    []*schema.Column{GroupJoinRequestsColumns[3]},
+				RefColumns: []*schema.Column{StudyGroupsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "group_join_requests_users_group_join_requests",
+				Columns:    []*schema.Column{GroupJoinRequestsColumns[4]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "groupjoinrequest_user_group_join_requests_study_group_join_requests",
+				Unique:  true,
+				Columns: []*schema.Column{GroupJoinRequestsColumns[4], GroupJoinRequestsColumns[3]},
+			},
+		},
+	}
+	// GroupMembershipsColumns holds the columns for the "group_memberships" table.
+	GroupMembershipsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"owner", "moderator", "member"}, Default: "member"},
+		{Name: "joined_at", Type: field.TypeTime},
+		{Name: "study_group_memberships", Type: field.TypeInt},
+		{Name: "user_group_memberships", Type: field.TypeInt},
+	}
+	// GroupMembershipsTable holds the schema information for the "group_memberships" table.
+	GroupMembershipsTable = &schema.Table{
+		Name:       "group_memberships",
+		Columns:    GroupMembershipsColumns,
+		PrimaryKey: []*schema.Column{GroupMembershipsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "group_memberships_study_groups_memberships",
+				Columns:    []*schema.Column{GroupMembershipsColumns[3]},
+				RefColumns: []*schema.Column{StudyGroupsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "group_memberships_users_group_memberships",
+				Columns:    []*schema.Column{GroupMembershipsColumns[4]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "groupmembership_user_group_memberships_study_group_memberships",
+				Unique:  true,
+				Columns: []*schema.Column{GroupMembershipsColumns[4], GroupMembershipsColumns[3]},
+			},
+		},
+	}
 	// NotesColumns holds the columns for the "notes" table.
 	NotesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -84,6 +341,82 @@ var (
 			},
 		},
 	}
+	// NoteLinksColumns holds the columns for the "note_links" table.
+	NoteLinksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "target", Type: field.TypeString},
+		{Name: "note_links", Type: field.TypeInt},
+		{Name: "note_backlinks", Type: field.TypeInt, Nullable: true},
+	}
+	// NoteLinksTable holds the schema information for the "note_links" table.
+	NoteLinksTable = &schema.Table{
+		Name:       "note_links",
+		Columns:    NoteLinksColumns,
+		PrimaryKey: []*schema.Column{NoteLinksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "note_links_notes_links",
+				Columns:    []*schema.Column{NoteLinksColumns[2]},
+				RefColumns: []*schema.Column{NotesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "note_links_notes_backlinks",
+				Columns:    []*schema.Column{NoteLinksColumns[3]},
+				RefColumns: []*schema.Column{NotesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "notelink_target",
+				Unique:  false,
+				Columns: []*schema.Column{NoteLinksColumns[1]},
+			},
+		},
+	}
+	// NoteProposalsColumns holds the columns for the "note_proposals" table.
+	NoteProposalsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "title", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "content", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "base_title", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "base_description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "base_content", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "accepted", "rejected"}, Default: "pending"},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "reviewed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "note_proposals", Type: field.TypeInt},
+		{Name: "user_note_proposals", Type: field.TypeInt},
+	}
+	// NoteProposalsTable holds the schema information for the "note_proposals" table.
+	NoteProposalsTable = &schema.Table{
+		Name:       "note_proposals",
+		Columns:    NoteProposalsColumns,
+		PrimaryKey: []*schema.Column{NoteProposalsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "note_proposals_notes_proposals",
+				Columns:    []*schema.Column{NoteProposalsColumns[10]},
+				RefColumns: []*schema.Column{NotesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "note_proposals_users_note_proposals",
+				Columns:    []*schema.Column{NoteProposalsColumns[11]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "noteproposal_status_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{NoteProposalsColumns[7], NoteProposalsColumns[8]},
+			},
+		},
+	}
 	// NoteRepostsColumns holds the columns for the "note_reposts" table.
 	NoteRepostsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -119,6 +452,74 @@ var (
 			},
 		},
 	}
+	// NoteRevisionsColumns holds the columns for the "note_revisions" table.
+	NoteRevisionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "title", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "content", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "resources", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "note_revisions", Type: field.TypeInt},
+		{Name: "user_note_revisions", Type: field.TypeInt, Nullable: true},
+	}
+	// NoteRevisionsTable holds the schema information for the "note_revisions" table.
+	NoteRevisionsTable = &schema.Table{
+		Name:       "note_revisions",
+		Columns:    NoteRevisionsColumns,
+		PrimaryKey: []*schema.Column{NoteRevisionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "note_revisions_notes_revisions",
+				Columns:    []*schema.Column{NoteRevisionsColumns[6]},
+				RefColumns: []*schema.Column{NotesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "note_revisions_users_note_revisions",
+				Columns:    []*schema.Column{NoteRevisionsColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "noterevision_created_at_note_revisions",
+				Unique:  false,
+				Columns: []*schema.Column{NoteRevisionsColumns[5], NoteRevisionsColumns[6]},
+			},
+		},
+	}
+	// NotebooksColumns holds the columns for the "notebooks" table.
+	NotebooksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Size: 100},
+		{Name: "default_visibility", Type: field.TypeEnum, Enums: []string{"private", "public"}, Default: "private"},
+		{Name: "default_permission_level", Type: field.TypeEnum, Enums: []string{"read_only", "read_write", "read_write_approval"}, Default: "read_only"},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "notebook_children", Type: field.TypeInt, Nullable: true},
+		{Name: "user_notebooks", Type: field.TypeInt},
+	}
+	// NotebooksTable holds the schema information for the "notebooks" table.
+	NotebooksTable = &schema.Table{
+		Name:       "notebooks",
+		Columns:    NotebooksColumns,
+		PrimaryKey: []*schema.Column{NotebooksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "notebooks_notebooks_children",
+				Columns:    []*schema.Column{NotebooksColumns[5]},
+				RefColumns: []*schema.Column{NotebooksColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "notebooks_users_notebooks",
+				Columns:    []*schema.Column{NotebooksColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
 	// PasswordTokensColumns holds the columns for the "password_tokens" table.
 	PasswordTokensColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -140,6 +541,256 @@ var (
 			},
 		},
 	}
+	// QuestionsColumns holds the columns for the "questions" table.
+	QuestionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"multiple_choice", "true_false", "short_answer"}},
+		{Name: "prompt", Type: field.TypeString, Size: 2147483647},
+		{Name: "accepted_answers", Type: field.TypeJSON, Nullable: true},
+		{Name: "explanation", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "points", Type: field.TypeInt, Default: 1},
+		{Name: "position", Type: field.TypeInt, Default: 0},
+		{Name: "quiz_questions", Type: field.TypeInt},
+	}
+	// QuestionsTable holds the schema information for the "questions" table.
+	QuestionsTable = &schema.Table{
+		Name:       "questions",
+		Columns:    QuestionsColumns,
+		PrimaryKey: []*schema.Column{QuestionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "questions_quizs_questions",
+				Columns:    []*schema.Column{QuestionsColumns[7]},
+				RefColumns: []*schema.Column{QuizsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// QuizsColumns holds the columns for the "quizs" table.
+	QuizsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "title", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "visibility", Type: field.TypeEnum, Enums: []string{"private", "public"}, Default: "private"},
+		{Name: "time_limit", Type: field.TypeInt, Default: 0},
+		{Name: "max_attempts", Type: field.TypeInt, Default: 0},
+		{Name: "generator", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "note_practice_sets", Type: field.TypeInt, Nullable: true},
+		{Name: "user_quizzes", Type: field.TypeInt},
+	}
+	// QuizsTable holds the schema information for the "quizs" table.
+	QuizsTable = &schema.Table{
+		Name:       "quizs",
+		Columns:    QuizsColumns,
+		PrimaryKey: []*schema.Column{QuizsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "quizs_notes_practice_sets",
+				Columns:    []*schema.Column{QuizsColumns[9]},
+				RefColumns: []*schema.Column{NotesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "quizs_users_quizzes",
+				Columns:    []*schema.Column{QuizsColumns[10]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "quiz_visibility_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{QuizsColumns[3], QuizsColumns[7]},
+			},
+		},
+	}
+	// QuizAttemptsColumns holds the columns for the "quiz_attempts" table.
+	QuizAttemptsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "answers", Type: field.TypeJSON, Nullable: true},
+		{Name: "score", Type: field.TypeInt, Default: 0},
+		{Name: "max_score", Type: field.TypeInt, Default: 0},
+		{Name: "started_at", Type: field.TypeTime},
+		{Name: "deadline", Type: field.TypeTime, Nullable: true},
+		{Name: "submitted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"in_progress", "submitted", "expired"}, Default: "in_progress"},
+		{Name: "quiz_attempts", Type: field.TypeInt},
+		{Name: "user_quiz_attempts", Type: field.TypeInt},
+	}
+	// QuizAttemptsTable holds the schema information for the "quiz_attempts" table.
+	QuizAttemptsTable = &schema.Table{
+		Name:       "quiz_attempts",
+		Columns:    QuizAttemptsColumns,
+		PrimaryKey: []*schema.Column{QuizAttemptsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "quiz_attempts_quizs_attempts",
+				Columns:    []*schema.Column{QuizAttemptsColumns[8]},
+				RefColumns: []*schema.Column{QuizsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "quiz_attempts_users_quiz_attempts",
+				Columns:    []*schema.Column{QuizAttemptsColumns[9]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "quizattempt_started_at",
+				Unique:  false,
+				Columns: []*schema.Column{QuizAttemptsColumns[4]},
+			},
+			{
+				Name:    "quizattempt_status_deadline",
+				Unique:  false,
+				Columns: []*schema.Column{QuizAttemptsColumns[7], QuizAttemptsColumns[5]},
+			},
+			{
+				Name:    "quizattempt_quiz_attempts_user_quiz_attempts",
+				Unique:  true,
+				Columns: []*schema.Column{QuizAttemptsColumns[8], QuizAttemptsColumns[9]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "status = 'in_progress'",
+				},
+			},
+		},
+	}
+	// ResumableUploadsColumns holds the columns for the "resumable_uploads" table.
+	ResumableUploadsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "token", Type: field.TypeString, Unique: true},
+		{Name: "note_id", Type: field.TypeInt},
+		{Name: "file_name", Type: field.TypeString},
+		{Name: "mime_type", Type: field.TypeString, Nullable: true},
+		{Name: "size", Type: field.TypeInt64},
+		{Name: "offset", Type: field.TypeInt64, Default: 0},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_resumable_uploads", Type: field.TypeInt},
+	}
+	// ResumableUploadsTable holds the schema information for the "resumable_uploads" table.
+	ResumableUploadsTable = &schema.Table{
+		Name:       "resumable_uploads",
+		Columns:    ResumableUploadsColumns,
+		PrimaryKey: []*schema.Column{ResumableUploadsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "resumable_uploads_users_resumable_uploads",
+				Columns:    []*schema.Column{ResumableUploadsColumns[10]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// StudyEventsColumns holds the columns for the "study_events" table.
+	StudyEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"note_viewed", "quiz_attempted"}},
+		{Name: "topic", Type: field.TypeString},
+		{Name: "score", Type: field.TypeInt, Nullable: true},
+		{Name: "max_score", Type: field.TypeInt, Nullable: true},
+		{Name: "duration_seconds", Type: field.TypeInt, Default: 0},
+		{Name: "occurred_at", Type: field.TypeTime},
+		{Name: "note_study_events", Type: field.TypeInt, Nullable: true},
+		{Name: "quiz_study_events", Type: field.TypeInt, Nullable: true},
+		{Name: "quiz_attempt_study_event", Type: field.TypeInt, Unique: true, Nullable: true},
+		{Name: "user_study_events", Type: field.TypeInt},
+	}
+	// StudyEventsTable holds the schema information for the "study_events" table.
+	StudyEventsTable = &schema.Table{
+		Name:       "study_events",
+		Columns:    StudyEventsColumns,
+		PrimaryKey: []*schema.Column{StudyEventsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "study_events_notes_study_events",
+				Columns:    []*schema.Column{StudyEventsColumns[7]},
+				RefColumns: []*schema.Column{NotesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "study_events_quizs_study_events",
+				Columns:    []*schema.Column{StudyEventsColumns[8]},
+				RefColumns: []*schema.Column{QuizsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "study_events_quiz_attempts_study_event",
+				Columns:    []*schema.Column{StudyEventsColumns[9]},
+				RefColumns: []*schema.Column{QuizAttemptsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "study_events_users_study_events",
+				Columns:    []*schema.Column{StudyEventsColumns[10]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "studyevent_occurred_at_user_study_events",
+				Unique:  false,
+				Columns: []*schema.Column{StudyEventsColumns[6], StudyEventsColumns[10]},
+			},
+		},
+	}
+	// StudyGroupsColumns holds the columns for the "study_groups" table.
+	StudyGroupsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "privacy", Type: field.TypeEnum, Enums: []string{"public", "invite_only"}, Default: "public"},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// StudyGroupsTable holds the schema information for the "study_groups" table.
+	StudyGroupsTable = &schema.Table{
+		Name:       "study_groups",
+		Columns:    StudyGroupsColumns,
+		PrimaryKey: []*schema.Column{StudyGroupsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "studygroup_privacy_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{StudyGroupsColumns[3], StudyGroupsColumns[4]},
+			},
+		},
+	}
+	// TagsColumns holds the columns for the "tags" table.
+	TagsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Size: 50},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_tags", Type: field.TypeInt},
+	}
+	// TagsTable holds the schema information for the "tags" table.
+	TagsTable = &schema.Table{
+		Name:       "tags",
+		Columns:    TagsColumns,
+		PrimaryKey: []*schema.Column{TagsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "tags_users_tags",
+				Columns:    []*schema.Column{TagsColumns[3]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "tag_name_user_tags",
+				Unique:  true,
+				Columns: []*schema.Column{TagsColumns[1], TagsColumns[3]},
+			},
+		},
+	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -158,6 +809,8 @@ var (
 		{Name: "sms_notifications", Type: field.TypeBool, Default: true},
 		{Name: "is_active", Type: field.TypeBool, Default: true},
 		{Name: "last_login", Type: field.TypeTime, Nullable: true},
+		{Name: "storage_quota", Type: field.TypeInt64, Nullable: true},
+		{Name: "storage_used", Type: field.TypeInt64, Default: 0},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
 	}
@@ -167,21 +820,184 @@ var (
 		Columns:    UsersColumns,
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
 	}
+	// DocumentNotesColumns holds the columns for the "document_notes" table.
+	DocumentNotesColumns = []*schema.Column{
+		{Name: "document_id", Type: field.TypeInt},
+		{Name: "note_id", Type: field.TypeInt},
+	}
+	// DocumentNotesTable holds the schema information for the "document_notes" table.
+	DocumentNotesTable = &schema.Table{
+		Name:       "document_notes",
+		Columns:    DocumentNotesColumns,
+		PrimaryKey: []*schema.Column{DocumentNotesColumns[0], DocumentNotesColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "document_notes_document_id",
+				Columns:    []*schema.Column{DocumentNotesColumns[0]},
+				RefColumns: []*schema.Column{DocumentsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "document_notes_note_id",
+				Columns:    []*schema.Column{DocumentNotesColumns[1]},
+				RefColumns: []*schema.Column{NotesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// NotebookNotesColumns holds the columns for the "notebook_notes" table.
+	NotebookNotesColumns = []*schema.Column{
+		{Name: "notebook_id", Type: field.TypeInt},
+		{Name: "note_id", Type: field.TypeInt},
+	}
+	// NotebookNotesTable holds the schema information for the "notebook_notes" table.
+	NotebookNotesTable = &schema.Table{
+		Name:       "notebook_notes",
+		Columns:    NotebookNotesColumns,
+		PrimaryKey: []*schema.Column{NotebookNotesColumns[0], NotebookNotesColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "notebook_notes_notebook_id",
+				Columns:    []*schema.Column{NotebookNotesColumns[0]},
+				RefColumns: []*schema.Column{NotebooksColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "notebook_notes_note_id",
+				Columns:    []*schema.Column{NotebookNotesColumns[1]},
+				RefColumns: []*schema.Column{NotesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// StudyGroupSharedNotesColumns holds the columns for the "study_group_shared_notes" table.
+	StudyGroupSharedNotesColumns = []*schema.Column{
+		{Name: "study_group_id", Type: field.TypeInt},
+		{Name: "note_id", Type: field.TypeInt},
+	}
+	// StudyGroupSharedNotesTable holds the schema information for the "study_group_shared_notes" table.
+	StudyGroupSharedNotesTable = &schema.Table{
+		Name:       "study_group_shared_notes",
+		Columns:    StudyGroupSharedNotesColumns,
+		PrimaryKey: []*schema.Column{StudyGroupSharedNotesColumns[0], StudyGroupSharedNotesColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "study_group_shared_notes_study_group_id",
+				Columns:    []*schema.Column{StudyGroupSharedNotesColumns[0]},
+				RefColumns: []*schema.Column{StudyGroupsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "study_group_shared_notes_note_id",
+				Columns:    []*schema.Column{StudyGroupSharedNotesColumns[1]},
+				RefColumns: []*schema.Column{NotesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// TagNotesColumns holds the columns for the "tag_notes" table.
+	TagNotesColumns = []*schema.Column{
+		{Name: "tag_id", Type: field.TypeInt},
+		{Name: "note_id", Type: field.TypeInt},
+	}
+	// TagNotesTable holds the schema information for the "tag_notes" table.
+	TagNotesTable = &schema.Table{
+		Name:       "tag_notes",
+		Columns:    TagNotesColumns,
+		PrimaryKey: []*schema.Column{TagNotesColumns[0], TagNotesColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "tag_notes_tag_id",
+				Columns:    []*schema.Column{TagNotesColumns[0]},
+				RefColumns: []*schema.Column{TagsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "tag_notes_note_id",
+				Columns:    []*schema.Column{TagNotesColumns[1]},
+				RefColumns: []*schema.Column{NotesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		BlobsTable,
+		BlobReferencesTable,
+		ChoicesTable,
+		DocumentsTable,
+		FoldersTable,
+		GroupInvitesTable,
+		GroupJoinRequestsTable,
+		GroupMembershipsTable,
 		NotesTable,
 		NoteLikesTable,
+		NoteLinksTable,
+		NoteProposalsTable,
 		NoteRepostsTable,
+		NoteRevisionsTable,
+		NotebooksTable,
 		PasswordTokensTable,
+		QuestionsTable,
+		QuizsTable,
+		QuizAttemptsTable,
+		ResumableUploadsTable,
+		StudyEventsTable,
+		StudyGroupsTable,
+		TagsTable,
 		UsersTable,
+		DocumentNotesTable,
+		NotebookNotesTable,
+		StudyGroupSharedNotesTable,
+		TagNotesTable,
 	}
 )
 
 func init() {
+	BlobReferencesTable.ForeignKeys[0].RefTable = BlobsTable
+	BlobReferencesTable.ForeignKeys[1].RefTable = UsersTable
+	ChoicesTable.ForeignKeys[0].RefTable = QuestionsTable
+	DocumentsTable.ForeignKeys[0].RefTable = FoldersTable
+	DocumentsTable.ForeignKeys[1].RefTable = UsersTable
+	FoldersTable.ForeignKeys[0].RefTable = FoldersTable
+	FoldersTable.ForeignKeys[1].RefTable = UsersTable
+	GroupInvitesTable.ForeignKeys[0].RefTable = StudyGroupsTable
+	GroupInvitesTable.ForeignKeys[1].RefTable = UsersTable
+	GroupJoinRequestsTable.ForeignKeys[0].RefTable = StudyGroupsTable
+	GroupJoinRequestsTable.ForeignKeys[1].RefTable = UsersTable
+	GroupMembershipsTable.ForeignKeys[0].RefTable = StudyGroupsTable
+	GroupMembershipsTable.ForeignKeys[1].RefTable = UsersTable
 	NotesTable.ForeignKeys[0].RefTable = UsersTable
 	NoteLikesTable.ForeignKeys[0].RefTable = NotesTable
 	NoteLikesTable.ForeignKeys[1].RefTable = UsersTable
+	NoteLinksTable.ForeignKeys[0].RefTable = NotesTable
+	NoteLinksTable.ForeignKeys[1].RefTable = NotesTable
+	NoteProposalsTable.ForeignKeys[0].RefTable = NotesTable
+	NoteProposalsTable.ForeignKeys[1].RefTable = UsersTable
 	NoteRepostsTable.ForeignKeys[0].RefTable = NotesTable
 	NoteRepostsTable.ForeignKeys[1].RefTable = UsersTable
+	NoteRevisionsTable.ForeignKeys[0].RefTable = NotesTable
+	NoteRevisionsTable.ForeignKeys[1].RefTable = UsersTable
+	NotebooksTable.ForeignKeys[0].RefTable = NotebooksTable
+	NotebooksTable.ForeignKeys[1].RefTable = UsersTable
 	PasswordTokensTable.ForeignKeys[0].RefTable = UsersTable
+	QuestionsTable.ForeignKeys[0].RefTable = QuizsTable
+	QuizsTable.ForeignKeys[0].RefTable = NotesTable
+	QuizsTable.ForeignKeys[1].RefTable = UsersTable
+	QuizAttemptsTable.ForeignKeys[0].RefTable = QuizsTable
+	QuizAttemptsTable.ForeignKeys[1].RefTable = UsersTable
+	ResumableUploadsTable.ForeignKeys[0].RefTable = UsersTable
+	StudyEventsTable.ForeignKeys[0].RefTable = NotesTable
+	StudyEventsTable.ForeignKeys[1].RefTable = QuizsTable
+	StudyEventsTable.ForeignKeys[2].RefTable = QuizAttemptsTable
+	StudyEventsTable.ForeignKeys[3].RefTable = UsersTable
+	TagsTable.ForeignKeys[0].RefTable = UsersTable
+	DocumentNotesTable.ForeignKeys[0].RefTable = DocumentsTable
+	DocumentNotesTable.ForeignKeys[1].RefTable = NotesTable
+	NotebookNotesTable.ForeignKeys[0].RefTable = NotebooksTable
+	NotebookNotesTable.ForeignKeys[1].RefTable = NotesTable
+	StudyGroupSharedNotesTable.ForeignKeys[0].RefTable = StudyGroupsTable
+	StudyGroupSharedNotesTable.ForeignKeys[1].RefTable = NotesTable
+	TagNotesTable.ForeignKeys[0].RefTable = TagsTable
+	TagNotesTable.ForeignKeys[1].RefTable = NotesTable
 }
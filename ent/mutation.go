@@ -11,11 +11,30 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/r-scheele/zero/ent/blob"
+	"github.com/r-scheele/zero/ent/blobreference"
+	"github.com/r-scheele/zero/ent/choice"
+	"github.com/r-scheele/zero/ent/document"
+	"github.com/r-scheele/zero/ent/folder"
+	"github.com/r-scheele/zero/ent/groupinvite"
+	"github.com/r-scheele/zero/ent/groupjoinrequest"
+	"github.com/r-scheele/zero/ent/groupmembership"
 	"github.com/r-scheele/zero/ent/note"
+	"github.com/r-scheele/zero/ent/notebook"
 	"github.com/r-scheele/zero/ent/notelike"
+	"github.com/r-scheele/zero/ent/notelink"
+	"github.com/r-scheele/zero/ent/noteproposal"
 	"github.com/r-scheele/zero/ent/noterepost"
+	"github.com/r-scheele/zero/ent/noterevision"
 	"github.com/r-scheele/zero/ent/passwordtoken"
 	"github.com/r-scheele/zero/ent/predicate"
+	"github.com/r-scheele/zero/ent/question"
+	"github.com/r-scheele/zero/ent/quiz"
+	"github.com/r-scheele/zero/ent/quizattempt"
+	"github.com/r-scheele/zero/ent/resumableupload"
+	"github.com/r-scheele/zero/ent/studyevent"
+	"github.com/r-scheele/zero/ent/studygroup"
+	"github.com/r-scheele/zero/ent/tag"
 	"github.com/r-scheele/zero/ent/user"
 	"github.com/r-scheele/zero/pkg/types"
 )
@@ -29,56 +48,64 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeNote          = "Note"
-	TypeNoteLike      = "NoteLike"
-	TypeNoteRepost    = "NoteRepost"
-	TypePasswordToken = "PasswordToken"
-	TypeUser          = "User"
+	TypeBlob             = "Blob"
+	TypeBlobReference    = "BlobReference"
+	TypeChoice           = "Choice"
+	TypeDocument         = "Document"
+	TypeFolder           = "Folder"
+	TypeGroupInvite      = "GroupInvite"
+	TypeGroupJoinRequest = "GroupJoinRequest"
+	TypeGroupMembership  = "GroupMembership"
+	TypeNote             = "Note"
+	TypeNoteLike         = "NoteLike"
+	TypeNoteLink         = "NoteLink"
+	TypeNoteProposal     = "NoteProposal"
+	TypeNoteRepost       = "NoteRepost"
+	TypeNoteRevision     = "NoteRevision"
+	TypeNotebook         = "Notebook"
+	TypePasswordToken    = "PasswordToken"
+	TypeQuestion         = "Question"
+	TypeQuiz             = "Quiz"
+	TypeQuizAttempt      = "QuizAttempt"
+	TypeResumableUpload  = "ResumableUpload"
+	TypeStudyEvent       = "StudyEvent"
+	TypeStudyGroup       = "StudyGroup"
+	TypeTag              = "Tag"
+	TypeUser             = "User"
 )
 
-// NoteMutation represents an operation that mutates the Note nodes in the graph.
-type NoteMutation struct {
+// BlobMutation represents an operation that mutates the Blob nodes in the graph.
+type BlobMutation struct {
 	config
-	op               Op
-	typ              string
-	id               *int
-	title            *string
-	description      *string
-	content          *string
-	resources        *[]types.Resource
-	appendresources  []types.Resource
-	ai_curriculum    *string
-	visibility       *note.Visibility
-	permission_level *note.PermissionLevel
-	share_token      *string
-	ai_processing    *bool
-	created_at       *time.Time
-	updated_at       *time.Time
-	clearedFields    map[string]struct{}
-	owner            *int
-	clearedowner     bool
-	likes            map[int]struct{}
-	removedlikes     map[int]struct{}
-	clearedlikes     bool
-	reposts          map[int]struct{}
-	removedreposts   map[int]struct{}
-	clearedreposts   bool
-	done             bool
-	oldValue         func(context.Context) (*Note, error)
-	predicates       []predicate.Note
-}
-
-var _ ent.Mutation = (*NoteMutation)(nil)
-
-// noteOption allows management of the mutation configuration using functional options.
-type noteOption func(*NoteMutation)
-
-// newNoteMutation creates new mutation for the Note entity.
-func newNoteMutation(c config, op Op, opts ...noteOption) *NoteMutation {
-	m := &NoteMutation{
+	op                Op
+	typ               string
+	id                *int
+	hash              *string
+	storage_key       *string
+	mime_type         *string
+	size              *int64
+	addsize           *int64
+	created_at        *time.Time
+	clearedFields     map[string]struct{}
+	references        map[int]struct{}
+	removedreferences map[int]struct{}
+	clearedreferences bool
+	done              bool
+	oldValue          func(context.Context) (*Blob, error)
+	predicates        []predicate.Blob
+}
+
+var _ ent.Mutation = (*BlobMutation)(nil)
+
+// blobOption allows management of the mutation configuration using functional options.
+type blobOption func(*BlobMutation)
+
+// newBlobMutation creates new mutation for the Blob entity.
+func newBlobMutation(c config, op Op, opts ...blobOption) *BlobMutation {
+	m := &BlobMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeNote,
+		typ:           TypeBlob,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -87,20 +114,20 @@ func newNoteMutation(c config, op Op, opts ...noteOption) *NoteMutation {
 	return m
 }
 
-// withNoteID sets the ID field of the mutation.
-func withNoteID(id int) noteOption {
-	return func(m *NoteMutation) {
+// withBlobID sets the ID field of the mutation.
+func withBlobID(id int) blobOption {
+	return func(m *BlobMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Note
+			value *Blob
 		)
-		m.oldValue = func(ctx context.Context) (*Note, error) {
+		m.oldValue = func(ctx context.Context) (*Blob, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Note.Get(ctx, id)
+					value, err = m.Client().Blob.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -109,10 +136,10 @@ func withNoteID(id int) noteOption {
 	}
 }
 
-// withNote sets the old Note of the mutation.
-func withNote(node *Note) noteOption {
-	return func(m *NoteMutation) {
-		m.oldValue = func(context.Context) (*Note, error) {
+// withBlob sets the old Blob of the mutation.
+func withBlob(node *Blob) blobOption {
+	return func(m *BlobMutation) {
+		m.oldValue = func(context.Context) (*Blob, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -121,7 +148,7 @@ func withNote(node *Note) noteOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m NoteMutation) Client() *Client {
+func (m BlobMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -129,7 +156,7 @@ func (m NoteMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m NoteMutation) Tx() (*Tx, error) {
+func (m BlobMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -140,7 +167,7 @@ func (m NoteMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *NoteMutation) ID() (id int, exists bool) {
+func (m *BlobMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -151,7 +178,7 @@ func (m *NoteMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *NoteMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *BlobMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -160,703 +187,1014 @@ func (m *NoteMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Note.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Blob.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetTitle sets the "title" field.
-func (m *NoteMutation) SetTitle(s string) {
-	m.title = &s
+// SetHash sets the "hash" field.
+func (m *BlobMutation) SetHash(s string) {
+	m.hash = &s
 }
 
-// Title returns the value of the "title" field in the mutation.
-func (m *NoteMutation) Title() (r string, exists bool) {
-	v := m.title
+// Hash returns the value of the "hash" field in the mutation.
+func (m *BlobMutation) Hash() (r string, exists bool) {
+	v := m.hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTitle returns the old "title" field's value of the Note entity.
-// If the Note object wasn't provided to the builder, the object is fetched from the database.
+// OldHash returns the old "hash" field's value of the Blob entity.
+// If the Blob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NoteMutation) OldTitle(ctx context.Context) (v string, err error) {
+func (m *BlobMutation) OldHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
+		return v, errors.New("OldHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldHash: %w", err)
 	}
-	return oldValue.Title, nil
+	return oldValue.Hash, nil
 }
 
-// ResetTitle resets all changes to the "title" field.
-func (m *NoteMutation) ResetTitle() {
-	m.title = nil
+// ResetHash resets all changes to the "hash" field.
+func (m *BlobMutation) ResetHash() {
+	m.hash = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *NoteMutation) SetDescription(s string) {
-	m.description = &s
+// SetStorageKey sets the "storage_key" field.
+func (m *BlobMutation) SetStorageKey(s string) {
+	m.storage_key = &s
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *NoteMutation) Description() (r string, exists bool) {
-	v := m.description
+// StorageKey returns the value of the "storage_key" field in the mutation.
+func (m *BlobMutation) StorageKey() (r string, exists bool) {
+	v := m.storage_key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Note entity.
-// If the Note object wasn't provided to the builder, the object is fetched from the database.
+// OldStorageKey returns the old "storage_key" field's value of the Blob entity.
+// If the Blob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NoteMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *BlobMutation) OldStorageKey(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldStorageKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldStorageKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldStorageKey: %w", err)
 	}
-	return oldValue.Description, nil
-}
-
-// ClearDescription clears the value of the "description" field.
-func (m *NoteMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[note.FieldDescription] = struct{}{}
-}
-
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *NoteMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[note.FieldDescription]
-	return ok
+	return oldValue.StorageKey, nil
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *NoteMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, note.FieldDescription)
+// ResetStorageKey resets all changes to the "storage_key" field.
+func (m *BlobMutation) ResetStorageKey() {
+	m.storage_key = nil
 }
 
-// SetContent sets the "content" field.
-func (m *NoteMutation) SetContent(s string) {
-	m.content = &s
+// SetMimeType sets the "mime_type" field.
+func (m *BlobMutation) SetMimeType(s string) {
+	m.mime_type = &s
 }
 
-// Content returns the value of the "content" field in the mutation.
-func (m *NoteMutation) Content() (r string, exists bool) {
-	v := m.content
+// MimeType returns the value of the "mime_type" field in the mutation.
+func (m *BlobMutation) MimeType() (r string, exists bool) {
+	v := m.mime_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldContent returns the old "content" field's value of the Note entity.
-// If the Note object wasn't provided to the builder, the object is fetched from the database.
+// OldMimeType returns the old "mime_type" field's value of the Blob entity.
+// If the Blob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NoteMutation) OldContent(ctx context.Context) (v string, err error) {
+func (m *BlobMutation) OldMimeType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+		return v, errors.New("OldMimeType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContent requires an ID field in the mutation")
+		return v, errors.New("OldMimeType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+		return v, fmt.Errorf("querying old value for OldMimeType: %w", err)
 	}
-	return oldValue.Content, nil
+	return oldValue.MimeType, nil
 }
 
-// ClearContent clears the value of the "content" field.
-func (m *NoteMutation) ClearContent() {
-	m.content = nil
-	m.clearedFields[note.FieldContent] = struct{}{}
+// ClearMimeType clears the value of the "mime_type" field.
+func (m *BlobMutation) ClearMimeType() {
+	m.mime_type = nil
+	m.clearedFields[blob.FieldMimeType] = struct{}{}
 }
 
-// ContentCleared returns if the "content" field was cleared in this mutation.
-func (m *NoteMutation) ContentCleared() bool {
-	_, ok := m.clearedFields[note.FieldContent]
+// MimeTypeCleared returns if the "mime_type" field was cleared in this mutation.
+func (m *BlobMutation) MimeTypeCleared() bool {
+	_, ok := m.clearedFields[blob.FieldMimeType]
 	return ok
 }
 
-// ResetContent resets all changes to the "content" field.
-func (m *NoteMutation) ResetContent() {
-	m.content = nil
-	delete(m.clearedFields, note.FieldContent)
+// ResetMimeType resets all changes to the "mime_type" field.
+func (m *BlobMutation) ResetMimeType() {
+	m.mime_type = nil
+	delete(m.clearedFields, blob.FieldMimeType)
 }
 
-// SetResources sets the "resources" field.
-func (m *NoteMutation) SetResources(t []types.Resource) {
-	m.resources = &t
-	m.appendresources = nil
+// SetSize sets the "size" field.
+func (m *BlobMutation) SetSize(i int64) {
+	m.size = &i
+	m.addsize = nil
 }
 
-// Resources returns the value of the "resources" field in the mutation.
-func (m *NoteMutation) Resources() (r []types.Resource, exists bool) {
-	v := m.resources
+// Size returns the value of the "size" field in the mutation.
+func (m *BlobMutation) Size() (r int64, exists bool) {
+	v := m.size
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldResources returns the old "resources" field's value of the Note entity.
-// If the Note object wasn't provided to the builder, the object is fetched from the database.
+// OldSize returns the old "size" field's value of the Blob entity.
+// If the Blob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NoteMutation) OldResources(ctx context.Context) (v []types.Resource, err error) {
+func (m *BlobMutation) OldSize(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldResources is only allowed on UpdateOne operations")
+		return v, errors.New("OldSize is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldResources requires an ID field in the mutation")
+		return v, errors.New("OldSize requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldResources: %w", err)
+		return v, fmt.Errorf("querying old value for OldSize: %w", err)
 	}
-	return oldValue.Resources, nil
-}
-
-// AppendResources adds t to the "resources" field.
-func (m *NoteMutation) AppendResources(t []types.Resource) {
-	m.appendresources = append(m.appendresources, t...)
+	return oldValue.Size, nil
 }
 
-// AppendedResources returns the list of values that were appended to the "resources" field in this mutation.
-func (m *NoteMutation) AppendedResources() ([]types.Resource, bool) {
-	if len(m.appendresources) == 0 {
-		return nil, false
+// AddSize adds i to the "size" field.
+func (m *BlobMutation) AddSize(i int64) {
+	if m.addsize != nil {
+		*m.addsize += i
+	} else {
+		m.addsize = &i
 	}
-	return m.appendresources, true
-}
-
-// ClearResources clears the value of the "resources" field.
-func (m *NoteMutation) ClearResources() {
-	m.resources = nil
-	m.appendresources = nil
-	m.clearedFields[note.FieldResources] = struct{}{}
 }
 
-// ResourcesCleared returns if the "resources" field was cleared in this mutation.
-func (m *NoteMutation) ResourcesCleared() bool {
-	_, ok := m.clearedFields[note.FieldResources]
-	return ok
+// AddedSize returns the value that was added to the "size" field in this mutation.
+func (m *BlobMutation) AddedSize() (r int64, exists bool) {
+	v := m.addsize
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetResources resets all changes to the "resources" field.
-func (m *NoteMutation) ResetResources() {
-	m.resources = nil
-	m.appendresources = nil
-	delete(m.clearedFields, note.FieldResources)
+// ResetSize resets all changes to the "size" field.
+func (m *BlobMutation) ResetSize() {
+	m.size = nil
+	m.addsize = nil
 }
 
-// SetAiCurriculum sets the "ai_curriculum" field.
-func (m *NoteMutation) SetAiCurriculum(s string) {
-	m.ai_curriculum = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *BlobMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// AiCurriculum returns the value of the "ai_curriculum" field in the mutation.
-func (m *NoteMutation) AiCurriculum() (r string, exists bool) {
-	v := m.ai_curriculum
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *BlobMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAiCurriculum returns the old "ai_curriculum" field's value of the Note entity.
-// If the Note object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Blob entity.
+// If the Blob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NoteMutation) OldAiCurriculum(ctx context.Context) (v string, err error) {
+func (m *BlobMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAiCurriculum is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAiCurriculum requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAiCurriculum: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.AiCurriculum, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ClearAiCurriculum clears the value of the "ai_curriculum" field.
-func (m *NoteMutation) ClearAiCurriculum() {
-	m.ai_curriculum = nil
-	m.clearedFields[note.FieldAiCurriculum] = struct{}{}
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *BlobMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// AiCurriculumCleared returns if the "ai_curriculum" field was cleared in this mutation.
-func (m *NoteMutation) AiCurriculumCleared() bool {
-	_, ok := m.clearedFields[note.FieldAiCurriculum]
-	return ok
+// AddReferenceIDs adds the "references" edge to the BlobReference entity by ids.
+func (m *BlobMutation) AddReferenceIDs(ids ...int) {
+	if m.references == nil {
+		m.references = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.references[ids[i]] = struct{}{}
+	}
 }
 
-// ResetAiCurriculum resets all changes to the "ai_curriculum" field.
-func (m *NoteMutation) ResetAiCurriculum() {
-	m.ai_curriculum = nil
-	delete(m.clearedFields, note.FieldAiCurriculum)
+// ClearReferences clears the "references" edge to the BlobReference entity.
+func (m *BlobMutation) ClearReferences() {
+	m.clearedreferences = true
 }
 
-// SetVisibility sets the "visibility" field.
-func (m *NoteMutation) SetVisibility(n note.Visibility) {
-	m.visibility = &n
+// ReferencesCleared reports if the "references" edge to the BlobReference entity was cleared.
+func (m *BlobMutation) ReferencesCleared() bool {
+	return m.clearedreferences
 }
 
-// Visibility returns the value of the "visibility" field in the mutation.
-func (m *NoteMutation) Visibility() (r note.Visibility, exists bool) {
-	v := m.visibility
-	if v == nil {
-		return
+// RemoveReferenceIDs removes the "references" edge to the BlobReference entity by IDs.
+func (m *BlobMutation) RemoveReferenceIDs(ids ...int) {
+	if m.removedreferences == nil {
+		m.removedreferences = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.references, ids[i])
+		m.removedreferences[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldVisibility returns the old "visibility" field's value of the Note entity.
-// If the Note object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NoteMutation) OldVisibility(ctx context.Context) (v note.Visibility, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldVisibility is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldVisibility requires an ID field in the mutation")
+// RemovedReferences returns the removed IDs of the "references" edge to the BlobReference entity.
+func (m *BlobMutation) RemovedReferencesIDs() (ids []int) {
+	for id := range m.removedreferences {
+		ids = append(ids, id)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldVisibility: %w", err)
+	return
+}
+
+// ReferencesIDs returns the "references" edge IDs in the mutation.
+func (m *BlobMutation) ReferencesIDs() (ids []int) {
+	for id := range m.references {
+		ids = append(ids, id)
 	}
-	return oldValue.Visibility, nil
+	return
 }
 
-// ResetVisibility resets all changes to the "visibility" field.
-func (m *NoteMutation) ResetVisibility() {
-	m.visibility = nil
+// ResetReferences resets all changes to the "references" edge.
+func (m *BlobMutation) ResetReferences() {
+	m.references = nil
+	m.clearedreferences = false
+	m.removedreferences = nil
 }
 
-// SetPermissionLevel sets the "permission_level" field.
-func (m *NoteMutation) SetPermissionLevel(nl note.PermissionLevel) {
-	m.permission_level = &nl
+// Where appends a list predicates to the BlobMutation builder.
+func (m *BlobMutation) Where(ps ...predicate.Blob) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// PermissionLevel returns the value of the "permission_level" field in the mutation.
-func (m *NoteMutation) PermissionLevel() (r note.PermissionLevel, exists bool) {
-	v := m.permission_level
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the BlobMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BlobMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Blob, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// OldPermissionLevel returns the old "permission_level" field's value of the Note entity.
-// If the Note object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NoteMutation) OldPermissionLevel(ctx context.Context) (v note.PermissionLevel, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPermissionLevel is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPermissionLevel requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPermissionLevel: %w", err)
-	}
-	return oldValue.PermissionLevel, nil
+// Op returns the operation name.
+func (m *BlobMutation) Op() Op {
+	return m.op
 }
 
-// ResetPermissionLevel resets all changes to the "permission_level" field.
-func (m *NoteMutation) ResetPermissionLevel() {
-	m.permission_level = nil
+// SetOp allows setting the mutation operation.
+func (m *BlobMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// SetShareToken sets the "share_token" field.
-func (m *NoteMutation) SetShareToken(s string) {
-	m.share_token = &s
+// Type returns the node type of this mutation (Blob).
+func (m *BlobMutation) Type() string {
+	return m.typ
 }
 
-// ShareToken returns the value of the "share_token" field in the mutation.
-func (m *NoteMutation) ShareToken() (r string, exists bool) {
-	v := m.share_token
-	if v == nil {
-		return
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BlobMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.hash != nil {
+		fields = append(fields, blob.FieldHash)
 	}
-	return *v, true
-}
-
-// OldShareToken returns the old "share_token" field's value of the Note entity.
-// If the Note object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NoteMutation) OldShareToken(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldShareToken is only allowed on UpdateOne operations")
+	if m.storage_key != nil {
+		fields = append(fields, blob.FieldStorageKey)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldShareToken requires an ID field in the mutation")
+	if m.mime_type != nil {
+		fields = append(fields, blob.FieldMimeType)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldShareToken: %w", err)
+	if m.size != nil {
+		fields = append(fields, blob.FieldSize)
 	}
-	return oldValue.ShareToken, nil
+	if m.created_at != nil {
+		fields = append(fields, blob.FieldCreatedAt)
+	}
+	return fields
 }
 
-// ClearShareToken clears the value of the "share_token" field.
-func (m *NoteMutation) ClearShareToken() {
-	m.share_token = nil
-	m.clearedFields[note.FieldShareToken] = struct{}{}
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BlobMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case blob.FieldHash:
+		return m.Hash()
+	case blob.FieldStorageKey:
+		return m.StorageKey()
+	case blob.FieldMimeType:
+		return m.MimeType()
+	case blob.FieldSize:
+		return m.Size()
+	case blob.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
 }
 
-// ShareTokenCleared returns if the "share_token" field was cleared in this mutation.
-func (m *NoteMutation) ShareTokenCleared() bool {
-	_, ok := m.clearedFields[note.FieldShareToken]
-	return ok
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BlobMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case blob.FieldHash:
+		return m.OldHash(ctx)
+	case blob.FieldStorageKey:
+		return m.OldStorageKey(ctx)
+	case blob.FieldMimeType:
+		return m.OldMimeType(ctx)
+	case blob.FieldSize:
+		return m.OldSize(ctx)
+	case blob.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Blob field %s", name)
 }
 
-// ResetShareToken resets all changes to the "share_token" field.
-func (m *NoteMutation) ResetShareToken() {
-	m.share_token = nil
-	delete(m.clearedFields, note.FieldShareToken)
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BlobMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case blob.FieldHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHash(v)
+		return nil
+	case blob.FieldStorageKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStorageKey(v)
+		return nil
+	case blob.FieldMimeType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMimeType(v)
+		return nil
+	case blob.FieldSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSize(v)
+		return nil
+	case blob.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Blob field %s", name)
 }
 
-// SetAiProcessing sets the "ai_processing" field.
-func (m *NoteMutation) SetAiProcessing(b bool) {
-	m.ai_processing = &b
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BlobMutation) AddedFields() []string {
+	var fields []string
+	if m.addsize != nil {
+		fields = append(fields, blob.FieldSize)
+	}
+	return fields
 }
 
-// AiProcessing returns the value of the "ai_processing" field in the mutation.
-func (m *NoteMutation) AiProcessing() (r bool, exists bool) {
-	v := m.ai_processing
-	if v == nil {
-		return
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BlobMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case blob.FieldSize:
+		return m.AddedSize()
 	}
-	return *v, true
+	return nil, false
 }
 
-// OldAiProcessing returns the old "ai_processing" field's value of the Note entity.
-// If the Note object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NoteMutation) OldAiProcessing(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAiProcessing is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAiProcessing requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAiProcessing: %w", err)
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BlobMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case blob.FieldSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSize(v)
+		return nil
 	}
-	return oldValue.AiProcessing, nil
+	return fmt.Errorf("unknown Blob numeric field %s", name)
 }
 
-// ResetAiProcessing resets all changes to the "ai_processing" field.
-func (m *NoteMutation) ResetAiProcessing() {
-	m.ai_processing = nil
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BlobMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(blob.FieldMimeType) {
+		fields = append(fields, blob.FieldMimeType)
+	}
+	return fields
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *NoteMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BlobMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *NoteMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BlobMutation) ClearField(name string) error {
+	switch name {
+	case blob.FieldMimeType:
+		m.ClearMimeType()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown Blob nullable field %s", name)
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Note entity.
-// If the Note object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NoteMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BlobMutation) ResetField(name string) error {
+	switch name {
+	case blob.FieldHash:
+		m.ResetHash()
+		return nil
+	case blob.FieldStorageKey:
+		m.ResetStorageKey()
+		return nil
+	case blob.FieldMimeType:
+		m.ResetMimeType()
+		return nil
+	case blob.FieldSize:
+		m.ResetSize()
+		return nil
+	case blob.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
 	}
-	return oldValue.CreatedAt, nil
+	return fmt.Errorf("unknown Blob field %s", name)
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *NoteMutation) ResetCreatedAt() {
-	m.created_at = nil
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BlobMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.references != nil {
+		edges = append(edges, blob.EdgeReferences)
+	}
+	return edges
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *NoteMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BlobMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case blob.EdgeReferences:
+		ids := make([]ent.Value, 0, len(m.references))
+		for id := range m.references {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *NoteMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BlobMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedreferences != nil {
+		edges = append(edges, blob.EdgeReferences)
 	}
-	return *v, true
+	return edges
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Note entity.
-// If the Note object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NoteMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BlobMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case blob.EdgeReferences:
+		ids := make([]ent.Value, 0, len(m.removedreferences))
+		for id := range m.removedreferences {
+			ids = append(ids, id)
+		}
+		return ids
 	}
-	return oldValue.UpdatedAt, nil
+	return nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *NoteMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BlobMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedreferences {
+		edges = append(edges, blob.EdgeReferences)
+	}
+	return edges
 }
 
-// SetOwnerID sets the "owner" edge to the User entity by id.
-func (m *NoteMutation) SetOwnerID(id int) {
-	m.owner = &id
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BlobMutation) EdgeCleared(name string) bool {
+	switch name {
+	case blob.EdgeReferences:
+		return m.clearedreferences
+	}
+	return false
 }
 
-// ClearOwner clears the "owner" edge to the User entity.
-func (m *NoteMutation) ClearOwner() {
-	m.clearedowner = true
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BlobMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Blob unique edge %s", name)
 }
 
-// OwnerCleared reports if the "owner" edge to the User entity was cleared.
-func (m *NoteMutation) OwnerCleared() bool {
-	return m.clearedowner
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BlobMutation) ResetEdge(name string) error {
+	switch name {
+	case blob.EdgeReferences:
+		m.ResetReferences()
+		return nil
+	}
+	return fmt.Errorf("unknown Blob edge %s", name)
 }
 
-// OwnerID returns the "owner" edge ID in the mutation.
-func (m *NoteMutation) OwnerID() (id int, exists bool) {
-	if m.owner != nil {
-		return *m.owner, true
-	}
-	return
+// BlobReferenceMutation represents an operation that mutates the BlobReference nodes in the graph.
+type BlobReferenceMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	kind          *blobreference.Kind
+	target_id     *int
+	addtarget_id  *int
+	size          *int64
+	addsize       *int64
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	blob          *int
+	clearedblob   bool
+	owner         *int
+	clearedowner  bool
+	done          bool
+	oldValue      func(context.Context) (*BlobReference, error)
+	predicates    []predicate.BlobReference
 }
 
-// OwnerIDs returns the "owner" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// OwnerID instead. It exists only for internal usage by the builders.
-func (m *NoteMutation) OwnerIDs() (ids []int) {
-	if id := m.owner; id != nil {
-		ids = append(ids, *id)
+var _ ent.Mutation = (*BlobReferenceMutation)(nil)
+
+// blobreferenceOption allows management of the mutation configuration using functional options.
+type blobreferenceOption func(*BlobReferenceMutation)
+
+// newBlobReferenceMutation creates new mutation for the BlobReference entity.
+func newBlobReferenceMutation(c config, op Op, opts ...blobreferenceOption) *BlobReferenceMutation {
+	m := &BlobReferenceMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBlobReference,
+		clearedFields: make(map[string]struct{}),
 	}
-	return
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// ResetOwner resets all changes to the "owner" edge.
-func (m *NoteMutation) ResetOwner() {
-	m.owner = nil
-	m.clearedowner = false
+// withBlobReferenceID sets the ID field of the mutation.
+func withBlobReferenceID(id int) blobreferenceOption {
+	return func(m *BlobReferenceMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *BlobReference
+		)
+		m.oldValue = func(ctx context.Context) (*BlobReference, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().BlobReference.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// AddLikeIDs adds the "likes" edge to the NoteLike entity by ids.
-func (m *NoteMutation) AddLikeIDs(ids ...int) {
-	if m.likes == nil {
-		m.likes = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.likes[ids[i]] = struct{}{}
+// withBlobReference sets the old BlobReference of the mutation.
+func withBlobReference(node *BlobReference) blobreferenceOption {
+	return func(m *BlobReferenceMutation) {
+		m.oldValue = func(context.Context) (*BlobReference, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
 }
 
-// ClearLikes clears the "likes" edge to the NoteLike entity.
-func (m *NoteMutation) ClearLikes() {
-	m.clearedlikes = true
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BlobReferenceMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// LikesCleared reports if the "likes" edge to the NoteLike entity was cleared.
-func (m *NoteMutation) LikesCleared() bool {
-	return m.clearedlikes
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BlobReferenceMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// RemoveLikeIDs removes the "likes" edge to the NoteLike entity by IDs.
-func (m *NoteMutation) RemoveLikeIDs(ids ...int) {
-	if m.removedlikes == nil {
-		m.removedlikes = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.likes, ids[i])
-		m.removedlikes[ids[i]] = struct{}{}
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BlobReferenceMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
 	}
+	return *m.id, true
 }
 
-// RemovedLikes returns the removed IDs of the "likes" edge to the NoteLike entity.
-func (m *NoteMutation) RemovedLikesIDs() (ids []int) {
-	for id := range m.removedlikes {
-		ids = append(ids, id)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BlobReferenceMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().BlobReference.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return
 }
 
-// LikesIDs returns the "likes" edge IDs in the mutation.
-func (m *NoteMutation) LikesIDs() (ids []int) {
-	for id := range m.likes {
-		ids = append(ids, id)
-	}
-	return
+// SetKind sets the "kind" field.
+func (m *BlobReferenceMutation) SetKind(b blobreference.Kind) {
+	m.kind = &b
 }
 
-// ResetLikes resets all changes to the "likes" edge.
-func (m *NoteMutation) ResetLikes() {
-	m.likes = nil
-	m.clearedlikes = false
-	m.removedlikes = nil
+// Kind returns the value of the "kind" field in the mutation.
+func (m *BlobReferenceMutation) Kind() (r blobreference.Kind, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddRepostIDs adds the "reposts" edge to the NoteRepost entity by ids.
-func (m *NoteMutation) AddRepostIDs(ids ...int) {
-	if m.reposts == nil {
-		m.reposts = make(map[int]struct{})
+// OldKind returns the old "kind" field's value of the BlobReference entity.
+// If the BlobReference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlobReferenceMutation) OldKind(ctx context.Context) (v blobreference.Kind, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.reposts[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
 	}
+	return oldValue.Kind, nil
 }
 
-// ClearReposts clears the "reposts" edge to the NoteRepost entity.
-func (m *NoteMutation) ClearReposts() {
-	m.clearedreposts = true
+// ResetKind resets all changes to the "kind" field.
+func (m *BlobReferenceMutation) ResetKind() {
+	m.kind = nil
 }
 
-// RepostsCleared reports if the "reposts" edge to the NoteRepost entity was cleared.
-func (m *NoteMutation) RepostsCleared() bool {
-	return m.clearedreposts
+// SetTargetID sets the "target_id" field.
+func (m *BlobReferenceMutation) SetTargetID(i int) {
+	m.target_id = &i
+	m.addtarget_id = nil
 }
 
-// RemoveRepostIDs removes the "reposts" edge to the NoteRepost entity by IDs.
-func (m *NoteMutation) RemoveRepostIDs(ids ...int) {
-	if m.removedreposts == nil {
-		m.removedreposts = make(map[int]struct{})
+// TargetID returns the value of the "target_id" field in the mutation.
+func (m *BlobReferenceMutation) TargetID() (r int, exists bool) {
+	v := m.target_id
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		delete(m.reposts, ids[i])
-		m.removedreposts[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldTargetID returns the old "target_id" field's value of the BlobReference entity.
+// If the BlobReference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlobReferenceMutation) OldTargetID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetID requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetID: %w", err)
+	}
+	return oldValue.TargetID, nil
 }
 
-// RemovedReposts returns the removed IDs of the "reposts" edge to the NoteRepost entity.
-func (m *NoteMutation) RemovedRepostsIDs() (ids []int) {
-	for id := range m.removedreposts {
-		ids = append(ids, id)
+// AddTargetID adds i to the "target_id" field.
+func (m *BlobReferenceMutation) AddTargetID(i int) {
+	if m.addtarget_id != nil {
+		*m.addtarget_id += i
+	} else {
+		m.addtarget_id = &i
 	}
-	return
 }
 
-// RepostsIDs returns the "reposts" edge IDs in the mutation.
-func (m *NoteMutation) RepostsIDs() (ids []int) {
-	for id := range m.reposts {
-		ids = append(ids, id)
+// AddedTargetID returns the value that was added to the "target_id" field in this mutation.
+func (m *BlobReferenceMutation) AddedTargetID() (r int, exists bool) {
+	v := m.addtarget_id
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetReposts resets all changes to the "reposts" edge.
-func (m *NoteMutation) ResetReposts() {
-	m.reposts = nil
-	m.clearedreposts = false
-	m.removedreposts = nil
+// ResetTargetID resets all changes to the "target_id" field.
+func (m *BlobReferenceMutation) ResetTargetID() {
+	m.target_id = nil
+	m.addtarget_id = nil
 }
 
-// Where appends a list predicates to the NoteMutation builder.
-func (m *NoteMutation) Where(ps ...predicate.Note) {
-	m.predicates = append(m.predicates, ps...)
+// SetSize sets the "size" field.
+func (m *BlobReferenceMutation) SetSize(i int64) {
+	m.size = &i
+	m.addsize = nil
 }
 
-// WhereP appends storage-level predicates to the NoteMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *NoteMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Note, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// Size returns the value of the "size" field in the mutation.
+func (m *BlobReferenceMutation) Size() (r int64, exists bool) {
+	v := m.size
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *NoteMutation) Op() Op {
-	return m.op
+// OldSize returns the old "size" field's value of the BlobReference entity.
+// If the BlobReference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlobReferenceMutation) OldSize(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSize: %w", err)
+	}
+	return oldValue.Size, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *NoteMutation) SetOp(op Op) {
-	m.op = op
+// AddSize adds i to the "size" field.
+func (m *BlobReferenceMutation) AddSize(i int64) {
+	if m.addsize != nil {
+		*m.addsize += i
+	} else {
+		m.addsize = &i
+	}
 }
 
-// Type returns the node type of this mutation (Note).
-func (m *NoteMutation) Type() string {
-	return m.typ
+// AddedSize returns the value that was added to the "size" field in this mutation.
+func (m *BlobReferenceMutation) AddedSize() (r int64, exists bool) {
+	v := m.addsize
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *NoteMutation) Fields() []string {
-	fields := make([]string, 0, 11)
-	if m.title != nil {
-		fields = append(fields, note.FieldTitle)
-	}
-	if m.description != nil {
-		fields = append(fields, note.FieldDescription)
-	}
-	if m.content != nil {
-		fields = append(fields, note.FieldContent)
-	}
-	if m.resources != nil {
-		fields = append(fields, note.FieldResources)
+// ResetSize resets all changes to the "size" field.
+func (m *BlobReferenceMutation) ResetSize() {
+	m.size = nil
+	m.addsize = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *BlobReferenceMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *BlobReferenceMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	if m.ai_curriculum != nil {
-		fields = append(fields, note.FieldAiCurriculum)
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the BlobReference entity.
+// If the BlobReference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlobReferenceMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	if m.visibility != nil {
-		fields = append(fields, note.FieldVisibility)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
-	if m.permission_level != nil {
-		fields = append(fields, note.FieldPermissionLevel)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	if m.share_token != nil {
-		fields = append(fields, note.FieldShareToken)
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *BlobReferenceMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetBlobID sets the "blob" edge to the Blob entity by id.
+func (m *BlobReferenceMutation) SetBlobID(id int) {
+	m.blob = &id
+}
+
+// ClearBlob clears the "blob" edge to the Blob entity.
+func (m *BlobReferenceMutation) ClearBlob() {
+	m.clearedblob = true
+}
+
+// BlobCleared reports if the "blob" edge to the Blob entity was cleared.
+func (m *BlobReferenceMutation) BlobCleared() bool {
+	return m.clearedblob
+}
+
+// BlobID returns the "blob" edge ID in the mutation.
+func (m *BlobReferenceMutation) BlobID() (id int, exists bool) {
+	if m.blob != nil {
+		return *m.blob, true
 	}
-	if m.ai_processing != nil {
-		fields = append(fields, note.FieldAiProcessing)
+	return
+}
+
+// BlobIDs returns the "blob" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BlobID instead. It exists only for internal usage by the builders.
+func (m *BlobReferenceMutation) BlobIDs() (ids []int) {
+	if id := m.blob; id != nil {
+		ids = append(ids, *id)
 	}
-	if m.created_at != nil {
-		fields = append(fields, note.FieldCreatedAt)
+	return
+}
+
+// ResetBlob resets all changes to the "blob" edge.
+func (m *BlobReferenceMutation) ResetBlob() {
+	m.blob = nil
+	m.clearedblob = false
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by id.
+func (m *BlobReferenceMutation) SetOwnerID(id int) {
+	m.owner = &id
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *BlobReferenceMutation) ClearOwner() {
+	m.clearedowner = true
+}
+
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *BlobReferenceMutation) OwnerCleared() bool {
+	return m.clearedowner
+}
+
+// OwnerID returns the "owner" edge ID in the mutation.
+func (m *BlobReferenceMutation) OwnerID() (id int, exists bool) {
+	if m.owner != nil {
+		return *m.owner, true
 	}
-	if m.updated_at != nil {
-		fields = append(fields, note.FieldUpdatedAt)
+	return
+}
+
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *BlobReferenceMutation) OwnerIDs() (ids []int) {
+	if id := m.owner; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOwner resets all changes to the "owner" edge.
+func (m *BlobReferenceMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
+}
+
+// Where appends a list predicates to the BlobReferenceMutation builder.
+func (m *BlobReferenceMutation) Where(ps ...predicate.BlobReference) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BlobReferenceMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BlobReferenceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BlobReference, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BlobReferenceMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BlobReferenceMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BlobReference).
+func (m *BlobReferenceMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BlobReferenceMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.kind != nil {
+		fields = append(fields, blobreference.FieldKind)
+	}
+	if m.target_id != nil {
+		fields = append(fields, blobreference.FieldTargetID)
+	}
+	if m.size != nil {
+		fields = append(fields, blobreference.FieldSize)
+	}
+	if m.created_at != nil {
+		fields = append(fields, blobreference.FieldCreatedAt)
 	}
 	return fields
 }
@@ -864,30 +1202,16 @@ func (m *NoteMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *NoteMutation) Field(name string) (ent.Value, bool) {
+func (m *BlobReferenceMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case note.FieldTitle:
-		return m.Title()
-	case note.FieldDescription:
-		return m.Description()
-	case note.FieldContent:
-		return m.Content()
-	case note.FieldResources:
-		return m.Resources()
-	case note.FieldAiCurriculum:
-		return m.AiCurriculum()
-	case note.FieldVisibility:
-		return m.Visibility()
-	case note.FieldPermissionLevel:
-		return m.PermissionLevel()
-	case note.FieldShareToken:
-		return m.ShareToken()
-	case note.FieldAiProcessing:
-		return m.AiProcessing()
-	case note.FieldCreatedAt:
+	case blobreference.FieldKind:
+		return m.Kind()
+	case blobreference.FieldTargetID:
+		return m.TargetID()
+	case blobreference.FieldSize:
+		return m.Size()
+	case blobreference.FieldCreatedAt:
 		return m.CreatedAt()
-	case note.FieldUpdatedAt:
-		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -895,391 +1219,266 @@ func (m *NoteMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *NoteMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *BlobReferenceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case note.FieldTitle:
-		return m.OldTitle(ctx)
-	case note.FieldDescription:
-		return m.OldDescription(ctx)
-	case note.FieldContent:
-		return m.OldContent(ctx)
-	case note.FieldResources:
-		return m.OldResources(ctx)
-	case note.FieldAiCurriculum:
-		return m.OldAiCurriculum(ctx)
-	case note.FieldVisibility:
-		return m.OldVisibility(ctx)
-	case note.FieldPermissionLevel:
-		return m.OldPermissionLevel(ctx)
-	case note.FieldShareToken:
-		return m.OldShareToken(ctx)
-	case note.FieldAiProcessing:
-		return m.OldAiProcessing(ctx)
-	case note.FieldCreatedAt:
+	case blobreference.FieldKind:
+		return m.OldKind(ctx)
+	case blobreference.FieldTargetID:
+		return m.OldTargetID(ctx)
+	case blobreference.FieldSize:
+		return m.OldSize(ctx)
+	case blobreference.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case note.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Note field %s", name)
+	return nil, fmt.Errorf("unknown BlobReference field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *NoteMutation) SetField(name string, value ent.Value) error {
+func (m *BlobReferenceMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case note.FieldTitle:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTitle(v)
-		return nil
-	case note.FieldDescription:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDescription(v)
-		return nil
-	case note.FieldContent:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetContent(v)
-		return nil
-	case note.FieldResources:
-		v, ok := value.([]types.Resource)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetResources(v)
-		return nil
-	case note.FieldAiCurriculum:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAiCurriculum(v)
-		return nil
-	case note.FieldVisibility:
-		v, ok := value.(note.Visibility)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetVisibility(v)
-		return nil
-	case note.FieldPermissionLevel:
-		v, ok := value.(note.PermissionLevel)
+	case blobreference.FieldKind:
+		v, ok := value.(blobreference.Kind)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPermissionLevel(v)
+		m.SetKind(v)
 		return nil
-	case note.FieldShareToken:
-		v, ok := value.(string)
+	case blobreference.FieldTargetID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetShareToken(v)
+		m.SetTargetID(v)
 		return nil
-	case note.FieldAiProcessing:
-		v, ok := value.(bool)
+	case blobreference.FieldSize:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAiProcessing(v)
+		m.SetSize(v)
 		return nil
-	case note.FieldCreatedAt:
+	case blobreference.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case note.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Note field %s", name)
+	return fmt.Errorf("unknown BlobReference field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *NoteMutation) AddedFields() []string {
-	return nil
+func (m *BlobReferenceMutation) AddedFields() []string {
+	var fields []string
+	if m.addtarget_id != nil {
+		fields = append(fields, blobreference.FieldTargetID)
+	}
+	if m.addsize != nil {
+		fields = append(fields, blobreference.FieldSize)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *NoteMutation) AddedField(name string) (ent.Value, bool) {
+func (m *BlobReferenceMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case blobreference.FieldTargetID:
+		return m.AddedTargetID()
+	case blobreference.FieldSize:
+		return m.AddedSize()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *NoteMutation) AddField(name string, value ent.Value) error {
+func (m *BlobReferenceMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case blobreference.FieldTargetID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTargetID(v)
+		return nil
+	case blobreference.FieldSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSize(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Note numeric field %s", name)
+	return fmt.Errorf("unknown BlobReference numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *NoteMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(note.FieldDescription) {
-		fields = append(fields, note.FieldDescription)
-	}
-	if m.FieldCleared(note.FieldContent) {
-		fields = append(fields, note.FieldContent)
-	}
-	if m.FieldCleared(note.FieldResources) {
-		fields = append(fields, note.FieldResources)
-	}
-	if m.FieldCleared(note.FieldAiCurriculum) {
-		fields = append(fields, note.FieldAiCurriculum)
-	}
-	if m.FieldCleared(note.FieldShareToken) {
-		fields = append(fields, note.FieldShareToken)
-	}
-	return fields
+func (m *BlobReferenceMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *NoteMutation) FieldCleared(name string) bool {
+func (m *BlobReferenceMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *NoteMutation) ClearField(name string) error {
+func (m *BlobReferenceMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown BlobReference nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BlobReferenceMutation) ResetField(name string) error {
 	switch name {
-	case note.FieldDescription:
-		m.ClearDescription()
+	case blobreference.FieldKind:
+		m.ResetKind()
 		return nil
-	case note.FieldContent:
-		m.ClearContent()
-		return nil
-	case note.FieldResources:
-		m.ClearResources()
+	case blobreference.FieldTargetID:
+		m.ResetTargetID()
 		return nil
-	case note.FieldAiCurriculum:
-		m.ClearAiCurriculum()
+	case blobreference.FieldSize:
+		m.ResetSize()
 		return nil
-	case note.FieldShareToken:
-		m.ClearShareToken()
+	case blobreference.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Note nullable field %s", name)
-}
-
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *NoteMutation) ResetField(name string) error {
-	switch name {
-	case note.FieldTitle:
-		m.ResetTitle()
-		return nil
-	case note.FieldDescription:
-		m.ResetDescription()
-		return nil
-	case note.FieldContent:
-		m.ResetContent()
-		return nil
-	case note.FieldResources:
-		m.ResetResources()
-		return nil
-	case note.FieldAiCurriculum:
-		m.ResetAiCurriculum()
-		return nil
-	case note.FieldVisibility:
-		m.ResetVisibility()
-		return nil
-	case note.FieldPermissionLevel:
-		m.ResetPermissionLevel()
-		return nil
-	case note.FieldShareToken:
-		m.ResetShareToken()
-		return nil
-	case note.FieldAiProcessing:
-		m.ResetAiProcessing()
-		return nil
-	case note.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case note.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	}
-	return fmt.Errorf("unknown Note field %s", name)
+	return fmt.Errorf("unknown BlobReference field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *NoteMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.owner != nil {
-		edges = append(edges, note.EdgeOwner)
-	}
-	if m.likes != nil {
-		edges = append(edges, note.EdgeLikes)
+func (m *BlobReferenceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.blob != nil {
+		edges = append(edges, blobreference.EdgeBlob)
 	}
-	if m.reposts != nil {
-		edges = append(edges, note.EdgeReposts)
+	if m.owner != nil {
+		edges = append(edges, blobreference.EdgeOwner)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *NoteMutation) AddedIDs(name string) []ent.Value {
+func (m *BlobReferenceMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case note.EdgeOwner:
-		if id := m.owner; id != nil {
+	case blobreference.EdgeBlob:
+		if id := m.blob; id != nil {
 			return []ent.Value{*id}
 		}
-	case note.EdgeLikes:
-		ids := make([]ent.Value, 0, len(m.likes))
-		for id := range m.likes {
-			ids = append(ids, id)
-		}
-		return ids
-	case note.EdgeReposts:
-		ids := make([]ent.Value, 0, len(m.reposts))
-		for id := range m.reposts {
-			ids = append(ids, id)
+	case blobreference.EdgeOwner:
+		if id := m.owner; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *NoteMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedlikes != nil {
-		edges = append(edges, note.EdgeLikes)
-	}
-	if m.removedreposts != nil {
-		edges = append(edges, note.EdgeReposts)
-	}
+func (m *BlobReferenceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *NoteMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case note.EdgeLikes:
-		ids := make([]ent.Value, 0, len(m.removedlikes))
-		for id := range m.removedlikes {
-			ids = append(ids, id)
-		}
-		return ids
-	case note.EdgeReposts:
-		ids := make([]ent.Value, 0, len(m.removedreposts))
-		for id := range m.removedreposts {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *BlobReferenceMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *NoteMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedowner {
-		edges = append(edges, note.EdgeOwner)
-	}
-	if m.clearedlikes {
-		edges = append(edges, note.EdgeLikes)
+func (m *BlobReferenceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedblob {
+		edges = append(edges, blobreference.EdgeBlob)
 	}
-	if m.clearedreposts {
-		edges = append(edges, note.EdgeReposts)
+	if m.clearedowner {
+		edges = append(edges, blobreference.EdgeOwner)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *NoteMutation) EdgeCleared(name string) bool {
+func (m *BlobReferenceMutation) EdgeCleared(name string) bool {
 	switch name {
-	case note.EdgeOwner:
+	case blobreference.EdgeBlob:
+		return m.clearedblob
+	case blobreference.EdgeOwner:
 		return m.clearedowner
-	case note.EdgeLikes:
-		return m.clearedlikes
-	case note.EdgeReposts:
-		return m.clearedreposts
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *NoteMutation) ClearEdge(name string) error {
+func (m *BlobReferenceMutation) ClearEdge(name string) error {
 	switch name {
-	case note.EdgeOwner:
+	case blobreference.EdgeBlob:
+		m.ClearBlob()
+		return nil
+	case blobreference.EdgeOwner:
 		m.ClearOwner()
 		return nil
 	}
-	return fmt.Errorf("unknown Note unique edge %s", name)
+	return fmt.Errorf("unknown BlobReference unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *NoteMutation) ResetEdge(name string) error {
+func (m *BlobReferenceMutation) ResetEdge(name string) error {
 	switch name {
-	case note.EdgeOwner:
-		m.ResetOwner()
-		return nil
-	case note.EdgeLikes:
-		m.ResetLikes()
+	case blobreference.EdgeBlob:
+		m.ResetBlob()
 		return nil
-	case note.EdgeReposts:
-		m.ResetReposts()
+	case blobreference.EdgeOwner:
+		m.ResetOwner()
 		return nil
 	}
-	return fmt.Errorf("unknown Note edge %s", name)
+	return fmt.Errorf("unknown BlobReference edge %s", name)
 }
 
-// NoteLikeMutation represents an operation that mutates the NoteLike nodes in the graph.
-type NoteLikeMutation struct {
+// ChoiceMutation represents an operation that mutates the Choice nodes in the graph.
+type ChoiceMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	created_at    *time.Time
-	clearedFields map[string]struct{}
-	user          *int
-	cleareduser   bool
-	note          *int
-	clearednote   bool
-	done          bool
-	oldValue      func(context.Context) (*NoteLike, error)
-	predicates    []predicate.NoteLike
-}
-
-var _ ent.Mutation = (*NoteLikeMutation)(nil)
-
-// notelikeOption allows management of the mutation configuration using functional options.
-type notelikeOption func(*NoteLikeMutation)
-
-// newNoteLikeMutation creates new mutation for the NoteLike entity.
-func newNoteLikeMutation(c config, op Op, opts ...notelikeOption) *NoteLikeMutation {
-	m := &NoteLikeMutation{
+	op              Op
+	typ             string
+	id              *int
+	text            *string
+	correct         *bool
+	position        *int
+	addposition     *int
+	clearedFields   map[string]struct{}
+	question        *int
+	clearedquestion bool
+	done            bool
+	oldValue        func(context.Context) (*Choice, error)
+	predicates      []predicate.Choice
+}
+
+var _ ent.Mutation = (*ChoiceMutation)(nil)
+
+// choiceOption allows management of the mutation configuration using functional options.
+type choiceOption func(*ChoiceMutation)
+
+// newChoiceMutation creates new mutation for the Choice entity.
+func newChoiceMutation(c config, op Op, opts ...choiceOption) *ChoiceMutation {
+	m := &ChoiceMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeNoteLike,
+		typ:           TypeChoice,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1288,20 +1487,20 @@ func newNoteLikeMutation(c config, op Op, opts ...notelikeOption) *NoteLikeMutat
 	return m
 }
 
-// withNoteLikeID sets the ID field of the mutation.
-func withNoteLikeID(id int) notelikeOption {
-	return func(m *NoteLikeMutation) {
+// withChoiceID sets the ID field of the mutation.
+func withChoiceID(id int) choiceOption {
+	return func(m *ChoiceMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *NoteLike
+			value *Choice
 		)
-		m.oldValue = func(ctx context.Context) (*NoteLike, error) {
+		m.oldValue = func(ctx context.Context) (*Choice, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().NoteLike.Get(ctx, id)
+					value, err = m.Client().Choice.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1310,10 +1509,10 @@ func withNoteLikeID(id int) notelikeOption {
 	}
 }
 
-// withNoteLike sets the old NoteLike of the mutation.
-func withNoteLike(node *NoteLike) notelikeOption {
-	return func(m *NoteLikeMutation) {
-		m.oldValue = func(context.Context) (*NoteLike, error) {
+// withChoice sets the old Choice of the mutation.
+func withChoice(node *Choice) choiceOption {
+	return func(m *ChoiceMutation) {
+		m.oldValue = func(context.Context) (*Choice, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1322,7 +1521,7 @@ func withNoteLike(node *NoteLike) notelikeOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m NoteLikeMutation) Client() *Client {
+func (m ChoiceMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1330,7 +1529,7 @@ func (m NoteLikeMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m NoteLikeMutation) Tx() (*Tx, error) {
+func (m ChoiceMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1341,7 +1540,7 @@ func (m NoteLikeMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *NoteLikeMutation) ID() (id int, exists bool) {
+func (m *ChoiceMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1352,7 +1551,7 @@ func (m *NoteLikeMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *NoteLikeMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *ChoiceMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1361,135 +1560,188 @@ func (m *NoteLikeMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().NoteLike.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Choice.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *NoteLikeMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetText sets the "text" field.
+func (m *ChoiceMutation) SetText(s string) {
+	m.text = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *NoteLikeMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Text returns the value of the "text" field in the mutation.
+func (m *ChoiceMutation) Text() (r string, exists bool) {
+	v := m.text
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the NoteLike entity.
-// If the NoteLike object wasn't provided to the builder, the object is fetched from the database.
+// OldText returns the old "text" field's value of the Choice entity.
+// If the Choice object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NoteLikeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ChoiceMutation) OldText(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldText is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldText requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldText: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Text, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *NoteLikeMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetText resets all changes to the "text" field.
+func (m *ChoiceMutation) ResetText() {
+	m.text = nil
 }
 
-// SetUserID sets the "user" edge to the User entity by id.
-func (m *NoteLikeMutation) SetUserID(id int) {
-	m.user = &id
+// SetCorrect sets the "correct" field.
+func (m *ChoiceMutation) SetCorrect(b bool) {
+	m.correct = &b
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *NoteLikeMutation) ClearUser() {
-	m.cleareduser = true
+// Correct returns the value of the "correct" field in the mutation.
+func (m *ChoiceMutation) Correct() (r bool, exists bool) {
+	v := m.correct
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *NoteLikeMutation) UserCleared() bool {
-	return m.cleareduser
+// OldCorrect returns the old "correct" field's value of the Choice entity.
+// If the Choice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChoiceMutation) OldCorrect(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCorrect is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCorrect requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCorrect: %w", err)
+	}
+	return oldValue.Correct, nil
 }
 
-// UserID returns the "user" edge ID in the mutation.
-func (m *NoteLikeMutation) UserID() (id int, exists bool) {
-	if m.user != nil {
-		return *m.user, true
+// ResetCorrect resets all changes to the "correct" field.
+func (m *ChoiceMutation) ResetCorrect() {
+	m.correct = nil
+}
+
+// SetPosition sets the "position" field.
+func (m *ChoiceMutation) SetPosition(i int) {
+	m.position = &i
+	m.addposition = nil
+}
+
+// Position returns the value of the "position" field in the mutation.
+func (m *ChoiceMutation) Position() (r int, exists bool) {
+	v := m.position
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *NoteLikeMutation) UserIDs() (ids []int) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
+// OldPosition returns the old "position" field's value of the Choice entity.
+// If the Choice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChoiceMutation) OldPosition(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPosition is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPosition requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPosition: %w", err)
+	}
+	return oldValue.Position, nil
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *NoteLikeMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// AddPosition adds i to the "position" field.
+func (m *ChoiceMutation) AddPosition(i int) {
+	if m.addposition != nil {
+		*m.addposition += i
+	} else {
+		m.addposition = &i
+	}
 }
 
-// SetNoteID sets the "note" edge to the Note entity by id.
-func (m *NoteLikeMutation) SetNoteID(id int) {
-	m.note = &id
+// AddedPosition returns the value that was added to the "position" field in this mutation.
+func (m *ChoiceMutation) AddedPosition() (r int, exists bool) {
+	v := m.addposition
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearNote clears the "note" edge to the Note entity.
-func (m *NoteLikeMutation) ClearNote() {
-	m.clearednote = true
+// ResetPosition resets all changes to the "position" field.
+func (m *ChoiceMutation) ResetPosition() {
+	m.position = nil
+	m.addposition = nil
 }
 
-// NoteCleared reports if the "note" edge to the Note entity was cleared.
-func (m *NoteLikeMutation) NoteCleared() bool {
-	return m.clearednote
+// SetQuestionID sets the "question" edge to the Question entity by id.
+func (m *ChoiceMutation) SetQuestionID(id int) {
+	m.question = &id
 }
 
-// NoteID returns the "note" edge ID in the mutation.
-func (m *NoteLikeMutation) NoteID() (id int, exists bool) {
-	if m.note != nil {
-		return *m.note, true
+// ClearQuestion clears the "question" edge to the Question entity.
+func (m *ChoiceMutation) ClearQuestion() {
+	m.clearedquestion = true
+}
+
+// QuestionCleared reports if the "question" edge to the Question entity was cleared.
+func (m *ChoiceMutation) QuestionCleared() bool {
+	return m.clearedquestion
+}
+
+// QuestionID returns the "question" edge ID in the mutation.
+func (m *ChoiceMutation) QuestionID() (id int, exists bool) {
+	if m.question != nil {
+		return *m.question, true
 	}
 	return
 }
 
-// NoteIDs returns the "note" edge IDs in the mutation.
+// QuestionIDs returns the "question" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// NoteID instead. It exists only for internal usage by the builders.
-func (m *NoteLikeMutation) NoteIDs() (ids []int) {
-	if id := m.note; id != nil {
+// QuestionID instead. It exists only for internal usage by the builders.
+func (m *ChoiceMutation) QuestionIDs() (ids []int) {
+	if id := m.question; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetNote resets all changes to the "note" edge.
-func (m *NoteLikeMutation) ResetNote() {
-	m.note = nil
-	m.clearednote = false
+// ResetQuestion resets all changes to the "question" edge.
+func (m *ChoiceMutation) ResetQuestion() {
+	m.question = nil
+	m.clearedquestion = false
 }
 
-// Where appends a list predicates to the NoteLikeMutation builder.
-func (m *NoteLikeMutation) Where(ps ...predicate.NoteLike) {
+// Where appends a list predicates to the ChoiceMutation builder.
+func (m *ChoiceMutation) Where(ps ...predicate.Choice) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the NoteLikeMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ChoiceMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *NoteLikeMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.NoteLike, len(ps))
+func (m *ChoiceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Choice, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1497,27 +1749,33 @@ func (m *NoteLikeMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *NoteLikeMutation) Op() Op {
+func (m *ChoiceMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *NoteLikeMutation) SetOp(op Op) {
+func (m *ChoiceMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (NoteLike).
-func (m *NoteLikeMutation) Type() string {
+// Type returns the node type of this mutation (Choice).
+func (m *ChoiceMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *NoteLikeMutation) Fields() []string {
-	fields := make([]string, 0, 1)
-	if m.created_at != nil {
-		fields = append(fields, notelike.FieldCreatedAt)
+func (m *ChoiceMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.text != nil {
+		fields = append(fields, choice.FieldText)
+	}
+	if m.correct != nil {
+		fields = append(fields, choice.FieldCorrect)
+	}
+	if m.position != nil {
+		fields = append(fields, choice.FieldPosition)
 	}
 	return fields
 }
@@ -1525,10 +1783,14 @@ func (m *NoteLikeMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *NoteLikeMutation) Field(name string) (ent.Value, bool) {
+func (m *ChoiceMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case notelike.FieldCreatedAt:
-		return m.CreatedAt()
+	case choice.FieldText:
+		return m.Text()
+	case choice.FieldCorrect:
+		return m.Correct()
+	case choice.FieldPosition:
+		return m.Position()
 	}
 	return nil, false
 }
@@ -1536,104 +1798,136 @@ func (m *NoteLikeMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *NoteLikeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ChoiceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case notelike.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
+	case choice.FieldText:
+		return m.OldText(ctx)
+	case choice.FieldCorrect:
+		return m.OldCorrect(ctx)
+	case choice.FieldPosition:
+		return m.OldPosition(ctx)
 	}
-	return nil, fmt.Errorf("unknown NoteLike field %s", name)
+	return nil, fmt.Errorf("unknown Choice field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *NoteLikeMutation) SetField(name string, value ent.Value) error {
+func (m *ChoiceMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case notelike.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case choice.FieldText:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetText(v)
+		return nil
+	case choice.FieldCorrect:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCorrect(v)
+		return nil
+	case choice.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPosition(v)
 		return nil
 	}
-	return fmt.Errorf("unknown NoteLike field %s", name)
+	return fmt.Errorf("unknown Choice field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *NoteLikeMutation) AddedFields() []string {
-	return nil
+func (m *ChoiceMutation) AddedFields() []string {
+	var fields []string
+	if m.addposition != nil {
+		fields = append(fields, choice.FieldPosition)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *NoteLikeMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ChoiceMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case choice.FieldPosition:
+		return m.AddedPosition()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *NoteLikeMutation) AddField(name string, value ent.Value) error {
+func (m *ChoiceMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case choice.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPosition(v)
+		return nil
 	}
-	return fmt.Errorf("unknown NoteLike numeric field %s", name)
+	return fmt.Errorf("unknown Choice numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *NoteLikeMutation) ClearedFields() []string {
+func (m *ChoiceMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *NoteLikeMutation) FieldCleared(name string) bool {
+func (m *ChoiceMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *NoteLikeMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown NoteLike nullable field %s", name)
+func (m *ChoiceMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Choice nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *NoteLikeMutation) ResetField(name string) error {
+func (m *ChoiceMutation) ResetField(name string) error {
 	switch name {
-	case notelike.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case choice.FieldText:
+		m.ResetText()
+		return nil
+	case choice.FieldCorrect:
+		m.ResetCorrect()
+		return nil
+	case choice.FieldPosition:
+		m.ResetPosition()
 		return nil
 	}
-	return fmt.Errorf("unknown NoteLike field %s", name)
+	return fmt.Errorf("unknown Choice field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *NoteLikeMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.user != nil {
-		edges = append(edges, notelike.EdgeUser)
-	}
-	if m.note != nil {
-		edges = append(edges, notelike.EdgeNote)
+func (m *ChoiceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.question != nil {
+		edges = append(edges, choice.EdgeQuestion)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *NoteLikeMutation) AddedIDs(name string) []ent.Value {
+func (m *ChoiceMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case notelike.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
-		}
-	case notelike.EdgeNote:
-		if id := m.note; id != nil {
+	case choice.EdgeQuestion:
+		if id := m.question; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -1641,98 +1935,101 @@ func (m *NoteLikeMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *NoteLikeMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *ChoiceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *NoteLikeMutation) RemovedIDs(name string) []ent.Value {
+func (m *ChoiceMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *NoteLikeMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.cleareduser {
-		edges = append(edges, notelike.EdgeUser)
-	}
-	if m.clearednote {
-		edges = append(edges, notelike.EdgeNote)
+func (m *ChoiceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedquestion {
+		edges = append(edges, choice.EdgeQuestion)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *NoteLikeMutation) EdgeCleared(name string) bool {
+func (m *ChoiceMutation) EdgeCleared(name string) bool {
 	switch name {
-	case notelike.EdgeUser:
-		return m.cleareduser
-	case notelike.EdgeNote:
-		return m.clearednote
+	case choice.EdgeQuestion:
+		return m.clearedquestion
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *NoteLikeMutation) ClearEdge(name string) error {
+func (m *ChoiceMutation) ClearEdge(name string) error {
 	switch name {
-	case notelike.EdgeUser:
-		m.ClearUser()
-		return nil
-	case notelike.EdgeNote:
-		m.ClearNote()
+	case choice.EdgeQuestion:
+		m.ClearQuestion()
 		return nil
 	}
-	return fmt.Errorf("unknown NoteLike unique edge %s", name)
+	return fmt.Errorf("unknown Choice unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *NoteLikeMutation) ResetEdge(name string) error {
+func (m *ChoiceMutation) ResetEdge(name string) error {
 	switch name {
-	case notelike.EdgeUser:
-		m.ResetUser()
-		return nil
-	case notelike.EdgeNote:
-		m.ResetNote()
+	case choice.EdgeQuestion:
+		m.ResetQuestion()
 		return nil
 	}
-	return fmt.Errorf("unknown NoteLike edge %s", name)
+	return fmt.Errorf("unknown Choice edge %s", name)
 }
 
-// NoteRepostMutation represents an operation that mutates the NoteRepost nodes in the graph.
-type NoteRepostMutation struct {
+// DocumentMutation represents an operation that mutates the Document nodes in the graph.
+type DocumentMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *int
-	comment       *string
+	title         *string
+	description   *string
+	file_name     *string
+	storage_key   *string
+	mime_type     *string
+	size          *int64
+	addsize       *int64
+	resource_type *string
+	tags          *[]string
+	appendtags    []string
+	visibility    *document.Visibility
 	created_at    *time.Time
+	updated_at    *time.Time
 	clearedFields map[string]struct{}
-	user          *int
-	cleareduser   bool
-	note          *int
-	clearednote   bool
+	owner         *int
+	clearedowner  bool
+	folder        *int
+	clearedfolder bool
+	notes         map[int]struct{}
+	removednotes  map[int]struct{}
+	clearednotes  bool
 	done          bool
-	oldValue      func(context.Context) (*NoteRepost, error)
-	predicates    []predicate.NoteRepost
+	oldValue      func(context.Context) (*Document, error)
+	predicates    []predicate.Document
 }
 
-var _ ent.Mutation = (*NoteRepostMutation)(nil)
+var _ ent.Mutation = (*DocumentMutation)(nil)
 
-// noterepostOption allows management of the mutation configuration using functional options.
-type noterepostOption func(*NoteRepostMutation)
+// documentOption allows management of the mutation configuration using functional options.
+type documentOption func(*DocumentMutation)
 
-// newNoteRepostMutation creates new mutation for the NoteRepost entity.
-func newNoteRepostMutation(c config, op Op, opts ...noterepostOption) *NoteRepostMutation {
-	m := &NoteRepostMutation{
+// newDocumentMutation creates new mutation for the Document entity.
+func newDocumentMutation(c config, op Op, opts ...documentOption) *DocumentMutation {
+	m := &DocumentMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeNoteRepost,
+		typ:           TypeDocument,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1741,20 +2038,20 @@ func newNoteRepostMutation(c config, op Op, opts ...noterepostOption) *NoteRepos
 	return m
 }
 
-// withNoteRepostID sets the ID field of the mutation.
-func withNoteRepostID(id int) noterepostOption {
-	return func(m *NoteRepostMutation) {
+// withDocumentID sets the ID field of the mutation.
+func withDocumentID(id int) documentOption {
+	return func(m *DocumentMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *NoteRepost
+			value *Document
 		)
-		m.oldValue = func(ctx context.Context) (*NoteRepost, error) {
+		m.oldValue = func(ctx context.Context) (*Document, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().NoteRepost.Get(ctx, id)
+					value, err = m.Client().Document.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1763,10 +2060,10 @@ func withNoteRepostID(id int) noterepostOption {
 	}
 }
 
-// withNoteRepost sets the old NoteRepost of the mutation.
-func withNoteRepost(node *NoteRepost) noterepostOption {
-	return func(m *NoteRepostMutation) {
-		m.oldValue = func(context.Context) (*NoteRepost, error) {
+// withDocument sets the old Document of the mutation.
+func withDocument(node *Document) documentOption {
+	return func(m *DocumentMutation) {
+		m.oldValue = func(context.Context) (*Document, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1775,7 +2072,7 @@ func withNoteRepost(node *NoteRepost) noterepostOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m NoteRepostMutation) Client() *Client {
+func (m DocumentMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1783,7 +2080,7 @@ func (m NoteRepostMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m NoteRepostMutation) Tx() (*Tx, error) {
+func (m DocumentMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1794,7 +2091,7 @@ func (m NoteRepostMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *NoteRepostMutation) ID() (id int, exists bool) {
+func (m *DocumentMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1805,7 +2102,7 @@ func (m *NoteRepostMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *NoteRepostMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *DocumentMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1814,682 +2111,624 @@ func (m *NoteRepostMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().NoteRepost.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Document.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetComment sets the "comment" field.
-func (m *NoteRepostMutation) SetComment(s string) {
-	m.comment = &s
+// SetTitle sets the "title" field.
+func (m *DocumentMutation) SetTitle(s string) {
+	m.title = &s
 }
 
-// Comment returns the value of the "comment" field in the mutation.
-func (m *NoteRepostMutation) Comment() (r string, exists bool) {
-	v := m.comment
+// Title returns the value of the "title" field in the mutation.
+func (m *DocumentMutation) Title() (r string, exists bool) {
+	v := m.title
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldComment returns the old "comment" field's value of the NoteRepost entity.
-// If the NoteRepost object wasn't provided to the builder, the object is fetched from the database.
+// OldTitle returns the old "title" field's value of the Document entity.
+// If the Document object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NoteRepostMutation) OldComment(ctx context.Context) (v string, err error) {
+func (m *DocumentMutation) OldTitle(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldComment is only allowed on UpdateOne operations")
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldComment requires an ID field in the mutation")
+		return v, errors.New("OldTitle requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldComment: %w", err)
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
 	}
-	return oldValue.Comment, nil
-}
-
-// ClearComment clears the value of the "comment" field.
-func (m *NoteRepostMutation) ClearComment() {
-	m.comment = nil
-	m.clearedFields[noterepost.FieldComment] = struct{}{}
-}
-
-// CommentCleared returns if the "comment" field was cleared in this mutation.
-func (m *NoteRepostMutation) CommentCleared() bool {
-	_, ok := m.clearedFields[noterepost.FieldComment]
-	return ok
+	return oldValue.Title, nil
 }
 
-// ResetComment resets all changes to the "comment" field.
-func (m *NoteRepostMutation) ResetComment() {
-	m.comment = nil
-	delete(m.clearedFields, noterepost.FieldComment)
+// ResetTitle resets all changes to the "title" field.
+func (m *DocumentMutation) ResetTitle() {
+	m.title = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *NoteRepostMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetDescription sets the "description" field.
+func (m *DocumentMutation) SetDescription(s string) {
+	m.description = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *NoteRepostMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Description returns the value of the "description" field in the mutation.
+func (m *DocumentMutation) Description() (r string, exists bool) {
+	v := m.description
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the NoteRepost entity.
-// If the NoteRepost object wasn't provided to the builder, the object is fetched from the database.
+// OldDescription returns the old "description" field's value of the Document entity.
+// If the Document object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NoteRepostMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *DocumentMutation) OldDescription(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldDescription requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Description, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *NoteRepostMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearDescription clears the value of the "description" field.
+func (m *DocumentMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[document.FieldDescription] = struct{}{}
 }
 
-// SetUserID sets the "user" edge to the User entity by id.
-func (m *NoteRepostMutation) SetUserID(id int) {
-	m.user = &id
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *DocumentMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[document.FieldDescription]
+	return ok
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *NoteRepostMutation) ClearUser() {
-	m.cleareduser = true
+// ResetDescription resets all changes to the "description" field.
+func (m *DocumentMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, document.FieldDescription)
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *NoteRepostMutation) UserCleared() bool {
-	return m.cleareduser
+// SetFileName sets the "file_name" field.
+func (m *DocumentMutation) SetFileName(s string) {
+	m.file_name = &s
 }
 
-// UserID returns the "user" edge ID in the mutation.
-func (m *NoteRepostMutation) UserID() (id int, exists bool) {
-	if m.user != nil {
-		return *m.user, true
+// FileName returns the value of the "file_name" field in the mutation.
+func (m *DocumentMutation) FileName() (r string, exists bool) {
+	v := m.file_name
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *NoteRepostMutation) UserIDs() (ids []int) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
+// OldFileName returns the old "file_name" field's value of the Document entity.
+// If the Document object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentMutation) OldFileName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFileName is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// ResetUser resets all changes to the "user" edge.
-func (m *NoteRepostMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFileName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFileName: %w", err)
+	}
+	return oldValue.FileName, nil
 }
 
-// SetNoteID sets the "note" edge to the Note entity by id.
-func (m *NoteRepostMutation) SetNoteID(id int) {
-	m.note = &id
+// ResetFileName resets all changes to the "file_name" field.
+func (m *DocumentMutation) ResetFileName() {
+	m.file_name = nil
 }
 
-// ClearNote clears the "note" edge to the Note entity.
-func (m *NoteRepostMutation) ClearNote() {
-	m.clearednote = true
+// SetStorageKey sets the "storage_key" field.
+func (m *DocumentMutation) SetStorageKey(s string) {
+	m.storage_key = &s
 }
 
-// NoteCleared reports if the "note" edge to the Note entity was cleared.
-func (m *NoteRepostMutation) NoteCleared() bool {
-	return m.clearednote
+// StorageKey returns the value of the "storage_key" field in the mutation.
+func (m *DocumentMutation) StorageKey() (r string, exists bool) {
+	v := m.storage_key
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// NoteID returns the "note" edge ID in the mutation.
-func (m *NoteRepostMutation) NoteID() (id int, exists bool) {
-	if m.note != nil {
-		return *m.note, true
+// OldStorageKey returns the old "storage_key" field's value of the Document entity.
+// If the Document object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentMutation) OldStorageKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStorageKey is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStorageKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStorageKey: %w", err)
+	}
+	return oldValue.StorageKey, nil
 }
 
-// NoteIDs returns the "note" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// NoteID instead. It exists only for internal usage by the builders.
-func (m *NoteRepostMutation) NoteIDs() (ids []int) {
-	if id := m.note; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// ResetStorageKey resets all changes to the "storage_key" field.
+func (m *DocumentMutation) ResetStorageKey() {
+	m.storage_key = nil
 }
 
-// ResetNote resets all changes to the "note" edge.
-func (m *NoteRepostMutation) ResetNote() {
-	m.note = nil
-	m.clearednote = false
+// SetMimeType sets the "mime_type" field.
+func (m *DocumentMutation) SetMimeType(s string) {
+	m.mime_type = &s
 }
 
-// Where appends a list predicates to the NoteRepostMutation builder.
-func (m *NoteRepostMutation) Where(ps ...predicate.NoteRepost) {
-	m.predicates = append(m.predicates, ps...)
+// MimeType returns the value of the "mime_type" field in the mutation.
+func (m *DocumentMutation) MimeType() (r string, exists bool) {
+	v := m.mime_type
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// WhereP appends storage-level predicates to the NoteRepostMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *NoteRepostMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.NoteRepost, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// OldMimeType returns the old "mime_type" field's value of the Document entity.
+// If the Document object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentMutation) OldMimeType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMimeType is only allowed on UpdateOne operations")
 	}
-	m.Where(p...)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMimeType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMimeType: %w", err)
+	}
+	return oldValue.MimeType, nil
 }
 
-// Op returns the operation name.
-func (m *NoteRepostMutation) Op() Op {
-	return m.op
+// ClearMimeType clears the value of the "mime_type" field.
+func (m *DocumentMutation) ClearMimeType() {
+	m.mime_type = nil
+	m.clearedFields[document.FieldMimeType] = struct{}{}
 }
 
-// SetOp allows setting the mutation operation.
-func (m *NoteRepostMutation) SetOp(op Op) {
-	m.op = op
+// MimeTypeCleared returns if the "mime_type" field was cleared in this mutation.
+func (m *DocumentMutation) MimeTypeCleared() bool {
+	_, ok := m.clearedFields[document.FieldMimeType]
+	return ok
 }
 
-// Type returns the node type of this mutation (NoteRepost).
-func (m *NoteRepostMutation) Type() string {
-	return m.typ
+// ResetMimeType resets all changes to the "mime_type" field.
+func (m *DocumentMutation) ResetMimeType() {
+	m.mime_type = nil
+	delete(m.clearedFields, document.FieldMimeType)
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *NoteRepostMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.comment != nil {
-		fields = append(fields, noterepost.FieldComment)
-	}
-	if m.created_at != nil {
-		fields = append(fields, noterepost.FieldCreatedAt)
+// SetSize sets the "size" field.
+func (m *DocumentMutation) SetSize(i int64) {
+	m.size = &i
+	m.addsize = nil
+}
+
+// Size returns the value of the "size" field in the mutation.
+func (m *DocumentMutation) Size() (r int64, exists bool) {
+	v := m.size
+	if v == nil {
+		return
 	}
-	return fields
+	return *v, true
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *NoteRepostMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case noterepost.FieldComment:
-		return m.Comment()
-	case noterepost.FieldCreatedAt:
-		return m.CreatedAt()
+// OldSize returns the old "size" field's value of the Document entity.
+// If the Document object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentMutation) OldSize(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSize is only allowed on UpdateOne operations")
 	}
-	return nil, false
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSize: %w", err)
+	}
+	return oldValue.Size, nil
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *NoteRepostMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case noterepost.FieldComment:
-		return m.OldComment(ctx)
-	case noterepost.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
+// AddSize adds i to the "size" field.
+func (m *DocumentMutation) AddSize(i int64) {
+	if m.addsize != nil {
+		*m.addsize += i
+	} else {
+		m.addsize = &i
 	}
-	return nil, fmt.Errorf("unknown NoteRepost field %s", name)
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *NoteRepostMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case noterepost.FieldComment:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetComment(v)
-		return nil
-	case noterepost.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
+// AddedSize returns the value that was added to the "size" field in this mutation.
+func (m *DocumentMutation) AddedSize() (r int64, exists bool) {
+	v := m.addsize
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown NoteRepost field %s", name)
+	return *v, true
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *NoteRepostMutation) AddedFields() []string {
-	return nil
+// ResetSize resets all changes to the "size" field.
+func (m *DocumentMutation) ResetSize() {
+	m.size = nil
+	m.addsize = nil
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *NoteRepostMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// SetResourceType sets the "resource_type" field.
+func (m *DocumentMutation) SetResourceType(s string) {
+	m.resource_type = &s
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *NoteRepostMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// ResourceType returns the value of the "resource_type" field in the mutation.
+func (m *DocumentMutation) ResourceType() (r string, exists bool) {
+	v := m.resource_type
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown NoteRepost numeric field %s", name)
+	return *v, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *NoteRepostMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(noterepost.FieldComment) {
-		fields = append(fields, noterepost.FieldComment)
+// OldResourceType returns the old "resource_type" field's value of the Document entity.
+// If the Document object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentMutation) OldResourceType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResourceType is only allowed on UpdateOne operations")
 	}
-	return fields
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResourceType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResourceType: %w", err)
+	}
+	return oldValue.ResourceType, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *NoteRepostMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// ResetResourceType resets all changes to the "resource_type" field.
+func (m *DocumentMutation) ResetResourceType() {
+	m.resource_type = nil
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *NoteRepostMutation) ClearField(name string) error {
-	switch name {
-	case noterepost.FieldComment:
-		m.ClearComment()
-		return nil
-	}
-	return fmt.Errorf("unknown NoteRepost nullable field %s", name)
+// SetTags sets the "tags" field.
+func (m *DocumentMutation) SetTags(s []string) {
+	m.tags = &s
+	m.appendtags = nil
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *NoteRepostMutation) ResetField(name string) error {
-	switch name {
-	case noterepost.FieldComment:
-		m.ResetComment()
-		return nil
-	case noterepost.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
+// Tags returns the value of the "tags" field in the mutation.
+func (m *DocumentMutation) Tags() (r []string, exists bool) {
+	v := m.tags
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown NoteRepost field %s", name)
+	return *v, true
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *NoteRepostMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.user != nil {
-		edges = append(edges, noterepost.EdgeUser)
+// OldTags returns the old "tags" field's value of the Document entity.
+// If the Document object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentMutation) OldTags(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTags is only allowed on UpdateOne operations")
 	}
-	if m.note != nil {
-		edges = append(edges, noterepost.EdgeNote)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTags requires an ID field in the mutation")
 	}
-	return edges
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTags: %w", err)
+	}
+	return oldValue.Tags, nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *NoteRepostMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case noterepost.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
-		}
-	case noterepost.EdgeNote:
-		if id := m.note; id != nil {
-			return []ent.Value{*id}
-		}
+// AppendTags adds s to the "tags" field.
+func (m *DocumentMutation) AppendTags(s []string) {
+	m.appendtags = append(m.appendtags, s...)
+}
+
+// AppendedTags returns the list of values that were appended to the "tags" field in this mutation.
+func (m *DocumentMutation) AppendedTags() ([]string, bool) {
+	if len(m.appendtags) == 0 {
+		return nil, false
 	}
-	return nil
+	return m.appendtags, true
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *NoteRepostMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	return edges
+// ClearTags clears the value of the "tags" field.
+func (m *DocumentMutation) ClearTags() {
+	m.tags = nil
+	m.appendtags = nil
+	m.clearedFields[document.FieldTags] = struct{}{}
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *NoteRepostMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// TagsCleared returns if the "tags" field was cleared in this mutation.
+func (m *DocumentMutation) TagsCleared() bool {
+	_, ok := m.clearedFields[document.FieldTags]
+	return ok
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *NoteRepostMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.cleareduser {
-		edges = append(edges, noterepost.EdgeUser)
-	}
-	if m.clearednote {
-		edges = append(edges, noterepost.EdgeNote)
-	}
-	return edges
+// ResetTags resets all changes to the "tags" field.
+func (m *DocumentMutation) ResetTags() {
+	m.tags = nil
+	m.appendtags = nil
+	delete(m.clearedFields, document.FieldTags)
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *NoteRepostMutation) EdgeCleared(name string) bool {
-	switch name {
-	case noterepost.EdgeUser:
-		return m.cleareduser
-	case noterepost.EdgeNote:
-		return m.clearednote
+// SetVisibility sets the "visibility" field.
+func (m *DocumentMutation) SetVisibility(d document.Visibility) {
+	m.visibility = &d
+}
+
+// Visibility returns the value of the "visibility" field in the mutation.
+func (m *DocumentMutation) Visibility() (r document.Visibility, exists bool) {
+	v := m.visibility
+	if v == nil {
+		return
 	}
-	return false
+	return *v, true
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *NoteRepostMutation) ClearEdge(name string) error {
-	switch name {
-	case noterepost.EdgeUser:
-		m.ClearUser()
-		return nil
-	case noterepost.EdgeNote:
-		m.ClearNote()
-		return nil
-	}
-	return fmt.Errorf("unknown NoteRepost unique edge %s", name)
-}
-
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *NoteRepostMutation) ResetEdge(name string) error {
-	switch name {
-	case noterepost.EdgeUser:
-		m.ResetUser()
-		return nil
-	case noterepost.EdgeNote:
-		m.ResetNote()
-		return nil
-	}
-	return fmt.Errorf("unknown NoteRepost edge %s", name)
-}
-
-// PasswordTokenMutation represents an operation that mutates the PasswordToken nodes in the graph.
-type PasswordTokenMutation struct {
-	config
-	op            Op
-	typ           string
-	id            *int
-	token         *string
-	created_at    *time.Time
-	clearedFields map[string]struct{}
-	user          *int
-	cleareduser   bool
-	done          bool
-	oldValue      func(context.Context) (*PasswordToken, error)
-	predicates    []predicate.PasswordToken
-}
-
-var _ ent.Mutation = (*PasswordTokenMutation)(nil)
-
-// passwordtokenOption allows management of the mutation configuration using functional options.
-type passwordtokenOption func(*PasswordTokenMutation)
-
-// newPasswordTokenMutation creates new mutation for the PasswordToken entity.
-func newPasswordTokenMutation(c config, op Op, opts ...passwordtokenOption) *PasswordTokenMutation {
-	m := &PasswordTokenMutation{
-		config:        c,
-		op:            op,
-		typ:           TypePasswordToken,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
-	}
-	return m
-}
-
-// withPasswordTokenID sets the ID field of the mutation.
-func withPasswordTokenID(id int) passwordtokenOption {
-	return func(m *PasswordTokenMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *PasswordToken
-		)
-		m.oldValue = func(ctx context.Context) (*PasswordToken, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().PasswordToken.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
-	}
-}
-
-// withPasswordToken sets the old PasswordToken of the mutation.
-func withPasswordToken(node *PasswordToken) passwordtokenOption {
-	return func(m *PasswordTokenMutation) {
-		m.oldValue = func(context.Context) (*PasswordToken, error) {
-			return node, nil
-		}
-		m.id = &node.ID
-	}
-}
-
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PasswordTokenMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
-}
-
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m PasswordTokenMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
-}
-
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *PasswordTokenMutation) ID() (id int, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
-}
-
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *PasswordTokenMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().PasswordToken.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
-}
-
-// SetToken sets the "token" field.
-func (m *PasswordTokenMutation) SetToken(s string) {
-	m.token = &s
-}
-
-// Token returns the value of the "token" field in the mutation.
-func (m *PasswordTokenMutation) Token() (r string, exists bool) {
-	v := m.token
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldToken returns the old "token" field's value of the PasswordToken entity.
-// If the PasswordToken object wasn't provided to the builder, the object is fetched from the database.
+// OldVisibility returns the old "visibility" field's value of the Document entity.
+// If the Document object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PasswordTokenMutation) OldToken(ctx context.Context) (v string, err error) {
+func (m *DocumentMutation) OldVisibility(ctx context.Context) (v document.Visibility, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldToken is only allowed on UpdateOne operations")
+		return v, errors.New("OldVisibility is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldToken requires an ID field in the mutation")
+		return v, errors.New("OldVisibility requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldToken: %w", err)
+		return v, fmt.Errorf("querying old value for OldVisibility: %w", err)
 	}
-	return oldValue.Token, nil
+	return oldValue.Visibility, nil
 }
 
-// ResetToken resets all changes to the "token" field.
-func (m *PasswordTokenMutation) ResetToken() {
-	m.token = nil
+// ResetVisibility resets all changes to the "visibility" field.
+func (m *DocumentMutation) ResetVisibility() {
+	m.visibility = nil
 }
 
-// SetUserID sets the "user_id" field.
-func (m *PasswordTokenMutation) SetUserID(i int) {
-	m.user = &i
+// SetCreatedAt sets the "created_at" field.
+func (m *DocumentMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *PasswordTokenMutation) UserID() (r int, exists bool) {
-	v := m.user
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *DocumentMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the PasswordToken entity.
-// If the PasswordToken object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Document entity.
+// If the Document object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PasswordTokenMutation) OldUserID(ctx context.Context) (v int, err error) {
+func (m *DocumentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.UserID, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *PasswordTokenMutation) ResetUserID() {
-	m.user = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *DocumentMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *PasswordTokenMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetUpdatedAt sets the "updated_at" field.
+func (m *DocumentMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *PasswordTokenMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *DocumentMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the PasswordToken entity.
-// If the PasswordToken object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Document entity.
+// If the Document object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PasswordTokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *DocumentMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *PasswordTokenMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *DocumentMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *PasswordTokenMutation) ClearUser() {
-	m.cleareduser = true
-	m.clearedFields[passwordtoken.FieldUserID] = struct{}{}
+// SetOwnerID sets the "owner" edge to the User entity by id.
+func (m *DocumentMutation) SetOwnerID(id int) {
+	m.owner = &id
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *PasswordTokenMutation) UserCleared() bool {
-	return m.cleareduser
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *DocumentMutation) ClearOwner() {
+	m.clearedowner = true
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *DocumentMutation) OwnerCleared() bool {
+	return m.clearedowner
+}
+
+// OwnerID returns the "owner" edge ID in the mutation.
+func (m *DocumentMutation) OwnerID() (id int, exists bool) {
+	if m.owner != nil {
+		return *m.owner, true
+	}
+	return
+}
+
+// OwnerIDs returns the "owner" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *PasswordTokenMutation) UserIDs() (ids []int) {
-	if id := m.user; id != nil {
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *DocumentMutation) OwnerIDs() (ids []int) {
+	if id := m.owner; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *PasswordTokenMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
-}
+// ResetOwner resets all changes to the "owner" edge.
+func (m *DocumentMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
+}
 
-// Where appends a list predicates to the PasswordTokenMutation builder.
-func (m *PasswordTokenMutation) Where(ps ...predicate.PasswordToken) {
+// SetFolderID sets the "folder" edge to the Folder entity by id.
+func (m *DocumentMutation) SetFolderID(id int) {
+	m.folder = &id
+}
+
+// ClearFolder clears the "folder" edge to the Folder entity.
+func (m *DocumentMutation) ClearFolder() {
+	m.clearedfolder = true
+}
+
+// FolderCleared reports if the "folder" edge to the Folder entity was cleared.
+func (m *DocumentMutation) FolderCleared() bool {
+	return m.clearedfolder
+}
+
+// FolderID returns the "folder" edge ID in the mutation.
+func (m *DocumentMutation) FolderID() (id int, exists bool) {
+	if m.folder != nil {
+		return *m.folder, true
+	}
+	return
+}
+
+// FolderIDs returns the "folder" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// FolderID instead. It exists only for internal usage by the builders.
+func (m *DocumentMutation) FolderIDs() (ids []int) {
+	if id := m.folder; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetFolder resets all changes to the "folder" edge.
+func (m *DocumentMutation) ResetFolder() {
+	m.folder = nil
+	m.clearedfolder = false
+}
+
+// AddNoteIDs adds the "notes" edge to the Note entity by ids.
+func (m *DocumentMutation) AddNoteIDs(ids ...int) {
+	if m.notes == nil {
+		m.notes = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.notes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearNotes clears the "notes" edge to the Note entity.
+func (m *DocumentMutation) ClearNotes() {
+	m.clearednotes = true
+}
+
+// NotesCleared reports if the "notes" edge to the Note entity was cleared.
+func (m *DocumentMutation) NotesCleared() bool {
+	return m.clearednotes
+}
+
+// RemoveNoteIDs removes the "notes" edge to the Note entity by IDs.
+func (m *DocumentMutation) RemoveNoteIDs(ids ...int) {
+	if m.removednotes == nil {
+		m.removednotes = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.notes, ids[i])
+		m.removednotes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedNotes returns the removed IDs of the "notes" edge to the Note entity.
+func (m *DocumentMutation) RemovedNotesIDs() (ids []int) {
+	for id := range m.removednotes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// NotesIDs returns the "notes" edge IDs in the mutation.
+func (m *DocumentMutation) NotesIDs() (ids []int) {
+	for id := range m.notes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetNotes resets all changes to the "notes" edge.
+func (m *DocumentMutation) ResetNotes() {
+	m.notes = nil
+	m.clearednotes = false
+	m.removednotes = nil
+}
+
+// Where appends a list predicates to the DocumentMutation builder.
+func (m *DocumentMutation) Where(ps ...predicate.Document) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the PasswordTokenMutation builder. Using this method,
+// WhereP appends storage-level predicates to the DocumentMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PasswordTokenMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.PasswordToken, len(ps))
+func (m *DocumentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Document, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2497,33 +2736,57 @@ func (m *PasswordTokenMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *PasswordTokenMutation) Op() Op {
+func (m *DocumentMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PasswordTokenMutation) SetOp(op Op) {
+func (m *DocumentMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (PasswordToken).
-func (m *PasswordTokenMutation) Type() string {
+// Type returns the node type of this mutation (Document).
+func (m *DocumentMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PasswordTokenMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.token != nil {
-		fields = append(fields, passwordtoken.FieldToken)
+func (m *DocumentMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.title != nil {
+		fields = append(fields, document.FieldTitle)
 	}
-	if m.user != nil {
-		fields = append(fields, passwordtoken.FieldUserID)
+	if m.description != nil {
+		fields = append(fields, document.FieldDescription)
+	}
+	if m.file_name != nil {
+		fields = append(fields, document.FieldFileName)
+	}
+	if m.storage_key != nil {
+		fields = append(fields, document.FieldStorageKey)
+	}
+	if m.mime_type != nil {
+		fields = append(fields, document.FieldMimeType)
+	}
+	if m.size != nil {
+		fields = append(fields, document.FieldSize)
+	}
+	if m.resource_type != nil {
+		fields = append(fields, document.FieldResourceType)
+	}
+	if m.tags != nil {
+		fields = append(fields, document.FieldTags)
+	}
+	if m.visibility != nil {
+		fields = append(fields, document.FieldVisibility)
 	}
 	if m.created_at != nil {
-		fields = append(fields, passwordtoken.FieldCreatedAt)
+		fields = append(fields, document.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, document.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -2531,14 +2794,30 @@ func (m *PasswordTokenMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PasswordTokenMutation) Field(name string) (ent.Value, bool) {
+func (m *DocumentMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case passwordtoken.FieldToken:
-		return m.Token()
-	case passwordtoken.FieldUserID:
-		return m.UserID()
-	case passwordtoken.FieldCreatedAt:
+	case document.FieldTitle:
+		return m.Title()
+	case document.FieldDescription:
+		return m.Description()
+	case document.FieldFileName:
+		return m.FileName()
+	case document.FieldStorageKey:
+		return m.StorageKey()
+	case document.FieldMimeType:
+		return m.MimeType()
+	case document.FieldSize:
+		return m.Size()
+	case document.FieldResourceType:
+		return m.ResourceType()
+	case document.FieldTags:
+		return m.Tags()
+	case document.FieldVisibility:
+		return m.Visibility()
+	case document.FieldCreatedAt:
 		return m.CreatedAt()
+	case document.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -2546,60 +2825,137 @@ func (m *PasswordTokenMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PasswordTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *DocumentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case passwordtoken.FieldToken:
-		return m.OldToken(ctx)
-	case passwordtoken.FieldUserID:
-		return m.OldUserID(ctx)
-	case passwordtoken.FieldCreatedAt:
+	case document.FieldTitle:
+		return m.OldTitle(ctx)
+	case document.FieldDescription:
+		return m.OldDescription(ctx)
+	case document.FieldFileName:
+		return m.OldFileName(ctx)
+	case document.FieldStorageKey:
+		return m.OldStorageKey(ctx)
+	case document.FieldMimeType:
+		return m.OldMimeType(ctx)
+	case document.FieldSize:
+		return m.OldSize(ctx)
+	case document.FieldResourceType:
+		return m.OldResourceType(ctx)
+	case document.FieldTags:
+		return m.OldTags(ctx)
+	case document.FieldVisibility:
+		return m.OldVisibility(ctx)
+	case document.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
+	case document.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown PasswordToken field %s", name)
+	return nil, fmt.Errorf("unknown Document field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PasswordTokenMutation) SetField(name string, value ent.Value) error {
+func (m *DocumentMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case passwordtoken.FieldToken:
+	case document.FieldTitle:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetToken(v)
+		m.SetTitle(v)
 		return nil
-	case passwordtoken.FieldUserID:
-		v, ok := value.(int)
+	case document.FieldDescription:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserID(v)
+		m.SetDescription(v)
 		return nil
-	case passwordtoken.FieldCreatedAt:
+	case document.FieldFileName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFileName(v)
+		return nil
+	case document.FieldStorageKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStorageKey(v)
+		return nil
+	case document.FieldMimeType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMimeType(v)
+		return nil
+	case document.FieldSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSize(v)
+		return nil
+	case document.FieldResourceType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResourceType(v)
+		return nil
+	case document.FieldTags:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTags(v)
+		return nil
+	case document.FieldVisibility:
+		v, ok := value.(document.Visibility)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVisibility(v)
+		return nil
+	case document.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
+	case document.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
 	}
-	return fmt.Errorf("unknown PasswordToken field %s", name)
+	return fmt.Errorf("unknown Document field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PasswordTokenMutation) AddedFields() []string {
+func (m *DocumentMutation) AddedFields() []string {
 	var fields []string
+	if m.addsize != nil {
+		fields = append(fields, document.FieldSize)
+	}
 	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PasswordTokenMutation) AddedField(name string) (ent.Value, bool) {
+func (m *DocumentMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
+	case document.FieldSize:
+		return m.AddedSize()
 	}
 	return nil, false
 }
@@ -2607,174 +2963,255 @@ func (m *PasswordTokenMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PasswordTokenMutation) AddField(name string, value ent.Value) error {
+func (m *DocumentMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case document.FieldSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSize(v)
+		return nil
 	}
-	return fmt.Errorf("unknown PasswordToken numeric field %s", name)
+	return fmt.Errorf("unknown Document numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PasswordTokenMutation) ClearedFields() []string {
-	return nil
+func (m *DocumentMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(document.FieldDescription) {
+		fields = append(fields, document.FieldDescription)
+	}
+	if m.FieldCleared(document.FieldMimeType) {
+		fields = append(fields, document.FieldMimeType)
+	}
+	if m.FieldCleared(document.FieldTags) {
+		fields = append(fields, document.FieldTags)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PasswordTokenMutation) FieldCleared(name string) bool {
+func (m *DocumentMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PasswordTokenMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown PasswordToken nullable field %s", name)
+func (m *DocumentMutation) ClearField(name string) error {
+	switch name {
+	case document.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case document.FieldMimeType:
+		m.ClearMimeType()
+		return nil
+	case document.FieldTags:
+		m.ClearTags()
+		return nil
+	}
+	return fmt.Errorf("unknown Document nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PasswordTokenMutation) ResetField(name string) error {
+func (m *DocumentMutation) ResetField(name string) error {
 	switch name {
-	case passwordtoken.FieldToken:
-		m.ResetToken()
+	case document.FieldTitle:
+		m.ResetTitle()
 		return nil
-	case passwordtoken.FieldUserID:
-		m.ResetUserID()
+	case document.FieldDescription:
+		m.ResetDescription()
 		return nil
-	case passwordtoken.FieldCreatedAt:
+	case document.FieldFileName:
+		m.ResetFileName()
+		return nil
+	case document.FieldStorageKey:
+		m.ResetStorageKey()
+		return nil
+	case document.FieldMimeType:
+		m.ResetMimeType()
+		return nil
+	case document.FieldSize:
+		m.ResetSize()
+		return nil
+	case document.FieldResourceType:
+		m.ResetResourceType()
+		return nil
+	case document.FieldTags:
+		m.ResetTags()
+		return nil
+	case document.FieldVisibility:
+		m.ResetVisibility()
+		return nil
+	case document.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
+	case document.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
 	}
-	return fmt.Errorf("unknown PasswordToken field %s", name)
+	return fmt.Errorf("unknown Document field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PasswordTokenMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.user != nil {
-		edges = append(edges, passwordtoken.EdgeUser)
+func (m *DocumentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.owner != nil {
+		edges = append(edges, document.EdgeOwner)
+	}
+	if m.folder != nil {
+		edges = append(edges, document.EdgeFolder)
+	}
+	if m.notes != nil {
+		edges = append(edges, document.EdgeNotes)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PasswordTokenMutation) AddedIDs(name string) []ent.Value {
+func (m *DocumentMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case passwordtoken.EdgeUser:
-		if id := m.user; id != nil {
+	case document.EdgeOwner:
+		if id := m.owner; id != nil {
+			return []ent.Value{*id}
+		}
+	case document.EdgeFolder:
+		if id := m.folder; id != nil {
 			return []ent.Value{*id}
 		}
+	case document.EdgeNotes:
+		ids := make([]ent.Value, 0, len(m.notes))
+		for id := range m.notes {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PasswordTokenMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *DocumentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removednotes != nil {
+		edges = append(edges, document.EdgeNotes)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PasswordTokenMutation) RemovedIDs(name string) []ent.Value {
+func (m *DocumentMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case document.EdgeNotes:
+		ids := make([]ent.Value, 0, len(m.removednotes))
+		for id := range m.removednotes {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PasswordTokenMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.cleareduser {
-		edges = append(edges, passwordtoken.EdgeUser)
+func (m *DocumentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedowner {
+		edges = append(edges, document.EdgeOwner)
+	}
+	if m.clearedfolder {
+		edges = append(edges, document.EdgeFolder)
+	}
+	if m.clearednotes {
+		edges = append(edges, document.EdgeNotes)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PasswordTokenMutation) EdgeCleared(name string) bool {
+func (m *DocumentMutation) EdgeCleared(name string) bool {
 	switch name {
-	case passwordtoken.EdgeUser:
-		return m.cleareduser
+	case document.EdgeOwner:
+		return m.clearedowner
+	case document.EdgeFolder:
+		return m.clearedfolder
+	case document.EdgeNotes:
+		return m.clearednotes
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PasswordTokenMutation) ClearEdge(name string) error {
+func (m *DocumentMutation) ClearEdge(name string) error {
 	switch name {
-	case passwordtoken.EdgeUser:
-		m.ClearUser()
+	case document.EdgeOwner:
+		m.ClearOwner()
+		return nil
+	case document.EdgeFolder:
+		m.ClearFolder()
 		return nil
 	}
-	return fmt.Errorf("unknown PasswordToken unique edge %s", name)
+	return fmt.Errorf("unknown Document unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PasswordTokenMutation) ResetEdge(name string) error {
+func (m *DocumentMutation) ResetEdge(name string) error {
 	switch name {
-	case passwordtoken.EdgeUser:
-		m.ResetUser()
+	case document.EdgeOwner:
+		m.ResetOwner()
+		return nil
+	case document.EdgeFolder:
+		m.ResetFolder()
+		return nil
+	case document.EdgeNotes:
+		m.ResetNotes()
 		return nil
 	}
-	return fmt.Errorf("unknown PasswordToken edge %s", name)
+	return fmt.Errorf("unknown Document edge %s", name)
 }
 
-// UserMutation represents an operation that mutates the User nodes in the graph.
-type UserMutation struct {
+// FolderMutation represents an operation that mutates the Folder nodes in the graph.
+type FolderMutation struct {
 	config
-	op                  Op
-	typ                 string
-	id                  *int
-	name                *string
-	phone_number        *string
-	email               *string
-	password            *string
-	verified            *bool
-	verification_code   *string
-	admin               *bool
-	registration_method *user.RegistrationMethod
-	profile_picture     *string
-	dark_mode           *bool
-	bio                 *string
-	email_notifications *bool
-	sms_notifications   *bool
-	is_active           *bool
-	last_login          *time.Time
-	created_at          *time.Time
-	updated_at          *time.Time
-	clearedFields       map[string]struct{}
-	owner               map[int]struct{}
-	removedowner        map[int]struct{}
-	clearedowner        bool
-	notes               map[int]struct{}
-	removednotes        map[int]struct{}
-	clearednotes        bool
-	note_likes          map[int]struct{}
-	removednote_likes   map[int]struct{}
-	clearednote_likes   bool
-	note_reposts        map[int]struct{}
-	removednote_reposts map[int]struct{}
-	clearednote_reposts bool
-	done                bool
-	oldValue            func(context.Context) (*User, error)
-	predicates          []predicate.User
+	op               Op
+	typ              string
+	id               *int
+	name             *string
+	created_at       *time.Time
+	clearedFields    map[string]struct{}
+	owner            *int
+	clearedowner     bool
+	parent           *int
+	clearedparent    bool
+	children         map[int]struct{}
+	removedchildren  map[int]struct{}
+	clearedchildren  bool
+	documents        map[int]struct{}
+	removeddocuments map[int]struct{}
+	cleareddocuments bool
+	done             bool
+	oldValue         func(context.Context) (*Folder, error)
+	predicates       []predicate.Folder
 }
 
-var _ ent.Mutation = (*UserMutation)(nil)
+var _ ent.Mutation = (*FolderMutation)(nil)
 
-// userOption allows management of the mutation configuration using functional options.
-type userOption func(*UserMutation)
+// folderOption allows management of the mutation configuration using functional options.
+type folderOption func(*FolderMutation)
 
-// newUserMutation creates new mutation for the User entity.
-func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
-	m := &UserMutation{
+// newFolderMutation creates new mutation for the Folder entity.
+func newFolderMutation(c config, op Op, opts ...folderOption) *FolderMutation {
+	m := &FolderMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeUser,
+		typ:           TypeFolder,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2783,20 +3220,20 @@ func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
 	return m
 }
 
-// withUserID sets the ID field of the mutation.
-func withUserID(id int) userOption {
-	return func(m *UserMutation) {
+// withFolderID sets the ID field of the mutation.
+func withFolderID(id int) folderOption {
+	return func(m *FolderMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *User
+			value *Folder
 		)
-		m.oldValue = func(ctx context.Context) (*User, error) {
+		m.oldValue = func(ctx context.Context) (*Folder, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().User.Get(ctx, id)
+					value, err = m.Client().Folder.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2805,10 +3242,10 @@ func withUserID(id int) userOption {
 	}
 }
 
-// withUser sets the old User of the mutation.
-func withUser(node *User) userOption {
-	return func(m *UserMutation) {
-		m.oldValue = func(context.Context) (*User, error) {
+// withFolder sets the old Folder of the mutation.
+func withFolder(node *Folder) folderOption {
+	return func(m *FolderMutation) {
+		m.oldValue = func(context.Context) (*Folder, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2817,7 +3254,7 @@ func withUser(node *User) userOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserMutation) Client() *Client {
+func (m FolderMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2825,7 +3262,7 @@ func (m UserMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m UserMutation) Tx() (*Tx, error) {
+func (m FolderMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2836,7 +3273,7 @@ func (m UserMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *UserMutation) ID() (id int, exists bool) {
+func (m *FolderMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2847,7 +3284,7 @@ func (m *UserMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *FolderMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2856,929 +3293,17386 @@ func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Folder.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *UserMutation) SetName(s string) {
-	m.name = &s
+// SetName sets the "name" field.
+func (m *FolderMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *FolderMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Folder entity.
+// If the Folder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FolderMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *FolderMutation) ResetName() {
+	m.name = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *FolderMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *FolderMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Folder entity.
+// If the Folder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FolderMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *FolderMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by id.
+func (m *FolderMutation) SetOwnerID(id int) {
+	m.owner = &id
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *FolderMutation) ClearOwner() {
+	m.clearedowner = true
+}
+
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *FolderMutation) OwnerCleared() bool {
+	return m.clearedowner
+}
+
+// OwnerID returns the "owner" edge ID in the mutation.
+func (m *FolderMutation) OwnerID() (id int, exists bool) {
+	if m.owner != nil {
+		return *m.owner, true
+	}
+	return
+}
+
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *FolderMutation) OwnerIDs() (ids []int) {
+	if id := m.owner; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOwner resets all changes to the "owner" edge.
+func (m *FolderMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
+}
+
+// SetParentID sets the "parent" edge to the Folder entity by id.
+func (m *FolderMutation) SetParentID(id int) {
+	m.parent = &id
+}
+
+// ClearParent clears the "parent" edge to the Folder entity.
+func (m *FolderMutation) ClearParent() {
+	m.clearedparent = true
+}
+
+// ParentCleared reports if the "parent" edge to the Folder entity was cleared.
+func (m *FolderMutation) ParentCleared() bool {
+	return m.clearedparent
+}
+
+// ParentID returns the "parent" edge ID in the mutation.
+func (m *FolderMutation) ParentID() (id int, exists bool) {
+	if m.parent != nil {
+		return *m.parent, true
+	}
+	return
+}
+
+// ParentIDs returns the "parent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ParentID instead. It exists only for internal usage by the builders.
+func (m *FolderMutation) ParentIDs() (ids []int) {
+	if id := m.parent; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetParent resets all changes to the "parent" edge.
+func (m *FolderMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
+}
+
+// AddChildIDs adds the "children" edge to the Folder entity by ids.
+func (m *FolderMutation) AddChildIDs(ids ...int) {
+	if m.children == nil {
+		m.children = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.children[ids[i]] = struct{}{}
+	}
+}
+
+// ClearChildren clears the "children" edge to the Folder entity.
+func (m *FolderMutation) ClearChildren() {
+	m.clearedchildren = true
+}
+
+// ChildrenCleared reports if the "children" edge to the Folder entity was cleared.
+func (m *FolderMutation) ChildrenCleared() bool {
+	return m.clearedchildren
+}
+
+// RemoveChildIDs removes the "children" edge to the Folder entity by IDs.
+func (m *FolderMutation) RemoveChildIDs(ids ...int) {
+	if m.removedchildren == nil {
+		m.removedchildren = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.children, ids[i])
+		m.removedchildren[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedChildren returns the removed IDs of the "children" edge to the Folder entity.
+func (m *FolderMutation) RemovedChildrenIDs() (ids []int) {
+	for id := range m.removedchildren {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ChildrenIDs returns the "children" edge IDs in the mutation.
+func (m *FolderMutation) ChildrenIDs() (ids []int) {
+	for id := range m.children {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetChildren resets all changes to the "children" edge.
+func (m *FolderMutation) ResetChildren() {
+	m.children = nil
+	m.clearedchildren = false
+	m.removedchildren = nil
+}
+
+// AddDocumentIDs adds the "documents" edge to the Document entity by ids.
+func (m *FolderMutation) AddDocumentIDs(ids ...int) {
+	if m.documents == nil {
+		m.documents = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.documents[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDocuments clears the "documents" edge to the Document entity.
+func (m *FolderMutation) ClearDocuments() {
+	m.cleareddocuments = true
+}
+
+// DocumentsCleared reports if the "documents" edge to the Document entity was cleared.
+func (m *FolderMutation) DocumentsCleared() bool {
+	return m.cleareddocuments
+}
+
+// RemoveDocumentIDs removes the "documents" edge to the Document entity by IDs.
+func (m *FolderMutation) RemoveDocumentIDs(ids ...int) {
+	if m.removeddocuments == nil {
+		m.removeddocuments = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.documents, ids[i])
+		m.removeddocuments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDocuments returns the removed IDs of the "documents" edge to the Document entity.
+func (m *FolderMutation) RemovedDocumentsIDs() (ids []int) {
+	for id := range m.removeddocuments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DocumentsIDs returns the "documents" edge IDs in the mutation.
+func (m *FolderMutation) DocumentsIDs() (ids []int) {
+	for id := range m.documents {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDocuments resets all changes to the "documents" edge.
+func (m *FolderMutation) ResetDocuments() {
+	m.documents = nil
+	m.cleareddocuments = false
+	m.removeddocuments = nil
+}
+
+// Where appends a list predicates to the FolderMutation builder.
+func (m *FolderMutation) Where(ps ...predicate.Folder) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the FolderMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FolderMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Folder, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *FolderMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *FolderMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Folder).
+func (m *FolderMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *FolderMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.name != nil {
+		fields = append(fields, folder.FieldName)
+	}
+	if m.created_at != nil {
+		fields = append(fields, folder.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *FolderMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case folder.FieldName:
+		return m.Name()
+	case folder.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *FolderMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case folder.FieldName:
+		return m.OldName(ctx)
+	case folder.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Folder field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FolderMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case folder.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case folder.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Folder field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FolderMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *FolderMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FolderMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Folder numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *FolderMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *FolderMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FolderMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Folder nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *FolderMutation) ResetField(name string) error {
+	switch name {
+	case folder.FieldName:
+		m.ResetName()
+		return nil
+	case folder.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Folder field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FolderMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.owner != nil {
+		edges = append(edges, folder.EdgeOwner)
+	}
+	if m.parent != nil {
+		edges = append(edges, folder.EdgeParent)
+	}
+	if m.children != nil {
+		edges = append(edges, folder.EdgeChildren)
+	}
+	if m.documents != nil {
+		edges = append(edges, folder.EdgeDocuments)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FolderMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case folder.EdgeOwner:
+		if id := m.owner; id != nil {
+			return []ent.Value{*id}
+		}
+	case folder.EdgeParent:
+		if id := m.parent; id != nil {
+			return []ent.Value{*id}
+		}
+	case folder.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.children))
+		for id := range m.children {
+			ids = append(ids, id)
+		}
+		return ids
+	case folder.EdgeDocuments:
+		ids := make([]ent.Value, 0, len(m.documents))
+		for id := range m.documents {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FolderMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedchildren != nil {
+		edges = append(edges, folder.EdgeChildren)
+	}
+	if m.removeddocuments != nil {
+		edges = append(edges, folder.EdgeDocuments)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FolderMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case folder.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.removedchildren))
+		for id := range m.removedchildren {
+			ids = append(ids, id)
+		}
+		return ids
+	case folder.EdgeDocuments:
+		ids := make([]ent.Value, 0, len(m.removeddocuments))
+		for id := range m.removeddocuments {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FolderMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedowner {
+		edges = append(edges, folder.EdgeOwner)
+	}
+	if m.clearedparent {
+		edges = append(edges, folder.EdgeParent)
+	}
+	if m.clearedchildren {
+		edges = append(edges, folder.EdgeChildren)
+	}
+	if m.cleareddocuments {
+		edges = append(edges, folder.EdgeDocuments)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FolderMutation) EdgeCleared(name string) bool {
+	switch name {
+	case folder.EdgeOwner:
+		return m.clearedowner
+	case folder.EdgeParent:
+		return m.clearedparent
+	case folder.EdgeChildren:
+		return m.clearedchildren
+	case folder.EdgeDocuments:
+		return m.cleareddocuments
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FolderMutation) ClearEdge(name string) error {
+	switch name {
+	case folder.EdgeOwner:
+		m.ClearOwner()
+		return nil
+	case folder.EdgeParent:
+		m.ClearParent()
+		return nil
+	}
+	return fmt.Errorf("unknown Folder unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FolderMutation) ResetEdge(name string) error {
+	switch name {
+	case folder.EdgeOwner:
+		m.ResetOwner()
+		return nil
+	case folder.EdgeParent:
+		m.ResetParent()
+		return nil
+	case folder.EdgeChildren:
+		m.ResetChildren()
+		return nil
+	case folder.EdgeDocuments:
+		m.ResetDocuments()
+		return nil
+	}
+	return fmt.Errorf("unknown Folder edge %s", name)
+}
+
+// GroupInviteMutation represents an operation that mutates the GroupInvite nodes in the graph.
+type GroupInviteMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	token          *string
+	phone_number   *string
+	max_uses       *int
+	addmax_uses    *int
+	uses           *int
+	adduses        *int
+	expires_at     *time.Time
+	revoked_at     *time.Time
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	group          *int
+	clearedgroup   bool
+	creator        *int
+	clearedcreator bool
+	done           bool
+	oldValue       func(context.Context) (*GroupInvite, error)
+	predicates     []predicate.GroupInvite
+}
+
+var _ ent.Mutation = (*GroupInviteMutation)(nil)
+
+// groupinviteOption allows management of the mutation configuration using functional options.
+type groupinviteOption func(*GroupInviteMutation)
+
+// newGroupInviteMutation creates new mutation for the GroupInvite entity.
+func newGroupInviteMutation(c config, op Op, opts ...groupinviteOption) *GroupInviteMutation {
+	m := &GroupInviteMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeGroupInvite,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withGroupInviteID sets the ID field of the mutation.
+func withGroupInviteID(id int) groupinviteOption {
+	return func(m *GroupInviteMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *GroupInvite
+		)
+		m.oldValue = func(ctx context.Context) (*GroupInvite, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().GroupInvite.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withGroupInvite sets the old GroupInvite of the mutation.
+func withGroupInvite(node *GroupInvite) groupinviteOption {
+	return func(m *GroupInviteMutation) {
+		m.oldValue = func(context.Context) (*GroupInvite, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m GroupInviteMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m GroupInviteMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *GroupInviteMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *GroupInviteMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().GroupInvite.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetToken sets the "token" field.
+func (m *GroupInviteMutation) SetToken(s string) {
+	m.token = &s
+}
+
+// Token returns the value of the "token" field in the mutation.
+func (m *GroupInviteMutation) Token() (r string, exists bool) {
+	v := m.token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldToken returns the old "token" field's value of the GroupInvite entity.
+// If the GroupInvite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupInviteMutation) OldToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToken: %w", err)
+	}
+	return oldValue.Token, nil
+}
+
+// ResetToken resets all changes to the "token" field.
+func (m *GroupInviteMutation) ResetToken() {
+	m.token = nil
+}
+
+// SetPhoneNumber sets the "phone_number" field.
+func (m *GroupInviteMutation) SetPhoneNumber(s string) {
+	m.phone_number = &s
+}
+
+// PhoneNumber returns the value of the "phone_number" field in the mutation.
+func (m *GroupInviteMutation) PhoneNumber() (r string, exists bool) {
+	v := m.phone_number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPhoneNumber returns the old "phone_number" field's value of the GroupInvite entity.
+// If the GroupInvite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupInviteMutation) OldPhoneNumber(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPhoneNumber is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPhoneNumber requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPhoneNumber: %w", err)
+	}
+	return oldValue.PhoneNumber, nil
+}
+
+// ClearPhoneNumber clears the value of the "phone_number" field.
+func (m *GroupInviteMutation) ClearPhoneNumber() {
+	m.phone_number = nil
+	m.clearedFields[groupinvite.FieldPhoneNumber] = struct{}{}
+}
+
+// PhoneNumberCleared returns if the "phone_number" field was cleared in this mutation.
+func (m *GroupInviteMutation) PhoneNumberCleared() bool {
+	_, ok := m.clearedFields[groupinvite.FieldPhoneNumber]
+	return ok
+}
+
+// ResetPhoneNumber resets all changes to the "phone_number" field.
+func (m *GroupInviteMutation) ResetPhoneNumber() {
+	m.phone_number = nil
+	delete(m.clearedFields, groupinvite.FieldPhoneNumber)
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (m *GroupInviteMutation) SetMaxUses(i int) {
+	m.max_uses = &i
+	m.addmax_uses = nil
+}
+
+// MaxUses returns the value of the "max_uses" field in the mutation.
+func (m *GroupInviteMutation) MaxUses() (r int, exists bool) {
+	v := m.max_uses
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxUses returns the old "max_uses" field's value of the GroupInvite entity.
+// If the GroupInvite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupInviteMutation) OldMaxUses(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxUses is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxUses requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxUses: %w", err)
+	}
+	return oldValue.MaxUses, nil
+}
+
+// AddMaxUses adds i to the "max_uses" field.
+func (m *GroupInviteMutation) AddMaxUses(i int) {
+	if m.addmax_uses != nil {
+		*m.addmax_uses += i
+	} else {
+		m.addmax_uses = &i
+	}
+}
+
+// AddedMaxUses returns the value that was added to the "max_uses" field in this mutation.
+func (m *GroupInviteMutation) AddedMaxUses() (r int, exists bool) {
+	v := m.addmax_uses
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMaxUses resets all changes to the "max_uses" field.
+func (m *GroupInviteMutation) ResetMaxUses() {
+	m.max_uses = nil
+	m.addmax_uses = nil
+}
+
+// SetUses sets the "uses" field.
+func (m *GroupInviteMutation) SetUses(i int) {
+	m.uses = &i
+	m.adduses = nil
+}
+
+// Uses returns the value of the "uses" field in the mutation.
+func (m *GroupInviteMutation) Uses() (r int, exists bool) {
+	v := m.uses
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUses returns the old "uses" field's value of the GroupInvite entity.
+// If the GroupInvite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupInviteMutation) OldUses(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUses is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUses requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUses: %w", err)
+	}
+	return oldValue.Uses, nil
+}
+
+// AddUses adds i to the "uses" field.
+func (m *GroupInviteMutation) AddUses(i int) {
+	if m.adduses != nil {
+		*m.adduses += i
+	} else {
+		m.adduses = &i
+	}
+}
+
+// AddedUses returns the value that was added to the "uses" field in this mutation.
+func (m *GroupInviteMutation) AddedUses() (r int, exists bool) {
+	v := m.adduses
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUses resets all changes to the "uses" field.
+func (m *GroupInviteMutation) ResetUses() {
+	m.uses = nil
+	m.adduses = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *GroupInviteMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *GroupInviteMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the GroupInvite entity.
+// If the GroupInvite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupInviteMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *GroupInviteMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (m *GroupInviteMutation) SetRevokedAt(t time.Time) {
+	m.revoked_at = &t
+}
+
+// RevokedAt returns the value of the "revoked_at" field in the mutation.
+func (m *GroupInviteMutation) RevokedAt() (r time.Time, exists bool) {
+	v := m.revoked_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRevokedAt returns the old "revoked_at" field's value of the GroupInvite entity.
+// If the GroupInvite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupInviteMutation) OldRevokedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRevokedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRevokedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevokedAt: %w", err)
+	}
+	return oldValue.RevokedAt, nil
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (m *GroupInviteMutation) ClearRevokedAt() {
+	m.revoked_at = nil
+	m.clearedFields[groupinvite.FieldRevokedAt] = struct{}{}
+}
+
+// RevokedAtCleared returns if the "revoked_at" field was cleared in this mutation.
+func (m *GroupInviteMutation) RevokedAtCleared() bool {
+	_, ok := m.clearedFields[groupinvite.FieldRevokedAt]
+	return ok
+}
+
+// ResetRevokedAt resets all changes to the "revoked_at" field.
+func (m *GroupInviteMutation) ResetRevokedAt() {
+	m.revoked_at = nil
+	delete(m.clearedFields, groupinvite.FieldRevokedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *GroupInviteMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *GroupInviteMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the GroupInvite entity.
+// If the GroupInvite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupInviteMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *GroupInviteMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetGroupID sets the "group" edge to the StudyGroup entity by id.
+func (m *GroupInviteMutation) SetGroupID(id int) {
+	m.group = &id
+}
+
+// ClearGroup clears the "group" edge to the StudyGroup entity.
+func (m *GroupInviteMutation) ClearGroup() {
+	m.clearedgroup = true
+}
+
+// GroupCleared reports if the "group" edge to the StudyGroup entity was cleared.
+func (m *GroupInviteMutation) GroupCleared() bool {
+	return m.clearedgroup
+}
+
+// GroupID returns the "group" edge ID in the mutation.
+func (m *GroupInviteMutation) GroupID() (id int, exists bool) {
+	if m.group != nil {
+		return *m.group, true
+	}
+	return
+}
+
+// GroupIDs returns the "group" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GroupID instead. It exists only for internal usage by the builders.
+func (m *GroupInviteMutation) GroupIDs() (ids []int) {
+	if id := m.group; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGroup resets all changes to the "group" edge.
+func (m *GroupInviteMutation) ResetGroup() {
+	m.group = nil
+	m.clearedgroup = false
+}
+
+// SetCreatorID sets the "creator" edge to the User entity by id.
+func (m *GroupInviteMutation) SetCreatorID(id int) {
+	m.creator = &id
+}
+
+// ClearCreator clears the "creator" edge to the User entity.
+func (m *GroupInviteMutation) ClearCreator() {
+	m.clearedcreator = true
+}
+
+// CreatorCleared reports if the "creator" edge to the User entity was cleared.
+func (m *GroupInviteMutation) CreatorCleared() bool {
+	return m.clearedcreator
+}
+
+// CreatorID returns the "creator" edge ID in the mutation.
+func (m *GroupInviteMutation) CreatorID() (id int, exists bool) {
+	if m.creator != nil {
+		return *m.creator, true
+	}
+	return
+}
+
+// CreatorIDs returns the "creator" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CreatorID instead. It exists only for internal usage by the builders.
+func (m *GroupInviteMutation) CreatorIDs() (ids []int) {
+	if id := m.creator; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCreator resets all changes to the "creator" edge.
+func (m *GroupInviteMutation) ResetCreator() {
+	m.creator = nil
+	m.clearedcreator = false
+}
+
+// Where appends a list predicates to the GroupInviteMutation builder.
+func (m *GroupInviteMutation) Where(ps ...predicate.GroupInvite) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the GroupInviteMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GroupInviteMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.GroupInvite, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *GroupInviteMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *GroupInviteMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (GroupInvite).
+func (m *GroupInviteMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GroupInviteMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.token != nil {
+		fields = append(fields, groupinvite.FieldToken)
+	}
+	if m.phone_number != nil {
+		fields = append(fields, groupinvite.FieldPhoneNumber)
+	}
+	if m.max_uses != nil {
+		fields = append(fields, groupinvite.FieldMaxUses)
+	}
+	if m.uses != nil {
+		fields = append(fields, groupinvite.FieldUses)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, groupinvite.FieldExpiresAt)
+	}
+	if m.revoked_at != nil {
+		fields = append(fields, groupinvite.FieldRevokedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, groupinvite.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GroupInviteMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case groupinvite.FieldToken:
+		return m.Token()
+	case groupinvite.FieldPhoneNumber:
+		return m.PhoneNumber()
+	case groupinvite.FieldMaxUses:
+		return m.MaxUses()
+	case groupinvite.FieldUses:
+		return m.Uses()
+	case groupinvite.FieldExpiresAt:
+		return m.ExpiresAt()
+	case groupinvite.FieldRevokedAt:
+		return m.RevokedAt()
+	case groupinvite.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GroupInviteMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case groupinvite.FieldToken:
+		return m.OldToken(ctx)
+	case groupinvite.FieldPhoneNumber:
+		return m.OldPhoneNumber(ctx)
+	case groupinvite.FieldMaxUses:
+		return m.OldMaxUses(ctx)
+	case groupinvite.FieldUses:
+		return m.OldUses(ctx)
+	case groupinvite.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case groupinvite.FieldRevokedAt:
+		return m.OldRevokedAt(ctx)
+	case groupinvite.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown GroupInvite field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GroupInviteMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case groupinvite.FieldToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToken(v)
+		return nil
+	case groupinvite.FieldPhoneNumber:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPhoneNumber(v)
+		return nil
+	case groupinvite.FieldMaxUses:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxUses(v)
+		return nil
+	case groupinvite.FieldUses:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUses(v)
+		return nil
+	case groupinvite.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case groupinvite.FieldRevokedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevokedAt(v)
+		return nil
+	case groupinvite.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown GroupInvite field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GroupInviteMutation) AddedFields() []string {
+	var fields []string
+	if m.addmax_uses != nil {
+		fields = append(fields, groupinvite.FieldMaxUses)
+	}
+	if m.adduses != nil {
+		fields = append(fields, groupinvite.FieldUses)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GroupInviteMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case groupinvite.FieldMaxUses:
+		return m.AddedMaxUses()
+	case groupinvite.FieldUses:
+		return m.AddedUses()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GroupInviteMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case groupinvite.FieldMaxUses:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxUses(v)
+		return nil
+	case groupinvite.FieldUses:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUses(v)
+		return nil
+	}
+	return fmt.Errorf("unknown GroupInvite numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GroupInviteMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(groupinvite.FieldPhoneNumber) {
+		fields = append(fields, groupinvite.FieldPhoneNumber)
+	}
+	if m.FieldCleared(groupinvite.FieldRevokedAt) {
+		fields = append(fields, groupinvite.FieldRevokedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GroupInviteMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GroupInviteMutation) ClearField(name string) error {
+	switch name {
+	case groupinvite.FieldPhoneNumber:
+		m.ClearPhoneNumber()
+		return nil
+	case groupinvite.FieldRevokedAt:
+		m.ClearRevokedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown GroupInvite nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GroupInviteMutation) ResetField(name string) error {
+	switch name {
+	case groupinvite.FieldToken:
+		m.ResetToken()
+		return nil
+	case groupinvite.FieldPhoneNumber:
+		m.ResetPhoneNumber()
+		return nil
+	case groupinvite.FieldMaxUses:
+		m.ResetMaxUses()
+		return nil
+	case groupinvite.FieldUses:
+		m.ResetUses()
+		return nil
+	case groupinvite.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case groupinvite.FieldRevokedAt:
+		m.ResetRevokedAt()
+		return nil
+	case groupinvite.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown GroupInvite field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GroupInviteMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.group != nil {
+		edges = append(edges, groupinvite.EdgeGroup)
+	}
+	if m.creator != nil {
+		edges = append(edges, groupinvite.EdgeCreator)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GroupInviteMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case groupinvite.EdgeGroup:
+		if id := m.group; id != nil {
+			return []ent.Value{*id}
+		}
+	case groupinvite.EdgeCreator:
+		if id := m.creator; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GroupInviteMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GroupInviteMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GroupInviteMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedgroup {
+		edges = append(edges, groupinvite.EdgeGroup)
+	}
+	if m.clearedcreator {
+		edges = append(edges, groupinvite.EdgeCreator)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GroupInviteMutation) EdgeCleared(name string) bool {
+	switch name {
+	case groupinvite.EdgeGroup:
+		return m.clearedgroup
+	case groupinvite.EdgeCreator:
+		return m.clearedcreator
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GroupInviteMutation) ClearEdge(name string) error {
+	switch name {
+	case groupinvite.EdgeGroup:
+		m.ClearGroup()
+		return nil
+	case groupinvite.EdgeCreator:
+		m.ClearCreator()
+		return nil
+	}
+	return fmt.Errorf("unknown GroupInvite unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GroupInviteMutation) ResetEdge(name string) error {
+	switch name {
+	case groupinvite.EdgeGroup:
+		m.ResetGroup()
+		return nil
+	case groupinvite.EdgeCreator:
+		m.ResetCreator()
+		return nil
+	}
+	return fmt.Errorf("unknown GroupInvite edge %s", name)
+}
+
+// GroupJoinRequestMutation represents an operation that mutates the GroupJoinRequest nodes in the graph.
+type GroupJoinRequestMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	message       *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *int
+	cleareduser   bool
+	group         *int
+	clearedgroup  bool
+	done          bool
+	oldValue      func(context.Context) (*GroupJoinRequest, error)
+	predicates    []predicate.GroupJoinRequest
+}
+
+var _ ent.Mutation = (*GroupJoinRequestMutation)(nil)
+
+// groupjoinrequestOption allows management of the mutation configuration using functional options.
+type groupjoinrequestOption func(*GroupJoinRequestMutation)
+
+// newGroupJoinRequestMutation creates new mutation for the GroupJoinRequest entity.
+func newGroupJoinRequestMutation(c config, op Op, opts ...groupjoinrequestOption) *GroupJoinRequestMutation {
+	m := &GroupJoinRequestMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeGroupJoinRequest,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withGroupJoinRequestID sets the ID field of the mutation.
+func withGroupJoinRequestID(id int) groupjoinrequestOption {
+	return func(m *GroupJoinRequestMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *GroupJoinRequest
+		)
+		m.oldValue = func(ctx context.Context) (*GroupJoinRequest, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().GroupJoinRequest.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withGroupJoinRequest sets the old GroupJoinRequest of the mutation.
+func withGroupJoinRequest(node *GroupJoinRequest) groupjoinrequestOption {
+	return func(m *GroupJoinRequestMutation) {
+		m.oldValue = func(context.Context) (*GroupJoinRequest, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m GroupJoinRequestMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m GroupJoinRequestMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *GroupJoinRequestMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *GroupJoinRequestMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().GroupJoinRequest.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetMessage sets the "message" field.
+func (m *GroupJoinRequestMutation) SetMessage(s string) {
+	m.message = &s
+}
+
+// Message returns the value of the "message" field in the mutation.
+func (m *GroupJoinRequestMutation) Message() (r string, exists bool) {
+	v := m.message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessage returns the old "message" field's value of the GroupJoinRequest entity.
+// If the GroupJoinRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupJoinRequestMutation) OldMessage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessage: %w", err)
+	}
+	return oldValue.Message, nil
+}
+
+// ClearMessage clears the value of the "message" field.
+func (m *GroupJoinRequestMutation) ClearMessage() {
+	m.message = nil
+	m.clearedFields[groupjoinrequest.FieldMessage] = struct{}{}
+}
+
+// MessageCleared returns if the "message" field was cleared in this mutation.
+func (m *GroupJoinRequestMutation) MessageCleared() bool {
+	_, ok := m.clearedFields[groupjoinrequest.FieldMessage]
+	return ok
+}
+
+// ResetMessage resets all changes to the "message" field.
+func (m *GroupJoinRequestMutation) ResetMessage() {
+	m.message = nil
+	delete(m.clearedFields, groupjoinrequest.FieldMessage)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *GroupJoinRequestMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *GroupJoinRequestMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the GroupJoinRequest entity.
+// If the GroupJoinRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupJoinRequestMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *GroupJoinRequestMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *GroupJoinRequestMutation) SetUserID(id int) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *GroupJoinRequestMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *GroupJoinRequestMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *GroupJoinRequestMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *GroupJoinRequestMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *GroupJoinRequestMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// SetGroupID sets the "group" edge to the StudyGroup entity by id.
+func (m *GroupJoinRequestMutation) SetGroupID(id int) {
+	m.group = &id
+}
+
+// ClearGroup clears the "group" edge to the StudyGroup entity.
+func (m *GroupJoinRequestMutation) ClearGroup() {
+	m.clearedgroup = true
+}
+
+// GroupCleared reports if the "group" edge to the StudyGroup entity was cleared.
+func (m *GroupJoinRequestMutation) GroupCleared() bool {
+	return m.clearedgroup
+}
+
+// GroupID returns the "group" edge ID in the mutation.
+func (m *GroupJoinRequestMutation) GroupID() (id int, exists bool) {
+	if m.group != nil {
+		return *m.group, true
+	}
+	return
+}
+
+// GroupIDs returns the "group" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GroupID instead. It exists only for internal usage by the builders.
+func (m *GroupJoinRequestMutation) GroupIDs() (ids []int) {
+	if id := m.group; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGroup resets all changes to the "group" edge.
+func (m *GroupJoinRequestMutation) ResetGroup() {
+	m.group = nil
+	m.clearedgroup = false
+}
+
+// Where appends a list predicates to the GroupJoinRequestMutation builder.
+func (m *GroupJoinRequestMutation) Where(ps ...predicate.GroupJoinRequest) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the GroupJoinRequestMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GroupJoinRequestMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.GroupJoinRequest, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *GroupJoinRequestMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *GroupJoinRequestMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (GroupJoinRequest).
+func (m *GroupJoinRequestMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GroupJoinRequestMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.message != nil {
+		fields = append(fields, groupjoinrequest.FieldMessage)
+	}
+	if m.created_at != nil {
+		fields = append(fields, groupjoinrequest.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GroupJoinRequestMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case groupjoinrequest.FieldMessage:
+		return m.Message()
+	case groupjoinrequest.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GroupJoinRequestMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case groupjoinrequest.FieldMessage:
+		return m.OldMessage(ctx)
+	case groupjoinrequest.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown GroupJoinRequest field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GroupJoinRequestMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case groupjoinrequest.FieldMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessage(v)
+		return nil
+	case groupjoinrequest.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown GroupJoinRequest field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GroupJoinRequestMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GroupJoinRequestMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GroupJoinRequestMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown GroupJoinRequest numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GroupJoinRequestMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(groupjoinrequest.FieldMessage) {
+		fields = append(fields, groupjoinrequest.FieldMessage)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GroupJoinRequestMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GroupJoinRequestMutation) ClearField(name string) error {
+	switch name {
+	case groupjoinrequest.FieldMessage:
+		m.ClearMessage()
+		return nil
+	}
+	return fmt.Errorf("unknown GroupJoinRequest nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GroupJoinRequestMutation) ResetField(name string) error {
+	switch name {
+	case groupjoinrequest.FieldMessage:
+		m.ResetMessage()
+		return nil
+	case groupjoinrequest.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown GroupJoinRequest field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GroupJoinRequestMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.user != nil {
+		edges = append(edges, groupjoinrequest.EdgeUser)
+	}
+	if m.group != nil {
+		edges = append(edges, groupjoinrequest.EdgeGroup)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GroupJoinRequestMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case groupjoinrequest.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case groupjoinrequest.EdgeGroup:
+		if id := m.group; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GroupJoinRequestMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GroupJoinRequestMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GroupJoinRequestMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareduser {
+		edges = append(edges, groupjoinrequest.EdgeUser)
+	}
+	if m.clearedgroup {
+		edges = append(edges, groupjoinrequest.EdgeGroup)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GroupJoinRequestMutation) EdgeCleared(name string) bool {
+	switch name {
+	case groupjoinrequest.EdgeUser:
+		return m.cleareduser
+	case groupjoinrequest.EdgeGroup:
+		return m.clearedgroup
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GroupJoinRequestMutation) ClearEdge(name string) error {
+	switch name {
+	case groupjoinrequest.EdgeUser:
+		m.ClearUser()
+		return nil
+	case groupjoinrequest.EdgeGroup:
+		m.ClearGroup()
+		return nil
+	}
+	return fmt.Errorf("unknown GroupJoinRequest unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GroupJoinRequestMutation) ResetEdge(name string) error {
+	switch name {
+	case groupjoinrequest.EdgeUser:
+		m.ResetUser()
+		return nil
+	case groupjoinrequest.EdgeGroup:
+		m.ResetGroup()
+		return nil
+	}
+	return fmt.Errorf("unknown GroupJoinRequest edge %s", name)
+}
+
+// GroupMembershipMutation represents an operation that mutates the GroupMembership nodes in the graph.
+type GroupMembershipMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	role          *groupmembership.Role
+	joined_at     *time.Time
+	clearedFields map[string]struct{}
+	user          *int
+	cleareduser   bool
+	group         *int
+	clearedgroup  bool
+	done          bool
+	oldValue      func(context.Context) (*GroupMembership, error)
+	predicates    []predicate.GroupMembership
+}
+
+var _ ent.Mutation = (*GroupMembershipMutation)(nil)
+
+// groupmembershipOption allows management of the mutation configuration using functional options.
+type groupmembershipOption func(*GroupMembershipMutation)
+
+// newGroupMembershipMutation creates new mutation for the GroupMembership entity.
+func newGroupMembershipMutation(c config, op Op, opts ...groupmembershipOption) *GroupMembershipMutation {
+	m := &GroupMembershipMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeGroupMembership,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withGroupMembershipID sets the ID field of the mutation.
+func withGroupMembershipID(id int) groupmembershipOption {
+	return func(m *GroupMembershipMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *GroupMembership
+		)
+		m.oldValue = func(ctx context.Context) (*GroupMembership, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().GroupMembership.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withGroupMembership sets the old GroupMembership of the mutation.
+func withGroupMembership(node *GroupMembership) groupmembershipOption {
+	return func(m *GroupMembershipMutation) {
+		m.oldValue = func(context.Context) (*GroupMembership, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m GroupMembershipMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m GroupMembershipMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *GroupMembershipMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *GroupMembershipMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().GroupMembership.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetRole sets the "role" field.
+func (m *GroupMembershipMutation) SetRole(gr groupmembership.Role) {
+	m.role = &gr
+}
+
+// Role returns the value of the "role" field in the mutation.
+func (m *GroupMembershipMutation) Role() (r groupmembership.Role, exists bool) {
+	v := m.role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRole returns the old "role" field's value of the GroupMembership entity.
+// If the GroupMembership object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMembershipMutation) OldRole(ctx context.Context) (v groupmembership.Role, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRole requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+	}
+	return oldValue.Role, nil
+}
+
+// ResetRole resets all changes to the "role" field.
+func (m *GroupMembershipMutation) ResetRole() {
+	m.role = nil
+}
+
+// SetJoinedAt sets the "joined_at" field.
+func (m *GroupMembershipMutation) SetJoinedAt(t time.Time) {
+	m.joined_at = &t
+}
+
+// JoinedAt returns the value of the "joined_at" field in the mutation.
+func (m *GroupMembershipMutation) JoinedAt() (r time.Time, exists bool) {
+	v := m.joined_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJoinedAt returns the old "joined_at" field's value of the GroupMembership entity.
+// If the GroupMembership object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMembershipMutation) OldJoinedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJoinedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJoinedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJoinedAt: %w", err)
+	}
+	return oldValue.JoinedAt, nil
+}
+
+// ResetJoinedAt resets all changes to the "joined_at" field.
+func (m *GroupMembershipMutation) ResetJoinedAt() {
+	m.joined_at = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *GroupMembershipMutation) SetUserID(id int) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *GroupMembershipMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *GroupMembershipMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *GroupMembershipMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *GroupMembershipMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *GroupMembershipMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// SetGroupID sets the "group" edge to the StudyGroup entity by id.
+func (m *GroupMembershipMutation) SetGroupID(id int) {
+	m.group = &id
+}
+
+// ClearGroup clears the "group" edge to the StudyGroup entity.
+func (m *GroupMembershipMutation) ClearGroup() {
+	m.clearedgroup = true
+}
+
+// GroupCleared reports if the "group" edge to the StudyGroup entity was cleared.
+func (m *GroupMembershipMutation) GroupCleared() bool {
+	return m.clearedgroup
+}
+
+// GroupID returns the "group" edge ID in the mutation.
+func (m *GroupMembershipMutation) GroupID() (id int, exists bool) {
+	if m.group != nil {
+		return *m.group, true
+	}
+	return
+}
+
+// GroupIDs returns the "group" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GroupID instead. It exists only for internal usage by the builders.
+func (m *GroupMembershipMutation) GroupIDs() (ids []int) {
+	if id := m.group; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGroup resets all changes to the "group" edge.
+func (m *GroupMembershipMutation) ResetGroup() {
+	m.group = nil
+	m.clearedgroup = false
+}
+
+// Where appends a list predicates to the GroupMembershipMutation builder.
+func (m *GroupMembershipMutation) Where(ps ...predicate.GroupMembership) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the GroupMembershipMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GroupMembershipMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.GroupMembership, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *GroupMembershipMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *GroupMembershipMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (GroupMembership).
+func (m *GroupMembershipMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GroupMembershipMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.role != nil {
+		fields = append(fields, groupmembership.FieldRole)
+	}
+	if m.joined_at != nil {
+		fields = append(fields, groupmembership.FieldJoinedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GroupMembershipMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case groupmembership.FieldRole:
+		return m.Role()
+	case groupmembership.FieldJoinedAt:
+		return m.JoinedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GroupMembershipMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case groupmembership.FieldRole:
+		return m.OldRole(ctx)
+	case groupmembership.FieldJoinedAt:
+		return m.OldJoinedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown GroupMembership field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GroupMembershipMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case groupmembership.FieldRole:
+		v, ok := value.(groupmembership.Role)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRole(v)
+		return nil
+	case groupmembership.FieldJoinedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJoinedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown GroupMembership field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GroupMembershipMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GroupMembershipMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GroupMembershipMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown GroupMembership numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GroupMembershipMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GroupMembershipMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GroupMembershipMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown GroupMembership nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GroupMembershipMutation) ResetField(name string) error {
+	switch name {
+	case groupmembership.FieldRole:
+		m.ResetRole()
+		return nil
+	case groupmembership.FieldJoinedAt:
+		m.ResetJoinedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown GroupMembership field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GroupMembershipMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.user != nil {
+		edges = append(edges, groupmembership.EdgeUser)
+	}
+	if m.group != nil {
+		edges = append(edges, groupmembership.EdgeGroup)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GroupMembershipMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case groupmembership.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case groupmembership.EdgeGroup:
+		if id := m.group; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GroupMembershipMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GroupMembershipMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GroupMembershipMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareduser {
+		edges = append(edges, groupmembership.EdgeUser)
+	}
+	if m.clearedgroup {
+		edges = append(edges, groupmembership.EdgeGroup)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GroupMembershipMutation) EdgeCleared(name string) bool {
+	switch name {
+	case groupmembership.EdgeUser:
+		return m.cleareduser
+	case groupmembership.EdgeGroup:
+		return m.clearedgroup
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GroupMembershipMutation) ClearEdge(name string) error {
+	switch name {
+	case groupmembership.EdgeUser:
+		m.ClearUser()
+		return nil
+	case groupmembership.EdgeGroup:
+		m.ClearGroup()
+		return nil
+	}
+	return fmt.Errorf("unknown GroupMembership unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GroupMembershipMutation) ResetEdge(name string) error {
+	switch name {
+	case groupmembership.EdgeUser:
+		m.ResetUser()
+		return nil
+	case groupmembership.EdgeGroup:
+		m.ResetGroup()
+		return nil
+	}
+	return fmt.Errorf("unknown GroupMembership edge %s", name)
+}
+
+// NoteMutation represents an operation that mutates the Note nodes in the graph.
+type NoteMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *int
+	title                *string
+	description          *string
+	content              *string
+	resources            *[]types.Resource
+	appendresources      []types.Resource
+	ai_curriculum        *string
+	visibility           *note.Visibility
+	permission_level     *note.PermissionLevel
+	share_token          *string
+	ai_processing        *bool
+	created_at           *time.Time
+	updated_at           *time.Time
+	clearedFields        map[string]struct{}
+	owner                *int
+	clearedowner         bool
+	likes                map[int]struct{}
+	removedlikes         map[int]struct{}
+	clearedlikes         bool
+	reposts              map[int]struct{}
+	removedreposts       map[int]struct{}
+	clearedreposts       bool
+	practice_sets        map[int]struct{}
+	removedpractice_sets map[int]struct{}
+	clearedpractice_sets bool
+	study_events         map[int]struct{}
+	removedstudy_events  map[int]struct{}
+	clearedstudy_events  bool
+	groups               map[int]struct{}
+	removedgroups        map[int]struct{}
+	clearedgroups        bool
+	documents            map[int]struct{}
+	removeddocuments     map[int]struct{}
+	cleareddocuments     bool
+	proposals            map[int]struct{}
+	removedproposals     map[int]struct{}
+	clearedproposals     bool
+	revisions            map[int]struct{}
+	removedrevisions     map[int]struct{}
+	clearedrevisions     bool
+	notebooks            map[int]struct{}
+	removednotebooks     map[int]struct{}
+	clearednotebooks     bool
+	tags                 map[int]struct{}
+	removedtags          map[int]struct{}
+	clearedtags          bool
+	links                map[int]struct{}
+	removedlinks         map[int]struct{}
+	clearedlinks         bool
+	backlinks            map[int]struct{}
+	removedbacklinks     map[int]struct{}
+	clearedbacklinks     bool
+	done                 bool
+	oldValue             func(context.Context) (*Note, error)
+	predicates           []predicate.Note
+}
+
+var _ ent.Mutation = (*NoteMutation)(nil)
+
+// noteOption allows management of the mutation configuration using functional options.
+type noteOption func(*NoteMutation)
+
+// newNoteMutation creates new mutation for the Note entity.
+func newNoteMutation(c config, op Op, opts ...noteOption) *NoteMutation {
+	m := &NoteMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeNote,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withNoteID sets the ID field of the mutation.
+func withNoteID(id int) noteOption {
+	return func(m *NoteMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Note
+		)
+		m.oldValue = func(ctx context.Context) (*Note, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Note.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withNote sets the old Note of the mutation.
+func withNote(node *Note) noteOption {
+	return func(m *NoteMutation) {
+		m.oldValue = func(context.Context) (*Note, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NoteMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NoteMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *NoteMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *NoteMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Note.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTitle sets the "title" field.
+func (m *NoteMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *NoteMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the Note entity.
+// If the Note object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *NoteMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *NoteMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *NoteMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Note entity.
+// If the Note object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *NoteMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[note.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *NoteMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[note.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *NoteMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, note.FieldDescription)
+}
+
+// SetContent sets the "content" field.
+func (m *NoteMutation) SetContent(s string) {
+	m.content = &s
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *NoteMutation) Content() (r string, exists bool) {
+	v := m.content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContent returns the old "content" field's value of the Note entity.
+// If the Note object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteMutation) OldContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
+}
+
+// ClearContent clears the value of the "content" field.
+func (m *NoteMutation) ClearContent() {
+	m.content = nil
+	m.clearedFields[note.FieldContent] = struct{}{}
+}
+
+// ContentCleared returns if the "content" field was cleared in this mutation.
+func (m *NoteMutation) ContentCleared() bool {
+	_, ok := m.clearedFields[note.FieldContent]
+	return ok
+}
+
+// ResetContent resets all changes to the "content" field.
+func (m *NoteMutation) ResetContent() {
+	m.content = nil
+	delete(m.clearedFields, note.FieldContent)
+}
+
+// SetResources sets the "resources" field.
+func (m *NoteMutation) SetResources(t []types.Resource) {
+	m.resources = &t
+	m.appendresources = nil
+}
+
+// Resources returns the value of the "resources" field in the mutation.
+func (m *NoteMutation) Resources() (r []types.Resource, exists bool) {
+	v := m.resources
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResources returns the old "resources" field's value of the Note entity.
+// If the Note object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteMutation) OldResources(ctx context.Context) (v []types.Resource, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResources is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResources requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResources: %w", err)
+	}
+	return oldValue.Resources, nil
+}
+
+// AppendResources adds t to the "resources" field.
+func (m *NoteMutation) AppendResources(t []types.Resource) {
+	m.appendresources = append(m.appendresources, t...)
+}
+
+// AppendedResources returns the list of values that were appended to the "resources" field in this mutation.
+func (m *NoteMutation) AppendedResources() ([]types.Resource, bool) {
+	if len(m.appendresources) == 0 {
+		return nil, false
+	}
+	return m.appendresources, true
+}
+
+// ClearResources clears the value of the "resources" field.
+func (m *NoteMutation) ClearResources() {
+	m.resources = nil
+	m.appendresources = nil
+	m.clearedFields[note.FieldResources] = struct{}{}
+}
+
+// ResourcesCleared returns if the "resources" field was cleared in this mutation.
+func (m *NoteMutation) ResourcesCleared() bool {
+	_, ok := m.clearedFields[note.FieldResources]
+	return ok
+}
+
+// ResetResources resets all changes to the "resources" field.
+func (m *NoteMutation) ResetResources() {
+	m.resources = nil
+	m.appendresources = nil
+	delete(m.clearedFields, note.FieldResources)
+}
+
+// SetAiCurriculum sets the "ai_curriculum" field.
+func (m *NoteMutation) SetAiCurriculum(s string) {
+	m.ai_curriculum = &s
+}
+
+// AiCurriculum returns the value of the "ai_curriculum" field in the mutation.
+func (m *NoteMutation) AiCurriculum() (r string, exists bool) {
+	v := m.ai_curriculum
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAiCurriculum returns the old "ai_curriculum" field's value of the Note entity.
+// If the Note object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteMutation) OldAiCurriculum(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAiCurriculum is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAiCurriculum requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAiCurriculum: %w", err)
+	}
+	return oldValue.AiCurriculum, nil
+}
+
+// ClearAiCurriculum clears the value of the "ai_curriculum" field.
+func (m *NoteMutation) ClearAiCurriculum() {
+	m.ai_curriculum = nil
+	m.clearedFields[note.FieldAiCurriculum] = struct{}{}
+}
+
+// AiCurriculumCleared returns if the "ai_curriculum" field was cleared in this mutation.
+func (m *NoteMutation) AiCurriculumCleared() bool {
+	_, ok := m.clearedFields[note.FieldAiCurriculum]
+	return ok
+}
+
+// ResetAiCurriculum resets all changes to the "ai_curriculum" field.
+func (m *NoteMutation) ResetAiCurriculum() {
+	m.ai_curriculum = nil
+	delete(m.clearedFields, note.FieldAiCurriculum)
+}
+
+// SetVisibility sets the "visibility" field.
+func (m *NoteMutation) SetVisibility(n note.Visibility) {
+	m.visibility = &n
+}
+
+// Visibility returns the value of the "visibility" field in the mutation.
+func (m *NoteMutation) Visibility() (r note.Visibility, exists bool) {
+	v := m.visibility
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVisibility returns the old "visibility" field's value of the Note entity.
+// If the Note object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteMutation) OldVisibility(ctx context.Context) (v note.Visibility, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVisibility is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVisibility requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVisibility: %w", err)
+	}
+	return oldValue.Visibility, nil
+}
+
+// ResetVisibility resets all changes to the "visibility" field.
+func (m *NoteMutation) ResetVisibility() {
+	m.visibility = nil
+}
+
+// SetPermissionLevel sets the "permission_level" field.
+func (m *NoteMutation) SetPermissionLevel(nl note.PermissionLevel) {
+	m.permission_level = &nl
+}
+
+// PermissionLevel returns the value of the "permission_level" field in the mutation.
+func (m *NoteMutation) PermissionLevel() (r note.PermissionLevel, exists bool) {
+	v := m.permission_level
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPermissionLevel returns the old "permission_level" field's value of the Note entity.
+// If the Note object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteMutation) OldPermissionLevel(ctx context.Context) (v note.PermissionLevel, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPermissionLevel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPermissionLevel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPermissionLevel: %w", err)
+	}
+	return oldValue.PermissionLevel, nil
+}
+
+// ResetPermissionLevel resets all changes to the "permission_level" field.
+func (m *NoteMutation) ResetPermissionLevel() {
+	m.permission_level = nil
+}
+
+// SetShareToken sets the "share_token" field.
+func (m *NoteMutation) SetShareToken(s string) {
+	m.share_token = &s
+}
+
+// ShareToken returns the value of the "share_token" field in the mutation.
+func (m *NoteMutation) ShareToken() (r string, exists bool) {
+	v := m.share_token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldShareToken returns the old "share_token" field's value of the Note entity.
+// If the Note object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteMutation) OldShareToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldShareToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldShareToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldShareToken: %w", err)
+	}
+	return oldValue.ShareToken, nil
+}
+
+// ClearShareToken clears the value of the "share_token" field.
+func (m *NoteMutation) ClearShareToken() {
+	m.share_token = nil
+	m.clearedFields[note.FieldShareToken] = struct{}{}
+}
+
+// ShareTokenCleared returns if the "share_token" field was cleared in this mutation.
+func (m *NoteMutation) ShareTokenCleared() bool {
+	_, ok := m.clearedFields[note.FieldShareToken]
+	return ok
+}
+
+// ResetShareToken resets all changes to the "share_token" field.
+func (m *NoteMutation) ResetShareToken() {
+	m.share_token = nil
+	delete(m.clearedFields, note.FieldShareToken)
+}
+
+// SetAiProcessing sets the "ai_processing" field.
+func (m *NoteMutation) SetAiProcessing(b bool) {
+	m.ai_processing = &b
+}
+
+// AiProcessing returns the value of the "ai_processing" field in the mutation.
+func (m *NoteMutation) AiProcessing() (r bool, exists bool) {
+	v := m.ai_processing
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAiProcessing returns the old "ai_processing" field's value of the Note entity.
+// If the Note object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteMutation) OldAiProcessing(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAiProcessing is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAiProcessing requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAiProcessing: %w", err)
+	}
+	return oldValue.AiProcessing, nil
+}
+
+// ResetAiProcessing resets all changes to the "ai_processing" field.
+func (m *NoteMutation) ResetAiProcessing() {
+	m.ai_processing = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *NoteMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *NoteMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Note entity.
+// If the Note object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *NoteMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *NoteMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *NoteMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Note entity.
+// If the Note object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *NoteMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by id.
+func (m *NoteMutation) SetOwnerID(id int) {
+	m.owner = &id
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *NoteMutation) ClearOwner() {
+	m.clearedowner = true
+}
+
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *NoteMutation) OwnerCleared() bool {
+	return m.clearedowner
+}
+
+// OwnerID returns the "owner" edge ID in the mutation.
+func (m *NoteMutation) OwnerID() (id int, exists bool) {
+	if m.owner != nil {
+		return *m.owner, true
+	}
+	return
+}
+
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *NoteMutation) OwnerIDs() (ids []int) {
+	if id := m.owner; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOwner resets all changes to the "owner" edge.
+func (m *NoteMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
+}
+
+// AddLikeIDs adds the "likes" edge to the NoteLike entity by ids.
+func (m *NoteMutation) AddLikeIDs(ids ...int) {
+	if m.likes == nil {
+		m.likes = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.likes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearLikes clears the "likes" edge to the NoteLike entity.
+func (m *NoteMutation) ClearLikes() {
+	m.clearedlikes = true
+}
+
+// LikesCleared reports if the "likes" edge to the NoteLike entity was cleared.
+func (m *NoteMutation) LikesCleared() bool {
+	return m.clearedlikes
+}
+
+// RemoveLikeIDs removes the "likes" edge to the NoteLike entity by IDs.
+func (m *NoteMutation) RemoveLikeIDs(ids ...int) {
+	if m.removedlikes == nil {
+		m.removedlikes = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.likes, ids[i])
+		m.removedlikes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedLikes returns the removed IDs of the "likes" edge to the NoteLike entity.
+func (m *NoteMutation) RemovedLikesIDs() (ids []int) {
+	for id := range m.removedlikes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// LikesIDs returns the "likes" edge IDs in the mutation.
+func (m *NoteMutation) LikesIDs() (ids []int) {
+	for id := range m.likes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetLikes resets all changes to the "likes" edge.
+func (m *NoteMutation) ResetLikes() {
+	m.likes = nil
+	m.clearedlikes = false
+	m.removedlikes = nil
+}
+
+// AddRepostIDs adds the "reposts" edge to the NoteRepost entity by ids.
+func (m *NoteMutation) AddRepostIDs(ids ...int) {
+	if m.reposts == nil {
+		m.reposts = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.reposts[ids[i]] = struct{}{}
+	}
+}
+
+// ClearReposts clears the "reposts" edge to the NoteRepost entity.
+func (m *NoteMutation) ClearReposts() {
+	m.clearedreposts = true
+}
+
+// RepostsCleared reports if the "reposts" edge to the NoteRepost entity was cleared.
+func (m *NoteMutation) RepostsCleared() bool {
+	return m.clearedreposts
+}
+
+// RemoveRepostIDs removes the "reposts" edge to the NoteRepost entity by IDs.
+func (m *NoteMutation) RemoveRepostIDs(ids ...int) {
+	if m.removedreposts == nil {
+		m.removedreposts = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.reposts, ids[i])
+		m.removedreposts[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedReposts returns the removed IDs of the "reposts" edge to the NoteRepost entity.
+func (m *NoteMutation) RemovedRepostsIDs() (ids []int) {
+	for id := range m.removedreposts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RepostsIDs returns the "reposts" edge IDs in the mutation.
+func (m *NoteMutation) RepostsIDs() (ids []int) {
+	for id := range m.reposts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetReposts resets all changes to the "reposts" edge.
+func (m *NoteMutation) ResetReposts() {
+	m.reposts = nil
+	m.clearedreposts = false
+	m.removedreposts = nil
+}
+
+// AddPracticeSetIDs adds the "practice_sets" edge to the Quiz entity by ids.
+func (m *NoteMutation) AddPracticeSetIDs(ids ...int) {
+	if m.practice_sets == nil {
+		m.practice_sets = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.practice_sets[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPracticeSets clears the "practice_sets" edge to the Quiz entity.
+func (m *NoteMutation) ClearPracticeSets() {
+	m.clearedpractice_sets = true
+}
+
+// PracticeSetsCleared reports if the "practice_sets" edge to the Quiz entity was cleared.
+func (m *NoteMutation) PracticeSetsCleared() bool {
+	return m.clearedpractice_sets
+}
+
+// RemovePracticeSetIDs removes the "practice_sets" edge to the Quiz entity by IDs.
+func (m *NoteMutation) RemovePracticeSetIDs(ids ...int) {
+	if m.removedpractice_sets == nil {
+		m.removedpractice_sets = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.practice_sets, ids[i])
+		m.removedpractice_sets[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPracticeSets returns the removed IDs of the "practice_sets" edge to the Quiz entity.
+func (m *NoteMutation) RemovedPracticeSetsIDs() (ids []int) {
+	for id := range m.removedpractice_sets {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PracticeSetsIDs returns the "practice_sets" edge IDs in the mutation.
+func (m *NoteMutation) PracticeSetsIDs() (ids []int) {
+	for id := range m.practice_sets {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPracticeSets resets all changes to the "practice_sets" edge.
+func (m *NoteMutation) ResetPracticeSets() {
+	m.practice_sets = nil
+	m.clearedpractice_sets = false
+	m.removedpractice_sets = nil
+}
+
+// AddStudyEventIDs adds the "study_events" edge to the StudyEvent entity by ids.
+func (m *NoteMutation) AddStudyEventIDs(ids ...int) {
+	if m.study_events == nil {
+		m.study_events = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.study_events[ids[i]] = struct{}{}
+	}
+}
+
+// ClearStudyEvents clears the "study_events" edge to the StudyEvent entity.
+func (m *NoteMutation) ClearStudyEvents() {
+	m.clearedstudy_events = true
+}
+
+// StudyEventsCleared reports if the "study_events" edge to the StudyEvent entity was cleared.
+func (m *NoteMutation) StudyEventsCleared() bool {
+	return m.clearedstudy_events
+}
+
+// RemoveStudyEventIDs removes the "study_events" edge to the StudyEvent entity by IDs.
+func (m *NoteMutation) RemoveStudyEventIDs(ids ...int) {
+	if m.removedstudy_events == nil {
+		m.removedstudy_events = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.study_events, ids[i])
+		m.removedstudy_events[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedStudyEvents returns the removed IDs of the "study_events" edge to the StudyEvent entity.
+func (m *NoteMutation) RemovedStudyEventsIDs() (ids []int) {
+	for id := range m.removedstudy_events {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// StudyEventsIDs returns the "study_events" edge IDs in the mutation.
+func (m *NoteMutation) StudyEventsIDs() (ids []int) {
+	for id := range m.study_events {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetStudyEvents resets all changes to the "study_events" edge.
+func (m *NoteMutation) ResetStudyEvents() {
+	m.study_events = nil
+	m.clearedstudy_events = false
+	m.removedstudy_events = nil
+}
+
+// AddGroupIDs adds the "groups" edge to the StudyGroup entity by ids.
+func (m *NoteMutation) AddGroupIDs(ids ...int) {
+	if m.groups == nil {
+		m.groups = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.groups[ids[i]] = struct{}{}
+	}
+}
+
+// ClearGroups clears the "groups" edge to the StudyGroup entity.
+func (m *NoteMutation) ClearGroups() {
+	m.clearedgroups = true
+}
+
+// GroupsCleared reports if the "groups" edge to the StudyGroup entity was cleared.
+func (m *NoteMutation) GroupsCleared() bool {
+	return m.clearedgroups
+}
+
+// RemoveGroupIDs removes the "groups" edge to the StudyGroup entity by IDs.
+func (m *NoteMutation) RemoveGroupIDs(ids ...int) {
+	if m.removedgroups == nil {
+		m.removedgroups = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.groups, ids[i])
+		m.removedgroups[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedGroups returns the removed IDs of the "groups" edge to the StudyGroup entity.
+func (m *NoteMutation) RemovedGroupsIDs() (ids []int) {
+	for id := range m.removedgroups {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// GroupsIDs returns the "groups" edge IDs in the mutation.
+func (m *NoteMutation) GroupsIDs() (ids []int) {
+	for id := range m.groups {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetGroups resets all changes to the "groups" edge.
+func (m *NoteMutation) ResetGroups() {
+	m.groups = nil
+	m.clearedgroups = false
+	m.removedgroups = nil
+}
+
+// AddDocumentIDs adds the "documents" edge to the Document entity by ids.
+func (m *NoteMutation) AddDocumentIDs(ids ...int) {
+	if m.documents == nil {
+		m.documents = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.documents[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDocuments clears the "documents" edge to the Document entity.
+func (m *NoteMutation) ClearDocuments() {
+	m.cleareddocuments = true
+}
+
+// DocumentsCleared reports if the "documents" edge to the Document entity was cleared.
+func (m *NoteMutation) DocumentsCleared() bool {
+	return m.cleareddocuments
+}
+
+// RemoveDocumentIDs removes the "documents" edge to the Document entity by IDs.
+func (m *NoteMutation) RemoveDocumentIDs(ids ...int) {
+	if m.removeddocuments == nil {
+		m.removeddocuments = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.documents, ids[i])
+		m.removeddocuments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDocuments returns the removed IDs of the "documents" edge to the Document entity.
+func (m *NoteMutation) RemovedDocumentsIDs() (ids []int) {
+	for id := range m.removeddocuments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DocumentsIDs returns the "documents" edge IDs in the mutation.
+func (m *NoteMutation) DocumentsIDs() (ids []int) {
+	for id := range m.documents {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDocuments resets all changes to the "documents" edge.
+func (m *NoteMutation) ResetDocuments() {
+	m.documents = nil
+	m.cleareddocuments = false
+	m.removeddocuments = nil
+}
+
+// AddProposalIDs adds the "proposals" edge to the NoteProposal entity by ids.
+func (m *NoteMutation) AddProposalIDs(ids ...int) {
+	if m.proposals == nil {
+		m.proposals = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.proposals[ids[i]] = struct{}{}
+	}
+}
+
+// ClearProposals clears the "proposals" edge to the NoteProposal entity.
+func (m *NoteMutation) ClearProposals() {
+	m.clearedproposals = true
+}
+
+// ProposalsCleared reports if the "proposals" edge to the NoteProposal entity was cleared.
+func (m *NoteMutation) ProposalsCleared() bool {
+	return m.clearedproposals
+}
+
+// RemoveProposalIDs removes the "proposals" edge to the NoteProposal entity by IDs.
+func (m *NoteMutation) RemoveProposalIDs(ids ...int) {
+	if m.removedproposals == nil {
+		m.removedproposals = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.proposals, ids[i])
+		m.removedproposals[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedProposals returns the removed IDs of the "proposals" edge to the NoteProposal entity.
+func (m *NoteMutation) RemovedProposalsIDs() (ids []int) {
+	for id := range m.removedproposals {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ProposalsIDs returns the "proposals" edge IDs in the mutation.
+func (m *NoteMutation) ProposalsIDs() (ids []int) {
+	for id := range m.proposals {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetProposals resets all changes to the "proposals" edge.
+func (m *NoteMutation) ResetProposals() {
+	m.proposals = nil
+	m.clearedproposals = false
+	m.removedproposals = nil
+}
+
+// AddRevisionIDs adds the "revisions" edge to the NoteRevision entity by ids.
+func (m *NoteMutation) AddRevisionIDs(ids ...int) {
+	if m.revisions == nil {
+		m.revisions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.revisions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRevisions clears the "revisions" edge to the NoteRevision entity.
+func (m *NoteMutation) ClearRevisions() {
+	m.clearedrevisions = true
+}
+
+// RevisionsCleared reports if the "revisions" edge to the NoteRevision entity was cleared.
+func (m *NoteMutation) RevisionsCleared() bool {
+	return m.clearedrevisions
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to the NoteRevision entity by IDs.
+func (m *NoteMutation) RemoveRevisionIDs(ids ...int) {
+	if m.removedrevisions == nil {
+		m.removedrevisions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.revisions, ids[i])
+		m.removedrevisions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRevisions returns the removed IDs of the "revisions" edge to the NoteRevision entity.
+func (m *NoteMutation) RemovedRevisionsIDs() (ids []int) {
+	for id := range m.removedrevisions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RevisionsIDs returns the "revisions" edge IDs in the mutation.
+func (m *NoteMutation) RevisionsIDs() (ids []int) {
+	for id := range m.revisions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRevisions resets all changes to the "revisions" edge.
+func (m *NoteMutation) ResetRevisions() {
+	m.revisions = nil
+	m.clearedrevisions = false
+	m.removedrevisions = nil
+}
+
+// AddNotebookIDs adds the "notebooks" edge to the Notebook entity by ids.
+func (m *NoteMutation) AddNotebookIDs(ids ...int) {
+	if m.notebooks == nil {
+		m.notebooks = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.notebooks[ids[i]] = struct{}{}
+	}
+}
+
+// ClearNotebooks clears the "notebooks" edge to the Notebook entity.
+func (m *NoteMutation) ClearNotebooks() {
+	m.clearednotebooks = true
+}
+
+// NotebooksCleared reports if the "notebooks" edge to the Notebook entity was cleared.
+func (m *NoteMutation) NotebooksCleared() bool {
+	return m.clearednotebooks
+}
+
+// RemoveNotebookIDs removes the "notebooks" edge to the Notebook entity by IDs.
+func (m *NoteMutation) RemoveNotebookIDs(ids ...int) {
+	if m.removednotebooks == nil {
+		m.removednotebooks = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.notebooks, ids[i])
+		m.removednotebooks[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedNotebooks returns the removed IDs of the "notebooks" edge to the Notebook entity.
+func (m *NoteMutation) RemovedNotebooksIDs() (ids []int) {
+	for id := range m.removednotebooks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// NotebooksIDs returns the "notebooks" edge IDs in the mutation.
+func (m *NoteMutation) NotebooksIDs() (ids []int) {
+	for id := range m.notebooks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetNotebooks resets all changes to the "notebooks" edge.
+func (m *NoteMutation) ResetNotebooks() {
+	m.notebooks = nil
+	m.clearednotebooks = false
+	m.removednotebooks = nil
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by ids.
+func (m *NoteMutation) AddTagIDs(ids ...int) {
+	if m.tags == nil {
+		m.tags = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.tags[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTags clears the "tags" edge to the Tag entity.
+func (m *NoteMutation) ClearTags() {
+	m.clearedtags = true
+}
+
+// TagsCleared reports if the "tags" edge to the Tag entity was cleared.
+func (m *NoteMutation) TagsCleared() bool {
+	return m.clearedtags
+}
+
+// RemoveTagIDs removes the "tags" edge to the Tag entity by IDs.
+func (m *NoteMutation) RemoveTagIDs(ids ...int) {
+	if m.removedtags == nil {
+		m.removedtags = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.tags, ids[i])
+		m.removedtags[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTags returns the removed IDs of the "tags" edge to the Tag entity.
+func (m *NoteMutation) RemovedTagsIDs() (ids []int) {
+	for id := range m.removedtags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TagsIDs returns the "tags" edge IDs in the mutation.
+func (m *NoteMutation) TagsIDs() (ids []int) {
+	for id := range m.tags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTags resets all changes to the "tags" edge.
+func (m *NoteMutation) ResetTags() {
+	m.tags = nil
+	m.clearedtags = false
+	m.removedtags = nil
+}
+
+// AddLinkIDs adds the "links" edge to the NoteLink entity by ids.
+func (m *NoteMutation) AddLinkIDs(ids ...int) {
+	if m.links == nil {
+		m.links = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.links[ids[i]] = struct{}{}
+	}
+}
+
+// ClearLinks clears the "links" edge to the NoteLink entity.
+func (m *NoteMutation) ClearLinks() {
+	m.clearedlinks = true
+}
+
+// LinksCleared reports if the "links" edge to the NoteLink entity was cleared.
+func (m *NoteMutation) LinksCleared() bool {
+	return m.clearedlinks
+}
+
+// RemoveLinkIDs removes the "links" edge to the NoteLink entity by IDs.
+func (m *NoteMutation) RemoveLinkIDs(ids ...int) {
+	if m.removedlinks == nil {
+		m.removedlinks = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.links, ids[i])
+		m.removedlinks[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedLinks returns the removed IDs of the "links" edge to the NoteLink entity.
+func (m *NoteMutation) RemovedLinksIDs() (ids []int) {
+	for id := range m.removedlinks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// LinksIDs returns the "links" edge IDs in the mutation.
+func (m *NoteMutation) LinksIDs() (ids []int) {
+	for id := range m.links {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetLinks resets all changes to the "links" edge.
+func (m *NoteMutation) ResetLinks() {
+	m.links = nil
+	m.clearedlinks = false
+	m.removedlinks = nil
+}
+
+// AddBacklinkIDs adds the "backlinks" edge to the NoteLink entity by ids.
+func (m *NoteMutation) AddBacklinkIDs(ids ...int) {
+	if m.backlinks == nil {
+		m.backlinks = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.backlinks[ids[i]] = struct{}{}
+	}
+}
+
+// ClearBacklinks clears the "backlinks" edge to the NoteLink entity.
+func (m *NoteMutation) ClearBacklinks() {
+	m.clearedbacklinks = true
+}
+
+// BacklinksCleared reports if the "backlinks" edge to the NoteLink entity was cleared.
+func (m *NoteMutation) BacklinksCleared() bool {
+	return m.clearedbacklinks
+}
+
+// RemoveBacklinkIDs removes the "backlinks" edge to the NoteLink entity by IDs.
+func (m *NoteMutation) RemoveBacklinkIDs(ids ...int) {
+	if m.removedbacklinks == nil {
+		m.removedbacklinks = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.backlinks, ids[i])
+		m.removedbacklinks[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedBacklinks returns the removed IDs of the "backlinks" edge to the NoteLink entity.
+func (m *NoteMutation) RemovedBacklinksIDs() (ids []int) {
+	for id := range m.removedbacklinks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// BacklinksIDs returns the "backlinks" edge IDs in the mutation.
+func (m *NoteMutation) BacklinksIDs() (ids []int) {
+	for id := range m.backlinks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetBacklinks resets all changes to the "backlinks" edge.
+func (m *NoteMutation) ResetBacklinks() {
+	m.backlinks = nil
+	m.clearedbacklinks = false
+	m.removedbacklinks = nil
+}
+
+// Where appends a list predicates to the NoteMutation builder.
+func (m *NoteMutation) Where(ps ...predicate.Note) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the NoteMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *NoteMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Note, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *NoteMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *NoteMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Note).
+func (m *NoteMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *NoteMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.title != nil {
+		fields = append(fields, note.FieldTitle)
+	}
+	if m.description != nil {
+		fields = append(fields, note.FieldDescription)
+	}
+	if m.content != nil {
+		fields = append(fields, note.FieldContent)
+	}
+	if m.resources != nil {
+		fields = append(fields, note.FieldResources)
+	}
+	if m.ai_curriculum != nil {
+		fields = append(fields, note.FieldAiCurriculum)
+	}
+	if m.visibility != nil {
+		fields = append(fields, note.FieldVisibility)
+	}
+	if m.permission_level != nil {
+		fields = append(fields, note.FieldPermissionLevel)
+	}
+	if m.share_token != nil {
+		fields = append(fields, note.FieldShareToken)
+	}
+	if m.ai_processing != nil {
+		fields = append(fields, note.FieldAiProcessing)
+	}
+	if m.created_at != nil {
+		fields = append(fields, note.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, note.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *NoteMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case note.FieldTitle:
+		return m.Title()
+	case note.FieldDescription:
+		return m.Description()
+	case note.FieldContent:
+		return m.Content()
+	case note.FieldResources:
+		return m.Resources()
+	case note.FieldAiCurriculum:
+		return m.AiCurriculum()
+	case note.FieldVisibility:
+		return m.Visibility()
+	case note.FieldPermissionLevel:
+		return m.PermissionLevel()
+	case note.FieldShareToken:
+		return m.ShareToken()
+	case note.FieldAiProcessing:
+		return m.AiProcessing()
+	case note.FieldCreatedAt:
+		return m.CreatedAt()
+	case note.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *NoteMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case note.FieldTitle:
+		return m.OldTitle(ctx)
+	case note.FieldDescription:
+		return m.OldDescription(ctx)
+	case note.FieldContent:
+		return m.OldContent(ctx)
+	case note.FieldResources:
+		return m.OldResources(ctx)
+	case note.FieldAiCurriculum:
+		return m.OldAiCurriculum(ctx)
+	case note.FieldVisibility:
+		return m.OldVisibility(ctx)
+	case note.FieldPermissionLevel:
+		return m.OldPermissionLevel(ctx)
+	case note.FieldShareToken:
+		return m.OldShareToken(ctx)
+	case note.FieldAiProcessing:
+		return m.OldAiProcessing(ctx)
+	case note.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case note.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Note field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NoteMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case note.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case note.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case note.FieldContent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContent(v)
+		return nil
+	case note.FieldResources:
+		v, ok := value.([]types.Resource)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResources(v)
+		return nil
+	case note.FieldAiCurriculum:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAiCurriculum(v)
+		return nil
+	case note.FieldVisibility:
+		v, ok := value.(note.Visibility)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVisibility(v)
+		return nil
+	case note.FieldPermissionLevel:
+		v, ok := value.(note.PermissionLevel)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPermissionLevel(v)
+		return nil
+	case note.FieldShareToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetShareToken(v)
+		return nil
+	case note.FieldAiProcessing:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAiProcessing(v)
+		return nil
+	case note.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case note.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Note field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *NoteMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *NoteMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NoteMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Note numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *NoteMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(note.FieldDescription) {
+		fields = append(fields, note.FieldDescription)
+	}
+	if m.FieldCleared(note.FieldContent) {
+		fields = append(fields, note.FieldContent)
+	}
+	if m.FieldCleared(note.FieldResources) {
+		fields = append(fields, note.FieldResources)
+	}
+	if m.FieldCleared(note.FieldAiCurriculum) {
+		fields = append(fields, note.FieldAiCurriculum)
+	}
+	if m.FieldCleared(note.FieldShareToken) {
+		fields = append(fields, note.FieldShareToken)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *NoteMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *NoteMutation) ClearField(name string) error {
+	switch name {
+	case note.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case note.FieldContent:
+		m.ClearContent()
+		return nil
+	case note.FieldResources:
+		m.ClearResources()
+		return nil
+	case note.FieldAiCurriculum:
+		m.ClearAiCurriculum()
+		return nil
+	case note.FieldShareToken:
+		m.ClearShareToken()
+		return nil
+	}
+	return fmt.Errorf("unknown Note nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *NoteMutation) ResetField(name string) error {
+	switch name {
+	case note.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case note.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case note.FieldContent:
+		m.ResetContent()
+		return nil
+	case note.FieldResources:
+		m.ResetResources()
+		return nil
+	case note.FieldAiCurriculum:
+		m.ResetAiCurriculum()
+		return nil
+	case note.FieldVisibility:
+		m.ResetVisibility()
+		return nil
+	case note.FieldPermissionLevel:
+		m.ResetPermissionLevel()
+		return nil
+	case note.FieldShareToken:
+		m.ResetShareToken()
+		return nil
+	case note.FieldAiProcessing:
+		m.ResetAiProcessing()
+		return nil
+	case note.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case note.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Note field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *NoteMutation) AddedEdges() []string {
+	edges := make([]string, 0, 13)
+	if m.owner != nil {
+		edges = append(edges, note.EdgeOwner)
+	}
+	if m.likes != nil {
+		edges = append(edges, note.EdgeLikes)
+	}
+	if m.reposts != nil {
+		edges = append(edges, note.EdgeReposts)
+	}
+	if m.practice_sets != nil {
+		edges = append(edges, note.EdgePracticeSets)
+	}
+	if m.study_events != nil {
+		edges = append(edges, note.EdgeStudyEvents)
+	}
+	if m.groups != nil {
+		edges = append(edges, note.EdgeGroups)
+	}
+	if m.documents != nil {
+		edges = append(edges, note.EdgeDocuments)
+	}
+	if m.proposals != nil {
+		edges = append(edges, note.EdgeProposals)
+	}
+	if m.revisions != nil {
+		edges = append(edges, note.EdgeRevisions)
+	}
+	if m.notebooks != nil {
+		edges = append(edges, note.EdgeNotebooks)
+	}
+	if m.tags != nil {
+		edges = append(edges, note.EdgeTags)
+	}
+	if m.links != nil {
+		edges = append(edges, note.EdgeLinks)
+	}
+	if m.backlinks != nil {
+		edges = append(edges, note.EdgeBacklinks)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *NoteMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case note.EdgeOwner:
+		if id := m.owner; id != nil {
+			return []ent.Value{*id}
+		}
+	case note.EdgeLikes:
+		ids := make([]ent.Value, 0, len(m.likes))
+		for id := range m.likes {
+			ids = append(ids, id)
+		}
+		return ids
+	case note.EdgeReposts:
+		ids := make([]ent.Value, 0, len(m.reposts))
+		for id := range m.reposts {
+			ids = append(ids, id)
+		}
+		return ids
+	case note.EdgePracticeSets:
+		ids := make([]ent.Value, 0, len(m.practice_sets))
+		for id := range m.practice_sets {
+			ids = append(ids, id)
+		}
+		return ids
+	case note.EdgeStudyEvents:
+		ids := make([]ent.Value, 0, len(m.study_events))
+		for id := range m.study_events {
+			ids = append(ids, id)
+		}
+		return ids
+	case note.EdgeGroups:
+		ids := make([]ent.Value, 0, len(m.groups))
+		for id := range m.groups {
+			ids = append(ids, id)
+		}
+		return ids
+	case note.EdgeDocuments:
+		ids := make([]ent.Value, 0, len(m.documents))
+		for id := range m.documents {
+			ids = append(ids, id)
+		}
+		return ids
+	case note.EdgeProposals:
+		ids := make([]ent.Value, 0, len(m.proposals))
+		for id := range m.proposals {
+			ids = append(ids, id)
+		}
+		return ids
+	case note.EdgeRevisions:
+		ids := make([]ent.Value, 0, len(m.revisions))
+		for id := range m.revisions {
+			ids = append(ids, id)
+		}
+		return ids
+	case note.EdgeNotebooks:
+		ids := make([]ent.Value, 0, len(m.notebooks))
+		for id := range m.notebooks {
+			ids = append(ids, id)
+		}
+		return ids
+	case note.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.tags))
+		for id := range m.tags {
+			ids = append(ids, id)
+		}
+		return ids
+	case note.EdgeLinks:
+		ids := make([]ent.Value, 0, len(m.links))
+		for id := range m.links {
+			ids = append(ids, id)
+		}
+		return ids
+	case note.EdgeBacklinks:
+		ids := make([]ent.Value, 0, len(m.backlinks))
+		for id := range m.backlinks {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *NoteMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 13)
+	if m.removedlikes != nil {
+		edges = append(edges, note.EdgeLikes)
+	}
+	if m.removedreposts != nil {
+		edges = append(edges, note.EdgeReposts)
+	}
+	if m.removedpractice_sets != nil {
+		edges = append(edges, note.EdgePracticeSets)
+	}
+	if m.removedstudy_events != nil {
+		edges = append(edges, note.EdgeStudyEvents)
+	}
+	if m.removedgroups != nil {
+		edges = append(edges, note.EdgeGroups)
+	}
+	if m.removeddocuments != nil {
+		edges = append(edges, note.EdgeDocuments)
+	}
+	if m.removedproposals != nil {
+		edges = append(edges, note.EdgeProposals)
+	}
+	if m.removedrevisions != nil {
+		edges = append(edges, note.EdgeRevisions)
+	}
+	if m.removednotebooks != nil {
+		edges = append(edges, note.EdgeNotebooks)
+	}
+	if m.removedtags != nil {
+		edges = append(edges, note.EdgeTags)
+	}
+	if m.removedlinks != nil {
+		edges = append(edges, note.EdgeLinks)
+	}
+	if m.removedbacklinks != nil {
+		edges = append(edges, note.EdgeBacklinks)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *NoteMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case note.EdgeLikes:
+		ids := make([]ent.Value, 0, len(m.removedlikes))
+		for id := range m.removedlikes {
+			ids = append(ids, id)
+		}
+		return ids
+	case note.EdgeReposts:
+		ids := make([]ent.Value, 0, len(m.removedreposts))
+		for id := range m.removedreposts {
+			ids = append(ids, id)
+		}
+		return ids
+	case note.EdgePracticeSets:
+		ids := make([]ent.Value, 0, len(m.removedpractice_sets))
+		for id := range m.removedpractice_sets {
+			ids = append(ids, id)
+		}
+		return ids
+	case note.EdgeStudyEvents:
+		ids := make([]ent.Value, 0, len(m.removedstudy_events))
+		for id := range m.removedstudy_events {
+			ids = append(ids, id)
+		}
+		return ids
+	case note.EdgeGroups:
+		ids := make([]ent.Value, 0, len(m.removedgroups))
+		for id := range m.removedgroups {
+			ids = append(ids, id)
+		}
+		return ids
+	case note.EdgeDocuments:
+		ids := make([]ent.Value, 0, len(m.removeddocuments))
+		for id := range m.removeddocuments {
+			ids = append(ids, id)
+		}
+		return ids
+	case note.EdgeProposals:
+		ids := make([]ent.Value, 0, len(m.removedproposals))
+		for id := range m.removedproposals {
+			ids = append(ids, id)
+		}
+		return ids
+	case note.EdgeRevisions:
+		ids := make([]ent.Value, 0, len(m.removedrevisions))
+		for id := range m.removedrevisions {
+			ids = append(ids, id)
+		}
+		return ids
+	case note.EdgeNotebooks:
+		ids := make([]ent.Value, 0, len(m.removednotebooks))
+		for id := range m.removednotebooks {
+			ids = append(ids, id)
+		}
+		return ids
+	case note.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.removedtags))
+		for id := range m.removedtags {
+			ids = append(ids, id)
+		}
+		return ids
+	case note.EdgeLinks:
+		ids := make([]ent.Value, 0, len(m.removedlinks))
+		for id := range m.removedlinks {
+			ids = append(ids, id)
+		}
+		return ids
+	case note.EdgeBacklinks:
+		ids := make([]ent.Value, 0, len(m.removedbacklinks))
+		for id := range m.removedbacklinks {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *NoteMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 13)
+	if m.clearedowner {
+		edges = append(edges, note.EdgeOwner)
+	}
+	if m.clearedlikes {
+		edges = append(edges, note.EdgeLikes)
+	}
+	if m.clearedreposts {
+		edges = append(edges, note.EdgeReposts)
+	}
+	if m.clearedpractice_sets {
+		edges = append(edges, note.EdgePracticeSets)
+	}
+	if m.clearedstudy_events {
+		edges = append(edges, note.EdgeStudyEvents)
+	}
+	if m.clearedgroups {
+		edges = append(edges, note.EdgeGroups)
+	}
+	if m.cleareddocuments {
+		edges = append(edges, note.EdgeDocuments)
+	}
+	if m.clearedproposals {
+		edges = append(edges, note.EdgeProposals)
+	}
+	if m.clearedrevisions {
+		edges = append(edges, note.EdgeRevisions)
+	}
+	if m.clearednotebooks {
+		edges = append(edges, note.EdgeNotebooks)
+	}
+	if m.clearedtags {
+		edges = append(edges, note.EdgeTags)
+	}
+	if m.clearedlinks {
+		edges = append(edges, note.EdgeLinks)
+	}
+	if m.clearedbacklinks {
+		edges = append(edges, note.EdgeBacklinks)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *NoteMutation) EdgeCleared(name string) bool {
+	switch name {
+	case note.EdgeOwner:
+		return m.clearedowner
+	case note.EdgeLikes:
+		return m.clearedlikes
+	case note.EdgeReposts:
+		return m.clearedreposts
+	case note.EdgePracticeSets:
+		return m.clearedpractice_sets
+	case note.EdgeStudyEvents:
+		return m.clearedstudy_events
+	case note.EdgeGroups:
+		return m.clearedgroups
+	case note.EdgeDocuments:
+		return m.cleareddocuments
+	case note.EdgeProposals:
+		return m.clearedproposals
+	case note.EdgeRevisions:
+		return m.clearedrevisions
+	case note.EdgeNotebooks:
+		return m.clearednotebooks
+	case note.EdgeTags:
+		return m.clearedtags
+	case note.EdgeLinks:
+		return m.clearedlinks
+	case note.EdgeBacklinks:
+		return m.clearedbacklinks
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *NoteMutation) ClearEdge(name string) error {
+	switch name {
+	case note.EdgeOwner:
+		m.ClearOwner()
+		return nil
+	}
+	return fmt.Errorf("unknown Note unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *NoteMutation) ResetEdge(name string) error {
+	switch name {
+	case note.EdgeOwner:
+		m.ResetOwner()
+		return nil
+	case note.EdgeLikes:
+		m.ResetLikes()
+		return nil
+	case note.EdgeReposts:
+		m.ResetReposts()
+		return nil
+	case note.EdgePracticeSets:
+		m.ResetPracticeSets()
+		return nil
+	case note.EdgeStudyEvents:
+		m.ResetStudyEvents()
+		return nil
+	case note.EdgeGroups:
+		m.ResetGroups()
+		return nil
+	case note.EdgeDocuments:
+		m.ResetDocuments()
+		return nil
+	case note.EdgeProposals:
+		m.ResetProposals()
+		return nil
+	case note.EdgeRevisions:
+		m.ResetRevisions()
+		return nil
+	case note.EdgeNotebooks:
+		m.ResetNotebooks()
+		return nil
+	case note.EdgeTags:
+		m.ResetTags()
+		return nil
+	case note.EdgeLinks:
+		m.ResetLinks()
+		return nil
+	case note.EdgeBacklinks:
+		m.ResetBacklinks()
+		return nil
+	}
+	return fmt.Errorf("unknown Note edge %s", name)
+}
+
+// NoteLikeMutation represents an operation that mutates the NoteLike nodes in the graph.
+type NoteLikeMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *int
+	cleareduser   bool
+	note          *int
+	clearednote   bool
+	done          bool
+	oldValue      func(context.Context) (*NoteLike, error)
+	predicates    []predicate.NoteLike
+}
+
+var _ ent.Mutation = (*NoteLikeMutation)(nil)
+
+// notelikeOption allows management of the mutation configuration using functional options.
+type notelikeOption func(*NoteLikeMutation)
+
+// newNoteLikeMutation creates new mutation for the NoteLike entity.
+func newNoteLikeMutation(c config, op Op, opts ...notelikeOption) *NoteLikeMutation {
+	m := &NoteLikeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeNoteLike,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withNoteLikeID sets the ID field of the mutation.
+func withNoteLikeID(id int) notelikeOption {
+	return func(m *NoteLikeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *NoteLike
+		)
+		m.oldValue = func(ctx context.Context) (*NoteLike, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().NoteLike.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withNoteLike sets the old NoteLike of the mutation.
+func withNoteLike(node *NoteLike) notelikeOption {
+	return func(m *NoteLikeMutation) {
+		m.oldValue = func(context.Context) (*NoteLike, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NoteLikeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NoteLikeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *NoteLikeMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *NoteLikeMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().NoteLike.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *NoteLikeMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *NoteLikeMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the NoteLike entity.
+// If the NoteLike object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteLikeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *NoteLikeMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *NoteLikeMutation) SetUserID(id int) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *NoteLikeMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *NoteLikeMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *NoteLikeMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *NoteLikeMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *NoteLikeMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// SetNoteID sets the "note" edge to the Note entity by id.
+func (m *NoteLikeMutation) SetNoteID(id int) {
+	m.note = &id
+}
+
+// ClearNote clears the "note" edge to the Note entity.
+func (m *NoteLikeMutation) ClearNote() {
+	m.clearednote = true
+}
+
+// NoteCleared reports if the "note" edge to the Note entity was cleared.
+func (m *NoteLikeMutation) NoteCleared() bool {
+	return m.clearednote
+}
+
+// NoteID returns the "note" edge ID in the mutation.
+func (m *NoteLikeMutation) NoteID() (id int, exists bool) {
+	if m.note != nil {
+		return *m.note, true
+	}
+	return
+}
+
+// NoteIDs returns the "note" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// NoteID instead. It exists only for internal usage by the builders.
+func (m *NoteLikeMutation) NoteIDs() (ids []int) {
+	if id := m.note; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetNote resets all changes to the "note" edge.
+func (m *NoteLikeMutation) ResetNote() {
+	m.note = nil
+	m.clearednote = false
+}
+
+// Where appends a list predicates to the NoteLikeMutation builder.
+func (m *NoteLikeMutation) Where(ps ...predicate.NoteLike) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the NoteLikeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *NoteLikeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.NoteLike, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *NoteLikeMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *NoteLikeMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (NoteLike).
+func (m *NoteLikeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *NoteLikeMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.created_at != nil {
+		fields = append(fields, notelike.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *NoteLikeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case notelike.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *NoteLikeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case notelike.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown NoteLike field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NoteLikeMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case notelike.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown NoteLike field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *NoteLikeMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *NoteLikeMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NoteLikeMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown NoteLike numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *NoteLikeMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *NoteLikeMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *NoteLikeMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown NoteLike nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *NoteLikeMutation) ResetField(name string) error {
+	switch name {
+	case notelike.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown NoteLike field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *NoteLikeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.user != nil {
+		edges = append(edges, notelike.EdgeUser)
+	}
+	if m.note != nil {
+		edges = append(edges, notelike.EdgeNote)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *NoteLikeMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case notelike.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case notelike.EdgeNote:
+		if id := m.note; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *NoteLikeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *NoteLikeMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *NoteLikeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareduser {
+		edges = append(edges, notelike.EdgeUser)
+	}
+	if m.clearednote {
+		edges = append(edges, notelike.EdgeNote)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *NoteLikeMutation) EdgeCleared(name string) bool {
+	switch name {
+	case notelike.EdgeUser:
+		return m.cleareduser
+	case notelike.EdgeNote:
+		return m.clearednote
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *NoteLikeMutation) ClearEdge(name string) error {
+	switch name {
+	case notelike.EdgeUser:
+		m.ClearUser()
+		return nil
+	case notelike.EdgeNote:
+		m.ClearNote()
+		return nil
+	}
+	return fmt.Errorf("unknown NoteLike unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *NoteLikeMutation) ResetEdge(name string) error {
+	switch name {
+	case notelike.EdgeUser:
+		m.ResetUser()
+		return nil
+	case notelike.EdgeNote:
+		m.ResetNote()
+		return nil
+	}
+	return fmt.Errorf("unknown NoteLike edge %s", name)
+}
+
+// NoteLinkMutation represents an operation that mutates the NoteLink nodes in the graph.
+type NoteLinkMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	target        *string
+	clearedFields map[string]struct{}
+	source        *int
+	clearedsource bool
+	note          *int
+	clearednote   bool
+	done          bool
+	oldValue      func(context.Context) (*NoteLink, error)
+	predicates    []predicate.NoteLink
+}
+
+var _ ent.Mutation = (*NoteLinkMutation)(nil)
+
+// notelinkOption allows management of the mutation configuration using functional options.
+type notelinkOption func(*NoteLinkMutation)
+
+// newNoteLinkMutation creates new mutation for the NoteLink entity.
+func newNoteLinkMutation(c config, op Op, opts ...notelinkOption) *NoteLinkMutation {
+	m := &NoteLinkMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeNoteLink,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withNoteLinkID sets the ID field of the mutation.
+func withNoteLinkID(id int) notelinkOption {
+	return func(m *NoteLinkMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *NoteLink
+		)
+		m.oldValue = func(ctx context.Context) (*NoteLink, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().NoteLink.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withNoteLink sets the old NoteLink of the mutation.
+func withNoteLink(node *NoteLink) notelinkOption {
+	return func(m *NoteLinkMutation) {
+		m.oldValue = func(context.Context) (*NoteLink, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NoteLinkMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NoteLinkMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *NoteLinkMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *NoteLinkMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().NoteLink.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTarget sets the "target" field.
+func (m *NoteLinkMutation) SetTarget(s string) {
+	m.target = &s
+}
+
+// Target returns the value of the "target" field in the mutation.
+func (m *NoteLinkMutation) Target() (r string, exists bool) {
+	v := m.target
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTarget returns the old "target" field's value of the NoteLink entity.
+// If the NoteLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteLinkMutation) OldTarget(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTarget is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTarget requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTarget: %w", err)
+	}
+	return oldValue.Target, nil
+}
+
+// ResetTarget resets all changes to the "target" field.
+func (m *NoteLinkMutation) ResetTarget() {
+	m.target = nil
+}
+
+// SetSourceID sets the "source" edge to the Note entity by id.
+func (m *NoteLinkMutation) SetSourceID(id int) {
+	m.source = &id
+}
+
+// ClearSource clears the "source" edge to the Note entity.
+func (m *NoteLinkMutation) ClearSource() {
+	m.clearedsource = true
+}
+
+// SourceCleared reports if the "source" edge to the Note entity was cleared.
+func (m *NoteLinkMutation) SourceCleared() bool {
+	return m.clearedsource
+}
+
+// SourceID returns the "source" edge ID in the mutation.
+func (m *NoteLinkMutation) SourceID() (id int, exists bool) {
+	if m.source != nil {
+		return *m.source, true
+	}
+	return
+}
+
+// SourceIDs returns the "source" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SourceID instead. It exists only for internal usage by the builders.
+func (m *NoteLinkMutation) SourceIDs() (ids []int) {
+	if id := m.source; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSource resets all changes to the "source" edge.
+func (m *NoteLinkMutation) ResetSource() {
+	m.source = nil
+	m.clearedsource = false
+}
+
+// SetNoteID sets the "note" edge to the Note entity by id.
+func (m *NoteLinkMutation) SetNoteID(id int) {
+	m.note = &id
+}
+
+// ClearNote clears the "note" edge to the Note entity.
+func (m *NoteLinkMutation) ClearNote() {
+	m.clearednote = true
+}
+
+// NoteCleared reports if the "note" edge to the Note entity was cleared.
+func (m *NoteLinkMutation) NoteCleared() bool {
+	return m.clearednote
+}
+
+// NoteID returns the "note" edge ID in the mutation.
+func (m *NoteLinkMutation) NoteID() (id int, exists bool) {
+	if m.note != nil {
+		return *m.note, true
+	}
+	return
+}
+
+// NoteIDs returns the "note" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// NoteID instead. It exists only for internal usage by the builders.
+func (m *NoteLinkMutation) NoteIDs() (ids []int) {
+	if id := m.note; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetNote resets all changes to the "note" edge.
+func (m *NoteLinkMutation) ResetNote() {
+	m.note = nil
+	m.clearednote = false
+}
+
+// Where appends a list predicates to the NoteLinkMutation builder.
+func (m *NoteLinkMutation) Where(ps ...predicate.NoteLink) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the NoteLinkMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *NoteLinkMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.NoteLink, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *NoteLinkMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *NoteLinkMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (NoteLink).
+func (m *NoteLinkMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *NoteLinkMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.target != nil {
+		fields = append(fields, notelink.FieldTarget)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *NoteLinkMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case notelink.FieldTarget:
+		return m.Target()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *NoteLinkMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case notelink.FieldTarget:
+		return m.OldTarget(ctx)
+	}
+	return nil, fmt.Errorf("unknown NoteLink field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NoteLinkMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case notelink.FieldTarget:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTarget(v)
+		return nil
+	}
+	return fmt.Errorf("unknown NoteLink field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *NoteLinkMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *NoteLinkMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NoteLinkMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown NoteLink numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *NoteLinkMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *NoteLinkMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *NoteLinkMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown NoteLink nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *NoteLinkMutation) ResetField(name string) error {
+	switch name {
+	case notelink.FieldTarget:
+		m.ResetTarget()
+		return nil
+	}
+	return fmt.Errorf("unknown NoteLink field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *NoteLinkMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.source != nil {
+		edges = append(edges, notelink.EdgeSource)
+	}
+	if m.note != nil {
+		edges = append(edges, notelink.EdgeNote)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *NoteLinkMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case notelink.EdgeSource:
+		if id := m.source; id != nil {
+			return []ent.Value{*id}
+		}
+	case notelink.EdgeNote:
+		if id := m.note; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *NoteLinkMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *NoteLinkMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *NoteLinkMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedsource {
+		edges = append(edges, notelink.EdgeSource)
+	}
+	if m.clearednote {
+		edges = append(edges, notelink.EdgeNote)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *NoteLinkMutation) EdgeCleared(name string) bool {
+	switch name {
+	case notelink.EdgeSource:
+		return m.clearedsource
+	case notelink.EdgeNote:
+		return m.clearednote
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *NoteLinkMutation) ClearEdge(name string) error {
+	switch name {
+	case notelink.EdgeSource:
+		m.ClearSource()
+		return nil
+	case notelink.EdgeNote:
+		m.ClearNote()
+		return nil
+	}
+	return fmt.Errorf("unknown NoteLink unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *NoteLinkMutation) ResetEdge(name string) error {
+	switch name {
+	case notelink.EdgeSource:
+		m.ResetSource()
+		return nil
+	case notelink.EdgeNote:
+		m.ResetNote()
+		return nil
+	}
+	return fmt.Errorf("unknown NoteLink edge %s", name)
+}
+
+// NoteProposalMutation represents an operation that mutates the NoteProposal nodes in the graph.
+type NoteProposalMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *int
+	title            *string
+	description      *string
+	content          *string
+	base_title       *string
+	base_description *string
+	base_content     *string
+	status           *noteproposal.Status
+	created_at       *time.Time
+	reviewed_at      *time.Time
+	clearedFields    map[string]struct{}
+	note             *int
+	clearednote      bool
+	proposer         *int
+	clearedproposer  bool
+	done             bool
+	oldValue         func(context.Context) (*NoteProposal, error)
+	predicates       []predicate.NoteProposal
+}
+
+var _ ent.Mutation = (*NoteProposalMutation)(nil)
+
+// noteproposalOption allows management of the mutation configuration using functional options.
+type noteproposalOption func(*NoteProposalMutation)
+
+// newNoteProposalMutation creates new mutation for the NoteProposal entity.
+func newNoteProposalMutation(c config, op Op, opts ...noteproposalOption) *NoteProposalMutation {
+	m := &NoteProposalMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeNoteProposal,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withNoteProposalID sets the ID field of the mutation.
+func withNoteProposalID(id int) noteproposalOption {
+	return func(m *NoteProposalMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *NoteProposal
+		)
+		m.oldValue = func(ctx context.Context) (*NoteProposal, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().NoteProposal.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withNoteProposal sets the old NoteProposal of the mutation.
+func withNoteProposal(node *NoteProposal) noteproposalOption {
+	return func(m *NoteProposalMutation) {
+		m.oldValue = func(context.Context) (*NoteProposal, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NoteProposalMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NoteProposalMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *NoteProposalMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *NoteProposalMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().NoteProposal.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTitle sets the "title" field.
+func (m *NoteProposalMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *NoteProposalMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the NoteProposal entity.
+// If the NoteProposal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteProposalMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *NoteProposalMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *NoteProposalMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *NoteProposalMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the NoteProposal entity.
+// If the NoteProposal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteProposalMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *NoteProposalMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[noteproposal.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *NoteProposalMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[noteproposal.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *NoteProposalMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, noteproposal.FieldDescription)
+}
+
+// SetContent sets the "content" field.
+func (m *NoteProposalMutation) SetContent(s string) {
+	m.content = &s
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *NoteProposalMutation) Content() (r string, exists bool) {
+	v := m.content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContent returns the old "content" field's value of the NoteProposal entity.
+// If the NoteProposal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteProposalMutation) OldContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
+}
+
+// ClearContent clears the value of the "content" field.
+func (m *NoteProposalMutation) ClearContent() {
+	m.content = nil
+	m.clearedFields[noteproposal.FieldContent] = struct{}{}
+}
+
+// ContentCleared returns if the "content" field was cleared in this mutation.
+func (m *NoteProposalMutation) ContentCleared() bool {
+	_, ok := m.clearedFields[noteproposal.FieldContent]
+	return ok
+}
+
+// ResetContent resets all changes to the "content" field.
+func (m *NoteProposalMutation) ResetContent() {
+	m.content = nil
+	delete(m.clearedFields, noteproposal.FieldContent)
+}
+
+// SetBaseTitle sets the "base_title" field.
+func (m *NoteProposalMutation) SetBaseTitle(s string) {
+	m.base_title = &s
+}
+
+// BaseTitle returns the value of the "base_title" field in the mutation.
+func (m *NoteProposalMutation) BaseTitle() (r string, exists bool) {
+	v := m.base_title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBaseTitle returns the old "base_title" field's value of the NoteProposal entity.
+// If the NoteProposal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteProposalMutation) OldBaseTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBaseTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBaseTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBaseTitle: %w", err)
+	}
+	return oldValue.BaseTitle, nil
+}
+
+// ClearBaseTitle clears the value of the "base_title" field.
+func (m *NoteProposalMutation) ClearBaseTitle() {
+	m.base_title = nil
+	m.clearedFields[noteproposal.FieldBaseTitle] = struct{}{}
+}
+
+// BaseTitleCleared returns if the "base_title" field was cleared in this mutation.
+func (m *NoteProposalMutation) BaseTitleCleared() bool {
+	_, ok := m.clearedFields[noteproposal.FieldBaseTitle]
+	return ok
+}
+
+// ResetBaseTitle resets all changes to the "base_title" field.
+func (m *NoteProposalMutation) ResetBaseTitle() {
+	m.base_title = nil
+	delete(m.clearedFields, noteproposal.FieldBaseTitle)
+}
+
+// SetBaseDescription sets the "base_description" field.
+func (m *NoteProposalMutation) SetBaseDescription(s string) {
+	m.base_description = &s
+}
+
+// BaseDescription returns the value of the "base_description" field in the mutation.
+func (m *NoteProposalMutation) BaseDescription() (r string, exists bool) {
+	v := m.base_description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBaseDescription returns the old "base_description" field's value of the NoteProposal entity.
+// If the NoteProposal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteProposalMutation) OldBaseDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBaseDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBaseDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBaseDescription: %w", err)
+	}
+	return oldValue.BaseDescription, nil
+}
+
+// ClearBaseDescription clears the value of the "base_description" field.
+func (m *NoteProposalMutation) ClearBaseDescription() {
+	m.base_description = nil
+	m.clearedFields[noteproposal.FieldBaseDescription] = struct{}{}
+}
+
+// BaseDescriptionCleared returns if the "base_description" field was cleared in this mutation.
+func (m *NoteProposalMutation) BaseDescriptionCleared() bool {
+	_, ok := m.clearedFields[noteproposal.FieldBaseDescription]
+	return ok
+}
+
+// ResetBaseDescription resets all changes to the "base_description" field.
+func (m *NoteProposalMutation) ResetBaseDescription() {
+	m.base_description = nil
+	delete(m.clearedFields, noteproposal.FieldBaseDescription)
+}
+
+// SetBaseContent sets the "base_content" field.
+func (m *NoteProposalMutation) SetBaseContent(s string) {
+	m.base_content = &s
+}
+
+// BaseContent returns the value of the "base_content" field in the mutation.
+func (m *NoteProposalMutation) BaseContent() (r string, exists bool) {
+	v := m.base_content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBaseContent returns the old "base_content" field's value of the NoteProposal entity.
+// If the NoteProposal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteProposalMutation) OldBaseContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBaseContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBaseContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBaseContent: %w", err)
+	}
+	return oldValue.BaseContent, nil
+}
+
+// ClearBaseContent clears the value of the "base_content" field.
+func (m *NoteProposalMutation) ClearBaseContent() {
+	m.base_content = nil
+	m.clearedFields[noteproposal.FieldBaseContent] = struct{}{}
+}
+
+// BaseContentCleared returns if the "base_content" field was cleared in this mutation.
+func (m *NoteProposalMutation) BaseContentCleared() bool {
+	_, ok := m.clearedFields[noteproposal.FieldBaseContent]
+	return ok
+}
+
+// ResetBaseContent resets all changes to the "base_content" field.
+func (m *NoteProposalMutation) ResetBaseContent() {
+	m.base_content = nil
+	delete(m.clearedFields, noteproposal.FieldBaseContent)
+}
+
+// SetStatus sets the "status" field.
+func (m *NoteProposalMutation) SetStatus(n noteproposal.Status) {
+	m.status = &n
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *NoteProposalMutation) Status() (r noteproposal.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the NoteProposal entity.
+// If the NoteProposal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteProposalMutation) OldStatus(ctx context.Context) (v noteproposal.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *NoteProposalMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *NoteProposalMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *NoteProposalMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the NoteProposal entity.
+// If the NoteProposal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteProposalMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *NoteProposalMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (m *NoteProposalMutation) SetReviewedAt(t time.Time) {
+	m.reviewed_at = &t
+}
+
+// ReviewedAt returns the value of the "reviewed_at" field in the mutation.
+func (m *NoteProposalMutation) ReviewedAt() (r time.Time, exists bool) {
+	v := m.reviewed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReviewedAt returns the old "reviewed_at" field's value of the NoteProposal entity.
+// If the NoteProposal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteProposalMutation) OldReviewedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReviewedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReviewedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReviewedAt: %w", err)
+	}
+	return oldValue.ReviewedAt, nil
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (m *NoteProposalMutation) ClearReviewedAt() {
+	m.reviewed_at = nil
+	m.clearedFields[noteproposal.FieldReviewedAt] = struct{}{}
+}
+
+// ReviewedAtCleared returns if the "reviewed_at" field was cleared in this mutation.
+func (m *NoteProposalMutation) ReviewedAtCleared() bool {
+	_, ok := m.clearedFields[noteproposal.FieldReviewedAt]
+	return ok
+}
+
+// ResetReviewedAt resets all changes to the "reviewed_at" field.
+func (m *NoteProposalMutation) ResetReviewedAt() {
+	m.reviewed_at = nil
+	delete(m.clearedFields, noteproposal.FieldReviewedAt)
+}
+
+// SetNoteID sets the "note" edge to the Note entity by id.
+func (m *NoteProposalMutation) SetNoteID(id int) {
+	m.note = &id
+}
+
+// ClearNote clears the "note" edge to the Note entity.
+func (m *NoteProposalMutation) ClearNote() {
+	m.clearednote = true
+}
+
+// NoteCleared reports if the "note" edge to the Note entity was cleared.
+func (m *NoteProposalMutation) NoteCleared() bool {
+	return m.clearednote
+}
+
+// NoteID returns the "note" edge ID in the mutation.
+func (m *NoteProposalMutation) NoteID() (id int, exists bool) {
+	if m.note != nil {
+		return *m.note, true
+	}
+	return
+}
+
+// NoteIDs returns the "note" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// NoteID instead. It exists only for internal usage by the builders.
+func (m *NoteProposalMutation) NoteIDs() (ids []int) {
+	if id := m.note; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetNote resets all changes to the "note" edge.
+func (m *NoteProposalMutation) ResetNote() {
+	m.note = nil
+	m.clearednote = false
+}
+
+// SetProposerID sets the "proposer" edge to the User entity by id.
+func (m *NoteProposalMutation) SetProposerID(id int) {
+	m.proposer = &id
+}
+
+// ClearProposer clears the "proposer" edge to the User entity.
+func (m *NoteProposalMutation) ClearProposer() {
+	m.clearedproposer = true
+}
+
+// ProposerCleared reports if the "proposer" edge to the User entity was cleared.
+func (m *NoteProposalMutation) ProposerCleared() bool {
+	return m.clearedproposer
+}
+
+// ProposerID returns the "proposer" edge ID in the mutation.
+func (m *NoteProposalMutation) ProposerID() (id int, exists bool) {
+	if m.proposer != nil {
+		return *m.proposer, true
+	}
+	return
+}
+
+// ProposerIDs returns the "proposer" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ProposerID instead. It exists only for internal usage by the builders.
+func (m *NoteProposalMutation) ProposerIDs() (ids []int) {
+	if id := m.proposer; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetProposer resets all changes to the "proposer" edge.
+func (m *NoteProposalMutation) ResetProposer() {
+	m.proposer = nil
+	m.clearedproposer = false
+}
+
+// Where appends a list predicates to the NoteProposalMutation builder.
+func (m *NoteProposalMutation) Where(ps ...predicate.NoteProposal) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the NoteProposalMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *NoteProposalMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.NoteProposal, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *NoteProposalMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *NoteProposalMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (NoteProposal).
+func (m *NoteProposalMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *NoteProposalMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.title != nil {
+		fields = append(fields, noteproposal.FieldTitle)
+	}
+	if m.description != nil {
+		fields = append(fields, noteproposal.FieldDescription)
+	}
+	if m.content != nil {
+		fields = append(fields, noteproposal.FieldContent)
+	}
+	if m.base_title != nil {
+		fields = append(fields, noteproposal.FieldBaseTitle)
+	}
+	if m.base_description != nil {
+		fields = append(fields, noteproposal.FieldBaseDescription)
+	}
+	if m.base_content != nil {
+		fields = append(fields, noteproposal.FieldBaseContent)
+	}
+	if m.status != nil {
+		fields = append(fields, noteproposal.FieldStatus)
+	}
+	if m.created_at != nil {
+		fields = append(fields, noteproposal.FieldCreatedAt)
+	}
+	if m.reviewed_at != nil {
+		fields = append(fields, noteproposal.FieldReviewedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *NoteProposalMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case noteproposal.FieldTitle:
+		return m.Title()
+	case noteproposal.FieldDescription:
+		return m.Description()
+	case noteproposal.FieldContent:
+		return m.Content()
+	case noteproposal.FieldBaseTitle:
+		return m.BaseTitle()
+	case noteproposal.FieldBaseDescription:
+		return m.BaseDescription()
+	case noteproposal.FieldBaseContent:
+		return m.BaseContent()
+	case noteproposal.FieldStatus:
+		return m.Status()
+	case noteproposal.FieldCreatedAt:
+		return m.CreatedAt()
+	case noteproposal.FieldReviewedAt:
+		return m.ReviewedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *NoteProposalMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case noteproposal.FieldTitle:
+		return m.OldTitle(ctx)
+	case noteproposal.FieldDescription:
+		return m.OldDescription(ctx)
+	case noteproposal.FieldContent:
+		return m.OldContent(ctx)
+	case noteproposal.FieldBaseTitle:
+		return m.OldBaseTitle(ctx)
+	case noteproposal.FieldBaseDescription:
+		return m.OldBaseDescription(ctx)
+	case noteproposal.FieldBaseContent:
+		return m.OldBaseContent(ctx)
+	case noteproposal.FieldStatus:
+		return m.OldStatus(ctx)
+	case noteproposal.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case noteproposal.FieldReviewedAt:
+		return m.OldReviewedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown NoteProposal field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NoteProposalMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case noteproposal.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case noteproposal.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case noteproposal.FieldContent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContent(v)
+		return nil
+	case noteproposal.FieldBaseTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBaseTitle(v)
+		return nil
+	case noteproposal.FieldBaseDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBaseDescription(v)
+		return nil
+	case noteproposal.FieldBaseContent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBaseContent(v)
+		return nil
+	case noteproposal.FieldStatus:
+		v, ok := value.(noteproposal.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case noteproposal.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case noteproposal.FieldReviewedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReviewedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown NoteProposal field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *NoteProposalMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *NoteProposalMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NoteProposalMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown NoteProposal numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *NoteProposalMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(noteproposal.FieldDescription) {
+		fields = append(fields, noteproposal.FieldDescription)
+	}
+	if m.FieldCleared(noteproposal.FieldContent) {
+		fields = append(fields, noteproposal.FieldContent)
+	}
+	if m.FieldCleared(noteproposal.FieldBaseTitle) {
+		fields = append(fields, noteproposal.FieldBaseTitle)
+	}
+	if m.FieldCleared(noteproposal.FieldBaseDescription) {
+		fields = append(fields, noteproposal.FieldBaseDescription)
+	}
+	if m.FieldCleared(noteproposal.FieldBaseContent) {
+		fields = append(fields, noteproposal.FieldBaseContent)
+	}
+	if m.FieldCleared(noteproposal.FieldReviewedAt) {
+		fields = append(fields, noteproposal.FieldReviewedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *NoteProposalMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *NoteProposalMutation) ClearField(name string) error {
+	switch name {
+	case noteproposal.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case noteproposal.FieldContent:
+		m.ClearContent()
+		return nil
+	case noteproposal.FieldBaseTitle:
+		m.ClearBaseTitle()
+		return nil
+	case noteproposal.FieldBaseDescription:
+		m.ClearBaseDescription()
+		return nil
+	case noteproposal.FieldBaseContent:
+		m.ClearBaseContent()
+		return nil
+	case noteproposal.FieldReviewedAt:
+		m.ClearReviewedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown NoteProposal nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *NoteProposalMutation) ResetField(name string) error {
+	switch name {
+	case noteproposal.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case noteproposal.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case noteproposal.FieldContent:
+		m.ResetContent()
+		return nil
+	case noteproposal.FieldBaseTitle:
+		m.ResetBaseTitle()
+		return nil
+	case noteproposal.FieldBaseDescription:
+		m.ResetBaseDescription()
+		return nil
+	case noteproposal.FieldBaseContent:
+		m.ResetBaseContent()
+		return nil
+	case noteproposal.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case noteproposal.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case noteproposal.FieldReviewedAt:
+		m.ResetReviewedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown NoteProposal field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *NoteProposalMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.note != nil {
+		edges = append(edges, noteproposal.EdgeNote)
+	}
+	if m.proposer != nil {
+		edges = append(edges, noteproposal.EdgeProposer)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *NoteProposalMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case noteproposal.EdgeNote:
+		if id := m.note; id != nil {
+			return []ent.Value{*id}
+		}
+	case noteproposal.EdgeProposer:
+		if id := m.proposer; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *NoteProposalMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *NoteProposalMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *NoteProposalMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearednote {
+		edges = append(edges, noteproposal.EdgeNote)
+	}
+	if m.clearedproposer {
+		edges = append(edges, noteproposal.EdgeProposer)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *NoteProposalMutation) EdgeCleared(name string) bool {
+	switch name {
+	case noteproposal.EdgeNote:
+		return m.clearednote
+	case noteproposal.EdgeProposer:
+		return m.clearedproposer
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *NoteProposalMutation) ClearEdge(name string) error {
+	switch name {
+	case noteproposal.EdgeNote:
+		m.ClearNote()
+		return nil
+	case noteproposal.EdgeProposer:
+		m.ClearProposer()
+		return nil
+	}
+	return fmt.Errorf("unknown NoteProposal unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *NoteProposalMutation) ResetEdge(name string) error {
+	switch name {
+	case noteproposal.EdgeNote:
+		m.ResetNote()
+		return nil
+	case noteproposal.EdgeProposer:
+		m.ResetProposer()
+		return nil
+	}
+	return fmt.Errorf("unknown NoteProposal edge %s", name)
+}
+
+// NoteRepostMutation represents an operation that mutates the NoteRepost nodes in the graph.
+type NoteRepostMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	comment       *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *int
+	cleareduser   bool
+	note          *int
+	clearednote   bool
+	done          bool
+	oldValue      func(context.Context) (*NoteRepost, error)
+	predicates    []predicate.NoteRepost
+}
+
+var _ ent.Mutation = (*NoteRepostMutation)(nil)
+
+// noterepostOption allows management of the mutation configuration using functional options.
+type noterepostOption func(*NoteRepostMutation)
+
+// newNoteRepostMutation creates new mutation for the NoteRepost entity.
+func newNoteRepostMutation(c config, op Op, opts ...noterepostOption) *NoteRepostMutation {
+	m := &NoteRepostMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeNoteRepost,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withNoteRepostID sets the ID field of the mutation.
+func withNoteRepostID(id int) noterepostOption {
+	return func(m *NoteRepostMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *NoteRepost
+		)
+		m.oldValue = func(ctx context.Context) (*NoteRepost, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().NoteRepost.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withNoteRepost sets the old NoteRepost of the mutation.
+func withNoteRepost(node *NoteRepost) noterepostOption {
+	return func(m *NoteRepostMutation) {
+		m.oldValue = func(context.Context) (*NoteRepost, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NoteRepostMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NoteRepostMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *NoteRepostMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *NoteRepostMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().NoteRepost.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetComment sets the "comment" field.
+func (m *NoteRepostMutation) SetComment(s string) {
+	m.comment = &s
+}
+
+// Comment returns the value of the "comment" field in the mutation.
+func (m *NoteRepostMutation) Comment() (r string, exists bool) {
+	v := m.comment
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldComment returns the old "comment" field's value of the NoteRepost entity.
+// If the NoteRepost object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteRepostMutation) OldComment(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldComment is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldComment requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldComment: %w", err)
+	}
+	return oldValue.Comment, nil
+}
+
+// ClearComment clears the value of the "comment" field.
+func (m *NoteRepostMutation) ClearComment() {
+	m.comment = nil
+	m.clearedFields[noterepost.FieldComment] = struct{}{}
+}
+
+// CommentCleared returns if the "comment" field was cleared in this mutation.
+func (m *NoteRepostMutation) CommentCleared() bool {
+	_, ok := m.clearedFields[noterepost.FieldComment]
+	return ok
+}
+
+// ResetComment resets all changes to the "comment" field.
+func (m *NoteRepostMutation) ResetComment() {
+	m.comment = nil
+	delete(m.clearedFields, noterepost.FieldComment)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *NoteRepostMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *NoteRepostMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the NoteRepost entity.
+// If the NoteRepost object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteRepostMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *NoteRepostMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *NoteRepostMutation) SetUserID(id int) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *NoteRepostMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *NoteRepostMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *NoteRepostMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *NoteRepostMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *NoteRepostMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// SetNoteID sets the "note" edge to the Note entity by id.
+func (m *NoteRepostMutation) SetNoteID(id int) {
+	m.note = &id
+}
+
+// ClearNote clears the "note" edge to the Note entity.
+func (m *NoteRepostMutation) ClearNote() {
+	m.clearednote = true
+}
+
+// NoteCleared reports if the "note" edge to the Note entity was cleared.
+func (m *NoteRepostMutation) NoteCleared() bool {
+	return m.clearednote
+}
+
+// NoteID returns the "note" edge ID in the mutation.
+func (m *NoteRepostMutation) NoteID() (id int, exists bool) {
+	if m.note != nil {
+		return *m.note, true
+	}
+	return
+}
+
+// NoteIDs returns the "note" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// NoteID instead. It exists only for internal usage by the builders.
+func (m *NoteRepostMutation) NoteIDs() (ids []int) {
+	if id := m.note; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetNote resets all changes to the "note" edge.
+func (m *NoteRepostMutation) ResetNote() {
+	m.note = nil
+	m.clearednote = false
+}
+
+// Where appends a list predicates to the NoteRepostMutation builder.
+func (m *NoteRepostMutation) Where(ps ...predicate.NoteRepost) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the NoteRepostMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *NoteRepostMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.NoteRepost, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *NoteRepostMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *NoteRepostMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (NoteRepost).
+func (m *NoteRepostMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *NoteRepostMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.comment != nil {
+		fields = append(fields, noterepost.FieldComment)
+	}
+	if m.created_at != nil {
+		fields = append(fields, noterepost.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *NoteRepostMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case noterepost.FieldComment:
+		return m.Comment()
+	case noterepost.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *NoteRepostMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case noterepost.FieldComment:
+		return m.OldComment(ctx)
+	case noterepost.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown NoteRepost field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NoteRepostMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case noterepost.FieldComment:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetComment(v)
+		return nil
+	case noterepost.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown NoteRepost field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *NoteRepostMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *NoteRepostMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NoteRepostMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown NoteRepost numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *NoteRepostMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(noterepost.FieldComment) {
+		fields = append(fields, noterepost.FieldComment)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *NoteRepostMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *NoteRepostMutation) ClearField(name string) error {
+	switch name {
+	case noterepost.FieldComment:
+		m.ClearComment()
+		return nil
+	}
+	return fmt.Errorf("unknown NoteRepost nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *NoteRepostMutation) ResetField(name string) error {
+	switch name {
+	case noterepost.FieldComment:
+		m.ResetComment()
+		return nil
+	case noterepost.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown NoteRepost field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *NoteRepostMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.user != nil {
+		edges = append(edges, noterepost.EdgeUser)
+	}
+	if m.note != nil {
+		edges = append(edges, noterepost.EdgeNote)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *NoteRepostMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case noterepost.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case noterepost.EdgeNote:
+		if id := m.note; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *NoteRepostMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *NoteRepostMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *NoteRepostMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareduser {
+		edges = append(edges, noterepost.EdgeUser)
+	}
+	if m.clearednote {
+		edges = append(edges, noterepost.EdgeNote)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *NoteRepostMutation) EdgeCleared(name string) bool {
+	switch name {
+	case noterepost.EdgeUser:
+		return m.cleareduser
+	case noterepost.EdgeNote:
+		return m.clearednote
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *NoteRepostMutation) ClearEdge(name string) error {
+	switch name {
+	case noterepost.EdgeUser:
+		m.ClearUser()
+		return nil
+	case noterepost.EdgeNote:
+		m.ClearNote()
+		return nil
+	}
+	return fmt.Errorf("unknown NoteRepost unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *NoteRepostMutation) ResetEdge(name string) error {
+	switch name {
+	case noterepost.EdgeUser:
+		m.ResetUser()
+		return nil
+	case noterepost.EdgeNote:
+		m.ResetNote()
+		return nil
+	}
+	return fmt.Errorf("unknown NoteRepost edge %s", name)
+}
+
+// NoteRevisionMutation represents an operation that mutates the NoteRevision nodes in the graph.
+type NoteRevisionMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	title           *string
+	description     *string
+	content         *string
+	resources       *[]types.Resource
+	appendresources []types.Resource
+	created_at      *time.Time
+	clearedFields   map[string]struct{}
+	note            *int
+	clearednote     bool
+	author          *int
+	clearedauthor   bool
+	done            bool
+	oldValue        func(context.Context) (*NoteRevision, error)
+	predicates      []predicate.NoteRevision
+}
+
+var _ ent.Mutation = (*NoteRevisionMutation)(nil)
+
+// noterevisionOption allows management of the mutation configuration using functional options.
+type noterevisionOption func(*NoteRevisionMutation)
+
+// newNoteRevisionMutation creates new mutation for the NoteRevision entity.
+func newNoteRevisionMutation(c config, op Op, opts ...noterevisionOption) *NoteRevisionMutation {
+	m := &NoteRevisionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeNoteRevision,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withNoteRevisionID sets the ID field of the mutation.
+func withNoteRevisionID(id int) noterevisionOption {
+	return func(m *NoteRevisionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *NoteRevision
+		)
+		m.oldValue = func(ctx context.Context) (*NoteRevision, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().NoteRevision.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withNoteRevision sets the old NoteRevision of the mutation.
+func withNoteRevision(node *NoteRevision) noterevisionOption {
+	return func(m *NoteRevisionMutation) {
+		m.oldValue = func(context.Context) (*NoteRevision, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NoteRevisionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NoteRevisionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *NoteRevisionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *NoteRevisionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().NoteRevision.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTitle sets the "title" field.
+func (m *NoteRevisionMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *NoteRevisionMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the NoteRevision entity.
+// If the NoteRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteRevisionMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *NoteRevisionMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *NoteRevisionMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *NoteRevisionMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the NoteRevision entity.
+// If the NoteRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteRevisionMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *NoteRevisionMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[noterevision.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *NoteRevisionMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[noterevision.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *NoteRevisionMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, noterevision.FieldDescription)
+}
+
+// SetContent sets the "content" field.
+func (m *NoteRevisionMutation) SetContent(s string) {
+	m.content = &s
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *NoteRevisionMutation) Content() (r string, exists bool) {
+	v := m.content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContent returns the old "content" field's value of the NoteRevision entity.
+// If the NoteRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteRevisionMutation) OldContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
+}
+
+// ClearContent clears the value of the "content" field.
+func (m *NoteRevisionMutation) ClearContent() {
+	m.content = nil
+	m.clearedFields[noterevision.FieldContent] = struct{}{}
+}
+
+// ContentCleared returns if the "content" field was cleared in this mutation.
+func (m *NoteRevisionMutation) ContentCleared() bool {
+	_, ok := m.clearedFields[noterevision.FieldContent]
+	return ok
+}
+
+// ResetContent resets all changes to the "content" field.
+func (m *NoteRevisionMutation) ResetContent() {
+	m.content = nil
+	delete(m.clearedFields, noterevision.FieldContent)
+}
+
+// SetResources sets the "resources" field.
+func (m *NoteRevisionMutation) SetResources(t []types.Resource) {
+	m.resources = &t
+	m.appendresources = nil
+}
+
+// Resources returns the value of the "resources" field in the mutation.
+func (m *NoteRevisionMutation) Resources() (r []types.Resource, exists bool) {
+	v := m.resources
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResources returns the old "resources" field's value of the NoteRevision entity.
+// If the NoteRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteRevisionMutation) OldResources(ctx context.Context) (v []types.Resource, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResources is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResources requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResources: %w", err)
+	}
+	return oldValue.Resources, nil
+}
+
+// AppendResources adds t to the "resources" field.
+func (m *NoteRevisionMutation) AppendResources(t []types.Resource) {
+	m.appendresources = append(m.appendresources, t...)
+}
+
+// AppendedResources returns the list of values that were appended to the "resources" field in this mutation.
+func (m *NoteRevisionMutation) AppendedResources() ([]types.Resource, bool) {
+	if len(m.appendresources) == 0 {
+		return nil, false
+	}
+	return m.appendresources, true
+}
+
+// ClearResources clears the value of the "resources" field.
+func (m *NoteRevisionMutation) ClearResources() {
+	m.resources = nil
+	m.appendresources = nil
+	m.clearedFields[noterevision.FieldResources] = struct{}{}
+}
+
+// ResourcesCleared returns if the "resources" field was cleared in this mutation.
+func (m *NoteRevisionMutation) ResourcesCleared() bool {
+	_, ok := m.clearedFields[noterevision.FieldResources]
+	return ok
+}
+
+// ResetResources resets all changes to the "resources" field.
+func (m *NoteRevisionMutation) ResetResources() {
+	m.resources = nil
+	m.appendresources = nil
+	delete(m.clearedFields, noterevision.FieldResources)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *NoteRevisionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *NoteRevisionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the NoteRevision entity.
+// If the NoteRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NoteRevisionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *NoteRevisionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetNoteID sets the "note" edge to the Note entity by id.
+func (m *NoteRevisionMutation) SetNoteID(id int) {
+	m.note = &id
+}
+
+// ClearNote clears the "note" edge to the Note entity.
+func (m *NoteRevisionMutation) ClearNote() {
+	m.clearednote = true
+}
+
+// NoteCleared reports if the "note" edge to the Note entity was cleared.
+func (m *NoteRevisionMutation) NoteCleared() bool {
+	return m.clearednote
+}
+
+// NoteID returns the "note" edge ID in the mutation.
+func (m *NoteRevisionMutation) NoteID() (id int, exists bool) {
+	if m.note != nil {
+		return *m.note, true
+	}
+	return
+}
+
+// NoteIDs returns the "note" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// NoteID instead. It exists only for internal usage by the builders.
+func (m *NoteRevisionMutation) NoteIDs() (ids []int) {
+	if id := m.note; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetNote resets all changes to the "note" edge.
+func (m *NoteRevisionMutation) ResetNote() {
+	m.note = nil
+	m.clearednote = false
+}
+
+// SetAuthorID sets the "author" edge to the User entity by id.
+func (m *NoteRevisionMutation) SetAuthorID(id int) {
+	m.author = &id
+}
+
+// ClearAuthor clears the "author" edge to the User entity.
+func (m *NoteRevisionMutation) ClearAuthor() {
+	m.clearedauthor = true
+}
+
+// AuthorCleared reports if the "author" edge to the User entity was cleared.
+func (m *NoteRevisionMutation) AuthorCleared() bool {
+	return m.clearedauthor
+}
+
+// AuthorID returns the "author" edge ID in the mutation.
+func (m *NoteRevisionMutation) AuthorID() (id int, exists bool) {
+	if m.author != nil {
+		return *m.author, true
+	}
+	return
+}
+
+// AuthorIDs returns the "author" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AuthorID instead. It exists only for internal usage by the builders.
+func (m *NoteRevisionMutation) AuthorIDs() (ids []int) {
+	if id := m.author; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAuthor resets all changes to the "author" edge.
+func (m *NoteRevisionMutation) ResetAuthor() {
+	m.author = nil
+	m.clearedauthor = false
+}
+
+// Where appends a list predicates to the NoteRevisionMutation builder.
+func (m *NoteRevisionMutation) Where(ps ...predicate.NoteRevision) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the NoteRevisionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *NoteRevisionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.NoteRevision, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *NoteRevisionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *NoteRevisionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (NoteRevision).
+func (m *NoteRevisionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *NoteRevisionMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.title != nil {
+		fields = append(fields, noterevision.FieldTitle)
+	}
+	if m.description != nil {
+		fields = append(fields, noterevision.FieldDescription)
+	}
+	if m.content != nil {
+		fields = append(fields, noterevision.FieldContent)
+	}
+	if m.resources != nil {
+		fields = append(fields, noterevision.FieldResources)
+	}
+	if m.created_at != nil {
+		fields = append(fields, noterevision.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *NoteRevisionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case noterevision.FieldTitle:
+		return m.Title()
+	case noterevision.FieldDescription:
+		return m.Description()
+	case noterevision.FieldContent:
+		return m.Content()
+	case noterevision.FieldResources:
+		return m.Resources()
+	case noterevision.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *NoteRevisionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case noterevision.FieldTitle:
+		return m.OldTitle(ctx)
+	case noterevision.FieldDescription:
+		return m.OldDescription(ctx)
+	case noterevision.FieldContent:
+		return m.OldContent(ctx)
+	case noterevision.FieldResources:
+		return m.OldResources(ctx)
+	case noterevision.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown NoteRevision field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NoteRevisionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case noterevision.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case noterevision.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case noterevision.FieldContent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContent(v)
+		return nil
+	case noterevision.FieldResources:
+		v, ok := value.([]types.Resource)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResources(v)
+		return nil
+	case noterevision.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown NoteRevision field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *NoteRevisionMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *NoteRevisionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NoteRevisionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown NoteRevision numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *NoteRevisionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(noterevision.FieldDescription) {
+		fields = append(fields, noterevision.FieldDescription)
+	}
+	if m.FieldCleared(noterevision.FieldContent) {
+		fields = append(fields, noterevision.FieldContent)
+	}
+	if m.FieldCleared(noterevision.FieldResources) {
+		fields = append(fields, noterevision.FieldResources)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *NoteRevisionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *NoteRevisionMutation) ClearField(name string) error {
+	switch name {
+	case noterevision.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case noterevision.FieldContent:
+		m.ClearContent()
+		return nil
+	case noterevision.FieldResources:
+		m.ClearResources()
+		return nil
+	}
+	return fmt.Errorf("unknown NoteRevision nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *NoteRevisionMutation) ResetField(name string) error {
+	switch name {
+	case noterevision.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case noterevision.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case noterevision.FieldContent:
+		m.ResetContent()
+		return nil
+	case noterevision.FieldResources:
+		m.ResetResources()
+		return nil
+	case noterevision.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown NoteRevision field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *NoteRevisionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.note != nil {
+		edges = append(edges, noterevision.EdgeNote)
+	}
+	if m.author != nil {
+		edges = append(edges, noterevision.EdgeAuthor)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *NoteRevisionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case noterevision.EdgeNote:
+		if id := m.note; id != nil {
+			return []ent.Value{*id}
+		}
+	case noterevision.EdgeAuthor:
+		if id := m.author; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *NoteRevisionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *NoteRevisionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *NoteRevisionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearednote {
+		edges = append(edges, noterevision.EdgeNote)
+	}
+	if m.clearedauthor {
+		edges = append(edges, noterevision.EdgeAuthor)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *NoteRevisionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case noterevision.EdgeNote:
+		return m.clearednote
+	case noterevision.EdgeAuthor:
+		return m.clearedauthor
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *NoteRevisionMutation) ClearEdge(name string) error {
+	switch name {
+	case noterevision.EdgeNote:
+		m.ClearNote()
+		return nil
+	case noterevision.EdgeAuthor:
+		m.ClearAuthor()
+		return nil
+	}
+	return fmt.Errorf("unknown NoteRevision unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *NoteRevisionMutation) ResetEdge(name string) error {
+	switch name {
+	case noterevision.EdgeNote:
+		m.ResetNote()
+		return nil
+	case noterevision.EdgeAuthor:
+		m.ResetAuthor()
+		return nil
+	}
+	return fmt.Errorf("unknown NoteRevision edge %s", name)
+}
+
+// NotebookMutation represents an operation that mutates the Notebook nodes in the graph.
+type NotebookMutation struct {
+	config
+	op                       Op
+	typ                      string
+	id                       *int
+	name                     *string
+	default_visibility       *notebook.DefaultVisibility
+	default_permission_level *notebook.DefaultPermissionLevel
+	created_at               *time.Time
+	clearedFields            map[string]struct{}
+	owner                    *int
+	clearedowner             bool
+	parent                   *int
+	clearedparent            bool
+	children                 map[int]struct{}
+	removedchildren          map[int]struct{}
+	clearedchildren          bool
+	notes                    map[int]struct{}
+	removednotes             map[int]struct{}
+	clearednotes             bool
+	done                     bool
+	oldValue                 func(context.Context) (*Notebook, error)
+	predicates               []predicate.Notebook
+}
+
+var _ ent.Mutation = (*NotebookMutation)(nil)
+
+// notebookOption allows management of the mutation configuration using functional options.
+type notebookOption func(*NotebookMutation)
+
+// newNotebookMutation creates new mutation for the Notebook entity.
+func newNotebookMutation(c config, op Op, opts ...notebookOption) *NotebookMutation {
+	m := &NotebookMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeNotebook,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withNotebookID sets the ID field of the mutation.
+func withNotebookID(id int) notebookOption {
+	return func(m *NotebookMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Notebook
+		)
+		m.oldValue = func(ctx context.Context) (*Notebook, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Notebook.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withNotebook sets the old Notebook of the mutation.
+func withNotebook(node *Notebook) notebookOption {
+	return func(m *NotebookMutation) {
+		m.oldValue = func(context.Context) (*Notebook, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NotebookMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NotebookMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *NotebookMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *NotebookMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Notebook.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *NotebookMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *NotebookMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Notebook entity.
+// If the Notebook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotebookMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *NotebookMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDefaultVisibility sets the "default_visibility" field.
+func (m *NotebookMutation) SetDefaultVisibility(nv notebook.DefaultVisibility) {
+	m.default_visibility = &nv
+}
+
+// DefaultVisibility returns the value of the "default_visibility" field in the mutation.
+func (m *NotebookMutation) DefaultVisibility() (r notebook.DefaultVisibility, exists bool) {
+	v := m.default_visibility
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDefaultVisibility returns the old "default_visibility" field's value of the Notebook entity.
+// If the Notebook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotebookMutation) OldDefaultVisibility(ctx context.Context) (v notebook.DefaultVisibility, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDefaultVisibility is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDefaultVisibility requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDefaultVisibility: %w", err)
+	}
+	return oldValue.DefaultVisibility, nil
+}
+
+// ResetDefaultVisibility resets all changes to the "default_visibility" field.
+func (m *NotebookMutation) ResetDefaultVisibility() {
+	m.default_visibility = nil
+}
+
+// SetDefaultPermissionLevel sets the "default_permission_level" field.
+func (m *NotebookMutation) SetDefaultPermissionLevel(npl notebook.DefaultPermissionLevel) {
+	m.default_permission_level = &npl
+}
+
+// DefaultPermissionLevel returns the value of the "default_permission_level" field in the mutation.
+func (m *NotebookMutation) DefaultPermissionLevel() (r notebook.DefaultPermissionLevel, exists bool) {
+	v := m.default_permission_level
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDefaultPermissionLevel returns the old "default_permission_level" field's value of the Notebook entity.
+// If the Notebook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotebookMutation) OldDefaultPermissionLevel(ctx context.Context) (v notebook.DefaultPermissionLevel, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDefaultPermissionLevel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDefaultPermissionLevel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDefaultPermissionLevel: %w", err)
+	}
+	return oldValue.DefaultPermissionLevel, nil
+}
+
+// ResetDefaultPermissionLevel resets all changes to the "default_permission_level" field.
+func (m *NotebookMutation) ResetDefaultPermissionLevel() {
+	m.default_permission_level = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *NotebookMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *NotebookMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Notebook entity.
+// If the Notebook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotebookMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *NotebookMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by id.
+func (m *NotebookMutation) SetOwnerID(id int) {
+	m.owner = &id
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *NotebookMutation) ClearOwner() {
+	m.clearedowner = true
+}
+
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *NotebookMutation) OwnerCleared() bool {
+	return m.clearedowner
+}
+
+// OwnerID returns the "owner" edge ID in the mutation.
+func (m *NotebookMutation) OwnerID() (id int, exists bool) {
+	if m.owner != nil {
+		return *m.owner, true
+	}
+	return
+}
+
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *NotebookMutation) OwnerIDs() (ids []int) {
+	if id := m.owner; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOwner resets all changes to the "owner" edge.
+func (m *NotebookMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
+}
+
+// SetParentID sets the "parent" edge to the Notebook entity by id.
+func (m *NotebookMutation) SetParentID(id int) {
+	m.parent = &id
+}
+
+// ClearParent clears the "parent" edge to the Notebook entity.
+func (m *NotebookMutation) ClearParent() {
+	m.clearedparent = true
+}
+
+// ParentCleared reports if the "parent" edge to the Notebook entity was cleared.
+func (m *NotebookMutation) ParentCleared() bool {
+	return m.clearedparent
+}
+
+// ParentID returns the "parent" edge ID in the mutation.
+func (m *NotebookMutation) ParentID() (id int, exists bool) {
+	if m.parent != nil {
+		return *m.parent, true
+	}
+	return
+}
+
+// ParentIDs returns the "parent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ParentID instead. It exists only for internal usage by the builders.
+func (m *NotebookMutation) ParentIDs() (ids []int) {
+	if id := m.parent; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetParent resets all changes to the "parent" edge.
+func (m *NotebookMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
+}
+
+// AddChildIDs adds the "children" edge to the Notebook entity by ids.
+func (m *NotebookMutation) AddChildIDs(ids ...int) {
+	if m.children == nil {
+		m.children = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.children[ids[i]] = struct{}{}
+	}
+}
+
+// ClearChildren clears the "children" edge to the Notebook entity.
+func (m *NotebookMutation) ClearChildren() {
+	m.clearedchildren = true
+}
+
+// ChildrenCleared reports if the "children" edge to the Notebook entity was cleared.
+func (m *NotebookMutation) ChildrenCleared() bool {
+	return m.clearedchildren
+}
+
+// RemoveChildIDs removes the "children" edge to the Notebook entity by IDs.
+func (m *NotebookMutation) RemoveChildIDs(ids ...int) {
+	if m.removedchildren == nil {
+		m.removedchildren = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.children, ids[i])
+		m.removedchildren[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedChildren returns the removed IDs of the "children" edge to the Notebook entity.
+func (m *NotebookMutation) RemovedChildrenIDs() (ids []int) {
+	for id := range m.removedchildren {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ChildrenIDs returns the "children" edge IDs in the mutation.
+func (m *NotebookMutation) ChildrenIDs() (ids []int) {
+	for id := range m.children {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetChildren resets all changes to the "children" edge.
+func (m *NotebookMutation) ResetChildren() {
+	m.children = nil
+	m.clearedchildren = false
+	m.removedchildren = nil
+}
+
+// AddNoteIDs adds the "notes" edge to the Note entity by ids.
+func (m *NotebookMutation) AddNoteIDs(ids ...int) {
+	if m.notes == nil {
+		m.notes = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.notes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearNotes clears the "notes" edge to the Note entity.
+func (m *NotebookMutation) ClearNotes() {
+	m.clearednotes = true
+}
+
+// NotesCleared reports if the "notes" edge to the Note entity was cleared.
+func (m *NotebookMutation) NotesCleared() bool {
+	return m.clearednotes
+}
+
+// RemoveNoteIDs removes the "notes" edge to the Note entity by IDs.
+func (m *NotebookMutation) RemoveNoteIDs(ids ...int) {
+	if m.removednotes == nil {
+		m.removednotes = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.notes, ids[i])
+		m.removednotes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedNotes returns the removed IDs of the "notes" edge to the Note entity.
+func (m *NotebookMutation) RemovedNotesIDs() (ids []int) {
+	for id := range m.removednotes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// NotesIDs returns the "notes" edge IDs in the mutation.
+func (m *NotebookMutation) NotesIDs() (ids []int) {
+	for id := range m.notes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetNotes resets all changes to the "notes" edge.
+func (m *NotebookMutation) ResetNotes() {
+	m.notes = nil
+	m.clearednotes = false
+	m.removednotes = nil
+}
+
+// Where appends a list predicates to the NotebookMutation builder.
+func (m *NotebookMutation) Where(ps ...predicate.Notebook) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the NotebookMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *NotebookMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Notebook, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *NotebookMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *NotebookMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Notebook).
+func (m *NotebookMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *NotebookMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.name != nil {
+		fields = append(fields, notebook.FieldName)
+	}
+	if m.default_visibility != nil {
+		fields = append(fields, notebook.FieldDefaultVisibility)
+	}
+	if m.default_permission_level != nil {
+		fields = append(fields, notebook.FieldDefaultPermissionLevel)
+	}
+	if m.created_at != nil {
+		fields = append(fields, notebook.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *NotebookMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case notebook.FieldName:
+		return m.Name()
+	case notebook.FieldDefaultVisibility:
+		return m.DefaultVisibility()
+	case notebook.FieldDefaultPermissionLevel:
+		return m.DefaultPermissionLevel()
+	case notebook.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *NotebookMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case notebook.FieldName:
+		return m.OldName(ctx)
+	case notebook.FieldDefaultVisibility:
+		return m.OldDefaultVisibility(ctx)
+	case notebook.FieldDefaultPermissionLevel:
+		return m.OldDefaultPermissionLevel(ctx)
+	case notebook.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Notebook field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NotebookMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case notebook.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case notebook.FieldDefaultVisibility:
+		v, ok := value.(notebook.DefaultVisibility)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDefaultVisibility(v)
+		return nil
+	case notebook.FieldDefaultPermissionLevel:
+		v, ok := value.(notebook.DefaultPermissionLevel)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDefaultPermissionLevel(v)
+		return nil
+	case notebook.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Notebook field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *NotebookMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *NotebookMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NotebookMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Notebook numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *NotebookMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *NotebookMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *NotebookMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Notebook nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *NotebookMutation) ResetField(name string) error {
+	switch name {
+	case notebook.FieldName:
+		m.ResetName()
+		return nil
+	case notebook.FieldDefaultVisibility:
+		m.ResetDefaultVisibility()
+		return nil
+	case notebook.FieldDefaultPermissionLevel:
+		m.ResetDefaultPermissionLevel()
+		return nil
+	case notebook.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Notebook field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *NotebookMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.owner != nil {
+		edges = append(edges, notebook.EdgeOwner)
+	}
+	if m.parent != nil {
+		edges = append(edges, notebook.EdgeParent)
+	}
+	if m.children != nil {
+		edges = append(edges, notebook.EdgeChildren)
+	}
+	if m.notes != nil {
+		edges = append(edges, notebook.EdgeNotes)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *NotebookMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case notebook.EdgeOwner:
+		if id := m.owner; id != nil {
+			return []ent.Value{*id}
+		}
+	case notebook.EdgeParent:
+		if id := m.parent; id != nil {
+			return []ent.Value{*id}
+		}
+	case notebook.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.children))
+		for id := range m.children {
+			ids = append(ids, id)
+		}
+		return ids
+	case notebook.EdgeNotes:
+		ids := make([]ent.Value, 0, len(m.notes))
+		for id := range m.notes {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *NotebookMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedchildren != nil {
+		edges = append(edges, notebook.EdgeChildren)
+	}
+	if m.removednotes != nil {
+		edges = append(edges, notebook.EdgeNotes)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *NotebookMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case notebook.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.removedchildren))
+		for id := range m.removedchildren {
+			ids = append(ids, id)
+		}
+		return ids
+	case notebook.EdgeNotes:
+		ids := make([]ent.Value, 0, len(m.removednotes))
+		for id := range m.removednotes {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *NotebookMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedowner {
+		edges = append(edges, notebook.EdgeOwner)
+	}
+	if m.clearedparent {
+		edges = append(edges, notebook.EdgeParent)
+	}
+	if m.clearedchildren {
+		edges = append(edges, notebook.EdgeChildren)
+	}
+	if m.clearednotes {
+		edges = append(edges, notebook.EdgeNotes)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *NotebookMutation) EdgeCleared(name string) bool {
+	switch name {
+	case notebook.EdgeOwner:
+		return m.clearedowner
+	case notebook.EdgeParent:
+		return m.clearedparent
+	case notebook.EdgeChildren:
+		return m.clearedchildren
+	case notebook.EdgeNotes:
+		return m.clearednotes
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *NotebookMutation) ClearEdge(name string) error {
+	switch name {
+	case notebook.EdgeOwner:
+		m.ClearOwner()
+		return nil
+	case notebook.EdgeParent:
+		m.ClearParent()
+		return nil
+	}
+	return fmt.Errorf("unknown Notebook unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *NotebookMutation) ResetEdge(name string) error {
+	switch name {
+	case notebook.EdgeOwner:
+		m.ResetOwner()
+		return nil
+	case notebook.EdgeParent:
+		m.ResetParent()
+		return nil
+	case notebook.EdgeChildren:
+		m.ResetChildren()
+		return nil
+	case notebook.EdgeNotes:
+		m.ResetNotes()
+		return nil
+	}
+	return fmt.Errorf("unknown Notebook edge %s", name)
+}
+
+// PasswordTokenMutation represents an operation that mutates the PasswordToken nodes in the graph.
+type PasswordTokenMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	token         *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *int
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*PasswordToken, error)
+	predicates    []predicate.PasswordToken
+}
+
+var _ ent.Mutation = (*PasswordTokenMutation)(nil)
+
+// passwordtokenOption allows management of the mutation configuration using functional options.
+type passwordtokenOption func(*PasswordTokenMutation)
+
+// newPasswordTokenMutation creates new mutation for the PasswordToken entity.
+func newPasswordTokenMutation(c config, op Op, opts ...passwordtokenOption) *PasswordTokenMutation {
+	m := &PasswordTokenMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePasswordToken,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPasswordTokenID sets the ID field of the mutation.
+func withPasswordTokenID(id int) passwordtokenOption {
+	return func(m *PasswordTokenMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PasswordToken
+		)
+		m.oldValue = func(ctx context.Context) (*PasswordToken, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PasswordToken.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPasswordToken sets the old PasswordToken of the mutation.
+func withPasswordToken(node *PasswordToken) passwordtokenOption {
+	return func(m *PasswordTokenMutation) {
+		m.oldValue = func(context.Context) (*PasswordToken, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PasswordTokenMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PasswordTokenMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PasswordTokenMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PasswordTokenMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PasswordToken.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetToken sets the "token" field.
+func (m *PasswordTokenMutation) SetToken(s string) {
+	m.token = &s
+}
+
+// Token returns the value of the "token" field in the mutation.
+func (m *PasswordTokenMutation) Token() (r string, exists bool) {
+	v := m.token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldToken returns the old "token" field's value of the PasswordToken entity.
+// If the PasswordToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasswordTokenMutation) OldToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToken: %w", err)
+	}
+	return oldValue.Token, nil
+}
+
+// ResetToken resets all changes to the "token" field.
+func (m *PasswordTokenMutation) ResetToken() {
+	m.token = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *PasswordTokenMutation) SetUserID(i int) {
+	m.user = &i
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *PasswordTokenMutation) UserID() (r int, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the PasswordToken entity.
+// If the PasswordToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasswordTokenMutation) OldUserID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *PasswordTokenMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PasswordTokenMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PasswordTokenMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the PasswordToken entity.
+// If the PasswordToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasswordTokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PasswordTokenMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *PasswordTokenMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[passwordtoken.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *PasswordTokenMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *PasswordTokenMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *PasswordTokenMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the PasswordTokenMutation builder.
+func (m *PasswordTokenMutation) Where(ps ...predicate.PasswordToken) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PasswordTokenMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PasswordTokenMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PasswordToken, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PasswordTokenMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PasswordTokenMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PasswordToken).
+func (m *PasswordTokenMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PasswordTokenMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.token != nil {
+		fields = append(fields, passwordtoken.FieldToken)
+	}
+	if m.user != nil {
+		fields = append(fields, passwordtoken.FieldUserID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, passwordtoken.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PasswordTokenMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case passwordtoken.FieldToken:
+		return m.Token()
+	case passwordtoken.FieldUserID:
+		return m.UserID()
+	case passwordtoken.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PasswordTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case passwordtoken.FieldToken:
+		return m.OldToken(ctx)
+	case passwordtoken.FieldUserID:
+		return m.OldUserID(ctx)
+	case passwordtoken.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown PasswordToken field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PasswordTokenMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case passwordtoken.FieldToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToken(v)
+		return nil
+	case passwordtoken.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case passwordtoken.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PasswordToken field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PasswordTokenMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PasswordTokenMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PasswordTokenMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown PasswordToken numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PasswordTokenMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PasswordTokenMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PasswordTokenMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown PasswordToken nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PasswordTokenMutation) ResetField(name string) error {
+	switch name {
+	case passwordtoken.FieldToken:
+		m.ResetToken()
+		return nil
+	case passwordtoken.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case passwordtoken.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown PasswordToken field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PasswordTokenMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, passwordtoken.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PasswordTokenMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case passwordtoken.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PasswordTokenMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PasswordTokenMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PasswordTokenMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, passwordtoken.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PasswordTokenMutation) EdgeCleared(name string) bool {
+	switch name {
+	case passwordtoken.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PasswordTokenMutation) ClearEdge(name string) error {
+	switch name {
+	case passwordtoken.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown PasswordToken unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PasswordTokenMutation) ResetEdge(name string) error {
+	switch name {
+	case passwordtoken.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown PasswordToken edge %s", name)
+}
+
+// QuestionMutation represents an operation that mutates the Question nodes in the graph.
+type QuestionMutation struct {
+	config
+	op                     Op
+	typ                    string
+	id                     *int
+	_type                  *question.Type
+	prompt                 *string
+	accepted_answers       *[]string
+	appendaccepted_answers []string
+	explanation            *string
+	points                 *int
+	addpoints              *int
+	position               *int
+	addposition            *int
+	clearedFields          map[string]struct{}
+	quiz                   *int
+	clearedquiz            bool
+	choices                map[int]struct{}
+	removedchoices         map[int]struct{}
+	clearedchoices         bool
+	done                   bool
+	oldValue               func(context.Context) (*Question, error)
+	predicates             []predicate.Question
+}
+
+var _ ent.Mutation = (*QuestionMutation)(nil)
+
+// questionOption allows management of the mutation configuration using functional options.
+type questionOption func(*QuestionMutation)
+
+// newQuestionMutation creates new mutation for the Question entity.
+func newQuestionMutation(c config, op Op, opts ...questionOption) *QuestionMutation {
+	m := &QuestionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeQuestion,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withQuestionID sets the ID field of the mutation.
+func withQuestionID(id int) questionOption {
+	return func(m *QuestionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Question
+		)
+		m.oldValue = func(ctx context.Context) (*Question, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Question.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withQuestion sets the old Question of the mutation.
+func withQuestion(node *Question) questionOption {
+	return func(m *QuestionMutation) {
+		m.oldValue = func(context.Context) (*Question, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m QuestionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m QuestionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *QuestionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *QuestionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Question.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetType sets the "type" field.
+func (m *QuestionMutation) SetType(q question.Type) {
+	m._type = &q
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *QuestionMutation) GetType() (r question.Type, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionMutation) OldType(ctx context.Context) (v question.Type, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *QuestionMutation) ResetType() {
+	m._type = nil
+}
+
+// SetPrompt sets the "prompt" field.
+func (m *QuestionMutation) SetPrompt(s string) {
+	m.prompt = &s
+}
+
+// Prompt returns the value of the "prompt" field in the mutation.
+func (m *QuestionMutation) Prompt() (r string, exists bool) {
+	v := m.prompt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrompt returns the old "prompt" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionMutation) OldPrompt(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrompt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrompt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrompt: %w", err)
+	}
+	return oldValue.Prompt, nil
+}
+
+// ResetPrompt resets all changes to the "prompt" field.
+func (m *QuestionMutation) ResetPrompt() {
+	m.prompt = nil
+}
+
+// SetAcceptedAnswers sets the "accepted_answers" field.
+func (m *QuestionMutation) SetAcceptedAnswers(s []string) {
+	m.accepted_answers = &s
+	m.appendaccepted_answers = nil
+}
+
+// AcceptedAnswers returns the value of the "accepted_answers" field in the mutation.
+func (m *QuestionMutation) AcceptedAnswers() (r []string, exists bool) {
+	v := m.accepted_answers
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAcceptedAnswers returns the old "accepted_answers" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionMutation) OldAcceptedAnswers(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAcceptedAnswers is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAcceptedAnswers requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAcceptedAnswers: %w", err)
+	}
+	return oldValue.AcceptedAnswers, nil
+}
+
+// AppendAcceptedAnswers adds s to the "accepted_answers" field.
+func (m *QuestionMutation) AppendAcceptedAnswers(s []string) {
+	m.appendaccepted_answers = append(m.appendaccepted_answers, s...)
+}
+
+// AppendedAcceptedAnswers returns the list of values that were appended to the "accepted_answers" field in this mutation.
+func (m *QuestionMutation) AppendedAcceptedAnswers() ([]string, bool) {
+	if len(m.appendaccepted_answers) == 0 {
+		return nil, false
+	}
+	return m.appendaccepted_answers, true
+}
+
+// ClearAcceptedAnswers clears the value of the "accepted_answers" field.
+func (m *QuestionMutation) ClearAcceptedAnswers() {
+	m.accepted_answers = nil
+	m.appendaccepted_answers = nil
+	m.clearedFields[question.FieldAcceptedAnswers] = struct{}{}
+}
+
+// AcceptedAnswersCleared returns if the "accepted_answers" field was cleared in this mutation.
+func (m *QuestionMutation) AcceptedAnswersCleared() bool {
+	_, ok := m.clearedFields[question.FieldAcceptedAnswers]
+	return ok
+}
+
+// ResetAcceptedAnswers resets all changes to the "accepted_answers" field.
+func (m *QuestionMutation) ResetAcceptedAnswers() {
+	m.accepted_answers = nil
+	m.appendaccepted_answers = nil
+	delete(m.clearedFields, question.FieldAcceptedAnswers)
+}
+
+// SetExplanation sets the "explanation" field.
+func (m *QuestionMutation) SetExplanation(s string) {
+	m.explanation = &s
+}
+
+// Explanation returns the value of the "explanation" field in the mutation.
+func (m *QuestionMutation) Explanation() (r string, exists bool) {
+	v := m.explanation
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExplanation returns the old "explanation" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionMutation) OldExplanation(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExplanation is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExplanation requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExplanation: %w", err)
+	}
+	return oldValue.Explanation, nil
+}
+
+// ClearExplanation clears the value of the "explanation" field.
+func (m *QuestionMutation) ClearExplanation() {
+	m.explanation = nil
+	m.clearedFields[question.FieldExplanation] = struct{}{}
+}
+
+// ExplanationCleared returns if the "explanation" field was cleared in this mutation.
+func (m *QuestionMutation) ExplanationCleared() bool {
+	_, ok := m.clearedFields[question.FieldExplanation]
+	return ok
+}
+
+// ResetExplanation resets all changes to the "explanation" field.
+func (m *QuestionMutation) ResetExplanation() {
+	m.explanation = nil
+	delete(m.clearedFields, question.FieldExplanation)
+}
+
+// SetPoints sets the "points" field.
+func (m *QuestionMutation) SetPoints(i int) {
+	m.points = &i
+	m.addpoints = nil
+}
+
+// Points returns the value of the "points" field in the mutation.
+func (m *QuestionMutation) Points() (r int, exists bool) {
+	v := m.points
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPoints returns the old "points" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionMutation) OldPoints(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPoints is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPoints requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPoints: %w", err)
+	}
+	return oldValue.Points, nil
+}
+
+// AddPoints adds i to the "points" field.
+func (m *QuestionMutation) AddPoints(i int) {
+	if m.addpoints != nil {
+		*m.addpoints += i
+	} else {
+		m.addpoints = &i
+	}
+}
+
+// AddedPoints returns the value that was added to the "points" field in this mutation.
+func (m *QuestionMutation) AddedPoints() (r int, exists bool) {
+	v := m.addpoints
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPoints resets all changes to the "points" field.
+func (m *QuestionMutation) ResetPoints() {
+	m.points = nil
+	m.addpoints = nil
+}
+
+// SetPosition sets the "position" field.
+func (m *QuestionMutation) SetPosition(i int) {
+	m.position = &i
+	m.addposition = nil
+}
+
+// Position returns the value of the "position" field in the mutation.
+func (m *QuestionMutation) Position() (r int, exists bool) {
+	v := m.position
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPosition returns the old "position" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionMutation) OldPosition(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPosition is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPosition requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPosition: %w", err)
+	}
+	return oldValue.Position, nil
+}
+
+// AddPosition adds i to the "position" field.
+func (m *QuestionMutation) AddPosition(i int) {
+	if m.addposition != nil {
+		*m.addposition += i
+	} else {
+		m.addposition = &i
+	}
+}
+
+// AddedPosition returns the value that was added to the "position" field in this mutation.
+func (m *QuestionMutation) AddedPosition() (r int, exists bool) {
+	v := m.addposition
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPosition resets all changes to the "position" field.
+func (m *QuestionMutation) ResetPosition() {
+	m.position = nil
+	m.addposition = nil
+}
+
+// SetQuizID sets the "quiz" edge to the Quiz entity by id.
+func (m *QuestionMutation) SetQuizID(id int) {
+	m.quiz = &id
+}
+
+// ClearQuiz clears the "quiz" edge to the Quiz entity.
+func (m *QuestionMutation) ClearQuiz() {
+	m.clearedquiz = true
+}
+
+// QuizCleared reports if the "quiz" edge to the Quiz entity was cleared.
+func (m *QuestionMutation) QuizCleared() bool {
+	return m.clearedquiz
+}
+
+// QuizID returns the "quiz" edge ID in the mutation.
+func (m *QuestionMutation) QuizID() (id int, exists bool) {
+	if m.quiz != nil {
+		return *m.quiz, true
+	}
+	return
+}
+
+// QuizIDs returns the "quiz" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// QuizID instead. It exists only for internal usage by the builders.
+func (m *QuestionMutation) QuizIDs() (ids []int) {
+	if id := m.quiz; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetQuiz resets all changes to the "quiz" edge.
+func (m *QuestionMutation) ResetQuiz() {
+	m.quiz = nil
+	m.clearedquiz = false
+}
+
+// AddChoiceIDs adds the "choices" edge to the Choice entity by ids.
+func (m *QuestionMutation) AddChoiceIDs(ids ...int) {
+	if m.choices == nil {
+		m.choices = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.choices[ids[i]] = struct{}{}
+	}
+}
+
+// ClearChoices clears the "choices" edge to the Choice entity.
+func (m *QuestionMutation) ClearChoices() {
+	m.clearedchoices = true
+}
+
+// ChoicesCleared reports if the "choices" edge to the Choice entity was cleared.
+func (m *QuestionMutation) ChoicesCleared() bool {
+	return m.clearedchoices
+}
+
+// RemoveChoiceIDs removes the "choices" edge to the Choice entity by IDs.
+func (m *QuestionMutation) RemoveChoiceIDs(ids ...int) {
+	if m.removedchoices == nil {
+		m.removedchoices = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.choices, ids[i])
+		m.removedchoices[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedChoices returns the removed IDs of the "choices" edge to the Choice entity.
+func (m *QuestionMutation) RemovedChoicesIDs() (ids []int) {
+	for id := range m.removedchoices {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ChoicesIDs returns the "choices" edge IDs in the mutation.
+func (m *QuestionMutation) ChoicesIDs() (ids []int) {
+	for id := range m.choices {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetChoices resets all changes to the "choices" edge.
+func (m *QuestionMutation) ResetChoices() {
+	m.choices = nil
+	m.clearedchoices = false
+	m.removedchoices = nil
+}
+
+// Where appends a list predicates to the QuestionMutation builder.
+func (m *QuestionMutation) Where(ps ...predicate.Question) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the QuestionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *QuestionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Question, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *QuestionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *QuestionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Question).
+func (m *QuestionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *QuestionMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m._type != nil {
+		fields = append(fields, question.FieldType)
+	}
+	if m.prompt != nil {
+		fields = append(fields, question.FieldPrompt)
+	}
+	if m.accepted_answers != nil {
+		fields = append(fields, question.FieldAcceptedAnswers)
+	}
+	if m.explanation != nil {
+		fields = append(fields, question.FieldExplanation)
+	}
+	if m.points != nil {
+		fields = append(fields, question.FieldPoints)
+	}
+	if m.position != nil {
+		fields = append(fields, question.FieldPosition)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *QuestionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case question.FieldType:
+		return m.GetType()
+	case question.FieldPrompt:
+		return m.Prompt()
+	case question.FieldAcceptedAnswers:
+		return m.AcceptedAnswers()
+	case question.FieldExplanation:
+		return m.Explanation()
+	case question.FieldPoints:
+		return m.Points()
+	case question.FieldPosition:
+		return m.Position()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *QuestionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case question.FieldType:
+		return m.OldType(ctx)
+	case question.FieldPrompt:
+		return m.OldPrompt(ctx)
+	case question.FieldAcceptedAnswers:
+		return m.OldAcceptedAnswers(ctx)
+	case question.FieldExplanation:
+		return m.OldExplanation(ctx)
+	case question.FieldPoints:
+		return m.OldPoints(ctx)
+	case question.FieldPosition:
+		return m.OldPosition(ctx)
+	}
+	return nil, fmt.Errorf("unknown Question field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *QuestionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case question.FieldType:
+		v, ok := value.(question.Type)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case question.FieldPrompt:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrompt(v)
+		return nil
+	case question.FieldAcceptedAnswers:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAcceptedAnswers(v)
+		return nil
+	case question.FieldExplanation:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExplanation(v)
+		return nil
+	case question.FieldPoints:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPoints(v)
+		return nil
+	case question.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPosition(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Question field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *QuestionMutation) AddedFields() []string {
+	var fields []string
+	if m.addpoints != nil {
+		fields = append(fields, question.FieldPoints)
+	}
+	if m.addposition != nil {
+		fields = append(fields, question.FieldPosition)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *QuestionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case question.FieldPoints:
+		return m.AddedPoints()
+	case question.FieldPosition:
+		return m.AddedPosition()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *QuestionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case question.FieldPoints:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPoints(v)
+		return nil
+	case question.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPosition(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Question numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *QuestionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(question.FieldAcceptedAnswers) {
+		fields = append(fields, question.FieldAcceptedAnswers)
+	}
+	if m.FieldCleared(question.FieldExplanation) {
+		fields = append(fields, question.FieldExplanation)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *QuestionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *QuestionMutation) ClearField(name string) error {
+	switch name {
+	case question.FieldAcceptedAnswers:
+		m.ClearAcceptedAnswers()
+		return nil
+	case question.FieldExplanation:
+		m.ClearExplanation()
+		return nil
+	}
+	return fmt.Errorf("unknown Question nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *QuestionMutation) ResetField(name string) error {
+	switch name {
+	case question.FieldType:
+		m.ResetType()
+		return nil
+	case question.FieldPrompt:
+		m.ResetPrompt()
+		return nil
+	case question.FieldAcceptedAnswers:
+		m.ResetAcceptedAnswers()
+		return nil
+	case question.FieldExplanation:
+		m.ResetExplanation()
+		return nil
+	case question.FieldPoints:
+		m.ResetPoints()
+		return nil
+	case question.FieldPosition:
+		m.ResetPosition()
+		return nil
+	}
+	return fmt.Errorf("unknown Question field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *QuestionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.quiz != nil {
+		edges = append(edges, question.EdgeQuiz)
+	}
+	if m.choices != nil {
+		edges = append(edges, question.EdgeChoices)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *QuestionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case question.EdgeQuiz:
+		if id := m.quiz; id != nil {
+			return []ent.Value{*id}
+		}
+	case question.EdgeChoices:
+		ids := make([]ent.Value, 0, len(m.choices))
+		for id := range m.choices {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *QuestionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedchoices != nil {
+		edges = append(edges, question.EdgeChoices)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *QuestionMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case question.EdgeChoices:
+		ids := make([]ent.Value, 0, len(m.removedchoices))
+		for id := range m.removedchoices {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *QuestionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedquiz {
+		edges = append(edges, question.EdgeQuiz)
+	}
+	if m.clearedchoices {
+		edges = append(edges, question.EdgeChoices)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *QuestionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case question.EdgeQuiz:
+		return m.clearedquiz
+	case question.EdgeChoices:
+		return m.clearedchoices
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *QuestionMutation) ClearEdge(name string) error {
+	switch name {
+	case question.EdgeQuiz:
+		m.ClearQuiz()
+		return nil
+	}
+	return fmt.Errorf("unknown Question unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *QuestionMutation) ResetEdge(name string) error {
+	switch name {
+	case question.EdgeQuiz:
+		m.ResetQuiz()
+		return nil
+	case question.EdgeChoices:
+		m.ResetChoices()
+		return nil
+	}
+	return fmt.Errorf("unknown Question edge %s", name)
+}
+
+// QuizMutation represents an operation that mutates the Quiz nodes in the graph.
+type QuizMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *int
+	title               *string
+	description         *string
+	visibility          *quiz.Visibility
+	time_limit          *int
+	addtime_limit       *int
+	max_attempts        *int
+	addmax_attempts     *int
+	generator           *string
+	created_at          *time.Time
+	updated_at          *time.Time
+	clearedFields       map[string]struct{}
+	owner               *int
+	clearedowner        bool
+	note                *int
+	clearednote         bool
+	questions           map[int]struct{}
+	removedquestions    map[int]struct{}
+	clearedquestions    bool
+	attempts            map[int]struct{}
+	removedattempts     map[int]struct{}
+	clearedattempts     bool
+	study_events        map[int]struct{}
+	removedstudy_events map[int]struct{}
+	clearedstudy_events bool
+	done                bool
+	oldValue            func(context.Context) (*Quiz, error)
+	predicates          []predicate.Quiz
+}
+
+var _ ent.Mutation = (*QuizMutation)(nil)
+
+// quizOption allows management of the mutation configuration using functional options.
+type quizOption func(*QuizMutation)
+
+// newQuizMutation creates new mutation for the Quiz entity.
+func newQuizMutation(c config, op Op, opts ...quizOption) *QuizMutation {
+	m := &QuizMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeQuiz,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withQuizID sets the ID field of the mutation.
+func withQuizID(id int) quizOption {
+	return func(m *QuizMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Quiz
+		)
+		m.oldValue = func(ctx context.Context) (*Quiz, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Quiz.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withQuiz sets the old Quiz of the mutation.
+func withQuiz(node *Quiz) quizOption {
+	return func(m *QuizMutation) {
+		m.oldValue = func(context.Context) (*Quiz, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m QuizMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m QuizMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *QuizMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *QuizMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Quiz.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTitle sets the "title" field.
+func (m *QuizMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *QuizMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the Quiz entity.
+// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *QuizMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *QuizMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *QuizMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Quiz entity.
+// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *QuizMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[quiz.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *QuizMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[quiz.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *QuizMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, quiz.FieldDescription)
+}
+
+// SetVisibility sets the "visibility" field.
+func (m *QuizMutation) SetVisibility(q quiz.Visibility) {
+	m.visibility = &q
+}
+
+// Visibility returns the value of the "visibility" field in the mutation.
+func (m *QuizMutation) Visibility() (r quiz.Visibility, exists bool) {
+	v := m.visibility
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVisibility returns the old "visibility" field's value of the Quiz entity.
+// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizMutation) OldVisibility(ctx context.Context) (v quiz.Visibility, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVisibility is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVisibility requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVisibility: %w", err)
+	}
+	return oldValue.Visibility, nil
+}
+
+// ResetVisibility resets all changes to the "visibility" field.
+func (m *QuizMutation) ResetVisibility() {
+	m.visibility = nil
+}
+
+// SetTimeLimit sets the "time_limit" field.
+func (m *QuizMutation) SetTimeLimit(i int) {
+	m.time_limit = &i
+	m.addtime_limit = nil
+}
+
+// TimeLimit returns the value of the "time_limit" field in the mutation.
+func (m *QuizMutation) TimeLimit() (r int, exists bool) {
+	v := m.time_limit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimeLimit returns the old "time_limit" field's value of the Quiz entity.
+// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizMutation) OldTimeLimit(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimeLimit is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimeLimit requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimeLimit: %w", err)
+	}
+	return oldValue.TimeLimit, nil
+}
+
+// AddTimeLimit adds i to the "time_limit" field.
+func (m *QuizMutation) AddTimeLimit(i int) {
+	if m.addtime_limit != nil {
+		*m.addtime_limit += i
+	} else {
+		m.addtime_limit = &i
+	}
+}
+
+// AddedTimeLimit returns the value that was added to the "time_limit" field in this mutation.
+func (m *QuizMutation) AddedTimeLimit() (r int, exists bool) {
+	v := m.addtime_limit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTimeLimit resets all changes to the "time_limit" field.
+func (m *QuizMutation) ResetTimeLimit() {
+	m.time_limit = nil
+	m.addtime_limit = nil
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (m *QuizMutation) SetMaxAttempts(i int) {
+	m.max_attempts = &i
+	m.addmax_attempts = nil
+}
+
+// MaxAttempts returns the value of the "max_attempts" field in the mutation.
+func (m *QuizMutation) MaxAttempts() (r int, exists bool) {
+	v := m.max_attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxAttempts returns the old "max_attempts" field's value of the Quiz entity.
+// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizMutation) OldMaxAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxAttempts: %w", err)
+	}
+	return oldValue.MaxAttempts, nil
+}
+
+// AddMaxAttempts adds i to the "max_attempts" field.
+func (m *QuizMutation) AddMaxAttempts(i int) {
+	if m.addmax_attempts != nil {
+		*m.addmax_attempts += i
+	} else {
+		m.addmax_attempts = &i
+	}
+}
+
+// AddedMaxAttempts returns the value that was added to the "max_attempts" field in this mutation.
+func (m *QuizMutation) AddedMaxAttempts() (r int, exists bool) {
+	v := m.addmax_attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMaxAttempts resets all changes to the "max_attempts" field.
+func (m *QuizMutation) ResetMaxAttempts() {
+	m.max_attempts = nil
+	m.addmax_attempts = nil
+}
+
+// SetGenerator sets the "generator" field.
+func (m *QuizMutation) SetGenerator(s string) {
+	m.generator = &s
+}
+
+// Generator returns the value of the "generator" field in the mutation.
+func (m *QuizMutation) Generator() (r string, exists bool) {
+	v := m.generator
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGenerator returns the old "generator" field's value of the Quiz entity.
+// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizMutation) OldGenerator(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGenerator is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGenerator requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGenerator: %w", err)
+	}
+	return oldValue.Generator, nil
+}
+
+// ClearGenerator clears the value of the "generator" field.
+func (m *QuizMutation) ClearGenerator() {
+	m.generator = nil
+	m.clearedFields[quiz.FieldGenerator] = struct{}{}
+}
+
+// GeneratorCleared returns if the "generator" field was cleared in this mutation.
+func (m *QuizMutation) GeneratorCleared() bool {
+	_, ok := m.clearedFields[quiz.FieldGenerator]
+	return ok
+}
+
+// ResetGenerator resets all changes to the "generator" field.
+func (m *QuizMutation) ResetGenerator() {
+	m.generator = nil
+	delete(m.clearedFields, quiz.FieldGenerator)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *QuizMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *QuizMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Quiz entity.
+// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *QuizMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *QuizMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *QuizMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Quiz entity.
+// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *QuizMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by id.
+func (m *QuizMutation) SetOwnerID(id int) {
+	m.owner = &id
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *QuizMutation) ClearOwner() {
+	m.clearedowner = true
+}
+
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *QuizMutation) OwnerCleared() bool {
+	return m.clearedowner
+}
+
+// OwnerID returns the "owner" edge ID in the mutation.
+func (m *QuizMutation) OwnerID() (id int, exists bool) {
+	if m.owner != nil {
+		return *m.owner, true
+	}
+	return
+}
+
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *QuizMutation) OwnerIDs() (ids []int) {
+	if id := m.owner; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOwner resets all changes to the "owner" edge.
+func (m *QuizMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
+}
+
+// SetNoteID sets the "note" edge to the Note entity by id.
+func (m *QuizMutation) SetNoteID(id int) {
+	m.note = &id
+}
+
+// ClearNote clears the "note" edge to the Note entity.
+func (m *QuizMutation) ClearNote() {
+	m.clearednote = true
+}
+
+// NoteCleared reports if the "note" edge to the Note entity was cleared.
+func (m *QuizMutation) NoteCleared() bool {
+	return m.clearednote
+}
+
+// NoteID returns the "note" edge ID in the mutation.
+func (m *QuizMutation) NoteID() (id int, exists bool) {
+	if m.note != nil {
+		return *m.note, true
+	}
+	return
+}
+
+// NoteIDs returns the "note" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// NoteID instead. It exists only for internal usage by the builders.
+func (m *QuizMutation) NoteIDs() (ids []int) {
+	if id := m.note; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetNote resets all changes to the "note" edge.
+func (m *QuizMutation) ResetNote() {
+	m.note = nil
+	m.clearednote = false
+}
+
+// AddQuestionIDs adds the "questions" edge to the Question entity by ids.
+func (m *QuizMutation) AddQuestionIDs(ids ...int) {
+	if m.questions == nil {
+		m.questions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.questions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearQuestions clears the "questions" edge to the Question entity.
+func (m *QuizMutation) ClearQuestions() {
+	m.clearedquestions = true
+}
+
+// QuestionsCleared reports if the "questions" edge to the Question entity was cleared.
+func (m *QuizMutation) QuestionsCleared() bool {
+	return m.clearedquestions
+}
+
+// RemoveQuestionIDs removes the "questions" edge to the Question entity by IDs.
+func (m *QuizMutation) RemoveQuestionIDs(ids ...int) {
+	if m.removedquestions == nil {
+		m.removedquestions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.questions, ids[i])
+		m.removedquestions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedQuestions returns the removed IDs of the "questions" edge to the Question entity.
+func (m *QuizMutation) RemovedQuestionsIDs() (ids []int) {
+	for id := range m.removedquestions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// QuestionsIDs returns the "questions" edge IDs in the mutation.
+func (m *QuizMutation) QuestionsIDs() (ids []int) {
+	for id := range m.questions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetQuestions resets all changes to the "questions" edge.
+func (m *QuizMutation) ResetQuestions() {
+	m.questions = nil
+	m.clearedquestions = false
+	m.removedquestions = nil
+}
+
+// AddAttemptIDs adds the "attempts" edge to the QuizAttempt entity by ids.
+func (m *QuizMutation) AddAttemptIDs(ids ...int) {
+	if m.attempts == nil {
+		m.attempts = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.attempts[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAttempts clears the "attempts" edge to the QuizAttempt entity.
+func (m *QuizMutation) ClearAttempts() {
+	m.clearedattempts = true
+}
+
+// AttemptsCleared reports if the "attempts" edge to the QuizAttempt entity was cleared.
+func (m *QuizMutation) AttemptsCleared() bool {
+	return m.clearedattempts
+}
+
+// RemoveAttemptIDs removes the "attempts" edge to the QuizAttempt entity by IDs.
+func (m *QuizMutation) RemoveAttemptIDs(ids ...int) {
+	if m.removedattempts == nil {
+		m.removedattempts = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.attempts, ids[i])
+		m.removedattempts[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAttempts returns the removed IDs of the "attempts" edge to the QuizAttempt entity.
+func (m *QuizMutation) RemovedAttemptsIDs() (ids []int) {
+	for id := range m.removedattempts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AttemptsIDs returns the "attempts" edge IDs in the mutation.
+func (m *QuizMutation) AttemptsIDs() (ids []int) {
+	for id := range m.attempts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAttempts resets all changes to the "attempts" edge.
+func (m *QuizMutation) ResetAttempts() {
+	m.attempts = nil
+	m.clearedattempts = false
+	m.removedattempts = nil
+}
+
+// AddStudyEventIDs adds the "study_events" edge to the StudyEvent entity by ids.
+func (m *QuizMutation) AddStudyEventIDs(ids ...int) {
+	if m.study_events == nil {
+		m.study_events = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.study_events[ids[i]] = struct{}{}
+	}
+}
+
+// ClearStudyEvents clears the "study_events" edge to the StudyEvent entity.
+func (m *QuizMutation) ClearStudyEvents() {
+	m.clearedstudy_events = true
+}
+
+// StudyEventsCleared reports if the "study_events" edge to the StudyEvent entity was cleared.
+func (m *QuizMutation) StudyEventsCleared() bool {
+	return m.clearedstudy_events
+}
+
+// RemoveStudyEventIDs removes the "study_events" edge to the StudyEvent entity by IDs.
+func (m *QuizMutation) RemoveStudyEventIDs(ids ...int) {
+	if m.removedstudy_events == nil {
+		m.removedstudy_events = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.study_events, ids[i])
+		m.removedstudy_events[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedStudyEvents returns the removed IDs of the "study_events" edge to the StudyEvent entity.
+func (m *QuizMutation) RemovedStudyEventsIDs() (ids []int) {
+	for id := range m.removedstudy_events {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// StudyEventsIDs returns the "study_events" edge IDs in the mutation.
+func (m *QuizMutation) StudyEventsIDs() (ids []int) {
+	for id := range m.study_events {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetStudyEvents resets all changes to the "study_events" edge.
+func (m *QuizMutation) ResetStudyEvents() {
+	m.study_events = nil
+	m.clearedstudy_events = false
+	m.removedstudy_events = nil
+}
+
+// Where appends a list predicates to the QuizMutation builder.
+func (m *QuizMutation) Where(ps ...predicate.Quiz) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the QuizMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *QuizMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Quiz, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *QuizMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *QuizMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Quiz).
+func (m *QuizMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *QuizMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.title != nil {
+		fields = append(fields, quiz.FieldTitle)
+	}
+	if m.description != nil {
+		fields = append(fields, quiz.FieldDescription)
+	}
+	if m.visibility != nil {
+		fields = append(fields, quiz.FieldVisibility)
+	}
+	if m.time_limit != nil {
+		fields = append(fields, quiz.FieldTimeLimit)
+	}
+	if m.max_attempts != nil {
+		fields = append(fields, quiz.FieldMaxAttempts)
+	}
+	if m.generator != nil {
+		fields = append(fields, quiz.FieldGenerator)
+	}
+	if m.created_at != nil {
+		fields = append(fields, quiz.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, quiz.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *QuizMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case quiz.FieldTitle:
+		return m.Title()
+	case quiz.FieldDescription:
+		return m.Description()
+	case quiz.FieldVisibility:
+		return m.Visibility()
+	case quiz.FieldTimeLimit:
+		return m.TimeLimit()
+	case quiz.FieldMaxAttempts:
+		return m.MaxAttempts()
+	case quiz.FieldGenerator:
+		return m.Generator()
+	case quiz.FieldCreatedAt:
+		return m.CreatedAt()
+	case quiz.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *QuizMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case quiz.FieldTitle:
+		return m.OldTitle(ctx)
+	case quiz.FieldDescription:
+		return m.OldDescription(ctx)
+	case quiz.FieldVisibility:
+		return m.OldVisibility(ctx)
+	case quiz.FieldTimeLimit:
+		return m.OldTimeLimit(ctx)
+	case quiz.FieldMaxAttempts:
+		return m.OldMaxAttempts(ctx)
+	case quiz.FieldGenerator:
+		return m.OldGenerator(ctx)
+	case quiz.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case quiz.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Quiz field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *QuizMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case quiz.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case quiz.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case quiz.FieldVisibility:
+		v, ok := value.(quiz.Visibility)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVisibility(v)
+		return nil
+	case quiz.FieldTimeLimit:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimeLimit(v)
+		return nil
+	case quiz.FieldMaxAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxAttempts(v)
+		return nil
+	case quiz.FieldGenerator:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGenerator(v)
+		return nil
+	case quiz.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case quiz.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Quiz field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *QuizMutation) AddedFields() []string {
+	var fields []string
+	if m.addtime_limit != nil {
+		fields = append(fields, quiz.FieldTimeLimit)
+	}
+	if m.addmax_attempts != nil {
+		fields = append(fields, quiz.FieldMaxAttempts)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *QuizMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case quiz.FieldTimeLimit:
+		return m.AddedTimeLimit()
+	case quiz.FieldMaxAttempts:
+		return m.AddedMaxAttempts()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *QuizMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case quiz.FieldTimeLimit:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTimeLimit(v)
+		return nil
+	case quiz.FieldMaxAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxAttempts(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Quiz numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *QuizMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(quiz.FieldDescription) {
+		fields = append(fields, quiz.FieldDescription)
+	}
+	if m.FieldCleared(quiz.FieldGenerator) {
+		fields = append(fields, quiz.FieldGenerator)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *QuizMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *QuizMutation) ClearField(name string) error {
+	switch name {
+	case quiz.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case quiz.FieldGenerator:
+		m.ClearGenerator()
+		return nil
+	}
+	return fmt.Errorf("unknown Quiz nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *QuizMutation) ResetField(name string) error {
+	switch name {
+	case quiz.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case quiz.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case quiz.FieldVisibility:
+		m.ResetVisibility()
+		return nil
+	case quiz.FieldTimeLimit:
+		m.ResetTimeLimit()
+		return nil
+	case quiz.FieldMaxAttempts:
+		m.ResetMaxAttempts()
+		return nil
+	case quiz.FieldGenerator:
+		m.ResetGenerator()
+		return nil
+	case quiz.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case quiz.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Quiz field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *QuizMutation) AddedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.owner != nil {
+		edges = append(edges, quiz.EdgeOwner)
+	}
+	if m.note != nil {
+		edges = append(edges, quiz.EdgeNote)
+	}
+	if m.questions != nil {
+		edges = append(edges, quiz.EdgeQuestions)
+	}
+	if m.attempts != nil {
+		edges = append(edges, quiz.EdgeAttempts)
+	}
+	if m.study_events != nil {
+		edges = append(edges, quiz.EdgeStudyEvents)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *QuizMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case quiz.EdgeOwner:
+		if id := m.owner; id != nil {
+			return []ent.Value{*id}
+		}
+	case quiz.EdgeNote:
+		if id := m.note; id != nil {
+			return []ent.Value{*id}
+		}
+	case quiz.EdgeQuestions:
+		ids := make([]ent.Value, 0, len(m.questions))
+		for id := range m.questions {
+			ids = append(ids, id)
+		}
+		return ids
+	case quiz.EdgeAttempts:
+		ids := make([]ent.Value, 0, len(m.attempts))
+		for id := range m.attempts {
+			ids = append(ids, id)
+		}
+		return ids
+	case quiz.EdgeStudyEvents:
+		ids := make([]ent.Value, 0, len(m.study_events))
+		for id := range m.study_events {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *QuizMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.removedquestions != nil {
+		edges = append(edges, quiz.EdgeQuestions)
+	}
+	if m.removedattempts != nil {
+		edges = append(edges, quiz.EdgeAttempts)
+	}
+	if m.removedstudy_events != nil {
+		edges = append(edges, quiz.EdgeStudyEvents)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *QuizMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case quiz.EdgeQuestions:
+		ids := make([]ent.Value, 0, len(m.removedquestions))
+		for id := range m.removedquestions {
+			ids = append(ids, id)
+		}
+		return ids
+	case quiz.EdgeAttempts:
+		ids := make([]ent.Value, 0, len(m.removedattempts))
+		for id := range m.removedattempts {
+			ids = append(ids, id)
+		}
+		return ids
+	case quiz.EdgeStudyEvents:
+		ids := make([]ent.Value, 0, len(m.removedstudy_events))
+		for id := range m.removedstudy_events {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *QuizMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.clearedowner {
+		edges = append(edges, quiz.EdgeOwner)
+	}
+	if m.clearednote {
+		edges = append(edges, quiz.EdgeNote)
+	}
+	if m.clearedquestions {
+		edges = append(edges, quiz.EdgeQuestions)
+	}
+	if m.clearedattempts {
+		edges = append(edges, quiz.EdgeAttempts)
+	}
+	if m.clearedstudy_events {
+		edges = append(edges, quiz.EdgeStudyEvents)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *QuizMutation) EdgeCleared(name string) bool {
+	switch name {
+	case quiz.EdgeOwner:
+		return m.clearedowner
+	case quiz.EdgeNote:
+		return m.clearednote
+	case quiz.EdgeQuestions:
+		return m.clearedquestions
+	case quiz.EdgeAttempts:
+		return m.clearedattempts
+	case quiz.EdgeStudyEvents:
+		return m.clearedstudy_events
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *QuizMutation) ClearEdge(name string) error {
+	switch name {
+	case quiz.EdgeOwner:
+		m.ClearOwner()
+		return nil
+	case quiz.EdgeNote:
+		m.ClearNote()
+		return nil
+	}
+	return fmt.Errorf("unknown Quiz unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *QuizMutation) ResetEdge(name string) error {
+	switch name {
+	case quiz.EdgeOwner:
+		m.ResetOwner()
+		return nil
+	case quiz.EdgeNote:
+		m.ResetNote()
+		return nil
+	case quiz.EdgeQuestions:
+		m.ResetQuestions()
+		return nil
+	case quiz.EdgeAttempts:
+		m.ResetAttempts()
+		return nil
+	case quiz.EdgeStudyEvents:
+		m.ResetStudyEvents()
+		return nil
+	}
+	return fmt.Errorf("unknown Quiz edge %s", name)
+}
+
+// QuizAttemptMutation represents an operation that mutates the QuizAttempt nodes in the graph.
+type QuizAttemptMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	answers            *map[int]string
+	score              *int
+	addscore           *int
+	max_score          *int
+	addmax_score       *int
+	started_at         *time.Time
+	deadline           *time.Time
+	submitted_at       *time.Time
+	status             *quizattempt.Status
+	clearedFields      map[string]struct{}
+	quiz               *int
+	clearedquiz        bool
+	user               *int
+	cleareduser        bool
+	study_event        *int
+	clearedstudy_event bool
+	done               bool
+	oldValue           func(context.Context) (*QuizAttempt, error)
+	predicates         []predicate.QuizAttempt
+}
+
+var _ ent.Mutation = (*QuizAttemptMutation)(nil)
+
+// quizattemptOption allows management of the mutation configuration using functional options.
+type quizattemptOption func(*QuizAttemptMutation)
+
+// newQuizAttemptMutation creates new mutation for the QuizAttempt entity.
+func newQuizAttemptMutation(c config, op Op, opts ...quizattemptOption) *QuizAttemptMutation {
+	m := &QuizAttemptMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeQuizAttempt,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withQuizAttemptID sets the ID field of the mutation.
+func withQuizAttemptID(id int) quizattemptOption {
+	return func(m *QuizAttemptMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *QuizAttempt
+		)
+		m.oldValue = func(ctx context.Context) (*QuizAttempt, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().QuizAttempt.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withQuizAttempt sets the old QuizAttempt of the mutation.
+func withQuizAttempt(node *QuizAttempt) quizattemptOption {
+	return func(m *QuizAttemptMutation) {
+		m.oldValue = func(context.Context) (*QuizAttempt, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m QuizAttemptMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m QuizAttemptMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *QuizAttemptMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *QuizAttemptMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().QuizAttempt.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetAnswers sets the "answers" field.
+func (m *QuizAttemptMutation) SetAnswers(value map[int]string) {
+	m.answers = &value
+}
+
+// Answers returns the value of the "answers" field in the mutation.
+func (m *QuizAttemptMutation) Answers() (r map[int]string, exists bool) {
+	v := m.answers
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAnswers returns the old "answers" field's value of the QuizAttempt entity.
+// If the QuizAttempt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizAttemptMutation) OldAnswers(ctx context.Context) (v map[int]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAnswers is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAnswers requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAnswers: %w", err)
+	}
+	return oldValue.Answers, nil
+}
+
+// ClearAnswers clears the value of the "answers" field.
+func (m *QuizAttemptMutation) ClearAnswers() {
+	m.answers = nil
+	m.clearedFields[quizattempt.FieldAnswers] = struct{}{}
+}
+
+// AnswersCleared returns if the "answers" field was cleared in this mutation.
+func (m *QuizAttemptMutation) AnswersCleared() bool {
+	_, ok := m.clearedFields[quizattempt.FieldAnswers]
+	return ok
+}
+
+// ResetAnswers resets all changes to the "answers" field.
+func (m *QuizAttemptMutation) ResetAnswers() {
+	m.answers = nil
+	delete(m.clearedFields, quizattempt.FieldAnswers)
+}
+
+// SetScore sets the "score" field.
+func (m *QuizAttemptMutation) SetScore(i int) {
+	m.score = &i
+	m.addscore = nil
+}
+
+// Score returns the value of the "score" field in the mutation.
+func (m *QuizAttemptMutation) Score() (r int, exists bool) {
+	v := m.score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScore returns the old "score" field's value of the QuizAttempt entity.
+// If the QuizAttempt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizAttemptMutation) OldScore(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScore: %w", err)
+	}
+	return oldValue.Score, nil
+}
+
+// AddScore adds i to the "score" field.
+func (m *QuizAttemptMutation) AddScore(i int) {
+	if m.addscore != nil {
+		*m.addscore += i
+	} else {
+		m.addscore = &i
+	}
+}
+
+// AddedScore returns the value that was added to the "score" field in this mutation.
+func (m *QuizAttemptMutation) AddedScore() (r int, exists bool) {
+	v := m.addscore
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetScore resets all changes to the "score" field.
+func (m *QuizAttemptMutation) ResetScore() {
+	m.score = nil
+	m.addscore = nil
+}
+
+// SetMaxScore sets the "max_score" field.
+func (m *QuizAttemptMutation) SetMaxScore(i int) {
+	m.max_score = &i
+	m.addmax_score = nil
+}
+
+// MaxScore returns the value of the "max_score" field in the mutation.
+func (m *QuizAttemptMutation) MaxScore() (r int, exists bool) {
+	v := m.max_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxScore returns the old "max_score" field's value of the QuizAttempt entity.
+// If the QuizAttempt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizAttemptMutation) OldMaxScore(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxScore: %w", err)
+	}
+	return oldValue.MaxScore, nil
+}
+
+// AddMaxScore adds i to the "max_score" field.
+func (m *QuizAttemptMutation) AddMaxScore(i int) {
+	if m.addmax_score != nil {
+		*m.addmax_score += i
+	} else {
+		m.addmax_score = &i
+	}
+}
+
+// AddedMaxScore returns the value that was added to the "max_score" field in this mutation.
+func (m *QuizAttemptMutation) AddedMaxScore() (r int, exists bool) {
+	v := m.addmax_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMaxScore resets all changes to the "max_score" field.
+func (m *QuizAttemptMutation) ResetMaxScore() {
+	m.max_score = nil
+	m.addmax_score = nil
+}
+
+// SetStartedAt sets the "started_at" field.
+func (m *QuizAttemptMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
+}
+
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *QuizAttemptMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartedAt returns the old "started_at" field's value of the QuizAttempt entity.
+// If the QuizAttempt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizAttemptMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+	}
+	return oldValue.StartedAt, nil
+}
+
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *QuizAttemptMutation) ResetStartedAt() {
+	m.started_at = nil
+}
+
+// SetDeadline sets the "deadline" field.
+func (m *QuizAttemptMutation) SetDeadline(t time.Time) {
+	m.deadline = &t
+}
+
+// Deadline returns the value of the "deadline" field in the mutation.
+func (m *QuizAttemptMutation) Deadline() (r time.Time, exists bool) {
+	v := m.deadline
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeadline returns the old "deadline" field's value of the QuizAttempt entity.
+// If the QuizAttempt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizAttemptMutation) OldDeadline(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeadline is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeadline requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeadline: %w", err)
+	}
+	return oldValue.Deadline, nil
+}
+
+// ClearDeadline clears the value of the "deadline" field.
+func (m *QuizAttemptMutation) ClearDeadline() {
+	m.deadline = nil
+	m.clearedFields[quizattempt.FieldDeadline] = struct{}{}
+}
+
+// DeadlineCleared returns if the "deadline" field was cleared in this mutation.
+func (m *QuizAttemptMutation) DeadlineCleared() bool {
+	_, ok := m.clearedFields[quizattempt.FieldDeadline]
+	return ok
+}
+
+// ResetDeadline resets all changes to the "deadline" field.
+func (m *QuizAttemptMutation) ResetDeadline() {
+	m.deadline = nil
+	delete(m.clearedFields, quizattempt.FieldDeadline)
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (m *QuizAttemptMutation) SetSubmittedAt(t time.Time) {
+	m.submitted_at = &t
+}
+
+// SubmittedAt returns the value of the "submitted_at" field in the mutation.
+func (m *QuizAttemptMutation) SubmittedAt() (r time.Time, exists bool) {
+	v := m.submitted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubmittedAt returns the old "submitted_at" field's value of the QuizAttempt entity.
+// If the QuizAttempt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizAttemptMutation) OldSubmittedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubmittedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubmittedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubmittedAt: %w", err)
+	}
+	return oldValue.SubmittedAt, nil
+}
+
+// ClearSubmittedAt clears the value of the "submitted_at" field.
+func (m *QuizAttemptMutation) ClearSubmittedAt() {
+	m.submitted_at = nil
+	m.clearedFields[quizattempt.FieldSubmittedAt] = struct{}{}
+}
+
+// SubmittedAtCleared returns if the "submitted_at" field was cleared in this mutation.
+func (m *QuizAttemptMutation) SubmittedAtCleared() bool {
+	_, ok := m.clearedFields[quizattempt.FieldSubmittedAt]
+	return ok
+}
+
+// ResetSubmittedAt resets all changes to the "submitted_at" field.
+func (m *QuizAttemptMutation) ResetSubmittedAt() {
+	m.submitted_at = nil
+	delete(m.clearedFields, quizattempt.FieldSubmittedAt)
+}
+
+// SetStatus sets the "status" field.
+func (m *QuizAttemptMutation) SetStatus(q quizattempt.Status) {
+	m.status = &q
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *QuizAttemptMutation) Status() (r quizattempt.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the QuizAttempt entity.
+// If the QuizAttempt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizAttemptMutation) OldStatus(ctx context.Context) (v quizattempt.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *QuizAttemptMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetQuizID sets the "quiz" edge to the Quiz entity by id.
+func (m *QuizAttemptMutation) SetQuizID(id int) {
+	m.quiz = &id
+}
+
+// ClearQuiz clears the "quiz" edge to the Quiz entity.
+func (m *QuizAttemptMutation) ClearQuiz() {
+	m.clearedquiz = true
+}
+
+// QuizCleared reports if the "quiz" edge to the Quiz entity was cleared.
+func (m *QuizAttemptMutation) QuizCleared() bool {
+	return m.clearedquiz
+}
+
+// QuizID returns the "quiz" edge ID in the mutation.
+func (m *QuizAttemptMutation) QuizID() (id int, exists bool) {
+	if m.quiz != nil {
+		return *m.quiz, true
+	}
+	return
+}
+
+// QuizIDs returns the "quiz" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// QuizID instead. It exists only for internal usage by the builders.
+func (m *QuizAttemptMutation) QuizIDs() (ids []int) {
+	if id := m.quiz; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetQuiz resets all changes to the "quiz" edge.
+func (m *QuizAttemptMutation) ResetQuiz() {
+	m.quiz = nil
+	m.clearedquiz = false
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *QuizAttemptMutation) SetUserID(id int) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *QuizAttemptMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *QuizAttemptMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *QuizAttemptMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *QuizAttemptMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *QuizAttemptMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// SetStudyEventID sets the "study_event" edge to the StudyEvent entity by id.
+func (m *QuizAttemptMutation) SetStudyEventID(id int) {
+	m.study_event = &id
+}
+
+// ClearStudyEvent clears the "study_event" edge to the StudyEvent entity.
+func (m *QuizAttemptMutation) ClearStudyEvent() {
+	m.clearedstudy_event = true
+}
+
+// StudyEventCleared reports if the "study_event" edge to the StudyEvent entity was cleared.
+func (m *QuizAttemptMutation) StudyEventCleared() bool {
+	return m.clearedstudy_event
+}
+
+// StudyEventID returns the "study_event" edge ID in the mutation.
+func (m *QuizAttemptMutation) StudyEventID() (id int, exists bool) {
+	if m.study_event != nil {
+		return *m.study_event, true
+	}
+	return
+}
+
+// StudyEventIDs returns the "study_event" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// StudyEventID instead. It exists only for internal usage by the builders.
+func (m *QuizAttemptMutation) StudyEventIDs() (ids []int) {
+	if id := m.study_event; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetStudyEvent resets all changes to the "study_event" edge.
+func (m *QuizAttemptMutation) ResetStudyEvent() {
+	m.study_event = nil
+	m.clearedstudy_event = false
+}
+
+// Where appends a list predicates to the QuizAttemptMutation builder.
+func (m *QuizAttemptMutation) Where(ps ...predicate.QuizAttempt) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the QuizAttemptMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *QuizAttemptMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.QuizAttempt, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *QuizAttemptMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *QuizAttemptMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (QuizAttempt).
+func (m *QuizAttemptMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *QuizAttemptMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.answers != nil {
+		fields = append(fields, quizattempt.FieldAnswers)
+	}
+	if m.score != nil {
+		fields = append(fields, quizattempt.FieldScore)
+	}
+	if m.max_score != nil {
+		fields = append(fields, quizattempt.FieldMaxScore)
+	}
+	if m.started_at != nil {
+		fields = append(fields, quizattempt.FieldStartedAt)
+	}
+	if m.deadline != nil {
+		fields = append(fields, quizattempt.FieldDeadline)
+	}
+	if m.submitted_at != nil {
+		fields = append(fields, quizattempt.FieldSubmittedAt)
+	}
+	if m.status != nil {
+		fields = append(fields, quizattempt.FieldStatus)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *QuizAttemptMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case quizattempt.FieldAnswers:
+		return m.Answers()
+	case quizattempt.FieldScore:
+		return m.Score()
+	case quizattempt.FieldMaxScore:
+		return m.MaxScore()
+	case quizattempt.FieldStartedAt:
+		return m.StartedAt()
+	case quizattempt.FieldDeadline:
+		return m.Deadline()
+	case quizattempt.FieldSubmittedAt:
+		return m.SubmittedAt()
+	case quizattempt.FieldStatus:
+		return m.Status()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *QuizAttemptMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case quizattempt.FieldAnswers:
+		return m.OldAnswers(ctx)
+	case quizattempt.FieldScore:
+		return m.OldScore(ctx)
+	case quizattempt.FieldMaxScore:
+		return m.OldMaxScore(ctx)
+	case quizattempt.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case quizattempt.FieldDeadline:
+		return m.OldDeadline(ctx)
+	case quizattempt.FieldSubmittedAt:
+		return m.OldSubmittedAt(ctx)
+	case quizattempt.FieldStatus:
+		return m.OldStatus(ctx)
+	}
+	return nil, fmt.Errorf("unknown QuizAttempt field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *QuizAttemptMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case quizattempt.FieldAnswers:
+		v, ok := value.(map[int]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAnswers(v)
+		return nil
+	case quizattempt.FieldScore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScore(v)
+		return nil
+	case quizattempt.FieldMaxScore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxScore(v)
+		return nil
+	case quizattempt.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	case quizattempt.FieldDeadline:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeadline(v)
+		return nil
+	case quizattempt.FieldSubmittedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubmittedAt(v)
+		return nil
+	case quizattempt.FieldStatus:
+		v, ok := value.(quizattempt.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	}
+	return fmt.Errorf("unknown QuizAttempt field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *QuizAttemptMutation) AddedFields() []string {
+	var fields []string
+	if m.addscore != nil {
+		fields = append(fields, quizattempt.FieldScore)
+	}
+	if m.addmax_score != nil {
+		fields = append(fields, quizattempt.FieldMaxScore)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *QuizAttemptMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case quizattempt.FieldScore:
+		return m.AddedScore()
+	case quizattempt.FieldMaxScore:
+		return m.AddedMaxScore()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *QuizAttemptMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case quizattempt.FieldScore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddScore(v)
+		return nil
+	case quizattempt.FieldMaxScore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxScore(v)
+		return nil
+	}
+	return fmt.Errorf("unknown QuizAttempt numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *QuizAttemptMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(quizattempt.FieldAnswers) {
+		fields = append(fields, quizattempt.FieldAnswers)
+	}
+	if m.FieldCleared(quizattempt.FieldDeadline) {
+		fields = append(fields, quizattempt.FieldDeadline)
+	}
+	if m.FieldCleared(quizattempt.FieldSubmittedAt) {
+		fields = append(fields, quizattempt.FieldSubmittedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *QuizAttemptMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *QuizAttemptMutation) ClearField(name string) error {
+	switch name {
+	case quizattempt.FieldAnswers:
+		m.ClearAnswers()
+		return nil
+	case quizattempt.FieldDeadline:
+		m.ClearDeadline()
+		return nil
+	case quizattempt.FieldSubmittedAt:
+		m.ClearSubmittedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown QuizAttempt nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *QuizAttemptMutation) ResetField(name string) error {
+	switch name {
+	case quizattempt.FieldAnswers:
+		m.ResetAnswers()
+		return nil
+	case quizattempt.FieldScore:
+		m.ResetScore()
+		return nil
+	case quizattempt.FieldMaxScore:
+		m.ResetMaxScore()
+		return nil
+	case quizattempt.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case quizattempt.FieldDeadline:
+		m.ResetDeadline()
+		return nil
+	case quizattempt.FieldSubmittedAt:
+		m.ResetSubmittedAt()
+		return nil
+	case quizattempt.FieldStatus:
+		m.ResetStatus()
+		return nil
+	}
+	return fmt.Errorf("unknown QuizAttempt field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *QuizAttemptMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.quiz != nil {
+		edges = append(edges, quizattempt.EdgeQuiz)
+	}
+	if m.user != nil {
+		edges = append(edges, quizattempt.EdgeUser)
+	}
+	if m.study_event != nil {
+		edges = append(edges, quizattempt.EdgeStudyEvent)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *QuizAttemptMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case quizattempt.EdgeQuiz:
+		if id := m.quiz; id != nil {
+			return []ent.Value{*id}
+		}
+	case quizattempt.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case quizattempt.EdgeStudyEvent:
+		if id := m.study_event; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *QuizAttemptMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *QuizAttemptMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *QuizAttemptMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedquiz {
+		edges = append(edges, quizattempt.EdgeQuiz)
+	}
+	if m.cleareduser {
+		edges = append(edges, quizattempt.EdgeUser)
+	}
+	if m.clearedstudy_event {
+		edges = append(edges, quizattempt.EdgeStudyEvent)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *QuizAttemptMutation) EdgeCleared(name string) bool {
+	switch name {
+	case quizattempt.EdgeQuiz:
+		return m.clearedquiz
+	case quizattempt.EdgeUser:
+		return m.cleareduser
+	case quizattempt.EdgeStudyEvent:
+		return m.clearedstudy_event
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *QuizAttemptMutation) ClearEdge(name string) error {
+	switch name {
+	case quizattempt.EdgeQuiz:
+		m.ClearQuiz()
+		return nil
+	case quizattempt.EdgeUser:
+		m.ClearUser()
+		return nil
+	case quizattempt.EdgeStudyEvent:
+		m.ClearStudyEvent()
+		return nil
+	}
+	return fmt.Errorf("unknown QuizAttempt unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *QuizAttemptMutation) ResetEdge(name string) error {
+	switch name {
+	case quizattempt.EdgeQuiz:
+		m.ResetQuiz()
+		return nil
+	case quizattempt.EdgeUser:
+		m.ResetUser()
+		return nil
+	case quizattempt.EdgeStudyEvent:
+		m.ResetStudyEvent()
+		return nil
+	}
+	return fmt.Errorf("unknown QuizAttempt edge %s", name)
+}
+
+// ResumableUploadMutation represents an operation that mutates the ResumableUpload nodes in the graph.
+type ResumableUploadMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	token         *string
+	note_id       *int
+	addnote_id    *int
+	file_name     *string
+	mime_type     *string
+	size          *int64
+	addsize       *int64
+	_offset       *int64
+	add_offset    *int64
+	expires_at    *time.Time
+	completed_at  *time.Time
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	owner         *int
+	clearedowner  bool
+	done          bool
+	oldValue      func(context.Context) (*ResumableUpload, error)
+	predicates    []predicate.ResumableUpload
+}
+
+var _ ent.Mutation = (*ResumableUploadMutation)(nil)
+
+// resumableuploadOption allows management of the mutation configuration using functional options.
+type resumableuploadOption func(*ResumableUploadMutation)
+
+// newResumableUploadMutation creates new mutation for the ResumableUpload entity.
+func newResumableUploadMutation(c config, op Op, opts ...resumableuploadOption) *ResumableUploadMutation {
+	m := &ResumableUploadMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeResumableUpload,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withResumableUploadID sets the ID field of the mutation.
+func withResumableUploadID(id int) resumableuploadOption {
+	return func(m *ResumableUploadMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ResumableUpload
+		)
+		m.oldValue = func(ctx context.Context) (*ResumableUpload, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ResumableUpload.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withResumableUpload sets the old ResumableUpload of the mutation.
+func withResumableUpload(node *ResumableUpload) resumableuploadOption {
+	return func(m *ResumableUploadMutation) {
+		m.oldValue = func(context.Context) (*ResumableUpload, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ResumableUploadMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ResumableUploadMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ResumableUploadMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ResumableUploadMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ResumableUpload.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetToken sets the "token" field.
+func (m *ResumableUploadMutation) SetToken(s string) {
+	m.token = &s
+}
+
+// Token returns the value of the "token" field in the mutation.
+func (m *ResumableUploadMutation) Token() (r string, exists bool) {
+	v := m.token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldToken returns the old "token" field's value of the ResumableUpload entity.
+// If the ResumableUpload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResumableUploadMutation) OldToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToken: %w", err)
+	}
+	return oldValue.Token, nil
+}
+
+// ResetToken resets all changes to the "token" field.
+func (m *ResumableUploadMutation) ResetToken() {
+	m.token = nil
+}
+
+// SetNoteID sets the "note_id" field.
+func (m *ResumableUploadMutation) SetNoteID(i int) {
+	m.note_id = &i
+	m.addnote_id = nil
+}
+
+// NoteID returns the value of the "note_id" field in the mutation.
+func (m *ResumableUploadMutation) NoteID() (r int, exists bool) {
+	v := m.note_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNoteID returns the old "note_id" field's value of the ResumableUpload entity.
+// If the ResumableUpload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResumableUploadMutation) OldNoteID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNoteID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNoteID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNoteID: %w", err)
+	}
+	return oldValue.NoteID, nil
+}
+
+// AddNoteID adds i to the "note_id" field.
+func (m *ResumableUploadMutation) AddNoteID(i int) {
+	if m.addnote_id != nil {
+		*m.addnote_id += i
+	} else {
+		m.addnote_id = &i
+	}
+}
+
+// AddedNoteID returns the value that was added to the "note_id" field in this mutation.
+func (m *ResumableUploadMutation) AddedNoteID() (r int, exists bool) {
+	v := m.addnote_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetNoteID resets all changes to the "note_id" field.
+func (m *ResumableUploadMutation) ResetNoteID() {
+	m.note_id = nil
+	m.addnote_id = nil
+}
+
+// SetFileName sets the "file_name" field.
+func (m *ResumableUploadMutation) SetFileName(s string) {
+	m.file_name = &s
+}
+
+// FileName returns the value of the "file_name" field in the mutation.
+func (m *ResumableUploadMutation) FileName() (r string, exists bool) {
+	v := m.file_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFileName returns the old "file_name" field's value of the ResumableUpload entity.
+// If the ResumableUpload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResumableUploadMutation) OldFileName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFileName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFileName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFileName: %w", err)
+	}
+	return oldValue.FileName, nil
+}
+
+// ResetFileName resets all changes to the "file_name" field.
+func (m *ResumableUploadMutation) ResetFileName() {
+	m.file_name = nil
+}
+
+// SetMimeType sets the "mime_type" field.
+func (m *ResumableUploadMutation) SetMimeType(s string) {
+	m.mime_type = &s
+}
+
+// MimeType returns the value of the "mime_type" field in the mutation.
+func (m *ResumableUploadMutation) MimeType() (r string, exists bool) {
+	v := m.mime_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMimeType returns the old "mime_type" field's value of the ResumableUpload entity.
+// If the ResumableUpload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResumableUploadMutation) OldMimeType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMimeType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMimeType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMimeType: %w", err)
+	}
+	return oldValue.MimeType, nil
+}
+
+// ClearMimeType clears the value of the "mime_type" field.
+func (m *ResumableUploadMutation) ClearMimeType() {
+	m.mime_type = nil
+	m.clearedFields[resumableupload.FieldMimeType] = struct{}{}
+}
+
+// MimeTypeCleared returns if the "mime_type" field was cleared in this mutation.
+func (m *ResumableUploadMutation) MimeTypeCleared() bool {
+	_, ok := m.clearedFields[resumableupload.FieldMimeType]
+	return ok
+}
+
+// ResetMimeType resets all changes to the "mime_type" field.
+func (m *ResumableUploadMutation) ResetMimeType() {
+	m.mime_type = nil
+	delete(m.clearedFields, resumableupload.FieldMimeType)
+}
+
+// SetSize sets the "size" field.
+func (m *ResumableUploadMutation) SetSize(i int64) {
+	m.size = &i
+	m.addsize = nil
+}
+
+// Size returns the value of the "size" field in the mutation.
+func (m *ResumableUploadMutation) Size() (r int64, exists bool) {
+	v := m.size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSize returns the old "size" field's value of the ResumableUpload entity.
+// If the ResumableUpload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResumableUploadMutation) OldSize(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSize: %w", err)
+	}
+	return oldValue.Size, nil
+}
+
+// AddSize adds i to the "size" field.
+func (m *ResumableUploadMutation) AddSize(i int64) {
+	if m.addsize != nil {
+		*m.addsize += i
+	} else {
+		m.addsize = &i
+	}
+}
+
+// AddedSize returns the value that was added to the "size" field in this mutation.
+func (m *ResumableUploadMutation) AddedSize() (r int64, exists bool) {
+	v := m.addsize
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSize resets all changes to the "size" field.
+func (m *ResumableUploadMutation) ResetSize() {
+	m.size = nil
+	m.addsize = nil
+}
+
+// SetOffset sets the "offset" field.
+func (m *ResumableUploadMutation) SetOffset(i int64) {
+	m._offset = &i
+	m.add_offset = nil
+}
+
+// Offset returns the value of the "offset" field in the mutation.
+func (m *ResumableUploadMutation) Offset() (r int64, exists bool) {
+	v := m._offset
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOffset returns the old "offset" field's value of the ResumableUpload entity.
+// If the ResumableUpload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResumableUploadMutation) OldOffset(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOffset is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOffset requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOffset: %w", err)
+	}
+	return oldValue.Offset, nil
+}
+
+// AddOffset adds i to the "offset" field.
+func (m *ResumableUploadMutation) AddOffset(i int64) {
+	if m.add_offset != nil {
+		*m.add_offset += i
+	} else {
+		m.add_offset = &i
+	}
+}
+
+// AddedOffset returns the value that was added to the "offset" field in this mutation.
+func (m *ResumableUploadMutation) AddedOffset() (r int64, exists bool) {
+	v := m.add_offset
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetOffset resets all changes to the "offset" field.
+func (m *ResumableUploadMutation) ResetOffset() {
+	m._offset = nil
+	m.add_offset = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *ResumableUploadMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *ResumableUploadMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the ResumableUpload entity.
+// If the ResumableUpload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResumableUploadMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *ResumableUploadMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (m *ResumableUploadMutation) SetCompletedAt(t time.Time) {
+	m.completed_at = &t
+}
+
+// CompletedAt returns the value of the "completed_at" field in the mutation.
+func (m *ResumableUploadMutation) CompletedAt() (r time.Time, exists bool) {
+	v := m.completed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompletedAt returns the old "completed_at" field's value of the ResumableUpload entity.
+// If the ResumableUpload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResumableUploadMutation) OldCompletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompletedAt: %w", err)
+	}
+	return oldValue.CompletedAt, nil
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (m *ResumableUploadMutation) ClearCompletedAt() {
+	m.completed_at = nil
+	m.clearedFields[resumableupload.FieldCompletedAt] = struct{}{}
+}
+
+// CompletedAtCleared returns if the "completed_at" field was cleared in this mutation.
+func (m *ResumableUploadMutation) CompletedAtCleared() bool {
+	_, ok := m.clearedFields[resumableupload.FieldCompletedAt]
+	return ok
+}
+
+// ResetCompletedAt resets all changes to the "completed_at" field.
+func (m *ResumableUploadMutation) ResetCompletedAt() {
+	m.completed_at = nil
+	delete(m.clearedFields, resumableupload.FieldCompletedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ResumableUploadMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ResumableUploadMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ResumableUpload entity.
+// If the ResumableUpload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ResumableUploadMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ResumableUploadMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by id.
+func (m *ResumableUploadMutation) SetOwnerID(id int) {
+	m.owner = &id
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *ResumableUploadMutation) ClearOwner() {
+	m.clearedowner = true
+}
+
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *ResumableUploadMutation) OwnerCleared() bool {
+	return m.clearedowner
+}
+
+// OwnerID returns the "owner" edge ID in the mutation.
+func (m *ResumableUploadMutation) OwnerID() (id int, exists bool) {
+	if m.owner != nil {
+		return *m.owner, true
+	}
+	return
+}
+
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *ResumableUploadMutation) OwnerIDs() (ids []int) {
+	if id := m.owner; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOwner resets all changes to the "owner" edge.
+func (m *ResumableUploadMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
+}
+
+// Where appends a list predicates to the ResumableUploadMutation builder.
+func (m *ResumableUploadMutation) Where(ps ...predicate.ResumableUpload) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ResumableUploadMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ResumableUploadMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ResumableUpload, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ResumableUploadMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ResumableUploadMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ResumableUpload).
+func (m *ResumableUploadMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ResumableUploadMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.token != nil {
+		fields = append(fields, resumableupload.FieldToken)
+	}
+	if m.note_id != nil {
+		fields = append(fields, resumableupload.FieldNoteID)
+	}
+	if m.file_name != nil {
+		fields = append(fields, resumableupload.FieldFileName)
+	}
+	if m.mime_type != nil {
+		fields = append(fields, resumableupload.FieldMimeType)
+	}
+	if m.size != nil {
+		fields = append(fields, resumableupload.FieldSize)
+	}
+	if m._offset != nil {
+		fields = append(fields, resumableupload.FieldOffset)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, resumableupload.FieldExpiresAt)
+	}
+	if m.completed_at != nil {
+		fields = append(fields, resumableupload.FieldCompletedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, resumableupload.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ResumableUploadMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case resumableupload.FieldToken:
+		return m.Token()
+	case resumableupload.FieldNoteID:
+		return m.NoteID()
+	case resumableupload.FieldFileName:
+		return m.FileName()
+	case resumableupload.FieldMimeType:
+		return m.MimeType()
+	case resumableupload.FieldSize:
+		return m.Size()
+	case resumableupload.FieldOffset:
+		return m.Offset()
+	case resumableupload.FieldExpiresAt:
+		return m.ExpiresAt()
+	case resumableupload.FieldCompletedAt:
+		return m.CompletedAt()
+	case resumableupload.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ResumableUploadMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case resumableupload.FieldToken:
+		return m.OldToken(ctx)
+	case resumableupload.FieldNoteID:
+		return m.OldNoteID(ctx)
+	case resumableupload.FieldFileName:
+		return m.OldFileName(ctx)
+	case resumableupload.FieldMimeType:
+		return m.OldMimeType(ctx)
+	case resumableupload.FieldSize:
+		return m.OldSize(ctx)
+	case resumableupload.FieldOffset:
+		return m.OldOffset(ctx)
+	case resumableupload.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case resumableupload.FieldCompletedAt:
+		return m.OldCompletedAt(ctx)
+	case resumableupload.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ResumableUpload field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ResumableUploadMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case resumableupload.FieldToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToken(v)
+		return nil
+	case resumableupload.FieldNoteID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNoteID(v)
+		return nil
+	case resumableupload.FieldFileName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFileName(v)
+		return nil
+	case resumableupload.FieldMimeType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMimeType(v)
+		return nil
+	case resumableupload.FieldSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSize(v)
+		return nil
+	case resumableupload.FieldOffset:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOffset(v)
+		return nil
+	case resumableupload.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case resumableupload.FieldCompletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompletedAt(v)
+		return nil
+	case resumableupload.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ResumableUpload field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ResumableUploadMutation) AddedFields() []string {
+	var fields []string
+	if m.addnote_id != nil {
+		fields = append(fields, resumableupload.FieldNoteID)
+	}
+	if m.addsize != nil {
+		fields = append(fields, resumableupload.FieldSize)
+	}
+	if m.add_offset != nil {
+		fields = append(fields, resumableupload.FieldOffset)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ResumableUploadMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case resumableupload.FieldNoteID:
+		return m.AddedNoteID()
+	case resumableupload.FieldSize:
+		return m.AddedSize()
+	case resumableupload.FieldOffset:
+		return m.AddedOffset()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ResumableUploadMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case resumableupload.FieldNoteID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddNoteID(v)
+		return nil
+	case resumableupload.FieldSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSize(v)
+		return nil
+	case resumableupload.FieldOffset:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOffset(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ResumableUpload numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ResumableUploadMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(resumableupload.FieldMimeType) {
+		fields = append(fields, resumableupload.FieldMimeType)
+	}
+	if m.FieldCleared(resumableupload.FieldCompletedAt) {
+		fields = append(fields, resumableupload.FieldCompletedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ResumableUploadMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ResumableUploadMutation) ClearField(name string) error {
+	switch name {
+	case resumableupload.FieldMimeType:
+		m.ClearMimeType()
+		return nil
+	case resumableupload.FieldCompletedAt:
+		m.ClearCompletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ResumableUpload nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ResumableUploadMutation) ResetField(name string) error {
+	switch name {
+	case resumableupload.FieldToken:
+		m.ResetToken()
+		return nil
+	case resumableupload.FieldNoteID:
+		m.ResetNoteID()
+		return nil
+	case resumableupload.FieldFileName:
+		m.ResetFileName()
+		return nil
+	case resumableupload.FieldMimeType:
+		m.ResetMimeType()
+		return nil
+	case resumableupload.FieldSize:
+		m.ResetSize()
+		return nil
+	case resumableupload.FieldOffset:
+		m.ResetOffset()
+		return nil
+	case resumableupload.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case resumableupload.FieldCompletedAt:
+		m.ResetCompletedAt()
+		return nil
+	case resumableupload.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ResumableUpload field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ResumableUploadMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.owner != nil {
+		edges = append(edges, resumableupload.EdgeOwner)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ResumableUploadMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case resumableupload.EdgeOwner:
+		if id := m.owner; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ResumableUploadMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ResumableUploadMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ResumableUploadMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedowner {
+		edges = append(edges, resumableupload.EdgeOwner)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ResumableUploadMutation) EdgeCleared(name string) bool {
+	switch name {
+	case resumableupload.EdgeOwner:
+		return m.clearedowner
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ResumableUploadMutation) ClearEdge(name string) error {
+	switch name {
+	case resumableupload.EdgeOwner:
+		m.ClearOwner()
+		return nil
+	}
+	return fmt.Errorf("unknown ResumableUpload unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ResumableUploadMutation) ResetEdge(name string) error {
+	switch name {
+	case resumableupload.EdgeOwner:
+		m.ResetOwner()
+		return nil
+	}
+	return fmt.Errorf("unknown ResumableUpload edge %s", name)
+}
+
+// StudyEventMutation represents an operation that mutates the StudyEvent nodes in the graph.
+type StudyEventMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *int
+	_type               *studyevent.Type
+	topic               *string
+	score               *int
+	addscore            *int
+	max_score           *int
+	addmax_score        *int
+	duration_seconds    *int
+	addduration_seconds *int
+	occurred_at         *time.Time
+	clearedFields       map[string]struct{}
+	user                *int
+	cleareduser         bool
+	note                *int
+	clearednote         bool
+	quiz                *int
+	clearedquiz         bool
+	attempt             *int
+	clearedattempt      bool
+	done                bool
+	oldValue            func(context.Context) (*StudyEvent, error)
+	predicates          []predicate.StudyEvent
+}
+
+var _ ent.Mutation = (*StudyEventMutation)(nil)
+
+// studyeventOption allows management of the mutation configuration using functional options.
+type studyeventOption func(*StudyEventMutation)
+
+// newStudyEventMutation creates new mutation for the StudyEvent entity.
+func newStudyEventMutation(c config, op Op, opts ...studyeventOption) *StudyEventMutation {
+	m := &StudyEventMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeStudyEvent,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withStudyEventID sets the ID field of the mutation.
+func withStudyEventID(id int) studyeventOption {
+	return func(m *StudyEventMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *StudyEvent
+		)
+		m.oldValue = func(ctx context.Context) (*StudyEvent, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().StudyEvent.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withStudyEvent sets the old StudyEvent of the mutation.
+func withStudyEvent(node *StudyEvent) studyeventOption {
+	return func(m *StudyEventMutation) {
+		m.oldValue = func(context.Context) (*StudyEvent, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m StudyEventMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m StudyEventMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *StudyEventMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *StudyEventMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().StudyEvent.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetType sets the "type" field.
+func (m *StudyEventMutation) SetType(s studyevent.Type) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *StudyEventMutation) GetType() (r studyevent.Type, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the StudyEvent entity.
+// If the StudyEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StudyEventMutation) OldType(ctx context.Context) (v studyevent.Type, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *StudyEventMutation) ResetType() {
+	m._type = nil
+}
+
+// SetTopic sets the "topic" field.
+func (m *StudyEventMutation) SetTopic(s string) {
+	m.topic = &s
+}
+
+// Topic returns the value of the "topic" field in the mutation.
+func (m *StudyEventMutation) Topic() (r string, exists bool) {
+	v := m.topic
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTopic returns the old "topic" field's value of the StudyEvent entity.
+// If the StudyEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StudyEventMutation) OldTopic(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTopic is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTopic requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTopic: %w", err)
+	}
+	return oldValue.Topic, nil
+}
+
+// ResetTopic resets all changes to the "topic" field.
+func (m *StudyEventMutation) ResetTopic() {
+	m.topic = nil
+}
+
+// SetScore sets the "score" field.
+func (m *StudyEventMutation) SetScore(i int) {
+	m.score = &i
+	m.addscore = nil
+}
+
+// Score returns the value of the "score" field in the mutation.
+func (m *StudyEventMutation) Score() (r int, exists bool) {
+	v := m.score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScore returns the old "score" field's value of the StudyEvent entity.
+// If the StudyEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StudyEventMutation) OldScore(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScore: %w", err)
+	}
+	return oldValue.Score, nil
+}
+
+// AddScore adds i to the "score" field.
+func (m *StudyEventMutation) AddScore(i int) {
+	if m.addscore != nil {
+		*m.addscore += i
+	} else {
+		m.addscore = &i
+	}
+}
+
+// AddedScore returns the value that was added to the "score" field in this mutation.
+func (m *StudyEventMutation) AddedScore() (r int, exists bool) {
+	v := m.addscore
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearScore clears the value of the "score" field.
+func (m *StudyEventMutation) ClearScore() {
+	m.score = nil
+	m.addscore = nil
+	m.clearedFields[studyevent.FieldScore] = struct{}{}
+}
+
+// ScoreCleared returns if the "score" field was cleared in this mutation.
+func (m *StudyEventMutation) ScoreCleared() bool {
+	_, ok := m.clearedFields[studyevent.FieldScore]
+	return ok
+}
+
+// ResetScore resets all changes to the "score" field.
+func (m *StudyEventMutation) ResetScore() {
+	m.score = nil
+	m.addscore = nil
+	delete(m.clearedFields, studyevent.FieldScore)
+}
+
+// SetMaxScore sets the "max_score" field.
+func (m *StudyEventMutation) SetMaxScore(i int) {
+	m.max_score = &i
+	m.addmax_score = nil
+}
+
+// MaxScore returns the value of the "max_score" field in the mutation.
+func (m *StudyEventMutation) MaxScore() (r int, exists bool) {
+	v := m.max_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxScore returns the old "max_score" field's value of the StudyEvent entity.
+// If the StudyEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StudyEventMutation) OldMaxScore(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxScore: %w", err)
+	}
+	return oldValue.MaxScore, nil
+}
+
+// AddMaxScore adds i to the "max_score" field.
+func (m *StudyEventMutation) AddMaxScore(i int) {
+	if m.addmax_score != nil {
+		*m.addmax_score += i
+	} else {
+		m.addmax_score = &i
+	}
+}
+
+// AddedMaxScore returns the value that was added to the "max_score" field in this mutation.
+func (m *StudyEventMutation) AddedMaxScore() (r int, exists bool) {
+	v := m.addmax_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMaxScore clears the value of the "max_score" field.
+func (m *StudyEventMutation) ClearMaxScore() {
+	m.max_score = nil
+	m.addmax_score = nil
+	m.clearedFields[studyevent.FieldMaxScore] = struct{}{}
+}
+
+// MaxScoreCleared returns if the "max_score" field was cleared in this mutation.
+func (m *StudyEventMutation) MaxScoreCleared() bool {
+	_, ok := m.clearedFields[studyevent.FieldMaxScore]
+	return ok
+}
+
+// ResetMaxScore resets all changes to the "max_score" field.
+func (m *StudyEventMutation) ResetMaxScore() {
+	m.max_score = nil
+	m.addmax_score = nil
+	delete(m.clearedFields, studyevent.FieldMaxScore)
+}
+
+// SetDurationSeconds sets the "duration_seconds" field.
+func (m *StudyEventMutation) SetDurationSeconds(i int) {
+	m.duration_seconds = &i
+	m.addduration_seconds = nil
+}
+
+// DurationSeconds returns the value of the "duration_seconds" field in the mutation.
+func (m *StudyEventMutation) DurationSeconds() (r int, exists bool) {
+	v := m.duration_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDurationSeconds returns the old "duration_seconds" field's value of the StudyEvent entity.
+// If the StudyEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StudyEventMutation) OldDurationSeconds(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDurationSeconds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDurationSeconds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDurationSeconds: %w", err)
+	}
+	return oldValue.DurationSeconds, nil
+}
+
+// AddDurationSeconds adds i to the "duration_seconds" field.
+func (m *StudyEventMutation) AddDurationSeconds(i int) {
+	if m.addduration_seconds != nil {
+		*m.addduration_seconds += i
+	} else {
+		m.addduration_seconds = &i
+	}
+}
+
+// AddedDurationSeconds returns the value that was added to the "duration_seconds" field in this mutation.
+func (m *StudyEventMutation) AddedDurationSeconds() (r int, exists bool) {
+	v := m.addduration_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDurationSeconds resets all changes to the "duration_seconds" field.
+func (m *StudyEventMutation) ResetDurationSeconds() {
+	m.duration_seconds = nil
+	m.addduration_seconds = nil
+}
+
+// SetOccurredAt sets the "occurred_at" field.
+func (m *StudyEventMutation) SetOccurredAt(t time.Time) {
+	m.occurred_at = &t
+}
+
+// OccurredAt returns the value of the "occurred_at" field in the mutation.
+func (m *StudyEventMutation) OccurredAt() (r time.Time, exists bool) {
+	v := m.occurred_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOccurredAt returns the old "occurred_at" field's value of the StudyEvent entity.
+// If the StudyEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StudyEventMutation) OldOccurredAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOccurredAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOccurredAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOccurredAt: %w", err)
+	}
+	return oldValue.OccurredAt, nil
+}
+
+// ResetOccurredAt resets all changes to the "occurred_at" field.
+func (m *StudyEventMutation) ResetOccurredAt() {
+	m.occurred_at = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *StudyEventMutation) SetUserID(id int) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *StudyEventMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *StudyEventMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *StudyEventMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *StudyEventMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *StudyEventMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// SetNoteID sets the "note" edge to the Note entity by id.
+func (m *StudyEventMutation) SetNoteID(id int) {
+	m.note = &id
+}
+
+// ClearNote clears the "note" edge to the Note entity.
+func (m *StudyEventMutation) ClearNote() {
+	m.clearednote = true
+}
+
+// NoteCleared reports if the "note" edge to the Note entity was cleared.
+func (m *StudyEventMutation) NoteCleared() bool {
+	return m.clearednote
+}
+
+// NoteID returns the "note" edge ID in the mutation.
+func (m *StudyEventMutation) NoteID() (id int, exists bool) {
+	if m.note != nil {
+		return *m.note, true
+	}
+	return
+}
+
+// NoteIDs returns the "note" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// NoteID instead. It exists only for internal usage by the builders.
+func (m *StudyEventMutation) NoteIDs() (ids []int) {
+	if id := m.note; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetNote resets all changes to the "note" edge.
+func (m *StudyEventMutation) ResetNote() {
+	m.note = nil
+	m.clearednote = false
+}
+
+// SetQuizID sets the "quiz" edge to the Quiz entity by id.
+func (m *StudyEventMutation) SetQuizID(id int) {
+	m.quiz = &id
+}
+
+// ClearQuiz clears the "quiz" edge to the Quiz entity.
+func (m *StudyEventMutation) ClearQuiz() {
+	m.clearedquiz = true
+}
+
+// QuizCleared reports if the "quiz" edge to the Quiz entity was cleared.
+func (m *StudyEventMutation) QuizCleared() bool {
+	return m.clearedquiz
+}
+
+// QuizID returns the "quiz" edge ID in the mutation.
+func (m *StudyEventMutation) QuizID() (id int, exists bool) {
+	if m.quiz != nil {
+		return *m.quiz, true
+	}
+	return
+}
+
+// QuizIDs returns the "quiz" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// QuizID instead. It exists only for internal usage by the builders.
+func (m *StudyEventMutation) QuizIDs() (ids []int) {
+	if id := m.quiz; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetQuiz resets all changes to the "quiz" edge.
+func (m *StudyEventMutation) ResetQuiz() {
+	m.quiz = nil
+	m.clearedquiz = false
+}
+
+// SetAttemptID sets the "attempt" edge to the QuizAttempt entity by id.
+func (m *StudyEventMutation) SetAttemptID(id int) {
+	m.attempt = &id
+}
+
+// ClearAttempt clears the "attempt" edge to the QuizAttempt entity.
+func (m *StudyEventMutation) ClearAttempt() {
+	m.clearedattempt = true
+}
+
+// AttemptCleared reports if the "attempt" edge to the QuizAttempt entity was cleared.
+func (m *StudyEventMutation) AttemptCleared() bool {
+	return m.clearedattempt
+}
+
+// AttemptID returns the "attempt" edge ID in the mutation.
+func (m *StudyEventMutation) AttemptID() (id int, exists bool) {
+	if m.attempt != nil {
+		return *m.attempt, true
+	}
+	return
+}
+
+// AttemptIDs returns the "attempt" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AttemptID instead. It exists only for internal usage by the builders.
+func (m *StudyEventMutation) AttemptIDs() (ids []int) {
+	if id := m.attempt; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAttempt resets all changes to the "attempt" edge.
+func (m *StudyEventMutation) ResetAttempt() {
+	m.attempt = nil
+	m.clearedattempt = false
+}
+
+// Where appends a list predicates to the StudyEventMutation builder.
+func (m *StudyEventMutation) Where(ps ...predicate.StudyEvent) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the StudyEventMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *StudyEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.StudyEvent, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *StudyEventMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *StudyEventMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (StudyEvent).
+func (m *StudyEventMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *StudyEventMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m._type != nil {
+		fields = append(fields, studyevent.FieldType)
+	}
+	if m.topic != nil {
+		fields = append(fields, studyevent.FieldTopic)
+	}
+	if m.score != nil {
+		fields = append(fields, studyevent.FieldScore)
+	}
+	if m.max_score != nil {
+		fields = append(fields, studyevent.FieldMaxScore)
+	}
+	if m.duration_seconds != nil {
+		fields = append(fields, studyevent.FieldDurationSeconds)
+	}
+	if m.occurred_at != nil {
+		fields = append(fields, studyevent.FieldOccurredAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *StudyEventMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case studyevent.FieldType:
+		return m.GetType()
+	case studyevent.FieldTopic:
+		return m.Topic()
+	case studyevent.FieldScore:
+		return m.Score()
+	case studyevent.FieldMaxScore:
+		return m.MaxScore()
+	case studyevent.FieldDurationSeconds:
+		return m.DurationSeconds()
+	case studyevent.FieldOccurredAt:
+		return m.OccurredAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *StudyEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case studyevent.FieldType:
+		return m.OldType(ctx)
+	case studyevent.FieldTopic:
+		return m.OldTopic(ctx)
+	case studyevent.FieldScore:
+		return m.OldScore(ctx)
+	case studyevent.FieldMaxScore:
+		return m.OldMaxScore(ctx)
+	case studyevent.FieldDurationSeconds:
+		return m.OldDurationSeconds(ctx)
+	case studyevent.FieldOccurredAt:
+		return m.OldOccurredAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown StudyEvent field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *StudyEventMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case studyevent.FieldType:
+		v, ok := value.(studyevent.Type)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case studyevent.FieldTopic:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTopic(v)
+		return nil
+	case studyevent.FieldScore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScore(v)
+		return nil
+	case studyevent.FieldMaxScore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxScore(v)
+		return nil
+	case studyevent.FieldDurationSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDurationSeconds(v)
+		return nil
+	case studyevent.FieldOccurredAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOccurredAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown StudyEvent field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *StudyEventMutation) AddedFields() []string {
+	var fields []string
+	if m.addscore != nil {
+		fields = append(fields, studyevent.FieldScore)
+	}
+	if m.addmax_score != nil {
+		fields = append(fields, studyevent.FieldMaxScore)
+	}
+	if m.addduration_seconds != nil {
+		fields = append(fields, studyevent.FieldDurationSeconds)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *StudyEventMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case studyevent.FieldScore:
+		return m.AddedScore()
+	case studyevent.FieldMaxScore:
+		return m.AddedMaxScore()
+	case studyevent.FieldDurationSeconds:
+		return m.AddedDurationSeconds()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *StudyEventMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case studyevent.FieldScore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddScore(v)
+		return nil
+	case studyevent.FieldMaxScore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxScore(v)
+		return nil
+	case studyevent.FieldDurationSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDurationSeconds(v)
+		return nil
+	}
+	return fmt.Errorf("unknown StudyEvent numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *StudyEventMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(studyevent.FieldScore) {
+		fields = append(fields, studyevent.FieldScore)
+	}
+	if m.FieldCleared(studyevent.FieldMaxScore) {
+		fields = append(fields, studyevent.FieldMaxScore)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *StudyEventMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *StudyEventMutation) ClearField(name string) error {
+	switch name {
+	case studyevent.FieldScore:
+		m.ClearScore()
+		return nil
+	case studyevent.FieldMaxScore:
+		m.ClearMaxScore()
+		return nil
+	}
+	return fmt.Errorf("unknown StudyEvent nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *StudyEventMutation) ResetField(name string) error {
+	switch name {
+	case studyevent.FieldType:
+		m.ResetType()
+		return nil
+	case studyevent.FieldTopic:
+		m.ResetTopic()
+		return nil
+	case studyevent.FieldScore:
+		m.ResetScore()
+		return nil
+	case studyevent.FieldMaxScore:
+		m.ResetMaxScore()
+		return nil
+	case studyevent.FieldDurationSeconds:
+		m.ResetDurationSeconds()
+		return nil
+	case studyevent.FieldOccurredAt:
+		m.ResetOccurredAt()
+		return nil
+	}
+	return fmt.Errorf("unknown StudyEvent field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *StudyEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.user != nil {
+		edges = append(edges, studyevent.EdgeUser)
+	}
+	if m.note != nil {
+		edges = append(edges, studyevent.EdgeNote)
+	}
+	if m.quiz != nil {
+		edges = append(edges, studyevent.EdgeQuiz)
+	}
+	if m.attempt != nil {
+		edges = append(edges, studyevent.EdgeAttempt)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *StudyEventMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case studyevent.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case studyevent.EdgeNote:
+		if id := m.note; id != nil {
+			return []ent.Value{*id}
+		}
+	case studyevent.EdgeQuiz:
+		if id := m.quiz; id != nil {
+			return []ent.Value{*id}
+		}
+	case studyevent.EdgeAttempt:
+		if id := m.attempt; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *StudyEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *StudyEventMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *StudyEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.cleareduser {
+		edges = append(edges, studyevent.EdgeUser)
+	}
+	if m.clearednote {
+		edges = append(edges, studyevent.EdgeNote)
+	}
+	if m.clearedquiz {
+		edges = append(edges, studyevent.EdgeQuiz)
+	}
+	if m.clearedattempt {
+		edges = append(edges, studyevent.EdgeAttempt)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *StudyEventMutation) EdgeCleared(name string) bool {
+	switch name {
+	case studyevent.EdgeUser:
+		return m.cleareduser
+	case studyevent.EdgeNote:
+		return m.clearednote
+	case studyevent.EdgeQuiz:
+		return m.clearedquiz
+	case studyevent.EdgeAttempt:
+		return m.clearedattempt
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *StudyEventMutation) ClearEdge(name string) error {
+	switch name {
+	case studyevent.EdgeUser:
+		m.ClearUser()
+		return nil
+	case studyevent.EdgeNote:
+		m.ClearNote()
+		return nil
+	case studyevent.EdgeQuiz:
+		m.ClearQuiz()
+		return nil
+	case studyevent.EdgeAttempt:
+		m.ClearAttempt()
+		return nil
+	}
+	return fmt.Errorf("unknown StudyEvent unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *StudyEventMutation) ResetEdge(name string) error {
+	switch name {
+	case studyevent.EdgeUser:
+		m.ResetUser()
+		return nil
+	case studyevent.EdgeNote:
+		m.ResetNote()
+		return nil
+	case studyevent.EdgeQuiz:
+		m.ResetQuiz()
+		return nil
+	case studyevent.EdgeAttempt:
+		m.ResetAttempt()
+		return nil
+	}
+	return fmt.Errorf("unknown StudyEvent edge %s", name)
+}
+
+// StudyGroupMutation represents an operation that mutates the StudyGroup nodes in the graph.
+type StudyGroupMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *int
+	name                 *string
+	description          *string
+	privacy              *studygroup.Privacy
+	created_at           *time.Time
+	updated_at           *time.Time
+	clearedFields        map[string]struct{}
+	memberships          map[int]struct{}
+	removedmemberships   map[int]struct{}
+	clearedmemberships   bool
+	join_requests        map[int]struct{}
+	removedjoin_requests map[int]struct{}
+	clearedjoin_requests bool
+	invites              map[int]struct{}
+	removedinvites       map[int]struct{}
+	clearedinvites       bool
+	shared_notes         map[int]struct{}
+	removedshared_notes  map[int]struct{}
+	clearedshared_notes  bool
+	done                 bool
+	oldValue             func(context.Context) (*StudyGroup, error)
+	predicates           []predicate.StudyGroup
+}
+
+var _ ent.Mutation = (*StudyGroupMutation)(nil)
+
+// studygroupOption allows management of the mutation configuration using functional options.
+type studygroupOption func(*StudyGroupMutation)
+
+// newStudyGroupMutation creates new mutation for the StudyGroup entity.
+func newStudyGroupMutation(c config, op Op, opts ...studygroupOption) *StudyGroupMutation {
+	m := &StudyGroupMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeStudyGroup,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withStudyGroupID sets the ID field of the mutation.
+func withStudyGroupID(id int) studygroupOption {
+	return func(m *StudyGroupMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *StudyGroup
+		)
+		m.oldValue = func(ctx context.Context) (*StudyGroup, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().StudyGroup.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withStudyGroup sets the old StudyGroup of the mutation.
+func withStudyGroup(node *StudyGroup) studygroupOption {
+	return func(m *StudyGroupMutation) {
+		m.oldValue = func(context.Context) (*StudyGroup, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m StudyGroupMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m StudyGroupMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *StudyGroupMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *StudyGroupMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().StudyGroup.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *StudyGroupMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *StudyGroupMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the StudyGroup entity.
+// If the StudyGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StudyGroupMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *StudyGroupMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *StudyGroupMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *StudyGroupMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the StudyGroup entity.
+// If the StudyGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StudyGroupMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *StudyGroupMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[studygroup.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *StudyGroupMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[studygroup.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *StudyGroupMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, studygroup.FieldDescription)
+}
+
+// SetPrivacy sets the "privacy" field.
+func (m *StudyGroupMutation) SetPrivacy(s studygroup.Privacy) {
+	m.privacy = &s
+}
+
+// Privacy returns the value of the "privacy" field in the mutation.
+func (m *StudyGroupMutation) Privacy() (r studygroup.Privacy, exists bool) {
+	v := m.privacy
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrivacy returns the old "privacy" field's value of the StudyGroup entity.
+// If the StudyGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StudyGroupMutation) OldPrivacy(ctx context.Context) (v studygroup.Privacy, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrivacy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrivacy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrivacy: %w", err)
+	}
+	return oldValue.Privacy, nil
+}
+
+// ResetPrivacy resets all changes to the "privacy" field.
+func (m *StudyGroupMutation) ResetPrivacy() {
+	m.privacy = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *StudyGroupMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *StudyGroupMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the StudyGroup entity.
+// If the StudyGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StudyGroupMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *StudyGroupMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *StudyGroupMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *StudyGroupMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the StudyGroup entity.
+// If the StudyGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StudyGroupMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *StudyGroupMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// AddMembershipIDs adds the "memberships" edge to the GroupMembership entity by ids.
+func (m *StudyGroupMutation) AddMembershipIDs(ids ...int) {
+	if m.memberships == nil {
+		m.memberships = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.memberships[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMemberships clears the "memberships" edge to the GroupMembership entity.
+func (m *StudyGroupMutation) ClearMemberships() {
+	m.clearedmemberships = true
+}
+
+// MembershipsCleared reports if the "memberships" edge to the GroupMembership entity was cleared.
+func (m *StudyGroupMutation) MembershipsCleared() bool {
+	return m.clearedmemberships
+}
+
+// RemoveMembershipIDs removes the "memberships" edge to the GroupMembership entity by IDs.
+func (m *StudyGroupMutation) RemoveMembershipIDs(ids ...int) {
+	if m.removedmemberships == nil {
+		m.removedmemberships = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.memberships, ids[i])
+		m.removedmemberships[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMemberships returns the removed IDs of the "memberships" edge to the GroupMembership entity.
+func (m *StudyGroupMutation) RemovedMembershipsIDs() (ids []int) {
+	for id := range m.removedmemberships {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MembershipsIDs returns the "memberships" edge IDs in the mutation.
+func (m *StudyGroupMutation) MembershipsIDs() (ids []int) {
+	for id := range m.memberships {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMemberships resets all changes to the "memberships" edge.
+func (m *StudyGroupMutation) ResetMemberships() {
+	m.memberships = nil
+	m.clearedmemberships = false
+	m.removedmemberships = nil
+}
+
+// AddJoinRequestIDs adds the "join_requests" edge to the GroupJoinRequest entity by ids.
+func (m *StudyGroupMutation) AddJoinRequestIDs(ids ...int) {
+	if m.join_requests == nil {
+		m.join_requests = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.join_requests[ids[i]] = struct{}{}
+	}
+}
+
+// ClearJoinRequests clears the "join_requests" edge to the GroupJoinRequest entity.
+func (m *StudyGroupMutation) ClearJoinRequests() {
+	m.clearedjoin_requests = true
+}
+
+// JoinRequestsCleared reports if the "join_requests" edge to the GroupJoinRequest entity was cleared.
+func (m *StudyGroupMutation) JoinRequestsCleared() bool {
+	return m.clearedjoin_requests
+}
+
+// RemoveJoinRequestIDs removes the "join_requests" edge to the GroupJoinRequest entity by IDs.
+func (m *StudyGroupMutation) RemoveJoinRequestIDs(ids ...int) {
+	if m.removedjoin_requests == nil {
+		m.removedjoin_requests = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.join_requests, ids[i])
+		m.removedjoin_requests[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedJoinRequests returns the removed IDs of the "join_requests" edge to the GroupJoinRequest entity.
+func (m *StudyGroupMutation) RemovedJoinRequestsIDs() (ids []int) {
+	for id := range m.removedjoin_requests {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// JoinRequestsIDs returns the "join_requests" edge IDs in the mutation.
+func (m *StudyGroupMutation) JoinRequestsIDs() (ids []int) {
+	for id := range m.join_requests {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetJoinRequests resets all changes to the "join_requests" edge.
+func (m *StudyGroupMutation) ResetJoinRequests() {
+	m.join_requests = nil
+	m.clearedjoin_requests = false
+	m.removedjoin_requests = nil
+}
+
+// AddInviteIDs adds the "invites" edge to the GroupInvite entity by ids.
+func (m *StudyGroupMutation) AddInviteIDs(ids ...int) {
+	if m.invites == nil {
+		m.invites = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.invites[ids[i]] = struct{}{}
+	}
+}
+
+// ClearInvites clears the "invites" edge to the GroupInvite entity.
+func (m *StudyGroupMutation) ClearInvites() {
+	m.clearedinvites = true
+}
+
+// InvitesCleared reports if the "invites" edge to the GroupInvite entity was cleared.
+func (m *StudyGroupMutation) InvitesCleared() bool {
+	return m.clearedinvites
+}
+
+// RemoveInviteIDs removes the "invites" edge to the GroupInvite entity by IDs.
+func (m *StudyGroupMutation) RemoveInviteIDs(ids ...int) {
+	if m.removedinvites == nil {
+		m.removedinvites = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.invites, ids[i])
+		m.removedinvites[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedInvites returns the removed IDs of the "invites" edge to the GroupInvite entity.
+func (m *StudyGroupMutation) RemovedInvitesIDs() (ids []int) {
+	for id := range m.removedinvites {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// InvitesIDs returns the "invites" edge IDs in the mutation.
+func (m *StudyGroupMutation) InvitesIDs() (ids []int) {
+	for id := range m.invites {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetInvites resets all changes to the "invites" edge.
+func (m *StudyGroupMutation) ResetInvites() {
+	m.invites = nil
+	m.clearedinvites = false
+	m.removedinvites = nil
+}
+
+// AddSharedNoteIDs adds the "shared_notes" edge to the Note entity by ids.
+func (m *StudyGroupMutation) AddSharedNoteIDs(ids ...int) {
+	if m.shared_notes == nil {
+		m.shared_notes = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.shared_notes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSharedNotes clears the "shared_notes" edge to the Note entity.
+func (m *StudyGroupMutation) ClearSharedNotes() {
+	m.clearedshared_notes = true
+}
+
+// SharedNotesCleared reports if the "shared_notes" edge to the Note entity was cleared.
+func (m *StudyGroupMutation) SharedNotesCleared() bool {
+	return m.clearedshared_notes
+}
+
+// RemoveSharedNoteIDs removes the "shared_notes" edge to the Note entity by IDs.
+func (m *StudyGroupMutation) RemoveSharedNoteIDs(ids ...int) {
+	if m.removedshared_notes == nil {
+		m.removedshared_notes = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.shared_notes, ids[i])
+		m.removedshared_notes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSharedNotes returns the removed IDs of the "shared_notes" edge to the Note entity.
+func (m *StudyGroupMutation) RemovedSharedNotesIDs() (ids []int) {
+	for id := range m.removedshared_notes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SharedNotesIDs returns the "shared_notes" edge IDs in the mutation.
+func (m *StudyGroupMutation) SharedNotesIDs() (ids []int) {
+	for id := range m.shared_notes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSharedNotes resets all changes to the "shared_notes" edge.
+func (m *StudyGroupMutation) ResetSharedNotes() {
+	m.shared_notes = nil
+	m.clearedshared_notes = false
+	m.removedshared_notes = nil
+}
+
+// Where appends a list predicates to the StudyGroupMutation builder.
+func (m *StudyGroupMutation) Where(ps ...predicate.StudyGroup) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the StudyGroupMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *StudyGroupMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.StudyGroup, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *StudyGroupMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *StudyGroupMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (StudyGroup).
+func (m *StudyGroupMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *StudyGroupMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.name != nil {
+		fields = append(fields, studygroup.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, studygroup.FieldDescription)
+	}
+	if m.privacy != nil {
+		fields = append(fields, studygroup.FieldPrivacy)
+	}
+	if m.created_at != nil {
+		fields = append(fields, studygroup.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, studygroup.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *StudyGroupMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case studygroup.FieldName:
+		return m.Name()
+	case studygroup.FieldDescription:
+		return m.Description()
+	case studygroup.FieldPrivacy:
+		return m.Privacy()
+	case studygroup.FieldCreatedAt:
+		return m.CreatedAt()
+	case studygroup.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *StudyGroupMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case studygroup.FieldName:
+		return m.OldName(ctx)
+	case studygroup.FieldDescription:
+		return m.OldDescription(ctx)
+	case studygroup.FieldPrivacy:
+		return m.OldPrivacy(ctx)
+	case studygroup.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case studygroup.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown StudyGroup field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *StudyGroupMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case studygroup.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case studygroup.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case studygroup.FieldPrivacy:
+		v, ok := value.(studygroup.Privacy)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrivacy(v)
+		return nil
+	case studygroup.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case studygroup.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown StudyGroup field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *StudyGroupMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *StudyGroupMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *StudyGroupMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown StudyGroup numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *StudyGroupMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(studygroup.FieldDescription) {
+		fields = append(fields, studygroup.FieldDescription)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *StudyGroupMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *StudyGroupMutation) ClearField(name string) error {
+	switch name {
+	case studygroup.FieldDescription:
+		m.ClearDescription()
+		return nil
+	}
+	return fmt.Errorf("unknown StudyGroup nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *StudyGroupMutation) ResetField(name string) error {
+	switch name {
+	case studygroup.FieldName:
+		m.ResetName()
+		return nil
+	case studygroup.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case studygroup.FieldPrivacy:
+		m.ResetPrivacy()
+		return nil
+	case studygroup.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case studygroup.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown StudyGroup field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *StudyGroupMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.memberships != nil {
+		edges = append(edges, studygroup.EdgeMemberships)
+	}
+	if m.join_requests != nil {
+		edges = append(edges, studygroup.EdgeJoinRequests)
+	}
+	if m.invites != nil {
+		edges = append(edges, studygroup.EdgeInvites)
+	}
+	if m.shared_notes != nil {
+		edges = append(edges, studygroup.EdgeSharedNotes)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *StudyGroupMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case studygroup.EdgeMemberships:
+		ids := make([]ent.Value, 0, len(m.memberships))
+		for id := range m.memberships {
+			ids = append(ids, id)
+		}
+		return ids
+	case studygroup.EdgeJoinRequests:
+		ids := make([]ent.Value, 0, len(m.join_requests))
+		for id := range m.join_requests {
+			ids = append(ids, id)
+		}
+		return ids
+	case studygroup.EdgeInvites:
+		ids := make([]ent.Value, 0, len(m.invites))
+		for id := range m.invites {
+			ids = append(ids, id)
+		}
+		return ids
+	case studygroup.EdgeSharedNotes:
+		ids := make([]ent.Value, 0, len(m.shared_notes))
+		for id := range m.shared_notes {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *StudyGroupMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedmemberships != nil {
+		edges = append(edges, studygroup.EdgeMemberships)
+	}
+	if m.removedjoin_requests != nil {
+		edges = append(edges, studygroup.EdgeJoinRequests)
+	}
+	if m.removedinvites != nil {
+		edges = append(edges, studygroup.EdgeInvites)
+	}
+	if m.removedshared_notes != nil {
+		edges = append(edges, studygroup.EdgeSharedNotes)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *StudyGroupMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case studygroup.EdgeMemberships:
+		ids := make([]ent.Value, 0, len(m.removedmemberships))
+		for id := range m.removedmemberships {
+			ids = append(ids, id)
+		}
+		return ids
+	case studygroup.EdgeJoinRequests:
+		ids := make([]ent.Value, 0, len(m.removedjoin_requests))
+		for id := range m.removedjoin_requests {
+			ids = append(ids, id)
+		}
+		return ids
+	case studygroup.EdgeInvites:
+		ids := make([]ent.Value, 0, len(m.removedinvites))
+		for id := range m.removedinvites {
+			ids = append(ids, id)
+		}
+		return ids
+	case studygroup.EdgeSharedNotes:
+		ids := make([]ent.Value, 0, len(m.removedshared_notes))
+		for id := range m.removedshared_notes {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *StudyGroupMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedmemberships {
+		edges = append(edges, studygroup.EdgeMemberships)
+	}
+	if m.clearedjoin_requests {
+		edges = append(edges, studygroup.EdgeJoinRequests)
+	}
+	if m.clearedinvites {
+		edges = append(edges, studygroup.EdgeInvites)
+	}
+	if m.clearedshared_notes {
+		edges = append(edges, studygroup.EdgeSharedNotes)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *StudyGroupMutation) EdgeCleared(name string) bool {
+	switch name {
+	case studygroup.EdgeMemberships:
+		return m.clearedmemberships
+	case studygroup.EdgeJoinRequests:
+		return m.clearedjoin_requests
+	case studygroup.EdgeInvites:
+		return m.clearedinvites
+	case studygroup.EdgeSharedNotes:
+		return m.clearedshared_notes
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *StudyGroupMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown StudyGroup unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *StudyGroupMutation) ResetEdge(name string) error {
+	switch name {
+	case studygroup.EdgeMemberships:
+		m.ResetMemberships()
+		return nil
+	case studygroup.EdgeJoinRequests:
+		m.ResetJoinRequests()
+		return nil
+	case studygroup.EdgeInvites:
+		m.ResetInvites()
+		return nil
+	case studygroup.EdgeSharedNotes:
+		m.ResetSharedNotes()
+		return nil
+	}
+	return fmt.Errorf("unknown StudyGroup edge %s", name)
+}
+
+// TagMutation represents an operation that mutates the Tag nodes in the graph.
+type TagMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	name          *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	owner         *int
+	clearedowner  bool
+	notes         map[int]struct{}
+	removednotes  map[int]struct{}
+	clearednotes  bool
+	done          bool
+	oldValue      func(context.Context) (*Tag, error)
+	predicates    []predicate.Tag
+}
+
+var _ ent.Mutation = (*TagMutation)(nil)
+
+// tagOption allows management of the mutation configuration using functional options.
+type tagOption func(*TagMutation)
+
+// newTagMutation creates new mutation for the Tag entity.
+func newTagMutation(c config, op Op, opts ...tagOption) *TagMutation {
+	m := &TagMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTag,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTagID sets the ID field of the mutation.
+func withTagID(id int) tagOption {
+	return func(m *TagMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Tag
+		)
+		m.oldValue = func(ctx context.Context) (*Tag, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Tag.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTag sets the old Tag of the mutation.
+func withTag(node *Tag) tagOption {
+	return func(m *TagMutation) {
+		m.oldValue = func(context.Context) (*Tag, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TagMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TagMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TagMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TagMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Tag.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *TagMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *TagMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *TagMutation) ResetName() {
+	m.name = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TagMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TagMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TagMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by id.
+func (m *TagMutation) SetOwnerID(id int) {
+	m.owner = &id
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *TagMutation) ClearOwner() {
+	m.clearedowner = true
+}
+
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *TagMutation) OwnerCleared() bool {
+	return m.clearedowner
+}
+
+// OwnerID returns the "owner" edge ID in the mutation.
+func (m *TagMutation) OwnerID() (id int, exists bool) {
+	if m.owner != nil {
+		return *m.owner, true
+	}
+	return
+}
+
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *TagMutation) OwnerIDs() (ids []int) {
+	if id := m.owner; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOwner resets all changes to the "owner" edge.
+func (m *TagMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
+}
+
+// AddNoteIDs adds the "notes" edge to the Note entity by ids.
+func (m *TagMutation) AddNoteIDs(ids ...int) {
+	if m.notes == nil {
+		m.notes = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.notes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearNotes clears the "notes" edge to the Note entity.
+func (m *TagMutation) ClearNotes() {
+	m.clearednotes = true
+}
+
+// NotesCleared reports if the "notes" edge to the Note entity was cleared.
+func (m *TagMutation) NotesCleared() bool {
+	return m.clearednotes
+}
+
+// RemoveNoteIDs removes the "notes" edge to the Note entity by IDs.
+func (m *TagMutation) RemoveNoteIDs(ids ...int) {
+	if m.removednotes == nil {
+		m.removednotes = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.notes, ids[i])
+		m.removednotes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedNotes returns the removed IDs of the "notes" edge to the Note entity.
+func (m *TagMutation) RemovedNotesIDs() (ids []int) {
+	for id := range m.removednotes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// NotesIDs returns the "notes" edge IDs in the mutation.
+func (m *TagMutation) NotesIDs() (ids []int) {
+	for id := range m.notes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetNotes resets all changes to the "notes" edge.
+func (m *TagMutation) ResetNotes() {
+	m.notes = nil
+	m.clearednotes = false
+	m.removednotes = nil
+}
+
+// Where appends a list predicates to the TagMutation builder.
+func (m *TagMutation) Where(ps ...predicate.Tag) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TagMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TagMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Tag, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TagMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TagMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Tag).
+func (m *TagMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TagMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.name != nil {
+		fields = append(fields, tag.FieldName)
+	}
+	if m.created_at != nil {
+		fields = append(fields, tag.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TagMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case tag.FieldName:
+		return m.Name()
+	case tag.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TagMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case tag.FieldName:
+		return m.OldName(ctx)
+	case tag.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Tag field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TagMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case tag.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case tag.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Tag field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TagMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TagMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TagMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Tag numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TagMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TagMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TagMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Tag nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TagMutation) ResetField(name string) error {
+	switch name {
+	case tag.FieldName:
+		m.ResetName()
+		return nil
+	case tag.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Tag field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TagMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.owner != nil {
+		edges = append(edges, tag.EdgeOwner)
+	}
+	if m.notes != nil {
+		edges = append(edges, tag.EdgeNotes)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TagMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case tag.EdgeOwner:
+		if id := m.owner; id != nil {
+			return []ent.Value{*id}
+		}
+	case tag.EdgeNotes:
+		ids := make([]ent.Value, 0, len(m.notes))
+		for id := range m.notes {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TagMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removednotes != nil {
+		edges = append(edges, tag.EdgeNotes)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TagMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case tag.EdgeNotes:
+		ids := make([]ent.Value, 0, len(m.removednotes))
+		for id := range m.removednotes {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TagMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedowner {
+		edges = append(edges, tag.EdgeOwner)
+	}
+	if m.clearednotes {
+		edges = append(edges, tag.EdgeNotes)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TagMutation) EdgeCleared(name string) bool {
+	switch name {
+	case tag.EdgeOwner:
+		return m.clearedowner
+	case tag.EdgeNotes:
+		return m.clearednotes
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TagMutation) ClearEdge(name string) error {
+	switch name {
+	case tag.EdgeOwner:
+		m.ClearOwner()
+		return nil
+	}
+	return fmt.Errorf("unknown Tag unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TagMutation) ResetEdge(name string) error {
+	switch name {
+	case tag.EdgeOwner:
+		m.ResetOwner()
+		return nil
+	case tag.EdgeNotes:
+		m.ResetNotes()
+		return nil
+	}
+	return fmt.Errorf("unknown Tag edge %s", name)
+}
+
+// UserMutation represents an operation that mutates the User nodes in the graph.
+type UserMutation struct {
+	config
+	op                         Op
+	typ                        string
+	id                         *int
+	name                       *string
+	phone_number               *string
+	email                      *string
+	password                   *string
+	verified                   *bool
+	verification_code          *string
+	admin                      *bool
+	registration_method        *user.RegistrationMethod
+	profile_picture            *string
+	dark_mode                  *bool
+	bio                        *string
+	email_notifications        *bool
+	sms_notifications          *bool
+	is_active                  *bool
+	last_login                 *time.Time
+	storage_quota              *int64
+	addstorage_quota           *int64
+	storage_used               *int64
+	addstorage_used            *int64
+	created_at                 *time.Time
+	updated_at                 *time.Time
+	clearedFields              map[string]struct{}
+	owner                      map[int]struct{}
+	removedowner               map[int]struct{}
+	clearedowner               bool
+	notes                      map[int]struct{}
+	removednotes               map[int]struct{}
+	clearednotes               bool
+	note_likes                 map[int]struct{}
+	removednote_likes          map[int]struct{}
+	clearednote_likes          bool
+	note_reposts               map[int]struct{}
+	removednote_reposts        map[int]struct{}
+	clearednote_reposts        bool
+	quizzes                    map[int]struct{}
+	removedquizzes             map[int]struct{}
+	clearedquizzes             bool
+	quiz_attempts              map[int]struct{}
+	removedquiz_attempts       map[int]struct{}
+	clearedquiz_attempts       bool
+	group_memberships          map[int]struct{}
+	removedgroup_memberships   map[int]struct{}
+	clearedgroup_memberships   bool
+	group_join_requests        map[int]struct{}
+	removedgroup_join_requests map[int]struct{}
+	clearedgroup_join_requests bool
+	group_invites              map[int]struct{}
+	removedgroup_invites       map[int]struct{}
+	clearedgroup_invites       bool
+	study_events               map[int]struct{}
+	removedstudy_events        map[int]struct{}
+	clearedstudy_events        bool
+	documents                  map[int]struct{}
+	removeddocuments           map[int]struct{}
+	cleareddocuments           bool
+	folders                    map[int]struct{}
+	removedfolders             map[int]struct{}
+	clearedfolders             bool
+	blob_references            map[int]struct{}
+	removedblob_references     map[int]struct{}
+	clearedblob_references     bool
+	resumable_uploads          map[int]struct{}
+	removedresumable_uploads   map[int]struct{}
+	clearedresumable_uploads   bool
+	note_proposals             map[int]struct{}
+	removednote_proposals      map[int]struct{}
+	clearednote_proposals      bool
+	note_revisions             map[int]struct{}
+	removednote_revisions      map[int]struct{}
+	clearednote_revisions      bool
+	notebooks                  map[int]struct{}
+	removednotebooks           map[int]struct{}
+	clearednotebooks           bool
+	tags                       map[int]struct{}
+	removedtags                map[int]struct{}
+	clearedtags                bool
+	done                       bool
+	oldValue                   func(context.Context) (*User, error)
+	predicates                 []predicate.User
+}
+
+var _ ent.Mutation = (*UserMutation)(nil)
+
+// userOption allows management of the mutation configuration using functional options.
+type userOption func(*UserMutation)
+
+// newUserMutation creates new mutation for the User entity.
+func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
+	m := &UserMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUser,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withUserID sets the ID field of the mutation.
+func withUserID(id int) userOption {
+	return func(m *UserMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *User
+		)
+		m.oldValue = func(ctx context.Context) (*User, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().User.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withUser sets the old User of the mutation.
+func withUser(node *User) userOption {
+	return func(m *UserMutation) {
+		m.oldValue = func(context.Context) (*User, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *UserMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *UserMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *UserMutation) ResetName() {
+	m.name = nil
+}
+
+// SetPhoneNumber sets the "phone_number" field.
+func (m *UserMutation) SetPhoneNumber(s string) {
+	m.phone_number = &s
+}
+
+// PhoneNumber returns the value of the "phone_number" field in the mutation.
+func (m *UserMutation) PhoneNumber() (r string, exists bool) {
+	v := m.phone_number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPhoneNumber returns the old "phone_number" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldPhoneNumber(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPhoneNumber is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPhoneNumber requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPhoneNumber: %w", err)
+	}
+	return oldValue.PhoneNumber, nil
+}
+
+// ResetPhoneNumber resets all changes to the "phone_number" field.
+func (m *UserMutation) ResetPhoneNumber() {
+	m.phone_number = nil
+}
+
+// SetEmail sets the "email" field.
+func (m *UserMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *UserMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldEmail(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ClearEmail clears the value of the "email" field.
+func (m *UserMutation) ClearEmail() {
+	m.email = nil
+	m.clearedFields[user.FieldEmail] = struct{}{}
+}
+
+// EmailCleared returns if the "email" field was cleared in this mutation.
+func (m *UserMutation) EmailCleared() bool {
+	_, ok := m.clearedFields[user.FieldEmail]
+	return ok
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *UserMutation) ResetEmail() {
+	m.email = nil
+	delete(m.clearedFields, user.FieldEmail)
+}
+
+// SetPassword sets the "password" field.
+func (m *UserMutation) SetPassword(s string) {
+	m.password = &s
+}
+
+// Password returns the value of the "password" field in the mutation.
+func (m *UserMutation) Password() (r string, exists bool) {
+	v := m.password
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPassword returns the old "password" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldPassword(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPassword requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
+	}
+	return oldValue.Password, nil
+}
+
+// ClearPassword clears the value of the "password" field.
+func (m *UserMutation) ClearPassword() {
+	m.password = nil
+	m.clearedFields[user.FieldPassword] = struct{}{}
+}
+
+// PasswordCleared returns if the "password" field was cleared in this mutation.
+func (m *UserMutation) PasswordCleared() bool {
+	_, ok := m.clearedFields[user.FieldPassword]
+	return ok
+}
+
+// ResetPassword resets all changes to the "password" field.
+func (m *UserMutation) ResetPassword() {
+	m.password = nil
+	delete(m.clearedFields, user.FieldPassword)
+}
+
+// SetVerified sets the "verified" field.
+func (m *UserMutation) SetVerified(b bool) {
+	m.verified = &b
+}
+
+// Verified returns the value of the "verified" field in the mutation.
+func (m *UserMutation) Verified() (r bool, exists bool) {
+	v := m.verified
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVerified returns the old "verified" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldVerified(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVerified is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVerified requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVerified: %w", err)
+	}
+	return oldValue.Verified, nil
+}
+
+// ResetVerified resets all changes to the "verified" field.
+func (m *UserMutation) ResetVerified() {
+	m.verified = nil
+}
+
+// SetVerificationCode sets the "verification_code" field.
+func (m *UserMutation) SetVerificationCode(s string) {
+	m.verification_code = &s
+}
+
+// VerificationCode returns the value of the "verification_code" field in the mutation.
+func (m *UserMutation) VerificationCode() (r string, exists bool) {
+	v := m.verification_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVerificationCode returns the old "verification_code" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldVerificationCode(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVerificationCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVerificationCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVerificationCode: %w", err)
+	}
+	return oldValue.VerificationCode, nil
+}
+
+// ClearVerificationCode clears the value of the "verification_code" field.
+func (m *UserMutation) ClearVerificationCode() {
+	m.verification_code = nil
+	m.clearedFields[user.FieldVerificationCode] = struct{}{}
+}
+
+// VerificationCodeCleared returns if the "verification_code" field was cleared in this mutation.
+func (m *UserMutation) VerificationCodeCleared() bool {
+	_, ok := m.clearedFields[user.FieldVerificationCode]
+	return ok
+}
+
+// ResetVerificationCode resets all changes to the "verification_code" field.
+func (m *UserMutation) ResetVerificationCode() {
+	m.verification_code = nil
+	delete(m.clearedFields, user.FieldVerificationCode)
+}
+
+// SetAdmin sets the "admin" field.
+func (m *UserMutation) SetAdmin(b bool) {
+	m.admin = &b
+}
+
+// Admin returns the value of the "admin" field in the mutation.
+func (m *UserMutation) Admin() (r bool, exists bool) {
+	v := m.admin
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAdmin returns the old "admin" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldAdmin(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAdmin is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAdmin requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAdmin: %w", err)
+	}
+	return oldValue.Admin, nil
+}
+
+// ResetAdmin resets all changes to the "admin" field.
+func (m *UserMutation) ResetAdmin() {
+	m.admin = nil
+}
+
+// SetRegistrationMethod sets the "registration_method" field.
+func (m *UserMutation) SetRegistrationMethod(um user.RegistrationMethod) {
+	m.registration_method = &um
+}
+
+// RegistrationMethod returns the value of the "registration_method" field in the mutation.
+func (m *UserMutation) RegistrationMethod() (r user.RegistrationMethod, exists bool) {
+	v := m.registration_method
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRegistrationMethod returns the old "registration_method" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldRegistrationMethod(ctx context.Context) (v user.RegistrationMethod, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRegistrationMethod is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRegistrationMethod requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRegistrationMethod: %w", err)
+	}
+	return oldValue.RegistrationMethod, nil
+}
+
+// ResetRegistrationMethod resets all changes to the "registration_method" field.
+func (m *UserMutation) ResetRegistrationMethod() {
+	m.registration_method = nil
+}
+
+// SetProfilePicture sets the "profile_picture" field.
+func (m *UserMutation) SetProfilePicture(s string) {
+	m.profile_picture = &s
+}
+
+// ProfilePicture returns the value of the "profile_picture" field in the mutation.
+func (m *UserMutation) ProfilePicture() (r string, exists bool) {
+	v := m.profile_picture
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProfilePicture returns the old "profile_picture" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldProfilePicture(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProfilePicture is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProfilePicture requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProfilePicture: %w", err)
+	}
+	return oldValue.ProfilePicture, nil
+}
+
+// ClearProfilePicture clears the value of the "profile_picture" field.
+func (m *UserMutation) ClearProfilePicture() {
+	m.profile_picture = nil
+	m.clearedFields[user.FieldProfilePicture] = struct{}{}
+}
+
+// ProfilePictureCleared returns if the "profile_picture" field was cleared in this mutation.
+func (m *UserMutation) ProfilePictureCleared() bool {
+	_, ok := m.clearedFields[user.FieldProfilePicture]
+	return ok
+}
+
+// ResetProfilePicture resets all changes to the "profile_picture" field.
+func (m *UserMutation) ResetProfilePicture() {
+	m.profile_picture = nil
+	delete(m.clearedFields, user.FieldProfilePicture)
+}
+
+// SetDarkMode sets the "dark_mode" field.
+func (m *UserMutation) SetDarkMode(b bool) {
+	m.dark_mode = &b
+}
+
+// DarkMode returns the value of the "dark_mode" field in the mutation.
+func (m *UserMutation) DarkMode() (r bool, exists bool) {
+	v := m.dark_mode
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDarkMode returns the old "dark_mode" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldDarkMode(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDarkMode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDarkMode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDarkMode: %w", err)
+	}
+	return oldValue.DarkMode, nil
+}
+
+// ResetDarkMode resets all changes to the "dark_mode" field.
+func (m *UserMutation) ResetDarkMode() {
+	m.dark_mode = nil
+}
+
+// SetBio sets the "bio" field.
+func (m *UserMutation) SetBio(s string) {
+	m.bio = &s
+}
+
+// Bio returns the value of the "bio" field in the mutation.
+func (m *UserMutation) Bio() (r string, exists bool) {
+	v := m.bio
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBio returns the old "bio" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldBio(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBio is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBio requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBio: %w", err)
+	}
+	return oldValue.Bio, nil
+}
+
+// ClearBio clears the value of the "bio" field.
+func (m *UserMutation) ClearBio() {
+	m.bio = nil
+	m.clearedFields[user.FieldBio] = struct{}{}
+}
+
+// BioCleared returns if the "bio" field was cleared in this mutation.
+func (m *UserMutation) BioCleared() bool {
+	_, ok := m.clearedFields[user.FieldBio]
+	return ok
+}
+
+// ResetBio resets all changes to the "bio" field.
+func (m *UserMutation) ResetBio() {
+	m.bio = nil
+	delete(m.clearedFields, user.FieldBio)
+}
+
+// SetEmailNotifications sets the "email_notifications" field.
+func (m *UserMutation) SetEmailNotifications(b bool) {
+	m.email_notifications = &b
+}
+
+// EmailNotifications returns the value of the "email_notifications" field in the mutation.
+func (m *UserMutation) EmailNotifications() (r bool, exists bool) {
+	v := m.email_notifications
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmailNotifications returns the old "email_notifications" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldEmailNotifications(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmailNotifications is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmailNotifications requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmailNotifications: %w", err)
+	}
+	return oldValue.EmailNotifications, nil
+}
+
+// ResetEmailNotifications resets all changes to the "email_notifications" field.
+func (m *UserMutation) ResetEmailNotifications() {
+	m.email_notifications = nil
+}
+
+// SetSmsNotifications sets the "sms_notifications" field.
+func (m *UserMutation) SetSmsNotifications(b bool) {
+	m.sms_notifications = &b
+}
+
+// SmsNotifications returns the value of the "sms_notifications" field in the mutation.
+func (m *UserMutation) SmsNotifications() (r bool, exists bool) {
+	v := m.sms_notifications
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSmsNotifications returns the old "sms_notifications" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldSmsNotifications(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSmsNotifications is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSmsNotifications requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSmsNotifications: %w", err)
+	}
+	return oldValue.SmsNotifications, nil
+}
+
+// ResetSmsNotifications resets all changes to the "sms_notifications" field.
+func (m *UserMutation) ResetSmsNotifications() {
+	m.sms_notifications = nil
+}
+
+// SetIsActive sets the "is_active" field.
+func (m *UserMutation) SetIsActive(b bool) {
+	m.is_active = &b
+}
+
+// IsActive returns the value of the "is_active" field in the mutation.
+func (m *UserMutation) IsActive() (r bool, exists bool) {
+	v := m.is_active
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsActive returns the old "is_active" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldIsActive(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsActive is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsActive requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsActive: %w", err)
+	}
+	return oldValue.IsActive, nil
+}
+
+// ResetIsActive resets all changes to the "is_active" field.
+func (m *UserMutation) ResetIsActive() {
+	m.is_active = nil
+}
+
+// SetLastLogin sets the "last_login" field.
+func (m *UserMutation) SetLastLogin(t time.Time) {
+	m.last_login = &t
+}
+
+// LastLogin returns the value of the "last_login" field in the mutation.
+func (m *UserMutation) LastLogin() (r time.Time, exists bool) {
+	v := m.last_login
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastLogin returns the old "last_login" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldLastLogin(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastLogin is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastLogin requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastLogin: %w", err)
+	}
+	return oldValue.LastLogin, nil
+}
+
+// ClearLastLogin clears the value of the "last_login" field.
+func (m *UserMutation) ClearLastLogin() {
+	m.last_login = nil
+	m.clearedFields[user.FieldLastLogin] = struct{}{}
+}
+
+// LastLoginCleared returns if the "last_login" field was cleared in this mutation.
+func (m *UserMutation) LastLoginCleared() bool {
+	_, ok := m.clearedFields[user.FieldLastLogin]
+	return ok
+}
+
+// ResetLastLogin resets all changes to the "last_login" field.
+func (m *UserMutation) ResetLastLogin() {
+	m.last_login = nil
+	delete(m.clearedFields, user.FieldLastLogin)
+}
+
+// SetStorageQuota sets the "storage_quota" field.
+func (m *UserMutation) SetStorageQuota(i int64) {
+	m.storage_quota = &i
+	m.addstorage_quota = nil
+}
+
+// StorageQuota returns the value of the "storage_quota" field in the mutation.
+func (m *UserMutation) StorageQuota() (r int64, exists bool) {
+	v := m.storage_quota
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStorageQuota returns the old "storage_quota" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldStorageQuota(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStorageQuota is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStorageQuota requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStorageQuota: %w", err)
+	}
+	return oldValue.StorageQuota, nil
+}
+
+// AddStorageQuota adds i to the "storage_quota" field.
+func (m *UserMutation) AddStorageQuota(i int64) {
+	if m.addstorage_quota != nil {
+		*m.addstorage_quota += i
+	} else {
+		m.addstorage_quota = &i
+	}
+}
+
+// AddedStorageQuota returns the value that was added to the "storage_quota" field in this mutation.
+func (m *UserMutation) AddedStorageQuota() (r int64, exists bool) {
+	v := m.addstorage_quota
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearStorageQuota clears the value of the "storage_quota" field.
+func (m *UserMutation) ClearStorageQuota() {
+	m.storage_quota = nil
+	m.addstorage_quota = nil
+	m.clearedFields[user.FieldStorageQuota] = struct{}{}
+}
+
+// StorageQuotaCleared returns if the "storage_quota" field was cleared in this mutation.
+func (m *UserMutation) StorageQuotaCleared() bool {
+	_, ok := m.clearedFields[user.FieldStorageQuota]
+	return ok
+}
+
+// ResetStorageQuota resets all changes to the "storage_quota" field.
+func (m *UserMutation) ResetStorageQuota() {
+	m.storage_quota = nil
+	m.addstorage_quota = nil
+	delete(m.clearedFields, user.FieldStorageQuota)
+}
+
+// SetStorageUsed sets the "storage_used" field.
+func (m *UserMutation) SetStorageUsed(i int64) {
+	m.storage_used = &i
+	m.addstorage_used = nil
+}
+
+// StorageUsed returns the value of the "storage_used" field in the mutation.
+func (m *UserMutation) StorageUsed() (r int64, exists bool) {
+	v := m.storage_used
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStorageUsed returns the old "storage_used" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldStorageUsed(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStorageUsed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStorageUsed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStorageUsed: %w", err)
+	}
+	return oldValue.StorageUsed, nil
+}
+
+// AddStorageUsed adds i to the "storage_used" field.
+func (m *UserMutation) AddStorageUsed(i int64) {
+	if m.addstorage_used != nil {
+		*m.addstorage_used += i
+	} else {
+		m.addstorage_used = &i
+	}
+}
+
+// AddedStorageUsed returns the value that was added to the "storage_used" field in this mutation.
+func (m *UserMutation) AddedStorageUsed() (r int64, exists bool) {
+	v := m.addstorage_used
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStorageUsed resets all changes to the "storage_used" field.
+func (m *UserMutation) ResetStorageUsed() {
+	m.storage_used = nil
+	m.addstorage_used = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *UserMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *UserMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *UserMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (m *UserMutation) ClearUpdatedAt() {
+	m.updated_at = nil
+	m.clearedFields[user.FieldUpdatedAt] = struct{}{}
+}
+
+// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
+func (m *UserMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[user.FieldUpdatedAt]
+	return ok
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *UserMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	delete(m.clearedFields, user.FieldUpdatedAt)
+}
+
+// AddOwnerIDs adds the "owner" edge to the PasswordToken entity by ids.
+func (m *UserMutation) AddOwnerIDs(ids ...int) {
+	if m.owner == nil {
+		m.owner = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.owner[ids[i]] = struct{}{}
+	}
+}
+
+// ClearOwner clears the "owner" edge to the PasswordToken entity.
+func (m *UserMutation) ClearOwner() {
+	m.clearedowner = true
+}
+
+// OwnerCleared reports if the "owner" edge to the PasswordToken entity was cleared.
+func (m *UserMutation) OwnerCleared() bool {
+	return m.clearedowner
+}
+
+// RemoveOwnerIDs removes the "owner" edge to the PasswordToken entity by IDs.
+func (m *UserMutation) RemoveOwnerIDs(ids ...int) {
+	if m.removedowner == nil {
+		m.removedowner = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.owner, ids[i])
+		m.removedowner[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedOwner returns the removed IDs of the "owner" edge to the PasswordToken entity.
+func (m *UserMutation) RemovedOwnerIDs() (ids []int) {
+	for id := range m.removedowner {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *UserMutation) Name() (r string, exists bool) {
-	v := m.name
-	if v == nil {
-		return
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+func (m *UserMutation) OwnerIDs() (ids []int) {
+	for id := range m.owner {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// OldName returns the old "name" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
-	}
-	return oldValue.Name, nil
+// ResetOwner resets all changes to the "owner" edge.
+func (m *UserMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
+	m.removedowner = nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *UserMutation) ResetName() {
-	m.name = nil
+// AddNoteIDs adds the "notes" edge to the Note entity by ids.
+func (m *UserMutation) AddNoteIDs(ids ...int) {
+	if m.notes == nil {
+		m.notes = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.notes[ids[i]] = struct{}{}
+	}
 }
 
-// SetPhoneNumber sets the "phone_number" field.
-func (m *UserMutation) SetPhoneNumber(s string) {
-	m.phone_number = &s
+// ClearNotes clears the "notes" edge to the Note entity.
+func (m *UserMutation) ClearNotes() {
+	m.clearednotes = true
 }
 
-// PhoneNumber returns the value of the "phone_number" field in the mutation.
-func (m *UserMutation) PhoneNumber() (r string, exists bool) {
-	v := m.phone_number
-	if v == nil {
-		return
-	}
-	return *v, true
+// NotesCleared reports if the "notes" edge to the Note entity was cleared.
+func (m *UserMutation) NotesCleared() bool {
+	return m.clearednotes
 }
 
-// OldPhoneNumber returns the old "phone_number" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldPhoneNumber(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPhoneNumber is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPhoneNumber requires an ID field in the mutation")
+// RemoveNoteIDs removes the "notes" edge to the Note entity by IDs.
+func (m *UserMutation) RemoveNoteIDs(ids ...int) {
+	if m.removednotes == nil {
+		m.removednotes = make(map[int]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPhoneNumber: %w", err)
+	for i := range ids {
+		delete(m.notes, ids[i])
+		m.removednotes[ids[i]] = struct{}{}
 	}
-	return oldValue.PhoneNumber, nil
 }
 
-// ResetPhoneNumber resets all changes to the "phone_number" field.
-func (m *UserMutation) ResetPhoneNumber() {
-	m.phone_number = nil
+// RemovedNotes returns the removed IDs of the "notes" edge to the Note entity.
+func (m *UserMutation) RemovedNotesIDs() (ids []int) {
+	for id := range m.removednotes {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// SetEmail sets the "email" field.
-func (m *UserMutation) SetEmail(s string) {
-	m.email = &s
+// NotesIDs returns the "notes" edge IDs in the mutation.
+func (m *UserMutation) NotesIDs() (ids []int) {
+	for id := range m.notes {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// Email returns the value of the "email" field in the mutation.
-func (m *UserMutation) Email() (r string, exists bool) {
-	v := m.email
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetNotes resets all changes to the "notes" edge.
+func (m *UserMutation) ResetNotes() {
+	m.notes = nil
+	m.clearednotes = false
+	m.removednotes = nil
 }
 
-// OldEmail returns the old "email" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldEmail(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmail requires an ID field in the mutation")
+// AddNoteLikeIDs adds the "note_likes" edge to the NoteLike entity by ids.
+func (m *UserMutation) AddNoteLikeIDs(ids ...int) {
+	if m.note_likes == nil {
+		m.note_likes = make(map[int]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	for i := range ids {
+		m.note_likes[ids[i]] = struct{}{}
 	}
-	return oldValue.Email, nil
 }
 
-// ClearEmail clears the value of the "email" field.
-func (m *UserMutation) ClearEmail() {
-	m.email = nil
-	m.clearedFields[user.FieldEmail] = struct{}{}
+// ClearNoteLikes clears the "note_likes" edge to the NoteLike entity.
+func (m *UserMutation) ClearNoteLikes() {
+	m.clearednote_likes = true
 }
 
-// EmailCleared returns if the "email" field was cleared in this mutation.
-func (m *UserMutation) EmailCleared() bool {
-	_, ok := m.clearedFields[user.FieldEmail]
-	return ok
+// NoteLikesCleared reports if the "note_likes" edge to the NoteLike entity was cleared.
+func (m *UserMutation) NoteLikesCleared() bool {
+	return m.clearednote_likes
 }
 
-// ResetEmail resets all changes to the "email" field.
-func (m *UserMutation) ResetEmail() {
-	m.email = nil
-	delete(m.clearedFields, user.FieldEmail)
+// RemoveNoteLikeIDs removes the "note_likes" edge to the NoteLike entity by IDs.
+func (m *UserMutation) RemoveNoteLikeIDs(ids ...int) {
+	if m.removednote_likes == nil {
+		m.removednote_likes = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.note_likes, ids[i])
+		m.removednote_likes[ids[i]] = struct{}{}
+	}
 }
 
-// SetPassword sets the "password" field.
-func (m *UserMutation) SetPassword(s string) {
-	m.password = &s
+// RemovedNoteLikes returns the removed IDs of the "note_likes" edge to the NoteLike entity.
+func (m *UserMutation) RemovedNoteLikesIDs() (ids []int) {
+	for id := range m.removednote_likes {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// Password returns the value of the "password" field in the mutation.
-func (m *UserMutation) Password() (r string, exists bool) {
-	v := m.password
-	if v == nil {
-		return
+// NoteLikesIDs returns the "note_likes" edge IDs in the mutation.
+func (m *UserMutation) NoteLikesIDs() (ids []int) {
+	for id := range m.note_likes {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// OldPassword returns the old "password" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldPassword(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPassword requires an ID field in the mutation")
+// ResetNoteLikes resets all changes to the "note_likes" edge.
+func (m *UserMutation) ResetNoteLikes() {
+	m.note_likes = nil
+	m.clearednote_likes = false
+	m.removednote_likes = nil
+}
+
+// AddNoteRepostIDs adds the "note_reposts" edge to the NoteRepost entity by ids.
+func (m *UserMutation) AddNoteRepostIDs(ids ...int) {
+	if m.note_reposts == nil {
+		m.note_reposts = make(map[int]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
+	for i := range ids {
+		m.note_reposts[ids[i]] = struct{}{}
 	}
-	return oldValue.Password, nil
 }
 
-// ClearPassword clears the value of the "password" field.
-func (m *UserMutation) ClearPassword() {
-	m.password = nil
-	m.clearedFields[user.FieldPassword] = struct{}{}
+// ClearNoteReposts clears the "note_reposts" edge to the NoteRepost entity.
+func (m *UserMutation) ClearNoteReposts() {
+	m.clearednote_reposts = true
 }
 
-// PasswordCleared returns if the "password" field was cleared in this mutation.
-func (m *UserMutation) PasswordCleared() bool {
-	_, ok := m.clearedFields[user.FieldPassword]
-	return ok
+// NoteRepostsCleared reports if the "note_reposts" edge to the NoteRepost entity was cleared.
+func (m *UserMutation) NoteRepostsCleared() bool {
+	return m.clearednote_reposts
 }
 
-// ResetPassword resets all changes to the "password" field.
-func (m *UserMutation) ResetPassword() {
-	m.password = nil
-	delete(m.clearedFields, user.FieldPassword)
+// RemoveNoteRepostIDs removes the "note_reposts" edge to the NoteRepost entity by IDs.
+func (m *UserMutation) RemoveNoteRepostIDs(ids ...int) {
+	if m.removednote_reposts == nil {
+		m.removednote_reposts = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.note_reposts, ids[i])
+		m.removednote_reposts[ids[i]] = struct{}{}
+	}
 }
 
-// SetVerified sets the "verified" field.
-func (m *UserMutation) SetVerified(b bool) {
-	m.verified = &b
+// RemovedNoteReposts returns the removed IDs of the "note_reposts" edge to the NoteRepost entity.
+func (m *UserMutation) RemovedNoteRepostsIDs() (ids []int) {
+	for id := range m.removednote_reposts {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// Verified returns the value of the "verified" field in the mutation.
-func (m *UserMutation) Verified() (r bool, exists bool) {
-	v := m.verified
-	if v == nil {
-		return
+// NoteRepostsIDs returns the "note_reposts" edge IDs in the mutation.
+func (m *UserMutation) NoteRepostsIDs() (ids []int) {
+	for id := range m.note_reposts {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// OldVerified returns the old "verified" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldVerified(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldVerified is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldVerified requires an ID field in the mutation")
+// ResetNoteReposts resets all changes to the "note_reposts" edge.
+func (m *UserMutation) ResetNoteReposts() {
+	m.note_reposts = nil
+	m.clearednote_reposts = false
+	m.removednote_reposts = nil
+}
+
+// AddQuizIDs adds the "quizzes" edge to the Quiz entity by ids.
+func (m *UserMutation) AddQuizIDs(ids ...int) {
+	if m.quizzes == nil {
+		m.quizzes = make(map[int]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldVerified: %w", err)
+	for i := range ids {
+		m.quizzes[ids[i]] = struct{}{}
 	}
-	return oldValue.Verified, nil
 }
 
-// ResetVerified resets all changes to the "verified" field.
-func (m *UserMutation) ResetVerified() {
-	m.verified = nil
+// ClearQuizzes clears the "quizzes" edge to the Quiz entity.
+func (m *UserMutation) ClearQuizzes() {
+	m.clearedquizzes = true
 }
 
-// SetVerificationCode sets the "verification_code" field.
-func (m *UserMutation) SetVerificationCode(s string) {
-	m.verification_code = &s
+// QuizzesCleared reports if the "quizzes" edge to the Quiz entity was cleared.
+func (m *UserMutation) QuizzesCleared() bool {
+	return m.clearedquizzes
 }
 
-// VerificationCode returns the value of the "verification_code" field in the mutation.
-func (m *UserMutation) VerificationCode() (r string, exists bool) {
-	v := m.verification_code
-	if v == nil {
-		return
+// RemoveQuizIDs removes the "quizzes" edge to the Quiz entity by IDs.
+func (m *UserMutation) RemoveQuizIDs(ids ...int) {
+	if m.removedquizzes == nil {
+		m.removedquizzes = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.quizzes, ids[i])
+		m.removedquizzes[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldVerificationCode returns the old "verification_code" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldVerificationCode(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldVerificationCode is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldVerificationCode requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldVerificationCode: %w", err)
+// RemovedQuizzes returns the removed IDs of the "quizzes" edge to the Quiz entity.
+func (m *UserMutation) RemovedQuizzesIDs() (ids []int) {
+	for id := range m.removedquizzes {
+		ids = append(ids, id)
 	}
-	return oldValue.VerificationCode, nil
+	return
 }
 
-// ClearVerificationCode clears the value of the "verification_code" field.
-func (m *UserMutation) ClearVerificationCode() {
-	m.verification_code = nil
-	m.clearedFields[user.FieldVerificationCode] = struct{}{}
+// QuizzesIDs returns the "quizzes" edge IDs in the mutation.
+func (m *UserMutation) QuizzesIDs() (ids []int) {
+	for id := range m.quizzes {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// VerificationCodeCleared returns if the "verification_code" field was cleared in this mutation.
-func (m *UserMutation) VerificationCodeCleared() bool {
-	_, ok := m.clearedFields[user.FieldVerificationCode]
-	return ok
+// ResetQuizzes resets all changes to the "quizzes" edge.
+func (m *UserMutation) ResetQuizzes() {
+	m.quizzes = nil
+	m.clearedquizzes = false
+	m.removedquizzes = nil
 }
 
-// ResetVerificationCode resets all changes to the "verification_code" field.
-func (m *UserMutation) ResetVerificationCode() {
-	m.verification_code = nil
-	delete(m.clearedFields, user.FieldVerificationCode)
+// AddQuizAttemptIDs adds the "quiz_attempts" edge to the QuizAttempt entity by ids.
+func (m *UserMutation) AddQuizAttemptIDs(ids ...int) {
+	if m.quiz_attempts == nil {
+		m.quiz_attempts = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.quiz_attempts[ids[i]] = struct{}{}
+	}
 }
 
-// SetAdmin sets the "admin" field.
-func (m *UserMutation) SetAdmin(b bool) {
-	m.admin = &b
+// ClearQuizAttempts clears the "quiz_attempts" edge to the QuizAttempt entity.
+func (m *UserMutation) ClearQuizAttempts() {
+	m.clearedquiz_attempts = true
 }
 
-// Admin returns the value of the "admin" field in the mutation.
-func (m *UserMutation) Admin() (r bool, exists bool) {
-	v := m.admin
-	if v == nil {
-		return
-	}
-	return *v, true
+// QuizAttemptsCleared reports if the "quiz_attempts" edge to the QuizAttempt entity was cleared.
+func (m *UserMutation) QuizAttemptsCleared() bool {
+	return m.clearedquiz_attempts
 }
 
-// OldAdmin returns the old "admin" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldAdmin(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAdmin is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAdmin requires an ID field in the mutation")
+// RemoveQuizAttemptIDs removes the "quiz_attempts" edge to the QuizAttempt entity by IDs.
+func (m *UserMutation) RemoveQuizAttemptIDs(ids ...int) {
+	if m.removedquiz_attempts == nil {
+		m.removedquiz_attempts = make(map[int]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAdmin: %w", err)
+	for i := range ids {
+		delete(m.quiz_attempts, ids[i])
+		m.removedquiz_attempts[ids[i]] = struct{}{}
 	}
-	return oldValue.Admin, nil
 }
 
-// ResetAdmin resets all changes to the "admin" field.
-func (m *UserMutation) ResetAdmin() {
-	m.admin = nil
+// RemovedQuizAttempts returns the removed IDs of the "quiz_attempts" edge to the QuizAttempt entity.
+func (m *UserMutation) RemovedQuizAttemptsIDs() (ids []int) {
+	for id := range m.removedquiz_attempts {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// SetRegistrationMethod sets the "registration_method" field.
-func (m *UserMutation) SetRegistrationMethod(um user.RegistrationMethod) {
-	m.registration_method = &um
+// QuizAttemptsIDs returns the "quiz_attempts" edge IDs in the mutation.
+func (m *UserMutation) QuizAttemptsIDs() (ids []int) {
+	for id := range m.quiz_attempts {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// RegistrationMethod returns the value of the "registration_method" field in the mutation.
-func (m *UserMutation) RegistrationMethod() (r user.RegistrationMethod, exists bool) {
-	v := m.registration_method
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetQuizAttempts resets all changes to the "quiz_attempts" edge.
+func (m *UserMutation) ResetQuizAttempts() {
+	m.quiz_attempts = nil
+	m.clearedquiz_attempts = false
+	m.removedquiz_attempts = nil
 }
 
-// OldRegistrationMethod returns the old "registration_method" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldRegistrationMethod(ctx context.Context) (v user.RegistrationMethod, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRegistrationMethod is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRegistrationMethod requires an ID field in the mutation")
+// AddGroupMembershipIDs adds the "group_memberships" edge to the GroupMembership entity by ids.
+func (m *UserMutation) AddGroupMembershipIDs(ids ...int) {
+	if m.group_memberships == nil {
+		m.group_memberships = make(map[int]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRegistrationMethod: %w", err)
+	for i := range ids {
+		m.group_memberships[ids[i]] = struct{}{}
 	}
-	return oldValue.RegistrationMethod, nil
 }
 
-// ResetRegistrationMethod resets all changes to the "registration_method" field.
-func (m *UserMutation) ResetRegistrationMethod() {
-	m.registration_method = nil
+// ClearGroupMemberships clears the "group_memberships" edge to the GroupMembership entity.
+func (m *UserMutation) ClearGroupMemberships() {
+	m.clearedgroup_memberships = true
 }
 
-// SetProfilePicture sets the "profile_picture" field.
-func (m *UserMutation) SetProfilePicture(s string) {
-	m.profile_picture = &s
+// GroupMembershipsCleared reports if the "group_memberships" edge to the GroupMembership entity was cleared.
+func (m *UserMutation) GroupMembershipsCleared() bool {
+	return m.clearedgroup_memberships
 }
 
-// ProfilePicture returns the value of the "profile_picture" field in the mutation.
-func (m *UserMutation) ProfilePicture() (r string, exists bool) {
-	v := m.profile_picture
-	if v == nil {
-		return
+// RemoveGroupMembershipIDs removes the "group_memberships" edge to the GroupMembership entity by IDs.
+func (m *UserMutation) RemoveGroupMembershipIDs(ids ...int) {
+	if m.removedgroup_memberships == nil {
+		m.removedgroup_memberships = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.group_memberships, ids[i])
+		m.removedgroup_memberships[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldProfilePicture returns the old "profile_picture" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldProfilePicture(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProfilePicture is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProfilePicture requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProfilePicture: %w", err)
+// RemovedGroupMemberships returns the removed IDs of the "group_memberships" edge to the GroupMembership entity.
+func (m *UserMutation) RemovedGroupMembershipsIDs() (ids []int) {
+	for id := range m.removedgroup_memberships {
+		ids = append(ids, id)
 	}
-	return oldValue.ProfilePicture, nil
+	return
 }
 
-// ClearProfilePicture clears the value of the "profile_picture" field.
-func (m *UserMutation) ClearProfilePicture() {
-	m.profile_picture = nil
-	m.clearedFields[user.FieldProfilePicture] = struct{}{}
+// GroupMembershipsIDs returns the "group_memberships" edge IDs in the mutation.
+func (m *UserMutation) GroupMembershipsIDs() (ids []int) {
+	for id := range m.group_memberships {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// ProfilePictureCleared returns if the "profile_picture" field was cleared in this mutation.
-func (m *UserMutation) ProfilePictureCleared() bool {
-	_, ok := m.clearedFields[user.FieldProfilePicture]
-	return ok
+// ResetGroupMemberships resets all changes to the "group_memberships" edge.
+func (m *UserMutation) ResetGroupMemberships() {
+	m.group_memberships = nil
+	m.clearedgroup_memberships = false
+	m.removedgroup_memberships = nil
 }
 
-// ResetProfilePicture resets all changes to the "profile_picture" field.
-func (m *UserMutation) ResetProfilePicture() {
-	m.profile_picture = nil
-	delete(m.clearedFields, user.FieldProfilePicture)
+// AddGroupJoinRequestIDs adds the "group_join_requests" edge to the GroupJoinRequest entity by ids.
+func (m *UserMutation) AddGroupJoinRequestIDs(ids ...int) {
+	if m.group_join_requests == nil {
+		m.group_join_requests = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.group_join_requests[ids[i]] = struct{}{}
+	}
 }
 
-// SetDarkMode sets the "dark_mode" field.
-func (m *UserMutation) SetDarkMode(b bool) {
-	m.dark_mode = &b
+// ClearGroupJoinRequests clears the "group_join_requests" edge to the GroupJoinRequest entity.
+func (m *UserMutation) ClearGroupJoinRequests() {
+	m.clearedgroup_join_requests = true
 }
 
-// DarkMode returns the value of the "dark_mode" field in the mutation.
-func (m *UserMutation) DarkMode() (r bool, exists bool) {
-	v := m.dark_mode
-	if v == nil {
-		return
-	}
-	return *v, true
+// GroupJoinRequestsCleared reports if the "group_join_requests" edge to the GroupJoinRequest entity was cleared.
+func (m *UserMutation) GroupJoinRequestsCleared() bool {
+	return m.clearedgroup_join_requests
 }
 
-// OldDarkMode returns the old "dark_mode" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldDarkMode(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDarkMode is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDarkMode requires an ID field in the mutation")
+// RemoveGroupJoinRequestIDs removes the "group_join_requests" edge to the GroupJoinRequest entity by IDs.
+func (m *UserMutation) RemoveGroupJoinRequestIDs(ids ...int) {
+	if m.removedgroup_join_requests == nil {
+		m.removedgroup_join_requests = make(map[int]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDarkMode: %w", err)
+	for i := range ids {
+		delete(m.group_join_requests, ids[i])
+		m.removedgroup_join_requests[ids[i]] = struct{}{}
 	}
-	return oldValue.DarkMode, nil
 }
 
-// ResetDarkMode resets all changes to the "dark_mode" field.
-func (m *UserMutation) ResetDarkMode() {
-	m.dark_mode = nil
+// RemovedGroupJoinRequests returns the removed IDs of the "group_join_requests" edge to the GroupJoinRequest entity.
+func (m *UserMutation) RemovedGroupJoinRequestsIDs() (ids []int) {
+	for id := range m.removedgroup_join_requests {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// SetBio sets the "bio" field.
-func (m *UserMutation) SetBio(s string) {
-	m.bio = &s
+// GroupJoinRequestsIDs returns the "group_join_requests" edge IDs in the mutation.
+func (m *UserMutation) GroupJoinRequestsIDs() (ids []int) {
+	for id := range m.group_join_requests {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// Bio returns the value of the "bio" field in the mutation.
-func (m *UserMutation) Bio() (r string, exists bool) {
-	v := m.bio
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetGroupJoinRequests resets all changes to the "group_join_requests" edge.
+func (m *UserMutation) ResetGroupJoinRequests() {
+	m.group_join_requests = nil
+	m.clearedgroup_join_requests = false
+	m.removedgroup_join_requests = nil
 }
 
-// OldBio returns the old "bio" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldBio(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBio is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBio requires an ID field in the mutation")
+// AddGroupInviteIDs adds the "group_invites" edge to the GroupInvite entity by ids.
+func (m *UserMutation) AddGroupInviteIDs(ids ...int) {
+	if m.group_invites == nil {
+		m.group_invites = make(map[int]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBio: %w", err)
+	for i := range ids {
+		m.group_invites[ids[i]] = struct{}{}
 	}
-	return oldValue.Bio, nil
 }
 
-// ClearBio clears the value of the "bio" field.
-func (m *UserMutation) ClearBio() {
-	m.bio = nil
-	m.clearedFields[user.FieldBio] = struct{}{}
+// ClearGroupInvites clears the "group_invites" edge to the GroupInvite entity.
+func (m *UserMutation) ClearGroupInvites() {
+	m.clearedgroup_invites = true
 }
 
-// BioCleared returns if the "bio" field was cleared in this mutation.
-func (m *UserMutation) BioCleared() bool {
-	_, ok := m.clearedFields[user.FieldBio]
-	return ok
+// GroupInvitesCleared reports if the "group_invites" edge to the GroupInvite entity was cleared.
+func (m *UserMutation) GroupInvitesCleared() bool {
+	return m.clearedgroup_invites
 }
 
-// ResetBio resets all changes to the "bio" field.
-func (m *UserMutation) ResetBio() {
-	m.bio = nil
-	delete(m.clearedFields, user.FieldBio)
+// RemoveGroupInviteIDs removes the "group_invites" edge to the GroupInvite entity by IDs.
+func (m *UserMutation) RemoveGroupInviteIDs(ids ...int) {
+	if m.removedgroup_invites == nil {
+		m.removedgroup_invites = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.group_invites, ids[i])
+		m.removedgroup_invites[ids[i]] = struct{}{}
+	}
 }
 
-// SetEmailNotifications sets the "email_notifications" field.
-func (m *UserMutation) SetEmailNotifications(b bool) {
-	m.email_notifications = &b
+// RemovedGroupInvites returns the removed IDs of the "group_invites" edge to the GroupInvite entity.
+func (m *UserMutation) RemovedGroupInvitesIDs() (ids []int) {
+	for id := range m.removedgroup_invites {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// EmailNotifications returns the value of the "email_notifications" field in the mutation.
-func (m *UserMutation) EmailNotifications() (r bool, exists bool) {
-	v := m.email_notifications
-	if v == nil {
-		return
+// GroupInvitesIDs returns the "group_invites" edge IDs in the mutation.
+func (m *UserMutation) GroupInvitesIDs() (ids []int) {
+	for id := range m.group_invites {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// OldEmailNotifications returns the old "email_notifications" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldEmailNotifications(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmailNotifications is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmailNotifications requires an ID field in the mutation")
+// ResetGroupInvites resets all changes to the "group_invites" edge.
+func (m *UserMutation) ResetGroupInvites() {
+	m.group_invites = nil
+	m.clearedgroup_invites = false
+	m.removedgroup_invites = nil
+}
+
+// AddStudyEventIDs adds the "study_events" edge to the StudyEvent entity by ids.
+func (m *UserMutation) AddStudyEventIDs(ids ...int) {
+	if m.study_events == nil {
+		m.study_events = make(map[int]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmailNotifications: %w", err)
+	for i := range ids {
+		m.study_events[ids[i]] = struct{}{}
 	}
-	return oldValue.EmailNotifications, nil
 }
 
-// ResetEmailNotifications resets all changes to the "email_notifications" field.
-func (m *UserMutation) ResetEmailNotifications() {
-	m.email_notifications = nil
+// ClearStudyEvents clears the "study_events" edge to the StudyEvent entity.
+func (m *UserMutation) ClearStudyEvents() {
+	m.clearedstudy_events = true
 }
 
-// SetSmsNotifications sets the "sms_notifications" field.
-func (m *UserMutation) SetSmsNotifications(b bool) {
-	m.sms_notifications = &b
+// StudyEventsCleared reports if the "study_events" edge to the StudyEvent entity was cleared.
+func (m *UserMutation) StudyEventsCleared() bool {
+	return m.clearedstudy_events
 }
 
-// SmsNotifications returns the value of the "sms_notifications" field in the mutation.
-func (m *UserMutation) SmsNotifications() (r bool, exists bool) {
-	v := m.sms_notifications
-	if v == nil {
-		return
+// RemoveStudyEventIDs removes the "study_events" edge to the StudyEvent entity by IDs.
+func (m *UserMutation) RemoveStudyEventIDs(ids ...int) {
+	if m.removedstudy_events == nil {
+		m.removedstudy_events = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.study_events, ids[i])
+		m.removedstudy_events[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldSmsNotifications returns the old "sms_notifications" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldSmsNotifications(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSmsNotifications is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSmsNotifications requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSmsNotifications: %w", err)
+// RemovedStudyEvents returns the removed IDs of the "study_events" edge to the StudyEvent entity.
+func (m *UserMutation) RemovedStudyEventsIDs() (ids []int) {
+	for id := range m.removedstudy_events {
+		ids = append(ids, id)
 	}
-	return oldValue.SmsNotifications, nil
+	return
 }
 
-// ResetSmsNotifications resets all changes to the "sms_notifications" field.
-func (m *UserMutation) ResetSmsNotifications() {
-	m.sms_notifications = nil
+// StudyEventsIDs returns the "study_events" edge IDs in the mutation.
+func (m *UserMutation) StudyEventsIDs() (ids []int) {
+	for id := range m.study_events {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// SetIsActive sets the "is_active" field.
-func (m *UserMutation) SetIsActive(b bool) {
-	m.is_active = &b
+// ResetStudyEvents resets all changes to the "study_events" edge.
+func (m *UserMutation) ResetStudyEvents() {
+	m.study_events = nil
+	m.clearedstudy_events = false
+	m.removedstudy_events = nil
 }
 
-// IsActive returns the value of the "is_active" field in the mutation.
-func (m *UserMutation) IsActive() (r bool, exists bool) {
-	v := m.is_active
-	if v == nil {
-		return
+// AddDocumentIDs adds the "documents" edge to the Document entity by ids.
+func (m *UserMutation) AddDocumentIDs(ids ...int) {
+	if m.documents == nil {
+		m.documents = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.documents[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldIsActive returns the old "is_active" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldIsActive(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsActive is only allowed on UpdateOne operations")
+// ClearDocuments clears the "documents" edge to the Document entity.
+func (m *UserMutation) ClearDocuments() {
+	m.cleareddocuments = true
+}
+
+// DocumentsCleared reports if the "documents" edge to the Document entity was cleared.
+func (m *UserMutation) DocumentsCleared() bool {
+	return m.cleareddocuments
+}
+
+// RemoveDocumentIDs removes the "documents" edge to the Document entity by IDs.
+func (m *UserMutation) RemoveDocumentIDs(ids ...int) {
+	if m.removeddocuments == nil {
+		m.removeddocuments = make(map[int]struct{})
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsActive requires an ID field in the mutation")
+	for i := range ids {
+		delete(m.documents, ids[i])
+		m.removeddocuments[ids[i]] = struct{}{}
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsActive: %w", err)
+}
+
+// RemovedDocuments returns the removed IDs of the "documents" edge to the Document entity.
+func (m *UserMutation) RemovedDocumentsIDs() (ids []int) {
+	for id := range m.removeddocuments {
+		ids = append(ids, id)
 	}
-	return oldValue.IsActive, nil
+	return
 }
 
-// ResetIsActive resets all changes to the "is_active" field.
-func (m *UserMutation) ResetIsActive() {
-	m.is_active = nil
+// DocumentsIDs returns the "documents" edge IDs in the mutation.
+func (m *UserMutation) DocumentsIDs() (ids []int) {
+	for id := range m.documents {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// SetLastLogin sets the "last_login" field.
-func (m *UserMutation) SetLastLogin(t time.Time) {
-	m.last_login = &t
+// ResetDocuments resets all changes to the "documents" edge.
+func (m *UserMutation) ResetDocuments() {
+	m.documents = nil
+	m.cleareddocuments = false
+	m.removeddocuments = nil
 }
 
-// LastLogin returns the value of the "last_login" field in the mutation.
-func (m *UserMutation) LastLogin() (r time.Time, exists bool) {
-	v := m.last_login
-	if v == nil {
-		return
+// AddFolderIDs adds the "folders" edge to the Folder entity by ids.
+func (m *UserMutation) AddFolderIDs(ids ...int) {
+	if m.folders == nil {
+		m.folders = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.folders[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldLastLogin returns the old "last_login" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldLastLogin(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastLogin is only allowed on UpdateOne operations")
+// ClearFolders clears the "folders" edge to the Folder entity.
+func (m *UserMutation) ClearFolders() {
+	m.clearedfolders = true
+}
+
+// FoldersCleared reports if the "folders" edge to the Folder entity was cleared.
+func (m *UserMutation) FoldersCleared() bool {
+	return m.clearedfolders
+}
+
+// RemoveFolderIDs removes the "folders" edge to the Folder entity by IDs.
+func (m *UserMutation) RemoveFolderIDs(ids ...int) {
+	if m.removedfolders == nil {
+		m.removedfolders = make(map[int]struct{})
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastLogin requires an ID field in the mutation")
+	for i := range ids {
+		delete(m.folders, ids[i])
+		m.removedfolders[ids[i]] = struct{}{}
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastLogin: %w", err)
+}
+
+// RemovedFolders returns the removed IDs of the "folders" edge to the Folder entity.
+func (m *UserMutation) RemovedFoldersIDs() (ids []int) {
+	for id := range m.removedfolders {
+		ids = append(ids, id)
 	}
-	return oldValue.LastLogin, nil
+	return
 }
 
-// ClearLastLogin clears the value of the "last_login" field.
-func (m *UserMutation) ClearLastLogin() {
-	m.last_login = nil
-	m.clearedFields[user.FieldLastLogin] = struct{}{}
+// FoldersIDs returns the "folders" edge IDs in the mutation.
+func (m *UserMutation) FoldersIDs() (ids []int) {
+	for id := range m.folders {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// LastLoginCleared returns if the "last_login" field was cleared in this mutation.
-func (m *UserMutation) LastLoginCleared() bool {
-	_, ok := m.clearedFields[user.FieldLastLogin]
-	return ok
+// ResetFolders resets all changes to the "folders" edge.
+func (m *UserMutation) ResetFolders() {
+	m.folders = nil
+	m.clearedfolders = false
+	m.removedfolders = nil
 }
 
-// ResetLastLogin resets all changes to the "last_login" field.
-func (m *UserMutation) ResetLastLogin() {
-	m.last_login = nil
-	delete(m.clearedFields, user.FieldLastLogin)
+// AddBlobReferenceIDs adds the "blob_references" edge to the BlobReference entity by ids.
+func (m *UserMutation) AddBlobReferenceIDs(ids ...int) {
+	if m.blob_references == nil {
+		m.blob_references = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.blob_references[ids[i]] = struct{}{}
+	}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *UserMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// ClearBlobReferences clears the "blob_references" edge to the BlobReference entity.
+func (m *UserMutation) ClearBlobReferences() {
+	m.clearedblob_references = true
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// BlobReferencesCleared reports if the "blob_references" edge to the BlobReference entity was cleared.
+func (m *UserMutation) BlobReferencesCleared() bool {
+	return m.clearedblob_references
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+// RemoveBlobReferenceIDs removes the "blob_references" edge to the BlobReference entity by IDs.
+func (m *UserMutation) RemoveBlobReferenceIDs(ids ...int) {
+	if m.removedblob_references == nil {
+		m.removedblob_references = make(map[int]struct{})
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	for i := range ids {
+		delete(m.blob_references, ids[i])
+		m.removedblob_references[ids[i]] = struct{}{}
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+}
+
+// RemovedBlobReferences returns the removed IDs of the "blob_references" edge to the BlobReference entity.
+func (m *UserMutation) RemovedBlobReferencesIDs() (ids []int) {
+	for id := range m.removedblob_references {
+		ids = append(ids, id)
 	}
-	return oldValue.CreatedAt, nil
+	return
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *UserMutation) ResetCreatedAt() {
-	m.created_at = nil
+// BlobReferencesIDs returns the "blob_references" edge IDs in the mutation.
+func (m *UserMutation) BlobReferencesIDs() (ids []int) {
+	for id := range m.blob_references {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *UserMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// ResetBlobReferences resets all changes to the "blob_references" edge.
+func (m *UserMutation) ResetBlobReferences() {
+	m.blob_references = nil
+	m.clearedblob_references = false
+	m.removedblob_references = nil
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
+// AddResumableUploadIDs adds the "resumable_uploads" edge to the ResumableUpload entity by ids.
+func (m *UserMutation) AddResumableUploadIDs(ids ...int) {
+	if m.resumable_uploads == nil {
+		m.resumable_uploads = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.resumable_uploads[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+// ClearResumableUploads clears the "resumable_uploads" edge to the ResumableUpload entity.
+func (m *UserMutation) ClearResumableUploads() {
+	m.clearedresumable_uploads = true
+}
+
+// ResumableUploadsCleared reports if the "resumable_uploads" edge to the ResumableUpload entity was cleared.
+func (m *UserMutation) ResumableUploadsCleared() bool {
+	return m.clearedresumable_uploads
+}
+
+// RemoveResumableUploadIDs removes the "resumable_uploads" edge to the ResumableUpload entity by IDs.
+func (m *UserMutation) RemoveResumableUploadIDs(ids ...int) {
+	if m.removedresumable_uploads == nil {
+		m.removedresumable_uploads = make(map[int]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	for i := range ids {
+		delete(m.resumable_uploads, ids[i])
+		m.removedresumable_uploads[ids[i]] = struct{}{}
 	}
-	return oldValue.UpdatedAt, nil
 }
 
-// ClearUpdatedAt clears the value of the "updated_at" field.
-func (m *UserMutation) ClearUpdatedAt() {
-	m.updated_at = nil
-	m.clearedFields[user.FieldUpdatedAt] = struct{}{}
+// RemovedResumableUploads returns the removed IDs of the "resumable_uploads" edge to the ResumableUpload entity.
+func (m *UserMutation) RemovedResumableUploadsIDs() (ids []int) {
+	for id := range m.removedresumable_uploads {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
-func (m *UserMutation) UpdatedAtCleared() bool {
-	_, ok := m.clearedFields[user.FieldUpdatedAt]
-	return ok
+// ResumableUploadsIDs returns the "resumable_uploads" edge IDs in the mutation.
+func (m *UserMutation) ResumableUploadsIDs() (ids []int) {
+	for id := range m.resumable_uploads {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *UserMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-	delete(m.clearedFields, user.FieldUpdatedAt)
+// ResetResumableUploads resets all changes to the "resumable_uploads" edge.
+func (m *UserMutation) ResetResumableUploads() {
+	m.resumable_uploads = nil
+	m.clearedresumable_uploads = false
+	m.removedresumable_uploads = nil
 }
 
-// AddOwnerIDs adds the "owner" edge to the PasswordToken entity by ids.
-func (m *UserMutation) AddOwnerIDs(ids ...int) {
-	if m.owner == nil {
-		m.owner = make(map[int]struct{})
+// AddNoteProposalIDs adds the "note_proposals" edge to the NoteProposal entity by ids.
+func (m *UserMutation) AddNoteProposalIDs(ids ...int) {
+	if m.note_proposals == nil {
+		m.note_proposals = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.owner[ids[i]] = struct{}{}
+		m.note_proposals[ids[i]] = struct{}{}
 	}
 }
 
-// ClearOwner clears the "owner" edge to the PasswordToken entity.
-func (m *UserMutation) ClearOwner() {
-	m.clearedowner = true
+// ClearNoteProposals clears the "note_proposals" edge to the NoteProposal entity.
+func (m *UserMutation) ClearNoteProposals() {
+	m.clearednote_proposals = true
 }
 
-// OwnerCleared reports if the "owner" edge to the PasswordToken entity was cleared.
-func (m *UserMutation) OwnerCleared() bool {
-	return m.clearedowner
+// NoteProposalsCleared reports if the "note_proposals" edge to the NoteProposal entity was cleared.
+func (m *UserMutation) NoteProposalsCleared() bool {
+	return m.clearednote_proposals
 }
 
-// RemoveOwnerIDs removes the "owner" edge to the PasswordToken entity by IDs.
-func (m *UserMutation) RemoveOwnerIDs(ids ...int) {
-	if m.removedowner == nil {
-		m.removedowner = make(map[int]struct{})
+// RemoveNoteProposalIDs removes the "note_proposals" edge to the NoteProposal entity by IDs.
+func (m *UserMutation) RemoveNoteProposalIDs(ids ...int) {
+	if m.removednote_proposals == nil {
+		m.removednote_proposals = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.owner, ids[i])
-		m.removedowner[ids[i]] = struct{}{}
+		delete(m.note_proposals, ids[i])
+		m.removednote_proposals[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedOwner returns the removed IDs of the "owner" edge to the PasswordToken entity.
-func (m *UserMutation) RemovedOwnerIDs() (ids []int) {
-	for id := range m.removedowner {
+// RemovedNoteProposals returns the removed IDs of the "note_proposals" edge to the NoteProposal entity.
+func (m *UserMutation) RemovedNoteProposalsIDs() (ids []int) {
+	for id := range m.removednote_proposals {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// OwnerIDs returns the "owner" edge IDs in the mutation.
-func (m *UserMutation) OwnerIDs() (ids []int) {
-	for id := range m.owner {
+// NoteProposalsIDs returns the "note_proposals" edge IDs in the mutation.
+func (m *UserMutation) NoteProposalsIDs() (ids []int) {
+	for id := range m.note_proposals {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetOwner resets all changes to the "owner" edge.
-func (m *UserMutation) ResetOwner() {
-	m.owner = nil
-	m.clearedowner = false
-	m.removedowner = nil
+// ResetNoteProposals resets all changes to the "note_proposals" edge.
+func (m *UserMutation) ResetNoteProposals() {
+	m.note_proposals = nil
+	m.clearednote_proposals = false
+	m.removednote_proposals = nil
 }
 
-// AddNoteIDs adds the "notes" edge to the Note entity by ids.
-func (m *UserMutation) AddNoteIDs(ids ...int) {
-	if m.notes == nil {
-		m.notes = make(map[int]struct{})
+// AddNoteRevisionIDs adds the "note_revisions" edge to the NoteRevision entity by ids.
+func (m *UserMutation) AddNoteRevisionIDs(ids ...int) {
+	if m.note_revisions == nil {
+		m.note_revisions = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.notes[ids[i]] = struct{}{}
+		m.note_revisions[ids[i]] = struct{}{}
 	}
 }
 
-// ClearNotes clears the "notes" edge to the Note entity.
-func (m *UserMutation) ClearNotes() {
-	m.clearednotes = true
+// ClearNoteRevisions clears the "note_revisions" edge to the NoteRevision entity.
+func (m *UserMutation) ClearNoteRevisions() {
+	m.clearednote_revisions = true
 }
 
-// NotesCleared reports if the "notes" edge to the Note entity was cleared.
-func (m *UserMutation) NotesCleared() bool {
-	return m.clearednotes
+// NoteRevisionsCleared reports if the "note_revisions" edge to the NoteRevision entity was cleared.
+func (m *UserMutation) NoteRevisionsCleared() bool {
+	return m.clearednote_revisions
 }
 
-// RemoveNoteIDs removes the "notes" edge to the Note entity by IDs.
-func (m *UserMutation) RemoveNoteIDs(ids ...int) {
-	if m.removednotes == nil {
-		m.removednotes = make(map[int]struct{})
+// RemoveNoteRevisionIDs removes the "note_revisions" edge to the NoteRevision entity by IDs.
+func (m *UserMutation) RemoveNoteRevisionIDs(ids ...int) {
+	if m.removednote_revisions == nil {
+		m.removednote_revisions = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.notes, ids[i])
-		m.removednotes[ids[i]] = struct{}{}
+		delete(m.note_revisions, ids[i])
+		m.removednote_revisions[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedNotes returns the removed IDs of the "notes" edge to the Note entity.
-func (m *UserMutation) RemovedNotesIDs() (ids []int) {
-	for id := range m.removednotes {
+// RemovedNoteRevisions returns the removed IDs of the "note_revisions" edge to the NoteRevision entity.
+func (m *UserMutation) RemovedNoteRevisionsIDs() (ids []int) {
+	for id := range m.removednote_revisions {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// NotesIDs returns the "notes" edge IDs in the mutation.
-func (m *UserMutation) NotesIDs() (ids []int) {
-	for id := range m.notes {
+// NoteRevisionsIDs returns the "note_revisions" edge IDs in the mutation.
+func (m *UserMutation) NoteRevisionsIDs() (ids []int) {
+	for id := range m.note_revisions {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetNotes resets all changes to the "notes" edge.
-func (m *UserMutation) ResetNotes() {
-	m.notes = nil
-	m.clearednotes = false
-	m.removednotes = nil
+// ResetNoteRevisions resets all changes to the "note_revisions" edge.
+func (m *UserMutation) ResetNoteRevisions() {
+	m.note_revisions = nil
+	m.clearednote_revisions = false
+	m.removednote_revisions = nil
 }
 
-// AddNoteLikeIDs adds the "note_likes" edge to the NoteLike entity by ids.
-func (m *UserMutation) AddNoteLikeIDs(ids ...int) {
-	if m.note_likes == nil {
-		m.note_likes = make(map[int]struct{})
+// AddNotebookIDs adds the "notebooks" edge to the Notebook entity by ids.
+func (m *UserMutation) AddNotebookIDs(ids ...int) {
+	if m.notebooks == nil {
+		m.notebooks = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.note_likes[ids[i]] = struct{}{}
+		m.notebooks[ids[i]] = struct{}{}
 	}
 }
 
-// ClearNoteLikes clears the "note_likes" edge to the NoteLike entity.
-func (m *UserMutation) ClearNoteLikes() {
-	m.clearednote_likes = true
+// ClearNotebooks clears the "notebooks" edge to the Notebook entity.
+func (m *UserMutation) ClearNotebooks() {
+	m.clearednotebooks = true
 }
 
-// NoteLikesCleared reports if the "note_likes" edge to the NoteLike entity was cleared.
-func (m *UserMutation) NoteLikesCleared() bool {
-	return m.clearednote_likes
+// NotebooksCleared reports if the "notebooks" edge to the Notebook entity was cleared.
+func (m *UserMutation) NotebooksCleared() bool {
+	return m.clearednotebooks
 }
 
-// RemoveNoteLikeIDs removes the "note_likes" edge to the NoteLike entity by IDs.
-func (m *UserMutation) RemoveNoteLikeIDs(ids ...int) {
-	if m.removednote_likes == nil {
-		m.removednote_likes = make(map[int]struct{})
+// RemoveNotebookIDs removes the "notebooks" edge to the Notebook entity by IDs.
+func (m *UserMutation) RemoveNotebookIDs(ids ...int) {
+	if m.removednotebooks == nil {
+		m.removednotebooks = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.note_likes, ids[i])
-		m.removednote_likes[ids[i]] = struct{}{}
+		delete(m.notebooks, ids[i])
+		m.removednotebooks[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedNoteLikes returns the removed IDs of the "note_likes" edge to the NoteLike entity.
-func (m *UserMutation) RemovedNoteLikesIDs() (ids []int) {
-	for id := range m.removednote_likes {
+// RemovedNotebooks returns the removed IDs of the "notebooks" edge to the Notebook entity.
+func (m *UserMutation) RemovedNotebooksIDs() (ids []int) {
+	for id := range m.removednotebooks {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// NoteLikesIDs returns the "note_likes" edge IDs in the mutation.
-func (m *UserMutation) NoteLikesIDs() (ids []int) {
-	for id := range m.note_likes {
+// NotebooksIDs returns the "notebooks" edge IDs in the mutation.
+func (m *UserMutation) NotebooksIDs() (ids []int) {
+	for id := range m.notebooks {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetNoteLikes resets all changes to the "note_likes" edge.
-func (m *UserMutation) ResetNoteLikes() {
-	m.note_likes = nil
-	m.clearednote_likes = false
-	m.removednote_likes = nil
+// ResetNotebooks resets all changes to the "notebooks" edge.
+func (m *UserMutation) ResetNotebooks() {
+	m.notebooks = nil
+	m.clearednotebooks = false
+	m.removednotebooks = nil
 }
 
-// AddNoteRepostIDs adds the "note_reposts" edge to the NoteRepost entity by ids.
-func (m *UserMutation) AddNoteRepostIDs(ids ...int) {
-	if m.note_reposts == nil {
-		m.note_reposts = make(map[int]struct{})
+// AddTagIDs adds the "tags" edge to the Tag entity by ids.
+func (m *UserMutation) AddTagIDs(ids ...int) {
+	if m.tags == nil {
+		m.tags = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.note_reposts[ids[i]] = struct{}{}
+		m.tags[ids[i]] = struct{}{}
 	}
 }
 
-// ClearNoteReposts clears the "note_reposts" edge to the NoteRepost entity.
-func (m *UserMutation) ClearNoteReposts() {
-	m.clearednote_reposts = true
+// ClearTags clears the "tags" edge to the Tag entity.
+func (m *UserMutation) ClearTags() {
+	m.clearedtags = true
 }
 
-// NoteRepostsCleared reports if the "note_reposts" edge to the NoteRepost entity was cleared.
-func (m *UserMutation) NoteRepostsCleared() bool {
-	return m.clearednote_reposts
+// TagsCleared reports if the "tags" edge to the Tag entity was cleared.
+func (m *UserMutation) TagsCleared() bool {
+	return m.clearedtags
 }
 
-// RemoveNoteRepostIDs removes the "note_reposts" edge to the NoteRepost entity by IDs.
-func (m *UserMutation) RemoveNoteRepostIDs(ids ...int) {
-	if m.removednote_reposts == nil {
-		m.removednote_reposts = make(map[int]struct{})
+// RemoveTagIDs removes the "tags" edge to the Tag entity by IDs.
+func (m *UserMutation) RemoveTagIDs(ids ...int) {
+	if m.removedtags == nil {
+		m.removedtags = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.note_reposts, ids[i])
-		m.removednote_reposts[ids[i]] = struct{}{}
+		delete(m.tags, ids[i])
+		m.removedtags[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedNoteReposts returns the removed IDs of the "note_reposts" edge to the NoteRepost entity.
-func (m *UserMutation) RemovedNoteRepostsIDs() (ids []int) {
-	for id := range m.removednote_reposts {
+// RemovedTags returns the removed IDs of the "tags" edge to the Tag entity.
+func (m *UserMutation) RemovedTagsIDs() (ids []int) {
+	for id := range m.removedtags {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// NoteRepostsIDs returns the "note_reposts" edge IDs in the mutation.
-func (m *UserMutation) NoteRepostsIDs() (ids []int) {
-	for id := range m.note_reposts {
+// TagsIDs returns the "tags" edge IDs in the mutation.
+func (m *UserMutation) TagsIDs() (ids []int) {
+	for id := range m.tags {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetNoteReposts resets all changes to the "note_reposts" edge.
-func (m *UserMutation) ResetNoteReposts() {
-	m.note_reposts = nil
-	m.clearednote_reposts = false
-	m.removednote_reposts = nil
+// ResetTags resets all changes to the "tags" edge.
+func (m *UserMutation) ResetTags() {
+	m.tags = nil
+	m.clearedtags = false
+	m.removedtags = nil
 }
 
 // Where appends a list predicates to the UserMutation builder.
@@ -3815,7 +20709,7 @@ func (m *UserMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 17)
+	fields := make([]string, 0, 19)
 	if m.name != nil {
 		fields = append(fields, user.FieldName)
 	}
@@ -3861,6 +20755,12 @@ func (m *UserMutation) Fields() []string {
 	if m.last_login != nil {
 		fields = append(fields, user.FieldLastLogin)
 	}
+	if m.storage_quota != nil {
+		fields = append(fields, user.FieldStorageQuota)
+	}
+	if m.storage_used != nil {
+		fields = append(fields, user.FieldStorageUsed)
+	}
 	if m.created_at != nil {
 		fields = append(fields, user.FieldCreatedAt)
 	}
@@ -3905,6 +20805,10 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 		return m.IsActive()
 	case user.FieldLastLogin:
 		return m.LastLogin()
+	case user.FieldStorageQuota:
+		return m.StorageQuota()
+	case user.FieldStorageUsed:
+		return m.StorageUsed()
 	case user.FieldCreatedAt:
 		return m.CreatedAt()
 	case user.FieldUpdatedAt:
@@ -3948,6 +20852,10 @@ func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, er
 		return m.OldIsActive(ctx)
 	case user.FieldLastLogin:
 		return m.OldLastLogin(ctx)
+	case user.FieldStorageQuota:
+		return m.OldStorageQuota(ctx)
+	case user.FieldStorageUsed:
+		return m.OldStorageUsed(ctx)
 	case user.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	case user.FieldUpdatedAt:
@@ -4066,6 +20974,20 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetLastLogin(v)
 		return nil
+	case user.FieldStorageQuota:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStorageQuota(v)
+		return nil
+	case user.FieldStorageUsed:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStorageUsed(v)
+		return nil
 	case user.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
@@ -4087,13 +21009,26 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
 func (m *UserMutation) AddedFields() []string {
-	return nil
+	var fields []string
+	if m.addstorage_quota != nil {
+		fields = append(fields, user.FieldStorageQuota)
+	}
+	if m.addstorage_used != nil {
+		fields = append(fields, user.FieldStorageUsed)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
 func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case user.FieldStorageQuota:
+		return m.AddedStorageQuota()
+	case user.FieldStorageUsed:
+		return m.AddedStorageUsed()
+	}
 	return nil, false
 }
 
@@ -4102,6 +21037,20 @@ func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
 // type.
 func (m *UserMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case user.FieldStorageQuota:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStorageQuota(v)
+		return nil
+	case user.FieldStorageUsed:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStorageUsed(v)
+		return nil
 	}
 	return fmt.Errorf("unknown User numeric field %s", name)
 }
@@ -4128,6 +21077,9 @@ func (m *UserMutation) ClearedFields() []string {
 	if m.FieldCleared(user.FieldLastLogin) {
 		fields = append(fields, user.FieldLastLogin)
 	}
+	if m.FieldCleared(user.FieldStorageQuota) {
+		fields = append(fields, user.FieldStorageQuota)
+	}
 	if m.FieldCleared(user.FieldUpdatedAt) {
 		fields = append(fields, user.FieldUpdatedAt)
 	}
@@ -4163,6 +21115,9 @@ func (m *UserMutation) ClearField(name string) error {
 	case user.FieldLastLogin:
 		m.ClearLastLogin()
 		return nil
+	case user.FieldStorageQuota:
+		m.ClearStorageQuota()
+		return nil
 	case user.FieldUpdatedAt:
 		m.ClearUpdatedAt()
 		return nil
@@ -4219,6 +21174,12 @@ func (m *UserMutation) ResetField(name string) error {
 	case user.FieldLastLogin:
 		m.ResetLastLogin()
 		return nil
+	case user.FieldStorageQuota:
+		m.ResetStorageQuota()
+		return nil
+	case user.FieldStorageUsed:
+		m.ResetStorageUsed()
+		return nil
 	case user.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
@@ -4231,7 +21192,7 @@ func (m *UserMutation) ResetField(name string) error {
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 4)
+	edges := make([]string, 0, 18)
 	if m.owner != nil {
 		edges = append(edges, user.EdgeOwner)
 	}
@@ -4244,6 +21205,48 @@ func (m *UserMutation) AddedEdges() []string {
 	if m.note_reposts != nil {
 		edges = append(edges, user.EdgeNoteReposts)
 	}
+	if m.quizzes != nil {
+		edges = append(edges, user.EdgeQuizzes)
+	}
+	if m.quiz_attempts != nil {
+		edges = append(edges, user.EdgeQuizAttempts)
+	}
+	if m.group_memberships != nil {
+		edges = append(edges, user.EdgeGroupMemberships)
+	}
+	if m.group_join_requests != nil {
+		edges = append(edges, user.EdgeGroupJoinRequests)
+	}
+	if m.group_invites != nil {
+		edges = append(edges, user.EdgeGroupInvites)
+	}
+	if m.study_events != nil {
+		edges = append(edges, user.EdgeStudyEvents)
+	}
+	if m.documents != nil {
+		edges = append(edges, user.EdgeDocuments)
+	}
+	if m.folders != nil {
+		edges = append(edges, user.EdgeFolders)
+	}
+	if m.blob_references != nil {
+		edges = append(edges, user.EdgeBlobReferences)
+	}
+	if m.resumable_uploads != nil {
+		edges = append(edges, user.EdgeResumableUploads)
+	}
+	if m.note_proposals != nil {
+		edges = append(edges, user.EdgeNoteProposals)
+	}
+	if m.note_revisions != nil {
+		edges = append(edges, user.EdgeNoteRevisions)
+	}
+	if m.notebooks != nil {
+		edges = append(edges, user.EdgeNotebooks)
+	}
+	if m.tags != nil {
+		edges = append(edges, user.EdgeTags)
+	}
 	return edges
 }
 
@@ -4275,13 +21278,97 @@ func (m *UserMutation) AddedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeQuizzes:
+		ids := make([]ent.Value, 0, len(m.quizzes))
+		for id := range m.quizzes {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeQuizAttempts:
+		ids := make([]ent.Value, 0, len(m.quiz_attempts))
+		for id := range m.quiz_attempts {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeGroupMemberships:
+		ids := make([]ent.Value, 0, len(m.group_memberships))
+		for id := range m.group_memberships {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeGroupJoinRequests:
+		ids := make([]ent.Value, 0, len(m.group_join_requests))
+		for id := range m.group_join_requests {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeGroupInvites:
+		ids := make([]ent.Value, 0, len(m.group_invites))
+		for id := range m.group_invites {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeStudyEvents:
+		ids := make([]ent.Value, 0, len(m.study_events))
+		for id := range m.study_events {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeDocuments:
+		ids := make([]ent.Value, 0, len(m.documents))
+		for id := range m.documents {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeFolders:
+		ids := make([]ent.Value, 0, len(m.folders))
+		for id := range m.folders {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeBlobReferences:
+		ids := make([]ent.Value, 0, len(m.blob_references))
+		for id := range m.blob_references {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeResumableUploads:
+		ids := make([]ent.Value, 0, len(m.resumable_uploads))
+		for id := range m.resumable_uploads {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeNoteProposals:
+		ids := make([]ent.Value, 0, len(m.note_proposals))
+		for id := range m.note_proposals {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeNoteRevisions:
+		ids := make([]ent.Value, 0, len(m.note_revisions))
+		for id := range m.note_revisions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeNotebooks:
+		ids := make([]ent.Value, 0, len(m.notebooks))
+		for id := range m.notebooks {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.tags))
+		for id := range m.tags {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 4)
+	edges := make([]string, 0, 18)
 	if m.removedowner != nil {
 		edges = append(edges, user.EdgeOwner)
 	}
@@ -4294,6 +21381,48 @@ func (m *UserMutation) RemovedEdges() []string {
 	if m.removednote_reposts != nil {
 		edges = append(edges, user.EdgeNoteReposts)
 	}
+	if m.removedquizzes != nil {
+		edges = append(edges, user.EdgeQuizzes)
+	}
+	if m.removedquiz_attempts != nil {
+		edges = append(edges, user.EdgeQuizAttempts)
+	}
+	if m.removedgroup_memberships != nil {
+		edges = append(edges, user.EdgeGroupMemberships)
+	}
+	if m.removedgroup_join_requests != nil {
+		edges = append(edges, user.EdgeGroupJoinRequests)
+	}
+	if m.removedgroup_invites != nil {
+		edges = append(edges, user.EdgeGroupInvites)
+	}
+	if m.removedstudy_events != nil {
+		edges = append(edges, user.EdgeStudyEvents)
+	}
+	if m.removeddocuments != nil {
+		edges = append(edges, user.EdgeDocuments)
+	}
+	if m.removedfolders != nil {
+		edges = append(edges, user.EdgeFolders)
+	}
+	if m.removedblob_references != nil {
+		edges = append(edges, user.EdgeBlobReferences)
+	}
+	if m.removedresumable_uploads != nil {
+		edges = append(edges, user.EdgeResumableUploads)
+	}
+	if m.removednote_proposals != nil {
+		edges = append(edges, user.EdgeNoteProposals)
+	}
+	if m.removednote_revisions != nil {
+		edges = append(edges, user.EdgeNoteRevisions)
+	}
+	if m.removednotebooks != nil {
+		edges = append(edges, user.EdgeNotebooks)
+	}
+	if m.removedtags != nil {
+		edges = append(edges, user.EdgeTags)
+	}
 	return edges
 }
 
@@ -4325,13 +21454,97 @@ func (m *UserMutation) RemovedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeQuizzes:
+		ids := make([]ent.Value, 0, len(m.removedquizzes))
+		for id := range m.removedquizzes {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeQuizAttempts:
+		ids := make([]ent.Value, 0, len(m.removedquiz_attempts))
+		for id := range m.removedquiz_attempts {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeGroupMemberships:
+		ids := make([]ent.Value, 0, len(m.removedgroup_memberships))
+		for id := range m.removedgroup_memberships {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeGroupJoinRequests:
+		ids := make([]ent.Value, 0, len(m.removedgroup_join_requests))
+		for id := range m.removedgroup_join_requests {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeGroupInvites:
+		ids := make([]ent.Value, 0, len(m.removedgroup_invites))
+		for id := range m.removedgroup_invites {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeStudyEvents:
+		ids := make([]ent.Value, 0, len(m.removedstudy_events))
+		for id := range m.removedstudy_events {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeDocuments:
+		ids := make([]ent.Value, 0, len(m.removeddocuments))
+		for id := range m.removeddocuments {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeFolders:
+		ids := make([]ent.Value, 0, len(m.removedfolders))
+		for id := range m.removedfolders {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeBlobReferences:
+		ids := make([]ent.Value, 0, len(m.removedblob_references))
+		for id := range m.removedblob_references {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeResumableUploads:
+		ids := make([]ent.Value, 0, len(m.removedresumable_uploads))
+		for id := range m.removedresumable_uploads {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeNoteProposals:
+		ids := make([]ent.Value, 0, len(m.removednote_proposals))
+		for id := range m.removednote_proposals {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeNoteRevisions:
+		ids := make([]ent.Value, 0, len(m.removednote_revisions))
+		for id := range m.removednote_revisions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeNotebooks:
+		ids := make([]ent.Value, 0, len(m.removednotebooks))
+		for id := range m.removednotebooks {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.removedtags))
+		for id := range m.removedtags {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 4)
+	edges := make([]string, 0, 18)
 	if m.clearedowner {
 		edges = append(edges, user.EdgeOwner)
 	}
@@ -4344,6 +21557,48 @@ func (m *UserMutation) ClearedEdges() []string {
 	if m.clearednote_reposts {
 		edges = append(edges, user.EdgeNoteReposts)
 	}
+	if m.clearedquizzes {
+		edges = append(edges, user.EdgeQuizzes)
+	}
+	if m.clearedquiz_attempts {
+		edges = append(edges, user.EdgeQuizAttempts)
+	}
+	if m.clearedgroup_memberships {
+		edges = append(edges, user.EdgeGroupMemberships)
+	}
+	if m.clearedgroup_join_requests {
+		edges = append(edges, user.EdgeGroupJoinRequests)
+	}
+	if m.clearedgroup_invites {
+		edges = append(edges, user.EdgeGroupInvites)
+	}
+	if m.clearedstudy_events {
+		edges = append(edges, user.EdgeStudyEvents)
+	}
+	if m.cleareddocuments {
+		edges = append(edges, user.EdgeDocuments)
+	}
+	if m.clearedfolders {
+		edges = append(edges, user.EdgeFolders)
+	}
+	if m.clearedblob_references {
+		edges = append(edges, user.EdgeBlobReferences)
+	}
+	if m.clearedresumable_uploads {
+		edges = append(edges, user.EdgeResumableUploads)
+	}
+	if m.clearednote_proposals {
+		edges = append(edges, user.EdgeNoteProposals)
+	}
+	if m.clearednote_revisions {
+		edges = append(edges, user.EdgeNoteRevisions)
+	}
+	if m.clearednotebooks {
+		edges = append(edges, user.EdgeNotebooks)
+	}
+	if m.clearedtags {
+		edges = append(edges, user.EdgeTags)
+	}
 	return edges
 }
 
@@ -4359,6 +21614,34 @@ func (m *UserMutation) EdgeCleared(name string) bool {
 		return m.clearednote_likes
 	case user.EdgeNoteReposts:
 		return m.clearednote_reposts
+	case user.EdgeQuizzes:
+		return m.clearedquizzes
+	case user.EdgeQuizAttempts:
+		return m.clearedquiz_attempts
+	case user.EdgeGroupMemberships:
+		return m.clearedgroup_memberships
+	case user.EdgeGroupJoinRequests:
+		return m.clearedgroup_join_requests
+	case user.EdgeGroupInvites:
+		return m.clearedgroup_invites
+	case user.EdgeStudyEvents:
+		return m.clearedstudy_events
+	case user.EdgeDocuments:
+		return m.cleareddocuments
+	case user.EdgeFolders:
+		return m.clearedfolders
+	case user.EdgeBlobReferences:
+		return m.clearedblob_references
+	case user.EdgeResumableUploads:
+		return m.clearedresumable_uploads
+	case user.EdgeNoteProposals:
+		return m.clearednote_proposals
+	case user.EdgeNoteRevisions:
+		return m.clearednote_revisions
+	case user.EdgeNotebooks:
+		return m.clearednotebooks
+	case user.EdgeTags:
+		return m.clearedtags
 	}
 	return false
 }
@@ -4387,6 +21670,48 @@ func (m *UserMutation) ResetEdge(name string) error {
 	case user.EdgeNoteReposts:
 		m.ResetNoteReposts()
 		return nil
+	case user.EdgeQuizzes:
+		m.ResetQuizzes()
+		return nil
+	case user.EdgeQuizAttempts:
+		m.ResetQuizAttempts()
+		return nil
+	case user.EdgeGroupMemberships:
+		m.ResetGroupMemberships()
+		return nil
+	case user.EdgeGroupJoinRequests:
+		m.ResetGroupJoinRequests()
+		return nil
+	case user.EdgeGroupInvites:
+		m.ResetGroupInvites()
+		return nil
+	case user.EdgeStudyEvents:
+		m.ResetStudyEvents()
+		return nil
+	case user.EdgeDocuments:
+		m.ResetDocuments()
+		return nil
+	case user.EdgeFolders:
+		m.ResetFolders()
+		return nil
+	case user.EdgeBlobReferences:
+		m.ResetBlobReferences()
+		return nil
+	case user.EdgeResumableUploads:
+		m.ResetResumableUploads()
+		return nil
+	case user.EdgeNoteProposals:
+		m.ResetNoteProposals()
+		return nil
+	case user.EdgeNoteRevisions:
+		m.ResetNoteRevisions()
+		return nil
+	case user.EdgeNotebooks:
+		m.ResetNotebooks()
+		return nil
+	case user.EdgeTags:
+		m.ResetTags()
+		return nil
 	}
 	return fmt.Errorf("unknown User edge %s", name)
 }
@@ -57,9 +57,29 @@ type NoteEdges struct {
 	Likes []*NoteLike `json:"likes,omitempty"`
 	// Reposts holds the value of the reposts edge.
 	Reposts []*NoteRepost `json:"reposts,omitempty"`
+	// PracticeSets holds the value of the practice_sets edge.
+	PracticeSets []*Quiz `json:"practice_sets,omitempty"`
+	// StudyEvents holds the value of the study_events edge.
+	StudyEvents []*StudyEvent `json:"study_events,omitempty"`
+	// Study groups the note is shared into
+	Groups []*StudyGroup `json:"groups,omitempty"`
+	// Library documents attached to the note
+	Documents []*Document `json:"documents,omitempty"`
+	// Changes proposed by other users, for notes that need the owner's approval
+	Proposals []*NoteProposal `json:"proposals,omitempty"`
+	// Snapshots of the note taken each time it's saved
+	Revisions []*NoteRevision `json:"revisions,omitempty"`
+	// Notebooks the note is filed in
+	Notebooks []*Notebook `json:"notebooks,omitempty"`
+	// Tags holds the value of the tags edge.
+	Tags []*Tag `json:"tags,omitempty"`
+	// Wiki links written in the note's content
+	Links []*NoteLink `json:"links,omitempty"`
+	// Wiki links in other notes that point to this one
+	Backlinks []*NoteLink `json:"backlinks,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [13]bool
 }
 
 // OwnerOrErr returns the Owner value or an error if the edge
@@ -91,6 +111,96 @@ func (e NoteEdges) RepostsOrErr() ([]*NoteRepost, error) {
 	return nil, &NotLoadedError{edge: "reposts"}
 }
 
+// PracticeSetsOrErr returns the PracticeSets value or an error if the edge
+// was not loaded in eager-loading.
+func (e NoteEdges) PracticeSetsOrErr() ([]*Quiz, error) {
+	if e.loadedTypes[3] {
+		return e.PracticeSets, nil
+	}
+	return nil, &NotLoadedError{edge: "practice_sets"}
+}
+
+// StudyEventsOrErr returns the StudyEvents value or an error if the edge
+// was not loaded in eager-loading.
+func (e NoteEdges) StudyEventsOrErr() ([]*StudyEvent, error) {
+	if e.loadedTypes[4] {
+		return e.StudyEvents, nil
+	}
+	return nil, &NotLoadedError{edge: "study_events"}
+}
+
+// GroupsOrErr returns the Groups value or an error if the edge
+// was not loaded in eager-loading.
+func (e NoteEdges) GroupsOrErr() ([]*StudyGroup, error) {
+	if e.loadedTypes[5] {
+		return e.Groups, nil
+	}
+	return nil, &NotLoadedError{edge: "groups"}
+}
+
+// DocumentsOrErr returns the Documents value or an error if the edge
+// was not loaded in eager-loading.
+func (e NoteEdges) DocumentsOrErr() ([]*Document, error) {
+	if e.loadedTypes[6] {
+		return e.Documents, nil
+	}
+	return nil, &NotLoadedError{edge: "documents"}
+}
+
+// ProposalsOrErr returns the Proposals value or an error if the edge
+// was not loaded in eager-loading.
+func (e NoteEdges) ProposalsOrErr() ([]*NoteProposal, error) {
+	if e.loadedTypes[7] {
+		return e.Proposals, nil
+	}
+	return nil, &NotLoadedError{edge: "proposals"}
+}
+
+// RevisionsOrErr returns the Revisions value or an error if the edge
+// was not loaded in eager-loading.
+func (e NoteEdges) RevisionsOrErr() ([]*NoteRevision, error) {
+	if e.loadedTypes[8] {
+		return e.Revisions, nil
+	}
+	return nil, &NotLoadedError{edge: "revisions"}
+}
+
+// NotebooksOrErr returns the Notebooks value or an error if the edge
+// was not loaded in eager-loading.
+func (e NoteEdges) NotebooksOrErr() ([]*Notebook, error) {
+	if e.loadedTypes[9] {
+		return e.Notebooks, nil
+	}
+	return nil, &NotLoadedError{edge: "notebooks"}
+}
+
+// TagsOrErr returns the Tags value or an error if the edge
+// was not loaded in eager-loading.
+func (e NoteEdges) TagsOrErr() ([]*Tag, error) {
+	if e.loadedTypes[10] {
+		return e.Tags, nil
+	}
+	return nil, &NotLoadedError{edge: "tags"}
+}
+
+// LinksOrErr returns the Links value or an error if the edge
+// was not loaded in eager-loading.
+func (e NoteEdges) LinksOrErr() ([]*NoteLink, error) {
+	if e.loadedTypes[11] {
+		return e.Links, nil
+	}
+	return nil, &NotLoadedError{edge: "links"}
+}
+
+// BacklinksOrErr returns the Backlinks value or an error if the edge
+// was not loaded in eager-loading.
+func (e NoteEdges) BacklinksOrErr() ([]*NoteLink, error) {
+	if e.loadedTypes[12] {
+		return e.Backlinks, nil
+	}
+	return nil, &NotLoadedError{edge: "backlinks"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Note) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -232,6 +342,56 @@ func (n *Note) QueryReposts() *NoteRepostQuery {
 	return NewNoteClient(n.config).QueryReposts(n)
 }
 
+// QueryPracticeSets queries the "practice_sets" edge of the Note entity.
+func (n *Note) QueryPracticeSets() *QuizQuery {
+	return NewNoteClient(n.config).QueryPracticeSets(n)
+}
+
+// QueryStudyEvents queries the "study_events" edge of the Note entity.
+func (n *Note) QueryStudyEvents() *StudyEventQuery {
+	return NewNoteClient(n.config).QueryStudyEvents(n)
+}
+
+// QueryGroups queries the "groups" edge of the Note entity.
+func (n *Note) QueryGroups() *StudyGroupQuery {
+	return NewNoteClient(n.config).QueryGroups(n)
+}
+
+// QueryDocuments queries the "documents" edge of the Note entity.
+func (n *Note) QueryDocuments() *DocumentQuery {
+	return NewNoteClient(n.config).QueryDocuments(n)
+}
+
+// QueryProposals queries the "proposals" edge of the Note entity.
+func (n *Note) QueryProposals() *NoteProposalQuery {
+	return NewNoteClient(n.config).QueryProposals(n)
+}
+
+// QueryRevisions queries the "revisions" edge of the Note entity.
+func (n *Note) QueryRevisions() *NoteRevisionQuery {
+	return NewNoteClient(n.config).QueryRevisions(n)
+}
+
+// QueryNotebooks queries the "notebooks" edge of the Note entity.
+func (n *Note) QueryNotebooks() *NotebookQuery {
+	return NewNoteClient(n.config).QueryNotebooks(n)
+}
+
+// QueryTags queries the "tags" edge of the Note entity.
+func (n *Note) QueryTags() *TagQuery {
+	return NewNoteClient(n.config).QueryTags(n)
+}
+
+// QueryLinks queries the "links" edge of the Note entity.
+func (n *Note) QueryLinks() *NoteLinkQuery {
+	return NewNoteClient(n.config).QueryLinks(n)
+}
+
+// QueryBacklinks queries the "backlinks" edge of the Note entity.
+func (n *Note) QueryBacklinks() *NoteLinkQuery {
+	return NewNoteClient(n.config).QueryBacklinks(n)
+}
+
 // Update returns a builder for updating this Note.
 // Note that you need to call Note.Unwrap() before calling this method if this Note
 // was returned from a transaction, and the transaction was committed or rolled back.
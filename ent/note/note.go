@@ -43,6 +43,26 @@ const (
 	EdgeLikes = "likes"
 	// EdgeReposts holds the string denoting the reposts edge name in mutations.
 	EdgeReposts = "reposts"
+	// EdgePracticeSets holds the string denoting the practice_sets edge name in mutations.
+	EdgePracticeSets = "practice_sets"
+	// EdgeStudyEvents holds the string denoting the study_events edge name in mutations.
+	EdgeStudyEvents = "study_events"
+	// EdgeGroups holds the string denoting the groups edge name in mutations.
+	EdgeGroups = "groups"
+	// EdgeDocuments holds the string denoting the documents edge name in mutations.
+	EdgeDocuments = "documents"
+	// EdgeProposals holds the string denoting the proposals edge name in mutations.
+	EdgeProposals = "proposals"
+	// EdgeRevisions holds the string denoting the revisions edge name in mutations.
+	EdgeRevisions = "revisions"
+	// EdgeNotebooks holds the string denoting the notebooks edge name in mutations.
+	EdgeNotebooks = "notebooks"
+	// EdgeTags holds the string denoting the tags edge name in mutations.
+	EdgeTags = "tags"
+	// EdgeLinks holds the string denoting the links edge name in mutations.
+	EdgeLinks = "links"
+	// EdgeBacklinks holds the string denoting the backlinks edge name in mutations.
+	EdgeBacklinks = "backlinks"
 	// Table holds the table name of the note in the database.
 	Table = "notes"
 	// OwnerTable is the table that holds the owner relation/edge.
@@ -66,6 +86,68 @@ const (
 	RepostsInverseTable = "note_reposts"
 	// RepostsColumn is the table column denoting the reposts relation/edge.
 	RepostsColumn = "note_reposts"
+	// PracticeSetsTable is the table that holds the practice_sets relation/edge.
+	PracticeSetsTable = "quizs"
+	// PracticeSetsInverseTable is the table name for the Quiz entity.
+	// It exists in this package in order to avoid circular dependency with the "quiz" package.
+	PracticeSetsInverseTable = "quizs"
+	// PracticeSetsColumn is the table column denoting the practice_sets relation/edge.
+	PracticeSetsColumn = "note_practice_sets"
+	// StudyEventsTable is the table that holds the study_events relation/edge.
+	StudyEventsTable = "study_events"
+	// StudyEventsInverseTable is the table name for the StudyEvent entity.
+	// It exists in this package in order to avoid circular dependency with the "studyevent" package.
+	StudyEventsInverseTable = "study_events"
+	// StudyEventsColumn is the table column denoting the study_events relation/edge.
+	StudyEventsColumn = "note_study_events"
+	// GroupsTable is the table that holds the groups relation/edge. The primary key declared below.
+	GroupsTable = "study_group_shared_notes"
+	// GroupsInverseTable is the table name for the StudyGroup entity.
+	// It exists in this package in order to avoid circular dependency with the "studygroup" package.
+	GroupsInverseTable = "study_groups"
+	// DocumentsTable is the table that holds the documents relation/edge. The primary key declared below.
+	DocumentsTable = "document_notes"
+	// DocumentsInverseTable is the table name for the Document entity.
+	// It exists in this package in order to avoid circular dependency with the "document" package.
+	DocumentsInverseTable = "documents"
+	// ProposalsTable is the table that holds the proposals relation/edge.
+	ProposalsTable = "note_proposals"
+	// ProposalsInverseTable is the table name for the NoteProposal entity.
+	// It exists in this package in order to avoid circular dependency with the "noteproposal" package.
+	ProposalsInverseTable = "note_proposals"
+	// ProposalsColumn is the table column denoting the proposals relation/edge.
+	ProposalsColumn = "note_proposals"
+	// RevisionsTable is the table that holds the revisions relation/edge.
+	RevisionsTable = "note_revisions"
+	// RevisionsInverseTable is the table name for the NoteRevision entity.
+	// It exists in this package in order to avoid circular dependency with the "noterevision" package.
+	RevisionsInverseTable = "note_revisions"
+	// RevisionsColumn is the table column denoting the revisions relation/edge.
+	RevisionsColumn = "note_revisions"
+	// NotebooksTable is the table that holds the notebooks relation/edge. The primary key declared below.
+	NotebooksTable = "notebook_notes"
+	// NotebooksInverseTable is the table name for the Notebook entity.
+	// It exists in this package in order to avoid circular dependency with the "notebook" package.
+	NotebooksInverseTable = "notebooks"
+	// TagsTable is the table that holds the tags relation/edge. The primary key declared below.
+	TagsTable = "tag_notes"
+	// TagsInverseTable is the table name for the Tag entity.
+	// It exists in this package in order to avoid circular dependency with the "tag" package.
+	TagsInverseTable = "tags"
+	// LinksTable is the table that holds the links relation/edge.
+	LinksTable = "note_links"
+	// LinksInverseTable is the table name for the NoteLink entity.
+	// It exists in this package in order to avoid circular dependency with the "notelink" package.
+	LinksInverseTable = "note_links"
+	// LinksColumn is the table column denoting the links relation/edge.
+	LinksColumn = "note_links"
+	// BacklinksTable is the table that holds the backlinks relation/edge.
+	BacklinksTable = "note_links"
+	// BacklinksInverseTable is the table name for the NoteLink entity.
+	// It exists in this package in order to avoid circular dependency with the "notelink" package.
+	BacklinksInverseTable = "note_links"
+	// BacklinksColumn is the table column denoting the backlinks relation/edge.
+	BacklinksColumn = "note_backlinks"
 )
 
 // Columns holds all SQL columns for note fields.
@@ -90,6 +172,21 @@ var ForeignKeys = []string{
 	"user_notes",
 }
 
+var (
+	// GroupsPrimaryKey and GroupsColumn2 are the table columns denoting the
+	// primary key for the groups relation (M2M).
+	GroupsPrimaryKey = []string{"study_group_id", "note_id"}
+	// DocumentsPrimaryKey and DocumentsColumn2 are the table columns denoting the
+	// primary key for the documents relation (M2M).
+	DocumentsPrimaryKey = []string{"document_id", "note_id"}
+	// NotebooksPrimaryKey and NotebooksColumn2 are the table columns denoting the
+	// primary key for the notebooks relation (M2M).
+	NotebooksPrimaryKey = []string{"notebook_id", "note_id"}
+	// TagsPrimaryKey and TagsColumn2 are the table columns denoting the
+	// primary key for the tags relation (M2M).
+	TagsPrimaryKey = []string{"tag_id", "note_id"}
+)
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -263,6 +360,146 @@ func ByReposts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newRepostsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByPracticeSetsCount orders the results by practice_sets count.
+func ByPracticeSetsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPracticeSetsStep(), opts...)
+	}
+}
+
+// ByPracticeSets orders the results by practice_sets terms.
+func ByPracticeSets(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPracticeSetsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByStudyEventsCount orders the results by study_events count.
+func ByStudyEventsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newStudyEventsStep(), opts...)
+	}
+}
+
+// ByStudyEvents orders the results by study_events terms.
+func ByStudyEvents(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStudyEventsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByGroupsCount orders the results by groups count.
+func ByGroupsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newGroupsStep(), opts...)
+	}
+}
+
+// ByGroups orders the results by groups terms.
+func ByGroups(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newGroupsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByDocumentsCount orders the results by documents count.
+func ByDocumentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDocumentsStep(), opts...)
+	}
+}
+
+// ByDocuments orders the results by documents terms.
+func ByDocuments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDocumentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByProposalsCount orders the results by proposals count.
+func ByProposalsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newProposalsStep(), opts...)
+	}
+}
+
+// ByProposals orders the results by proposals terms.
+func ByProposals(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newProposalsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRevisionsCount orders the results by revisions count.
+func ByRevisionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRevisionsStep(), opts...)
+	}
+}
+
+// ByRevisions orders the results by revisions terms.
+func ByRevisions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRevisionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByNotebooksCount orders the results by notebooks count.
+func ByNotebooksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newNotebooksStep(), opts...)
+	}
+}
+
+// ByNotebooks orders the results by notebooks terms.
+func ByNotebooks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newNotebooksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTagsCount orders the results by tags count.
+func ByTagsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTagsStep(), opts...)
+	}
+}
+
+// ByTags orders the results by tags terms.
+func ByTags(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTagsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByLinksCount orders the results by links count.
+func ByLinksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLinksStep(), opts...)
+	}
+}
+
+// ByLinks orders the results by links terms.
+func ByLinks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLinksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByBacklinksCount orders the results by backlinks count.
+func ByBacklinksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBacklinksStep(), opts...)
+	}
+}
+
+// ByBacklinks orders the results by backlinks terms.
+func ByBacklinks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBacklinksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newOwnerStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -284,3 +521,73 @@ func newRepostsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, RepostsTable, RepostsColumn),
 	)
 }
+func newPracticeSetsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PracticeSetsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PracticeSetsTable, PracticeSetsColumn),
+	)
+}
+func newStudyEventsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StudyEventsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, StudyEventsTable, StudyEventsColumn),
+	)
+}
+func newGroupsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(GroupsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, GroupsTable, GroupsPrimaryKey...),
+	)
+}
+func newDocumentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DocumentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, DocumentsTable, DocumentsPrimaryKey...),
+	)
+}
+func newProposalsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ProposalsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ProposalsTable, ProposalsColumn),
+	)
+}
+func newRevisionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RevisionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RevisionsTable, RevisionsColumn),
+	)
+}
+func newNotebooksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(NotebooksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, NotebooksTable, NotebooksPrimaryKey...),
+	)
+}
+func newTagsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TagsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, TagsTable, TagsPrimaryKey...),
+	)
+}
+func newLinksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LinksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, LinksTable, LinksColumn),
+	)
+}
+func newBacklinksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BacklinksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, BacklinksTable, BacklinksColumn),
+	)
+}
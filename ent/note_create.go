@@ -10,9 +10,18 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/r-scheele/zero/ent/document"
 	"github.com/r-scheele/zero/ent/note"
+	"github.com/r-scheele/zero/ent/notebook"
 	"github.com/r-scheele/zero/ent/notelike"
+	"github.com/r-scheele/zero/ent/notelink"
+	"github.com/r-scheele/zero/ent/noteproposal"
 	"github.com/r-scheele/zero/ent/noterepost"
+	"github.com/r-scheele/zero/ent/noterevision"
+	"github.com/r-scheele/zero/ent/quiz"
+	"github.com/r-scheele/zero/ent/studyevent"
+	"github.com/r-scheele/zero/ent/studygroup"
+	"github.com/r-scheele/zero/ent/tag"
 	"github.com/r-scheele/zero/ent/user"
 	"github.com/r-scheele/zero/pkg/types"
 )
@@ -203,6 +212,156 @@ func (nc *NoteCreate) AddReposts(n ...*NoteRepost) *NoteCreate {
 	return nc.AddRepostIDs(ids...)
 }
 
+// AddPracticeSetIDs adds the "practice_sets" edge to the Quiz entity by IDs.
+func (nc *NoteCreate) AddPracticeSetIDs(ids ...int) *NoteCreate {
+	nc.mutation.AddPracticeSetIDs(ids...)
+	return nc
+}
+
+// AddPracticeSets adds the "practice_sets" edges to the Quiz entity.
+func (nc *NoteCreate) AddPracticeSets(q ...*Quiz) *NoteCreate {
+	ids := make([]int, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return nc.AddPracticeSetIDs(ids...)
+}
+
+// AddStudyEventIDs adds the "study_events" edge to the StudyEvent entity by IDs.
+func (nc *NoteCreate) AddStudyEventIDs(ids ...int) *NoteCreate {
+	nc.mutation.AddStudyEventIDs(ids...)
+	return nc
+}
+
+// AddStudyEvents adds the "study_events" edges to the StudyEvent entity.
+func (nc *NoteCreate) AddStudyEvents(s ...*StudyEvent) *NoteCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return nc.AddStudyEventIDs(ids...)
+}
+
+// AddGroupIDs adds the "groups" edge to the StudyGroup entity by IDs.
+func (nc *NoteCreate) AddGroupIDs(ids ...int) *NoteCreate {
+	nc.mutation.AddGroupIDs(ids...)
+	return nc
+}
+
+// AddGroups adds the "groups" edges to the StudyGroup entity.
+func (nc *NoteCreate) AddGroups(s ...*StudyGroup) *NoteCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return nc.AddGroupIDs(ids...)
+}
+
+// AddDocumentIDs adds the "documents" edge to the Document entity by IDs.
+func (nc *NoteCreate) AddDocumentIDs(ids ...int) *NoteCreate {
+	nc.mutation.AddDocumentIDs(ids...)
+	return nc
+}
+
+// AddDocuments adds the "documents" edges to the Document entity.
+func (nc *NoteCreate) AddDocuments(d ...*Document) *NoteCreate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return nc.AddDocumentIDs(ids...)
+}
+
+// AddProposalIDs adds the "proposals" edge to the NoteProposal entity by IDs.
+func (nc *NoteCreate) AddProposalIDs(ids ...int) *NoteCreate {
+	nc.mutation.AddProposalIDs(ids...)
+	return nc
+}
+
+// AddProposals adds the "proposals" edges to the NoteProposal entity.
+func (nc *NoteCreate) AddProposals(n ...*NoteProposal) *NoteCreate {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return nc.AddProposalIDs(ids...)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the NoteRevision entity by IDs.
+func (nc *NoteCreate) AddRevisionIDs(ids ...int) *NoteCreate {
+	nc.mutation.AddRevisionIDs(ids...)
+	return nc
+}
+
+// AddRevisions adds the "revisions" edges to the NoteRevision entity.
+func (nc *NoteCreate) AddRevisions(n ...*NoteRevision) *NoteCreate {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return nc.AddRevisionIDs(ids...)
+}
+
+// AddNotebookIDs adds the "notebooks" edge to the Notebook entity by IDs.
+func (nc *NoteCreate) AddNotebookIDs(ids ...int) *NoteCreate {
+	nc.mutation.AddNotebookIDs(ids...)
+	return nc
+}
+
+// AddNotebooks adds the "notebooks" edges to the Notebook entity.
+func (nc *NoteCreate) AddNotebooks(n ...*Notebook) *NoteCreate {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return nc.AddNotebookIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (nc *NoteCreate) AddTagIDs(ids ...int) *NoteCreate {
+	nc.mutation.AddTagIDs(ids...)
+	return nc
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (nc *NoteCreate) AddTags(t ...*Tag) *NoteCreate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return nc.AddTagIDs(ids...)
+}
+
+// AddLinkIDs adds the "links" edge to the NoteLink entity by IDs.
+func (nc *NoteCreate) AddLinkIDs(ids ...int) *NoteCreate {
+	nc.mutation.AddLinkIDs(ids...)
+	return nc
+}
+
+// AddLinks adds the "links" edges to the NoteLink entity.
+func (nc *NoteCreate) AddLinks(n ...*NoteLink) *NoteCreate {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return nc.AddLinkIDs(ids...)
+}
+
+// AddBacklinkIDs adds the "backlinks" edge to the NoteLink entity by IDs.
+func (nc *NoteCreate) AddBacklinkIDs(ids ...int) *NoteCreate {
+	nc.mutation.AddBacklinkIDs(ids...)
+	return nc
+}
+
+// AddBacklinks adds the "backlinks" edges to the NoteLink entity.
+func (nc *NoteCreate) AddBacklinks(n ...*NoteLink) *NoteCreate {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return nc.AddBacklinkIDs(ids...)
+}
+
 // Mutation returns the NoteMutation object of the builder.
 func (nc *NoteCreate) Mutation() *NoteMutation {
 	return nc.mutation
@@ -417,6 +576,166 @@ func (nc *NoteCreate) createSpec() (*Note, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := nc.mutation.PracticeSetsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   note.PracticeSetsTable,
+			Columns: []string{note.PracticeSetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quiz.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := nc.mutation.StudyEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   note.StudyEventsTable,
+			Columns: []string{note.StudyEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(studyevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := nc.mutation.GroupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   note.GroupsTable,
+			Columns: note.GroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(studygroup.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := nc.mutation.DocumentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   note.DocumentsTable,
+			Columns: note.DocumentsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(document.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := nc.mutation.ProposalsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   note.ProposalsTable,
+			Columns: []string{note.ProposalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(noteproposal.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := nc.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   note.RevisionsTable,
+			Columns: []string{note.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(noterevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := nc.mutation.NotebooksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   note.NotebooksTable,
+			Columns: note.NotebooksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notebook.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := nc.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   note.TagsTable,
+			Columns: note.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := nc.mutation.LinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   note.LinksTable,
+			Columns: []string{note.LinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notelink.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := nc.mutation.BacklinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   note.BacklinksTable,
+			Columns: []string{note.BacklinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notelink.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -12,24 +12,43 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/r-scheele/zero/ent/document"
 	"github.com/r-scheele/zero/ent/note"
+	"github.com/r-scheele/zero/ent/notebook"
 	"github.com/r-scheele/zero/ent/notelike"
+	"github.com/r-scheele/zero/ent/notelink"
+	"github.com/r-scheele/zero/ent/noteproposal"
 	"github.com/r-scheele/zero/ent/noterepost"
+	"github.com/r-scheele/zero/ent/noterevision"
 	"github.com/r-scheele/zero/ent/predicate"
+	"github.com/r-scheele/zero/ent/quiz"
+	"github.com/r-scheele/zero/ent/studyevent"
+	"github.com/r-scheele/zero/ent/studygroup"
+	"github.com/r-scheele/zero/ent/tag"
 	"github.com/r-scheele/zero/ent/user"
 )
 
 // NoteQuery is the builder for querying Note entities.
 type NoteQuery struct {
 	config
-	ctx         *QueryContext
-	order       []note.OrderOption
-	inters      []Interceptor
-	predicates  []predicate.Note
-	withOwner   *UserQuery
-	withLikes   *NoteLikeQuery
-	withReposts *NoteRepostQuery
-	withFKs     bool
+	ctx              *QueryContext
+	order            []note.OrderOption
+	inters           []Interceptor
+	predicates       []predicate.Note
+	withOwner        *UserQuery
+	withLikes        *NoteLikeQuery
+	withReposts      *NoteRepostQuery
+	withPracticeSets *QuizQuery
+	withStudyEvents  *StudyEventQuery
+	withGroups       *StudyGroupQuery
+	withDocuments    *DocumentQuery
+	withProposals    *NoteProposalQuery
+	withRevisions    *NoteRevisionQuery
+	withNotebooks    *NotebookQuery
+	withTags         *TagQuery
+	withLinks        *NoteLinkQuery
+	withBacklinks    *NoteLinkQuery
+	withFKs          bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -132,6 +151,226 @@ func (nq *NoteQuery) QueryReposts() *NoteRepostQuery {
 	return query
 }
 
+// QueryPracticeSets chains the current query on the "practice_sets" edge.
+func (nq *NoteQuery) QueryPracticeSets() *QuizQuery {
+	query := (&QuizClient{config: nq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := nq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := nq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(note.Table, note.FieldID, selector),
+			sqlgraph.To(quiz.Table, quiz.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, note.PracticeSetsTable, note.PracticeSetsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(nq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryStudyEvents chains the current query on the "study_events" edge.
+func (nq *NoteQuery) QueryStudyEvents() *StudyEventQuery {
+	query := (&StudyEventClient{config: nq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := nq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := nq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(note.Table, note.FieldID, selector),
+			sqlgraph.To(studyevent.Table, studyevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, note.StudyEventsTable, note.StudyEventsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(nq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryGroups chains the current query on the "groups" edge.
+func (nq *NoteQuery) QueryGroups() *StudyGroupQuery {
+	query := (&StudyGroupClient{config: nq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := nq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := nq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(note.Table, note.FieldID, selector),
+			sqlgraph.To(studygroup.Table, studygroup.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, note.GroupsTable, note.GroupsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(nq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryDocuments chains the current query on the "documents" edge.
+func (nq *NoteQuery) QueryDocuments() *DocumentQuery {
+	query := (&DocumentClient{config: nq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := nq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := nq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(note.Table, note.FieldID, selector),
+			sqlgraph.To(document.Table, document.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, note.DocumentsTable, note.DocumentsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(nq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryProposals chains the current query on the "proposals" edge.
+func (nq *NoteQuery) QueryProposals() *NoteProposalQuery {
+	query := (&NoteProposalClient{config: nq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := nq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := nq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(note.Table, note.FieldID, selector),
+			sqlgraph.To(noteproposal.Table, noteproposal.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, note.ProposalsTable, note.ProposalsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(nq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRevisions chains the current query on the "revisions" edge.
+func (nq *NoteQuery) QueryRevisions() *NoteRevisionQuery {
+	query := (&NoteRevisionClient{config: nq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := nq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := nq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(note.Table, note.FieldID, selector),
+			sqlgraph.To(noterevision.Table, noterevision.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, note.RevisionsTable, note.RevisionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(nq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryNotebooks chains the current query on the "notebooks" edge.
+func (nq *NoteQuery) QueryNotebooks() *NotebookQuery {
+	query := (&NotebookClient{config: nq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := nq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := nq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(note.Table, note.FieldID, selector),
+			sqlgraph.To(notebook.Table, notebook.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, note.NotebooksTable, note.NotebooksPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(nq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTags chains the current query on the "tags" edge.
+func (nq *NoteQuery) QueryTags() *TagQuery {
+	query := (&TagClient{config: nq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := nq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := nq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(note.Table, note.FieldID, selector),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, note.TagsTable, note.TagsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(nq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryLinks chains the current query on the "links" edge.
+func (nq *NoteQuery) QueryLinks() *NoteLinkQuery {
+	query := (&NoteLinkClient{config: nq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := nq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := nq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(note.Table, note.FieldID, selector),
+			sqlgraph.To(notelink.Table, notelink.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, note.LinksTable, note.LinksColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(nq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBacklinks chains the current query on the "backlinks" edge.
+func (nq *NoteQuery) QueryBacklinks() *NoteLinkQuery {
+	query := (&NoteLinkClient{config: nq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := nq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := nq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(note.Table, note.FieldID, selector),
+			sqlgraph.To(notelink.Table, notelink.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, note.BacklinksTable, note.BacklinksColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(nq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Note entity from the query.
 // Returns a *NotFoundError when no Note was found.
 func (nq *NoteQuery) First(ctx context.Context) (*Note, error) {
@@ -319,14 +558,24 @@ func (nq *NoteQuery) Clone() *NoteQuery {
 		return nil
 	}
 	return &NoteQuery{
-		config:      nq.config,
-		ctx:         nq.ctx.Clone(),
-		order:       append([]note.OrderOption{}, nq.order...),
-		inters:      append([]Interceptor{}, nq.inters...),
-		predicates:  append([]predicate.Note{}, nq.predicates...),
-		withOwner:   nq.withOwner.Clone(),
-		withLikes:   nq.withLikes.Clone(),
-		withReposts: nq.withReposts.Clone(),
+		config:           nq.config,
+		ctx:              nq.ctx.Clone(),
+		order:            append([]note.OrderOption{}, nq.order...),
+		inters:           append([]Interceptor{}, nq.inters...),
+		predicates:       append([]predicate.Note{}, nq.predicates...),
+		withOwner:        nq.withOwner.Clone(),
+		withLikes:        nq.withLikes.Clone(),
+		withReposts:      nq.withReposts.Clone(),
+		withPracticeSets: nq.withPracticeSets.Clone(),
+		withStudyEvents:  nq.withStudyEvents.Clone(),
+		withGroups:       nq.withGroups.Clone(),
+		withDocuments:    nq.withDocuments.Clone(),
+		withProposals:    nq.withProposals.Clone(),
+		withRevisions:    nq.withRevisions.Clone(),
+		withNotebooks:    nq.withNotebooks.Clone(),
+		withTags:         nq.withTags.Clone(),
+		withLinks:        nq.withLinks.Clone(),
+		withBacklinks:    nq.withBacklinks.Clone(),
 		// clone intermediate query.
 		sql:  nq.sql.Clone(),
 		path: nq.path,
@@ -366,6 +615,116 @@ func (nq *NoteQuery) WithReposts(opts ...func(*NoteRepostQuery)) *NoteQuery {
 	return nq
 }
 
+// WithPracticeSets tells the query-builder to eager-load the nodes that are connected to
+// the "practice_sets" edge. The optional arguments are used to configure the query builder of the edge.
+func (nq *NoteQuery) WithPracticeSets(opts ...func(*QuizQuery)) *NoteQuery {
+	query := (&QuizClient{config: nq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	nq.withPracticeSets = query
+	return nq
+}
+
+// WithStudyEvents tells the query-builder to eager-load the nodes that are connected to
+// the "study_events" edge. The optional arguments are used to configure the query builder of the edge.
+func (nq *NoteQuery) WithStudyEvents(opts ...func(*StudyEventQuery)) *NoteQuery {
+	query := (&StudyEventClient{config: nq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	nq.withStudyEvents = query
+	return nq
+}
+
+// WithGroups tells the query-builder to eager-load the nodes that are connected to
+// the "groups" edge. The optional arguments are used to configure the query builder of the edge.
+func (nq *NoteQuery) WithGroups(opts ...func(*StudyGroupQuery)) *NoteQuery {
+	query := (&StudyGroupClient{config: nq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	nq.withGroups = query
+	return nq
+}
+
+// WithDocuments tells the query-builder to eager-load the nodes that are connected to
+// the "documents" edge. The optional arguments are used to configure the query builder of the edge.
+func (nq *NoteQuery) WithDocuments(opts ...func(*DocumentQuery)) *NoteQuery {
+	query := (&DocumentClient{config: nq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	nq.withDocuments = query
+	return nq
+}
+
+// WithProposals tells the query-builder to eager-load the nodes that are connected to
+// the "proposals" edge. The optional arguments are used to configure the query builder of the edge.
+func (nq *NoteQuery) WithProposals(opts ...func(*NoteProposalQuery)) *NoteQuery {
+	query := (&NoteProposalClient{config: nq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	nq.withProposals = query
+	return nq
+}
+
+// WithRevisions tells the query-builder to eager-load the nodes that are connected to
+// the "revisions" edge. The optional arguments are used to configure the query builder of the edge.
+func (nq *NoteQuery) WithRevisions(opts ...func(*NoteRevisionQuery)) *NoteQuery {
+	query := (&NoteRevisionClient{config: nq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	nq.withRevisions = query
+	return nq
+}
+
+// WithNotebooks tells the query-builder to eager-load the nodes that are connected to
+// the "notebooks" edge. The optional arguments are used to configure the query builder of the edge.
+func (nq *NoteQuery) WithNotebooks(opts ...func(*NotebookQuery)) *NoteQuery {
+	query := (&NotebookClient{config: nq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	nq.withNotebooks = query
+	return nq
+}
+
+// WithTags tells the query-builder to eager-load the nodes that are connected to
+// the "tags" edge. The optional arguments are used to configure the query builder of the edge.
+func (nq *NoteQuery) WithTags(opts ...func(*TagQuery)) *NoteQuery {
+	query := (&TagClient{config: nq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	nq.withTags = query
+	return nq
+}
+
+// WithLinks tells the query-builder to eager-load the nodes that are connected to
+// the "links" edge. The optional arguments are used to configure the query builder of the edge.
+func (nq *NoteQuery) WithLinks(opts ...func(*NoteLinkQuery)) *NoteQuery {
+	query := (&NoteLinkClient{config: nq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	nq.withLinks = query
+	return nq
+}
+
+// WithBacklinks tells the query-builder to eager-load the nodes that are connected to
+// the "backlinks" edge. The optional arguments are used to configure the query builder of the edge.
+func (nq *NoteQuery) WithBacklinks(opts ...func(*NoteLinkQuery)) *NoteQuery {
+	query := (&NoteLinkClient{config: nq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	nq.withBacklinks = query
+	return nq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -445,10 +804,20 @@ func (nq *NoteQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Note, e
 		nodes       = []*Note{}
 		withFKs     = nq.withFKs
 		_spec       = nq.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [13]bool{
 			nq.withOwner != nil,
 			nq.withLikes != nil,
 			nq.withReposts != nil,
+			nq.withPracticeSets != nil,
+			nq.withStudyEvents != nil,
+			nq.withGroups != nil,
+			nq.withDocuments != nil,
+			nq.withProposals != nil,
+			nq.withRevisions != nil,
+			nq.withNotebooks != nil,
+			nq.withTags != nil,
+			nq.withLinks != nil,
+			nq.withBacklinks != nil,
 		}
 	)
 	if nq.withOwner != nil {
@@ -495,6 +864,76 @@ func (nq *NoteQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Note, e
 			return nil, err
 		}
 	}
+	if query := nq.withPracticeSets; query != nil {
+		if err := nq.loadPracticeSets(ctx, query, nodes,
+			func(n *Note) { n.Edges.PracticeSets = []*Quiz{} },
+			func(n *Note, e *Quiz) { n.Edges.PracticeSets = append(n.Edges.PracticeSets, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := nq.withStudyEvents; query != nil {
+		if err := nq.loadStudyEvents(ctx, query, nodes,
+			func(n *Note) { n.Edges.StudyEvents = []*StudyEvent{} },
+			func(n *Note, e *StudyEvent) { n.Edges.StudyEvents = append(n.Edges.StudyEvents, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := nq.withGroups; query != nil {
+		if err := nq.loadGroups(ctx, query, nodes,
+			func(n *Note) { n.Edges.Groups = []*StudyGroup{} },
+			func(n *Note, e *StudyGroup) { n.Edges.Groups = append(n.Edges.Groups, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := nq.withDocuments; query != nil {
+		if err := nq.loadDocuments(ctx, query, nodes,
+			func(n *Note) { n.Edges.Documents = []*Document{} },
+			func(n *Note, e *Document) { n.Edges.Documents = append(n.Edges.Documents, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := nq.withProposals; query != nil {
+		if err := nq.loadProposals(ctx, query, nodes,
+			func(n *Note) { n.Edges.Proposals = []*NoteProposal{} },
+			func(n *Note, e *NoteProposal) { n.Edges.Proposals = append(n.Edges.Proposals, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := nq.withRevisions; query != nil {
+		if err := nq.loadRevisions(ctx, query, nodes,
+			func(n *Note) { n.Edges.Revisions = []*NoteRevision{} },
+			func(n *Note, e *NoteRevision) { n.Edges.Revisions = append(n.Edges.Revisions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := nq.withNotebooks; query != nil {
+		if err := nq.loadNotebooks(ctx, query, nodes,
+			func(n *Note) { n.Edges.Notebooks = []*Notebook{} },
+			func(n *Note, e *Notebook) { n.Edges.Notebooks = append(n.Edges.Notebooks, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := nq.withTags; query != nil {
+		if err := nq.loadTags(ctx, query, nodes,
+			func(n *Note) { n.Edges.Tags = []*Tag{} },
+			func(n *Note, e *Tag) { n.Edges.Tags = append(n.Edges.Tags, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := nq.withLinks; query != nil {
+		if err := nq.loadLinks(ctx, query, nodes,
+			func(n *Note) { n.Edges.Links = []*NoteLink{} },
+			func(n *Note, e *NoteLink) { n.Edges.Links = append(n.Edges.Links, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := nq.withBacklinks; query != nil {
+		if err := nq.loadBacklinks(ctx, query, nodes,
+			func(n *Note) { n.Edges.Backlinks = []*NoteLink{} },
+			func(n *Note, e *NoteLink) { n.Edges.Backlinks = append(n.Edges.Backlinks, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -592,6 +1031,436 @@ func (nq *NoteQuery) loadReposts(ctx context.Context, query *NoteRepostQuery, no
 	}
 	return nil
 }
+func (nq *NoteQuery) loadPracticeSets(ctx context.Context, query *QuizQuery, nodes []*Note, init func(*Note), assign func(*Note, *Quiz)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Note)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Quiz(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(note.PracticeSetsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.note_practice_sets
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "note_practice_sets" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "note_practice_sets" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (nq *NoteQuery) loadStudyEvents(ctx context.Context, query *StudyEventQuery, nodes []*Note, init func(*Note), assign func(*Note, *StudyEvent)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Note)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.StudyEvent(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(note.StudyEventsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.note_study_events
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "note_study_events" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "note_study_events" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (nq *NoteQuery) loadGroups(ctx context.Context, query *StudyGroupQuery, nodes []*Note, init func(*Note), assign func(*Note, *StudyGroup)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Note)
+	nids := make(map[int]map[*Note]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(note.GroupsTable)
+		s.Join(joinT).On(s.C(studygroup.FieldID), joinT.C(note.GroupsPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(note.GroupsPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(note.GroupsPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Note]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*StudyGroup](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "groups" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (nq *NoteQuery) loadDocuments(ctx context.Context, query *DocumentQuery, nodes []*Note, init func(*Note), assign func(*Note, *Document)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Note)
+	nids := make(map[int]map[*Note]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(note.DocumentsTable)
+		s.Join(joinT).On(s.C(document.FieldID), joinT.C(note.DocumentsPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(note.DocumentsPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(note.DocumentsPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Note]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Document](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "documents" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (nq *NoteQuery) loadProposals(ctx context.Context, query *NoteProposalQuery, nodes []*Note, init func(*Note), assign func(*Note, *NoteProposal)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Note)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.NoteProposal(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(note.ProposalsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.note_proposals
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "note_proposals" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "note_proposals" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (nq *NoteQuery) loadRevisions(ctx context.Context, query *NoteRevisionQuery, nodes []*Note, init func(*Note), assign func(*Note, *NoteRevision)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Note)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.NoteRevision(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(note.RevisionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.note_revisions
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "note_revisions" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "note_revisions" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (nq *NoteQuery) loadNotebooks(ctx context.Context, query *NotebookQuery, nodes []*Note, init func(*Note), assign func(*Note, *Notebook)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Note)
+	nids := make(map[int]map[*Note]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(note.NotebooksTable)
+		s.Join(joinT).On(s.C(notebook.FieldID), joinT.C(note.NotebooksPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(note.NotebooksPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(note.NotebooksPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Note]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Notebook](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "notebooks" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (nq *NoteQuery) loadTags(ctx context.Context, query *TagQuery, nodes []*Note, init func(*Note), assign func(*Note, *Tag)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Note)
+	nids := make(map[int]map[*Note]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(note.TagsTable)
+		s.Join(joinT).On(s.C(tag.FieldID), joinT.C(note.TagsPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(note.TagsPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(note.TagsPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Note]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Tag](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "tags" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (nq *NoteQuery) loadLinks(ctx context.Context, query *NoteLinkQuery, nodes []*Note, init func(*Note), assign func(*Note, *NoteLink)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Note)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.NoteLink(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(note.LinksColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.note_links
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "note_links" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "note_links" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (nq *NoteQuery) loadBacklinks(ctx context.Context, query *NoteLinkQuery, nodes []*Note, init func(*Note), assign func(*Note, *NoteLink)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Note)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.NoteLink(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(note.BacklinksColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.note_backlinks
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "note_backlinks" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "note_backlinks" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (nq *NoteQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := nq.querySpec()
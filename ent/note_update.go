@@ -12,10 +12,19 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
+	"github.com/r-scheele/zero/ent/document"
 	"github.com/r-scheele/zero/ent/note"
+	"github.com/r-scheele/zero/ent/notebook"
 	"github.com/r-scheele/zero/ent/notelike"
+	"github.com/r-scheele/zero/ent/notelink"
+	"github.com/r-scheele/zero/ent/noteproposal"
 	"github.com/r-scheele/zero/ent/noterepost"
+	"github.com/r-scheele/zero/ent/noterevision"
 	"github.com/r-scheele/zero/ent/predicate"
+	"github.com/r-scheele/zero/ent/quiz"
+	"github.com/r-scheele/zero/ent/studyevent"
+	"github.com/r-scheele/zero/ent/studygroup"
+	"github.com/r-scheele/zero/ent/tag"
 	"github.com/r-scheele/zero/ent/user"
 	"github.com/r-scheele/zero/pkg/types"
 )
@@ -234,6 +243,156 @@ func (nu *NoteUpdate) AddReposts(n ...*NoteRepost) *NoteUpdate {
 	return nu.AddRepostIDs(ids...)
 }
 
+// AddPracticeSetIDs adds the "practice_sets" edge to the Quiz entity by IDs.
+func (nu *NoteUpdate) AddPracticeSetIDs(ids ...int) *NoteUpdate {
+	nu.mutation.AddPracticeSetIDs(ids...)
+	return nu
+}
+
+// AddPracticeSets adds the "practice_sets" edges to the Quiz entity.
+func (nu *NoteUpdate) AddPracticeSets(q ...*Quiz) *NoteUpdate {
+	ids := make([]int, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return nu.AddPracticeSetIDs(ids...)
+}
+
+// AddStudyEventIDs adds the "study_events" edge to the StudyEvent entity by IDs.
+func (nu *NoteUpdate) AddStudyEventIDs(ids ...int) *NoteUpdate {
+	nu.mutation.AddStudyEventIDs(ids...)
+	return nu
+}
+
+// AddStudyEvents adds the "study_events" edges to the StudyEvent entity.
+func (nu *NoteUpdate) AddStudyEvents(s ...*StudyEvent) *NoteUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return nu.AddStudyEventIDs(ids...)
+}
+
+// AddGroupIDs adds the "groups" edge to the StudyGroup entity by IDs.
+func (nu *NoteUpdate) AddGroupIDs(ids ...int) *NoteUpdate {
+	nu.mutation.AddGroupIDs(ids...)
+	return nu
+}
+
+// AddGroups adds the "groups" edges to the StudyGroup entity.
+func (nu *NoteUpdate) AddGroups(s ...*StudyGroup) *NoteUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return nu.AddGroupIDs(ids...)
+}
+
+// AddDocumentIDs adds the "documents" edge to the Document entity by IDs.
+func (nu *NoteUpdate) AddDocumentIDs(ids ...int) *NoteUpdate {
+	nu.mutation.AddDocumentIDs(ids...)
+	return nu
+}
+
+// AddDocuments adds the "documents" edges to the Document entity.
+func (nu *NoteUpdate) AddDocuments(d ...*Document) *NoteUpdate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return nu.AddDocumentIDs(ids...)
+}
+
+// AddProposalIDs adds the "proposals" edge to the NoteProposal entity by IDs.
+func (nu *NoteUpdate) AddProposalIDs(ids ...int) *NoteUpdate {
+	nu.mutation.AddProposalIDs(ids...)
+	return nu
+}
+
+// AddProposals adds the "proposals" edges to the NoteProposal entity.
+func (nu *NoteUpdate) AddProposals(n ...*NoteProposal) *NoteUpdate {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return nu.AddProposalIDs(ids...)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the NoteRevision entity by IDs.
+func (nu *NoteUpdate) AddRevisionIDs(ids ...int) *NoteUpdate {
+	nu.mutation.AddRevisionIDs(ids...)
+	return nu
+}
+
+// AddRevisions adds the "revisions" edges to the NoteRevision entity.
+func (nu *NoteUpdate) AddRevisions(n ...*NoteRevision) *NoteUpdate {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return nu.AddRevisionIDs(ids...)
+}
+
+// AddNotebookIDs adds the "notebooks" edge to the Notebook entity by IDs.
+func (nu *NoteUpdate) AddNotebookIDs(ids ...int) *NoteUpdate {
+	nu.mutation.AddNotebookIDs(ids...)
+	return nu
+}
+
+// AddNotebooks adds the "notebooks" edges to the Notebook entity.
+func (nu *NoteUpdate) AddNotebooks(n ...*Notebook) *NoteUpdate {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return nu.AddNotebookIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (nu *NoteUpdate) AddTagIDs(ids ...int) *NoteUpdate {
+	nu.mutation.AddTagIDs(ids...)
+	return nu
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (nu *NoteUpdate) AddTags(t ...*Tag) *NoteUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return nu.AddTagIDs(ids...)
+}
+
+// AddLinkIDs adds the "links" edge to the NoteLink entity by IDs.
+func (nu *NoteUpdate) AddLinkIDs(ids ...int) *NoteUpdate {
+	nu.mutation.AddLinkIDs(ids...)
+	return nu
+}
+
+// AddLinks adds the "links" edges to the NoteLink entity.
+func (nu *NoteUpdate) AddLinks(n ...*NoteLink) *NoteUpdate {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return nu.AddLinkIDs(ids...)
+}
+
+// AddBacklinkIDs adds the "backlinks" edge to the NoteLink entity by IDs.
+func (nu *NoteUpdate) AddBacklinkIDs(ids ...int) *NoteUpdate {
+	nu.mutation.AddBacklinkIDs(ids...)
+	return nu
+}
+
+// AddBacklinks adds the "backlinks" edges to the NoteLink entity.
+func (nu *NoteUpdate) AddBacklinks(n ...*NoteLink) *NoteUpdate {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return nu.AddBacklinkIDs(ids...)
+}
+
 // Mutation returns the NoteMutation object of the builder.
 func (nu *NoteUpdate) Mutation() *NoteMutation {
 	return nu.mutation
@@ -287,6 +446,216 @@ func (nu *NoteUpdate) RemoveReposts(n ...*NoteRepost) *NoteUpdate {
 	return nu.RemoveRepostIDs(ids...)
 }
 
+// ClearPracticeSets clears all "practice_sets" edges to the Quiz entity.
+func (nu *NoteUpdate) ClearPracticeSets() *NoteUpdate {
+	nu.mutation.ClearPracticeSets()
+	return nu
+}
+
+// RemovePracticeSetIDs removes the "practice_sets" edge to Quiz entities by IDs.
+func (nu *NoteUpdate) RemovePracticeSetIDs(ids ...int) *NoteUpdate {
+	nu.mutation.RemovePracticeSetIDs(ids...)
+	return nu
+}
+
+// RemovePracticeSets removes "practice_sets" edges to Quiz entities.
+func (nu *NoteUpdate) RemovePracticeSets(q ...*Quiz) *NoteUpdate {
+	ids := make([]int, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return nu.RemovePracticeSetIDs(ids...)
+}
+
+// ClearStudyEvents clears all "study_events" edges to the StudyEvent entity.
+func (nu *NoteUpdate) ClearStudyEvents() *NoteUpdate {
+	nu.mutation.ClearStudyEvents()
+	return nu
+}
+
+// RemoveStudyEventIDs removes the "study_events" edge to StudyEvent entities by IDs.
+func (nu *NoteUpdate) RemoveStudyEventIDs(ids ...int) *NoteUpdate {
+	nu.mutation.RemoveStudyEventIDs(ids...)
+	return nu
+}
+
+// RemoveStudyEvents removes "study_events" edges to StudyEvent entities.
+func (nu *NoteUpdate) RemoveStudyEvents(s ...*StudyEvent) *NoteUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return nu.RemoveStudyEventIDs(ids...)
+}
+
+// ClearGroups clears all "groups" edges to the StudyGroup entity.
+func (nu *NoteUpdate) ClearGroups() *NoteUpdate {
+	nu.mutation.ClearGroups()
+	return nu
+}
+
+// RemoveGroupIDs removes the "groups" edge to StudyGroup entities by IDs.
+func (nu *NoteUpdate) RemoveGroupIDs(ids ...int) *NoteUpdate {
+	nu.mutation.RemoveGroupIDs(ids...)
+	return nu
+}
+
+// RemoveGroups removes "groups" edges to StudyGroup entities.
+func (nu *NoteUpdate) RemoveGroups(s ...*StudyGroup) *NoteUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return nu.RemoveGroupIDs(ids...)
+}
+
+// ClearDocuments clears all "documents" edges to the Document entity.
+func (nu *NoteUpdate) ClearDocuments() *NoteUpdate {
+	nu.mutation.ClearDocuments()
+	return nu
+}
+
+// RemoveDocumentIDs removes the "documents" edge to Document entities by IDs.
+func (nu *NoteUpdate) RemoveDocumentIDs(ids ...int) *NoteUpdate {
+	nu.mutation.RemoveDocumentIDs(ids...)
+	return nu
+}
+
+// RemoveDocuments removes "documents" edges to Document entities.
+func (nu *NoteUpdate) RemoveDocuments(d ...*Document) *NoteUpdate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return nu.RemoveDocumentIDs(ids...)
+}
+
+// ClearProposals clears all "proposals" edges to the NoteProposal entity.
+func (nu *NoteUpdate) ClearProposals() *NoteUpdate {
+	nu.mutation.ClearProposals()
+	return nu
+}
+
+// RemoveProposalIDs removes the "proposals" edge to NoteProposal entities by IDs.
+func (nu *NoteUpdate) RemoveProposalIDs(ids ...int) *NoteUpdate {
+	nu.mutation.RemoveProposalIDs(ids...)
+	return nu
+}
+
+// RemoveProposals removes "proposals" edges to NoteProposal entities.
+func (nu *NoteUpdate) RemoveProposals(n ...*NoteProposal) *NoteUpdate {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return nu.RemoveProposalIDs(ids...)
+}
+
+// ClearRevisions clears all "revisions" edges to the NoteRevision entity.
+func (nu *NoteUpdate) ClearRevisions() *NoteUpdate {
+	nu.mutation.ClearRevisions()
+	return nu
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to NoteRevision entities by IDs.
+func (nu *NoteUpdate) RemoveRevisionIDs(ids ...int) *NoteUpdate {
+	nu.mutation.RemoveRevisionIDs(ids...)
+	return nu
+}
+
+// RemoveRevisions removes "revisions" edges to NoteRevision entities.
+func (nu *NoteUpdate) RemoveRevisions(n ...*NoteRevision) *NoteUpdate {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return nu.RemoveRevisionIDs(ids...)
+}
+
+// ClearNotebooks clears all "notebooks" edges to the Notebook entity.
+func (nu *NoteUpdate) ClearNotebooks() *NoteUpdate {
+	nu.mutation.ClearNotebooks()
+	return nu
+}
+
+// RemoveNotebookIDs removes the "notebooks" edge to Notebook entities by IDs.
+func (nu *NoteUpdate) RemoveNotebookIDs(ids ...int) *NoteUpdate {
+	nu.mutation.RemoveNotebookIDs(ids...)
+	return nu
+}
+
+// RemoveNotebooks removes "notebooks" edges to Notebook entities.
+func (nu *NoteUpdate) RemoveNotebooks(n ...*Notebook) *NoteUpdate {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return nu.RemoveNotebookIDs(ids...)
+}
+
+// ClearTags clears all "tags" edges to the Tag entity.
+func (nu *NoteUpdate) ClearTags() *NoteUpdate {
+	nu.mutation.ClearTags()
+	return nu
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (nu *NoteUpdate) RemoveTagIDs(ids ...int) *NoteUpdate {
+	nu.mutation.RemoveTagIDs(ids...)
+	return nu
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (nu *NoteUpdate) RemoveTags(t ...*Tag) *NoteUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return nu.RemoveTagIDs(ids...)
+}
+
+// ClearLinks clears all "links" edges to the NoteLink entity.
+func (nu *NoteUpdate) ClearLinks() *NoteUpdate {
+	nu.mutation.ClearLinks()
+	return nu
+}
+
+// RemoveLinkIDs removes the "links" edge to NoteLink entities by IDs.
+func (nu *NoteUpdate) RemoveLinkIDs(ids ...int) *NoteUpdate {
+	nu.mutation.RemoveLinkIDs(ids...)
+	return nu
+}
+
+// RemoveLinks removes "links" edges to NoteLink entities.
+func (nu *NoteUpdate) RemoveLinks(n ...*NoteLink) *NoteUpdate {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return nu.RemoveLinkIDs(ids...)
+}
+
+// ClearBacklinks clears all "backlinks" edges to the NoteLink entity.
+func (nu *NoteUpdate) ClearBacklinks() *NoteUpdate {
+	nu.mutation.ClearBacklinks()
+	return nu
+}
+
+// RemoveBacklinkIDs removes the "backlinks" edge to NoteLink entities by IDs.
+func (nu *NoteUpdate) RemoveBacklinkIDs(ids ...int) *NoteUpdate {
+	nu.mutation.RemoveBacklinkIDs(ids...)
+	return nu
+}
+
+// RemoveBacklinks removes "backlinks" edges to NoteLink entities.
+func (nu *NoteUpdate) RemoveBacklinks(n ...*NoteLink) *NoteUpdate {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return nu.RemoveBacklinkIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (nu *NoteUpdate) Save(ctx context.Context) (int, error) {
 	nu.defaults()
@@ -527,60 +896,510 @@ func (nu *NoteUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, nu.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{note.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if nu.mutation.PracticeSetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   note.PracticeSetsTable,
+			Columns: []string{note.PracticeSetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quiz.FieldID, field.TypeInt),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	nu.mutation.done = true
-	return n, nil
-}
-
-// NoteUpdateOne is the builder for updating a single Note entity.
-type NoteUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *NoteMutation
-}
-
-// SetTitle sets the "title" field.
-func (nuo *NoteUpdateOne) SetTitle(s string) *NoteUpdateOne {
-	nuo.mutation.SetTitle(s)
-	return nuo
-}
-
-// SetNillableTitle sets the "title" field if the given value is not nil.
-func (nuo *NoteUpdateOne) SetNillableTitle(s *string) *NoteUpdateOne {
-	if s != nil {
-		nuo.SetTitle(*s)
+	if nodes := nu.mutation.RemovedPracticeSetsIDs(); len(nodes) > 0 && !nu.mutation.PracticeSetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   note.PracticeSetsTable,
+			Columns: []string{note.PracticeSetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quiz.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return nuo
-}
-
-// SetDescription sets the "description" field.
-func (nuo *NoteUpdateOne) SetDescription(s string) *NoteUpdateOne {
-	nuo.mutation.SetDescription(s)
-	return nuo
-}
-
-// SetNillableDescription sets the "description" field if the given value is not nil.
-func (nuo *NoteUpdateOne) SetNillableDescription(s *string) *NoteUpdateOne {
-	if s != nil {
-		nuo.SetDescription(*s)
+	if nodes := nu.mutation.PracticeSetsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   note.PracticeSetsTable,
+			Columns: []string{note.PracticeSetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quiz.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if nu.mutation.StudyEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   note.StudyEventsTable,
+			Columns: []string{note.StudyEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(studyevent.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nu.mutation.RemovedStudyEventsIDs(); len(nodes) > 0 && !nu.mutation.StudyEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   note.StudyEventsTable,
+			Columns: []string{note.StudyEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(studyevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nu.mutation.StudyEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   note.StudyEventsTable,
+			Columns: []string{note.StudyEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(studyevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nu.mutation.GroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   note.GroupsTable,
+			Columns: note.GroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(studygroup.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nu.mutation.RemovedGroupsIDs(); len(nodes) > 0 && !nu.mutation.GroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   note.GroupsTable,
+			Columns: note.GroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(studygroup.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nu.mutation.GroupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   note.GroupsTable,
+			Columns: note.GroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(studygroup.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nu.mutation.DocumentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   note.DocumentsTable,
+			Columns: note.DocumentsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(document.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nu.mutation.RemovedDocumentsIDs(); len(nodes) > 0 && !nu.mutation.DocumentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   note.DocumentsTable,
+			Columns: note.DocumentsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(document.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nu.mutation.DocumentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   note.DocumentsTable,
+			Columns: note.DocumentsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(document.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nu.mutation.ProposalsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   note.ProposalsTable,
+			Columns: []string{note.ProposalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(noteproposal.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nu.mutation.RemovedProposalsIDs(); len(nodes) > 0 && !nu.mutation.ProposalsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   note.ProposalsTable,
+			Columns: []string{note.ProposalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(noteproposal.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nu.mutation.ProposalsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   note.ProposalsTable,
+			Columns: []string{note.ProposalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(noteproposal.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nu.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   note.RevisionsTable,
+			Columns: []string{note.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(noterevision.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nu.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !nu.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   note.RevisionsTable,
+			Columns: []string{note.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(noterevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nu.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   note.RevisionsTable,
+			Columns: []string{note.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(noterevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nu.mutation.NotebooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   note.NotebooksTable,
+			Columns: note.NotebooksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notebook.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nu.mutation.RemovedNotebooksIDs(); len(nodes) > 0 && !nu.mutation.NotebooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   note.NotebooksTable,
+			Columns: note.NotebooksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notebook.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nu.mutation.NotebooksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   note.NotebooksTable,
+			Columns: note.NotebooksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notebook.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nu.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   note.TagsTable,
+			Columns: note.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nu.mutation.RemovedTagsIDs(); len(nodes) > 0 && !nu.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   note.TagsTable,
+			Columns: note.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nu.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   note.TagsTable,
+			Columns: note.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nu.mutation.LinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   note.LinksTable,
+			Columns: []string{note.LinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notelink.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nu.mutation.RemovedLinksIDs(); len(nodes) > 0 && !nu.mutation.LinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   note.LinksTable,
+			Columns: []string{note.LinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notelink.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nu.mutation.LinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   note.LinksTable,
+			Columns: []string{note.LinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notelink.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nu.mutation.BacklinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   note.BacklinksTable,
+			Columns: []string{note.BacklinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notelink.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nu.mutation.RemovedBacklinksIDs(); len(nodes) > 0 && !nu.mutation.BacklinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   note.BacklinksTable,
+			Columns: []string{note.BacklinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notelink.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nu.mutation.BacklinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   note.BacklinksTable,
+			Columns: []string{note.BacklinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notelink.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, nu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{note.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	nu.mutation.done = true
+	return n, nil
+}
+
+// NoteUpdateOne is the builder for updating a single Note entity.
+type NoteUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *NoteMutation
+}
+
+// SetTitle sets the "title" field.
+func (nuo *NoteUpdateOne) SetTitle(s string) *NoteUpdateOne {
+	nuo.mutation.SetTitle(s)
+	return nuo
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (nuo *NoteUpdateOne) SetNillableTitle(s *string) *NoteUpdateOne {
+	if s != nil {
+		nuo.SetTitle(*s)
+	}
+	return nuo
+}
+
+// SetDescription sets the "description" field.
+func (nuo *NoteUpdateOne) SetDescription(s string) *NoteUpdateOne {
+	nuo.mutation.SetDescription(s)
+	return nuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (nuo *NoteUpdateOne) SetNillableDescription(s *string) *NoteUpdateOne {
+	if s != nil {
+		nuo.SetDescription(*s)
+	}
+	return nuo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (nuo *NoteUpdateOne) ClearDescription() *NoteUpdateOne {
+	nuo.mutation.ClearDescription()
 	return nuo
 }
 
-// ClearDescription clears the value of the "description" field.
-func (nuo *NoteUpdateOne) ClearDescription() *NoteUpdateOne {
-	nuo.mutation.ClearDescription()
-	return nuo
-}
-
 // SetContent sets the "content" field.
 func (nuo *NoteUpdateOne) SetContent(s string) *NoteUpdateOne {
 	nuo.mutation.SetContent(s)
@@ -748,57 +1567,417 @@ func (nuo *NoteUpdateOne) AddReposts(n ...*NoteRepost) *NoteUpdateOne {
 	return nuo.AddRepostIDs(ids...)
 }
 
+// AddPracticeSetIDs adds the "practice_sets" edge to the Quiz entity by IDs.
+func (nuo *NoteUpdateOne) AddPracticeSetIDs(ids ...int) *NoteUpdateOne {
+	nuo.mutation.AddPracticeSetIDs(ids...)
+	return nuo
+}
+
+// AddPracticeSets adds the "practice_sets" edges to the Quiz entity.
+func (nuo *NoteUpdateOne) AddPracticeSets(q ...*Quiz) *NoteUpdateOne {
+	ids := make([]int, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return nuo.AddPracticeSetIDs(ids...)
+}
+
+// AddStudyEventIDs adds the "study_events" edge to the StudyEvent entity by IDs.
+func (nuo *NoteUpdateOne) AddStudyEventIDs(ids ...int) *NoteUpdateOne {
+	nuo.mutation.AddStudyEventIDs(ids...)
+	return nuo
+}
+
+// AddStudyEvents adds the "study_events" edges to the StudyEvent entity.
+func (nuo *NoteUpdateOne) AddStudyEvents(s ...*StudyEvent) *NoteUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return nuo.AddStudyEventIDs(ids...)
+}
+
+// AddGroupIDs adds the "groups" edge to the StudyGroup entity by IDs.
+func (nuo *NoteUpdateOne) AddGroupIDs(ids ...int) *NoteUpdateOne {
+	nuo.mutation.AddGroupIDs(ids...)
+	return nuo
+}
+
+// AddGroups adds the "groups" edges to the StudyGroup entity.
+func (nuo *NoteUpdateOne) AddGroups(s ...*StudyGroup) *NoteUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return nuo.AddGroupIDs(ids...)
+}
+
+// AddDocumentIDs adds the "documents" edge to the Document entity by IDs.
+func (nuo *NoteUpdateOne) AddDocumentIDs(ids ...int) *NoteUpdateOne {
+	nuo.mutation.AddDocumentIDs(ids...)
+	return nuo
+}
+
+// AddDocuments adds the "documents" edges to the Document entity.
+func (nuo *NoteUpdateOne) AddDocuments(d ...*Document) *NoteUpdateOne {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return nuo.AddDocumentIDs(ids...)
+}
+
+// AddProposalIDs adds the "proposals" edge to the NoteProposal entity by IDs.
+func (nuo *NoteUpdateOne) AddProposalIDs(ids ...int) *NoteUpdateOne {
+	nuo.mutation.AddProposalIDs(ids...)
+	return nuo
+}
+
+// AddProposals adds the "proposals" edges to the NoteProposal entity.
+func (nuo *NoteUpdateOne) AddProposals(n ...*NoteProposal) *NoteUpdateOne {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return nuo.AddProposalIDs(ids...)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the NoteRevision entity by IDs.
+func (nuo *NoteUpdateOne) AddRevisionIDs(ids ...int) *NoteUpdateOne {
+	nuo.mutation.AddRevisionIDs(ids...)
+	return nuo
+}
+
+// AddRevisions adds the "revisions" edges to the NoteRevision entity.
+func (nuo *NoteUpdateOne) AddRevisions(n ...*NoteRevision) *NoteUpdateOne {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return nuo.AddRevisionIDs(ids...)
+}
+
+// AddNotebookIDs adds the "notebooks" edge to the Notebook entity by IDs.
+func (nuo *NoteUpdateOne) AddNotebookIDs(ids ...int) *NoteUpdateOne {
+	nuo.mutation.AddNotebookIDs(ids...)
+	return nuo
+}
+
+// AddNotebooks adds the "notebooks" edges to the Notebook entity.
+func (nuo *NoteUpdateOne) AddNotebooks(n ...*Notebook) *NoteUpdateOne {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return nuo.AddNotebookIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (nuo *NoteUpdateOne) AddTagIDs(ids ...int) *NoteUpdateOne {
+	nuo.mutation.AddTagIDs(ids...)
+	return nuo
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (nuo *NoteUpdateOne) AddTags(t ...*Tag) *NoteUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return nuo.AddTagIDs(ids...)
+}
+
+// AddLinkIDs adds the "links" edge to the NoteLink entity by IDs.
+func (nuo *NoteUpdateOne) AddLinkIDs(ids ...int) *NoteUpdateOne {
+	nuo.mutation.AddLinkIDs(ids...)
+	return nuo
+}
+
+// AddLinks adds the "links" edges to the NoteLink entity.
+func (nuo *NoteUpdateOne) AddLinks(n ...*NoteLink) *NoteUpdateOne {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return nuo.AddLinkIDs(ids...)
+}
+
+// AddBacklinkIDs adds the "backlinks" edge to the NoteLink entity by IDs.
+func (nuo *NoteUpdateOne) AddBacklinkIDs(ids ...int) *NoteUpdateOne {
+	nuo.mutation.AddBacklinkIDs(ids...)
+	return nuo
+}
+
+// AddBacklinks adds the "backlinks" edges to the NoteLink entity.
+func (nuo *NoteUpdateOne) AddBacklinks(n ...*NoteLink) *NoteUpdateOne {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return nuo.AddBacklinkIDs(ids...)
+}
+
 // Mutation returns the NoteMutation object of the builder.
 func (nuo *NoteUpdateOne) Mutation() *NoteMutation {
 	return nuo.mutation
 }
 
-// ClearOwner clears the "owner" edge to the User entity.
-func (nuo *NoteUpdateOne) ClearOwner() *NoteUpdateOne {
-	nuo.mutation.ClearOwner()
+// ClearOwner clears the "owner" edge to the User entity.
+func (nuo *NoteUpdateOne) ClearOwner() *NoteUpdateOne {
+	nuo.mutation.ClearOwner()
+	return nuo
+}
+
+// ClearLikes clears all "likes" edges to the NoteLike entity.
+func (nuo *NoteUpdateOne) ClearLikes() *NoteUpdateOne {
+	nuo.mutation.ClearLikes()
+	return nuo
+}
+
+// RemoveLikeIDs removes the "likes" edge to NoteLike entities by IDs.
+func (nuo *NoteUpdateOne) RemoveLikeIDs(ids ...int) *NoteUpdateOne {
+	nuo.mutation.RemoveLikeIDs(ids...)
+	return nuo
+}
+
+// RemoveLikes removes "likes" edges to NoteLike entities.
+func (nuo *NoteUpdateOne) RemoveLikes(n ...*NoteLike) *NoteUpdateOne {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return nuo.RemoveLikeIDs(ids...)
+}
+
+// ClearReposts clears all "reposts" edges to the NoteRepost entity.
+func (nuo *NoteUpdateOne) ClearReposts() *NoteUpdateOne {
+	nuo.mutation.ClearReposts()
+	return nuo
+}
+
+// RemoveRepostIDs removes the "reposts" edge to NoteRepost entities by IDs.
+func (nuo *NoteUpdateOne) RemoveRepostIDs(ids ...int) *NoteUpdateOne {
+	nuo.mutation.RemoveRepostIDs(ids...)
+	return nuo
+}
+
+// RemoveReposts removes "reposts" edges to NoteRepost entities.
+func (nuo *NoteUpdateOne) RemoveReposts(n ...*NoteRepost) *NoteUpdateOne {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return nuo.RemoveRepostIDs(ids...)
+}
+
+// ClearPracticeSets clears all "practice_sets" edges to the Quiz entity.
+func (nuo *NoteUpdateOne) ClearPracticeSets() *NoteUpdateOne {
+	nuo.mutation.ClearPracticeSets()
+	return nuo
+}
+
+// RemovePracticeSetIDs removes the "practice_sets" edge to Quiz entities by IDs.
+func (nuo *NoteUpdateOne) RemovePracticeSetIDs(ids ...int) *NoteUpdateOne {
+	nuo.mutation.RemovePracticeSetIDs(ids...)
+	return nuo
+}
+
+// RemovePracticeSets removes "practice_sets" edges to Quiz entities.
+func (nuo *NoteUpdateOne) RemovePracticeSets(q ...*Quiz) *NoteUpdateOne {
+	ids := make([]int, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return nuo.RemovePracticeSetIDs(ids...)
+}
+
+// ClearStudyEvents clears all "study_events" edges to the StudyEvent entity.
+func (nuo *NoteUpdateOne) ClearStudyEvents() *NoteUpdateOne {
+	nuo.mutation.ClearStudyEvents()
+	return nuo
+}
+
+// RemoveStudyEventIDs removes the "study_events" edge to StudyEvent entities by IDs.
+func (nuo *NoteUpdateOne) RemoveStudyEventIDs(ids ...int) *NoteUpdateOne {
+	nuo.mutation.RemoveStudyEventIDs(ids...)
+	return nuo
+}
+
+// RemoveStudyEvents removes "study_events" edges to StudyEvent entities.
+func (nuo *NoteUpdateOne) RemoveStudyEvents(s ...*StudyEvent) *NoteUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return nuo.RemoveStudyEventIDs(ids...)
+}
+
+// ClearGroups clears all "groups" edges to the StudyGroup entity.
+func (nuo *NoteUpdateOne) ClearGroups() *NoteUpdateOne {
+	nuo.mutation.ClearGroups()
+	return nuo
+}
+
+// RemoveGroupIDs removes the "groups" edge to StudyGroup entities by IDs.
+func (nuo *NoteUpdateOne) RemoveGroupIDs(ids ...int) *NoteUpdateOne {
+	nuo.mutation.RemoveGroupIDs(ids...)
+	return nuo
+}
+
+// RemoveGroups removes "groups" edges to StudyGroup entities.
+func (nuo *NoteUpdateOne) RemoveGroups(s ...*StudyGroup) *NoteUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return nuo.RemoveGroupIDs(ids...)
+}
+
+// ClearDocuments clears all "documents" edges to the Document entity.
+func (nuo *NoteUpdateOne) ClearDocuments() *NoteUpdateOne {
+	nuo.mutation.ClearDocuments()
+	return nuo
+}
+
+// RemoveDocumentIDs removes the "documents" edge to Document entities by IDs.
+func (nuo *NoteUpdateOne) RemoveDocumentIDs(ids ...int) *NoteUpdateOne {
+	nuo.mutation.RemoveDocumentIDs(ids...)
+	return nuo
+}
+
+// RemoveDocuments removes "documents" edges to Document entities.
+func (nuo *NoteUpdateOne) RemoveDocuments(d ...*Document) *NoteUpdateOne {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return nuo.RemoveDocumentIDs(ids...)
+}
+
+// ClearProposals clears all "proposals" edges to the NoteProposal entity.
+func (nuo *NoteUpdateOne) ClearProposals() *NoteUpdateOne {
+	nuo.mutation.ClearProposals()
+	return nuo
+}
+
+// RemoveProposalIDs removes the "proposals" edge to NoteProposal entities by IDs.
+func (nuo *NoteUpdateOne) RemoveProposalIDs(ids ...int) *NoteUpdateOne {
+	nuo.mutation.RemoveProposalIDs(ids...)
+	return nuo
+}
+
+// RemoveProposals removes "proposals" edges to NoteProposal entities.
+func (nuo *NoteUpdateOne) RemoveProposals(n ...*NoteProposal) *NoteUpdateOne {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return nuo.RemoveProposalIDs(ids...)
+}
+
+// ClearRevisions clears all "revisions" edges to the NoteRevision entity.
+func (nuo *NoteUpdateOne) ClearRevisions() *NoteUpdateOne {
+	nuo.mutation.ClearRevisions()
+	return nuo
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to NoteRevision entities by IDs.
+func (nuo *NoteUpdateOne) RemoveRevisionIDs(ids ...int) *NoteUpdateOne {
+	nuo.mutation.RemoveRevisionIDs(ids...)
+	return nuo
+}
+
+// RemoveRevisions removes "revisions" edges to NoteRevision entities.
+func (nuo *NoteUpdateOne) RemoveRevisions(n ...*NoteRevision) *NoteUpdateOne {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return nuo.RemoveRevisionIDs(ids...)
+}
+
+// ClearNotebooks clears all "notebooks" edges to the Notebook entity.
+func (nuo *NoteUpdateOne) ClearNotebooks() *NoteUpdateOne {
+	nuo.mutation.ClearNotebooks()
+	return nuo
+}
+
+// RemoveNotebookIDs removes the "notebooks" edge to Notebook entities by IDs.
+func (nuo *NoteUpdateOne) RemoveNotebookIDs(ids ...int) *NoteUpdateOne {
+	nuo.mutation.RemoveNotebookIDs(ids...)
+	return nuo
+}
+
+// RemoveNotebooks removes "notebooks" edges to Notebook entities.
+func (nuo *NoteUpdateOne) RemoveNotebooks(n ...*Notebook) *NoteUpdateOne {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return nuo.RemoveNotebookIDs(ids...)
+}
+
+// ClearTags clears all "tags" edges to the Tag entity.
+func (nuo *NoteUpdateOne) ClearTags() *NoteUpdateOne {
+	nuo.mutation.ClearTags()
+	return nuo
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (nuo *NoteUpdateOne) RemoveTagIDs(ids ...int) *NoteUpdateOne {
+	nuo.mutation.RemoveTagIDs(ids...)
 	return nuo
 }
 
-// ClearLikes clears all "likes" edges to the NoteLike entity.
-func (nuo *NoteUpdateOne) ClearLikes() *NoteUpdateOne {
-	nuo.mutation.ClearLikes()
+// RemoveTags removes "tags" edges to Tag entities.
+func (nuo *NoteUpdateOne) RemoveTags(t ...*Tag) *NoteUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return nuo.RemoveTagIDs(ids...)
+}
+
+// ClearLinks clears all "links" edges to the NoteLink entity.
+func (nuo *NoteUpdateOne) ClearLinks() *NoteUpdateOne {
+	nuo.mutation.ClearLinks()
 	return nuo
 }
 
-// RemoveLikeIDs removes the "likes" edge to NoteLike entities by IDs.
-func (nuo *NoteUpdateOne) RemoveLikeIDs(ids ...int) *NoteUpdateOne {
-	nuo.mutation.RemoveLikeIDs(ids...)
+// RemoveLinkIDs removes the "links" edge to NoteLink entities by IDs.
+func (nuo *NoteUpdateOne) RemoveLinkIDs(ids ...int) *NoteUpdateOne {
+	nuo.mutation.RemoveLinkIDs(ids...)
 	return nuo
 }
 
-// RemoveLikes removes "likes" edges to NoteLike entities.
-func (nuo *NoteUpdateOne) RemoveLikes(n ...*NoteLike) *NoteUpdateOne {
+// RemoveLinks removes "links" edges to NoteLink entities.
+func (nuo *NoteUpdateOne) RemoveLinks(n ...*NoteLink) *NoteUpdateOne {
 	ids := make([]int, len(n))
 	for i := range n {
 		ids[i] = n[i].ID
 	}
-	return nuo.RemoveLikeIDs(ids...)
+	return nuo.RemoveLinkIDs(ids...)
 }
 
-// ClearReposts clears all "reposts" edges to the NoteRepost entity.
-func (nuo *NoteUpdateOne) ClearReposts() *NoteUpdateOne {
-	nuo.mutation.ClearReposts()
+// ClearBacklinks clears all "backlinks" edges to the NoteLink entity.
+func (nuo *NoteUpdateOne) ClearBacklinks() *NoteUpdateOne {
+	nuo.mutation.ClearBacklinks()
 	return nuo
 }
 
-// RemoveRepostIDs removes the "reposts" edge to NoteRepost entities by IDs.
-func (nuo *NoteUpdateOne) RemoveRepostIDs(ids ...int) *NoteUpdateOne {
-	nuo.mutation.RemoveRepostIDs(ids...)
+// RemoveBacklinkIDs removes the "backlinks" edge to NoteLink entities by IDs.
+func (nuo *NoteUpdateOne) RemoveBacklinkIDs(ids ...int) *NoteUpdateOne {
+	nuo.mutation.RemoveBacklinkIDs(ids...)
 	return nuo
 }
 
-// RemoveReposts removes "reposts" edges to NoteRepost entities.
-func (nuo *NoteUpdateOne) RemoveReposts(n ...*NoteRepost) *NoteUpdateOne {
+// RemoveBacklinks removes "backlinks" edges to NoteLink entities.
+func (nuo *NoteUpdateOne) RemoveBacklinks(n ...*NoteLink) *NoteUpdateOne {
 	ids := make([]int, len(n))
 	for i := range n {
 		ids[i] = n[i].ID
 	}
-	return nuo.RemoveRepostIDs(ids...)
+	return nuo.RemoveBacklinkIDs(ids...)
 }
 
 // Where appends a list predicates to the NoteUpdate builder.
@@ -882,98 +2061,548 @@ func (nuo *NoteUpdateOne) sqlSave(ctx context.Context) (_node *Note, err error)
 	if !ok {
 		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Note.id" for update`)}
 	}
-	_spec.Node.ID.Value = id
-	if fields := nuo.fields; len(fields) > 0 {
-		_spec.Node.Columns = make([]string, 0, len(fields))
-		_spec.Node.Columns = append(_spec.Node.Columns, note.FieldID)
-		for _, f := range fields {
-			if !note.ValidColumn(f) {
-				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
-			}
-			if f != note.FieldID {
-				_spec.Node.Columns = append(_spec.Node.Columns, f)
-			}
+	_spec.Node.ID.Value = id
+	if fields := nuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, note.FieldID)
+		for _, f := range fields {
+			if !note.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != note.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := nuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := nuo.mutation.Title(); ok {
+		_spec.SetField(note.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := nuo.mutation.Description(); ok {
+		_spec.SetField(note.FieldDescription, field.TypeString, value)
+	}
+	if nuo.mutation.DescriptionCleared() {
+		_spec.ClearField(note.FieldDescription, field.TypeString)
+	}
+	if value, ok := nuo.mutation.Content(); ok {
+		_spec.SetField(note.FieldContent, field.TypeString, value)
+	}
+	if nuo.mutation.ContentCleared() {
+		_spec.ClearField(note.FieldContent, field.TypeString)
+	}
+	if value, ok := nuo.mutation.Resources(); ok {
+		_spec.SetField(note.FieldResources, field.TypeJSON, value)
+	}
+	if value, ok := nuo.mutation.AppendedResources(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, note.FieldResources, value)
+		})
+	}
+	if nuo.mutation.ResourcesCleared() {
+		_spec.ClearField(note.FieldResources, field.TypeJSON)
+	}
+	if value, ok := nuo.mutation.AiCurriculum(); ok {
+		_spec.SetField(note.FieldAiCurriculum, field.TypeString, value)
+	}
+	if nuo.mutation.AiCurriculumCleared() {
+		_spec.ClearField(note.FieldAiCurriculum, field.TypeString)
+	}
+	if value, ok := nuo.mutation.Visibility(); ok {
+		_spec.SetField(note.FieldVisibility, field.TypeEnum, value)
+	}
+	if value, ok := nuo.mutation.PermissionLevel(); ok {
+		_spec.SetField(note.FieldPermissionLevel, field.TypeEnum, value)
+	}
+	if value, ok := nuo.mutation.ShareToken(); ok {
+		_spec.SetField(note.FieldShareToken, field.TypeString, value)
+	}
+	if nuo.mutation.ShareTokenCleared() {
+		_spec.ClearField(note.FieldShareToken, field.TypeString)
+	}
+	if value, ok := nuo.mutation.AiProcessing(); ok {
+		_spec.SetField(note.FieldAiProcessing, field.TypeBool, value)
+	}
+	if value, ok := nuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(note.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if nuo.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   note.OwnerTable,
+			Columns: []string{note.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nuo.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   note.OwnerTable,
+			Columns: []string{note.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nuo.mutation.LikesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   note.LikesTable,
+			Columns: []string{note.LikesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notelike.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nuo.mutation.RemovedLikesIDs(); len(nodes) > 0 && !nuo.mutation.LikesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   note.LikesTable,
+			Columns: []string{note.LikesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notelike.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nuo.mutation.LikesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   note.LikesTable,
+			Columns: []string{note.LikesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notelike.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nuo.mutation.RepostsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   note.RepostsTable,
+			Columns: []string{note.RepostsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(noterepost.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nuo.mutation.RemovedRepostsIDs(); len(nodes) > 0 && !nuo.mutation.RepostsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   note.RepostsTable,
+			Columns: []string{note.RepostsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(noterepost.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nuo.mutation.RepostsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   note.RepostsTable,
+			Columns: []string{note.RepostsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(noterepost.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nuo.mutation.PracticeSetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   note.PracticeSetsTable,
+			Columns: []string{note.PracticeSetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quiz.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nuo.mutation.RemovedPracticeSetsIDs(); len(nodes) > 0 && !nuo.mutation.PracticeSetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   note.PracticeSetsTable,
+			Columns: []string{note.PracticeSetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quiz.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nuo.mutation.PracticeSetsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   note.PracticeSetsTable,
+			Columns: []string{note.PracticeSetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quiz.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nuo.mutation.StudyEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   note.StudyEventsTable,
+			Columns: []string{note.StudyEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(studyevent.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nuo.mutation.RemovedStudyEventsIDs(); len(nodes) > 0 && !nuo.mutation.StudyEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   note.StudyEventsTable,
+			Columns: []string{note.StudyEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(studyevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if ps := nuo.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
+	if nodes := nuo.mutation.StudyEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   note.StudyEventsTable,
+			Columns: []string{note.StudyEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(studyevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := nuo.mutation.Title(); ok {
-		_spec.SetField(note.FieldTitle, field.TypeString, value)
+	if nuo.mutation.GroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   note.GroupsTable,
+			Columns: note.GroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(studygroup.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := nuo.mutation.Description(); ok {
-		_spec.SetField(note.FieldDescription, field.TypeString, value)
+	if nodes := nuo.mutation.RemovedGroupsIDs(); len(nodes) > 0 && !nuo.mutation.GroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   note.GroupsTable,
+			Columns: note.GroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(studygroup.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nuo.mutation.DescriptionCleared() {
-		_spec.ClearField(note.FieldDescription, field.TypeString)
+	if nodes := nuo.mutation.GroupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   note.GroupsTable,
+			Columns: note.GroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(studygroup.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := nuo.mutation.Content(); ok {
-		_spec.SetField(note.FieldContent, field.TypeString, value)
+	if nuo.mutation.DocumentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   note.DocumentsTable,
+			Columns: note.DocumentsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(document.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nuo.mutation.ContentCleared() {
-		_spec.ClearField(note.FieldContent, field.TypeString)
+	if nodes := nuo.mutation.RemovedDocumentsIDs(); len(nodes) > 0 && !nuo.mutation.DocumentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   note.DocumentsTable,
+			Columns: note.DocumentsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(document.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := nuo.mutation.Resources(); ok {
-		_spec.SetField(note.FieldResources, field.TypeJSON, value)
+	if nodes := nuo.mutation.DocumentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   note.DocumentsTable,
+			Columns: note.DocumentsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(document.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := nuo.mutation.AppendedResources(); ok {
-		_spec.AddModifier(func(u *sql.UpdateBuilder) {
-			sqljson.Append(u, note.FieldResources, value)
-		})
+	if nuo.mutation.ProposalsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   note.ProposalsTable,
+			Columns: []string{note.ProposalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(noteproposal.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nuo.mutation.ResourcesCleared() {
-		_spec.ClearField(note.FieldResources, field.TypeJSON)
+	if nodes := nuo.mutation.RemovedProposalsIDs(); len(nodes) > 0 && !nuo.mutation.ProposalsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   note.ProposalsTable,
+			Columns: []string{note.ProposalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(noteproposal.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := nuo.mutation.AiCurriculum(); ok {
-		_spec.SetField(note.FieldAiCurriculum, field.TypeString, value)
+	if nodes := nuo.mutation.ProposalsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   note.ProposalsTable,
+			Columns: []string{note.ProposalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(noteproposal.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if nuo.mutation.AiCurriculumCleared() {
-		_spec.ClearField(note.FieldAiCurriculum, field.TypeString)
+	if nuo.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   note.RevisionsTable,
+			Columns: []string{note.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(noterevision.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := nuo.mutation.Visibility(); ok {
-		_spec.SetField(note.FieldVisibility, field.TypeEnum, value)
+	if nodes := nuo.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !nuo.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   note.RevisionsTable,
+			Columns: []string{note.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(noterevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := nuo.mutation.PermissionLevel(); ok {
-		_spec.SetField(note.FieldPermissionLevel, field.TypeEnum, value)
+	if nodes := nuo.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   note.RevisionsTable,
+			Columns: []string{note.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(noterevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := nuo.mutation.ShareToken(); ok {
-		_spec.SetField(note.FieldShareToken, field.TypeString, value)
+	if nuo.mutation.NotebooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   note.NotebooksTable,
+			Columns: note.NotebooksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notebook.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nuo.mutation.ShareTokenCleared() {
-		_spec.ClearField(note.FieldShareToken, field.TypeString)
+	if nodes := nuo.mutation.RemovedNotebooksIDs(); len(nodes) > 0 && !nuo.mutation.NotebooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   note.NotebooksTable,
+			Columns: note.NotebooksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notebook.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := nuo.mutation.AiProcessing(); ok {
-		_spec.SetField(note.FieldAiProcessing, field.TypeBool, value)
+	if nodes := nuo.mutation.NotebooksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   note.NotebooksTable,
+			Columns: note.NotebooksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notebook.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := nuo.mutation.UpdatedAt(); ok {
-		_spec.SetField(note.FieldUpdatedAt, field.TypeTime, value)
+	if nuo.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   note.TagsTable,
+			Columns: note.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nuo.mutation.OwnerCleared() {
+	if nodes := nuo.mutation.RemovedTagsIDs(); len(nodes) > 0 && !nuo.mutation.TagsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
+			Rel:     sqlgraph.M2M,
 			Inverse: true,
-			Table:   note.OwnerTable,
-			Columns: []string{note.OwnerColumn},
+			Table:   note.TagsTable,
+			Columns: note.TagsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
 			},
 		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := nuo.mutation.OwnerIDs(); len(nodes) > 0 {
+	if nodes := nuo.mutation.TagsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
+			Rel:     sqlgraph.M2M,
 			Inverse: true,
-			Table:   note.OwnerTable,
-			Columns: []string{note.OwnerColumn},
+			Table:   note.TagsTable,
+			Columns: note.TagsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -981,28 +2610,28 @@ func (nuo *NoteUpdateOne) sqlSave(ctx context.Context) (_node *Note, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if nuo.mutation.LikesCleared() {
+	if nuo.mutation.LinksCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   note.LikesTable,
-			Columns: []string{note.LikesColumn},
+			Table:   note.LinksTable,
+			Columns: []string{note.LinksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(notelike.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(notelink.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := nuo.mutation.RemovedLikesIDs(); len(nodes) > 0 && !nuo.mutation.LikesCleared() {
+	if nodes := nuo.mutation.RemovedLinksIDs(); len(nodes) > 0 && !nuo.mutation.LinksCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   note.LikesTable,
-			Columns: []string{note.LikesColumn},
+			Table:   note.LinksTable,
+			Columns: []string{note.LinksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(notelike.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(notelink.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1010,15 +2639,15 @@ func (nuo *NoteUpdateOne) sqlSave(ctx context.Context) (_node *Note, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := nuo.mutation.LikesIDs(); len(nodes) > 0 {
+	if nodes := nuo.mutation.LinksIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   note.LikesTable,
-			Columns: []string{note.LikesColumn},
+			Table:   note.LinksTable,
+			Columns: []string{note.LinksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(notelike.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(notelink.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1026,28 +2655,28 @@ func (nuo *NoteUpdateOne) sqlSave(ctx context.Context) (_node *Note, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if nuo.mutation.RepostsCleared() {
+	if nuo.mutation.BacklinksCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   note.RepostsTable,
-			Columns: []string{note.RepostsColumn},
+			Table:   note.BacklinksTable,
+			Columns: []string{note.BacklinksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(noterepost.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(notelink.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := nuo.mutation.RemovedRepostsIDs(); len(nodes) > 0 && !nuo.mutation.RepostsCleared() {
+	if nodes := nuo.mutation.RemovedBacklinksIDs(); len(nodes) > 0 && !nuo.mutation.BacklinksCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   note.RepostsTable,
-			Columns: []string{note.RepostsColumn},
+			Table:   note.BacklinksTable,
+			Columns: []string{note.BacklinksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(noterepost.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(notelink.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1055,15 +2684,15 @@ func (nuo *NoteUpdateOne) sqlSave(ctx context.Context) (_node *Note, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := nuo.mutation.RepostsIDs(); len(nodes) > 0 {
+	if nodes := nuo.mutation.BacklinksIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   note.RepostsTable,
-			Columns: []string{note.RepostsColumn},
+			Table:   note.BacklinksTable,
+			Columns: []string{note.BacklinksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(noterepost.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(notelink.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -0,0 +1,238 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/r-scheele/zero/ent/notebook"
+	"github.com/r-scheele/zero/ent/user"
+)
+
+// Notebook is the model entity for the Notebook schema.
+type Notebook struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Visibility given to notes created in the notebook
+	DefaultVisibility notebook.DefaultVisibility `json:"default_visibility,omitempty"`
+	// Permission level given to notes created in the notebook
+	DefaultPermissionLevel notebook.DefaultPermissionLevel `json:"default_permission_level,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the NotebookQuery when eager-loading is set.
+	Edges             NotebookEdges `json:"edges"`
+	notebook_children *int
+	user_notebooks    *int
+	selectValues      sql.SelectValues
+}
+
+// NotebookEdges holds the relations/edges for other nodes in the graph.
+type NotebookEdges struct {
+	// Owner holds the value of the owner edge.
+	Owner *User `json:"owner,omitempty"`
+	// Notebooks nest, with no parent at the top level
+	Parent *Notebook `json:"parent,omitempty"`
+	// Children holds the value of the children edge.
+	Children []*Notebook `json:"children,omitempty"`
+	// Notes holds the value of the notes edge.
+	Notes []*Note `json:"notes,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// OwnerOrErr returns the Owner value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e NotebookEdges) OwnerOrErr() (*User, error) {
+	if e.Owner != nil {
+		return e.Owner, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "owner"}
+}
+
+// ParentOrErr returns the Parent value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e NotebookEdges) ParentOrErr() (*Notebook, error) {
+	if e.Parent != nil {
+		return e.Parent, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: notebook.Label}
+	}
+	return nil, &NotLoadedError{edge: "parent"}
+}
+
+// ChildrenOrErr returns the Children value or an error if the edge
+// was not loaded in eager-loading.
+func (e NotebookEdges) ChildrenOrErr() ([]*Notebook, error) {
+	if e.loadedTypes[2] {
+		return e.Children, nil
+	}
+	return nil, &NotLoadedError{edge: "children"}
+}
+
+// NotesOrErr returns the Notes value or an error if the edge
+// was not loaded in eager-loading.
+func (e NotebookEdges) NotesOrErr() ([]*Note, error) {
+	if e.loadedTypes[3] {
+		return e.Notes, nil
+	}
+	return nil, &NotLoadedError{edge: "notes"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Notebook) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case notebook.FieldID:
+			values[i] = new(sql.NullInt64)
+		case notebook.FieldName, notebook.FieldDefaultVisibility, notebook.FieldDefaultPermissionLevel:
+			values[i] = new(sql.NullString)
+		case notebook.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case notebook.ForeignKeys[0]: // notebook_children
+			values[i] = new(sql.NullInt64)
+		case notebook.ForeignKeys[1]: // user_notebooks
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Notebook fields.
+func (n *Notebook) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case notebook.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			n.ID = int(value.Int64)
+		case notebook.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				n.Name = value.String
+			}
+		case notebook.FieldDefaultVisibility:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field default_visibility", values[i])
+			} else if value.Valid {
+				n.DefaultVisibility = notebook.DefaultVisibility(value.String)
+			}
+		case notebook.FieldDefaultPermissionLevel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field default_permission_level", values[i])
+			} else if value.Valid {
+				n.DefaultPermissionLevel = notebook.DefaultPermissionLevel(value.String)
+			}
+		case notebook.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				n.CreatedAt = value.Time
+			}
+		case notebook.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field notebook_children", value)
+			} else if value.Valid {
+				n.notebook_children = new(int)
+				*n.notebook_children = int(value.Int64)
+			}
+		case notebook.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_notebooks", value)
+			} else if value.Valid {
+				n.user_notebooks = new(int)
+				*n.user_notebooks = int(value.Int64)
+			}
+		default:
+			n.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Notebook.
+// This includes values selected through modifiers, order, etc.
+func (n *Notebook) Value(name string) (ent.Value, error) {
+	return n.selectValues.Get(name)
+}
+
+// QueryOwner queries the "owner" edge of the Notebook entity.
+func (n *Notebook) QueryOwner() *UserQuery {
+	return NewNotebookClient(n.config).QueryOwner(n)
+}
+
+// QueryParent queries the "parent" edge of the Notebook entity.
+func (n *Notebook) QueryParent() *NotebookQuery {
+	return NewNotebookClient(n.config).QueryParent(n)
+}
+
+// QueryChildren queries the "children" edge of the Notebook entity.
+func (n *Notebook) QueryChildren() *NotebookQuery {
+	return NewNotebookClient(n.config).QueryChildren(n)
+}
+
+// QueryNotes queries the "notes" edge of the Notebook entity.
+func (n *Notebook) QueryNotes() *NoteQuery {
+	return NewNotebookClient(n.config).QueryNotes(n)
+}
+
+// Update returns a builder for updating this Notebook.
+// Note that you need to call Notebook.Unwrap() before calling this method if this Notebook
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (n *Notebook) Update() *NotebookUpdateOne {
+	return NewNotebookClient(n.config).UpdateOne(n)
+}
+
+// Unwrap unwraps the Notebook entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (n *Notebook) Unwrap() *Notebook {
+	_tx, ok := n.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Notebook is not a transactional entity")
+	}
+	n.config.driver = _tx.drv
+	return n
+}
+
+// String implements the fmt.Stringer.
+func (n *Notebook) String() string {
+	var builder strings.Builder
+	builder.WriteString("Notebook(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", n.ID))
+	builder.WriteString("name=")
+	builder.WriteString(n.Name)
+	builder.WriteString(", ")
+	builder.WriteString("default_visibility=")
+	builder.WriteString(fmt.Sprintf("%v", n.DefaultVisibility))
+	builder.WriteString(", ")
+	builder.WriteString("default_permission_level=")
+	builder.WriteString(fmt.Sprintf("%v", n.DefaultPermissionLevel))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(n.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Notebooks is a parsable slice of Notebook.
+type Notebooks []*Notebook
@@ -0,0 +1,251 @@
+// Code generated by ent, DO NOT EDIT.
+
+package notebook
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the notebook type in the database.
+	Label = "notebook"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldDefaultVisibility holds the string denoting the default_visibility field in the database.
+	FieldDefaultVisibility = "default_visibility"
+	// FieldDefaultPermissionLevel holds the string denoting the default_permission_level field in the database.
+	FieldDefaultPermissionLevel = "default_permission_level"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeOwner holds the string denoting the owner edge name in mutations.
+	EdgeOwner = "owner"
+	// EdgeParent holds the string denoting the parent edge name in mutations.
+	EdgeParent = "parent"
+	// EdgeChildren holds the string denoting the children edge name in mutations.
+	EdgeChildren = "children"
+	// EdgeNotes holds the string denoting the notes edge name in mutations.
+	EdgeNotes = "notes"
+	// Table holds the table name of the notebook in the database.
+	Table = "notebooks"
+	// OwnerTable is the table that holds the owner relation/edge.
+	OwnerTable = "notebooks"
+	// OwnerInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	OwnerInverseTable = "users"
+	// OwnerColumn is the table column denoting the owner relation/edge.
+	OwnerColumn = "user_notebooks"
+	// ParentTable is the table that holds the parent relation/edge.
+	ParentTable = "notebooks"
+	// ParentColumn is the table column denoting the parent relation/edge.
+	ParentColumn = "notebook_children"
+	// ChildrenTable is the table that holds the children relation/edge.
+	ChildrenTable = "notebooks"
+	// ChildrenColumn is the table column denoting the children relation/edge.
+	ChildrenColumn = "notebook_children"
+	// NotesTable is the table that holds the notes relation/edge. The primary key declared below.
+	NotesTable = "notebook_notes"
+	// NotesInverseTable is the table name for the Note entity.
+	// It exists in this package in order to avoid circular dependency with the "note" package.
+	NotesInverseTable = "notes"
+)
+
+// Columns holds all SQL columns for notebook fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldDefaultVisibility,
+	FieldDefaultPermissionLevel,
+	FieldCreatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "notebooks"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"notebook_children",
+	"user_notebooks",
+}
+
+var (
+	// NotesPrimaryKey and NotesColumn2 are the table columns denoting the
+	// primary key for the notes relation (M2M).
+	NotesPrimaryKey = []string{"notebook_id", "note_id"}
+)
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// DefaultVisibility defines the type for the "default_visibility" enum field.
+type DefaultVisibility string
+
+// DefaultVisibilityPrivate is the default value of the DefaultVisibility enum.
+const DefaultDefaultVisibility = DefaultVisibilityPrivate
+
+// DefaultVisibility values.
+const (
+	DefaultVisibilityPrivate DefaultVisibility = "private"
+	DefaultVisibilityPublic  DefaultVisibility = "public"
+)
+
+func (dv DefaultVisibility) String() string {
+	return string(dv)
+}
+
+// DefaultVisibilityValidator is a validator for the "default_visibility" field enum values. It is called by the builders before save.
+func DefaultVisibilityValidator(dv DefaultVisibility) error {
+	switch dv {
+	case DefaultVisibilityPrivate, DefaultVisibilityPublic:
+		return nil
+	default:
+		return fmt.Errorf("notebook: invalid enum value for default_visibility field: %q", dv)
+	}
+}
+
+// DefaultPermissionLevel defines the type for the "default_permission_level" enum field.
+type DefaultPermissionLevel string
+
+// DefaultPermissionLevelReadOnly is the default value of the DefaultPermissionLevel enum.
+const DefaultDefaultPermissionLevel = DefaultPermissionLevelReadOnly
+
+// DefaultPermissionLevel values.
+const (
+	DefaultPermissionLevelReadOnly          DefaultPermissionLevel = "read_only"
+	DefaultPermissionLevelReadWrite         DefaultPermissionLevel = "read_write"
+	DefaultPermissionLevelReadWriteApproval DefaultPermissionLevel = "read_write_approval"
+)
+
+func (dpl DefaultPermissionLevel) String() string {
+	return string(dpl)
+}
+
+// DefaultPermissionLevelValidator is a validator for the "default_permission_level" field enum values. It is called by the builders before save.
+func DefaultPermissionLevelValidator(dpl DefaultPermissionLevel) error {
+	switch dpl {
+	case DefaultPermissionLevelReadOnly, DefaultPermissionLevelReadWrite, DefaultPermissionLevelReadWriteApproval:
+		return nil
+	default:
+		return fmt.Errorf("notebook: invalid enum value for default_permission_level field: %q", dpl)
+	}
+}
+
+// OrderOption defines the ordering options for the Notebook queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByDefaultVisibility orders the results by the default_visibility field.
+func ByDefaultVisibility(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDefaultVisibility, opts...).ToFunc()
+}
+
+// ByDefaultPermissionLevel orders the results by the default_permission_level field.
+func ByDefaultPermissionLevel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDefaultPermissionLevel, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByOwnerField orders the results by owner field.
+func ByOwnerField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOwnerStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByParentField orders the results by parent field.
+func ByParentField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newParentStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByChildrenCount orders the results by children count.
+func ByChildrenCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newChildrenStep(), opts...)
+	}
+}
+
+// ByChildren orders the results by children terms.
+func ByChildren(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newChildrenStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByNotesCount orders the results by notes count.
+func ByNotesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newNotesStep(), opts...)
+	}
+}
+
+// ByNotes orders the results by notes terms.
+func ByNotes(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newNotesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newOwnerStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OwnerInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, OwnerTable, OwnerColumn),
+	)
+}
+func newParentStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ParentTable, ParentColumn),
+	)
+}
+func newChildrenStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ChildrenTable, ChildrenColumn),
+	)
+}
+func newNotesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(NotesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, NotesTable, NotesPrimaryKey...),
+	)
+}
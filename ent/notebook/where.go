@@ -0,0 +1,318 @@
+// Code generated by ent, DO NOT EDIT.
+
+package notebook
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/r-scheele/zero/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Notebook {
+	return predicate.Notebook(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Notebook {
+	return predicate.Notebook(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Notebook {
+	return predicate.Notebook(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Notebook {
+	return predicate.Notebook(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Notebook {
+	return predicate.Notebook(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Notebook {
+	return predicate.Notebook(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Notebook {
+	return predicate.Notebook(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Notebook {
+	return predicate.Notebook(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Notebook {
+	return predicate.Notebook(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Notebook {
+	return predicate.Notebook(sql.FieldEQ(FieldName, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Notebook {
+	return predicate.Notebook(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Notebook {
+	return predicate.Notebook(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Notebook {
+	return predicate.Notebook(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Notebook {
+	return predicate.Notebook(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Notebook {
+	return predicate.Notebook(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Notebook {
+	return predicate.Notebook(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Notebook {
+	return predicate.Notebook(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Notebook {
+	return predicate.Notebook(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Notebook {
+	return predicate.Notebook(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Notebook {
+	return predicate.Notebook(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Notebook {
+	return predicate.Notebook(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Notebook {
+	return predicate.Notebook(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Notebook {
+	return predicate.Notebook(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Notebook {
+	return predicate.Notebook(sql.FieldContainsFold(FieldName, v))
+}
+
+// DefaultVisibilityEQ applies the EQ predicate on the "default_visibility" field.
+func DefaultVisibilityEQ(v DefaultVisibility) predicate.Notebook {
+	return predicate.Notebook(sql.FieldEQ(FieldDefaultVisibility, v))
+}
+
+// DefaultVisibilityNEQ applies the NEQ predicate on the "default_visibility" field.
+func DefaultVisibilityNEQ(v DefaultVisibility) predicate.Notebook {
+	return predicate.Notebook(sql.FieldNEQ(FieldDefaultVisibility, v))
+}
+
+// DefaultVisibilityIn applies the In predicate on the "default_visibility" field.
+func DefaultVisibilityIn(vs ...DefaultVisibility) predicate.Notebook {
+	return predicate.Notebook(sql.FieldIn(FieldDefaultVisibility, vs...))
+}
+
+// DefaultVisibilityNotIn applies the NotIn predicate on the "default_visibility" field.
+func DefaultVisibilityNotIn(vs ...DefaultVisibility) predicate.Notebook {
+	return predicate.Notebook(sql.FieldNotIn(FieldDefaultVisibility, vs...))
+}
+
+// DefaultPermissionLevelEQ applies the EQ predicate on the "default_permission_level" field.
+func DefaultPermissionLevelEQ(v DefaultPermissionLevel) predicate.Notebook {
+	return predicate.Notebook(sql.FieldEQ(FieldDefaultPermissionLevel, v))
+}
+
+// DefaultPermissionLevelNEQ applies the NEQ predicate on the "default_permission_level" field.
+func DefaultPermissionLevelNEQ(v DefaultPermissionLevel) predicate.Notebook {
+	return predicate.Notebook(sql.FieldNEQ(FieldDefaultPermissionLevel, v))
+}
+
+// DefaultPermissionLevelIn applies the In predicate on the "default_permission_level" field.
+func DefaultPermissionLevelIn(vs ...DefaultPermissionLevel) predicate.Notebook {
+	return predicate.Notebook(sql.FieldIn(FieldDefaultPermissionLevel, vs...))
+}
+
+// DefaultPermissionLevelNotIn applies the NotIn predicate on the "default_permission_level" field.
+func DefaultPermissionLevelNotIn(vs ...DefaultPermissionLevel) predicate.Notebook {
+	return predicate.Notebook(sql.FieldNotIn(FieldDefaultPermissionLevel, vs...))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Notebook {
+	return predicate.Notebook(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Notebook {
+	return predicate.Notebook(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Notebook {
+	return predicate.Notebook(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Notebook {
+	return predicate.Notebook(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Notebook {
+	return predicate.Notebook(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Notebook {
+	return predicate.Notebook(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Notebook {
+	return predicate.Notebook(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Notebook {
+	return predicate.Notebook(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasOwner applies the HasEdge predicate on the "owner" edge.
+func HasOwner() predicate.Notebook {
+	return predicate.Notebook(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OwnerTable, OwnerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOwnerWith applies the HasEdge predicate on the "owner" edge with a given conditions (other predicates).
+func HasOwnerWith(preds ...predicate.User) predicate.Notebook {
+	return predicate.Notebook(func(s *sql.Selector) {
+		step := newOwnerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasParent applies the HasEdge predicate on the "parent" edge.
+func HasParent() predicate.Notebook {
+	return predicate.Notebook(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ParentTable, ParentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasParentWith applies the HasEdge predicate on the "parent" edge with a given conditions (other predicates).
+func HasParentWith(preds ...predicate.Notebook) predicate.Notebook {
+	return predicate.Notebook(func(s *sql.Selector) {
+		step := newParentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasChildren applies the HasEdge predicate on the "children" edge.
+func HasChildren() predicate.Notebook {
+	return predicate.Notebook(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ChildrenTable, ChildrenColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasChildrenWith applies the HasEdge predicate on the "children" edge with a given conditions (other predicates).
+func HasChildrenWith(preds ...predicate.Notebook) predicate.Notebook {
+	return predicate.Notebook(func(s *sql.Selector) {
+		step := newChildrenStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasNotes applies the HasEdge predicate on the "notes" edge.
+func HasNotes() predicate.Notebook {
+	return predicate.Notebook(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, NotesTable, NotesPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasNotesWith applies the HasEdge predicate on the "notes" edge with a given conditions (other predicates).
+func HasNotesWith(preds ...predicate.Note) predicate.Notebook {
+	return predicate.Notebook(func(s *sql.Selector) {
+		step := newNotesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Notebook) predicate.Notebook {
+	return predicate.Notebook(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Notebook) predicate.Notebook {
+	return predicate.Notebook(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Notebook) predicate.Notebook {
+	return predicate.Notebook(sql.NotPredicates(p))
+}
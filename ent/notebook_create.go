@@ -0,0 +1,411 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/r-scheele/zero/ent/note"
+	"github.com/r-scheele/zero/ent/notebook"
+	"github.com/r-scheele/zero/ent/user"
+)
+
+// NotebookCreate is the builder for creating a Notebook entity.
+type NotebookCreate struct {
+	config
+	mutation *NotebookMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (nc *NotebookCreate) SetName(s string) *NotebookCreate {
+	nc.mutation.SetName(s)
+	return nc
+}
+
+// SetDefaultVisibility sets the "default_visibility" field.
+func (nc *NotebookCreate) SetDefaultVisibility(nv notebook.DefaultVisibility) *NotebookCreate {
+	nc.mutation.SetDefaultVisibility(nv)
+	return nc
+}
+
+// SetNillableDefaultVisibility sets the "default_visibility" field if the given value is not nil.
+func (nc *NotebookCreate) SetNillableDefaultVisibility(nv *notebook.DefaultVisibility) *NotebookCreate {
+	if nv != nil {
+		nc.SetDefaultVisibility(*nv)
+	}
+	return nc
+}
+
+// SetDefaultPermissionLevel sets the "default_permission_level" field.
+func (nc *NotebookCreate) SetDefaultPermissionLevel(npl notebook.DefaultPermissionLevel) *NotebookCreate {
+	nc.mutation.SetDefaultPermissionLevel(npl)
+	return nc
+}
+
+// SetNillableDefaultPermissionLevel sets the "default_permission_level" field if the given value is not nil.
+func (nc *NotebookCreate) SetNillableDefaultPermissionLevel(npl *notebook.DefaultPermissionLevel) *NotebookCreate {
+	if npl != nil {
+		nc.SetDefaultPermissionLevel(*npl)
+	}
+	return nc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (nc *NotebookCreate) SetCreatedAt(t time.Time) *NotebookCreate {
+	nc.mutation.SetCreatedAt(t)
+	return nc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (nc *NotebookCreate) SetNillableCreatedAt(t *time.Time) *NotebookCreate {
+	if t != nil {
+		nc.SetCreatedAt(*t)
+	}
+	return nc
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (nc *NotebookCreate) SetOwnerID(id int) *NotebookCreate {
+	nc.mutation.SetOwnerID(id)
+	return nc
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (nc *NotebookCreate) SetOwner(u *User) *NotebookCreate {
+	return nc.SetOwnerID(u.ID)
+}
+
+// SetParentID sets the "parent" edge to the Notebook entity by ID.
+func (nc *NotebookCreate) SetParentID(id int) *NotebookCreate {
+	nc.mutation.SetParentID(id)
+	return nc
+}
+
+// SetNillableParentID sets the "parent" edge to the Notebook entity by ID if the given value is not nil.
+func (nc *NotebookCreate) SetNillableParentID(id *int) *NotebookCreate {
+	if id != nil {
+		nc = nc.SetParentID(*id)
+	}
+	return nc
+}
+
+// SetParent sets the "parent" edge to the Notebook entity.
+func (nc *NotebookCreate) SetParent(n *Notebook) *NotebookCreate {
+	return nc.SetParentID(n.ID)
+}
+
+// AddChildIDs adds the "children" edge to the Notebook entity by IDs.
+func (nc *NotebookCreate) AddChildIDs(ids ...int) *NotebookCreate {
+	nc.mutation.AddChildIDs(ids...)
+	return nc
+}
+
+// AddChildren adds the "children" edges to the Notebook entity.
+func (nc *NotebookCreate) AddChildren(n ...*Notebook) *NotebookCreate {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return nc.AddChildIDs(ids...)
+}
+
+// AddNoteIDs adds the "notes" edge to the Note entity by IDs.
+func (nc *NotebookCreate) AddNoteIDs(ids ...int) *NotebookCreate {
+	nc.mutation.AddNoteIDs(ids...)
+	return nc
+}
+
+// AddNotes adds the "notes" edges to the Note entity.
+func (nc *NotebookCreate) AddNotes(n ...*Note) *NotebookCreate {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return nc.AddNoteIDs(ids...)
+}
+
+// Mutation returns the NotebookMutation object of the builder.
+func (nc *NotebookCreate) Mutation() *NotebookMutation {
+	return nc.mutation
+}
+
+// Save creates the Notebook in the database.
+func (nc *NotebookCreate) Save(ctx context.Context) (*Notebook, error) {
+	nc.defaults()
+	return withHooks(ctx, nc.sqlSave, nc.mutation, nc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (nc *NotebookCreate) SaveX(ctx context.Context) *Notebook {
+	v, err := nc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (nc *NotebookCreate) Exec(ctx context.Context) error {
+	_, err := nc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nc *NotebookCreate) ExecX(ctx context.Context) {
+	if err := nc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (nc *NotebookCreate) defaults() {
+	if _, ok := nc.mutation.DefaultVisibility(); !ok {
+		v := notebook.DefaultDefaultVisibility
+		nc.mutation.SetDefaultVisibility(v)
+	}
+	if _, ok := nc.mutation.DefaultPermissionLevel(); !ok {
+		v := notebook.DefaultDefaultPermissionLevel
+		nc.mutation.SetDefaultPermissionLevel(v)
+	}
+	if _, ok := nc.mutation.CreatedAt(); !ok {
+		v := notebook.DefaultCreatedAt()
+		nc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nc *NotebookCreate) check() error {
+	if _, ok := nc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Notebook.name"`)}
+	}
+	if v, ok := nc.mutation.Name(); ok {
+		if err := notebook.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Notebook.name": %w`, err)}
+		}
+	}
+	if _, ok := nc.mutation.DefaultVisibility(); !ok {
+		return &ValidationError{Name: "default_visibility", err: errors.New(`ent: missing required field "Notebook.default_visibility"`)}
+	}
+	if v, ok := nc.mutation.DefaultVisibility(); ok {
+		if err := notebook.DefaultVisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "default_visibility", err: fmt.Errorf(`ent: validator failed for field "Notebook.default_visibility": %w`, err)}
+		}
+	}
+	if _, ok := nc.mutation.DefaultPermissionLevel(); !ok {
+		return &ValidationError{Name: "default_permission_level", err: errors.New(`ent: missing required field "Notebook.default_permission_level"`)}
+	}
+	if v, ok := nc.mutation.DefaultPermissionLevel(); ok {
+		if err := notebook.DefaultPermissionLevelValidator(v); err != nil {
+			return &ValidationError{Name: "default_permission_level", err: fmt.Errorf(`ent: validator failed for field "Notebook.default_permission_level": %w`, err)}
+		}
+	}
+	if _, ok := nc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Notebook.created_at"`)}
+	}
+	if len(nc.mutation.OwnerIDs()) == 0 {
+		return &ValidationError{Name: "owner", err: errors.New(`ent: missing required edge "Notebook.owner"`)}
+	}
+	return nil
+}
+
+func (nc *NotebookCreate) sqlSave(ctx context.Context) (*Notebook, error) {
+	if err := nc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := nc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, nc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	nc.mutation.id = &_node.ID
+	nc.mutation.done = true
+	return _node, nil
+}
+
+func (nc *NotebookCreate) createSpec() (*Notebook, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Notebook{config: nc.config}
+		_spec = sqlgraph.NewCreateSpec(notebook.Table, sqlgraph.NewFieldSpec(notebook.FieldID, field.TypeInt))
+	)
+	if value, ok := nc.mutation.Name(); ok {
+		_spec.SetField(notebook.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := nc.mutation.DefaultVisibility(); ok {
+		_spec.SetField(notebook.FieldDefaultVisibility, field.TypeEnum, value)
+		_node.DefaultVisibility = value
+	}
+	if value, ok := nc.mutation.DefaultPermissionLevel(); ok {
+		_spec.SetField(notebook.FieldDefaultPermissionLevel, field.TypeEnum, value)
+		_node.DefaultPermissionLevel = value
+	}
+	if value, ok := nc.mutation.CreatedAt(); ok {
+		_spec.SetField(notebook.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := nc.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notebook.OwnerTable,
+			Columns: []string{notebook.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_notebooks = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := nc.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notebook.ParentTable,
+			Columns: []string{notebook.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notebook.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.notebook_children = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := nc.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   notebook.ChildrenTable,
+			Columns: []string{notebook.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notebook.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := nc.mutation.NotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   notebook.NotesTable,
+			Columns: notebook.NotesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// NotebookCreateBulk is the builder for creating many Notebook entities in bulk.
+type NotebookCreateBulk struct {
+	config
+	err      error
+	builders []*NotebookCreate
+}
+
+// Save creates the Notebook entities in the database.
+func (ncb *NotebookCreateBulk) Save(ctx context.Context) ([]*Notebook, error) {
+	if ncb.err != nil {
+		return nil, ncb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ncb.builders))
+	nodes := make([]*Notebook, len(ncb.builders))
+	mutators := make([]Mutator, len(ncb.builders))
+	for i := range ncb.builders {
+		func(i int, root context.Context) {
+			builder := ncb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*NotebookMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ncb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ncb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ncb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ncb *NotebookCreateBulk) SaveX(ctx context.Context) []*Notebook {
+	v, err := ncb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ncb *NotebookCreateBulk) Exec(ctx context.Context) error {
+	_, err := ncb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ncb *NotebookCreateBulk) ExecX(ctx context.Context) {
+	if err := ncb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,855 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/r-scheele/zero/ent/note"
+	"github.com/r-scheele/zero/ent/notebook"
+	"github.com/r-scheele/zero/ent/predicate"
+	"github.com/r-scheele/zero/ent/user"
+)
+
+// NotebookUpdate is the builder for updating Notebook entities.
+type NotebookUpdate struct {
+	config
+	hooks    []Hook
+	mutation *NotebookMutation
+}
+
+// Where appends a list predicates to the NotebookUpdate builder.
+func (nu *NotebookUpdate) Where(ps ...predicate.Notebook) *NotebookUpdate {
+	nu.mutation.Where(ps...)
+	return nu
+}
+
+// SetName sets the "name" field.
+func (nu *NotebookUpdate) SetName(s string) *NotebookUpdate {
+	nu.mutation.SetName(s)
+	return nu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (nu *NotebookUpdate) SetNillableName(s *string) *NotebookUpdate {
+	if s != nil {
+		nu.SetName(*s)
+	}
+	return nu
+}
+
+// SetDefaultVisibility sets the "default_visibility" field.
+func (nu *NotebookUpdate) SetDefaultVisibility(nv notebook.DefaultVisibility) *NotebookUpdate {
+	nu.mutation.SetDefaultVisibility(nv)
+	return nu
+}
+
+// SetNillableDefaultVisibility sets the "default_visibility" field if the given value is not nil.
+func (nu *NotebookUpdate) SetNillableDefaultVisibility(nv *notebook.DefaultVisibility) *NotebookUpdate {
+	if nv != nil {
+		nu.SetDefaultVisibility(*nv)
+	}
+	return nu
+}
+
+// SetDefaultPermissionLevel sets the "default_permission_level" field.
+func (nu *NotebookUpdate) SetDefaultPermissionLevel(npl notebook.DefaultPermissionLevel) *NotebookUpdate {
+	nu.mutation.SetDefaultPermissionLevel(npl)
+	return nu
+}
+
+// SetNillableDefaultPermissionLevel sets the "default_permission_level" field if the given value is not nil.
+func (nu *NotebookUpdate) SetNillableDefaultPermissionLevel(npl *notebook.DefaultPermissionLevel) *NotebookUpdate {
+	if npl != nil {
+		nu.SetDefaultPermissionLevel(*npl)
+	}
+	return nu
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (nu *NotebookUpdate) SetOwnerID(id int) *NotebookUpdate {
+	nu.mutation.SetOwnerID(id)
+	return nu
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (nu *NotebookUpdate) SetOwner(u *User) *NotebookUpdate {
+	return nu.SetOwnerID(u.ID)
+}
+
+// SetParentID sets the "parent" edge to the Notebook entity by ID.
+func (nu *NotebookUpdate) SetParentID(id int) *NotebookUpdate {
+	nu.mutation.SetParentID(id)
+	return nu
+}
+
+// SetNillableParentID sets the "parent" edge to the Notebook entity by ID if the given value is not nil.
+func (nu *NotebookUpdate) SetNillableParentID(id *int) *NotebookUpdate {
+	if id != nil {
+		nu = nu.SetParentID(*id)
+	}
+	return nu
+}
+
+// SetParent sets the "parent" edge to the Notebook entity.
+func (nu *NotebookUpdate) SetParent(n *Notebook) *NotebookUpdate {
+	return nu.SetParentID(n.ID)
+}
+
+// AddChildIDs adds the "children" edge to the Notebook entity by IDs.
+func (nu *NotebookUpdate) AddChildIDs(ids ...int) *NotebookUpdate {
+	nu.mutation.AddChildIDs(ids...)
+	return nu
+}
+
+// AddChildren adds the "children" edges to the Notebook entity.
+func (nu *NotebookUpdate) AddChildren(n ...*Notebook) *NotebookUpdate {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return nu.AddChildIDs(ids...)
+}
+
+// AddNoteIDs adds the "notes" edge to the Note entity by IDs.
+func (nu *NotebookUpdate) AddNoteIDs(ids ...int) *NotebookUpdate {
+	nu.mutation.AddNoteIDs(ids...)
+	return nu
+}
+
+// AddNotes adds the "notes" edges to the Note entity.
+func (nu *NotebookUpdate) AddNotes(n ...*Note) *NotebookUpdate {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return nu.AddNoteIDs(ids...)
+}
+
+// Mutation returns the NotebookMutation object of the builder.
+func (nu *NotebookUpdate) Mutation() *NotebookMutation {
+	return nu.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (nu *NotebookUpdate) ClearOwner() *NotebookUpdate {
+	nu.mutation.ClearOwner()
+	return nu
+}
+
+// ClearParent clears the "parent" edge to the Notebook entity.
+func (nu *NotebookUpdate) ClearParent() *NotebookUpdate {
+	nu.mutation.ClearParent()
+	return nu
+}
+
+// ClearChildren clears all "children" edges to the Notebook entity.
+func (nu *NotebookUpdate) ClearChildren() *NotebookUpdate {
+	nu.mutation.ClearChildren()
+	return nu
+}
+
+// RemoveChildIDs removes the "children" edge to Notebook entities by IDs.
+func (nu *NotebookUpdate) RemoveChildIDs(ids ...int) *NotebookUpdate {
+	nu.mutation.RemoveChildIDs(ids...)
+	return nu
+}
+
+// RemoveChildren removes "children" edges to Notebook entities.
+func (nu *NotebookUpdate) RemoveChildren(n ...*Notebook) *NotebookUpdate {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return nu.RemoveChildIDs(ids...)
+}
+
+// ClearNotes clears all "notes" edges to the Note entity.
+func (nu *NotebookUpdate) ClearNotes() *NotebookUpdate {
+	nu.mutation.ClearNotes()
+	return nu
+}
+
+// RemoveNoteIDs removes the "notes" edge to Note entities by IDs.
+func (nu *NotebookUpdate) RemoveNoteIDs(ids ...int) *NotebookUpdate {
+	nu.mutation.RemoveNoteIDs(ids...)
+	return nu
+}
+
+// RemoveNotes removes "notes" edges to Note entities.
+func (nu *NotebookUpdate) RemoveNotes(n ...*Note) *NotebookUpdate {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return nu.RemoveNoteIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (nu *NotebookUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, nu.sqlSave, nu.mutation, nu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nu *NotebookUpdate) SaveX(ctx context.Context) int {
+	affected, err := nu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (nu *NotebookUpdate) Exec(ctx context.Context) error {
+	_, err := nu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nu *NotebookUpdate) ExecX(ctx context.Context) {
+	if err := nu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nu *NotebookUpdate) check() error {
+	if v, ok := nu.mutation.Name(); ok {
+		if err := notebook.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Notebook.name": %w`, err)}
+		}
+	}
+	if v, ok := nu.mutation.DefaultVisibility(); ok {
+		if err := notebook.DefaultVisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "default_visibility", err: fmt.Errorf(`ent: validator failed for field "Notebook.default_visibility": %w`, err)}
+		}
+	}
+	if v, ok := nu.mutation.DefaultPermissionLevel(); ok {
+		if err := notebook.DefaultPermissionLevelValidator(v); err != nil {
+			return &ValidationError{Name: "default_permission_level", err: fmt.Errorf(`ent: validator failed for field "Notebook.default_permission_level": %w`, err)}
+		}
+	}
+	if nu.mutation.OwnerCleared() && len(nu.mutation.OwnerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Notebook.owner"`)
+	}
+	return nil
+}
+
+func (nu *NotebookUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := nu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notebook.Table, notebook.Columns, sqlgraph.NewFieldSpec(notebook.FieldID, field.TypeInt))
+	if ps := nu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := nu.mutation.Name(); ok {
+		_spec.SetField(notebook.FieldName, field.TypeString, value)
+	}
+	if value, ok := nu.mutation.DefaultVisibility(); ok {
+		_spec.SetField(notebook.FieldDefaultVisibility, field.TypeEnum, value)
+	}
+	if value, ok := nu.mutation.DefaultPermissionLevel(); ok {
+		_spec.SetField(notebook.FieldDefaultPermissionLevel, field.TypeEnum, value)
+	}
+	if nu.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notebook.OwnerTable,
+			Columns: []string{notebook.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nu.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notebook.OwnerTable,
+			Columns: []string{notebook.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nu.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notebook.ParentTable,
+			Columns: []string{notebook.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notebook.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nu.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notebook.ParentTable,
+			Columns: []string{notebook.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notebook.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nu.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   notebook.ChildrenTable,
+			Columns: []string{notebook.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notebook.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nu.mutation.RemovedChildrenIDs(); len(nodes) > 0 && !nu.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   notebook.ChildrenTable,
+			Columns: []string{notebook.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notebook.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nu.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   notebook.ChildrenTable,
+			Columns: []string{notebook.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notebook.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nu.mutation.NotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   notebook.NotesTable,
+			Columns: notebook.NotesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nu.mutation.RemovedNotesIDs(); len(nodes) > 0 && !nu.mutation.NotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   notebook.NotesTable,
+			Columns: notebook.NotesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nu.mutation.NotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   notebook.NotesTable,
+			Columns: notebook.NotesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, nu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notebook.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	nu.mutation.done = true
+	return n, nil
+}
+
+// NotebookUpdateOne is the builder for updating a single Notebook entity.
+type NotebookUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *NotebookMutation
+}
+
+// SetName sets the "name" field.
+func (nuo *NotebookUpdateOne) SetName(s string) *NotebookUpdateOne {
+	nuo.mutation.SetName(s)
+	return nuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (nuo *NotebookUpdateOne) SetNillableName(s *string) *NotebookUpdateOne {
+	if s != nil {
+		nuo.SetName(*s)
+	}
+	return nuo
+}
+
+// SetDefaultVisibility sets the "default_visibility" field.
+func (nuo *NotebookUpdateOne) SetDefaultVisibility(nv notebook.DefaultVisibility) *NotebookUpdateOne {
+	nuo.mutation.SetDefaultVisibility(nv)
+	return nuo
+}
+
+// SetNillableDefaultVisibility sets the "default_visibility" field if the given value is not nil.
+func (nuo *NotebookUpdateOne) SetNillableDefaultVisibility(nv *notebook.DefaultVisibility) *NotebookUpdateOne {
+	if nv != nil {
+		nuo.SetDefaultVisibility(*nv)
+	}
+	return nuo
+}
+
+// SetDefaultPermissionLevel sets the "default_permission_level" field.
+func (nuo *NotebookUpdateOne) SetDefaultPermissionLevel(npl notebook.DefaultPermissionLevel) *NotebookUpdateOne {
+	nuo.mutation.SetDefaultPermissionLevel(npl)
+	return nuo
+}
+
+// SetNillableDefaultPermissionLevel sets the "default_permission_level" field if the given value is not nil.
+func (nuo *NotebookUpdateOne) SetNillableDefaultPermissionLevel(npl *notebook.DefaultPermissionLevel) *NotebookUpdateOne {
+	if npl != nil {
+		nuo.SetDefaultPermissionLevel(*npl)
+	}
+	return nuo
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (nuo *NotebookUpdateOne) SetOwnerID(id int) *NotebookUpdateOne {
+	nuo.mutation.SetOwnerID(id)
+	return nuo
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (nuo *NotebookUpdateOne) SetOwner(u *User) *NotebookUpdateOne {
+	return nuo.SetOwnerID(u.ID)
+}
+
+// SetParentID sets the "parent" edge to the Notebook entity by ID.
+func (nuo *NotebookUpdateOne) SetParentID(id int) *NotebookUpdateOne {
+	nuo.mutation.SetParentID(id)
+	return nuo
+}
+
+// SetNillableParentID sets the "parent" edge to the Notebook entity by ID if the given value is not nil.
+func (nuo *NotebookUpdateOne) SetNillableParentID(id *int) *NotebookUpdateOne {
+	if id != nil {
+		nuo = nuo.SetParentID(*id)
+	}
+	return nuo
+}
+
+// SetParent sets the "parent" edge to the Notebook entity.
+func (nuo *NotebookUpdateOne) SetParent(n *Notebook) *NotebookUpdateOne {
+	return nuo.SetParentID(n.ID)
+}
+
+// AddChildIDs adds the "children" edge to the Notebook entity by IDs.
+func (nuo *NotebookUpdateOne) AddChildIDs(ids ...int) *NotebookUpdateOne {
+	nuo.mutation.AddChildIDs(ids...)
+	return nuo
+}
+
+// AddChildren adds the "children" edges to the Notebook entity.
+func (nuo *NotebookUpdateOne) AddChildren(n ...*Notebook) *NotebookUpdateOne {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return nuo.AddChildIDs(ids...)
+}
+
+// AddNoteIDs adds the "notes" edge to the Note entity by IDs.
+func (nuo *NotebookUpdateOne) AddNoteIDs(ids ...int) *NotebookUpdateOne {
+	nuo.mutation.AddNoteIDs(ids...)
+	return nuo
+}
+
+// AddNotes adds the "notes" edges to the Note entity.
+func (nuo *NotebookUpdateOne) AddNotes(n ...*Note) *NotebookUpdateOne {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return nuo.AddNoteIDs(ids...)
+}
+
+// Mutation returns the NotebookMutation object of the builder.
+func (nuo *NotebookUpdateOne) Mutation() *NotebookMutation {
+	return nuo.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (nuo *NotebookUpdateOne) ClearOwner() *NotebookUpdateOne {
+	nuo.mutation.ClearOwner()
+	return nuo
+}
+
+// ClearParent clears the "parent" edge to the Notebook entity.
+func (nuo *NotebookUpdateOne) ClearParent() *NotebookUpdateOne {
+	nuo.mutation.ClearParent()
+	return nuo
+}
+
+// ClearChildren clears all "children" edges to the Notebook entity.
+func (nuo *NotebookUpdateOne) ClearChildren() *NotebookUpdateOne {
+	nuo.mutation.ClearChildren()
+	return nuo
+}
+
+// RemoveChildIDs removes the "children" edge to Notebook entities by IDs.
+func (nuo *NotebookUpdateOne) RemoveChildIDs(ids ...int) *NotebookUpdateOne {
+	nuo.mutation.RemoveChildIDs(ids...)
+	return nuo
+}
+
+// RemoveChildren removes "children" edges to Notebook entities.
+func (nuo *NotebookUpdateOne) RemoveChildren(n ...*Notebook) *NotebookUpdateOne {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return nuo.RemoveChildIDs(ids...)
+}
+
+// ClearNotes clears all "notes" edges to the Note entity.
+func (nuo *NotebookUpdateOne) ClearNotes() *NotebookUpdateOne {
+	nuo.mutation.ClearNotes()
+	return nuo
+}
+
+// RemoveNoteIDs removes the "notes" edge to Note entities by IDs.
+func (nuo *NotebookUpdateOne) RemoveNoteIDs(ids ...int) *NotebookUpdateOne {
+	nuo.mutation.RemoveNoteIDs(ids...)
+	return nuo
+}
+
+// RemoveNotes removes "notes" edges to Note entities.
+func (nuo *NotebookUpdateOne) RemoveNotes(n ...*Note) *NotebookUpdateOne {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return nuo.RemoveNoteIDs(ids...)
+}
+
+// Where appends a list predicates to the NotebookUpdate builder.
+func (nuo *NotebookUpdateOne) Where(ps ...predicate.Notebook) *NotebookUpdateOne {
+	nuo.mutation.Where(ps...)
+	return nuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (nuo *NotebookUpdateOne) Select(field string, fields ...string) *NotebookUpdateOne {
+	nuo.fields = append([]string{field}, fields...)
+	return nuo
+}
+
+// Save executes the query and returns the updated Notebook entity.
+func (nuo *NotebookUpdateOne) Save(ctx context.Context) (*Notebook, error) {
+	return withHooks(ctx, nuo.sqlSave, nuo.mutation, nuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nuo *NotebookUpdateOne) SaveX(ctx context.Context) *Notebook {
+	node, err := nuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (nuo *NotebookUpdateOne) Exec(ctx context.Context) error {
+	_, err := nuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nuo *NotebookUpdateOne) ExecX(ctx context.Context) {
+	if err := nuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nuo *NotebookUpdateOne) check() error {
+	if v, ok := nuo.mutation.Name(); ok {
+		if err := notebook.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Notebook.name": %w`, err)}
+		}
+	}
+	if v, ok := nuo.mutation.DefaultVisibility(); ok {
+		if err := notebook.DefaultVisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "default_visibility", err: fmt.Errorf(`ent: validator failed for field "Notebook.default_visibility": %w`, err)}
+		}
+	}
+	if v, ok := nuo.mutation.DefaultPermissionLevel(); ok {
+		if err := notebook.DefaultPermissionLevelValidator(v); err != nil {
+			return &ValidationError{Name: "default_permission_level", err: fmt.Errorf(`ent: validator failed for field "Notebook.default_permission_level": %w`, err)}
+		}
+	}
+	if nuo.mutation.OwnerCleared() && len(nuo.mutation.OwnerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Notebook.owner"`)
+	}
+	return nil
+}
+
+func (nuo *NotebookUpdateOne) sqlSave(ctx context.Context) (_node *Notebook, err error) {
+	if err := nuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notebook.Table, notebook.Columns, sqlgraph.NewFieldSpec(notebook.FieldID, field.TypeInt))
+	id, ok := nuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Notebook.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := nuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, notebook.FieldID)
+		for _, f := range fields {
+			if !notebook.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != notebook.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := nuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := nuo.mutation.Name(); ok {
+		_spec.SetField(notebook.FieldName, field.TypeString, value)
+	}
+	if value, ok := nuo.mutation.DefaultVisibility(); ok {
+		_spec.SetField(notebook.FieldDefaultVisibility, field.TypeEnum, value)
+	}
+	if value, ok := nuo.mutation.DefaultPermissionLevel(); ok {
+		_spec.SetField(notebook.FieldDefaultPermissionLevel, field.TypeEnum, value)
+	}
+	if nuo.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notebook.OwnerTable,
+			Columns: []string{notebook.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nuo.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notebook.OwnerTable,
+			Columns: []string{notebook.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nuo.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notebook.ParentTable,
+			Columns: []string{notebook.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notebook.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nuo.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notebook.ParentTable,
+			Columns: []string{notebook.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notebook.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nuo.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   notebook.ChildrenTable,
+			Columns: []string{notebook.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notebook.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nuo.mutation.RemovedChildrenIDs(); len(nodes) > 0 && !nuo.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   notebook.ChildrenTable,
+			Columns: []string{notebook.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notebook.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nuo.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   notebook.ChildrenTable,
+			Columns: []string{notebook.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notebook.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nuo.mutation.NotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   notebook.NotesTable,
+			Columns: notebook.NotesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nuo.mutation.RemovedNotesIDs(); len(nodes) > 0 && !nuo.mutation.NotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   notebook.NotesTable,
+			Columns: notebook.NotesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nuo.mutation.NotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   notebook.NotesTable,
+			Columns: notebook.NotesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Notebook{config: nuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, nuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notebook.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	nuo.mutation.done = true
+	return _node, nil
+}
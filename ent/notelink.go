@@ -0,0 +1,170 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/r-scheele/zero/ent/note"
+	"github.com/r-scheele/zero/ent/notelink"
+)
+
+// NoteLink is the model entity for the NoteLink schema.
+type NoteLink struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Note title or note:ID the link was written with, between [[ and ]]
+	Target string `json:"target,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the NoteLinkQuery when eager-loading is set.
+	Edges          NoteLinkEdges `json:"edges"`
+	note_links     *int
+	note_backlinks *int
+	selectValues   sql.SelectValues
+}
+
+// NoteLinkEdges holds the relations/edges for other nodes in the graph.
+type NoteLinkEdges struct {
+	// Source holds the value of the source edge.
+	Source *Note `json:"source,omitempty"`
+	// Note the link points to, or none when the link is broken
+	Note *Note `json:"note,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// SourceOrErr returns the Source value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e NoteLinkEdges) SourceOrErr() (*Note, error) {
+	if e.Source != nil {
+		return e.Source, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: note.Label}
+	}
+	return nil, &NotLoadedError{edge: "source"}
+}
+
+// NoteOrErr returns the Note value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e NoteLinkEdges) NoteOrErr() (*Note, error) {
+	if e.Note != nil {
+		return e.Note, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: note.Label}
+	}
+	return nil, &NotLoadedError{edge: "note"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*NoteLink) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case notelink.FieldID:
+			values[i] = new(sql.NullInt64)
+		case notelink.FieldTarget:
+			values[i] = new(sql.NullString)
+		case notelink.ForeignKeys[0]: // note_links
+			values[i] = new(sql.NullInt64)
+		case notelink.ForeignKeys[1]: // note_backlinks
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the NoteLink fields.
+func (nl *NoteLink) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case notelink.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			nl.ID = int(value.Int64)
+		case notelink.FieldTarget:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field target", values[i])
+			} else if value.Valid {
+				nl.Target = value.String
+			}
+		case notelink.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field note_links", value)
+			} else if value.Valid {
+				nl.note_links = new(int)
+				*nl.note_links = int(value.Int64)
+			}
+		case notelink.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field note_backlinks", value)
+			} else if value.Valid {
+				nl.note_backlinks = new(int)
+				*nl.note_backlinks = int(value.Int64)
+			}
+		default:
+			nl.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the NoteLink.
+// This includes values selected through modifiers, order, etc.
+func (nl *NoteLink) Value(name string) (ent.Value, error) {
+	return nl.selectValues.Get(name)
+}
+
+// QuerySource queries the "source" edge of the NoteLink entity.
+func (nl *NoteLink) QuerySource() *NoteQuery {
+	return NewNoteLinkClient(nl.config).QuerySource(nl)
+}
+
+// QueryNote queries the "note" edge of the NoteLink entity.
+func (nl *NoteLink) QueryNote() *NoteQuery {
+	return NewNoteLinkClient(nl.config).QueryNote(nl)
+}
+
+// Update returns a builder for updating this NoteLink.
+// Note that you need to call NoteLink.Unwrap() before calling this method if this NoteLink
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (nl *NoteLink) Update() *NoteLinkUpdateOne {
+	return NewNoteLinkClient(nl.config).UpdateOne(nl)
+}
+
+// Unwrap unwraps the NoteLink entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (nl *NoteLink) Unwrap() *NoteLink {
+	_tx, ok := nl.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: NoteLink is not a transactional entity")
+	}
+	nl.config.driver = _tx.drv
+	return nl
+}
+
+// String implements the fmt.Stringer.
+func (nl *NoteLink) String() string {
+	var builder strings.Builder
+	builder.WriteString("NoteLink(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", nl.ID))
+	builder.WriteString("target=")
+	builder.WriteString(nl.Target)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NoteLinks is a parsable slice of NoteLink.
+type NoteLinks []*NoteLink
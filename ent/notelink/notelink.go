@@ -0,0 +1,111 @@
+// Code generated by ent, DO NOT EDIT.
+
+package notelink
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the notelink type in the database.
+	Label = "note_link"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTarget holds the string denoting the target field in the database.
+	FieldTarget = "target"
+	// EdgeSource holds the string denoting the source edge name in mutations.
+	EdgeSource = "source"
+	// EdgeNote holds the string denoting the note edge name in mutations.
+	EdgeNote = "note"
+	// Table holds the table name of the notelink in the database.
+	Table = "note_links"
+	// SourceTable is the table that holds the source relation/edge.
+	SourceTable = "note_links"
+	// SourceInverseTable is the table name for the Note entity.
+	// It exists in this package in order to avoid circular dependency with the "note" package.
+	SourceInverseTable = "notes"
+	// SourceColumn is the table column denoting the source relation/edge.
+	SourceColumn = "note_links"
+	// NoteTable is the table that holds the note relation/edge.
+	NoteTable = "note_links"
+	// NoteInverseTable is the table name for the Note entity.
+	// It exists in this package in order to avoid circular dependency with the "note" package.
+	NoteInverseTable = "notes"
+	// NoteColumn is the table column denoting the note relation/edge.
+	NoteColumn = "note_backlinks"
+)
+
+// Columns holds all SQL columns for notelink fields.
+var Columns = []string{
+	FieldID,
+	FieldTarget,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "note_links"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"note_links",
+	"note_backlinks",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// TargetValidator is a validator for the "target" field. It is called by the builders before save.
+	TargetValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the NoteLink queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTarget orders the results by the target field.
+func ByTarget(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTarget, opts...).ToFunc()
+}
+
+// BySourceField orders the results by source field.
+func BySourceField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSourceStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByNoteField orders the results by note field.
+func ByNoteField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newNoteStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newSourceStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SourceInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, SourceTable, SourceColumn),
+	)
+}
+func newNoteStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(NoteInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, NoteTable, NoteColumn),
+	)
+}
@@ -0,0 +1,256 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/r-scheele/zero/ent/note"
+	"github.com/r-scheele/zero/ent/notelink"
+)
+
+// NoteLinkCreate is the builder for creating a NoteLink entity.
+type NoteLinkCreate struct {
+	config
+	mutation *NoteLinkMutation
+	hooks    []Hook
+}
+
+// SetTarget sets the "target" field.
+func (nlc *NoteLinkCreate) SetTarget(s string) *NoteLinkCreate {
+	nlc.mutation.SetTarget(s)
+	return nlc
+}
+
+// SetSourceID sets the "source" edge to the Note entity by ID.
+func (nlc *NoteLinkCreate) SetSourceID(id int) *NoteLinkCreate {
+	nlc.mutation.SetSourceID(id)
+	return nlc
+}
+
+// SetSource sets the "source" edge to the Note entity.
+func (nlc *NoteLinkCreate) SetSource(n *Note) *NoteLinkCreate {
+	return nlc.SetSourceID(n.ID)
+}
+
+// SetNoteID sets the "note" edge to the Note entity by ID.
+func (nlc *NoteLinkCreate) SetNoteID(id int) *NoteLinkCreate {
+	nlc.mutation.SetNoteID(id)
+	return nlc
+}
+
+// SetNillableNoteID sets the "note" edge to the Note entity by ID if the given value is not nil.
+func (nlc *NoteLinkCreate) SetNillableNoteID(id *int) *NoteLinkCreate {
+	if id != nil {
+		nlc = nlc.SetNoteID(*id)
+	}
+	return nlc
+}
+
+// SetNote sets the "note" edge to the Note entity.
+func (nlc *NoteLinkCreate) SetNote(n *Note) *NoteLinkCreate {
+	return nlc.SetNoteID(n.ID)
+}
+
+// Mutation returns the NoteLinkMutation object of the builder.
+func (nlc *NoteLinkCreate) Mutation() *NoteLinkMutation {
+	return nlc.mutation
+}
+
+// Save creates the NoteLink in the database.
+func (nlc *NoteLinkCreate) Save(ctx context.Context) (*NoteLink, error) {
+	return withHooks(ctx, nlc.sqlSave, nlc.mutation, nlc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (nlc *NoteLinkCreate) SaveX(ctx context.Context) *NoteLink {
+	v, err := nlc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (nlc *NoteLinkCreate) Exec(ctx context.Context) error {
+	_, err := nlc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nlc *NoteLinkCreate) ExecX(ctx context.Context) {
+	if err := nlc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nlc *NoteLinkCreate) check() error {
+	if _, ok := nlc.mutation.Target(); !ok {
+		return &ValidationError{Name: "target", err: errors.New(`ent: missing required field "NoteLink.target"`)}
+	}
+	if v, ok := nlc.mutation.Target(); ok {
+		if err := notelink.TargetValidator(v); err != nil {
+			return &ValidationError{Name: "target", err: fmt.Errorf(`ent: validator failed for field "NoteLink.target": %w`, err)}
+		}
+	}
+	if len(nlc.mutation.SourceIDs()) == 0 {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required edge "NoteLink.source"`)}
+	}
+	return nil
+}
+
+func (nlc *NoteLinkCreate) sqlSave(ctx context.Context) (*NoteLink, error) {
+	if err := nlc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := nlc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, nlc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	nlc.mutation.id = &_node.ID
+	nlc.mutation.done = true
+	return _node, nil
+}
+
+func (nlc *NoteLinkCreate) createSpec() (*NoteLink, *sqlgraph.CreateSpec) {
+	var (
+		_node = &NoteLink{config: nlc.config}
+		_spec = sqlgraph.NewCreateSpec(notelink.Table, sqlgraph.NewFieldSpec(notelink.FieldID, field.TypeInt))
+	)
+	if value, ok := nlc.mutation.Target(); ok {
+		_spec.SetField(notelink.FieldTarget, field.TypeString, value)
+		_node.Target = value
+	}
+	if nodes := nlc.mutation.SourceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notelink.SourceTable,
+			Columns: []string{notelink.SourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.note_links = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := nlc.mutation.NoteIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notelink.NoteTable,
+			Columns: []string{notelink.NoteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.note_backlinks = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// NoteLinkCreateBulk is the builder for creating many NoteLink entities in bulk.
+type NoteLinkCreateBulk struct {
+	config
+	err      error
+	builders []*NoteLinkCreate
+}
+
+// Save creates the NoteLink entities in the database.
+func (nlcb *NoteLinkCreateBulk) Save(ctx context.Context) ([]*NoteLink, error) {
+	if nlcb.err != nil {
+		return nil, nlcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(nlcb.builders))
+	nodes := make([]*NoteLink, len(nlcb.builders))
+	mutators := make([]Mutator, len(nlcb.builders))
+	for i := range nlcb.builders {
+		func(i int, root context.Context) {
+			builder := nlcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*NoteLinkMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, nlcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, nlcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, nlcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nlcb *NoteLinkCreateBulk) SaveX(ctx context.Context) []*NoteLink {
+	v, err := nlcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (nlcb *NoteLinkCreateBulk) Exec(ctx context.Context) error {
+	_, err := nlcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nlcb *NoteLinkCreateBulk) ExecX(ctx context.Context) {
+	if err := nlcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/r-scheele/zero/ent/notelink"
+	"github.com/r-scheele/zero/ent/predicate"
+)
+
+// NoteLinkDelete is the builder for deleting a NoteLink entity.
+type NoteLinkDelete struct {
+	config
+	hooks    []Hook
+	mutation *NoteLinkMutation
+}
+
+// Where appends a list predicates to the NoteLinkDelete builder.
+func (nld *NoteLinkDelete) Where(ps ...predicate.NoteLink) *NoteLinkDelete {
+	nld.mutation.Where(ps...)
+	return nld
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (nld *NoteLinkDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, nld.sqlExec, nld.mutation, nld.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nld *NoteLinkDelete) ExecX(ctx context.Context) int {
+	n, err := nld.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (nld *NoteLinkDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(notelink.Table, sqlgraph.NewFieldSpec(notelink.FieldID, field.TypeInt))
+	if ps := nld.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, nld.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	nld.mutation.done = true
+	return affected, err
+}
+
+// NoteLinkDeleteOne is the builder for deleting a single NoteLink entity.
+type NoteLinkDeleteOne struct {
+	nld *NoteLinkDelete
+}
+
+// Where appends a list predicates to the NoteLinkDelete builder.
+func (nldo *NoteLinkDeleteOne) Where(ps ...predicate.NoteLink) *NoteLinkDeleteOne {
+	nldo.nld.mutation.Where(ps...)
+	return nldo
+}
+
+// Exec executes the deletion query.
+func (nldo *NoteLinkDeleteOne) Exec(ctx context.Context) error {
+	n, err := nldo.nld.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{notelink.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nldo *NoteLinkDeleteOne) ExecX(ctx context.Context) {
+	if err := nldo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
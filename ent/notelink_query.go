@@ -0,0 +1,688 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/r-scheele/zero/ent/note"
+	"github.com/r-scheele/zero/ent/notelink"
+	"github.com/r-scheele/zero/ent/predicate"
+)
+
+// NoteLinkQuery is the builder for querying NoteLink entities.
+type NoteLinkQuery struct {
+	config
+	ctx        *QueryContext
+	order      []notelink.OrderOption
+	inters     []Interceptor
+	predicates []predicate.NoteLink
+	withSource *NoteQuery
+	withNote   *NoteQuery
+	withFKs    bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the NoteLinkQuery builder.
+func (nlq *NoteLinkQuery) Where(ps ...predicate.NoteLink) *NoteLinkQuery {
+	nlq.predicates = append(nlq.predicates, ps...)
+	return nlq
+}
+
+// Limit the number of records to be returned by this query.
+func (nlq *NoteLinkQuery) Limit(limit int) *NoteLinkQuery {
+	nlq.ctx.Limit = &limit
+	return nlq
+}
+
+// Offset to start from.
+func (nlq *NoteLinkQuery) Offset(offset int) *NoteLinkQuery {
+	nlq.ctx.Offset = &offset
+	return nlq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (nlq *NoteLinkQuery) Unique(unique bool) *NoteLinkQuery {
+	nlq.ctx.Unique = &unique
+	return nlq
+}
+
+// Order specifies how the records should be ordered.
+func (nlq *NoteLinkQuery) Order(o ...notelink.OrderOption) *NoteLinkQuery {
+	nlq.order = append(nlq.order, o...)
+	return nlq
+}
+
+// QuerySource chains the current query on the "source" edge.
+func (nlq *NoteLinkQuery) QuerySource() *NoteQuery {
+	query := (&NoteClient{config: nlq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := nlq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := nlq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(notelink.Table, notelink.FieldID, selector),
+			sqlgraph.To(note.Table, note.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, notelink.SourceTable, notelink.SourceColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(nlq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryNote chains the current query on the "note" edge.
+func (nlq *NoteLinkQuery) QueryNote() *NoteQuery {
+	query := (&NoteClient{config: nlq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := nlq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := nlq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(notelink.Table, notelink.FieldID, selector),
+			sqlgraph.To(note.Table, note.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, notelink.NoteTable, notelink.NoteColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(nlq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first NoteLink entity from the query.
+// Returns a *NotFoundError when no NoteLink was found.
+func (nlq *NoteLinkQuery) First(ctx context.Context) (*NoteLink, error) {
+	nodes, err := nlq.Limit(1).All(setContextOp(ctx, nlq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{notelink.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (nlq *NoteLinkQuery) FirstX(ctx context.Context) *NoteLink {
+	node, err := nlq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first NoteLink ID from the query.
+// Returns a *NotFoundError when no NoteLink ID was found.
+func (nlq *NoteLinkQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = nlq.Limit(1).IDs(setContextOp(ctx, nlq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{notelink.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (nlq *NoteLinkQuery) FirstIDX(ctx context.Context) int {
+	id, err := nlq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single NoteLink entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one NoteLink entity is found.
+// Returns a *NotFoundError when no NoteLink entities are found.
+func (nlq *NoteLinkQuery) Only(ctx context.Context) (*NoteLink, error) {
+	nodes, err := nlq.Limit(2).All(setContextOp(ctx, nlq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{notelink.Label}
+	default:
+		return nil, &NotSingularError{notelink.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (nlq *NoteLinkQuery) OnlyX(ctx context.Context) *NoteLink {
+	node, err := nlq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only NoteLink ID in the query.
+// Returns a *NotSingularError when more than one NoteLink ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (nlq *NoteLinkQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = nlq.Limit(2).IDs(setContextOp(ctx, nlq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{notelink.Label}
+	default:
+		err = &NotSingularError{notelink.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (nlq *NoteLinkQuery) OnlyIDX(ctx context.Context) int {
+	id, err := nlq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of NoteLinks.
+func (nlq *NoteLinkQuery) All(ctx context.Context) ([]*NoteLink, error) {
+	ctx = setContextOp(ctx, nlq.ctx, ent.OpQueryAll)
+	if err := nlq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*NoteLink, *NoteLinkQuery]()
+	return withInterceptors[[]*NoteLink](ctx, nlq, qr, nlq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (nlq *NoteLinkQuery) AllX(ctx context.Context) []*NoteLink {
+	nodes, err := nlq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of NoteLink IDs.
+func (nlq *NoteLinkQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if nlq.ctx.Unique == nil && nlq.path != nil {
+		nlq.Unique(true)
+	}
+	ctx = setContextOp(ctx, nlq.ctx, ent.OpQueryIDs)
+	if err = nlq.Select(notelink.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (nlq *NoteLinkQuery) IDsX(ctx context.Context) []int {
+	ids, err := nlq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (nlq *NoteLinkQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, nlq.ctx, ent.OpQueryCount)
+	if err := nlq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, nlq, querierCount[*NoteLinkQuery](), nlq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (nlq *NoteLinkQuery) CountX(ctx context.Context) int {
+	count, err := nlq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (nlq *NoteLinkQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, nlq.ctx, ent.OpQueryExist)
+	switch _, err := nlq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (nlq *NoteLinkQuery) ExistX(ctx context.Context) bool {
+	exist, err := nlq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the NoteLinkQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (nlq *NoteLinkQuery) Clone() *NoteLinkQuery {
+	if nlq == nil {
+		return nil
+	}
+	return &NoteLinkQuery{
+		config:     nlq.config,
+		ctx:        nlq.ctx.Clone(),
+		order:      append([]notelink.OrderOption{}, nlq.order...),
+		inters:     append([]Interceptor{}, nlq.inters...),
+		predicates: append([]predicate.NoteLink{}, nlq.predicates...),
+		withSource: nlq.withSource.Clone(),
+		withNote:   nlq.withNote.Clone(),
+		// clone intermediate query.
+		sql:  nlq.sql.Clone(),
+		path: nlq.path,
+	}
+}
+
+// WithSource tells the query-builder to eager-load the nodes that are connected to
+// the "source" edge. The optional arguments are used to configure the query builder of the edge.
+func (nlq *NoteLinkQuery) WithSource(opts ...func(*NoteQuery)) *NoteLinkQuery {
+	query := (&NoteClient{config: nlq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	nlq.withSource = query
+	return nlq
+}
+
+// WithNote tells the query-builder to eager-load the nodes that are connected to
+// the "note" edge. The optional arguments are used to configure the query builder of the edge.
+func (nlq *NoteLinkQuery) WithNote(opts ...func(*NoteQuery)) *NoteLinkQuery {
+	query := (&NoteClient{config: nlq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	nlq.withNote = query
+	return nlq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Target string `json:"target,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.NoteLink.Query().
+//		GroupBy(notelink.FieldTarget).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (nlq *NoteLinkQuery) GroupBy(field string, fields ...string) *NoteLinkGroupBy {
+	nlq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &NoteLinkGroupBy{build: nlq}
+	grbuild.flds = &nlq.ctx.Fields
+	grbuild.label = notelink.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Target string `json:"target,omitempty"`
+//	}
+//
+//	client.NoteLink.Query().
+//		Select(notelink.FieldTarget).
+//		Scan(ctx, &v)
+func (nlq *NoteLinkQuery) Select(fields ...string) *NoteLinkSelect {
+	nlq.ctx.Fields = append(nlq.ctx.Fields, fields...)
+	sbuild := &NoteLinkSelect{NoteLinkQuery: nlq}
+	sbuild.label = notelink.Label
+	sbuild.flds, sbuild.scan = &nlq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a NoteLinkSelect configured with the given aggregations.
+func (nlq *NoteLinkQuery) Aggregate(fns ...AggregateFunc) *NoteLinkSelect {
+	return nlq.Select().Aggregate(fns...)
+}
+
+func (nlq *NoteLinkQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range nlq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, nlq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range nlq.ctx.Fields {
+		if !notelink.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if nlq.path != nil {
+		prev, err := nlq.path(ctx)
+		if err != nil {
+			return err
+		}
+		nlq.sql = prev
+	}
+	return nil
+}
+
+func (nlq *NoteLinkQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*NoteLink, error) {
+	var (
+		nodes       = []*NoteLink{}
+		withFKs     = nlq.withFKs
+		_spec       = nlq.querySpec()
+		loadedTypes = [2]bool{
+			nlq.withSource != nil,
+			nlq.withNote != nil,
+		}
+	)
+	if nlq.withSource != nil || nlq.withNote != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, notelink.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*NoteLink).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &NoteLink{config: nlq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, nlq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := nlq.withSource; query != nil {
+		if err := nlq.loadSource(ctx, query, nodes, nil,
+			func(n *NoteLink, e *Note) { n.Edges.Source = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := nlq.withNote; query != nil {
+		if err := nlq.loadNote(ctx, query, nodes, nil,
+			func(n *NoteLink, e *Note) { n.Edges.Note = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (nlq *NoteLinkQuery) loadSource(ctx context.Context, query *NoteQuery, nodes []*NoteLink, init func(*NoteLink), assign func(*NoteLink, *Note)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*NoteLink)
+	for i := range nodes {
+		if nodes[i].note_links == nil {
+			continue
+		}
+		fk := *nodes[i].note_links
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(note.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "note_links" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (nlq *NoteLinkQuery) loadNote(ctx context.Context, query *NoteQuery, nodes []*NoteLink, init func(*NoteLink), assign func(*NoteLink, *Note)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*NoteLink)
+	for i := range nodes {
+		if nodes[i].note_backlinks == nil {
+			continue
+		}
+		fk := *nodes[i].note_backlinks
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(note.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "note_backlinks" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (nlq *NoteLinkQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := nlq.querySpec()
+	_spec.Node.Columns = nlq.ctx.Fields
+	if len(nlq.ctx.Fields) > 0 {
+		_spec.Unique = nlq.ctx.Unique != nil && *nlq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, nlq.driver, _spec)
+}
+
+func (nlq *NoteLinkQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(notelink.Table, notelink.Columns, sqlgraph.NewFieldSpec(notelink.FieldID, field.TypeInt))
+	_spec.From = nlq.sql
+	if unique := nlq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if nlq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := nlq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, notelink.FieldID)
+		for i := range fields {
+			if fields[i] != notelink.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := nlq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := nlq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := nlq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := nlq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (nlq *NoteLinkQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(nlq.driver.Dialect())
+	t1 := builder.Table(notelink.Table)
+	columns := nlq.ctx.Fields
+	if len(columns) == 0 {
+		columns = notelink.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if nlq.sql != nil {
+		selector = nlq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if nlq.ctx.Unique != nil && *nlq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range nlq.predicates {
+		p(selector)
+	}
+	for _, p := range nlq.order {
+		p(selector)
+	}
+	if offset := nlq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := nlq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// NoteLinkGroupBy is the group-by builder for NoteLink entities.
+type NoteLinkGroupBy struct {
+	selector
+	build *NoteLinkQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (nlgb *NoteLinkGroupBy) Aggregate(fns ...AggregateFunc) *NoteLinkGroupBy {
+	nlgb.fns = append(nlgb.fns, fns...)
+	return nlgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (nlgb *NoteLinkGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, nlgb.build.ctx, ent.OpQueryGroupBy)
+	if err := nlgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*NoteLinkQuery, *NoteLinkGroupBy](ctx, nlgb.build, nlgb, nlgb.build.inters, v)
+}
+
+func (nlgb *NoteLinkGroupBy) sqlScan(ctx context.Context, root *NoteLinkQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(nlgb.fns))
+	for _, fn := range nlgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*nlgb.flds)+len(nlgb.fns))
+		for _, f := range *nlgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*nlgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := nlgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// NoteLinkSelect is the builder for selecting fields of NoteLink entities.
+type NoteLinkSelect struct {
+	*NoteLinkQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (nls *NoteLinkSelect) Aggregate(fns ...AggregateFunc) *NoteLinkSelect {
+	nls.fns = append(nls.fns, fns...)
+	return nls
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (nls *NoteLinkSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, nls.ctx, ent.OpQuerySelect)
+	if err := nls.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*NoteLinkQuery, *NoteLinkSelect](ctx, nls.NoteLinkQuery, nls, nls.inters, v)
+}
+
+func (nls *NoteLinkSelect) sqlScan(ctx context.Context, root *NoteLinkQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(nls.fns))
+	for _, fn := range nls.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*nls.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := nls.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
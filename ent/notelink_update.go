@@ -0,0 +1,442 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/r-scheele/zero/ent/note"
+	"github.com/r-scheele/zero/ent/notelink"
+	"github.com/r-scheele/zero/ent/predicate"
+)
+
+// NoteLinkUpdate is the builder for updating NoteLink entities.
+type NoteLinkUpdate struct {
+	config
+	hooks    []Hook
+	mutation *NoteLinkMutation
+}
+
+// Where appends a list predicates to the NoteLinkUpdate builder.
+func (nlu *NoteLinkUpdate) Where(ps ...predicate.NoteLink) *NoteLinkUpdate {
+	nlu.mutation.Where(ps...)
+	return nlu
+}
+
+// SetTarget sets the "target" field.
+func (nlu *NoteLinkUpdate) SetTarget(s string) *NoteLinkUpdate {
+	nlu.mutation.SetTarget(s)
+	return nlu
+}
+
+// SetNillableTarget sets the "target" field if the given value is not nil.
+func (nlu *NoteLinkUpdate) SetNillableTarget(s *string) *NoteLinkUpdate {
+	if s != nil {
+		nlu.SetTarget(*s)
+	}
+	return nlu
+}
+
+// SetSourceID sets the "source" edge to the Note entity by ID.
+func (nlu *NoteLinkUpdate) SetSourceID(id int) *NoteLinkUpdate {
+	nlu.mutation.SetSourceID(id)
+	return nlu
+}
+
+// SetSource sets the "source" edge to the Note entity.
+func (nlu *NoteLinkUpdate) SetSource(n *Note) *NoteLinkUpdate {
+	return nlu.SetSourceID(n.ID)
+}
+
+// SetNoteID sets the "note" edge to the Note entity by ID.
+func (nlu *NoteLinkUpdate) SetNoteID(id int) *NoteLinkUpdate {
+	nlu.mutation.SetNoteID(id)
+	return nlu
+}
+
+// SetNillableNoteID sets the "note" edge to the Note entity by ID if the given value is not nil.
+func (nlu *NoteLinkUpdate) SetNillableNoteID(id *int) *NoteLinkUpdate {
+	if id != nil {
+		nlu = nlu.SetNoteID(*id)
+	}
+	return nlu
+}
+
+// SetNote sets the "note" edge to the Note entity.
+func (nlu *NoteLinkUpdate) SetNote(n *Note) *NoteLinkUpdate {
+	return nlu.SetNoteID(n.ID)
+}
+
+// Mutation returns the NoteLinkMutation object of the builder.
+func (nlu *NoteLinkUpdate) Mutation() *NoteLinkMutation {
+	return nlu.mutation
+}
+
+// ClearSource clears the "source" edge to the Note entity.
+func (nlu *NoteLinkUpdate) ClearSource() *NoteLinkUpdate {
+	nlu.mutation.ClearSource()
+	return nlu
+}
+
+// ClearNote clears the "note" edge to the Note entity.
+func (nlu *NoteLinkUpdate) ClearNote() *NoteLinkUpdate {
+	nlu.mutation.ClearNote()
+	return nlu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (nlu *NoteLinkUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, nlu.sqlSave, nlu.mutation, nlu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nlu *NoteLinkUpdate) SaveX(ctx context.Context) int {
+	affected, err := nlu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (nlu *NoteLinkUpdate) Exec(ctx context.Context) error {
+	_, err := nlu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nlu *NoteLinkUpdate) ExecX(ctx context.Context) {
+	if err := nlu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nlu *NoteLinkUpdate) check() error {
+	if v, ok := nlu.mutation.Target(); ok {
+		if err := notelink.TargetValidator(v); err != nil {
+			return &ValidationError{Name: "target", err: fmt.Errorf(`ent: validator failed for field "NoteLink.target": %w`, err)}
+		}
+	}
+	if nlu.mutation.SourceCleared() && len(nlu.mutation.SourceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "NoteLink.source"`)
+	}
+	return nil
+}
+
+func (nlu *NoteLinkUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := nlu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notelink.Table, notelink.Columns, sqlgraph.NewFieldSpec(notelink.FieldID, field.TypeInt))
+	if ps := nlu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := nlu.mutation.Target(); ok {
+		_spec.SetField(notelink.FieldTarget, field.TypeString, value)
+	}
+	if nlu.mutation.SourceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notelink.SourceTable,
+			Columns: []string{notelink.SourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nlu.mutation.SourceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notelink.SourceTable,
+			Columns: []string{notelink.SourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nlu.mutation.NoteCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notelink.NoteTable,
+			Columns: []string{notelink.NoteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nlu.mutation.NoteIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notelink.NoteTable,
+			Columns: []string{notelink.NoteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, nlu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notelink.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	nlu.mutation.done = true
+	return n, nil
+}
+
+// NoteLinkUpdateOne is the builder for updating a single NoteLink entity.
+type NoteLinkUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *NoteLinkMutation
+}
+
+// SetTarget sets the "target" field.
+func (nluo *NoteLinkUpdateOne) SetTarget(s string) *NoteLinkUpdateOne {
+	nluo.mutation.SetTarget(s)
+	return nluo
+}
+
+// SetNillableTarget sets the "target" field if the given value is not nil.
+func (nluo *NoteLinkUpdateOne) SetNillableTarget(s *string) *NoteLinkUpdateOne {
+	if s != nil {
+		nluo.SetTarget(*s)
+	}
+	return nluo
+}
+
+// SetSourceID sets the "source" edge to the Note entity by ID.
+func (nluo *NoteLinkUpdateOne) SetSourceID(id int) *NoteLinkUpdateOne {
+	nluo.mutation.SetSourceID(id)
+	return nluo
+}
+
+// SetSource sets the "source" edge to the Note entity.
+func (nluo *NoteLinkUpdateOne) SetSource(n *Note) *NoteLinkUpdateOne {
+	return nluo.SetSourceID(n.ID)
+}
+
+// SetNoteID sets the "note" edge to the Note entity by ID.
+func (nluo *NoteLinkUpdateOne) SetNoteID(id int) *NoteLinkUpdateOne {
+	nluo.mutation.SetNoteID(id)
+	return nluo
+}
+
+// SetNillableNoteID sets the "note" edge to the Note entity by ID if the given value is not nil.
+func (nluo *NoteLinkUpdateOne) SetNillableNoteID(id *int) *NoteLinkUpdateOne {
+	if id != nil {
+		nluo = nluo.SetNoteID(*id)
+	}
+	return nluo
+}
+
+// SetNote sets the "note" edge to the Note entity.
+func (nluo *NoteLinkUpdateOne) SetNote(n *Note) *NoteLinkUpdateOne {
+	return nluo.SetNoteID(n.ID)
+}
+
+// Mutation returns the NoteLinkMutation object of the builder.
+func (nluo *NoteLinkUpdateOne) Mutation() *NoteLinkMutation {
+	return nluo.mutation
+}
+
+// ClearSource clears the "source" edge to the Note entity.
+func (nluo *NoteLinkUpdateOne) ClearSource() *NoteLinkUpdateOne {
+	nluo.mutation.ClearSource()
+	return nluo
+}
+
+// ClearNote clears the "note" edge to the Note entity.
+func (nluo *NoteLinkUpdateOne) ClearNote() *NoteLinkUpdateOne {
+	nluo.mutation.ClearNote()
+	return nluo
+}
+
+// Where appends a list predicates to the NoteLinkUpdate builder.
+func (nluo *NoteLinkUpdateOne) Where(ps ...predicate.NoteLink) *NoteLinkUpdateOne {
+	nluo.mutation.Where(ps...)
+	return nluo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (nluo *NoteLinkUpdateOne) Select(field string, fields ...string) *NoteLinkUpdateOne {
+	nluo.fields = append([]string{field}, fields...)
+	return nluo
+}
+
+// Save executes the query and returns the updated NoteLink entity.
+func (nluo *NoteLinkUpdateOne) Save(ctx context.Context) (*NoteLink, error) {
+	return withHooks(ctx, nluo.sqlSave, nluo.mutation, nluo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nluo *NoteLinkUpdateOne) SaveX(ctx context.Context) *NoteLink {
+	node, err := nluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (nluo *NoteLinkUpdateOne) Exec(ctx context.Context) error {
+	_, err := nluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nluo *NoteLinkUpdateOne) ExecX(ctx context.Context) {
+	if err := nluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nluo *NoteLinkUpdateOne) check() error {
+	if v, ok := nluo.mutation.Target(); ok {
+		if err := notelink.TargetValidator(v); err != nil {
+			return &ValidationError{Name: "target", err: fmt.Errorf(`ent: validator failed for field "NoteLink.target": %w`, err)}
+		}
+	}
+	if nluo.mutation.SourceCleared() && len(nluo.mutation.SourceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "NoteLink.source"`)
+	}
+	return nil
+}
+
+func (nluo *NoteLinkUpdateOne) sqlSave(ctx context.Context) (_node *NoteLink, err error) {
+	if err := nluo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notelink.Table, notelink.Columns, sqlgraph.NewFieldSpec(notelink.FieldID, field.TypeInt))
+	id, ok := nluo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "NoteLink.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := nluo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, notelink.FieldID)
+		for _, f := range fields {
+			if !notelink.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != notelink.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := nluo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := nluo.mutation.Target(); ok {
+		_spec.SetField(notelink.FieldTarget, field.TypeString, value)
+	}
+	if nluo.mutation.SourceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notelink.SourceTable,
+			Columns: []string{notelink.SourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nluo.mutation.SourceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notelink.SourceTable,
+			Columns: []string{notelink.SourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nluo.mutation.NoteCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notelink.NoteTable,
+			Columns: []string{notelink.NoteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nluo.mutation.NoteIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notelink.NoteTable,
+			Columns: []string{notelink.NoteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &NoteLink{config: nluo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, nluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notelink.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	nluo.mutation.done = true
+	return _node, nil
+}
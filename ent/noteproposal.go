@@ -0,0 +1,265 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/r-scheele/zero/ent/note"
+	"github.com/r-scheele/zero/ent/noteproposal"
+	"github.com/r-scheele/zero/ent/user"
+)
+
+// NoteProposal is the model entity for the NoteProposal schema.
+type NoteProposal struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Proposed title of the note
+	Title string `json:"title,omitempty"`
+	// Proposed description of the note
+	Description string `json:"description,omitempty"`
+	// Proposed content of the note
+	Content string `json:"content,omitempty"`
+	// Title of the note when the change was proposed
+	BaseTitle string `json:"base_title,omitempty"`
+	// Description of the note when the change was proposed
+	BaseDescription string `json:"base_description,omitempty"`
+	// Content of the note when the change was proposed, which the change is shown against
+	BaseContent string `json:"base_content,omitempty"`
+	// Status holds the value of the "status" field.
+	Status noteproposal.Status `json:"status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// When the owner accepted or rejected the change
+	ReviewedAt *time.Time `json:"reviewed_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the NoteProposalQuery when eager-loading is set.
+	Edges               NoteProposalEdges `json:"edges"`
+	note_proposals      *int
+	user_note_proposals *int
+	selectValues        sql.SelectValues
+}
+
+// NoteProposalEdges holds the relations/edges for other nodes in the graph.
+type NoteProposalEdges struct {
+	// Note holds the value of the note edge.
+	Note *Note `json:"note,omitempty"`
+	// Proposer holds the value of the proposer edge.
+	Proposer *User `json:"proposer,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// NoteOrErr returns the Note value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e NoteProposalEdges) NoteOrErr() (*Note, error) {
+	if e.Note != nil {
+		return e.Note, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: note.Label}
+	}
+	return nil, &NotLoadedError{edge: "note"}
+}
+
+// ProposerOrErr returns the Proposer value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e NoteProposalEdges) ProposerOrErr() (*User, error) {
+	if e.Proposer != nil {
+		return e.Proposer, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "proposer"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*NoteProposal) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case noteproposal.FieldID:
+			values[i] = new(sql.NullInt64)
+		case noteproposal.FieldTitle, noteproposal.FieldDescription, noteproposal.FieldContent, noteproposal.FieldBaseTitle, noteproposal.FieldBaseDescription, noteproposal.FieldBaseContent, noteproposal.FieldStatus:
+			values[i] = new(sql.NullString)
+		case noteproposal.FieldCreatedAt, noteproposal.FieldReviewedAt:
+			values[i] = new(sql.NullTime)
+		case noteproposal.ForeignKeys[0]: // note_proposals
+			values[i] = new(sql.NullInt64)
+		case noteproposal.ForeignKeys[1]: // user_note_proposals
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the NoteProposal fields.
+func (np *NoteProposal) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case noteproposal.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			np.ID = int(value.Int64)
+		case noteproposal.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				np.Title = value.String
+			}
+		case noteproposal.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				np.Description = value.String
+			}
+		case noteproposal.FieldContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content", values[i])
+			} else if value.Valid {
+				np.Content = value.String
+			}
+		case noteproposal.FieldBaseTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field base_title", values[i])
+			} else if value.Valid {
+				np.BaseTitle = value.String
+			}
+		case noteproposal.FieldBaseDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field base_description", values[i])
+			} else if value.Valid {
+				np.BaseDescription = value.String
+			}
+		case noteproposal.FieldBaseContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field base_content", values[i])
+			} else if value.Valid {
+				np.BaseContent = value.String
+			}
+		case noteproposal.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				np.Status = noteproposal.Status(value.String)
+			}
+		case noteproposal.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				np.CreatedAt = value.Time
+			}
+		case noteproposal.FieldReviewedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_at", values[i])
+			} else if value.Valid {
+				np.ReviewedAt = new(time.Time)
+				*np.ReviewedAt = value.Time
+			}
+		case noteproposal.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field note_proposals", value)
+			} else if value.Valid {
+				np.note_proposals = new(int)
+				*np.note_proposals = int(value.Int64)
+			}
+		case noteproposal.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_note_proposals", value)
+			} else if value.Valid {
+				np.user_note_proposals = new(int)
+				*np.user_note_proposals = int(value.Int64)
+			}
+		default:
+			np.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the NoteProposal.
+// This includes values selected through modifiers, order, etc.
+func (np *NoteProposal) Value(name string) (ent.Value, error) {
+	return np.selectValues.Get(name)
+}
+
+// QueryNote queries the "note" edge of the NoteProposal entity.
+func (np *NoteProposal) QueryNote() *NoteQuery {
+	return NewNoteProposalClient(np.config).QueryNote(np)
+}
+
+// QueryProposer queries the "proposer" edge of the NoteProposal entity.
+func (np *NoteProposal) QueryProposer() *UserQuery {
+	return NewNoteProposalClient(np.config).QueryProposer(np)
+}
+
+// Update returns a builder for updating this NoteProposal.
+// Note that you need to call NoteProposal.Unwrap() before calling this method if this NoteProposal
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (np *NoteProposal) Update() *NoteProposalUpdateOne {
+	return NewNoteProposalClient(np.config).UpdateOne(np)
+}
+
+// Unwrap unwraps the NoteProposal entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (np *NoteProposal) Unwrap() *NoteProposal {
+	_tx, ok := np.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: NoteProposal is not a transactional entity")
+	}
+	np.config.driver = _tx.drv
+	return np
+}
+
+// String implements the fmt.Stringer.
+func (np *NoteProposal) String() string {
+	var builder strings.Builder
+	builder.WriteString("NoteProposal(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", np.ID))
+	builder.WriteString("title=")
+	builder.WriteString(np.Title)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(np.Description)
+	builder.WriteString(", ")
+	builder.WriteString("content=")
+	builder.WriteString(np.Content)
+	builder.WriteString(", ")
+	builder.WriteString("base_title=")
+	builder.WriteString(np.BaseTitle)
+	builder.WriteString(", ")
+	builder.WriteString("base_description=")
+	builder.WriteString(np.BaseDescription)
+	builder.WriteString(", ")
+	builder.WriteString("base_content=")
+	builder.WriteString(np.BaseContent)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", np.Status))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(np.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := np.ReviewedAt; v != nil {
+		builder.WriteString("reviewed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NoteProposals is a parsable slice of NoteProposal.
+type NoteProposals []*NoteProposal
@@ -0,0 +1,207 @@
+// Code generated by ent, DO NOT EDIT.
+
+package noteproposal
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the noteproposal type in the database.
+	Label = "note_proposal"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTitle holds the string denoting the title field in the database.
+	FieldTitle = "title"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldContent holds the string denoting the content field in the database.
+	FieldContent = "content"
+	// FieldBaseTitle holds the string denoting the base_title field in the database.
+	FieldBaseTitle = "base_title"
+	// FieldBaseDescription holds the string denoting the base_description field in the database.
+	FieldBaseDescription = "base_description"
+	// FieldBaseContent holds the string denoting the base_content field in the database.
+	FieldBaseContent = "base_content"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldReviewedAt holds the string denoting the reviewed_at field in the database.
+	FieldReviewedAt = "reviewed_at"
+	// EdgeNote holds the string denoting the note edge name in mutations.
+	EdgeNote = "note"
+	// EdgeProposer holds the string denoting the proposer edge name in mutations.
+	EdgeProposer = "proposer"
+	// Table holds the table name of the noteproposal in the database.
+	Table = "note_proposals"
+	// NoteTable is the table that holds the note relation/edge.
+	NoteTable = "note_proposals"
+	// NoteInverseTable is the table name for the Note entity.
+	// It exists in this package in order to avoid circular dependency with the "note" package.
+	NoteInverseTable = "notes"
+	// NoteColumn is the table column denoting the note relation/edge.
+	NoteColumn = "note_proposals"
+	// ProposerTable is the table that holds the proposer relation/edge.
+	ProposerTable = "note_proposals"
+	// ProposerInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	ProposerInverseTable = "users"
+	// ProposerColumn is the table column denoting the proposer relation/edge.
+	ProposerColumn = "user_note_proposals"
+)
+
+// Columns holds all SQL columns for noteproposal fields.
+var Columns = []string{
+	FieldID,
+	FieldTitle,
+	FieldDescription,
+	FieldContent,
+	FieldBaseTitle,
+	FieldBaseDescription,
+	FieldBaseContent,
+	FieldStatus,
+	FieldCreatedAt,
+	FieldReviewedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "note_proposals"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"note_proposals",
+	"user_note_proposals",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	TitleValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusPending is the default value of the Status enum.
+const DefaultStatus = StatusPending
+
+// Status values.
+const (
+	StatusPending  Status = "pending"
+	StatusAccepted Status = "accepted"
+	StatusRejected Status = "rejected"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPending, StatusAccepted, StatusRejected:
+		return nil
+	default:
+		return fmt.Errorf("noteproposal: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the NoteProposal queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTitle orders the results by the title field.
+func ByTitle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTitle, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
+// ByContent orders the results by the content field.
+func ByContent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContent, opts...).ToFunc()
+}
+
+// ByBaseTitle orders the results by the base_title field.
+func ByBaseTitle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBaseTitle, opts...).ToFunc()
+}
+
+// ByBaseDescription orders the results by the base_description field.
+func ByBaseDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBaseDescription, opts...).ToFunc()
+}
+
+// ByBaseContent orders the results by the base_content field.
+func ByBaseContent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBaseContent, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByReviewedAt orders the results by the reviewed_at field.
+func ByReviewedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewedAt, opts...).ToFunc()
+}
+
+// ByNoteField orders the results by note field.
+func ByNoteField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newNoteStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByProposerField orders the results by proposer field.
+func ByProposerField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newProposerStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newNoteStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(NoteInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, NoteTable, NoteColumn),
+	)
+}
+func newProposerStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ProposerInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ProposerTable, ProposerColumn),
+	)
+}
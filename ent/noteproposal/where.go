@@ -0,0 +1,707 @@
+// Code generated by ent, DO NOT EDIT.
+
+package noteproposal
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/r-scheele/zero/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldLTE(FieldID, id))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldEQ(FieldTitle, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldEQ(FieldDescription, v))
+}
+
+// Content applies equality check predicate on the "content" field. It's identical to ContentEQ.
+func Content(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldEQ(FieldContent, v))
+}
+
+// BaseTitle applies equality check predicate on the "base_title" field. It's identical to BaseTitleEQ.
+func BaseTitle(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldEQ(FieldBaseTitle, v))
+}
+
+// BaseDescription applies equality check predicate on the "base_description" field. It's identical to BaseDescriptionEQ.
+func BaseDescription(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldEQ(FieldBaseDescription, v))
+}
+
+// BaseContent applies equality check predicate on the "base_content" field. It's identical to BaseContentEQ.
+func BaseContent(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldEQ(FieldBaseContent, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ReviewedAt applies equality check predicate on the "reviewed_at" field. It's identical to ReviewedAtEQ.
+func ReviewedAt(v time.Time) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldEQ(FieldReviewedAt, v))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// ContentEQ applies the EQ predicate on the "content" field.
+func ContentEQ(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldEQ(FieldContent, v))
+}
+
+// ContentNEQ applies the NEQ predicate on the "content" field.
+func ContentNEQ(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldNEQ(FieldContent, v))
+}
+
+// ContentIn applies the In predicate on the "content" field.
+func ContentIn(vs ...string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldIn(FieldContent, vs...))
+}
+
+// ContentNotIn applies the NotIn predicate on the "content" field.
+func ContentNotIn(vs ...string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldNotIn(FieldContent, vs...))
+}
+
+// ContentGT applies the GT predicate on the "content" field.
+func ContentGT(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldGT(FieldContent, v))
+}
+
+// ContentGTE applies the GTE predicate on the "content" field.
+func ContentGTE(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldGTE(FieldContent, v))
+}
+
+// ContentLT applies the LT predicate on the "content" field.
+func ContentLT(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldLT(FieldContent, v))
+}
+
+// ContentLTE applies the LTE predicate on the "content" field.
+func ContentLTE(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldLTE(FieldContent, v))
+}
+
+// ContentContains applies the Contains predicate on the "content" field.
+func ContentContains(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldContains(FieldContent, v))
+}
+
+// ContentHasPrefix applies the HasPrefix predicate on the "content" field.
+func ContentHasPrefix(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldHasPrefix(FieldContent, v))
+}
+
+// ContentHasSuffix applies the HasSuffix predicate on the "content" field.
+func ContentHasSuffix(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldHasSuffix(FieldContent, v))
+}
+
+// ContentIsNil applies the IsNil predicate on the "content" field.
+func ContentIsNil() predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldIsNull(FieldContent))
+}
+
+// ContentNotNil applies the NotNil predicate on the "content" field.
+func ContentNotNil() predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldNotNull(FieldContent))
+}
+
+// ContentEqualFold applies the EqualFold predicate on the "content" field.
+func ContentEqualFold(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldEqualFold(FieldContent, v))
+}
+
+// ContentContainsFold applies the ContainsFold predicate on the "content" field.
+func ContentContainsFold(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldContainsFold(FieldContent, v))
+}
+
+// BaseTitleEQ applies the EQ predicate on the "base_title" field.
+func BaseTitleEQ(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldEQ(FieldBaseTitle, v))
+}
+
+// BaseTitleNEQ applies the NEQ predicate on the "base_title" field.
+func BaseTitleNEQ(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldNEQ(FieldBaseTitle, v))
+}
+
+// BaseTitleIn applies the In predicate on the "base_title" field.
+func BaseTitleIn(vs ...string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldIn(FieldBaseTitle, vs...))
+}
+
+// BaseTitleNotIn applies the NotIn predicate on the "base_title" field.
+func BaseTitleNotIn(vs ...string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldNotIn(FieldBaseTitle, vs...))
+}
+
+// BaseTitleGT applies the GT predicate on the "base_title" field.
+func BaseTitleGT(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldGT(FieldBaseTitle, v))
+}
+
+// BaseTitleGTE applies the GTE predicate on the "base_title" field.
+func BaseTitleGTE(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldGTE(FieldBaseTitle, v))
+}
+
+// BaseTitleLT applies the LT predicate on the "base_title" field.
+func BaseTitleLT(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldLT(FieldBaseTitle, v))
+}
+
+// BaseTitleLTE applies the LTE predicate on the "base_title" field.
+func BaseTitleLTE(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldLTE(FieldBaseTitle, v))
+}
+
+// BaseTitleContains applies the Contains predicate on the "base_title" field.
+func BaseTitleContains(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldContains(FieldBaseTitle, v))
+}
+
+// BaseTitleHasPrefix applies the HasPrefix predicate on the "base_title" field.
+func BaseTitleHasPrefix(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldHasPrefix(FieldBaseTitle, v))
+}
+
+// BaseTitleHasSuffix applies the HasSuffix predicate on the "base_title" field.
+func BaseTitleHasSuffix(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldHasSuffix(FieldBaseTitle, v))
+}
+
+// BaseTitleIsNil applies the IsNil predicate on the "base_title" field.
+func BaseTitleIsNil() predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldIsNull(FieldBaseTitle))
+}
+
+// BaseTitleNotNil applies the NotNil predicate on the "base_title" field.
+func BaseTitleNotNil() predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldNotNull(FieldBaseTitle))
+}
+
+// BaseTitleEqualFold applies the EqualFold predicate on the "base_title" field.
+func BaseTitleEqualFold(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldEqualFold(FieldBaseTitle, v))
+}
+
+// BaseTitleContainsFold applies the ContainsFold predicate on the "base_title" field.
+func BaseTitleContainsFold(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldContainsFold(FieldBaseTitle, v))
+}
+
+// BaseDescriptionEQ applies the EQ predicate on the "base_description" field.
+func BaseDescriptionEQ(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldEQ(FieldBaseDescription, v))
+}
+
+// BaseDescriptionNEQ applies the NEQ predicate on the "base_description" field.
+func BaseDescriptionNEQ(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldNEQ(FieldBaseDescription, v))
+}
+
+// BaseDescriptionIn applies the In predicate on the "base_description" field.
+func BaseDescriptionIn(vs ...string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldIn(FieldBaseDescription, vs...))
+}
+
+// BaseDescriptionNotIn applies the NotIn predicate on the "base_description" field.
+func BaseDescriptionNotIn(vs ...string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldNotIn(FieldBaseDescription, vs...))
+}
+
+// BaseDescriptionGT applies the GT predicate on the "base_description" field.
+func BaseDescriptionGT(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldGT(FieldBaseDescription, v))
+}
+
+// BaseDescriptionGTE applies the GTE predicate on the "base_description" field.
+func BaseDescriptionGTE(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldGTE(FieldBaseDescription, v))
+}
+
+// BaseDescriptionLT applies the LT predicate on the "base_description" field.
+func BaseDescriptionLT(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldLT(FieldBaseDescription, v))
+}
+
+// BaseDescriptionLTE applies the LTE predicate on the "base_description" field.
+func BaseDescriptionLTE(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldLTE(FieldBaseDescription, v))
+}
+
+// BaseDescriptionContains applies the Contains predicate on the "base_description" field.
+func BaseDescriptionContains(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldContains(FieldBaseDescription, v))
+}
+
+// BaseDescriptionHasPrefix applies the HasPrefix predicate on the "base_description" field.
+func BaseDescriptionHasPrefix(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldHasPrefix(FieldBaseDescription, v))
+}
+
+// BaseDescriptionHasSuffix applies the HasSuffix predicate on the "base_description" field.
+func BaseDescriptionHasSuffix(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldHasSuffix(FieldBaseDescription, v))
+}
+
+// BaseDescriptionIsNil applies the IsNil predicate on the "base_description" field.
+func BaseDescriptionIsNil() predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldIsNull(FieldBaseDescription))
+}
+
+// BaseDescriptionNotNil applies the NotNil predicate on the "base_description" field.
+func BaseDescriptionNotNil() predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldNotNull(FieldBaseDescription))
+}
+
+// BaseDescriptionEqualFold applies the EqualFold predicate on the "base_description" field.
+func BaseDescriptionEqualFold(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldEqualFold(FieldBaseDescription, v))
+}
+
+// BaseDescriptionContainsFold applies the ContainsFold predicate on the "base_description" field.
+func BaseDescriptionContainsFold(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldContainsFold(FieldBaseDescription, v))
+}
+
+// BaseContentEQ applies the EQ predicate on the "base_content" field.
+func BaseContentEQ(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldEQ(FieldBaseContent, v))
+}
+
+// BaseContentNEQ applies the NEQ predicate on the "base_content" field.
+func BaseContentNEQ(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldNEQ(FieldBaseContent, v))
+}
+
+// BaseContentIn applies the In predicate on the "base_content" field.
+func BaseContentIn(vs ...string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldIn(FieldBaseContent, vs...))
+}
+
+// BaseContentNotIn applies the NotIn predicate on the "base_content" field.
+func BaseContentNotIn(vs ...string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldNotIn(FieldBaseContent, vs...))
+}
+
+// BaseContentGT applies the GT predicate on the "base_content" field.
+func BaseContentGT(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldGT(FieldBaseContent, v))
+}
+
+// BaseContentGTE applies the GTE predicate on the "base_content" field.
+func BaseContentGTE(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldGTE(FieldBaseContent, v))
+}
+
+// BaseContentLT applies the LT predicate on the "base_content" field.
+func BaseContentLT(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldLT(FieldBaseContent, v))
+}
+
+// BaseContentLTE applies the LTE predicate on the "base_content" field.
+func BaseContentLTE(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldLTE(FieldBaseContent, v))
+}
+
+// BaseContentContains applies the Contains predicate on the "base_content" field.
+func BaseContentContains(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldContains(FieldBaseContent, v))
+}
+
+// BaseContentHasPrefix applies the HasPrefix predicate on the "base_content" field.
+func BaseContentHasPrefix(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldHasPrefix(FieldBaseContent, v))
+}
+
+// BaseContentHasSuffix applies the HasSuffix predicate on the "base_content" field.
+func BaseContentHasSuffix(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldHasSuffix(FieldBaseContent, v))
+}
+
+// BaseContentIsNil applies the IsNil predicate on the "base_content" field.
+func BaseContentIsNil() predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldIsNull(FieldBaseContent))
+}
+
+// BaseContentNotNil applies the NotNil predicate on the "base_content" field.
+func BaseContentNotNil() predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldNotNull(FieldBaseContent))
+}
+
+// BaseContentEqualFold applies the EqualFold predicate on the "base_content" field.
+func BaseContentEqualFold(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldEqualFold(FieldBaseContent, v))
+}
+
+// BaseContentContainsFold applies the ContainsFold predicate on the "base_content" field.
+func BaseContentContainsFold(v string) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldContainsFold(FieldBaseContent, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// ReviewedAtEQ applies the EQ predicate on the "reviewed_at" field.
+func ReviewedAtEQ(v time.Time) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldEQ(FieldReviewedAt, v))
+}
+
+// ReviewedAtNEQ applies the NEQ predicate on the "reviewed_at" field.
+func ReviewedAtNEQ(v time.Time) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldNEQ(FieldReviewedAt, v))
+}
+
+// ReviewedAtIn applies the In predicate on the "reviewed_at" field.
+func ReviewedAtIn(vs ...time.Time) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldIn(FieldReviewedAt, vs...))
+}
+
+// ReviewedAtNotIn applies the NotIn predicate on the "reviewed_at" field.
+func ReviewedAtNotIn(vs ...time.Time) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldNotIn(FieldReviewedAt, vs...))
+}
+
+// ReviewedAtGT applies the GT predicate on the "reviewed_at" field.
+func ReviewedAtGT(v time.Time) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldGT(FieldReviewedAt, v))
+}
+
+// ReviewedAtGTE applies the GTE predicate on the "reviewed_at" field.
+func ReviewedAtGTE(v time.Time) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldGTE(FieldReviewedAt, v))
+}
+
+// ReviewedAtLT applies the LT predicate on the "reviewed_at" field.
+func ReviewedAtLT(v time.Time) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldLT(FieldReviewedAt, v))
+}
+
+// ReviewedAtLTE applies the LTE predicate on the "reviewed_at" field.
+func ReviewedAtLTE(v time.Time) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldLTE(FieldReviewedAt, v))
+}
+
+// ReviewedAtIsNil applies the IsNil predicate on the "reviewed_at" field.
+func ReviewedAtIsNil() predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldIsNull(FieldReviewedAt))
+}
+
+// ReviewedAtNotNil applies the NotNil predicate on the "reviewed_at" field.
+func ReviewedAtNotNil() predicate.NoteProposal {
+	return predicate.NoteProposal(sql.FieldNotNull(FieldReviewedAt))
+}
+
+// HasNote applies the HasEdge predicate on the "note" edge.
+func HasNote() predicate.NoteProposal {
+	return predicate.NoteProposal(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, NoteTable, NoteColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasNoteWith applies the HasEdge predicate on the "note" edge with a given conditions (other predicates).
+func HasNoteWith(preds ...predicate.Note) predicate.NoteProposal {
+	return predicate.NoteProposal(func(s *sql.Selector) {
+		step := newNoteStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasProposer applies the HasEdge predicate on the "proposer" edge.
+func HasProposer() predicate.NoteProposal {
+	return predicate.NoteProposal(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ProposerTable, ProposerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProposerWith applies the HasEdge predicate on the "proposer" edge with a given conditions (other predicates).
+func HasProposerWith(preds ...predicate.User) predicate.NoteProposal {
+	return predicate.NoteProposal(func(s *sql.Selector) {
+		step := newProposerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.NoteProposal) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.NoteProposal) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.NoteProposal) predicate.NoteProposal {
+	return predicate.NoteProposal(sql.NotPredicates(p))
+}
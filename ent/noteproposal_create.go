@@ -0,0 +1,422 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/r-scheele/zero/ent/note"
+	"github.com/r-scheele/zero/ent/noteproposal"
+	"github.com/r-scheele/zero/ent/user"
+)
+
+// NoteProposalCreate is the builder for creating a NoteProposal entity.
+type NoteProposalCreate struct {
+	config
+	mutation *NoteProposalMutation
+	hooks    []Hook
+}
+
+// SetTitle sets the "title" field.
+func (npc *NoteProposalCreate) SetTitle(s string) *NoteProposalCreate {
+	npc.mutation.SetTitle(s)
+	return npc
+}
+
+// SetDescription sets the "description" field.
+func (npc *NoteProposalCreate) SetDescription(s string) *NoteProposalCreate {
+	npc.mutation.SetDescription(s)
+	return npc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (npc *NoteProposalCreate) SetNillableDescription(s *string) *NoteProposalCreate {
+	if s != nil {
+		npc.SetDescription(*s)
+	}
+	return npc
+}
+
+// SetContent sets the "content" field.
+func (npc *NoteProposalCreate) SetContent(s string) *NoteProposalCreate {
+	npc.mutation.SetContent(s)
+	return npc
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (npc *NoteProposalCreate) SetNillableContent(s *string) *NoteProposalCreate {
+	if s != nil {
+		npc.SetContent(*s)
+	}
+	return npc
+}
+
+// SetBaseTitle sets the "base_title" field.
+func (npc *NoteProposalCreate) SetBaseTitle(s string) *NoteProposalCreate {
+	npc.mutation.SetBaseTitle(s)
+	return npc
+}
+
+// SetNillableBaseTitle sets the "base_title" field if the given value is not nil.
+func (npc *NoteProposalCreate) SetNillableBaseTitle(s *string) *NoteProposalCreate {
+	if s != nil {
+		npc.SetBaseTitle(*s)
+	}
+	return npc
+}
+
+// SetBaseDescription sets the "base_description" field.
+func (npc *NoteProposalCreate) SetBaseDescription(s string) *NoteProposalCreate {
+	npc.mutation.SetBaseDescription(s)
+	return npc
+}
+
+// SetNillableBaseDescription sets the "base_description" field if the given value is not nil.
+func (npc *NoteProposalCreate) SetNillableBaseDescription(s *string) *NoteProposalCreate {
+	if s != nil {
+		npc.SetBaseDescription(*s)
+	}
+	return npc
+}
+
+// SetBaseContent sets the "base_content" field.
+func (npc *NoteProposalCreate) SetBaseContent(s string) *NoteProposalCreate {
+	npc.mutation.SetBaseContent(s)
+	return npc
+}
+
+// SetNillableBaseContent sets the "base_content" field if the given value is not nil.
+func (npc *NoteProposalCreate) SetNillableBaseContent(s *string) *NoteProposalCreate {
+	if s != nil {
+		npc.SetBaseContent(*s)
+	}
+	return npc
+}
+
+// SetStatus sets the "status" field.
+func (npc *NoteProposalCreate) SetStatus(n noteproposal.Status) *NoteProposalCreate {
+	npc.mutation.SetStatus(n)
+	return npc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (npc *NoteProposalCreate) SetNillableStatus(n *noteproposal.Status) *NoteProposalCreate {
+	if n != nil {
+		npc.SetStatus(*n)
+	}
+	return npc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (npc *NoteProposalCreate) SetCreatedAt(t time.Time) *NoteProposalCreate {
+	npc.mutation.SetCreatedAt(t)
+	return npc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (npc *NoteProposalCreate) SetNillableCreatedAt(t *time.Time) *NoteProposalCreate {
+	if t != nil {
+		npc.SetCreatedAt(*t)
+	}
+	return npc
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (npc *NoteProposalCreate) SetReviewedAt(t time.Time) *NoteProposalCreate {
+	npc.mutation.SetReviewedAt(t)
+	return npc
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (npc *NoteProposalCreate) SetNillableReviewedAt(t *time.Time) *NoteProposalCreate {
+	if t != nil {
+		npc.SetReviewedAt(*t)
+	}
+	return npc
+}
+
+// SetNoteID sets the "note" edge to the Note entity by ID.
+func (npc *NoteProposalCreate) SetNoteID(id int) *NoteProposalCreate {
+	npc.mutation.SetNoteID(id)
+	return npc
+}
+
+// SetNote sets the "note" edge to the Note entity.
+func (npc *NoteProposalCreate) SetNote(n *Note) *NoteProposalCreate {
+	return npc.SetNoteID(n.ID)
+}
+
+// SetProposerID sets the "proposer" edge to the User entity by ID.
+func (npc *NoteProposalCreate) SetProposerID(id int) *NoteProposalCreate {
+	npc.mutation.SetProposerID(id)
+	return npc
+}
+
+// SetProposer sets the "proposer" edge to the User entity.
+func (npc *NoteProposalCreate) SetProposer(u *User) *NoteProposalCreate {
+	return npc.SetProposerID(u.ID)
+}
+
+// Mutation returns the NoteProposalMutation object of the builder.
+func (npc *NoteProposalCreate) Mutation() *NoteProposalMutation {
+	return npc.mutation
+}
+
+// Save creates the NoteProposal in the database.
+func (npc *NoteProposalCreate) Save(ctx context.Context) (*NoteProposal, error) {
+	npc.defaults()
+	return withHooks(ctx, npc.sqlSave, npc.mutation, npc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (npc *NoteProposalCreate) SaveX(ctx context.Context) *NoteProposal {
+	v, err := npc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (npc *NoteProposalCreate) Exec(ctx context.Context) error {
+	_, err := npc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (npc *NoteProposalCreate) ExecX(ctx context.Context) {
+	if err := npc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (npc *NoteProposalCreate) defaults() {
+	if _, ok := npc.mutation.Status(); !ok {
+		v := noteproposal.DefaultStatus
+		npc.mutation.SetStatus(v)
+	}
+	if _, ok := npc.mutation.CreatedAt(); !ok {
+		v := noteproposal.DefaultCreatedAt()
+		npc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (npc *NoteProposalCreate) check() error {
+	if _, ok := npc.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "NoteProposal.title"`)}
+	}
+	if v, ok := npc.mutation.Title(); ok {
+		if err := noteproposal.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "NoteProposal.title": %w`, err)}
+		}
+	}
+	if _, ok := npc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "NoteProposal.status"`)}
+	}
+	if v, ok := npc.mutation.Status(); ok {
+		if err := noteproposal.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "NoteProposal.status": %w`, err)}
+		}
+	}
+	if _, ok := npc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "NoteProposal.created_at"`)}
+	}
+	if len(npc.mutation.NoteIDs()) == 0 {
+		return &ValidationError{Name: "note", err: errors.New(`ent: missing required edge "NoteProposal.note"`)}
+	}
+	if len(npc.mutation.ProposerIDs()) == 0 {
+		return &ValidationError{Name: "proposer", err: errors.New(`ent: missing required edge "NoteProposal.proposer"`)}
+	}
+	return nil
+}
+
+func (npc *NoteProposalCreate) sqlSave(ctx context.Context) (*NoteProposal, error) {
+	if err := npc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := npc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, npc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	npc.mutation.id = &_node.ID
+	npc.mutation.done = true
+	return _node, nil
+}
+
+func (npc *NoteProposalCreate) createSpec() (*NoteProposal, *sqlgraph.CreateSpec) {
+	var (
+		_node = &NoteProposal{config: npc.config}
+		_spec = sqlgraph.NewCreateSpec(noteproposal.Table, sqlgraph.NewFieldSpec(noteproposal.FieldID, field.TypeInt))
+	)
+	if value, ok := npc.mutation.Title(); ok {
+		_spec.SetField(noteproposal.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := npc.mutation.Description(); ok {
+		_spec.SetField(noteproposal.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := npc.mutation.Content(); ok {
+		_spec.SetField(noteproposal.FieldContent, field.TypeString, value)
+		_node.Content = value
+	}
+	if value, ok := npc.mutation.BaseTitle(); ok {
+		_spec.SetField(noteproposal.FieldBaseTitle, field.TypeString, value)
+		_node.BaseTitle = value
+	}
+	if value, ok := npc.mutation.BaseDescription(); ok {
+		_spec.SetField(noteproposal.FieldBaseDescription, field.TypeString, value)
+		_node.BaseDescription = value
+	}
+	if value, ok := npc.mutation.BaseContent(); ok {
+		_spec.SetField(noteproposal.FieldBaseContent, field.TypeString, value)
+		_node.BaseContent = value
+	}
+	if value, ok := npc.mutation.Status(); ok {
+		_spec.SetField(noteproposal.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := npc.mutation.CreatedAt(); ok {
+		_spec.SetField(noteproposal.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := npc.mutation.ReviewedAt(); ok {
+		_spec.SetField(noteproposal.FieldReviewedAt, field.TypeTime, value)
+		_node.ReviewedAt = &value
+	}
+	if nodes := npc.mutation.NoteIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   noteproposal.NoteTable,
+			Columns: []string{noteproposal.NoteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.note_proposals = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := npc.mutation.ProposerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   noteproposal.ProposerTable,
+			Columns: []string{noteproposal.ProposerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_note_proposals = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// NoteProposalCreateBulk is the builder for creating many NoteProposal entities in bulk.
+type NoteProposalCreateBulk struct {
+	config
+	err      error
+	builders []*NoteProposalCreate
+}
+
+// Save creates the NoteProposal entities in the database.
+func (npcb *NoteProposalCreateBulk) Save(ctx context.Context) ([]*NoteProposal, error) {
+	if npcb.err != nil {
+		return nil, npcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(npcb.builders))
+	nodes := make([]*NoteProposal, len(npcb.builders))
+	mutators := make([]Mutator, len(npcb.builders))
+	for i := range npcb.builders {
+		func(i int, root context.Context) {
+			builder := npcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*NoteProposalMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, npcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, npcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, npcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (npcb *NoteProposalCreateBulk) SaveX(ctx context.Context) []*NoteProposal {
+	v, err := npcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (npcb *NoteProposalCreateBulk) Exec(ctx context.Context) error {
+	_, err := npcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (npcb *NoteProposalCreateBulk) ExecX(ctx context.Context) {
+	if err := npcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/r-scheele/zero/ent/noteproposal"
+	"github.com/r-scheele/zero/ent/predicate"
+)
+
+// NoteProposalDelete is the builder for deleting a NoteProposal entity.
+type NoteProposalDelete struct {
+	config
+	hooks    []Hook
+	mutation *NoteProposalMutation
+}
+
+// Where appends a list predicates to the NoteProposalDelete builder.
+func (npd *NoteProposalDelete) Where(ps ...predicate.NoteProposal) *NoteProposalDelete {
+	npd.mutation.Where(ps...)
+	return npd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (npd *NoteProposalDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, npd.sqlExec, npd.mutation, npd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (npd *NoteProposalDelete) ExecX(ctx context.Context) int {
+	n, err := npd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (npd *NoteProposalDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(noteproposal.Table, sqlgraph.NewFieldSpec(noteproposal.FieldID, field.TypeInt))
+	if ps := npd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, npd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	npd.mutation.done = true
+	return affected, err
+}
+
+// NoteProposalDeleteOne is the builder for deleting a single NoteProposal entity.
+type NoteProposalDeleteOne struct {
+	npd *NoteProposalDelete
+}
+
+// Where appends a list predicates to the NoteProposalDelete builder.
+func (npdo *NoteProposalDeleteOne) Where(ps ...predicate.NoteProposal) *NoteProposalDeleteOne {
+	npdo.npd.mutation.Where(ps...)
+	return npdo
+}
+
+// Exec executes the deletion query.
+func (npdo *NoteProposalDeleteOne) Exec(ctx context.Context) error {
+	n, err := npdo.npd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{noteproposal.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (npdo *NoteProposalDeleteOne) ExecX(ctx context.Context) {
+	if err := npdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
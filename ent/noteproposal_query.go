@@ -0,0 +1,689 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/r-scheele/zero/ent/note"
+	"github.com/r-scheele/zero/ent/noteproposal"
+	"github.com/r-scheele/zero/ent/predicate"
+	"github.com/r-scheele/zero/ent/user"
+)
+
+// NoteProposalQuery is the builder for querying NoteProposal entities.
+type NoteProposalQuery struct {
+	config
+	ctx          *QueryContext
+	order        []noteproposal.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.NoteProposal
+	withNote     *NoteQuery
+	withProposer *UserQuery
+	withFKs      bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the NoteProposalQuery builder.
+func (npq *NoteProposalQuery) Where(ps ...predicate.NoteProposal) *NoteProposalQuery {
+	npq.predicates = append(npq.predicates, ps...)
+	return npq
+}
+
+// Limit the number of records to be returned by this query.
+func (npq *NoteProposalQuery) Limit(limit int) *NoteProposalQuery {
+	npq.ctx.Limit = &limit
+	return npq
+}
+
+// Offset to start from.
+func (npq *NoteProposalQuery) Offset(offset int) *NoteProposalQuery {
+	npq.ctx.Offset = &offset
+	return npq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (npq *NoteProposalQuery) Unique(unique bool) *NoteProposalQuery {
+	npq.ctx.Unique = &unique
+	return npq
+}
+
+// Order specifies how the records should be ordered.
+func (npq *NoteProposalQuery) Order(o ...noteproposal.OrderOption) *NoteProposalQuery {
+	npq.order = append(npq.order, o...)
+	return npq
+}
+
+// QueryNote chains the current query on the "note" edge.
+func (npq *NoteProposalQuery) QueryNote() *NoteQuery {
+	query := (&NoteClient{config: npq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := npq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := npq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(noteproposal.Table, noteproposal.FieldID, selector),
+			sqlgraph.To(note.Table, note.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, noteproposal.NoteTable, noteproposal.NoteColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(npq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryProposer chains the current query on the "proposer" edge.
+func (npq *NoteProposalQuery) QueryProposer() *UserQuery {
+	query := (&UserClient{config: npq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := npq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := npq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(noteproposal.Table, noteproposal.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, noteproposal.ProposerTable, noteproposal.ProposerColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(npq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first NoteProposal entity from the query.
+// Returns a *NotFoundError when no NoteProposal was found.
+func (npq *NoteProposalQuery) First(ctx context.Context) (*NoteProposal, error) {
+	nodes, err := npq.Limit(1).All(setContextOp(ctx, npq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{noteproposal.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (npq *NoteProposalQuery) FirstX(ctx context.Context) *NoteProposal {
+	node, err := npq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first NoteProposal ID from the query.
+// Returns a *NotFoundError when no NoteProposal ID was found.
+func (npq *NoteProposalQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = npq.Limit(1).IDs(setContextOp(ctx, npq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{noteproposal.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (npq *NoteProposalQuery) FirstIDX(ctx context.Context) int {
+	id, err := npq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single NoteProposal entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one NoteProposal entity is found.
+// Returns a *NotFoundError when no NoteProposal entities are found.
+func (npq *NoteProposalQuery) Only(ctx context.Context) (*NoteProposal, error) {
+	nodes, err := npq.Limit(2).All(setContextOp(ctx, npq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{noteproposal.Label}
+	default:
+		return nil, &NotSingularError{noteproposal.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (npq *NoteProposalQuery) OnlyX(ctx context.Context) *NoteProposal {
+	node, err := npq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only NoteProposal ID in the query.
+// Returns a *NotSingularError when more than one NoteProposal ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (npq *NoteProposalQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = npq.Limit(2).IDs(setContextOp(ctx, npq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{noteproposal.Label}
+	default:
+		err = &NotSingularError{noteproposal.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (npq *NoteProposalQuery) OnlyIDX(ctx context.Context) int {
+	id, err := npq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of NoteProposals.
+func (npq *NoteProposalQuery) All(ctx context.Context) ([]*NoteProposal, error) {
+	ctx = setContextOp(ctx, npq.ctx, ent.OpQueryAll)
+	if err := npq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*NoteProposal, *NoteProposalQuery]()
+	return withInterceptors[[]*NoteProposal](ctx, npq, qr, npq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (npq *NoteProposalQuery) AllX(ctx context.Context) []*NoteProposal {
+	nodes, err := npq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of NoteProposal IDs.
+func (npq *NoteProposalQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if npq.ctx.Unique == nil && npq.path != nil {
+		npq.Unique(true)
+	}
+	ctx = setContextOp(ctx, npq.ctx, ent.OpQueryIDs)
+	if err = npq.Select(noteproposal.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (npq *NoteProposalQuery) IDsX(ctx context.Context) []int {
+	ids, err := npq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (npq *NoteProposalQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, npq.ctx, ent.OpQueryCount)
+	if err := npq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, npq, querierCount[*NoteProposalQuery](), npq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (npq *NoteProposalQuery) CountX(ctx context.Context) int {
+	count, err := npq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (npq *NoteProposalQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, npq.ctx, ent.OpQueryExist)
+	switch _, err := npq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (npq *NoteProposalQuery) ExistX(ctx context.Context) bool {
+	exist, err := npq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the NoteProposalQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (npq *NoteProposalQuery) Clone() *NoteProposalQuery {
+	if npq == nil {
+		return nil
+	}
+	return &NoteProposalQuery{
+		config:       npq.config,
+		ctx:          npq.ctx.Clone(),
+		order:        append([]noteproposal.OrderOption{}, npq.order...),
+		inters:       append([]Interceptor{}, npq.inters...),
+		predicates:   append([]predicate.NoteProposal{}, npq.predicates...),
+		withNote:     npq.withNote.Clone(),
+		withProposer: npq.withProposer.Clone(),
+		// clone intermediate query.
+		sql:  npq.sql.Clone(),
+		path: npq.path,
+	}
+}
+
+// WithNote tells the query-builder to eager-load the nodes that are connected to
+// the "note" edge. The optional arguments are used to configure the query builder of the edge.
+func (npq *NoteProposalQuery) WithNote(opts ...func(*NoteQuery)) *NoteProposalQuery {
+	query := (&NoteClient{config: npq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	npq.withNote = query
+	return npq
+}
+
+// WithProposer tells the query-builder to eager-load the nodes that are connected to
+// the "proposer" edge. The optional arguments are used to configure the query builder of the edge.
+func (npq *NoteProposalQuery) WithProposer(opts ...func(*UserQuery)) *NoteProposalQuery {
+	query := (&UserClient{config: npq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	npq.withProposer = query
+	return npq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Title string `json:"title,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.NoteProposal.Query().
+//		GroupBy(noteproposal.FieldTitle).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (npq *NoteProposalQuery) GroupBy(field string, fields ...string) *NoteProposalGroupBy {
+	npq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &NoteProposalGroupBy{build: npq}
+	grbuild.flds = &npq.ctx.Fields
+	grbuild.label = noteproposal.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Title string `json:"title,omitempty"`
+//	}
+//
+//	client.NoteProposal.Query().
+//		Select(noteproposal.FieldTitle).
+//		Scan(ctx, &v)
+func (npq *NoteProposalQuery) Select(fields ...string) *NoteProposalSelect {
+	npq.ctx.Fields = append(npq.ctx.Fields, fields...)
+	sbuild := &NoteProposalSelect{NoteProposalQuery: npq}
+	sbuild.label = noteproposal.Label
+	sbuild.flds, sbuild.scan = &npq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a NoteProposalSelect configured with the given aggregations.
+func (npq *NoteProposalQuery) Aggregate(fns ...AggregateFunc) *NoteProposalSelect {
+	return npq.Select().Aggregate(fns...)
+}
+
+func (npq *NoteProposalQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range npq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, npq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range npq.ctx.Fields {
+		if !noteproposal.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if npq.path != nil {
+		prev, err := npq.path(ctx)
+		if err != nil {
+			return err
+		}
+		npq.sql = prev
+	}
+	return nil
+}
+
+func (npq *NoteProposalQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*NoteProposal, error) {
+	var (
+		nodes       = []*NoteProposal{}
+		withFKs     = npq.withFKs
+		_spec       = npq.querySpec()
+		loadedTypes = [2]bool{
+			npq.withNote != nil,
+			npq.withProposer != nil,
+		}
+	)
+	if npq.withNote != nil || npq.withProposer != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, noteproposal.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*NoteProposal).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &NoteProposal{config: npq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, npq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := npq.withNote; query != nil {
+		if err := npq.loadNote(ctx, query, nodes, nil,
+			func(n *NoteProposal, e *Note) { n.Edges.Note = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := npq.withProposer; query != nil {
+		if err := npq.loadProposer(ctx, query, nodes, nil,
+			func(n *NoteProposal, e *User) { n.Edges.Proposer = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (npq *NoteProposalQuery) loadNote(ctx context.Context, query *NoteQuery, nodes []*NoteProposal, init func(*NoteProposal), assign func(*NoteProposal, *Note)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*NoteProposal)
+	for i := range nodes {
+		if nodes[i].note_proposals == nil {
+			continue
+		}
+		fk := *nodes[i].note_proposals
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(note.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "note_proposals" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (npq *NoteProposalQuery) loadProposer(ctx context.Context, query *UserQuery, nodes []*NoteProposal, init func(*NoteProposal), assign func(*NoteProposal, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*NoteProposal)
+	for i := range nodes {
+		if nodes[i].user_note_proposals == nil {
+			continue
+		}
+		fk := *nodes[i].user_note_proposals
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_note_proposals" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (npq *NoteProposalQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := npq.querySpec()
+	_spec.Node.Columns = npq.ctx.Fields
+	if len(npq.ctx.Fields) > 0 {
+		_spec.Unique = npq.ctx.Unique != nil && *npq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, npq.driver, _spec)
+}
+
+func (npq *NoteProposalQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(noteproposal.Table, noteproposal.Columns, sqlgraph.NewFieldSpec(noteproposal.FieldID, field.TypeInt))
+	_spec.From = npq.sql
+	if unique := npq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if npq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := npq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, noteproposal.FieldID)
+		for i := range fields {
+			if fields[i] != noteproposal.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := npq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := npq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := npq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := npq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (npq *NoteProposalQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(npq.driver.Dialect())
+	t1 := builder.Table(noteproposal.Table)
+	columns := npq.ctx.Fields
+	if len(columns) == 0 {
+		columns = noteproposal.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if npq.sql != nil {
+		selector = npq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if npq.ctx.Unique != nil && *npq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range npq.predicates {
+		p(selector)
+	}
+	for _, p := range npq.order {
+		p(selector)
+	}
+	if offset := npq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := npq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// NoteProposalGroupBy is the group-by builder for NoteProposal entities.
+type NoteProposalGroupBy struct {
+	selector
+	build *NoteProposalQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (npgb *NoteProposalGroupBy) Aggregate(fns ...AggregateFunc) *NoteProposalGroupBy {
+	npgb.fns = append(npgb.fns, fns...)
+	return npgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (npgb *NoteProposalGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, npgb.build.ctx, ent.OpQueryGroupBy)
+	if err := npgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*NoteProposalQuery, *NoteProposalGroupBy](ctx, npgb.build, npgb, npgb.build.inters, v)
+}
+
+func (npgb *NoteProposalGroupBy) sqlScan(ctx context.Context, root *NoteProposalQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(npgb.fns))
+	for _, fn := range npgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*npgb.flds)+len(npgb.fns))
+		for _, f := range *npgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*npgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := npgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// NoteProposalSelect is the builder for selecting fields of NoteProposal entities.
+type NoteProposalSelect struct {
+	*NoteProposalQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (nps *NoteProposalSelect) Aggregate(fns ...AggregateFunc) *NoteProposalSelect {
+	nps.fns = append(nps.fns, fns...)
+	return nps
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (nps *NoteProposalSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, nps.ctx, ent.OpQuerySelect)
+	if err := nps.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*NoteProposalQuery, *NoteProposalSelect](ctx, nps.NoteProposalQuery, nps, nps.inters, v)
+}
+
+func (nps *NoteProposalSelect) sqlScan(ctx context.Context, root *NoteProposalQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(nps.fns))
+	for _, fn := range nps.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*nps.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := nps.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
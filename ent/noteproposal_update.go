@@ -0,0 +1,790 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/r-scheele/zero/ent/note"
+	"github.com/r-scheele/zero/ent/noteproposal"
+	"github.com/r-scheele/zero/ent/predicate"
+	"github.com/r-scheele/zero/ent/user"
+)
+
+// NoteProposalUpdate is the builder for updating NoteProposal entities.
+type NoteProposalUpdate struct {
+	config
+	hooks    []Hook
+	mutation *NoteProposalMutation
+}
+
+// Where appends a list predicates to the NoteProposalUpdate builder.
+func (npu *NoteProposalUpdate) Where(ps ...predicate.NoteProposal) *NoteProposalUpdate {
+	npu.mutation.Where(ps...)
+	return npu
+}
+
+// SetTitle sets the "title" field.
+func (npu *NoteProposalUpdate) SetTitle(s string) *NoteProposalUpdate {
+	npu.mutation.SetTitle(s)
+	return npu
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (npu *NoteProposalUpdate) SetNillableTitle(s *string) *NoteProposalUpdate {
+	if s != nil {
+		npu.SetTitle(*s)
+	}
+	return npu
+}
+
+// SetDescription sets the "description" field.
+func (npu *NoteProposalUpdate) SetDescription(s string) *NoteProposalUpdate {
+	npu.mutation.SetDescription(s)
+	return npu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (npu *NoteProposalUpdate) SetNillableDescription(s *string) *NoteProposalUpdate {
+	if s != nil {
+		npu.SetDescription(*s)
+	}
+	return npu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (npu *NoteProposalUpdate) ClearDescription() *NoteProposalUpdate {
+	npu.mutation.ClearDescription()
+	return npu
+}
+
+// SetContent sets the "content" field.
+func (npu *NoteProposalUpdate) SetContent(s string) *NoteProposalUpdate {
+	npu.mutation.SetContent(s)
+	return npu
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (npu *NoteProposalUpdate) SetNillableContent(s *string) *NoteProposalUpdate {
+	if s != nil {
+		npu.SetContent(*s)
+	}
+	return npu
+}
+
+// ClearContent clears the value of the "content" field.
+func (npu *NoteProposalUpdate) ClearContent() *NoteProposalUpdate {
+	npu.mutation.ClearContent()
+	return npu
+}
+
+// SetBaseTitle sets the "base_title" field.
+func (npu *NoteProposalUpdate) SetBaseTitle(s string) *NoteProposalUpdate {
+	npu.mutation.SetBaseTitle(s)
+	return npu
+}
+
+// SetNillableBaseTitle sets the "base_title" field if the given value is not nil.
+func (npu *NoteProposalUpdate) SetNillableBaseTitle(s *string) *NoteProposalUpdate {
+	if s != nil {
+		npu.SetBaseTitle(*s)
+	}
+	return npu
+}
+
+// ClearBaseTitle clears the value of the "base_title" field.
+func (npu *NoteProposalUpdate) ClearBaseTitle() *NoteProposalUpdate {
+	npu.mutation.ClearBaseTitle()
+	return npu
+}
+
+// SetBaseDescription sets the "base_description" field.
+func (npu *NoteProposalUpdate) SetBaseDescription(s string) *NoteProposalUpdate {
+	npu.mutation.SetBaseDescription(s)
+	return npu
+}
+
+// SetNillableBaseDescription sets the "base_description" field if the given value is not nil.
+func (npu *NoteProposalUpdate) SetNillableBaseDescription(s *string) *NoteProposalUpdate {
+	if s != nil {
+		npu.SetBaseDescription(*s)
+	}
+	return npu
+}
+
+// ClearBaseDescription clears the value of the "base_description" field.
+func (npu *NoteProposalUpdate) ClearBaseDescription() *NoteProposalUpdate {
+	npu.mutation.ClearBaseDescription()
+	return npu
+}
+
+// SetBaseContent sets the "base_content" field.
+func (npu *NoteProposalUpdate) SetBaseContent(s string) *NoteProposalUpdate {
+	npu.mutation.SetBaseContent(s)
+	return npu
+}
+
+// SetNillableBaseContent sets the "base_content" field if the given value is not nil.
+func (npu *NoteProposalUpdate) SetNillableBaseContent(s *string) *NoteProposalUpdate {
+	if s != nil {
+		npu.SetBaseContent(*s)
+	}
+	return npu
+}
+
+// ClearBaseContent clears the value of the "base_content" field.
+func (npu *NoteProposalUpdate) ClearBaseContent() *NoteProposalUpdate {
+	npu.mutation.ClearBaseContent()
+	return npu
+}
+
+// SetStatus sets the "status" field.
+func (npu *NoteProposalUpdate) SetStatus(n noteproposal.Status) *NoteProposalUpdate {
+	npu.mutation.SetStatus(n)
+	return npu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (npu *NoteProposalUpdate) SetNillableStatus(n *noteproposal.Status) *NoteProposalUpdate {
+	if n != nil {
+		npu.SetStatus(*n)
+	}
+	return npu
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (npu *NoteProposalUpdate) SetReviewedAt(t time.Time) *NoteProposalUpdate {
+	npu.mutation.SetReviewedAt(t)
+	return npu
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (npu *NoteProposalUpdate) SetNillableReviewedAt(t *time.Time) *NoteProposalUpdate {
+	if t != nil {
+		npu.SetReviewedAt(*t)
+	}
+	return npu
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (npu *NoteProposalUpdate) ClearReviewedAt() *NoteProposalUpdate {
+	npu.mutation.ClearReviewedAt()
+	return npu
+}
+
+// SetNoteID sets the "note" edge to the Note entity by ID.
+func (npu *NoteProposalUpdate) SetNoteID(id int) *NoteProposalUpdate {
+	npu.mutation.SetNoteID(id)
+	return npu
+}
+
+// SetNote sets the "note" edge to the Note entity.
+func (npu *NoteProposalUpdate) SetNote(n *Note) *NoteProposalUpdate {
+	return npu.SetNoteID(n.ID)
+}
+
+// SetProposerID sets the "proposer" edge to the User entity by ID.
+func (npu *NoteProposalUpdate) SetProposerID(id int) *NoteProposalUpdate {
+	npu.mutation.SetProposerID(id)
+	return npu
+}
+
+// SetProposer sets the "proposer" edge to the User entity.
+func (npu *NoteProposalUpdate) SetProposer(u *User) *NoteProposalUpdate {
+	return npu.SetProposerID(u.ID)
+}
+
+// Mutation returns the NoteProposalMutation object of the builder.
+func (npu *NoteProposalUpdate) Mutation() *NoteProposalMutation {
+	return npu.mutation
+}
+
+// ClearNote clears the "note" edge to the Note entity.
+func (npu *NoteProposalUpdate) ClearNote() *NoteProposalUpdate {
+	npu.mutation.ClearNote()
+	return npu
+}
+
+// ClearProposer clears the "proposer" edge to the User entity.
+func (npu *NoteProposalUpdate) ClearProposer() *NoteProposalUpdate {
+	npu.mutation.ClearProposer()
+	return npu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (npu *NoteProposalUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, npu.sqlSave, npu.mutation, npu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (npu *NoteProposalUpdate) SaveX(ctx context.Context) int {
+	affected, err := npu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (npu *NoteProposalUpdate) Exec(ctx context.Context) error {
+	_, err := npu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (npu *NoteProposalUpdate) ExecX(ctx context.Context) {
+	if err := npu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (npu *NoteProposalUpdate) check() error {
+	if v, ok := npu.mutation.Title(); ok {
+		if err := noteproposal.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "NoteProposal.title": %w`, err)}
+		}
+	}
+	if v, ok := npu.mutation.Status(); ok {
+		if err := noteproposal.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "NoteProposal.status": %w`, err)}
+		}
+	}
+	if npu.mutation.NoteCleared() && len(npu.mutation.NoteIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "NoteProposal.note"`)
+	}
+	if npu.mutation.ProposerCleared() && len(npu.mutation.ProposerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "NoteProposal.proposer"`)
+	}
+	return nil
+}
+
+func (npu *NoteProposalUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := npu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(noteproposal.Table, noteproposal.Columns, sqlgraph.NewFieldSpec(noteproposal.FieldID, field.TypeInt))
+	if ps := npu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := npu.mutation.Title(); ok {
+		_spec.SetField(noteproposal.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := npu.mutation.Description(); ok {
+		_spec.SetField(noteproposal.FieldDescription, field.TypeString, value)
+	}
+	if npu.mutation.DescriptionCleared() {
+		_spec.ClearField(noteproposal.FieldDescription, field.TypeString)
+	}
+	if value, ok := npu.mutation.Content(); ok {
+		_spec.SetField(noteproposal.FieldContent, field.TypeString, value)
+	}
+	if npu.mutation.ContentCleared() {
+		_spec.ClearField(noteproposal.FieldContent, field.TypeString)
+	}
+	if value, ok := npu.mutation.BaseTitle(); ok {
+		_spec.SetField(noteproposal.FieldBaseTitle, field.TypeString, value)
+	}
+	if npu.mutation.BaseTitleCleared() {
+		_spec.ClearField(noteproposal.FieldBaseTitle, field.TypeString)
+	}
+	if value, ok := npu.mutation.BaseDescription(); ok {
+		_spec.SetField(noteproposal.FieldBaseDescription, field.TypeString, value)
+	}
+	if npu.mutation.BaseDescriptionCleared() {
+		_spec.ClearField(noteproposal.FieldBaseDescription, field.TypeString)
+	}
+	if value, ok := npu.mutation.BaseContent(); ok {
+		_spec.SetField(noteproposal.FieldBaseContent, field.TypeString, value)
+	}
+	if npu.mutation.BaseContentCleared() {
+		_spec.ClearField(noteproposal.FieldBaseContent, field.TypeString)
+	}
+	if value, ok := npu.mutation.Status(); ok {
+		_spec.SetField(noteproposal.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := npu.mutation.ReviewedAt(); ok {
+		_spec.SetField(noteproposal.FieldReviewedAt, field.TypeTime, value)
+	}
+	if npu.mutation.ReviewedAtCleared() {
+		_spec.ClearField(noteproposal.FieldReviewedAt, field.TypeTime)
+	}
+	if npu.mutation.NoteCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   noteproposal.NoteTable,
+			Columns: []string{noteproposal.NoteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := npu.mutation.NoteIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   noteproposal.NoteTable,
+			Columns: []string{noteproposal.NoteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if npu.mutation.ProposerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   noteproposal.ProposerTable,
+			Columns: []string{noteproposal.ProposerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := npu.mutation.ProposerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   noteproposal.ProposerTable,
+			Columns: []string{noteproposal.ProposerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, npu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{noteproposal.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	npu.mutation.done = true
+	return n, nil
+}
+
+// NoteProposalUpdateOne is the builder for updating a single NoteProposal entity.
+type NoteProposalUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *NoteProposalMutation
+}
+
+// SetTitle sets the "title" field.
+func (npuo *NoteProposalUpdateOne) SetTitle(s string) *NoteProposalUpdateOne {
+	npuo.mutation.SetTitle(s)
+	return npuo
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (npuo *NoteProposalUpdateOne) SetNillableTitle(s *string) *NoteProposalUpdateOne {
+	if s != nil {
+		npuo.SetTitle(*s)
+	}
+	return npuo
+}
+
+// SetDescription sets the "description" field.
+func (npuo *NoteProposalUpdateOne) SetDescription(s string) *NoteProposalUpdateOne {
+	npuo.mutation.SetDescription(s)
+	return npuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (npuo *NoteProposalUpdateOne) SetNillableDescription(s *string) *NoteProposalUpdateOne {
+	if s != nil {
+		npuo.SetDescription(*s)
+	}
+	return npuo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (npuo *NoteProposalUpdateOne) ClearDescription() *NoteProposalUpdateOne {
+	npuo.mutation.ClearDescription()
+	return npuo
+}
+
+// SetContent sets the "content" field.
+func (npuo *NoteProposalUpdateOne) SetContent(s string) *NoteProposalUpdateOne {
+	npuo.mutation.SetContent(s)
+	return npuo
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (npuo *NoteProposalUpdateOne) SetNillableContent(s *string) *NoteProposalUpdateOne {
+	if s != nil {
+		npuo.SetContent(*s)
+	}
+	return npuo
+}
+
+// ClearContent clears the value of the "content" field.
+func (npuo *NoteProposalUpdateOne) ClearContent() *NoteProposalUpdateOne {
+	npuo.mutation.ClearContent()
+	return npuo
+}
+
+// SetBaseTitle sets the "base_title" field.
+func (npuo *NoteProposalUpdateOne) SetBaseTitle(s string) *NoteProposalUpdateOne {
+	npuo.mutation.SetBaseTitle(s)
+	return npuo
+}
+
+// SetNillableBaseTitle sets the "base_title" field if the given value is not nil.
+func (npuo *NoteProposalUpdateOne) SetNillableBaseTitle(s *string) *NoteProposalUpdateOne {
+	if s != nil {
+		npuo.SetBaseTitle(*s)
+	}
+	return npuo
+}
+
+// ClearBaseTitle clears the value of the "base_title" field.
+func (npuo *NoteProposalUpdateOne) ClearBaseTitle() *NoteProposalUpdateOne {
+	npuo.mutation.ClearBaseTitle()
+	return npuo
+}
+
+// SetBaseDescription sets the "base_description" field.
+func (npuo *NoteProposalUpdateOne) SetBaseDescription(s string) *NoteProposalUpdateOne {
+	npuo.mutation.SetBaseDescription(s)
+	return npuo
+}
+
+// SetNillableBaseDescription sets the "base_description" field if the given value is not nil.
+func (npuo *NoteProposalUpdateOne) SetNillableBaseDescription(s *string) *NoteProposalUpdateOne {
+	if s != nil {
+		npuo.SetBaseDescription(*s)
+	}
+	return npuo
+}
+
+// ClearBaseDescription clears the value of the "base_description" field.
+func (npuo *NoteProposalUpdateOne) ClearBaseDescription() *NoteProposalUpdateOne {
+	npuo.mutation.ClearBaseDescription()
+	return npuo
+}
+
+// SetBaseContent sets the "base_content" field.
+func (npuo *NoteProposalUpdateOne) SetBaseContent(s string) *NoteProposalUpdateOne {
+	npuo.mutation.SetBaseContent(s)
+	return npuo
+}
+
+// SetNillableBaseContent sets the "base_content" field if the given value is not nil.
+func (npuo *NoteProposalUpdateOne) SetNillableBaseContent(s *string) *NoteProposalUpdateOne {
+	if s != nil {
+		npuo.SetBaseContent(*s)
+	}
+	return npuo
+}
+
+// ClearBaseContent clears the value of the "base_content" field.
+func (npuo *NoteProposalUpdateOne) ClearBaseContent() *NoteProposalUpdateOne {
+	npuo.mutation.ClearBaseContent()
+	return npuo
+}
+
+// SetStatus sets the "status" field.
+func (npuo *NoteProposalUpdateOne) SetStatus(n noteproposal.Status) *NoteProposalUpdateOne {
+	npuo.mutation.SetStatus(n)
+	return npuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (npuo *NoteProposalUpdateOne) SetNillableStatus(n *noteproposal.Status) *NoteProposalUpdateOne {
+	if n != nil {
+		npuo.SetStatus(*n)
+	}
+	return npuo
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (npuo *NoteProposalUpdateOne) SetReviewedAt(t time.Time) *NoteProposalUpdateOne {
+	npuo.mutation.SetReviewedAt(t)
+	return npuo
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (npuo *NoteProposalUpdateOne) SetNillableReviewedAt(t *time.Time) *NoteProposalUpdateOne {
+	if t != nil {
+		npuo.SetReviewedAt(*t)
+	}
+	return npuo
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (npuo *NoteProposalUpdateOne) ClearReviewedAt() *NoteProposalUpdateOne {
+	npuo.mutation.ClearReviewedAt()
+	return npuo
+}
+
+// SetNoteID sets the "note" edge to the Note entity by ID.
+func (npuo *NoteProposalUpdateOne) SetNoteID(id int) *NoteProposalUpdateOne {
+	npuo.mutation.SetNoteID(id)
+	return npuo
+}
+
+// SetNote sets the "note" edge to the Note entity.
+func (npuo *NoteProposalUpdateOne) SetNote(n *Note) *NoteProposalUpdateOne {
+	return npuo.SetNoteID(n.ID)
+}
+
+// SetProposerID sets the "proposer" edge to the User entity by ID.
+func (npuo *NoteProposalUpdateOne) SetProposerID(id int) *NoteProposalUpdateOne {
+	npuo.mutation.SetProposerID(id)
+	return npuo
+}
+
+// SetProposer sets the "proposer" edge to the User entity.
+func (npuo *NoteProposalUpdateOne) SetProposer(u *User) *NoteProposalUpdateOne {
+	return npuo.SetProposerID(u.ID)
+}
+
+// Mutation returns the NoteProposalMutation object of the builder.
+func (npuo *NoteProposalUpdateOne) Mutation() *NoteProposalMutation {
+	return npuo.mutation
+}
+
+// ClearNote clears the "note" edge to the Note entity.
+func (npuo *NoteProposalUpdateOne) ClearNote() *NoteProposalUpdateOne {
+	npuo.mutation.ClearNote()
+	return npuo
+}
+
+// ClearProposer clears the "proposer" edge to the User entity.
+func (npuo *NoteProposalUpdateOne) ClearProposer() *NoteProposalUpdateOne {
+	npuo.mutation.ClearProposer()
+	return npuo
+}
+
+// Where appends a list predicates to the NoteProposalUpdate builder.
+func (npuo *NoteProposalUpdateOne) Where(ps ...predicate.NoteProposal) *NoteProposalUpdateOne {
+	npuo.mutation.Where(ps...)
+	return npuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (npuo *NoteProposalUpdateOne) Select(field string, fields ...string) *NoteProposalUpdateOne {
+	npuo.fields = append([]string{field}, fields...)
+	return npuo
+}
+
+// Save executes the query and returns the updated NoteProposal entity.
+func (npuo *NoteProposalUpdateOne) Save(ctx context.Context) (*NoteProposal, error) {
+	return withHooks(ctx, npuo.sqlSave, npuo.mutation, npuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (npuo *NoteProposalUpdateOne) SaveX(ctx context.Context) *NoteProposal {
+	node, err := npuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (npuo *NoteProposalUpdateOne) Exec(ctx context.Context) error {
+	_, err := npuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (npuo *NoteProposalUpdateOne) ExecX(ctx context.Context) {
+	if err := npuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (npuo *NoteProposalUpdateOne) check() error {
+	if v, ok := npuo.mutation.Title(); ok {
+		if err := noteproposal.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "NoteProposal.title": %w`, err)}
+		}
+	}
+	if v, ok := npuo.mutation.Status(); ok {
+		if err := noteproposal.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "NoteProposal.status": %w`, err)}
+		}
+	}
+	if npuo.mutation.NoteCleared() && len(npuo.mutation.NoteIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "NoteProposal.note"`)
+	}
+	if npuo.mutation.ProposerCleared() && len(npuo.mutation.ProposerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "NoteProposal.proposer"`)
+	}
+	return nil
+}
+
+func (npuo *NoteProposalUpdateOne) sqlSave(ctx context.Context) (_node *NoteProposal, err error) {
+	if err := npuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(noteproposal.Table, noteproposal.Columns, sqlgraph.NewFieldSpec(noteproposal.FieldID, field.TypeInt))
+	id, ok := npuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "NoteProposal.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := npuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, noteproposal.FieldID)
+		for _, f := range fields {
+			if !noteproposal.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != noteproposal.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := npuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := npuo.mutation.Title(); ok {
+		_spec.SetField(noteproposal.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := npuo.mutation.Description(); ok {
+		_spec.SetField(noteproposal.FieldDescription, field.TypeString, value)
+	}
+	if npuo.mutation.DescriptionCleared() {
+		_spec.ClearField(noteproposal.FieldDescription, field.TypeString)
+	}
+	if value, ok := npuo.mutation.Content(); ok {
+		_spec.SetField(noteproposal.FieldContent, field.TypeString, value)
+	}
+	if npuo.mutation.ContentCleared() {
+		_spec.ClearField(noteproposal.FieldContent, field.TypeString)
+	}
+	if value, ok := npuo.mutation.BaseTitle(); ok {
+		_spec.SetField(noteproposal.FieldBaseTitle, field.TypeString, value)
+	}
+	if npuo.mutation.BaseTitleCleared() {
+		_spec.ClearField(noteproposal.FieldBaseTitle, field.TypeString)
+	}
+	if value, ok := npuo.mutation.BaseDescription(); ok {
+		_spec.SetField(noteproposal.FieldBaseDescription, field.TypeString, value)
+	}
+	if npuo.mutation.BaseDescriptionCleared() {
+		_spec.ClearField(noteproposal.FieldBaseDescription, field.TypeString)
+	}
+	if value, ok := npuo.mutation.BaseContent(); ok {
+		_spec.SetField(noteproposal.FieldBaseContent, field.TypeString, value)
+	}
+	if npuo.mutation.BaseContentCleared() {
+		_spec.ClearField(noteproposal.FieldBaseContent, field.TypeString)
+	}
+	if value, ok := npuo.mutation.Status(); ok {
+		_spec.SetField(noteproposal.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := npuo.mutation.ReviewedAt(); ok {
+		_spec.SetField(noteproposal.FieldReviewedAt, field.TypeTime, value)
+	}
+	if npuo.mutation.ReviewedAtCleared() {
+		_spec.ClearField(noteproposal.FieldReviewedAt, field.TypeTime)
+	}
+	if npuo.mutation.NoteCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   noteproposal.NoteTable,
+			Columns: []string{noteproposal.NoteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := npuo.mutation.NoteIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   noteproposal.NoteTable,
+			Columns: []string{noteproposal.NoteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if npuo.mutation.ProposerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   noteproposal.ProposerTable,
+			Columns: []string{noteproposal.ProposerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := npuo.mutation.ProposerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   noteproposal.ProposerTable,
+			Columns: []string{noteproposal.ProposerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &NoteProposal{config: npuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, npuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{noteproposal.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	npuo.mutation.done = true
+	return _node, nil
+}
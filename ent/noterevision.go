@@ -0,0 +1,224 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/r-scheele/zero/ent/note"
+	"github.com/r-scheele/zero/ent/noterevision"
+	"github.com/r-scheele/zero/ent/user"
+	"github.com/r-scheele/zero/pkg/types"
+)
+
+// NoteRevision is the model entity for the NoteRevision schema.
+type NoteRevision struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Title of the note when it was saved
+	Title string `json:"title,omitempty"`
+	// Description of the note when it was saved
+	Description string `json:"description,omitempty"`
+	// Content of the note when it was saved
+	Content string `json:"content,omitempty"`
+	// Resources attached to the note when it was saved
+	Resources []types.Resource `json:"resources,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the NoteRevisionQuery when eager-loading is set.
+	Edges               NoteRevisionEdges `json:"edges"`
+	note_revisions      *int
+	user_note_revisions *int
+	selectValues        sql.SelectValues
+}
+
+// NoteRevisionEdges holds the relations/edges for other nodes in the graph.
+type NoteRevisionEdges struct {
+	// Note holds the value of the note edge.
+	Note *Note `json:"note,omitempty"`
+	// User who saved the revision, if their account still exists
+	Author *User `json:"author,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// NoteOrErr returns the Note value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e NoteRevisionEdges) NoteOrErr() (*Note, error) {
+	if e.Note != nil {
+		return e.Note, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: note.Label}
+	}
+	return nil, &NotLoadedError{edge: "note"}
+}
+
+// AuthorOrErr returns the Author value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e NoteRevisionEdges) AuthorOrErr() (*User, error) {
+	if e.Author != nil {
+		return e.Author, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "author"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*NoteRevision) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case noterevision.FieldResources:
+			values[i] = new([]byte)
+		case noterevision.FieldID:
+			values[i] = new(sql.NullInt64)
+		case noterevision.FieldTitle, noterevision.FieldDescription, noterevision.FieldContent:
+			values[i] = new(sql.NullString)
+		case noterevision.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case noterevision.ForeignKeys[0]: // note_revisions
+			values[i] = new(sql.NullInt64)
+		case noterevision.ForeignKeys[1]: // user_note_revisions
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the NoteRevision fields.
+func (nr *NoteRevision) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case noterevision.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			nr.ID = int(value.Int64)
+		case noterevision.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				nr.Title = value.String
+			}
+		case noterevision.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				nr.Description = value.String
+			}
+		case noterevision.FieldContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content", values[i])
+			} else if value.Valid {
+				nr.Content = value.String
+			}
+		case noterevision.FieldResources:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field resources", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &nr.Resources); err != nil {
+					return fmt.Errorf("unmarshal field resources: %w", err)
+				}
+			}
+		case noterevision.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				nr.CreatedAt = value.Time
+			}
+		case noterevision.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field note_revisions", value)
+			} else if value.Valid {
+				nr.note_revisions = new(int)
+				*nr.note_revisions = int(value.Int64)
+			}
+		case noterevision.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_note_revisions", value)
+			} else if value.Valid {
+				nr.user_note_revisions = new(int)
+				*nr.user_note_revisions = int(value.Int64)
+			}
+		default:
+			nr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the NoteRevision.
+// This includes values selected through modifiers, order, etc.
+func (nr *NoteRevision) Value(name string) (ent.Value, error) {
+	return nr.selectValues.Get(name)
+}
+
+// QueryNote queries the "note" edge of the NoteRevision entity.
+func (nr *NoteRevision) QueryNote() *NoteQuery {
+	return NewNoteRevisionClient(nr.config).QueryNote(nr)
+}
+
+// QueryAuthor queries the "author" edge of the NoteRevision entity.
+func (nr *NoteRevision) QueryAuthor() *UserQuery {
+	return NewNoteRevisionClient(nr.config).QueryAuthor(nr)
+}
+
+// Update returns a builder for updating this NoteRevision.
+// Note that you need to call NoteRevision.Unwrap() before calling this method if this NoteRevision
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (nr *NoteRevision) Update() *NoteRevisionUpdateOne {
+	return NewNoteRevisionClient(nr.config).UpdateOne(nr)
+}
+
+// Unwrap unwraps the NoteRevision entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (nr *NoteRevision) Unwrap() *NoteRevision {
+	_tx, ok := nr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: NoteRevision is not a transactional entity")
+	}
+	nr.config.driver = _tx.drv
+	return nr
+}
+
+// String implements the fmt.Stringer.
+func (nr *NoteRevision) String() string {
+	var builder strings.Builder
+	builder.WriteString("NoteRevision(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", nr.ID))
+	builder.WriteString("title=")
+	builder.WriteString(nr.Title)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(nr.Description)
+	builder.WriteString(", ")
+	builder.WriteString("content=")
+	builder.WriteString(nr.Content)
+	builder.WriteString(", ")
+	builder.WriteString("resources=")
+	builder.WriteString(fmt.Sprintf("%v", nr.Resources))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(nr.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NoteRevisions is a parsable slice of NoteRevision.
+type NoteRevisions []*NoteRevision
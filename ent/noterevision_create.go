@@ -0,0 +1,336 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/r-scheele/zero/ent/note"
+	"github.com/r-scheele/zero/ent/noterevision"
+	"github.com/r-scheele/zero/ent/user"
+	"github.com/r-scheele/zero/pkg/types"
+)
+
+// NoteRevisionCreate is the builder for creating a NoteRevision entity.
+type NoteRevisionCreate struct {
+	config
+	mutation *NoteRevisionMutation
+	hooks    []Hook
+}
+
+// SetTitle sets the "title" field.
+func (nrc *NoteRevisionCreate) SetTitle(s string) *NoteRevisionCreate {
+	nrc.mutation.SetTitle(s)
+	return nrc
+}
+
+// SetDescription sets the "description" field.
+func (nrc *NoteRevisionCreate) SetDescription(s string) *NoteRevisionCreate {
+	nrc.mutation.SetDescription(s)
+	return nrc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (nrc *NoteRevisionCreate) SetNillableDescription(s *string) *NoteRevisionCreate {
+	if s != nil {
+		nrc.SetDescription(*s)
+	}
+	return nrc
+}
+
+// SetContent sets the "content" field.
+func (nrc *NoteRevisionCreate) SetContent(s string) *NoteRevisionCreate {
+	nrc.mutation.SetContent(s)
+	return nrc
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (nrc *NoteRevisionCreate) SetNillableContent(s *string) *NoteRevisionCreate {
+	if s != nil {
+		nrc.SetContent(*s)
+	}
+	return nrc
+}
+
+// SetResources sets the "resources" field.
+func (nrc *NoteRevisionCreate) SetResources(t []types.Resource) *NoteRevisionCreate {
+	nrc.mutation.SetResources(t)
+	return nrc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (nrc *NoteRevisionCreate) SetCreatedAt(t time.Time) *NoteRevisionCreate {
+	nrc.mutation.SetCreatedAt(t)
+	return nrc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (nrc *NoteRevisionCreate) SetNillableCreatedAt(t *time.Time) *NoteRevisionCreate {
+	if t != nil {
+		nrc.SetCreatedAt(*t)
+	}
+	return nrc
+}
+
+// SetNoteID sets the "note" edge to the Note entity by ID.
+func (nrc *NoteRevisionCreate) SetNoteID(id int) *NoteRevisionCreate {
+	nrc.mutation.SetNoteID(id)
+	return nrc
+}
+
+// SetNote sets the "note" edge to the Note entity.
+func (nrc *NoteRevisionCreate) SetNote(n *Note) *NoteRevisionCreate {
+	return nrc.SetNoteID(n.ID)
+}
+
+// SetAuthorID sets the "author" edge to the User entity by ID.
+func (nrc *NoteRevisionCreate) SetAuthorID(id int) *NoteRevisionCreate {
+	nrc.mutation.SetAuthorID(id)
+	return nrc
+}
+
+// SetNillableAuthorID sets the "author" edge to the User entity by ID if the given value is not nil.
+func (nrc *NoteRevisionCreate) SetNillableAuthorID(id *int) *NoteRevisionCreate {
+	if id != nil {
+		nrc = nrc.SetAuthorID(*id)
+	}
+	return nrc
+}
+
+// SetAuthor sets the "author" edge to the User entity.
+func (nrc *NoteRevisionCreate) SetAuthor(u *User) *NoteRevisionCreate {
+	return nrc.SetAuthorID(u.ID)
+}
+
+// Mutation returns the NoteRevisionMutation object of the builder.
+func (nrc *NoteRevisionCreate) Mutation() *NoteRevisionMutation {
+	return nrc.mutation
+}
+
+// Save creates the NoteRevision in the database.
+func (nrc *NoteRevisionCreate) Save(ctx context.Context) (*NoteRevision, error) {
+	nrc.defaults()
+	return withHooks(ctx, nrc.sqlSave, nrc.mutation, nrc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (nrc *NoteRevisionCreate) SaveX(ctx context.Context) *NoteRevision {
+	v, err := nrc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (nrc *NoteRevisionCreate) Exec(ctx context.Context) error {
+	_, err := nrc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nrc *NoteRevisionCreate) ExecX(ctx context.Context) {
+	if err := nrc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (nrc *NoteRevisionCreate) defaults() {
+	if _, ok := nrc.mutation.CreatedAt(); !ok {
+		v := noterevision.DefaultCreatedAt()
+		nrc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nrc *NoteRevisionCreate) check() error {
+	if _, ok := nrc.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "NoteRevision.title"`)}
+	}
+	if v, ok := nrc.mutation.Title(); ok {
+		if err := noterevision.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "NoteRevision.title": %w`, err)}
+		}
+	}
+	if _, ok := nrc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "NoteRevision.created_at"`)}
+	}
+	if len(nrc.mutation.NoteIDs()) == 0 {
+		return &ValidationError{Name: "note", err: errors.New(`ent: missing required edge "NoteRevision.note"`)}
+	}
+	return nil
+}
+
+func (nrc *NoteRevisionCreate) sqlSave(ctx context.Context) (*NoteRevision, error) {
+	if err := nrc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := nrc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, nrc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	nrc.mutation.id = &_node.ID
+	nrc.mutation.done = true
+	return _node, nil
+}
+
+func (nrc *NoteRevisionCreate) createSpec() (*NoteRevision, *sqlgraph.CreateSpec) {
+	var (
+		_node = &NoteRevision{config: nrc.config}
+		_spec = sqlgraph.NewCreateSpec(noterevision.Table, sqlgraph.NewFieldSpec(noterevision.FieldID, field.TypeInt))
+	)
+	if value, ok := nrc.mutation.Title(); ok {
+		_spec.SetField(noterevision.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := nrc.mutation.Description(); ok {
+		_spec.SetField(noterevision.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := nrc.mutation.Content(); ok {
+		_spec.SetField(noterevision.FieldContent, field.TypeString, value)
+		_node.Content = value
+	}
+	if value, ok := nrc.mutation.Resources(); ok {
+		_spec.SetField(noterevision.FieldResources, field.TypeJSON, value)
+		_node.Resources = value
+	}
+	if value, ok := nrc.mutation.CreatedAt(); ok {
+		_spec.SetField(noterevision.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := nrc.mutation.NoteIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   noterevision.NoteTable,
+			Columns: []string{noterevision.NoteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.note_revisions = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := nrc.mutation.AuthorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   noterevision.AuthorTable,
+			Columns: []string{noterevision.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_note_revisions = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// NoteRevisionCreateBulk is the builder for creating many NoteRevision entities in bulk.
+type NoteRevisionCreateBulk struct {
+	config
+	err      error
+	builders []*NoteRevisionCreate
+}
+
+// Save creates the NoteRevision entities in the database.
+func (nrcb *NoteRevisionCreateBulk) Save(ctx context.Context) ([]*NoteRevision, error) {
+	if nrcb.err != nil {
+		return nil, nrcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(nrcb.builders))
+	nodes := make([]*NoteRevision, len(nrcb.builders))
+	mutators := make([]Mutator, len(nrcb.builders))
+	for i := range nrcb.builders {
+		func(i int, root context.Context) {
+			builder := nrcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*NoteRevisionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, nrcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, nrcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, nrcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nrcb *NoteRevisionCreateBulk) SaveX(ctx context.Context) []*NoteRevision {
+	v, err := nrcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (nrcb *NoteRevisionCreateBulk) Exec(ctx context.Context) error {
+	_, err := nrcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nrcb *NoteRevisionCreateBulk) ExecX(ctx context.Context) {
+	if err := nrcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
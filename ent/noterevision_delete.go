@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/r-scheele/zero/ent/noterevision"
+	"github.com/r-scheele/zero/ent/predicate"
+)
+
+// NoteRevisionDelete is the builder for deleting a NoteRevision entity.
+type NoteRevisionDelete struct {
+	config
+	hooks    []Hook
+	mutation *NoteRevisionMutation
+}
+
+// Where appends a list predicates to the NoteRevisionDelete builder.
+func (nrd *NoteRevisionDelete) Where(ps ...predicate.NoteRevision) *NoteRevisionDelete {
+	nrd.mutation.Where(ps...)
+	return nrd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (nrd *NoteRevisionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, nrd.sqlExec, nrd.mutation, nrd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nrd *NoteRevisionDelete) ExecX(ctx context.Context) int {
+	n, err := nrd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (nrd *NoteRevisionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(noterevision.Table, sqlgraph.NewFieldSpec(noterevision.FieldID, field.TypeInt))
+	if ps := nrd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, nrd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	nrd.mutation.done = true
+	return affected, err
+}
+
+// NoteRevisionDeleteOne is the builder for deleting a single NoteRevision entity.
+type NoteRevisionDeleteOne struct {
+	nrd *NoteRevisionDelete
+}
+
+// Where appends a list predicates to the NoteRevisionDelete builder.
+func (nrdo *NoteRevisionDeleteOne) Where(ps ...predicate.NoteRevision) *NoteRevisionDeleteOne {
+	nrdo.nrd.mutation.Where(ps...)
+	return nrdo
+}
+
+// Exec executes the deletion query.
+func (nrdo *NoteRevisionDeleteOne) Exec(ctx context.Context) error {
+	n, err := nrdo.nrd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{noterevision.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nrdo *NoteRevisionDeleteOne) ExecX(ctx context.Context) {
+	if err := nrdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,607 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/r-scheele/zero/ent/note"
+	"github.com/r-scheele/zero/ent/noterevision"
+	"github.com/r-scheele/zero/ent/predicate"
+	"github.com/r-scheele/zero/ent/user"
+	"github.com/r-scheele/zero/pkg/types"
+)
+
+// NoteRevisionUpdate is the builder for updating NoteRevision entities.
+type NoteRevisionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *NoteRevisionMutation
+}
+
+// Where appends a list predicates to the NoteRevisionUpdate builder.
+func (nru *NoteRevisionUpdate) Where(ps ...predicate.NoteRevision) *NoteRevisionUpdate {
+	nru.mutation.Where(ps...)
+	return nru
+}
+
+// SetTitle sets the "title" field.
+func (nru *NoteRevisionUpdate) SetTitle(s string) *NoteRevisionUpdate {
+	nru.mutation.SetTitle(s)
+	return nru
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (nru *NoteRevisionUpdate) SetNillableTitle(s *string) *NoteRevisionUpdate {
+	if s != nil {
+		nru.SetTitle(*s)
+	}
+	return nru
+}
+
+// SetDescription sets the "description" field.
+func (nru *NoteRevisionUpdate) SetDescription(s string) *NoteRevisionUpdate {
+	nru.mutation.SetDescription(s)
+	return nru
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (nru *NoteRevisionUpdate) SetNillableDescription(s *string) *NoteRevisionUpdate {
+	if s != nil {
+		nru.SetDescription(*s)
+	}
+	return nru
+}
+
+// ClearDescription clears the value of the "description" field.
+func (nru *NoteRevisionUpdate) ClearDescription() *NoteRevisionUpdate {
+	nru.mutation.ClearDescription()
+	return nru
+}
+
+// SetContent sets the "content" field.
+func (nru *NoteRevisionUpdate) SetContent(s string) *NoteRevisionUpdate {
+	nru.mutation.SetContent(s)
+	return nru
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (nru *NoteRevisionUpdate) SetNillableContent(s *string) *NoteRevisionUpdate {
+	if s != nil {
+		nru.SetContent(*s)
+	}
+	return nru
+}
+
+// ClearContent clears the value of the "content" field.
+func (nru *NoteRevisionUpdate) ClearContent() *NoteRevisionUpdate {
+	nru.mutation.ClearContent()
+	return nru
+}
+
+// SetResources sets the "resources" field.
+func (nru *NoteRevisionUpdate) SetResources(t []types.Resource) *NoteRevisionUpdate {
+	nru.mutation.SetResources(t)
+	return nru
+}
+
+// AppendResources appends t to the "resources" field.
+func (nru *NoteRevisionUpdate) AppendResources(t []types.Resource) *NoteRevisionUpdate {
+	nru.mutation.AppendResources(t)
+	return nru
+}
+
+// ClearResources clears the value of the "resources" field.
+func (nru *NoteRevisionUpdate) ClearResources() *NoteRevisionUpdate {
+	nru.mutation.ClearResources()
+	return nru
+}
+
+// SetNoteID sets the "note" edge to the Note entity by ID.
+func (nru *NoteRevisionUpdate) SetNoteID(id int) *NoteRevisionUpdate {
+	nru.mutation.SetNoteID(id)
+	return nru
+}
+
+// SetNote sets the "note" edge to the Note entity.
+func (nru *NoteRevisionUpdate) SetNote(n *Note) *NoteRevisionUpdate {
+	return nru.SetNoteID(n.ID)
+}
+
+// SetAuthorID sets the "author" edge to the User entity by ID.
+func (nru *NoteRevisionUpdate) SetAuthorID(id int) *NoteRevisionUpdate {
+	nru.mutation.SetAuthorID(id)
+	return nru
+}
+
+// SetNillableAuthorID sets the "author" edge to the User entity by ID if the given value is not nil.
+func (nru *NoteRevisionUpdate) SetNillableAuthorID(id *int) *NoteRevisionUpdate {
+	if id != nil {
+		nru = nru.SetAuthorID(*id)
+	}
+	return nru
+}
+
+// SetAuthor sets the "author" edge to the User entity.
+func (nru *NoteRevisionUpdate) SetAuthor(u *User) *NoteRevisionUpdate {
+	return nru.SetAuthorID(u.ID)
+}
+
+// Mutation returns the NoteRevisionMutation object of the builder.
+func (nru *NoteRevisionUpdate) Mutation() *NoteRevisionMutation {
+	return nru.mutation
+}
+
+// ClearNote clears the "note" edge to the Note entity.
+func (nru *NoteRevisionUpdate) ClearNote() *NoteRevisionUpdate {
+	nru.mutation.ClearNote()
+	return nru
+}
+
+// ClearAuthor clears the "author" edge to the User entity.
+func (nru *NoteRevisionUpdate) ClearAuthor() *NoteRevisionUpdate {
+	nru.mutation.ClearAuthor()
+	return nru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (nru *NoteRevisionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, nru.sqlSave, nru.mutation, nru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nru *NoteRevisionUpdate) SaveX(ctx context.Context) int {
+	affected, err := nru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (nru *NoteRevisionUpdate) Exec(ctx context.Context) error {
+	_, err := nru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nru *NoteRevisionUpdate) ExecX(ctx context.Context) {
+	if err := nru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nru *NoteRevisionUpdate) check() error {
+	if v, ok := nru.mutation.Title(); ok {
+		if err := noterevision.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "NoteRevision.title": %w`, err)}
+		}
+	}
+	if nru.mutation.NoteCleared() && len(nru.mutation.NoteIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "NoteRevision.note"`)
+	}
+	return nil
+}
+
+func (nru *NoteRevisionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := nru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(noterevision.Table, noterevision.Columns, sqlgraph.NewFieldSpec(noterevision.FieldID, field.TypeInt))
+	if ps := nru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := nru.mutation.Title(); ok {
+		_spec.SetField(noterevision.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := nru.mutation.Description(); ok {
+		_spec.SetField(noterevision.FieldDescription, field.TypeString, value)
+	}
+	if nru.mutation.DescriptionCleared() {
+		_spec.ClearField(noterevision.FieldDescription, field.TypeString)
+	}
+	if value, ok := nru.mutation.Content(); ok {
+		_spec.SetField(noterevision.FieldContent, field.TypeString, value)
+	}
+	if nru.mutation.ContentCleared() {
+		_spec.ClearField(noterevision.FieldContent, field.TypeString)
+	}
+	if value, ok := nru.mutation.Resources(); ok {
+		_spec.SetField(noterevision.FieldResources, field.TypeJSON, value)
+	}
+	if value, ok := nru.mutation.AppendedResources(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, noterevision.FieldResources, value)
+		})
+	}
+	if nru.mutation.ResourcesCleared() {
+		_spec.ClearField(noterevision.FieldResources, field.TypeJSON)
+	}
+	if nru.mutation.NoteCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   noterevision.NoteTable,
+			Columns: []string{noterevision.NoteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nru.mutation.NoteIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   noterevision.NoteTable,
+			Columns: []string{noterevision.NoteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nru.mutation.AuthorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   noterevision.AuthorTable,
+			Columns: []string{noterevision.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nru.mutation.AuthorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   noterevision.AuthorTable,
+			Columns: []string{noterevision.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, nru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{noterevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	nru.mutation.done = true
+	return n, nil
+}
+
+// NoteRevisionUpdateOne is the builder for updating a single NoteRevision entity.
+type NoteRevisionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *NoteRevisionMutation
+}
+
+// SetTitle sets the "title" field.
+func (nruo *NoteRevisionUpdateOne) SetTitle(s string) *NoteRevisionUpdateOne {
+	nruo.mutation.SetTitle(s)
+	return nruo
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (nruo *NoteRevisionUpdateOne) SetNillableTitle(s *string) *NoteRevisionUpdateOne {
+	if s != nil {
+		nruo.SetTitle(*s)
+	}
+	return nruo
+}
+
+// SetDescription sets the "description" field.
+func (nruo *NoteRevisionUpdateOne) SetDescription(s string) *NoteRevisionUpdateOne {
+	nruo.mutation.SetDescription(s)
+	return nruo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (nruo *NoteRevisionUpdateOne) SetNillableDescription(s *string) *NoteRevisionUpdateOne {
+	if s != nil {
+		nruo.SetDescription(*s)
+	}
+	return nruo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (nruo *NoteRevisionUpdateOne) ClearDescription() *NoteRevisionUpdateOne {
+	nruo.mutation.ClearDescription()
+	return nruo
+}
+
+// SetContent sets the "content" field.
+func (nruo *NoteRevisionUpdateOne) SetContent(s string) *NoteRevisionUpdateOne {
+	nruo.mutation.SetContent(s)
+	return nruo
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (nruo *NoteRevisionUpdateOne) SetNillableContent(s *string) *NoteRevisionUpdateOne {
+	if s != nil {
+		nruo.SetContent(*s)
+	}
+	return nruo
+}
+
+// ClearContent clears the value of the "content" field.
+func (nruo *NoteRevisionUpdateOne) ClearContent() *NoteRevisionUpdateOne {
+	nruo.mutation.ClearContent()
+	return nruo
+}
+
+// SetResources sets the "resources" field.
+func (nruo *NoteRevisionUpdateOne) SetResources(t []types.Resource) *NoteRevisionUpdateOne {
+	nruo.mutation.SetResources(t)
+	return nruo
+}
+
+// AppendResources appends t to the "resources" field.
+func (nruo *NoteRevisionUpdateOne) AppendResources(t []types.Resource) *NoteRevisionUpdateOne {
+	nruo.mutation.AppendResources(t)
+	return nruo
+}
+
+// ClearResources clears the value of the "resources" field.
+func (nruo *NoteRevisionUpdateOne) ClearResources() *NoteRevisionUpdateOne {
+	nruo.mutation.ClearResources()
+	return nruo
+}
+
+// SetNoteID sets the "note" edge to the Note entity by ID.
+func (nruo *NoteRevisionUpdateOne) SetNoteID(id int) *NoteRevisionUpdateOne {
+	nruo.mutation.SetNoteID(id)
+	return nruo
+}
+
+// SetNote sets the "note" edge to the Note entity.
+func (nruo *NoteRevisionUpdateOne) SetNote(n *Note) *NoteRevisionUpdateOne {
+	return nruo.SetNoteID(n.ID)
+}
+
+// SetAuthorID sets the "author" edge to the User entity by ID.
+func (nruo *NoteRevisionUpdateOne) SetAuthorID(id int) *NoteRevisionUpdateOne {
+	nruo.mutation.SetAuthorID(id)
+	return nruo
+}
+
+// SetNillableAuthorID sets the "author" edge to the User entity by ID if the given value is not nil.
+func (nruo *NoteRevisionUpdateOne) SetNillableAuthorID(id *int) *NoteRevisionUpdateOne {
+	if id != nil {
+		nruo = nruo.SetAuthorID(*id)
+	}
+	return nruo
+}
+
+// SetAuthor sets the "author" edge to the User entity.
+func (nruo *NoteRevisionUpdateOne) SetAuthor(u *User) *NoteRevisionUpdateOne {
+	return nruo.SetAuthorID(u.ID)
+}
+
+// Mutation returns the NoteRevisionMutation object of the builder.
+func (nruo *NoteRevisionUpdateOne) Mutation() *NoteRevisionMutation {
+	return nruo.mutation
+}
+
+// ClearNote clears the "note" edge to the Note entity.
+func (nruo *NoteRevisionUpdateOne) ClearNote() *NoteRevisionUpdateOne {
+	nruo.mutation.ClearNote()
+	return nruo
+}
+
+// ClearAuthor clears the "author" edge to the User entity.
+func (nruo *NoteRevisionUpdateOne) ClearAuthor() *NoteRevisionUpdateOne {
+	nruo.mutation.ClearAuthor()
+	return nruo
+}
+
+// Where appends a list predicates to the NoteRevisionUpdate builder.
+func (nruo *NoteRevisionUpdateOne) Where(ps ...predicate.NoteRevision) *NoteRevisionUpdateOne {
+	nruo.mutation.Where(ps...)
+	return nruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (nruo *NoteRevisionUpdateOne) Select(field string, fields ...string) *NoteRevisionUpdateOne {
+	nruo.fields = append([]string{field}, fields...)
+	return nruo
+}
+
+// Save executes the query and returns the updated NoteRevision entity.
+func (nruo *NoteRevisionUpdateOne) Save(ctx context.Context) (*NoteRevision, error) {
+	return withHooks(ctx, nruo.sqlSave, nruo.mutation, nruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nruo *NoteRevisionUpdateOne) SaveX(ctx context.Context) *NoteRevision {
+	node, err := nruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (nruo *NoteRevisionUpdateOne) Exec(ctx context.Context) error {
+	_, err := nruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nruo *NoteRevisionUpdateOne) ExecX(ctx context.Context) {
+	if err := nruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nruo *NoteRevisionUpdateOne) check() error {
+	if v, ok := nruo.mutation.Title(); ok {
+		if err := noterevision.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "NoteRevision.title": %w`, err)}
+		}
+	}
+	if nruo.mutation.NoteCleared() && len(nruo.mutation.NoteIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "NoteRevision.note"`)
+	}
+	return nil
+}
+
+func (nruo *NoteRevisionUpdateOne) sqlSave(ctx context.Context) (_node *NoteRevision, err error) {
+	if err := nruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(noterevision.Table, noterevision.Columns, sqlgraph.NewFieldSpec(noterevision.FieldID, field.TypeInt))
+	id, ok := nruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "NoteRevision.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := nruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, noterevision.FieldID)
+		for _, f := range fields {
+			if !noterevision.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != noterevision.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := nruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := nruo.mutation.Title(); ok {
+		_spec.SetField(noterevision.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := nruo.mutation.Description(); ok {
+		_spec.SetField(noterevision.FieldDescription, field.TypeString, value)
+	}
+	if nruo.mutation.DescriptionCleared() {
+		_spec.ClearField(noterevision.FieldDescription, field.TypeString)
+	}
+	if value, ok := nruo.mutation.Content(); ok {
+		_spec.SetField(noterevision.FieldContent, field.TypeString, value)
+	}
+	if nruo.mutation.ContentCleared() {
+		_spec.ClearField(noterevision.FieldContent, field.TypeString)
+	}
+	if value, ok := nruo.mutation.Resources(); ok {
+		_spec.SetField(noterevision.FieldResources, field.TypeJSON, value)
+	}
+	if value, ok := nruo.mutation.AppendedResources(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, noterevision.FieldResources, value)
+		})
+	}
+	if nruo.mutation.ResourcesCleared() {
+		_spec.ClearField(noterevision.FieldResources, field.TypeJSON)
+	}
+	if nruo.mutation.NoteCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   noterevision.NoteTable,
+			Columns: []string{noterevision.NoteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nruo.mutation.NoteIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   noterevision.NoteTable,
+			Columns: []string{noterevision.NoteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nruo.mutation.AuthorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   noterevision.AuthorTable,
+			Columns: []string{noterevision.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nruo.mutation.AuthorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   noterevision.AuthorTable,
+			Columns: []string{noterevision.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &NoteRevision{config: nruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, nruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{noterevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	nruo.mutation.done = true
+	return _node, nil
+}
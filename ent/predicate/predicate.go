@@ -6,17 +6,74 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// Blob is the predicate function for blob builders.
+type Blob func(*sql.Selector)
+
+// BlobReference is the predicate function for blobreference builders.
+type BlobReference func(*sql.Selector)
+
+// Choice is the predicate function for choice builders.
+type Choice func(*sql.Selector)
+
+// Document is the predicate function for document builders.
+type Document func(*sql.Selector)
+
+// Folder is the predicate function for folder builders.
+type Folder func(*sql.Selector)
+
+// GroupInvite is the predicate function for groupinvite builders.
+type GroupInvite func(*sql.Selector)
+
+// GroupJoinRequest is the predicate function for groupjoinrequest builders.
+type GroupJoinRequest func(*sql.Selector)
+
+// GroupMembership is the predicate function for groupmembership builders.
+type GroupMembership func(*sql.Selector)
+
 // Note is the predicate function for note builders.
 type Note func(*sql.Selector)
 
 // NoteLike is the predicate function for notelike builders.
 type NoteLike func(*sql.Selector)
 
+// NoteLink is the predicate function for notelink builders.
+type NoteLink func(*sql.Selector)
+
+// NoteProposal is the predicate function for noteproposal builders.
+type NoteProposal func(*sql.Selector)
+
 // NoteRepost is the predicate function for noterepost builders.
 type NoteRepost func(*sql.Selector)
 
+// NoteRevision is the predicate function for noterevision builders.
+type NoteRevision func(*sql.Selector)
+
+// Notebook is the predicate function for notebook builders.
+type Notebook func(*sql.Selector)
+
 // PasswordToken is the predicate function for passwordtoken builders.
 type PasswordToken func(*sql.Selector)
 
+// Question is the predicate function for question builders.
+type Question func(*sql.Selector)
+
+// Quiz is the predicate function for quiz builders.
+type Quiz func(*sql.Selector)
+
+// QuizAttempt is the predicate function for quizattempt builders.
+type QuizAttempt func(*sql.Selector)
+
+// ResumableUpload is the predicate function for resumableupload builders.
+type ResumableUpload func(*sql.Selector)
+
+// StudyEvent is the predicate function for studyevent builders.
+type StudyEvent func(*sql.Selector)
+
+// StudyGroup is the predicate function for studygroup builders.
+type StudyGroup func(*sql.Selector)
+
+// Tag is the predicate function for tag builders.
+type Tag func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
@@ -0,0 +1,218 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/r-scheele/zero/ent/question"
+	"github.com/r-scheele/zero/ent/quiz"
+)
+
+// Question is the model entity for the Question schema.
+type Question struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// How the question is answered and graded
+	Type question.Type `json:"type,omitempty"`
+	// The question text shown to the student
+	Prompt string `json:"prompt,omitempty"`
+	// Accepted answers for short answer questions
+	AcceptedAnswers []string `json:"accepted_answers,omitempty"`
+	// Optional explanation shown after grading
+	Explanation string `json:"explanation,omitempty"`
+	// Points holds the value of the "points" field.
+	Points int `json:"points,omitempty"`
+	// Order of the question within the quiz
+	Position int `json:"position,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the QuestionQuery when eager-loading is set.
+	Edges          QuestionEdges `json:"edges"`
+	quiz_questions *int
+	selectValues   sql.SelectValues
+}
+
+// QuestionEdges holds the relations/edges for other nodes in the graph.
+type QuestionEdges struct {
+	// Quiz holds the value of the quiz edge.
+	Quiz *Quiz `json:"quiz,omitempty"`
+	// Choices holds the value of the choices edge.
+	Choices []*Choice `json:"choices,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// QuizOrErr returns the Quiz value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e QuestionEdges) QuizOrErr() (*Quiz, error) {
+	if e.Quiz != nil {
+		return e.Quiz, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: quiz.Label}
+	}
+	return nil, &NotLoadedError{edge: "quiz"}
+}
+
+// ChoicesOrErr returns the Choices value or an error if the edge
+// was not loaded in eager-loading.
+func (e QuestionEdges) ChoicesOrErr() ([]*Choice, error) {
+	if e.loadedTypes[1] {
+		return e.Choices, nil
+	}
+	return nil, &NotLoadedError{edge: "choices"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Question) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case question.FieldAcceptedAnswers:
+			values[i] = new([]byte)
+		case question.FieldID, question.FieldPoints, question.FieldPosition:
+			values[i] = new(sql.NullInt64)
+		case question.FieldType, question.FieldPrompt, question.FieldExplanation:
+			values[i] = new(sql.NullString)
+		case question.ForeignKeys[0]: // quiz_questions
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Question fields.
+func (q *Question) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case question.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			q.ID = int(value.Int64)
+		case question.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				q.Type = question.Type(value.String)
+			}
+		case question.FieldPrompt:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field prompt", values[i])
+			} else if value.Valid {
+				q.Prompt = value.String
+			}
+		case question.FieldAcceptedAnswers:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field accepted_answers", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &q.AcceptedAnswers); err != nil {
+					return fmt.Errorf("unmarshal field accepted_answers: %w", err)
+				}
+			}
+		case question.FieldExplanation:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field explanation", values[i])
+			} else if value.Valid {
+				q.Explanation = value.String
+			}
+		case question.FieldPoints:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field points", values[i])
+			} else if value.Valid {
+				q.Points = int(value.Int64)
+			}
+		case question.FieldPosition:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field position", values[i])
+			} else if value.Valid {
+				q.Position = int(value.Int64)
+			}
+		case question.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field quiz_questions", value)
+			} else if value.Valid {
+				q.quiz_questions = new(int)
+				*q.quiz_questions = int(value.Int64)
+			}
+		default:
+			q.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Question.
+// This includes values selected through modifiers, order, etc.
+func (q *Question) Value(name string) (ent.Value, error) {
+	return q.selectValues.Get(name)
+}
+
+// QueryQuiz queries the "quiz" edge of the Question entity.
+func (q *Question) QueryQuiz() *QuizQuery {
+	return NewQuestionClient(q.config).QueryQuiz(q)
+}
+
+// QueryChoices queries the "choices" edge of the Question entity.
+func (q *Question) QueryChoices() *ChoiceQuery {
+	return NewQuestionClient(q.config).QueryChoices(q)
+}
+
+// Update returns a builder for updating this Question.
+// Note that you need to call Question.Unwrap() before calling this method if this Question
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (q *Question) Update() *QuestionUpdateOne {
+	return NewQuestionClient(q.config).UpdateOne(q)
+}
+
+// Unwrap unwraps the Question entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (q *Question) Unwrap() *Question {
+	_tx, ok := q.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Question is not a transactional entity")
+	}
+	q.config.driver = _tx.drv
+	return q
+}
+
+// String implements the fmt.Stringer.
+func (q *Question) String() string {
+	var builder strings.Builder
+	builder.WriteString("Question(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", q.ID))
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", q.Type))
+	builder.WriteString(", ")
+	builder.WriteString("prompt=")
+	builder.WriteString(q.Prompt)
+	builder.WriteString(", ")
+	builder.WriteString("accepted_answers=")
+	builder.WriteString(fmt.Sprintf("%v", q.AcceptedAnswers))
+	builder.WriteString(", ")
+	builder.WriteString("explanation=")
+	builder.WriteString(q.Explanation)
+	builder.WriteString(", ")
+	builder.WriteString("points=")
+	builder.WriteString(fmt.Sprintf("%v", q.Points))
+	builder.WriteString(", ")
+	builder.WriteString("position=")
+	builder.WriteString(fmt.Sprintf("%v", q.Position))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Questions is a parsable slice of Question.
+type Questions []*Question
@@ -0,0 +1,184 @@
+// Code generated by ent, DO NOT EDIT.
+
+package question
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the question type in the database.
+	Label = "question"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldType holds the string denoting the type field in the database.
+	FieldType = "type"
+	// FieldPrompt holds the string denoting the prompt field in the database.
+	FieldPrompt = "prompt"
+	// FieldAcceptedAnswers holds the string denoting the accepted_answers field in the database.
+	FieldAcceptedAnswers = "accepted_answers"
+	// FieldExplanation holds the string denoting the explanation field in the database.
+	FieldExplanation = "explanation"
+	// FieldPoints holds the string denoting the points field in the database.
+	FieldPoints = "points"
+	// FieldPosition holds the string denoting the position field in the database.
+	FieldPosition = "position"
+	// EdgeQuiz holds the string denoting the quiz edge name in mutations.
+	EdgeQuiz = "quiz"
+	// EdgeChoices holds the string denoting the choices edge name in mutations.
+	EdgeChoices = "choices"
+	// Table holds the table name of the question in the database.
+	Table = "questions"
+	// QuizTable is the table that holds the quiz relation/edge.
+	QuizTable = "questions"
+	// QuizInverseTable is the table name for the Quiz entity.
+	// It exists in this package in order to avoid circular dependency with the "quiz" package.
+	QuizInverseTable = "quizs"
+	// QuizColumn is the table column denoting the quiz relation/edge.
+	QuizColumn = "quiz_questions"
+	// ChoicesTable is the table that holds the choices relation/edge.
+	ChoicesTable = "choices"
+	// ChoicesInverseTable is the table name for the Choice entity.
+	// It exists in this package in order to avoid circular dependency with the "choice" package.
+	ChoicesInverseTable = "choices"
+	// ChoicesColumn is the table column denoting the choices relation/edge.
+	ChoicesColumn = "question_choices"
+)
+
+// Columns holds all SQL columns for question fields.
+var Columns = []string{
+	FieldID,
+	FieldType,
+	FieldPrompt,
+	FieldAcceptedAnswers,
+	FieldExplanation,
+	FieldPoints,
+	FieldPosition,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "questions"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"quiz_questions",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// PromptValidator is a validator for the "prompt" field. It is called by the builders before save.
+	PromptValidator func(string) error
+	// DefaultPoints holds the default value on creation for the "points" field.
+	DefaultPoints int
+	// PointsValidator is a validator for the "points" field. It is called by the builders before save.
+	PointsValidator func(int) error
+	// DefaultPosition holds the default value on creation for the "position" field.
+	DefaultPosition int
+)
+
+// Type defines the type for the "type" enum field.
+type Type string
+
+// Type values.
+const (
+	TypeMultipleChoice Type = "multiple_choice"
+	TypeTrueFalse      Type = "true_false"
+	TypeShortAnswer    Type = "short_answer"
+)
+
+func (_type Type) String() string {
+	return string(_type)
+}
+
+// TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
+func TypeValidator(_type Type) error {
+	switch _type {
+	case TypeMultipleChoice, TypeTrueFalse, TypeShortAnswer:
+		return nil
+	default:
+		return fmt.Errorf("question: invalid enum value for type field: %q", _type)
+	}
+}
+
+// OrderOption defines the ordering options for the Question queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByType orders the results by the type field.
+func ByType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldType, opts...).ToFunc()
+}
+
+// ByPrompt orders the results by the prompt field.
+func ByPrompt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPrompt, opts...).ToFunc()
+}
+
+// ByExplanation orders the results by the explanation field.
+func ByExplanation(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExplanation, opts...).ToFunc()
+}
+
+// ByPoints orders the results by the points field.
+func ByPoints(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPoints, opts...).ToFunc()
+}
+
+// ByPosition orders the results by the position field.
+func ByPosition(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPosition, opts...).ToFunc()
+}
+
+// ByQuizField orders the results by quiz field.
+func ByQuizField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newQuizStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByChoicesCount orders the results by choices count.
+func ByChoicesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newChoicesStep(), opts...)
+	}
+}
+
+// ByChoices orders the results by choices terms.
+func ByChoices(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newChoicesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newQuizStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(QuizInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, QuizTable, QuizColumn),
+	)
+}
+func newChoicesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ChoicesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ChoicesTable, ChoicesColumn),
+	)
+}
@@ -0,0 +1,359 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/r-scheele/zero/ent/choice"
+	"github.com/r-scheele/zero/ent/question"
+	"github.com/r-scheele/zero/ent/quiz"
+)
+
+// QuestionCreate is the builder for creating a Question entity.
+type QuestionCreate struct {
+	config
+	mutation *QuestionMutation
+	hooks    []Hook
+}
+
+// SetType sets the "type" field.
+func (qc *QuestionCreate) SetType(q question.Type) *QuestionCreate {
+	qc.mutation.SetType(q)
+	return qc
+}
+
+// SetPrompt sets the "prompt" field.
+func (qc *QuestionCreate) SetPrompt(s string) *QuestionCreate {
+	qc.mutation.SetPrompt(s)
+	return qc
+}
+
+// SetAcceptedAnswers sets the "accepted_answers" field.
+func (qc *QuestionCreate) SetAcceptedAnswers(s []string) *QuestionCreate {
+	qc.mutation.SetAcceptedAnswers(s)
+	return qc
+}
+
+// SetExplanation sets the "explanation" field.
+func (qc *QuestionCreate) SetExplanation(s string) *QuestionCreate {
+	qc.mutation.SetExplanation(s)
+	return qc
+}
+
+// SetNillableExplanation sets the "explanation" field if the given value is not nil.
+func (qc *QuestionCreate) SetNillableExplanation(s *string) *QuestionCreate {
+	if s != nil {
+		qc.SetExplanation(*s)
+	}
+	return qc
+}
+
+// SetPoints sets the "points" field.
+func (qc *QuestionCreate) SetPoints(i int) *QuestionCreate {
+	qc.mutation.SetPoints(i)
+	return qc
+}
+
+// SetNillablePoints sets the "points" field if the given value is not nil.
+func (qc *QuestionCreate) SetNillablePoints(i *int) *QuestionCreate {
+	if i != nil {
+		qc.SetPoints(*i)
+	}
+	return qc
+}
+
+// SetPosition sets the "position" field.
+func (qc *QuestionCreate) SetPosition(i int) *QuestionCreate {
+	qc.mutation.SetPosition(i)
+	return qc
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (qc *QuestionCreate) SetNillablePosition(i *int) *QuestionCreate {
+	if i != nil {
+		qc.SetPosition(*i)
+	}
+	return qc
+}
+
+// SetQuizID sets the "quiz" edge to the Quiz entity by ID.
+func (qc *QuestionCreate) SetQuizID(id int) *QuestionCreate {
+	qc.mutation.SetQuizID(id)
+	return qc
+}
+
+// SetQuiz sets the "quiz" edge to the Quiz entity.
+func (qc *QuestionCreate) SetQuiz(q *Quiz) *QuestionCreate {
+	return qc.SetQuizID(q.ID)
+}
+
+// AddChoiceIDs adds the "choices" edge to the Choice entity by IDs.
+func (qc *QuestionCreate) AddChoiceIDs(ids ...int) *QuestionCreate {
+	qc.mutation.AddChoiceIDs(ids...)
+	return qc
+}
+
+// AddChoices adds the "choices" edges to the Choice entity.
+func (qc *QuestionCreate) AddChoices(c ...*Choice) *QuestionCreate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return qc.AddChoiceIDs(ids...)
+}
+
+// Mutation returns the QuestionMutation object of the builder.
+func (qc *QuestionCreate) Mutation() *QuestionMutation {
+	return qc.mutation
+}
+
+// Save creates the Question in the database.
+func (qc *QuestionCreate) Save(ctx context.Context) (*Question, error) {
+	qc.defaults()
+	return withHooks(ctx, qc.sqlSave, qc.mutation, qc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (qc *QuestionCreate) SaveX(ctx context.Context) *Question {
+	v, err := qc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (qc *QuestionCreate) Exec(ctx context.Context) error {
+	_, err := qc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qc *QuestionCreate) ExecX(ctx context.Context) {
+	if err := qc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (qc *QuestionCreate) defaults() {
+	if _, ok := qc.mutation.Points(); !ok {
+		v := question.DefaultPoints
+		qc.mutation.SetPoints(v)
+	}
+	if _, ok := qc.mutation.Position(); !ok {
+		v := question.DefaultPosition
+		qc.mutation.SetPosition(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (qc *QuestionCreate) check() error {
+	if _, ok := qc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "Question.type"`)}
+	}
+	if v, ok := qc.mutation.GetType(); ok {
+		if err := question.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Question.type": %w`, err)}
+		}
+	}
+	if _, ok := qc.mutation.Prompt(); !ok {
+		return &ValidationError{Name: "prompt", err: errors.New(`ent: missing required field "Question.prompt"`)}
+	}
+	if v, ok := qc.mutation.Prompt(); ok {
+		if err := question.PromptValidator(v); err != nil {
+			return &ValidationError{Name: "prompt", err: fmt.Errorf(`ent: validator failed for field "Question.prompt": %w`, err)}
+		}
+	}
+	if _, ok := qc.mutation.Points(); !ok {
+		return &ValidationError{Name: "points", err: errors.New(`ent: missing required field "Question.points"`)}
+	}
+	if v, ok := qc.mutation.Points(); ok {
+		if err := question.PointsValidator(v); err != nil {
+			return &ValidationError{Name: "points", err: fmt.Errorf(`ent: validator failed for field "Question.points": %w`, err)}
+		}
+	}
+	if _, ok := qc.mutation.Position(); !ok {
+		return &ValidationError{Name: "position", err: errors.New(`ent: missing required field "Question.position"`)}
+	}
+	if len(qc.mutation.QuizIDs()) == 0 {
+		return &ValidationError{Name: "quiz", err: errors.New(`ent: missing required edge "Question.quiz"`)}
+	}
+	return nil
+}
+
+func (qc *QuestionCreate) sqlSave(ctx context.Context) (*Question, error) {
+	if err := qc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := qc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, qc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	qc.mutation.id = &_node.ID
+	qc.mutation.done = true
+	return _node, nil
+}
+
+func (qc *QuestionCreate) createSpec() (*Question, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Question{config: qc.config}
+		_spec = sqlgraph.NewCreateSpec(question.Table, sqlgraph.NewFieldSpec(question.FieldID, field.TypeInt))
+	)
+	if value, ok := qc.mutation.GetType(); ok {
+		_spec.SetField(question.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := qc.mutation.Prompt(); ok {
+		_spec.SetField(question.FieldPrompt, field.TypeString, value)
+		_node.Prompt = value
+	}
+	if value, ok := qc.mutation.AcceptedAnswers(); ok {
+		_spec.SetField(question.FieldAcceptedAnswers, field.TypeJSON, value)
+		_node.AcceptedAnswers = value
+	}
+	if value, ok := qc.mutation.Explanation(); ok {
+		_spec.SetField(question.FieldExplanation, field.TypeString, value)
+		_node.Explanation = value
+	}
+	if value, ok := qc.mutation.Points(); ok {
+		_spec.SetField(question.FieldPoints, field.TypeInt, value)
+		_node.Points = value
+	}
+	if value, ok := qc.mutation.Position(); ok {
+		_spec.SetField(question.FieldPosition, field.TypeInt, value)
+		_node.Position = value
+	}
+	if nodes := qc.mutation.QuizIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   question.QuizTable,
+			Columns: []string{question.QuizColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quiz.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.quiz_questions = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := qc.mutation.ChoicesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   question.ChoicesTable,
+			Columns: []string{question.ChoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(choice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// QuestionCreateBulk is the builder for creating many Question entities in bulk.
+type QuestionCreateBulk struct {
+	config
+	err      error
+	builders []*QuestionCreate
+}
+
+// Save creates the Question entities in the database.
+func (qcb *QuestionCreateBulk) Save(ctx context.Context) ([]*Question, error) {
+	if qcb.err != nil {
+		return nil, qcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(qcb.builders))
+	nodes := make([]*Question, len(qcb.builders))
+	mutators := make([]Mutator, len(qcb.builders))
+	for i := range qcb.builders {
+		func(i int, root context.Context) {
+			builder := qcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*QuestionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, qcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, qcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, qcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (qcb *QuestionCreateBulk) SaveX(ctx context.Context) []*Question {
+	v, err := qcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (qcb *QuestionCreateBulk) Exec(ctx context.Context) error {
+	_, err := qcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qcb *QuestionCreateBulk) ExecX(ctx context.Context) {
+	if err := qcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
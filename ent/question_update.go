@@ -0,0 +1,770 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/r-scheele/zero/ent/choice"
+	"github.com/r-scheele/zero/ent/predicate"
+	"github.com/r-scheele/zero/ent/question"
+	"github.com/r-scheele/zero/ent/quiz"
+)
+
+// QuestionUpdate is the builder for updating Question entities.
+type QuestionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *QuestionMutation
+}
+
+// Where appends a list predicates to the QuestionUpdate builder.
+func (qu *QuestionUpdate) Where(ps ...predicate.Question) *QuestionUpdate {
+	qu.mutation.Where(ps...)
+	return qu
+}
+
+// SetType sets the "type" field.
+func (qu *QuestionUpdate) SetType(q question.Type) *QuestionUpdate {
+	qu.mutation.SetType(q)
+	return qu
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (qu *QuestionUpdate) SetNillableType(q *question.Type) *QuestionUpdate {
+	if q != nil {
+		qu.SetType(*q)
+	}
+	return qu
+}
+
+// SetPrompt sets the "prompt" field.
+func (qu *QuestionUpdate) SetPrompt(s string) *QuestionUpdate {
+	qu.mutation.SetPrompt(s)
+	return qu
+}
+
+// SetNillablePrompt sets the "prompt" field if the given value is not nil.
+func (qu *QuestionUpdate) SetNillablePrompt(s *string) *QuestionUpdate {
+	if s != nil {
+		qu.SetPrompt(*s)
+	}
+	return qu
+}
+
+// SetAcceptedAnswers sets the "accepted_answers" field.
+func (qu *QuestionUpdate) SetAcceptedAnswers(s []string) *QuestionUpdate {
+	qu.mutation.SetAcceptedAnswers(s)
+	return qu
+}
+
+// AppendAcceptedAnswers appends s to the "accepted_answers" field.
+func (qu *QuestionUpdate) AppendAcceptedAnswers(s []string) *QuestionUpdate {
+	qu.mutation.AppendAcceptedAnswers(s)
+	return qu
+}
+
+// ClearAcceptedAnswers clears the value of the "accepted_answers" field.
+func (qu *QuestionUpdate) ClearAcceptedAnswers() *QuestionUpdate {
+	qu.mutation.ClearAcceptedAnswers()
+	return qu
+}
+
+// SetExplanation sets the "explanation" field.
+func (qu *QuestionUpdate) SetExplanation(s string) *QuestionUpdate {
+	qu.mutation.SetExplanation(s)
+	return qu
+}
+
+// SetNillableExplanation sets the "explanation" field if the given value is not nil.
+func (qu *QuestionUpdate) SetNillableExplanation(s *string) *QuestionUpdate {
+	if s != nil {
+		qu.SetExplanation(*s)
+	}
+	return qu
+}
+
+// ClearExplanation clears the value of the "explanation" field.
+func (qu *QuestionUpdate) ClearExplanation() *QuestionUpdate {
+	qu.mutation.ClearExplanation()
+	return qu
+}
+
+// SetPoints sets the "points" field.
+func (qu *QuestionUpdate) SetPoints(i int) *QuestionUpdate {
+	qu.mutation.ResetPoints()
+	qu.mutation.SetPoints(i)
+	return qu
+}
+
+// SetNillablePoints sets the "points" field if the given value is not nil.
+func (qu *QuestionUpdate) SetNillablePoints(i *int) *QuestionUpdate {
+	if i != nil {
+		qu.SetPoints(*i)
+	}
+	return qu
+}
+
+// AddPoints adds i to the "points" field.
+func (qu *QuestionUpdate) AddPoints(i int) *QuestionUpdate {
+	qu.mutation.AddPoints(i)
+	return qu
+}
+
+// SetPosition sets the "position" field.
+func (qu *QuestionUpdate) SetPosition(i int) *QuestionUpdate {
+	qu.mutation.ResetPosition()
+	qu.mutation.SetPosition(i)
+	return qu
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (qu *QuestionUpdate) SetNillablePosition(i *int) *QuestionUpdate {
+	if i != nil {
+		qu.SetPosition(*i)
+	}
+	return qu
+}
+
+// AddPosition adds i to the "position" field.
+func (qu *QuestionUpdate) AddPosition(i int) *QuestionUpdate {
+	qu.mutation.AddPosition(i)
+	return qu
+}
+
+// SetQuizID sets the "quiz" edge to the Quiz entity by ID.
+func (qu *QuestionUpdate) SetQuizID(id int) *QuestionUpdate {
+	qu.mutation.SetQuizID(id)
+	return qu
+}
+
+// SetQuiz sets the "quiz" edge to the Quiz entity.
+func (qu *QuestionUpdate) SetQuiz(q *Quiz) *QuestionUpdate {
+	return qu.SetQuizID(q.ID)
+}
+
+// AddChoiceIDs adds the "choices" edge to the Choice entity by IDs.
+func (qu *QuestionUpdate) AddChoiceIDs(ids ...int) *QuestionUpdate {
+	qu.mutation.AddChoiceIDs(ids...)
+	return qu
+}
+
+// AddChoices adds the "choices" edges to the Choice entity.
+func (qu *QuestionUpdate) AddChoices(c ...*Choice) *QuestionUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return qu.AddChoiceIDs(ids...)
+}
+
+// Mutation returns the QuestionMutation object of the builder.
+func (qu *QuestionUpdate) Mutation() *QuestionMutation {
+	return qu.mutation
+}
+
+// ClearQuiz clears the "quiz" edge to the Quiz entity.
+func (qu *QuestionUpdate) ClearQuiz() *QuestionUpdate {
+	qu.mutation.ClearQuiz()
+	return qu
+}
+
+// ClearChoices clears all "choices" edges to the Choice entity.
+func (qu *QuestionUpdate) ClearChoices() *QuestionUpdate {
+	qu.mutation.ClearChoices()
+	return qu
+}
+
+// RemoveChoiceIDs removes the "choices" edge to Choice entities by IDs.
+func (qu *QuestionUpdate) RemoveChoiceIDs(ids ...int) *QuestionUpdate {
+	qu.mutation.RemoveChoiceIDs(ids...)
+	return qu
+}
+
+// RemoveChoices removes "choices" edges to Choice entities.
+func (qu *QuestionUpdate) RemoveChoices(c ...*Choice) *QuestionUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return qu.RemoveChoiceIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (qu *QuestionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, qu.sqlSave, qu.mutation, qu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (qu *QuestionUpdate) SaveX(ctx context.Context) int {
+	affected, err := qu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (qu *QuestionUpdate) Exec(ctx context.Context) error {
+	_, err := qu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qu *QuestionUpdate) ExecX(ctx context.Context) {
+	if err := qu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (qu *QuestionUpdate) check() error {
+	if v, ok := qu.mutation.GetType(); ok {
+		if err := question.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Question.type": %w`, err)}
+		}
+	}
+	if v, ok := qu.mutation.Prompt(); ok {
+		if err := question.PromptValidator(v); err != nil {
+			return &ValidationError{Name: "prompt", err: fmt.Errorf(`ent: validator failed for field "Question.prompt": %w`, err)}
+		}
+	}
+	if v, ok := qu.mutation.Points(); ok {
+		if err := question.PointsValidator(v); err != nil {
+			return &ValidationError{Name: "points", err: fmt.Errorf(`ent: validator failed for field "Question.points": %w`, err)}
+		}
+	}
+	if qu.mutation.QuizCleared() && len(qu.mutation.QuizIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Question.quiz"`)
+	}
+	return nil
+}
+
+func (qu *QuestionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := qu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(question.Table, question.Columns, sqlgraph.NewFieldSpec(question.FieldID, field.TypeInt))
+	if ps := qu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := qu.mutation.GetType(); ok {
+		_spec.SetField(question.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := qu.mutation.Prompt(); ok {
+		_spec.SetField(question.FieldPrompt, field.TypeString, value)
+	}
+	if value, ok := qu.mutation.AcceptedAnswers(); ok {
+		_spec.SetField(question.FieldAcceptedAnswers, field.TypeJSON, value)
+	}
+	if value, ok := qu.mutation.AppendedAcceptedAnswers(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, question.FieldAcceptedAnswers, value)
+		})
+	}
+	if qu.mutation.AcceptedAnswersCleared() {
+		_spec.ClearField(question.FieldAcceptedAnswers, field.TypeJSON)
+	}
+	if value, ok := qu.mutation.Explanation(); ok {
+		_spec.SetField(question.FieldExplanation, field.TypeString, value)
+	}
+	if qu.mutation.ExplanationCleared() {
+		_spec.ClearField(question.FieldExplanation, field.TypeString)
+	}
+	if value, ok := qu.mutation.Points(); ok {
+		_spec.SetField(question.FieldPoints, field.TypeInt, value)
+	}
+	if value, ok := qu.mutation.AddedPoints(); ok {
+		_spec.AddField(question.FieldPoints, field.TypeInt, value)
+	}
+	if value, ok := qu.mutation.Position(); ok {
+		_spec.SetField(question.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := qu.mutation.AddedPosition(); ok {
+		_spec.AddField(question.FieldPosition, field.TypeInt, value)
+	}
+	if qu.mutation.QuizCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   question.QuizTable,
+			Columns: []string{question.QuizColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quiz.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := qu.mutation.QuizIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   question.QuizTable,
+			Columns: []string{question.QuizColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quiz.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if qu.mutation.ChoicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   question.ChoicesTable,
+			Columns: []string{question.ChoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(choice.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := qu.mutation.RemovedChoicesIDs(); len(nodes) > 0 && !qu.mutation.ChoicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   question.ChoicesTable,
+			Columns: []string{question.ChoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(choice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := qu.mutation.ChoicesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   question.ChoicesTable,
+			Columns: []string{question.ChoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(choice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, qu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{question.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	qu.mutation.done = true
+	return n, nil
+}
+
+// QuestionUpdateOne is the builder for updating a single Question entity.
+type QuestionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *QuestionMutation
+}
+
+// SetType sets the "type" field.
+func (quo *QuestionUpdateOne) SetType(q question.Type) *QuestionUpdateOne {
+	quo.mutation.SetType(q)
+	return quo
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (quo *QuestionUpdateOne) SetNillableType(q *question.Type) *QuestionUpdateOne {
+	if q != nil {
+		quo.SetType(*q)
+	}
+	return quo
+}
+
+// SetPrompt sets the "prompt" field.
+func (quo *QuestionUpdateOne) SetPrompt(s string) *QuestionUpdateOne {
+	quo.mutation.SetPrompt(s)
+	return quo
+}
+
+// SetNillablePrompt sets the "prompt" field if the given value is not nil.
+func (quo *QuestionUpdateOne) SetNillablePrompt(s *string) *QuestionUpdateOne {
+	if s != nil {
+		quo.SetPrompt(*s)
+	}
+	return quo
+}
+
+// SetAcceptedAnswers sets the "accepted_answers" field.
+func (quo *QuestionUpdateOne) SetAcceptedAnswers(s []string) *QuestionUpdateOne {
+	quo.mutation.SetAcceptedAnswers(s)
+	return quo
+}
+
+// AppendAcceptedAnswers appends s to the "accepted_answers" field.
+func (quo *QuestionUpdateOne) AppendAcceptedAnswers(s []string) *QuestionUpdateOne {
+	quo.mutation.AppendAcceptedAnswers(s)
+	return quo
+}
+
+// ClearAcceptedAnswers clears the value of the "accepted_answers" field.
+func (quo *QuestionUpdateOne) ClearAcceptedAnswers() *QuestionUpdateOne {
+	quo.mutation.ClearAcceptedAnswers()
+	return quo
+}
+
+// SetExplanation sets the "explanation" field.
+func (quo *QuestionUpdateOne) SetExplanation(s string) *QuestionUpdateOne {
+	quo.mutation.SetExplanation(s)
+	return quo
+}
+
+// SetNillableExplanation sets the "explanation" field if the given value is not nil.
+func (quo *QuestionUpdateOne) SetNillableExplanation(s *string) *QuestionUpdateOne {
+	if s != nil {
+		quo.SetExplanation(*s)
+	}
+	return quo
+}
+
+// ClearExplanation clears the value of the "explanation" field.
+func (quo *QuestionUpdateOne) ClearExplanation() *QuestionUpdateOne {
+	quo.mutation.ClearExplanation()
+	return quo
+}
+
+// SetPoints sets the "points" field.
+func (quo *QuestionUpdateOne) SetPoints(i int) *QuestionUpdateOne {
+	quo.mutation.ResetPoints()
+	quo.mutation.SetPoints(i)
+	return quo
+}
+
+// SetNillablePoints sets the "points" field if the given value is not nil.
+func (quo *QuestionUpdateOne) SetNillablePoints(i *int) *QuestionUpdateOne {
+	if i != nil {
+		quo.SetPoints(*i)
+	}
+	return quo
+}
+
+// AddPoints adds i to the "points" field.
+func (quo *QuestionUpdateOne) AddPoints(i int) *QuestionUpdateOne {
+	quo.mutation.AddPoints(i)
+	return quo
+}
+
+// SetPosition sets the "position" field.
+func (quo *QuestionUpdateOne) SetPosition(i int) *QuestionUpdateOne {
+	quo.mutation.ResetPosition()
+	quo.mutation.SetPosition(i)
+	return quo
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (quo *QuestionUpdateOne) SetNillablePosition(i *int) *QuestionUpdateOne {
+	if i != nil {
+		quo.SetPosition(*i)
+	}
+	return quo
+}
+
+// AddPosition adds i to the "position" field.
+func (quo *QuestionUpdateOne) AddPosition(i int) *QuestionUpdateOne {
+	quo.mutation.AddPosition(i)
+	return quo
+}
+
+// SetQuizID sets the "quiz" edge to the Quiz entity by ID.
+func (quo *QuestionUpdateOne) SetQuizID(id int) *QuestionUpdateOne {
+	quo.mutation.SetQuizID(id)
+	return quo
+}
+
+// SetQuiz sets the "quiz" edge to the Quiz entity.
+func (quo *QuestionUpdateOne) SetQuiz(q *Quiz) *QuestionUpdateOne {
+	return quo.SetQuizID(q.ID)
+}
+
+// AddChoiceIDs adds the "choices" edge to the Choice entity by IDs.
+func (quo *QuestionUpdateOne) AddChoiceIDs(ids ...int) *QuestionUpdateOne {
+	quo.mutation.AddChoiceIDs(ids...)
+	return quo
+}
+
+// AddChoices adds the "choices" edges to the Choice entity.
+func (quo *QuestionUpdateOne) AddChoices(c ...*Choice) *QuestionUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return quo.AddChoiceIDs(ids...)
+}
+
+// Mutation returns the QuestionMutation object of the builder.
+func (quo *QuestionUpdateOne) Mutation() *QuestionMutation {
+	return quo.mutation
+}
+
+// ClearQuiz clears the "quiz" edge to the Quiz entity.
+func (quo *QuestionUpdateOne) ClearQuiz() *QuestionUpdateOne {
+	quo.mutation.ClearQuiz()
+	return quo
+}
+
+// ClearChoices clears all "choices" edges to the Choice entity.
+func (quo *QuestionUpdateOne) ClearChoices() *QuestionUpdateOne {
+	quo.mutation.ClearChoices()
+	return quo
+}
+
+// RemoveChoiceIDs removes the "choices" edge to Choice entities by IDs.
+func (quo *QuestionUpdateOne) RemoveChoiceIDs(ids ...int) *QuestionUpdateOne {
+	quo.mutation.RemoveChoiceIDs(ids...)
+	return quo
+}
+
+// RemoveChoices removes "choices" edges to Choice entities.
+func (quo *QuestionUpdateOne) RemoveChoices(c ...*Choice) *QuestionUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return quo.RemoveChoiceIDs(ids...)
+}
+
+// Where appends a list predicates to the QuestionUpdate builder.
+func (quo *QuestionUpdateOne) Where(ps ...predicate.Question) *QuestionUpdateOne {
+	quo.mutation.Where(ps...)
+	return quo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (quo *QuestionUpdateOne) Select(field string, fields ...string) *QuestionUpdateOne {
+	quo.fields = append([]string{field}, fields...)
+	return quo
+}
+
+// Save executes the query and returns the updated Question entity.
+func (quo *QuestionUpdateOne) Save(ctx context.Context) (*Question, error) {
+	return withHooks(ctx, quo.sqlSave, quo.mutation, quo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (quo *QuestionUpdateOne) SaveX(ctx context.Context) *Question {
+	node, err := quo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (quo *QuestionUpdateOne) Exec(ctx context.Context) error {
+	_, err := quo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (quo *QuestionUpdateOne) ExecX(ctx context.Context) {
+	if err := quo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (quo *QuestionUpdateOne) check() error {
+	if v, ok := quo.mutation.GetType(); ok {
+		if err := question.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Question.type": %w`, err)}
+		}
+	}
+	if v, ok := quo.mutation.Prompt(); ok {
+		if err := question.PromptValidator(v); err != nil {
+			return &ValidationError{Name: "prompt", err: fmt.Errorf(`ent: validator failed for field "Question.prompt": %w`, err)}
+		}
+	}
+	if v, ok := quo.mutation.Points(); ok {
+		if err := question.PointsValidator(v); err != nil {
+			return &ValidationError{Name: "points", err: fmt.Errorf(`ent: validator failed for field "Question.points": %w`, err)}
+		}
+	}
+	if quo.mutation.QuizCleared() && len(quo.mutation.QuizIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Question.quiz"`)
+	}
+	return nil
+}
+
+func (quo *QuestionUpdateOne) sqlSave(ctx context.Context) (_node *Question, err error) {
+	if err := quo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(question.Table, question.Columns, sqlgraph.NewFieldSpec(question.FieldID, field.TypeInt))
+	id, ok := quo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Question.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := quo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, question.FieldID)
+		for _, f := range fields {
+			if !question.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != question.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := quo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := quo.mutation.GetType(); ok {
+		_spec.SetField(question.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := quo.mutation.Prompt(); ok {
+		_spec.SetField(question.FieldPrompt, field.TypeString, value)
+	}
+	if value, ok := quo.mutation.AcceptedAnswers(); ok {
+		_spec.SetField(question.FieldAcceptedAnswers, field.TypeJSON, value)
+	}
+	if value, ok := quo.mutation.AppendedAcceptedAnswers(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, question.FieldAcceptedAnswers, value)
+		})
+	}
+	if quo.mutation.AcceptedAnswersCleared() {
+		_spec.ClearField(question.FieldAcceptedAnswers, field.TypeJSON)
+	}
+	if value, ok := quo.mutation.Explanation(); ok {
+		_spec.SetField(question.FieldExplanation, field.TypeString, value)
+	}
+	if quo.mutation.ExplanationCleared() {
+		_spec.ClearField(question.FieldExplanation, field.TypeString)
+	}
+	if value, ok := quo.mutation.Points(); ok {
+		_spec.SetField(question.FieldPoints, field.TypeInt, value)
+	}
+	if value, ok := quo.mutation.AddedPoints(); ok {
+		_spec.AddField(question.FieldPoints, field.TypeInt, value)
+	}
+	if value, ok := quo.mutation.Position(); ok {
+		_spec.SetField(question.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := quo.mutation.AddedPosition(); ok {
+		_spec.AddField(question.FieldPosition, field.TypeInt, value)
+	}
+	if quo.mutation.QuizCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   question.QuizTable,
+			Columns: []string{question.QuizColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quiz.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := quo.mutation.QuizIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   question.QuizTable,
+			Columns: []string{question.QuizColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quiz.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if quo.mutation.ChoicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   question.ChoicesTable,
+			Columns: []string{question.ChoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(choice.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := quo.mutation.RemovedChoicesIDs(); len(nodes) > 0 && !quo.mutation.ChoicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   question.ChoicesTable,
+			Columns: []string{question.ChoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(choice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := quo.mutation.ChoicesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   question.ChoicesTable,
+			Columns: []string{question.ChoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(choice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Question{config: quo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, quo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{question.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	quo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,299 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/r-scheele/zero/ent/note"
+	"github.com/r-scheele/zero/ent/quiz"
+	"github.com/r-scheele/zero/ent/user"
+)
+
+// Quiz is the model entity for the Quiz schema.
+type Quiz struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Title of the quiz
+	Title string `json:"title,omitempty"`
+	// Brief description of the quiz
+	Description string `json:"description,omitempty"`
+	// Quiz visibility setting
+	Visibility quiz.Visibility `json:"visibility,omitempty"`
+	// Time limit for an attempt in minutes, 0 for no limit
+	TimeLimit int `json:"time_limit,omitempty"`
+	// Maximum number of attempts per user, 0 for unlimited
+	MaxAttempts int `json:"max_attempts,omitempty"`
+	// Name of the generator that built this quiz from a note, if any
+	Generator string `json:"generator,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the QuizQuery when eager-loading is set.
+	Edges              QuizEdges `json:"edges"`
+	note_practice_sets *int
+	user_quizzes       *int
+	selectValues       sql.SelectValues
+}
+
+// QuizEdges holds the relations/edges for other nodes in the graph.
+type QuizEdges struct {
+	// Owner holds the value of the owner edge.
+	Owner *User `json:"owner,omitempty"`
+	// Note this practice set was generated from
+	Note *Note `json:"note,omitempty"`
+	// Questions holds the value of the questions edge.
+	Questions []*Question `json:"questions,omitempty"`
+	// Attempts holds the value of the attempts edge.
+	Attempts []*QuizAttempt `json:"attempts,omitempty"`
+	// StudyEvents holds the value of the study_events edge.
+	StudyEvents []*StudyEvent `json:"study_events,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [5]bool
+}
+
+// OwnerOrErr returns the Owner value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e QuizEdges) OwnerOrErr() (*User, error) {
+	if e.Owner != nil {
+		return e.Owner, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "owner"}
+}
+
+// NoteOrErr returns the Note value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e QuizEdges) NoteOrErr() (*Note, error) {
+	if e.Note != nil {
+		return e.Note, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: note.Label}
+	}
+	return nil, &NotLoadedError{edge: "note"}
+}
+
+// QuestionsOrErr returns the Questions value or an error if the edge
+// was not loaded in eager-loading.
+func (e QuizEdges) QuestionsOrErr() ([]*Question, error) {
+	if e.loadedTypes[2] {
+		return e.Questions, nil
+	}
+	return nil, &NotLoadedError{edge: "questions"}
+}
+
+// AttemptsOrErr returns the Attempts value or an error if the edge
+// was not loaded in eager-loading.
+func (e QuizEdges) AttemptsOrErr() ([]*QuizAttempt, error) {
+	if e.loadedTypes[3] {
+		return e.Attempts, nil
+	}
+	return nil, &NotLoadedError{edge: "attempts"}
+}
+
+// StudyEventsOrErr returns the StudyEvents value or an error if the edge
+// was not loaded in eager-loading.
+func (e QuizEdges) StudyEventsOrErr() ([]*StudyEvent, error) {
+	if e.loadedTypes[4] {
+		return e.StudyEvents, nil
+	}
+	return nil, &NotLoadedError{edge: "study_events"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Quiz) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case quiz.FieldID, quiz.FieldTimeLimit, quiz.FieldMaxAttempts:
+			values[i] = new(sql.NullInt64)
+		case quiz.FieldTitle, quiz.FieldDescription, quiz.FieldVisibility, quiz.FieldGenerator:
+			values[i] = new(sql.NullString)
+		case quiz.FieldCreatedAt, quiz.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case quiz.ForeignKeys[0]: // note_practice_sets
+			values[i] = new(sql.NullInt64)
+		case quiz.ForeignKeys[1]: // user_quizzes
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Quiz fields.
+func (q *Quiz) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case quiz.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			q.ID = int(value.Int64)
+		case quiz.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				q.Title = value.String
+			}
+		case quiz.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				q.Description = value.String
+			}
+		case quiz.FieldVisibility:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field visibility", values[i])
+			} else if value.Valid {
+				q.Visibility = quiz.Visibility(value.String)
+			}
+		case quiz.FieldTimeLimit:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field time_limit", values[i])
+			} else if value.Valid {
+				q.TimeLimit = int(value.Int64)
+			}
+		case quiz.FieldMaxAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_attempts", values[i])
+			} else if value.Valid {
+				q.MaxAttempts = int(value.Int64)
+			}
+		case quiz.FieldGenerator:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field generator", values[i])
+			} else if value.Valid {
+				q.Generator = value.String
+			}
+		case quiz.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				q.CreatedAt = value.Time
+			}
+		case quiz.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				q.UpdatedAt = value.Time
+			}
+		case quiz.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field note_practice_sets", value)
+			} else if value.Valid {
+				q.note_practice_sets = new(int)
+				*q.note_practice_sets = int(value.Int64)
+			}
+		case quiz.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_quizzes", value)
+			} else if value.Valid {
+				q.user_quizzes = new(int)
+				*q.user_quizzes = int(value.Int64)
+			}
+		default:
+			q.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Quiz.
+// This includes values selected through modifiers, order, etc.
+func (q *Quiz) Value(name string) (ent.Value, error) {
+	return q.selectValues.Get(name)
+}
+
+// QueryOwner queries the "owner" edge of the Quiz entity.
+func (q *Quiz) QueryOwner() *UserQuery {
+	return NewQuizClient(q.config).QueryOwner(q)
+}
+
+// QueryNote queries the "note" edge of the Quiz entity.
+func (q *Quiz) QueryNote() *NoteQuery {
+	return NewQuizClient(q.config).QueryNote(q)
+}
+
+// QueryQuestions queries the "questions" edge of the Quiz entity.
+func (q *Quiz) QueryQuestions() *QuestionQuery {
+	return NewQuizClient(q.config).QueryQuestions(q)
+}
+
+// QueryAttempts queries the "attempts" edge of the Quiz entity.
+func (q *Quiz) QueryAttempts() *QuizAttemptQuery {
+	return NewQuizClient(q.config).QueryAttempts(q)
+}
+
+// QueryStudyEvents queries the "study_events" edge of the Quiz entity.
+func (q *Quiz) QueryStudyEvents() *StudyEventQuery {
+	return NewQuizClient(q.config).QueryStudyEvents(q)
+}
+
+// Update returns a builder for updating this Quiz.
+// Note that you need to call Quiz.Unwrap() before calling this method if this Quiz
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (q *Quiz) Update() *QuizUpdateOne {
+	return NewQuizClient(q.config).UpdateOne(q)
+}
+
+// Unwrap unwraps the Quiz entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (q *Quiz) Unwrap() *Quiz {
+	_tx, ok := q.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Quiz is not a transactional entity")
+	}
+	q.config.driver = _tx.drv
+	return q
+}
+
+// String implements the fmt.Stringer.
+func (q *Quiz) String() string {
+	var builder strings.Builder
+	builder.WriteString("Quiz(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", q.ID))
+	builder.WriteString("title=")
+	builder.WriteString(q.Title)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(q.Description)
+	builder.WriteString(", ")
+	builder.WriteString("visibility=")
+	builder.WriteString(fmt.Sprintf("%v", q.Visibility))
+	builder.WriteString(", ")
+	builder.WriteString("time_limit=")
+	builder.WriteString(fmt.Sprintf("%v", q.TimeLimit))
+	builder.WriteString(", ")
+	builder.WriteString("max_attempts=")
+	builder.WriteString(fmt.Sprintf("%v", q.MaxAttempts))
+	builder.WriteString(", ")
+	builder.WriteString("generator=")
+	builder.WriteString(q.Generator)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(q.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(q.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Quizs is a parsable slice of Quiz.
+type Quizs []*Quiz
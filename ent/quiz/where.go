@@ -0,0 +1,616 @@
+// Code generated by ent, DO NOT EDIT.
+
+package quiz
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/r-scheele/zero/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLTE(FieldID, id))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldTitle, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldDescription, v))
+}
+
+// TimeLimit applies equality check predicate on the "time_limit" field. It's identical to TimeLimitEQ.
+func TimeLimit(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldTimeLimit, v))
+}
+
+// MaxAttempts applies equality check predicate on the "max_attempts" field. It's identical to MaxAttemptsEQ.
+func MaxAttempts(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldMaxAttempts, v))
+}
+
+// Generator applies equality check predicate on the "generator" field. It's identical to GeneratorEQ.
+func Generator(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldGenerator, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.Quiz {
+	return predicate.Quiz(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// VisibilityEQ applies the EQ predicate on the "visibility" field.
+func VisibilityEQ(v Visibility) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldVisibility, v))
+}
+
+// VisibilityNEQ applies the NEQ predicate on the "visibility" field.
+func VisibilityNEQ(v Visibility) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNEQ(FieldVisibility, v))
+}
+
+// VisibilityIn applies the In predicate on the "visibility" field.
+func VisibilityIn(vs ...Visibility) predicate.Quiz {
+	return predicate.Quiz(sql.FieldIn(FieldVisibility, vs...))
+}
+
+// VisibilityNotIn applies the NotIn predicate on the "visibility" field.
+func VisibilityNotIn(vs ...Visibility) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotIn(FieldVisibility, vs...))
+}
+
+// TimeLimitEQ applies the EQ predicate on the "time_limit" field.
+func TimeLimitEQ(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldTimeLimit, v))
+}
+
+// TimeLimitNEQ applies the NEQ predicate on the "time_limit" field.
+func TimeLimitNEQ(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNEQ(FieldTimeLimit, v))
+}
+
+// TimeLimitIn applies the In predicate on the "time_limit" field.
+func TimeLimitIn(vs ...int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldIn(FieldTimeLimit, vs...))
+}
+
+// TimeLimitNotIn applies the NotIn predicate on the "time_limit" field.
+func TimeLimitNotIn(vs ...int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotIn(FieldTimeLimit, vs...))
+}
+
+// TimeLimitGT applies the GT predicate on the "time_limit" field.
+func TimeLimitGT(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGT(FieldTimeLimit, v))
+}
+
+// TimeLimitGTE applies the GTE predicate on the "time_limit" field.
+func TimeLimitGTE(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGTE(FieldTimeLimit, v))
+}
+
+// TimeLimitLT applies the LT predicate on the "time_limit" field.
+func TimeLimitLT(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLT(FieldTimeLimit, v))
+}
+
+// TimeLimitLTE applies the LTE predicate on the "time_limit" field.
+func TimeLimitLTE(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLTE(FieldTimeLimit, v))
+}
+
+// MaxAttemptsEQ applies the EQ predicate on the "max_attempts" field.
+func MaxAttemptsEQ(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldMaxAttempts, v))
+}
+
+// MaxAttemptsNEQ applies the NEQ predicate on the "max_attempts" field.
+func MaxAttemptsNEQ(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNEQ(FieldMaxAttempts, v))
+}
+
+// MaxAttemptsIn applies the In predicate on the "max_attempts" field.
+func MaxAttemptsIn(vs ...int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldIn(FieldMaxAttempts, vs...))
+}
+
+// MaxAttemptsNotIn applies the NotIn predicate on the "max_attempts" field.
+func MaxAttemptsNotIn(vs ...int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotIn(FieldMaxAttempts, vs...))
+}
+
+// MaxAttemptsGT applies the GT predicate on the "max_attempts" field.
+func MaxAttemptsGT(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGT(FieldMaxAttempts, v))
+}
+
+// MaxAttemptsGTE applies the GTE predicate on the "max_attempts" field.
+func MaxAttemptsGTE(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGTE(FieldMaxAttempts, v))
+}
+
+// MaxAttemptsLT applies the LT predicate on the "max_attempts" field.
+func MaxAttemptsLT(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLT(FieldMaxAttempts, v))
+}
+
+// MaxAttemptsLTE applies the LTE predicate on the "max_attempts" field.
+func MaxAttemptsLTE(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLTE(FieldMaxAttempts, v))
+}
+
+// GeneratorEQ applies the EQ predicate on the "generator" field.
+func GeneratorEQ(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldGenerator, v))
+}
+
+// GeneratorNEQ applies the NEQ predicate on the "generator" field.
+func GeneratorNEQ(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNEQ(FieldGenerator, v))
+}
+
+// GeneratorIn applies the In predicate on the "generator" field.
+func GeneratorIn(vs ...string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldIn(FieldGenerator, vs...))
+}
+
+// GeneratorNotIn applies the NotIn predicate on the "generator" field.
+func GeneratorNotIn(vs ...string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotIn(FieldGenerator, vs...))
+}
+
+// GeneratorGT applies the GT predicate on the "generator" field.
+func GeneratorGT(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGT(FieldGenerator, v))
+}
+
+// GeneratorGTE applies the GTE predicate on the "generator" field.
+func GeneratorGTE(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGTE(FieldGenerator, v))
+}
+
+// GeneratorLT applies the LT predicate on the "generator" field.
+func GeneratorLT(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLT(FieldGenerator, v))
+}
+
+// GeneratorLTE applies the LTE predicate on the "generator" field.
+func GeneratorLTE(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLTE(FieldGenerator, v))
+}
+
+// GeneratorContains applies the Contains predicate on the "generator" field.
+func GeneratorContains(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldContains(FieldGenerator, v))
+}
+
+// GeneratorHasPrefix applies the HasPrefix predicate on the "generator" field.
+func GeneratorHasPrefix(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldHasPrefix(FieldGenerator, v))
+}
+
+// GeneratorHasSuffix applies the HasSuffix predicate on the "generator" field.
+func GeneratorHasSuffix(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldHasSuffix(FieldGenerator, v))
+}
+
+// GeneratorIsNil applies the IsNil predicate on the "generator" field.
+func GeneratorIsNil() predicate.Quiz {
+	return predicate.Quiz(sql.FieldIsNull(FieldGenerator))
+}
+
+// GeneratorNotNil applies the NotNil predicate on the "generator" field.
+func GeneratorNotNil() predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotNull(FieldGenerator))
+}
+
+// GeneratorEqualFold applies the EqualFold predicate on the "generator" field.
+func GeneratorEqualFold(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEqualFold(FieldGenerator, v))
+}
+
+// GeneratorContainsFold applies the ContainsFold predicate on the "generator" field.
+func GeneratorContainsFold(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldContainsFold(FieldGenerator, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasOwner applies the HasEdge predicate on the "owner" edge.
+func HasOwner() predicate.Quiz {
+	return predicate.Quiz(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OwnerTable, OwnerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOwnerWith applies the HasEdge predicate on the "owner" edge with a given conditions (other predicates).
+func HasOwnerWith(preds ...predicate.User) predicate.Quiz {
+	return predicate.Quiz(func(s *sql.Selector) {
+		step := newOwnerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasNote applies the HasEdge predicate on the "note" edge.
+func HasNote() predicate.Quiz {
+	return predicate.Quiz(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, NoteTable, NoteColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasNoteWith applies the HasEdge predicate on the "note" edge with a given conditions (other predicates).
+func HasNoteWith(preds ...predicate.Note) predicate.Quiz {
+	return predicate.Quiz(func(s *sql.Selector) {
+		step := newNoteStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasQuestions applies the HasEdge predicate on the "questions" edge.
+func HasQuestions() predicate.Quiz {
+	return predicate.Quiz(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, QuestionsTable, QuestionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasQuestionsWith applies the HasEdge predicate on the "questions" edge with a given conditions (other predicates).
+func HasQuestionsWith(preds ...predicate.Question) predicate.Quiz {
+	return predicate.Quiz(func(s *sql.Selector) {
+		step := newQuestionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAttempts applies the HasEdge predicate on the "attempts" edge.
+func HasAttempts() predicate.Quiz {
+	return predicate.Quiz(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AttemptsTable, AttemptsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAttemptsWith applies the HasEdge predicate on the "attempts" edge with a given conditions (other predicates).
+func HasAttemptsWith(preds ...predicate.QuizAttempt) predicate.Quiz {
+	return predicate.Quiz(func(s *sql.Selector) {
+		step := newAttemptsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasStudyEvents applies the HasEdge predicate on the "study_events" edge.
+func HasStudyEvents() predicate.Quiz {
+	return predicate.Quiz(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, StudyEventsTable, StudyEventsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStudyEventsWith applies the HasEdge predicate on the "study_events" edge with a given conditions (other predicates).
+func HasStudyEventsWith(preds ...predicate.StudyEvent) predicate.Quiz {
+	return predicate.Quiz(func(s *sql.Selector) {
+		step := newStudyEventsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Quiz) predicate.Quiz {
+	return predicate.Quiz(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Quiz) predicate.Quiz {
+	return predicate.Quiz(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Quiz) predicate.Quiz {
+	return predicate.Quiz(sql.NotPredicates(p))
+}
@@ -0,0 +1,536 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/r-scheele/zero/ent/note"
+	"github.com/r-scheele/zero/ent/question"
+	"github.com/r-scheele/zero/ent/quiz"
+	"github.com/r-scheele/zero/ent/quizattempt"
+	"github.com/r-scheele/zero/ent/studyevent"
+	"github.com/r-scheele/zero/ent/user"
+)
+
+// QuizCreate is the builder for creating a Quiz entity.
+type QuizCreate struct {
+	config
+	mutation *QuizMutation
+	hooks    []Hook
+}
+
+// SetTitle sets the "title" field.
+func (qc *QuizCreate) SetTitle(s string) *QuizCreate {
+	qc.mutation.SetTitle(s)
+	return qc
+}
+
+// SetDescription sets the "description" field.
+func (qc *QuizCreate) SetDescription(s string) *QuizCreate {
+	qc.mutation.SetDescription(s)
+	return qc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (qc *QuizCreate) SetNillableDescription(s *string) *QuizCreate {
+	if s != nil {
+		qc.SetDescription(*s)
+	}
+	return qc
+}
+
+// SetVisibility sets the "visibility" field.
+func (qc *QuizCreate) SetVisibility(q quiz.Visibility) *QuizCreate {
+	qc.mutation.SetVisibility(q)
+	return qc
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (qc *QuizCreate) SetNillableVisibility(q *quiz.Visibility) *QuizCreate {
+	if q != nil {
+		qc.SetVisibility(*q)
+	}
+	return qc
+}
+
+// SetTimeLimit sets the "time_limit" field.
+func (qc *QuizCreate) SetTimeLimit(i int) *QuizCreate {
+	qc.mutation.SetTimeLimit(i)
+	return qc
+}
+
+// SetNillableTimeLimit sets the "time_limit" field if the given value is not nil.
+func (qc *QuizCreate) SetNillableTimeLimit(i *int) *QuizCreate {
+	if i != nil {
+		qc.SetTimeLimit(*i)
+	}
+	return qc
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (qc *QuizCreate) SetMaxAttempts(i int) *QuizCreate {
+	qc.mutation.SetMaxAttempts(i)
+	return qc
+}
+
+// SetNillableMaxAttempts sets the "max_attempts" field if the given value is not nil.
+func (qc *QuizCreate) SetNillableMaxAttempts(i *int) *QuizCreate {
+	if i != nil {
+		qc.SetMaxAttempts(*i)
+	}
+	return qc
+}
+
+// SetGenerator sets the "generator" field.
+func (qc *QuizCreate) SetGenerator(s string) *QuizCreate {
+	qc.mutation.SetGenerator(s)
+	return qc
+}
+
+// SetNillableGenerator sets the "generator" field if the given value is not nil.
+func (qc *QuizCreate) SetNillableGenerator(s *string) *QuizCreate {
+	if s != nil {
+		qc.SetGenerator(*s)
+	}
+	return qc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (qc *QuizCreate) SetCreatedAt(t time.Time) *QuizCreate {
+	qc.mutation.SetCreatedAt(t)
+	return qc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (qc *QuizCreate) SetNillableCreatedAt(t *time.Time) *QuizCreate {
+	if t != nil {
+		qc.SetCreatedAt(*t)
+	}
+	return qc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (qc *QuizCreate) SetUpdatedAt(t time.Time) *QuizCreate {
+	qc.mutation.SetUpdatedAt(t)
+	return qc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (qc *QuizCreate) SetNillableUpdatedAt(t *time.Time) *QuizCreate {
+	if t != nil {
+		qc.SetUpdatedAt(*t)
+	}
+	return qc
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (qc *QuizCreate) SetOwnerID(id int) *QuizCreate {
+	qc.mutation.SetOwnerID(id)
+	return qc
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (qc *QuizCreate) SetOwner(u *User) *QuizCreate {
+	return qc.SetOwnerID(u.ID)
+}
+
+// SetNoteID sets the "note" edge to the Note entity by ID.
+func (qc *QuizCreate) SetNoteID(id int) *QuizCreate {
+	qc.mutation.SetNoteID(id)
+	return qc
+}
+
+// SetNillableNoteID sets the "note" edge to the Note entity by ID if the given value is not nil.
+func (qc *QuizCreate) SetNillableNoteID(id *int) *QuizCreate {
+	if id != nil {
+		qc = qc.SetNoteID(*id)
+	}
+	return qc
+}
+
+// SetNote sets the "note" edge to the Note entity.
+func (qc *QuizCreate) SetNote(n *Note) *QuizCreate {
+	return qc.SetNoteID(n.ID)
+}
+
+// AddQuestionIDs adds the "questions" edge to the Question entity by IDs.
+func (qc *QuizCreate) AddQuestionIDs(ids ...int) *QuizCreate {
+	qc.mutation.AddQuestionIDs(ids...)
+	return qc
+}
+
+// AddQuestions adds the "questions" edges to the Question entity.
+func (qc *QuizCreate) AddQuestions(q ...*Question) *QuizCreate {
+	ids := make([]int, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return qc.AddQuestionIDs(ids...)
+}
+
+// AddAttemptIDs adds the "attempts" edge to the QuizAttempt entity by IDs.
+func (qc *QuizCreate) AddAttemptIDs(ids ...int) *QuizCreate {
+	qc.mutation.AddAttemptIDs(ids...)
+	return qc
+}
+
+// AddAttempts adds the "attempts" edges to the QuizAttempt entity.
+func (qc *QuizCreate) AddAttempts(q ...*QuizAttempt) *QuizCreate {
+	ids := make([]int, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return qc.AddAttemptIDs(ids...)
+}
+
+// AddStudyEventIDs adds the "study_events" edge to the StudyEvent entity by IDs.
+func (qc *QuizCreate) AddStudyEventIDs(ids ...int) *QuizCreate {
+	qc.mutation.AddStudyEventIDs(ids...)
+	return qc
+}
+
+// AddStudyEvents adds the "study_events" edges to the StudyEvent entity.
+func (qc *QuizCreate) AddStudyEvents(s ...*StudyEvent) *QuizCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return qc.AddStudyEventIDs(ids...)
+}
+
+// Mutation returns the QuizMutation object of the builder.
+func (qc *QuizCreate) Mutation() *QuizMutation {
+	return qc.mutation
+}
+
+// Save creates the Quiz in the database.
+func (qc *QuizCreate) Save(ctx context.Context) (*Quiz, error) {
+	qc.defaults()
+	return withHooks(ctx, qc.sqlSave, qc.mutation, qc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (qc *QuizCreate) SaveX(ctx context.Context) *Quiz {
+	v, err := qc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (qc *QuizCreate) Exec(ctx context.Context) error {
+	_, err := qc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qc *QuizCreate) ExecX(ctx context.Context) {
+	if err := qc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (qc *QuizCreate) defaults() {
+	if _, ok := qc.mutation.Visibility(); !ok {
+		v := quiz.DefaultVisibility
+		qc.mutation.SetVisibility(v)
+	}
+	if _, ok := qc.mutation.TimeLimit(); !ok {
+		v := quiz.DefaultTimeLimit
+		qc.mutation.SetTimeLimit(v)
+	}
+	if _, ok := qc.mutation.MaxAttempts(); !ok {
+		v := quiz.DefaultMaxAttempts
+		qc.mutation.SetMaxAttempts(v)
+	}
+	if _, ok := qc.mutation.CreatedAt(); !ok {
+		v := quiz.DefaultCreatedAt()
+		qc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := qc.mutation.UpdatedAt(); !ok {
+		v := quiz.DefaultUpdatedAt()
+		qc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (qc *QuizCreate) check() error {
+	if _, ok := qc.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "Quiz.title"`)}
+	}
+	if v, ok := qc.mutation.Title(); ok {
+		if err := quiz.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Quiz.title": %w`, err)}
+		}
+	}
+	if _, ok := qc.mutation.Visibility(); !ok {
+		return &ValidationError{Name: "visibility", err: errors.New(`ent: missing required field "Quiz.visibility"`)}
+	}
+	if v, ok := qc.mutation.Visibility(); ok {
+		if err := quiz.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "Quiz.visibility": %w`, err)}
+		}
+	}
+	if _, ok := qc.mutation.TimeLimit(); !ok {
+		return &ValidationError{Name: "time_limit", err: errors.New(`ent: missing required field "Quiz.time_limit"`)}
+	}
+	if v, ok := qc.mutation.TimeLimit(); ok {
+		if err := quiz.TimeLimitValidator(v); err != nil {
+			return &ValidationError{Name: "time_limit", err: fmt.Errorf(`ent: validator failed for field "Quiz.time_limit": %w`, err)}
+		}
+	}
+	if _, ok := qc.mutation.MaxAttempts(); !ok {
+		return &ValidationError{Name: "max_attempts", err: errors.New(`ent: missing required field "Quiz.max_attempts"`)}
+	}
+	if v, ok := qc.mutation.MaxAttempts(); ok {
+		if err := quiz.MaxAttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "max_attempts", err: fmt.Errorf(`ent: validator failed for field "Quiz.max_attempts": %w`, err)}
+		}
+	}
+	if _, ok := qc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Quiz.created_at"`)}
+	}
+	if _, ok := qc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Quiz.updated_at"`)}
+	}
+	if len(qc.mutation.OwnerIDs()) == 0 {
+		return &ValidationError{Name: "owner", err: errors.New(`ent: missing required edge "Quiz.owner"`)}
+	}
+	return nil
+}
+
+func (qc *QuizCreate) sqlSave(ctx context.Context) (*Quiz, error) {
+	if err := qc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := qc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, qc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	qc.mutation.id = &_node.ID
+	qc.mutation.done = true
+	return _node, nil
+}
+
+func (qc *QuizCreate) createSpec() (*Quiz, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Quiz{config: qc.config}
+		_spec = sqlgraph.NewCreateSpec(quiz.Table, sqlgraph.NewFieldSpec(quiz.FieldID, field.TypeInt))
+	)
+	if value, ok := qc.mutation.Title(); ok {
+		_spec.SetField(quiz.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := qc.mutation.Description(); ok {
+		_spec.SetField(quiz.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := qc.mutation.Visibility(); ok {
+		_spec.SetField(quiz.FieldVisibility, field.TypeEnum, value)
+		_node.Visibility = value
+	}
+	if value, ok := qc.mutation.TimeLimit(); ok {
+		_spec.SetField(quiz.FieldTimeLimit, field.TypeInt, value)
+		_node.TimeLimit = value
+	}
+	if value, ok := qc.mutation.MaxAttempts(); ok {
+		_spec.SetField(quiz.FieldMaxAttempts, field.TypeInt, value)
+		_node.MaxAttempts = value
+	}
+	if value, ok := qc.mutation.Generator(); ok {
+		_spec.SetField(quiz.FieldGenerator, field.TypeString, value)
+		_node.Generator = value
+	}
+	if value, ok := qc.mutation.CreatedAt(); ok {
+		_spec.SetField(quiz.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := qc.mutation.UpdatedAt(); ok {
+		_spec.SetField(quiz.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := qc.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   quiz.OwnerTable,
+			Columns: []string{quiz.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_quizzes = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := qc.mutation.NoteIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   quiz.NoteTable,
+			Columns: []string{quiz.NoteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.note_practice_sets = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := qc.mutation.QuestionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   quiz.QuestionsTable,
+			Columns: []string{quiz.QuestionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(question.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := qc.mutation.AttemptsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   quiz.AttemptsTable,
+			Columns: []string{quiz.AttemptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quizattempt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := qc.mutation.StudyEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   quiz.StudyEventsTable,
+			Columns: []string{quiz.StudyEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(studyevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// QuizCreateBulk is the builder for creating many Quiz entities in bulk.
+type QuizCreateBulk struct {
+	config
+	err      error
+	builders []*QuizCreate
+}
+
+// Save creates the Quiz entities in the database.
+func (qcb *QuizCreateBulk) Save(ctx context.Context) ([]*Quiz, error) {
+	if qcb.err != nil {
+		return nil, qcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(qcb.builders))
+	nodes := make([]*Quiz, len(qcb.builders))
+	mutators := make([]Mutator, len(qcb.builders))
+	for i := range qcb.builders {
+		func(i int, root context.Context) {
+			builder := qcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*QuizMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, qcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, qcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, qcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (qcb *QuizCreateBulk) SaveX(ctx context.Context) []*Quiz {
+	v, err := qcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (qcb *QuizCreateBulk) Exec(ctx context.Context) error {
+	_, err := qcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qcb *QuizCreateBulk) ExecX(ctx context.Context) {
+	if err := qcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
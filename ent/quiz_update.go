@@ -0,0 +1,1245 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/r-scheele/zero/ent/note"
+	"github.com/r-scheele/zero/ent/predicate"
+	"github.com/r-scheele/zero/ent/question"
+	"github.com/r-scheele/zero/ent/quiz"
+	"github.com/r-scheele/zero/ent/quizattempt"
+	"github.com/r-scheele/zero/ent/studyevent"
+	"github.com/r-scheele/zero/ent/user"
+)
+
+// QuizUpdate is the builder for updating Quiz entities.
+type QuizUpdate struct {
+	config
+	hooks    []Hook
+	mutation *QuizMutation
+}
+
+// Where appends a list predicates to the QuizUpdate builder.
+func (qu *QuizUpdate) Where(ps ...predicate.Quiz) *QuizUpdate {
+	qu.mutation.Where(ps...)
+	return qu
+}
+
+// SetTitle sets the "title" field.
+func (qu *QuizUpdate) SetTitle(s string) *QuizUpdate {
+	qu.mutation.SetTitle(s)
+	return qu
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (qu *QuizUpdate) SetNillableTitle(s *string) *QuizUpdate {
+	if s != nil {
+		qu.SetTitle(*s)
+	}
+	return qu
+}
+
+// SetDescription sets the "description" field.
+func (qu *QuizUpdate) SetDescription(s string) *QuizUpdate {
+	qu.mutation.SetDescription(s)
+	return qu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (qu *QuizUpdate) SetNillableDescription(s *string) *QuizUpdate {
+	if s != nil {
+		qu.SetDescription(*s)
+	}
+	return qu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (qu *QuizUpdate) ClearDescription() *QuizUpdate {
+	qu.mutation.ClearDescription()
+	return qu
+}
+
+// SetVisibility sets the "visibility" field.
+func (qu *QuizUpdate) SetVisibility(q quiz.Visibility) *QuizUpdate {
+	qu.mutation.SetVisibility(q)
+	return qu
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (qu *QuizUpdate) SetNillableVisibility(q *quiz.Visibility) *QuizUpdate {
+	if q != nil {
+		qu.SetVisibility(*q)
+	}
+	return qu
+}
+
+// SetTimeLimit sets the "time_limit" field.
+func (qu *QuizUpdate) SetTimeLimit(i int) *QuizUpdate {
+	qu.mutation.ResetTimeLimit()
+	qu.mutation.SetTimeLimit(i)
+	return qu
+}
+
+// SetNillableTimeLimit sets the "time_limit" field if the given value is not nil.
+func (qu *QuizUpdate) SetNillableTimeLimit(i *int) *QuizUpdate {
+	if i != nil {
+		qu.SetTimeLimit(*i)
+	}
+	return qu
+}
+
+// AddTimeLimit adds i to the "time_limit" field.
+func (qu *QuizUpdate) AddTimeLimit(i int) *QuizUpdate {
+	qu.mutation.AddTimeLimit(i)
+	return qu
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (qu *QuizUpdate) SetMaxAttempts(i int) *QuizUpdate {
+	qu.mutation.ResetMaxAttempts()
+	qu.mutation.SetMaxAttempts(i)
+	return qu
+}
+
+// SetNillableMaxAttempts sets the "max_attempts" field if the given value is not nil.
+func (qu *QuizUpdate) SetNillableMaxAttempts(i *int) *QuizUpdate {
+	if i != nil {
+		qu.SetMaxAttempts(*i)
+	}
+	return qu
+}
+
+// AddMaxAttempts adds i to the "max_attempts" field.
+func (qu *QuizUpdate) AddMaxAttempts(i int) *QuizUpdate {
+	qu.mutation.AddMaxAttempts(i)
+	return qu
+}
+
+// SetGenerator sets the "generator" field.
+func (qu *QuizUpdate) SetGenerator(s string) *QuizUpdate {
+	qu.mutation.SetGenerator(s)
+	return qu
+}
+
+// SetNillableGenerator sets the "generator" field if the given value is not nil.
+func (qu *QuizUpdate) SetNillableGenerator(s *string) *QuizUpdate {
+	if s != nil {
+		qu.SetGenerator(*s)
+	}
+	return qu
+}
+
+// ClearGenerator clears the value of the "generator" field.
+func (qu *QuizUpdate) ClearGenerator() *QuizUpdate {
+	qu.mutation.ClearGenerator()
+	return qu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (qu *QuizUpdate) SetUpdatedAt(t time.Time) *QuizUpdate {
+	qu.mutation.SetUpdatedAt(t)
+	return qu
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (qu *QuizUpdate) SetOwnerID(id int) *QuizUpdate {
+	qu.mutation.SetOwnerID(id)
+	return qu
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (qu *QuizUpdate) SetOwner(u *User) *QuizUpdate {
+	return qu.SetOwnerID(u.ID)
+}
+
+// SetNoteID sets the "note" edge to the Note entity by ID.
+func (qu *QuizUpdate) SetNoteID(id int) *QuizUpdate {
+	qu.mutation.SetNoteID(id)
+	return qu
+}
+
+// SetNillableNoteID sets the "note" edge to the Note entity by ID if the given value is not nil.
+func (qu *QuizUpdate) SetNillableNoteID(id *int) *QuizUpdate {
+	if id != nil {
+		qu = qu.SetNoteID(*id)
+	}
+	return qu
+}
+
+// SetNote sets the "note" edge to the Note entity.
+func (qu *QuizUpdate) SetNote(n *Note) *QuizUpdate {
+	return qu.SetNoteID(n.ID)
+}
+
+// AddQuestionIDs adds the "questions" edge to the Question entity by IDs.
+func (qu *QuizUpdate) AddQuestionIDs(ids ...int) *QuizUpdate {
+	qu.mutation.AddQuestionIDs(ids...)
+	return qu
+}
+
+// AddQuestions adds the "questions" edges to the Question entity.
+func (qu *QuizUpdate) AddQuestions(q ...*Question) *QuizUpdate {
+	ids := make([]int, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return qu.AddQuestionIDs(ids...)
+}
+
+// AddAttemptIDs adds the "attempts" edge to the QuizAttempt entity by IDs.
+func (qu *QuizUpdate) AddAttemptIDs(ids ...int) *QuizUpdate {
+	qu.mutation.AddAttemptIDs(ids...)
+	return qu
+}
+
+// AddAttempts adds the "attempts" edges to the QuizAttempt entity.
+func (qu *QuizUpdate) AddAttempts(q ...*QuizAttempt) *QuizUpdate {
+	ids := make([]int, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return qu.AddAttemptIDs(ids...)
+}
+
+// AddStudyEventIDs adds the "study_events" edge to the StudyEvent entity by IDs.
+func (qu *QuizUpdate) AddStudyEventIDs(ids ...int) *QuizUpdate {
+	qu.mutation.AddStudyEventIDs(ids...)
+	return qu
+}
+
+// AddStudyEvents adds the "study_events" edges to the StudyEvent entity.
+func (qu *QuizUpdate) AddStudyEvents(s ...*StudyEvent) *QuizUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return qu.AddStudyEventIDs(ids...)
+}
+
+// Mutation returns the QuizMutation object of the builder.
+func (qu *QuizUpdate) Mutation() *QuizMutation {
+	return qu.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (qu *QuizUpdate) ClearOwner() *QuizUpdate {
+	qu.mutation.ClearOwner()
+	return qu
+}
+
+// ClearNote clears the "note" edge to the Note entity.
+func (qu *QuizUpdate) ClearNote() *QuizUpdate {
+	qu.mutation.ClearNote()
+	return qu
+}
+
+// ClearQuestions clears all "questions" edges to the Question entity.
+func (qu *QuizUpdate) ClearQuestions() *QuizUpdate {
+	qu.mutation.ClearQuestions()
+	return qu
+}
+
+// RemoveQuestionIDs removes the "questions" edge to Question entities by IDs.
+func (qu *QuizUpdate) RemoveQuestionIDs(ids ...int) *QuizUpdate {
+	qu.mutation.RemoveQuestionIDs(ids...)
+	return qu
+}
+
+// RemoveQuestions removes "questions" edges to Question entities.
+func (qu *QuizUpdate) RemoveQuestions(q ...*Question) *QuizUpdate {
+	ids := make([]int, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return qu.RemoveQuestionIDs(ids...)
+}
+
+// ClearAttempts clears all "attempts" edges to the QuizAttempt entity.
+func (qu *QuizUpdate) ClearAttempts() *QuizUpdate {
+	qu.mutation.ClearAttempts()
+	return qu
+}
+
+// RemoveAttemptIDs removes the "attempts" edge to QuizAttempt entities by IDs.
+func (qu *QuizUpdate) RemoveAttemptIDs(ids ...int) *QuizUpdate {
+	qu.mutation.RemoveAttemptIDs(ids...)
+	return qu
+}
+
+// RemoveAttempts removes "attempts" edges to QuizAttempt entities.
+func (qu *QuizUpdate) RemoveAttempts(q ...*QuizAttempt) *QuizUpdate {
+	ids := make([]int, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return qu.RemoveAttemptIDs(ids...)
+}
+
+// ClearStudyEvents clears all "study_events" edges to the StudyEvent entity.
+func (qu *QuizUpdate) ClearStudyEvents() *QuizUpdate {
+	qu.mutation.ClearStudyEvents()
+	return qu
+}
+
+// RemoveStudyEventIDs removes the "study_events" edge to StudyEvent entities by IDs.
+func (qu *QuizUpdate) RemoveStudyEventIDs(ids ...int) *QuizUpdate {
+	qu.mutation.RemoveStudyEventIDs(ids...)
+	return qu
+}
+
+// RemoveStudyEvents removes "study_events" edges to StudyEvent entities.
+func (qu *QuizUpdate) RemoveStudyEvents(s ...*StudyEvent) *QuizUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return qu.RemoveStudyEventIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (qu *QuizUpdate) Save(ctx context.Context) (int, error) {
+	qu.defaults()
+	return withHooks(ctx, qu.sqlSave, qu.mutation, qu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (qu *QuizUpdate) SaveX(ctx context.Context) int {
+	affected, err := qu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (qu *QuizUpdate) Exec(ctx context.Context) error {
+	_, err := qu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qu *QuizUpdate) ExecX(ctx context.Context) {
+	if err := qu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (qu *QuizUpdate) defaults() {
+	if _, ok := qu.mutation.UpdatedAt(); !ok {
+		v := quiz.UpdateDefaultUpdatedAt()
+		qu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (qu *QuizUpdate) check() error {
+	if v, ok := qu.mutation.Title(); ok {
+		if err := quiz.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Quiz.title": %w`, err)}
+		}
+	}
+	if v, ok := qu.mutation.Visibility(); ok {
+		if err := quiz.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "Quiz.visibility": %w`, err)}
+		}
+	}
+	if v, ok := qu.mutation.TimeLimit(); ok {
+		if err := quiz.TimeLimitValidator(v); err != nil {
+			return &ValidationError{Name: "time_limit", err: fmt.Errorf(`ent: validator failed for field "Quiz.time_limit": %w`, err)}
+		}
+	}
+	if v, ok := qu.mutation.MaxAttempts(); ok {
+		if err := quiz.MaxAttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "max_attempts", err: fmt.Errorf(`ent: validator failed for field "Quiz.max_attempts": %w`, err)}
+		}
+	}
+	if qu.mutation.OwnerCleared() && len(qu.mutation.OwnerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Quiz.owner"`)
+	}
+	return nil
+}
+
+func (qu *QuizUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := qu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(quiz.Table, quiz.Columns, sqlgraph.NewFieldSpec(quiz.FieldID, field.TypeInt))
+	if ps := qu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := qu.mutation.Title(); ok {
+		_spec.SetField(quiz.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := qu.mutation.Description(); ok {
+		_spec.SetField(quiz.FieldDescription, field.TypeString, value)
+	}
+	if qu.mutation.DescriptionCleared() {
+		_spec.ClearField(quiz.FieldDescription, field.TypeString)
+	}
+	if value, ok := qu.mutation.Visibility(); ok {
+		_spec.SetField(quiz.FieldVisibility, field.TypeEnum, value)
+	}
+	if value, ok := qu.mutation.TimeLimit(); ok {
+		_spec.SetField(quiz.FieldTimeLimit, field.TypeInt, value)
+	}
+	if value, ok := qu.mutation.AddedTimeLimit(); ok {
+		_spec.AddField(quiz.FieldTimeLimit, field.TypeInt, value)
+	}
+	if value, ok := qu.mutation.MaxAttempts(); ok {
+		_spec.SetField(quiz.FieldMaxAttempts, field.TypeInt, value)
+	}
+	if value, ok := qu.mutation.AddedMaxAttempts(); ok {
+		_spec.AddField(quiz.FieldMaxAttempts, field.TypeInt, value)
+	}
+	if value, ok := qu.mutation.Generator(); ok {
+		_spec.SetField(quiz.FieldGenerator, field.TypeString, value)
+	}
+	if qu.mutation.GeneratorCleared() {
+		_spec.ClearField(quiz.FieldGenerator, field.TypeString)
+	}
+	if value, ok := qu.mutation.UpdatedAt(); ok {
+		_spec.SetField(quiz.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if qu.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   quiz.OwnerTable,
+			Columns: []string{quiz.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := qu.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   quiz.OwnerTable,
+			Columns: []string{quiz.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if qu.mutation.NoteCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   quiz.NoteTable,
+			Columns: []string{quiz.NoteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := qu.mutation.NoteIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   quiz.NoteTable,
+			Columns: []string{quiz.NoteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if qu.mutation.QuestionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   quiz.QuestionsTable,
+			Columns: []string{quiz.QuestionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(question.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := qu.mutation.RemovedQuestionsIDs(); len(nodes) > 0 && !qu.mutation.QuestionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   quiz.QuestionsTable,
+			Columns: []string{quiz.QuestionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(question.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := qu.mutation.QuestionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   quiz.QuestionsTable,
+			Columns: []string{quiz.QuestionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(question.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if qu.mutation.AttemptsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   quiz.AttemptsTable,
+			Columns: []string{quiz.AttemptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quizattempt.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := qu.mutation.RemovedAttemptsIDs(); len(nodes) > 0 && !qu.mutation.AttemptsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   quiz.AttemptsTable,
+			Columns: []string{quiz.AttemptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quizattempt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := qu.mutation.AttemptsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   quiz.AttemptsTable,
+			Columns: []string{quiz.AttemptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quizattempt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if qu.mutation.StudyEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   quiz.StudyEventsTable,
+			Columns: []string{quiz.StudyEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(studyevent.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := qu.mutation.RemovedStudyEventsIDs(); len(nodes) > 0 && !qu.mutation.StudyEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   quiz.StudyEventsTable,
+			Columns: []string{quiz.StudyEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(studyevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := qu.mutation.StudyEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   quiz.StudyEventsTable,
+			Columns: []string{quiz.StudyEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(studyevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, qu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{quiz.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	qu.mutation.done = true
+	return n, nil
+}
+
+// QuizUpdateOne is the builder for updating a single Quiz entity.
+type QuizUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *QuizMutation
+}
+
+// SetTitle sets the "title" field.
+func (quo *QuizUpdateOne) SetTitle(s string) *QuizUpdateOne {
+	quo.mutation.SetTitle(s)
+	return quo
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (quo *QuizUpdateOne) SetNillableTitle(s *string) *QuizUpdateOne {
+	if s != nil {
+		quo.SetTitle(*s)
+	}
+	return quo
+}
+
+// SetDescription sets the "description" field.
+func (quo *QuizUpdateOne) SetDescription(s string) *QuizUpdateOne {
+	quo.mutation.SetDescription(s)
+	return quo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (quo *QuizUpdateOne) SetNillableDescription(s *string) *QuizUpdateOne {
+	if s != nil {
+		quo.SetDescription(*s)
+	}
+	return quo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (quo *QuizUpdateOne) ClearDescription() *QuizUpdateOne {
+	quo.mutation.ClearDescription()
+	return quo
+}
+
+// SetVisibility sets the "visibility" field.
+func (quo *QuizUpdateOne) SetVisibility(q quiz.Visibility) *QuizUpdateOne {
+	quo.mutation.SetVisibility(q)
+	return quo
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (quo *QuizUpdateOne) SetNillableVisibility(q *quiz.Visibility) *QuizUpdateOne {
+	if q != nil {
+		quo.SetVisibility(*q)
+	}
+	return quo
+}
+
+// SetTimeLimit sets the "time_limit" field.
+func (quo *QuizUpdateOne) SetTimeLimit(i int) *QuizUpdateOne {
+	quo.mutation.ResetTimeLimit()
+	quo.mutation.SetTimeLimit(i)
+	return quo
+}
+
+// SetNillableTimeLimit sets the "time_limit" field if the given value is not nil.
+func (quo *QuizUpdateOne) SetNillableTimeLimit(i *int) *QuizUpdateOne {
+	if i != nil {
+		quo.SetTimeLimit(*i)
+	}
+	return quo
+}
+
+// AddTimeLimit adds i to the "time_limit" field.
+func (quo *QuizUpdateOne) AddTimeLimit(i int) *QuizUpdateOne {
+	quo.mutation.AddTimeLimit(i)
+	return quo
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (quo *QuizUpdateOne) SetMaxAttempts(i int) *QuizUpdateOne {
+	quo.mutation.ResetMaxAttempts()
+	quo.mutation.SetMaxAttempts(i)
+	return quo
+}
+
+// SetNillableMaxAttempts sets the "max_attempts" field if the given value is not nil.
+func (quo *QuizUpdateOne) SetNillableMaxAttempts(i *int) *QuizUpdateOne {
+	if i != nil {
+		quo.SetMaxAttempts(*i)
+	}
+	return quo
+}
+
+// AddMaxAttempts adds i to the "max_attempts" field.
+func (quo *QuizUpdateOne) AddMaxAttempts(i int) *QuizUpdateOne {
+	quo.mutation.AddMaxAttempts(i)
+	return quo
+}
+
+// SetGenerator sets the "generator" field.
+func (quo *QuizUpdateOne) SetGenerator(s string) *QuizUpdateOne {
+	quo.mutation.SetGenerator(s)
+	return quo
+}
+
+// SetNillableGenerator sets the "generator" field if the given value is not nil.
+func (quo *QuizUpdateOne) SetNillableGenerator(s *string) *QuizUpdateOne {
+	if s != nil {
+		quo.SetGenerator(*s)
+	}
+	return quo
+}
+
+// ClearGenerator clears the value of the "generator" field.
+func (quo *QuizUpdateOne) ClearGenerator() *QuizUpdateOne {
+	quo.mutation.ClearGenerator()
+	return quo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (quo *QuizUpdateOne) SetUpdatedAt(t time.Time) *QuizUpdateOne {
+	quo.mutation.SetUpdatedAt(t)
+	return quo
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (quo *QuizUpdateOne) SetOwnerID(id int) *QuizUpdateOne {
+	quo.mutation.SetOwnerID(id)
+	return quo
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (quo *QuizUpdateOne) SetOwner(u *User) *QuizUpdateOne {
+	return quo.SetOwnerID(u.ID)
+}
+
+// SetNoteID sets the "note" edge to the Note entity by ID.
+func (quo *QuizUpdateOne) SetNoteID(id int) *QuizUpdateOne {
+	quo.mutation.SetNoteID(id)
+	return quo
+}
+
+// SetNillableNoteID sets the "note" edge to the Note entity by ID if the given value is not nil.
+func (quo *QuizUpdateOne) SetNillableNoteID(id *int) *QuizUpdateOne {
+	if id != nil {
+		quo = quo.SetNoteID(*id)
+	}
+	return quo
+}
+
+// SetNote sets the "note" edge to the Note entity.
+func (quo *QuizUpdateOne) SetNote(n *Note) *QuizUpdateOne {
+	return quo.SetNoteID(n.ID)
+}
+
+// AddQuestionIDs adds the "questions" edge to the Question entity by IDs.
+func (quo *QuizUpdateOne) AddQuestionIDs(ids ...int) *QuizUpdateOne {
+	quo.mutation.AddQuestionIDs(ids...)
+	return quo
+}
+
+// AddQuestions adds the "questions" edges to the Question entity.
+func (quo *QuizUpdateOne) AddQuestions(q ...*Question) *QuizUpdateOne {
+	ids := make([]int, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return quo.AddQuestionIDs(ids...)
+}
+
+// AddAttemptIDs adds the "attempts" edge to the QuizAttempt entity by IDs.
+func (quo *QuizUpdateOne) AddAttemptIDs(ids ...int) *QuizUpdateOne {
+	quo.mutation.AddAttemptIDs(ids...)
+	return quo
+}
+
+// AddAttempts adds the "attempts" edges to the QuizAttempt entity.
+func (quo *QuizUpdateOne) AddAttempts(q ...*QuizAttempt) *QuizUpdateOne {
+	ids := make([]int, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return quo.AddAttemptIDs(ids...)
+}
+
+// AddStudyEventIDs adds the "study_events" edge to the StudyEvent entity by IDs.
+func (quo *QuizUpdateOne) AddStudyEventIDs(ids ...int) *QuizUpdateOne {
+	quo.mutation.AddStudyEventIDs(ids...)
+	return quo
+}
+
+// AddStudyEvents adds the "study_events" edges to the StudyEvent entity.
+func (quo *QuizUpdateOne) AddStudyEvents(s ...*StudyEvent) *QuizUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return quo.AddStudyEventIDs(ids...)
+}
+
+// Mutation returns the QuizMutation object of the builder.
+func (quo *QuizUpdateOne) Mutation() *QuizMutation {
+	return quo.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (quo *QuizUpdateOne) ClearOwner() *QuizUpdateOne {
+	quo.mutation.ClearOwner()
+	return quo
+}
+
+// ClearNote clears the "note" edge to the Note entity.
+func (quo *QuizUpdateOne) ClearNote() *QuizUpdateOne {
+	quo.mutation.ClearNote()
+	return quo
+}
+
+// ClearQuestions clears all "questions" edges to the Question entity.
+func (quo *QuizUpdateOne) ClearQuestions() *QuizUpdateOne {
+	quo.mutation.ClearQuestions()
+	return quo
+}
+
+// RemoveQuestionIDs removes the "questions" edge to Question entities by IDs.
+func (quo *QuizUpdateOne) RemoveQuestionIDs(ids ...int) *QuizUpdateOne {
+	quo.mutation.RemoveQuestionIDs(ids...)
+	return quo
+}
+
+// RemoveQuestions removes "questions" edges to Question entities.
+func (quo *QuizUpdateOne) RemoveQuestions(q ...*Question) *QuizUpdateOne {
+	ids := make([]int, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return quo.RemoveQuestionIDs(ids...)
+}
+
+// ClearAttempts clears all "attempts" edges to the QuizAttempt entity.
+func (quo *QuizUpdateOne) ClearAttempts() *QuizUpdateOne {
+	quo.mutation.ClearAttempts()
+	return quo
+}
+
+// RemoveAttemptIDs removes the "attempts" edge to QuizAttempt entities by IDs.
+func (quo *QuizUpdateOne) RemoveAttemptIDs(ids ...int) *QuizUpdateOne {
+	quo.mutation.RemoveAttemptIDs(ids...)
+	return quo
+}
+
+// RemoveAttempts removes "attempts" edges to QuizAttempt entities.
+func (quo *QuizUpdateOne) RemoveAttempts(q ...*QuizAttempt) *QuizUpdateOne {
+	ids := make([]int, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return quo.RemoveAttemptIDs(ids...)
+}
+
+// ClearStudyEvents clears all "study_events" edges to the StudyEvent entity.
+func (quo *QuizUpdateOne) ClearStudyEvents() *QuizUpdateOne {
+	quo.mutation.ClearStudyEvents()
+	return quo
+}
+
+// RemoveStudyEventIDs removes the "study_events" edge to StudyEvent entities by IDs.
+func (quo *QuizUpdateOne) RemoveStudyEventIDs(ids ...int) *QuizUpdateOne {
+	quo.mutation.RemoveStudyEventIDs(ids...)
+	return quo
+}
+
+// RemoveStudyEvents removes "study_events" edges to StudyEvent entities.
+func (quo *QuizUpdateOne) RemoveStudyEvents(s ...*StudyEvent) *QuizUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return quo.RemoveStudyEventIDs(ids...)
+}
+
+// Where appends a list predicates to the QuizUpdate builder.
+func (quo *QuizUpdateOne) Where(ps ...predicate.Quiz) *QuizUpdateOne {
+	quo.mutation.Where(ps...)
+	return quo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (quo *QuizUpdateOne) Select(field string, fields ...string) *QuizUpdateOne {
+	quo.fields = append([]string{field}, fields...)
+	return quo
+}
+
+// Save executes the query and returns the updated Quiz entity.
+func (quo *QuizUpdateOne) Save(ctx context.Context) (*Quiz, error) {
+	quo.defaults()
+	return withHooks(ctx, quo.sqlSave, quo.mutation, quo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (quo *QuizUpdateOne) SaveX(ctx context.Context) *Quiz {
+	node, err := quo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (quo *QuizUpdateOne) Exec(ctx context.Context) error {
+	_, err := quo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (quo *QuizUpdateOne) ExecX(ctx context.Context) {
+	if err := quo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (quo *QuizUpdateOne) defaults() {
+	if _, ok := quo.mutation.UpdatedAt(); !ok {
+		v := quiz.UpdateDefaultUpdatedAt()
+		quo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (quo *QuizUpdateOne) check() error {
+	if v, ok := quo.mutation.Title(); ok {
+		if err := quiz.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Quiz.title": %w`, err)}
+		}
+	}
+	if v, ok := quo.mutation.Visibility(); ok {
+		if err := quiz.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "Quiz.visibility": %w`, err)}
+		}
+	}
+	if v, ok := quo.mutation.TimeLimit(); ok {
+		if err := quiz.TimeLimitValidator(v); err != nil {
+			return &ValidationError{Name: "time_limit", err: fmt.Errorf(`ent: validator failed for field "Quiz.time_limit": %w`, err)}
+		}
+	}
+	if v, ok := quo.mutation.MaxAttempts(); ok {
+		if err := quiz.MaxAttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "max_attempts", err: fmt.Errorf(`ent: validator failed for field "Quiz.max_attempts": %w`, err)}
+		}
+	}
+	if quo.mutation.OwnerCleared() && len(quo.mutation.OwnerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Quiz.owner"`)
+	}
+	return nil
+}
+
+func (quo *QuizUpdateOne) sqlSave(ctx context.Context) (_node *Quiz, err error) {
+	if err := quo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(quiz.Table, quiz.Columns, sqlgraph.NewFieldSpec(quiz.FieldID, field.TypeInt))
+	id, ok := quo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Quiz.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := quo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, quiz.FieldID)
+		for _, f := range fields {
+			if !quiz.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != quiz.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := quo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := quo.mutation.Title(); ok {
+		_spec.SetField(quiz.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := quo.mutation.Description(); ok {
+		_spec.SetField(quiz.FieldDescription, field.TypeString, value)
+	}
+	if quo.mutation.DescriptionCleared() {
+		_spec.ClearField(quiz.FieldDescription, field.TypeString)
+	}
+	if value, ok := quo.mutation.Visibility(); ok {
+		_spec.SetField(quiz.FieldVisibility, field.TypeEnum, value)
+	}
+	if value, ok := quo.mutation.TimeLimit(); ok {
+		_spec.SetField(quiz.FieldTimeLimit, field.TypeInt, value)
+	}
+	if value, ok := quo.mutation.AddedTimeLimit(); ok {
+		_spec.AddField(quiz.FieldTimeLimit, field.TypeInt, value)
+	}
+	if value, ok := quo.mutation.MaxAttempts(); ok {
+		_spec.SetField(quiz.FieldMaxAttempts, field.TypeInt, value)
+	}
+	if value, ok := quo.mutation.AddedMaxAttempts(); ok {
+		_spec.AddField(quiz.FieldMaxAttempts, field.TypeInt, value)
+	}
+	if value, ok := quo.mutation.Generator(); ok {
+		_spec.SetField(quiz.FieldGenerator, field.TypeString, value)
+	}
+	if quo.mutation.GeneratorCleared() {
+		_spec.ClearField(quiz.FieldGenerator, field.TypeString)
+	}
+	if value, ok := quo.mutation.UpdatedAt(); ok {
+		_spec.SetField(quiz.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if quo.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   quiz.OwnerTable,
+			Columns: []string{quiz.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := quo.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   quiz.OwnerTable,
+			Columns: []string{quiz.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if quo.mutation.NoteCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   quiz.NoteTable,
+			Columns: []string{quiz.NoteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := quo.mutation.NoteIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   quiz.NoteTable,
+			Columns: []string{quiz.NoteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if quo.mutation.QuestionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   quiz.QuestionsTable,
+			Columns: []string{quiz.QuestionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(question.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := quo.mutation.RemovedQuestionsIDs(); len(nodes) > 0 && !quo.mutation.QuestionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   quiz.QuestionsTable,
+			Columns: []string{quiz.QuestionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(question.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := quo.mutation.QuestionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   quiz.QuestionsTable,
+			Columns: []string{quiz.QuestionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(question.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if quo.mutation.AttemptsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   quiz.AttemptsTable,
+			Columns: []string{quiz.AttemptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quizattempt.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := quo.mutation.RemovedAttemptsIDs(); len(nodes) > 0 && !quo.mutation.AttemptsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   quiz.AttemptsTable,
+			Columns: []string{quiz.AttemptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quizattempt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := quo.mutation.AttemptsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   quiz.AttemptsTable,
+			Columns: []string{quiz.AttemptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quizattempt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if quo.mutation.StudyEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   quiz.StudyEventsTable,
+			Columns: []string{quiz.StudyEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(studyevent.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := quo.mutation.RemovedStudyEventsIDs(); len(nodes) > 0 && !quo.mutation.StudyEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   quiz.StudyEventsTable,
+			Columns: []string{quiz.StudyEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(studyevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := quo.mutation.StudyEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   quiz.StudyEventsTable,
+			Columns: []string{quiz.StudyEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(studyevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Quiz{config: quo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, quo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{quiz.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	quo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,270 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/r-scheele/zero/ent/quiz"
+	"github.com/r-scheele/zero/ent/quizattempt"
+	"github.com/r-scheele/zero/ent/studyevent"
+	"github.com/r-scheele/zero/ent/user"
+)
+
+// QuizAttempt is the model entity for the QuizAttempt schema.
+type QuizAttempt struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Saved answers keyed by question ID
+	Answers map[int]string `json:"answers,omitempty"`
+	// Points earned
+	Score int `json:"score,omitempty"`
+	// Points available when the attempt was graded
+	MaxScore int `json:"max_score,omitempty"`
+	// StartedAt holds the value of the "started_at" field.
+	StartedAt time.Time `json:"started_at,omitempty"`
+	// Time by which the attempt must be submitted, if the quiz is timed
+	Deadline *time.Time `json:"deadline,omitempty"`
+	// SubmittedAt holds the value of the "submitted_at" field.
+	SubmittedAt *time.Time `json:"submitted_at,omitempty"`
+	// Expired attempts were closed at their deadline and graded from the saved answers
+	Status quizattempt.Status `json:"status,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the QuizAttemptQuery when eager-loading is set.
+	Edges              QuizAttemptEdges `json:"edges"`
+	quiz_attempts      *int
+	user_quiz_attempts *int
+	selectValues       sql.SelectValues
+}
+
+// QuizAttemptEdges holds the relations/edges for other nodes in the graph.
+type QuizAttemptEdges struct {
+	// Quiz holds the value of the quiz edge.
+	Quiz *Quiz `json:"quiz,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// StudyEvent holds the value of the study_event edge.
+	StudyEvent *StudyEvent `json:"study_event,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// QuizOrErr returns the Quiz value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e QuizAttemptEdges) QuizOrErr() (*Quiz, error) {
+	if e.Quiz != nil {
+		return e.Quiz, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: quiz.Label}
+	}
+	return nil, &NotLoadedError{edge: "quiz"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e QuizAttemptEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// StudyEventOrErr returns the StudyEvent value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e QuizAttemptEdges) StudyEventOrErr() (*StudyEvent, error) {
+	if e.StudyEvent != nil {
+		return e.StudyEvent, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: studyevent.Label}
+	}
+	return nil, &NotLoadedError{edge: "study_event"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*QuizAttempt) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case quizattempt.FieldAnswers:
+			values[i] = new([]byte)
+		case quizattempt.FieldID, quizattempt.FieldScore, quizattempt.FieldMaxScore:
+			values[i] = new(sql.NullInt64)
+		case quizattempt.FieldStatus:
+			values[i] = new(sql.NullString)
+		case quizattempt.FieldStartedAt, quizattempt.FieldDeadline, quizattempt.FieldSubmittedAt:
+			values[i] = new(sql.NullTime)
+		case quizattempt.ForeignKeys[0]: // quiz_attempts
+			values[i] = new(sql.NullInt64)
+		case quizattempt.ForeignKeys[1]: // user_quiz_attempts
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the QuizAttempt fields.
+func (qa *QuizAttempt) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case quizattempt.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			qa.ID = int(value.Int64)
+		case quizattempt.FieldAnswers:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field answers", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &qa.Answers); err != nil {
+					return fmt.Errorf("unmarshal field answers: %w", err)
+				}
+			}
+		case quizattempt.FieldScore:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field score", values[i])
+			} else if value.Valid {
+				qa.Score = int(value.Int64)
+			}
+		case quizattempt.FieldMaxScore:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_score", values[i])
+			} else if value.Valid {
+				qa.MaxScore = int(value.Int64)
+			}
+		case quizattempt.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				qa.StartedAt = value.Time
+			}
+		case quizattempt.FieldDeadline:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deadline", values[i])
+			} else if value.Valid {
+				qa.Deadline = new(time.Time)
+				*qa.Deadline = value.Time
+			}
+		case quizattempt.FieldSubmittedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field submitted_at", values[i])
+			} else if value.Valid {
+				qa.SubmittedAt = new(time.Time)
+				*qa.SubmittedAt = value.Time
+			}
+		case quizattempt.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				qa.Status = quizattempt.Status(value.String)
+			}
+		case quizattempt.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field quiz_attempts", value)
+			} else if value.Valid {
+				qa.quiz_attempts = new(int)
+				*qa.quiz_attempts = int(value.Int64)
+			}
+		case quizattempt.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_quiz_attempts", value)
+			} else if value.Valid {
+				qa.user_quiz_attempts = new(int)
+				*qa.user_quiz_attempts = int(value.Int64)
+			}
+		default:
+			qa.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the QuizAttempt.
+// This includes values selected through modifiers, order, etc.
+func (qa *QuizAttempt) Value(name string) (ent.Value, error) {
+	return qa.selectValues.Get(name)
+}
+
+// QueryQuiz queries the "quiz" edge of the QuizAttempt entity.
+func (qa *QuizAttempt) QueryQuiz() *QuizQuery {
+	return NewQuizAttemptClient(qa.config).QueryQuiz(qa)
+}
+
+// QueryUser queries the "user" edge of the QuizAttempt entity.
+func (qa *QuizAttempt) QueryUser() *UserQuery {
+	return NewQuizAttemptClient(qa.config).QueryUser(qa)
+}
+
+// QueryStudyEvent queries the "study_event" edge of the QuizAttempt entity.
+func (qa *QuizAttempt) QueryStudyEvent() *StudyEventQuery {
+	return NewQuizAttemptClient(qa.config).QueryStudyEvent(qa)
+}
+
+// Update returns a builder for updating this QuizAttempt.
+// Note that you need to call QuizAttempt.Unwrap() before calling this method if this QuizAttempt
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (qa *QuizAttempt) Update() *QuizAttemptUpdateOne {
+	return NewQuizAttemptClient(qa.config).UpdateOne(qa)
+}
+
+// Unwrap unwraps the QuizAttempt entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (qa *QuizAttempt) Unwrap() *QuizAttempt {
+	_tx, ok := qa.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: QuizAttempt is not a transactional entity")
+	}
+	qa.config.driver = _tx.drv
+	return qa
+}
+
+// String implements the fmt.Stringer.
+func (qa *QuizAttempt) String() string {
+	var builder strings.Builder
+	builder.WriteString("QuizAttempt(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", qa.ID))
+	builder.WriteString("answers=")
+	builder.WriteString(fmt.Sprintf("%v", qa.Answers))
+	builder.WriteString(", ")
+	builder.WriteString("score=")
+	builder.WriteString(fmt.Sprintf("%v", qa.Score))
+	builder.WriteString(", ")
+	builder.WriteString("max_score=")
+	builder.WriteString(fmt.Sprintf("%v", qa.MaxScore))
+	builder.WriteString(", ")
+	builder.WriteString("started_at=")
+	builder.WriteString(qa.StartedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := qa.Deadline; v != nil {
+		builder.WriteString("deadline=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := qa.SubmittedAt; v != nil {
+		builder.WriteString("submitted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", qa.Status))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// QuizAttempts is a parsable slice of QuizAttempt.
+type QuizAttempts []*QuizAttempt
@@ -0,0 +1,211 @@
+// Code generated by ent, DO NOT EDIT.
+
+package quizattempt
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the quizattempt type in the database.
+	Label = "quiz_attempt"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldAnswers holds the string denoting the answers field in the database.
+	FieldAnswers = "answers"
+	// FieldScore holds the string denoting the score field in the database.
+	FieldScore = "score"
+	// FieldMaxScore holds the string denoting the max_score field in the database.
+	FieldMaxScore = "max_score"
+	// FieldStartedAt holds the string denoting the started_at field in the database.
+	FieldStartedAt = "started_at"
+	// FieldDeadline holds the string denoting the deadline field in the database.
+	FieldDeadline = "deadline"
+	// FieldSubmittedAt holds the string denoting the submitted_at field in the database.
+	FieldSubmittedAt = "submitted_at"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// EdgeQuiz holds the string denoting the quiz edge name in mutations.
+	EdgeQuiz = "quiz"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// EdgeStudyEvent holds the string denoting the study_event edge name in mutations.
+	EdgeStudyEvent = "study_event"
+	// Table holds the table name of the quizattempt in the database.
+	Table = "quiz_attempts"
+	// QuizTable is the table that holds the quiz relation/edge.
+	QuizTable = "quiz_attempts"
+	// QuizInverseTable is the table name for the Quiz entity.
+	// It exists in this package in order to avoid circular dependency with the "quiz" package.
+	QuizInverseTable = "quizs"
+	// QuizColumn is the table column denoting the quiz relation/edge.
+	QuizColumn = "quiz_attempts"
+	// UserTable is the table that holds the user relation/edge.
+	UserTable = "quiz_attempts"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_quiz_attempts"
+	// StudyEventTable is the table that holds the study_event relation/edge.
+	StudyEventTable = "study_events"
+	// StudyEventInverseTable is the table name for the StudyEvent entity.
+	// It exists in this package in order to avoid circular dependency with the "studyevent" package.
+	StudyEventInverseTable = "study_events"
+	// StudyEventColumn is the table column denoting the study_event relation/edge.
+	StudyEventColumn = "quiz_attempt_study_event"
+)
+
+// Columns holds all SQL columns for quizattempt fields.
+var Columns = []string{
+	FieldID,
+	FieldAnswers,
+	FieldScore,
+	FieldMaxScore,
+	FieldStartedAt,
+	FieldDeadline,
+	FieldSubmittedAt,
+	FieldStatus,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "quiz_attempts"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"quiz_attempts",
+	"user_quiz_attempts",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultScore holds the default value on creation for the "score" field.
+	DefaultScore int
+	// DefaultMaxScore holds the default value on creation for the "max_score" field.
+	DefaultMaxScore int
+	// DefaultStartedAt holds the default value on creation for the "started_at" field.
+	DefaultStartedAt func() time.Time
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusInProgress is the default value of the Status enum.
+const DefaultStatus = StatusInProgress
+
+// Status values.
+const (
+	StatusInProgress Status = "in_progress"
+	StatusSubmitted  Status = "submitted"
+	StatusExpired    Status = "expired"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusInProgress, StatusSubmitted, StatusExpired:
+		return nil
+	default:
+		return fmt.Errorf("quizattempt: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the QuizAttempt queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByScore orders the results by the score field.
+func ByScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScore, opts...).ToFunc()
+}
+
+// ByMaxScore orders the results by the max_score field.
+func ByMaxScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxScore, opts...).ToFunc()
+}
+
+// ByStartedAt orders the results by the started_at field.
+func ByStartedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartedAt, opts...).ToFunc()
+}
+
+// ByDeadline orders the results by the deadline field.
+func ByDeadline(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeadline, opts...).ToFunc()
+}
+
+// BySubmittedAt orders the results by the submitted_at field.
+func BySubmittedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubmittedAt, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByQuizField orders the results by quiz field.
+func ByQuizField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newQuizStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByUserField orders the results by user field.
+func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByStudyEventField orders the results by study_event field.
+func ByStudyEventField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStudyEventStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newQuizStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(QuizInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, QuizTable, QuizColumn),
+	)
+}
+func newUserStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UserInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+	)
+}
+func newStudyEventStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StudyEventInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, StudyEventTable, StudyEventColumn),
+	)
+}
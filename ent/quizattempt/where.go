@@ -0,0 +1,415 @@
+// Code generated by ent, DO NOT EDIT.
+
+package quizattempt
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/r-scheele/zero/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLTE(FieldID, id))
+}
+
+// Score applies equality check predicate on the "score" field. It's identical to ScoreEQ.
+func Score(v int) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldScore, v))
+}
+
+// MaxScore applies equality check predicate on the "max_score" field. It's identical to MaxScoreEQ.
+func MaxScore(v int) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldMaxScore, v))
+}
+
+// StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
+func StartedAt(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// Deadline applies equality check predicate on the "deadline" field. It's identical to DeadlineEQ.
+func Deadline(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldDeadline, v))
+}
+
+// SubmittedAt applies equality check predicate on the "submitted_at" field. It's identical to SubmittedAtEQ.
+func SubmittedAt(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldSubmittedAt, v))
+}
+
+// AnswersIsNil applies the IsNil predicate on the "answers" field.
+func AnswersIsNil() predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldIsNull(FieldAnswers))
+}
+
+// AnswersNotNil applies the NotNil predicate on the "answers" field.
+func AnswersNotNil() predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNotNull(FieldAnswers))
+}
+
+// ScoreEQ applies the EQ predicate on the "score" field.
+func ScoreEQ(v int) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldScore, v))
+}
+
+// ScoreNEQ applies the NEQ predicate on the "score" field.
+func ScoreNEQ(v int) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNEQ(FieldScore, v))
+}
+
+// ScoreIn applies the In predicate on the "score" field.
+func ScoreIn(vs ...int) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldIn(FieldScore, vs...))
+}
+
+// ScoreNotIn applies the NotIn predicate on the "score" field.
+func ScoreNotIn(vs ...int) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNotIn(FieldScore, vs...))
+}
+
+// ScoreGT applies the GT predicate on the "score" field.
+func ScoreGT(v int) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGT(FieldScore, v))
+}
+
+// ScoreGTE applies the GTE predicate on the "score" field.
+func ScoreGTE(v int) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGTE(FieldScore, v))
+}
+
+// ScoreLT applies the LT predicate on the "score" field.
+func ScoreLT(v int) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLT(FieldScore, v))
+}
+
+// ScoreLTE applies the LTE predicate on the "score" field.
+func ScoreLTE(v int) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLTE(FieldScore, v))
+}
+
+// MaxScoreEQ applies the EQ predicate on the "max_score" field.
+func MaxScoreEQ(v int) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldMaxScore, v))
+}
+
+// MaxScoreNEQ applies the NEQ predicate on the "max_score" field.
+func MaxScoreNEQ(v int) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNEQ(FieldMaxScore, v))
+}
+
+// MaxScoreIn applies the In predicate on the "max_score" field.
+func MaxScoreIn(vs ...int) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldIn(FieldMaxScore, vs...))
+}
+
+// MaxScoreNotIn applies the NotIn predicate on the "max_score" field.
+func MaxScoreNotIn(vs ...int) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNotIn(FieldMaxScore, vs...))
+}
+
+// MaxScoreGT applies the GT predicate on the "max_score" field.
+func MaxScoreGT(v int) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGT(FieldMaxScore, v))
+}
+
+// MaxScoreGTE applies the GTE predicate on the "max_score" field.
+func MaxScoreGTE(v int) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGTE(FieldMaxScore, v))
+}
+
+// MaxScoreLT applies the LT predicate on the "max_score" field.
+func MaxScoreLT(v int) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLT(FieldMaxScore, v))
+}
+
+// MaxScoreLTE applies the LTE predicate on the "max_score" field.
+func MaxScoreLTE(v int) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLTE(FieldMaxScore, v))
+}
+
+// StartedAtEQ applies the EQ predicate on the "started_at" field.
+func StartedAtEQ(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// StartedAtNEQ applies the NEQ predicate on the "started_at" field.
+func StartedAtNEQ(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNEQ(FieldStartedAt, v))
+}
+
+// StartedAtIn applies the In predicate on the "started_at" field.
+func StartedAtIn(vs ...time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldIn(FieldStartedAt, vs...))
+}
+
+// StartedAtNotIn applies the NotIn predicate on the "started_at" field.
+func StartedAtNotIn(vs ...time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNotIn(FieldStartedAt, vs...))
+}
+
+// StartedAtGT applies the GT predicate on the "started_at" field.
+func StartedAtGT(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGT(FieldStartedAt, v))
+}
+
+// StartedAtGTE applies the GTE predicate on the "started_at" field.
+func StartedAtGTE(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGTE(FieldStartedAt, v))
+}
+
+// StartedAtLT applies the LT predicate on the "started_at" field.
+func StartedAtLT(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLT(FieldStartedAt, v))
+}
+
+// StartedAtLTE applies the LTE predicate on the "started_at" field.
+func StartedAtLTE(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLTE(FieldStartedAt, v))
+}
+
+// DeadlineEQ applies the EQ predicate on the "deadline" field.
+func DeadlineEQ(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldDeadline, v))
+}
+
+// DeadlineNEQ applies the NEQ predicate on the "deadline" field.
+func DeadlineNEQ(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNEQ(FieldDeadline, v))
+}
+
+// DeadlineIn applies the In predicate on the "deadline" field.
+func DeadlineIn(vs ...time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldIn(FieldDeadline, vs...))
+}
+
+// DeadlineNotIn applies the NotIn predicate on the "deadline" field.
+func DeadlineNotIn(vs ...time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNotIn(FieldDeadline, vs...))
+}
+
+// DeadlineGT applies the GT predicate on the "deadline" field.
+func DeadlineGT(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGT(FieldDeadline, v))
+}
+
+// DeadlineGTE applies the GTE predicate on the "deadline" field.
+func DeadlineGTE(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGTE(FieldDeadline, v))
+}
+
+// DeadlineLT applies the LT predicate on the "deadline" field.
+func DeadlineLT(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLT(FieldDeadline, v))
+}
+
+// DeadlineLTE applies the LTE predicate on the "deadline" field.
+func DeadlineLTE(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLTE(FieldDeadline, v))
+}
+
+// DeadlineIsNil applies the IsNil predicate on the "deadline" field.
+func DeadlineIsNil() predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldIsNull(FieldDeadline))
+}
+
+// DeadlineNotNil applies the NotNil predicate on the "deadline" field.
+func DeadlineNotNil() predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNotNull(FieldDeadline))
+}
+
+// SubmittedAtEQ applies the EQ predicate on the "submitted_at" field.
+func SubmittedAtEQ(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldSubmittedAt, v))
+}
+
+// SubmittedAtNEQ applies the NEQ predicate on the "submitted_at" field.
+func SubmittedAtNEQ(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNEQ(FieldSubmittedAt, v))
+}
+
+// SubmittedAtIn applies the In predicate on the "submitted_at" field.
+func SubmittedAtIn(vs ...time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldIn(FieldSubmittedAt, vs...))
+}
+
+// SubmittedAtNotIn applies the NotIn predicate on the "submitted_at" field.
+func SubmittedAtNotIn(vs ...time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNotIn(FieldSubmittedAt, vs...))
+}
+
+// SubmittedAtGT applies the GT predicate on the "submitted_at" field.
+func SubmittedAtGT(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGT(FieldSubmittedAt, v))
+}
+
+// SubmittedAtGTE applies the GTE predicate on the "submitted_at" field.
+func SubmittedAtGTE(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGTE(FieldSubmittedAt, v))
+}
+
+// SubmittedAtLT applies the LT predicate on the "submitted_at" field.
+func SubmittedAtLT(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLT(FieldSubmittedAt, v))
+}
+
+// SubmittedAtLTE applies the LTE predicate on the "submitted_at" field.
+func SubmittedAtLTE(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLTE(FieldSubmittedAt, v))
+}
+
+// SubmittedAtIsNil applies the IsNil predicate on the "submitted_at" field.
+func SubmittedAtIsNil() predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldIsNull(FieldSubmittedAt))
+}
+
+// SubmittedAtNotNil applies the NotNil predicate on the "submitted_at" field.
+func SubmittedAtNotNil() predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNotNull(FieldSubmittedAt))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// HasQuiz applies the HasEdge predicate on the "quiz" edge.
+func HasQuiz() predicate.QuizAttempt {
+	return predicate.QuizAttempt(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, QuizTable, QuizColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasQuizWith applies the HasEdge predicate on the "quiz" edge with a given conditions (other predicates).
+func HasQuizWith(preds ...predicate.Quiz) predicate.QuizAttempt {
+	return predicate.QuizAttempt(func(s *sql.Selector) {
+		step := newQuizStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.QuizAttempt {
+	return predicate.QuizAttempt(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.QuizAttempt {
+	return predicate.QuizAttempt(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasStudyEvent applies the HasEdge predicate on the "study_event" edge.
+func HasStudyEvent() predicate.QuizAttempt {
+	return predicate.QuizAttempt(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, StudyEventTable, StudyEventColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStudyEventWith applies the HasEdge predicate on the "study_event" edge with a given conditions (other predicates).
+func HasStudyEventWith(preds ...predicate.StudyEvent) predicate.QuizAttempt {
+	return predicate.QuizAttempt(func(s *sql.Selector) {
+		step := newStudyEventStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.QuizAttempt) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.QuizAttempt) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.QuizAttempt) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.NotPredicates(p))
+}
@@ -0,0 +1,428 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/r-scheele/zero/ent/quiz"
+	"github.com/r-scheele/zero/ent/quizattempt"
+	"github.com/r-scheele/zero/ent/studyevent"
+	"github.com/r-scheele/zero/ent/user"
+)
+
+// QuizAttemptCreate is the builder for creating a QuizAttempt entity.
+type QuizAttemptCreate struct {
+	config
+	mutation *QuizAttemptMutation
+	hooks    []Hook
+}
+
+// SetAnswers sets the "answers" field.
+func (qac *QuizAttemptCreate) SetAnswers(m map[int]string) *QuizAttemptCreate {
+	qac.mutation.SetAnswers(m)
+	return qac
+}
+
+// SetScore sets the "score" field.
+func (qac *QuizAttemptCreate) SetScore(i int) *QuizAttemptCreate {
+	qac.mutation.SetScore(i)
+	return qac
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (qac *QuizAttemptCreate) SetNillableScore(i *int) *QuizAttemptCreate {
+	if i != nil {
+		qac.SetScore(*i)
+	}
+	return qac
+}
+
+// SetMaxScore sets the "max_score" field.
+func (qac *QuizAttemptCreate) SetMaxScore(i int) *QuizAttemptCreate {
+	qac.mutation.SetMaxScore(i)
+	return qac
+}
+
+// SetNillableMaxScore sets the "max_score" field if the given value is not nil.
+func (qac *QuizAttemptCreate) SetNillableMaxScore(i *int) *QuizAttemptCreate {
+	if i != nil {
+		qac.SetMaxScore(*i)
+	}
+	return qac
+}
+
+// SetStartedAt sets the "started_at" field.
+func (qac *QuizAttemptCreate) SetStartedAt(t time.Time) *QuizAttemptCreate {
+	qac.mutation.SetStartedAt(t)
+	return qac
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (qac *QuizAttemptCreate) SetNillableStartedAt(t *time.Time) *QuizAttemptCreate {
+	if t != nil {
+		qac.SetStartedAt(*t)
+	}
+	return qac
+}
+
+// SetDeadline sets the "deadline" field.
+func (qac *QuizAttemptCreate) SetDeadline(t time.Time) *QuizAttemptCreate {
+	qac.mutation.SetDeadline(t)
+	return qac
+}
+
+// SetNillableDeadline sets the "deadline" field if the given value is not nil.
+func (qac *QuizAttemptCreate) SetNillableDeadline(t *time.Time) *QuizAttemptCreate {
+	if t != nil {
+		qac.SetDeadline(*t)
+	}
+	return qac
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (qac *QuizAttemptCreate) SetSubmittedAt(t time.Time) *QuizAttemptCreate {
+	qac.mutation.SetSubmittedAt(t)
+	return qac
+}
+
+// SetNillableSubmittedAt sets the "submitted_at" field if the given value is not nil.
+func (qac *QuizAttemptCreate) SetNillableSubmittedAt(t *time.Time) *QuizAttemptCreate {
+	if t != nil {
+		qac.SetSubmittedAt(*t)
+	}
+	return qac
+}
+
+// SetStatus sets the "status" field.
+func (qac *QuizAttemptCreate) SetStatus(q quizattempt.Status) *QuizAttemptCreate {
+	qac.mutation.SetStatus(q)
+	return qac
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (qac *QuizAttemptCreate) SetNillableStatus(q *quizattempt.Status) *QuizAttemptCreate {
+	if q != nil {
+		qac.SetStatus(*q)
+	}
+	return qac
+}
+
+// SetQuizID sets the "quiz" edge to the Quiz entity by ID.
+func (qac *QuizAttemptCreate) SetQuizID(id int) *QuizAttemptCreate {
+	qac.mutation.SetQuizID(id)
+	return qac
+}
+
+// SetQuiz sets the "quiz" edge to the Quiz entity.
+func (qac *QuizAttemptCreate) SetQuiz(q *Quiz) *QuizAttemptCreate {
+	return qac.SetQuizID(q.ID)
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (qac *QuizAttemptCreate) SetUserID(id int) *QuizAttemptCreate {
+	qac.mutation.SetUserID(id)
+	return qac
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (qac *QuizAttemptCreate) SetUser(u *User) *QuizAttemptCreate {
+	return qac.SetUserID(u.ID)
+}
+
+// SetStudyEventID sets the "study_event" edge to the StudyEvent entity by ID.
+func (qac *QuizAttemptCreate) SetStudyEventID(id int) *QuizAttemptCreate {
+	qac.mutation.SetStudyEventID(id)
+	return qac
+}
+
+// SetNillableStudyEventID sets the "study_event" edge to the StudyEvent entity by ID if the given value is not nil.
+func (qac *QuizAttemptCreate) SetNillableStudyEventID(id *int) *QuizAttemptCreate {
+	if id != nil {
+		qac = qac.SetStudyEventID(*id)
+	}
+	return qac
+}
+
+// SetStudyEvent sets the "study_event" edge to the StudyEvent entity.
+func (qac *QuizAttemptCreate) SetStudyEvent(s *StudyEvent) *QuizAttemptCreate {
+	return qac.SetStudyEventID(s.ID)
+}
+
+// Mutation returns the QuizAttemptMutation object of the builder.
+func (qac *QuizAttemptCreate) Mutation() *QuizAttemptMutation {
+	return qac.mutation
+}
+
+// Save creates the QuizAttempt in the database.
+func (qac *QuizAttemptCreate) Save(ctx context.Context) (*QuizAttempt, error) {
+	qac.defaults()
+	return withHooks(ctx, qac.sqlSave, qac.mutation, qac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (qac *QuizAttemptCreate) SaveX(ctx context.Context) *QuizAttempt {
+	v, err := qac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (qac *QuizAttemptCreate) Exec(ctx context.Context) error {
+	_, err := qac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qac *QuizAttemptCreate) ExecX(ctx context.Context) {
+	if err := qac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (qac *QuizAttemptCreate) defaults() {
+	if _, ok := qac.mutation.Score(); !ok {
+		v := quizattempt.DefaultScore
+		qac.mutation.SetScore(v)
+	}
+	if _, ok := qac.mutation.MaxScore(); !ok {
+		v := quizattempt.DefaultMaxScore
+		qac.mutation.SetMaxScore(v)
+	}
+	if _, ok := qac.mutation.StartedAt(); !ok {
+		v := quizattempt.DefaultStartedAt()
+		qac.mutation.SetStartedAt(v)
+	}
+	if _, ok := qac.mutation.Status(); !ok {
+		v := quizattempt.DefaultStatus
+		qac.mutation.SetStatus(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (qac *QuizAttemptCreate) check() error {
+	if _, ok := qac.mutation.Score(); !ok {
+		return &ValidationError{Name: "score", err: errors.New(`ent: missing required field "QuizAttempt.score"`)}
+	}
+	if _, ok := qac.mutation.MaxScore(); !ok {
+		return &ValidationError{Name: "max_score", err: errors.New(`ent: missing required field "QuizAttempt.max_score"`)}
+	}
+	if _, ok := qac.mutation.StartedAt(); !ok {
+		return &ValidationError{Name: "started_at", err: errors.New(`ent: missing required field "QuizAttempt.started_at"`)}
+	}
+	if _, ok := qac.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "QuizAttempt.status"`)}
+	}
+	if v, ok := qac.mutation.Status(); ok {
+		if err := quizattempt.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "QuizAttempt.status": %w`, err)}
+		}
+	}
+	if len(qac.mutation.QuizIDs()) == 0 {
+		return &ValidationError{Name: "quiz", err: errors.New(`ent: missing required edge "QuizAttempt.quiz"`)}
+	}
+	if len(qac.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "QuizAttempt.user"`)}
+	}
+	return nil
+}
+
+func (qac *QuizAttemptCreate) sqlSave(ctx context.Context) (*QuizAttempt, error) {
+	if err := qac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := qac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, qac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	qac.mutation.id = &_node.ID
+	qac.mutation.done = true
+	return _node, nil
+}
+
+func (qac *QuizAttemptCreate) createSpec() (*QuizAttempt, *sqlgraph.CreateSpec) {
+	var (
+		_node = &QuizAttempt{config: qac.config}
+		_spec = sqlgraph.NewCreateSpec(quizattempt.Table, sqlgraph.NewFieldSpec(quizattempt.FieldID, field.TypeInt))
+	)
+	if value, ok := qac.mutation.Answers(); ok {
+		_spec.SetField(quizattempt.FieldAnswers, field.TypeJSON, value)
+		_node.Answers = value
+	}
+	if value, ok := qac.mutation.Score(); ok {
+		_spec.SetField(quizattempt.FieldScore, field.TypeInt, value)
+		_node.Score = value
+	}
+	if value, ok := qac.mutation.MaxScore(); ok {
+		_spec.SetField(quizattempt.FieldMaxScore, field.TypeInt, value)
+		_node.MaxScore = value
+	}
+	if value, ok := qac.mutation.StartedAt(); ok {
+		_spec.SetField(quizattempt.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = value
+	}
+	if value, ok := qac.mutation.Deadline(); ok {
+		_spec.SetField(quizattempt.FieldDeadline, field.TypeTime, value)
+		_node.Deadline = &value
+	}
+	if value, ok := qac.mutation.SubmittedAt(); ok {
+		_spec.SetField(quizattempt.FieldSubmittedAt, field.TypeTime, value)
+		_node.SubmittedAt = &value
+	}
+	if value, ok := qac.mutation.Status(); ok {
+		_spec.SetField(quizattempt.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if nodes := qac.mutation.QuizIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   quizattempt.QuizTable,
+			Columns: []string{quizattempt.QuizColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quiz.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.quiz_attempts = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := qac.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   quizattempt.UserTable,
+			Columns: []string{quizattempt.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_quiz_attempts = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := qac.mutation.StudyEventIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   quizattempt.StudyEventTable,
+			Columns: []string{quizattempt.StudyEventColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(studyevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// QuizAttemptCreateBulk is the builder for creating many QuizAttempt entities in bulk.
+type QuizAttemptCreateBulk struct {
+	config
+	err      error
+	builders []*QuizAttemptCreate
+}
+
+// Save creates the QuizAttempt entities in the database.
+func (qacb *QuizAttemptCreateBulk) Save(ctx context.Context) ([]*QuizAttempt, error) {
+	if qacb.err != nil {
+		return nil, qacb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(qacb.builders))
+	nodes := make([]*QuizAttempt, len(qacb.builders))
+	mutators := make([]Mutator, len(qacb.builders))
+	for i := range qacb.builders {
+		func(i int, root context.Context) {
+			builder := qacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*QuizAttemptMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, qacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, qacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, qacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (qacb *QuizAttemptCreateBulk) SaveX(ctx context.Context) []*QuizAttempt {
+	v, err := qacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (qacb *QuizAttemptCreateBulk) Exec(ctx context.Context) error {
+	_, err := qacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qacb *QuizAttemptCreateBulk) ExecX(ctx context.Context) {
+	if err := qacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,745 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/r-scheele/zero/ent/predicate"
+	"github.com/r-scheele/zero/ent/quiz"
+	"github.com/r-scheele/zero/ent/quizattempt"
+	"github.com/r-scheele/zero/ent/studyevent"
+	"github.com/r-scheele/zero/ent/user"
+)
+
+// QuizAttemptUpdate is the builder for updating QuizAttempt entities.
+type QuizAttemptUpdate struct {
+	config
+	hooks    []Hook
+	mutation *QuizAttemptMutation
+}
+
+// Where appends a list predicates to the QuizAttemptUpdate builder.
+func (qau *QuizAttemptUpdate) Where(ps ...predicate.QuizAttempt) *QuizAttemptUpdate {
+	qau.mutation.Where(ps...)
+	return qau
+}
+
+// SetAnswers sets the "answers" field.
+func (qau *QuizAttemptUpdate) SetAnswers(m map[int]string) *QuizAttemptUpdate {
+	qau.mutation.SetAnswers(m)
+	return qau
+}
+
+// ClearAnswers clears the value of the "answers" field.
+func (qau *QuizAttemptUpdate) ClearAnswers() *QuizAttemptUpdate {
+	qau.mutation.ClearAnswers()
+	return qau
+}
+
+// SetScore sets the "score" field.
+func (qau *QuizAttemptUpdate) SetScore(i int) *QuizAttemptUpdate {
+	qau.mutation.ResetScore()
+	qau.mutation.SetScore(i)
+	return qau
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (qau *QuizAttemptUpdate) SetNillableScore(i *int) *QuizAttemptUpdate {
+	if i != nil {
+		qau.SetScore(*i)
+	}
+	return qau
+}
+
+// AddScore adds i to the "score" field.
+func (qau *QuizAttemptUpdate) AddScore(i int) *QuizAttemptUpdate {
+	qau.mutation.AddScore(i)
+	return qau
+}
+
+// SetMaxScore sets the "max_score" field.
+func (qau *QuizAttemptUpdate) SetMaxScore(i int) *QuizAttemptUpdate {
+	qau.mutation.ResetMaxScore()
+	qau.mutation.SetMaxScore(i)
+	return qau
+}
+
+// SetNillableMaxScore sets the "max_score" field if the given value is not nil.
+func (qau *QuizAttemptUpdate) SetNillableMaxScore(i *int) *QuizAttemptUpdate {
+	if i != nil {
+		qau.SetMaxScore(*i)
+	}
+	return qau
+}
+
+// AddMaxScore adds i to the "max_score" field.
+func (qau *QuizAttemptUpdate) AddMaxScore(i int) *QuizAttemptUpdate {
+	qau.mutation.AddMaxScore(i)
+	return qau
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (qau *QuizAttemptUpdate) SetSubmittedAt(t time.Time) *QuizAttemptUpdate {
+	qau.mutation.SetSubmittedAt(t)
+	return qau
+}
+
+// SetNillableSubmittedAt sets the "submitted_at" field if the given value is not nil.
+func (qau *QuizAttemptUpdate) SetNillableSubmittedAt(t *time.Time) *QuizAttemptUpdate {
+	if t != nil {
+		qau.SetSubmittedAt(*t)
+	}
+	return qau
+}
+
+// ClearSubmittedAt clears the value of the "submitted_at" field.
+func (qau *QuizAttemptUpdate) ClearSubmittedAt() *QuizAttemptUpdate {
+	qau.mutation.ClearSubmittedAt()
+	return qau
+}
+
+// SetStatus sets the "status" field.
+func (qau *QuizAttemptUpdate) SetStatus(q quizattempt.Status) *QuizAttemptUpdate {
+	qau.mutation.SetStatus(q)
+	return qau
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (qau *QuizAttemptUpdate) SetNillableStatus(q *quizattempt.Status) *QuizAttemptUpdate {
+	if q != nil {
+		qau.SetStatus(*q)
+	}
+	return qau
+}
+
+// SetQuizID sets the "quiz" edge to the Quiz entity by ID.
+func (qau *QuizAttemptUpdate) SetQuizID(id int) *QuizAttemptUpdate {
+	qau.mutation.SetQuizID(id)
+	return qau
+}
+
+// SetQuiz sets the "quiz" edge to the Quiz entity.
+func (qau *QuizAttemptUpdate) SetQuiz(q *Quiz) *QuizAttemptUpdate {
+	return qau.SetQuizID(q.ID)
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (qau *QuizAttemptUpdate) SetUserID(id int) *QuizAttemptUpdate {
+	qau.mutation.SetUserID(id)
+	return qau
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (qau *QuizAttemptUpdate) SetUser(u *User) *QuizAttemptUpdate {
+	return qau.SetUserID(u.ID)
+}
+
+// SetStudyEventID sets the "study_event" edge to the StudyEvent entity by ID.
+func (qau *QuizAttemptUpdate) SetStudyEventID(id int) *QuizAttemptUpdate {
+	qau.mutation.SetStudyEventID(id)
+	return qau
+}
+
+// SetNillableStudyEventID sets the "study_event" edge to the StudyEvent entity by ID if the given value is not nil.
+func (qau *QuizAttemptUpdate) SetNillableStudyEventID(id *int) *QuizAttemptUpdate {
+	if id != nil {
+		qau = qau.SetStudyEventID(*id)
+	}
+	return qau
+}
+
+// SetStudyEvent sets the "study_event" edge to the StudyEvent entity.
+func (qau *QuizAttemptUpdate) SetStudyEvent(s *StudyEvent) *QuizAttemptUpdate {
+	return qau.SetStudyEventID(s.ID)
+}
+
+// Mutation returns the QuizAttemptMutation object of the builder.
+func (qau *QuizAttemptUpdate) Mutation() *QuizAttemptMutation {
+	return qau.mutation
+}
+
+// ClearQuiz clears the "quiz" edge to the Quiz entity.
+func (qau *QuizAttemptUpdate) ClearQuiz() *QuizAttemptUpdate {
+	qau.mutation.ClearQuiz()
+	return qau
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (qau *QuizAttemptUpdate) ClearUser() *QuizAttemptUpdate {
+	qau.mutation.ClearUser()
+	return qau
+}
+
+// ClearStudyEvent clears the "study_event" edge to the StudyEvent entity.
+func (qau *QuizAttemptUpdate) ClearStudyEvent() *QuizAttemptUpdate {
+	qau.mutation.ClearStudyEvent()
+	return qau
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (qau *QuizAttemptUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, qau.sqlSave, qau.mutation, qau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (qau *QuizAttemptUpdate) SaveX(ctx context.Context) int {
+	affected, err := qau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (qau *QuizAttemptUpdate) Exec(ctx context.Context) error {
+	_, err := qau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qau *QuizAttemptUpdate) ExecX(ctx context.Context) {
+	if err := qau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (qau *QuizAttemptUpdate) check() error {
+	if v, ok := qau.mutation.Status(); ok {
+		if err := quizattempt.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "QuizAttempt.status": %w`, err)}
+		}
+	}
+	if qau.mutation.QuizCleared() && len(qau.mutation.QuizIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "QuizAttempt.quiz"`)
+	}
+	if qau.mutation.UserCleared() && len(qau.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "QuizAttempt.user"`)
+	}
+	return nil
+}
+
+func (qau *QuizAttemptUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := qau.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(quizattempt.Table, quizattempt.Columns, sqlgraph.NewFieldSpec(quizattempt.FieldID, field.TypeInt))
+	if ps := qau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := qau.mutation.Answers(); ok {
+		_spec.SetField(quizattempt.FieldAnswers, field.TypeJSON, value)
+	}
+	if qau.mutation.AnswersCleared() {
+		_spec.ClearField(quizattempt.FieldAnswers, field.TypeJSON)
+	}
+	if value, ok := qau.mutation.Score(); ok {
+		_spec.SetField(quizattempt.FieldScore, field.TypeInt, value)
+	}
+	if value, ok := qau.mutation.AddedScore(); ok {
+		_spec.AddField(quizattempt.FieldScore, field.TypeInt, value)
+	}
+	if value, ok := qau.mutation.MaxScore(); ok {
+		_spec.SetField(quizattempt.FieldMaxScore, field.TypeInt, value)
+	}
+	if value, ok := qau.mutation.AddedMaxScore(); ok {
+		_spec.AddField(quizattempt.FieldMaxScore, field.TypeInt, value)
+	}
+	if qau.mutation.DeadlineCleared() {
+		_spec.ClearField(quizattempt.FieldDeadline, field.TypeTime)
+	}
+	if value, ok := qau.mutation.SubmittedAt(); ok {
+		_spec.SetField(quizattempt.FieldSubmittedAt, field.TypeTime, value)
+	}
+	if qau.mutation.SubmittedAtCleared() {
+		_spec.ClearField(quizattempt.FieldSubmittedAt, field.TypeTime)
+	}
+	if value, ok := qau.mutation.Status(); ok {
+		_spec.SetField(quizattempt.FieldStatus, field.TypeEnum, value)
+	}
+	if qau.mutation.QuizCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   quizattempt.QuizTable,
+			Columns: []string{quizattempt.QuizColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quiz.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := qau.mutation.QuizIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   quizattempt.QuizTable,
+			Columns: []string{quizattempt.QuizColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quiz.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if qau.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   quizattempt.UserTable,
+			Columns: []string{quizattempt.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := qau.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   quizattempt.UserTable,
+			Columns: []string{quizattempt.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if qau.mutation.StudyEventCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   quizattempt.StudyEventTable,
+			Columns: []string{quizattempt.StudyEventColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(studyevent.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := qau.mutation.StudyEventIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   quizattempt.StudyEventTable,
+			Columns: []string{quizattempt.StudyEventColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(studyevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, qau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{quizattempt.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	qau.mutation.done = true
+	return n, nil
+}
+
+// QuizAttemptUpdateOne is the builder for updating a single QuizAttempt entity.
+type QuizAttemptUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *QuizAttemptMutation
+}
+
+// SetAnswers sets the "answers" field.
+func (qauo *QuizAttemptUpdateOne) SetAnswers(m map[int]string) *QuizAttemptUpdateOne {
+	qauo.mutation.SetAnswers(m)
+	return qauo
+}
+
+// ClearAnswers clears the value of the "answers" field.
+func (qauo *QuizAttemptUpdateOne) ClearAnswers() *QuizAttemptUpdateOne {
+	qauo.mutation.ClearAnswers()
+	return qauo
+}
+
+// SetScore sets the "score" field.
+func (qauo *QuizAttemptUpdateOne) SetScore(i int) *QuizAttemptUpdateOne {
+	qauo.mutation.ResetScore()
+	qauo.mutation.SetScore(i)
+	return qauo
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (qauo *QuizAttemptUpdateOne) SetNillableScore(i *int) *QuizAttemptUpdateOne {
+	if i != nil {
+		qauo.SetScore(*i)
+	}
+	return qauo
+}
+
+// AddScore adds i to the "score" field.
+func (qauo *QuizAttemptUpdateOne) AddScore(i int) *QuizAttemptUpdateOne {
+	qauo.mutation.AddScore(i)
+	return qauo
+}
+
+// SetMaxScore sets the "max_score" field.
+func (qauo *QuizAttemptUpdateOne) SetMaxScore(i int) *QuizAttemptUpdateOne {
+	qauo.mutation.ResetMaxScore()
+	qauo.mutation.SetMaxScore(i)
+	return qauo
+}
+
+// SetNillableMaxScore sets the "max_score" field if the given value is not nil.
+func (qauo *QuizAttemptUpdateOne) SetNillableMaxScore(i *int) *QuizAttemptUpdateOne {
+	if i != nil {
+		qauo.SetMaxScore(*i)
+	}
+	return qauo
+}
+
+// AddMaxScore adds i to the "max_score" field.
+func (qauo *QuizAttemptUpdateOne) AddMaxScore(i int) *QuizAttemptUpdateOne {
+	qauo.mutation.AddMaxScore(i)
+	return qauo
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (qauo *QuizAttemptUpdateOne) SetSubmittedAt(t time.Time) *QuizAttemptUpdateOne {
+	qauo.mutation.SetSubmittedAt(t)
+	return qauo
+}
+
+// SetNillableSubmittedAt sets the "submitted_at" field if the given value is not nil.
+func (qauo *QuizAttemptUpdateOne) SetNillableSubmittedAt(t *time.Time) *QuizAttemptUpdateOne {
+	if t != nil {
+		qauo.SetSubmittedAt(*t)
+	}
+	return qauo
+}
+
+// ClearSubmittedAt clears the value of the "submitted_at" field.
+func (qauo *QuizAttemptUpdateOne) ClearSubmittedAt() *QuizAttemptUpdateOne {
+	qauo.mutation.ClearSubmittedAt()
+	return qauo
+}
+
+// SetStatus sets the "status" field.
+func (qauo *QuizAttemptUpdateOne) SetStatus(q quizattempt.Status) *QuizAttemptUpdateOne {
+	qauo.mutation.SetStatus(q)
+	return qauo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (qauo *QuizAttemptUpdateOne) SetNillableStatus(q *quizattempt.Status) *QuizAttemptUpdateOne {
+	if q != nil {
+		qauo.SetStatus(*q)
+	}
+	return qauo
+}
+
+// SetQuizID sets the "quiz" edge to the Quiz entity by ID.
+func (qauo *QuizAttemptUpdateOne) SetQuizID(id int) *QuizAttemptUpdateOne {
+	qauo.mutation.SetQuizID(id)
+	return qauo
+}
+
+// SetQuiz sets the "quiz" edge to the Quiz entity.
+func (qauo *QuizAttemptUpdateOne) SetQuiz(q *Quiz) *QuizAttemptUpdateOne {
+	return qauo.SetQuizID(q.ID)
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (qauo *QuizAttemptUpdateOne) SetUserID(id int) *QuizAttemptUpdateOne {
+	qauo.mutation.SetUserID(id)
+	return qauo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (qauo *QuizAttemptUpdateOne) SetUser(u *User) *QuizAttemptUpdateOne {
+	return qauo.SetUserID(u.ID)
+}
+
+// SetStudyEventID sets the "study_event" edge to the StudyEvent entity by ID.
+func (qauo *QuizAttemptUpdateOne) SetStudyEventID(id int) *QuizAttemptUpdateOne {
+	qauo.mutation.SetStudyEventID(id)
+	return qauo
+}
+
+// SetNillableStudyEventID sets the "study_event" edge to the StudyEvent entity by ID if the given value is not nil.
+func (qauo *QuizAttemptUpdateOne) SetNillableStudyEventID(id *int) *QuizAttemptUpdateOne {
+	if id != nil {
+		qauo = qauo.SetStudyEventID(*id)
+	}
+	return qauo
+}
+
+// SetStudyEvent sets the "study_event" edge to the StudyEvent entity.
+func (qauo *QuizAttemptUpdateOne) SetStudyEvent(s *StudyEvent) *QuizAttemptUpdateOne {
+	return qauo.SetStudyEventID(s.ID)
+}
+
+// Mutation returns the QuizAttemptMutation object of the builder.
+func (qauo *QuizAttemptUpdateOne) Mutation() *QuizAttemptMutation {
+	return qauo.mutation
+}
+
+// ClearQuiz clears the "quiz" edge to the Quiz entity.
+func (qauo *QuizAttemptUpdateOne) ClearQuiz() *QuizAttemptUpdateOne {
+	qauo.mutation.ClearQuiz()
+	return qauo
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (qauo *QuizAttemptUpdateOne) ClearUser() *QuizAttemptUpdateOne {
+	qauo.mutation.ClearUser()
+	return qauo
+}
+
+// ClearStudyEvent clears the "study_event" edge to the StudyEvent entity.
+func (qauo *QuizAttemptUpdateOne) ClearStudyEvent() *QuizAttemptUpdateOne {
+	qauo.mutation.ClearStudyEvent()
+	return qauo
+}
+
+// Where appends a list predicates to the QuizAttemptUpdate builder.
+func (qauo *QuizAttemptUpdateOne) Where(ps ...predicate.QuizAttempt) *QuizAttemptUpdateOne {
+	qauo.mutation.Where(ps...)
+	return qauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (qauo *QuizAttemptUpdateOne) Select(field string, fields ...string) *QuizAttemptUpdateOne {
+	qauo.fields = append([]string{field}, fields...)
+	return qauo
+}
+
+// Save executes the query and returns the updated QuizAttempt entity.
+func (qauo *QuizAttemptUpdateOne) Save(ctx context.Context) (*QuizAttempt, error) {
+	return withHooks(ctx, qauo.sqlSave, qauo.mutation, qauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (qauo *QuizAttemptUpdateOne) SaveX(ctx context.Context) *QuizAttempt {
+	node, err := qauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (qauo *QuizAttemptUpdateOne) Exec(ctx context.Context) error {
+	_, err := qauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qauo *QuizAttemptUpdateOne) ExecX(ctx context.Context) {
+	if err := qauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (qauo *QuizAttemptUpdateOne) check() error {
+	if v, ok := qauo.mutation.Status(); ok {
+		if err := quizattempt.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "QuizAttempt.status": %w`, err)}
+		}
+	}
+	if qauo.mutation.QuizCleared() && len(qauo.mutation.QuizIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "QuizAttempt.quiz"`)
+	}
+	if qauo.mutation.UserCleared() && len(qauo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "QuizAttempt.user"`)
+	}
+	return nil
+}
+
+func (qauo *QuizAttemptUpdateOne) sqlSave(ctx context.Context) (_node *QuizAttempt, err error) {
+	if err := qauo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(quizattempt.Table, quizattempt.Columns, sqlgraph.NewFieldSpec(quizattempt.FieldID, field.TypeInt))
+	id, ok := qauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "QuizAttempt.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := qauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, quizattempt.FieldID)
+		for _, f := range fields {
+			if !quizattempt.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != quizattempt.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := qauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := qauo.mutation.Answers(); ok {
+		_spec.SetField(quizattempt.FieldAnswers, field.TypeJSON, value)
+	}
+	if qauo.mutation.AnswersCleared() {
+		_spec.ClearField(quizattempt.FieldAnswers, field.TypeJSON)
+	}
+	if value, ok := qauo.mutation.Score(); ok {
+		_spec.SetField(quizattempt.FieldScore, field.TypeInt, value)
+	}
+	if value, ok := qauo.mutation.AddedScore(); ok {
+		_spec.AddField(quizattempt.FieldScore, field.TypeInt, value)
+	}
+	if value, ok := qauo.mutation.MaxScore(); ok {
+		_spec.SetField(quizattempt.FieldMaxScore, field.TypeInt, value)
+	}
+	if value, ok := qauo.mutation.AddedMaxScore(); ok {
+		_spec.AddField(quizattempt.FieldMaxScore, field.TypeInt, value)
+	}
+	if qauo.mutation.DeadlineCleared() {
+		_spec.ClearField(quizattempt.FieldDeadline, field.TypeTime)
+	}
+	if value, ok := qauo.mutation.SubmittedAt(); ok {
+		_spec.SetField(quizattempt.FieldSubmittedAt, field.TypeTime, value)
+	}
+	if qauo.mutation.SubmittedAtCleared() {
+		_spec.ClearField(quizattempt.FieldSubmittedAt, field.TypeTime)
+	}
+	if value, ok := qauo.mutation.Status(); ok {
+		_spec.SetField(quizattempt.FieldStatus, field.TypeEnum, value)
+	}
+	if qauo.mutation.QuizCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   quizattempt.QuizTable,
+			Columns: []string{quizattempt.QuizColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quiz.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := qauo.mutation.QuizIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   quizattempt.QuizTable,
+			Columns: []string{quizattempt.QuizColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quiz.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if qauo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   quizattempt.UserTable,
+			Columns: []string{quizattempt.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := qauo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   quizattempt.UserTable,
+			Columns: []string{quizattempt.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if qauo.mutation.StudyEventCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   quizattempt.StudyEventTable,
+			Columns: []string{quizattempt.StudyEventColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(studyevent.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := qauo.mutation.StudyEventIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   quizattempt.StudyEventTable,
+			Columns: []string{quizattempt.StudyEventColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(studyevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &QuizAttempt{config: qauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, qauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{quizattempt.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	qauo.mutation.done = true
+	return _node, nil
+}
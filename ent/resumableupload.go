@@ -0,0 +1,235 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/r-scheele/zero/ent/resumableupload"
+	"github.com/r-scheele/zero/ent/user"
+)
+
+// ResumableUpload is the model entity for the ResumableUpload schema.
+type ResumableUpload struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Random token identifying the upload in its URL
+	Token string `json:"-"`
+	// ID of the note the file is added to once it's uploaded
+	NoteID int `json:"note_id,omitempty"`
+	// FileName holds the value of the "file_name" field.
+	FileName string `json:"file_name,omitempty"`
+	// MimeType holds the value of the "mime_type" field.
+	MimeType string `json:"mime_type,omitempty"`
+	// Bytes in the complete file
+	Size int64 `json:"size,omitempty"`
+	// Bytes received so far
+	Offset int64 `json:"offset,omitempty"`
+	// When the upload is removed if it hasn't been completed
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// When the file was assembled and queued to be added to the note
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ResumableUploadQuery when eager-loading is set.
+	Edges                  ResumableUploadEdges `json:"edges"`
+	user_resumable_uploads *int
+	selectValues           sql.SelectValues
+}
+
+// ResumableUploadEdges holds the relations/edges for other nodes in the graph.
+type ResumableUploadEdges struct {
+	// Owner holds the value of the owner edge.
+	Owner *User `json:"owner,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// OwnerOrErr returns the Owner value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ResumableUploadEdges) OwnerOrErr() (*User, error) {
+	if e.Owner != nil {
+		return e.Owner, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "owner"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ResumableUpload) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case resumableupload.FieldID, resumableupload.FieldNoteID, resumableupload.FieldSize, resumableupload.FieldOffset:
+			values[i] = new(sql.NullInt64)
+		case resumableupload.FieldToken, resumableupload.FieldFileName, resumableupload.FieldMimeType:
+			values[i] = new(sql.NullString)
+		case resumableupload.FieldExpiresAt, resumableupload.FieldCompletedAt, resumableupload.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case resumableupload.ForeignKeys[0]: // user_resumable_uploads
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ResumableUpload fields.
+func (ru *ResumableUpload) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case resumableupload.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ru.ID = int(value.Int64)
+		case resumableupload.FieldToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token", values[i])
+			} else if value.Valid {
+				ru.Token = value.String
+			}
+		case resumableupload.FieldNoteID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field note_id", values[i])
+			} else if value.Valid {
+				ru.NoteID = int(value.Int64)
+			}
+		case resumableupload.FieldFileName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field file_name", values[i])
+			} else if value.Valid {
+				ru.FileName = value.String
+			}
+		case resumableupload.FieldMimeType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mime_type", values[i])
+			} else if value.Valid {
+				ru.MimeType = value.String
+			}
+		case resumableupload.FieldSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field size", values[i])
+			} else if value.Valid {
+				ru.Size = value.Int64
+			}
+		case resumableupload.FieldOffset:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field offset", values[i])
+			} else if value.Valid {
+				ru.Offset = value.Int64
+			}
+		case resumableupload.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				ru.ExpiresAt = value.Time
+			}
+		case resumableupload.FieldCompletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field completed_at", values[i])
+			} else if value.Valid {
+				ru.CompletedAt = new(time.Time)
+				*ru.CompletedAt = value.Time
+			}
+		case resumableupload.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ru.CreatedAt = value.Time
+			}
+		case resumableupload.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_resumable_uploads", value)
+			} else if value.Valid {
+				ru.user_resumable_uploads = new(int)
+				*ru.user_resumable_uploads = int(value.Int64)
+			}
+		default:
+			ru.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ResumableUpload.
+// This includes values selected through modifiers, order, etc.
+func (ru *ResumableUpload) Value(name string) (ent.Value, error) {
+	return ru.selectValues.Get(name)
+}
+
+// QueryOwner queries the "owner" edge of the ResumableUpload entity.
+func (ru *ResumableUpload) QueryOwner() *UserQuery {
+	return NewResumableUploadClient(ru.config).QueryOwner(ru)
+}
+
+// Update returns a builder for updating this ResumableUpload.
+// Note that you need to call ResumableUpload.Unwrap() before calling this method if this ResumableUpload
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ru *ResumableUpload) Update() *ResumableUploadUpdateOne {
+	return NewResumableUploadClient(ru.config).UpdateOne(ru)
+}
+
+// Unwrap unwraps the ResumableUpload entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ru *ResumableUpload) Unwrap() *ResumableUpload {
+	_tx, ok := ru.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ResumableUpload is not a transactional entity")
+	}
+	ru.config.driver = _tx.drv
+	return ru
+}
+
+// String implements the fmt.Stringer.
+func (ru *ResumableUpload) String() string {
+	var builder strings.Builder
+	builder.WriteString("ResumableUpload(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ru.ID))
+	builder.WriteString("token=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("note_id=")
+	builder.WriteString(fmt.Sprintf("%v", ru.NoteID))
+	builder.WriteString(", ")
+	builder.WriteString("file_name=")
+	builder.WriteString(ru.FileName)
+	builder.WriteString(", ")
+	builder.WriteString("mime_type=")
+	builder.WriteString(ru.MimeType)
+	builder.WriteString(", ")
+	builder.WriteString("size=")
+	builder.WriteString(fmt.Sprintf("%v", ru.Size))
+	builder.WriteString(", ")
+	builder.WriteString("offset=")
+	builder.WriteString(fmt.Sprintf("%v", ru.Offset))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(ru.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := ru.CompletedAt; v != nil {
+		builder.WriteString("completed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ru.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ResumableUploads is a parsable slice of ResumableUpload.
+type ResumableUploads []*ResumableUpload
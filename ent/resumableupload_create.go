@@ -0,0 +1,380 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/r-scheele/zero/ent/resumableupload"
+	"github.com/r-scheele/zero/ent/user"
+)
+
+// ResumableUploadCreate is the builder for creating a ResumableUpload entity.
+type ResumableUploadCreate struct {
+	config
+	mutation *ResumableUploadMutation
+	hooks    []Hook
+}
+
+// SetToken sets the "token" field.
+func (ruc *ResumableUploadCreate) SetToken(s string) *ResumableUploadCreate {
+	ruc.mutation.SetToken(s)
+	return ruc
+}
+
+// SetNoteID sets the "note_id" field.
+func (ruc *ResumableUploadCreate) SetNoteID(i int) *ResumableUploadCreate {
+	ruc.mutation.SetNoteID(i)
+	return ruc
+}
+
+// SetFileName sets the "file_name" field.
+func (ruc *ResumableUploadCreate) SetFileName(s string) *ResumableUploadCreate {
+	ruc.mutation.SetFileName(s)
+	return ruc
+}
+
+// SetMimeType sets the "mime_type" field.
+func (ruc *ResumableUploadCreate) SetMimeType(s string) *ResumableUploadCreate {
+	ruc.mutation.SetMimeType(s)
+	return ruc
+}
+
+// SetNillableMimeType sets the "mime_type" field if the given value is not nil.
+func (ruc *ResumableUploadCreate) SetNillableMimeType(s *string) *ResumableUploadCreate {
+	if s != nil {
+		ruc.SetMimeType(*s)
+	}
+	return ruc
+}
+
+// SetSize sets the "size" field.
+func (ruc *ResumableUploadCreate) SetSize(i int64) *ResumableUploadCreate {
+	ruc.mutation.SetSize(i)
+	return ruc
+}
+
+// SetOffset sets the "offset" field.
+func (ruc *ResumableUploadCreate) SetOffset(i int64) *ResumableUploadCreate {
+	ruc.mutation.SetOffset(i)
+	return ruc
+}
+
+// SetNillableOffset sets the "offset" field if the given value is not nil.
+func (ruc *ResumableUploadCreate) SetNillableOffset(i *int64) *ResumableUploadCreate {
+	if i != nil {
+		ruc.SetOffset(*i)
+	}
+	return ruc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (ruc *ResumableUploadCreate) SetExpiresAt(t time.Time) *ResumableUploadCreate {
+	ruc.mutation.SetExpiresAt(t)
+	return ruc
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (ruc *ResumableUploadCreate) SetCompletedAt(t time.Time) *ResumableUploadCreate {
+	ruc.mutation.SetCompletedAt(t)
+	return ruc
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (ruc *ResumableUploadCreate) SetNillableCompletedAt(t *time.Time) *ResumableUploadCreate {
+	if t != nil {
+		ruc.SetCompletedAt(*t)
+	}
+	return ruc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ruc *ResumableUploadCreate) SetCreatedAt(t time.Time) *ResumableUploadCreate {
+	ruc.mutation.SetCreatedAt(t)
+	return ruc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ruc *ResumableUploadCreate) SetNillableCreatedAt(t *time.Time) *ResumableUploadCreate {
+	if t != nil {
+		ruc.SetCreatedAt(*t)
+	}
+	return ruc
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (ruc *ResumableUploadCreate) SetOwnerID(id int) *ResumableUploadCreate {
+	ruc.mutation.SetOwnerID(id)
+	return ruc
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (ruc *ResumableUploadCreate) SetOwner(u *User) *ResumableUploadCreate {
+	return ruc.SetOwnerID(u.ID)
+}
+
+// Mutation returns the ResumableUploadMutation object of the builder.
+func (ruc *ResumableUploadCreate) Mutation() *ResumableUploadMutation {
+	return ruc.mutation
+}
+
+// Save creates the ResumableUpload in the database.
+func (ruc *ResumableUploadCreate) Save(ctx context.Context) (*ResumableUpload, error) {
+	ruc.defaults()
+	return withHooks(ctx, ruc.sqlSave, ruc.mutation, ruc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ruc *ResumableUploadCreate) SaveX(ctx context.Context) *ResumableUpload {
+	v, err := ruc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ruc *ResumableUploadCreate) Exec(ctx context.Context) error {
+	_, err := ruc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ruc *ResumableUploadCreate) ExecX(ctx context.Context) {
+	if err := ruc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ruc *ResumableUploadCreate) defaults() {
+	if _, ok := ruc.mutation.Offset(); !ok {
+		v := resumableupload.DefaultOffset
+		ruc.mutation.SetOffset(v)
+	}
+	if _, ok := ruc.mutation.CreatedAt(); !ok {
+		v := resumableupload.DefaultCreatedAt()
+		ruc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ruc *ResumableUploadCreate) check() error {
+	if _, ok := ruc.mutation.Token(); !ok {
+		return &ValidationError{Name: "token", err: errors.New(`ent: missing required field "ResumableUpload.token"`)}
+	}
+	if v, ok := ruc.mutation.Token(); ok {
+		if err := resumableupload.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "ResumableUpload.token": %w`, err)}
+		}
+	}
+	if _, ok := ruc.mutation.NoteID(); !ok {
+		return &ValidationError{Name: "note_id", err: errors.New(`ent: missing required field "ResumableUpload.note_id"`)}
+	}
+	if _, ok := ruc.mutation.FileName(); !ok {
+		return &ValidationError{Name: "file_name", err: errors.New(`ent: missing required field "ResumableUpload.file_name"`)}
+	}
+	if v, ok := ruc.mutation.FileName(); ok {
+		if err := resumableupload.FileNameValidator(v); err != nil {
+			return &ValidationError{Name: "file_name", err: fmt.Errorf(`ent: validator failed for field "ResumableUpload.file_name": %w`, err)}
+		}
+	}
+	if _, ok := ruc.mutation.Size(); !ok {
+		return &ValidationError{Name: "size", err: errors.New(`ent: missing required field "ResumableUpload.size"`)}
+	}
+	if v, ok := ruc.mutation.Size(); ok {
+		if err := resumableupload.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "ResumableUpload.size": %w`, err)}
+		}
+	}
+	if _, ok := ruc.mutation.Offset(); !ok {
+		return &ValidationError{Name: "offset", err: errors.New(`ent: missing required field "ResumableUpload.offset"`)}
+	}
+	if v, ok := ruc.mutation.Offset(); ok {
+		if err := resumableupload.OffsetValidator(v); err != nil {
+			return &ValidationError{Name: "offset", err: fmt.Errorf(`ent: validator failed for field "ResumableUpload.offset": %w`, err)}
+		}
+	}
+	if _, ok := ruc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "ResumableUpload.expires_at"`)}
+	}
+	if _, ok := ruc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ResumableUpload.created_at"`)}
+	}
+	if len(ruc.mutation.OwnerIDs()) == 0 {
+		return &ValidationError{Name: "owner", err: errors.New(`ent: missing required edge "ResumableUpload.owner"`)}
+	}
+	return nil
+}
+
+func (ruc *ResumableUploadCreate) sqlSave(ctx context.Context) (*ResumableUpload, error) {
+	if err := ruc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ruc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ruc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	ruc.mutation.id = &_node.ID
+	ruc.mutation.done = true
+	return _node, nil
+}
+
+func (ruc *ResumableUploadCreate) createSpec() (*ResumableUpload, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ResumableUpload{config: ruc.config}
+		_spec = sqlgraph.NewCreateSpec(resumableupload.Table, sqlgraph.NewFieldSpec(resumableupload.FieldID, field.TypeInt))
+	)
+	if value, ok := ruc.mutation.Token(); ok {
+		_spec.SetField(resumableupload.FieldToken, field.TypeString, value)
+		_node.Token = value
+	}
+	if value, ok := ruc.mutation.NoteID(); ok {
+		_spec.SetField(resumableupload.FieldNoteID, field.TypeInt, value)
+		_node.NoteID = value
+	}
+	if value, ok := ruc.mutation.FileName(); ok {
+		_spec.SetField(resumableupload.FieldFileName, field.TypeString, value)
+		_node.FileName = value
+	}
+	if value, ok := ruc.mutation.MimeType(); ok {
+		_spec.SetField(resumableupload.FieldMimeType, field.TypeString, value)
+		_node.MimeType = value
+	}
+	if value, ok := ruc.mutation.Size(); ok {
+		_spec.SetField(resumableupload.FieldSize, field.TypeInt64, value)
+		_node.Size = value
+	}
+	if value, ok := ruc.mutation.Offset(); ok {
+		_spec.SetField(resumableupload.FieldOffset, field.TypeInt64, value)
+		_node.Offset = value
+	}
+	if value, ok := ruc.mutation.ExpiresAt(); ok {
+		_spec.SetField(resumableupload.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := ruc.mutation.CompletedAt(); ok {
+		_spec.SetField(resumableupload.FieldCompletedAt, field.TypeTime, value)
+		_node.CompletedAt = &value
+	}
+	if value, ok := ruc.mutation.CreatedAt(); ok {
+		_spec.SetField(resumableupload.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := ruc.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   resumableupload.OwnerTable,
+			Columns: []string{resumableupload.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_resumable_uploads = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ResumableUploadCreateBulk is the builder for creating many ResumableUpload entities in bulk.
+type ResumableUploadCreateBulk struct {
+	config
+	err      error
+	builders []*ResumableUploadCreate
+}
+
+// Save creates the ResumableUpload entities in the database.
+func (rucb *ResumableUploadCreateBulk) Save(ctx context.Context) ([]*ResumableUpload, error) {
+	if rucb.err != nil {
+		return nil, rucb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rucb.builders))
+	nodes := make([]*ResumableUpload, len(rucb.builders))
+	mutators := make([]Mutator, len(rucb.builders))
+	for i := range rucb.builders {
+		func(i int, root context.Context) {
+			builder := rucb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ResumableUploadMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rucb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rucb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rucb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rucb *ResumableUploadCreateBulk) SaveX(ctx context.Context) []*ResumableUpload {
+	v, err := rucb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rucb *ResumableUploadCreateBulk) Exec(ctx context.Context) error {
+	_, err := rucb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rucb *ResumableUploadCreateBulk) ExecX(ctx context.Context) {
+	if err := rucb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
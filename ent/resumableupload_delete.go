@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/r-scheele/zero/ent/predicate"
+	"github.com/r-scheele/zero/ent/resumableupload"
+)
+
+// ResumableUploadDelete is the builder for deleting a ResumableUpload entity.
+type ResumableUploadDelete struct {
+	config
+	hooks    []Hook
+	mutation *ResumableUploadMutation
+}
+
+// Where appends a list predicates to the ResumableUploadDelete builder.
+func (rud *ResumableUploadDelete) Where(ps ...predicate.ResumableUpload) *ResumableUploadDelete {
+	rud.mutation.Where(ps...)
+	return rud
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (rud *ResumableUploadDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, rud.sqlExec, rud.mutation, rud.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rud *ResumableUploadDelete) ExecX(ctx context.Context) int {
+	n, err := rud.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (rud *ResumableUploadDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(resumableupload.Table, sqlgraph.NewFieldSpec(resumableupload.FieldID, field.TypeInt))
+	if ps := rud.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, rud.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	rud.mutation.done = true
+	return affected, err
+}
+
+// ResumableUploadDeleteOne is the builder for deleting a single ResumableUpload entity.
+type ResumableUploadDeleteOne struct {
+	rud *ResumableUploadDelete
+}
+
+// Where appends a list predicates to the ResumableUploadDelete builder.
+func (rudo *ResumableUploadDeleteOne) Where(ps ...predicate.ResumableUpload) *ResumableUploadDeleteOne {
+	rudo.rud.mutation.Where(ps...)
+	return rudo
+}
+
+// Exec executes the deletion query.
+func (rudo *ResumableUploadDeleteOne) Exec(ctx context.Context) error {
+	n, err := rudo.rud.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{resumableupload.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rudo *ResumableUploadDeleteOne) ExecX(ctx context.Context) {
+	if err := rudo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
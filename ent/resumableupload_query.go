@@ -0,0 +1,614 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/r-scheele/zero/ent/predicate"
+	"github.com/r-scheele/zero/ent/resumableupload"
+	"github.com/r-scheele/zero/ent/user"
+)
+
+// ResumableUploadQuery is the builder for querying ResumableUpload entities.
+type ResumableUploadQuery struct {
+	config
+	ctx        *QueryContext
+	order      []resumableupload.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ResumableUpload
+	withOwner  *UserQuery
+	withFKs    bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ResumableUploadQuery builder.
+func (ruq *ResumableUploadQuery) Where(ps ...predicate.ResumableUpload) *ResumableUploadQuery {
+	ruq.predicates = append(ruq.predicates, ps...)
+	return ruq
+}
+
+// Limit the number of records to be returned by this query.
+func (ruq *ResumableUploadQuery) Limit(limit int) *ResumableUploadQuery {
+	ruq.ctx.Limit = &limit
+	return ruq
+}
+
+// Offset to start from.
+func (ruq *ResumableUploadQuery) Offset(offset int) *ResumableUploadQuery {
+	ruq.ctx.Offset = &offset
+	return ruq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ruq *ResumableUploadQuery) Unique(unique bool) *ResumableUploadQuery {
+	ruq.ctx.Unique = &unique
+	return ruq
+}
+
+// Order specifies how the records should be ordered.
+func (ruq *ResumableUploadQuery) Order(o ...resumableupload.OrderOption) *ResumableUploadQuery {
+	ruq.order = append(ruq.order, o...)
+	return ruq
+}
+
+// QueryOwner chains the current query on the "owner" edge.
+func (ruq *ResumableUploadQuery) QueryOwner() *UserQuery {
+	query := (&UserClient{config: ruq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ruq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ruq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resumableupload.Table, resumableupload.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, resumableupload.OwnerTable, resumableupload.OwnerColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ruq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ResumableUpload entity from the query.
+// Returns a *NotFoundError when no ResumableUpload was found.
+func (ruq *ResumableUploadQuery) First(ctx context.Context) (*ResumableUpload, error) {
+	nodes, err := ruq.Limit(1).All(setContextOp(ctx, ruq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{resumableupload.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ruq *ResumableUploadQuery) FirstX(ctx context.Context) *ResumableUpload {
+	node, err := ruq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ResumableUpload ID from the query.
+// Returns a *NotFoundError when no ResumableUpload ID was found.
+func (ruq *ResumableUploadQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ruq.Limit(1).IDs(setContextOp(ctx, ruq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{resumableupload.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ruq *ResumableUploadQuery) FirstIDX(ctx context.Context) int {
+	id, err := ruq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ResumableUpload entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ResumableUpload entity is found.
+// Returns a *NotFoundError when no ResumableUpload entities are found.
+func (ruq *ResumableUploadQuery) Only(ctx context.Context) (*ResumableUpload, error) {
+	nodes, err := ruq.Limit(2).All(setContextOp(ctx, ruq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{resumableupload.Label}
+	default:
+		return nil, &NotSingularError{resumableupload.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ruq *ResumableUploadQuery) OnlyX(ctx context.Context) *ResumableUpload {
+	node, err := ruq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ResumableUpload ID in the query.
+// Returns a *NotSingularError when more than one ResumableUpload ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ruq *ResumableUploadQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ruq.Limit(2).IDs(setContextOp(ctx, ruq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{resumableupload.Label}
+	default:
+		err = &NotSingularError{resumableupload.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ruq *ResumableUploadQuery) OnlyIDX(ctx context.Context) int {
+	id, err := ruq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ResumableUploads.
+func (ruq *ResumableUploadQuery) All(ctx context.Context) ([]*ResumableUpload, error) {
+	ctx = setContextOp(ctx, ruq.ctx, ent.OpQueryAll)
+	if err := ruq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ResumableUpload, *ResumableUploadQuery]()
+	return withInterceptors[[]*ResumableUpload](ctx, ruq, qr, ruq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ruq *ResumableUploadQuery) AllX(ctx context.Context) []*ResumableUpload {
+	nodes, err := ruq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ResumableUpload IDs.
+func (ruq *ResumableUploadQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if ruq.ctx.Unique == nil && ruq.path != nil {
+		ruq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ruq.ctx, ent.OpQueryIDs)
+	if err = ruq.Select(resumableupload.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ruq *ResumableUploadQuery) IDsX(ctx context.Context) []int {
+	ids, err := ruq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ruq *ResumableUploadQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ruq.ctx, ent.OpQueryCount)
+	if err := ruq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ruq, querierCount[*ResumableUploadQuery](), ruq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ruq *ResumableUploadQuery) CountX(ctx context.Context) int {
+	count, err := ruq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ruq *ResumableUploadQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ruq.ctx, ent.OpQueryExist)
+	switch _, err := ruq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ruq *ResumableUploadQuery) ExistX(ctx context.Context) bool {
+	exist, err := ruq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ResumableUploadQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ruq *ResumableUploadQuery) Clone() *ResumableUploadQuery {
+	if ruq == nil {
+		return nil
+	}
+	return &ResumableUploadQuery{
+		config:     ruq.config,
+		ctx:        ruq.ctx.Clone(),
+		order:      append([]resumableupload.OrderOption{}, ruq.order...),
+		inters:     append([]Interceptor{}, ruq.inters...),
+		predicates: append([]predicate.ResumableUpload{}, ruq.predicates...),
+		withOwner:  ruq.withOwner.Clone(),
+		// clone intermediate query.
+		sql:  ruq.sql.Clone(),
+		path: ruq.path,
+	}
+}
+
+// WithOwner tells the query-builder to eager-load the nodes that are connected to
+// the "owner" edge. The optional arguments are used to configure the query builder of the edge.
+func (ruq *ResumableUploadQuery) WithOwner(opts ...func(*UserQuery)) *ResumableUploadQuery {
+	query := (&UserClient{config: ruq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ruq.withOwner = query
+	return ruq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Token string `json:"token,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ResumableUpload.Query().
+//		GroupBy(resumableupload.FieldToken).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ruq *ResumableUploadQuery) GroupBy(field string, fields ...string) *ResumableUploadGroupBy {
+	ruq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ResumableUploadGroupBy{build: ruq}
+	grbuild.flds = &ruq.ctx.Fields
+	grbuild.label = resumableupload.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Token string `json:"token,omitempty"`
+//	}
+//
+//	client.ResumableUpload.Query().
+//		Select(resumableupload.FieldToken).
+//		Scan(ctx, &v)
+func (ruq *ResumableUploadQuery) Select(fields ...string) *ResumableUploadSelect {
+	ruq.ctx.Fields = append(ruq.ctx.Fields, fields...)
+	sbuild := &ResumableUploadSelect{ResumableUploadQuery: ruq}
+	sbuild.label = resumableupload.Label
+	sbuild.flds, sbuild.scan = &ruq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ResumableUploadSelect configured with the given aggregations.
+func (ruq *ResumableUploadQuery) Aggregate(fns ...AggregateFunc) *ResumableUploadSelect {
+	return ruq.Select().Aggregate(fns...)
+}
+
+func (ruq *ResumableUploadQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ruq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ruq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ruq.ctx.Fields {
+		if !resumableupload.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ruq.path != nil {
+		prev, err := ruq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ruq.sql = prev
+	}
+	return nil
+}
+
+func (ruq *ResumableUploadQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ResumableUpload, error) {
+	var (
+		nodes       = []*ResumableUpload{}
+		withFKs     = ruq.withFKs
+		_spec       = ruq.querySpec()
+		loadedTypes = [1]bool{
+			ruq.withOwner != nil,
+		}
+	)
+	if ruq.withOwner != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, resumableupload.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ResumableUpload).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ResumableUpload{config: ruq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ruq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := ruq.withOwner; query != nil {
+		if err := ruq.loadOwner(ctx, query, nodes, nil,
+			func(n *ResumableUpload, e *User) { n.Edges.Owner = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (ruq *ResumableUploadQuery) loadOwner(ctx context.Context, query *UserQuery, nodes []*ResumableUpload, init func(*ResumableUpload), assign func(*ResumableUpload, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*ResumableUpload)
+	for i := range nodes {
+		if nodes[i].user_resumable_uploads == nil {
+			continue
+		}
+		fk := *nodes[i].user_resumable_uploads
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_resumable_uploads" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (ruq *ResumableUploadQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ruq.querySpec()
+	_spec.Node.Columns = ruq.ctx.Fields
+	if len(ruq.ctx.Fields) > 0 {
+		_spec.Unique = ruq.ctx.Unique != nil && *ruq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ruq.driver, _spec)
+}
+
+func (ruq *ResumableUploadQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(resumableupload.Table, resumableupload.Columns, sqlgraph.NewFieldSpec(resumableupload.FieldID, field.TypeInt))
+	_spec.From = ruq.sql
+	if unique := ruq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ruq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ruq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, resumableupload.FieldID)
+		for i := range fields {
+			if fields[i] != resumableupload.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ruq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ruq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ruq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ruq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ruq *ResumableUploadQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ruq.driver.Dialect())
+	t1 := builder.Table(resumableupload.Table)
+	columns := ruq.ctx.Fields
+	if len(columns) == 0 {
+		columns = resumableupload.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ruq.sql != nil {
+		selector = ruq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ruq.ctx.Unique != nil && *ruq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range ruq.predicates {
+		p(selector)
+	}
+	for _, p := range ruq.order {
+		p(selector)
+	}
+	if offset := ruq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ruq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ResumableUploadGroupBy is the group-by builder for ResumableUpload entities.
+type ResumableUploadGroupBy struct {
+	selector
+	build *ResumableUploadQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (rugb *ResumableUploadGroupBy) Aggregate(fns ...AggregateFunc) *ResumableUploadGroupBy {
+	rugb.fns = append(rugb.fns, fns...)
+	return rugb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rugb *ResumableUploadGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rugb.build.ctx, ent.OpQueryGroupBy)
+	if err := rugb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ResumableUploadQuery, *ResumableUploadGroupBy](ctx, rugb.build, rugb, rugb.build.inters, v)
+}
+
+func (rugb *ResumableUploadGroupBy) sqlScan(ctx context.Context, root *ResumableUploadQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(rugb.fns))
+	for _, fn := range rugb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*rugb.flds)+len(rugb.fns))
+		for _, f := range *rugb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*rugb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rugb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ResumableUploadSelect is the builder for selecting fields of ResumableUpload entities.
+type ResumableUploadSelect struct {
+	*ResumableUploadQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (rus *ResumableUploadSelect) Aggregate(fns ...AggregateFunc) *ResumableUploadSelect {
+	rus.fns = append(rus.fns, fns...)
+	return rus
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rus *ResumableUploadSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rus.ctx, ent.OpQuerySelect)
+	if err := rus.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ResumableUploadQuery, *ResumableUploadSelect](ctx, rus.ResumableUploadQuery, rus, rus.inters, v)
+}
+
+func (rus *ResumableUploadSelect) sqlScan(ctx context.Context, root *ResumableUploadQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(rus.fns))
+	for _, fn := range rus.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*rus.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rus.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,655 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/r-scheele/zero/ent/predicate"
+	"github.com/r-scheele/zero/ent/resumableupload"
+	"github.com/r-scheele/zero/ent/user"
+)
+
+// ResumableUploadUpdate is the builder for updating ResumableUpload entities.
+type ResumableUploadUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ResumableUploadMutation
+}
+
+// Where appends a list predicates to the ResumableUploadUpdate builder.
+func (ruu *ResumableUploadUpdate) Where(ps ...predicate.ResumableUpload) *ResumableUploadUpdate {
+	ruu.mutation.Where(ps...)
+	return ruu
+}
+
+// SetNoteID sets the "note_id" field.
+func (ruu *ResumableUploadUpdate) SetNoteID(i int) *ResumableUploadUpdate {
+	ruu.mutation.ResetNoteID()
+	ruu.mutation.SetNoteID(i)
+	return ruu
+}
+
+// SetNillableNoteID sets the "note_id" field if the given value is not nil.
+func (ruu *ResumableUploadUpdate) SetNillableNoteID(i *int) *ResumableUploadUpdate {
+	if i != nil {
+		ruu.SetNoteID(*i)
+	}
+	return ruu
+}
+
+// AddNoteID adds i to the "note_id" field.
+func (ruu *ResumableUploadUpdate) AddNoteID(i int) *ResumableUploadUpdate {
+	ruu.mutation.AddNoteID(i)
+	return ruu
+}
+
+// SetFileName sets the "file_name" field.
+func (ruu *ResumableUploadUpdate) SetFileName(s string) *ResumableUploadUpdate {
+	ruu.mutation.SetFileName(s)
+	return ruu
+}
+
+// SetNillableFileName sets the "file_name" field if the given value is not nil.
+func (ruu *ResumableUploadUpdate) SetNillableFileName(s *string) *ResumableUploadUpdate {
+	if s != nil {
+		ruu.SetFileName(*s)
+	}
+	return ruu
+}
+
+// SetMimeType sets the "mime_type" field.
+func (ruu *ResumableUploadUpdate) SetMimeType(s string) *ResumableUploadUpdate {
+	ruu.mutation.SetMimeType(s)
+	return ruu
+}
+
+// SetNillableMimeType sets the "mime_type" field if the given value is not nil.
+func (ruu *ResumableUploadUpdate) SetNillableMimeType(s *string) *ResumableUploadUpdate {
+	if s != nil {
+		ruu.SetMimeType(*s)
+	}
+	return ruu
+}
+
+// ClearMimeType clears the value of the "mime_type" field.
+func (ruu *ResumableUploadUpdate) ClearMimeType() *ResumableUploadUpdate {
+	ruu.mutation.ClearMimeType()
+	return ruu
+}
+
+// SetSize sets the "size" field.
+func (ruu *ResumableUploadUpdate) SetSize(i int64) *ResumableUploadUpdate {
+	ruu.mutation.ResetSize()
+	ruu.mutation.SetSize(i)
+	return ruu
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (ruu *ResumableUploadUpdate) SetNillableSize(i *int64) *ResumableUploadUpdate {
+	if i != nil {
+		ruu.SetSize(*i)
+	}
+	return ruu
+}
+
+// AddSize adds i to the "size" field.
+func (ruu *ResumableUploadUpdate) AddSize(i int64) *ResumableUploadUpdate {
+	ruu.mutation.AddSize(i)
+	return ruu
+}
+
+// SetOffset sets the "offset" field.
+func (ruu *ResumableUploadUpdate) SetOffset(i int64) *ResumableUploadUpdate {
+	ruu.mutation.ResetOffset()
+	ruu.mutation.SetOffset(i)
+	return ruu
+}
+
+// SetNillableOffset sets the "offset" field if the given value is not nil.
+func (ruu *ResumableUploadUpdate) SetNillableOffset(i *int64) *ResumableUploadUpdate {
+	if i != nil {
+		ruu.SetOffset(*i)
+	}
+	return ruu
+}
+
+// AddOffset adds i to the "offset" field.
+func (ruu *ResumableUploadUpdate) AddOffset(i int64) *ResumableUploadUpdate {
+	ruu.mutation.AddOffset(i)
+	return ruu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (ruu *ResumableUploadUpdate) SetExpiresAt(t time.Time) *ResumableUploadUpdate {
+	ruu.mutation.SetExpiresAt(t)
+	return ruu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (ruu *ResumableUploadUpdate) SetNillableExpiresAt(t *time.Time) *ResumableUploadUpdate {
+	if t != nil {
+		ruu.SetExpiresAt(*t)
+	}
+	return ruu
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (ruu *ResumableUploadUpdate) SetCompletedAt(t time.Time) *ResumableUploadUpdate {
+	ruu.mutation.SetCompletedAt(t)
+	return ruu
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (ruu *ResumableUploadUpdate) SetNillableCompletedAt(t *time.Time) *ResumableUploadUpdate {
+	if t != nil {
+		ruu.SetCompletedAt(*t)
+	}
+	return ruu
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (ruu *ResumableUploadUpdate) ClearCompletedAt() *ResumableUploadUpdate {
+	ruu.mutation.ClearCompletedAt()
+	return ruu
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (ruu *ResumableUploadUpdate) SetOwnerID(id int) *ResumableUploadUpdate {
+	ruu.mutation.SetOwnerID(id)
+	return ruu
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (ruu *ResumableUploadUpdate) SetOwner(u *User) *ResumableUploadUpdate {
+	return ruu.SetOwnerID(u.ID)
+}
+
+// Mutation returns the ResumableUploadMutation object of the builder.
+func (ruu *ResumableUploadUpdate) Mutation() *ResumableUploadMutation {
+	return ruu.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (ruu *ResumableUploadUpdate) ClearOwner() *ResumableUploadUpdate {
+	ruu.mutation.ClearOwner()
+	return ruu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ruu *ResumableUploadUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ruu.sqlSave, ruu.mutation, ruu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ruu *ResumableUploadUpdate) SaveX(ctx context.Context) int {
+	affected, err := ruu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ruu *ResumableUploadUpdate) Exec(ctx context.Context) error {
+	_, err := ruu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ruu *ResumableUploadUpdate) ExecX(ctx context.Context) {
+	if err := ruu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ruu *ResumableUploadUpdate) check() error {
+	if v, ok := ruu.mutation.FileName(); ok {
+		if err := resumableupload.FileNameValidator(v); err != nil {
+			return &ValidationError{Name: "file_name", err: fmt.Errorf(`ent: validator failed for field "ResumableUpload.file_name": %w`, err)}
+		}
+	}
+	if v, ok := ruu.mutation.Size(); ok {
+		if err := resumableupload.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "ResumableUpload.size": %w`, err)}
+		}
+	}
+	if v, ok := ruu.mutation.Offset(); ok {
+		if err := resumableupload.OffsetValidator(v); err != nil {
+			return &ValidationError{Name: "offset", err: fmt.Errorf(`ent: validator failed for field "ResumableUpload.offset": %w`, err)}
+		}
+	}
+	if ruu.mutation.OwnerCleared() && len(ruu.mutation.OwnerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ResumableUpload.owner"`)
+	}
+	return nil
+}
+
+func (ruu *ResumableUploadUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ruu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(resumableupload.Table, resumableupload.Columns, sqlgraph.NewFieldSpec(resumableupload.FieldID, field.TypeInt))
+	if ps := ruu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ruu.mutation.NoteID(); ok {
+		_spec.SetField(resumableupload.FieldNoteID, field.TypeInt, value)
+	}
+	if value, ok := ruu.mutation.AddedNoteID(); ok {
+		_spec.AddField(resumableupload.FieldNoteID, field.TypeInt, value)
+	}
+	if value, ok := ruu.mutation.FileName(); ok {
+		_spec.SetField(resumableupload.FieldFileName, field.TypeString, value)
+	}
+	if value, ok := ruu.mutation.MimeType(); ok {
+		_spec.SetField(resumableupload.FieldMimeType, field.TypeString, value)
+	}
+	if ruu.mutation.MimeTypeCleared() {
+		_spec.ClearField(resumableupload.FieldMimeType, field.TypeString)
+	}
+	if value, ok := ruu.mutation.Size(); ok {
+		_spec.SetField(resumableupload.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := ruu.mutation.AddedSize(); ok {
+		_spec.AddField(resumableupload.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := ruu.mutation.Offset(); ok {
+		_spec.SetField(resumableupload.FieldOffset, field.TypeInt64, value)
+	}
+	if value, ok := ruu.mutation.AddedOffset(); ok {
+		_spec.AddField(resumableupload.FieldOffset, field.TypeInt64, value)
+	}
+	if value, ok := ruu.mutation.ExpiresAt(); ok {
+		_spec.SetField(resumableupload.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := ruu.mutation.CompletedAt(); ok {
+		_spec.SetField(resumableupload.FieldCompletedAt, field.TypeTime, value)
+	}
+	if ruu.mutation.CompletedAtCleared() {
+		_spec.ClearField(resumableupload.FieldCompletedAt, field.TypeTime)
+	}
+	if ruu.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   resumableupload.OwnerTable,
+			Columns: []string{resumableupload.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruu.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   resumableupload.OwnerTable,
+			Columns: []string{resumableupload.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ruu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{resumableupload.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ruu.mutation.done = true
+	return n, nil
+}
+
+// ResumableUploadUpdateOne is the builder for updating a single ResumableUpload entity.
+type ResumableUploadUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ResumableUploadMutation
+}
+
+// SetNoteID sets the "note_id" field.
+func (ruuo *ResumableUploadUpdateOne) SetNoteID(i int) *ResumableUploadUpdateOne {
+	ruuo.mutation.ResetNoteID()
+	ruuo.mutation.SetNoteID(i)
+	return ruuo
+}
+
+// SetNillableNoteID sets the "note_id" field if the given value is not nil.
+func (ruuo *ResumableUploadUpdateOne) SetNillableNoteID(i *int) *ResumableUploadUpdateOne {
+	if i != nil {
+		ruuo.SetNoteID(*i)
+	}
+	return ruuo
+}
+
+// AddNoteID adds i to the "note_id" field.
+func (ruuo *ResumableUploadUpdateOne) AddNoteID(i int) *ResumableUploadUpdateOne {
+	ruuo.mutation.AddNoteID(i)
+	return ruuo
+}
+
+// SetFileName sets the "file_name" field.
+func (ruuo *ResumableUploadUpdateOne) SetFileName(s string) *ResumableUploadUpdateOne {
+	ruuo.mutation.SetFileName(s)
+	return ruuo
+}
+
+// SetNillableFileName sets the "file_name" field if the given value is not nil.
+func (ruuo *ResumableUploadUpdateOne) SetNillableFileName(s *string) *ResumableUploadUpdateOne {
+	if s != nil {
+		ruuo.SetFileName(*s)
+	}
+	return ruuo
+}
+
+// SetMimeType sets the "mime_type" field.
+func (ruuo *ResumableUploadUpdateOne) SetMimeType(s string) *ResumableUploadUpdateOne {
+	ruuo.mutation.SetMimeType(s)
+	return ruuo
+}
+
+// SetNillableMimeType sets the "mime_type" field if the given value is not nil.
+func (ruuo *ResumableUploadUpdateOne) SetNillableMimeType(s *string) *ResumableUploadUpdateOne {
+	if s != nil {
+		ruuo.SetMimeType(*s)
+	}
+	return ruuo
+}
+
+// ClearMimeType clears the value of the "mime_type" field.
+func (ruuo *ResumableUploadUpdateOne) ClearMimeType() *ResumableUploadUpdateOne {
+	ruuo.mutation.ClearMimeType()
+	return ruuo
+}
+
+// SetSize sets the "size" field.
+func (ruuo *ResumableUploadUpdateOne) SetSize(i int64) *ResumableUploadUpdateOne {
+	ruuo.mutation.ResetSize()
+	ruuo.mutation.SetSize(i)
+	return ruuo
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (ruuo *ResumableUploadUpdateOne) SetNillableSize(i *int64) *ResumableUploadUpdateOne {
+	if i != nil {
+		ruuo.SetSize(*i)
+	}
+	return ruuo
+}
+
+// AddSize adds i to the "size" field.
+func (ruuo *ResumableUploadUpdateOne) AddSize(i int64) *ResumableUploadUpdateOne {
+	ruuo.mutation.AddSize(i)
+	return ruuo
+}
+
+// SetOffset sets the "offset" field.
+func (ruuo *ResumableUploadUpdateOne) SetOffset(i int64) *ResumableUploadUpdateOne {
+	ruuo.mutation.ResetOffset()
+	ruuo.mutation.SetOffset(i)
+	return ruuo
+}
+
+// SetNillableOffset sets the "offset" field if the given value is not nil.
+func (ruuo *ResumableUploadUpdateOne) SetNillableOffset(i *int64) *ResumableUploadUpdateOne {
+	if i != nil {
+		ruuo.SetOffset(*i)
+	}
+	return ruuo
+}
+
+// AddOffset adds i to the "offset" field.
+func (ruuo *ResumableUploadUpdateOne) AddOffset(i int64) *ResumableUploadUpdateOne {
+	ruuo.mutation.AddOffset(i)
+	return ruuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (ruuo *ResumableUploadUpdateOne) SetExpiresAt(t time.Time) *ResumableUploadUpdateOne {
+	ruuo.mutation.SetExpiresAt(t)
+	return ruuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (ruuo *ResumableUploadUpdateOne) SetNillableExpiresAt(t *time.Time) *ResumableUploadUpdateOne {
+	if t != nil {
+		ruuo.SetExpiresAt(*t)
+	}
+	return ruuo
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (ruuo *ResumableUploadUpdateOne) SetCompletedAt(t time.Time) *ResumableUploadUpdateOne {
+	ruuo.mutation.SetCompletedAt(t)
+	return ruuo
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (ruuo *ResumableUploadUpdateOne) SetNillableCompletedAt(t *time.Time) *ResumableUploadUpdateOne {
+	if t != nil {
+		ruuo.SetCompletedAt(*t)
+	}
+	return ruuo
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (ruuo *ResumableUploadUpdateOne) ClearCompletedAt() *ResumableUploadUpdateOne {
+	ruuo.mutation.ClearCompletedAt()
+	return ruuo
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (ruuo *ResumableUploadUpdateOne) SetOwnerID(id int) *ResumableUploadUpdateOne {
+	ruuo.mutation.SetOwnerID(id)
+	return ruuo
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (ruuo *ResumableUploadUpdateOne) SetOwner(u *User) *ResumableUploadUpdateOne {
+	return ruuo.SetOwnerID(u.ID)
+}
+
+// Mutation returns the ResumableUploadMutation object of the builder.
+func (ruuo *ResumableUploadUpdateOne) Mutation() *ResumableUploadMutation {
+	return ruuo.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (ruuo *ResumableUploadUpdateOne) ClearOwner() *ResumableUploadUpdateOne {
+	ruuo.mutation.ClearOwner()
+	return ruuo
+}
+
+// Where appends a list predicates to the ResumableUploadUpdate builder.
+func (ruuo *ResumableUploadUpdateOne) Where(ps ...predicate.ResumableUpload) *ResumableUploadUpdateOne {
+	ruuo.mutation.Where(ps...)
+	return ruuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ruuo *ResumableUploadUpdateOne) Select(field string, fields ...string) *ResumableUploadUpdateOne {
+	ruuo.fields = append([]string{field}, fields...)
+	return ruuo
+}
+
+// Save executes the query and returns the updated ResumableUpload entity.
+func (ruuo *ResumableUploadUpdateOne) Save(ctx context.Context) (*ResumableUpload, error) {
+	return withHooks(ctx, ruuo.sqlSave, ruuo.mutation, ruuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ruuo *ResumableUploadUpdateOne) SaveX(ctx context.Context) *ResumableUpload {
+	node, err := ruuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ruuo *ResumableUploadUpdateOne) Exec(ctx context.Context) error {
+	_, err := ruuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ruuo *ResumableUploadUpdateOne) ExecX(ctx context.Context) {
+	if err := ruuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ruuo *ResumableUploadUpdateOne) check() error {
+	if v, ok := ruuo.mutation.FileName(); ok {
+		if err := resumableupload.FileNameValidator(v); err != nil {
+			return &ValidationError{Name: "file_name", err: fmt.Errorf(`ent: validator failed for field "ResumableUpload.file_name": %w`, err)}
+		}
+	}
+	if v, ok := ruuo.mutation.Size(); ok {
+		if err := resumableupload.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "ResumableUpload.size": %w`, err)}
+		}
+	}
+	if v, ok := ruuo.mutation.Offset(); ok {
+		if err := resumableupload.OffsetValidator(v); err != nil {
+			return &ValidationError{Name: "offset", err: fmt.Errorf(`ent: validator failed for field "ResumableUpload.offset": %w`, err)}
+		}
+	}
+	if ruuo.mutation.OwnerCleared() && len(ruuo.mutation.OwnerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ResumableUpload.owner"`)
+	}
+	return nil
+}
+
+func (ruuo *ResumableUploadUpdateOne) sqlSave(ctx context.Context) (_node *ResumableUpload, err error) {
+	if err := ruuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(resumableupload.Table, resumableupload.Columns, sqlgraph.NewFieldSpec(resumableupload.FieldID, field.TypeInt))
+	id, ok := ruuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ResumableUpload.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ruuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, resumableupload.FieldID)
+		for _, f := range fields {
+			if !resumableupload.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != resumableupload.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ruuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ruuo.mutation.NoteID(); ok {
+		_spec.SetField(resumableupload.FieldNoteID, field.TypeInt, value)
+	}
+	if value, ok := ruuo.mutation.AddedNoteID(); ok {
+		_spec.AddField(resumableupload.FieldNoteID, field.TypeInt, value)
+	}
+	if value, ok := ruuo.mutation.FileName(); ok {
+		_spec.SetField(resumableupload.FieldFileName, field.TypeString, value)
+	}
+	if value, ok := ruuo.mutation.MimeType(); ok {
+		_spec.SetField(resumableupload.FieldMimeType, field.TypeString, value)
+	}
+	if ruuo.mutation.MimeTypeCleared() {
+		_spec.ClearField(resumableupload.FieldMimeType, field.TypeString)
+	}
+	if value, ok := ruuo.mutation.Size(); ok {
+		_spec.SetField(resumableupload.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := ruuo.mutation.AddedSize(); ok {
+		_spec.AddField(resumableupload.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := ruuo.mutation.Offset(); ok {
+		_spec.SetField(resumableupload.FieldOffset, field.TypeInt64, value)
+	}
+	if value, ok := ruuo.mutation.AddedOffset(); ok {
+		_spec.AddField(resumableupload.FieldOffset, field.TypeInt64, value)
+	}
+	if value, ok := ruuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(resumableupload.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := ruuo.mutation.CompletedAt(); ok {
+		_spec.SetField(resumableupload.FieldCompletedAt, field.TypeTime, value)
+	}
+	if ruuo.mutation.CompletedAtCleared() {
+		_spec.ClearField(resumableupload.FieldCompletedAt, field.TypeTime)
+	}
+	if ruuo.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   resumableupload.OwnerTable,
+			Columns: []string{resumableupload.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruuo.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   resumableupload.OwnerTable,
+			Columns: []string{resumableupload.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ResumableUpload{config: ruuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ruuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{resumableupload.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ruuo.mutation.done = true
+	return _node, nil
+}
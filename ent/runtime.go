@@ -5,11 +5,30 @@ package ent
 import (
 	"time"
 
+	"github.com/r-scheele/zero/ent/blob"
+	"github.com/r-scheele/zero/ent/blobreference"
+	"github.com/r-scheele/zero/ent/choice"
+	"github.com/r-scheele/zero/ent/document"
+	"github.com/r-scheele/zero/ent/folder"
+	"github.com/r-scheele/zero/ent/groupinvite"
+	"github.com/r-scheele/zero/ent/groupjoinrequest"
+	"github.com/r-scheele/zero/ent/groupmembership"
 	"github.com/r-scheele/zero/ent/note"
+	"github.com/r-scheele/zero/ent/notebook"
 	"github.com/r-scheele/zero/ent/notelike"
+	"github.com/r-scheele/zero/ent/notelink"
+	"github.com/r-scheele/zero/ent/noteproposal"
 	"github.com/r-scheele/zero/ent/noterepost"
+	"github.com/r-scheele/zero/ent/noterevision"
 	"github.com/r-scheele/zero/ent/passwordtoken"
+	"github.com/r-scheele/zero/ent/question"
+	"github.com/r-scheele/zero/ent/quiz"
+	"github.com/r-scheele/zero/ent/quizattempt"
+	"github.com/r-scheele/zero/ent/resumableupload"
 	"github.com/r-scheele/zero/ent/schema"
+	"github.com/r-scheele/zero/ent/studyevent"
+	"github.com/r-scheele/zero/ent/studygroup"
+	"github.com/r-scheele/zero/ent/tag"
 	"github.com/r-scheele/zero/ent/user"
 )
 
@@ -17,6 +36,144 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	blobFields := schema.Blob{}.Fields()
+	_ = blobFields
+	// blobDescHash is the schema descriptor for hash field.
+	blobDescHash := blobFields[0].Descriptor()
+	// blob.HashValidator is a validator for the "hash" field. It is called by the builders before save.
+	blob.HashValidator = blobDescHash.Validators[0].(func(string) error)
+	// blobDescStorageKey is the schema descriptor for storage_key field.
+	blobDescStorageKey := blobFields[1].Descriptor()
+	// blob.StorageKeyValidator is a validator for the "storage_key" field. It is called by the builders before save.
+	blob.StorageKeyValidator = blobDescStorageKey.Validators[0].(func(string) error)
+	// blobDescSize is the schema descriptor for size field.
+	blobDescSize := blobFields[3].Descriptor()
+	// blob.DefaultSize holds the default value on creation for the size field.
+	blob.DefaultSize = blobDescSize.Default.(int64)
+	// blob.SizeValidator is a validator for the "size" field. It is called by the builders before save.
+	blob.SizeValidator = blobDescSize.Validators[0].(func(int64) error)
+	// blobDescCreatedAt is the schema descriptor for created_at field.
+	blobDescCreatedAt := blobFields[4].Descriptor()
+	// blob.DefaultCreatedAt holds the default value on creation for the created_at field.
+	blob.DefaultCreatedAt = blobDescCreatedAt.Default.(func() time.Time)
+	blobreferenceFields := schema.BlobReference{}.Fields()
+	_ = blobreferenceFields
+	// blobreferenceDescSize is the schema descriptor for size field.
+	blobreferenceDescSize := blobreferenceFields[2].Descriptor()
+	// blobreference.DefaultSize holds the default value on creation for the size field.
+	blobreference.DefaultSize = blobreferenceDescSize.Default.(int64)
+	// blobreference.SizeValidator is a validator for the "size" field. It is called by the builders before save.
+	blobreference.SizeValidator = blobreferenceDescSize.Validators[0].(func(int64) error)
+	// blobreferenceDescCreatedAt is the schema descriptor for created_at field.
+	blobreferenceDescCreatedAt := blobreferenceFields[3].Descriptor()
+	// blobreference.DefaultCreatedAt holds the default value on creation for the created_at field.
+	blobreference.DefaultCreatedAt = blobreferenceDescCreatedAt.Default.(func() time.Time)
+	choiceFields := schema.Choice{}.Fields()
+	_ = choiceFields
+	// choiceDescText is the schema descriptor for text field.
+	choiceDescText := choiceFields[0].Descriptor()
+	// choice.TextValidator is a validator for the "text" field. It is called by the builders before save.
+	choice.TextValidator = choiceDescText.Validators[0].(func(string) error)
+	// choiceDescCorrect is the schema descriptor for correct field.
+	choiceDescCorrect := choiceFields[1].Descriptor()
+	// choice.DefaultCorrect holds the default value on creation for the correct field.
+	choice.DefaultCorrect = choiceDescCorrect.Default.(bool)
+	// choiceDescPosition is the schema descriptor for position field.
+	choiceDescPosition := choiceFields[2].Descriptor()
+	// choice.DefaultPosition holds the default value on creation for the position field.
+	choice.DefaultPosition = choiceDescPosition.Default.(int)
+	documentFields := schema.Document{}.Fields()
+	_ = documentFields
+	// documentDescTitle is the schema descriptor for title field.
+	documentDescTitle := documentFields[0].Descriptor()
+	// document.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	document.TitleValidator = documentDescTitle.Validators[0].(func(string) error)
+	// documentDescFileName is the schema descriptor for file_name field.
+	documentDescFileName := documentFields[2].Descriptor()
+	// document.FileNameValidator is a validator for the "file_name" field. It is called by the builders before save.
+	document.FileNameValidator = documentDescFileName.Validators[0].(func(string) error)
+	// documentDescStorageKey is the schema descriptor for storage_key field.
+	documentDescStorageKey := documentFields[3].Descriptor()
+	// document.StorageKeyValidator is a validator for the "storage_key" field. It is called by the builders before save.
+	document.StorageKeyValidator = documentDescStorageKey.Validators[0].(func(string) error)
+	// documentDescSize is the schema descriptor for size field.
+	documentDescSize := documentFields[5].Descriptor()
+	// document.DefaultSize holds the default value on creation for the size field.
+	document.DefaultSize = documentDescSize.Default.(int64)
+	// document.SizeValidator is a validator for the "size" field. It is called by the builders before save.
+	document.SizeValidator = documentDescSize.Validators[0].(func(int64) error)
+	// documentDescResourceType is the schema descriptor for resource_type field.
+	documentDescResourceType := documentFields[6].Descriptor()
+	// document.DefaultResourceType holds the default value on creation for the resource_type field.
+	document.DefaultResourceType = documentDescResourceType.Default.(string)
+	// documentDescCreatedAt is the schema descriptor for created_at field.
+	documentDescCreatedAt := documentFields[9].Descriptor()
+	// document.DefaultCreatedAt holds the default value on creation for the created_at field.
+	document.DefaultCreatedAt = documentDescCreatedAt.Default.(func() time.Time)
+	// documentDescUpdatedAt is the schema descriptor for updated_at field.
+	documentDescUpdatedAt := documentFields[10].Descriptor()
+	// document.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	document.DefaultUpdatedAt = documentDescUpdatedAt.Default.(func() time.Time)
+	// document.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	document.UpdateDefaultUpdatedAt = documentDescUpdatedAt.UpdateDefault.(func() time.Time)
+	folderFields := schema.Folder{}.Fields()
+	_ = folderFields
+	// folderDescName is the schema descriptor for name field.
+	folderDescName := folderFields[0].Descriptor()
+	// folder.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	folder.NameValidator = func() func(string) error {
+		validators := folderDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// folderDescCreatedAt is the schema descriptor for created_at field.
+	folderDescCreatedAt := folderFields[1].Descriptor()
+	// folder.DefaultCreatedAt holds the default value on creation for the created_at field.
+	folder.DefaultCreatedAt = folderDescCreatedAt.Default.(func() time.Time)
+	groupinviteFields := schema.GroupInvite{}.Fields()
+	_ = groupinviteFields
+	// groupinviteDescToken is the schema descriptor for token field.
+	groupinviteDescToken := groupinviteFields[0].Descriptor()
+	// groupinvite.TokenValidator is a validator for the "token" field. It is called by the builders before save.
+	groupinvite.TokenValidator = groupinviteDescToken.Validators[0].(func(string) error)
+	// groupinviteDescMaxUses is the schema descriptor for max_uses field.
+	groupinviteDescMaxUses := groupinviteFields[2].Descriptor()
+	// groupinvite.DefaultMaxUses holds the default value on creation for the max_uses field.
+	groupinvite.DefaultMaxUses = groupinviteDescMaxUses.Default.(int)
+	// groupinvite.MaxUsesValidator is a validator for the "max_uses" field. It is called by the builders before save.
+	groupinvite.MaxUsesValidator = groupinviteDescMaxUses.Validators[0].(func(int) error)
+	// groupinviteDescUses is the schema descriptor for uses field.
+	groupinviteDescUses := groupinviteFields[3].Descriptor()
+	// groupinvite.DefaultUses holds the default value on creation for the uses field.
+	groupinvite.DefaultUses = groupinviteDescUses.Default.(int)
+	// groupinvite.UsesValidator is a validator for the "uses" field. It is called by the builders before save.
+	groupinvite.UsesValidator = groupinviteDescUses.Validators[0].(func(int) error)
+	// groupinviteDescCreatedAt is the schema descriptor for created_at field.
+	groupinviteDescCreatedAt := groupinviteFields[6].Descriptor()
+	// groupinvite.DefaultCreatedAt holds the default value on creation for the created_at field.
+	groupinvite.DefaultCreatedAt = groupinviteDescCreatedAt.Default.(func() time.Time)
+	groupjoinrequestFields := schema.GroupJoinRequest{}.Fields()
+	_ = groupjoinrequestFields
+	// groupjoinrequestDescCreatedAt is the schema descriptor for created_at field.
+	groupjoinrequestDescCreatedAt := groupjoinrequestFields[1].Descriptor()
+	// groupjoinrequest.DefaultCreatedAt holds the default value on creation for the created_at field.
+	groupjoinrequest.DefaultCreatedAt = groupjoinrequestDescCreatedAt.Default.(func() time.Time)
+	groupmembershipFields := schema.GroupMembership{}.Fields()
+	_ = groupmembershipFields
+	// groupmembershipDescJoinedAt is the schema descriptor for joined_at field.
+	groupmembershipDescJoinedAt := groupmembershipFields[1].Descriptor()
+	// groupmembership.DefaultJoinedAt holds the default value on creation for the joined_at field.
+	groupmembership.DefaultJoinedAt = groupmembershipDescJoinedAt.Default.(func() time.Time)
 	noteFields := schema.Note{}.Fields()
 	_ = noteFields
 	// noteDescTitle is the schema descriptor for title field.
@@ -43,12 +200,62 @@ func init() {
 	notelikeDescCreatedAt := notelikeFields[0].Descriptor()
 	// notelike.DefaultCreatedAt holds the default value on creation for the created_at field.
 	notelike.DefaultCreatedAt = notelikeDescCreatedAt.Default.(func() time.Time)
+	notelinkFields := schema.NoteLink{}.Fields()
+	_ = notelinkFields
+	// notelinkDescTarget is the schema descriptor for target field.
+	notelinkDescTarget := notelinkFields[0].Descriptor()
+	// notelink.TargetValidator is a validator for the "target" field. It is called by the builders before save.
+	notelink.TargetValidator = notelinkDescTarget.Validators[0].(func(string) error)
+	noteproposalFields := schema.NoteProposal{}.Fields()
+	_ = noteproposalFields
+	// noteproposalDescTitle is the schema descriptor for title field.
+	noteproposalDescTitle := noteproposalFields[0].Descriptor()
+	// noteproposal.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	noteproposal.TitleValidator = noteproposalDescTitle.Validators[0].(func(string) error)
+	// noteproposalDescCreatedAt is the schema descriptor for created_at field.
+	noteproposalDescCreatedAt := noteproposalFields[7].Descriptor()
+	// noteproposal.DefaultCreatedAt holds the default value on creation for the created_at field.
+	noteproposal.DefaultCreatedAt = noteproposalDescCreatedAt.Default.(func() time.Time)
 	noterepostFields := schema.NoteRepost{}.Fields()
 	_ = noterepostFields
 	// noterepostDescCreatedAt is the schema descriptor for created_at field.
 	noterepostDescCreatedAt := noterepostFields[1].Descriptor()
 	// noterepost.DefaultCreatedAt holds the default value on creation for the created_at field.
 	noterepost.DefaultCreatedAt = noterepostDescCreatedAt.Default.(func() time.Time)
+	noterevisionFields := schema.NoteRevision{}.Fields()
+	_ = noterevisionFields
+	// noterevisionDescTitle is the schema descriptor for title field.
+	noterevisionDescTitle := noterevisionFields[0].Descriptor()
+	// noterevision.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	noterevision.TitleValidator = noterevisionDescTitle.Validators[0].(func(string) error)
+	// noterevisionDescCreatedAt is the schema descriptor for created_at field.
+	noterevisionDescCreatedAt := noterevisionFields[4].Descriptor()
+	// noterevision.DefaultCreatedAt holds the default value on creation for the created_at field.
+	noterevision.DefaultCreatedAt = noterevisionDescCreatedAt.Default.(func() time.Time)
+	notebookFields := schema.Notebook{}.Fields()
+	_ = notebookFields
+	// notebookDescName is the schema descriptor for name field.
+	notebookDescName := notebookFields[0].Descriptor()
+	// notebook.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	notebook.NameValidator = func() func(string) error {
+		validators := notebookDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// notebookDescCreatedAt is the schema descriptor for created_at field.
+	notebookDescCreatedAt := notebookFields[3].Descriptor()
+	// notebook.DefaultCreatedAt holds the default value on creation for the created_at field.
+	notebook.DefaultCreatedAt = notebookDescCreatedAt.Default.(func() time.Time)
 	passwordtokenFields := schema.PasswordToken{}.Fields()
 	_ = passwordtokenFields
 	// passwordtokenDescToken is the schema descriptor for token field.
@@ -59,6 +266,140 @@ func init() {
 	passwordtokenDescCreatedAt := passwordtokenFields[2].Descriptor()
 	// passwordtoken.DefaultCreatedAt holds the default value on creation for the created_at field.
 	passwordtoken.DefaultCreatedAt = passwordtokenDescCreatedAt.Default.(func() time.Time)
+	questionFields := schema.Question{}.Fields()
+	_ = questionFields
+	// questionDescPrompt is the schema descriptor for prompt field.
+	questionDescPrompt := questionFields[1].Descriptor()
+	// question.PromptValidator is a validator for the "prompt" field. It is called by the builders before save.
+	question.PromptValidator = questionDescPrompt.Validators[0].(func(string) error)
+	// questionDescPoints is the schema descriptor for points field.
+	questionDescPoints := questionFields[4].Descriptor()
+	// question.DefaultPoints holds the default value on creation for the points field.
+	question.DefaultPoints = questionDescPoints.Default.(int)
+	// question.PointsValidator is a validator for the "points" field. It is called by the builders before save.
+	question.PointsValidator = questionDescPoints.Validators[0].(func(int) error)
+	// questionDescPosition is the schema descriptor for position field.
+	questionDescPosition := questionFields[5].Descriptor()
+	// question.DefaultPosition holds the default value on creation for the position field.
+	question.DefaultPosition = questionDescPosition.Default.(int)
+	quizFields := schema.Quiz{}.Fields()
+	_ = quizFields
+	// quizDescTitle is the schema descriptor for title field.
+	quizDescTitle := quizFields[0].Descriptor()
+	// quiz.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	quiz.TitleValidator = quizDescTitle.Validators[0].(func(string) error)
+	// quizDescTimeLimit is the schema descriptor for time_limit field.
+	quizDescTimeLimit := quizFields[3].Descriptor()
+	// quiz.DefaultTimeLimit holds the default value on creation for the time_limit field.
+	quiz.DefaultTimeLimit = quizDescTimeLimit.Default.(int)
+	// quiz.TimeLimitValidator is a validator for the "time_limit" field. It is called by the builders before save.
+	quiz.TimeLimitValidator = quizDescTimeLimit.Validators[0].(func(int) error)
+	// quizDescMaxAttempts is the schema descriptor for max_attempts field.
+	quizDescMaxAttempts := quizFields[4].Descriptor()
+	// quiz.DefaultMaxAttempts holds the default value on creation for the max_attempts field.
+	quiz.DefaultMaxAttempts = quizDescMaxAttempts.Default.(int)
+	// quiz.MaxAttemptsValidator is a validator for the "max_attempts" field. It is called by the builders before save.
+	quiz.MaxAttemptsValidator = quizDescMaxAttempts.Validators[0].(func(int) error)
+	// quizDescCreatedAt is the schema descriptor for created_at field.
+	quizDescCreatedAt := quizFields[6].Descriptor()
+	// quiz.DefaultCreatedAt holds the default value on creation for the created_at field.
+	quiz.DefaultCreatedAt = quizDescCreatedAt.Default.(func() time.Time)
+	// quizDescUpdatedAt is the schema descriptor for updated_at field.
+	quizDescUpdatedAt := quizFields[7].Descriptor()
+	// quiz.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	quiz.DefaultUpdatedAt = quizDescUpdatedAt.Default.(func() time.Time)
+	// quiz.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	quiz.UpdateDefaultUpdatedAt = quizDescUpdatedAt.UpdateDefault.(func() time.Time)
+	quizattemptFields := schema.QuizAttempt{}.Fields()
+	_ = quizattemptFields
+	// quizattemptDescScore is the schema descriptor for score field.
+	quizattemptDescScore := quizattemptFields[1].Descriptor()
+	// quizattempt.DefaultScore holds the default value on creation for the score field.
+	quizattempt.DefaultScore = quizattemptDescScore.Default.(int)
+	// quizattemptDescMaxScore is the schema descriptor for max_score field.
+	quizattemptDescMaxScore := quizattemptFields[2].Descriptor()
+	// quizattempt.DefaultMaxScore holds the default value on creation for the max_score field.
+	quizattempt.DefaultMaxScore = quizattemptDescMaxScore.Default.(int)
+	// quizattemptDescStartedAt is the schema descriptor for started_at field.
+	quizattemptDescStartedAt := quizattemptFields[3].Descriptor()
+	// quizattempt.DefaultStartedAt holds the default value on creation for the started_at field.
+	quizattempt.DefaultStartedAt = quizattemptDescStartedAt.Default.(func() time.Time)
+	resumableuploadFields := schema.ResumableUpload{}.Fields()
+	_ = resumableuploadFields
+	// resumableuploadDescToken is the schema descriptor for token field.
+	resumableuploadDescToken := resumableuploadFields[0].Descriptor()
+	// resumableupload.TokenValidator is a validator for the "token" field. It is called by the builders before save.
+	resumableupload.TokenValidator = resumableuploadDescToken.Validators[0].(func(string) error)
+	// resumableuploadDescFileName is the schema descriptor for file_name field.
+	resumableuploadDescFileName := resumableuploadFields[2].Descriptor()
+	// resumableupload.FileNameValidator is a validator for the "file_name" field. It is called by the builders before save.
+	resumableupload.FileNameValidator = resumableuploadDescFileName.Validators[0].(func(string) error)
+	// resumableuploadDescSize is the schema descriptor for size field.
+	resumableuploadDescSize := resumableuploadFields[4].Descriptor()
+	// resumableupload.SizeValidator is a validator for the "size" field. It is called by the builders before save.
+	resumableupload.SizeValidator = resumableuploadDescSize.Validators[0].(func(int64) error)
+	// resumableuploadDescOffset is the schema descriptor for offset field.
+	resumableuploadDescOffset := resumableuploadFields[5].Descriptor()
+	// resumableupload.DefaultOffset holds the default value on creation for the offset field.
+	resumableupload.DefaultOffset = resumableuploadDescOffset.Default.(int64)
+	// resumableupload.OffsetValidator is a validator for the "offset" field. It is called by the builders before save.
+	resumableupload.OffsetValidator = resumableuploadDescOffset.Validators[0].(func(int64) error)
+	// resumableuploadDescCreatedAt is the schema descriptor for created_at field.
+	resumableuploadDescCreatedAt := resumableuploadFields[8].Descriptor()
+	// resumableupload.DefaultCreatedAt holds the default value on creation for the created_at field.
+	resumableupload.DefaultCreatedAt = resumableuploadDescCreatedAt.Default.(func() time.Time)
+	studyeventFields := schema.StudyEvent{}.Fields()
+	_ = studyeventFields
+	// studyeventDescDurationSeconds is the schema descriptor for duration_seconds field.
+	studyeventDescDurationSeconds := studyeventFields[4].Descriptor()
+	// studyevent.DefaultDurationSeconds holds the default value on creation for the duration_seconds field.
+	studyevent.DefaultDurationSeconds = studyeventDescDurationSeconds.Default.(int)
+	// studyevent.DurationSecondsValidator is a validator for the "duration_seconds" field. It is called by the builders before save.
+	studyevent.DurationSecondsValidator = studyeventDescDurationSeconds.Validators[0].(func(int) error)
+	// studyeventDescOccurredAt is the schema descriptor for occurred_at field.
+	studyeventDescOccurredAt := studyeventFields[5].Descriptor()
+	// studyevent.DefaultOccurredAt holds the default value on creation for the occurred_at field.
+	studyevent.DefaultOccurredAt = studyeventDescOccurredAt.Default.(func() time.Time)
+	studygroupFields := schema.StudyGroup{}.Fields()
+	_ = studygroupFields
+	// studygroupDescName is the schema descriptor for name field.
+	studygroupDescName := studygroupFields[0].Descriptor()
+	// studygroup.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	studygroup.NameValidator = studygroupDescName.Validators[0].(func(string) error)
+	// studygroupDescCreatedAt is the schema descriptor for created_at field.
+	studygroupDescCreatedAt := studygroupFields[3].Descriptor()
+	// studygroup.DefaultCreatedAt holds the default value on creation for the created_at field.
+	studygroup.DefaultCreatedAt = studygroupDescCreatedAt.Default.(func() time.Time)
+	// studygroupDescUpdatedAt is the schema descriptor for updated_at field.
+	studygroupDescUpdatedAt := studygroupFields[4].Descriptor()
+	// studygroup.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	studygroup.DefaultUpdatedAt = studygroupDescUpdatedAt.Default.(func() time.Time)
+	// studygroup.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	studygroup.UpdateDefaultUpdatedAt = studygroupDescUpdatedAt.UpdateDefault.(func() time.Time)
+	tagFields := schema.Tag{}.Fields()
+	_ = tagFields
+	// tagDescName is the schema descriptor for name field.
+	tagDescName := tagFields[0].Descriptor()
+	// tag.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	tag.NameValidator = func() func(string) error {
+		validators := tagDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// tagDescCreatedAt is the schema descriptor for created_at field.
+	tagDescCreatedAt := tagFields[1].Descriptor()
+	// tag.DefaultCreatedAt holds the default value on creation for the created_at field.
+	tag.DefaultCreatedAt = tagDescCreatedAt.Default.(func() time.Time)
 	userFields := schema.User{}.Fields()
 	_ = userFields
 	// userDescName is the schema descriptor for name field.
@@ -119,12 +460,20 @@ func init() {
 	userDescIsActive := userFields[13].Descriptor()
 	// user.DefaultIsActive holds the default value on creation for the is_active field.
 	user.DefaultIsActive = userDescIsActive.Default.(bool)
+	// userDescStorageQuota is the schema descriptor for storage_quota field.
+	userDescStorageQuota := userFields[15].Descriptor()
+	// user.StorageQuotaValidator is a validator for the "storage_quota" field. It is called by the builders before save.
+	user.StorageQuotaValidator = userDescStorageQuota.Validators[0].(func(int64) error)
+	// userDescStorageUsed is the schema descriptor for storage_used field.
+	userDescStorageUsed := userFields[16].Descriptor()
+	// user.DefaultStorageUsed holds the default value on creation for the storage_used field.
+	user.DefaultStorageUsed = userDescStorageUsed.Default.(int64)
 	// userDescCreatedAt is the schema descriptor for created_at field.
-	userDescCreatedAt := userFields[15].Descriptor()
+	userDescCreatedAt := userFields[17].Descriptor()
 	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
 	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
 	// userDescUpdatedAt is the schema descriptor for updated_at field.
-	userDescUpdatedAt := userFields[16].Descriptor()
+	userDescUpdatedAt := userFields[18].Descriptor()
 	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
 	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
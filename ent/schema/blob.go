@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Blob holds the schema definition for the Blob entity, an uploaded file stored once no matter
+// how many times the same content is uploaded.
+type Blob struct {
+	ent.Schema
+}
+
+// Fields of the Blob.
+func (Blob) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("hash").
+			NotEmpty().
+			Unique().
+			Immutable().
+			Comment("Hex-encoded SHA-256 of the content"),
+		field.String("storage_key").
+			NotEmpty().
+			Unique().
+			Immutable().
+			Comment("Key of the file in the storage backend, derived from the hash"),
+		field.String("mime_type").
+			Optional(),
+		field.Int64("size").
+			Default(0).
+			NonNegative().
+			Comment("File size in bytes"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the Blob.
+func (Blob) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("references", BlobReference.Type).
+			Comment("Notes, documents and profiles using the file; it is deleted with the last one"),
+	}
+}
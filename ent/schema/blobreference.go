@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// BlobReference holds the schema definition for the BlobReference entity, a use of a stored
+// file by a note, document or profile, charged to the user who uploaded it.
+type BlobReference struct {
+	ent.Schema
+}
+
+// Fields of the BlobReference.
+func (BlobReference) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("kind").
+			Values("note", "document", "profile").
+			Comment("What kind of thing uses the file"),
+		field.Int("target_id").
+			Comment("ID of the note, document or user using the file"),
+		field.Int64("size").
+			Default(0).
+			NonNegative().
+			Comment("Bytes charged to the uploader for this use of the file"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the BlobReference.
+func (BlobReference) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("blob", Blob.Type).
+			Ref("references").
+			Unique().
+			Required(),
+		edge.From("owner", User.Type).
+			Ref("blob_references").
+			Unique().
+			Required().
+			Comment("User who uploaded the file"),
+	}
+}
+
+// Indexes of the BlobReference.
+func (BlobReference) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("kind", "target_id"),
+	}
+}
@@ -0,0 +1,35 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Choice holds the schema definition for the Choice entity.
+type Choice struct {
+	ent.Schema
+}
+
+// Fields of the Choice.
+func (Choice) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("text").
+			NotEmpty(),
+		field.Bool("correct").
+			Default(false),
+		field.Int("position").
+			Default(0).
+			Comment("Order of the choice within the question"),
+	}
+}
+
+// Edges of the Choice.
+func (Choice) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("question", Question.Type).
+			Ref("choices").
+			Unique().
+			Required(),
+	}
+}
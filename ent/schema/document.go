@@ -0,0 +1,76 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Document holds the schema definition for the Document entity.
+type Document struct {
+	ent.Schema
+}
+
+// Fields of the Document.
+func (Document) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("title").
+			NotEmpty().
+			Comment("Title of the document, defaulting to the file name"),
+		field.Text("description").
+			Optional(),
+		field.String("file_name").
+			NotEmpty().
+			Comment("Original name of the uploaded file"),
+		field.String("storage_key").
+			NotEmpty().
+			Comment("Key of the file in the storage backend, shared by documents with the same content"),
+		field.String("mime_type").
+			Optional(),
+		field.Int64("size").
+			Default(0).
+			NonNegative().
+			Comment("File size in bytes"),
+		field.String("resource_type").
+			Default("file").
+			Comment("Kind of file, using the same types as note resources"),
+		field.Strings("tags").
+			Optional(),
+		field.Enum("visibility").
+			Values("private", "public").
+			Default("private"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Document.
+func (Document) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", User.Type).
+			Ref("documents").
+			Unique().
+			Required(),
+		edge.From("folder", Folder.Type).
+			Ref("documents").
+			Unique().
+			Comment("Folder the document is filed in, or none for the top level"),
+		edge.To("notes", Note.Type).
+			Comment("Notes the document is attached to"),
+	}
+}
+
+// Indexes of the Document.
+func (Document) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("owner").
+			Fields("created_at"),
+	}
+}
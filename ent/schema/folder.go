@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Folder holds the schema definition for the Folder entity.
+type Folder struct {
+	ent.Schema
+}
+
+// Fields of the Folder.
+func (Folder) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty().
+			MaxLen(100),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the Folder.
+func (Folder) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", User.Type).
+			Ref("folders").
+			Unique().
+			Required(),
+		edge.To("children", Folder.Type).
+			From("parent").
+			Unique().
+			Comment("Folders nest, with no parent at the top level"),
+		edge.To("documents", Document.Type),
+	}
+}
@@ -0,0 +1,65 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// GroupInvite holds the schema definition for the GroupInvite entity.
+type GroupInvite struct {
+	ent.Schema
+}
+
+// Fields of the GroupInvite.
+func (GroupInvite) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("token").
+			NotEmpty().
+			Unique().
+			Immutable().
+			Sensitive().
+			Comment("Unique token used in the invite link and WhatsApp reply buttons"),
+		field.String("phone_number").
+			Optional().
+			Comment("If set, only the user with this phone number can accept the invite"),
+		field.Int("max_uses").
+			Default(0).
+			NonNegative().
+			Comment("Maximum number of times the invite can be accepted, 0 for unlimited"),
+		field.Int("uses").
+			Default(0).
+			NonNegative(),
+		field.Time("expires_at"),
+		field.Time("revoked_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the GroupInvite.
+func (GroupInvite) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("group", StudyGroup.Type).
+			Ref("invites").
+			Unique().
+			Required(),
+		edge.From("creator", User.Type).
+			Ref("group_invites").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the GroupInvite.
+func (GroupInvite) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("expires_at"),
+	}
+}
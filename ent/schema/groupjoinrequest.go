@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// GroupJoinRequest holds the schema definition for the GroupJoinRequest entity.
+type GroupJoinRequest struct {
+	ent.Schema
+}
+
+// Fields of the GroupJoinRequest.
+func (GroupJoinRequest) Fields() []ent.Field {
+	return []ent.Field{
+		field.Text("message").
+			Optional().
+			Comment("Optional message to the group's owners"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the GroupJoinRequest.
+func (GroupJoinRequest) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("group_join_requests").
+			Unique().
+			Required(),
+		edge.From("group", StudyGroup.Type).
+			Ref("join_requests").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the GroupJoinRequest.
+func (GroupJoinRequest) Indexes() []ent.Index {
+	return []ent.Index{
+		// A user can only have one pending request per group
+		index.Edges("user", "group").
+			Unique(),
+	}
+}
@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// GroupMembership holds the schema definition for the GroupMembership entity.
+type GroupMembership struct {
+	ent.Schema
+}
+
+// Fields of the GroupMembership.
+func (GroupMembership) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("role").
+			Values("owner", "moderator", "member").
+			Default("member").
+			Comment("Role of the user within the group"),
+		field.Time("joined_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the GroupMembership.
+func (GroupMembership) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("group_memberships").
+			Unique().
+			Required(),
+		edge.From("group", StudyGroup.Type).
+			Ref("memberships").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the GroupMembership.
+func (GroupMembership) Indexes() []ent.Index {
+	return []ent.Index{
+		// A user can only be a member of a group once
+		index.Edges("user", "group").
+			Unique(),
+	}
+}
@@ -65,6 +65,27 @@ func (Note) Edges() []ent.Edge {
 			Required(),
 		edge.To("likes", NoteLike.Type),
 		edge.To("reposts", NoteRepost.Type),
+		edge.To("practice_sets", Quiz.Type),
+		edge.To("study_events", StudyEvent.Type),
+		edge.From("groups", StudyGroup.Type).
+			Ref("shared_notes").
+			Comment("Study groups the note is shared into"),
+		edge.From("documents", Document.Type).
+			Ref("notes").
+			Comment("Library documents attached to the note"),
+		edge.To("proposals", NoteProposal.Type).
+			Comment("Changes proposed by other users, for notes that need the owner's approval"),
+		edge.To("revisions", NoteRevision.Type).
+			Comment("Snapshots of the note taken each time it's saved"),
+		edge.From("notebooks", Notebook.Type).
+			Ref("notes").
+			Comment("Notebooks the note is filed in"),
+		edge.From("tags", Tag.Type).
+			Ref("notes"),
+		edge.To("links", NoteLink.Type).
+			Comment("Wiki links written in the note's content"),
+		edge.To("backlinks", NoteLink.Type).
+			Comment("Wiki links in other notes that point to this one"),
 	}
 }
 
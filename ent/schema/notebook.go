@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Notebook holds the schema definition for the Notebook entity.
+type Notebook struct {
+	ent.Schema
+}
+
+// Fields of the Notebook.
+func (Notebook) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty().
+			MaxLen(100),
+		field.Enum("default_visibility").
+			Values("private", "public").
+			Default("private").
+			Comment("Visibility given to notes created in the notebook"),
+		field.Enum("default_permission_level").
+			Values("read_only", "read_write", "read_write_approval").
+			Default("read_only").
+			Comment("Permission level given to notes created in the notebook"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the Notebook.
+func (Notebook) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", User.Type).
+			Ref("notebooks").
+			Unique().
+			Required(),
+		edge.To("children", Notebook.Type).
+			From("parent").
+			Unique().
+			Comment("Notebooks nest, with no parent at the top level"),
+		edge.To("notes", Note.Type),
+	}
+}
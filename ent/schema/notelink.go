@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// NoteLink holds the schema definition for the NoteLink entity.
+type NoteLink struct {
+	ent.Schema
+}
+
+// Fields of the NoteLink.
+func (NoteLink) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("target").
+			NotEmpty().
+			Comment("Note title or note:ID the link was written with, between [[ and ]]"),
+	}
+}
+
+// Edges of the NoteLink.
+func (NoteLink) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("source", Note.Type).
+			Ref("links").
+			Unique().
+			Required(),
+		edge.From("note", Note.Type).
+			Ref("backlinks").
+			Unique().
+			Comment("Note the link points to, or none when the link is broken"),
+	}
+}
+
+// Indexes of the NoteLink.
+func (NoteLink) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("target"),
+	}
+}
@@ -0,0 +1,70 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// NoteProposal holds the schema definition for the NoteProposal entity.
+type NoteProposal struct {
+	ent.Schema
+}
+
+// Fields of the NoteProposal.
+func (NoteProposal) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("title").
+			NotEmpty().
+			Comment("Proposed title of the note"),
+		field.Text("description").
+			Optional().
+			Comment("Proposed description of the note"),
+		field.Text("content").
+			Optional().
+			Comment("Proposed content of the note"),
+		field.Text("base_title").
+			Optional().
+			Comment("Title of the note when the change was proposed"),
+		field.Text("base_description").
+			Optional().
+			Comment("Description of the note when the change was proposed"),
+		field.Text("base_content").
+			Optional().
+			Comment("Content of the note when the change was proposed, which the change is shown against"),
+		field.Enum("status").
+			Values("pending", "accepted", "rejected").
+			Default("pending"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("reviewed_at").
+			Optional().
+			Nillable().
+			Comment("When the owner accepted or rejected the change"),
+	}
+}
+
+// Edges of the NoteProposal.
+func (NoteProposal) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("note", Note.Type).
+			Ref("proposals").
+			Unique().
+			Required(),
+		edge.From("proposer", User.Type).
+			Ref("note_proposals").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the NoteProposal.
+func (NoteProposal) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "created_at"),
+	}
+}
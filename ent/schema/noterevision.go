@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/r-scheele/zero/pkg/types"
+)
+
+// NoteRevision holds the schema definition for the NoteRevision entity.
+type NoteRevision struct {
+	ent.Schema
+}
+
+// Fields of the NoteRevision.
+func (NoteRevision) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("title").
+			NotEmpty().
+			Comment("Title of the note when it was saved"),
+		field.Text("description").
+			Optional().
+			Comment("Description of the note when it was saved"),
+		field.Text("content").
+			Optional().
+			Comment("Content of the note when it was saved"),
+		field.JSON("resources", []types.Resource{}).
+			Optional().
+			Comment("Resources attached to the note when it was saved"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the NoteRevision.
+func (NoteRevision) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("note", Note.Type).
+			Ref("revisions").
+			Unique().
+			Required(),
+		edge.From("author", User.Type).
+			Ref("note_revisions").
+			Unique().
+			Comment("User who saved the revision, if their account still exists"),
+	}
+}
+
+// Indexes of the NoteRevision.
+func (NoteRevision) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("note").
+			Fields("created_at"),
+	}
+}
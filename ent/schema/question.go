@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Question holds the schema definition for the Question entity.
+type Question struct {
+	ent.Schema
+}
+
+// Fields of the Question.
+func (Question) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("type").
+			Values("multiple_choice", "true_false", "short_answer").
+			Comment("How the question is answered and graded"),
+		field.Text("prompt").
+			NotEmpty().
+			Comment("The question text shown to the student"),
+		field.JSON("accepted_answers", []string{}).
+			Optional().
+			Comment("Accepted answers for short answer questions"),
+		field.Text("explanation").
+			Optional().
+			Comment("Optional explanation shown after grading"),
+		field.Int("points").
+			Default(1).
+			Positive(),
+		field.Int("position").
+			Default(0).
+			Comment("Order of the question within the quiz"),
+	}
+}
+
+// Edges of the Question.
+func (Question) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("quiz", Quiz.Type).
+			Ref("questions").
+			Unique().
+			Required(),
+		edge.To("choices", Choice.Type),
+	}
+}
@@ -0,0 +1,72 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Quiz holds the schema definition for the Quiz entity.
+type Quiz struct {
+	ent.Schema
+}
+
+// Fields of the Quiz.
+func (Quiz) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("title").
+			NotEmpty().
+			Comment("Title of the quiz"),
+		field.Text("description").
+			Optional().
+			Comment("Brief description of the quiz"),
+		field.Enum("visibility").
+			Values("private", "public").
+			Default("private").
+			Comment("Quiz visibility setting"),
+		field.Int("time_limit").
+			Default(0).
+			NonNegative().
+			Comment("Time limit for an attempt in minutes, 0 for no limit"),
+		field.Int("max_attempts").
+			Default(0).
+			NonNegative().
+			Comment("Maximum number of attempts per user, 0 for unlimited"),
+		field.String("generator").
+			Optional().
+			Comment("Name of the generator that built this quiz from a note, if any"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Quiz.
+func (Quiz) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", User.Type).
+			Ref("quizzes").
+			Unique().
+			Required(),
+		edge.From("note", Note.Type).
+			Ref("practice_sets").
+			Unique().
+			Comment("Note this practice set was generated from"),
+		edge.To("questions", Question.Type),
+		edge.To("attempts", QuizAttempt.Type),
+		edge.To("study_events", StudyEvent.Type),
+	}
+}
+
+// Indexes of the Quiz.
+func (Quiz) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("visibility", "created_at"),
+	}
+}
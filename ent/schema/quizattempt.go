@@ -0,0 +1,74 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// QuizAttempt holds the schema definition for the QuizAttempt entity.
+type QuizAttempt struct {
+	ent.Schema
+}
+
+// Fields of the QuizAttempt.
+func (QuizAttempt) Fields() []ent.Field {
+	return []ent.Field{
+		field.JSON("answers", map[int]string{}).
+			Optional().
+			Comment("Saved answers keyed by question ID"),
+		field.Int("score").
+			Default(0).
+			Comment("Points earned"),
+		field.Int("max_score").
+			Default(0).
+			Comment("Points available when the attempt was graded"),
+		field.Time("started_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("deadline").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("Time by which the attempt must be submitted, if the quiz is timed"),
+		field.Time("submitted_at").
+			Optional().
+			Nillable(),
+		field.Enum("status").
+			Values("in_progress", "submitted", "expired").
+			Default("in_progress").
+			Comment("Expired attempts were closed at their deadline and graded from the saved answers"),
+	}
+}
+
+// Edges of the QuizAttempt.
+func (QuizAttempt) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("quiz", Quiz.Type).
+			Ref("attempts").
+			Unique().
+			Required(),
+		edge.From("user", User.Type).
+			Ref("quiz_attempts").
+			Unique().
+			Required(),
+		edge.To("study_event", StudyEvent.Type).
+			Unique(),
+	}
+}
+
+// Indexes of the QuizAttempt.
+func (QuizAttempt) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("started_at"),
+		index.Fields("status", "deadline"),
+		// A user has at most one attempt in progress at a quiz, even if they start it twice at once
+		index.Edges("quiz", "user").
+			Unique().
+			Annotations(entsql.IndexWhere("status = 'in_progress'")),
+	}
+}
@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// ResumableUpload holds the schema definition for the ResumableUpload entity, a file being
+// uploaded to a note in chunks that can be resumed after the connection drops.
+type ResumableUpload struct {
+	ent.Schema
+}
+
+// Fields of the ResumableUpload.
+func (ResumableUpload) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("token").
+			NotEmpty().
+			Unique().
+			Immutable().
+			Sensitive().
+			Comment("Random token identifying the upload in its URL"),
+		field.Int("note_id").
+			Comment("ID of the note the file is added to once it's uploaded"),
+		field.String("file_name").
+			NotEmpty(),
+		field.String("mime_type").
+			Optional(),
+		field.Int64("size").
+			NonNegative().
+			Comment("Bytes in the complete file"),
+		field.Int64("offset").
+			Default(0).
+			NonNegative().
+			Comment("Bytes received so far"),
+		field.Time("expires_at").
+			Comment("When the upload is removed if it hasn't been completed"),
+		field.Time("completed_at").
+			Optional().
+			Nillable().
+			Comment("When the file was assembled and queued to be added to the note"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the ResumableUpload.
+func (ResumableUpload) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", User.Type).
+			Ref("resumable_uploads").
+			Unique().
+			Required(),
+	}
+}
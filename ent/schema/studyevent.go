@@ -0,0 +1,69 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// StudyEvent holds the schema definition for the StudyEvent entity.
+type StudyEvent struct {
+	ent.Schema
+}
+
+// Fields of the StudyEvent.
+func (StudyEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("type").
+			Values("note_viewed", "quiz_attempted").
+			Immutable(),
+		field.String("topic").
+			Comment("Title of the note or quiz studied, kept so history survives deletions"),
+		field.Int("score").
+			Optional().
+			Nillable().
+			Comment("Points earned, for quiz attempts"),
+		field.Int("max_score").
+			Optional().
+			Nillable().
+			Comment("Points available, for quiz attempts"),
+		field.Int("duration_seconds").
+			Default(0).
+			NonNegative().
+			Comment("Time spent studying"),
+		field.Time("occurred_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the StudyEvent.
+func (StudyEvent) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("study_events").
+			Unique().
+			Required(),
+		edge.From("note", Note.Type).
+			Ref("study_events").
+			Unique(),
+		edge.From("quiz", Quiz.Type).
+			Ref("study_events").
+			Unique(),
+		edge.From("attempt", QuizAttempt.Type).
+			Ref("study_event").
+			Unique().
+			Comment("The graded attempt, so each attempt is only recorded once"),
+	}
+}
+
+// Indexes of the StudyEvent.
+func (StudyEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("user").
+			Fields("occurred_at"),
+	}
+}
@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// StudyGroup holds the schema definition for the StudyGroup entity.
+type StudyGroup struct {
+	ent.Schema
+}
+
+// Fields of the StudyGroup.
+func (StudyGroup) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty().
+			Comment("Name of the study group"),
+		field.Text("description").
+			Optional().
+			Comment("What the group studies"),
+		field.Enum("privacy").
+			Values("public", "invite_only").
+			Default("public").
+			Comment("Anyone can join public groups; invite-only groups require an approved join request"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the StudyGroup.
+func (StudyGroup) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("memberships", GroupMembership.Type),
+		edge.To("join_requests", GroupJoinRequest.Type),
+		edge.To("invites", GroupInvite.Type),
+		edge.To("shared_notes", Note.Type).
+			Comment("Notes shared into the group, readable by all members"),
+	}
+}
+
+// Indexes of the StudyGroup.
+func (StudyGroup) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("privacy", "created_at"),
+	}
+}
@@ -91,6 +91,14 @@ func (User) Fields() []ent.Field {
 			Optional().
 			Nillable().
 			Comment("Last login timestamp"),
+		field.Int64("storage_quota").
+			Optional().
+			Nillable().
+			NonNegative().
+			Comment("Bytes of uploads the user may store, overriding the configured quota; zero for no limit"),
+		field.Int64("storage_used").
+			Default(0).
+			Comment("Bytes of uploads charged to the user, kept up to date as files are added and removed"),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -110,5 +118,20 @@ func (User) Edges() []ent.Edge {
 		edge.To("notes", Note.Type),
 		edge.To("note_likes", NoteLike.Type),
 		edge.To("note_reposts", NoteRepost.Type),
+		edge.To("quizzes", Quiz.Type),
+		edge.To("quiz_attempts", QuizAttempt.Type),
+		edge.To("group_memberships", GroupMembership.Type),
+		edge.To("group_join_requests", GroupJoinRequest.Type),
+		edge.To("group_invites", GroupInvite.Type),
+		edge.To("study_events", StudyEvent.Type),
+		edge.To("documents", Document.Type),
+		edge.To("folders", Folder.Type),
+		edge.To("blob_references", BlobReference.Type).
+			Comment("Uploaded files the user is charged for"),
+		edge.To("resumable_uploads", ResumableUpload.Type),
+		edge.To("note_proposals", NoteProposal.Type),
+		edge.To("note_revisions", NoteRevision.Type),
+		edge.To("notebooks", Notebook.Type),
+		edge.To("tags", Tag.Type),
 	}
 }
@@ -0,0 +1,293 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/r-scheele/zero/ent/note"
+	"github.com/r-scheele/zero/ent/quiz"
+	"github.com/r-scheele/zero/ent/quizattempt"
+	"github.com/r-scheele/zero/ent/studyevent"
+	"github.com/r-scheele/zero/ent/user"
+)
+
+// StudyEvent is the model entity for the StudyEvent schema.
+type StudyEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Type holds the value of the "type" field.
+	Type studyevent.Type `json:"type,omitempty"`
+	// Title of the note or quiz studied, kept so history survives deletions
+	Topic string `json:"topic,omitempty"`
+	// Points earned, for quiz attempts
+	Score *int `json:"score,omitempty"`
+	// Points available, for quiz attempts
+	MaxScore *int `json:"max_score,omitempty"`
+	// Time spent studying
+	DurationSeconds int `json:"duration_seconds,omitempty"`
+	// OccurredAt holds the value of the "occurred_at" field.
+	OccurredAt time.Time `json:"occurred_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the StudyEventQuery when eager-loading is set.
+	Edges                    StudyEventEdges `json:"edges"`
+	note_study_events        *int
+	quiz_study_events        *int
+	quiz_attempt_study_event *int
+	user_study_events        *int
+	selectValues             sql.SelectValues
+}
+
+// StudyEventEdges holds the relations/edges for other nodes in the graph.
+type StudyEventEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Note holds the value of the note edge.
+	Note *Note `json:"note,omitempty"`
+	// Quiz holds the value of the quiz edge.
+	Quiz *Quiz `json:"quiz,omitempty"`
+	// The graded attempt, so each attempt is only recorded once
+	Attempt *QuizAttempt `json:"attempt,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e StudyEventEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// NoteOrErr returns the Note value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e StudyEventEdges) NoteOrErr() (*Note, error) {
+	if e.Note != nil {
+		return e.Note, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: note.Label}
+	}
+	return nil, &NotLoadedError{edge: "note"}
+}
+
+// QuizOrErr returns the Quiz value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e StudyEventEdges) QuizOrErr() (*Quiz, error) {
+	if e.Quiz != nil {
+		return e.Quiz, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: quiz.Label}
+	}
+	return nil, &NotLoadedError{edge: "quiz"}
+}
+
+// AttemptOrErr returns the Attempt value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e StudyEventEdges) AttemptOrErr() (*QuizAttempt, error) {
+	if e.Attempt != nil {
+		return e.Attempt, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: quizattempt.Label}
+	}
+	return nil, &NotLoadedError{edge: "attempt"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*StudyEvent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case studyevent.FieldID, studyevent.FieldScore, studyevent.FieldMaxScore, studyevent.FieldDurationSeconds:
+			values[i] = new(sql.NullInt64)
+		case studyevent.FieldType, studyevent.FieldTopic:
+			values[i] = new(sql.NullString)
+		case studyevent.FieldOccurredAt:
+			values[i] = new(sql.NullTime)
+		case studyevent.ForeignKeys[0]: // note_study_events
+			values[i] = new(sql.NullInt64)
+		case studyevent.ForeignKeys[1]: // quiz_study_events
+			values[i] = new(sql.NullInt64)
+		case studyevent.ForeignKeys[2]: // quiz_attempt_study_event
+			values[i] = new(sql.NullInt64)
+		case studyevent.ForeignKeys[3]: // user_study_events
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the StudyEvent fields.
+func (se *StudyEvent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case studyevent.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			se.ID = int(value.Int64)
+		case studyevent.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				se.Type = studyevent.Type(value.String)
+			}
+		case studyevent.FieldTopic:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field topic", values[i])
+			} else if value.Valid {
+				se.Topic = value.String
+			}
+		case studyevent.FieldScore:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field score", values[i])
+			} else if value.Valid {
+				se.Score = new(int)
+				*se.Score = int(value.Int64)
+			}
+		case studyevent.FieldMaxScore:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_score", values[i])
+			} else if value.Valid {
+				se.MaxScore = new(int)
+				*se.MaxScore = int(value.Int64)
+			}
+		case studyevent.FieldDurationSeconds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field duration_seconds", values[i])
+			} else if value.Valid {
+				se.DurationSeconds = int(value.Int64)
+			}
+		case studyevent.FieldOccurredAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field occurred_at", values[i])
+			} else if value.Valid {
+				se.OccurredAt = value.Time
+			}
+		case studyevent.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field note_study_events", value)
+			} else if value.Valid {
+				se.note_study_events = new(int)
+				*se.note_study_events = int(value.Int64)
+			}
+		case studyevent.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field quiz_study_events", value)
+			} else if value.Valid {
+				se.quiz_study_events = new(int)
+				*se.quiz_study_events = int(value.Int64)
+			}
+		case studyevent.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field quiz_attempt_study_event", value)
+			} else if value.Valid {
+				se.quiz_attempt_study_event = new(int)
+				*se.quiz_attempt_study_event = int(value.Int64)
+			}
+		case studyevent.ForeignKeys[3]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_study_events", value)
+			} else if value.Valid {
+				se.user_study_events = new(int)
+				*se.user_study_events = int(value.Int64)
+			}
+		default:
+			se.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the StudyEvent.
+// This includes values selected through modifiers, order, etc.
+func (se *StudyEvent) Value(name string) (ent.Value, error) {
+	return se.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the StudyEvent entity.
+func (se *StudyEvent) QueryUser() *UserQuery {
+	return NewStudyEventClient(se.config).QueryUser(se)
+}
+
+// QueryNote queries the "note" edge of the StudyEvent entity.
+func (se *StudyEvent) QueryNote() *NoteQuery {
+	return NewStudyEventClient(se.config).QueryNote(se)
+}
+
+// QueryQuiz queries the "quiz" edge of the StudyEvent entity.
+func (se *StudyEvent) QueryQuiz() *QuizQuery {
+	return NewStudyEventClient(se.config).QueryQuiz(se)
+}
+
+// QueryAttempt queries the "attempt" edge of the StudyEvent entity.
+func (se *StudyEvent) QueryAttempt() *QuizAttemptQuery {
+	return NewStudyEventClient(se.config).QueryAttempt(se)
+}
+
+// Update returns a builder for updating this StudyEvent.
+// Note that you need to call StudyEvent.Unwrap() before calling this method if this StudyEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (se *StudyEvent) Update() *StudyEventUpdateOne {
+	return NewStudyEventClient(se.config).UpdateOne(se)
+}
+
+// Unwrap unwraps the StudyEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (se *StudyEvent) Unwrap() *StudyEvent {
+	_tx, ok := se.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: StudyEvent is not a transactional entity")
+	}
+	se.config.driver = _tx.drv
+	return se
+}
+
+// String implements the fmt.Stringer.
+func (se *StudyEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("StudyEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", se.ID))
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", se.Type))
+	builder.WriteString(", ")
+	builder.WriteString("topic=")
+	builder.WriteString(se.Topic)
+	builder.WriteString(", ")
+	if v := se.Score; v != nil {
+		builder.WriteString("score=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := se.MaxScore; v != nil {
+		builder.WriteString("max_score=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("duration_seconds=")
+	builder.WriteString(fmt.Sprintf("%v", se.DurationSeconds))
+	builder.WriteString(", ")
+	builder.WriteString("occurred_at=")
+	builder.WriteString(se.OccurredAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// StudyEvents is a parsable slice of StudyEvent.
+type StudyEvents []*StudyEvent
@@ -0,0 +1,453 @@
+// Code generated by ent, DO NOT EDIT.
+
+package studyevent
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/r-scheele/zero/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldLTE(FieldID, id))
+}
+
+// Topic applies equality check predicate on the "topic" field. It's identical to TopicEQ.
+func Topic(v string) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldEQ(FieldTopic, v))
+}
+
+// Score applies equality check predicate on the "score" field. It's identical to ScoreEQ.
+func Score(v int) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldEQ(FieldScore, v))
+}
+
+// MaxScore applies equality check predicate on the "max_score" field. It's identical to MaxScoreEQ.
+func MaxScore(v int) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldEQ(FieldMaxScore, v))
+}
+
+// DurationSeconds applies equality check predicate on the "duration_seconds" field. It's identical to DurationSecondsEQ.
+func DurationSeconds(v int) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldEQ(FieldDurationSeconds, v))
+}
+
+// OccurredAt applies equality check predicate on the "occurred_at" field. It's identical to OccurredAtEQ.
+func OccurredAt(v time.Time) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldEQ(FieldOccurredAt, v))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v Type) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldEQ(FieldType, v))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v Type) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldNEQ(FieldType, v))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...Type) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldIn(FieldType, vs...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...Type) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldNotIn(FieldType, vs...))
+}
+
+// TopicEQ applies the EQ predicate on the "topic" field.
+func TopicEQ(v string) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldEQ(FieldTopic, v))
+}
+
+// TopicNEQ applies the NEQ predicate on the "topic" field.
+func TopicNEQ(v string) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldNEQ(FieldTopic, v))
+}
+
+// TopicIn applies the In predicate on the "topic" field.
+func TopicIn(vs ...string) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldIn(FieldTopic, vs...))
+}
+
+// TopicNotIn applies the NotIn predicate on the "topic" field.
+func TopicNotIn(vs ...string) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldNotIn(FieldTopic, vs...))
+}
+
+// TopicGT applies the GT predicate on the "topic" field.
+func TopicGT(v string) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldGT(FieldTopic, v))
+}
+
+// TopicGTE applies the GTE predicate on the "topic" field.
+func TopicGTE(v string) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldGTE(FieldTopic, v))
+}
+
+// TopicLT applies the LT predicate on the "topic" field.
+func TopicLT(v string) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldLT(FieldTopic, v))
+}
+
+// TopicLTE applies the LTE predicate on the "topic" field.
+func TopicLTE(v string) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldLTE(FieldTopic, v))
+}
+
+// TopicContains applies the Contains predicate on the "topic" field.
+func TopicContains(v string) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldContains(FieldTopic, v))
+}
+
+// TopicHasPrefix applies the HasPrefix predicate on the "topic" field.
+func TopicHasPrefix(v string) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldHasPrefix(FieldTopic, v))
+}
+
+// TopicHasSuffix applies the HasSuffix predicate on the "topic" field.
+func TopicHasSuffix(v string) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldHasSuffix(FieldTopic, v))
+}
+
+// TopicEqualFold applies the EqualFold predicate on the "topic" field.
+func TopicEqualFold(v string) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldEqualFold(FieldTopic, v))
+}
+
+// TopicContainsFold applies the ContainsFold predicate on the "topic" field.
+func TopicContainsFold(v string) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldContainsFold(FieldTopic, v))
+}
+
+// ScoreEQ applies the EQ predicate on the "score" field.
+func ScoreEQ(v int) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldEQ(FieldScore, v))
+}
+
+// ScoreNEQ applies the NEQ predicate on the "score" field.
+func ScoreNEQ(v int) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldNEQ(FieldScore, v))
+}
+
+// ScoreIn applies the In predicate on the "score" field.
+func ScoreIn(vs ...int) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldIn(FieldScore, vs...))
+}
+
+// ScoreNotIn applies the NotIn predicate on the "score" field.
+func ScoreNotIn(vs ...int) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldNotIn(FieldScore, vs...))
+}
+
+// ScoreGT applies the GT predicate on the "score" field.
+func ScoreGT(v int) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldGT(FieldScore, v))
+}
+
+// ScoreGTE applies the GTE predicate on the "score" field.
+func ScoreGTE(v int) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldGTE(FieldScore, v))
+}
+
+// ScoreLT applies the LT predicate on the "score" field.
+func ScoreLT(v int) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldLT(FieldScore, v))
+}
+
+// ScoreLTE applies the LTE predicate on the "score" field.
+func ScoreLTE(v int) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldLTE(FieldScore, v))
+}
+
+// ScoreIsNil applies the IsNil predicate on the "score" field.
+func ScoreIsNil() predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldIsNull(FieldScore))
+}
+
+// ScoreNotNil applies the NotNil predicate on the "score" field.
+func ScoreNotNil() predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldNotNull(FieldScore))
+}
+
+// MaxScoreEQ applies the EQ predicate on the "max_score" field.
+func MaxScoreEQ(v int) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldEQ(FieldMaxScore, v))
+}
+
+// MaxScoreNEQ applies the NEQ predicate on the "max_score" field.
+func MaxScoreNEQ(v int) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldNEQ(FieldMaxScore, v))
+}
+
+// MaxScoreIn applies the In predicate on the "max_score" field.
+func MaxScoreIn(vs ...int) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldIn(FieldMaxScore, vs...))
+}
+
+// MaxScoreNotIn applies the NotIn predicate on the "max_score" field.
+func MaxScoreNotIn(vs ...int) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldNotIn(FieldMaxScore, vs...))
+}
+
+// MaxScoreGT applies the GT predicate on the "max_score" field.
+func MaxScoreGT(v int) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldGT(FieldMaxScore, v))
+}
+
+// MaxScoreGTE applies the GTE predicate on the "max_score" field.
+func MaxScoreGTE(v int) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldGTE(FieldMaxScore, v))
+}
+
+// MaxScoreLT applies the LT predicate on the "max_score" field.
+func MaxScoreLT(v int) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldLT(FieldMaxScore, v))
+}
+
+// MaxScoreLTE applies the LTE predicate on the "max_score" field.
+func MaxScoreLTE(v int) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldLTE(FieldMaxScore, v))
+}
+
+// MaxScoreIsNil applies the IsNil predicate on the "max_score" field.
+func MaxScoreIsNil() predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldIsNull(FieldMaxScore))
+}
+
+// MaxScoreNotNil applies the NotNil predicate on the "max_score" field.
+func MaxScoreNotNil() predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldNotNull(FieldMaxScore))
+}
+
+// DurationSecondsEQ applies the EQ predicate on the "duration_seconds" field.
+func DurationSecondsEQ(v int) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldEQ(FieldDurationSeconds, v))
+}
+
+// DurationSecondsNEQ applies the NEQ predicate on the "duration_seconds" field.
+func DurationSecondsNEQ(v int) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldNEQ(FieldDurationSeconds, v))
+}
+
+// DurationSecondsIn applies the In predicate on the "duration_seconds" field.
+func DurationSecondsIn(vs ...int) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldIn(FieldDurationSeconds, vs...))
+}
+
+// DurationSecondsNotIn applies the NotIn predicate on the "duration_seconds" field.
+func DurationSecondsNotIn(vs ...int) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldNotIn(FieldDurationSeconds, vs...))
+}
+
+// DurationSecondsGT applies the GT predicate on the "duration_seconds" field.
+func DurationSecondsGT(v int) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldGT(FieldDurationSeconds, v))
+}
+
+// DurationSecondsGTE applies the GTE predicate on the "duration_seconds" field.
+func DurationSecondsGTE(v int) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldGTE(FieldDurationSeconds, v))
+}
+
+// DurationSecondsLT applies the LT predicate on the "duration_seconds" field.
+func DurationSecondsLT(v int) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldLT(FieldDurationSeconds, v))
+}
+
+// DurationSecondsLTE applies the LTE predicate on the "duration_seconds" field.
+func DurationSecondsLTE(v int) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldLTE(FieldDurationSeconds, v))
+}
+
+// OccurredAtEQ applies the EQ predicate on the "occurred_at" field.
+func OccurredAtEQ(v time.Time) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldEQ(FieldOccurredAt, v))
+}
+
+// OccurredAtNEQ applies the NEQ predicate on the "occurred_at" field.
+func OccurredAtNEQ(v time.Time) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldNEQ(FieldOccurredAt, v))
+}
+
+// OccurredAtIn applies the In predicate on the "occurred_at" field.
+func OccurredAtIn(vs ...time.Time) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldIn(FieldOccurredAt, vs...))
+}
+
+// OccurredAtNotIn applies the NotIn predicate on the "occurred_at" field.
+func OccurredAtNotIn(vs ...time.Time) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldNotIn(FieldOccurredAt, vs...))
+}
+
+// OccurredAtGT applies the GT predicate on the "occurred_at" field.
+func OccurredAtGT(v time.Time) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldGT(FieldOccurredAt, v))
+}
+
+// OccurredAtGTE applies the GTE predicate on the "occurred_at" field.
+func OccurredAtGTE(v time.Time) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldGTE(FieldOccurredAt, v))
+}
+
+// OccurredAtLT applies the LT predicate on the "occurred_at" field.
+func OccurredAtLT(v time.Time) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldLT(FieldOccurredAt, v))
+}
+
+// OccurredAtLTE applies the LTE predicate on the "occurred_at" field.
+func OccurredAtLTE(v time.Time) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.FieldLTE(FieldOccurredAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.StudyEvent {
+	return predicate.StudyEvent(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.StudyEvent {
+	return predicate.StudyEvent(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasNote applies the HasEdge predicate on the "note" edge.
+func HasNote() predicate.StudyEvent {
+	return predicate.StudyEvent(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, NoteTable, NoteColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasNoteWith applies the HasEdge predicate on the "note" edge with a given conditions (other predicates).
+func HasNoteWith(preds ...predicate.Note) predicate.StudyEvent {
+	return predicate.StudyEvent(func(s *sql.Selector) {
+		step := newNoteStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasQuiz applies the HasEdge predicate on the "quiz" edge.
+func HasQuiz() predicate.StudyEvent {
+	return predicate.StudyEvent(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, QuizTable, QuizColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasQuizWith applies the HasEdge predicate on the "quiz" edge with a given conditions (other predicates).
+func HasQuizWith(preds ...predicate.Quiz) predicate.StudyEvent {
+	return predicate.StudyEvent(func(s *sql.Selector) {
+		step := newQuizStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAttempt applies the HasEdge predicate on the "attempt" edge.
+func HasAttempt() predicate.StudyEvent {
+	return predicate.StudyEvent(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, AttemptTable, AttemptColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAttemptWith applies the HasEdge predicate on the "attempt" edge with a given conditions (other predicates).
+func HasAttemptWith(preds ...predicate.QuizAttempt) predicate.StudyEvent {
+	return predicate.StudyEvent(func(s *sql.Selector) {
+		step := newAttemptStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.StudyEvent) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.StudyEvent) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.StudyEvent) predicate.StudyEvent {
+	return predicate.StudyEvent(sql.NotPredicates(p))
+}
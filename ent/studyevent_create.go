@@ -0,0 +1,442 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/r-scheele/zero/ent/note"
+	"github.com/r-scheele/zero/ent/quiz"
+	"github.com/r-scheele/zero/ent/quizattempt"
+	"github.com/r-scheele/zero/ent/studyevent"
+	"github.com/r-scheele/zero/ent/user"
+)
+
+// StudyEventCreate is the builder for creating a StudyEvent entity.
+type StudyEventCreate struct {
+	config
+	mutation *StudyEventMutation
+	hooks    []Hook
+}
+
+// SetType sets the "type" field.
+func (sec *StudyEventCreate) SetType(s studyevent.Type) *StudyEventCreate {
+	sec.mutation.SetType(s)
+	return sec
+}
+
+// SetTopic sets the "topic" field.
+func (sec *StudyEventCreate) SetTopic(s string) *StudyEventCreate {
+	sec.mutation.SetTopic(s)
+	return sec
+}
+
+// SetScore sets the "score" field.
+func (sec *StudyEventCreate) SetScore(i int) *StudyEventCreate {
+	sec.mutation.SetScore(i)
+	return sec
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (sec *StudyEventCreate) SetNillableScore(i *int) *StudyEventCreate {
+	if i != nil {
+		sec.SetScore(*i)
+	}
+	return sec
+}
+
+// SetMaxScore sets the "max_score" field.
+func (sec *StudyEventCreate) SetMaxScore(i int) *StudyEventCreate {
+	sec.mutation.SetMaxScore(i)
+	return sec
+}
+
+// SetNillableMaxScore sets the "max_score" field if the given value is not nil.
+func (sec *StudyEventCreate) SetNillableMaxScore(i *int) *StudyEventCreate {
+	if i != nil {
+		sec.SetMaxScore(*i)
+	}
+	return sec
+}
+
+// SetDurationSeconds sets the "duration_seconds" field.
+func (sec *StudyEventCreate) SetDurationSeconds(i int) *StudyEventCreate {
+	sec.mutation.SetDurationSeconds(i)
+	return sec
+}
+
+// SetNillableDurationSeconds sets the "duration_seconds" field if the given value is not nil.
+func (sec *StudyEventCreate) SetNillableDurationSeconds(i *int) *StudyEventCreate {
+	if i != nil {
+		sec.SetDurationSeconds(*i)
+	}
+	return sec
+}
+
+// SetOccurredAt sets the "occurred_at" field.
+func (sec *StudyEventCreate) SetOccurredAt(t time.Time) *StudyEventCreate {
+	sec.mutation.SetOccurredAt(t)
+	return sec
+}
+
+// SetNillableOccurredAt sets the "occurred_at" field if the given value is not nil.
+func (sec *StudyEventCreate) SetNillableOccurredAt(t *time.Time) *StudyEventCreate {
+	if t != nil {
+		sec.SetOccurredAt(*t)
+	}
+	return sec
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (sec *StudyEventCreate) SetUserID(id int) *StudyEventCreate {
+	sec.mutation.SetUserID(id)
+	return sec
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (sec *StudyEventCreate) SetUser(u *User) *StudyEventCreate {
+	return sec.SetUserID(u.ID)
+}
+
+// SetNoteID sets the "note" edge to the Note entity by ID.
+func (sec *StudyEventCreate) SetNoteID(id int) *StudyEventCreate {
+	sec.mutation.SetNoteID(id)
+	return sec
+}
+
+// SetNillableNoteID sets the "note" edge to the Note entity by ID if the given value is not nil.
+func (sec *StudyEventCreate) SetNillableNoteID(id *int) *StudyEventCreate {
+	if id != nil {
+		sec = sec.SetNoteID(*id)
+	}
+	return sec
+}
+
+// SetNote sets the "note" edge to the Note entity.
+func (sec *StudyEventCreate) SetNote(n *Note) *StudyEventCreate {
+	return sec.SetNoteID(n.ID)
+}
+
+// SetQuizID sets the "quiz" edge to the Quiz entity by ID.
+func (sec *StudyEventCreate) SetQuizID(id int) *StudyEventCreate {
+	sec.mutation.SetQuizID(id)
+	return sec
+}
+
+// SetNillableQuizID sets the "quiz" edge to the Quiz entity by ID if the given value is not nil.
+func (sec *StudyEventCreate) SetNillableQuizID(id *int) *StudyEventCreate {
+	if id != nil {
+		sec = sec.SetQuizID(*id)
+	}
+	return sec
+}
+
+// SetQuiz sets the "quiz" edge to the Quiz entity.
+func (sec *StudyEventCreate) SetQuiz(q *Quiz) *StudyEventCreate {
+	return sec.SetQuizID(q.ID)
+}
+
+// SetAttemptID sets the "attempt" edge to the QuizAttempt entity by ID.
+func (sec *StudyEventCreate) SetAttemptID(id int) *StudyEventCreate {
+	sec.mutation.SetAttemptID(id)
+	return sec
+}
+
+// SetNillableAttemptID sets the "attempt" edge to the QuizAttempt entity by ID if the given value is not nil.
+func (sec *StudyEventCreate) SetNillableAttemptID(id *int) *StudyEventCreate {
+	if id != nil {
+		sec = sec.SetAttemptID(*id)
+	}
+	return sec
+}
+
+// SetAttempt sets the "attempt" edge to the QuizAttempt entity.
+func (sec *StudyEventCreate) SetAttempt(q *QuizAttempt) *StudyEventCreate {
+	return sec.SetAttemptID(q.ID)
+}
+
+// Mutation returns the StudyEventMutation object of the builder.
+func (sec *StudyEventCreate) Mutation() *StudyEventMutation {
+	return sec.mutation
+}
+
+// Save creates the StudyEvent in the database.
+func (sec *StudyEventCreate) Save(ctx context.Context) (*StudyEvent, error) {
+	sec.defaults()
+	return withHooks(ctx, sec.sqlSave, sec.mutation, sec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sec *StudyEventCreate) SaveX(ctx context.Context) *StudyEvent {
+	v, err := sec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sec *StudyEventCreate) Exec(ctx context.Context) error {
+	_, err := sec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sec *StudyEventCreate) ExecX(ctx context.Context) {
+	if err := sec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sec *StudyEventCreate) defaults() {
+	if _, ok := sec.mutation.DurationSeconds(); !ok {
+		v := studyevent.DefaultDurationSeconds
+		sec.mutation.SetDurationSeconds(v)
+	}
+	if _, ok := sec.mutation.OccurredAt(); !ok {
+		v := studyevent.DefaultOccurredAt()
+		sec.mutation.SetOccurredAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sec *StudyEventCreate) check() error {
+	if _, ok := sec.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "StudyEvent.type"`)}
+	}
+	if v, ok := sec.mutation.GetType(); ok {
+		if err := studyevent.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "StudyEvent.type": %w`, err)}
+		}
+	}
+	if _, ok := sec.mutation.Topic(); !ok {
+		return &ValidationError{Name: "topic", err: errors.New(`ent: missing required field "StudyEvent.topic"`)}
+	}
+	if _, ok := sec.mutation.DurationSeconds(); !ok {
+		return &ValidationError{Name: "duration_seconds", err: errors.New(`ent: missing required field "StudyEvent.duration_seconds"`)}
+	}
+	if v, ok := sec.mutation.DurationSeconds(); ok {
+		if err := studyevent.DurationSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "duration_seconds", err: fmt.Errorf(`ent: validator failed for field "StudyEvent.duration_seconds": %w`, err)}
+		}
+	}
+	if _, ok := sec.mutation.OccurredAt(); !ok {
+		return &ValidationError{Name: "occurred_at", err: errors.New(`ent: missing required field "StudyEvent.occurred_at"`)}
+	}
+	if len(sec.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "StudyEvent.user"`)}
+	}
+	return nil
+}
+
+func (sec *StudyEventCreate) sqlSave(ctx context.Context) (*StudyEvent, error) {
+	if err := sec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	sec.mutation.id = &_node.ID
+	sec.mutation.done = true
+	return _node, nil
+}
+
+func (sec *StudyEventCreate) createSpec() (*StudyEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &StudyEvent{config: sec.config}
+		_spec = sqlgraph.NewCreateSpec(studyevent.Table, sqlgraph.NewFieldSpec(studyevent.FieldID, field.TypeInt))
+	)
+	if value, ok := sec.mutation.GetType(); ok {
+		_spec.SetField(studyevent.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := sec.mutation.Topic(); ok {
+		_spec.SetField(studyevent.FieldTopic, field.TypeString, value)
+		_node.Topic = value
+	}
+	if value, ok := sec.mutation.Score(); ok {
+		_spec.SetField(studyevent.FieldScore, field.TypeInt, value)
+		_node.Score = &value
+	}
+	if value, ok := sec.mutation.MaxScore(); ok {
+		_spec.SetField(studyevent.FieldMaxScore, field.TypeInt, value)
+		_node.MaxScore = &value
+	}
+	if value, ok := sec.mutation.DurationSeconds(); ok {
+		_spec.SetField(studyevent.FieldDurationSeconds, field.TypeInt, value)
+		_node.DurationSeconds = value
+	}
+	if value, ok := sec.mutation.OccurredAt(); ok {
+		_spec.SetField(studyevent.FieldOccurredAt, field.TypeTime, value)
+		_node.OccurredAt = value
+	}
+	if nodes := sec.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   studyevent.UserTable,
+			Columns: []string{studyevent.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_study_events = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sec.mutation.NoteIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   studyevent.NoteTable,
+			Columns: []string{studyevent.NoteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.note_study_events = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sec.mutation.QuizIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   studyevent.QuizTable,
+			Columns: []string{studyevent.QuizColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quiz.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.quiz_study_events = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sec.mutation.AttemptIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   studyevent.AttemptTable,
+			Columns: []string{studyevent.AttemptColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quizattempt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.quiz_attempt_study_event = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// StudyEventCreateBulk is the builder for creating many StudyEvent entities in bulk.
+type StudyEventCreateBulk struct {
+	config
+	err      error
+	builders []*StudyEventCreate
+}
+
+// Save creates the StudyEvent entities in the database.
+func (secb *StudyEventCreateBulk) Save(ctx context.Context) ([]*StudyEvent, error) {
+	if secb.err != nil {
+		return nil, secb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(secb.builders))
+	nodes := make([]*StudyEvent, len(secb.builders))
+	mutators := make([]Mutator, len(secb.builders))
+	for i := range secb.builders {
+		func(i int, root context.Context) {
+			builder := secb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*StudyEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, secb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, secb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, secb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (secb *StudyEventCreateBulk) SaveX(ctx context.Context) []*StudyEvent {
+	v, err := secb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (secb *StudyEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := secb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (secb *StudyEventCreateBulk) ExecX(ctx context.Context) {
+	if err := secb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
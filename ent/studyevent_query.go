@@ -0,0 +1,839 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/r-scheele/zero/ent/note"
+	"github.com/r-scheele/zero/ent/predicate"
+	"github.com/r-scheele/zero/ent/quiz"
+	"github.com/r-scheele/zero/ent/quizattempt"
+	"github.com/r-scheele/zero/ent/studyevent"
+	"github.com/r-scheele/zero/ent/user"
+)
+
+// StudyEventQuery is the builder for querying StudyEvent entities.
+type StudyEventQuery struct {
+	config
+	ctx         *QueryContext
+	order       []studyevent.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.StudyEvent
+	withUser    *UserQuery
+	withNote    *NoteQuery
+	withQuiz    *QuizQuery
+	withAttempt *QuizAttemptQuery
+	withFKs     bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the StudyEventQuery builder.
+func (seq *StudyEventQuery) Where(ps ...predicate.StudyEvent) *StudyEventQuery {
+	seq.predicates = append(seq.predicates, ps...)
+	return seq
+}
+
+// Limit the number of records to be returned by this query.
+func (seq *StudyEventQuery) Limit(limit int) *StudyEventQuery {
+	seq.ctx.Limit = &limit
+	return seq
+}
+
+// Offset to start from.
+func (seq *StudyEventQuery) Offset(offset int) *StudyEventQuery {
+	seq.ctx.Offset = &offset
+	return seq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (seq *StudyEventQuery) Unique(unique bool) *StudyEventQuery {
+	seq.ctx.Unique = &unique
+	return seq
+}
+
+// Order specifies how the records should be ordered.
+func (seq *StudyEventQuery) Order(o ...studyevent.OrderOption) *StudyEventQuery {
+	seq.order = append(seq.order, o...)
+	return seq
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (seq *StudyEventQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: seq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := seq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := seq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(studyevent.Table, studyevent.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, studyevent.UserTable, studyevent.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(seq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryNote chains the current query on the "note" edge.
+func (seq *StudyEventQuery) QueryNote() *NoteQuery {
+	query := (&NoteClient{config: seq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := seq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := seq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(studyevent.Table, studyevent.FieldID, selector),
+			sqlgraph.To(note.Table, note.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, studyevent.NoteTable, studyevent.NoteColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(seq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryQuiz chains the current query on the "quiz" edge.
+func (seq *StudyEventQuery) QueryQuiz() *QuizQuery {
+	query := (&QuizClient{config: seq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := seq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := seq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(studyevent.Table, studyevent.FieldID, selector),
+			sqlgraph.To(quiz.Table, quiz.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, studyevent.QuizTable, studyevent.QuizColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(seq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAttempt chains the current query on the "attempt" edge.
+func (seq *StudyEventQuery) QueryAttempt() *QuizAttemptQuery {
+	query := (&QuizAttemptClient{config: seq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := seq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := seq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(studyevent.Table, studyevent.FieldID, selector),
+			sqlgraph.To(quizattempt.Table, quizattempt.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, studyevent.AttemptTable, studyevent.AttemptColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(seq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first StudyEvent entity from the query.
+// Returns a *NotFoundError when no StudyEvent was found.
+func (seq *StudyEventQuery) First(ctx context.Context) (*StudyEvent, error) {
+	nodes, err := seq.Limit(1).All(setContextOp(ctx, seq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{studyevent.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (seq *StudyEventQuery) FirstX(ctx context.Context) *StudyEvent {
+	node, err := seq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first StudyEvent ID from the query.
+// Returns a *NotFoundError when no StudyEvent ID was found.
+func (seq *StudyEventQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = seq.Limit(1).IDs(setContextOp(ctx, seq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{studyevent.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (seq *StudyEventQuery) FirstIDX(ctx context.Context) int {
+	id, err := seq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single StudyEvent entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one StudyEvent entity is found.
+// Returns a *NotFoundError when no StudyEvent entities are found.
+func (seq *StudyEventQuery) Only(ctx context.Context) (*StudyEvent, error) {
+	nodes, err := seq.Limit(2).All(setContextOp(ctx, seq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{studyevent.Label}
+	default:
+		return nil, &NotSingularError{studyevent.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (seq *StudyEventQuery) OnlyX(ctx context.Context) *StudyEvent {
+	node, err := seq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only StudyEvent ID in the query.
+// Returns a *NotSingularError when more than one StudyEvent ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (seq *StudyEventQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = seq.Limit(2).IDs(setContextOp(ctx, seq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{studyevent.Label}
+	default:
+		err = &NotSingularError{studyevent.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (seq *StudyEventQuery) OnlyIDX(ctx context.Context) int {
+	id, err := seq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of StudyEvents.
+func (seq *StudyEventQuery) All(ctx context.Context) ([]*StudyEvent, error) {
+	ctx = setContextOp(ctx, seq.ctx, ent.OpQueryAll)
+	if err := seq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*StudyEvent, *StudyEventQuery]()
+	return withInterceptors[[]*StudyEvent](ctx, seq, qr, seq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (seq *StudyEventQuery) AllX(ctx context.Context) []*StudyEvent {
+	nodes, err := seq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of StudyEvent IDs.
+func (seq *StudyEventQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if seq.ctx.Unique == nil && seq.path != nil {
+		seq.Unique(true)
+	}
+	ctx = setContextOp(ctx, seq.ctx, ent.OpQueryIDs)
+	if err = seq.Select(studyevent.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (seq *StudyEventQuery) IDsX(ctx context.Context) []int {
+	ids, err := seq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (seq *StudyEventQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, seq.ctx, ent.OpQueryCount)
+	if err := seq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, seq, querierCount[*StudyEventQuery](), seq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (seq *StudyEventQuery) CountX(ctx context.Context) int {
+	count, err := seq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (seq *StudyEventQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, seq.ctx, ent.OpQueryExist)
+	switch _, err := seq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (seq *StudyEventQuery) ExistX(ctx context.Context) bool {
+	exist, err := seq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the StudyEventQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (seq *StudyEventQuery) Clone() *StudyEventQuery {
+	if seq == nil {
+		return nil
+	}
+	return &StudyEventQuery{
+		config:      seq.config,
+		ctx:         seq.ctx.Clone(),
+		order:       append([]studyevent.OrderOption{}, seq.order...),
+		inters:      append([]Interceptor{}, seq.inters...),
+		predicates:  append([]predicate.StudyEvent{}, seq.predicates...),
+		withUser:    seq.withUser.Clone(),
+		withNote:    seq.withNote.Clone(),
+		withQuiz:    seq.withQuiz.Clone(),
+		withAttempt: seq.withAttempt.Clone(),
+		// clone intermediate query.
+		sql:  seq.sql.Clone(),
+		path: seq.path,
+	}
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (seq *StudyEventQuery) WithUser(opts ...func(*UserQuery)) *StudyEventQuery {
+	query := (&UserClient{config: seq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	seq.withUser = query
+	return seq
+}
+
+// WithNote tells the query-builder to eager-load the nodes that are connected to
+// the "note" edge. The optional arguments are used to configure the query builder of the edge.
+func (seq *StudyEventQuery) WithNote(opts ...func(*NoteQuery)) *StudyEventQuery {
+	query := (&NoteClient{config: seq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	seq.withNote = query
+	return seq
+}
+
+// WithQuiz tells the query-builder to eager-load the nodes that are connected to
+// the "quiz" edge. The optional arguments are used to configure the query builder of the edge.
+func (seq *StudyEventQuery) WithQuiz(opts ...func(*QuizQuery)) *StudyEventQuery {
+	query := (&QuizClient{config: seq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	seq.withQuiz = query
+	return seq
+}
+
+// WithAttempt tells the query-builder to eager-load the nodes that are connected to
+// the "attempt" edge. The optional arguments are used to configure the query builder of the edge.
+func (seq *StudyEventQuery) WithAttempt(opts ...func(*QuizAttemptQuery)) *StudyEventQuery {
+	query := (&QuizAttemptClient{config: seq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	seq.withAttempt = query
+	return seq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Type studyevent.Type `json:"type,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.StudyEvent.Query().
+//		GroupBy(studyevent.FieldType).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (seq *StudyEventQuery) GroupBy(field string, fields ...string) *StudyEventGroupBy {
+	seq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &StudyEventGroupBy{build: seq}
+	grbuild.flds = &seq.ctx.Fields
+	grbuild.label = studyevent.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Type studyevent.Type `json:"type,omitempty"`
+//	}
+//
+//	client.StudyEvent.Query().
+//		Select(studyevent.FieldType).
+//		Scan(ctx, &v)
+func (seq *StudyEventQuery) Select(fields ...string) *StudyEventSelect {
+	seq.ctx.Fields = append(seq.ctx.Fields, fields...)
+	sbuild := &StudyEventSelect{StudyEventQuery: seq}
+	sbuild.label = studyevent.Label
+	sbuild.flds, sbuild.scan = &seq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a StudyEventSelect configured with the given aggregations.
+func (seq *StudyEventQuery) Aggregate(fns ...AggregateFunc) *StudyEventSelect {
+	return seq.Select().Aggregate(fns...)
+}
+
+func (seq *StudyEventQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range seq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, seq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range seq.ctx.Fields {
+		if !studyevent.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if seq.path != nil {
+		prev, err := seq.path(ctx)
+		if err != nil {
+			return err
+		}
+		seq.sql = prev
+	}
+	return nil
+}
+
+func (seq *StudyEventQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*StudyEvent, error) {
+	var (
+		nodes       = []*StudyEvent{}
+		withFKs     = seq.withFKs
+		_spec       = seq.querySpec()
+		loadedTypes = [4]bool{
+			seq.withUser != nil,
+			seq.withNote != nil,
+			seq.withQuiz != nil,
+			seq.withAttempt != nil,
+		}
+	)
+	if seq.withUser != nil || seq.withNote != nil || seq.withQuiz != nil || seq.withAttempt != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, studyevent.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*StudyEvent).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &StudyEvent{config: seq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, seq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := seq.withUser; query != nil {
+		if err := seq.loadUser(ctx, query, nodes, nil,
+			func(n *StudyEvent, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := seq.withNote; query != nil {
+		if err := seq.loadNote(ctx, query, nodes, nil,
+			func(n *StudyEvent, e *Note) { n.Edges.Note = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := seq.withQuiz; query != nil {
+		if err := seq.loadQuiz(ctx, query, nodes, nil,
+			func(n *StudyEvent, e *Quiz) { n.Edges.Quiz = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := seq.withAttempt; query != nil {
+		if err := seq.loadAttempt(ctx, query, nodes, nil,
+			func(n *StudyEvent, e *QuizAttempt) { n.Edges.Attempt = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (seq *StudyEventQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*StudyEvent, init func(*StudyEvent), assign func(*StudyEvent, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*StudyEvent)
+	for i := range nodes {
+		if nodes[i].user_study_events == nil {
+			continue
+		}
+		fk := *nodes[i].user_study_events
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_study_events" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (seq *StudyEventQuery) loadNote(ctx context.Context, query *NoteQuery, nodes []*StudyEvent, init func(*StudyEvent), assign func(*StudyEvent, *Note)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*StudyEvent)
+	for i := range nodes {
+		if nodes[i].note_study_events == nil {
+			continue
+		}
+		fk := *nodes[i].note_study_events
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(note.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "note_study_events" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (seq *StudyEventQuery) loadQuiz(ctx context.Context, query *QuizQuery, nodes []*StudyEvent, init func(*StudyEvent), assign func(*StudyEvent, *Quiz)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*StudyEvent)
+	for i := range nodes {
+		if nodes[i].quiz_study_events == nil {
+			continue
+		}
+		fk := *nodes[i].quiz_study_events
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(quiz.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "quiz_study_events" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (seq *StudyEventQuery) loadAttempt(ctx context.Context, query *QuizAttemptQuery, nodes []*StudyEvent, init func(*StudyEvent), assign func(*StudyEvent, *QuizAttempt)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*StudyEvent)
+	for i := range nodes {
+		if nodes[i].quiz_attempt_study_event == nil {
+			continue
+		}
+		fk := *nodes[i].quiz_attempt_study_event
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(quizattempt.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "quiz_attempt_study_event" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (seq *StudyEventQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := seq.querySpec()
+	_spec.Node.Columns = seq.ctx.Fields
+	if len(seq.ctx.Fields) > 0 {
+		_spec.Unique = seq.ctx.Unique != nil && *seq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, seq.driver, _spec)
+}
+
+func (seq *StudyEventQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(studyevent.Table, studyevent.Columns, sqlgraph.NewFieldSpec(studyevent.FieldID, field.TypeInt))
+	_spec.From = seq.sql
+	if unique := seq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if seq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := seq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, studyevent.FieldID)
+		for i := range fields {
+			if fields[i] != studyevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := seq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := seq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := seq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := seq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (seq *StudyEventQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(seq.driver.Dialect())
+	t1 := builder.Table(studyevent.Table)
+	columns := seq.ctx.Fields
+	if len(columns) == 0 {
+		columns = studyevent.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if seq.sql != nil {
+		selector = seq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if seq.ctx.Unique != nil && *seq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range seq.predicates {
+		p(selector)
+	}
+	for _, p := range seq.order {
+		p(selector)
+	}
+	if offset := seq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := seq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// StudyEventGroupBy is the group-by builder for StudyEvent entities.
+type StudyEventGroupBy struct {
+	selector
+	build *StudyEventQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (segb *StudyEventGroupBy) Aggregate(fns ...AggregateFunc) *StudyEventGroupBy {
+	segb.fns = append(segb.fns, fns...)
+	return segb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (segb *StudyEventGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, segb.build.ctx, ent.OpQueryGroupBy)
+	if err := segb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*StudyEventQuery, *StudyEventGroupBy](ctx, segb.build, segb, segb.build.inters, v)
+}
+
+func (segb *StudyEventGroupBy) sqlScan(ctx context.Context, root *StudyEventQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(segb.fns))
+	for _, fn := range segb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*segb.flds)+len(segb.fns))
+		for _, f := range *segb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*segb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := segb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// StudyEventSelect is the builder for selecting fields of StudyEvent entities.
+type StudyEventSelect struct {
+	*StudyEventQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ses *StudyEventSelect) Aggregate(fns ...AggregateFunc) *StudyEventSelect {
+	ses.fns = append(ses.fns, fns...)
+	return ses
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ses *StudyEventSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ses.ctx, ent.OpQuerySelect)
+	if err := ses.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*StudyEventQuery, *StudyEventSelect](ctx, ses.StudyEventQuery, ses, ses.inters, v)
+}
+
+func (ses *StudyEventSelect) sqlScan(ctx context.Context, root *StudyEventQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ses.fns))
+	for _, fn := range ses.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ses.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ses.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,859 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/r-scheele/zero/ent/note"
+	"github.com/r-scheele/zero/ent/predicate"
+	"github.com/r-scheele/zero/ent/quiz"
+	"github.com/r-scheele/zero/ent/quizattempt"
+	"github.com/r-scheele/zero/ent/studyevent"
+	"github.com/r-scheele/zero/ent/user"
+)
+
+// StudyEventUpdate is the builder for updating StudyEvent entities.
+type StudyEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *StudyEventMutation
+}
+
+// Where appends a list predicates to the StudyEventUpdate builder.
+func (seu *StudyEventUpdate) Where(ps ...predicate.StudyEvent) *StudyEventUpdate {
+	seu.mutation.Where(ps...)
+	return seu
+}
+
+// SetTopic sets the "topic" field.
+func (seu *StudyEventUpdate) SetTopic(s string) *StudyEventUpdate {
+	seu.mutation.SetTopic(s)
+	return seu
+}
+
+// SetNillableTopic sets the "topic" field if the given value is not nil.
+func (seu *StudyEventUpdate) SetNillableTopic(s *string) *StudyEventUpdate {
+	if s != nil {
+		seu.SetTopic(*s)
+	}
+	return seu
+}
+
+// SetScore sets the "score" field.
+func (seu *StudyEventUpdate) SetScore(i int) *StudyEventUpdate {
+	seu.mutation.ResetScore()
+	seu.mutation.SetScore(i)
+	return seu
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (seu *StudyEventUpdate) SetNillableScore(i *int) *StudyEventUpdate {
+	if i != nil {
+		seu.SetScore(*i)
+	}
+	return seu
+}
+
+// AddScore adds i to the "score" field.
+func (seu *StudyEventUpdate) AddScore(i int) *StudyEventUpdate {
+	seu.mutation.AddScore(i)
+	return seu
+}
+
+// ClearScore clears the value of the "score" field.
+func (seu *StudyEventUpdate) ClearScore() *StudyEventUpdate {
+	seu.mutation.ClearScore()
+	return seu
+}
+
+// SetMaxScore sets the "max_score" field.
+func (seu *StudyEventUpdate) SetMaxScore(i int) *StudyEventUpdate {
+	seu.mutation.ResetMaxScore()
+	seu.mutation.SetMaxScore(i)
+	return seu
+}
+
+// SetNillableMaxScore sets the "max_score" field if the given value is not nil.
+func (seu *StudyEventUpdate) SetNillableMaxScore(i *int) *StudyEventUpdate {
+	if i != nil {
+		seu.SetMaxScore(*i)
+	}
+	return seu
+}
+
+// AddMaxScore adds i to the "max_score" field.
+func (seu *StudyEventUpdate) AddMaxScore(i int) *StudyEventUpdate {
+	seu.mutation.AddMaxScore(i)
+	return seu
+}
+
+// ClearMaxScore clears the value of the "max_score" field.
+func (seu *StudyEventUpdate) ClearMaxScore() *StudyEventUpdate {
+	seu.mutation.ClearMaxScore()
+	return seu
+}
+
+// SetDurationSeconds sets the "duration_seconds" field.
+func (seu *StudyEventUpdate) SetDurationSeconds(i int) *StudyEventUpdate {
+	seu.mutation.ResetDurationSeconds()
+	seu.mutation.SetDurationSeconds(i)
+	return seu
+}
+
+// SetNillableDurationSeconds sets the "duration_seconds" field if the given value is not nil.
+func (seu *StudyEventUpdate) SetNillableDurationSeconds(i *int) *StudyEventUpdate {
+	if i != nil {
+		seu.SetDurationSeconds(*i)
+	}
+	return seu
+}
+
+// AddDurationSeconds adds i to the "duration_seconds" field.
+func (seu *StudyEventUpdate) AddDurationSeconds(i int) *StudyEventUpdate {
+	seu.mutation.AddDurationSeconds(i)
+	return seu
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (seu *StudyEventUpdate) SetUserID(id int) *StudyEventUpdate {
+	seu.mutation.SetUserID(id)
+	return seu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (seu *StudyEventUpdate) SetUser(u *User) *StudyEventUpdate {
+	return seu.SetUserID(u.ID)
+}
+
+// SetNoteID sets the "note" edge to the Note entity by ID.
+func (seu *StudyEventUpdate) SetNoteID(id int) *StudyEventUpdate {
+	seu.mutation.SetNoteID(id)
+	return seu
+}
+
+// SetNillableNoteID sets the "note" edge to the Note entity by ID if the given value is not nil.
+func (seu *StudyEventUpdate) SetNillableNoteID(id *int) *StudyEventUpdate {
+	if id != nil {
+		seu = seu.SetNoteID(*id)
+	}
+	return seu
+}
+
+// SetNote sets the "note" edge to the Note entity.
+func (seu *StudyEventUpdate) SetNote(n *Note) *StudyEventUpdate {
+	return seu.SetNoteID(n.ID)
+}
+
+// SetQuizID sets the "quiz" edge to the Quiz entity by ID.
+func (seu *StudyEventUpdate) SetQuizID(id int) *StudyEventUpdate {
+	seu.mutation.SetQuizID(id)
+	return seu
+}
+
+// SetNillableQuizID sets the "quiz" edge to the Quiz entity by ID if the given value is not nil.
+func (seu *StudyEventUpdate) SetNillableQuizID(id *int) *StudyEventUpdate {
+	if id != nil {
+		seu = seu.SetQuizID(*id)
+	}
+	return seu
+}
+
+// SetQuiz sets the "quiz" edge to the Quiz entity.
+func (seu *StudyEventUpdate) SetQuiz(q *Quiz) *StudyEventUpdate {
+	return seu.SetQuizID(q.ID)
+}
+
+// SetAttemptID sets the "attempt" edge to the QuizAttempt entity by ID.
+func (seu *StudyEventUpdate) SetAttemptID(id int) *StudyEventUpdate {
+	seu.mutation.SetAttemptID(id)
+	return seu
+}
+
+// SetNillableAttemptID sets the "attempt" edge to the QuizAttempt entity by ID if the given value is not nil.
+func (seu *StudyEventUpdate) SetNillableAttemptID(id *int) *StudyEventUpdate {
+	if id != nil {
+		seu = seu.SetAttemptID(*id)
+	}
+	return seu
+}
+
+// SetAttempt sets the "attempt" edge to the QuizAttempt entity.
+func (seu *StudyEventUpdate) SetAttempt(q *QuizAttempt) *StudyEventUpdate {
+	return seu.SetAttemptID(q.ID)
+}
+
+// Mutation returns the StudyEventMutation object of the builder.
+func (seu *StudyEventUpdate) Mutation() *StudyEventMutation {
+	return seu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (seu *StudyEventUpdate) ClearUser() *StudyEventUpdate {
+	seu.mutation.ClearUser()
+	return seu
+}
+
+// ClearNote clears the "note" edge to the Note entity.
+func (seu *StudyEventUpdate) ClearNote() *StudyEventUpdate {
+	seu.mutation.ClearNote()
+	return seu
+}
+
+// ClearQuiz clears the "quiz" edge to the Quiz entity.
+func (seu *StudyEventUpdate) ClearQuiz() *StudyEventUpdate {
+	seu.mutation.ClearQuiz()
+	return seu
+}
+
+// ClearAttempt clears the "attempt" edge to the QuizAttempt entity.
+func (seu *StudyEventUpdate) ClearAttempt() *StudyEventUpdate {
+	seu.mutation.ClearAttempt()
+	return seu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (seu *StudyEventUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, seu.sqlSave, seu.mutation, seu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (seu *StudyEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := seu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (seu *StudyEventUpdate) Exec(ctx context.Context) error {
+	_, err := seu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (seu *StudyEventUpdate) ExecX(ctx context.Context) {
+	if err := seu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (seu *StudyEventUpdate) check() error {
+	if v, ok := seu.mutation.DurationSeconds(); ok {
+		if err := studyevent.DurationSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "duration_seconds", err: fmt.Errorf(`ent: validator failed for field "StudyEvent.duration_seconds": %w`, err)}
+		}
+	}
+	if seu.mutation.UserCleared() && len(seu.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "StudyEvent.user"`)
+	}
+	return nil
+}
+
+func (seu *StudyEventUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := seu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(studyevent.Table, studyevent.Columns, sqlgraph.NewFieldSpec(studyevent.FieldID, field.TypeInt))
+	if ps := seu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := seu.mutation.Topic(); ok {
+		_spec.SetField(studyevent.FieldTopic, field.TypeString, value)
+	}
+	if value, ok := seu.mutation.Score(); ok {
+		_spec.SetField(studyevent.FieldScore, field.TypeInt, value)
+	}
+	if value, ok := seu.mutation.AddedScore(); ok {
+		_spec.AddField(studyevent.FieldScore, field.TypeInt, value)
+	}
+	if seu.mutation.ScoreCleared() {
+		_spec.ClearField(studyevent.FieldScore, field.TypeInt)
+	}
+	if value, ok := seu.mutation.MaxScore(); ok {
+		_spec.SetField(studyevent.FieldMaxScore, field.TypeInt, value)
+	}
+	if value, ok := seu.mutation.AddedMaxScore(); ok {
+		_spec.AddField(studyevent.FieldMaxScore, field.TypeInt, value)
+	}
+	if seu.mutation.MaxScoreCleared() {
+		_spec.ClearField(studyevent.FieldMaxScore, field.TypeInt)
+	}
+	if value, ok := seu.mutation.DurationSeconds(); ok {
+		_spec.SetField(studyevent.FieldDurationSeconds, field.TypeInt, value)
+	}
+	if value, ok := seu.mutation.AddedDurationSeconds(); ok {
+		_spec.AddField(studyevent.FieldDurationSeconds, field.TypeInt, value)
+	}
+	if seu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   studyevent.UserTable,
+			Columns: []string{studyevent.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := seu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   studyevent.UserTable,
+			Columns: []string{studyevent.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if seu.mutation.NoteCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   studyevent.NoteTable,
+			Columns: []string{studyevent.NoteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := seu.mutation.NoteIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   studyevent.NoteTable,
+			Columns: []string{studyevent.NoteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if seu.mutation.QuizCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   studyevent.QuizTable,
+			Columns: []string{studyevent.QuizColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quiz.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := seu.mutation.QuizIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   studyevent.QuizTable,
+			Columns: []string{studyevent.QuizColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quiz.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if seu.mutation.AttemptCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   studyevent.AttemptTable,
+			Columns: []string{studyevent.AttemptColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quizattempt.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := seu.mutation.AttemptIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   studyevent.AttemptTable,
+			Columns: []string{studyevent.AttemptColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quizattempt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, seu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{studyevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	seu.mutation.done = true
+	return n, nil
+}
+
+// StudyEventUpdateOne is the builder for updating a single StudyEvent entity.
+type StudyEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *StudyEventMutation
+}
+
+// SetTopic sets the "topic" field.
+func (seuo *StudyEventUpdateOne) SetTopic(s string) *StudyEventUpdateOne {
+	seuo.mutation.SetTopic(s)
+	return seuo
+}
+
+// SetNillableTopic sets the "topic" field if the given value is not nil.
+func (seuo *StudyEventUpdateOne) SetNillableTopic(s *string) *StudyEventUpdateOne {
+	if s != nil {
+		seuo.SetTopic(*s)
+	}
+	return seuo
+}
+
+// SetScore sets the "score" field.
+func (seuo *StudyEventUpdateOne) SetScore(i int) *StudyEventUpdateOne {
+	seuo.mutation.ResetScore()
+	seuo.mutation.SetScore(i)
+	return seuo
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (seuo *StudyEventUpdateOne) SetNillableScore(i *int) *StudyEventUpdateOne {
+	if i != nil {
+		seuo.SetScore(*i)
+	}
+	return seuo
+}
+
+// AddScore adds i to the "score" field.
+func (seuo *StudyEventUpdateOne) AddScore(i int) *StudyEventUpdateOne {
+	seuo.mutation.AddScore(i)
+	return seuo
+}
+
+// ClearScore clears the value of the "score" field.
+func (seuo *StudyEventUpdateOne) ClearScore() *StudyEventUpdateOne {
+	seuo.mutation.ClearScore()
+	return seuo
+}
+
+// SetMaxScore sets the "max_score" field.
+func (seuo *StudyEventUpdateOne) SetMaxScore(i int) *StudyEventUpdateOne {
+	seuo.mutation.ResetMaxScore()
+	seuo.mutation.SetMaxScore(i)
+	return seuo
+}
+
+// SetNillableMaxScore sets the "max_score" field if the given value is not nil.
+func (seuo *StudyEventUpdateOne) SetNillableMaxScore(i *int) *StudyEventUpdateOne {
+	if i != nil {
+		seuo.SetMaxScore(*i)
+	}
+	return seuo
+}
+
+// AddMaxScore adds i to the "max_score" field.
+func (seuo *StudyEventUpdateOne) AddMaxScore(i int) *StudyEventUpdateOne {
+	seuo.mutation.AddMaxScore(i)
+	return seuo
+}
+
+// ClearMaxScore clears the value of the "max_score" field.
+func (seuo *StudyEventUpdateOne) ClearMaxScore() *StudyEventUpdateOne {
+	seuo.mutation.ClearMaxScore()
+	return seuo
+}
+
+// SetDurationSeconds sets the "duration_seconds" field.
+func (seuo *StudyEventUpdateOne) SetDurationSeconds(i int) *StudyEventUpdateOne {
+	seuo.mutation.ResetDurationSeconds()
+	seuo.mutation.SetDurationSeconds(i)
+	return seuo
+}
+
+// SetNillableDurationSeconds sets the "duration_seconds" field if the given value is not nil.
+func (seuo *StudyEventUpdateOne) SetNillableDurationSeconds(i *int) *StudyEventUpdateOne {
+	if i != nil {
+		seuo.SetDurationSeconds(*i)
+	}
+	return seuo
+}
+
+// AddDurationSeconds adds i to the "duration_seconds" field.
+func (seuo *StudyEventUpdateOne) AddDurationSeconds(i int) *StudyEventUpdateOne {
+	seuo.mutation.AddDurationSeconds(i)
+	return seuo
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (seuo *StudyEventUpdateOne) SetUserID(id int) *StudyEventUpdateOne {
+	seuo.mutation.SetUserID(id)
+	return seuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (seuo *StudyEventUpdateOne) SetUser(u *User) *StudyEventUpdateOne {
+	return seuo.SetUserID(u.ID)
+}
+
+// SetNoteID sets the "note" edge to the Note entity by ID.
+func (seuo *StudyEventUpdateOne) SetNoteID(id int) *StudyEventUpdateOne {
+	seuo.mutation.SetNoteID(id)
+	return seuo
+}
+
+// SetNillableNoteID sets the "note" edge to the Note entity by ID if the given value is not nil.
+func (seuo *StudyEventUpdateOne) SetNillableNoteID(id *int) *StudyEventUpdateOne {
+	if id != nil {
+		seuo = seuo.SetNoteID(*id)
+	}
+	return seuo
+}
+
+// SetNote sets the "note" edge to the Note entity.
+func (seuo *StudyEventUpdateOne) SetNote(n *Note) *StudyEventUpdateOne {
+	return seuo.SetNoteID(n.ID)
+}
+
+// SetQuizID sets the "quiz" edge to the Quiz entity by ID.
+func (seuo *StudyEventUpdateOne) SetQuizID(id int) *StudyEventUpdateOne {
+	seuo.mutation.SetQuizID(id)
+	return seuo
+}
+
+// SetNillableQuizID sets the "quiz" edge to the Quiz entity by ID if the given value is not nil.
+func (seuo *StudyEventUpdateOne) SetNillableQuizID(id *int) *StudyEventUpdateOne {
+	if id != nil {
+		seuo = seuo.SetQuizID(*id)
+	}
+	return seuo
+}
+
+// SetQuiz sets the "quiz" edge to the Quiz entity.
+func (seuo *StudyEventUpdateOne) SetQuiz(q *Quiz) *StudyEventUpdateOne {
+	return seuo.SetQuizID(q.ID)
+}
+
+// SetAttemptID sets the "attempt" edge to the QuizAttempt entity by ID.
+func (seuo *StudyEventUpdateOne) SetAttemptID(id int) *StudyEventUpdateOne {
+	seuo.mutation.SetAttemptID(id)
+	return seuo
+}
+
+// SetNillableAttemptID sets the "attempt" edge to the QuizAttempt entity by ID if the given value is not nil.
+func (seuo *StudyEventUpdateOne) SetNillableAttemptID(id *int) *StudyEventUpdateOne {
+	if id != nil {
+		seuo = seuo.SetAttemptID(*id)
+	}
+	return seuo
+}
+
+// SetAttempt sets the "attempt" edge to the QuizAttempt entity.
+func (seuo *StudyEventUpdateOne) SetAttempt(q *QuizAttempt) *StudyEventUpdateOne {
+	return seuo.SetAttemptID(q.ID)
+}
+
+// Mutation returns the StudyEventMutation object of the builder.
+func (seuo *StudyEventUpdateOne) Mutation() *StudyEventMutation {
+	return seuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (seuo *StudyEventUpdateOne) ClearUser() *StudyEventUpdateOne {
+	seuo.mutation.ClearUser()
+	return seuo
+}
+
+// ClearNote clears the "note" edge to the Note entity.
+func (seuo *StudyEventUpdateOne) ClearNote() *StudyEventUpdateOne {
+	seuo.mutation.ClearNote()
+	return seuo
+}
+
+// ClearQuiz clears the "quiz" edge to the Quiz entity.
+func (seuo *StudyEventUpdateOne) ClearQuiz() *StudyEventUpdateOne {
+	seuo.mutation.ClearQuiz()
+	return seuo
+}
+
+// ClearAttempt clears the "attempt" edge to the QuizAttempt entity.
+func (seuo *StudyEventUpdateOne) ClearAttempt() *StudyEventUpdateOne {
+	seuo.mutation.ClearAttempt()
+	return seuo
+}
+
+// Where appends a list predicates to the StudyEventUpdate builder.
+func (seuo *StudyEventUpdateOne) Where(ps ...predicate.StudyEvent) *StudyEventUpdateOne {
+	seuo.mutation.Where(ps...)
+	return seuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (seuo *StudyEventUpdateOne) Select(field string, fields ...string) *StudyEventUpdateOne {
+	seuo.fields = append([]string{field}, fields...)
+	return seuo
+}
+
+// Save executes the query and returns the updated StudyEvent entity.
+func (seuo *StudyEventUpdateOne) Save(ctx context.Context) (*StudyEvent, error) {
+	return withHooks(ctx, seuo.sqlSave, seuo.mutation, seuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (seuo *StudyEventUpdateOne) SaveX(ctx context.Context) *StudyEvent {
+	node, err := seuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (seuo *StudyEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := seuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (seuo *StudyEventUpdateOne) ExecX(ctx context.Context) {
+	if err := seuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (seuo *StudyEventUpdateOne) check() error {
+	if v, ok := seuo.mutation.DurationSeconds(); ok {
+		if err := studyevent.DurationSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "duration_seconds", err: fmt.Errorf(`ent: validator failed for field "StudyEvent.duration_seconds": %w`, err)}
+		}
+	}
+	if seuo.mutation.UserCleared() && len(seuo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "StudyEvent.user"`)
+	}
+	return nil
+}
+
+func (seuo *StudyEventUpdateOne) sqlSave(ctx context.Context) (_node *StudyEvent, err error) {
+	if err := seuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(studyevent.Table, studyevent.Columns, sqlgraph.NewFieldSpec(studyevent.FieldID, field.TypeInt))
+	id, ok := seuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "StudyEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := seuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, studyevent.FieldID)
+		for _, f := range fields {
+			if !studyevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != studyevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := seuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := seuo.mutation.Topic(); ok {
+		_spec.SetField(studyevent.FieldTopic, field.TypeString, value)
+	}
+	if value, ok := seuo.mutation.Score(); ok {
+		_spec.SetField(studyevent.FieldScore, field.TypeInt, value)
+	}
+	if value, ok := seuo.mutation.AddedScore(); ok {
+		_spec.AddField(studyevent.FieldScore, field.TypeInt, value)
+	}
+	if seuo.mutation.ScoreCleared() {
+		_spec.ClearField(studyevent.FieldScore, field.TypeInt)
+	}
+	if value, ok := seuo.mutation.MaxScore(); ok {
+		_spec.SetField(studyevent.FieldMaxScore, field.TypeInt, value)
+	}
+	if value, ok := seuo.mutation.AddedMaxScore(); ok {
+		_spec.AddField(studyevent.FieldMaxScore, field.TypeInt, value)
+	}
+	if seuo.mutation.MaxScoreCleared() {
+		_spec.ClearField(studyevent.FieldMaxScore, field.TypeInt)
+	}
+	if value, ok := seuo.mutation.DurationSeconds(); ok {
+		_spec.SetField(studyevent.FieldDurationSeconds, field.TypeInt, value)
+	}
+	if value, ok := seuo.mutation.AddedDurationSeconds(); ok {
+		_spec.AddField(studyevent.FieldDurationSeconds, field.TypeInt, value)
+	}
+	if seuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   studyevent.UserTable,
+			Columns: []string{studyevent.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := seuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   studyevent.UserTable,
+			Columns: []string{studyevent.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if seuo.mutation.NoteCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   studyevent.NoteTable,
+			Columns: []string{studyevent.NoteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := seuo.mutation.NoteIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   studyevent.NoteTable,
+			Columns: []string{studyevent.NoteColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if seuo.mutation.QuizCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   studyevent.QuizTable,
+			Columns: []string{studyevent.QuizColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quiz.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := seuo.mutation.QuizIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   studyevent.QuizTable,
+			Columns: []string{studyevent.QuizColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quiz.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if seuo.mutation.AttemptCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   studyevent.AttemptTable,
+			Columns: []string{studyevent.AttemptColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quizattempt.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := seuo.mutation.AttemptIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   studyevent.AttemptTable,
+			Columns: []string{studyevent.AttemptColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quizattempt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &StudyEvent{config: seuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, seuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{studyevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	seuo.mutation.done = true
+	return _node, nil
+}
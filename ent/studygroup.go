@@ -0,0 +1,224 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/r-scheele/zero/ent/studygroup"
+)
+
+// StudyGroup is the model entity for the StudyGroup schema.
+type StudyGroup struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name of the study group
+	Name string `json:"name,omitempty"`
+	// What the group studies
+	Description string `json:"description,omitempty"`
+	// Anyone can join public groups; invite-only groups require an approved join request
+	Privacy studygroup.Privacy `json:"privacy,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the StudyGroupQuery when eager-loading is set.
+	Edges        StudyGroupEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// StudyGroupEdges holds the relations/edges for other nodes in the graph.
+type StudyGroupEdges struct {
+	// Memberships holds the value of the memberships edge.
+	Memberships []*GroupMembership `json:"memberships,omitempty"`
+	// JoinRequests holds the value of the join_requests edge.
+	JoinRequests []*GroupJoinRequest `json:"join_requests,omitempty"`
+	// Invites holds the value of the invites edge.
+	Invites []*GroupInvite `json:"invites,omitempty"`
+	// Notes shared into the group, readable by all members
+	SharedNotes []*Note `json:"shared_notes,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// MembershipsOrErr returns the Memberships value or an error if the edge
+// was not loaded in eager-loading.
+func (e StudyGroupEdges) MembershipsOrErr() ([]*GroupMembership, error) {
+	if e.loadedTypes[0] {
+		return e.Memberships, nil
+	}
+	return nil, &NotLoadedError{edge: "memberships"}
+}
+
+// JoinRequestsOrErr returns the JoinRequests value or an error if the edge
+// was not loaded in eager-loading.
+func (e StudyGroupEdges) JoinRequestsOrErr() ([]*GroupJoinRequest, error) {
+	if e.loadedTypes[1] {
+		return e.JoinRequests, nil
+	}
+	return nil, &NotLoadedError{edge: "join_requests"}
+}
+
+// InvitesOrErr returns the Invites value or an error if the edge
+// was not loaded in eager-loading.
+func (e StudyGroupEdges) InvitesOrErr() ([]*GroupInvite, error) {
+	if e.loadedTypes[2] {
+		return e.Invites, nil
+	}
+	return nil, &NotLoadedError{edge: "invites"}
+}
+
+// SharedNotesOrErr returns the SharedNotes value or an error if the edge
+// was not loaded in eager-loading.
+func (e StudyGroupEdges) SharedNotesOrErr() ([]*Note, error) {
+	if e.loadedTypes[3] {
+		return e.SharedNotes, nil
+	}
+	return nil, &NotLoadedError{edge: "shared_notes"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*StudyGroup) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case studygroup.FieldID:
+			values[i] = new(sql.NullInt64)
+		case studygroup.FieldName, studygroup.FieldDescription, studygroup.FieldPrivacy:
+			values[i] = new(sql.NullString)
+		case studygroup.FieldCreatedAt, studygroup.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the StudyGroup fields.
+func (sg *StudyGroup) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case studygroup.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			sg.ID = int(value.Int64)
+		case studygroup.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				sg.Name = value.String
+			}
+		case studygroup.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				sg.Description = value.String
+			}
+		case studygroup.FieldPrivacy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field privacy", values[i])
+			} else if value.Valid {
+				sg.Privacy = studygroup.Privacy(value.String)
+			}
+		case studygroup.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				sg.CreatedAt = value.Time
+			}
+		case studygroup.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				sg.UpdatedAt = value.Time
+			}
+		default:
+			sg.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the StudyGroup.
+// This includes values selected through modifiers, order, etc.
+func (sg *StudyGroup) Value(name string) (ent.Value, error) {
+	return sg.selectValues.Get(name)
+}
+
+// QueryMemberships queries the "memberships" edge of the StudyGroup entity.
+func (sg *StudyGroup) QueryMemberships() *GroupMembershipQuery {
+	return NewStudyGroupClient(sg.config).QueryMemberships(sg)
+}
+
+// QueryJoinRequests queries the "join_requests" edge of the StudyGroup entity.
+func (sg *StudyGroup) QueryJoinRequests() *GroupJoinRequestQuery {
+	return NewStudyGroupClient(sg.config).QueryJoinRequests(sg)
+}
+
+// QueryInvites queries the "invites" edge of the StudyGroup entity.
+func (sg *StudyGroup) QueryInvites() *GroupInviteQuery {
+	return NewStudyGroupClient(sg.config).QueryInvites(sg)
+}
+
+// QuerySharedNotes queries the "shared_notes" edge of the StudyGroup entity.
+func (sg *StudyGroup) QuerySharedNotes() *NoteQuery {
+	return NewStudyGroupClient(sg.config).QuerySharedNotes(sg)
+}
+
+// Update returns a builder for updating this StudyGroup.
+// Note that you need to call StudyGroup.Unwrap() before calling this method if this StudyGroup
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sg *StudyGroup) Update() *StudyGroupUpdateOne {
+	return NewStudyGroupClient(sg.config).UpdateOne(sg)
+}
+
+// Unwrap unwraps the StudyGroup entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sg *StudyGroup) Unwrap() *StudyGroup {
+	_tx, ok := sg.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: StudyGroup is not a transactional entity")
+	}
+	sg.config.driver = _tx.drv
+	return sg
+}
+
+// String implements the fmt.Stringer.
+func (sg *StudyGroup) String() string {
+	var builder strings.Builder
+	builder.WriteString("StudyGroup(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sg.ID))
+	builder.WriteString("name=")
+	builder.WriteString(sg.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(sg.Description)
+	builder.WriteString(", ")
+	builder.WriteString("privacy=")
+	builder.WriteString(fmt.Sprintf("%v", sg.Privacy))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(sg.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(sg.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// StudyGroups is a parsable slice of StudyGroup.
+type StudyGroups []*StudyGroup
@@ -0,0 +1,421 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/r-scheele/zero/ent/groupinvite"
+	"github.com/r-scheele/zero/ent/groupjoinrequest"
+	"github.com/r-scheele/zero/ent/groupmembership"
+	"github.com/r-scheele/zero/ent/note"
+	"github.com/r-scheele/zero/ent/studygroup"
+)
+
+// StudyGroupCreate is the builder for creating a StudyGroup entity.
+type StudyGroupCreate struct {
+	config
+	mutation *StudyGroupMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (sgc *StudyGroupCreate) SetName(s string) *StudyGroupCreate {
+	sgc.mutation.SetName(s)
+	return sgc
+}
+
+// SetDescription sets the "description" field.
+func (sgc *StudyGroupCreate) SetDescription(s string) *StudyGroupCreate {
+	sgc.mutation.SetDescription(s)
+	return sgc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (sgc *StudyGroupCreate) SetNillableDescription(s *string) *StudyGroupCreate {
+	if s != nil {
+		sgc.SetDescription(*s)
+	}
+	return sgc
+}
+
+// SetPrivacy sets the "privacy" field.
+func (sgc *StudyGroupCreate) SetPrivacy(s studygroup.Privacy) *StudyGroupCreate {
+	sgc.mutation.SetPrivacy(s)
+	return sgc
+}
+
+// SetNillablePrivacy sets the "privacy" field if the given value is not nil.
+func (sgc *StudyGroupCreate) SetNillablePrivacy(s *studygroup.Privacy) *StudyGroupCreate {
+	if s != nil {
+		sgc.SetPrivacy(*s)
+	}
+	return sgc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sgc *StudyGroupCreate) SetCreatedAt(t time.Time) *StudyGroupCreate {
+	sgc.mutation.SetCreatedAt(t)
+	return sgc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sgc *StudyGroupCreate) SetNillableCreatedAt(t *time.Time) *StudyGroupCreate {
+	if t != nil {
+		sgc.SetCreatedAt(*t)
+	}
+	return sgc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sgc *StudyGroupCreate) SetUpdatedAt(t time.Time) *StudyGroupCreate {
+	sgc.mutation.SetUpdatedAt(t)
+	return sgc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (sgc *StudyGroupCreate) SetNillableUpdatedAt(t *time.Time) *StudyGroupCreate {
+	if t != nil {
+		sgc.SetUpdatedAt(*t)
+	}
+	return sgc
+}
+
+// AddMembershipIDs adds the "memberships" edge to the GroupMembership entity by IDs.
+func (sgc *StudyGroupCreate) AddMembershipIDs(ids ...int) *StudyGroupCreate {
+	sgc.mutation.AddMembershipIDs(ids...)
+	return sgc
+}
+
+// AddMemberships adds the "memberships" edges to the GroupMembership entity.
+func (sgc *StudyGroupCreate) AddMemberships(g ...*GroupMembership) *StudyGroupCreate {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return sgc.AddMembershipIDs(ids...)
+}
+
+// AddJoinRequestIDs adds the "join_requests" edge to the GroupJoinRequest entity by IDs.
+func (sgc *StudyGroupCreate) AddJoinRequestIDs(ids ...int) *StudyGroupCreate {
+	sgc.mutation.AddJoinRequestIDs(ids...)
+	return sgc
+}
+
+// AddJoinRequests adds the "join_requests" edges to the GroupJoinRequest entity.
+func (sgc *StudyGroupCreate) AddJoinRequests(g ...*GroupJoinRequest) *StudyGroupCreate {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return sgc.AddJoinRequestIDs(ids...)
+}
+
+// AddInviteIDs adds the "invites" edge to the GroupInvite entity by IDs.
+func (sgc *StudyGroupCreate) AddInviteIDs(ids ...int) *StudyGroupCreate {
+	sgc.mutation.AddInviteIDs(ids...)
+	return sgc
+}
+
+// AddInvites adds the "invites" edges to the GroupInvite entity.
+func (sgc *StudyGroupCreate) AddInvites(g ...*GroupInvite) *StudyGroupCreate {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return sgc.AddInviteIDs(ids...)
+}
+
+// AddSharedNoteIDs adds the "shared_notes" edge to the Note entity by IDs.
+func (sgc *StudyGroupCreate) AddSharedNoteIDs(ids ...int) *StudyGroupCreate {
+	sgc.mutation.AddSharedNoteIDs(ids...)
+	return sgc
+}
+
+// AddSharedNotes adds the "shared_notes" edges to the Note entity.
+func (sgc *StudyGroupCreate) AddSharedNotes(n ...*Note) *StudyGroupCreate {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return sgc.AddSharedNoteIDs(ids...)
+}
+
+// Mutation returns the StudyGroupMutation object of the builder.
+func (sgc *StudyGroupCreate) Mutation() *StudyGroupMutation {
+	return sgc.mutation
+}
+
+// Save creates the StudyGroup in the database.
+func (sgc *StudyGroupCreate) Save(ctx context.Context) (*StudyGroup, error) {
+	sgc.defaults()
+	return withHooks(ctx, sgc.sqlSave, sgc.mutation, sgc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sgc *StudyGroupCreate) SaveX(ctx context.Context) *StudyGroup {
+	v, err := sgc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sgc *StudyGroupCreate) Exec(ctx context.Context) error {
+	_, err := sgc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sgc *StudyGroupCreate) ExecX(ctx context.Context) {
+	if err := sgc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sgc *StudyGroupCreate) defaults() {
+	if _, ok := sgc.mutation.Privacy(); !ok {
+		v := studygroup.DefaultPrivacy
+		sgc.mutation.SetPrivacy(v)
+	}
+	if _, ok := sgc.mutation.CreatedAt(); !ok {
+		v := studygroup.DefaultCreatedAt()
+		sgc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := sgc.mutation.UpdatedAt(); !ok {
+		v := studygroup.DefaultUpdatedAt()
+		sgc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sgc *StudyGroupCreate) check() error {
+	if _, ok := sgc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "StudyGroup.name"`)}
+	}
+	if v, ok := sgc.mutation.Name(); ok {
+		if err := studygroup.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "StudyGroup.name": %w`, err)}
+		}
+	}
+	if _, ok := sgc.mutation.Privacy(); !ok {
+		return &ValidationError{Name: "privacy", err: errors.New(`ent: missing required field "StudyGroup.privacy"`)}
+	}
+	if v, ok := sgc.mutation.Privacy(); ok {
+		if err := studygroup.PrivacyValidator(v); err != nil {
+			return &ValidationError{Name: "privacy", err: fmt.Errorf(`ent: validator failed for field "StudyGroup.privacy": %w`, err)}
+		}
+	}
+	if _, ok := sgc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "StudyGroup.created_at"`)}
+	}
+	if _, ok := sgc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "StudyGroup.updated_at"`)}
+	}
+	return nil
+}
+
+func (sgc *StudyGroupCreate) sqlSave(ctx context.Context) (*StudyGroup, error) {
+	if err := sgc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sgc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sgc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	sgc.mutation.id = &_node.ID
+	sgc.mutation.done = true
+	return _node, nil
+}
+
+func (sgc *StudyGroupCreate) createSpec() (*StudyGroup, *sqlgraph.CreateSpec) {
+	var (
+		_node = &StudyGroup{config: sgc.config}
+		_spec = sqlgraph.NewCreateSpec(studygroup.Table, sqlgraph.NewFieldSpec(studygroup.FieldID, field.TypeInt))
+	)
+	if value, ok := sgc.mutation.Name(); ok {
+		_spec.SetField(studygroup.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := sgc.mutation.Description(); ok {
+		_spec.SetField(studygroup.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := sgc.mutation.Privacy(); ok {
+		_spec.SetField(studygroup.FieldPrivacy, field.TypeEnum, value)
+		_node.Privacy = value
+	}
+	if value, ok := sgc.mutation.CreatedAt(); ok {
+		_spec.SetField(studygroup.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := sgc.mutation.UpdatedAt(); ok {
+		_spec.SetField(studygroup.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := sgc.mutation.MembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   studygroup.MembershipsTable,
+			Columns: []string{studygroup.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupmembership.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sgc.mutation.JoinRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   studygroup.JoinRequestsTable,
+			Columns: []string{studygroup.JoinRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupjoinrequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sgc.mutation.InvitesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   studygroup.InvitesTable,
+			Columns: []string{studygroup.InvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupinvite.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sgc.mutation.SharedNotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   studygroup.SharedNotesTable,
+			Columns: studygroup.SharedNotesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// StudyGroupCreateBulk is the builder for creating many StudyGroup entities in bulk.
+type StudyGroupCreateBulk struct {
+	config
+	err      error
+	builders []*StudyGroupCreate
+}
+
+// Save creates the StudyGroup entities in the database.
+func (sgcb *StudyGroupCreateBulk) Save(ctx context.Context) ([]*StudyGroup, error) {
+	if sgcb.err != nil {
+		return nil, sgcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(sgcb.builders))
+	nodes := make([]*StudyGroup, len(sgcb.builders))
+	mutators := make([]Mutator, len(sgcb.builders))
+	for i := range sgcb.builders {
+		func(i int, root context.Context) {
+			builder := sgcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*StudyGroupMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sgcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sgcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sgcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sgcb *StudyGroupCreateBulk) SaveX(ctx context.Context) []*StudyGroup {
+	v, err := sgcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sgcb *StudyGroupCreateBulk) Exec(ctx context.Context) error {
+	_, err := sgcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sgcb *StudyGroupCreateBulk) ExecX(ctx context.Context) {
+	if err := sgcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/r-scheele/zero/ent/predicate"
+	"github.com/r-scheele/zero/ent/studygroup"
+)
+
+// StudyGroupDelete is the builder for deleting a StudyGroup entity.
+type StudyGroupDelete struct {
+	config
+	hooks    []Hook
+	mutation *StudyGroupMutation
+}
+
+// Where appends a list predicates to the StudyGroupDelete builder.
+func (sgd *StudyGroupDelete) Where(ps ...predicate.StudyGroup) *StudyGroupDelete {
+	sgd.mutation.Where(ps...)
+	return sgd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (sgd *StudyGroupDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, sgd.sqlExec, sgd.mutation, sgd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sgd *StudyGroupDelete) ExecX(ctx context.Context) int {
+	n, err := sgd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (sgd *StudyGroupDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(studygroup.Table, sqlgraph.NewFieldSpec(studygroup.FieldID, field.TypeInt))
+	if ps := sgd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, sgd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	sgd.mutation.done = true
+	return affected, err
+}
+
+// StudyGroupDeleteOne is the builder for deleting a single StudyGroup entity.
+type StudyGroupDeleteOne struct {
+	sgd *StudyGroupDelete
+}
+
+// Where appends a list predicates to the StudyGroupDelete builder.
+func (sgdo *StudyGroupDeleteOne) Where(ps ...predicate.StudyGroup) *StudyGroupDeleteOne {
+	sgdo.sgd.mutation.Where(ps...)
+	return sgdo
+}
+
+// Exec executes the deletion query.
+func (sgdo *StudyGroupDeleteOne) Exec(ctx context.Context) error {
+	n, err := sgdo.sgd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{studygroup.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sgdo *StudyGroupDeleteOne) ExecX(ctx context.Context) {
+	if err := sgdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,862 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/r-scheele/zero/ent/groupinvite"
+	"github.com/r-scheele/zero/ent/groupjoinrequest"
+	"github.com/r-scheele/zero/ent/groupmembership"
+	"github.com/r-scheele/zero/ent/note"
+	"github.com/r-scheele/zero/ent/predicate"
+	"github.com/r-scheele/zero/ent/studygroup"
+)
+
+// StudyGroupQuery is the builder for querying StudyGroup entities.
+type StudyGroupQuery struct {
+	config
+	ctx              *QueryContext
+	order            []studygroup.OrderOption
+	inters           []Interceptor
+	predicates       []predicate.StudyGroup
+	withMemberships  *GroupMembershipQuery
+	withJoinRequests *GroupJoinRequestQuery
+	withInvites      *GroupInviteQuery
+	withSharedNotes  *NoteQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the StudyGroupQuery builder.
+func (sgq *StudyGroupQuery) Where(ps ...predicate.StudyGroup) *StudyGroupQuery {
+	sgq.predicates = append(sgq.predicates, ps...)
+	return sgq
+}
+
+// Limit the number of records to be returned by this query.
+func (sgq *StudyGroupQuery) Limit(limit int) *StudyGroupQuery {
+	sgq.ctx.Limit = &limit
+	return sgq
+}
+
+// Offset to start from.
+func (sgq *StudyGroupQuery) Offset(offset int) *StudyGroupQuery {
+	sgq.ctx.Offset = &offset
+	return sgq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (sgq *StudyGroupQuery) Unique(unique bool) *StudyGroupQuery {
+	sgq.ctx.Unique = &unique
+	return sgq
+}
+
+// Order specifies how the records should be ordered.
+func (sgq *StudyGroupQuery) Order(o ...studygroup.OrderOption) *StudyGroupQuery {
+	sgq.order = append(sgq.order, o...)
+	return sgq
+}
+
+// QueryMemberships chains the current query on the "memberships" edge.
+func (sgq *StudyGroupQuery) QueryMemberships() *GroupMembershipQuery {
+	query := (&GroupMembershipClient{config: sgq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := sgq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := sgq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(studygroup.Table, studygroup.FieldID, selector),
+			sqlgraph.To(groupmembership.Table, groupmembership.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, studygroup.MembershipsTable, studygroup.MembershipsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(sgq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryJoinRequests chains the current query on the "join_requests" edge.
+func (sgq *StudyGroupQuery) QueryJoinRequests() *GroupJoinRequestQuery {
+	query := (&GroupJoinRequestClient{config: sgq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := sgq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := sgq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(studygroup.Table, studygroup.FieldID, selector),
+			sqlgraph.To(groupjoinrequest.Table, groupjoinrequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, studygroup.JoinRequestsTable, studygroup.JoinRequestsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(sgq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryInvites chains the current query on the "invites" edge.
+func (sgq *StudyGroupQuery) QueryInvites() *GroupInviteQuery {
+	query := (&GroupInviteClient{config: sgq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := sgq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := sgq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(studygroup.Table, studygroup.FieldID, selector),
+			sqlgraph.To(groupinvite.Table, groupinvite.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, studygroup.InvitesTable, studygroup.InvitesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(sgq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySharedNotes chains the current query on the "shared_notes" edge.
+func (sgq *StudyGroupQuery) QuerySharedNotes() *NoteQuery {
+	query := (&NoteClient{config: sgq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := sgq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := sgq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(studygroup.Table, studygroup.FieldID, selector),
+			sqlgraph.To(note.Table, note.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, studygroup.SharedNotesTable, studygroup.SharedNotesPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(sgq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first StudyGroup entity from the query.
+// Returns a *NotFoundError when no StudyGroup was found.
+func (sgq *StudyGroupQuery) First(ctx context.Context) (*StudyGroup, error) {
+	nodes, err := sgq.Limit(1).All(setContextOp(ctx, sgq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{studygroup.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (sgq *StudyGroupQuery) FirstX(ctx context.Context) *StudyGroup {
+	node, err := sgq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first StudyGroup ID from the query.
+// Returns a *NotFoundError when no StudyGroup ID was found.
+func (sgq *StudyGroupQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = sgq.Limit(1).IDs(setContextOp(ctx, sgq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{studygroup.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (sgq *StudyGroupQuery) FirstIDX(ctx context.Context) int {
+	id, err := sgq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single StudyGroup entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one StudyGroup entity is found.
+// Returns a *NotFoundError when no StudyGroup entities are found.
+func (sgq *StudyGroupQuery) Only(ctx context.Context) (*StudyGroup, error) {
+	nodes, err := sgq.Limit(2).All(setContextOp(ctx, sgq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{studygroup.Label}
+	default:
+		return nil, &NotSingularError{studygroup.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (sgq *StudyGroupQuery) OnlyX(ctx context.Context) *StudyGroup {
+	node, err := sgq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only StudyGroup ID in the query.
+// Returns a *NotSingularError when more than one StudyGroup ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (sgq *StudyGroupQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = sgq.Limit(2).IDs(setContextOp(ctx, sgq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{studygroup.Label}
+	default:
+		err = &NotSingularError{studygroup.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (sgq *StudyGroupQuery) OnlyIDX(ctx context.Context) int {
+	id, err := sgq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of StudyGroups.
+func (sgq *StudyGroupQuery) All(ctx context.Context) ([]*StudyGroup, error) {
+	ctx = setContextOp(ctx, sgq.ctx, ent.OpQueryAll)
+	if err := sgq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*StudyGroup, *StudyGroupQuery]()
+	return withInterceptors[[]*StudyGroup](ctx, sgq, qr, sgq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (sgq *StudyGroupQuery) AllX(ctx context.Context) []*StudyGroup {
+	nodes, err := sgq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of StudyGroup IDs.
+func (sgq *StudyGroupQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if sgq.ctx.Unique == nil && sgq.path != nil {
+		sgq.Unique(true)
+	}
+	ctx = setContextOp(ctx, sgq.ctx, ent.OpQueryIDs)
+	if err = sgq.Select(studygroup.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (sgq *StudyGroupQuery) IDsX(ctx context.Context) []int {
+	ids, err := sgq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (sgq *StudyGroupQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, sgq.ctx, ent.OpQueryCount)
+	if err := sgq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, sgq, querierCount[*StudyGroupQuery](), sgq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (sgq *StudyGroupQuery) CountX(ctx context.Context) int {
+	count, err := sgq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (sgq *StudyGroupQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, sgq.ctx, ent.OpQueryExist)
+	switch _, err := sgq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (sgq *StudyGroupQuery) ExistX(ctx context.Context) bool {
+	exist, err := sgq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the StudyGroupQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (sgq *StudyGroupQuery) Clone() *StudyGroupQuery {
+	if sgq == nil {
+		return nil
+	}
+	return &StudyGroupQuery{
+		config:           sgq.config,
+		ctx:              sgq.ctx.Clone(),
+		order:            append([]studygroup.OrderOption{}, sgq.order...),
+		inters:           append([]Interceptor{}, sgq.inters...),
+		predicates:       append([]predicate.StudyGroup{}, sgq.predicates...),
+		withMemberships:  sgq.withMemberships.Clone(),
+		withJoinRequests: sgq.withJoinRequests.Clone(),
+		withInvites:      sgq.withInvites.Clone(),
+		withSharedNotes:  sgq.withSharedNotes.Clone(),
+		// clone intermediate query.
+		sql:  sgq.sql.Clone(),
+		path: sgq.path,
+	}
+}
+
+// WithMemberships tells the query-builder to eager-load the nodes that are connected to
+// the "memberships" edge. The optional arguments are used to configure the query builder of the edge.
+func (sgq *StudyGroupQuery) WithMemberships(opts ...func(*GroupMembershipQuery)) *StudyGroupQuery {
+	query := (&GroupMembershipClient{config: sgq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	sgq.withMemberships = query
+	return sgq
+}
+
+// WithJoinRequests tells the query-builder to eager-load the nodes that are connected to
+// the "join_requests" edge. The optional arguments are used to configure the query builder of the edge.
+func (sgq *StudyGroupQuery) WithJoinRequests(opts ...func(*GroupJoinRequestQuery)) *StudyGroupQuery {
+	query := (&GroupJoinRequestClient{config: sgq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	sgq.withJoinRequests = query
+	return sgq
+}
+
+// WithInvites tells the query-builder to eager-load the nodes that are connected to
+// the "invites" edge. The optional arguments are used to configure the query builder of the edge.
+func (sgq *StudyGroupQuery) WithInvites(opts ...func(*GroupInviteQuery)) *StudyGroupQuery {
+	query := (&GroupInviteClient{config: sgq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	sgq.withInvites = query
+	return sgq
+}
+
+// WithSharedNotes tells the query-builder to eager-load the nodes that are connected to
+// the "shared_notes" edge. The optional arguments are used to configure the query builder of the edge.
+func (sgq *StudyGroupQuery) WithSharedNotes(opts ...func(*NoteQuery)) *StudyGroupQuery {
+	query := (&NoteClient{config: sgq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	sgq.withSharedNotes = query
+	return sgq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.StudyGroup.Query().
+//		GroupBy(studygroup.FieldName).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (sgq *StudyGroupQuery) GroupBy(field string, fields ...string) *StudyGroupGroupBy {
+	sgq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &StudyGroupGroupBy{build: sgq}
+	grbuild.flds = &sgq.ctx.Fields
+	grbuild.label = studygroup.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.StudyGroup.Query().
+//		Select(studygroup.FieldName).
+//		Scan(ctx, &v)
+func (sgq *StudyGroupQuery) Select(fields ...string) *StudyGroupSelect {
+	sgq.ctx.Fields = append(sgq.ctx.Fields, fields...)
+	sbuild := &StudyGroupSelect{StudyGroupQuery: sgq}
+	sbuild.label = studygroup.Label
+	sbuild.flds, sbuild.scan = &sgq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a StudyGroupSelect configured with the given aggregations.
+func (sgq *StudyGroupQuery) Aggregate(fns ...AggregateFunc) *StudyGroupSelect {
+	return sgq.Select().Aggregate(fns...)
+}
+
+func (sgq *StudyGroupQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range sgq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, sgq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range sgq.ctx.Fields {
+		if !studygroup.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if sgq.path != nil {
+		prev, err := sgq.path(ctx)
+		if err != nil {
+			return err
+		}
+		sgq.sql = prev
+	}
+	return nil
+}
+
+func (sgq *StudyGroupQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*StudyGroup, error) {
+	var (
+		nodes       = []*StudyGroup{}
+		_spec       = sgq.querySpec()
+		loadedTypes = [4]bool{
+			sgq.withMemberships != nil,
+			sgq.withJoinRequests != nil,
+			sgq.withInvites != nil,
+			sgq.withSharedNotes != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*StudyGroup).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &StudyGroup{config: sgq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, sgq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := sgq.withMemberships; query != nil {
+		if err := sgq.loadMemberships(ctx, query, nodes,
+			func(n *StudyGroup) { n.Edges.Memberships = []*GroupMembership{} },
+			func(n *StudyGroup, e *GroupMembership) { n.Edges.Memberships = append(n.Edges.Memberships, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := sgq.withJoinRequests; query != nil {
+		if err := sgq.loadJoinRequests(ctx, query, nodes,
+			func(n *StudyGroup) { n.Edges.JoinRequests = []*GroupJoinRequest{} },
+			func(n *StudyGroup, e *GroupJoinRequest) { n.Edges.JoinRequests = append(n.Edges.JoinRequests, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := sgq.withInvites; query != nil {
+		if err := sgq.loadInvites(ctx, query, nodes,
+			func(n *StudyGroup) { n.Edges.Invites = []*GroupInvite{} },
+			func(n *StudyGroup, e *GroupInvite) { n.Edges.Invites = append(n.Edges.Invites, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := sgq.withSharedNotes; query != nil {
+		if err := sgq.loadSharedNotes(ctx, query, nodes,
+			func(n *StudyGroup) { n.Edges.SharedNotes = []*Note{} },
+			func(n *StudyGroup, e *Note) { n.Edges.SharedNotes = append(n.Edges.SharedNotes, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (sgq *StudyGroupQuery) loadMemberships(ctx context.Context, query *GroupMembershipQuery, nodes []*StudyGroup, init func(*StudyGroup), assign func(*StudyGroup, *GroupMembership)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*StudyGroup)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.GroupMembership(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(studygroup.MembershipsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.study_group_memberships
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "study_group_memberships" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "study_group_memberships" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (sgq *StudyGroupQuery) loadJoinRequests(ctx context.Context, query *GroupJoinRequestQuery, nodes []*StudyGroup, init func(*StudyGroup), assign func(*StudyGroup, *GroupJoinRequest)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*StudyGroup)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.GroupJoinRequest(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(studygroup.JoinRequestsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.study_group_join_requests
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "study_group_join_requests" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "study_group_join_requests" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (sgq *StudyGroupQuery) loadInvites(ctx context.Context, query *GroupInviteQuery, nodes []*StudyGroup, init func(*StudyGroup), assign func(*StudyGroup, *GroupInvite)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*StudyGroup)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.GroupInvite(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(studygroup.InvitesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.study_group_invites
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "study_group_invites" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "study_group_invites" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (sgq *StudyGroupQuery) loadSharedNotes(ctx context.Context, query *NoteQuery, nodes []*StudyGroup, init func(*StudyGroup), assign func(*StudyGroup, *Note)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*StudyGroup)
+	nids := make(map[int]map[*StudyGroup]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(studygroup.SharedNotesTable)
+		s.Join(joinT).On(s.C(note.FieldID), joinT.C(studygroup.SharedNotesPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(studygroup.SharedNotesPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(studygroup.SharedNotesPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*StudyGroup]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Note](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "shared_notes" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+
+func (sgq *StudyGroupQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := sgq.querySpec()
+	_spec.Node.Columns = sgq.ctx.Fields
+	if len(sgq.ctx.Fields) > 0 {
+		_spec.Unique = sgq.ctx.Unique != nil && *sgq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, sgq.driver, _spec)
+}
+
+func (sgq *StudyGroupQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(studygroup.Table, studygroup.Columns, sqlgraph.NewFieldSpec(studygroup.FieldID, field.TypeInt))
+	_spec.From = sgq.sql
+	if unique := sgq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if sgq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := sgq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, studygroup.FieldID)
+		for i := range fields {
+			if fields[i] != studygroup.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := sgq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := sgq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := sgq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := sgq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (sgq *StudyGroupQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(sgq.driver.Dialect())
+	t1 := builder.Table(studygroup.Table)
+	columns := sgq.ctx.Fields
+	if len(columns) == 0 {
+		columns = studygroup.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if sgq.sql != nil {
+		selector = sgq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if sgq.ctx.Unique != nil && *sgq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range sgq.predicates {
+		p(selector)
+	}
+	for _, p := range sgq.order {
+		p(selector)
+	}
+	if offset := sgq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := sgq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// StudyGroupGroupBy is the group-by builder for StudyGroup entities.
+type StudyGroupGroupBy struct {
+	selector
+	build *StudyGroupQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (sggb *StudyGroupGroupBy) Aggregate(fns ...AggregateFunc) *StudyGroupGroupBy {
+	sggb.fns = append(sggb.fns, fns...)
+	return sggb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sggb *StudyGroupGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sggb.build.ctx, ent.OpQueryGroupBy)
+	if err := sggb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*StudyGroupQuery, *StudyGroupGroupBy](ctx, sggb.build, sggb, sggb.build.inters, v)
+}
+
+func (sggb *StudyGroupGroupBy) sqlScan(ctx context.Context, root *StudyGroupQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(sggb.fns))
+	for _, fn := range sggb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*sggb.flds)+len(sggb.fns))
+		for _, f := range *sggb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*sggb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sggb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// StudyGroupSelect is the builder for selecting fields of StudyGroup entities.
+type StudyGroupSelect struct {
+	*StudyGroupQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (sgs *StudyGroupSelect) Aggregate(fns ...AggregateFunc) *StudyGroupSelect {
+	sgs.fns = append(sgs.fns, fns...)
+	return sgs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sgs *StudyGroupSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sgs.ctx, ent.OpQuerySelect)
+	if err := sgs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*StudyGroupQuery, *StudyGroupSelect](ctx, sgs.StudyGroupQuery, sgs, sgs.inters, v)
+}
+
+func (sgs *StudyGroupSelect) sqlScan(ctx context.Context, root *StudyGroupQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(sgs.fns))
+	for _, fn := range sgs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*sgs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sgs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,1020 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/r-scheele/zero/ent/groupinvite"
+	"github.com/r-scheele/zero/ent/groupjoinrequest"
+	"github.com/r-scheele/zero/ent/groupmembership"
+	"github.com/r-scheele/zero/ent/note"
+	"github.com/r-scheele/zero/ent/predicate"
+	"github.com/r-scheele/zero/ent/studygroup"
+)
+
+// StudyGroupUpdate is the builder for updating StudyGroup entities.
+type StudyGroupUpdate struct {
+	config
+	hooks    []Hook
+	mutation *StudyGroupMutation
+}
+
+// Where appends a list predicates to the StudyGroupUpdate builder.
+func (sgu *StudyGroupUpdate) Where(ps ...predicate.StudyGroup) *StudyGroupUpdate {
+	sgu.mutation.Where(ps...)
+	return sgu
+}
+
+// SetName sets the "name" field.
+func (sgu *StudyGroupUpdate) SetName(s string) *StudyGroupUpdate {
+	sgu.mutation.SetName(s)
+	return sgu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (sgu *StudyGroupUpdate) SetNillableName(s *string) *StudyGroupUpdate {
+	if s != nil {
+		sgu.SetName(*s)
+	}
+	return sgu
+}
+
+// SetDescription sets the "description" field.
+func (sgu *StudyGroupUpdate) SetDescription(s string) *StudyGroupUpdate {
+	sgu.mutation.SetDescription(s)
+	return sgu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (sgu *StudyGroupUpdate) SetNillableDescription(s *string) *StudyGroupUpdate {
+	if s != nil {
+		sgu.SetDescription(*s)
+	}
+	return sgu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (sgu *StudyGroupUpdate) ClearDescription() *StudyGroupUpdate {
+	sgu.mutation.ClearDescription()
+	return sgu
+}
+
+// SetPrivacy sets the "privacy" field.
+func (sgu *StudyGroupUpdate) SetPrivacy(s studygroup.Privacy) *StudyGroupUpdate {
+	sgu.mutation.SetPrivacy(s)
+	return sgu
+}
+
+// SetNillablePrivacy sets the "privacy" field if the given value is not nil.
+func (sgu *StudyGroupUpdate) SetNillablePrivacy(s *studygroup.Privacy) *StudyGroupUpdate {
+	if s != nil {
+		sgu.SetPrivacy(*s)
+	}
+	return sgu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sgu *StudyGroupUpdate) SetUpdatedAt(t time.Time) *StudyGroupUpdate {
+	sgu.mutation.SetUpdatedAt(t)
+	return sgu
+}
+
+// AddMembershipIDs adds the "memberships" edge to the GroupMembership entity by IDs.
+func (sgu *StudyGroupUpdate) AddMembershipIDs(ids ...int) *StudyGroupUpdate {
+	sgu.mutation.AddMembershipIDs(ids...)
+	return sgu
+}
+
+// AddMemberships adds the "memberships" edges to the GroupMembership entity.
+func (sgu *StudyGroupUpdate) AddMemberships(g ...*GroupMembership) *StudyGroupUpdate {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return sgu.AddMembershipIDs(ids...)
+}
+
+// AddJoinRequestIDs adds the "join_requests" edge to the GroupJoinRequest entity by IDs.
+func (sgu *StudyGroupUpdate) AddJoinRequestIDs(ids ...int) *StudyGroupUpdate {
+	sgu.mutation.AddJoinRequestIDs(ids...)
+	return sgu
+}
+
+// AddJoinRequests adds the "join_requests" edges to the GroupJoinRequest entity.
+func (sgu *StudyGroupUpdate) AddJoinRequests(g ...*GroupJoinRequest) *StudyGroupUpdate {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return sgu.AddJoinRequestIDs(ids...)
+}
+
+// AddInviteIDs adds the "invites" edge to the GroupInvite entity by IDs.
+func (sgu *StudyGroupUpdate) AddInviteIDs(ids ...int) *StudyGroupUpdate {
+	sgu.mutation.AddInviteIDs(ids...)
+	return sgu
+}
+
+// AddInvites adds the "invites" edges to the GroupInvite entity.
+func (sgu *StudyGroupUpdate) AddInvites(g ...*GroupInvite) *StudyGroupUpdate {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return sgu.AddInviteIDs(ids...)
+}
+
+// AddSharedNoteIDs adds the "shared_notes" edge to the Note entity by IDs.
+func (sgu *StudyGroupUpdate) AddSharedNoteIDs(ids ...int) *StudyGroupUpdate {
+	sgu.mutation.AddSharedNoteIDs(ids...)
+	return sgu
+}
+
+// AddSharedNotes adds the "shared_notes" edges to the Note entity.
+func (sgu *StudyGroupUpdate) AddSharedNotes(n ...*Note) *StudyGroupUpdate {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return sgu.AddSharedNoteIDs(ids...)
+}
+
+// Mutation returns the StudyGroupMutation object of the builder.
+func (sgu *StudyGroupUpdate) Mutation() *StudyGroupMutation {
+	return sgu.mutation
+}
+
+// ClearMemberships clears all "memberships" edges to the GroupMembership entity.
+func (sgu *StudyGroupUpdate) ClearMemberships() *StudyGroupUpdate {
+	sgu.mutation.ClearMemberships()
+	return sgu
+}
+
+// RemoveMembershipIDs removes the "memberships" edge to GroupMembership entities by IDs.
+func (sgu *StudyGroupUpdate) RemoveMembershipIDs(ids ...int) *StudyGroupUpdate {
+	sgu.mutation.RemoveMembershipIDs(ids...)
+	return sgu
+}
+
+// RemoveMemberships removes "memberships" edges to GroupMembership entities.
+func (sgu *StudyGroupUpdate) RemoveMemberships(g ...*GroupMembership) *StudyGroupUpdate {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return sgu.RemoveMembershipIDs(ids...)
+}
+
+// ClearJoinRequests clears all "join_requests" edges to the GroupJoinRequest entity.
+func (sgu *StudyGroupUpdate) ClearJoinRequests() *StudyGroupUpdate {
+	sgu.mutation.ClearJoinRequests()
+	return sgu
+}
+
+// RemoveJoinRequestIDs removes the "join_requests" edge to GroupJoinRequest entities by IDs.
+func (sgu *StudyGroupUpdate) RemoveJoinRequestIDs(ids ...int) *StudyGroupUpdate {
+	sgu.mutation.RemoveJoinRequestIDs(ids...)
+	return sgu
+}
+
+// RemoveJoinRequests removes "join_requests" edges to GroupJoinRequest entities.
+func (sgu *StudyGroupUpdate) RemoveJoinRequests(g ...*GroupJoinRequest) *StudyGroupUpdate {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return sgu.RemoveJoinRequestIDs(ids...)
+}
+
+// ClearInvites clears all "invites" edges to the GroupInvite entity.
+func (sgu *StudyGroupUpdate) ClearInvites() *StudyGroupUpdate {
+	sgu.mutation.ClearInvites()
+	return sgu
+}
+
+// RemoveInviteIDs removes the "invites" edge to GroupInvite entities by IDs.
+func (sgu *StudyGroupUpdate) RemoveInviteIDs(ids ...int) *StudyGroupUpdate {
+	sgu.mutation.RemoveInviteIDs(ids...)
+	return sgu
+}
+
+// RemoveInvites removes "invites" edges to GroupInvite entities.
+func (sgu *StudyGroupUpdate) RemoveInvites(g ...*GroupInvite) *StudyGroupUpdate {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return sgu.RemoveInviteIDs(ids...)
+}
+
+// ClearSharedNotes clears all "shared_notes" edges to the Note entity.
+func (sgu *StudyGroupUpdate) ClearSharedNotes() *StudyGroupUpdate {
+	sgu.mutation.ClearSharedNotes()
+	return sgu
+}
+
+// RemoveSharedNoteIDs removes the "shared_notes" edge to Note entities by IDs.
+func (sgu *StudyGroupUpdate) RemoveSharedNoteIDs(ids ...int) *StudyGroupUpdate {
+	sgu.mutation.RemoveSharedNoteIDs(ids...)
+	return sgu
+}
+
+// RemoveSharedNotes removes "shared_notes" edges to Note entities.
+func (sgu *StudyGroupUpdate) RemoveSharedNotes(n ...*Note) *StudyGroupUpdate {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return sgu.RemoveSharedNoteIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (sgu *StudyGroupUpdate) Save(ctx context.Context) (int, error) {
+	sgu.defaults()
+	return withHooks(ctx, sgu.sqlSave, sgu.mutation, sgu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sgu *StudyGroupUpdate) SaveX(ctx context.Context) int {
+	affected, err := sgu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (sgu *StudyGroupUpdate) Exec(ctx context.Context) error {
+	_, err := sgu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sgu *StudyGroupUpdate) ExecX(ctx context.Context) {
+	if err := sgu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sgu *StudyGroupUpdate) defaults() {
+	if _, ok := sgu.mutation.UpdatedAt(); !ok {
+		v := studygroup.UpdateDefaultUpdatedAt()
+		sgu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sgu *StudyGroupUpdate) check() error {
+	if v, ok := sgu.mutation.Name(); ok {
+		if err := studygroup.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "StudyGroup.name": %w`, err)}
+		}
+	}
+	if v, ok := sgu.mutation.Privacy(); ok {
+		if err := studygroup.PrivacyValidator(v); err != nil {
+			return &ValidationError{Name: "privacy", err: fmt.Errorf(`ent: validator failed for field "StudyGroup.privacy": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (sgu *StudyGroupUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := sgu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(studygroup.Table, studygroup.Columns, sqlgraph.NewFieldSpec(studygroup.FieldID, field.TypeInt))
+	if ps := sgu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sgu.mutation.Name(); ok {
+		_spec.SetField(studygroup.FieldName, field.TypeString, value)
+	}
+	if value, ok := sgu.mutation.Description(); ok {
+		_spec.SetField(studygroup.FieldDescription, field.TypeString, value)
+	}
+	if sgu.mutation.DescriptionCleared() {
+		_spec.ClearField(studygroup.FieldDescription, field.TypeString)
+	}
+	if value, ok := sgu.mutation.Privacy(); ok {
+		_spec.SetField(studygroup.FieldPrivacy, field.TypeEnum, value)
+	}
+	if value, ok := sgu.mutation.UpdatedAt(); ok {
+		_spec.SetField(studygroup.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if sgu.mutation.MembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   studygroup.MembershipsTable,
+			Columns: []string{studygroup.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupmembership.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sgu.mutation.RemovedMembershipsIDs(); len(nodes) > 0 && !sgu.mutation.MembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   studygroup.MembershipsTable,
+			Columns: []string{studygroup.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupmembership.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sgu.mutation.MembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   studygroup.MembershipsTable,
+			Columns: []string{studygroup.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupmembership.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if sgu.mutation.JoinRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   studygroup.JoinRequestsTable,
+			Columns: []string{studygroup.JoinRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupjoinrequest.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sgu.mutation.RemovedJoinRequestsIDs(); len(nodes) > 0 && !sgu.mutation.JoinRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   studygroup.JoinRequestsTable,
+			Columns: []string{studygroup.JoinRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupjoinrequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sgu.mutation.JoinRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   studygroup.JoinRequestsTable,
+			Columns: []string{studygroup.JoinRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupjoinrequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if sgu.mutation.InvitesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   studygroup.InvitesTable,
+			Columns: []string{studygroup.InvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupinvite.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sgu.mutation.RemovedInvitesIDs(); len(nodes) > 0 && !sgu.mutation.InvitesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   studygroup.InvitesTable,
+			Columns: []string{studygroup.InvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupinvite.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sgu.mutation.InvitesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   studygroup.InvitesTable,
+			Columns: []string{studygroup.InvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupinvite.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if sgu.mutation.SharedNotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   studygroup.SharedNotesTable,
+			Columns: studygroup.SharedNotesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sgu.mutation.RemovedSharedNotesIDs(); len(nodes) > 0 && !sgu.mutation.SharedNotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   studygroup.SharedNotesTable,
+			Columns: studygroup.SharedNotesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sgu.mutation.SharedNotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   studygroup.SharedNotesTable,
+			Columns: studygroup.SharedNotesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, sgu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{studygroup.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	sgu.mutation.done = true
+	return n, nil
+}
+
+// StudyGroupUpdateOne is the builder for updating a single StudyGroup entity.
+type StudyGroupUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *StudyGroupMutation
+}
+
+// SetName sets the "name" field.
+func (sguo *StudyGroupUpdateOne) SetName(s string) *StudyGroupUpdateOne {
+	sguo.mutation.SetName(s)
+	return sguo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (sguo *StudyGroupUpdateOne) SetNillableName(s *string) *StudyGroupUpdateOne {
+	if s != nil {
+		sguo.SetName(*s)
+	}
+	return sguo
+}
+
+// SetDescription sets the "description" field.
+func (sguo *StudyGroupUpdateOne) SetDescription(s string) *StudyGroupUpdateOne {
+	sguo.mutation.SetDescription(s)
+	return sguo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (sguo *StudyGroupUpdateOne) SetNillableDescription(s *string) *StudyGroupUpdateOne {
+	if s != nil {
+		sguo.SetDescription(*s)
+	}
+	return sguo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (sguo *StudyGroupUpdateOne) ClearDescription() *StudyGroupUpdateOne {
+	sguo.mutation.ClearDescription()
+	return sguo
+}
+
+// SetPrivacy sets the "privacy" field.
+func (sguo *StudyGroupUpdateOne) SetPrivacy(s studygroup.Privacy) *StudyGroupUpdateOne {
+	sguo.mutation.SetPrivacy(s)
+	return sguo
+}
+
+// SetNillablePrivacy sets the "privacy" field if the given value is not nil.
+func (sguo *StudyGroupUpdateOne) SetNillablePrivacy(s *studygroup.Privacy) *StudyGroupUpdateOne {
+	if s != nil {
+		sguo.SetPrivacy(*s)
+	}
+	return sguo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sguo *StudyGroupUpdateOne) SetUpdatedAt(t time.Time) *StudyGroupUpdateOne {
+	sguo.mutation.SetUpdatedAt(t)
+	return sguo
+}
+
+// AddMembershipIDs adds the "memberships" edge to the GroupMembership entity by IDs.
+func (sguo *StudyGroupUpdateOne) AddMembershipIDs(ids ...int) *StudyGroupUpdateOne {
+	sguo.mutation.AddMembershipIDs(ids...)
+	return sguo
+}
+
+// AddMemberships adds the "memberships" edges to the GroupMembership entity.
+func (sguo *StudyGroupUpdateOne) AddMemberships(g ...*GroupMembership) *StudyGroupUpdateOne {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return sguo.AddMembershipIDs(ids...)
+}
+
+// AddJoinRequestIDs adds the "join_requests" edge to the GroupJoinRequest entity by IDs.
+func (sguo *StudyGroupUpdateOne) AddJoinRequestIDs(ids ...int) *StudyGroupUpdateOne {
+	sguo.mutation.AddJoinRequestIDs(ids...)
+	return sguo
+}
+
+// AddJoinRequests adds the "join_requests" edges to the GroupJoinRequest entity.
+func (sguo *StudyGroupUpdateOne) AddJoinRequests(g ...*GroupJoinRequest) *StudyGroupUpdateOne {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return sguo.AddJoinRequestIDs(ids...)
+}
+
+// AddInviteIDs adds the "invites" edge to the GroupInvite entity by IDs.
+func (sguo *StudyGroupUpdateOne) AddInviteIDs(ids ...int) *StudyGroupUpdateOne {
+	sguo.mutation.AddInviteIDs(ids...)
+	return sguo
+}
+
+// AddInvites adds the "invites" edges to the GroupInvite entity.
+func (sguo *StudyGroupUpdateOne) AddInvites(g ...*GroupInvite) *StudyGroupUpdateOne {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return sguo.AddInviteIDs(ids...)
+}
+
+// AddSharedNoteIDs adds the "shared_notes" edge to the Note entity by IDs.
+func (sguo *StudyGroupUpdateOne) AddSharedNoteIDs(ids ...int) *StudyGroupUpdateOne {
+	sguo.mutation.AddSharedNoteIDs(ids...)
+	return sguo
+}
+
+// AddSharedNotes adds the "shared_notes" edges to the Note entity.
+func (sguo *StudyGroupUpdateOne) AddSharedNotes(n ...*Note) *StudyGroupUpdateOne {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return sguo.AddSharedNoteIDs(ids...)
+}
+
+// Mutation returns the StudyGroupMutation object of the builder.
+func (sguo *StudyGroupUpdateOne) Mutation() *StudyGroupMutation {
+	return sguo.mutation
+}
+
+// ClearMemberships clears all "memberships" edges to the GroupMembership entity.
+func (sguo *StudyGroupUpdateOne) ClearMemberships() *StudyGroupUpdateOne {
+	sguo.mutation.ClearMemberships()
+	return sguo
+}
+
+// RemoveMembershipIDs removes the "memberships" edge to GroupMembership entities by IDs.
+func (sguo *StudyGroupUpdateOne) RemoveMembershipIDs(ids ...int) *StudyGroupUpdateOne {
+	sguo.mutation.RemoveMembershipIDs(ids...)
+	return sguo
+}
+
+// RemoveMemberships removes "memberships" edges to GroupMembership entities.
+func (sguo *StudyGroupUpdateOne) RemoveMemberships(g ...*GroupMembership) *StudyGroupUpdateOne {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return sguo.RemoveMembershipIDs(ids...)
+}
+
+// ClearJoinRequests clears all "join_requests" edges to the GroupJoinRequest entity.
+func (sguo *StudyGroupUpdateOne) ClearJoinRequests() *StudyGroupUpdateOne {
+	sguo.mutation.ClearJoinRequests()
+	return sguo
+}
+
+// RemoveJoinRequestIDs removes the "join_requests" edge to GroupJoinRequest entities by IDs.
+func (sguo *StudyGroupUpdateOne) RemoveJoinRequestIDs(ids ...int) *StudyGroupUpdateOne {
+	sguo.mutation.RemoveJoinRequestIDs(ids...)
+	return sguo
+}
+
+// RemoveJoinRequests removes "join_requests" edges to GroupJoinRequest entities.
+func (sguo *StudyGroupUpdateOne) RemoveJoinRequests(g ...*GroupJoinRequest) *StudyGroupUpdateOne {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return sguo.RemoveJoinRequestIDs(ids...)
+}
+
+// ClearInvites clears all "invites" edges to the GroupInvite entity.
+func (sguo *StudyGroupUpdateOne) ClearInvites() *StudyGroupUpdateOne {
+	sguo.mutation.ClearInvites()
+	return sguo
+}
+
+// RemoveInviteIDs removes the "invites" edge to GroupInvite entities by IDs.
+func (sguo *StudyGroupUpdateOne) RemoveInviteIDs(ids ...int) *StudyGroupUpdateOne {
+	sguo.mutation.RemoveInviteIDs(ids...)
+	return sguo
+}
+
+// RemoveInvites removes "invites" edges to GroupInvite entities.
+func (sguo *StudyGroupUpdateOne) RemoveInvites(g ...*GroupInvite) *StudyGroupUpdateOne {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return sguo.RemoveInviteIDs(ids...)
+}
+
+// ClearSharedNotes clears all "shared_notes" edges to the Note entity.
+func (sguo *StudyGroupUpdateOne) ClearSharedNotes() *StudyGroupUpdateOne {
+	sguo.mutation.ClearSharedNotes()
+	return sguo
+}
+
+// RemoveSharedNoteIDs removes the "shared_notes" edge to Note entities by IDs.
+func (sguo *StudyGroupUpdateOne) RemoveSharedNoteIDs(ids ...int) *StudyGroupUpdateOne {
+	sguo.mutation.RemoveSharedNoteIDs(ids...)
+	return sguo
+}
+
+// RemoveSharedNotes removes "shared_notes" edges to Note entities.
+func (sguo *StudyGroupUpdateOne) RemoveSharedNotes(n ...*Note) *StudyGroupUpdateOne {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return sguo.RemoveSharedNoteIDs(ids...)
+}
+
+// Where appends a list predicates to the StudyGroupUpdate builder.
+func (sguo *StudyGroupUpdateOne) Where(ps ...predicate.StudyGroup) *StudyGroupUpdateOne {
+	sguo.mutation.Where(ps...)
+	return sguo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (sguo *StudyGroupUpdateOne) Select(field string, fields ...string) *StudyGroupUpdateOne {
+	sguo.fields = append([]string{field}, fields...)
+	return sguo
+}
+
+// Save executes the query and returns the updated StudyGroup entity.
+func (sguo *StudyGroupUpdateOne) Save(ctx context.Context) (*StudyGroup, error) {
+	sguo.defaults()
+	return withHooks(ctx, sguo.sqlSave, sguo.mutation, sguo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sguo *StudyGroupUpdateOne) SaveX(ctx context.Context) *StudyGroup {
+	node, err := sguo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (sguo *StudyGroupUpdateOne) Exec(ctx context.Context) error {
+	_, err := sguo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sguo *StudyGroupUpdateOne) ExecX(ctx context.Context) {
+	if err := sguo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sguo *StudyGroupUpdateOne) defaults() {
+	if _, ok := sguo.mutation.UpdatedAt(); !ok {
+		v := studygroup.UpdateDefaultUpdatedAt()
+		sguo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sguo *StudyGroupUpdateOne) check() error {
+	if v, ok := sguo.mutation.Name(); ok {
+		if err := studygroup.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "StudyGroup.name": %w`, err)}
+		}
+	}
+	if v, ok := sguo.mutation.Privacy(); ok {
+		if err := studygroup.PrivacyValidator(v); err != nil {
+			return &ValidationError{Name: "privacy", err: fmt.Errorf(`ent: validator failed for field "StudyGroup.privacy": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (sguo *StudyGroupUpdateOne) sqlSave(ctx context.Context) (_node *StudyGroup, err error) {
+	if err := sguo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(studygroup.Table, studygroup.Columns, sqlgraph.NewFieldSpec(studygroup.FieldID, field.TypeInt))
+	id, ok := sguo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "StudyGroup.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := sguo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, studygroup.FieldID)
+		for _, f := range fields {
+			if !studygroup.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != studygroup.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := sguo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sguo.mutation.Name(); ok {
+		_spec.SetField(studygroup.FieldName, field.TypeString, value)
+	}
+	if value, ok := sguo.mutation.Description(); ok {
+		_spec.SetField(studygroup.FieldDescription, field.TypeString, value)
+	}
+	if sguo.mutation.DescriptionCleared() {
+		_spec.ClearField(studygroup.FieldDescription, field.TypeString)
+	}
+	if value, ok := sguo.mutation.Privacy(); ok {
+		_spec.SetField(studygroup.FieldPrivacy, field.TypeEnum, value)
+	}
+	if value, ok := sguo.mutation.UpdatedAt(); ok {
+		_spec.SetField(studygroup.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if sguo.mutation.MembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   studygroup.MembershipsTable,
+			Columns: []string{studygroup.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupmembership.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sguo.mutation.RemovedMembershipsIDs(); len(nodes) > 0 && !sguo.mutation.MembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   studygroup.MembershipsTable,
+			Columns: []string{studygroup.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupmembership.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sguo.mutation.MembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   studygroup.MembershipsTable,
+			Columns: []string{studygroup.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupmembership.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if sguo.mutation.JoinRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   studygroup.JoinRequestsTable,
+			Columns: []string{studygroup.JoinRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupjoinrequest.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sguo.mutation.RemovedJoinRequestsIDs(); len(nodes) > 0 && !sguo.mutation.JoinRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   studygroup.JoinRequestsTable,
+			Columns: []string{studygroup.JoinRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupjoinrequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sguo.mutation.JoinRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   studygroup.JoinRequestsTable,
+			Columns: []string{studygroup.JoinRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupjoinrequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if sguo.mutation.InvitesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   studygroup.InvitesTable,
+			Columns: []string{studygroup.InvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupinvite.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sguo.mutation.RemovedInvitesIDs(); len(nodes) > 0 && !sguo.mutation.InvitesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   studygroup.InvitesTable,
+			Columns: []string{studygroup.InvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupinvite.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sguo.mutation.InvitesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   studygroup.InvitesTable,
+			Columns: []string{studygroup.InvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupinvite.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if sguo.mutation.SharedNotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   studygroup.SharedNotesTable,
+			Columns: studygroup.SharedNotesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sguo.mutation.RemovedSharedNotesIDs(); len(nodes) > 0 && !sguo.mutation.SharedNotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   studygroup.SharedNotesTable,
+			Columns: studygroup.SharedNotesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sguo.mutation.SharedNotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   studygroup.SharedNotesTable,
+			Columns: studygroup.SharedNotesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &StudyGroup{config: sguo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, sguo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{studygroup.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	sguo.mutation.done = true
+	return _node, nil
+}
@@ -12,14 +12,52 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// Blob is the client for interacting with the Blob builders.
+	Blob *BlobClient
+	// BlobReference is the client for interacting with the BlobReference builders.
+	BlobReference *BlobReferenceClient
+	// Choice is the client for interacting with the Choice builders.
+	Choice *ChoiceClient
+	// Document is the client for interacting with the Document builders.
+	Document *DocumentClient
+	// Folder is the client for interacting with the Folder builders.
+	Folder *FolderClient
+	// GroupInvite is the client for interacting with the GroupInvite builders.
+	GroupInvite *GroupInviteClient
+	// GroupJoinRequest is the client for interacting with the GroupJoinRequest builders.
+	GroupJoinRequest *GroupJoinRequestClient
+	// GroupMembership is the client for interacting with the GroupMembership builders.
+	GroupMembership *GroupMembershipClient
 	// Note is the client for interacting with the Note builders.
 	Note *NoteClient
 	// NoteLike is the client for interacting with the NoteLike builders.
 	NoteLike *NoteLikeClient
+	// NoteLink is the client for interacting with the NoteLink builders.
+	NoteLink *NoteLinkClient
+	// NoteProposal is the client for interacting with the NoteProposal builders.
+	NoteProposal *NoteProposalClient
 	// NoteRepost is the client for interacting with the NoteRepost builders.
 	NoteRepost *NoteRepostClient
+	// NoteRevision is the client for interacting with the NoteRevision builders.
+	NoteRevision *NoteRevisionClient
+	// Notebook is the client for interacting with the Notebook builders.
+	Notebook *NotebookClient
 	// PasswordToken is the client for interacting with the PasswordToken builders.
 	PasswordToken *PasswordTokenClient
+	// Question is the client for interacting with the Question builders.
+	Question *QuestionClient
+	// Quiz is the client for interacting with the Quiz builders.
+	Quiz *QuizClient
+	// QuizAttempt is the client for interacting with the QuizAttempt builders.
+	QuizAttempt *QuizAttemptClient
+	// ResumableUpload is the client for interacting with the ResumableUpload builders.
+	ResumableUpload *ResumableUploadClient
+	// StudyEvent is the client for interacting with the StudyEvent builders.
+	StudyEvent *StudyEventClient
+	// StudyGroup is the client for interacting with the StudyGroup builders.
+	StudyGroup *StudyGroupClient
+	// Tag is the client for interacting with the Tag builders.
+	Tag *TagClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 
@@ -153,10 +191,29 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.Blob = NewBlobClient(tx.config)
+	tx.BlobReference = NewBlobReferenceClient(tx.config)
+	tx.Choice = NewChoiceClient(tx.config)
+	tx.Document = NewDocumentClient(tx.config)
+	tx.Folder = NewFolderClient(tx.config)
+	tx.GroupInvite = NewGroupInviteClient(tx.config)
+	tx.GroupJoinRequest = NewGroupJoinRequestClient(tx.config)
+	tx.GroupMembership = NewGroupMembershipClient(tx.config)
 	tx.Note = NewNoteClient(tx.config)
 	tx.NoteLike = NewNoteLikeClient(tx.config)
+	tx.NoteLink = NewNoteLinkClient(tx.config)
+	tx.NoteProposal = NewNoteProposalClient(tx.config)
 	tx.NoteRepost = NewNoteRepostClient(tx.config)
+	tx.NoteRevision = NewNoteRevisionClient(tx.config)
+	tx.Notebook = NewNotebookClient(tx.config)
 	tx.PasswordToken = NewPasswordTokenClient(tx.config)
+	tx.Question = NewQuestionClient(tx.config)
+	tx.Quiz = NewQuizClient(tx.config)
+	tx.QuizAttempt = NewQuizAttemptClient(tx.config)
+	tx.ResumableUpload = NewResumableUploadClient(tx.config)
+	tx.StudyEvent = NewStudyEventClient(tx.config)
+	tx.StudyGroup = NewStudyGroupClient(tx.config)
+	tx.Tag = NewTagClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 }
 
@@ -167,7 +224,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Note.QueryXXX(), the query will be executed
+// applies a query, for example: Blob.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -47,6 +47,10 @@ type User struct {
 	IsActive bool `json:"is_active,omitempty"`
 	// Last login timestamp
 	LastLogin *time.Time `json:"last_login,omitempty"`
+	// Bytes of uploads the user may store, overriding the configured quota; zero for no limit
+	StorageQuota *int64 `json:"storage_quota,omitempty"`
+	// Bytes of uploads charged to the user, kept up to date as files are added and removed
+	StorageUsed int64 `json:"storage_used,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -67,9 +71,37 @@ type UserEdges struct {
 	NoteLikes []*NoteLike `json:"note_likes,omitempty"`
 	// NoteReposts holds the value of the note_reposts edge.
 	NoteReposts []*NoteRepost `json:"note_reposts,omitempty"`
+	// Quizzes holds the value of the quizzes edge.
+	Quizzes []*Quiz `json:"quizzes,omitempty"`
+	// QuizAttempts holds the value of the quiz_attempts edge.
+	QuizAttempts []*QuizAttempt `json:"quiz_attempts,omitempty"`
+	// GroupMemberships holds the value of the group_memberships edge.
+	GroupMemberships []*GroupMembership `json:"group_memberships,omitempty"`
+	// GroupJoinRequests holds the value of the group_join_requests edge.
+	GroupJoinRequests []*GroupJoinRequest `json:"group_join_requests,omitempty"`
+	// GroupInvites holds the value of the group_invites edge.
+	GroupInvites []*GroupInvite `json:"group_invites,omitempty"`
+	// StudyEvents holds the value of the study_events edge.
+	StudyEvents []*StudyEvent `json:"study_events,omitempty"`
+	// Documents holds the value of the documents edge.
+	Documents []*Document `json:"documents,omitempty"`
+	// Folders holds the value of the folders edge.
+	Folders []*Folder `json:"folders,omitempty"`
+	// Uploaded files the user is charged for
+	BlobReferences []*BlobReference `json:"blob_references,omitempty"`
+	// ResumableUploads holds the value of the resumable_uploads edge.
+	ResumableUploads []*ResumableUpload `json:"resumable_uploads,omitempty"`
+	// NoteProposals holds the value of the note_proposals edge.
+	NoteProposals []*NoteProposal `json:"note_proposals,omitempty"`
+	// NoteRevisions holds the value of the note_revisions edge.
+	NoteRevisions []*NoteRevision `json:"note_revisions,omitempty"`
+	// Notebooks holds the value of the notebooks edge.
+	Notebooks []*Notebook `json:"notebooks,omitempty"`
+	// Tags holds the value of the tags edge.
+	Tags []*Tag `json:"tags,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [4]bool
+	loadedTypes [18]bool
 }
 
 // OwnerOrErr returns the Owner value or an error if the edge
@@ -108,6 +140,132 @@ func (e UserEdges) NoteRepostsOrErr() ([]*NoteRepost, error) {
 	return nil, &NotLoadedError{edge: "note_reposts"}
 }
 
+// QuizzesOrErr returns the Quizzes value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) QuizzesOrErr() ([]*Quiz, error) {
+	if e.loadedTypes[4] {
+		return e.Quizzes, nil
+	}
+	return nil, &NotLoadedError{edge: "quizzes"}
+}
+
+// QuizAttemptsOrErr returns the QuizAttempts value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) QuizAttemptsOrErr() ([]*QuizAttempt, error) {
+	if e.loadedTypes[5] {
+		return e.QuizAttempts, nil
+	}
+	return nil, &NotLoadedError{edge: "quiz_attempts"}
+}
+
+// GroupMembershipsOrErr returns the GroupMemberships value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) GroupMembershipsOrErr() ([]*GroupMembership, error) {
+	if e.loadedTypes[6] {
+		return e.GroupMemberships, nil
+	}
+	return nil, &NotLoadedError{edge: "group_memberships"}
+}
+
+// GroupJoinRequestsOrErr returns the GroupJoinRequests value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) GroupJoinRequestsOrErr() ([]*GroupJoinRequest, error) {
+	if e.loadedTypes[7] {
+		return e.GroupJoinRequests, nil
+	}
+	return nil, &NotLoadedError{edge: "group_join_requests"}
+}
+
+// GroupInvitesOrErr returns the GroupInvites value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) GroupInvitesOrErr() ([]*GroupInvite, error) {
+	if e.loadedTypes[8] {
+		return e.GroupInvites, nil
+	}
+	return nil, &NotLoadedError{edge: "group_invites"}
+}
+
+// StudyEventsOrErr returns the StudyEvents value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) StudyEventsOrErr() ([]*StudyEvent, error) {
+	if e.loadedTypes[9] {
+		return e.StudyEvents, nil
+	}
+	return nil, &NotLoadedError{edge: "study_events"}
+}
+
+// DocumentsOrErr returns the Documents value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) DocumentsOrErr() ([]*Document, error) {
+	if e.loadedTypes[10] {
+		return e.Documents, nil
+	}
+	return nil, &NotLoadedError{edge: "documents"}
+}
+
+// FoldersOrErr returns the Folders value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) FoldersOrErr() ([]*Folder, error) {
+	if e.loadedTypes[11] {
+		return e.Folders, nil
+	}
+	return nil, &NotLoadedError{edge: "folders"}
+}
+
+// BlobReferencesOrErr returns the BlobReferences value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) BlobReferencesOrErr() ([]*BlobReference, error) {
+	if e.loadedTypes[12] {
+		return e.BlobReferences, nil
+	}
+	return nil, &NotLoadedError{edge: "blob_references"}
+}
+
+// ResumableUploadsOrErr returns the ResumableUploads value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ResumableUploadsOrErr() ([]*ResumableUpload, error) {
+	if e.loadedTypes[13] {
+		return e.ResumableUploads, nil
+	}
+	return nil, &NotLoadedError{edge: "resumable_uploads"}
+}
+
+// NoteProposalsOrErr returns the NoteProposals value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) NoteProposalsOrErr() ([]*NoteProposal, error) {
+	if e.loadedTypes[14] {
+		return e.NoteProposals, nil
+	}
+	return nil, &NotLoadedError{edge: "note_proposals"}
+}
+
+// NoteRevisionsOrErr returns the NoteRevisions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) NoteRevisionsOrErr() ([]*NoteRevision, error) {
+	if e.loadedTypes[15] {
+		return e.NoteRevisions, nil
+	}
+	return nil, &NotLoadedError{edge: "note_revisions"}
+}
+
+// NotebooksOrErr returns the Notebooks value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) NotebooksOrErr() ([]*Notebook, error) {
+	if e.loadedTypes[16] {
+		return e.Notebooks, nil
+	}
+	return nil, &NotLoadedError{edge: "notebooks"}
+}
+
+// TagsOrErr returns the Tags value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) TagsOrErr() ([]*Tag, error) {
+	if e.loadedTypes[17] {
+		return e.Tags, nil
+	}
+	return nil, &NotLoadedError{edge: "tags"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -115,7 +273,7 @@ func (*User) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case user.FieldVerified, user.FieldAdmin, user.FieldDarkMode, user.FieldEmailNotifications, user.FieldSmsNotifications, user.FieldIsActive:
 			values[i] = new(sql.NullBool)
-		case user.FieldID:
+		case user.FieldID, user.FieldStorageQuota, user.FieldStorageUsed:
 			values[i] = new(sql.NullInt64)
 		case user.FieldName, user.FieldPhoneNumber, user.FieldEmail, user.FieldPassword, user.FieldVerificationCode, user.FieldRegistrationMethod, user.FieldProfilePicture, user.FieldBio:
 			values[i] = new(sql.NullString)
@@ -237,6 +395,19 @@ func (u *User) assignValues(columns []string, values []any) error {
 				u.LastLogin = new(time.Time)
 				*u.LastLogin = value.Time
 			}
+		case user.FieldStorageQuota:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field storage_quota", values[i])
+			} else if value.Valid {
+				u.StorageQuota = new(int64)
+				*u.StorageQuota = value.Int64
+			}
+		case user.FieldStorageUsed:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field storage_used", values[i])
+			} else if value.Valid {
+				u.StorageUsed = value.Int64
+			}
 		case user.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -283,6 +454,76 @@ func (u *User) QueryNoteReposts() *NoteRepostQuery {
 	return NewUserClient(u.config).QueryNoteReposts(u)
 }
 
+// QueryQuizzes queries the "quizzes" edge of the User entity.
+func (u *User) QueryQuizzes() *QuizQuery {
+	return NewUserClient(u.config).QueryQuizzes(u)
+}
+
+// QueryQuizAttempts queries the "quiz_attempts" edge of the User entity.
+func (u *User) QueryQuizAttempts() *QuizAttemptQuery {
+	return NewUserClient(u.config).QueryQuizAttempts(u)
+}
+
+// QueryGroupMemberships queries the "group_memberships" edge of the User entity.
+func (u *User) QueryGroupMemberships() *GroupMembershipQuery {
+	return NewUserClient(u.config).QueryGroupMemberships(u)
+}
+
+// QueryGroupJoinRequests queries the "group_join_requests" edge of the User entity.
+func (u *User) QueryGroupJoinRequests() *GroupJoinRequestQuery {
+	return NewUserClient(u.config).QueryGroupJoinRequests(u)
+}
+
+// QueryGroupInvites queries the "group_invites" edge of the User entity.
+func (u *User) QueryGroupInvites() *GroupInviteQuery {
+	return NewUserClient(u.config).QueryGroupInvites(u)
+}
+
+// QueryStudyEvents queries the "study_events" edge of the User entity.
+func (u *User) QueryStudyEvents() *StudyEventQuery {
+	return NewUserClient(u.config).QueryStudyEvents(u)
+}
+
+// QueryDocuments queries the "documents" edge of the User entity.
+func (u *User) QueryDocuments() *DocumentQuery {
+	return NewUserClient(u.config).QueryDocuments(u)
+}
+
+// QueryFolders queries the "folders" edge of the User entity.
+func (u *User) QueryFolders() *FolderQuery {
+	return NewUserClient(u.config).QueryFolders(u)
+}
+
+// QueryBlobReferences queries the "blob_references" edge of the User entity.
+func (u *User) QueryBlobReferences() *BlobReferenceQuery {
+	return NewUserClient(u.config).QueryBlobReferences(u)
+}
+
+// QueryResumableUploads queries the "resumable_uploads" edge of the User entity.
+func (u *User) QueryResumableUploads() *ResumableUploadQuery {
+	return NewUserClient(u.config).QueryResumableUploads(u)
+}
+
+// QueryNoteProposals queries the "note_proposals" edge of the User entity.
+func (u *User) QueryNoteProposals() *NoteProposalQuery {
+	return NewUserClient(u.config).QueryNoteProposals(u)
+}
+
+// QueryNoteRevisions queries the "note_revisions" edge of the User entity.
+func (u *User) QueryNoteRevisions() *NoteRevisionQuery {
+	return NewUserClient(u.config).QueryNoteRevisions(u)
+}
+
+// QueryNotebooks queries the "notebooks" edge of the User entity.
+func (u *User) QueryNotebooks() *NotebookQuery {
+	return NewUserClient(u.config).QueryNotebooks(u)
+}
+
+// QueryTags queries the "tags" edge of the User entity.
+func (u *User) QueryTags() *TagQuery {
+	return NewUserClient(u.config).QueryTags(u)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -360,6 +601,14 @@ func (u *User) String() string {
 		builder.WriteString(v.Format(time.ANSIC))
 	}
 	builder.WriteString(", ")
+	if v := u.StorageQuota; v != nil {
+		builder.WriteString("storage_quota=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("storage_used=")
+	builder.WriteString(fmt.Sprintf("%v", u.StorageUsed))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(u.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -45,6 +45,10 @@ const (
 	FieldIsActive = "is_active"
 	// FieldLastLogin holds the string denoting the last_login field in the database.
 	FieldLastLogin = "last_login"
+	// FieldStorageQuota holds the string denoting the storage_quota field in the database.
+	FieldStorageQuota = "storage_quota"
+	// FieldStorageUsed holds the string denoting the storage_used field in the database.
+	FieldStorageUsed = "storage_used"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -57,6 +61,34 @@ const (
 	EdgeNoteLikes = "note_likes"
 	// EdgeNoteReposts holds the string denoting the note_reposts edge name in mutations.
 	EdgeNoteReposts = "note_reposts"
+	// EdgeQuizzes holds the string denoting the quizzes edge name in mutations.
+	EdgeQuizzes = "quizzes"
+	// EdgeQuizAttempts holds the string denoting the quiz_attempts edge name in mutations.
+	EdgeQuizAttempts = "quiz_attempts"
+	// EdgeGroupMemberships holds the string denoting the group_memberships edge name in mutations.
+	EdgeGroupMemberships = "group_memberships"
+	// EdgeGroupJoinRequests holds the string denoting the group_join_requests edge name in mutations.
+	EdgeGroupJoinRequests = "group_join_requests"
+	// EdgeGroupInvites holds the string denoting the group_invites edge name in mutations.
+	EdgeGroupInvites = "group_invites"
+	// EdgeStudyEvents holds the string denoting the study_events edge name in mutations.
+	EdgeStudyEvents = "study_events"
+	// EdgeDocuments holds the string denoting the documents edge name in mutations.
+	EdgeDocuments = "documents"
+	// EdgeFolders holds the string denoting the folders edge name in mutations.
+	EdgeFolders = "folders"
+	// EdgeBlobReferences holds the string denoting the blob_references edge name in mutations.
+	EdgeBlobReferences = "blob_references"
+	// EdgeResumableUploads holds the string denoting the resumable_uploads edge name in mutations.
+	EdgeResumableUploads = "resumable_uploads"
+	// EdgeNoteProposals holds the string denoting the note_proposals edge name in mutations.
+	EdgeNoteProposals = "note_proposals"
+	// EdgeNoteRevisions holds the string denoting the note_revisions edge name in mutations.
+	EdgeNoteRevisions = "note_revisions"
+	// EdgeNotebooks holds the string denoting the notebooks edge name in mutations.
+	EdgeNotebooks = "notebooks"
+	// EdgeTags holds the string denoting the tags edge name in mutations.
+	EdgeTags = "tags"
 	// Table holds the table name of the user in the database.
 	Table = "users"
 	// OwnerTable is the table that holds the owner relation/edge.
@@ -87,6 +119,104 @@ const (
 	NoteRepostsInverseTable = "note_reposts"
 	// NoteRepostsColumn is the table column denoting the note_reposts relation/edge.
 	NoteRepostsColumn = "user_note_reposts"
+	// QuizzesTable is the table that holds the quizzes relation/edge.
+	QuizzesTable = "quizs"
+	// QuizzesInverseTable is the table name for the Quiz entity.
+	// It exists in this package in order to avoid circular dependency with the "quiz" package.
+	QuizzesInverseTable = "quizs"
+	// QuizzesColumn is the table column denoting the quizzes relation/edge.
+	QuizzesColumn = "user_quizzes"
+	// QuizAttemptsTable is the table that holds the quiz_attempts relation/edge.
+	QuizAttemptsTable = "quiz_attempts"
+	// QuizAttemptsInverseTable is the table name for the QuizAttempt entity.
+	// It exists in this package in order to avoid circular dependency with the "quizattempt" package.
+	QuizAttemptsInverseTable = "quiz_attempts"
+	// QuizAttemptsColumn is the table column denoting the quiz_attempts relation/edge.
+	QuizAttemptsColumn = "user_quiz_attempts"
+	// GroupMembershipsTable is the table that holds the group_memberships relation/edge.
+	GroupMembershipsTable = "group_memberships"
+	// GroupMembershipsInverseTable is the table name for the GroupMembership entity.
+	// It exists in this package in order to avoid circular dependency with the "groupmembership" package.
+	GroupMembershipsInverseTable = "group_memberships"
+	// GroupMembershipsColumn is the table column denoting the group_memberships relation/edge.
+	GroupMembershipsColumn = "user_group_memberships"
+	// GroupJoinRequestsTable is the table that holds the group_join_requests relation/edge.
+	GroupJoinRequestsTable = "group_join_requests"
+	// GroupJoinRequestsInverseTable is the table name for the GroupJoinRequest entity.
+	// It exists in this package in order to avoid circular dependency with the "groupjoinrequest" package.
+	GroupJoinRequestsInverseTable = "group_join_requests"
+	// GroupJoinRequestsColumn is the table column denoting the group_join_requests relation/edge.
+	GroupJoinRequestsColumn = "user_group_join_requests"
+	// GroupInvitesTable is the table that holds the group_invites relation/edge.
+	GroupInvitesTable = "group_invites"
+	// GroupInvitesInverseTable is the table name for the GroupInvite entity.
+	// It exists in this package in order to avoid circular dependency with the "groupinvite" package.
+	GroupInvitesInverseTable = "group_invites"
+	// GroupInvitesColumn is the table column denoting the group_invites relation/edge.
+	GroupInvitesColumn = "user_group_invites"
+	// StudyEventsTable is the table that holds the study_events relation/edge.
+	StudyEventsTable = "study_events"
+	// StudyEventsInverseTable is the table name for the StudyEvent entity.
+	// It exists in this package in order to avoid circular dependency with the "studyevent" package.
+	StudyEventsInverseTable = "study_events"
+	// StudyEventsColumn is the table column denoting the study_events relation/edge.
+	StudyEventsColumn = "user_study_events"
+	// DocumentsTable is the table that holds the documents relation/edge.
+	DocumentsTable = "documents"
+	// DocumentsInverseTable is the table name for the Document entity.
+	// It exists in this package in order to avoid circular dependency with the "document" package.
+	DocumentsInverseTable = "documents"
+	// DocumentsColumn is the table column denoting the documents relation/edge.
+	DocumentsColumn = "user_documents"
+	// FoldersTable is the table that holds the folders relation/edge.
+	FoldersTable = "folders"
+	// FoldersInverseTable is the table name for the Folder entity.
+	// It exists in this package in order to avoid circular dependency with the "folder" package.
+	FoldersInverseTable = "folders"
+	// FoldersColumn is the table column denoting the folders relation/edge.
+	FoldersColumn = "user_folders"
+	// BlobReferencesTable is the table that holds the blob_references relation/edge.
+	BlobReferencesTable = "blob_references"
+	// BlobReferencesInverseTable is the table name for the BlobReference entity.
+	// It exists in this package in order to avoid circular dependency with the "blobreference" package.
+	BlobReferencesInverseTable = "blob_references"
+	// BlobReferencesColumn is the table column denoting the blob_references relation/edge.
+	BlobReferencesColumn = "user_blob_references"
+	// ResumableUploadsTable is the table that holds the resumable_uploads relation/edge.
+	ResumableUploadsTable = "resumable_uploads"
+	// ResumableUploadsInverseTable is the table name for the ResumableUpload entity.
+	// It exists in this package in order to avoid circular dependency with the "resumableupload" package.
+	ResumableUploadsInverseTable = "resumable_uploads"
+	// ResumableUploadsColumn is the table column denoting the resumable_uploads relation/edge.
+	ResumableUploadsColumn = "user_resumable_uploads"
+	// NoteProposalsTable is the table that holds the note_proposals relation/edge.
+	NoteProposalsTable = "note_proposals"
+	// NoteProposalsInverseTable is the table name for the NoteProposal entity.
+	// It exists in this package in order to avoid circular dependency with the "noteproposal" package.
+	NoteProposalsInverseTable = "note_proposals"
+	// NoteProposalsColumn is the table column denoting the note_proposals relation/edge.
+	NoteProposalsColumn = "user_note_proposals"
+	// NoteRevisionsTable is the table that holds the note_revisions relation/edge.
+	NoteRevisionsTable = "note_revisions"
+	// NoteRevisionsInverseTable is the table name for the NoteRevision entity.
+	// It exists in this package in order to avoid circular dependency with the "noterevision" package.
+	NoteRevisionsInverseTable = "note_revisions"
+	// NoteRevisionsColumn is the table column denoting the note_revisions relation/edge.
+	NoteRevisionsColumn = "user_note_revisions"
+	// NotebooksTable is the table that holds the notebooks relation/edge.
+	NotebooksTable = "notebooks"
+	// NotebooksInverseTable is the table name for the Notebook entity.
+	// It exists in this package in order to avoid circular dependency with the "notebook" package.
+	NotebooksInverseTable = "notebooks"
+	// NotebooksColumn is the table column denoting the notebooks relation/edge.
+	NotebooksColumn = "user_notebooks"
+	// TagsTable is the table that holds the tags relation/edge.
+	TagsTable = "tags"
+	// TagsInverseTable is the table name for the Tag entity.
+	// It exists in this package in order to avoid circular dependency with the "tag" package.
+	TagsInverseTable = "tags"
+	// TagsColumn is the table column denoting the tags relation/edge.
+	TagsColumn = "user_tags"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -107,6 +237,8 @@ var Columns = []string{
 	FieldSmsNotifications,
 	FieldIsActive,
 	FieldLastLogin,
+	FieldStorageQuota,
+	FieldStorageUsed,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -144,6 +276,10 @@ var (
 	DefaultSmsNotifications bool
 	// DefaultIsActive holds the default value on creation for the "is_active" field.
 	DefaultIsActive bool
+	// StorageQuotaValidator is a validator for the "storage_quota" field. It is called by the builders before save.
+	StorageQuotaValidator func(int64) error
+	// DefaultStorageUsed holds the default value on creation for the "storage_used" field.
+	DefaultStorageUsed int64
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -261,6 +397,16 @@ func ByLastLogin(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLastLogin, opts...).ToFunc()
 }
 
+// ByStorageQuota orders the results by the storage_quota field.
+func ByStorageQuota(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStorageQuota, opts...).ToFunc()
+}
+
+// ByStorageUsed orders the results by the storage_used field.
+func ByStorageUsed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStorageUsed, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -326,6 +472,202 @@ func ByNoteReposts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newNoteRepostsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByQuizzesCount orders the results by quizzes count.
+func ByQuizzesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newQuizzesStep(), opts...)
+	}
+}
+
+// ByQuizzes orders the results by quizzes terms.
+func ByQuizzes(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newQuizzesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByQuizAttemptsCount orders the results by quiz_attempts count.
+func ByQuizAttemptsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newQuizAttemptsStep(), opts...)
+	}
+}
+
+// ByQuizAttempts orders the results by quiz_attempts terms.
+func ByQuizAttempts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newQuizAttemptsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByGroupMembershipsCount orders the results by group_memberships count.
+func ByGroupMembershipsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newGroupMembershipsStep(), opts...)
+	}
+}
+
+// ByGroupMemberships orders the results by group_memberships terms.
+func ByGroupMemberships(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newGroupMembershipsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByGroupJoinRequestsCount orders the results by group_join_requests count.
+func ByGroupJoinRequestsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newGroupJoinRequestsStep(), opts...)
+	}
+}
+
+// ByGroupJoinRequests orders the results by group_join_requests terms.
+func ByGroupJoinRequests(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newGroupJoinRequestsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByGroupInvitesCount orders the results by group_invites count.
+func ByGroupInvitesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newGroupInvitesStep(), opts...)
+	}
+}
+
+// ByGroupInvites orders the results by group_invites terms.
+func ByGroupInvites(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newGroupInvitesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByStudyEventsCount orders the results by study_events count.
+func ByStudyEventsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newStudyEventsStep(), opts...)
+	}
+}
+
+// ByStudyEvents orders the results by study_events terms.
+func ByStudyEvents(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStudyEventsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByDocumentsCount orders the results by documents count.
+func ByDocumentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDocumentsStep(), opts...)
+	}
+}
+
+// ByDocuments orders the results by documents terms.
+func ByDocuments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDocumentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByFoldersCount orders the results by folders count.
+func ByFoldersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newFoldersStep(), opts...)
+	}
+}
+
+// ByFolders orders the results by folders terms.
+func ByFolders(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFoldersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByBlobReferencesCount orders the results by blob_references count.
+func ByBlobReferencesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBlobReferencesStep(), opts...)
+	}
+}
+
+// ByBlobReferences orders the results by blob_references terms.
+func ByBlobReferences(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBlobReferencesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByResumableUploadsCount orders the results by resumable_uploads count.
+func ByResumableUploadsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newResumableUploadsStep(), opts...)
+	}
+}
+
+// ByResumableUploads orders the results by resumable_uploads terms.
+func ByResumableUploads(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newResumableUploadsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByNoteProposalsCount orders the results by note_proposals count.
+func ByNoteProposalsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newNoteProposalsStep(), opts...)
+	}
+}
+
+// ByNoteProposals orders the results by note_proposals terms.
+func ByNoteProposals(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newNoteProposalsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByNoteRevisionsCount orders the results by note_revisions count.
+func ByNoteRevisionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newNoteRevisionsStep(), opts...)
+	}
+}
+
+// ByNoteRevisions orders the results by note_revisions terms.
+func ByNoteRevisions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newNoteRevisionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByNotebooksCount orders the results by notebooks count.
+func ByNotebooksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newNotebooksStep(), opts...)
+	}
+}
+
+// ByNotebooks orders the results by notebooks terms.
+func ByNotebooks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newNotebooksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTagsCount orders the results by tags count.
+func ByTagsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTagsStep(), opts...)
+	}
+}
+
+// ByTags orders the results by tags terms.
+func ByTags(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTagsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newOwnerStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -354,3 +696,101 @@ func newNoteRepostsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, NoteRepostsTable, NoteRepostsColumn),
 	)
 }
+func newQuizzesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(QuizzesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, QuizzesTable, QuizzesColumn),
+	)
+}
+func newQuizAttemptsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(QuizAttemptsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, QuizAttemptsTable, QuizAttemptsColumn),
+	)
+}
+func newGroupMembershipsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(GroupMembershipsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, GroupMembershipsTable, GroupMembershipsColumn),
+	)
+}
+func newGroupJoinRequestsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(GroupJoinRequestsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, GroupJoinRequestsTable, GroupJoinRequestsColumn),
+	)
+}
+func newGroupInvitesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(GroupInvitesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, GroupInvitesTable, GroupInvitesColumn),
+	)
+}
+func newStudyEventsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StudyEventsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, StudyEventsTable, StudyEventsColumn),
+	)
+}
+func newDocumentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DocumentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, DocumentsTable, DocumentsColumn),
+	)
+}
+func newFoldersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FoldersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, FoldersTable, FoldersColumn),
+	)
+}
+func newBlobReferencesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BlobReferencesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, BlobReferencesTable, BlobReferencesColumn),
+	)
+}
+func newResumableUploadsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ResumableUploadsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ResumableUploadsTable, ResumableUploadsColumn),
+	)
+}
+func newNoteProposalsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(NoteProposalsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, NoteProposalsTable, NoteProposalsColumn),
+	)
+}
+func newNoteRevisionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(NoteRevisionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, NoteRevisionsTable, NoteRevisionsColumn),
+	)
+}
+func newNotebooksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(NotebooksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, NotebooksTable, NotebooksColumn),
+	)
+}
+func newTagsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TagsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TagsTable, TagsColumn),
+	)
+}
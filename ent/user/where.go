@@ -125,6 +125,16 @@ func LastLogin(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldLastLogin, v))
 }
 
+// StorageQuota applies equality check predicate on the "storage_quota" field. It's identical to StorageQuotaEQ.
+func StorageQuota(v int64) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldStorageQuota, v))
+}
+
+// StorageUsed applies equality check predicate on the "storage_used" field. It's identical to StorageUsedEQ.
+func StorageUsed(v int64) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldStorageUsed, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldCreatedAt, v))
@@ -770,6 +780,96 @@ func LastLoginNotNil() predicate.User {
 	return predicate.User(sql.FieldNotNull(FieldLastLogin))
 }
 
+// StorageQuotaEQ applies the EQ predicate on the "storage_quota" field.
+func StorageQuotaEQ(v int64) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldStorageQuota, v))
+}
+
+// StorageQuotaNEQ applies the NEQ predicate on the "storage_quota" field.
+func StorageQuotaNEQ(v int64) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldStorageQuota, v))
+}
+
+// StorageQuotaIn applies the In predicate on the "storage_quota" field.
+func StorageQuotaIn(vs ...int64) predicate.User {
+	return predicate.User(sql.FieldIn(FieldStorageQuota, vs...))
+}
+
+// StorageQuotaNotIn applies the NotIn predicate on the "storage_quota" field.
+func StorageQuotaNotIn(vs ...int64) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldStorageQuota, vs...))
+}
+
+// StorageQuotaGT applies the GT predicate on the "storage_quota" field.
+func StorageQuotaGT(v int64) predicate.User {
+	return predicate.User(sql.FieldGT(FieldStorageQuota, v))
+}
+
+// StorageQuotaGTE applies the GTE predicate on the "storage_quota" field.
+func StorageQuotaGTE(v int64) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldStorageQuota, v))
+}
+
+// StorageQuotaLT applies the LT predicate on the "storage_quota" field.
+func StorageQuotaLT(v int64) predicate.User {
+	return predicate.User(sql.FieldLT(FieldStorageQuota, v))
+}
+
+// StorageQuotaLTE applies the LTE predicate on the "storage_quota" field.
+func StorageQuotaLTE(v int64) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldStorageQuota, v))
+}
+
+// StorageQuotaIsNil applies the IsNil predicate on the "storage_quota" field.
+func StorageQuotaIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldStorageQuota))
+}
+
+// StorageQuotaNotNil applies the NotNil predicate on the "storage_quota" field.
+func StorageQuotaNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldStorageQuota))
+}
+
+// StorageUsedEQ applies the EQ predicate on the "storage_used" field.
+func StorageUsedEQ(v int64) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldStorageUsed, v))
+}
+
+// StorageUsedNEQ applies the NEQ predicate on the "storage_used" field.
+func StorageUsedNEQ(v int64) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldStorageUsed, v))
+}
+
+// StorageUsedIn applies the In predicate on the "storage_used" field.
+func StorageUsedIn(vs ...int64) predicate.User {
+	return predicate.User(sql.FieldIn(FieldStorageUsed, vs...))
+}
+
+// StorageUsedNotIn applies the NotIn predicate on the "storage_used" field.
+func StorageUsedNotIn(vs ...int64) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldStorageUsed, vs...))
+}
+
+// StorageUsedGT applies the GT predicate on the "storage_used" field.
+func StorageUsedGT(v int64) predicate.User {
+	return predicate.User(sql.FieldGT(FieldStorageUsed, v))
+}
+
+// StorageUsedGTE applies the GTE predicate on the "storage_used" field.
+func StorageUsedGTE(v int64) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldStorageUsed, v))
+}
+
+// StorageUsedLT applies the LT predicate on the "storage_used" field.
+func StorageUsedLT(v int64) predicate.User {
+	return predicate.User(sql.FieldLT(FieldStorageUsed, v))
+}
+
+// StorageUsedLTE applies the LTE predicate on the "storage_used" field.
+func StorageUsedLTE(v int64) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldStorageUsed, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldCreatedAt, v))
@@ -952,6 +1052,328 @@ func HasNoteRepostsWith(preds ...predicate.NoteRepost) predicate.User {
 	})
 }
 
+// HasQuizzes applies the HasEdge predicate on the "quizzes" edge.
+func HasQuizzes() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, QuizzesTable, QuizzesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasQuizzesWith applies the HasEdge predicate on the "quizzes" edge with a given conditions (other predicates).
+func HasQuizzesWith(preds ...predicate.Quiz) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newQuizzesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasQuizAttempts applies the HasEdge predicate on the "quiz_attempts" edge.
+func HasQuizAttempts() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, QuizAttemptsTable, QuizAttemptsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasQuizAttemptsWith applies the HasEdge predicate on the "quiz_attempts" edge with a given conditions (other predicates).
+func HasQuizAttemptsWith(preds ...predicate.QuizAttempt) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newQuizAttemptsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasGroupMemberships applies the HasEdge predicate on the "group_memberships" edge.
+func HasGroupMemberships() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, GroupMembershipsTable, GroupMembershipsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGroupMembershipsWith applies the HasEdge predicate on the "group_memberships" edge with a given conditions (other predicates).
+func HasGroupMembershipsWith(preds ...predicate.GroupMembership) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newGroupMembershipsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasGroupJoinRequests applies the HasEdge predicate on the "group_join_requests" edge.
+func HasGroupJoinRequests() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, GroupJoinRequestsTable, GroupJoinRequestsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGroupJoinRequestsWith applies the HasEdge predicate on the "group_join_requests" edge with a given conditions (other predicates).
+func HasGroupJoinRequestsWith(preds ...predicate.GroupJoinRequest) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newGroupJoinRequestsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasGroupInvites applies the HasEdge predicate on the "group_invites" edge.
+func HasGroupInvites() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, GroupInvitesTable, GroupInvitesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGroupInvitesWith applies the HasEdge predicate on the "group_invites" edge with a given conditions (other predicates).
+func HasGroupInvitesWith(preds ...predicate.GroupInvite) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newGroupInvitesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasStudyEvents applies the HasEdge predicate on the "study_events" edge.
+func HasStudyEvents() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, StudyEventsTable, StudyEventsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStudyEventsWith applies the HasEdge predicate on the "study_events" edge with a given conditions (other predicates).
+func HasStudyEventsWith(preds ...predicate.StudyEvent) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newStudyEventsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDocuments applies the HasEdge predicate on the "documents" edge.
+func HasDocuments() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, DocumentsTable, DocumentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDocumentsWith applies the HasEdge predicate on the "documents" edge with a given conditions (other predicates).
+func HasDocumentsWith(preds ...predicate.Document) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newDocumentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasFolders applies the HasEdge predicate on the "folders" edge.
+func HasFolders() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, FoldersTable, FoldersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFoldersWith applies the HasEdge predicate on the "folders" edge with a given conditions (other predicates).
+func HasFoldersWith(preds ...predicate.Folder) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newFoldersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBlobReferences applies the HasEdge predicate on the "blob_references" edge.
+func HasBlobReferences() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, BlobReferencesTable, BlobReferencesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBlobReferencesWith applies the HasEdge predicate on the "blob_references" edge with a given conditions (other predicates).
+func HasBlobReferencesWith(preds ...predicate.BlobReference) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newBlobReferencesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasResumableUploads applies the HasEdge predicate on the "resumable_uploads" edge.
+func HasResumableUploads() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ResumableUploadsTable, ResumableUploadsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasResumableUploadsWith applies the HasEdge predicate on the "resumable_uploads" edge with a given conditions (other predicates).
+func HasResumableUploadsWith(preds ...predicate.ResumableUpload) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newResumableUploadsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasNoteProposals applies the HasEdge predicate on the "note_proposals" edge.
+func HasNoteProposals() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, NoteProposalsTable, NoteProposalsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasNoteProposalsWith applies the HasEdge predicate on the "note_proposals" edge with a given conditions (other predicates).
+func HasNoteProposalsWith(preds ...predicate.NoteProposal) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newNoteProposalsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasNoteRevisions applies the HasEdge predicate on the "note_revisions" edge.
+func HasNoteRevisions() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, NoteRevisionsTable, NoteRevisionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasNoteRevisionsWith applies the HasEdge predicate on the "note_revisions" edge with a given conditions (other predicates).
+func HasNoteRevisionsWith(preds ...predicate.NoteRevision) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newNoteRevisionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasNotebooks applies the HasEdge predicate on the "notebooks" edge.
+func HasNotebooks() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, NotebooksTable, NotebooksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasNotebooksWith applies the HasEdge predicate on the "notebooks" edge with a given conditions (other predicates).
+func HasNotebooksWith(preds ...predicate.Notebook) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newNotebooksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTags applies the HasEdge predicate on the "tags" edge.
+func HasTags() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TagsTable, TagsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTagsWith applies the HasEdge predicate on the "tags" edge with a given conditions (other predicates).
+func HasTagsWith(preds ...predicate.Tag) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newTagsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.User) predicate.User {
 	return predicate.User(sql.AndPredicates(predicates...))
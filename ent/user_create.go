@@ -10,10 +10,24 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/r-scheele/zero/ent/blobreference"
+	"github.com/r-scheele/zero/ent/document"
+	"github.com/r-scheele/zero/ent/folder"
+	"github.com/r-scheele/zero/ent/groupinvite"
+	"github.com/r-scheele/zero/ent/groupjoinrequest"
+	"github.com/r-scheele/zero/ent/groupmembership"
 	"github.com/r-scheele/zero/ent/note"
+	"github.com/r-scheele/zero/ent/notebook"
 	"github.com/r-scheele/zero/ent/notelike"
+	"github.com/r-scheele/zero/ent/noteproposal"
 	"github.com/r-scheele/zero/ent/noterepost"
+	"github.com/r-scheele/zero/ent/noterevision"
 	"github.com/r-scheele/zero/ent/passwordtoken"
+	"github.com/r-scheele/zero/ent/quiz"
+	"github.com/r-scheele/zero/ent/quizattempt"
+	"github.com/r-scheele/zero/ent/resumableupload"
+	"github.com/r-scheele/zero/ent/studyevent"
+	"github.com/r-scheele/zero/ent/tag"
 	"github.com/r-scheele/zero/ent/user"
 )
 
@@ -218,6 +232,34 @@ func (uc *UserCreate) SetNillableLastLogin(t *time.Time) *UserCreate {
 	return uc
 }
 
+// SetStorageQuota sets the "storage_quota" field.
+func (uc *UserCreate) SetStorageQuota(i int64) *UserCreate {
+	uc.mutation.SetStorageQuota(i)
+	return uc
+}
+
+// SetNillableStorageQuota sets the "storage_quota" field if the given value is not nil.
+func (uc *UserCreate) SetNillableStorageQuota(i *int64) *UserCreate {
+	if i != nil {
+		uc.SetStorageQuota(*i)
+	}
+	return uc
+}
+
+// SetStorageUsed sets the "storage_used" field.
+func (uc *UserCreate) SetStorageUsed(i int64) *UserCreate {
+	uc.mutation.SetStorageUsed(i)
+	return uc
+}
+
+// SetNillableStorageUsed sets the "storage_used" field if the given value is not nil.
+func (uc *UserCreate) SetNillableStorageUsed(i *int64) *UserCreate {
+	if i != nil {
+		uc.SetStorageUsed(*i)
+	}
+	return uc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (uc *UserCreate) SetCreatedAt(t time.Time) *UserCreate {
 	uc.mutation.SetCreatedAt(t)
@@ -306,6 +348,216 @@ func (uc *UserCreate) AddNoteReposts(n ...*NoteRepost) *UserCreate {
 	return uc.AddNoteRepostIDs(ids...)
 }
 
+// AddQuizIDs adds the "quizzes" edge to the Quiz entity by IDs.
+func (uc *UserCreate) AddQuizIDs(ids ...int) *UserCreate {
+	uc.mutation.AddQuizIDs(ids...)
+	return uc
+}
+
+// AddQuizzes adds the "quizzes" edges to the Quiz entity.
+func (uc *UserCreate) AddQuizzes(q ...*Quiz) *UserCreate {
+	ids := make([]int, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return uc.AddQuizIDs(ids...)
+}
+
+// AddQuizAttemptIDs adds the "quiz_attempts" edge to the QuizAttempt entity by IDs.
+func (uc *UserCreate) AddQuizAttemptIDs(ids ...int) *UserCreate {
+	uc.mutation.AddQuizAttemptIDs(ids...)
+	return uc
+}
+
+// AddQuizAttempts adds the "quiz_attempts" edges to the QuizAttempt entity.
+func (uc *UserCreate) AddQuizAttempts(q ...*QuizAttempt) *UserCreate {
+	ids := make([]int, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return uc.AddQuizAttemptIDs(ids...)
+}
+
+// AddGroupMembershipIDs adds the "group_memberships" edge to the GroupMembership entity by IDs.
+func (uc *UserCreate) AddGroupMembershipIDs(ids ...int) *UserCreate {
+	uc.mutation.AddGroupMembershipIDs(ids...)
+	return uc
+}
+
+// AddGroupMemberships adds the "group_memberships" edges to the GroupMembership entity.
+func (uc *UserCreate) AddGroupMemberships(g ...*GroupMembership) *UserCreate {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return uc.AddGroupMembershipIDs(ids...)
+}
+
+// AddGroupJoinRequestIDs adds the "group_join_requests" edge to the GroupJoinRequest entity by IDs.
+func (uc *UserCreate) AddGroupJoinRequestIDs(ids ...int) *UserCreate {
+	uc.mutation.AddGroupJoinRequestIDs(ids...)
+	return uc
+}
+
+// AddGroupJoinRequests adds the "group_join_requests" edges to the GroupJoinRequest entity.
+func (uc *UserCreate) AddGroupJoinRequests(g ...*GroupJoinRequest) *UserCreate {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return uc.AddGroupJoinRequestIDs(ids...)
+}
+
+// AddGroupInviteIDs adds the "group_invites" edge to the GroupInvite entity by IDs.
+func (uc *UserCreate) AddGroupInviteIDs(ids ...int) *UserCreate {
+	uc.mutation.AddGroupInviteIDs(ids...)
+	return uc
+}
+
+// AddGroupInvites adds the "group_invites" edges to the GroupInvite entity.
+func (uc *UserCreate) AddGroupInvites(g ...*GroupInvite) *UserCreate {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return uc.AddGroupInviteIDs(ids...)
+}
+
+// AddStudyEventIDs adds the "study_events" edge to the StudyEvent entity by IDs.
+func (uc *UserCreate) AddStudyEventIDs(ids ...int) *UserCreate {
+	uc.mutation.AddStudyEventIDs(ids...)
+	return uc
+}
+
+// AddStudyEvents adds the "study_events" edges to the StudyEvent entity.
+func (uc *UserCreate) AddStudyEvents(s ...*StudyEvent) *UserCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uc.AddStudyEventIDs(ids...)
+}
+
+// AddDocumentIDs adds the "documents" edge to the Document entity by IDs.
+func (uc *UserCreate) AddDocumentIDs(ids ...int) *UserCreate {
+	uc.mutation.AddDocumentIDs(ids...)
+	return uc
+}
+
+// AddDocuments adds the "documents" edges to the Document entity.
+func (uc *UserCreate) AddDocuments(d ...*Document) *UserCreate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return uc.AddDocumentIDs(ids...)
+}
+
+// AddFolderIDs adds the "folders" edge to the Folder entity by IDs.
+func (uc *UserCreate) AddFolderIDs(ids ...int) *UserCreate {
+	uc.mutation.AddFolderIDs(ids...)
+	return uc
+}
+
+// AddFolders adds the "folders" edges to the Folder entity.
+func (uc *UserCreate) AddFolders(f ...*Folder) *UserCreate {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uc.AddFolderIDs(ids...)
+}
+
+// AddBlobReferenceIDs adds the "blob_references" edge to the BlobReference entity by IDs.
+func (uc *UserCreate) AddBlobReferenceIDs(ids ...int) *UserCreate {
+	uc.mutation.AddBlobReferenceIDs(ids...)
+	return uc
+}
+
+// AddBlobReferences adds the "blob_references" edges to the BlobReference entity.
+func (uc *UserCreate) AddBlobReferences(b ...*BlobReference) *UserCreate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return uc.AddBlobReferenceIDs(ids...)
+}
+
+// AddResumableUploadIDs adds the "resumable_uploads" edge to the ResumableUpload entity by IDs.
+func (uc *UserCreate) AddResumableUploadIDs(ids ...int) *UserCreate {
+	uc.mutation.AddResumableUploadIDs(ids...)
+	return uc
+}
+
+// AddResumableUploads adds the "resumable_uploads" edges to the ResumableUpload entity.
+func (uc *UserCreate) AddResumableUploads(r ...*ResumableUpload) *UserCreate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uc.AddResumableUploadIDs(ids...)
+}
+
+// AddNoteProposalIDs adds the "note_proposals" edge to the NoteProposal entity by IDs.
+func (uc *UserCreate) AddNoteProposalIDs(ids ...int) *UserCreate {
+	uc.mutation.AddNoteProposalIDs(ids...)
+	return uc
+}
+
+// AddNoteProposals adds the "note_proposals" edges to the NoteProposal entity.
+func (uc *UserCreate) AddNoteProposals(n ...*NoteProposal) *UserCreate {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return uc.AddNoteProposalIDs(ids...)
+}
+
+// AddNoteRevisionIDs adds the "note_revisions" edge to the NoteRevision entity by IDs.
+func (uc *UserCreate) AddNoteRevisionIDs(ids ...int) *UserCreate {
+	uc.mutation.AddNoteRevisionIDs(ids...)
+	return uc
+}
+
+// AddNoteRevisions adds the "note_revisions" edges to the NoteRevision entity.
+func (uc *UserCreate) AddNoteRevisions(n ...*NoteRevision) *UserCreate {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return uc.AddNoteRevisionIDs(ids...)
+}
+
+// AddNotebookIDs adds the "notebooks" edge to the Notebook entity by IDs.
+func (uc *UserCreate) AddNotebookIDs(ids ...int) *UserCreate {
+	uc.mutation.AddNotebookIDs(ids...)
+	return uc
+}
+
+// AddNotebooks adds the "notebooks" edges to the Notebook entity.
+func (uc *UserCreate) AddNotebooks(n ...*Notebook) *UserCreate {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return uc.AddNotebookIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (uc *UserCreate) AddTagIDs(ids ...int) *UserCreate {
+	uc.mutation.AddTagIDs(ids...)
+	return uc
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (uc *UserCreate) AddTags(t ...*Tag) *UserCreate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return uc.AddTagIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uc *UserCreate) Mutation() *UserMutation {
 	return uc.mutation
@@ -369,6 +621,10 @@ func (uc *UserCreate) defaults() {
 		v := user.DefaultIsActive
 		uc.mutation.SetIsActive(v)
 	}
+	if _, ok := uc.mutation.StorageUsed(); !ok {
+		v := user.DefaultStorageUsed
+		uc.mutation.SetStorageUsed(v)
+	}
 	if _, ok := uc.mutation.CreatedAt(); !ok {
 		v := user.DefaultCreatedAt()
 		uc.mutation.SetCreatedAt(v)
@@ -438,6 +694,14 @@ func (uc *UserCreate) check() error {
 	if _, ok := uc.mutation.IsActive(); !ok {
 		return &ValidationError{Name: "is_active", err: errors.New(`ent: missing required field "User.is_active"`)}
 	}
+	if v, ok := uc.mutation.StorageQuota(); ok {
+		if err := user.StorageQuotaValidator(v); err != nil {
+			return &ValidationError{Name: "storage_quota", err: fmt.Errorf(`ent: validator failed for field "User.storage_quota": %w`, err)}
+		}
+	}
+	if _, ok := uc.mutation.StorageUsed(); !ok {
+		return &ValidationError{Name: "storage_used", err: errors.New(`ent: missing required field "User.storage_used"`)}
+	}
 	if _, ok := uc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "User.created_at"`)}
 	}
@@ -527,6 +791,14 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldLastLogin, field.TypeTime, value)
 		_node.LastLogin = &value
 	}
+	if value, ok := uc.mutation.StorageQuota(); ok {
+		_spec.SetField(user.FieldStorageQuota, field.TypeInt64, value)
+		_node.StorageQuota = &value
+	}
+	if value, ok := uc.mutation.StorageUsed(); ok {
+		_spec.SetField(user.FieldStorageUsed, field.TypeInt64, value)
+		_node.StorageUsed = value
+	}
 	if value, ok := uc.mutation.CreatedAt(); ok {
 		_spec.SetField(user.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -599,6 +871,230 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := uc.mutation.QuizzesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.QuizzesTable,
+			Columns: []string{user.QuizzesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quiz.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.QuizAttemptsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.QuizAttemptsTable,
+			Columns: []string{user.QuizAttemptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quizattempt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.GroupMembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GroupMembershipsTable,
+			Columns: []string{user.GroupMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupmembership.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.GroupJoinRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GroupJoinRequestsTable,
+			Columns: []string{user.GroupJoinRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupjoinrequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.GroupInvitesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GroupInvitesTable,
+			Columns: []string{user.GroupInvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupinvite.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.StudyEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.StudyEventsTable,
+			Columns: []string{user.StudyEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(studyevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.DocumentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DocumentsTable,
+			Columns: []string{user.DocumentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(document.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.FoldersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FoldersTable,
+			Columns: []string{user.FoldersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(folder.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.BlobReferencesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BlobReferencesTable,
+			Columns: []string{user.BlobReferencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blobreference.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.ResumableUploadsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ResumableUploadsTable,
+			Columns: []string{user.ResumableUploadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resumableupload.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.NoteProposalsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NoteProposalsTable,
+			Columns: []string{user.NoteProposalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(noteproposal.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.NoteRevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NoteRevisionsTable,
+			Columns: []string{user.NoteRevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(noterevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.NotebooksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotebooksTable,
+			Columns: []string{user.NotebooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notebook.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TagsTable,
+			Columns: []string{user.TagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
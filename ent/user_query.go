@@ -12,25 +12,53 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/r-scheele/zero/ent/blobreference"
+	"github.com/r-scheele/zero/ent/document"
+	"github.com/r-scheele/zero/ent/folder"
+	"github.com/r-scheele/zero/ent/groupinvite"
+	"github.com/r-scheele/zero/ent/groupjoinrequest"
+	"github.com/r-scheele/zero/ent/groupmembership"
 	"github.com/r-scheele/zero/ent/note"
+	"github.com/r-scheele/zero/ent/notebook"
 	"github.com/r-scheele/zero/ent/notelike"
+	"github.com/r-scheele/zero/ent/noteproposal"
 	"github.com/r-scheele/zero/ent/noterepost"
+	"github.com/r-scheele/zero/ent/noterevision"
 	"github.com/r-scheele/zero/ent/passwordtoken"
 	"github.com/r-scheele/zero/ent/predicate"
+	"github.com/r-scheele/zero/ent/quiz"
+	"github.com/r-scheele/zero/ent/quizattempt"
+	"github.com/r-scheele/zero/ent/resumableupload"
+	"github.com/r-scheele/zero/ent/studyevent"
+	"github.com/r-scheele/zero/ent/tag"
 	"github.com/r-scheele/zero/ent/user"
 )
 
 // UserQuery is the builder for querying User entities.
 type UserQuery struct {
 	config
-	ctx             *QueryContext
-	order           []user.OrderOption
-	inters          []Interceptor
-	predicates      []predicate.User
-	withOwner       *PasswordTokenQuery
-	withNotes       *NoteQuery
-	withNoteLikes   *NoteLikeQuery
-	withNoteReposts *NoteRepostQuery
+	ctx                   *QueryContext
+	order                 []user.OrderOption
+	inters                []Interceptor
+	predicates            []predicate.User
+	withOwner             *PasswordTokenQuery
+	withNotes             *NoteQuery
+	withNoteLikes         *NoteLikeQuery
+	withNoteReposts       *NoteRepostQuery
+	withQuizzes           *QuizQuery
+	withQuizAttempts      *QuizAttemptQuery
+	withGroupMemberships  *GroupMembershipQuery
+	withGroupJoinRequests *GroupJoinRequestQuery
+	withGroupInvites      *GroupInviteQuery
+	withStudyEvents       *StudyEventQuery
+	withDocuments         *DocumentQuery
+	withFolders           *FolderQuery
+	withBlobReferences    *BlobReferenceQuery
+	withResumableUploads  *ResumableUploadQuery
+	withNoteProposals     *NoteProposalQuery
+	withNoteRevisions     *NoteRevisionQuery
+	withNotebooks         *NotebookQuery
+	withTags              *TagQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -155,6 +183,314 @@ func (uq *UserQuery) QueryNoteReposts() *NoteRepostQuery {
 	return query
 }
 
+// QueryQuizzes chains the current query on the "quizzes" edge.
+func (uq *UserQuery) QueryQuizzes() *QuizQuery {
+	query := (&QuizClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(quiz.Table, quiz.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.QuizzesTable, user.QuizzesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryQuizAttempts chains the current query on the "quiz_attempts" edge.
+func (uq *UserQuery) QueryQuizAttempts() *QuizAttemptQuery {
+	query := (&QuizAttemptClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(quizattempt.Table, quizattempt.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.QuizAttemptsTable, user.QuizAttemptsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryGroupMemberships chains the current query on the "group_memberships" edge.
+func (uq *UserQuery) QueryGroupMemberships() *GroupMembershipQuery {
+	query := (&GroupMembershipClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(groupmembership.Table, groupmembership.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.GroupMembershipsTable, user.GroupMembershipsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryGroupJoinRequests chains the current query on the "group_join_requests" edge.
+func (uq *UserQuery) QueryGroupJoinRequests() *GroupJoinRequestQuery {
+	query := (&GroupJoinRequestClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(groupjoinrequest.Table, groupjoinrequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.GroupJoinRequestsTable, user.GroupJoinRequestsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryGroupInvites chains the current query on the "group_invites" edge.
+func (uq *UserQuery) QueryGroupInvites() *GroupInviteQuery {
+	query := (&GroupInviteClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(groupinvite.Table, groupinvite.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.GroupInvitesTable, user.GroupInvitesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryStudyEvents chains the current query on the "study_events" edge.
+func (uq *UserQuery) QueryStudyEvents() *StudyEventQuery {
+	query := (&StudyEventClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(studyevent.Table, studyevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.StudyEventsTable, user.StudyEventsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryDocuments chains the current query on the "documents" edge.
+func (uq *UserQuery) QueryDocuments() *DocumentQuery {
+	query := (&DocumentClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(document.Table, document.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.DocumentsTable, user.DocumentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryFolders chains the current query on the "folders" edge.
+func (uq *UserQuery) QueryFolders() *FolderQuery {
+	query := (&FolderClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(folder.Table, folder.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.FoldersTable, user.FoldersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBlobReferences chains the current query on the "blob_references" edge.
+func (uq *UserQuery) QueryBlobReferences() *BlobReferenceQuery {
+	query := (&BlobReferenceClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(blobreference.Table, blobreference.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.BlobReferencesTable, user.BlobReferencesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryResumableUploads chains the current query on the "resumable_uploads" edge.
+func (uq *UserQuery) QueryResumableUploads() *ResumableUploadQuery {
+	query := (&ResumableUploadClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(resumableupload.Table, resumableupload.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ResumableUploadsTable, user.ResumableUploadsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryNoteProposals chains the current query on the "note_proposals" edge.
+func (uq *UserQuery) QueryNoteProposals() *NoteProposalQuery {
+	query := (&NoteProposalClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(noteproposal.Table, noteproposal.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.NoteProposalsTable, user.NoteProposalsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryNoteRevisions chains the current query on the "note_revisions" edge.
+func (uq *UserQuery) QueryNoteRevisions() *NoteRevisionQuery {
+	query := (&NoteRevisionClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(noterevision.Table, noterevision.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.NoteRevisionsTable, user.NoteRevisionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryNotebooks chains the current query on the "notebooks" edge.
+func (uq *UserQuery) QueryNotebooks() *NotebookQuery {
+	query := (&NotebookClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(notebook.Table, notebook.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.NotebooksTable, user.NotebooksColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTags chains the current query on the "tags" edge.
+func (uq *UserQuery) QueryTags() *TagQuery {
+	query := (&TagClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.TagsTable, user.TagsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first User entity from the query.
 // Returns a *NotFoundError when no User was found.
 func (uq *UserQuery) First(ctx context.Context) (*User, error) {
@@ -342,15 +678,29 @@ func (uq *UserQuery) Clone() *UserQuery {
 		return nil
 	}
 	return &UserQuery{
-		config:          uq.config,
-		ctx:             uq.ctx.Clone(),
-		order:           append([]user.OrderOption{}, uq.order...),
-		inters:          append([]Interceptor{}, uq.inters...),
-		predicates:      append([]predicate.User{}, uq.predicates...),
-		withOwner:       uq.withOwner.Clone(),
-		withNotes:       uq.withNotes.Clone(),
-		withNoteLikes:   uq.withNoteLikes.Clone(),
-		withNoteReposts: uq.withNoteReposts.Clone(),
+		config:                uq.config,
+		ctx:                   uq.ctx.Clone(),
+		order:                 append([]user.OrderOption{}, uq.order...),
+		inters:                append([]Interceptor{}, uq.inters...),
+		predicates:            append([]predicate.User{}, uq.predicates...),
+		withOwner:             uq.withOwner.Clone(),
+		withNotes:             uq.withNotes.Clone(),
+		withNoteLikes:         uq.withNoteLikes.Clone(),
+		withNoteReposts:       uq.withNoteReposts.Clone(),
+		withQuizzes:           uq.withQuizzes.Clone(),
+		withQuizAttempts:      uq.withQuizAttempts.Clone(),
+		withGroupMemberships:  uq.withGroupMemberships.Clone(),
+		withGroupJoinRequests: uq.withGroupJoinRequests.Clone(),
+		withGroupInvites:      uq.withGroupInvites.Clone(),
+		withStudyEvents:       uq.withStudyEvents.Clone(),
+		withDocuments:         uq.withDocuments.Clone(),
+		withFolders:           uq.withFolders.Clone(),
+		withBlobReferences:    uq.withBlobReferences.Clone(),
+		withResumableUploads:  uq.withResumableUploads.Clone(),
+		withNoteProposals:     uq.withNoteProposals.Clone(),
+		withNoteRevisions:     uq.withNoteRevisions.Clone(),
+		withNotebooks:         uq.withNotebooks.Clone(),
+		withTags:              uq.withTags.Clone(),
 		// clone intermediate query.
 		sql:  uq.sql.Clone(),
 		path: uq.path,
@@ -401,24 +751,178 @@ func (uq *UserQuery) WithNoteReposts(opts ...func(*NoteRepostQuery)) *UserQuery
 	return uq
 }
 
-// GroupBy is used to group vertices by one or more fields/columns.
-// It is often used with aggregate functions, like: count, max, mean, min, sum.
-//
-// Example:
-//
-//	var v []struct {
-//		Name string `json:"name,omitempty"`
-//		Count int `json:"count,omitempty"`
-//	}
-//
-//	client.User.Query().
-//		GroupBy(user.FieldName).
-//		Aggregate(ent.Count()).
-//		Scan(ctx, &v)
-func (uq *UserQuery) GroupBy(field string, fields ...string) *UserGroupBy {
-	uq.ctx.Fields = append([]string{field}, fields...)
-	grbuild := &UserGroupBy{build: uq}
-	grbuild.flds = &uq.ctx.Fields
+// WithQuizzes tells the query-builder to eager-load the nodes that are connected to
+// the "quizzes" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithQuizzes(opts ...func(*QuizQuery)) *UserQuery {
+	query := (&QuizClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withQuizzes = query
+	return uq
+}
+
+// WithQuizAttempts tells the query-builder to eager-load the nodes that are connected to
+// the "quiz_attempts" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithQuizAttempts(opts ...func(*QuizAttemptQuery)) *UserQuery {
+	query := (&QuizAttemptClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withQuizAttempts = query
+	return uq
+}
+
+// WithGroupMemberships tells the query-builder to eager-load the nodes that are connected to
+// the "group_memberships" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithGroupMemberships(opts ...func(*GroupMembershipQuery)) *UserQuery {
+	query := (&GroupMembershipClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withGroupMemberships = query
+	return uq
+}
+
+// WithGroupJoinRequests tells the query-builder to eager-load the nodes that are connected to
+// the "group_join_requests" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithGroupJoinRequests(opts ...func(*GroupJoinRequestQuery)) *UserQuery {
+	query := (&GroupJoinRequestClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withGroupJoinRequests = query
+	return uq
+}
+
+// WithGroupInvites tells the query-builder to eager-load the nodes that are connected to
+// the "group_invites" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithGroupInvites(opts ...func(*GroupInviteQuery)) *UserQuery {
+	query := (&GroupInviteClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withGroupInvites = query
+	return uq
+}
+
+// WithStudyEvents tells the query-builder to eager-load the nodes that are connected to
+// the "study_events" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithStudyEvents(opts ...func(*StudyEventQuery)) *UserQuery {
+	query := (&StudyEventClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withStudyEvents = query
+	return uq
+}
+
+// WithDocuments tells the query-builder to eager-load the nodes that are connected to
+// the "documents" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithDocuments(opts ...func(*DocumentQuery)) *UserQuery {
+	query := (&DocumentClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withDocuments = query
+	return uq
+}
+
+// WithFolders tells the query-builder to eager-load the nodes that are connected to
+// the "folders" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithFolders(opts ...func(*FolderQuery)) *UserQuery {
+	query := (&FolderClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withFolders = query
+	return uq
+}
+
+// WithBlobReferences tells the query-builder to eager-load the nodes that are connected to
+// the "blob_references" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithBlobReferences(opts ...func(*BlobReferenceQuery)) *UserQuery {
+	query := (&BlobReferenceClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withBlobReferences = query
+	return uq
+}
+
+// WithResumableUploads tells the query-builder to eager-load the nodes that are connected to
+// the "resumable_uploads" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithResumableUploads(opts ...func(*ResumableUploadQuery)) *UserQuery {
+	query := (&ResumableUploadClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withResumableUploads = query
+	return uq
+}
+
+// WithNoteProposals tells the query-builder to eager-load the nodes that are connected to
+// the "note_proposals" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNoteProposals(opts ...func(*NoteProposalQuery)) *UserQuery {
+	query := (&NoteProposalClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withNoteProposals = query
+	return uq
+}
+
+// WithNoteRevisions tells the query-builder to eager-load the nodes that are connected to
+// the "note_revisions" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNoteRevisions(opts ...func(*NoteRevisionQuery)) *UserQuery {
+	query := (&NoteRevisionClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withNoteRevisions = query
+	return uq
+}
+
+// WithNotebooks tells the query-builder to eager-load the nodes that are connected to
+// the "notebooks" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNotebooks(opts ...func(*NotebookQuery)) *UserQuery {
+	query := (&NotebookClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withNotebooks = query
+	return uq
+}
+
+// WithTags tells the query-builder to eager-load the nodes that are connected to
+// the "tags" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithTags(opts ...func(*TagQuery)) *UserQuery {
+	query := (&TagClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withTags = query
+	return uq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.User.Query().
+//		GroupBy(user.FieldName).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (uq *UserQuery) GroupBy(field string, fields ...string) *UserGroupBy {
+	uq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &UserGroupBy{build: uq}
+	grbuild.flds = &uq.ctx.Fields
 	grbuild.label = user.Label
 	grbuild.scan = grbuild.Scan
 	return grbuild
@@ -479,11 +983,25 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 	var (
 		nodes       = []*User{}
 		_spec       = uq.querySpec()
-		loadedTypes = [4]bool{
+		loadedTypes = [18]bool{
 			uq.withOwner != nil,
 			uq.withNotes != nil,
 			uq.withNoteLikes != nil,
 			uq.withNoteReposts != nil,
+			uq.withQuizzes != nil,
+			uq.withQuizAttempts != nil,
+			uq.withGroupMemberships != nil,
+			uq.withGroupJoinRequests != nil,
+			uq.withGroupInvites != nil,
+			uq.withStudyEvents != nil,
+			uq.withDocuments != nil,
+			uq.withFolders != nil,
+			uq.withBlobReferences != nil,
+			uq.withResumableUploads != nil,
+			uq.withNoteProposals != nil,
+			uq.withNoteRevisions != nil,
+			uq.withNotebooks != nil,
+			uq.withTags != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -532,6 +1050,104 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			return nil, err
 		}
 	}
+	if query := uq.withQuizzes; query != nil {
+		if err := uq.loadQuizzes(ctx, query, nodes,
+			func(n *User) { n.Edges.Quizzes = []*Quiz{} },
+			func(n *User, e *Quiz) { n.Edges.Quizzes = append(n.Edges.Quizzes, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withQuizAttempts; query != nil {
+		if err := uq.loadQuizAttempts(ctx, query, nodes,
+			func(n *User) { n.Edges.QuizAttempts = []*QuizAttempt{} },
+			func(n *User, e *QuizAttempt) { n.Edges.QuizAttempts = append(n.Edges.QuizAttempts, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withGroupMemberships; query != nil {
+		if err := uq.loadGroupMemberships(ctx, query, nodes,
+			func(n *User) { n.Edges.GroupMemberships = []*GroupMembership{} },
+			func(n *User, e *GroupMembership) { n.Edges.GroupMemberships = append(n.Edges.GroupMemberships, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withGroupJoinRequests; query != nil {
+		if err := uq.loadGroupJoinRequests(ctx, query, nodes,
+			func(n *User) { n.Edges.GroupJoinRequests = []*GroupJoinRequest{} },
+			func(n *User, e *GroupJoinRequest) { n.Edges.GroupJoinRequests = append(n.Edges.GroupJoinRequests, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withGroupInvites; query != nil {
+		if err := uq.loadGroupInvites(ctx, query, nodes,
+			func(n *User) { n.Edges.GroupInvites = []*GroupInvite{} },
+			func(n *User, e *GroupInvite) { n.Edges.GroupInvites = append(n.Edges.GroupInvites, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withStudyEvents; query != nil {
+		if err := uq.loadStudyEvents(ctx, query, nodes,
+			func(n *User) { n.Edges.StudyEvents = []*StudyEvent{} },
+			func(n *User, e *StudyEvent) { n.Edges.StudyEvents = append(n.Edges.StudyEvents, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withDocuments; query != nil {
+		if err := uq.loadDocuments(ctx, query, nodes,
+			func(n *User) { n.Edges.Documents = []*Document{} },
+			func(n *User, e *Document) { n.Edges.Documents = append(n.Edges.Documents, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withFolders; query != nil {
+		if err := uq.loadFolders(ctx, query, nodes,
+			func(n *User) { n.Edges.Folders = []*Folder{} },
+			func(n *User, e *Folder) { n.Edges.Folders = append(n.Edges.Folders, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withBlobReferences; query != nil {
+		if err := uq.loadBlobReferences(ctx, query, nodes,
+			func(n *User) { n.Edges.BlobReferences = []*BlobReference{} },
+			func(n *User, e *BlobReference) { n.Edges.BlobReferences = append(n.Edges.BlobReferences, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withResumableUploads; query != nil {
+		if err := uq.loadResumableUploads(ctx, query, nodes,
+			func(n *User) { n.Edges.ResumableUploads = []*ResumableUpload{} },
+			func(n *User, e *ResumableUpload) { n.Edges.ResumableUploads = append(n.Edges.ResumableUploads, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withNoteProposals; query != nil {
+		if err := uq.loadNoteProposals(ctx, query, nodes,
+			func(n *User) { n.Edges.NoteProposals = []*NoteProposal{} },
+			func(n *User, e *NoteProposal) { n.Edges.NoteProposals = append(n.Edges.NoteProposals, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withNoteRevisions; query != nil {
+		if err := uq.loadNoteRevisions(ctx, query, nodes,
+			func(n *User) { n.Edges.NoteRevisions = []*NoteRevision{} },
+			func(n *User, e *NoteRevision) { n.Edges.NoteRevisions = append(n.Edges.NoteRevisions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withNotebooks; query != nil {
+		if err := uq.loadNotebooks(ctx, query, nodes,
+			func(n *User) { n.Edges.Notebooks = []*Notebook{} },
+			func(n *User, e *Notebook) { n.Edges.Notebooks = append(n.Edges.Notebooks, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withTags; query != nil {
+		if err := uq.loadTags(ctx, query, nodes,
+			func(n *User) { n.Edges.Tags = []*Tag{} },
+			func(n *User, e *Tag) { n.Edges.Tags = append(n.Edges.Tags, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -658,6 +1274,440 @@ func (uq *UserQuery) loadNoteReposts(ctx context.Context, query *NoteRepostQuery
 	}
 	return nil
 }
+func (uq *UserQuery) loadQuizzes(ctx context.Context, query *QuizQuery, nodes []*User, init func(*User), assign func(*User, *Quiz)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Quiz(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.QuizzesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_quizzes
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_quizzes" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_quizzes" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadQuizAttempts(ctx context.Context, query *QuizAttemptQuery, nodes []*User, init func(*User), assign func(*User, *QuizAttempt)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.QuizAttempt(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.QuizAttemptsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_quiz_attempts
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_quiz_attempts" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_quiz_attempts" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadGroupMemberships(ctx context.Context, query *GroupMembershipQuery, nodes []*User, init func(*User), assign func(*User, *GroupMembership)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.GroupMembership(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.GroupMembershipsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_group_memberships
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_group_memberships" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_group_memberships" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadGroupJoinRequests(ctx context.Context, query *GroupJoinRequestQuery, nodes []*User, init func(*User), assign func(*User, *GroupJoinRequest)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.GroupJoinRequest(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.GroupJoinRequestsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_group_join_requests
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_group_join_requests" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_group_join_requests" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadGroupInvites(ctx context.Context, query *GroupInviteQuery, nodes []*User, init func(*User), assign func(*User, *GroupInvite)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.GroupInvite(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.GroupInvitesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_group_invites
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_group_invites" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_group_invites" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadStudyEvents(ctx context.Context, query *StudyEventQuery, nodes []*User, init func(*User), assign func(*User, *StudyEvent)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.StudyEvent(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.StudyEventsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_study_events
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_study_events" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_study_events" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadDocuments(ctx context.Context, query *DocumentQuery, nodes []*User, init func(*User), assign func(*User, *Document)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Document(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.DocumentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_documents
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_documents" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_documents" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadFolders(ctx context.Context, query *FolderQuery, nodes []*User, init func(*User), assign func(*User, *Folder)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Folder(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.FoldersColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_folders
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_folders" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_folders" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadBlobReferences(ctx context.Context, query *BlobReferenceQuery, nodes []*User, init func(*User), assign func(*User, *BlobReference)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.BlobReference(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.BlobReferencesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_blob_references
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_blob_references" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_blob_references" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadResumableUploads(ctx context.Context, query *ResumableUploadQuery, nodes []*User, init func(*User), assign func(*User, *ResumableUpload)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.ResumableUpload(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.ResumableUploadsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_resumable_uploads
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_resumable_uploads" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_resumable_uploads" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadNoteProposals(ctx context.Context, query *NoteProposalQuery, nodes []*User, init func(*User), assign func(*User, *NoteProposal)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.NoteProposal(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.NoteProposalsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_note_proposals
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_note_proposals" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_note_proposals" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadNoteRevisions(ctx context.Context, query *NoteRevisionQuery, nodes []*User, init func(*User), assign func(*User, *NoteRevision)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.NoteRevision(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.NoteRevisionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_note_revisions
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_note_revisions" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_note_revisions" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadNotebooks(ctx context.Context, query *NotebookQuery, nodes []*User, init func(*User), assign func(*User, *Notebook)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Notebook(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.NotebooksColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_notebooks
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_notebooks" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_notebooks" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadTags(ctx context.Context, query *TagQuery, nodes []*User, init func(*User), assign func(*User, *Tag)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Tag(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.TagsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_tags
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_tags" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_tags" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (uq *UserQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := uq.querySpec()
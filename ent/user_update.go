@@ -11,11 +11,25 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/r-scheele/zero/ent/blobreference"
+	"github.com/r-scheele/zero/ent/document"
+	"github.com/r-scheele/zero/ent/folder"
+	"github.com/r-scheele/zero/ent/groupinvite"
+	"github.com/r-scheele/zero/ent/groupjoinrequest"
+	"github.com/r-scheele/zero/ent/groupmembership"
 	"github.com/r-scheele/zero/ent/note"
+	"github.com/r-scheele/zero/ent/notebook"
 	"github.com/r-scheele/zero/ent/notelike"
+	"github.com/r-scheele/zero/ent/noteproposal"
 	"github.com/r-scheele/zero/ent/noterepost"
+	"github.com/r-scheele/zero/ent/noterevision"
 	"github.com/r-scheele/zero/ent/passwordtoken"
 	"github.com/r-scheele/zero/ent/predicate"
+	"github.com/r-scheele/zero/ent/quiz"
+	"github.com/r-scheele/zero/ent/quizattempt"
+	"github.com/r-scheele/zero/ent/resumableupload"
+	"github.com/r-scheele/zero/ent/studyevent"
+	"github.com/r-scheele/zero/ent/tag"
 	"github.com/r-scheele/zero/ent/user"
 )
 
@@ -278,6 +292,54 @@ func (uu *UserUpdate) ClearLastLogin() *UserUpdate {
 	return uu
 }
 
+// SetStorageQuota sets the "storage_quota" field.
+func (uu *UserUpdate) SetStorageQuota(i int64) *UserUpdate {
+	uu.mutation.ResetStorageQuota()
+	uu.mutation.SetStorageQuota(i)
+	return uu
+}
+
+// SetNillableStorageQuota sets the "storage_quota" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableStorageQuota(i *int64) *UserUpdate {
+	if i != nil {
+		uu.SetStorageQuota(*i)
+	}
+	return uu
+}
+
+// AddStorageQuota adds i to the "storage_quota" field.
+func (uu *UserUpdate) AddStorageQuota(i int64) *UserUpdate {
+	uu.mutation.AddStorageQuota(i)
+	return uu
+}
+
+// ClearStorageQuota clears the value of the "storage_quota" field.
+func (uu *UserUpdate) ClearStorageQuota() *UserUpdate {
+	uu.mutation.ClearStorageQuota()
+	return uu
+}
+
+// SetStorageUsed sets the "storage_used" field.
+func (uu *UserUpdate) SetStorageUsed(i int64) *UserUpdate {
+	uu.mutation.ResetStorageUsed()
+	uu.mutation.SetStorageUsed(i)
+	return uu
+}
+
+// SetNillableStorageUsed sets the "storage_used" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableStorageUsed(i *int64) *UserUpdate {
+	if i != nil {
+		uu.SetStorageUsed(*i)
+	}
+	return uu
+}
+
+// AddStorageUsed adds i to the "storage_used" field.
+func (uu *UserUpdate) AddStorageUsed(i int64) *UserUpdate {
+	uu.mutation.AddStorageUsed(i)
+	return uu
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (uu *UserUpdate) SetUpdatedAt(t time.Time) *UserUpdate {
 	uu.mutation.SetUpdatedAt(t)
@@ -350,6 +412,216 @@ func (uu *UserUpdate) AddNoteReposts(n ...*NoteRepost) *UserUpdate {
 	return uu.AddNoteRepostIDs(ids...)
 }
 
+// AddQuizIDs adds the "quizzes" edge to the Quiz entity by IDs.
+func (uu *UserUpdate) AddQuizIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddQuizIDs(ids...)
+	return uu
+}
+
+// AddQuizzes adds the "quizzes" edges to the Quiz entity.
+func (uu *UserUpdate) AddQuizzes(q ...*Quiz) *UserUpdate {
+	ids := make([]int, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return uu.AddQuizIDs(ids...)
+}
+
+// AddQuizAttemptIDs adds the "quiz_attempts" edge to the QuizAttempt entity by IDs.
+func (uu *UserUpdate) AddQuizAttemptIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddQuizAttemptIDs(ids...)
+	return uu
+}
+
+// AddQuizAttempts adds the "quiz_attempts" edges to the QuizAttempt entity.
+func (uu *UserUpdate) AddQuizAttempts(q ...*QuizAttempt) *UserUpdate {
+	ids := make([]int, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return uu.AddQuizAttemptIDs(ids...)
+}
+
+// AddGroupMembershipIDs adds the "group_memberships" edge to the GroupMembership entity by IDs.
+func (uu *UserUpdate) AddGroupMembershipIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddGroupMembershipIDs(ids...)
+	return uu
+}
+
+// AddGroupMemberships adds the "group_memberships" edges to the GroupMembership entity.
+func (uu *UserUpdate) AddGroupMemberships(g ...*GroupMembership) *UserUpdate {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return uu.AddGroupMembershipIDs(ids...)
+}
+
+// AddGroupJoinRequestIDs adds the "group_join_requests" edge to the GroupJoinRequest entity by IDs.
+func (uu *UserUpdate) AddGroupJoinRequestIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddGroupJoinRequestIDs(ids...)
+	return uu
+}
+
+// AddGroupJoinRequests adds the "group_join_requests" edges to the GroupJoinRequest entity.
+func (uu *UserUpdate) AddGroupJoinRequests(g ...*GroupJoinRequest) *UserUpdate {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return uu.AddGroupJoinRequestIDs(ids...)
+}
+
+// AddGroupInviteIDs adds the "group_invites" edge to the GroupInvite entity by IDs.
+func (uu *UserUpdate) AddGroupInviteIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddGroupInviteIDs(ids...)
+	return uu
+}
+
+// AddGroupInvites adds the "group_invites" edges to the GroupInvite entity.
+func (uu *UserUpdate) AddGroupInvites(g ...*GroupInvite) *UserUpdate {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return uu.AddGroupInviteIDs(ids...)
+}
+
+// AddStudyEventIDs adds the "study_events" edge to the StudyEvent entity by IDs.
+func (uu *UserUpdate) AddStudyEventIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddStudyEventIDs(ids...)
+	return uu
+}
+
+// AddStudyEvents adds the "study_events" edges to the StudyEvent entity.
+func (uu *UserUpdate) AddStudyEvents(s ...*StudyEvent) *UserUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uu.AddStudyEventIDs(ids...)
+}
+
+// AddDocumentIDs adds the "documents" edge to the Document entity by IDs.
+func (uu *UserUpdate) AddDocumentIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddDocumentIDs(ids...)
+	return uu
+}
+
+// AddDocuments adds the "documents" edges to the Document entity.
+func (uu *UserUpdate) AddDocuments(d ...*Document) *UserUpdate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return uu.AddDocumentIDs(ids...)
+}
+
+// AddFolderIDs adds the "folders" edge to the Folder entity by IDs.
+func (uu *UserUpdate) AddFolderIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddFolderIDs(ids...)
+	return uu
+}
+
+// AddFolders adds the "folders" edges to the Folder entity.
+func (uu *UserUpdate) AddFolders(f ...*Folder) *UserUpdate {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uu.AddFolderIDs(ids...)
+}
+
+// AddBlobReferenceIDs adds the "blob_references" edge to the BlobReference entity by IDs.
+func (uu *UserUpdate) AddBlobReferenceIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddBlobReferenceIDs(ids...)
+	return uu
+}
+
+// AddBlobReferences adds the "blob_references" edges to the BlobReference entity.
+func (uu *UserUpdate) AddBlobReferences(b ...*BlobReference) *UserUpdate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return uu.AddBlobReferenceIDs(ids...)
+}
+
+// AddResumableUploadIDs adds the "resumable_uploads" edge to the ResumableUpload entity by IDs.
+func (uu *UserUpdate) AddResumableUploadIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddResumableUploadIDs(ids...)
+	return uu
+}
+
+// AddResumableUploads adds the "resumable_uploads" edges to the ResumableUpload entity.
+func (uu *UserUpdate) AddResumableUploads(r ...*ResumableUpload) *UserUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uu.AddResumableUploadIDs(ids...)
+}
+
+// AddNoteProposalIDs adds the "note_proposals" edge to the NoteProposal entity by IDs.
+func (uu *UserUpdate) AddNoteProposalIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddNoteProposalIDs(ids...)
+	return uu
+}
+
+// AddNoteProposals adds the "note_proposals" edges to the NoteProposal entity.
+func (uu *UserUpdate) AddNoteProposals(n ...*NoteProposal) *UserUpdate {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return uu.AddNoteProposalIDs(ids...)
+}
+
+// AddNoteRevisionIDs adds the "note_revisions" edge to the NoteRevision entity by IDs.
+func (uu *UserUpdate) AddNoteRevisionIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddNoteRevisionIDs(ids...)
+	return uu
+}
+
+// AddNoteRevisions adds the "note_revisions" edges to the NoteRevision entity.
+func (uu *UserUpdate) AddNoteRevisions(n ...*NoteRevision) *UserUpdate {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return uu.AddNoteRevisionIDs(ids...)
+}
+
+// AddNotebookIDs adds the "notebooks" edge to the Notebook entity by IDs.
+func (uu *UserUpdate) AddNotebookIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddNotebookIDs(ids...)
+	return uu
+}
+
+// AddNotebooks adds the "notebooks" edges to the Notebook entity.
+func (uu *UserUpdate) AddNotebooks(n ...*Notebook) *UserUpdate {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return uu.AddNotebookIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (uu *UserUpdate) AddTagIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddTagIDs(ids...)
+	return uu
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (uu *UserUpdate) AddTags(t ...*Tag) *UserUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return uu.AddTagIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uu *UserUpdate) Mutation() *UserMutation {
 	return uu.mutation
@@ -439,6 +711,300 @@ func (uu *UserUpdate) RemoveNoteReposts(n ...*NoteRepost) *UserUpdate {
 	return uu.RemoveNoteRepostIDs(ids...)
 }
 
+// ClearQuizzes clears all "quizzes" edges to the Quiz entity.
+func (uu *UserUpdate) ClearQuizzes() *UserUpdate {
+	uu.mutation.ClearQuizzes()
+	return uu
+}
+
+// RemoveQuizIDs removes the "quizzes" edge to Quiz entities by IDs.
+func (uu *UserUpdate) RemoveQuizIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveQuizIDs(ids...)
+	return uu
+}
+
+// RemoveQuizzes removes "quizzes" edges to Quiz entities.
+func (uu *UserUpdate) RemoveQuizzes(q ...*Quiz) *UserUpdate {
+	ids := make([]int, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return uu.RemoveQuizIDs(ids...)
+}
+
+// ClearQuizAttempts clears all "quiz_attempts" edges to the QuizAttempt entity.
+func (uu *UserUpdate) ClearQuizAttempts() *UserUpdate {
+	uu.mutation.ClearQuizAttempts()
+	return uu
+}
+
+// RemoveQuizAttemptIDs removes the "quiz_attempts" edge to QuizAttempt entities by IDs.
+func (uu *UserUpdate) RemoveQuizAttemptIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveQuizAttemptIDs(ids...)
+	return uu
+}
+
+// RemoveQuizAttempts removes "quiz_attempts" edges to QuizAttempt entities.
+func (uu *UserUpdate) RemoveQuizAttempts(q ...*QuizAttempt) *UserUpdate {
+	ids := make([]int, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return uu.RemoveQuizAttemptIDs(ids...)
+}
+
+// ClearGroupMemberships clears all "group_memberships" edges to the GroupMembership entity.
+func (uu *UserUpdate) ClearGroupMemberships() *UserUpdate {
+	uu.mutation.ClearGroupMemberships()
+	return uu
+}
+
+// RemoveGroupMembershipIDs removes the "group_memberships" edge to GroupMembership entities by IDs.
+func (uu *UserUpdate) RemoveGroupMembershipIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveGroupMembershipIDs(ids...)
+	return uu
+}
+
+// RemoveGroupMemberships removes "group_memberships" edges to GroupMembership entities.
+func (uu *UserUpdate) RemoveGroupMemberships(g ...*GroupMembership) *UserUpdate {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return uu.RemoveGroupMembershipIDs(ids...)
+}
+
+// ClearGroupJoinRequests clears all "group_join_requests" edges to the GroupJoinRequest entity.
+func (uu *UserUpdate) ClearGroupJoinRequests() *UserUpdate {
+	uu.mutation.ClearGroupJoinRequests()
+	return uu
+}
+
+// RemoveGroupJoinRequestIDs removes the "group_join_requests" edge to GroupJoinRequest entities by IDs.
+func (uu *UserUpdate) RemoveGroupJoinRequestIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveGroupJoinRequestIDs(ids...)
+	return uu
+}
+
+// RemoveGroupJoinRequests removes "group_join_requests" edges to GroupJoinRequest entities.
+func (uu *UserUpdate) RemoveGroupJoinRequests(g ...*GroupJoinRequest) *UserUpdate {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return uu.RemoveGroupJoinRequestIDs(ids...)
+}
+
+// ClearGroupInvites clears all "group_invites" edges to the GroupInvite entity.
+func (uu *UserUpdate) ClearGroupInvites() *UserUpdate {
+	uu.mutation.ClearGroupInvites()
+	return uu
+}
+
+// RemoveGroupInviteIDs removes the "group_invites" edge to GroupInvite entities by IDs.
+func (uu *UserUpdate) RemoveGroupInviteIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveGroupInviteIDs(ids...)
+	return uu
+}
+
+// RemoveGroupInvites removes "group_invites" edges to GroupInvite entities.
+func (uu *UserUpdate) RemoveGroupInvites(g ...*GroupInvite) *UserUpdate {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return uu.RemoveGroupInviteIDs(ids...)
+}
+
+// ClearStudyEvents clears all "study_events" edges to the StudyEvent entity.
+func (uu *UserUpdate) ClearStudyEvents() *UserUpdate {
+	uu.mutation.ClearStudyEvents()
+	return uu
+}
+
+// RemoveStudyEventIDs removes the "study_events" edge to StudyEvent entities by IDs.
+func (uu *UserUpdate) RemoveStudyEventIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveStudyEventIDs(ids...)
+	return uu
+}
+
+// RemoveStudyEvents removes "study_events" edges to StudyEvent entities.
+func (uu *UserUpdate) RemoveStudyEvents(s ...*StudyEvent) *UserUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uu.RemoveStudyEventIDs(ids...)
+}
+
+// ClearDocuments clears all "documents" edges to the Document entity.
+func (uu *UserUpdate) ClearDocuments() *UserUpdate {
+	uu.mutation.ClearDocuments()
+	return uu
+}
+
+// RemoveDocumentIDs removes the "documents" edge to Document entities by IDs.
+func (uu *UserUpdate) RemoveDocumentIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveDocumentIDs(ids...)
+	return uu
+}
+
+// RemoveDocuments removes "documents" edges to Document entities.
+func (uu *UserUpdate) RemoveDocuments(d ...*Document) *UserUpdate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return uu.RemoveDocumentIDs(ids...)
+}
+
+// ClearFolders clears all "folders" edges to the Folder entity.
+func (uu *UserUpdate) ClearFolders() *UserUpdate {
+	uu.mutation.ClearFolders()
+	return uu
+}
+
+// RemoveFolderIDs removes the "folders" edge to Folder entities by IDs.
+func (uu *UserUpdate) RemoveFolderIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveFolderIDs(ids...)
+	return uu
+}
+
+// RemoveFolders removes "folders" edges to Folder entities.
+func (uu *UserUpdate) RemoveFolders(f ...*Folder) *UserUpdate {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uu.RemoveFolderIDs(ids...)
+}
+
+// ClearBlobReferences clears all "blob_references" edges to the BlobReference entity.
+func (uu *UserUpdate) ClearBlobReferences() *UserUpdate {
+	uu.mutation.ClearBlobReferences()
+	return uu
+}
+
+// RemoveBlobReferenceIDs removes the "blob_references" edge to BlobReference entities by IDs.
+func (uu *UserUpdate) RemoveBlobReferenceIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveBlobReferenceIDs(ids...)
+	return uu
+}
+
+// RemoveBlobReferences removes "blob_references" edges to BlobReference entities.
+func (uu *UserUpdate) RemoveBlobReferences(b ...*BlobReference) *UserUpdate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return uu.RemoveBlobReferenceIDs(ids...)
+}
+
+// ClearResumableUploads clears all "resumable_uploads" edges to the ResumableUpload entity.
+func (uu *UserUpdate) ClearResumableUploads() *UserUpdate {
+	uu.mutation.ClearResumableUploads()
+	return uu
+}
+
+// RemoveResumableUploadIDs removes the "resumable_uploads" edge to ResumableUpload entities by IDs.
+func (uu *UserUpdate) RemoveResumableUploadIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveResumableUploadIDs(ids...)
+	return uu
+}
+
+// RemoveResumableUploads removes "resumable_uploads" edges to ResumableUpload entities.
+func (uu *UserUpdate) RemoveResumableUploads(r ...*ResumableUpload) *UserUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uu.RemoveResumableUploadIDs(ids...)
+}
+
+// ClearNoteProposals clears all "note_proposals" edges to the NoteProposal entity.
+func (uu *UserUpdate) ClearNoteProposals() *UserUpdate {
+	uu.mutation.ClearNoteProposals()
+	return uu
+}
+
+// RemoveNoteProposalIDs removes the "note_proposals" edge to NoteProposal entities by IDs.
+func (uu *UserUpdate) RemoveNoteProposalIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveNoteProposalIDs(ids...)
+	return uu
+}
+
+// RemoveNoteProposals removes "note_proposals" edges to NoteProposal entities.
+func (uu *UserUpdate) RemoveNoteProposals(n ...*NoteProposal) *UserUpdate {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return uu.RemoveNoteProposalIDs(ids...)
+}
+
+// ClearNoteRevisions clears all "note_revisions" edges to the NoteRevision entity.
+func (uu *UserUpdate) ClearNoteRevisions() *UserUpdate {
+	uu.mutation.ClearNoteRevisions()
+	return uu
+}
+
+// RemoveNoteRevisionIDs removes the "note_revisions" edge to NoteRevision entities by IDs.
+func (uu *UserUpdate) RemoveNoteRevisionIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveNoteRevisionIDs(ids...)
+	return uu
+}
+
+// RemoveNoteRevisions removes "note_revisions" edges to NoteRevision entities.
+func (uu *UserUpdate) RemoveNoteRevisions(n ...*NoteRevision) *UserUpdate {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return uu.RemoveNoteRevisionIDs(ids...)
+}
+
+// ClearNotebooks clears all "notebooks" edges to the Notebook entity.
+func (uu *UserUpdate) ClearNotebooks() *UserUpdate {
+	uu.mutation.ClearNotebooks()
+	return uu
+}
+
+// RemoveNotebookIDs removes the "notebooks" edge to Notebook entities by IDs.
+func (uu *UserUpdate) RemoveNotebookIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveNotebookIDs(ids...)
+	return uu
+}
+
+// RemoveNotebooks removes "notebooks" edges to Notebook entities.
+func (uu *UserUpdate) RemoveNotebooks(n ...*Notebook) *UserUpdate {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return uu.RemoveNotebookIDs(ids...)
+}
+
+// ClearTags clears all "tags" edges to the Tag entity.
+func (uu *UserUpdate) ClearTags() *UserUpdate {
+	uu.mutation.ClearTags()
+	return uu
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (uu *UserUpdate) RemoveTagIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveTagIDs(ids...)
+	return uu
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (uu *UserUpdate) RemoveTags(t ...*Tag) *UserUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return uu.RemoveTagIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (uu *UserUpdate) Save(ctx context.Context) (int, error) {
 	uu.defaults()
@@ -507,6 +1073,11 @@ func (uu *UserUpdate) check() error {
 			return &ValidationError{Name: "bio", err: fmt.Errorf(`ent: validator failed for field "User.bio": %w`, err)}
 		}
 	}
+	if v, ok := uu.mutation.StorageQuota(); ok {
+		if err := user.StorageQuotaValidator(v); err != nil {
+			return &ValidationError{Name: "storage_quota", err: fmt.Errorf(`ent: validator failed for field "User.storage_quota": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -585,10 +1156,25 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if uu.mutation.LastLoginCleared() {
 		_spec.ClearField(user.FieldLastLogin, field.TypeTime)
 	}
-	if value, ok := uu.mutation.UpdatedAt(); ok {
-		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
+	if value, ok := uu.mutation.StorageQuota(); ok {
+		_spec.SetField(user.FieldStorageQuota, field.TypeInt64, value)
 	}
-	if uu.mutation.UpdatedAtCleared() {
+	if value, ok := uu.mutation.AddedStorageQuota(); ok {
+		_spec.AddField(user.FieldStorageQuota, field.TypeInt64, value)
+	}
+	if uu.mutation.StorageQuotaCleared() {
+		_spec.ClearField(user.FieldStorageQuota, field.TypeInt64)
+	}
+	if value, ok := uu.mutation.StorageUsed(); ok {
+		_spec.SetField(user.FieldStorageUsed, field.TypeInt64, value)
+	}
+	if value, ok := uu.mutation.AddedStorageUsed(); ok {
+		_spec.AddField(user.FieldStorageUsed, field.TypeInt64, value)
+	}
+	if value, ok := uu.mutation.UpdatedAt(); ok {
+		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if uu.mutation.UpdatedAtCleared() {
 		_spec.ClearField(user.FieldUpdatedAt, field.TypeTime)
 	}
 	if uu.mutation.OwnerCleared() {
@@ -771,637 +1357,2469 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{user.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if uu.mutation.QuizzesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.QuizzesTable,
+			Columns: []string{user.QuizzesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quiz.FieldID, field.TypeInt),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	uu.mutation.done = true
-	return n, nil
-}
-
-// UserUpdateOne is the builder for updating a single User entity.
-type UserUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *UserMutation
-}
-
-// SetName sets the "name" field.
-func (uuo *UserUpdateOne) SetName(s string) *UserUpdateOne {
-	uuo.mutation.SetName(s)
-	return uuo
-}
-
-// SetNillableName sets the "name" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableName(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetName(*s)
+	if nodes := uu.mutation.RemovedQuizzesIDs(); len(nodes) > 0 && !uu.mutation.QuizzesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.QuizzesTable,
+			Columns: []string{user.QuizzesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quiz.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo
-}
-
-// SetPhoneNumber sets the "phone_number" field.
-func (uuo *UserUpdateOne) SetPhoneNumber(s string) *UserUpdateOne {
-	uuo.mutation.SetPhoneNumber(s)
-	return uuo
-}
-
-// SetNillablePhoneNumber sets the "phone_number" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillablePhoneNumber(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetPhoneNumber(*s)
+	if nodes := uu.mutation.QuizzesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.QuizzesTable,
+			Columns: []string{user.QuizzesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quiz.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return uuo
-}
-
-// SetEmail sets the "email" field.
-func (uuo *UserUpdateOne) SetEmail(s string) *UserUpdateOne {
-	uuo.mutation.SetEmail(s)
-	return uuo
-}
-
-// SetNillableEmail sets the "email" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableEmail(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetEmail(*s)
+	if uu.mutation.QuizAttemptsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.QuizAttemptsTable,
+			Columns: []string{user.QuizAttemptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quizattempt.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo
-}
-
-// ClearEmail clears the value of the "email" field.
-func (uuo *UserUpdateOne) ClearEmail() *UserUpdateOne {
-	uuo.mutation.ClearEmail()
-	return uuo
-}
-
-// SetPassword sets the "password" field.
-func (uuo *UserUpdateOne) SetPassword(s string) *UserUpdateOne {
-	uuo.mutation.SetPassword(s)
-	return uuo
-}
-
-// SetNillablePassword sets the "password" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillablePassword(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetPassword(*s)
+	if nodes := uu.mutation.RemovedQuizAttemptsIDs(); len(nodes) > 0 && !uu.mutation.QuizAttemptsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.QuizAttemptsTable,
+			Columns: []string{user.QuizAttemptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quizattempt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo
-}
-
-// ClearPassword clears the value of the "password" field.
-func (uuo *UserUpdateOne) ClearPassword() *UserUpdateOne {
-	uuo.mutation.ClearPassword()
-	return uuo
-}
-
-// SetVerified sets the "verified" field.
-func (uuo *UserUpdateOne) SetVerified(b bool) *UserUpdateOne {
-	uuo.mutation.SetVerified(b)
-	return uuo
-}
-
-// SetNillableVerified sets the "verified" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableVerified(b *bool) *UserUpdateOne {
-	if b != nil {
-		uuo.SetVerified(*b)
+	if nodes := uu.mutation.QuizAttemptsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.QuizAttemptsTable,
+			Columns: []string{user.QuizAttemptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quizattempt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return uuo
-}
-
-// SetVerificationCode sets the "verification_code" field.
-func (uuo *UserUpdateOne) SetVerificationCode(s string) *UserUpdateOne {
-	uuo.mutation.SetVerificationCode(s)
-	return uuo
-}
-
-// SetNillableVerificationCode sets the "verification_code" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableVerificationCode(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetVerificationCode(*s)
+	if uu.mutation.GroupMembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GroupMembershipsTable,
+			Columns: []string{user.GroupMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupmembership.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo
-}
-
-// ClearVerificationCode clears the value of the "verification_code" field.
-func (uuo *UserUpdateOne) ClearVerificationCode() *UserUpdateOne {
-	uuo.mutation.ClearVerificationCode()
-	return uuo
-}
-
-// SetAdmin sets the "admin" field.
-func (uuo *UserUpdateOne) SetAdmin(b bool) *UserUpdateOne {
-	uuo.mutation.SetAdmin(b)
-	return uuo
-}
-
-// SetNillableAdmin sets the "admin" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableAdmin(b *bool) *UserUpdateOne {
-	if b != nil {
-		uuo.SetAdmin(*b)
+	if nodes := uu.mutation.RemovedGroupMembershipsIDs(); len(nodes) > 0 && !uu.mutation.GroupMembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GroupMembershipsTable,
+			Columns: []string{user.GroupMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupmembership.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo
-}
-
-// SetRegistrationMethod sets the "registration_method" field.
-func (uuo *UserUpdateOne) SetRegistrationMethod(um user.RegistrationMethod) *UserUpdateOne {
-	uuo.mutation.SetRegistrationMethod(um)
-	return uuo
-}
-
-// SetNillableRegistrationMethod sets the "registration_method" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableRegistrationMethod(um *user.RegistrationMethod) *UserUpdateOne {
-	if um != nil {
-		uuo.SetRegistrationMethod(*um)
+	if nodes := uu.mutation.GroupMembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GroupMembershipsTable,
+			Columns: []string{user.GroupMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupmembership.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return uuo
-}
-
-// SetProfilePicture sets the "profile_picture" field.
-func (uuo *UserUpdateOne) SetProfilePicture(s string) *UserUpdateOne {
-	uuo.mutation.SetProfilePicture(s)
-	return uuo
-}
-
-// SetNillableProfilePicture sets the "profile_picture" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableProfilePicture(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetProfilePicture(*s)
+	if uu.mutation.GroupJoinRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GroupJoinRequestsTable,
+			Columns: []string{user.GroupJoinRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupjoinrequest.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo
+	if nodes := uu.mutation.RemovedGroupJoinRequestsIDs(); len(nodes) > 0 && !uu.mutation.GroupJoinRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GroupJoinRequestsTable,
+			Columns: []string{user.GroupJoinRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupjoinrequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.GroupJoinRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GroupJoinRequestsTable,
+			Columns: []string{user.GroupJoinRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupjoinrequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.GroupInvitesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GroupInvitesTable,
+			Columns: []string{user.GroupInvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupinvite.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedGroupInvitesIDs(); len(nodes) > 0 && !uu.mutation.GroupInvitesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GroupInvitesTable,
+			Columns: []string{user.GroupInvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupinvite.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.GroupInvitesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GroupInvitesTable,
+			Columns: []string{user.GroupInvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupinvite.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.StudyEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.StudyEventsTable,
+			Columns: []string{user.StudyEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(studyevent.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedStudyEventsIDs(); len(nodes) > 0 && !uu.mutation.StudyEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.StudyEventsTable,
+			Columns: []string{user.StudyEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(studyevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.StudyEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.StudyEventsTable,
+			Columns: []string{user.StudyEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(studyevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.DocumentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DocumentsTable,
+			Columns: []string{user.DocumentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(document.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedDocumentsIDs(); len(nodes) > 0 && !uu.mutation.DocumentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DocumentsTable,
+			Columns: []string{user.DocumentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(document.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.DocumentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DocumentsTable,
+			Columns: []string{user.DocumentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(document.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.FoldersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FoldersTable,
+			Columns: []string{user.FoldersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(folder.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedFoldersIDs(); len(nodes) > 0 && !uu.mutation.FoldersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FoldersTable,
+			Columns: []string{user.FoldersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(folder.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.FoldersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FoldersTable,
+			Columns: []string{user.FoldersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(folder.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.BlobReferencesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BlobReferencesTable,
+			Columns: []string{user.BlobReferencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blobreference.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedBlobReferencesIDs(); len(nodes) > 0 && !uu.mutation.BlobReferencesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BlobReferencesTable,
+			Columns: []string{user.BlobReferencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blobreference.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.BlobReferencesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BlobReferencesTable,
+			Columns: []string{user.BlobReferencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blobreference.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.ResumableUploadsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ResumableUploadsTable,
+			Columns: []string{user.ResumableUploadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resumableupload.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedResumableUploadsIDs(); len(nodes) > 0 && !uu.mutation.ResumableUploadsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ResumableUploadsTable,
+			Columns: []string{user.ResumableUploadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resumableupload.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.ResumableUploadsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ResumableUploadsTable,
+			Columns: []string{user.ResumableUploadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resumableupload.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.NoteProposalsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NoteProposalsTable,
+			Columns: []string{user.NoteProposalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(noteproposal.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedNoteProposalsIDs(); len(nodes) > 0 && !uu.mutation.NoteProposalsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NoteProposalsTable,
+			Columns: []string{user.NoteProposalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(noteproposal.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.NoteProposalsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NoteProposalsTable,
+			Columns: []string{user.NoteProposalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(noteproposal.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.NoteRevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NoteRevisionsTable,
+			Columns: []string{user.NoteRevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(noterevision.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedNoteRevisionsIDs(); len(nodes) > 0 && !uu.mutation.NoteRevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NoteRevisionsTable,
+			Columns: []string{user.NoteRevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(noterevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.NoteRevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NoteRevisionsTable,
+			Columns: []string{user.NoteRevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(noterevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.NotebooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotebooksTable,
+			Columns: []string{user.NotebooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notebook.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedNotebooksIDs(); len(nodes) > 0 && !uu.mutation.NotebooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotebooksTable,
+			Columns: []string{user.NotebooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notebook.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.NotebooksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotebooksTable,
+			Columns: []string{user.NotebooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notebook.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TagsTable,
+			Columns: []string{user.TagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedTagsIDs(); len(nodes) > 0 && !uu.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TagsTable,
+			Columns: []string{user.TagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TagsTable,
+			Columns: []string{user.TagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{user.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	uu.mutation.done = true
+	return n, nil
+}
+
+// UserUpdateOne is the builder for updating a single User entity.
+type UserUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UserMutation
+}
+
+// SetName sets the "name" field.
+func (uuo *UserUpdateOne) SetName(s string) *UserUpdateOne {
+	uuo.mutation.SetName(s)
+	return uuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableName(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetName(*s)
+	}
+	return uuo
+}
+
+// SetPhoneNumber sets the "phone_number" field.
+func (uuo *UserUpdateOne) SetPhoneNumber(s string) *UserUpdateOne {
+	uuo.mutation.SetPhoneNumber(s)
+	return uuo
+}
+
+// SetNillablePhoneNumber sets the "phone_number" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillablePhoneNumber(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetPhoneNumber(*s)
+	}
+	return uuo
+}
+
+// SetEmail sets the "email" field.
+func (uuo *UserUpdateOne) SetEmail(s string) *UserUpdateOne {
+	uuo.mutation.SetEmail(s)
+	return uuo
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableEmail(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetEmail(*s)
+	}
+	return uuo
+}
+
+// ClearEmail clears the value of the "email" field.
+func (uuo *UserUpdateOne) ClearEmail() *UserUpdateOne {
+	uuo.mutation.ClearEmail()
+	return uuo
+}
+
+// SetPassword sets the "password" field.
+func (uuo *UserUpdateOne) SetPassword(s string) *UserUpdateOne {
+	uuo.mutation.SetPassword(s)
+	return uuo
+}
+
+// SetNillablePassword sets the "password" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillablePassword(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetPassword(*s)
+	}
+	return uuo
+}
+
+// ClearPassword clears the value of the "password" field.
+func (uuo *UserUpdateOne) ClearPassword() *UserUpdateOne {
+	uuo.mutation.ClearPassword()
+	return uuo
+}
+
+// SetVerified sets the "verified" field.
+func (uuo *UserUpdateOne) SetVerified(b bool) *UserUpdateOne {
+	uuo.mutation.SetVerified(b)
+	return uuo
+}
+
+// SetNillableVerified sets the "verified" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableVerified(b *bool) *UserUpdateOne {
+	if b != nil {
+		uuo.SetVerified(*b)
+	}
+	return uuo
+}
+
+// SetVerificationCode sets the "verification_code" field.
+func (uuo *UserUpdateOne) SetVerificationCode(s string) *UserUpdateOne {
+	uuo.mutation.SetVerificationCode(s)
+	return uuo
+}
+
+// SetNillableVerificationCode sets the "verification_code" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableVerificationCode(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetVerificationCode(*s)
+	}
+	return uuo
+}
+
+// ClearVerificationCode clears the value of the "verification_code" field.
+func (uuo *UserUpdateOne) ClearVerificationCode() *UserUpdateOne {
+	uuo.mutation.ClearVerificationCode()
+	return uuo
+}
+
+// SetAdmin sets the "admin" field.
+func (uuo *UserUpdateOne) SetAdmin(b bool) *UserUpdateOne {
+	uuo.mutation.SetAdmin(b)
+	return uuo
+}
+
+// SetNillableAdmin sets the "admin" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableAdmin(b *bool) *UserUpdateOne {
+	if b != nil {
+		uuo.SetAdmin(*b)
+	}
+	return uuo
+}
+
+// SetRegistrationMethod sets the "registration_method" field.
+func (uuo *UserUpdateOne) SetRegistrationMethod(um user.RegistrationMethod) *UserUpdateOne {
+	uuo.mutation.SetRegistrationMethod(um)
+	return uuo
+}
+
+// SetNillableRegistrationMethod sets the "registration_method" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableRegistrationMethod(um *user.RegistrationMethod) *UserUpdateOne {
+	if um != nil {
+		uuo.SetRegistrationMethod(*um)
+	}
+	return uuo
+}
+
+// SetProfilePicture sets the "profile_picture" field.
+func (uuo *UserUpdateOne) SetProfilePicture(s string) *UserUpdateOne {
+	uuo.mutation.SetProfilePicture(s)
+	return uuo
+}
+
+// SetNillableProfilePicture sets the "profile_picture" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableProfilePicture(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetProfilePicture(*s)
+	}
+	return uuo
+}
+
+// ClearProfilePicture clears the value of the "profile_picture" field.
+func (uuo *UserUpdateOne) ClearProfilePicture() *UserUpdateOne {
+	uuo.mutation.ClearProfilePicture()
+	return uuo
+}
+
+// SetDarkMode sets the "dark_mode" field.
+func (uuo *UserUpdateOne) SetDarkMode(b bool) *UserUpdateOne {
+	uuo.mutation.SetDarkMode(b)
+	return uuo
+}
+
+// SetNillableDarkMode sets the "dark_mode" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableDarkMode(b *bool) *UserUpdateOne {
+	if b != nil {
+		uuo.SetDarkMode(*b)
+	}
+	return uuo
+}
+
+// SetBio sets the "bio" field.
+func (uuo *UserUpdateOne) SetBio(s string) *UserUpdateOne {
+	uuo.mutation.SetBio(s)
+	return uuo
+}
+
+// SetNillableBio sets the "bio" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableBio(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetBio(*s)
+	}
+	return uuo
+}
+
+// ClearBio clears the value of the "bio" field.
+func (uuo *UserUpdateOne) ClearBio() *UserUpdateOne {
+	uuo.mutation.ClearBio()
+	return uuo
+}
+
+// SetEmailNotifications sets the "email_notifications" field.
+func (uuo *UserUpdateOne) SetEmailNotifications(b bool) *UserUpdateOne {
+	uuo.mutation.SetEmailNotifications(b)
+	return uuo
+}
+
+// SetNillableEmailNotifications sets the "email_notifications" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableEmailNotifications(b *bool) *UserUpdateOne {
+	if b != nil {
+		uuo.SetEmailNotifications(*b)
+	}
+	return uuo
+}
+
+// SetSmsNotifications sets the "sms_notifications" field.
+func (uuo *UserUpdateOne) SetSmsNotifications(b bool) *UserUpdateOne {
+	uuo.mutation.SetSmsNotifications(b)
+	return uuo
+}
+
+// SetNillableSmsNotifications sets the "sms_notifications" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableSmsNotifications(b *bool) *UserUpdateOne {
+	if b != nil {
+		uuo.SetSmsNotifications(*b)
+	}
+	return uuo
+}
+
+// SetIsActive sets the "is_active" field.
+func (uuo *UserUpdateOne) SetIsActive(b bool) *UserUpdateOne {
+	uuo.mutation.SetIsActive(b)
+	return uuo
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableIsActive(b *bool) *UserUpdateOne {
+	if b != nil {
+		uuo.SetIsActive(*b)
+	}
+	return uuo
+}
+
+// SetLastLogin sets the "last_login" field.
+func (uuo *UserUpdateOne) SetLastLogin(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetLastLogin(t)
+	return uuo
+}
+
+// SetNillableLastLogin sets the "last_login" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableLastLogin(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetLastLogin(*t)
+	}
+	return uuo
+}
+
+// ClearLastLogin clears the value of the "last_login" field.
+func (uuo *UserUpdateOne) ClearLastLogin() *UserUpdateOne {
+	uuo.mutation.ClearLastLogin()
+	return uuo
+}
+
+// SetStorageQuota sets the "storage_quota" field.
+func (uuo *UserUpdateOne) SetStorageQuota(i int64) *UserUpdateOne {
+	uuo.mutation.ResetStorageQuota()
+	uuo.mutation.SetStorageQuota(i)
+	return uuo
+}
+
+// SetNillableStorageQuota sets the "storage_quota" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableStorageQuota(i *int64) *UserUpdateOne {
+	if i != nil {
+		uuo.SetStorageQuota(*i)
+	}
+	return uuo
+}
+
+// AddStorageQuota adds i to the "storage_quota" field.
+func (uuo *UserUpdateOne) AddStorageQuota(i int64) *UserUpdateOne {
+	uuo.mutation.AddStorageQuota(i)
+	return uuo
+}
+
+// ClearStorageQuota clears the value of the "storage_quota" field.
+func (uuo *UserUpdateOne) ClearStorageQuota() *UserUpdateOne {
+	uuo.mutation.ClearStorageQuota()
+	return uuo
+}
+
+// SetStorageUsed sets the "storage_used" field.
+func (uuo *UserUpdateOne) SetStorageUsed(i int64) *UserUpdateOne {
+	uuo.mutation.ResetStorageUsed()
+	uuo.mutation.SetStorageUsed(i)
+	return uuo
+}
+
+// SetNillableStorageUsed sets the "storage_used" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableStorageUsed(i *int64) *UserUpdateOne {
+	if i != nil {
+		uuo.SetStorageUsed(*i)
+	}
+	return uuo
+}
+
+// AddStorageUsed adds i to the "storage_used" field.
+func (uuo *UserUpdateOne) AddStorageUsed(i int64) *UserUpdateOne {
+	uuo.mutation.AddStorageUsed(i)
+	return uuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (uuo *UserUpdateOne) SetUpdatedAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetUpdatedAt(t)
+	return uuo
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (uuo *UserUpdateOne) ClearUpdatedAt() *UserUpdateOne {
+	uuo.mutation.ClearUpdatedAt()
+	return uuo
+}
+
+// AddOwnerIDs adds the "owner" edge to the PasswordToken entity by IDs.
+func (uuo *UserUpdateOne) AddOwnerIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddOwnerIDs(ids...)
+	return uuo
+}
+
+// AddOwner adds the "owner" edges to the PasswordToken entity.
+func (uuo *UserUpdateOne) AddOwner(p ...*PasswordToken) *UserUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uuo.AddOwnerIDs(ids...)
+}
+
+// AddNoteIDs adds the "notes" edge to the Note entity by IDs.
+func (uuo *UserUpdateOne) AddNoteIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddNoteIDs(ids...)
+	return uuo
+}
+
+// AddNotes adds the "notes" edges to the Note entity.
+func (uuo *UserUpdateOne) AddNotes(n ...*Note) *UserUpdateOne {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return uuo.AddNoteIDs(ids...)
+}
+
+// AddNoteLikeIDs adds the "note_likes" edge to the NoteLike entity by IDs.
+func (uuo *UserUpdateOne) AddNoteLikeIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddNoteLikeIDs(ids...)
+	return uuo
+}
+
+// AddNoteLikes adds the "note_likes" edges to the NoteLike entity.
+func (uuo *UserUpdateOne) AddNoteLikes(n ...*NoteLike) *UserUpdateOne {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return uuo.AddNoteLikeIDs(ids...)
+}
+
+// AddNoteRepostIDs adds the "note_reposts" edge to the NoteRepost entity by IDs.
+func (uuo *UserUpdateOne) AddNoteRepostIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddNoteRepostIDs(ids...)
+	return uuo
+}
+
+// AddNoteReposts adds the "note_reposts" edges to the NoteRepost entity.
+func (uuo *UserUpdateOne) AddNoteReposts(n ...*NoteRepost) *UserUpdateOne {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return uuo.AddNoteRepostIDs(ids...)
+}
+
+// AddQuizIDs adds the "quizzes" edge to the Quiz entity by IDs.
+func (uuo *UserUpdateOne) AddQuizIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddQuizIDs(ids...)
+	return uuo
+}
+
+// AddQuizzes adds the "quizzes" edges to the Quiz entity.
+func (uuo *UserUpdateOne) AddQuizzes(q ...*Quiz) *UserUpdateOne {
+	ids := make([]int, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return uuo.AddQuizIDs(ids...)
+}
+
+// AddQuizAttemptIDs adds the "quiz_attempts" edge to the QuizAttempt entity by IDs.
+func (uuo *UserUpdateOne) AddQuizAttemptIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddQuizAttemptIDs(ids...)
+	return uuo
+}
+
+// AddQuizAttempts adds the "quiz_attempts" edges to the QuizAttempt entity.
+func (uuo *UserUpdateOne) AddQuizAttempts(q ...*QuizAttempt) *UserUpdateOne {
+	ids := make([]int, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return uuo.AddQuizAttemptIDs(ids...)
+}
+
+// AddGroupMembershipIDs adds the "group_memberships" edge to the GroupMembership entity by IDs.
+func (uuo *UserUpdateOne) AddGroupMembershipIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddGroupMembershipIDs(ids...)
+	return uuo
+}
+
+// AddGroupMemberships adds the "group_memberships" edges to the GroupMembership entity.
+func (uuo *UserUpdateOne) AddGroupMemberships(g ...*GroupMembership) *UserUpdateOne {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return uuo.AddGroupMembershipIDs(ids...)
+}
+
+// AddGroupJoinRequestIDs adds the "group_join_requests" edge to the GroupJoinRequest entity by IDs.
+func (uuo *UserUpdateOne) AddGroupJoinRequestIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddGroupJoinRequestIDs(ids...)
+	return uuo
+}
+
+// AddGroupJoinRequests adds the "group_join_requests" edges to the GroupJoinRequest entity.
+func (uuo *UserUpdateOne) AddGroupJoinRequests(g ...*GroupJoinRequest) *UserUpdateOne {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return uuo.AddGroupJoinRequestIDs(ids...)
+}
+
+// AddGroupInviteIDs adds the "group_invites" edge to the GroupInvite entity by IDs.
+func (uuo *UserUpdateOne) AddGroupInviteIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddGroupInviteIDs(ids...)
+	return uuo
+}
+
+// AddGroupInvites adds the "group_invites" edges to the GroupInvite entity.
+func (uuo *UserUpdateOne) AddGroupInvites(g ...*GroupInvite) *UserUpdateOne {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return uuo.AddGroupInviteIDs(ids...)
+}
+
+// AddStudyEventIDs adds the "study_events" edge to the StudyEvent entity by IDs.
+func (uuo *UserUpdateOne) AddStudyEventIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddStudyEventIDs(ids...)
+	return uuo
+}
+
+// AddStudyEvents adds the "study_events" edges to the StudyEvent entity.
+func (uuo *UserUpdateOne) AddStudyEvents(s ...*StudyEvent) *UserUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uuo.AddStudyEventIDs(ids...)
+}
+
+// AddDocumentIDs adds the "documents" edge to the Document entity by IDs.
+func (uuo *UserUpdateOne) AddDocumentIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddDocumentIDs(ids...)
+	return uuo
+}
+
+// AddDocuments adds the "documents" edges to the Document entity.
+func (uuo *UserUpdateOne) AddDocuments(d ...*Document) *UserUpdateOne {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return uuo.AddDocumentIDs(ids...)
+}
+
+// AddFolderIDs adds the "folders" edge to the Folder entity by IDs.
+func (uuo *UserUpdateOne) AddFolderIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddFolderIDs(ids...)
+	return uuo
+}
+
+// AddFolders adds the "folders" edges to the Folder entity.
+func (uuo *UserUpdateOne) AddFolders(f ...*Folder) *UserUpdateOne {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uuo.AddFolderIDs(ids...)
+}
+
+// AddBlobReferenceIDs adds the "blob_references" edge to the BlobReference entity by IDs.
+func (uuo *UserUpdateOne) AddBlobReferenceIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddBlobReferenceIDs(ids...)
+	return uuo
+}
+
+// AddBlobReferences adds the "blob_references" edges to the BlobReference entity.
+func (uuo *UserUpdateOne) AddBlobReferences(b ...*BlobReference) *UserUpdateOne {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return uuo.AddBlobReferenceIDs(ids...)
+}
+
+// AddResumableUploadIDs adds the "resumable_uploads" edge to the ResumableUpload entity by IDs.
+func (uuo *UserUpdateOne) AddResumableUploadIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddResumableUploadIDs(ids...)
+	return uuo
+}
+
+// AddResumableUploads adds the "resumable_uploads" edges to the ResumableUpload entity.
+func (uuo *UserUpdateOne) AddResumableUploads(r ...*ResumableUpload) *UserUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uuo.AddResumableUploadIDs(ids...)
+}
+
+// AddNoteProposalIDs adds the "note_proposals" edge to the NoteProposal entity by IDs.
+func (uuo *UserUpdateOne) AddNoteProposalIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddNoteProposalIDs(ids...)
+	return uuo
+}
+
+// AddNoteProposals adds the "note_proposals" edges to the NoteProposal entity.
+func (uuo *UserUpdateOne) AddNoteProposals(n ...*NoteProposal) *UserUpdateOne {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return uuo.AddNoteProposalIDs(ids...)
+}
+
+// AddNoteRevisionIDs adds the "note_revisions" edge to the NoteRevision entity by IDs.
+func (uuo *UserUpdateOne) AddNoteRevisionIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddNoteRevisionIDs(ids...)
+	return uuo
+}
+
+// AddNoteRevisions adds the "note_revisions" edges to the NoteRevision entity.
+func (uuo *UserUpdateOne) AddNoteRevisions(n ...*NoteRevision) *UserUpdateOne {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return uuo.AddNoteRevisionIDs(ids...)
+}
+
+// AddNotebookIDs adds the "notebooks" edge to the Notebook entity by IDs.
+func (uuo *UserUpdateOne) AddNotebookIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddNotebookIDs(ids...)
+	return uuo
+}
+
+// AddNotebooks adds the "notebooks" edges to the Notebook entity.
+func (uuo *UserUpdateOne) AddNotebooks(n ...*Notebook) *UserUpdateOne {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return uuo.AddNotebookIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (uuo *UserUpdateOne) AddTagIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddTagIDs(ids...)
+	return uuo
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (uuo *UserUpdateOne) AddTags(t ...*Tag) *UserUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return uuo.AddTagIDs(ids...)
+}
+
+// Mutation returns the UserMutation object of the builder.
+func (uuo *UserUpdateOne) Mutation() *UserMutation {
+	return uuo.mutation
+}
+
+// ClearOwner clears all "owner" edges to the PasswordToken entity.
+func (uuo *UserUpdateOne) ClearOwner() *UserUpdateOne {
+	uuo.mutation.ClearOwner()
+	return uuo
+}
+
+// RemoveOwnerIDs removes the "owner" edge to PasswordToken entities by IDs.
+func (uuo *UserUpdateOne) RemoveOwnerIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveOwnerIDs(ids...)
+	return uuo
+}
+
+// RemoveOwner removes "owner" edges to PasswordToken entities.
+func (uuo *UserUpdateOne) RemoveOwner(p ...*PasswordToken) *UserUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uuo.RemoveOwnerIDs(ids...)
+}
+
+// ClearNotes clears all "notes" edges to the Note entity.
+func (uuo *UserUpdateOne) ClearNotes() *UserUpdateOne {
+	uuo.mutation.ClearNotes()
+	return uuo
+}
+
+// RemoveNoteIDs removes the "notes" edge to Note entities by IDs.
+func (uuo *UserUpdateOne) RemoveNoteIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveNoteIDs(ids...)
+	return uuo
+}
+
+// RemoveNotes removes "notes" edges to Note entities.
+func (uuo *UserUpdateOne) RemoveNotes(n ...*Note) *UserUpdateOne {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return uuo.RemoveNoteIDs(ids...)
+}
+
+// ClearNoteLikes clears all "note_likes" edges to the NoteLike entity.
+func (uuo *UserUpdateOne) ClearNoteLikes() *UserUpdateOne {
+	uuo.mutation.ClearNoteLikes()
+	return uuo
+}
+
+// RemoveNoteLikeIDs removes the "note_likes" edge to NoteLike entities by IDs.
+func (uuo *UserUpdateOne) RemoveNoteLikeIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveNoteLikeIDs(ids...)
+	return uuo
 }
 
-// ClearProfilePicture clears the value of the "profile_picture" field.
-func (uuo *UserUpdateOne) ClearProfilePicture() *UserUpdateOne {
-	uuo.mutation.ClearProfilePicture()
+// RemoveNoteLikes removes "note_likes" edges to NoteLike entities.
+func (uuo *UserUpdateOne) RemoveNoteLikes(n ...*NoteLike) *UserUpdateOne {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return uuo.RemoveNoteLikeIDs(ids...)
+}
+
+// ClearNoteReposts clears all "note_reposts" edges to the NoteRepost entity.
+func (uuo *UserUpdateOne) ClearNoteReposts() *UserUpdateOne {
+	uuo.mutation.ClearNoteReposts()
 	return uuo
 }
 
-// SetDarkMode sets the "dark_mode" field.
-func (uuo *UserUpdateOne) SetDarkMode(b bool) *UserUpdateOne {
-	uuo.mutation.SetDarkMode(b)
+// RemoveNoteRepostIDs removes the "note_reposts" edge to NoteRepost entities by IDs.
+func (uuo *UserUpdateOne) RemoveNoteRepostIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveNoteRepostIDs(ids...)
 	return uuo
 }
 
-// SetNillableDarkMode sets the "dark_mode" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableDarkMode(b *bool) *UserUpdateOne {
-	if b != nil {
-		uuo.SetDarkMode(*b)
+// RemoveNoteReposts removes "note_reposts" edges to NoteRepost entities.
+func (uuo *UserUpdateOne) RemoveNoteReposts(n ...*NoteRepost) *UserUpdateOne {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return uuo.RemoveNoteRepostIDs(ids...)
+}
+
+// ClearQuizzes clears all "quizzes" edges to the Quiz entity.
+func (uuo *UserUpdateOne) ClearQuizzes() *UserUpdateOne {
+	uuo.mutation.ClearQuizzes()
+	return uuo
+}
+
+// RemoveQuizIDs removes the "quizzes" edge to Quiz entities by IDs.
+func (uuo *UserUpdateOne) RemoveQuizIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveQuizIDs(ids...)
+	return uuo
+}
+
+// RemoveQuizzes removes "quizzes" edges to Quiz entities.
+func (uuo *UserUpdateOne) RemoveQuizzes(q ...*Quiz) *UserUpdateOne {
+	ids := make([]int, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return uuo.RemoveQuizIDs(ids...)
+}
+
+// ClearQuizAttempts clears all "quiz_attempts" edges to the QuizAttempt entity.
+func (uuo *UserUpdateOne) ClearQuizAttempts() *UserUpdateOne {
+	uuo.mutation.ClearQuizAttempts()
+	return uuo
+}
+
+// RemoveQuizAttemptIDs removes the "quiz_attempts" edge to QuizAttempt entities by IDs.
+func (uuo *UserUpdateOne) RemoveQuizAttemptIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveQuizAttemptIDs(ids...)
+	return uuo
+}
+
+// RemoveQuizAttempts removes "quiz_attempts" edges to QuizAttempt entities.
+func (uuo *UserUpdateOne) RemoveQuizAttempts(q ...*QuizAttempt) *UserUpdateOne {
+	ids := make([]int, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return uuo.RemoveQuizAttemptIDs(ids...)
+}
+
+// ClearGroupMemberships clears all "group_memberships" edges to the GroupMembership entity.
+func (uuo *UserUpdateOne) ClearGroupMemberships() *UserUpdateOne {
+	uuo.mutation.ClearGroupMemberships()
+	return uuo
+}
+
+// RemoveGroupMembershipIDs removes the "group_memberships" edge to GroupMembership entities by IDs.
+func (uuo *UserUpdateOne) RemoveGroupMembershipIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveGroupMembershipIDs(ids...)
+	return uuo
+}
+
+// RemoveGroupMemberships removes "group_memberships" edges to GroupMembership entities.
+func (uuo *UserUpdateOne) RemoveGroupMemberships(g ...*GroupMembership) *UserUpdateOne {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return uuo.RemoveGroupMembershipIDs(ids...)
+}
+
+// ClearGroupJoinRequests clears all "group_join_requests" edges to the GroupJoinRequest entity.
+func (uuo *UserUpdateOne) ClearGroupJoinRequests() *UserUpdateOne {
+	uuo.mutation.ClearGroupJoinRequests()
+	return uuo
+}
+
+// RemoveGroupJoinRequestIDs removes the "group_join_requests" edge to GroupJoinRequest entities by IDs.
+func (uuo *UserUpdateOne) RemoveGroupJoinRequestIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveGroupJoinRequestIDs(ids...)
+	return uuo
+}
+
+// RemoveGroupJoinRequests removes "group_join_requests" edges to GroupJoinRequest entities.
+func (uuo *UserUpdateOne) RemoveGroupJoinRequests(g ...*GroupJoinRequest) *UserUpdateOne {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return uuo.RemoveGroupJoinRequestIDs(ids...)
+}
+
+// ClearGroupInvites clears all "group_invites" edges to the GroupInvite entity.
+func (uuo *UserUpdateOne) ClearGroupInvites() *UserUpdateOne {
+	uuo.mutation.ClearGroupInvites()
+	return uuo
+}
+
+// RemoveGroupInviteIDs removes the "group_invites" edge to GroupInvite entities by IDs.
+func (uuo *UserUpdateOne) RemoveGroupInviteIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveGroupInviteIDs(ids...)
+	return uuo
+}
+
+// RemoveGroupInvites removes "group_invites" edges to GroupInvite entities.
+func (uuo *UserUpdateOne) RemoveGroupInvites(g ...*GroupInvite) *UserUpdateOne {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return uuo.RemoveGroupInviteIDs(ids...)
+}
+
+// ClearStudyEvents clears all "study_events" edges to the StudyEvent entity.
+func (uuo *UserUpdateOne) ClearStudyEvents() *UserUpdateOne {
+	uuo.mutation.ClearStudyEvents()
+	return uuo
+}
+
+// RemoveStudyEventIDs removes the "study_events" edge to StudyEvent entities by IDs.
+func (uuo *UserUpdateOne) RemoveStudyEventIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveStudyEventIDs(ids...)
+	return uuo
+}
+
+// RemoveStudyEvents removes "study_events" edges to StudyEvent entities.
+func (uuo *UserUpdateOne) RemoveStudyEvents(s ...*StudyEvent) *UserUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uuo.RemoveStudyEventIDs(ids...)
+}
+
+// ClearDocuments clears all "documents" edges to the Document entity.
+func (uuo *UserUpdateOne) ClearDocuments() *UserUpdateOne {
+	uuo.mutation.ClearDocuments()
+	return uuo
+}
+
+// RemoveDocumentIDs removes the "documents" edge to Document entities by IDs.
+func (uuo *UserUpdateOne) RemoveDocumentIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveDocumentIDs(ids...)
+	return uuo
+}
+
+// RemoveDocuments removes "documents" edges to Document entities.
+func (uuo *UserUpdateOne) RemoveDocuments(d ...*Document) *UserUpdateOne {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return uuo.RemoveDocumentIDs(ids...)
+}
+
+// ClearFolders clears all "folders" edges to the Folder entity.
+func (uuo *UserUpdateOne) ClearFolders() *UserUpdateOne {
+	uuo.mutation.ClearFolders()
+	return uuo
+}
+
+// RemoveFolderIDs removes the "folders" edge to Folder entities by IDs.
+func (uuo *UserUpdateOne) RemoveFolderIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveFolderIDs(ids...)
+	return uuo
+}
+
+// RemoveFolders removes "folders" edges to Folder entities.
+func (uuo *UserUpdateOne) RemoveFolders(f ...*Folder) *UserUpdateOne {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uuo.RemoveFolderIDs(ids...)
+}
+
+// ClearBlobReferences clears all "blob_references" edges to the BlobReference entity.
+func (uuo *UserUpdateOne) ClearBlobReferences() *UserUpdateOne {
+	uuo.mutation.ClearBlobReferences()
+	return uuo
+}
+
+// RemoveBlobReferenceIDs removes the "blob_references" edge to BlobReference entities by IDs.
+func (uuo *UserUpdateOne) RemoveBlobReferenceIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveBlobReferenceIDs(ids...)
+	return uuo
+}
+
+// RemoveBlobReferences removes "blob_references" edges to BlobReference entities.
+func (uuo *UserUpdateOne) RemoveBlobReferences(b ...*BlobReference) *UserUpdateOne {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return uuo.RemoveBlobReferenceIDs(ids...)
+}
+
+// ClearResumableUploads clears all "resumable_uploads" edges to the ResumableUpload entity.
+func (uuo *UserUpdateOne) ClearResumableUploads() *UserUpdateOne {
+	uuo.mutation.ClearResumableUploads()
+	return uuo
+}
+
+// RemoveResumableUploadIDs removes the "resumable_uploads" edge to ResumableUpload entities by IDs.
+func (uuo *UserUpdateOne) RemoveResumableUploadIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveResumableUploadIDs(ids...)
+	return uuo
+}
+
+// RemoveResumableUploads removes "resumable_uploads" edges to ResumableUpload entities.
+func (uuo *UserUpdateOne) RemoveResumableUploads(r ...*ResumableUpload) *UserUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uuo.RemoveResumableUploadIDs(ids...)
+}
+
+// ClearNoteProposals clears all "note_proposals" edges to the NoteProposal entity.
+func (uuo *UserUpdateOne) ClearNoteProposals() *UserUpdateOne {
+	uuo.mutation.ClearNoteProposals()
+	return uuo
+}
+
+// RemoveNoteProposalIDs removes the "note_proposals" edge to NoteProposal entities by IDs.
+func (uuo *UserUpdateOne) RemoveNoteProposalIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveNoteProposalIDs(ids...)
+	return uuo
+}
+
+// RemoveNoteProposals removes "note_proposals" edges to NoteProposal entities.
+func (uuo *UserUpdateOne) RemoveNoteProposals(n ...*NoteProposal) *UserUpdateOne {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return uuo.RemoveNoteProposalIDs(ids...)
+}
+
+// ClearNoteRevisions clears all "note_revisions" edges to the NoteRevision entity.
+func (uuo *UserUpdateOne) ClearNoteRevisions() *UserUpdateOne {
+	uuo.mutation.ClearNoteRevisions()
+	return uuo
+}
+
+// RemoveNoteRevisionIDs removes the "note_revisions" edge to NoteRevision entities by IDs.
+func (uuo *UserUpdateOne) RemoveNoteRevisionIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveNoteRevisionIDs(ids...)
+	return uuo
+}
+
+// RemoveNoteRevisions removes "note_revisions" edges to NoteRevision entities.
+func (uuo *UserUpdateOne) RemoveNoteRevisions(n ...*NoteRevision) *UserUpdateOne {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return uuo.RemoveNoteRevisionIDs(ids...)
+}
+
+// ClearNotebooks clears all "notebooks" edges to the Notebook entity.
+func (uuo *UserUpdateOne) ClearNotebooks() *UserUpdateOne {
+	uuo.mutation.ClearNotebooks()
+	return uuo
+}
+
+// RemoveNotebookIDs removes the "notebooks" edge to Notebook entities by IDs.
+func (uuo *UserUpdateOne) RemoveNotebookIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveNotebookIDs(ids...)
+	return uuo
+}
+
+// RemoveNotebooks removes "notebooks" edges to Notebook entities.
+func (uuo *UserUpdateOne) RemoveNotebooks(n ...*Notebook) *UserUpdateOne {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return uuo.RemoveNotebookIDs(ids...)
+}
+
+// ClearTags clears all "tags" edges to the Tag entity.
+func (uuo *UserUpdateOne) ClearTags() *UserUpdateOne {
+	uuo.mutation.ClearTags()
+	return uuo
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (uuo *UserUpdateOne) RemoveTagIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveTagIDs(ids...)
+	return uuo
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (uuo *UserUpdateOne) RemoveTags(t ...*Tag) *UserUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return uuo.RemoveTagIDs(ids...)
+}
+
+// Where appends a list predicates to the UserUpdate builder.
+func (uuo *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
+	uuo.mutation.Where(ps...)
+	return uuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (uuo *UserUpdateOne) Select(field string, fields ...string) *UserUpdateOne {
+	uuo.fields = append([]string{field}, fields...)
+	return uuo
+}
+
+// Save executes the query and returns the updated User entity.
+func (uuo *UserUpdateOne) Save(ctx context.Context) (*User, error) {
+	uuo.defaults()
+	return withHooks(ctx, uuo.sqlSave, uuo.mutation, uuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uuo *UserUpdateOne) SaveX(ctx context.Context) *User {
+	node, err := uuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (uuo *UserUpdateOne) Exec(ctx context.Context) error {
+	_, err := uuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uuo *UserUpdateOne) ExecX(ctx context.Context) {
+	if err := uuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (uuo *UserUpdateOne) defaults() {
+	if _, ok := uuo.mutation.UpdatedAt(); !ok && !uuo.mutation.UpdatedAtCleared() {
+		v := user.UpdateDefaultUpdatedAt()
+		uuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (uuo *UserUpdateOne) check() error {
+	if v, ok := uuo.mutation.Name(); ok {
+		if err := user.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "User.name": %w`, err)}
+		}
+	}
+	if v, ok := uuo.mutation.PhoneNumber(); ok {
+		if err := user.PhoneNumberValidator(v); err != nil {
+			return &ValidationError{Name: "phone_number", err: fmt.Errorf(`ent: validator failed for field "User.phone_number": %w`, err)}
+		}
+	}
+	if v, ok := uuo.mutation.Email(); ok {
+		if err := user.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "User.email": %w`, err)}
+		}
+	}
+	if v, ok := uuo.mutation.Password(); ok {
+		if err := user.PasswordValidator(v); err != nil {
+			return &ValidationError{Name: "password", err: fmt.Errorf(`ent: validator failed for field "User.password": %w`, err)}
+		}
+	}
+	if v, ok := uuo.mutation.RegistrationMethod(); ok {
+		if err := user.RegistrationMethodValidator(v); err != nil {
+			return &ValidationError{Name: "registration_method", err: fmt.Errorf(`ent: validator failed for field "User.registration_method": %w`, err)}
+		}
+	}
+	if v, ok := uuo.mutation.Bio(); ok {
+		if err := user.BioValidator(v); err != nil {
+			return &ValidationError{Name: "bio", err: fmt.Errorf(`ent: validator failed for field "User.bio": %w`, err)}
+		}
+	}
+	if v, ok := uuo.mutation.StorageQuota(); ok {
+		if err := user.StorageQuotaValidator(v); err != nil {
+			return &ValidationError{Name: "storage_quota", err: fmt.Errorf(`ent: validator failed for field "User.storage_quota": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
+	if err := uuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt))
+	id, ok := uuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "User.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := uuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, user.FieldID)
+		for _, f := range fields {
+			if !user.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != user.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := uuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := uuo.mutation.Name(); ok {
+		_spec.SetField(user.FieldName, field.TypeString, value)
+	}
+	if value, ok := uuo.mutation.PhoneNumber(); ok {
+		_spec.SetField(user.FieldPhoneNumber, field.TypeString, value)
+	}
+	if value, ok := uuo.mutation.Email(); ok {
+		_spec.SetField(user.FieldEmail, field.TypeString, value)
+	}
+	if uuo.mutation.EmailCleared() {
+		_spec.ClearField(user.FieldEmail, field.TypeString)
+	}
+	if value, ok := uuo.mutation.Password(); ok {
+		_spec.SetField(user.FieldPassword, field.TypeString, value)
+	}
+	if uuo.mutation.PasswordCleared() {
+		_spec.ClearField(user.FieldPassword, field.TypeString)
+	}
+	if value, ok := uuo.mutation.Verified(); ok {
+		_spec.SetField(user.FieldVerified, field.TypeBool, value)
+	}
+	if value, ok := uuo.mutation.VerificationCode(); ok {
+		_spec.SetField(user.FieldVerificationCode, field.TypeString, value)
+	}
+	if uuo.mutation.VerificationCodeCleared() {
+		_spec.ClearField(user.FieldVerificationCode, field.TypeString)
+	}
+	if value, ok := uuo.mutation.Admin(); ok {
+		_spec.SetField(user.FieldAdmin, field.TypeBool, value)
+	}
+	if value, ok := uuo.mutation.RegistrationMethod(); ok {
+		_spec.SetField(user.FieldRegistrationMethod, field.TypeEnum, value)
+	}
+	if value, ok := uuo.mutation.ProfilePicture(); ok {
+		_spec.SetField(user.FieldProfilePicture, field.TypeString, value)
+	}
+	if uuo.mutation.ProfilePictureCleared() {
+		_spec.ClearField(user.FieldProfilePicture, field.TypeString)
+	}
+	if value, ok := uuo.mutation.DarkMode(); ok {
+		_spec.SetField(user.FieldDarkMode, field.TypeBool, value)
+	}
+	if value, ok := uuo.mutation.Bio(); ok {
+		_spec.SetField(user.FieldBio, field.TypeString, value)
+	}
+	if uuo.mutation.BioCleared() {
+		_spec.ClearField(user.FieldBio, field.TypeString)
+	}
+	if value, ok := uuo.mutation.EmailNotifications(); ok {
+		_spec.SetField(user.FieldEmailNotifications, field.TypeBool, value)
+	}
+	if value, ok := uuo.mutation.SmsNotifications(); ok {
+		_spec.SetField(user.FieldSmsNotifications, field.TypeBool, value)
+	}
+	if value, ok := uuo.mutation.IsActive(); ok {
+		_spec.SetField(user.FieldIsActive, field.TypeBool, value)
+	}
+	if value, ok := uuo.mutation.LastLogin(); ok {
+		_spec.SetField(user.FieldLastLogin, field.TypeTime, value)
+	}
+	if uuo.mutation.LastLoginCleared() {
+		_spec.ClearField(user.FieldLastLogin, field.TypeTime)
+	}
+	if value, ok := uuo.mutation.StorageQuota(); ok {
+		_spec.SetField(user.FieldStorageQuota, field.TypeInt64, value)
+	}
+	if value, ok := uuo.mutation.AddedStorageQuota(); ok {
+		_spec.AddField(user.FieldStorageQuota, field.TypeInt64, value)
+	}
+	if uuo.mutation.StorageQuotaCleared() {
+		_spec.ClearField(user.FieldStorageQuota, field.TypeInt64)
+	}
+	if value, ok := uuo.mutation.StorageUsed(); ok {
+		_spec.SetField(user.FieldStorageUsed, field.TypeInt64, value)
+	}
+	if value, ok := uuo.mutation.AddedStorageUsed(); ok {
+		_spec.AddField(user.FieldStorageUsed, field.TypeInt64, value)
+	}
+	if value, ok := uuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if uuo.mutation.UpdatedAtCleared() {
+		_spec.ClearField(user.FieldUpdatedAt, field.TypeTime)
+	}
+	if uuo.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.OwnerTable,
+			Columns: []string{user.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(passwordtoken.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedOwnerIDs(); len(nodes) > 0 && !uuo.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.OwnerTable,
+			Columns: []string{user.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(passwordtoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.OwnerTable,
+			Columns: []string{user.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(passwordtoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.NotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotesTable,
+			Columns: []string{user.NotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedNotesIDs(); len(nodes) > 0 && !uuo.mutation.NotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotesTable,
+			Columns: []string{user.NotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.NotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotesTable,
+			Columns: []string{user.NotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.NoteLikesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NoteLikesTable,
+			Columns: []string{user.NoteLikesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notelike.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedNoteLikesIDs(); len(nodes) > 0 && !uuo.mutation.NoteLikesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NoteLikesTable,
+			Columns: []string{user.NoteLikesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notelike.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.NoteLikesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NoteLikesTable,
+			Columns: []string{user.NoteLikesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notelike.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.NoteRepostsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NoteRepostsTable,
+			Columns: []string{user.NoteRepostsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(noterepost.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedNoteRepostsIDs(); len(nodes) > 0 && !uuo.mutation.NoteRepostsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NoteRepostsTable,
+			Columns: []string{user.NoteRepostsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(noterepost.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo
-}
-
-// SetBio sets the "bio" field.
-func (uuo *UserUpdateOne) SetBio(s string) *UserUpdateOne {
-	uuo.mutation.SetBio(s)
-	return uuo
-}
-
-// SetNillableBio sets the "bio" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableBio(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetBio(*s)
+	if nodes := uuo.mutation.NoteRepostsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NoteRepostsTable,
+			Columns: []string{user.NoteRepostsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(noterepost.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return uuo
-}
-
-// ClearBio clears the value of the "bio" field.
-func (uuo *UserUpdateOne) ClearBio() *UserUpdateOne {
-	uuo.mutation.ClearBio()
-	return uuo
-}
-
-// SetEmailNotifications sets the "email_notifications" field.
-func (uuo *UserUpdateOne) SetEmailNotifications(b bool) *UserUpdateOne {
-	uuo.mutation.SetEmailNotifications(b)
-	return uuo
-}
-
-// SetNillableEmailNotifications sets the "email_notifications" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableEmailNotifications(b *bool) *UserUpdateOne {
-	if b != nil {
-		uuo.SetEmailNotifications(*b)
+	if uuo.mutation.QuizzesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.QuizzesTable,
+			Columns: []string{user.QuizzesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quiz.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo
-}
-
-// SetSmsNotifications sets the "sms_notifications" field.
-func (uuo *UserUpdateOne) SetSmsNotifications(b bool) *UserUpdateOne {
-	uuo.mutation.SetSmsNotifications(b)
-	return uuo
-}
-
-// SetNillableSmsNotifications sets the "sms_notifications" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableSmsNotifications(b *bool) *UserUpdateOne {
-	if b != nil {
-		uuo.SetSmsNotifications(*b)
+	if nodes := uuo.mutation.RemovedQuizzesIDs(); len(nodes) > 0 && !uuo.mutation.QuizzesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.QuizzesTable,
+			Columns: []string{user.QuizzesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quiz.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo
-}
-
-// SetIsActive sets the "is_active" field.
-func (uuo *UserUpdateOne) SetIsActive(b bool) *UserUpdateOne {
-	uuo.mutation.SetIsActive(b)
-	return uuo
-}
-
-// SetNillableIsActive sets the "is_active" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableIsActive(b *bool) *UserUpdateOne {
-	if b != nil {
-		uuo.SetIsActive(*b)
+	if nodes := uuo.mutation.QuizzesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.QuizzesTable,
+			Columns: []string{user.QuizzesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quiz.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return uuo
-}
-
-// SetLastLogin sets the "last_login" field.
-func (uuo *UserUpdateOne) SetLastLogin(t time.Time) *UserUpdateOne {
-	uuo.mutation.SetLastLogin(t)
-	return uuo
-}
-
-// SetNillableLastLogin sets the "last_login" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableLastLogin(t *time.Time) *UserUpdateOne {
-	if t != nil {
-		uuo.SetLastLogin(*t)
+	if uuo.mutation.QuizAttemptsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.QuizAttemptsTable,
+			Columns: []string{user.QuizAttemptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quizattempt.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo
-}
-
-// ClearLastLogin clears the value of the "last_login" field.
-func (uuo *UserUpdateOne) ClearLastLogin() *UserUpdateOne {
-	uuo.mutation.ClearLastLogin()
-	return uuo
-}
-
-// SetUpdatedAt sets the "updated_at" field.
-func (uuo *UserUpdateOne) SetUpdatedAt(t time.Time) *UserUpdateOne {
-	uuo.mutation.SetUpdatedAt(t)
-	return uuo
-}
-
-// ClearUpdatedAt clears the value of the "updated_at" field.
-func (uuo *UserUpdateOne) ClearUpdatedAt() *UserUpdateOne {
-	uuo.mutation.ClearUpdatedAt()
-	return uuo
-}
-
-// AddOwnerIDs adds the "owner" edge to the PasswordToken entity by IDs.
-func (uuo *UserUpdateOne) AddOwnerIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.AddOwnerIDs(ids...)
-	return uuo
-}
-
-// AddOwner adds the "owner" edges to the PasswordToken entity.
-func (uuo *UserUpdateOne) AddOwner(p ...*PasswordToken) *UserUpdateOne {
-	ids := make([]int, len(p))
-	for i := range p {
-		ids[i] = p[i].ID
+	if nodes := uuo.mutation.RemovedQuizAttemptsIDs(); len(nodes) > 0 && !uuo.mutation.QuizAttemptsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.QuizAttemptsTable,
+			Columns: []string{user.QuizAttemptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quizattempt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.AddOwnerIDs(ids...)
-}
-
-// AddNoteIDs adds the "notes" edge to the Note entity by IDs.
-func (uuo *UserUpdateOne) AddNoteIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.AddNoteIDs(ids...)
-	return uuo
-}
-
-// AddNotes adds the "notes" edges to the Note entity.
-func (uuo *UserUpdateOne) AddNotes(n ...*Note) *UserUpdateOne {
-	ids := make([]int, len(n))
-	for i := range n {
-		ids[i] = n[i].ID
+	if nodes := uuo.mutation.QuizAttemptsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.QuizAttemptsTable,
+			Columns: []string{user.QuizAttemptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quizattempt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return uuo.AddNoteIDs(ids...)
-}
-
-// AddNoteLikeIDs adds the "note_likes" edge to the NoteLike entity by IDs.
-func (uuo *UserUpdateOne) AddNoteLikeIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.AddNoteLikeIDs(ids...)
-	return uuo
-}
-
-// AddNoteLikes adds the "note_likes" edges to the NoteLike entity.
-func (uuo *UserUpdateOne) AddNoteLikes(n ...*NoteLike) *UserUpdateOne {
-	ids := make([]int, len(n))
-	for i := range n {
-		ids[i] = n[i].ID
+	if uuo.mutation.GroupMembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GroupMembershipsTable,
+			Columns: []string{user.GroupMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupmembership.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedGroupMembershipsIDs(); len(nodes) > 0 && !uuo.mutation.GroupMembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GroupMembershipsTable,
+			Columns: []string{user.GroupMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupmembership.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.GroupMembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GroupMembershipsTable,
+			Columns: []string{user.GroupMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupmembership.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.GroupJoinRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GroupJoinRequestsTable,
+			Columns: []string{user.GroupJoinRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupjoinrequest.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.AddNoteLikeIDs(ids...)
-}
-
-// AddNoteRepostIDs adds the "note_reposts" edge to the NoteRepost entity by IDs.
-func (uuo *UserUpdateOne) AddNoteRepostIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.AddNoteRepostIDs(ids...)
-	return uuo
-}
-
-// AddNoteReposts adds the "note_reposts" edges to the NoteRepost entity.
-func (uuo *UserUpdateOne) AddNoteReposts(n ...*NoteRepost) *UserUpdateOne {
-	ids := make([]int, len(n))
-	for i := range n {
-		ids[i] = n[i].ID
+	if nodes := uuo.mutation.RemovedGroupJoinRequestsIDs(); len(nodes) > 0 && !uuo.mutation.GroupJoinRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GroupJoinRequestsTable,
+			Columns: []string{user.GroupJoinRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupjoinrequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.AddNoteRepostIDs(ids...)
-}
-
-// Mutation returns the UserMutation object of the builder.
-func (uuo *UserUpdateOne) Mutation() *UserMutation {
-	return uuo.mutation
-}
-
-// ClearOwner clears all "owner" edges to the PasswordToken entity.
-func (uuo *UserUpdateOne) ClearOwner() *UserUpdateOne {
-	uuo.mutation.ClearOwner()
-	return uuo
-}
-
-// RemoveOwnerIDs removes the "owner" edge to PasswordToken entities by IDs.
-func (uuo *UserUpdateOne) RemoveOwnerIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.RemoveOwnerIDs(ids...)
-	return uuo
-}
-
-// RemoveOwner removes "owner" edges to PasswordToken entities.
-func (uuo *UserUpdateOne) RemoveOwner(p ...*PasswordToken) *UserUpdateOne {
-	ids := make([]int, len(p))
-	for i := range p {
-		ids[i] = p[i].ID
+	if nodes := uuo.mutation.GroupJoinRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GroupJoinRequestsTable,
+			Columns: []string{user.GroupJoinRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupjoinrequest.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return uuo.RemoveOwnerIDs(ids...)
-}
-
-// ClearNotes clears all "notes" edges to the Note entity.
-func (uuo *UserUpdateOne) ClearNotes() *UserUpdateOne {
-	uuo.mutation.ClearNotes()
-	return uuo
-}
-
-// RemoveNoteIDs removes the "notes" edge to Note entities by IDs.
-func (uuo *UserUpdateOne) RemoveNoteIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.RemoveNoteIDs(ids...)
-	return uuo
-}
-
-// RemoveNotes removes "notes" edges to Note entities.
-func (uuo *UserUpdateOne) RemoveNotes(n ...*Note) *UserUpdateOne {
-	ids := make([]int, len(n))
-	for i := range n {
-		ids[i] = n[i].ID
+	if uuo.mutation.GroupInvitesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GroupInvitesTable,
+			Columns: []string{user.GroupInvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupinvite.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.RemoveNoteIDs(ids...)
-}
-
-// ClearNoteLikes clears all "note_likes" edges to the NoteLike entity.
-func (uuo *UserUpdateOne) ClearNoteLikes() *UserUpdateOne {
-	uuo.mutation.ClearNoteLikes()
-	return uuo
-}
-
-// RemoveNoteLikeIDs removes the "note_likes" edge to NoteLike entities by IDs.
-func (uuo *UserUpdateOne) RemoveNoteLikeIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.RemoveNoteLikeIDs(ids...)
-	return uuo
-}
-
-// RemoveNoteLikes removes "note_likes" edges to NoteLike entities.
-func (uuo *UserUpdateOne) RemoveNoteLikes(n ...*NoteLike) *UserUpdateOne {
-	ids := make([]int, len(n))
-	for i := range n {
-		ids[i] = n[i].ID
+	if nodes := uuo.mutation.RemovedGroupInvitesIDs(); len(nodes) > 0 && !uuo.mutation.GroupInvitesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GroupInvitesTable,
+			Columns: []string{user.GroupInvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupinvite.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.RemoveNoteLikeIDs(ids...)
-}
-
-// ClearNoteReposts clears all "note_reposts" edges to the NoteRepost entity.
-func (uuo *UserUpdateOne) ClearNoteReposts() *UserUpdateOne {
-	uuo.mutation.ClearNoteReposts()
-	return uuo
-}
-
-// RemoveNoteRepostIDs removes the "note_reposts" edge to NoteRepost entities by IDs.
-func (uuo *UserUpdateOne) RemoveNoteRepostIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.RemoveNoteRepostIDs(ids...)
-	return uuo
-}
-
-// RemoveNoteReposts removes "note_reposts" edges to NoteRepost entities.
-func (uuo *UserUpdateOne) RemoveNoteReposts(n ...*NoteRepost) *UserUpdateOne {
-	ids := make([]int, len(n))
-	for i := range n {
-		ids[i] = n[i].ID
+	if nodes := uuo.mutation.GroupInvitesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GroupInvitesTable,
+			Columns: []string{user.GroupInvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupinvite.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return uuo.RemoveNoteRepostIDs(ids...)
-}
-
-// Where appends a list predicates to the UserUpdate builder.
-func (uuo *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
-	uuo.mutation.Where(ps...)
-	return uuo
-}
-
-// Select allows selecting one or more fields (columns) of the returned entity.
-// The default is selecting all fields defined in the entity schema.
-func (uuo *UserUpdateOne) Select(field string, fields ...string) *UserUpdateOne {
-	uuo.fields = append([]string{field}, fields...)
-	return uuo
-}
-
-// Save executes the query and returns the updated User entity.
-func (uuo *UserUpdateOne) Save(ctx context.Context) (*User, error) {
-	uuo.defaults()
-	return withHooks(ctx, uuo.sqlSave, uuo.mutation, uuo.hooks)
-}
-
-// SaveX is like Save, but panics if an error occurs.
-func (uuo *UserUpdateOne) SaveX(ctx context.Context) *User {
-	node, err := uuo.Save(ctx)
-	if err != nil {
-		panic(err)
+	if uuo.mutation.StudyEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.StudyEventsTable,
+			Columns: []string{user.StudyEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(studyevent.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return node
-}
-
-// Exec executes the query on the entity.
-func (uuo *UserUpdateOne) Exec(ctx context.Context) error {
-	_, err := uuo.Save(ctx)
-	return err
-}
-
-// ExecX is like Exec, but panics if an error occurs.
-func (uuo *UserUpdateOne) ExecX(ctx context.Context) {
-	if err := uuo.Exec(ctx); err != nil {
-		panic(err)
+	if nodes := uuo.mutation.RemovedStudyEventsIDs(); len(nodes) > 0 && !uuo.mutation.StudyEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.StudyEventsTable,
+			Columns: []string{user.StudyEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(studyevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-}
-
-// defaults sets the default values of the builder before save.
-func (uuo *UserUpdateOne) defaults() {
-	if _, ok := uuo.mutation.UpdatedAt(); !ok && !uuo.mutation.UpdatedAtCleared() {
-		v := user.UpdateDefaultUpdatedAt()
-		uuo.mutation.SetUpdatedAt(v)
+	if nodes := uuo.mutation.StudyEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.StudyEventsTable,
+			Columns: []string{user.StudyEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(studyevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-}
-
-// check runs all checks and user-defined validators on the builder.
-func (uuo *UserUpdateOne) check() error {
-	if v, ok := uuo.mutation.Name(); ok {
-		if err := user.NameValidator(v); err != nil {
-			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "User.name": %w`, err)}
+	if uuo.mutation.DocumentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DocumentsTable,
+			Columns: []string{user.DocumentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(document.FieldID, field.TypeInt),
+			},
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if v, ok := uuo.mutation.PhoneNumber(); ok {
-		if err := user.PhoneNumberValidator(v); err != nil {
-			return &ValidationError{Name: "phone_number", err: fmt.Errorf(`ent: validator failed for field "User.phone_number": %w`, err)}
+	if nodes := uuo.mutation.RemovedDocumentsIDs(); len(nodes) > 0 && !uuo.mutation.DocumentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DocumentsTable,
+			Columns: []string{user.DocumentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(document.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if v, ok := uuo.mutation.Email(); ok {
-		if err := user.EmailValidator(v); err != nil {
-			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "User.email": %w`, err)}
+	if nodes := uuo.mutation.DocumentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DocumentsTable,
+			Columns: []string{user.DocumentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(document.FieldID, field.TypeInt),
+			},
 		}
-	}
-	if v, ok := uuo.mutation.Password(); ok {
-		if err := user.PasswordValidator(v); err != nil {
-			return &ValidationError{Name: "password", err: fmt.Errorf(`ent: validator failed for field "User.password": %w`, err)}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if v, ok := uuo.mutation.RegistrationMethod(); ok {
-		if err := user.RegistrationMethodValidator(v); err != nil {
-			return &ValidationError{Name: "registration_method", err: fmt.Errorf(`ent: validator failed for field "User.registration_method": %w`, err)}
+	if uuo.mutation.FoldersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FoldersTable,
+			Columns: []string{user.FoldersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(folder.FieldID, field.TypeInt),
+			},
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if v, ok := uuo.mutation.Bio(); ok {
-		if err := user.BioValidator(v); err != nil {
-			return &ValidationError{Name: "bio", err: fmt.Errorf(`ent: validator failed for field "User.bio": %w`, err)}
+	if nodes := uuo.mutation.RemovedFoldersIDs(); len(nodes) > 0 && !uuo.mutation.FoldersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FoldersTable,
+			Columns: []string{user.FoldersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(folder.FieldID, field.TypeInt),
+			},
 		}
-	}
-	return nil
-}
-
-func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
-	if err := uuo.check(); err != nil {
-		return _node, err
-	}
-	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt))
-	id, ok := uuo.mutation.ID()
-	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "User.id" for update`)}
-	}
-	_spec.Node.ID.Value = id
-	if fields := uuo.fields; len(fields) > 0 {
-		_spec.Node.Columns = make([]string, 0, len(fields))
-		_spec.Node.Columns = append(_spec.Node.Columns, user.FieldID)
-		for _, f := range fields {
-			if !user.ValidColumn(f) {
-				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
-			}
-			if f != user.FieldID {
-				_spec.Node.Columns = append(_spec.Node.Columns, f)
-			}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if ps := uuo.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
+	if nodes := uuo.mutation.FoldersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FoldersTable,
+			Columns: []string{user.FoldersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(folder.FieldID, field.TypeInt),
+			},
 		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := uuo.mutation.Name(); ok {
-		_spec.SetField(user.FieldName, field.TypeString, value)
-	}
-	if value, ok := uuo.mutation.PhoneNumber(); ok {
-		_spec.SetField(user.FieldPhoneNumber, field.TypeString, value)
-	}
-	if value, ok := uuo.mutation.Email(); ok {
-		_spec.SetField(user.FieldEmail, field.TypeString, value)
-	}
-	if uuo.mutation.EmailCleared() {
-		_spec.ClearField(user.FieldEmail, field.TypeString)
-	}
-	if value, ok := uuo.mutation.Password(); ok {
-		_spec.SetField(user.FieldPassword, field.TypeString, value)
-	}
-	if uuo.mutation.PasswordCleared() {
-		_spec.ClearField(user.FieldPassword, field.TypeString)
-	}
-	if value, ok := uuo.mutation.Verified(); ok {
-		_spec.SetField(user.FieldVerified, field.TypeBool, value)
-	}
-	if value, ok := uuo.mutation.VerificationCode(); ok {
-		_spec.SetField(user.FieldVerificationCode, field.TypeString, value)
-	}
-	if uuo.mutation.VerificationCodeCleared() {
-		_spec.ClearField(user.FieldVerificationCode, field.TypeString)
-	}
-	if value, ok := uuo.mutation.Admin(); ok {
-		_spec.SetField(user.FieldAdmin, field.TypeBool, value)
-	}
-	if value, ok := uuo.mutation.RegistrationMethod(); ok {
-		_spec.SetField(user.FieldRegistrationMethod, field.TypeEnum, value)
-	}
-	if value, ok := uuo.mutation.ProfilePicture(); ok {
-		_spec.SetField(user.FieldProfilePicture, field.TypeString, value)
-	}
-	if uuo.mutation.ProfilePictureCleared() {
-		_spec.ClearField(user.FieldProfilePicture, field.TypeString)
-	}
-	if value, ok := uuo.mutation.DarkMode(); ok {
-		_spec.SetField(user.FieldDarkMode, field.TypeBool, value)
-	}
-	if value, ok := uuo.mutation.Bio(); ok {
-		_spec.SetField(user.FieldBio, field.TypeString, value)
-	}
-	if uuo.mutation.BioCleared() {
-		_spec.ClearField(user.FieldBio, field.TypeString)
-	}
-	if value, ok := uuo.mutation.EmailNotifications(); ok {
-		_spec.SetField(user.FieldEmailNotifications, field.TypeBool, value)
-	}
-	if value, ok := uuo.mutation.SmsNotifications(); ok {
-		_spec.SetField(user.FieldSmsNotifications, field.TypeBool, value)
+	if uuo.mutation.BlobReferencesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BlobReferencesTable,
+			Columns: []string{user.BlobReferencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blobreference.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.IsActive(); ok {
-		_spec.SetField(user.FieldIsActive, field.TypeBool, value)
+	if nodes := uuo.mutation.RemovedBlobReferencesIDs(); len(nodes) > 0 && !uuo.mutation.BlobReferencesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BlobReferencesTable,
+			Columns: []string{user.BlobReferencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blobreference.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.LastLogin(); ok {
-		_spec.SetField(user.FieldLastLogin, field.TypeTime, value)
+	if nodes := uuo.mutation.BlobReferencesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BlobReferencesTable,
+			Columns: []string{user.BlobReferencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blobreference.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.LastLoginCleared() {
-		_spec.ClearField(user.FieldLastLogin, field.TypeTime)
+	if uuo.mutation.ResumableUploadsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ResumableUploadsTable,
+			Columns: []string{user.ResumableUploadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resumableupload.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.UpdatedAt(); ok {
-		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
+	if nodes := uuo.mutation.RemovedResumableUploadsIDs(); len(nodes) > 0 && !uuo.mutation.ResumableUploadsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ResumableUploadsTable,
+			Columns: []string{user.ResumableUploadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resumableupload.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if uuo.mutation.UpdatedAtCleared() {
-		_spec.ClearField(user.FieldUpdatedAt, field.TypeTime)
+	if nodes := uuo.mutation.ResumableUploadsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ResumableUploadsTable,
+			Columns: []string{user.ResumableUploadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resumableupload.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.OwnerCleared() {
+	if uuo.mutation.NoteProposalsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
-			Inverse: true,
-			Table:   user.OwnerTable,
-			Columns: []string{user.OwnerColumn},
+			Inverse: false,
+			Table:   user.NoteProposalsTable,
+			Columns: []string{user.NoteProposalsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(passwordtoken.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(noteproposal.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.RemovedOwnerIDs(); len(nodes) > 0 && !uuo.mutation.OwnerCleared() {
+	if nodes := uuo.mutation.RemovedNoteProposalsIDs(); len(nodes) > 0 && !uuo.mutation.NoteProposalsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
-			Inverse: true,
-			Table:   user.OwnerTable,
-			Columns: []string{user.OwnerColumn},
+			Inverse: false,
+			Table:   user.NoteProposalsTable,
+			Columns: []string{user.NoteProposalsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(passwordtoken.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(noteproposal.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1409,15 +3827,15 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.OwnerIDs(); len(nodes) > 0 {
+	if nodes := uuo.mutation.NoteProposalsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
-			Inverse: true,
-			Table:   user.OwnerTable,
-			Columns: []string{user.OwnerColumn},
+			Inverse: false,
+			Table:   user.NoteProposalsTable,
+			Columns: []string{user.NoteProposalsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(passwordtoken.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(noteproposal.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1425,28 +3843,28 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.NotesCleared() {
+	if uuo.mutation.NoteRevisionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.NotesTable,
-			Columns: []string{user.NotesColumn},
+			Table:   user.NoteRevisionsTable,
+			Columns: []string{user.NoteRevisionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(noterevision.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.RemovedNotesIDs(); len(nodes) > 0 && !uuo.mutation.NotesCleared() {
+	if nodes := uuo.mutation.RemovedNoteRevisionsIDs(); len(nodes) > 0 && !uuo.mutation.NoteRevisionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.NotesTable,
-			Columns: []string{user.NotesColumn},
+			Table:   user.NoteRevisionsTable,
+			Columns: []string{user.NoteRevisionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(noterevision.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1454,15 +3872,15 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.NotesIDs(); len(nodes) > 0 {
+	if nodes := uuo.mutation.NoteRevisionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.NotesTable,
-			Columns: []string{user.NotesColumn},
+			Table:   user.NoteRevisionsTable,
+			Columns: []string{user.NoteRevisionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(note.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(noterevision.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1470,28 +3888,28 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.NoteLikesCleared() {
+	if uuo.mutation.NotebooksCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.NoteLikesTable,
-			Columns: []string{user.NoteLikesColumn},
+			Table:   user.NotebooksTable,
+			Columns: []string{user.NotebooksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(notelike.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(notebook.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.RemovedNoteLikesIDs(); len(nodes) > 0 && !uuo.mutation.NoteLikesCleared() {
+	if nodes := uuo.mutation.RemovedNotebooksIDs(); len(nodes) > 0 && !uuo.mutation.NotebooksCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.NoteLikesTable,
-			Columns: []string{user.NoteLikesColumn},
+			Table:   user.NotebooksTable,
+			Columns: []string{user.NotebooksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(notelike.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(notebook.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1499,15 +3917,15 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.NoteLikesIDs(); len(nodes) > 0 {
+	if nodes := uuo.mutation.NotebooksIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.NoteLikesTable,
-			Columns: []string{user.NoteLikesColumn},
+			Table:   user.NotebooksTable,
+			Columns: []string{user.NotebooksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(notelike.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(notebook.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1515,28 +3933,28 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.NoteRepostsCleared() {
+	if uuo.mutation.TagsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.NoteRepostsTable,
-			Columns: []string{user.NoteRepostsColumn},
+			Table:   user.TagsTable,
+			Columns: []string{user.TagsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(noterepost.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.RemovedNoteRepostsIDs(); len(nodes) > 0 && !uuo.mutation.NoteRepostsCleared() {
+	if nodes := uuo.mutation.RemovedTagsIDs(); len(nodes) > 0 && !uuo.mutation.TagsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.NoteRepostsTable,
-			Columns: []string{user.NoteRepostsColumn},
+			Table:   user.TagsTable,
+			Columns: []string{user.TagsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(noterepost.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1544,15 +3962,15 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.NoteRepostsIDs(); len(nodes) > 0 {
+	if nodes := uuo.mutation.TagsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.NoteRepostsTable,
-			Columns: []string{user.NoteRepostsColumn},
+			Table:   user.TagsTable,
+			Columns: []string{user.TagsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(noterepost.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
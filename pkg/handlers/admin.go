@@ -11,9 +11,11 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/mikestefanello/backlite/ui"
 	"github.com/r-scheele/zero/ent"
+	"github.com/r-scheele/zero/ent/blobreference"
 	"github.com/r-scheele/zero/ent/note"
 	"github.com/r-scheele/zero/ent/user"
 	"github.com/r-scheele/zero/pkg/context"
+	"github.com/r-scheele/zero/pkg/log"
 	"github.com/r-scheele/zero/pkg/middleware"
 	"github.com/r-scheele/zero/pkg/routenames"
 	"github.com/r-scheele/zero/pkg/services"
@@ -25,6 +27,7 @@ type Admin struct {
 	graph    *gen.Graph
 	backlite *ui.Handler
 	auth     *services.AuthClient
+	blobs    *services.BlobService
 }
 
 func init() {
@@ -36,6 +39,7 @@ func (h *Admin) Init(c *services.Container) error {
 	h.graph = c.Graph
 	h.orm = c.ORM
 	h.auth = c.Auth
+	h.blobs = c.Blobs
 	h.backlite, err = ui.NewHandler(ui.Config{
 		DB:           c.Database,
 		BasePath:     "/admin/tasks",
@@ -153,6 +157,12 @@ func (h *Admin) middlewareEntityLoad(n *gen.Type) echo.MiddlewareFunc {
 					updatedAt = user.UpdatedAt.Format("2006-01-02 15:04")
 				}
 				
+				// An empty quota means the configured default applies
+				storageQuota := ""
+				if user.StorageQuota != nil {
+					storageQuota = strconv.FormatInt(*user.StorageQuota, 10)
+				}
+				
 				// Populate all user fields for the detail view
 				entityData := map[string][]string{
 					"id":                   {strconv.Itoa(user.ID)},
@@ -170,6 +180,8 @@ func (h *Admin) middlewareEntityLoad(n *gen.Type) echo.MiddlewareFunc {
 					"sms_notifications":    {strconv.FormatBool(user.SmsNotifications)},
 					"is_active":            {strconv.FormatBool(user.IsActive)},
 					"last_login":           {lastLogin},
+					"storage_quota":        {storageQuota},
+					"storage_used":         {strconv.FormatInt(user.StorageUsed, 10)},
 					"created_at":           {user.CreatedAt.Format("2006-01-02 15:04")},
 					"updated_at":           {updatedAt},
 				}
@@ -456,6 +468,7 @@ func (h *Admin) EntityEditSubmit(n *gen.Type) echo.HandlerFunc {
 			password := ctx.FormValue("password")
 			verified := ctx.FormValue("verified") == "true"
 			admin := ctx.FormValue("admin") == "true"
+			storageQuota := strings.TrimSpace(ctx.FormValue("storage_quota"))
 			
 			userUpdate := h.orm.User.UpdateOneID(id).
 				SetName(name).
@@ -463,6 +476,17 @@ func (h *Admin) EntityEditSubmit(n *gen.Type) echo.HandlerFunc {
 				SetVerified(verified).
 				SetAdmin(admin)
 			
+			// Leaving the quota empty falls back to the configured default
+			if storageQuota != "" {
+				quota, err := strconv.ParseInt(storageQuota, 10, 64)
+				if err != nil || quota < 0 {
+					return echo.NewHTTPError(http.StatusBadRequest, "storage quota must be a number of bytes")
+				}
+				userUpdate = userUpdate.SetStorageQuota(quota)
+			} else {
+				userUpdate = userUpdate.ClearStorageQuota()
+			}
+			
 			if email != "" {
 				userUpdate = userUpdate.SetEmail(email)
 			} else {
@@ -525,6 +549,11 @@ func (h *Admin) EntityDeleteSubmit(n *gen.Type) echo.HandlerFunc {
 				return echo.NewHTTPError(http.StatusInternalServerError, "failed to delete note: "+err.Error())
 			}
 			
+			// Release the note's files so their owners stop being charged for them
+			if err := h.blobs.ReleaseAll(ctx.Request().Context(), blobreference.KindNote, id); err != nil {
+				log.Ctx(ctx).Error("failed to release note files", "note_id", id, "error", err)
+			}
+			
 			return ctx.Redirect(http.StatusSeeOther, "/admin/entity/note")
 		default:
 			return echo.NewHTTPError(http.StatusNotImplemented, "entity type not supported")
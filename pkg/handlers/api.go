@@ -2,12 +2,13 @@ package handlers
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"math/rand"
 	"net/http"
 	"os"
-	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -17,10 +18,14 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/r-scheele/zero/config"
 	"github.com/r-scheele/zero/ent"
+	"github.com/r-scheele/zero/ent/blobreference"
 	"github.com/r-scheele/zero/ent/user"
 	pkgcontext "github.com/r-scheele/zero/pkg/context"
+	"github.com/r-scheele/zero/pkg/log"
+	"github.com/r-scheele/zero/pkg/pager"
 	"github.com/r-scheele/zero/pkg/services"
 	"github.com/r-scheele/zero/pkg/tasks"
+	"github.com/r-scheele/zero/pkg/ui"
 	"github.com/r-scheele/zero/pkg/ui/forms"
 	"github.com/r-scheele/zero/pkg/ui/models"
 	"github.com/spf13/afero"
@@ -391,7 +396,7 @@ func (h *API) GetProfile(ctx echo.Context) error {
 		"dark_mode":           u.DarkMode,
 		"email_notifications": u.EmailNotifications,
 		"sms_notifications":   u.SmsNotifications,
-		"profile_picture":     u.ProfilePicture,
+		"profile_picture":     h.profilePictureURL(ctx, u),
 		"registration_method": u.RegistrationMethod,
 		"created_at":          u.CreatedAt,
 		"updated_at":          u.UpdatedAt,
@@ -463,13 +468,6 @@ func (h *API) UpdateProfilePicture(ctx echo.Context) error {
 		})
 	}
 
-	// Validate file type
-	if !strings.HasPrefix(file.Header.Get("Content-Type"), "image/") {
-		return ctx.JSON(http.StatusBadRequest, map[string]string{
-			"error": "Please upload a valid image file",
-		})
-	}
-
 	// Validate file size (5MB max)
 	if file.Size > 5*1024*1024 {
 		return ctx.JSON(http.StatusBadRequest, map[string]string{
@@ -486,37 +484,61 @@ func (h *API) UpdateProfilePicture(ctx echo.Context) error {
 	}
 	defer src.Close()
 
-	// Create uploads directory
-	uploadsDir := "uploads"
-	if err = os.MkdirAll(uploadsDir, 0755); err != nil {
+	// The picture's type is worked out from its content, and must be a raster image
+	mimeType, err := services.VerifyContentType(h.container.Config, file.Filename, file.Header.Get("Content-Type"), src)
+	switch {
+	case errors.Is(err, services.ErrContentTypeMismatch), errors.Is(err, services.ErrFileTypeNotAllowed):
+		return ctx.JSON(http.StatusUnsupportedMediaType, map[string]string{
+			"error": err.Error(),
+		})
+	case err != nil:
 		return ctx.JSON(http.StatusInternalServerError, map[string]string{
-			"error": "Failed to create upload directory",
+			"error": "Failed to process uploaded image",
+		})
+	case !slices.Contains(services.ProfilePictureTypes, mimeType):
+		return ctx.JSON(http.StatusUnsupportedMediaType, map[string]string{
+			"error": "Please upload a PNG, JPEG, GIF or WebP image",
 		})
 	}
 
-	// Generate unique filename
-	filename := fmt.Sprintf("profile_%d_%d%s", u.ID, time.Now().Unix(), filepath.Ext(file.Filename))
-	filePath := filepath.Join(uploadsDir, filename)
-
-	// Create destination file
-	dst, err := os.Create(filePath)
-	if err != nil {
-		return ctx.JSON(http.StatusInternalServerError, map[string]string{
-			"error": "Failed to save image",
-		})
+	// Pictures are scanned for viruses before they're saved
+	if h.container.Scanner != nil {
+		result, err := h.container.Scanner.Scan(ctx.Request().Context(), src)
+		if err != nil {
+			log.Ctx(ctx).Error("failed to scan profile picture", "error", err)
+			return ctx.JSON(http.StatusServiceUnavailable, map[string]string{
+				"error": "Unable to scan image, please try again later",
+			})
+		}
+		if !result.Clean {
+			return ctx.JSON(http.StatusUnprocessableEntity, map[string]string{
+				"error": "Image rejected: " + result.Threat + " found",
+			})
+		}
+		if _, err := src.Seek(0, io.SeekStart); err != nil {
+			return ctx.JSON(http.StatusInternalServerError, map[string]string{
+				"error": "Failed to process uploaded image",
+			})
+		}
 	}
-	defer dst.Close()
 
-	// Copy file
-	if _, err = io.Copy(dst, src); err != nil {
+	// Store the picture by its content, so re-uploading the same picture doesn't store it again
+	ref := services.BlobRef{OwnerID: u.ID, Kind: blobreference.KindProfile, TargetID: u.ID}
+	stored, err := h.container.Blobs.Store(ctx.Request().Context(), ref, file.Filename, src, mimeType)
+	switch {
+	case errors.Is(err, services.ErrQuotaExceeded):
+		return ctx.JSON(http.StatusRequestEntityTooLarge, map[string]string{
+			"error": "Not enough storage space: " + err.Error(),
+		})
+	case err != nil:
 		return ctx.JSON(http.StatusInternalServerError, map[string]string{
 			"error": "Failed to save image",
 		})
 	}
 
-	// Update user profile picture
+	// The picture is kept by its key, since links to stored files are signed when they're shown
 	updatedUser, err := h.orm.User.UpdateOneID(u.ID).
-		SetProfilePicture(filename).
+		SetProfilePicture(stored.StorageKey).
 		Save(ctx.Request().Context())
 
 	if err != nil {
@@ -525,12 +547,31 @@ func (h *API) UpdateProfilePicture(ctx echo.Context) error {
 		})
 	}
 
+	// Release the previous picture, deleting it unless someone else uploaded the same one
+	if err := h.container.Blobs.ReleaseAll(ctx.Request().Context(), ref.Kind, u.ID, stored.StorageKey); err != nil {
+		log.Ctx(ctx).Error("failed to release previous profile picture", "error", err)
+	}
+
 	return ctx.JSON(http.StatusOK, map[string]interface{}{
 		"message":         "Profile picture updated successfully",
-		"profile_picture": updatedUser.ProfilePicture,
+		"profile_picture": h.profilePictureURL(ctx, updatedUser),
 	})
 }
 
+// profilePictureURL returns a link to a user's profile picture, signing it if the picture is kept
+// in a storage backend
+func (h *API) profilePictureURL(ctx echo.Context, u *ent.User) *string {
+	if u.ProfilePicture == nil || !ui.StoredProfilePicture(*u.ProfilePicture) {
+		return u.ProfilePicture
+	}
+	url, err := h.container.Storage.GetSignedURL(ctx.Request().Context(), *u.ProfilePicture, int64(services.ThumbnailLinkTTL/time.Second))
+	if err != nil {
+		log.Ctx(ctx).Error("failed to sign profile picture URL", "user_id", u.ID, "error", err)
+		return nil
+	}
+	return &url
+}
+
 func (h *API) ChangePassword(ctx echo.Context) error {
 	u := ctx.Get(pkgcontext.AuthenticatedUserKey).(*ent.User)
 	var input struct {
@@ -685,6 +726,8 @@ func (h *API) ListFiles(ctx echo.Context) error {
 }
 
 func (h *API) UploadFile(ctx echo.Context) error {
+	u := ctx.Get(pkgcontext.AuthenticatedUserKey).(*ent.User)
+
 	file, err := ctx.FormFile("file")
 	if err != nil {
 		return ctx.JSON(http.StatusBadRequest, map[string]string{
@@ -692,6 +735,12 @@ func (h *API) UploadFile(ctx echo.Context) error {
 		})
 	}
 
+	if err := h.container.Blobs.CheckQuota(ctx.Request().Context(), u.ID, file.Size); err != nil {
+		return ctx.JSON(http.StatusRequestEntityTooLarge, map[string]string{
+			"error": "Not enough storage space: " + err.Error(),
+		})
+	}
+
 	src, err := file.Open()
 	if err != nil {
 		return ctx.JSON(http.StatusInternalServerError, map[string]string{
@@ -700,24 +749,64 @@ func (h *API) UploadFile(ctx echo.Context) error {
 	}
 	defer src.Close()
 
-	dst, err := h.files.Create(file.Filename)
-	if err != nil {
+	// The file's content must be the type it was uploaded as, and one that's allowed
+	mimeType, err := services.VerifyContentType(h.container.Config, file.Filename, file.Header.Get("Content-Type"), src)
+	switch {
+	case errors.Is(err, services.ErrContentTypeMismatch), errors.Is(err, services.ErrFileTypeNotAllowed):
+		return ctx.JSON(http.StatusUnsupportedMediaType, map[string]string{
+			"error": err.Error(),
+		})
+	case err != nil:
 		return ctx.JSON(http.StatusInternalServerError, map[string]string{
-			"error": "Failed to create file",
+			"error": "Failed to read uploaded file",
 		})
 	}
-	defer dst.Close()
 
-	if _, err = io.Copy(dst, src); err != nil {
+	// Files are scanned for viruses before they're saved
+	if h.container.Scanner != nil {
+		result, err := h.container.Scanner.Scan(ctx.Request().Context(), src)
+		if err != nil {
+			log.Ctx(ctx).Error("failed to scan uploaded file", "error", err)
+			return ctx.JSON(http.StatusServiceUnavailable, map[string]string{
+				"error": "Unable to scan file, please try again later",
+			})
+		}
+		if !result.Clean {
+			return ctx.JSON(http.StatusUnprocessableEntity, map[string]string{
+				"error": "File rejected: " + result.Threat + " found",
+			})
+		}
+		if _, err := src.Seek(0, io.SeekStart); err != nil {
+			return ctx.JSON(http.StatusInternalServerError, map[string]string{
+				"error": "Failed to read uploaded file",
+			})
+		}
+	}
+
+	// Uploads are added to the user's document library, which charges them to the user's quota
+	doc, err := h.container.Documents.Upload(ctx.Request().Context(), u.ID, services.DocumentUpload{
+		FileName: file.Filename,
+		MimeType: mimeType,
+		Size:     file.Size,
+		Body:     src,
+	}, services.DocumentInput{})
+	switch {
+	case errors.Is(err, services.ErrQuotaExceeded):
+		return ctx.JSON(http.StatusRequestEntityTooLarge, map[string]string{
+			"error": "Not enough storage space: " + err.Error(),
+		})
+	case err != nil:
 		return ctx.JSON(http.StatusInternalServerError, map[string]string{
 			"error": "Failed to save file",
 		})
 	}
 
 	return ctx.JSON(http.StatusOK, map[string]interface{}{
-		"message":  "File uploaded successfully",
-		"filename": file.Filename,
-		"size":     file.Size,
+		"message":     "File uploaded successfully",
+		"document_id": doc.ID,
+		"filename":    doc.FileName,
+		"size":        doc.Size,
+		"mime_type":   doc.MimeType,
 	})
 }
 
@@ -762,19 +851,40 @@ func (h *API) CreateTask(ctx echo.Context) error {
 
 // Search endpoint
 func (h *API) Search(ctx echo.Context) error {
-	query := ctx.QueryParam("q")
-	if query == "" {
+	params := searchParams(ctx)
+	if params.Query == "" {
 		return ctx.JSON(http.StatusBadRequest, map[string]string{
 			"error": "Search query is required",
 		})
 	}
 
-	// For now, return empty results (implement actual search logic as needed)
-	results := make([]*models.SearchResult, 0)
+	// Searching is open to everyone, but signed in users also find their own private notes
+	if token := strings.TrimPrefix(ctx.Request().Header.Get("Authorization"), "Bearer "); token != "" {
+		if userID, err := h.validateJWTToken(token); err == nil {
+			params.ViewerID = &userID
+		}
+	}
+
+	pg := pager.NewPager(ctx, services.DefaultSearchLimit)
+	results, err := h.container.Search.Search(ctx.Request().Context(), params, pg.ItemsPerPage, pg.GetOffset())
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]string{
+			"error": "Failed to search",
+		})
+	}
+	pg.SetItems(results.Total)
+	setSearchResultURLs(ctx, params.Query, results.Results)
 
 	return ctx.JSON(http.StatusOK, map[string]interface{}{
-		"query":   query,
-		"results": results,
+		"query":   params.Query,
+		"results": results.Results,
+		"facets":  results.Facets,
+		"pagination": map[string]interface{}{
+			"page":  pg.Page,
+			"limit": pg.ItemsPerPage,
+			"total": pg.Items,
+			"pages": pg.Pages,
+		},
 	})
 }
 
@@ -1015,6 +1125,22 @@ type Message struct {
 	Type      string         `json:"type"`
 	Text      *TextMessage   `json:"text,omitempty"`
 	Button    *ButtonMessage `json:"button,omitempty"`
+
+	// Interactive is set when a reply button of an interactive message is clicked
+	Interactive *InteractiveMessage `json:"interactive,omitempty"`
+}
+
+// buttonPayload returns the payload of a template button that was clicked, or the ID of a reply
+// button
+func (m Message) buttonPayload() (string, bool) {
+	switch {
+	case m.Button != nil:
+		return m.Button.Payload, true
+	case m.Interactive != nil && m.Interactive.ButtonReply != nil:
+		return m.Interactive.ButtonReply.ID, true
+	default:
+		return "", false
+	}
 }
 
 type TextMessage struct {
@@ -1026,6 +1152,16 @@ type ButtonMessage struct {
 	Payload string `json:"payload"`
 }
 
+type InteractiveMessage struct {
+	Type        string       `json:"type"`
+	ButtonReply *ButtonReply `json:"button_reply,omitempty"`
+}
+
+type ButtonReply struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+}
+
 // getEnvWithDefault gets environment variable with default value
 func getEnvWithDefault(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
@@ -1081,8 +1217,8 @@ func (h *API) processMessage(ctx context.Context, message Message) error {
 	}
 
 	// Handle button responses
-	if message.Button != nil {
-		return h.handleButtonMessage(ctx, message)
+	if payload, ok := message.buttonPayload(); ok {
+		return h.handleButtonMessage(ctx, message, payload)
 	}
 
 	return nil
@@ -1119,18 +1255,45 @@ func (h *API) handleTextMessage(ctx context.Context, message Message) error {
 }
 
 // handleButtonMessage processes button responses
-func (h *API) handleButtonMessage(ctx context.Context, message Message) error {
+func (h *API) handleButtonMessage(ctx context.Context, message Message, payload string) error {
 	// Handle different button payloads
-	switch message.Button.Payload {
-	case "verify_account":
+	switch {
+	case payload == "verify_account":
 		return h.handleVerifyAccountButton(ctx, message)
-	case "reset_password":
+	case payload == "reset_password":
 		return h.handleResetPasswordButton(ctx, message)
+	case strings.HasPrefix(payload, services.GroupInviteJoinPayload):
+		return h.handleGroupInviteButton(ctx, message, strings.TrimPrefix(payload, services.GroupInviteJoinPayload), true)
+	case strings.HasPrefix(payload, services.GroupInviteDeclinePayload):
+		return h.handleGroupInviteButton(ctx, message, strings.TrimPrefix(payload, services.GroupInviteDeclinePayload), false)
 	default:
 		return nil
 	}
 }
 
+// handleGroupInviteButton processes Join/Decline clicks on a study group invitation
+func (h *API) handleGroupInviteButton(ctx context.Context, message Message, token string, accept bool) error {
+	if !accept {
+		group, err := h.container.StudyGroups.DeclineInvite(ctx, token, message.From)
+		if err != nil {
+			return h.container.API.SendWhatsAppMessage(ctx, message.From, "This invitation is no longer valid.")
+		}
+		return h.container.API.SendWhatsAppMessage(ctx, message.From, fmt.Sprintf("You declined the invitation to %s.", group.Name))
+	}
+
+	group, err := h.container.StudyGroups.AcceptInviteByPhone(ctx, token, message.From)
+	switch {
+	case errors.Is(err, services.ErrAlreadyMember):
+		return h.container.API.SendWhatsAppMessage(ctx, message.From, fmt.Sprintf("You're already a member of %s.", group.Name))
+	case errors.Is(err, services.ErrInviteInvalid):
+		return h.container.API.SendWhatsAppMessage(ctx, message.From, "This invitation is no longer valid. Ask the group owner to send a new one.")
+	case err != nil:
+		return h.container.API.SendWhatsAppMessage(ctx, message.From, "We couldn't add you to the group. Make sure you've registered on Zero with this phone number.")
+	}
+
+	return h.container.API.SendWhatsAppMessage(ctx, message.From, fmt.Sprintf("✅ You joined %s! Open Zero to see the group's shared notes.", group.Name))
+}
+
 // handleVerifyAccountButton processes verify account button clicks
 func (h *API) handleVerifyAccountButton(ctx context.Context, message Message) error {
 	// Find user by phone number
@@ -0,0 +1,41 @@
+package handlers
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMessageButtonPayload(t *testing.T) {
+	tests := map[string]struct {
+		body    string
+		payload string
+		ok      bool
+	}{
+		"template button": {
+			body:    `{"type": "button", "button": {"text": "Verify", "payload": "verify_account"}}`,
+			payload: "verify_account",
+			ok:      true,
+		},
+		"reply button": {
+			body:    `{"type": "interactive", "interactive": {"type": "button_reply", "button_reply": {"id": "group_join_abc", "title": "Join"}}}`,
+			payload: "group_join_abc",
+			ok:      true,
+		},
+		"text": {
+			body: `{"type": "text", "text": {"body": "123456"}}`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var message Message
+			require.NoError(t, json.Unmarshal([]byte(test.body), &message))
+			payload, ok := message.buttonPayload()
+			assert.Equal(t, test.ok, ok)
+			assert.Equal(t, test.payload, payload)
+		})
+	}
+}
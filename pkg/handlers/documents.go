@@ -1,9 +1,24 @@
 package handlers
 
 import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
 	"github.com/labstack/echo/v4"
+	"github.com/r-scheele/zero/ent"
+	"github.com/r-scheele/zero/pkg/context"
+	"github.com/r-scheele/zero/pkg/form"
+	"github.com/r-scheele/zero/pkg/log"
 	"github.com/r-scheele/zero/pkg/middleware"
+	"github.com/r-scheele/zero/pkg/msg"
 	"github.com/r-scheele/zero/pkg/services"
+	"github.com/r-scheele/zero/pkg/ui/components"
+	"github.com/r-scheele/zero/pkg/ui/forms"
 	"github.com/r-scheele/zero/pkg/ui/pages"
 )
 
@@ -23,44 +38,407 @@ func (h *Documents) Init(c *services.Container) error {
 func (h *Documents) Routes(g *echo.Group) {
 	// Documents routes (require authentication and verification)
 	docs := g.Group("/documents", middleware.RequireAuthentication, middleware.RequireVerification)
-	
+
 	// List documents
 	docs.GET("", h.ListDocuments).Name = "documents.list"
-	
+
 	// Upload documents
 	docs.GET("/upload", h.UploadDocumentsPage).Name = "documents.upload"
 	docs.POST("/upload", h.UploadDocumentsSubmit).Name = "documents.upload.submit"
-	
-	// View document
+
+	// Folders
+	docs.POST("/folders", h.CreateFolder).Name = "documents.folder.create"
+	docs.POST("/folders/:id/delete", h.DeleteFolder).Name = "documents.folder.delete"
+
+	// View and edit document
 	docs.GET("/:id", h.ViewDocument).Name = "documents.view"
-	
+	docs.POST("/:id", h.EditDocument).Name = "documents.edit"
+
 	// Delete document
 	docs.POST("/:id/delete", h.DeleteDocument).Name = "documents.delete"
+
+	// Attached notes
+	docs.POST("/:id/notes", h.AttachDocument).Name = "documents.attach"
+	docs.POST("/:id/notes/:note/detach", h.DetachDocument).Name = "documents.detach"
 }
 
-// ListDocuments displays all documents
+// ListDocuments displays the documents in a folder, or with a tag
 func (h *Documents) ListDocuments(ctx echo.Context) error {
-	return pages.DocumentsList(ctx)
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+	reqCtx := ctx.Request().Context()
+
+	var err error
+	data := pages.DocumentLibraryData{Tag: ctx.QueryParam("tag")}
+
+	data.Folders, err = h.container.Documents.ListFolders(reqCtx, user.ID)
+	if err != nil {
+		return fail(err, "failed to fetch folders")
+	}
+
+	filter := services.DocumentFilter{Tag: data.Tag}
+	if id, err := strconv.Atoi(ctx.QueryParam("folder")); err == nil && data.Tag == "" {
+		for _, f := range data.Folders {
+			if f.ID == id {
+				data.Folder = f
+				filter.FolderID = id
+			}
+		}
+		if data.Folder == nil {
+			return echo.NewHTTPError(404, "Folder not found")
+		}
+	}
+
+	data.Tags, err = h.container.Documents.Tags(reqCtx, user.ID)
+	if err != nil {
+		return fail(err, "failed to fetch tags")
+	}
+
+	data.Documents, err = h.container.Documents.List(reqCtx, user.ID, filter)
+	if err != nil {
+		return fail(err, "failed to fetch documents")
+	}
+
+	return pages.DocumentsList(ctx, data)
 }
 
 // UploadDocumentsPage displays the document upload form
 func (h *Documents) UploadDocumentsPage(ctx echo.Context) error {
-	return pages.UploadDocuments(ctx)
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	f := form.Get[forms.Document](ctx)
+	if !f.IsSubmitted() {
+		f.Visibility = "private"
+		f.FolderID, _ = strconv.Atoi(ctx.QueryParam("folder"))
+	}
+
+	var err error
+	f.Folders, err = h.folderChoices(ctx, user.ID)
+	if err != nil {
+		return fail(err, "failed to fetch folders")
+	}
+
+	return pages.UploadDocuments(ctx, f)
 }
 
 // UploadDocumentsSubmit handles document upload
 func (h *Documents) UploadDocumentsSubmit(ctx echo.Context) error {
-	// TODO: Implement document upload logic
-	return ctx.String(200, "Document upload functionality coming soon!")
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+	var input forms.Document
+
+	err := form.Submit(ctx, &input)
+	switch err.(type) {
+	case nil:
+	case validator.ValidationErrors:
+		return h.UploadDocumentsPage(ctx)
+	default:
+		return err
+	}
+
+	fileHeader, err := ctx.FormFile("file")
+	if err != nil || fileHeader.Size == 0 {
+		msg.Error(ctx, "Please choose a file to upload.")
+		return h.UploadDocumentsPage(ctx)
+	}
+
+	maxFileSize, _, _, err := services.GetFileUploadLimits(h.container.Config)
+	if err != nil {
+		msg.Error(ctx, "Configuration error: "+err.Error())
+		return h.UploadDocumentsPage(ctx)
+	}
+	if fileHeader.Size > maxFileSize {
+		msg.Error(ctx, fmt.Sprintf("File %s exceeds %s limit", fileHeader.Filename, services.FormatFileSize(maxFileSize)))
+		return h.UploadDocumentsPage(ctx)
+	}
+
+	src, err := fileHeader.Open()
+	if err != nil {
+		return fail(err, "failed to open uploaded file")
+	}
+	defer src.Close()
+
+	// The file's content must be the type it was uploaded as, and one that's allowed
+	mimeType, err := services.VerifyContentType(h.container.Config, fileHeader.Filename, fileHeader.Header.Get("Content-Type"), src)
+	switch {
+	case errors.Is(err, services.ErrContentTypeMismatch), errors.Is(err, services.ErrFileTypeNotAllowed):
+		msg.Error(ctx, fmt.Sprintf("File %s was rejected: %s", fileHeader.Filename, err.Error()))
+		return h.UploadDocumentsPage(ctx)
+	case err != nil:
+		return fail(err, "failed to read uploaded file")
+	}
+
+	// Files are scanned for viruses before they're saved
+	if h.container.Scanner != nil {
+		result, err := h.container.Scanner.Scan(ctx.Request().Context(), src)
+		if err != nil {
+			log.Ctx(ctx).Error("failed to scan uploaded document", "error", err)
+			msg.Error(ctx, "Unable to scan the file right now. Please try again later.")
+			return h.UploadDocumentsPage(ctx)
+		}
+		if !result.Clean {
+			msg.Error(ctx, fmt.Sprintf("File %s was rejected: %s found", fileHeader.Filename, result.Threat))
+			return h.UploadDocumentsPage(ctx)
+		}
+		if _, err := src.Seek(0, io.SeekStart); err != nil {
+			return fail(err, "failed to read uploaded file")
+		}
+	}
+
+	doc, err := h.container.Documents.Upload(ctx.Request().Context(), user.ID, services.DocumentUpload{
+		FileName: fileHeader.Filename,
+		MimeType: mimeType,
+		Size:     fileHeader.Size,
+		Body:     src,
+	}, documentInput(&input))
+	if err != nil {
+		msg.Error(ctx, "Failed to upload document: "+err.Error())
+		return h.UploadDocumentsPage(ctx)
+	}
+
+	form.Clear(ctx)
+	msg.Success(ctx, "Document uploaded.")
+	return ctx.Redirect(302, ctx.Echo().Reverse("documents.view", doc.ID))
 }
 
 // ViewDocument displays a specific document
 func (h *Documents) ViewDocument(ctx echo.Context) error {
-	return pages.ViewDocument(ctx)
+	docID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(404, "Document not found")
+	}
+
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+	reqCtx := ctx.Request().Context()
+
+	doc, err := h.container.Documents.Get(reqCtx, docID, user.ID)
+	if err != nil {
+		if errors.Is(err, services.ErrDocumentNotFound) {
+			return echo.NewHTTPError(404, "Document not found")
+		}
+		return fail(err, "failed to fetch document")
+	}
+
+	data := pages.DocumentData{
+		Document: doc,
+		IsOwner:  doc.Edges.Owner != nil && doc.Edges.Owner.ID == user.ID,
+	}
+
+	data.FileURL, err = h.container.Documents.FileURL(reqCtx, doc)
+	if err != nil {
+		return fail(err, "failed to get document URL")
+	}
+
+	if data.IsOwner {
+		notes, err := h.container.Notes.ListUserNotes(reqCtx, user.ID, services.NoteFilter{}, 100, 0)
+		if err != nil {
+			return fail(err, "failed to fetch notes")
+		}
+		attached := make(map[int]bool)
+		for _, n := range doc.Edges.Notes {
+			attached[n.ID] = true
+		}
+		for _, n := range notes {
+			if !attached[n.ID] {
+				data.OwnNotes = append(data.OwnNotes, n)
+			}
+		}
+
+		data.Form = form.Get[forms.Document](ctx)
+		if !data.Form.IsSubmitted() {
+			data.Form.Title = doc.Title
+			data.Form.Description = doc.Description
+			data.Form.Tags = strings.Join(doc.Tags, ", ")
+			data.Form.Visibility = string(doc.Visibility)
+			if doc.Edges.Folder != nil {
+				data.Form.FolderID = doc.Edges.Folder.ID
+			}
+		}
+		data.Form.ID = doc.ID
+		data.Form.Folders, err = h.folderChoices(ctx, user.ID)
+		if err != nil {
+			return fail(err, "failed to fetch folders")
+		}
+	}
+
+	return pages.ViewDocument(ctx, data)
+}
+
+// EditDocument saves changes to a document's details
+func (h *Documents) EditDocument(ctx echo.Context) error {
+	docID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(404, "Document not found")
+	}
+
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+	var input forms.Document
+
+	err = form.Submit(ctx, &input)
+	switch err.(type) {
+	case nil:
+	case validator.ValidationErrors:
+		return h.ViewDocument(ctx)
+	default:
+		return err
+	}
+
+	if _, err := h.container.Documents.Update(ctx.Request().Context(), docID, user.ID, documentInput(&input)); err != nil {
+		return h.documentError(ctx, docID, err)
+	}
+
+	form.Clear(ctx)
+	msg.Success(ctx, "Document updated.")
+	return ctx.Redirect(302, ctx.Echo().Reverse("documents.view", docID))
 }
 
 // DeleteDocument handles document deletion
 func (h *Documents) DeleteDocument(ctx echo.Context) error {
-	// TODO: Implement document deletion logic
-	return ctx.String(200, "Document deletion functionality coming soon!")
-}
\ No newline at end of file
+	docID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(404, "Document not found")
+	}
+
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	if err := h.container.Documents.Delete(ctx.Request().Context(), docID, user.ID); err != nil {
+		return h.documentError(ctx, docID, err)
+	}
+
+	msg.Success(ctx, "Document deleted.")
+	return ctx.Redirect(302, ctx.Echo().Reverse("documents.list"))
+}
+
+// AttachDocument attaches a document to the selected notes
+func (h *Documents) AttachDocument(ctx echo.Context) error {
+	docID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(404, "Document not found")
+	}
+
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	params, err := ctx.FormParams()
+	if err != nil {
+		return fail(err, "failed to read form")
+	}
+
+	var noteIDs []int
+	for _, v := range params["note_ids"] {
+		if id, err := strconv.Atoi(v); err == nil {
+			noteIDs = append(noteIDs, id)
+		}
+	}
+	if len(noteIDs) == 0 {
+		msg.Error(ctx, "Please choose at least one note.")
+		return ctx.Redirect(302, ctx.Echo().Reverse("documents.view", docID))
+	}
+
+	if err := h.container.Documents.AttachToNotes(ctx.Request().Context(), docID, user.ID, noteIDs); err != nil {
+		return h.documentError(ctx, docID, err)
+	}
+
+	msg.Success(ctx, "Document attached.")
+	return ctx.Redirect(302, ctx.Echo().Reverse("documents.view", docID))
+}
+
+// DetachDocument removes a document from a note
+func (h *Documents) DetachDocument(ctx echo.Context) error {
+	docID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(404, "Document not found")
+	}
+
+	noteID, err := strconv.Atoi(ctx.Param("note"))
+	if err != nil {
+		return echo.NewHTTPError(404, "Note not found")
+	}
+
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	if err := h.container.Documents.DetachFromNote(ctx.Request().Context(), docID, noteID, user.ID); err != nil {
+		return h.documentError(ctx, docID, err)
+	}
+
+	msg.Success(ctx, "Document detached.")
+	return ctx.Redirect(302, ctx.Echo().Reverse("documents.view", docID))
+}
+
+// CreateFolder creates a folder inside the open folder
+func (h *Documents) CreateFolder(ctx echo.Context) error {
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+	parentID, _ := strconv.Atoi(ctx.FormValue("parent_id"))
+
+	f, err := h.container.Documents.CreateFolder(ctx.Request().Context(), user.ID, ctx.FormValue("name"), parentID)
+	if err != nil {
+		msg.Error(ctx, "Failed to create folder: "+err.Error())
+		return ctx.Redirect(302, ctx.Echo().Reverse("documents.list"))
+	}
+
+	return ctx.Redirect(302, fmt.Sprintf("%s?folder=%d", ctx.Echo().Reverse("documents.list"), f.ID))
+}
+
+// DeleteFolder deletes a folder, keeping its contents
+func (h *Documents) DeleteFolder(ctx echo.Context) error {
+	folderID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(404, "Folder not found")
+	}
+
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	if err := h.container.Documents.DeleteFolder(ctx.Request().Context(), folderID, user.ID); err != nil {
+		if errors.Is(err, services.ErrFolderNotFound) {
+			return echo.NewHTTPError(404, "Folder not found")
+		}
+		return fail(err, "failed to delete folder")
+	}
+
+	msg.Success(ctx, "Folder deleted.")
+	return ctx.Redirect(302, ctx.Echo().Reverse("documents.list"))
+}
+
+// folderChoices lists the user's folders for a select, with each folder's path as its label
+func (h *Documents) folderChoices(ctx echo.Context, userID int) ([]components.Choice, error) {
+	folders, err := h.container.Documents.ListFolders(ctx.Request().Context(), userID)
+	if err != nil {
+		return nil, err
+	}
+
+	parents := make(map[int]*ent.Folder)
+	for _, f := range folders {
+		parents[f.ID] = f.Edges.Parent
+	}
+
+	choices := make([]components.Choice, 0, len(folders))
+	for _, f := range folders {
+		path := f.Name
+		for p := f.Edges.Parent; p != nil; p = parents[p.ID] {
+			path = p.Name + " / " + path
+		}
+		choices = append(choices, components.Choice{Value: strconv.Itoa(f.ID), Label: path})
+	}
+	sort.Slice(choices, func(i, j int) bool {
+		return choices[i].Label < choices[j].Label
+	})
+
+	return choices, nil
+}
+
+func (h *Documents) documentError(ctx echo.Context, docID int, err error) error {
+	if errors.Is(err, services.ErrDocumentNotFound) {
+		return echo.NewHTTPError(404, "Document not found")
+	}
+
+	msg.Error(ctx, err.Error())
+	return ctx.Redirect(302, ctx.Echo().Reverse("documents.view", docID))
+}
+
+// documentInput converts the document form to service input
+func documentInput(f *forms.Document) services.DocumentInput {
+	return services.DocumentInput{
+		Title:       f.Title,
+		Description: f.Description,
+		Tags:        services.ParseTags(f.Tags),
+		Visibility:  f.Visibility,
+		FolderID:    f.FolderID,
+	}
+}
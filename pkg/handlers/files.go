@@ -1,11 +1,14 @@
 package handlers
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"time"
 
 	"github.com/labstack/echo/v4"
+	"github.com/r-scheele/zero/ent"
+	"github.com/r-scheele/zero/pkg/context"
 	"github.com/r-scheele/zero/pkg/middleware"
 	"github.com/r-scheele/zero/pkg/msg"
 	"github.com/r-scheele/zero/pkg/routenames"
@@ -17,6 +20,7 @@ import (
 
 type Files struct {
 	files afero.Fs
+	blobs *services.BlobService
 }
 
 func init() {
@@ -25,6 +29,7 @@ func init() {
 
 func (h *Files) Init(c *services.Container) error {
 	h.files = c.Files
+	h.blobs = c.Blobs
 	return nil
 }
 
@@ -60,6 +65,16 @@ func (h *Files) Submit(ctx echo.Context) error {
 		return h.Page(ctx)
 	}
 
+	u := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+	err = h.blobs.CheckQuota(ctx.Request().Context(), u.ID, file.Size)
+	switch {
+	case errors.Is(err, services.ErrQuotaExceeded):
+		msg.Error(ctx, "Not enough storage space: "+err.Error())
+		return h.Page(ctx)
+	case err != nil:
+		return fail(err, "failed to check storage quota")
+	}
+
 	src, err := file.Open()
 	if err != nil {
 		return err
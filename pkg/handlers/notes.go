@@ -1,27 +1,37 @@
 package handlers
 
 import (
+	"errors"
 	"fmt"
 	"io"
+	"net/url"
 	"os"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/labstack/echo/v4"
 	"github.com/r-scheele/zero/ent"
 	"github.com/r-scheele/zero/pkg/context"
 	"github.com/r-scheele/zero/pkg/form"
+	"github.com/r-scheele/zero/pkg/log"
 	"github.com/r-scheele/zero/pkg/middleware"
 	"github.com/r-scheele/zero/pkg/msg"
 	"github.com/r-scheele/zero/pkg/routenames"
 	"github.com/r-scheele/zero/pkg/services"
 	"github.com/r-scheele/zero/pkg/tasks"
+	"github.com/r-scheele/zero/pkg/ui/components"
 	"github.com/r-scheele/zero/pkg/ui/forms"
 	"github.com/r-scheele/zero/pkg/ui/pages"
 )
 
+// curriculumUploadDelay is how long building a curriculum waits after files are uploaded, so it
+// can include the text extracted from them
+const curriculumUploadDelay = 2 * time.Minute
+
 type Notes struct {
 	notesService *services.NotesService
 	container    *services.Container
@@ -66,15 +76,40 @@ func (h *Notes) Routes(g *echo.Group) {
 	notes.POST("/:id/repost", h.RepostNote).Name = routenames.Notes + ".repost"
 	notes.POST("/:id/unrepost", h.UnrepostNote).Name = routenames.Notes + ".unrepost"
 
+	// Generate a practice set from the note
+	notes.POST("/:id/practice", h.GeneratePracticeSet).Name = routenames.Notes + ".practice"
+
+	// Rebuild the note's AI curriculum
+	notes.POST("/:id/curriculum", h.GenerateCurriculum).Name = routenames.Notes + ".curriculum"
+
+	// Review changes proposed by other users
+	notes.GET("/:id/proposals/:proposal", h.ViewProposal).Name = routenames.Notes + ".proposal"
+	notes.POST("/:id/proposals/:proposal/accept", h.AcceptProposal).Name = routenames.Notes + ".proposal.accept"
+	notes.POST("/:id/proposals/:proposal/reject", h.RejectProposal).Name = routenames.Notes + ".proposal.reject"
+
+	// Render markdown for the editor's live preview
+	notes.POST("/preview", h.PreviewContent).Name = routenames.Notes + ".preview"
+
+	// Browse and restore earlier revisions
+	notes.GET("/:id/history", h.NoteHistory).Name = routenames.Notes + ".history"
+	notes.POST("/:id/history/:revision/restore", h.RestoreRevision).Name = routenames.Notes + ".history.restore"
+
+	// Organize notes into notebooks and tags
+	notes.POST("/notebooks", h.CreateNotebook).Name = routenames.Notes + ".notebooks.create"
+	notes.POST("/notebooks/:notebook/edit", h.UpdateNotebook).Name = routenames.Notes + ".notebooks.update"
+	notes.POST("/notebooks/:notebook/delete", h.DeleteNotebook).Name = routenames.Notes + ".notebooks.delete"
+	notes.POST("/bulk", h.BulkUpdateNotes).Name = routenames.Notes + ".bulk"
+
 	// Share note (public access)
 	g.GET("/share/:token", h.ViewSharedNote).Name = routenames.Notes + ".share"
 }
 
-// ListNotes displays the user's notes
+// ListNotes displays the user's notes, in a notebook or with a tag when one is chosen
 func (h *Notes) ListNotes(ctx echo.Context) error {
 	// Get authenticated user
 	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
 	userID := user.ID
+	reqCtx := ctx.Request().Context()
 
 	// Get pagination parameters
 	page := 1
@@ -87,13 +122,43 @@ func (h *Notes) ListNotes(ctx echo.Context) error {
 	limit := 20
 	offset := (page - 1) * limit
 
+	var err error
+	data := pages.NoteListData{Tag: ctx.QueryParam("tag")}
+
+	data.Notebooks, err = h.notesService.ListNotebooks(reqCtx, userID)
+	if err != nil {
+		return fail(err, "failed to fetch notebooks")
+	}
+	data.Choices = notebookChoices(data.Notebooks)
+
+	filter := services.NoteFilter{Tag: data.Tag}
+	if id, err := strconv.Atoi(ctx.QueryParam("notebook")); err == nil {
+		for _, nb := range data.Notebooks {
+			if nb.ID == id {
+				data.Notebook = nb
+				filter.NotebookID = id
+			}
+		}
+		if data.Notebook == nil {
+			return echo.NewHTTPError(404, "Notebook not found")
+		}
+	}
+
+	data.Tags, err = h.notesService.ListTags(reqCtx, userID)
+	if err != nil {
+		return fail(err, "failed to fetch tags")
+	}
+
 	// Fetch user's notes
-	notes, err := h.notesService.ListUserNotes(ctx.Request().Context(), userID, limit, offset)
+	data.Notes, err = h.notesService.ListUserNotes(reqCtx, userID, filter, limit, offset)
 	if err != nil {
 		return fail(err, "failed to fetch notes")
 	}
 
-	return pages.ListNotes(ctx, notes, page)
+	// Thumbnails are shown through signed links, since stored files may not be public
+	services.SignThumbnails(reqCtx, h.container.Storage, services.ThumbnailMedium, data.Notes...)
+
+	return pages.ListNotes(ctx, data)
 }
 
 // CreateNotePage displays the create note form
@@ -114,6 +179,24 @@ func (h *Notes) CreateNotePage(ctx echo.Context) error {
 	createForm.MaxTotalSize = maxTotalSize
 	createForm.MaxFiles = maxFiles
 
+	// Notes can be created in a notebook, starting with its settings
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+	notebooks, err := h.notesService.ListNotebooks(ctx.Request().Context(), user.ID)
+	if err != nil {
+		return fail(err, "failed to fetch notebooks")
+	}
+	createForm.Notebooks = notebookChoices(notebooks)
+
+	if !createForm.IsSubmitted() {
+		createForm.NotebookID, _ = strconv.Atoi(ctx.QueryParam("notebook"))
+		for _, nb := range notebooks {
+			if nb.ID == createForm.NotebookID {
+				createForm.Visibility = nb.DefaultVisibility.String()
+				createForm.PermissionLevel = nb.DefaultPermissionLevel.String()
+			}
+		}
+	}
+
 	return pages.CreateNote(ctx, createForm)
 }
 
@@ -141,14 +224,7 @@ func (h *Notes) CreateNoteSubmit(ctx echo.Context) error {
 		Visibility:      input.Visibility,
 		PermissionLevel: input.PermissionLevel,
 		Resources:       []services.Resource{},
-	}
-
-	// Set defaults
-	if createInput.Visibility == "" {
-		createInput.Visibility = "private"
-	}
-	if createInput.PermissionLevel == "" {
-		createInput.PermissionLevel = "read_only"
+		NotebookID:      input.NotebookID,
 	}
 
 	// Create the note first
@@ -159,6 +235,7 @@ func (h *Notes) CreateNoteSubmit(ctx echo.Context) error {
 	}
 
 	// Process file uploads using task queue for better performance
+	uploaded := 0
 	multipartForm, err := ctx.MultipartForm()
 	if err == nil && multipartForm.File["files"] != nil {
 		// Get file upload limits from configuration
@@ -190,6 +267,12 @@ func (h *Notes) CreateNoteSubmit(ctx echo.Context) error {
 			return h.CreateNotePage(ctx)
 		}
 
+		// Check the user's storage quota
+		if err := h.container.Blobs.CheckQuota(ctx.Request().Context(), userID, totalSize); err != nil {
+			msg.Error(ctx, "Unable to upload files: "+err.Error())
+			return h.CreateNotePage(ctx)
+		}
+
 		for _, fileHeader := range multipartForm.File["files"] {
 			if fileHeader.Size > 0 { // Only process non-empty files
 				// Save file to temporary location
@@ -200,6 +283,13 @@ func (h *Notes) CreateNoteSubmit(ctx echo.Context) error {
 				}
 				defer src.Close()
 
+				// Check the file's content is the type it was uploaded as
+				mimeType, err := services.VerifyContentType(h.container.Config, fileHeader.Filename, fileHeader.Header.Get("Content-Type"), src)
+				if err != nil {
+					msg.Error(ctx, "Unable to upload "+fileHeader.Filename+": "+err.Error())
+					continue
+				}
+
 				// Create temporary file
 				tempFile, err := os.CreateTemp("", "upload_*_"+filepath.Base(fileHeader.Filename))
 				if err != nil {
@@ -223,12 +313,17 @@ func (h *Notes) CreateNoteSubmit(ctx echo.Context) error {
 					UserID:   userID,
 					FileName: fileHeader.Filename,
 					FileSize: fileHeader.Size,
-					MimeType: fileHeader.Header.Get("Content-Type"),
+					MimeType: mimeType,
 					TempPath: tempPath,
 				}
 
 				// Queue the file upload task
-				h.container.Tasks.Add(fileUploadTask)
+				if err := h.container.Tasks.Add(fileUploadTask).Save(); err != nil {
+					os.Remove(tempPath)
+					msg.Error(ctx, "Failed to queue upload of "+fileHeader.Filename+": "+err.Error())
+				} else {
+					uploaded++
+				}
 			}
 		}
 	}
@@ -245,6 +340,10 @@ func (h *Notes) CreateNoteSubmit(ctx echo.Context) error {
 		}
 	}
 
+	if createdNote.Content != "" || uploaded > 0 {
+		h.queueCurriculum(ctx, createdNote.ID, uploaded)
+	}
+
 	form.Clear(ctx)
 
 	// Set success message and redirect to the created note
@@ -273,7 +372,350 @@ func (h *Notes) ViewNote(ctx echo.Context) error {
 		return echo.NewHTTPError(404, "Note not found")
 	}
 
-	return pages.ViewNote(ctx, note)
+	// Attach the library documents the user can see
+	note.Edges.Documents, err = h.container.Documents.ListNoteDocuments(ctx.Request().Context(), noteID, userID)
+	if err != nil {
+		return fail(err, "failed to fetch note documents")
+	}
+
+	// Fetch the user's practice sets for this note
+	var practiceSets []*ent.Quiz
+	if userID != nil {
+		practiceSets, err = h.container.Quiz.ListPracticeSets(ctx.Request().Context(), noteID, *userID)
+		if err != nil {
+			return fail(err, "failed to fetch practice sets")
+		}
+	}
+
+	// Owners see the changes waiting for their approval, and other users the changes they proposed
+	var proposals []*ent.NoteProposal
+	if userID != nil {
+		if note.Edges.Owner != nil && note.Edges.Owner.ID == *userID {
+			proposals, err = h.notesService.ListProposals(ctx.Request().Context(), noteID, *userID)
+		} else {
+			proposals, err = h.notesService.ListUserProposals(ctx.Request().Context(), noteID, *userID, 5)
+		}
+		if err != nil {
+			return fail(err, "failed to fetch proposed changes")
+		}
+	}
+
+	// Wiki links are rendered as links to the notes they point to
+	note.Edges.Links, err = h.notesService.ListLinks(ctx.Request().Context(), noteID, userID)
+	if err != nil {
+		return fail(err, "failed to fetch note links")
+	}
+
+	content, err := h.container.Markdown.RenderNote(ctx.Request().Context(), note, h.noteURL(ctx))
+	if err != nil {
+		return fail(err, "failed to render note")
+	}
+
+	backlinks, err := h.notesService.ListBacklinks(ctx.Request().Context(), noteID, userID)
+	if err != nil {
+		return fail(err, "failed to fetch backlinks")
+	}
+
+	// Record the view towards the user's learning progress
+	var view *ent.StudyEvent
+	if userID != nil {
+		view, err = h.container.Progress.RecordNoteView(ctx.Request().Context(), *userID, note)
+		if err != nil {
+			log.Ctx(ctx).Error("failed to record note view", "note_id", note.ID, "error", err)
+		}
+	}
+
+	services.SignResources(ctx.Request().Context(), h.container.Storage, note)
+
+	return pages.ViewNote(ctx, note, content, practiceSets, proposals, backlinks, view)
+}
+
+// GeneratePracticeSet queues a task to generate a practice set from a note
+func (h *Notes) GeneratePracticeSet(ctx echo.Context) error {
+	noteID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(404, "Note not found")
+	}
+
+	// Get authenticated user
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+	userID := user.ID
+
+	// Make sure the user can access the note before queueing
+	if _, err = h.notesService.GetNote(ctx.Request().Context(), noteID, &userID); err != nil {
+		return echo.NewHTTPError(404, "Note not found")
+	}
+
+	err = h.container.Tasks.
+		Add(tasks.PracticeSetTask{
+			NoteID: noteID,
+			UserID: userID,
+		}).
+		Save()
+	if err != nil {
+		msg.Error(ctx, "Failed to queue practice set generation: "+err.Error())
+	} else {
+		msg.Success(ctx, "Generating your practice set. Refresh the page in a moment to see it.")
+	}
+
+	// Redirect back to the note
+	return ctx.Redirect(302, ctx.Echo().Reverse(routenames.Notes+".view", noteID))
+}
+
+// GenerateCurriculum queues a task to rebuild the AI curriculum of a note the user owns
+func (h *Notes) GenerateCurriculum(ctx echo.Context) error {
+	noteID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(404, "Note not found")
+	}
+
+	// Get authenticated user
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+	userID := user.ID
+
+	n, err := h.notesService.GetNote(ctx.Request().Context(), noteID, &userID)
+	if err != nil || n.Edges.Owner == nil || n.Edges.Owner.ID != userID {
+		return echo.NewHTTPError(404, "Note not found")
+	}
+
+	switch {
+	case !h.container.Curriculum.Enabled():
+		msg.Error(ctx, "AI curriculum generation is not enabled.")
+	case n.AiProcessing:
+		msg.Info(ctx, "A curriculum is already being generated for this note.")
+	case h.queueCurriculum(ctx, noteID, 0):
+		msg.Success(ctx, "Generating a curriculum. Refresh the page in a moment to see it.")
+	default:
+		msg.Error(ctx, "Failed to queue curriculum generation.")
+	}
+
+	return ctx.Redirect(302, ctx.Echo().Reverse(routenames.Notes+".view", noteID))
+}
+
+// queueCurriculum marks a note as processing and queues a task to build its AI curriculum, if
+// AI is enabled. When files were just uploaded, it waits for their text to be extracted first.
+// It reports whether the task was queued.
+func (h *Notes) queueCurriculum(ctx echo.Context, noteID, uploaded int) bool {
+	if !h.container.Curriculum.Enabled() {
+		return false
+	}
+
+	if err := h.container.Curriculum.Start(ctx.Request().Context(), noteID); err != nil {
+		log.Ctx(ctx).Error("failed to start curriculum", "note_id", noteID, "error", err)
+		return false
+	}
+
+	var wait time.Duration
+	if uploaded > 0 {
+		wait = curriculumUploadDelay
+	}
+	err := h.container.Tasks.
+		Add(tasks.CurriculumTask{NoteID: noteID}).
+		Wait(wait).
+		Save()
+	if err != nil {
+		log.Ctx(ctx).Error("failed to queue curriculum", "note_id", noteID, "error", err)
+		if err := h.container.Curriculum.Fail(ctx.Request().Context(), noteID); err != nil {
+			log.Ctx(ctx).Error("failed to clear note processing", "note_id", noteID, "error", err)
+		}
+		return false
+	}
+
+	return true
+}
+
+// ViewProposal shows a proposed change to a note as a diff against the note
+func (h *Notes) ViewProposal(ctx echo.Context) error {
+	noteID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(404, "Note not found")
+	}
+	proposalID, err := strconv.Atoi(ctx.Param("proposal"))
+	if err != nil {
+		return echo.NewHTTPError(404, "Proposed change not found")
+	}
+
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	proposal, err := h.notesService.GetProposal(ctx.Request().Context(), noteID, proposalID, user.ID)
+	switch {
+	case errors.Is(err, services.ErrProposalNotFound):
+		return echo.NewHTTPError(404, err.Error())
+	case err != nil:
+		return fail(err, "failed to fetch proposed change")
+	}
+
+	return pages.NoteProposal(ctx, proposal)
+}
+
+// AcceptProposal applies a proposed change to a note
+func (h *Notes) AcceptProposal(ctx echo.Context) error {
+	return h.reviewProposal(ctx, true)
+}
+
+// RejectProposal discards a proposed change to a note
+func (h *Notes) RejectProposal(ctx echo.Context) error {
+	return h.reviewProposal(ctx, false)
+}
+
+func (h *Notes) reviewProposal(ctx echo.Context, accept bool) error {
+	noteID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(404, "Note not found")
+	}
+	proposalID, err := strconv.Atoi(ctx.Param("proposal"))
+	if err != nil {
+		return echo.NewHTTPError(404, "Proposed change not found")
+	}
+
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	proposal, err := h.notesService.ReviewProposal(ctx.Request().Context(), noteID, proposalID, user.ID, accept, time.Now())
+	switch {
+	case errors.Is(err, services.ErrProposalNotFound):
+		return echo.NewHTTPError(404, err.Error())
+	case errors.Is(err, services.ErrProposalReviewed):
+		msg.Warning(ctx, "This change has already been reviewed.")
+		return ctx.Redirect(302, ctx.Echo().Reverse(routenames.Notes+".view", noteID))
+	case errors.Is(err, services.ErrProposalStale):
+		msg.Warning(ctx, "The note has changed since this change was proposed, so it can't be applied. Reject it, and the contributor can propose it again.")
+		return ctx.Redirect(302, ctx.Echo().Reverse(routenames.Notes+".view", noteID))
+	case err != nil:
+		return fail(err, "failed to review proposed change")
+	}
+
+	// Let the proposer know either way
+	if err := h.container.Tasks.Add(tasks.NoteProposalReviewedTask{ProposalID: proposal.ID}).Save(); err != nil {
+		log.Ctx(ctx).Error("failed to queue proposal notification", "proposal_id", proposal.ID, "error", err)
+	}
+
+	if accept {
+		if proposal.Content != proposal.Edges.Note.Content {
+			h.queueCurriculum(ctx, noteID, 0)
+		}
+		msg.Success(ctx, "The change was applied to your note.")
+	} else {
+		msg.Info(ctx, "The change was rejected.")
+	}
+
+	return ctx.Redirect(302, ctx.Echo().Reverse(routenames.Notes+".view", noteID))
+}
+
+// PreviewContent renders the content being written in the note editor. Wiki links are resolved
+// for the user writing them, so links that don't match a note are flagged as they're typed.
+func (h *Notes) PreviewContent(ctx echo.Context) error {
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+	noteURL := h.noteURL(ctx)
+
+	var resolveErr error
+	content, err := h.container.Markdown.Render(ctx.FormValue("content"), func(link services.WikiLink) (string, string, bool) {
+		target, err := h.notesService.ResolveWikiLink(ctx.Request().Context(), user.ID, link)
+		if err != nil {
+			resolveErr = err
+		}
+		if target == nil {
+			return "", "", false
+		}
+		return noteURL(target.ID), target.Title, true
+	})
+	if err == nil {
+		err = resolveErr
+	}
+	if err != nil {
+		return fail(err, "failed to render preview")
+	}
+
+	return pages.NoteContentPreview(ctx, content)
+}
+
+// noteURL returns a function that links to the page of a note
+func (h *Notes) noteURL(ctx echo.Context) func(id int) string {
+	return func(id int) string {
+		return ctx.Echo().Reverse(routenames.Notes+".view", id)
+	}
+}
+
+// NoteHistory shows the revisions of a note and the changes between two of them, which default
+// to the latest revision and the one before it
+func (h *Notes) NoteHistory(ctx echo.Context) error {
+	noteID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(404, "Note not found")
+	}
+
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+	userID := user.ID
+
+	note, err := h.notesService.GetNote(ctx.Request().Context(), noteID, &userID)
+	if err != nil {
+		return echo.NewHTTPError(404, "Note not found")
+	}
+
+	revisions, err := h.notesService.ListRevisions(ctx.Request().Context(), noteID, userID)
+	switch {
+	case errors.Is(err, services.ErrNoteEditDenied):
+		return echo.NewHTTPError(403, "Access denied")
+	case err != nil:
+		return fail(err, "failed to fetch note history")
+	}
+
+	var from, to *ent.NoteRevision
+	if len(revisions) > 0 {
+		to = revisions[0]
+	}
+	if len(revisions) > 1 {
+		from = revisions[1]
+	}
+	for _, rev := range revisions {
+		if ctx.QueryParam("from") == strconv.Itoa(rev.ID) {
+			from = rev
+		}
+		if ctx.QueryParam("to") == strconv.Itoa(rev.ID) {
+			to = rev
+		}
+	}
+
+	return pages.NoteHistory(ctx, note, revisions, from, to)
+}
+
+// RestoreRevision sets a note back to one of its earlier revisions
+func (h *Notes) RestoreRevision(ctx echo.Context) error {
+	noteID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(404, "Note not found")
+	}
+	revisionID, err := strconv.Atoi(ctx.Param("revision"))
+	if err != nil {
+		return echo.NewHTTPError(404, "Revision not found")
+	}
+
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+	userID := user.ID
+
+	previous, err := h.notesService.GetNote(ctx.Request().Context(), noteID, &userID)
+	if err != nil {
+		return echo.NewHTTPError(404, "Note not found")
+	}
+
+	restored, err := h.notesService.RestoreRevision(ctx.Request().Context(), noteID, revisionID, userID)
+	switch {
+	case errors.Is(err, services.ErrRevisionNotFound):
+		return echo.NewHTTPError(404, err.Error())
+	case errors.Is(err, services.ErrNoteEditDenied):
+		return echo.NewHTTPError(403, "Access denied")
+	case errors.Is(err, services.ErrNoteNeedsApproval):
+		msg.Warning(ctx, "Changes to this note need the owner's approval, so earlier revisions can't be restored directly.")
+		return ctx.Redirect(302, ctx.Echo().Reverse(routenames.Notes+".history", noteID))
+	case err != nil:
+		return fail(err, "failed to restore revision")
+	}
+
+	if restored.Content != previous.Content {
+		h.queueCurriculum(ctx, noteID, 0)
+	}
+
+	msg.Success(ctx, "The note was restored to an earlier revision.")
+	return ctx.Redirect(302, ctx.Echo().Reverse(routenames.Notes+".view", noteID))
 }
 
 // LikeNote handles liking a note
@@ -384,7 +826,29 @@ func (h *Notes) ViewSharedNote(ctx echo.Context) error {
 		return echo.NewHTTPError(404, "Note not found")
 	}
 
-	return pages.ViewNote(ctx, note)
+	note.Edges.Documents, err = h.container.Documents.ListNoteDocuments(ctx.Request().Context(), note.ID, nil)
+	if err != nil {
+		return fail(err, "failed to fetch note documents")
+	}
+
+	note.Edges.Links, err = h.notesService.ListLinks(ctx.Request().Context(), note.ID, nil)
+	if err != nil {
+		return fail(err, "failed to fetch note links")
+	}
+
+	content, err := h.container.Markdown.RenderNote(ctx.Request().Context(), note, h.noteURL(ctx))
+	if err != nil {
+		return fail(err, "failed to render note")
+	}
+
+	backlinks, err := h.notesService.ListBacklinks(ctx.Request().Context(), note.ID, nil)
+	if err != nil {
+		return fail(err, "failed to fetch backlinks")
+	}
+
+	services.SignResources(ctx.Request().Context(), h.container.Storage, note)
+
+	return pages.ViewNote(ctx, note, content, nil, nil, backlinks, nil)
 }
 
 // EditNotePage displays the edit note form
@@ -404,13 +868,16 @@ func (h *Notes) EditNotePage(ctx echo.Context) error {
 		return echo.NewHTTPError(404, "Note not found")
 	}
 
-	// Check if user owns the note
-	if note.Edges.Owner.ID != userID {
+	// Check if user can edit the note
+	mode := services.EditModeFor(note, userID)
+	if mode == services.NoteEditNone {
 		return echo.NewHTTPError(403, "Access denied")
 	}
 
 	// Populate form with existing data
 	editForm := form.Get[forms.EditNote](ctx)
+	editForm.Collaborator = mode != services.NoteEditOwner
+	editForm.NeedsApproval = mode == services.NoteEditPropose
 	if !editForm.IsSubmitted() {
 		editForm.ID = note.ID
 		editForm.Title = note.Title
@@ -429,6 +896,17 @@ func (h *Notes) EditNotePage(ctx echo.Context) error {
 		editForm.ResourceURLs = resourceURLs
 	}
 
+	// Links that don't point to a note the user can see are flagged
+	links, err := h.notesService.ListLinks(ctx.Request().Context(), noteID, &userID)
+	if err != nil {
+		return fail(err, "failed to fetch note links")
+	}
+	for _, l := range links {
+		if l.Edges.Note == nil {
+			editForm.BrokenLinks = append(editForm.BrokenLinks, l.Target)
+		}
+	}
+
 	// Set file upload limits from configuration
 	maxFileSize, maxTotalSize, maxFiles, err := services.GetFileUploadLimits(h.container.Config)
 	if err != nil {
@@ -479,6 +957,22 @@ func (h *Notes) EditNoteSubmit(ctx echo.Context) error {
 		PermissionLevel: &input.PermissionLevel,
 	}
 
+	// The curriculum is rebuilt when the material it covers changes
+	previous, err := h.notesService.GetNote(ctx.Request().Context(), noteID, &userID)
+	if err != nil {
+		return echo.NewHTTPError(404, "Note not found")
+	}
+
+	// Other users' changes to notes that need approval are sent to the owner
+	mode := services.EditModeFor(previous, userID)
+	if mode == services.NoteEditPropose {
+		return h.proposeChange(ctx, noteID, userID, services.ProposalInput{
+			Title:       title,
+			Description: description,
+			Content:     content,
+		})
+	}
+
 	// Update the note
 	updatedNote, err := h.notesService.UpdateNote(ctx.Request().Context(), noteID, userID, updateInput)
 	if err != nil {
@@ -486,7 +980,18 @@ func (h *Notes) EditNoteSubmit(ctx echo.Context) error {
 		return h.EditNotePage(ctx)
 	}
 
+	// Only the owner can change the note's resources
+	if mode != services.NoteEditOwner {
+		if updatedNote.Content != previous.Content {
+			h.queueCurriculum(ctx, updatedNote.ID, 0)
+		}
+		form.Clear(ctx)
+		msg.Success(ctx, "Note updated successfully!")
+		return h.redirectToNote(ctx, updatedNote.ID)
+	}
+
 	// Process file uploads using task queue for better performance
+	uploaded := 0
 	multipartForm, err := ctx.MultipartForm()
 	if err == nil && multipartForm.File["files"] != nil {
 		// Get file upload limits from configuration
@@ -519,6 +1024,12 @@ func (h *Notes) EditNoteSubmit(ctx echo.Context) error {
 			return h.EditNotePage(ctx)
 		}
 
+		// Check the user's storage quota
+		if err := h.container.Blobs.CheckQuota(ctx.Request().Context(), userID, totalSize); err != nil {
+			msg.Error(ctx, "Unable to upload files: "+err.Error())
+			return h.EditNotePage(ctx)
+		}
+
 		// Process each file
 		for _, fileHeader := range multipartForm.File["files"] {
 			// Create temporary file
@@ -533,6 +1044,13 @@ func (h *Notes) EditNoteSubmit(ctx echo.Context) error {
 			}
 			defer src.Close()
 
+			// Check the file's content is the type it was uploaded as
+			mimeType, err := services.VerifyContentType(h.container.Config, fileHeader.Filename, fileHeader.Header.Get("Content-Type"), src)
+			if err != nil {
+				msg.Error(ctx, "Unable to upload "+fileHeader.Filename+": "+err.Error())
+				continue
+			}
+
 			// Create temporary file
 			tempFile, err := os.Create(tempPath)
 			if err != nil {
@@ -555,12 +1073,17 @@ func (h *Notes) EditNoteSubmit(ctx echo.Context) error {
 				UserID:   userID,
 				FileName: fileHeader.Filename,
 				FileSize: fileHeader.Size,
-				MimeType: fileHeader.Header.Get("Content-Type"),
+				MimeType: mimeType,
 				TempPath: tempPath,
 			}
 
 			// Queue the file upload task
-			h.container.Tasks.Add(fileUploadTask)
+			if err := h.container.Tasks.Add(fileUploadTask).Save(); err != nil {
+				os.Remove(tempPath)
+				msg.Error(ctx, "Failed to queue upload of "+fileHeader.Filename+": "+err.Error())
+			} else {
+				uploaded++
+			}
 		}
 	}
 
@@ -576,11 +1099,37 @@ func (h *Notes) EditNoteSubmit(ctx echo.Context) error {
 		}
 	}
 
+	if updatedNote.Content != previous.Content || uploaded > 0 {
+		h.queueCurriculum(ctx, updatedNote.ID, uploaded)
+	}
+
 	// Clear form before redirect
 	form.Clear(ctx)
 
+	return h.redirectToNote(ctx, updatedNote.ID)
+}
+
+// proposeChange sends a user's change to a note to its owner for approval
+func (h *Notes) proposeChange(ctx echo.Context, noteID, userID int, input services.ProposalInput) error {
+	_, err := h.notesService.ProposeChange(ctx.Request().Context(), noteID, userID, input)
+	switch {
+	case errors.Is(err, services.ErrProposalEmpty):
+		msg.Info(ctx, "You haven't changed anything yet.")
+		return h.EditNotePage(ctx)
+	case err != nil:
+		msg.Error(ctx, "Failed to propose change: "+err.Error())
+		return h.EditNotePage(ctx)
+	}
+
+	form.Clear(ctx)
+	msg.Success(ctx, "Your change was sent to the note's owner for approval.")
+	return h.redirectToNote(ctx, noteID)
+}
+
+// redirectToNote redirects to a note after its form is submitted
+func (h *Notes) redirectToNote(ctx echo.Context, noteID int) error {
 	// Handle HTMX redirect
-	redirectURL := ctx.Echo().Reverse(routenames.Notes+".view", updatedNote.ID)
+	redirectURL := ctx.Echo().Reverse(routenames.Notes+".view", noteID)
 	if ctx.Request().Header.Get("HX-Request") == "true" {
 		// For HTMX requests, use HX-Redirect header
 		ctx.Response().Header().Set("HX-Redirect", redirectURL)
@@ -614,3 +1163,157 @@ func (h *Notes) DeleteNote(ctx echo.Context) error {
 	// Redirect to notes list
 	return ctx.Redirect(302, ctx.Echo().Reverse(routenames.Notes))
 }
+
+// CreateNotebook creates a notebook, inside the notebook being browsed when there is one
+func (h *Notes) CreateNotebook(ctx echo.Context) error {
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+	parentID, _ := strconv.Atoi(ctx.FormValue("parent_id"))
+
+	nb, err := h.notesService.CreateNotebook(ctx.Request().Context(), user.ID, services.NotebookInput{
+		Name:                   ctx.FormValue("name"),
+		ParentID:               parentID,
+		DefaultVisibility:      ctx.FormValue("default_visibility"),
+		DefaultPermissionLevel: ctx.FormValue("default_permission_level"),
+	})
+	if err != nil {
+		msg.Error(ctx, "Failed to create notebook: "+err.Error())
+		return ctx.Redirect(302, notesListURL(ctx, parentID, ""))
+	}
+
+	return ctx.Redirect(302, notesListURL(ctx, nb.ID, ""))
+}
+
+// UpdateNotebook renames or moves a notebook and changes the defaults of new notes in it
+func (h *Notes) UpdateNotebook(ctx echo.Context) error {
+	notebookID, err := strconv.Atoi(ctx.Param("notebook"))
+	if err != nil {
+		return echo.NewHTTPError(404, "Notebook not found")
+	}
+
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+	parentID, _ := strconv.Atoi(ctx.FormValue("parent_id"))
+
+	_, err = h.notesService.UpdateNotebook(ctx.Request().Context(), notebookID, user.ID, services.NotebookInput{
+		Name:                   ctx.FormValue("name"),
+		ParentID:               parentID,
+		DefaultVisibility:      ctx.FormValue("default_visibility"),
+		DefaultPermissionLevel: ctx.FormValue("default_permission_level"),
+	})
+	if err != nil {
+		msg.Error(ctx, "Failed to update notebook: "+err.Error())
+	} else {
+		msg.Success(ctx, "Notebook saved.")
+	}
+
+	return ctx.Redirect(302, notesListURL(ctx, notebookID, ""))
+}
+
+// DeleteNotebook deletes a notebook, keeping its notes
+func (h *Notes) DeleteNotebook(ctx echo.Context) error {
+	notebookID, err := strconv.Atoi(ctx.Param("notebook"))
+	if err != nil {
+		return echo.NewHTTPError(404, "Notebook not found")
+	}
+
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	if err := h.notesService.DeleteNotebook(ctx.Request().Context(), notebookID, user.ID); err != nil {
+		if errors.Is(err, services.ErrNotebookNotFound) {
+			return echo.NewHTTPError(404, "Notebook not found")
+		}
+		return fail(err, "failed to delete notebook")
+	}
+
+	msg.Success(ctx, "Notebook deleted. Its notes were kept.")
+	return ctx.Redirect(302, ctx.Echo().Reverse(routenames.Notes))
+}
+
+// BulkUpdateNotes moves the selected notes to a notebook, or adds or removes tags from them
+func (h *Notes) BulkUpdateNotes(ctx echo.Context) error {
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+	reqCtx := ctx.Request().Context()
+
+	params, err := ctx.FormParams()
+	if err != nil {
+		return echo.NewHTTPError(400, "Invalid form")
+	}
+
+	var noteIDs []int
+	for _, v := range params["note_ids"] {
+		if id, err := strconv.Atoi(v); err == nil {
+			noteIDs = append(noteIDs, id)
+		}
+	}
+
+	// The list the notes were chosen from is shown again afterwards
+	currentID, _ := strconv.Atoi(ctx.FormValue("notebook"))
+	redirect := notesListURL(ctx, currentID, ctx.FormValue("tag"))
+
+	var done string
+	switch ctx.FormValue("action") {
+	case "move":
+		notebookID, _ := strconv.Atoi(ctx.FormValue("notebook_id"))
+		err = h.notesService.MoveNotes(reqCtx, user.ID, noteIDs, notebookID)
+		done = "Notes moved."
+	case "tag":
+		err = h.notesService.TagNotes(reqCtx, user.ID, noteIDs, services.ParseTags(ctx.FormValue("tags")))
+		done = "Tags added."
+	case "untag":
+		err = h.notesService.UntagNotes(reqCtx, user.ID, noteIDs, services.ParseTags(ctx.FormValue("tags")))
+		done = "Tags removed."
+	default:
+		msg.Error(ctx, "Choose what to do with the selected notes.")
+		return ctx.Redirect(302, redirect)
+	}
+
+	if err != nil {
+		msg.Error(ctx, "Failed to update notes: "+err.Error())
+	} else {
+		msg.Success(ctx, done)
+	}
+
+	return ctx.Redirect(302, redirect)
+}
+
+// notesListURL links to the user's notes, in a notebook or with a tag when they're set
+func notesListURL(ctx echo.Context, notebookID int, tag string) string {
+	query := url.Values{}
+	if notebookID != 0 {
+		query.Set("notebook", strconv.Itoa(notebookID))
+	}
+	if tag != "" {
+		query.Set("tag", tag)
+	}
+
+	path := ctx.Echo().Reverse(routenames.Notes)
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+	return path
+}
+
+// notebookChoices lists notebooks for a select, with each notebook's path as its label
+func notebookChoices(notebooks []*ent.Notebook) []forms.NotebookChoice {
+	parents := make(map[int]*ent.Notebook)
+	for _, nb := range notebooks {
+		parents[nb.ID] = nb.Edges.Parent
+	}
+
+	choices := make([]forms.NotebookChoice, 0, len(notebooks))
+	for _, nb := range notebooks {
+		path := nb.Name
+		for p := nb.Edges.Parent; p != nil; p = parents[p.ID] {
+			path = p.Name + " / " + path
+		}
+		choices = append(choices, forms.NotebookChoice{
+			Choice:          components.Choice{Value: strconv.Itoa(nb.ID), Label: path},
+			Visibility:      nb.DefaultVisibility.String(),
+			PermissionLevel: nb.DefaultPermissionLevel.String(),
+		})
+	}
+	sort.Slice(choices, func(i, j int) bool {
+		return choices[i].Label < choices[j].Label
+	})
+
+	return choices
+}
@@ -1,23 +1,24 @@
 package handlers
 
 import (
-	"fmt"
+	"errors"
 	"io"
-	"os"
-	"path/filepath"
-	"strings"
+	"slices"
 	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/labstack/echo/v4"
 	"github.com/r-scheele/zero/ent"
+	"github.com/r-scheele/zero/ent/blobreference"
 	"github.com/r-scheele/zero/pkg/context"
 	"github.com/r-scheele/zero/pkg/form"
+	"github.com/r-scheele/zero/pkg/log"
 	"github.com/r-scheele/zero/pkg/middleware"
 	"github.com/r-scheele/zero/pkg/msg"
 	"github.com/r-scheele/zero/pkg/redirect"
 	"github.com/r-scheele/zero/pkg/routenames"
 	"github.com/r-scheele/zero/pkg/services"
+	"github.com/r-scheele/zero/pkg/ui"
 	"github.com/r-scheele/zero/pkg/ui/forms"
 	"github.com/r-scheele/zero/pkg/ui/pages"
 	"golang.org/x/crypto/bcrypt"
@@ -48,7 +49,11 @@ func (h *Profile) Routes(g *echo.Group) {
 	profileGroup.GET("/change-password", h.ChangePasswordPage).Name = routenames.ProfileChangePassword
 	profileGroup.POST("/change-password", h.ChangePasswordSubmit)
 	profileGroup.GET("/deactivate", h.DeactivateAccountPage).Name = routenames.ProfileDeactivate
+	profileGroup.GET("/storage", h.StoragePage).Name = routenames.ProfileStorage
 	profileGroup.POST("/deactivate", h.DeactivateAccountSubmit)
+
+	// The picture is shown in the navigation on every page, so it isn't limited to verified users
+	g.GET("/profile/picture/image", h.ProfilePictureImage, middleware.RequireAuthentication).Name = routenames.ProfilePictureImage
 }
 
 func (h *Profile) ProfilePage(ctx echo.Context) error {
@@ -183,6 +188,22 @@ func (h *Profile) ProfilePicturePage(ctx echo.Context) error {
 	return pages.ProfilePicture(ctx, form.Get[forms.ProfilePicture](ctx), u)
 }
 
+// ProfilePictureImage redirects to a signed URL of the user's profile picture, for pictures kept
+// in a storage backend
+func (h *Profile) ProfilePictureImage(ctx echo.Context) error {
+	u := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+	if u.ProfilePicture == nil || !ui.StoredProfilePicture(*u.ProfilePicture) {
+		return echo.NewHTTPError(404, "Profile picture not found")
+	}
+
+	url, err := h.container.Storage.GetSignedURL(ctx.Request().Context(), *u.ProfilePicture, int64(services.ThumbnailLinkTTL/time.Second))
+	if err != nil {
+		return fail(err, "failed to sign profile picture URL")
+	}
+
+	return ctx.Redirect(302, url)
+}
+
 func (h *Profile) ProfilePictureSubmit(ctx echo.Context) error {
 	userValue := ctx.Get(context.AuthenticatedUserKey)
 	if userValue == nil {
@@ -212,18 +233,21 @@ func (h *Profile) ProfilePictureSubmit(ctx echo.Context) error {
 		return h.ProfilePicturePage(ctx)
 	}
 
-	// Validate file type
-	if !strings.HasPrefix(file.Header.Get("Content-Type"), "image/") {
-		input.SetFieldError("Picture", "Please upload a valid image file")
-		return h.ProfilePicturePage(ctx)
-	}
-
 	// Validate file size (5MB max)
 	if file.Size > 5*1024*1024 {
 		input.SetFieldError("Picture", "Image file must be smaller than 5MB")
 		return h.ProfilePicturePage(ctx)
 	}
 
+	err = h.container.Blobs.CheckQuota(ctx.Request().Context(), u.ID, file.Size)
+	switch {
+	case errors.Is(err, services.ErrQuotaExceeded):
+		input.SetFieldError("Picture", "Not enough storage space: "+err.Error())
+		return h.ProfilePicturePage(ctx)
+	case err != nil:
+		return fail(err, "failed to check storage quota")
+	}
+
 	// Open the uploaded file
 	src, err := file.Open()
 	if err != nil {
@@ -232,52 +256,63 @@ func (h *Profile) ProfilePictureSubmit(ctx echo.Context) error {
 	}
 	defer src.Close()
 
-	// Create uploads directory if it doesn't exist
-	uploadsDir := "uploads"
-	if err := os.MkdirAll(uploadsDir, 0755); err != nil {
-		msg.Error(ctx, "Failed to save the image. Please try again.")
+	// The picture's type is worked out from its content, and must be a raster image
+	mimeType, err := services.VerifyContentType(h.container.Config, file.Filename, file.Header.Get("Content-Type"), src)
+	switch {
+	case errors.Is(err, services.ErrContentTypeMismatch), errors.Is(err, services.ErrFileTypeNotAllowed),
+		err == nil && !slices.Contains(services.ProfilePictureTypes, mimeType):
+		input.SetFieldError("Picture", "Please upload a PNG, JPEG, GIF or WebP image")
+		return h.ProfilePicturePage(ctx)
+	case err != nil:
+		msg.Error(ctx, "Failed to process the uploaded image. Please try again.")
 		return h.ProfilePicturePage(ctx)
 	}
 
-	// Generate unique filename
-	ext := filepath.Ext(file.Filename)
-	filename := fmt.Sprintf("profile_%d_%d%s", u.ID, time.Now().Unix(), ext)
-	filePath := filepath.Join(uploadsDir, filename)
-
-	// Create the destination file
-	dst, err := os.Create(filePath)
-	if err != nil {
-		msg.Error(ctx, "Failed to save the image. Please try again.")
-		return h.ProfilePicturePage(ctx)
+	// Pictures are scanned for viruses before they're saved
+	if h.container.Scanner != nil {
+		result, err := h.container.Scanner.Scan(ctx.Request().Context(), src)
+		if err != nil {
+			log.Ctx(ctx).Error("failed to scan profile picture", "error", err)
+			msg.Error(ctx, "Unable to scan the image right now. Please try again later.")
+			return h.ProfilePicturePage(ctx)
+		}
+		if !result.Clean {
+			input.SetFieldError("Picture", "The image was rejected because it contains "+result.Threat)
+			return h.ProfilePicturePage(ctx)
+		}
+		if _, err := src.Seek(0, io.SeekStart); err != nil {
+			msg.Error(ctx, "Failed to process the uploaded image. Please try again.")
+			return h.ProfilePicturePage(ctx)
+		}
 	}
-	defer dst.Close()
 
-	// Copy the uploaded file to the destination
-	if _, err = io.Copy(dst, src); err != nil {
+	// Store the picture by its content, so re-uploading the same picture doesn't store it again
+	ref := services.BlobRef{OwnerID: u.ID, Kind: blobreference.KindProfile, TargetID: u.ID}
+	stored, err := h.container.Blobs.Store(ctx.Request().Context(), ref, file.Filename, src, mimeType)
+	switch {
+	case errors.Is(err, services.ErrQuotaExceeded):
+		input.SetFieldError("Picture", "Not enough storage space: "+err.Error())
+		return h.ProfilePicturePage(ctx)
+	case err != nil:
+		log.Ctx(ctx).Error("failed to store profile picture", "error", err)
 		msg.Error(ctx, "Failed to save the image. Please try again.")
 		return h.ProfilePicturePage(ctx)
 	}
 
-	// Delete old profile picture if exists
-	if u.ProfilePicture != nil && *u.ProfilePicture != "" {
-		oldPath := filepath.Join(uploadsDir, *u.ProfilePicture)
-		if err := os.Remove(oldPath); err != nil {
-			// Log but don't fail - old file might not exist
-			fmt.Printf("Warning: failed to delete old profile picture: %v\n", err)
-		}
-	}
-
-	// Update user profile picture in database
+	// The picture is kept by its key, since links to stored files are signed when they're shown
 	_, err = h.orm.User.UpdateOneID(u.ID).
-		SetProfilePicture(filename).
+		SetProfilePicture(stored.StorageKey).
 		Save(ctx.Request().Context())
 	if err != nil {
-		// Try to clean up the uploaded file
-		os.Remove(filePath)
 		msg.Error(ctx, "Failed to update profile picture. Please try again.")
 		return h.ProfilePicturePage(ctx)
 	}
 
+	// Release the previous picture, deleting it unless someone else uploaded the same one
+	if err := h.container.Blobs.ReleaseAll(ctx.Request().Context(), ref.Kind, u.ID, stored.StorageKey); err != nil {
+		log.Ctx(ctx).Error("failed to release previous profile picture", "error", err)
+	}
+
 	msg.Success(ctx, "Profile picture updated successfully!")
 	return redirect.New(ctx).Route(routenames.Profile).Go()
 }
@@ -349,6 +384,20 @@ func (h *Profile) DeactivateAccountPage(ctx echo.Context) error {
 	return pages.DeactivateAccount(ctx, form.Get[forms.DeactivateAccount](ctx))
 }
 
+func (h *Profile) StoragePage(ctx echo.Context) error {
+	u, ok := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+	if !ok || u == nil {
+		return echo.NewHTTPError(401, "User not authenticated")
+	}
+
+	usage, err := h.container.Blobs.Usage(ctx.Request().Context(), u.ID)
+	if err != nil {
+		return fail(err, "failed to load storage usage")
+	}
+
+	return pages.Storage(ctx, usage)
+}
+
 func (h *Profile) DeactivateAccountSubmit(ctx echo.Context) error {
 	userValue := ctx.Get(context.AuthenticatedUserKey)
 	if userValue == nil {
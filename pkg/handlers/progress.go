@@ -1,9 +1,20 @@
 package handlers
 
 import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
 	"github.com/labstack/echo/v4"
+	"github.com/r-scheele/zero/ent"
+	"github.com/r-scheele/zero/pkg/context"
+	"github.com/r-scheele/zero/pkg/log"
 	"github.com/r-scheele/zero/pkg/middleware"
+	"github.com/r-scheele/zero/pkg/msg"
 	"github.com/r-scheele/zero/pkg/services"
+	"github.com/r-scheele/zero/pkg/tasks"
 	"github.com/r-scheele/zero/pkg/ui/pages"
 )
 
@@ -23,29 +34,145 @@ func (h *Progress) Init(c *services.Container) error {
 func (h *Progress) Routes(g *echo.Group) {
 	// Progress routes (require authentication and verification)
 	progress := g.Group("/progress", middleware.RequireAuthentication, middleware.RequireVerification)
-	
+
 	// View progress dashboard
 	progress.GET("", h.ViewProgress).Name = "progress.view"
-	
+
 	// View detailed analytics
 	progress.GET("/analytics", h.ViewAnalytics).Name = "progress.analytics"
-	
+
 	// Export progress report
 	progress.GET("/export", h.ExportProgress).Name = "progress.export"
+
+	// Download an export prepared in the background
+	progress.GET("/export/:file", h.DownloadExport).Name = "progress.export.download"
+
+	// Report time spent on a study event
+	progress.POST("/events/:id/time", h.RecordTimeSpent).Name = "progress.event.time"
 }
 
 // ViewProgress displays the progress dashboard
 func (h *Progress) ViewProgress(ctx echo.Context) error {
-	return pages.ViewProgress(ctx)
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	summary, err := h.container.Progress.Summary(ctx.Request().Context(), user.ID, time.Now())
+	if err != nil {
+		return fail(err, "failed to compute progress")
+	}
+
+	return pages.ViewProgress(ctx, summary)
 }
 
 // ViewAnalytics displays detailed analytics
 func (h *Progress) ViewAnalytics(ctx echo.Context) error {
-	return pages.ViewAnalytics(ctx)
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	summary, err := h.container.Progress.Summary(ctx.Request().Context(), user.ID, time.Now())
+	if err != nil {
+		return fail(err, "failed to compute progress")
+	}
+
+	events, err := h.container.Progress.ListEvents(ctx.Request().Context(), user.ID, 50, 0)
+	if err != nil {
+		return fail(err, "failed to fetch study history")
+	}
+
+	return pages.ViewAnalytics(ctx, summary, events)
 }
 
-// ExportProgress handles progress report export
+// ExportProgress streams the user's study history in the requested format. Histories too large
+// to export while the user waits are exported in the background and the link is emailed.
 func (h *Progress) ExportProgress(ctx echo.Context) error {
-	// TODO: Implement progress export logic
-	return ctx.String(200, "Progress export functionality coming soon!")
-}
\ No newline at end of file
+	format, err := services.ParseExportFormat(ctx.QueryParam("format"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Unsupported export format")
+	}
+
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	count, err := h.container.Progress.CountEvents(ctx.Request().Context(), user.ID)
+	if err != nil {
+		return fail(err, "failed to count study events")
+	}
+
+	if count > services.ExportSyncLimit {
+		if user.Email == nil || *user.Email == "" {
+			msg.Warning(ctx, "Your study history is too large to download directly. Add an email address to your profile and we'll email you a download link instead.")
+			return ctx.Redirect(302, ctx.Echo().Reverse("progress.view"))
+		}
+
+		err = h.container.Tasks.
+			Add(tasks.ProgressExportTask{
+				UserID: user.ID,
+				Format: string(format),
+			}).
+			Save()
+		if err != nil {
+			return fail(err, "failed to queue progress export")
+		}
+
+		msg.Success(ctx, fmt.Sprintf("Your export is being prepared. We'll email a download link to %s when it's ready.", *user.Email))
+		return ctx.Redirect(302, ctx.Echo().Reverse("progress.view"))
+	}
+
+	now := time.Now()
+	res := ctx.Response()
+	res.Header().Set(echo.HeaderContentType, format.ContentType())
+	res.Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", format.FileName(now)))
+	res.WriteHeader(http.StatusOK)
+
+	// The response has started, so errors can only be logged
+	if err := h.container.Progress.Export(ctx.Request().Context(), res, user, format, now); err != nil {
+		log.Ctx(ctx).Error("failed to export progress",
+			"user_id", user.ID,
+			"format", format,
+			"error", err,
+		)
+	}
+
+	return nil
+}
+
+// DownloadExport serves an export prepared in the background
+func (h *Progress) DownloadExport(ctx echo.Context) error {
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	file, format, err := h.container.Progress.OpenExport(user.ID, ctx.Param("file"), time.Now())
+	switch {
+	case errors.Is(err, services.ErrExportNotFound):
+		return echo.NewHTTPError(http.StatusNotFound, "This export has expired. Please request a new one from your progress page.")
+	case err != nil:
+		return fail(err, "failed to open export")
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return fail(err, "failed to open export")
+	}
+
+	ctx.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", format.FileName(info.ModTime())))
+	return ctx.Stream(http.StatusOK, format.ContentType(), file)
+}
+
+// RecordTimeSpent records how long a note has been open, as reported by the note page
+func (h *Progress) RecordTimeSpent(ctx echo.Context) error {
+	eventID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Event not found")
+	}
+
+	seconds, err := strconv.Atoi(ctx.FormValue("seconds"))
+	if err != nil || seconds < 0 {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid duration")
+	}
+
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	err = h.container.Progress.AddTimeSpent(ctx.Request().Context(), eventID, user.ID, time.Duration(seconds)*time.Second)
+	if err != nil {
+		return fail(err, "failed to record time spent")
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
@@ -1,9 +1,24 @@
 package handlers
 
 import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
 	"github.com/labstack/echo/v4"
+	"github.com/r-scheele/zero/ent"
+	"github.com/r-scheele/zero/ent/quizattempt"
+	"github.com/r-scheele/zero/pkg/context"
+	"github.com/r-scheele/zero/pkg/form"
 	"github.com/r-scheele/zero/pkg/middleware"
+	"github.com/r-scheele/zero/pkg/msg"
 	"github.com/r-scheele/zero/pkg/services"
+	"github.com/r-scheele/zero/pkg/tasks"
+	"github.com/r-scheele/zero/pkg/ui/forms"
 	"github.com/r-scheele/zero/pkg/ui/pages"
 )
 
@@ -23,74 +38,511 @@ func (h *Quiz) Init(c *services.Container) error {
 func (h *Quiz) Routes(g *echo.Group) {
 	// Quiz routes (require authentication and verification)
 	quiz := g.Group("/quiz", middleware.RequireAuthentication, middleware.RequireVerification)
-	
+
 	// List quizzes
 	quiz.GET("", h.ListQuizzes).Name = "quiz.list"
-	
+
 	// Create quiz
 	quiz.GET("/create", h.CreateQuizPage).Name = "quiz.create"
 	quiz.POST("/create", h.CreateQuizSubmit).Name = "quiz.create.submit"
-	
+
 	// View quiz
 	quiz.GET("/:id", h.ViewQuiz).Name = "quiz.view"
-	
+
 	// Edit quiz
 	quiz.GET("/:id/edit", h.EditQuizPage).Name = "quiz.edit"
 	quiz.POST("/:id/edit", h.EditQuizSubmit).Name = "quiz.edit.submit"
-	
+
 	// Delete quiz
 	quiz.POST("/:id/delete", h.DeleteQuiz).Name = "quiz.delete"
-	
+
 	// Take quiz
 	quiz.GET("/:id/take", h.TakeQuiz).Name = "quiz.take"
 	quiz.POST("/:id/submit", h.SubmitQuiz).Name = "quiz.submit"
+	quiz.POST("/:id/attempts/:attempt/save", h.SaveAnswers).Name = "quiz.attempt.save"
+
+	// Self-check practice
+	quiz.GET("/:id/practice", h.PracticeQuiz).Name = "quiz.practice"
+
+	// Attempt results
+	quiz.GET("/:id/attempts/:attempt", h.ViewAttempt).Name = "quiz.attempt"
 }
 
-// ListQuizzes displays all quizzes
+// ListQuizzes displays the user's quizzes and public quizzes
 func (h *Quiz) ListQuizzes(ctx echo.Context) error {
-	return pages.QuizList(ctx)
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	// Get pagination parameters
+	page := 1
+	if p := ctx.QueryParam("page"); p != "" {
+		if parsed, err := strconv.Atoi(p); err == nil && parsed > 0 {
+			page = parsed
+		}
+	}
+
+	limit := 20
+	offset := (page - 1) * limit
+
+	quizzes, err := h.container.Quiz.ListQuizzes(ctx.Request().Context(), user.ID, limit, offset)
+	if err != nil {
+		return fail(err, "failed to fetch quizzes")
+	}
+
+	return pages.QuizList(ctx, quizzes, page)
 }
 
 // CreateQuizPage displays the quiz creation form
 func (h *Quiz) CreateQuizPage(ctx echo.Context) error {
-	return pages.CreateQuiz(ctx)
+	return pages.CreateQuiz(ctx, form.Get[forms.Quiz](ctx))
 }
 
 // CreateQuizSubmit handles quiz creation
 func (h *Quiz) CreateQuizSubmit(ctx echo.Context) error {
-	// TODO: Implement quiz creation logic
-	return ctx.String(200, "Quiz creation functionality coming soon!")
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+	var input forms.Quiz
+
+	err := form.Submit(ctx, &input)
+	switch err.(type) {
+	case nil:
+	case validator.ValidationErrors:
+		return h.CreateQuizPage(ctx)
+	default:
+		return err
+	}
+
+	quizInput, err := quizInputFromForm(&input)
+	if err != nil {
+		msg.Error(ctx, err.Error())
+		return h.CreateQuizPage(ctx)
+	}
+
+	created, err := h.container.Quiz.CreateQuiz(ctx.Request().Context(), user.ID, quizInput)
+	if err != nil {
+		msg.Error(ctx, "Failed to create quiz: "+err.Error())
+		return h.CreateQuizPage(ctx)
+	}
+
+	form.Clear(ctx)
+	msg.Success(ctx, "Quiz created successfully!")
+	return ctx.Redirect(302, ctx.Echo().Reverse("quiz.view", created.ID))
 }
 
-// ViewQuiz displays a specific quiz
+// ViewQuiz displays a specific quiz along with the user's previous attempts
 func (h *Quiz) ViewQuiz(ctx echo.Context) error {
-	return pages.ViewQuiz(ctx)
+	quizID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(404, "Quiz not found")
+	}
+
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	quiz, err := h.container.Quiz.GetQuiz(ctx.Request().Context(), quizID, &user.ID)
+	if err != nil {
+		return echo.NewHTTPError(404, "Quiz not found")
+	}
+
+	attempts, err := h.container.Quiz.ListAttempts(ctx.Request().Context(), quizID, user.ID)
+	if err != nil {
+		return fail(err, "failed to fetch attempts")
+	}
+
+	return pages.ViewQuiz(ctx, quiz, attempts)
 }
 
 // EditQuizPage displays the quiz edit form
 func (h *Quiz) EditQuizPage(ctx echo.Context) error {
-	return pages.EditQuiz(ctx)
+	quizID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(404, "Quiz not found")
+	}
+
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	quiz, err := h.container.Quiz.GetQuiz(ctx.Request().Context(), quizID, &user.ID)
+	if err != nil {
+		return echo.NewHTTPError(404, "Quiz not found")
+	}
+
+	// Check if user owns the quiz
+	if quiz.Edges.Owner.ID != user.ID {
+		return echo.NewHTTPError(403, "Access denied")
+	}
+
+	// Populate form with existing data
+	editForm := form.Get[forms.Quiz](ctx)
+	if !editForm.IsSubmitted() {
+		quizFormFromEntity(editForm, quiz)
+	}
+	editForm.ID = quiz.ID
+
+	return pages.EditQuiz(ctx, editForm)
 }
 
 // EditQuizSubmit handles quiz updates
 func (h *Quiz) EditQuizSubmit(ctx echo.Context) error {
-	// TODO: Implement quiz update logic
-	return ctx.String(200, "Quiz editing functionality coming soon!")
+	quizID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(404, "Quiz not found")
+	}
+
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+	var input forms.Quiz
+
+	err = form.Submit(ctx, &input)
+	switch err.(type) {
+	case nil:
+	case validator.ValidationErrors:
+		return h.EditQuizPage(ctx)
+	default:
+		return err
+	}
+
+	quizInput, err := quizInputFromForm(&input)
+	if err != nil {
+		msg.Error(ctx, err.Error())
+		return h.EditQuizPage(ctx)
+	}
+
+	_, err = h.container.Quiz.UpdateQuiz(ctx.Request().Context(), quizID, user.ID, quizInput)
+	if err != nil {
+		msg.Error(ctx, "Failed to update quiz: "+err.Error())
+		return h.EditQuizPage(ctx)
+	}
+
+	form.Clear(ctx)
+	msg.Success(ctx, "Quiz updated successfully!")
+	return ctx.Redirect(302, ctx.Echo().Reverse("quiz.view", quizID))
 }
 
 // DeleteQuiz handles quiz deletion
 func (h *Quiz) DeleteQuiz(ctx echo.Context) error {
-	// TODO: Implement quiz deletion logic
-	return ctx.String(200, "Quiz deletion functionality coming soon!")
+	quizID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(404, "Quiz not found")
+	}
+
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	if err := h.container.Quiz.DeleteQuiz(ctx.Request().Context(), quizID, user.ID); err != nil {
+		msg.Error(ctx, "Failed to delete quiz: "+err.Error())
+		return ctx.Redirect(302, ctx.Echo().Reverse("quiz.view", quizID))
+	}
+
+	msg.Success(ctx, "Quiz deleted successfully!")
+	return ctx.Redirect(302, ctx.Echo().Reverse("quiz.list"))
 }
 
-// TakeQuiz displays the quiz taking interface
+// TakeQuiz starts or resumes an attempt and displays the quiz taking interface
 func (h *Quiz) TakeQuiz(ctx echo.Context) error {
-	return pages.TakeQuiz(ctx)
+	quizID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(404, "Quiz not found")
+	}
+
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	quiz, err := h.container.Quiz.GetQuiz(ctx.Request().Context(), quizID, &user.ID)
+	if err != nil {
+		return echo.NewHTTPError(404, "Quiz not found")
+	}
+
+	attempt, started, err := h.container.Quiz.StartAttempt(ctx.Request().Context(), quizID, user.ID)
+	switch {
+	case errors.Is(err, services.ErrAttemptLimitReached):
+		msg.Error(ctx, "You have used all of your attempts for this quiz.")
+		return ctx.Redirect(302, ctx.Echo().Reverse("quiz.view", quizID))
+	case err != nil:
+		return fail(err, "failed to start quiz attempt")
+	}
+
+	// Close the attempt on the server once its deadline passes, whether or not it is submitted
+	if started && attempt.Deadline != nil {
+		err = h.container.Tasks.
+			Add(tasks.QuizDeadlineTask{
+				AttemptID: attempt.ID,
+			}).
+			Wait(time.Until(*attempt.Deadline) + services.AttemptGracePeriod + time.Second).
+			Save()
+		if err != nil {
+			return fail(err, "failed to schedule quiz deadline")
+		}
+	}
+
+	return pages.TakeQuiz(ctx, quiz, attempt)
 }
 
-// SubmitQuiz handles quiz submission
+// PracticeQuiz displays the self-check page where answers can be revealed one at a time
+func (h *Quiz) PracticeQuiz(ctx echo.Context) error {
+	quizID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(404, "Quiz not found")
+	}
+
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	quiz, err := h.container.Quiz.GetQuiz(ctx.Request().Context(), quizID, &user.ID)
+	if err != nil {
+		return echo.NewHTTPError(404, "Quiz not found")
+	}
+
+	// Practice mode shows the answers, which would give away the answer key of an assessment
+	if !services.CanPractice(quiz, user.ID) {
+		return echo.NewHTTPError(403, "Practice mode isn't available for this quiz")
+	}
+
+	return pages.PracticeQuiz(ctx, quiz)
+}
+
+// SubmitQuiz grades a quiz submission and redirects to the results
 func (h *Quiz) SubmitQuiz(ctx echo.Context) error {
-	// TODO: Implement quiz submission logic
-	return ctx.String(200, "Quiz submission functionality coming soon!")
-}
\ No newline at end of file
+	quizID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(404, "Quiz not found")
+	}
+
+	attemptID, err := strconv.Atoi(ctx.FormValue("attempt_id"))
+	if err != nil {
+		return echo.NewHTTPError(400, "Missing attempt")
+	}
+
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	quiz, err := h.container.Quiz.GetQuiz(ctx.Request().Context(), quizID, &user.ID)
+	if err != nil {
+		return echo.NewHTTPError(404, "Quiz not found")
+	}
+
+	// The answers are read for the quiz in the URL, so they can only go to an attempt at it
+	if err := h.checkAttempt(ctx, quizID, attemptID, user.ID); err != nil {
+		return err
+	}
+
+	attempt, err := h.container.Quiz.SubmitAttempt(ctx.Request().Context(), attemptID, user.ID, quizAnswers(ctx, quiz))
+	switch {
+	case err == nil:
+		msg.Success(ctx, fmt.Sprintf("Quiz submitted! You scored %d out of %d.", attempt.Score, attempt.MaxScore))
+	case errors.Is(err, services.ErrAttemptExpired):
+		msg.Error(ctx, "Time is up. Your attempt was graded using the answers saved before the deadline.")
+	case errors.Is(err, services.ErrAttemptClosed):
+		msg.Warning(ctx, "This attempt was already submitted.")
+	default:
+		msg.Error(ctx, "Failed to submit quiz: "+err.Error())
+		return ctx.Redirect(302, ctx.Echo().Reverse("quiz.take", quizID))
+	}
+
+	return ctx.Redirect(302, ctx.Echo().Reverse("quiz.attempt", quizID, attemptID))
+}
+
+// SaveAnswers saves the answers of an attempt in progress so they count if the deadline passes before submission
+func (h *Quiz) SaveAnswers(ctx echo.Context) error {
+	quizID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(404, "Quiz not found")
+	}
+
+	attemptID, err := strconv.Atoi(ctx.Param("attempt"))
+	if err != nil {
+		return echo.NewHTTPError(404, "Attempt not found")
+	}
+
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	quiz, err := h.container.Quiz.GetQuiz(ctx.Request().Context(), quizID, &user.ID)
+	if err != nil {
+		return echo.NewHTTPError(404, "Quiz not found")
+	}
+
+	if err := h.checkAttempt(ctx, quizID, attemptID, user.ID); err != nil {
+		return err
+	}
+
+	err = h.container.Quiz.SaveAnswers(ctx.Request().Context(), attemptID, user.ID, quizAnswers(ctx, quiz))
+	switch {
+	case err == nil:
+		return ctx.NoContent(http.StatusNoContent)
+	case errors.Is(err, services.ErrAttemptExpired), errors.Is(err, services.ErrAttemptClosed):
+		// Send the user to their results since the attempt can no longer change
+		ctx.Response().Header().Set("HX-Redirect", ctx.Echo().Reverse("quiz.attempt", quizID, attemptID))
+		return ctx.NoContent(http.StatusConflict)
+	default:
+		return fail(err, "failed to save answers")
+	}
+}
+
+// checkAttempt returns a 404 error unless the attempt is the user's attempt at the quiz
+func (h *Quiz) checkAttempt(ctx echo.Context, quizID, attemptID, userID int) error {
+	attempt, err := h.container.Quiz.GetAttempt(ctx.Request().Context(), attemptID, userID)
+	if err != nil || attempt.Edges.Quiz == nil || attempt.Edges.Quiz.ID != quizID {
+		return echo.NewHTTPError(404, "Attempt not found")
+	}
+	return nil
+}
+
+// ViewAttempt displays the graded results of an attempt
+func (h *Quiz) ViewAttempt(ctx echo.Context) error {
+	quizID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(404, "Quiz not found")
+	}
+
+	attemptID, err := strconv.Atoi(ctx.Param("attempt"))
+	if err != nil {
+		return echo.NewHTTPError(404, "Attempt not found")
+	}
+
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	attempt, err := h.container.Quiz.GetAttempt(ctx.Request().Context(), attemptID, user.ID)
+	if err != nil || attempt.Edges.Quiz.ID != quizID {
+		return echo.NewHTTPError(404, "Attempt not found")
+	}
+
+	// Attempts in progress are continued rather than reviewed
+	if attempt.Status == quizattempt.StatusInProgress {
+		closed, err := h.container.Quiz.CloseExpiredAttempt(ctx.Request().Context(), attemptID)
+		if err != nil {
+			return fail(err, "failed to close attempt")
+		}
+		if !closed {
+			return ctx.Redirect(302, ctx.Echo().Reverse("quiz.take", quizID))
+		}
+		if attempt, err = h.container.Quiz.GetAttempt(ctx.Request().Context(), attemptID, user.ID); err != nil {
+			return fail(err, "failed to fetch attempt")
+		}
+	}
+
+	quiz, err := h.container.Quiz.GetQuiz(ctx.Request().Context(), quizID, &user.ID)
+	if err != nil {
+		return echo.NewHTTPError(404, "Quiz not found")
+	}
+
+	results := services.GradeQuestions(quiz.Edges.Questions, attempt.Answers)
+
+	// The answers are kept back while the user can still make another attempt
+	attempts, err := h.container.Quiz.ListAttempts(ctx.Request().Context(), quizID, user.ID)
+	if err != nil {
+		return fail(err, "failed to fetch attempts")
+	}
+	showAnswers := services.CanReviewAnswers(quiz, user.ID, len(attempts))
+
+	return pages.QuizResult(ctx, quiz, attempt, results, showAnswers)
+}
+
+// quizAnswers reads the answers to a quiz's questions, which are submitted as question_<id> fields
+func quizAnswers(ctx echo.Context, quiz *ent.Quiz) map[int]string {
+	answers := make(map[int]string, len(quiz.Edges.Questions))
+	for _, q := range quiz.Edges.Questions {
+		if answer := strings.TrimSpace(ctx.FormValue(fmt.Sprintf("question_%d", q.ID))); answer != "" {
+			answers[q.ID] = answer
+		}
+	}
+	return answers
+}
+
+// quizInputFromForm converts the submitted quiz form into service input.
+// Multiple choice questions list one choice per line with the correct one prefixed by "*",
+// true/false questions take "true" or "false" as the answer, and short answer questions
+// take a "|" separated list of accepted answers.
+func quizInputFromForm(f *forms.Quiz) (services.QuizInput, error) {
+	input := services.QuizInput{
+		Title:       strings.TrimSpace(f.Title),
+		Description: strings.TrimSpace(f.Description),
+		Visibility:  f.Visibility,
+		TimeLimit:   f.TimeLimit,
+		MaxAttempts: f.MaxAttempts,
+	}
+
+	for i := 0; i < f.QuestionCount(); i++ {
+		prompt := strings.TrimSpace(f.QuestionPrompts[i])
+		if prompt == "" {
+			// Skip blank question blocks
+			continue
+		}
+
+		q := services.QuestionInput{
+			Prompt: prompt,
+			Type:   services.QuestionTypeMultipleChoice,
+		}
+		if i < len(f.QuestionTypes) && f.QuestionTypes[i] != "" {
+			q.Type = f.QuestionTypes[i]
+		}
+
+		var answer string
+		if i < len(f.QuestionAnswers) {
+			answer = strings.TrimSpace(f.QuestionAnswers[i])
+		}
+
+		switch q.Type {
+		case services.QuestionTypeMultipleChoice:
+			if i < len(f.QuestionChoices) {
+				for _, line := range strings.Split(f.QuestionChoices[i], "\n") {
+					line = strings.TrimSpace(line)
+					if line == "" {
+						continue
+					}
+					correct := strings.HasPrefix(line, "*")
+					q.Choices = append(q.Choices, services.ChoiceInput{
+						Text:    strings.TrimSpace(strings.TrimPrefix(line, "*")),
+						Correct: correct,
+					})
+				}
+			}
+		case services.QuestionTypeTrueFalse:
+			value, err := strconv.ParseBool(answer)
+			if err != nil {
+				return input, fmt.Errorf("question %d: answer must be true or false", len(input.Questions)+1)
+			}
+			q.Choices = services.TrueFalseChoices(value)
+		case services.QuestionTypeShortAnswer:
+			for _, a := range strings.Split(answer, "|") {
+				if a = strings.TrimSpace(a); a != "" {
+					q.AcceptedAnswers = append(q.AcceptedAnswers, a)
+				}
+			}
+		}
+
+		input.Questions = append(input.Questions, q)
+	}
+
+	return input, input.Validate()
+}
+
+// quizFormFromEntity populates the quiz form from an existing quiz
+func quizFormFromEntity(f *forms.Quiz, quiz *ent.Quiz) {
+	f.Title = quiz.Title
+	f.Description = quiz.Description
+	f.Visibility = string(quiz.Visibility)
+	f.TimeLimit = quiz.TimeLimit
+	f.MaxAttempts = quiz.MaxAttempts
+
+	for _, q := range quiz.Edges.Questions {
+		var choices, answer string
+
+		switch string(q.Type) {
+		case services.QuestionTypeMultipleChoice:
+			lines := make([]string, 0, len(q.Edges.Choices))
+			for _, c := range q.Edges.Choices {
+				if c.Correct {
+					lines = append(lines, "*"+c.Text)
+				} else {
+					lines = append(lines, c.Text)
+				}
+			}
+			choices = strings.Join(lines, "\n")
+		case services.QuestionTypeTrueFalse:
+			answer = "false"
+			for _, c := range q.Edges.Choices {
+				if c.Correct && c.Text == "True" {
+					answer = "true"
+				}
+			}
+		case services.QuestionTypeShortAnswer:
+			answer = strings.Join(q.AcceptedAnswers, " | ")
+		}
+
+		f.QuestionTypes = append(f.QuestionTypes, string(q.Type))
+		f.QuestionPrompts = append(f.QuestionPrompts, q.Prompt)
+		f.QuestionChoices = append(f.QuestionChoices, choices)
+		f.QuestionAnswers = append(f.QuestionAnswers, answer)
+	}
+}
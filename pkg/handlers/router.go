@@ -8,6 +8,7 @@ import (
 	"github.com/labstack/echo/v4"
 	echomw "github.com/labstack/echo/v4/middleware"
 	"github.com/r-scheele/zero/pkg/context"
+	"github.com/r-scheele/zero/pkg/routenames"
 	mw "github.com/r-scheele/zero/pkg/middleware"
 	"github.com/r-scheele/zero/pkg/services"
 	files "github.com/r-scheele/zero/public"
@@ -24,6 +25,12 @@ func BuildRouter(c *services.Container) error {
 	c.Web.Group("", mw.CacheControl(c.Config.Cache.Expiration.PublicFile)).
 		Static("files", "public/files")
 
+	// Serve files from local storage through signed URLs. This is kept out of the route group
+	// below so the file contents are never put in the response cache.
+	if local, ok := c.Storage.(*services.LocalStorageService); ok {
+		c.Web.GET("/storage/*", NewStorageFile(local).Serve).Name = routenames.StorageFile
+	}
+
 	// Serve static files.
 	// ui.StaticFile() should be used in ui components to append a cache key to the URL to break cache
 	// after each server reboot.
@@ -96,7 +103,7 @@ func BuildRouter(c *services.Container) error {
 			Expiration: c.Config.Cache.Expiration.PublicNotes,
 		}),
 		echomw.CSRFWithConfig(echomw.CSRFConfig{
-			TokenLookup:    "form:csrf",
+			TokenLookup:    "header:X-CSRF-Token,form:csrf",
 			CookieName:     "csrf",
 			CookieHTTPOnly: true,
 			CookieSameSite: func() http.SameSite {
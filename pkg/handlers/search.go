@@ -1,20 +1,32 @@
 package handlers
 
 import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
 	"github.com/labstack/echo/v4"
+	"github.com/r-scheele/zero/ent"
+	"github.com/r-scheele/zero/ent/note"
+	"github.com/r-scheele/zero/pkg/context"
+	"github.com/r-scheele/zero/pkg/pager"
 	"github.com/r-scheele/zero/pkg/routenames"
 	"github.com/r-scheele/zero/pkg/services"
 	"github.com/r-scheele/zero/pkg/ui/models"
 	"github.com/r-scheele/zero/pkg/ui/pages"
 )
 
-type Search struct{}
+type Search struct {
+	search *services.SearchService
+}
 
 func init() {
 	Register(new(Search))
 }
 
 func (h *Search) Init(c *services.Container) error {
+	h.search = c.Search
 	return nil
 }
 
@@ -23,7 +35,69 @@ func (h *Search) Routes(g *echo.Group) {
 }
 
 func (h *Search) Page(ctx echo.Context) error {
-	// Return empty search results for now
-	results := make([]*models.SearchResult, 0)
-	return pages.SearchResults(ctx, results)
+	params := searchParams(ctx)
+	if u, ok := ctx.Get(context.AuthenticatedUserKey).(*ent.User); ok {
+		params.ViewerID = &u.ID
+	}
+
+	pg := pager.NewPager(ctx, services.DefaultSearchLimit)
+	results, err := h.search.Search(ctx.Request().Context(), params, pg.ItemsPerPage, pg.GetOffset())
+	if err != nil {
+		return fail(err, "failed to search")
+	}
+	pg.SetItems(results.Total)
+	setSearchResultURLs(ctx, params.Query, results.Results)
+
+	return pages.SearchResults(ctx, params, results, pg)
+}
+
+// searchParams reads the search query and filters from the query string. Invalid filters are ignored.
+func searchParams(ctx echo.Context) services.SearchParams {
+	// The search modal sends "query" and the search bar sends "q"
+	params := services.SearchParams{Query: ctx.QueryParam("q")}
+	if params.Query == "" {
+		params.Query = ctx.QueryParam("query")
+	}
+
+	if owner, err := strconv.Atoi(ctx.QueryParam("owner")); err == nil && owner > 0 {
+		params.OwnerID = owner
+	}
+
+	if v := ctx.QueryParam("visibility"); v != "" && note.VisibilityValidator(note.Visibility(v)) == nil {
+		params.Visibility = v
+	}
+
+	params.ResourceType = ctx.QueryParam("type")
+
+	if from, err := time.Parse(time.DateOnly, ctx.QueryParam("from")); err == nil {
+		params.From = from
+	}
+
+	// The end date is inclusive
+	if to, err := time.Parse(time.DateOnly, ctx.QueryParam("to")); err == nil {
+		params.To = to.AddDate(0, 0, 1)
+	}
+
+	params.LikedByMe, _ = strconv.ParseBool(ctx.QueryParam("liked"))
+	if ctx.QueryParam("liked") == "on" {
+		params.LikedByMe = true
+	}
+
+	return params
+}
+
+// setSearchResultURLs links search results to the pages they were found on. People link to the
+// same search narrowed down to their notes.
+func setSearchResultURLs(ctx echo.Context, query string, results []*models.SearchResult) {
+	for _, r := range results {
+		switch r.Type {
+		case models.SearchResultNote:
+			r.URL = ctx.Echo().Reverse(routenames.Notes+".view", r.ID)
+		case models.SearchResultUser:
+			r.URL = fmt.Sprintf("%s?%s", ctx.Echo().Reverse(routenames.Search), url.Values{
+				"q":     {query},
+				"owner": {strconv.Itoa(r.ID)},
+			}.Encode())
+		}
+	}
 }
@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"errors"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/r-scheele/zero/pkg/log"
+	"github.com/r-scheele/zero/pkg/services"
+)
+
+// inlineFileTypes are the content types of stored files that browsers may display. Anything
+// else, such as HTML or SVG that could run scripts, is served as a download.
+var inlineFileTypes = []string{"image/png", "image/jpeg", "image/gif", "image/webp", "video/", "audio/", "application/pdf", "text/plain"}
+
+// StorageFile streams files from local storage. Files are only served through signed URLs, so
+// links to private files can't be shared beyond their expiry.
+type StorageFile struct {
+	storage *services.LocalStorageService
+}
+
+// NewStorageFile creates a handler for local storage files
+func NewStorageFile(storage *services.LocalStorageService) *StorageFile {
+	return &StorageFile{storage: storage}
+}
+
+// Serve validates a signed URL and streams the file it points to. This route is outside of the
+// main route group, so errors are written as plain text rather than rendered as error pages.
+func (h *StorageFile) Serve(ctx echo.Context) error {
+	key := strings.TrimPrefix(ctx.Request().URL.Path, "/storage/")
+
+	err := h.storage.VerifySignature(key, ctx.QueryParam("expires"), ctx.QueryParam("signature"), time.Now())
+	switch {
+	case errors.Is(err, services.ErrSignatureExpired):
+		return ctx.String(http.StatusForbidden, "This link has expired")
+	case err != nil:
+		return ctx.String(http.StatusForbidden, "This link is invalid")
+	}
+
+	f, err := h.storage.Open(key)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return ctx.String(http.StatusNotFound, "File not found")
+		}
+		log.Ctx(ctx).Error("failed to open file", "key", key, "error", err)
+		return ctx.String(http.StatusInternalServerError, "Unable to open file")
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		log.Ctx(ctx).Error("failed to stat file", "key", key, "error", err)
+		return ctx.String(http.StatusInternalServerError, "Unable to open file")
+	}
+
+	name := path.Base(key)
+	contentType := mime.TypeByExtension(path.Ext(name))
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	disposition := "attachment"
+	for _, t := range inlineFileTypes {
+		if strings.HasPrefix(contentType, t) {
+			disposition = "inline"
+			break
+		}
+	}
+
+	header := ctx.Response().Header()
+	header.Set(echo.HeaderContentType, contentType)
+	header.Set(echo.HeaderContentDisposition, mime.FormatMediaType(disposition, map[string]string{"filename": name}))
+	header.Set(echo.HeaderXContentTypeOptions, "nosniff")
+	header.Set("Cache-Control", "private, max-age=300")
+
+	http.ServeContent(ctx.Response(), ctx.Request(), name, info.ModTime(), f)
+	return nil
+}
@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorageFile(t *testing.T) {
+	ctx := context.Background()
+	key := "notes/1/private notes.txt"
+	_, err := c.Storage.UploadFile(ctx, key, strings.NewReader("mitochondria"), "text/plain")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = c.Storage.DeleteFile(ctx, key)
+	})
+
+	get := func(fileURL string) *http.Response {
+		u, err := url.Parse(fileURL)
+		require.NoError(t, err)
+		resp, err := http.Get(srv.URL + u.RequestURI())
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			resp.Body.Close()
+		})
+		return resp
+	}
+
+	// Signed URLs stream the file
+	signed, err := c.Storage.GetSignedURL(ctx, key, 60)
+	require.NoError(t, err)
+	resp := get(signed)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "mitochondria", string(body))
+	assert.Equal(t, "nosniff", resp.Header.Get("X-Content-Type-Options"))
+	assert.Contains(t, resp.Header.Get("Content-Disposition"), "inline")
+
+	// Unsigned, tampered and expired URLs are refused
+	unsigned, err := c.Storage.GetFileURL(ctx, key)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusForbidden, get(unsigned).StatusCode)
+	assert.Equal(t, http.StatusForbidden, get(strings.Replace(signed, "private", "public", 1)).StatusCode)
+	expired, err := c.Storage.GetSignedURL(ctx, key, -60)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusForbidden, get(expired).StatusCode)
+
+	// Deleted files are gone
+	require.NoError(t, c.Storage.DeleteFile(ctx, key))
+	assert.Equal(t, http.StatusNotFound, get(signed).StatusCode)
+}
@@ -1,9 +1,21 @@
 package handlers
 
 import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
 	"github.com/labstack/echo/v4"
+	"github.com/r-scheele/zero/ent"
+	"github.com/r-scheele/zero/ent/groupmembership"
+	"github.com/r-scheele/zero/pkg/context"
+	"github.com/r-scheele/zero/pkg/form"
 	"github.com/r-scheele/zero/pkg/middleware"
+	"github.com/r-scheele/zero/pkg/msg"
 	"github.com/r-scheele/zero/pkg/services"
+	"github.com/r-scheele/zero/pkg/ui/forms"
 	"github.com/r-scheele/zero/pkg/ui/pages"
 )
 
@@ -23,53 +35,467 @@ func (h *StudyGroups) Init(c *services.Container) error {
 func (h *StudyGroups) Routes(g *echo.Group) {
 	// Study Groups routes (require authentication and verification)
 	studyGroups := g.Group("/study-groups", middleware.RequireAuthentication, middleware.RequireVerification)
-	
+
 	// List study groups
 	studyGroups.GET("", h.ListStudyGroups).Name = "study-groups.list"
-	
+
 	// Create study group
 	studyGroups.GET("/create", h.CreateStudyGroupPage).Name = "study-groups.create"
 	studyGroups.POST("/create", h.CreateStudyGroupSubmit).Name = "study-groups.create.submit"
-	
+
 	// View study group
 	studyGroups.GET("/:id", h.ViewStudyGroup).Name = "study-groups.view"
-	
+
+	// Delete study group
+	studyGroups.POST("/:id/delete", h.DeleteStudyGroup).Name = "study-groups.delete"
+
 	// Join study group
 	studyGroups.POST("/:id/join", h.JoinStudyGroup).Name = "study-groups.join"
-	
+
 	// Leave study group
 	studyGroups.POST("/:id/leave", h.LeaveStudyGroup).Name = "study-groups.leave"
+
+	// Join requests
+	studyGroups.POST("/:id/requests/:request/approve", h.ApproveJoinRequest).Name = "study-groups.request.approve"
+	studyGroups.POST("/:id/requests/:request/reject", h.RejectJoinRequest).Name = "study-groups.request.reject"
+
+	// Members
+	studyGroups.POST("/:id/members/:member/role", h.SetMemberRole).Name = "study-groups.member.role"
+	studyGroups.POST("/:id/members/:member/remove", h.RemoveMember).Name = "study-groups.member.remove"
+
+	// Shared notes
+	studyGroups.POST("/:id/notes", h.ShareNote).Name = "study-groups.note.share"
+	studyGroups.POST("/:id/notes/:note/unshare", h.UnshareNote).Name = "study-groups.note.unshare"
+
+	// Invites
+	studyGroups.POST("/:id/invites", h.CreateInvite).Name = "study-groups.invite.create"
+	studyGroups.POST("/:id/invites/:invite/revoke", h.RevokeInvite).Name = "study-groups.invite.revoke"
+	studyGroups.GET("/invite/:token", h.ViewInvite).Name = "study-groups.invite"
+	studyGroups.POST("/invite/:token", h.AcceptInvite).Name = "study-groups.invite.accept"
 }
 
-// ListStudyGroups displays all study groups
+// ListStudyGroups displays the user's study groups and groups they can join
 func (h *StudyGroups) ListStudyGroups(ctx echo.Context) error {
-	return pages.StudyGroupsList(ctx)
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	// Get pagination parameters
+	page := 1
+	if p := ctx.QueryParam("page"); p != "" {
+		if parsed, err := strconv.Atoi(p); err == nil && parsed > 0 {
+			page = parsed
+		}
+	}
+
+	limit := 20
+	offset := (page - 1) * limit
+
+	mine, err := h.container.StudyGroups.ListUserGroups(ctx.Request().Context(), user.ID)
+	if err != nil {
+		return fail(err, "failed to fetch study groups")
+	}
+
+	all, err := h.container.StudyGroups.ListGroups(ctx.Request().Context(), limit, offset)
+	if err != nil {
+		return fail(err, "failed to fetch study groups")
+	}
+
+	return pages.StudyGroupsList(ctx, mine, all, page)
 }
 
 // CreateStudyGroupPage displays the study group creation form
 func (h *StudyGroups) CreateStudyGroupPage(ctx echo.Context) error {
-	return pages.CreateStudyGroup(ctx)
+	return pages.CreateStudyGroup(ctx, form.Get[forms.StudyGroup](ctx))
 }
 
 // CreateStudyGroupSubmit handles study group creation
 func (h *StudyGroups) CreateStudyGroupSubmit(ctx echo.Context) error {
-	// TODO: Implement study group creation logic
-	return ctx.String(200, "Study group creation functionality coming soon!")
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+	var input forms.StudyGroup
+
+	err := form.Submit(ctx, &input)
+	switch err.(type) {
+	case nil:
+	case validator.ValidationErrors:
+		return h.CreateStudyGroupPage(ctx)
+	default:
+		return err
+	}
+
+	group, err := h.container.StudyGroups.CreateGroup(ctx.Request().Context(), user.ID, services.StudyGroupInput{
+		Name:        input.Name,
+		Description: input.Description,
+		Privacy:     input.Privacy,
+	})
+	if err != nil {
+		msg.Error(ctx, "Failed to create study group: "+err.Error())
+		return h.CreateStudyGroupPage(ctx)
+	}
+
+	form.Clear(ctx)
+	msg.Success(ctx, "Study group created successfully!")
+	return ctx.Redirect(302, ctx.Echo().Reverse("study-groups.view", group.ID))
 }
 
-// ViewStudyGroup displays a specific study group
+// ViewStudyGroup displays a specific study group. Members see the member list and
+// shared notes; owners and moderators also see pending join requests and invites.
 func (h *StudyGroups) ViewStudyGroup(ctx echo.Context) error {
-	return pages.ViewStudyGroup(ctx)
+	groupID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(404, "Study group not found")
+	}
+
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+	reqCtx := ctx.Request().Context()
+
+	group, err := h.container.StudyGroups.GetGroup(reqCtx, groupID)
+	if err != nil {
+		return echo.NewHTTPError(404, "Study group not found")
+	}
+
+	data := pages.StudyGroupData{Group: group}
+
+	data.Membership, err = h.container.StudyGroups.GetMembership(reqCtx, groupID, user.ID)
+	if err != nil {
+		return fail(err, "failed to fetch membership")
+	}
+
+	if data.Membership == nil {
+		data.Pending, err = h.container.StudyGroups.HasPendingRequest(reqCtx, groupID, user.ID)
+		if err != nil {
+			return fail(err, "failed to fetch join request")
+		}
+		return pages.ViewStudyGroup(ctx, data)
+	}
+
+	data.Members, err = h.container.StudyGroups.ListMembers(reqCtx, groupID)
+	if err != nil {
+		return fail(err, "failed to fetch members")
+	}
+
+	data.Notes, err = h.container.StudyGroups.ListSharedNotes(reqCtx, groupID, user.ID)
+	if err != nil {
+		return fail(err, "failed to fetch shared notes")
+	}
+
+	switch data.Membership.Role {
+	case groupmembership.RoleOwner:
+		data.OwnNotes, err = h.container.Notes.ListUserNotes(reqCtx, user.ID, services.NoteFilter{}, 100, 0)
+		if err != nil {
+			return fail(err, "failed to fetch notes")
+		}
+		fallthrough
+	case groupmembership.RoleModerator:
+		data.Requests, err = h.container.StudyGroups.ListJoinRequests(reqCtx, groupID, user.ID)
+		if err != nil {
+			return fail(err, "failed to fetch join requests")
+		}
+		data.Invites, err = h.container.StudyGroups.ListInvites(reqCtx, groupID, user.ID)
+		if err != nil {
+			return fail(err, "failed to fetch invites")
+		}
+	}
+
+	return pages.ViewStudyGroup(ctx, data)
+}
+
+// DeleteStudyGroup handles study group deletion
+func (h *StudyGroups) DeleteStudyGroup(ctx echo.Context) error {
+	groupID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(404, "Study group not found")
+	}
+
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	if err := h.container.StudyGroups.DeleteGroup(ctx.Request().Context(), groupID, user.ID); err != nil {
+		return h.groupError(ctx, groupID, err)
+	}
+
+	msg.Success(ctx, "Study group deleted.")
+	return ctx.Redirect(302, ctx.Echo().Reverse("study-groups.list"))
 }
 
-// JoinStudyGroup handles joining a study group
+// JoinStudyGroup handles joining a study group, or requesting to join an invite-only group
 func (h *StudyGroups) JoinStudyGroup(ctx echo.Context) error {
-	// TODO: Implement join study group logic
-	return ctx.String(200, "Join study group functionality coming soon!")
+	groupID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(404, "Study group not found")
+	}
+
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	joined, err := h.container.StudyGroups.Join(ctx.Request().Context(), groupID, user.ID, ctx.FormValue("message"))
+	if err != nil {
+		return h.groupError(ctx, groupID, err)
+	}
+
+	if joined {
+		msg.Success(ctx, "You joined the study group.")
+	} else {
+		msg.Info(ctx, "Your request to join has been sent to the group's moderators.")
+	}
+	return ctx.Redirect(302, ctx.Echo().Reverse("study-groups.view", groupID))
 }
 
 // LeaveStudyGroup handles leaving a study group
 func (h *StudyGroups) LeaveStudyGroup(ctx echo.Context) error {
-	// TODO: Implement leave study group logic
-	return ctx.String(200, "Leave study group functionality coming soon!")
-}
\ No newline at end of file
+	groupID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(404, "Study group not found")
+	}
+
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	if err := h.container.StudyGroups.Leave(ctx.Request().Context(), groupID, user.ID); err != nil {
+		return h.groupError(ctx, groupID, err)
+	}
+
+	msg.Success(ctx, "You left the study group.")
+	return ctx.Redirect(302, ctx.Echo().Reverse("study-groups.list"))
+}
+
+// ApproveJoinRequest adds the requesting user to the group
+func (h *StudyGroups) ApproveJoinRequest(ctx echo.Context) error {
+	return h.decideJoinRequest(ctx, true)
+}
+
+// RejectJoinRequest discards a join request
+func (h *StudyGroups) RejectJoinRequest(ctx echo.Context) error {
+	return h.decideJoinRequest(ctx, false)
+}
+
+func (h *StudyGroups) decideJoinRequest(ctx echo.Context, approve bool) error {
+	groupID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(404, "Study group not found")
+	}
+
+	requestID, err := strconv.Atoi(ctx.Param("request"))
+	if err != nil {
+		return echo.NewHTTPError(404, "Join request not found")
+	}
+
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	requester, err := h.container.StudyGroups.DecideJoinRequest(ctx.Request().Context(), groupID, requestID, user.ID, approve)
+	if err != nil {
+		return h.groupError(ctx, groupID, err)
+	}
+
+	if approve {
+		msg.Success(ctx, requester.Name+" has been added to the group.")
+	} else {
+		msg.Success(ctx, "Join request from "+requester.Name+" rejected.")
+	}
+	return ctx.Redirect(302, ctx.Echo().Reverse("study-groups.view", groupID))
+}
+
+// SetMemberRole changes a member's role
+func (h *StudyGroups) SetMemberRole(ctx echo.Context) error {
+	groupID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(404, "Study group not found")
+	}
+
+	memberID, err := strconv.Atoi(ctx.Param("member"))
+	if err != nil {
+		return echo.NewHTTPError(404, "Member not found")
+	}
+
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	if err := h.container.StudyGroups.SetRole(ctx.Request().Context(), groupID, user.ID, memberID, ctx.FormValue("role")); err != nil {
+		return h.groupError(ctx, groupID, err)
+	}
+
+	msg.Success(ctx, "Role updated.")
+	return ctx.Redirect(302, ctx.Echo().Reverse("study-groups.view", groupID))
+}
+
+// RemoveMember removes a member from the group
+func (h *StudyGroups) RemoveMember(ctx echo.Context) error {
+	groupID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(404, "Study group not found")
+	}
+
+	memberID, err := strconv.Atoi(ctx.Param("member"))
+	if err != nil {
+		return echo.NewHTTPError(404, "Member not found")
+	}
+
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	if err := h.container.StudyGroups.RemoveMember(ctx.Request().Context(), groupID, user.ID, memberID); err != nil {
+		return h.groupError(ctx, groupID, err)
+	}
+
+	msg.Success(ctx, "Member removed.")
+	return ctx.Redirect(302, ctx.Echo().Reverse("study-groups.view", groupID))
+}
+
+// ShareNote shares one of the user's notes into the group
+func (h *StudyGroups) ShareNote(ctx echo.Context) error {
+	groupID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(404, "Study group not found")
+	}
+
+	noteID, err := strconv.Atoi(ctx.FormValue("note_id"))
+	if err != nil {
+		msg.Error(ctx, "Please choose a note to share.")
+		return ctx.Redirect(302, ctx.Echo().Reverse("study-groups.view", groupID))
+	}
+
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	if err := h.container.StudyGroups.ShareNote(ctx.Request().Context(), groupID, noteID, user.ID); err != nil {
+		return h.groupError(ctx, groupID, err)
+	}
+
+	msg.Success(ctx, "Note shared with the group.")
+	return ctx.Redirect(302, ctx.Echo().Reverse("study-groups.view", groupID))
+}
+
+// UnshareNote removes a note from the group
+func (h *StudyGroups) UnshareNote(ctx echo.Context) error {
+	groupID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(404, "Study group not found")
+	}
+
+	noteID, err := strconv.Atoi(ctx.Param("note"))
+	if err != nil {
+		return echo.NewHTTPError(404, "Note not found")
+	}
+
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	if err := h.container.StudyGroups.UnshareNote(ctx.Request().Context(), groupID, noteID, user.ID); err != nil {
+		return h.groupError(ctx, groupID, err)
+	}
+
+	msg.Success(ctx, "Note removed from the group.")
+	return ctx.Redirect(302, ctx.Echo().Reverse("study-groups.view", groupID))
+}
+
+// CreateInvite creates an invite link, or sends a WhatsApp invitation when a phone number is given
+func (h *StudyGroups) CreateInvite(ctx echo.Context) error {
+	groupID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(404, "Study group not found")
+	}
+
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+	reqCtx := ctx.Request().Context()
+
+	input := services.GroupInviteInput{
+		PhoneNumber: strings.TrimSpace(ctx.FormValue("phone_number")),
+	}
+	if v, err := strconv.Atoi(ctx.FormValue("max_uses")); err == nil {
+		input.MaxUses = v
+	}
+	if v, err := strconv.Atoi(ctx.FormValue("expires_days")); err == nil {
+		input.ExpiresIn = time.Duration(v) * 24 * time.Hour
+	}
+
+	group, err := h.container.StudyGroups.GetGroup(reqCtx, groupID)
+	if err != nil {
+		return echo.NewHTTPError(404, "Study group not found")
+	}
+
+	invite, err := h.container.StudyGroups.CreateInvite(reqCtx, groupID, user.ID, input)
+	if err != nil {
+		return h.groupError(ctx, groupID, err)
+	}
+
+	if invite.PhoneNumber == "" {
+		msg.Success(ctx, "Invite link created.")
+		return ctx.Redirect(302, ctx.Echo().Reverse("study-groups.view", groupID))
+	}
+
+	if err := h.container.API.SendGroupInviteMessage(reqCtx, invite.PhoneNumber, user.Name, group.Name, invite.Token); err != nil {
+		// Don't leave an invite around that its recipient never received
+		_ = h.container.StudyGroups.RevokeInvite(reqCtx, groupID, invite.ID, user.ID)
+		msg.Error(ctx, "Failed to send the WhatsApp invitation. Please check the phone number and try again.")
+		return ctx.Redirect(302, ctx.Echo().Reverse("study-groups.view", groupID))
+	}
+
+	msg.Success(ctx, "Invitation sent to "+invite.PhoneNumber+" on WhatsApp.")
+	return ctx.Redirect(302, ctx.Echo().Reverse("study-groups.view", groupID))
+}
+
+// RevokeInvite revokes an invite
+func (h *StudyGroups) RevokeInvite(ctx echo.Context) error {
+	groupID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(404, "Study group not found")
+	}
+
+	inviteID, err := strconv.Atoi(ctx.Param("invite"))
+	if err != nil {
+		return echo.NewHTTPError(404, "Invite not found")
+	}
+
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	if err := h.container.StudyGroups.RevokeInvite(ctx.Request().Context(), groupID, inviteID, user.ID); err != nil {
+		return h.groupError(ctx, groupID, err)
+	}
+
+	msg.Success(ctx, "Invite revoked.")
+	return ctx.Redirect(302, ctx.Echo().Reverse("study-groups.view", groupID))
+}
+
+// ViewInvite displays an invitation to join a study group
+func (h *StudyGroups) ViewInvite(ctx echo.Context) error {
+	invite, err := h.container.StudyGroups.GetInvite(ctx.Request().Context(), ctx.Param("token"))
+	if err != nil {
+		if errors.Is(err, services.ErrInviteInvalid) {
+			return echo.NewHTTPError(404, err.Error())
+		}
+		return fail(err, "failed to fetch invite")
+	}
+
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	m, err := h.container.StudyGroups.GetMembership(ctx.Request().Context(), invite.Edges.Group.ID, user.ID)
+	if err != nil {
+		return fail(err, "failed to fetch membership")
+	}
+	if m != nil {
+		return ctx.Redirect(302, ctx.Echo().Reverse("study-groups.view", invite.Edges.Group.ID))
+	}
+
+	return pages.StudyGroupInvite(ctx, invite)
+}
+
+// AcceptInvite joins the study group using an invite
+func (h *StudyGroups) AcceptInvite(ctx echo.Context) error {
+	user := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	group, err := h.container.StudyGroups.AcceptInvite(ctx.Request().Context(), ctx.Param("token"), user.ID)
+	switch {
+	case err == nil:
+		msg.Success(ctx, "You joined "+group.Name+"!")
+	case errors.Is(err, services.ErrAlreadyMember):
+		msg.Info(ctx, err.Error())
+	case errors.Is(err, services.ErrInviteInvalid):
+		msg.Error(ctx, err.Error())
+		return ctx.Redirect(302, ctx.Echo().Reverse("study-groups.list"))
+	default:
+		return fail(err, "failed to accept invite")
+	}
+
+	return ctx.Redirect(302, ctx.Echo().Reverse("study-groups.view", group.ID))
+}
+
+// groupError reports a study group service error back to the group page
+func (h *StudyGroups) groupError(ctx echo.Context, groupID int, err error) error {
+	if errors.Is(err, services.ErrGroupNotFound) {
+		return echo.NewHTTPError(404, "Study group not found")
+	}
+
+	msg.Error(ctx, err.Error())
+	return ctx.Redirect(302, ctx.Echo().Reverse("study-groups.view", groupID))
+}
@@ -0,0 +1,297 @@
+package handlers
+
+import (
+	"encoding/base64"
+	"errors"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/r-scheele/zero/ent"
+	"github.com/r-scheele/zero/pkg/context"
+	"github.com/r-scheele/zero/pkg/log"
+	"github.com/r-scheele/zero/pkg/middleware"
+	"github.com/r-scheele/zero/pkg/routenames"
+	"github.com/r-scheele/zero/pkg/services"
+	"github.com/r-scheele/zero/pkg/tasks"
+)
+
+const (
+	// tusVersion is the version of the tus resumable upload protocol that is supported
+	tusVersion = "1.0.0"
+
+	// tusExtensions are the extensions of the tus protocol that are supported
+	tusExtensions = "creation,expiration,termination"
+
+	// tusContentType is the content type chunks must be sent with
+	tusContentType = "application/offset+octet-stream"
+)
+
+// Uploads implements the tus protocol (https://tus.io/protocols/resumable-upload), so large
+// files can be uploaded to notes in chunks and resumed after the connection drops. Once every
+// chunk has arrived the file is handed to the FileUploadTask queue, like files uploaded with the
+// note form.
+type Uploads struct {
+	uploads   *services.UploadService
+	container *services.Container
+}
+
+func init() {
+	Register(new(Uploads))
+}
+
+func (h *Uploads) Init(c *services.Container) error {
+	h.uploads = c.Uploads
+	h.container = c
+	return nil
+}
+
+func (h *Uploads) Routes(g *echo.Group) {
+	notes := g.Group("/notes", middleware.RequireAuthentication, middleware.RequireVerification)
+	notes.OPTIONS("/:id/uploads", h.Options, h.protocol)
+	notes.POST("/:id/uploads", h.Create, h.protocol).Name = routenames.Notes + ".upload"
+
+	uploads := g.Group("/uploads", middleware.RequireAuthentication, middleware.RequireVerification)
+	uploads.HEAD("/:token", h.Head, h.protocol).Name = routenames.Upload
+	uploads.PATCH("/:token", h.Patch, h.protocol)
+	uploads.DELETE("/:token", h.Delete, h.protocol)
+}
+
+// protocol adds the protocol version to every response, and rejects requests for versions
+// that aren't supported
+func (h *Uploads) protocol(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		ctx.Response().Header().Set("Tus-Resumable", tusVersion)
+
+		if ctx.Request().Method != http.MethodOptions && ctx.Request().Header.Get("Tus-Resumable") != tusVersion {
+			ctx.Response().Header().Set("Tus-Version", tusVersion)
+			return ctx.NoContent(http.StatusPreconditionFailed)
+		}
+
+		return next(ctx)
+	}
+}
+
+// Options describes the protocol features supported
+func (h *Uploads) Options(ctx echo.Context) error {
+	header := ctx.Response().Header()
+	header.Set("Tus-Version", tusVersion)
+	header.Set("Tus-Extension", tusExtensions)
+	header.Set("Tus-Max-Size", strconv.FormatInt(h.uploads.MaxSize(), 10))
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// Create starts an upload to a note. The file name and type are sent in the Upload-Metadata
+// header as "filename" and "filetype".
+func (h *Uploads) Create(ctx echo.Context) error {
+	u := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	noteID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusNotFound, "note not found")
+	}
+
+	size, err := strconv.ParseInt(ctx.Request().Header.Get("Upload-Length"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Upload-Length is required")
+	}
+	if size > h.uploads.MaxSize() {
+		return echo.NewHTTPError(http.StatusRequestEntityTooLarge, "file exceeds "+services.FormatFileSize(h.uploads.MaxSize())+" limit")
+	}
+
+	metadata := parseUploadMetadata(ctx.Request().Header.Get("Upload-Metadata"))
+	mimeType := metadata["filetype"]
+	if mimeType == "" {
+		mimeType = mime.TypeByExtension(filepath.Ext(metadata["filename"]))
+	}
+
+	up, err := h.uploads.Create(ctx.Request().Context(), u.ID, services.ResumableUploadInput{
+		NoteID:   noteID,
+		FileName: metadata["filename"],
+		MimeType: mimeType,
+		Size:     size,
+	}, time.Now())
+	switch {
+	case errors.Is(err, services.ErrUploadInvalid):
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	case errors.Is(err, services.ErrUploadNoteNotFound):
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	case errors.Is(err, services.ErrUploadTooLarge), errors.Is(err, services.ErrQuotaExceeded):
+		return echo.NewHTTPError(http.StatusRequestEntityTooLarge, err.Error())
+	case err != nil:
+		return fail(err, "failed to create upload")
+	}
+
+	// Remove the upload if it's abandoned
+	err = h.container.Tasks.
+		Add(tasks.UploadExpiryTask{UploadID: up.ID}).
+		Wait(time.Until(up.ExpiresAt) + time.Second).
+		Save()
+	if err != nil {
+		log.Ctx(ctx).Error("failed to queue upload expiry", "upload_id", up.ID, "error", err)
+	}
+
+	ctx.Response().Header().Set("Location", ctx.Echo().Reverse(routenames.Upload, up.Token))
+	ctx.Response().Header().Set("Upload-Expires", up.ExpiresAt.UTC().Format(http.TimeFormat))
+	return ctx.NoContent(http.StatusCreated)
+}
+
+// Head reports how much of the file has been received, so the client knows where to resume
+func (h *Uploads) Head(ctx echo.Context) error {
+	up, err := h.load(ctx)
+	if err != nil {
+		return err
+	}
+
+	header := ctx.Response().Header()
+	header.Set("Cache-Control", "no-store")
+	header.Set("Upload-Offset", strconv.FormatInt(up.Offset, 10))
+	header.Set("Upload-Length", strconv.FormatInt(up.Size, 10))
+	if up.CompletedAt == nil {
+		header.Set("Upload-Expires", up.ExpiresAt.UTC().Format(http.TimeFormat))
+	}
+	return ctx.NoContent(http.StatusOK)
+}
+
+// Patch receives the next chunk of the file. Once the last chunk arrives, the file is assembled
+// and queued to be added to the note.
+func (h *Uploads) Patch(ctx echo.Context) error {
+	if ctx.Request().Header.Get(echo.HeaderContentType) != tusContentType {
+		return echo.NewHTTPError(http.StatusUnsupportedMediaType, "chunks must be sent as "+tusContentType)
+	}
+
+	offset, err := strconv.ParseInt(ctx.Request().Header.Get("Upload-Offset"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Upload-Offset is required")
+	}
+
+	up, err := h.load(ctx)
+	if err != nil {
+		return err
+	}
+
+	// A completed upload that failed to be queued can be finished by sending an empty chunk
+	if up.Offset != up.Size || offset != up.Offset {
+		up, err = h.uploads.Append(ctx.Request().Context(), up, offset, ctx.Request().Body, time.Now())
+		switch {
+		case errors.Is(err, services.ErrUploadOffset), errors.Is(err, services.ErrUploadComplete):
+			return echo.NewHTTPError(http.StatusConflict, err.Error())
+		case errors.Is(err, services.ErrUploadTooLarge):
+			return echo.NewHTTPError(http.StatusRequestEntityTooLarge, err.Error())
+		case err != nil:
+			return fail(err, "failed to store chunk")
+		}
+	}
+
+	if up.Offset == up.Size && up.CompletedAt == nil {
+		if err := h.complete(ctx, up); err != nil {
+			return err
+		}
+	}
+
+	ctx.Response().Header().Set("Upload-Offset", strconv.FormatInt(up.Offset, 10))
+	if up.CompletedAt == nil && up.Offset < up.Size {
+		ctx.Response().Header().Set("Upload-Expires", up.ExpiresAt.UTC().Format(http.TimeFormat))
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// Delete abandons an upload
+func (h *Uploads) Delete(ctx echo.Context) error {
+	up, err := h.load(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := h.uploads.Delete(ctx.Request().Context(), up); err != nil {
+		return fail(err, "failed to delete upload")
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// load loads the authenticated user's upload from the URL
+func (h *Uploads) load(ctx echo.Context) (*ent.ResumableUpload, error) {
+	u := ctx.Get(context.AuthenticatedUserKey).(*ent.User)
+
+	up, err := h.uploads.Get(ctx.Request().Context(), u.ID, ctx.Param("token"), time.Now())
+	switch {
+	case errors.Is(err, services.ErrUploadNotFound):
+		return nil, echo.NewHTTPError(http.StatusNotFound, err.Error())
+	case err != nil:
+		return nil, fail(err, "failed to load upload")
+	}
+
+	return up, nil
+}
+
+// complete assembles an upload and queues the file to be added to the note. The upload is marked
+// complete first, so it's only queued once however many requests finish it.
+func (h *Uploads) complete(ctx echo.Context, up *ent.ResumableUpload) error {
+	err := h.uploads.Complete(ctx.Request().Context(), up, time.Now())
+	switch {
+	case errors.Is(err, services.ErrUploadComplete):
+		return nil
+	case err != nil:
+		return fail(err, "failed to complete upload")
+	}
+
+	tempPath, err := h.uploads.Assemble(up)
+	if err != nil {
+		h.reopen(ctx, up)
+		return fail(err, "failed to assemble upload")
+	}
+
+	err = h.container.Tasks.
+		Add(tasks.FileUploadTask{
+			NoteID:   up.NoteID,
+			UserID:   ctx.Get(context.AuthenticatedUserKey).(*ent.User).ID,
+			FileName: up.FileName,
+			FileSize: up.Size,
+			MimeType: up.MimeType,
+			TempPath: tempPath,
+		}).
+		Save()
+	if err != nil {
+		os.Remove(tempPath)
+		h.reopen(ctx, up)
+		return fail(err, "failed to queue upload")
+	}
+
+	// The file is queued, so failing to clean up the chunks now is left to the expiry task
+	if err := h.uploads.RemoveChunks(up); err != nil {
+		log.Ctx(ctx).Error("failed to remove upload chunks", "upload_id", up.ID, "error", err)
+	}
+
+	return nil
+}
+
+// reopen lets an upload that couldn't be queued be completed again by sending an empty chunk
+func (h *Uploads) reopen(ctx echo.Context, up *ent.ResumableUpload) {
+	if err := h.uploads.Reopen(ctx.Request().Context(), up); err != nil {
+		log.Ctx(ctx).Error("failed to reopen upload", "upload_id", up.ID, "error", err)
+	}
+}
+
+// parseUploadMetadata parses the Upload-Metadata header, a comma separated list of keys each
+// followed by an optional base64 encoded value
+func parseUploadMetadata(header string) map[string]string {
+	metadata := make(map[string]string)
+	for _, pair := range strings.Split(header, ",") {
+		key, value, _ := strings.Cut(strings.TrimSpace(pair), " ")
+		if key == "" {
+			continue
+		}
+		decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(value))
+		if err != nil {
+			continue
+		}
+		metadata[key] = string(decoded)
+	}
+	return metadata
+}
@@ -23,8 +23,10 @@ const (
 	ProfileEdit           = "profile.edit"
 	ProfileUpdate         = "profile.update"
 	ProfilePicture        = "profile.picture"
+	ProfilePictureImage   = "profile.picture.image"
 	ProfileChangePassword = "profile.change_password"
 	ProfileDeactivate     = "profile.deactivate"
+	ProfileStorage        = "profile.storage"
 	VerifyEmail           = "verify_email"
 	VerificationNotice    = "verification_notice"
 	ResendVerification    = "resend_verification"
@@ -38,6 +40,8 @@ const (
 	CacheSubmit           = "cache.submit"
 	Files                 = "files"
 	FilesSubmit           = "files.submit"
+	StorageFile           = "storage.file"
+	Upload                = "upload"
 	AdminTasks            = "admin:tasks"
 )
 
@@ -0,0 +1,242 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/r-scheele/zero/config"
+)
+
+const (
+	// openAIBaseURL is the default base URL of the OpenAI API
+	openAIBaseURL = "https://api.openai.com/v1"
+
+	// anthropicBaseURL is the default base URL of the Anthropic API
+	anthropicBaseURL = "https://api.anthropic.com/v1"
+
+	// anthropicVersion is the Anthropic API version sent with each request
+	anthropicVersion = "2023-06-01"
+)
+
+// AIProvider sends a prompt to a large language model and returns the text of its reply
+type AIProvider interface {
+	// Name returns the name of the provider
+	Name() string
+
+	// Complete sends the system instructions and prompt and returns the model's reply
+	Complete(ctx context.Context, system, prompt string) (string, error)
+}
+
+// OpenAIProvider implements AIProvider using the OpenAI chat completions API
+type OpenAIProvider struct {
+	baseURL   string
+	apiKey    string
+	model     string
+	maxTokens int
+	client    *http.Client
+}
+
+// AnthropicProvider implements AIProvider using the Anthropic messages API
+type AnthropicProvider struct {
+	baseURL   string
+	apiKey    string
+	model     string
+	maxTokens int
+	client    *http.Client
+}
+
+// NewAIProvider returns the first enabled AI provider, or nil if AI is disabled or no provider is configured
+func NewAIProvider(cfg config.AIConfig) AIProvider {
+	if !cfg.Enabled {
+		return nil
+	}
+
+	if cfg.OpenAI.Enabled && cfg.OpenAI.APIKey != "" {
+		return NewOpenAIProvider(cfg.OpenAI, openAIBaseURL)
+	}
+
+	if cfg.Anthropic.Enabled && cfg.Anthropic.APIKey != "" {
+		return NewAnthropicProvider(cfg.Anthropic, anthropicBaseURL)
+	}
+
+	return nil
+}
+
+// NewOpenAIProvider creates a new OpenAI provider
+func NewOpenAIProvider(cfg config.OpenAIConfig, baseURL string) *OpenAIProvider {
+	return &OpenAIProvider{
+		baseURL:   strings.TrimSuffix(baseURL, "/"),
+		apiKey:    cfg.APIKey,
+		model:     cfg.Model,
+		maxTokens: cfg.MaxTokens,
+		client: &http.Client{
+			Timeout: 2 * time.Minute,
+		},
+	}
+}
+
+// Name returns the name of the provider
+func (p *OpenAIProvider) Name() string {
+	return "openai"
+}
+
+// Complete sends a chat completion request to OpenAI
+func (p *OpenAIProvider) Complete(ctx context.Context, system, prompt string) (string, error) {
+	type message struct {
+		Role    string `json:"role"`
+		Content string `json:"content"`
+	}
+
+	request := struct {
+		Model     string    `json:"model"`
+		Messages  []message `json:"messages"`
+		MaxTokens int       `json:"max_tokens,omitempty"`
+	}{
+		Model: p.model,
+		Messages: []message{
+			{Role: "system", Content: system},
+			{Role: "user", Content: prompt},
+		},
+		MaxTokens: p.maxTokens,
+	}
+
+	var response struct {
+		Choices []struct {
+			Message message `json:"message"`
+		} `json:"choices"`
+	}
+
+	headers := map[string]string{
+		"Authorization": "Bearer " + p.apiKey,
+	}
+
+	if err := postJSON(ctx, p.client, p.baseURL+"/chat/completions", headers, request, &response); err != nil {
+		return "", fmt.Errorf("openai request failed: %w", err)
+	}
+
+	if len(response.Choices) == 0 {
+		return "", fmt.Errorf("openai returned no choices")
+	}
+
+	return response.Choices[0].Message.Content, nil
+}
+
+// NewAnthropicProvider creates a new Anthropic provider
+func NewAnthropicProvider(cfg config.AnthropicConfig, baseURL string) *AnthropicProvider {
+	maxTokens := cfg.MaxTokens
+	if maxTokens <= 0 {
+		// The messages API requires max_tokens
+		maxTokens = 4000
+	}
+
+	return &AnthropicProvider{
+		baseURL:   strings.TrimSuffix(baseURL, "/"),
+		apiKey:    cfg.APIKey,
+		model:     cfg.Model,
+		maxTokens: maxTokens,
+		client: &http.Client{
+			Timeout: 2 * time.Minute,
+		},
+	}
+}
+
+// Name returns the name of the provider
+func (p *AnthropicProvider) Name() string {
+	return "anthropic"
+}
+
+// Complete sends a messages request to Anthropic
+func (p *AnthropicProvider) Complete(ctx context.Context, system, prompt string) (string, error) {
+	type message struct {
+		Role    string `json:"role"`
+		Content string `json:"content"`
+	}
+
+	request := struct {
+		Model     string    `json:"model"`
+		System    string    `json:"system,omitempty"`
+		Messages  []message `json:"messages"`
+		MaxTokens int       `json:"max_tokens"`
+	}{
+		Model:  p.model,
+		System: system,
+		Messages: []message{
+			{Role: "user", Content: prompt},
+		},
+		MaxTokens: p.maxTokens,
+	}
+
+	var response struct {
+		Content []struct {
+			Type string `json:"type"`
+			Text string `json:"text"`
+		} `json:"content"`
+	}
+
+	headers := map[string]string{
+		"x-api-key":         p.apiKey,
+		"anthropic-version": anthropicVersion,
+	}
+
+	if err := postJSON(ctx, p.client, p.baseURL+"/messages", headers, request, &response); err != nil {
+		return "", fmt.Errorf("anthropic request failed: %w", err)
+	}
+
+	var text strings.Builder
+	for _, block := range response.Content {
+		if block.Type == "text" {
+			text.WriteString(block.Text)
+		}
+	}
+
+	if text.Len() == 0 {
+		return "", fmt.Errorf("anthropic returned no text")
+	}
+
+	return text.String(), nil
+}
+
+// postJSON sends a JSON POST request and decodes the JSON response into out
+func postJSON(ctx context.Context, client *http.Client, url string, headers map[string]string, in, out any) error {
+	jsonData, err := json.Marshal(in)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("status %d: %s", resp.StatusCode, string(body))
+	}
+
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/r-scheele/zero/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenAIProvider(t *testing.T) {
+	var received struct {
+		Model     string `json:"model"`
+		MaxTokens int    `json:"max_tokens"`
+		Messages  []struct {
+			Role    string `json:"role"`
+			Content string `json:"content"`
+		} `json:"messages"`
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/chat/completions", r.URL.Path)
+		assert.Equal(t, "Bearer sk-test", r.Header.Get("Authorization"))
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		_, _ = w.Write([]byte(`{"choices":[{"message":{"role":"assistant","content":"Mitochondria"}}]}`))
+	}))
+	defer server.Close()
+
+	provider := NewOpenAIProvider(config.OpenAIConfig{APIKey: "sk-test", Model: "gpt-4", MaxTokens: 100}, server.URL+"/")
+	reply, err := provider.Complete(context.Background(), "Be brief.", "What powers the cell?")
+	require.NoError(t, err)
+	assert.Equal(t, "Mitochondria", reply)
+
+	assert.Equal(t, "gpt-4", received.Model)
+	assert.Equal(t, 100, received.MaxTokens)
+	require.Len(t, received.Messages, 2)
+	assert.Equal(t, "system", received.Messages[0].Role)
+	assert.Equal(t, "Be brief.", received.Messages[0].Content)
+	assert.Equal(t, "user", received.Messages[1].Role)
+	assert.Equal(t, "What powers the cell?", received.Messages[1].Content)
+}
+
+func TestAnthropicProvider(t *testing.T) {
+	var received struct {
+		Model     string `json:"model"`
+		System    string `json:"system"`
+		MaxTokens int    `json:"max_tokens"`
+		Messages  []struct {
+			Role    string `json:"role"`
+			Content string `json:"content"`
+		} `json:"messages"`
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/messages", r.URL.Path)
+		assert.Equal(t, "key-test", r.Header.Get("x-api-key"))
+		assert.Equal(t, anthropicVersion, r.Header.Get("anthropic-version"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		_, _ = w.Write([]byte(`{"content":[{"type":"text","text":"Mito"},{"type":"tool_use"},{"type":"text","text":"chondria"}]}`))
+	}))
+	defer server.Close()
+
+	// The messages API requires max_tokens, so one is set if the config has none
+	provider := NewAnthropicProvider(config.AnthropicConfig{APIKey: "key-test", Model: "claude"}, server.URL)
+	reply, err := provider.Complete(context.Background(), "Be brief.", "What powers the cell?")
+	require.NoError(t, err)
+	assert.Equal(t, "Mitochondria", reply)
+
+	assert.Equal(t, "claude", received.Model)
+	assert.Equal(t, "Be brief.", received.System)
+	assert.Equal(t, 4000, received.MaxTokens)
+	require.Len(t, received.Messages, 1)
+	assert.Equal(t, "user", received.Messages[0].Role)
+}
+
+func TestAIProviderErrors(t *testing.T) {
+	status := http.StatusTooManyRequests
+	body := `{"error":{"message":"rate limited"}}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	openai := NewOpenAIProvider(config.OpenAIConfig{APIKey: "sk-test"}, server.URL)
+	anthropic := NewAnthropicProvider(config.AnthropicConfig{APIKey: "key-test"}, server.URL)
+
+	_, err := openai.Complete(context.Background(), "", "prompt")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "status 429")
+	assert.Contains(t, err.Error(), "rate limited")
+	_, err = anthropic.Complete(context.Background(), "", "prompt")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "status 429")
+
+	// Successful responses with nothing in them are errors too
+	status, body = http.StatusOK, `{"choices":[],"content":[]}`
+	_, err = openai.Complete(context.Background(), "", "prompt")
+	assert.Error(t, err)
+	_, err = anthropic.Complete(context.Background(), "", "prompt")
+	assert.Error(t, err)
+}
+
+func TestNewAIProvider(t *testing.T) {
+	cfg := config.AIConfig{
+		OpenAI:    config.OpenAIConfig{Enabled: true, APIKey: "sk-test"},
+		Anthropic: config.AnthropicConfig{Enabled: true, APIKey: "key-test"},
+	}
+	assert.Nil(t, NewAIProvider(cfg))
+
+	cfg.Enabled = true
+	assert.Equal(t, "openai", NewAIProvider(cfg).Name())
+
+	cfg.OpenAI.APIKey = ""
+	assert.Equal(t, "anthropic", NewAIProvider(cfg).Name())
+
+	cfg.Anthropic.Enabled = false
+	assert.Nil(t, NewAIProvider(cfg))
+}
@@ -0,0 +1,434 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/r-scheele/zero/ent"
+	"github.com/r-scheele/zero/ent/blob"
+	"github.com/r-scheele/zero/ent/blobreference"
+)
+
+var (
+	// ErrBlobReferenceNotFound is returned when something doesn't hold a reference to a stored file
+	ErrBlobReferenceNotFound = errors.New("file reference not found")
+
+	// errBlobPruned is returned when a stored file is pruned after it was looked up but before it
+	// could be referenced, so it has to be stored again
+	errBlobPruned = errors.New("file was deleted before it could be referenced")
+)
+
+// blobPutAttempts is the number of times a file is stored when it keeps being pruned before it
+// can be referenced
+const blobPutAttempts = 3
+
+// BlobService stores uploaded files once per distinct content. Files are keyed by the SHA-256 of
+// their content, and each note, document or profile using a file holds a reference to it that is
+// charged to the uploader's quota. A file is deleted when its last reference is released.
+type BlobService struct {
+	orm     *ent.Client
+	storage StorageService
+	quota   int64
+}
+
+// NewBlobService creates a new blob service. The quota is the number of bytes each user may
+// store unless an admin has set their own, and zero means no limit.
+func NewBlobService(orm *ent.Client, storage StorageService, quota int64) *BlobService {
+	return &BlobService{
+		orm:     orm,
+		storage: storage,
+		quota:   quota,
+	}
+}
+
+// BlobRef identifies a use of a stored file and the user it is charged to
+type BlobRef struct {
+	OwnerID  int
+	Kind     blobreference.Kind
+	TargetID int
+}
+
+var blobExtension = regexp.MustCompile(`^\.[a-z0-9]{1,10}$`)
+
+// BlobKey returns the storage key of content with the given hash. The extension of the file name
+// is kept so the file is served with the right content type.
+func BlobKey(hash, name string) string {
+	ext := strings.ToLower(path.Ext(path.Base(strings.ReplaceAll(name, "\\", "/"))))
+	if !blobExtension.MatchString(ext) {
+		ext = ""
+	}
+	return fmt.Sprintf("blobs/%s/%s%s", hash[:2], hash, ext)
+}
+
+// Store stores a file, unless the same content is already stored, and adds a reference to it.
+// ErrQuotaExceeded is returned, before anything is stored, if the owner can't afford the file.
+func (s *BlobService) Store(ctx context.Context, ref BlobRef, name string, body io.ReadSeeker, mimeType string) (*ent.Blob, error) {
+	size, err := body.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file size: %w", err)
+	}
+	if err := s.CheckQuota(ctx, ref.OwnerID, size); err != nil {
+		return nil, err
+	}
+
+	for attempt := 1; ; attempt++ {
+		b, err := s.Put(ctx, name, body, mimeType)
+		if err != nil {
+			return nil, err
+		}
+
+		err = s.Reference(ctx, b, ref)
+		if errors.Is(err, errBlobPruned) && attempt < blobPutAttempts {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}
+}
+
+// Put stores a file, unless the same content is already stored, without referencing it. Callers
+// that don't go on to reference the file should Prune it. A file that is already stored may be
+// pruned before it's referenced, in which case referencing it fails with errBlobPruned and it has
+// to be stored again.
+func (s *BlobService) Put(ctx context.Context, name string, body io.ReadSeeker, mimeType string) (*ent.Blob, error) {
+	if _, err := body.Seek(0, io.SeekStart); err != nil {
+		return nil, fmt.Errorf("failed to rewind file: %w", err)
+	}
+	h := sha256.New()
+	size, err := io.Copy(h, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+	if _, err := body.Seek(0, io.SeekStart); err != nil {
+		return nil, fmt.Errorf("failed to rewind file: %w", err)
+	}
+	hash := hex.EncodeToString(h.Sum(nil))
+
+	existing, err := s.orm.Blob.Query().
+		Where(blob.Hash(hash)).
+		Only(ctx)
+	switch {
+	case err == nil:
+		return existing, nil
+	case !ent.IsNotFound(err):
+		return nil, fmt.Errorf("failed to fetch file: %w", err)
+	}
+
+	key := BlobKey(hash, name)
+	if _, err := s.storage.UploadFile(ctx, key, body, mimeType); err != nil {
+		return nil, fmt.Errorf("failed to store file: %w", err)
+	}
+
+	b, err := s.orm.Blob.Create().
+		SetHash(hash).
+		SetStorageKey(key).
+		SetMimeType(mimeType).
+		SetSize(size).
+		Save(ctx)
+	if err != nil {
+		// The same content was stored at the same time, under the same key
+		if ent.IsConstraintError(err) {
+			if b, err := s.orm.Blob.Query().Where(blob.Hash(hash)).Only(ctx); err == nil {
+				return b, nil
+			}
+		}
+		return nil, fmt.Errorf("failed to save file: %w", err)
+	}
+
+	return b, nil
+}
+
+// Reference adds a reference to a stored file, charging its size to the owner. ErrQuotaExceeded
+// is returned if the owner can't afford it, and errBlobPruned if the file has since been pruned.
+func (s *BlobService) Reference(ctx context.Context, b *ent.Blob, ref BlobRef) error {
+	tx, err := s.orm.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	if err := checkBlob(ctx, tx, b); err != nil {
+		return rollback(tx, err)
+	}
+
+	if err := s.checkQuota(ctx, tx.Client(), ref.OwnerID, b.Size); err != nil {
+		return rollback(tx, err)
+	}
+
+	err = tx.BlobReference.Create().
+		SetBlob(b).
+		SetOwnerID(ref.OwnerID).
+		SetKind(ref.Kind).
+		SetTargetID(ref.TargetID).
+		SetSize(b.Size).
+		Exec(ctx)
+	if err != nil {
+		return rollback(tx, fmt.Errorf("failed to reference file: %w", err))
+	}
+
+	if err := tx.User.UpdateOneID(ref.OwnerID).AddStorageUsed(b.Size).Exec(ctx); err != nil {
+		return rollback(tx, fmt.Errorf("failed to update storage used: %w", err))
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// Replace stores new content for a file something references, moving the reference and its
+// charge to the new content and releasing the old. The quota isn't checked, since the content is
+// replaced by the app rather than uploaded. ErrBlobReferenceNotFound is returned if the target
+// doesn't reference the file with the given key.
+func (s *BlobService) Replace(ctx context.Context, kind blobreference.Kind, targetID int, key, name string, body io.ReadSeeker, mimeType string) (*ent.Blob, error) {
+	ref, err := s.orm.BlobReference.Query().
+		Where(
+			blobreference.KindEQ(kind),
+			blobreference.TargetID(targetID),
+			blobreference.HasBlobWith(blob.StorageKey(key)),
+		).
+		WithBlob().
+		WithOwner().
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrBlobReferenceNotFound
+		}
+		return nil, fmt.Errorf("failed to fetch file reference: %w", err)
+	}
+
+	for attempt := 1; ; attempt++ {
+		b, err := s.Put(ctx, name, body, mimeType)
+		if err != nil {
+			return nil, err
+		}
+		if b.ID == ref.Edges.Blob.ID {
+			return b, nil
+		}
+
+		err = s.moveReference(ctx, ref, b)
+		if errors.Is(err, errBlobPruned) && attempt < blobPutAttempts {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.Prune(ctx, ref.Edges.Blob); err != nil {
+			return nil, err
+		}
+
+		return b, nil
+	}
+}
+
+// moveReference points a reference, which must have its owner loaded, at another stored file,
+// moving its charge to the new file's size
+func (s *BlobService) moveReference(ctx context.Context, ref *ent.BlobReference, b *ent.Blob) error {
+	tx, err := s.orm.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	if err := checkBlob(ctx, tx, b); err != nil {
+		return rollback(tx, err)
+	}
+
+	err = tx.BlobReference.UpdateOne(ref).
+		SetBlob(b).
+		SetSize(b.Size).
+		Exec(ctx)
+	if err != nil {
+		return rollback(tx, fmt.Errorf("failed to update file reference: %w", err))
+	}
+
+	err = tx.User.UpdateOneID(ref.Edges.Owner.ID).
+		AddStorageUsed(b.Size - ref.Size).
+		Exec(ctx)
+	if err != nil {
+		return rollback(tx, fmt.Errorf("failed to update storage used: %w", err))
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// checkBlob returns errBlobPruned if a stored file has been pruned since it was looked up. It's
+// checked in the transaction that references the file, so Prune can't delete it in between.
+func checkBlob(ctx context.Context, tx *ent.Tx, b *ent.Blob) error {
+	exists, err := tx.Blob.Query().Where(blob.ID(b.ID)).Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch file: %w", err)
+	}
+	if !exists {
+		return errBlobPruned
+	}
+	return nil
+}
+
+// Release removes one of the target's references to the file with the given key, deleting the
+// file if nothing else references it. Files stored before content addressing have no references
+// and are deleted straight away.
+func (s *BlobService) Release(ctx context.Context, kind blobreference.Kind, targetID int, key string) error {
+	b, err := s.orm.Blob.Query().
+		Where(blob.StorageKey(key)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			if err := s.storage.DeleteFile(ctx, key); err != nil {
+				return fmt.Errorf("failed to delete file: %w", err)
+			}
+			return nil
+		}
+		return fmt.Errorf("failed to fetch file: %w", err)
+	}
+
+	ref, err := s.orm.BlobReference.Query().
+		Where(
+			blobreference.KindEQ(kind),
+			blobreference.TargetID(targetID),
+			blobreference.HasBlobWith(blob.ID(b.ID)),
+		).
+		WithOwner().
+		First(ctx)
+	switch {
+	case err == nil:
+		if err := s.release(ctx, ref); err != nil {
+			return err
+		}
+	case !ent.IsNotFound(err):
+		return fmt.Errorf("failed to fetch file reference: %w", err)
+	}
+
+	return s.Prune(ctx, b)
+}
+
+// ReleaseAll removes the target's references to files, such as when it is deleted, and deletes
+// the files nothing else references. One reference to each of the keys to keep is left in place.
+func (s *BlobService) ReleaseAll(ctx context.Context, kind blobreference.Kind, targetID int, keep ...string) error {
+	refs, err := s.orm.BlobReference.Query().
+		Where(
+			blobreference.KindEQ(kind),
+			blobreference.TargetID(targetID),
+		).
+		WithBlob().
+		WithOwner().
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch file references: %w", err)
+	}
+
+	kept := make(map[string]bool, len(keep))
+	for _, key := range keep {
+		kept[key] = false
+	}
+
+	var release []*ent.BlobReference
+	released := make(map[int]*ent.Blob)
+	for _, ref := range refs {
+		key := ref.Edges.Blob.StorageKey
+		if done, ok := kept[key]; ok && !done {
+			kept[key] = true
+			continue
+		}
+		release = append(release, ref)
+		released[ref.Edges.Blob.ID] = ref.Edges.Blob
+	}
+
+	if err := s.release(ctx, release...); err != nil {
+		return err
+	}
+
+	for _, b := range released {
+		if err := s.Prune(ctx, b); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// release deletes references, which must have their owners loaded, refunding their charges
+func (s *BlobService) release(ctx context.Context, refs ...*ent.BlobReference) error {
+	if len(refs) == 0 {
+		return nil
+	}
+
+	tx, err := s.orm.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	for _, ref := range refs {
+		if err := tx.BlobReference.DeleteOneID(ref.ID).Exec(ctx); err != nil {
+			return rollback(tx, fmt.Errorf("failed to release file: %w", err))
+		}
+		err := tx.User.UpdateOneID(ref.Edges.Owner.ID).
+			AddStorageUsed(-ref.Size).
+			Exec(ctx)
+		if err != nil {
+			return rollback(tx, fmt.Errorf("failed to update storage used: %w", err))
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// Prune deletes a stored file, and any thumbnails of it, if nothing references it
+func (s *BlobService) Prune(ctx context.Context, b *ent.Blob) error {
+	tx, err := s.orm.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	referenced, err := tx.BlobReference.Query().
+		Where(blobreference.HasBlobWith(blob.ID(b.ID))).
+		Exist(ctx)
+	if err != nil {
+		return rollback(tx, fmt.Errorf("failed to count file references: %w", err))
+	}
+	if referenced {
+		return tx.Rollback()
+	}
+
+	// A file that was already pruned may have been stored again under the same key since
+	err = tx.Blob.DeleteOneID(b.ID).Exec(ctx)
+	if ent.IsNotFound(err) {
+		return tx.Rollback()
+	}
+	if err != nil {
+		return rollback(tx, fmt.Errorf("failed to delete file record: %w", err))
+	}
+
+	// The file is deleted before the record is committed, so content stored again under the same
+	// key once the record is gone isn't deleted with it
+	if err := s.storage.DeleteFile(ctx, b.StorageKey); err != nil {
+		return rollback(tx, fmt.Errorf("failed to delete file: %w", err))
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	// Not every image gets thumbnails, so missing ones are ignored
+	if strings.HasPrefix(b.MimeType, "image/") {
+		for size := range ThumbnailSizes {
+			_ = s.storage.DeleteFile(ctx, ThumbnailKey(b.StorageKey, size))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,238 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/r-scheele/zero/ent/blobreference"
+	"github.com/r-scheele/zero/pkg/tests"
+	"github.com/r-scheele/zero/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlobKey(t *testing.T) {
+	hash := strings.Repeat("ab", 32)
+	assert.Equal(t, "blobs/ab/"+hash+".pdf", BlobKey(hash, "Lecture 1.PDF"))
+	assert.Equal(t, "blobs/ab/"+hash, BlobKey(hash, "notes"))
+	assert.Equal(t, "blobs/ab/"+hash, BlobKey(hash, "archive.tar.g z"))
+	assert.Equal(t, "blobs/ab/"+hash+".txt", BlobKey(hash, `..\\dir\\notes.txt`))
+}
+
+func TestBlobService(t *testing.T) {
+	ctx := context.Background()
+	storage := &memoryStorage{files: make(map[string]string)}
+	blobs := NewBlobService(c.ORM, storage, 0)
+	docs := NewDocumentService(c.ORM, storage, blobs)
+
+	alice, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	bob, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+
+	// The same lecture uploaded to two notes, a document and a profile is stored once
+	const lecture = "%PDF- the cell"
+	first, err := blobs.Store(ctx, BlobRef{OwnerID: alice.ID, Kind: blobreference.KindNote, TargetID: 1}, "cells.pdf", strings.NewReader(lecture), "application/pdf")
+	require.NoError(t, err)
+	second, err := blobs.Store(ctx, BlobRef{OwnerID: bob.ID, Kind: blobreference.KindNote, TargetID: 2}, "week 1.pdf", strings.NewReader(lecture), "application/pdf")
+	require.NoError(t, err)
+	assert.Equal(t, first.ID, second.ID)
+	assert.Equal(t, int64(len(lecture)), first.Size)
+
+	doc, err := docs.Upload(ctx, bob.ID, DocumentUpload{
+		FileName: "lecture.pdf",
+		MimeType: "application/pdf",
+		Size:     int64(len(lecture)),
+		Body:     strings.NewReader(lecture),
+	}, DocumentInput{})
+	require.NoError(t, err)
+	assert.Equal(t, first.StorageKey, doc.StorageKey)
+
+	_, err = blobs.Store(ctx, BlobRef{OwnerID: alice.ID, Kind: blobreference.KindProfile, TargetID: alice.ID}, "me.pdf", strings.NewReader(lecture), "application/pdf")
+	require.NoError(t, err)
+	assert.Len(t, storage.files, 1)
+	assert.Equal(t, lecture, storage.files[first.StorageKey])
+
+	// Each uploader is charged for each of their uploads
+	usage, err := blobs.Usage(ctx, alice.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2*len(lecture)), usage.Used)
+	usage, err = blobs.Usage(ctx, bob.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2*len(lecture)), usage.Used)
+
+	// The file survives until its last reference is released
+	require.NoError(t, blobs.Release(ctx, blobreference.KindNote, 1, first.StorageKey))
+	require.NoError(t, blobs.ReleaseAll(ctx, blobreference.KindNote, 2))
+	require.NoError(t, docs.Delete(ctx, doc.ID, bob.ID))
+	assert.Contains(t, storage.files, first.StorageKey)
+	usage, err = blobs.Usage(ctx, bob.ID)
+	require.NoError(t, err)
+	assert.Zero(t, usage.Used)
+
+	// Replacing the profile picture keeps the new one and releases the old
+	picture, err := blobs.Store(ctx, BlobRef{OwnerID: alice.ID, Kind: blobreference.KindProfile, TargetID: alice.ID}, "me.png", strings.NewReader("png"), "image/png")
+	require.NoError(t, err)
+	require.NoError(t, blobs.ReleaseAll(ctx, blobreference.KindProfile, alice.ID, picture.StorageKey))
+	assert.NotContains(t, storage.files, first.StorageKey)
+	assert.Contains(t, storage.files, picture.StorageKey)
+	usage, err = blobs.Usage(ctx, alice.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), usage.Used)
+
+	// Replacing content moves the reference and its charge
+	stripped, err := blobs.Replace(ctx, blobreference.KindProfile, alice.ID, picture.StorageKey, "me.png", strings.NewReader("png!"), "image/png")
+	require.NoError(t, err)
+	assert.NotEqual(t, picture.StorageKey, stripped.StorageKey)
+	assert.NotContains(t, storage.files, picture.StorageKey)
+	usage, err = blobs.Usage(ctx, alice.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), usage.Used)
+	_, err = blobs.Replace(ctx, blobreference.KindProfile, bob.ID, stripped.StorageKey, "me.png", strings.NewReader("png"), "image/png")
+	assert.ErrorIs(t, err, ErrBlobReferenceNotFound)
+
+	// Files stored before content addressing are deleted when released
+	storage.files["notes/3/old.pdf"] = "old"
+	require.NoError(t, blobs.Release(ctx, blobreference.KindNote, 3, "notes/3/old.pdf"))
+	assert.NotContains(t, storage.files, "notes/3/old.pdf")
+}
+
+func TestNotesServiceBlobs(t *testing.T) {
+	ctx := context.Background()
+	storage := &memoryStorage{files: make(map[string]string)}
+	blobs := NewBlobService(c.ORM, storage, 0)
+	notes := NewNotesService(c.ORM, c.Files, c.Cache, c.Config, blobs)
+
+	owner, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	first, err := notes.CreateNote(ctx, owner.ID, CreateNoteInput{Title: "Cells", Visibility: "private", PermissionLevel: "read_only"})
+	require.NoError(t, err)
+	second, err := notes.CreateNote(ctx, owner.ID, CreateNoteInput{Title: "Biology", Visibility: "private", PermissionLevel: "read_only"})
+	require.NoError(t, err)
+
+	ref := BlobRef{OwnerID: owner.ID, Kind: blobreference.KindNote}
+	for _, n := range []int{first.ID, second.ID} {
+		ref.TargetID = n
+		stored, err := blobs.Store(ctx, ref, "cells.pdf", strings.NewReader("%PDF-"), "application/pdf")
+		require.NoError(t, err)
+		require.NoError(t, notes.AddResourceToNote(ctx, n, owner.ID, types.Resource{
+			Type:       "pdf",
+			Name:       "cells.pdf",
+			Key:        stored.StorageKey,
+			Size:       stored.Size,
+			Thumbnails: map[string]string{ThumbnailSmall: "thumbnails/cells.jpg"},
+			ScanStatus: types.ScanClean,
+		}))
+	}
+	require.Len(t, storage.files, 1)
+
+	// Editing a note's resources keeps the files already on it, matched by their storage keys,
+	// and ignores keys the note doesn't have
+	first, err = c.ORM.Note.Get(ctx, first.ID)
+	require.NoError(t, err)
+	key := first.Resources[0].Key
+	kept := []Resource{
+		{Type: "url", Name: "Lecture", URL: "https://example.com/lecture"},
+		{Type: "pdf", Name: "stolen.pdf", Key: "blobs/someone/else.pdf"},
+		{Type: "pdf", Name: "renamed.pdf", Key: key, Size: 1},
+	}
+	first, err = notes.UpdateNote(ctx, first.ID, owner.ID, UpdateNoteInput{Resources: &kept})
+	require.NoError(t, err)
+	require.Len(t, first.Resources, 3)
+	assert.Empty(t, first.Resources[0].Key)
+	assert.Empty(t, first.Resources[1].Key)
+	assert.Equal(t, "renamed.pdf", first.Resources[2].Name)
+	assert.Equal(t, key, first.Resources[2].Key)
+	assert.Equal(t, int64(5), first.Resources[2].Size)
+	assert.Equal(t, "thumbnails/cells.jpg", first.Resources[2].Thumbnails[ThumbnailSmall])
+	assert.True(t, first.Resources[2].Available())
+	assert.Contains(t, storage.files, key)
+
+	none := []Resource{}
+	_, err = notes.UpdateNote(ctx, first.ID, owner.ID, UpdateNoteInput{Resources: &none})
+	require.NoError(t, err)
+	assert.Contains(t, storage.files, key)
+
+	// Deleting the last note using the file deletes it
+	require.NoError(t, notes.DeleteNote(ctx, second.ID, owner.ID))
+	assert.NotContains(t, storage.files, key)
+}
+
+func TestBlobServiceQuota(t *testing.T) {
+	ctx := context.Background()
+	storage := &memoryStorage{files: make(map[string]string)}
+	blobs := NewBlobService(c.ORM, storage, 10)
+	notes := NewNotesService(c.ORM, c.Files, c.Cache, c.Config, blobs)
+
+	owner, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	n, err := notes.CreateNote(ctx, owner.ID, CreateNoteInput{Title: "Cells", Visibility: "private", PermissionLevel: "read_only"})
+	require.NoError(t, err)
+
+	ref := BlobRef{OwnerID: owner.ID, Kind: blobreference.KindNote, TargetID: n.ID}
+	_, err = blobs.Store(ctx, ref, "cells.pdf", strings.NewReader("%PDF-cell"), "application/pdf")
+	require.NoError(t, err)
+	_, err = blobs.Store(ctx, BlobRef{OwnerID: owner.ID, Kind: blobreference.KindProfile, TargetID: owner.ID}, "me.png", strings.NewReader("p"), "image/png")
+	require.NoError(t, err)
+
+	// Uploads over the configured quota are refused, even when the content is already stored
+	_, err = blobs.Store(ctx, ref, "more.pdf", strings.NewReader("%PDF-more"), "application/pdf")
+	assert.ErrorIs(t, err, ErrQuotaExceeded)
+	_, err = blobs.Store(ctx, ref, "again.pdf", strings.NewReader("%PDF-cell"), "application/pdf")
+	assert.ErrorIs(t, err, ErrQuotaExceeded)
+	assert.Len(t, storage.files, 2)
+
+	usage, err := blobs.Usage(ctx, owner.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), usage.Used)
+	assert.Equal(t, int64(10), usage.Quota)
+	assert.Equal(t, 100, usage.Percent())
+	assert.Zero(t, usage.Remaining())
+	assert.Equal(t, []NoteStorage{{NoteID: n.ID, Title: "Cells", Files: 1, Bytes: 9}}, usage.Notes)
+	assert.Equal(t, []TypeStorage{{Type: "pdf", Files: 1, Bytes: 9}, {Type: "image", Files: 1, Bytes: 1}}, usage.Types)
+	assert.Equal(t, int64(1), usage.Profile)
+
+	// An admin can raise a user's quota, or lift it
+	owner, err = owner.Update().SetStorageQuota(20).Save(ctx)
+	require.NoError(t, err)
+	_, err = blobs.Store(ctx, ref, "more.pdf", strings.NewReader("%PDF-more"), "application/pdf")
+	require.NoError(t, err)
+	owner, err = owner.Update().SetStorageQuota(0).Save(ctx)
+	require.NoError(t, err)
+	require.NoError(t, blobs.CheckQuota(ctx, owner.ID, 1<<40))
+	usage, err = blobs.Usage(ctx, owner.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(-1), usage.Remaining())
+
+	// Releasing files frees up space
+	_, err = owner.Update().ClearStorageQuota().Save(ctx)
+	require.NoError(t, err)
+	require.NoError(t, notes.DeleteNote(ctx, n.ID, owner.ID))
+	require.NoError(t, blobs.CheckQuota(ctx, owner.ID, 9))
+	assert.ErrorIs(t, blobs.CheckQuota(ctx, owner.ID, 10), ErrQuotaExceeded)
+}
+
+func TestBlobServicePrunedBeforeReference(t *testing.T) {
+	ctx := context.Background()
+	storage := &memoryStorage{files: make(map[string]string)}
+	blobs := NewBlobService(c.ORM, storage, 0)
+
+	owner, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+
+	// A file looked up by Put and pruned before it's referenced can't be referenced
+	const notes = "pruned before reference"
+	b, err := blobs.Put(ctx, "notes.txt", strings.NewReader(notes), "text/plain")
+	require.NoError(t, err)
+	require.NoError(t, blobs.Prune(ctx, b))
+	assert.NotContains(t, storage.files, b.StorageKey)
+	err = blobs.Reference(ctx, b, BlobRef{OwnerID: owner.ID, Kind: blobreference.KindNote, TargetID: 1})
+	assert.ErrorIs(t, err, errBlobPruned)
+
+	// Once it's stored again, pruning the stale copy leaves the new file alone
+	stored, err := blobs.Store(ctx, BlobRef{OwnerID: owner.ID, Kind: blobreference.KindNote, TargetID: 1}, "notes.txt", strings.NewReader(notes), "text/plain")
+	require.NoError(t, err)
+	require.NoError(t, blobs.Prune(ctx, b))
+	assert.Equal(t, notes, storage.files[stored.StorageKey])
+}
@@ -54,6 +54,9 @@ type Container struct {
 	// Graph is the entity graph defined by your Ent schema.
 	Graph *gen.Graph
 
+	// Search stores the search service, which keeps the search index in sync with the ORM.
+	Search *SearchService
+
 	// Mail stores an email sending client.
 	Mail *MailClient
 
@@ -68,8 +71,35 @@ type Container struct {
 	// Notes stores the notes service.
 	Notes *NotesService
 
+	// Quiz stores the quiz service.
+	Quiz *QuizService
+
+	// StudyGroups stores the study group service.
+	StudyGroups *StudyGroupService
+
+	// Progress stores the learning progress service.
+	Progress *ProgressService
+
 	// Storage stores the cloud storage service.
 	Storage StorageService
+
+	// Blobs stores the service that shares stored files between uploads of the same content.
+	Blobs *BlobService
+
+	// Scanner stores the virus scanner uploads are checked with, or nil if scanning is disabled.
+	Scanner Scanner
+
+	// Curriculum stores the service that builds AI curricula for notes.
+	Curriculum *CurriculumService
+
+	// Markdown stores the service that renders note content written in markdown.
+	Markdown *MarkdownService
+
+	// Documents stores the document library service.
+	Documents *DocumentService
+
+	// Uploads stores the service for resumable uploads of large files.
+	Uploads *UploadService
 }
 
 // NewContainer creates and initializes a new Container.
@@ -82,12 +112,22 @@ func NewContainer() *Container {
 	c.initDatabase()
 	c.initFiles()
 	c.initORM()
+	c.initSearch()
 	c.initAuth()
 	c.initMail()
 	c.initTasks()
 	c.initStorage()
+	c.initBlobs()
+	c.initScanner()
 	c.initAPI()
 	c.initNotes()
+	c.initProgress()
+	c.initQuiz()
+	c.initCurriculum()
+	c.initMarkdown()
+	c.initStudyGroups()
+	c.initDocuments()
+	c.initUploads()
 	return c
 }
 
@@ -215,6 +255,14 @@ func (c *Container) initORM() {
 	c.Graph = g
 }
 
+// initSearch initializes the search service.
+func (c *Container) initSearch() {
+	var err error
+	if c.Search, err = NewSearchService(c.ORM, c.Database, c.Config.Database.Driver); err != nil {
+		panic(err)
+	}
+}
+
 // initAuth initializes the authentication client.
 func (c *Container) initAuth() {
 	c.Auth = NewAuthClient(c.Config, c.ORM, c.Cache)
@@ -253,45 +301,22 @@ func (c *Container) initTasks() {
 
 // initStorage initializes the storage service.
 func (c *Container) initStorage() {
-	// Check for cloud storage configuration from config
-	
-	// For S3:
-	if c.Config.Storage.AWS.Enabled && c.Config.Storage.AWS.Bucket != "" {
-		storage, err := NewS3StorageService(c.Config.Storage.AWS.Bucket, c.Config.Storage.AWS.Region)
-		if err != nil {
-			panic(fmt.Sprintf("failed to create S3 storage: %v", err))
-		}
-		c.Storage = storage
-		return
-	}
-	
-	// For GCS:
-	if c.Config.Storage.GCS.Enabled && c.Config.Storage.GCS.Bucket != "" {
-		storage, err := NewGCSStorageService(c.Config.Storage.GCS.Bucket, c.Config.Storage.GCS.ProjectID)
-		if err != nil {
-			panic(fmt.Sprintf("failed to create GCS storage: %v", err))
-		}
-		c.Storage = storage
-		return
+	// Use the first cloud storage provider that is configured, and default to local storage
+	backend := StorageBackendLocal
+	switch {
+	case c.Config.Storage.AWS.Enabled && c.Config.Storage.AWS.Bucket != "":
+		backend = StorageBackendS3
+	case c.Config.Storage.GCS.Enabled && c.Config.Storage.GCS.Bucket != "":
+		backend = StorageBackendGCS
+	case c.Config.Storage.Azure.Enabled && c.Config.Storage.Azure.Account != "":
+		backend = StorageBackendAzure
 	}
-	
-	// For Azure:
-	if c.Config.Storage.Azure.Enabled && c.Config.Storage.Azure.Account != "" {
-		storage, err := NewAzureBlobStorageService(c.Config.Storage.Azure.Account, c.Config.Storage.Azure.Key, c.Config.Storage.Azure.Container)
-		if err != nil {
-			panic(fmt.Sprintf("failed to create Azure storage: %v", err))
-		}
-		c.Storage = storage
-		return
-	}
-	
-	// Default to local storage if no cloud storage is configured
-	if c.Config.Storage.Local.Enabled {
-		c.Storage = NewLocalStorageService(c.Config.Storage.Local.BaseURL)
-	} else {
-		// Fallback to app host if local storage config is not set
-		c.Storage = NewLocalStorageService(c.Config.App.Host)
+
+	storage, err := NewStorageBackend(c.Config, backend, c.Files)
+	if err != nil {
+		panic(fmt.Sprintf("failed to create %s storage: %v", backend, err))
 	}
+	c.Storage = storage
 }
 
 // openDB opens a database connection.
@@ -315,6 +340,28 @@ func openDB(driver, connection string) (*sql.DB, error) {
 	return sql.Open(driver, connection)
 }
 
+// initBlobs initializes the content-addressed file storage service.
+func (c *Container) initBlobs() {
+	quota, err := GetUserQuota(c.Config)
+	if err != nil {
+		panic(err)
+	}
+	c.Blobs = NewBlobService(c.ORM, c.Storage, quota)
+}
+
+// initScanner initializes the virus scanner, if one is configured.
+func (c *Container) initScanner() {
+	if c.Config.FileUpload.Scanner.Clamd == "" {
+		return
+	}
+
+	scanner, err := NewClamdScanner(c.Config.FileUpload.Scanner.Clamd, c.Config.FileUpload.Scanner.Timeout)
+	if err != nil {
+		panic(fmt.Sprintf("failed to create virus scanner: %v", err))
+	}
+	c.Scanner = scanner
+}
+
 func (c *Container) initAPI() {
 	// Initialize API service
 	c.API = NewAPIService(c.ORM, c.Auth, c.Mail, c.Files, c.Config)
@@ -322,5 +369,40 @@ func (c *Container) initAPI() {
 
 // initNotes initializes the notes service.
 func (c *Container) initNotes() {
-	c.Notes = NewNotesService(c.ORM, c.Files, c.Cache, c.Config)
+	c.Notes = NewNotesService(c.ORM, c.Files, c.Cache, c.Config, c.Blobs)
+}
+
+// initProgress initializes the learning progress service.
+func (c *Container) initProgress() {
+	c.Progress = NewProgressService(c.ORM, c.Files)
+}
+
+// initQuiz initializes the quiz service.
+func (c *Container) initQuiz() {
+	c.Quiz = NewQuizService(c.ORM, NewQuestionGenerator(c.Config), c.Progress)
+}
+
+// initCurriculum initializes the curriculum service, which is disabled if no AI provider is configured.
+func (c *Container) initCurriculum() {
+	c.Curriculum = NewCurriculumService(c.ORM, NewAIProvider(c.Config.AI))
+}
+
+// initMarkdown initializes the markdown service.
+func (c *Container) initMarkdown() {
+	c.Markdown = NewMarkdownService(c.Cache, c.Config)
+}
+
+// initStudyGroups initializes the study group service.
+func (c *Container) initStudyGroups() {
+	c.StudyGroups = NewStudyGroupService(c.ORM)
+}
+
+// initDocuments initializes the document library service.
+func (c *Container) initDocuments() {
+	c.Documents = NewDocumentService(c.ORM, c.Storage, c.Blobs)
+}
+
+// initUploads initializes the resumable upload service.
+func (c *Container) initUploads() {
+	c.Uploads = NewUploadService(c.ORM, c.Files, c.Config, c.Blobs)
 }
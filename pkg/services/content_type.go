@@ -0,0 +1,128 @@
+package services
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/r-scheele/zero/config"
+)
+
+var (
+	// ErrContentTypeMismatch is returned when a file's content isn't the type it was uploaded as
+	ErrContentTypeMismatch = errors.New("file content does not match its type")
+
+	// ErrFileTypeNotAllowed is returned when a file's content is a type that can't be uploaded
+	ErrFileTypeNotAllowed = errors.New("file type is not allowed")
+)
+
+// ProfilePictureTypes are the types profile pictures can be. Only raster images are allowed,
+// since vector images such as SVG can contain scripts.
+var ProfilePictureTypes = []string{"image/png", "image/jpeg", "image/gif", "image/webp"}
+
+// sniffLength is the number of bytes read from the start of a file to work out its type
+const sniffLength = 512
+
+var (
+	// oleSignature starts the compound files used by older Office formats
+	oleSignature = []byte("\xD0\xCF\x11\xE0\xA1\xB1\x1A\xE1")
+
+	// containerTypes are the types of the formats files with these extensions are stored in,
+	// with the type of the file itself
+	containerTypes = map[string]map[string]string{
+		"application/zip": {
+			".docx": "application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+			".xlsx": "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+			".pptx": "application/vnd.openxmlformats-officedocument.presentationml.presentation",
+		},
+		"ole": {
+			".doc": "application/msword",
+			".xls": "application/vnd.ms-excel",
+			".ppt": "application/vnd.ms-powerpoint",
+		},
+	}
+
+	// typeAliases maps types clients send to the type sniffing finds for the same content
+	typeAliases = map[string]string{
+		"image/jpg":         "image/jpeg",
+		"image/pjpeg":       "image/jpeg",
+		"video/x-msvideo":   "video/avi",
+		"video/msvideo":     "video/avi",
+		"application/x-pdf": "application/pdf",
+	}
+)
+
+// SniffContentType works out the MIME type of a file from the first bytes of its content. The
+// file name's extension is only used to tell apart formats stored in the same kind of container,
+// such as Word documents, which are zip or OLE files.
+func SniffContentType(name string, head []byte) string {
+	sniffed := normalizeContentType(http.DetectContentType(head))
+	ext := strings.ToLower(filepath.Ext(name))
+
+	// QuickTime movies start with the same kind of box as MP4s, but with their own brand
+	if len(head) >= 12 && string(head[4:8]) == "ftyp" && string(head[8:12]) == "qt  " {
+		return "video/quicktime"
+	}
+
+	if sniffed == "application/octet-stream" && bytes.HasPrefix(head, oleSignature) {
+		sniffed = "ole"
+	}
+	if t, ok := containerTypes[sniffed][ext]; ok {
+		return t
+	}
+	if sniffed == "ole" {
+		return "application/octet-stream"
+	}
+
+	return sniffed
+}
+
+// VerifyContentType sniffs the type of a file, which is left seeked to its start, and checks it
+// is the type the client claimed and one of the types uploads are allowed to be. The sniffed
+// type is returned, so it can be used instead of the claimed one.
+func VerifyContentType(cfg *config.Config, name, claimed string, file io.ReadSeeker) (string, error) {
+	head := make([]byte, sniffLength)
+	n, err := io.ReadFull(file, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return "", fmt.Errorf("failed to read file: %w", err)
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return "", fmt.Errorf("failed to read file: %w", err)
+	}
+
+	sniffed := SniffContentType(name, head[:n])
+	claimed = normalizeContentType(claimed)
+
+	switch {
+	case claimed == "" || claimed == "application/octet-stream" || claimed == sniffed:
+	case sniffed == "text/plain" && strings.HasPrefix(claimed, "text/"):
+		// Plain text can't be told apart from other kinds of text, such as CSV
+	default:
+		return "", fmt.Errorf("%w: %s was uploaded as %s but contains %s", ErrContentTypeMismatch, name, claimed, sniffed)
+	}
+
+	if allowed := cfg.FileUpload.AllowedTypes; len(allowed) > 0 && !slices.Contains(allowed, sniffed) {
+		return "", fmt.Errorf("%w: %s", ErrFileTypeNotAllowed, sniffed)
+	}
+
+	return sniffed, nil
+}
+
+// normalizeContentType strips the parameters from a MIME type and replaces aliases with the
+// type sniffing finds
+func normalizeContentType(contentType string) string {
+	t, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		t = strings.ToLower(strings.TrimSpace(contentType))
+	}
+	if alias, ok := typeAliases[t]; ok {
+		return alias
+	}
+	return t
+}
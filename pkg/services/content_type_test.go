@@ -0,0 +1,80 @@
+package services
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/r-scheele/zero/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSniffContentType(t *testing.T) {
+	var docx bytes.Buffer
+	w := zip.NewWriter(&docx)
+	_, err := w.Create("word/document.xml")
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	tests := map[string]struct {
+		name     string
+		content  []byte
+		expected string
+	}{
+		"jpeg":      {"photo.jpg", []byte("\xFF\xD8\xFF\xE0\x00\x10JFIF\x00"), "image/jpeg"},
+		"png":       {"photo.png", []byte("\x89PNG\x0D\x0A\x1A\x0A\x00\x00\x00\x0DIHDR"), "image/png"},
+		"pdf":       {"notes.pdf", []byte("%PDF-1.7\n"), "application/pdf"},
+		"docx":      {"essay.docx", docx.Bytes(), "application/vnd.openxmlformats-officedocument.wordprocessingml.document"},
+		"zip":       {"essay.zip", docx.Bytes(), "application/zip"},
+		"doc":       {"essay.doc", append([]byte("\xD0\xCF\x11\xE0\xA1\xB1\x1A\xE1"), make([]byte, 32)...), "application/msword"},
+		"quicktime": {"clip.mov", []byte("\x00\x00\x00\x14ftypqt  \x00\x00\x00\x00qt  "), "video/quicktime"},
+		"text":      {"notes.txt", []byte("Lecture notes"), "text/plain"},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, SniffContentType(tc.name, tc.content))
+		})
+	}
+}
+
+func TestVerifyContentType(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.FileUpload.AllowedTypes = []string{"image/png", "application/pdf", "text/plain"}
+	pdf := "%PDF-1.7\n" + strings.Repeat("x", 1024)
+
+	// The sniffed type is returned, and the file can be read again from the start
+	file := strings.NewReader(pdf)
+	mimeType, err := VerifyContentType(cfg, "notes.pdf", "application/pdf", file)
+	require.NoError(t, err)
+	assert.Equal(t, "application/pdf", mimeType)
+	data, err := io.ReadAll(file)
+	require.NoError(t, err)
+	assert.Equal(t, pdf, string(data))
+
+	// Clients that don't know the type can leave it to be sniffed
+	mimeType, err = VerifyContentType(cfg, "notes.pdf", "application/octet-stream", strings.NewReader(pdf))
+	require.NoError(t, err)
+	assert.Equal(t, "application/pdf", mimeType)
+
+	// Plain text passes for other kinds of text
+	_, err = VerifyContentType(cfg, "marks.csv", "text/csv; charset=utf-8", strings.NewReader("name,mark\n"))
+	assert.NoError(t, err)
+
+	// Files can't pretend to be another type
+	_, err = VerifyContentType(cfg, "photo.png", "image/png", strings.NewReader("MZ\x90\x00\x03\x00\x00\x00"))
+	assert.ErrorIs(t, err, ErrContentTypeMismatch)
+	_, err = VerifyContentType(cfg, "notes.pdf", "application/pdf", strings.NewReader("<html><script>alert(1)</script>"))
+	assert.ErrorIs(t, err, ErrContentTypeMismatch)
+
+	// Files that are what they say must still be an allowed type
+	_, err = VerifyContentType(cfg, "photo.gif", "image/gif", strings.NewReader("GIF89a\x01\x00\x01\x00"))
+	assert.ErrorIs(t, err, ErrFileTypeNotAllowed)
+
+	// Every type is allowed when none are listed
+	_, err = VerifyContentType(&config.Config{}, "photo.gif", "image/gif", strings.NewReader("GIF89a\x01\x00\x01\x00"))
+	assert.NoError(t, err)
+}
@@ -0,0 +1,185 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/r-scheele/zero/ent"
+)
+
+var (
+	// ErrCurriculumDisabled is returned when no AI provider is configured to build curricula
+	ErrCurriculumDisabled = errors.New("AI curriculum generation is not enabled")
+
+	// ErrCurriculumNoText is returned when a note has no text to build a curriculum from
+	ErrCurriculumNoText = errors.New("note has no text to build a curriculum from")
+)
+
+// Curriculum is a structured study plan for a note, built by an AI provider
+type Curriculum struct {
+	// Overview summarises what the note covers
+	Overview string `json:"overview"`
+
+	// Modules are the units the material is split into, in the order they should be studied
+	Modules []CurriculumModule `json:"modules"`
+
+	// ReadingOrder lists the note's sections and attachments in the order they should be read
+	ReadingOrder []string `json:"reading_order,omitempty"`
+}
+
+// CurriculumModule is a single unit of a curriculum
+type CurriculumModule struct {
+	Title      string   `json:"title"`
+	Summary    string   `json:"summary,omitempty"`
+	Objectives []string `json:"objectives"`
+}
+
+// CurriculumService builds curricula for notes with an AI provider and stores them on the note
+type CurriculumService struct {
+	orm      *ent.Client
+	provider AIProvider
+}
+
+// NewCurriculumService creates a new curriculum service. The provider can be nil, in which case
+// curricula are never built.
+func NewCurriculumService(orm *ent.Client, provider AIProvider) *CurriculumService {
+	return &CurriculumService{
+		orm:      orm,
+		provider: provider,
+	}
+}
+
+// Enabled reports whether an AI provider is configured to build curricula
+func (s *CurriculumService) Enabled() bool {
+	return s.provider != nil
+}
+
+// Start marks a note as being processed, so a curriculum can be built for it in the background
+func (s *CurriculumService) Start(ctx context.Context, noteID int) error {
+	if !s.Enabled() {
+		return ErrCurriculumDisabled
+	}
+
+	if err := s.orm.Note.UpdateOneID(noteID).SetAiProcessing(true).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to mark note as processing: %w", err)
+	}
+	return nil
+}
+
+// Fail marks a note as no longer being processed, keeping any curriculum built before
+func (s *CurriculumService) Fail(ctx context.Context, noteID int) error {
+	if err := s.orm.Note.UpdateOneID(noteID).SetAiProcessing(false).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to clear note processing: %w", err)
+	}
+	return nil
+}
+
+// Generate builds a curriculum from a note's content and the text extracted from its
+// attachments, and stores it on the note
+func (s *CurriculumService) Generate(ctx context.Context, noteID int) (*Curriculum, error) {
+	if !s.Enabled() {
+		return nil, ErrCurriculumDisabled
+	}
+
+	n, err := s.orm.Note.Get(ctx, noteID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch note: %w", err)
+	}
+
+	text := NoteStudyText(n)
+	if text == "" {
+		return nil, ErrCurriculumNoText
+	}
+	text = truncateText(text, maxGeneratorInput)
+
+	var attachments []string
+	for _, r := range n.Resources {
+		if r.Available() {
+			attachments = append(attachments, r.Name)
+		}
+	}
+
+	system := "You design study plans. Reply only with a JSON object with the keys \"overview\", a short summary " +
+		"of the material; \"modules\", an array of objects with \"title\", \"summary\" and \"objectives\", a list of " +
+		"what the student should be able to do after the module; and \"reading_order\", the sections and attachments " +
+		"of the notes in the order they should be studied."
+	prompt := fmt.Sprintf("Build a curriculum for the notes titled %q.", n.Title)
+	if len(attachments) > 0 {
+		prompt += fmt.Sprintf("\n\nAttachments: %s", strings.Join(attachments, ", "))
+	}
+	prompt += "\n\n" + text
+
+	reply, err := s.provider.Complete(ctx, system, prompt)
+	if err != nil {
+		return nil, err
+	}
+
+	curriculum, err := ParseCurriculum(reply)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(curriculum)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode curriculum: %w", err)
+	}
+
+	err = s.orm.Note.UpdateOneID(noteID).
+		SetAiCurriculum(string(data)).
+		SetAiProcessing(false).
+		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save curriculum: %w", err)
+	}
+
+	return curriculum, nil
+}
+
+// ParseCurriculum extracts the curriculum from a model reply or a note's stored curriculum,
+// ignoring any surrounding prose or code fences
+func ParseCurriculum(reply string) (*Curriculum, error) {
+	start := strings.Index(reply, "{")
+	end := strings.LastIndex(reply, "}")
+	if start == -1 || end < start {
+		return nil, fmt.Errorf("no curriculum found in AI response")
+	}
+
+	var parsed Curriculum
+	if err := json.Unmarshal([]byte(reply[start:end+1]), &parsed); err != nil {
+		return nil, fmt.Errorf("failed to parse AI response: %w", err)
+	}
+
+	curriculum := &Curriculum{
+		Overview:     strings.TrimSpace(parsed.Overview),
+		ReadingOrder: trimStrings(parsed.ReadingOrder),
+	}
+	for _, m := range parsed.Modules {
+		if strings.TrimSpace(m.Title) == "" {
+			continue
+		}
+		curriculum.Modules = append(curriculum.Modules, CurriculumModule{
+			Title:      strings.TrimSpace(m.Title),
+			Summary:    strings.TrimSpace(m.Summary),
+			Objectives: trimStrings(m.Objectives),
+		})
+	}
+	if len(curriculum.Modules) == 0 {
+		return nil, fmt.Errorf("AI response has no curriculum modules")
+	}
+
+	return curriculum, nil
+}
+
+// trimStrings trims the strings in a list, dropping any that are empty
+func trimStrings(values []string) []string {
+	var trimmed []string
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			trimmed = append(trimmed, v)
+		}
+	}
+	return trimmed
+}
@@ -0,0 +1,116 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/r-scheele/zero/pkg/tests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testCurriculumReply = "Here is the plan:\n```json\n" + `{
+	"overview": "How cells produce energy.",
+	"modules": [
+		{"title": "Cell structure", "summary": "The parts of a cell.", "objectives": ["Name the organelles", " "]},
+		{"title": " ", "objectives": ["Dropped"]},
+		{"title": "Respiration", "objectives": ["Describe the Krebs cycle"]}
+	],
+	"reading_order": ["Introduction", "slides.pdf"]
+}` + "\n```"
+
+func TestParseCurriculum(t *testing.T) {
+	curriculum, err := ParseCurriculum(testCurriculumReply)
+	require.NoError(t, err)
+	assert.Equal(t, "How cells produce energy.", curriculum.Overview)
+	require.Len(t, curriculum.Modules, 2)
+	assert.Equal(t, "Cell structure", curriculum.Modules[0].Title)
+	assert.Equal(t, []string{"Name the organelles"}, curriculum.Modules[0].Objectives)
+	assert.Equal(t, "Respiration", curriculum.Modules[1].Title)
+	assert.Equal(t, []string{"Introduction", "slides.pdf"}, curriculum.ReadingOrder)
+
+	_, err = ParseCurriculum("I can't help with that.")
+	assert.Error(t, err)
+	_, err = ParseCurriculum(`{"overview": "Nothing", "modules": []}`)
+	assert.Error(t, err)
+}
+
+func TestCurriculumServiceGenerate(t *testing.T) {
+	ctx := context.Background()
+
+	owner, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	n, err := c.Notes.CreateNote(ctx, owner.ID, CreateNoteInput{
+		Title:           "Biology",
+		Content:         "Cells produce energy through respiration.",
+		Visibility:      "private",
+		PermissionLevel: "read_only",
+		Resources: []Resource{
+			{Type: "pdf", Name: "slides.pdf", ExtractedText: "The Krebs cycle."},
+		},
+	})
+	require.NoError(t, err)
+
+	// Nothing is built without a provider
+	disabled := NewCurriculumService(c.ORM, nil)
+	assert.False(t, disabled.Enabled())
+	assert.ErrorIs(t, disabled.Start(ctx, n.ID), ErrCurriculumDisabled)
+	_, err = disabled.Generate(ctx, n.ID)
+	assert.ErrorIs(t, err, ErrCurriculumDisabled)
+
+	provider := &recordingAIProvider{reply: testCurriculumReply}
+	curricula := NewCurriculumService(c.ORM, provider)
+	require.NoError(t, curricula.Start(ctx, n.ID))
+	n, err = c.ORM.Note.Get(ctx, n.ID)
+	require.NoError(t, err)
+	assert.True(t, n.AiProcessing)
+
+	// The note's content and the text of its attachments are sent to the provider
+	curriculum, err := curricula.Generate(ctx, n.ID)
+	require.NoError(t, err)
+	assert.Len(t, curriculum.Modules, 2)
+	assert.Contains(t, provider.prompt, "Cells produce energy")
+	assert.Contains(t, provider.prompt, "The Krebs cycle.")
+	assert.Contains(t, provider.prompt, "slides.pdf")
+
+	n, err = c.ORM.Note.Get(ctx, n.ID)
+	require.NoError(t, err)
+	assert.False(t, n.AiProcessing)
+	stored, err := ParseCurriculum(n.AiCurriculum)
+	require.NoError(t, err)
+	assert.Equal(t, curriculum, stored)
+
+	// A failed rebuild keeps the last curriculum
+	require.NoError(t, curricula.Start(ctx, n.ID))
+	provider.err = errors.New("rate limited")
+	_, err = curricula.Generate(ctx, n.ID)
+	assert.Error(t, err)
+	require.NoError(t, curricula.Fail(ctx, n.ID))
+	n, err = c.ORM.Note.Get(ctx, n.ID)
+	require.NoError(t, err)
+	assert.False(t, n.AiProcessing)
+	assert.NotEmpty(t, n.AiCurriculum)
+
+	// Notes without text can't have a curriculum
+	empty, err := c.Notes.CreateNote(ctx, owner.ID, CreateNoteInput{Title: "Empty", Visibility: "private", PermissionLevel: "read_only"})
+	require.NoError(t, err)
+	_, err = curricula.Generate(ctx, empty.ID)
+	assert.ErrorIs(t, err, ErrCurriculumNoText)
+}
+
+// recordingAIProvider replies with a fixed response and records the prompt it was sent
+type recordingAIProvider struct {
+	reply  string
+	err    error
+	prompt string
+}
+
+func (p *recordingAIProvider) Name() string {
+	return "recording"
+}
+
+func (p *recordingAIProvider) Complete(ctx context.Context, system, prompt string) (string, error) {
+	p.prompt = prompt
+	return p.reply, p.err
+}
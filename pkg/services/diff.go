@@ -0,0 +1,175 @@
+package services
+
+import (
+	"strings"
+)
+
+// DiffOp is what happened to a line between two versions of a text
+type DiffOp int
+
+const (
+	// DiffEqual is a line in both versions
+	DiffEqual DiffOp = iota
+
+	// DiffDelete is a line only in the old version
+	DiffDelete
+
+	// DiffInsert is a line only in the new version
+	DiffInsert
+)
+
+// maxDiffEdits caps the number of edits searched for between two texts. Texts that differ by
+// more are shown as the whole of the old text replaced by the new.
+const maxDiffEdits = 1000
+
+// DiffLine is a single line of a diff
+type DiffLine struct {
+	Op   DiffOp
+	Text string
+}
+
+// DiffStats counts the lines added and removed by a diff
+type DiffStats struct {
+	Added   int
+	Removed int
+}
+
+// LineDiff returns the shortest list of line insertions and deletions that turns the old text
+// into the new, using Myers' algorithm
+func LineDiff(old, new string) []DiffLine {
+	a, b := splitLines(old), splitLines(new)
+
+	// Lines the texts start and end with are the same, so only the middle is searched
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	diff := make([]DiffLine, 0, len(a)+len(b))
+	for _, line := range a[:prefix] {
+		diff = append(diff, DiffLine{Op: DiffEqual, Text: line})
+	}
+	diff = append(diff, myersDiff(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])...)
+	for _, line := range a[len(a)-suffix:] {
+		diff = append(diff, DiffLine{Op: DiffEqual, Text: line})
+	}
+
+	return diff
+}
+
+// CountDiff counts the lines added and removed by a diff
+func CountDiff(diff []DiffLine) DiffStats {
+	var stats DiffStats
+	for _, line := range diff {
+		switch line.Op {
+		case DiffInsert:
+			stats.Added++
+		case DiffDelete:
+			stats.Removed++
+		}
+	}
+	return stats
+}
+
+// Changed reports whether any lines were added or removed
+func (s DiffStats) Changed() bool {
+	return s.Added > 0 || s.Removed > 0
+}
+
+// myersDiff finds the shortest edit script between two lists of lines
+func myersDiff(a, b []string) []DiffLine {
+	n, m := len(a), len(b)
+	maxEdits := min(n+m, maxDiffEdits)
+	offset := maxEdits + 1
+
+	// v holds the furthest point reached in a on each diagonal, and trace keeps the diagonals
+	// reachable with each number of edits so the path can be walked back
+	v := make([]int, 2*offset+1)
+	var trace [][]int
+
+	found := false
+	for d := 0; d <= maxEdits && !found; d++ {
+		trace = append(trace, append([]int(nil), v[offset-d-1:offset+d+2]...))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				found = true
+				break
+			}
+		}
+	}
+
+	if !found {
+		diff := make([]DiffLine, 0, n+m)
+		for _, line := range a {
+			diff = append(diff, DiffLine{Op: DiffDelete, Text: line})
+		}
+		for _, line := range b {
+			diff = append(diff, DiffLine{Op: DiffInsert, Text: line})
+		}
+		return diff
+	}
+
+	// Walk back from the end, collecting the lines in reverse
+	var reversed []DiffLine
+	x, y := n, m
+	for d := len(trace) - 1; d >= 0; d-- {
+		v, offset := trace[d], d+1
+		k := x - y
+
+		var prevK int
+		if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := v[offset+prevK]
+		prevY := prevX - prevK
+
+		for x > prevX && y > prevY {
+			x--
+			y--
+			reversed = append(reversed, DiffLine{Op: DiffEqual, Text: a[x]})
+		}
+		if d == 0 {
+			break
+		}
+		if x == prevX {
+			y--
+			reversed = append(reversed, DiffLine{Op: DiffInsert, Text: b[y]})
+		} else {
+			x--
+			reversed = append(reversed, DiffLine{Op: DiffDelete, Text: a[x]})
+		}
+	}
+
+	diff := make([]DiffLine, len(reversed))
+	for i, line := range reversed {
+		diff[len(reversed)-1-i] = line
+	}
+	return diff
+}
+
+// splitLines splits text into lines, treating Windows line endings the same as Unix ones
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	return strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+}
@@ -0,0 +1,38 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLineDiff(t *testing.T) {
+	diff := LineDiff("a\nb\nc\nd\n", "a\nc\nx\nd")
+	assert.Equal(t, []DiffLine{
+		{Op: DiffEqual, Text: "a"},
+		{Op: DiffDelete, Text: "b"},
+		{Op: DiffEqual, Text: "c"},
+		{Op: DiffInsert, Text: "x"},
+		{Op: DiffEqual, Text: "d"},
+	}, diff)
+	assert.Equal(t, DiffStats{Added: 1, Removed: 1}, CountDiff(diff))
+
+	// Line endings don't count as changes
+	assert.False(t, CountDiff(LineDiff("a\r\nb\r\n", "a\nb")).Changed())
+
+	assert.Equal(t, []DiffLine{{Op: DiffInsert, Text: "new"}}, LineDiff("", "new"))
+	assert.Equal(t, []DiffLine{{Op: DiffDelete, Text: "old"}}, LineDiff("old", ""))
+	assert.Empty(t, LineDiff("", ""))
+}
+
+func TestLineDiffTooManyEdits(t *testing.T) {
+	var old, new []byte
+	for i := 0; i < maxDiffEdits; i++ {
+		old = append(old, "a\n"...)
+		new = append(new, "b\n"...)
+	}
+
+	// Texts that differ by too much are replaced wholesale
+	stats := CountDiff(LineDiff(string(old), string(new)))
+	assert.Equal(t, DiffStats{Added: maxDiffEdits, Removed: maxDiffEdits}, stats)
+}
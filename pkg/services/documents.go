@@ -0,0 +1,507 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	entsql "entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/r-scheele/zero/ent"
+	"github.com/r-scheele/zero/ent/blobreference"
+	"github.com/r-scheele/zero/ent/document"
+	"github.com/r-scheele/zero/ent/folder"
+	"github.com/r-scheele/zero/ent/note"
+	"github.com/r-scheele/zero/ent/user"
+)
+
+// DocumentLinkTTL is how long links to private documents stay valid
+const DocumentLinkTTL = time.Hour
+
+var (
+	// ErrDocumentNotFound is returned when a document does not exist or the user can't see it
+	ErrDocumentNotFound = errors.New("document not found")
+
+	// ErrFolderNotFound is returned when a folder does not exist or belongs to someone else
+	ErrFolderNotFound = errors.New("folder not found")
+)
+
+// DocumentService handles the document library: standalone uploaded files, the folders
+// they're filed in and the notes they're attached to
+type DocumentService struct {
+	orm     *ent.Client
+	storage StorageService
+	blobs   *BlobService
+}
+
+// NewDocumentService creates a new document service
+func NewDocumentService(orm *ent.Client, storage StorageService, blobs *BlobService) *DocumentService {
+	return &DocumentService{
+		orm:     orm,
+		storage: storage,
+		blobs:   blobs,
+	}
+}
+
+// DocumentInput represents the editable details of a document
+type DocumentInput struct {
+	Title       string   `json:"title"`
+	Description string   `json:"description"`
+	Tags        []string `json:"tags"`
+	Visibility  string   `json:"visibility"`
+	FolderID    int      `json:"folder_id"` // Zero files the document at the top level
+}
+
+// DocumentUpload describes an uploaded file
+type DocumentUpload struct {
+	FileName string
+	MimeType string
+	Size     int64
+	Body     io.ReadSeeker
+}
+
+// DocumentFilter selects documents in a user's library
+type DocumentFilter struct {
+	// FolderID lists the documents in a folder, or the top level when zero
+	FolderID int
+
+	// Tag lists the documents with a tag in every folder, ignoring FolderID
+	Tag string
+}
+
+// Upload stores a file and adds it to the user's library
+func (s *DocumentService) Upload(ctx context.Context, ownerID int, upload DocumentUpload, input DocumentInput) (*ent.Document, error) {
+	if err := s.checkFolder(ctx, ownerID, input.FolderID); err != nil {
+		return nil, err
+	}
+
+	if err := s.blobs.CheckQuota(ctx, ownerID, upload.Size); err != nil {
+		return nil, err
+	}
+
+	// The file is stored again if it's pruned before the document can reference it
+	for attempt := 1; ; attempt++ {
+		doc, err := s.upload(ctx, ownerID, upload, input)
+		if errors.Is(err, errBlobPruned) && attempt < blobPutAttempts {
+			continue
+		}
+		return doc, err
+	}
+}
+
+// upload stores a file and creates its document, which references it
+func (s *DocumentService) upload(ctx context.Context, ownerID int, upload DocumentUpload, input DocumentInput) (*ent.Document, error) {
+	// Files are stored by their content, so a file in many libraries is only stored once
+	name := filepath.Base(upload.FileName)
+	stored, err := s.blobs.Put(ctx, name, upload.Body, upload.MimeType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to store document: %w", err)
+	}
+
+	title := strings.TrimSpace(input.Title)
+	if title == "" {
+		title = name
+	}
+
+	create := s.orm.Document.Create().
+		SetTitle(title).
+		SetDescription(strings.TrimSpace(input.Description)).
+		SetFileName(name).
+		SetStorageKey(stored.StorageKey).
+		SetMimeType(upload.MimeType).
+		SetSize(stored.Size).
+		SetResourceType(resourceTypeFor(upload.MimeType, filepath.Ext(name))).
+		SetTags(normalizeTags(input.Tags)).
+		SetVisibility(documentVisibility(input.Visibility)).
+		SetOwnerID(ownerID)
+	if input.FolderID != 0 {
+		create.SetFolderID(input.FolderID)
+	}
+
+	doc, err := create.Save(ctx)
+	if err != nil {
+		// Don't leave an orphaned file behind
+		return nil, errors.Join(fmt.Errorf("failed to save document: %w", err), s.blobs.Prune(ctx, stored))
+	}
+
+	ref := BlobRef{OwnerID: ownerID, Kind: blobreference.KindDocument, TargetID: doc.ID}
+	if err := s.blobs.Reference(ctx, stored, ref); err != nil {
+		return nil, errors.Join(err, s.orm.Document.DeleteOne(doc).Exec(ctx), s.blobs.Prune(ctx, stored))
+	}
+
+	return doc, nil
+}
+
+// Update changes a document's details
+func (s *DocumentService) Update(ctx context.Context, docID, ownerID int, input DocumentInput) (*ent.Document, error) {
+	title := strings.TrimSpace(input.Title)
+	if title == "" {
+		return nil, fmt.Errorf("title is required")
+	}
+
+	if err := s.checkFolder(ctx, ownerID, input.FolderID); err != nil {
+		return nil, err
+	}
+
+	doc, err := s.getOwned(ctx, docID, ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	update := doc.Update().
+		SetTitle(title).
+		SetDescription(strings.TrimSpace(input.Description)).
+		SetTags(normalizeTags(input.Tags)).
+		SetVisibility(documentVisibility(input.Visibility))
+	if input.FolderID != 0 {
+		update.SetFolderID(input.FolderID)
+	} else {
+		update.ClearFolder()
+	}
+
+	doc, err = update.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update document: %w", err)
+	}
+
+	return doc, nil
+}
+
+// Get returns a document the user can see, which is any of their own and anyone's public
+// documents. Only the attached notes the user can see are loaded.
+func (s *DocumentService) Get(ctx context.Context, docID, userID int) (*ent.Document, error) {
+	doc, err := s.orm.Document.Query().
+		Where(
+			document.ID(docID),
+			document.Or(
+				document.HasOwnerWith(user.ID(userID)),
+				document.VisibilityEQ(document.VisibilityPublic),
+			),
+		).
+		WithOwner().
+		WithFolder().
+		WithNotes(func(q *ent.NoteQuery) {
+			q.Where(note.Or(
+				note.HasOwnerWith(user.ID(userID)),
+				note.VisibilityEQ(note.VisibilityPublic),
+			)).Order(ent.Asc(note.FieldTitle))
+		}).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrDocumentNotFound
+		}
+		return nil, fmt.Errorf("failed to fetch document: %w", err)
+	}
+
+	return doc, nil
+}
+
+// List returns documents in the user's library, newest first
+func (s *DocumentService) List(ctx context.Context, ownerID int, filter DocumentFilter) ([]*ent.Document, error) {
+	query := s.orm.Document.Query().
+		Where(document.HasOwnerWith(user.ID(ownerID)))
+
+	switch {
+	case filter.Tag != "":
+		query.Where(func(sel *entsql.Selector) {
+			sel.Where(sqljson.ValueContains(document.FieldTags, strings.ToLower(filter.Tag)))
+		})
+	case filter.FolderID != 0:
+		query.Where(document.HasFolderWith(folder.ID(filter.FolderID)))
+	default:
+		query.Where(document.Not(document.HasFolder()))
+	}
+
+	docs, err := query.
+		Order(ent.Desc(document.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch documents: %w", err)
+	}
+
+	return docs, nil
+}
+
+// Tags returns every tag used in the user's library, alphabetically
+func (s *DocumentService) Tags(ctx context.Context, ownerID int) ([]string, error) {
+	docs, err := s.orm.Document.Query().
+		Where(document.HasOwnerWith(user.ID(ownerID))).
+		Select(document.FieldTags).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch tags: %w", err)
+	}
+
+	seen := make(map[string]bool)
+	var tags []string
+	for _, d := range docs {
+		for _, t := range d.Tags {
+			if !seen[t] {
+				seen[t] = true
+				tags = append(tags, t)
+			}
+		}
+	}
+	sort.Strings(tags)
+
+	return tags, nil
+}
+
+// Delete removes a document from the library, and its file from storage unless a note, another
+// document or a profile uses the same file
+func (s *DocumentService) Delete(ctx context.Context, docID, ownerID int) error {
+	doc, err := s.getOwned(ctx, docID, ownerID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.orm.Document.DeleteOne(doc).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to delete document: %w", err)
+	}
+
+	if err := s.blobs.Release(ctx, blobreference.KindDocument, doc.ID, doc.StorageKey); err != nil {
+		return fmt.Errorf("failed to delete document file: %w", err)
+	}
+
+	return nil
+}
+
+// FileURL returns a link the document can be viewed at until it expires. Links are signed even
+// for public documents, since some storage backends don't serve files any other way.
+func (s *DocumentService) FileURL(ctx context.Context, doc *ent.Document) (string, error) {
+	url, err := s.storage.GetSignedURL(ctx, doc.StorageKey, int64(DocumentLinkTTL/time.Second))
+	if err != nil {
+		return "", fmt.Errorf("failed to sign document URL: %w", err)
+	}
+
+	return url, nil
+}
+
+// AttachToNotes attaches a document to some of the user's notes. Notes it is already attached
+// to, and notes belonging to someone else, are skipped.
+func (s *DocumentService) AttachToNotes(ctx context.Context, docID, ownerID int, noteIDs []int) error {
+	doc, err := s.getOwned(ctx, docID, ownerID)
+	if err != nil {
+		return err
+	}
+
+	ids, err := s.orm.Note.Query().
+		Where(
+			note.IDIn(noteIDs...),
+			note.HasOwnerWith(user.ID(ownerID)),
+			note.Not(note.HasDocumentsWith(document.ID(docID))),
+		).
+		IDs(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch notes: %w", err)
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	if err := doc.Update().AddNoteIDs(ids...).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to attach document: %w", err)
+	}
+
+	return nil
+}
+
+// DetachFromNote removes a document from a note. Either the document's owner or the note's
+// owner can detach it.
+func (s *DocumentService) DetachFromNote(ctx context.Context, docID, noteID, userID int) error {
+	n, err := s.orm.Note.Query().
+		Where(
+			note.ID(noteID),
+			note.HasDocumentsWith(document.ID(docID)),
+			note.Or(
+				note.HasOwnerWith(user.ID(userID)),
+				note.HasDocumentsWith(document.ID(docID), document.HasOwnerWith(user.ID(userID))),
+			),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ErrDocumentNotFound
+		}
+		return fmt.Errorf("failed to fetch note: %w", err)
+	}
+
+	if err := n.Update().RemoveDocumentIDs(docID).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to detach document: %w", err)
+	}
+
+	return nil
+}
+
+// ListNoteDocuments returns the documents attached to a note that the user can see. Private
+// documents are only listed for their owner.
+func (s *DocumentService) ListNoteDocuments(ctx context.Context, noteID int, userID *int) ([]*ent.Document, error) {
+	visible := document.VisibilityEQ(document.VisibilityPublic)
+	if userID != nil {
+		visible = document.Or(visible, document.HasOwnerWith(user.ID(*userID)))
+	}
+
+	docs, err := s.orm.Document.Query().
+		Where(
+			document.HasNotesWith(note.ID(noteID)),
+			visible,
+		).
+		Order(ent.Asc(document.FieldTitle)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch note documents: %w", err)
+	}
+
+	return docs, nil
+}
+
+// CreateFolder creates a folder, inside another of the user's folders when parentID is non-zero
+func (s *DocumentService) CreateFolder(ctx context.Context, ownerID int, name string, parentID int) (*ent.Folder, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, fmt.Errorf("folder name is required")
+	}
+
+	if err := s.checkFolder(ctx, ownerID, parentID); err != nil {
+		return nil, err
+	}
+
+	create := s.orm.Folder.Create().
+		SetName(name).
+		SetOwnerID(ownerID)
+	if parentID != 0 {
+		create.SetParentID(parentID)
+	}
+
+	f, err := create.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create folder: %w", err)
+	}
+
+	return f, nil
+}
+
+// ListFolders returns all of the user's folders with their parents loaded, so the caller can
+// build the folder tree
+func (s *DocumentService) ListFolders(ctx context.Context, ownerID int) ([]*ent.Folder, error) {
+	folders, err := s.orm.Folder.Query().
+		Where(folder.HasOwnerWith(user.ID(ownerID))).
+		WithParent().
+		Order(ent.Asc(folder.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch folders: %w", err)
+	}
+
+	return folders, nil
+}
+
+// DeleteFolder deletes a folder, moving its documents and subfolders up into its parent
+func (s *DocumentService) DeleteFolder(ctx context.Context, folderID, ownerID int) error {
+	f, err := s.orm.Folder.Query().
+		Where(folder.ID(folderID), folder.HasOwnerWith(user.ID(ownerID))).
+		WithParent().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ErrFolderNotFound
+		}
+		return fmt.Errorf("failed to fetch folder: %w", err)
+	}
+
+	tx, err := s.orm.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	docs := tx.Document.Update().Where(document.HasFolderWith(folder.ID(folderID)))
+	children := tx.Folder.Update().Where(folder.HasParentWith(folder.ID(folderID)))
+	if f.Edges.Parent != nil {
+		docs.SetFolderID(f.Edges.Parent.ID)
+		children.SetParentID(f.Edges.Parent.ID)
+	} else {
+		docs.ClearFolder()
+		children.ClearParent()
+	}
+
+	if _, err := docs.Save(ctx); err != nil {
+		return rollback(tx, fmt.Errorf("failed to move documents: %w", err))
+	}
+	if _, err := children.Save(ctx); err != nil {
+		return rollback(tx, fmt.Errorf("failed to move folders: %w", err))
+	}
+	if err := tx.Folder.DeleteOneID(folderID).Exec(ctx); err != nil {
+		return rollback(tx, fmt.Errorf("failed to delete folder: %w", err))
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// getOwned returns one of the user's documents
+func (s *DocumentService) getOwned(ctx context.Context, docID, ownerID int) (*ent.Document, error) {
+	doc, err := s.orm.Document.Query().
+		Where(document.ID(docID), document.HasOwnerWith(user.ID(ownerID))).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrDocumentNotFound
+		}
+		return nil, fmt.Errorf("failed to fetch document: %w", err)
+	}
+	return doc, nil
+}
+
+// checkFolder checks that a folder belongs to the user. Zero means no folder and is always allowed.
+func (s *DocumentService) checkFolder(ctx context.Context, ownerID, folderID int) error {
+	if folderID == 0 {
+		return nil
+	}
+
+	exists, err := s.orm.Folder.Query().
+		Where(folder.ID(folderID), folder.HasOwnerWith(user.ID(ownerID))).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch folder: %w", err)
+	}
+	if !exists {
+		return ErrFolderNotFound
+	}
+	return nil
+}
+
+// ParseTags splits a comma-separated list of tags
+func ParseTags(s string) []string {
+	return normalizeTags(strings.Split(s, ","))
+}
+
+// normalizeTags lowercases and trims tags, dropping blanks and duplicates
+func normalizeTags(tags []string) []string {
+	seen := make(map[string]bool)
+	out := []string{}
+	for _, t := range tags {
+		t = strings.ToLower(strings.TrimSpace(t))
+		if t != "" && !seen[t] {
+			seen[t] = true
+			out = append(out, t)
+		}
+	}
+	return out
+}
+
+// documentVisibility parses a visibility, defaulting to private
+func documentVisibility(v string) document.Visibility {
+	if v == string(document.VisibilityPublic) {
+		return document.VisibilityPublic
+	}
+	return document.VisibilityPrivate
+}
@@ -0,0 +1,159 @@
+package services
+
+import (
+	"context"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/r-scheele/zero/ent/document"
+	"github.com/r-scheele/zero/ent/note"
+	"github.com/r-scheele/zero/pkg/tests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryStorage is a StorageService that keeps files in memory
+type memoryStorage struct {
+	files map[string]string
+
+	// baseURL prefixes file URLs, and defaults to /files/
+	baseURL string
+}
+
+func (s *memoryStorage) fileURL(key string) string {
+	if s.baseURL == "" {
+		return "/files/" + key
+	}
+	return s.baseURL + key
+}
+
+func (s *memoryStorage) UploadFile(ctx context.Context, key string, reader io.Reader, contentType string) (string, error) {
+	b, err := io.ReadAll(reader)
+	if err != nil {
+		return "", err
+	}
+	s.files[key] = string(b)
+	return s.fileURL(key), nil
+}
+
+func (s *memoryStorage) DeleteFile(ctx context.Context, key string) error {
+	delete(s.files, key)
+	return nil
+}
+
+func (s *memoryStorage) GetFileURL(ctx context.Context, key string) (string, error) {
+	return s.fileURL(key), nil
+}
+
+func (s *memoryStorage) GetSignedURL(ctx context.Context, key string, expiration int64) (string, error) {
+	return s.fileURL(key) + "?signed", nil
+}
+
+func (s *memoryStorage) OpenFile(ctx context.Context, key string) (io.ReadCloser, error) {
+	f, ok := s.files[key]
+	if !ok {
+		return nil, os.ErrNotExist
+	}
+	return io.NopCloser(strings.NewReader(f)), nil
+}
+
+func TestDocumentService(t *testing.T) {
+	ctxb := context.Background()
+	storage := &memoryStorage{files: make(map[string]string)}
+	docs := NewDocumentService(c.ORM, storage, NewBlobService(c.ORM, storage, 0))
+
+	owner, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	other, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+
+	// Folders nest
+	course, err := docs.CreateFolder(ctxb, owner.ID, "Biology", 0)
+	require.NoError(t, err)
+	week, err := docs.CreateFolder(ctxb, owner.ID, "Week 1", course.ID)
+	require.NoError(t, err)
+	_, err = docs.CreateFolder(ctxb, other.ID, "Theirs", course.ID)
+	assert.ErrorIs(t, err, ErrFolderNotFound)
+
+	// Uploads are stored and filed
+	doc, err := docs.Upload(ctxb, owner.ID, DocumentUpload{
+		FileName: "../slides.pdf",
+		MimeType: "application/pdf",
+		Size:     5,
+		Body:     strings.NewReader("%PDF-"),
+	}, DocumentInput{Tags: ParseTags("Exam, cells, exam, "), FolderID: week.ID})
+	require.NoError(t, err)
+	assert.Equal(t, "slides.pdf", doc.Title)
+	assert.Equal(t, "pdf", doc.ResourceType)
+	assert.Equal(t, []string{"exam", "cells"}, doc.Tags)
+	assert.Equal(t, document.VisibilityPrivate, doc.Visibility)
+	assert.Equal(t, "%PDF-", storage.files[doc.StorageKey])
+	assert.True(t, strings.HasPrefix(doc.StorageKey, "blobs/"))
+
+	list, err := docs.List(ctxb, owner.ID, DocumentFilter{FolderID: week.ID})
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	list, err = docs.List(ctxb, owner.ID, DocumentFilter{})
+	require.NoError(t, err)
+	assert.Empty(t, list)
+	list, err = docs.List(ctxb, owner.ID, DocumentFilter{Tag: "Cells"})
+	require.NoError(t, err)
+	assert.Len(t, list, 1)
+
+	tags, err := docs.Tags(ctxb, owner.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cells", "exam"}, tags)
+
+	// Private documents are only visible to their owner, and get signed links
+	_, err = docs.Get(ctxb, doc.ID, other.ID)
+	assert.ErrorIs(t, err, ErrDocumentNotFound)
+	url, err := docs.FileURL(ctxb, doc)
+	require.NoError(t, err)
+	assert.Contains(t, url, "?signed")
+
+	doc, err = docs.Update(ctxb, doc.ID, owner.ID, DocumentInput{Title: "Lecture slides", Visibility: "public", FolderID: week.ID})
+	require.NoError(t, err)
+	_, err = docs.Get(ctxb, doc.ID, other.ID)
+	require.NoError(t, err)
+	_, err = docs.Update(ctxb, doc.ID, other.ID, DocumentInput{Title: "Mine now"})
+	assert.ErrorIs(t, err, ErrDocumentNotFound)
+
+	// Documents attach to the owner's notes only
+	own, err := c.ORM.Note.Create().SetTitle("Cells").SetOwner(owner).Save(ctxb)
+	require.NoError(t, err)
+	theirs, err := c.ORM.Note.Create().SetTitle("Theirs").SetOwner(other).SetVisibility(note.VisibilityPublic).Save(ctxb)
+	require.NoError(t, err)
+
+	require.NoError(t, docs.AttachToNotes(ctxb, doc.ID, owner.ID, []int{own.ID, theirs.ID}))
+	require.NoError(t, docs.AttachToNotes(ctxb, doc.ID, owner.ID, []int{own.ID}))
+	attached, err := docs.ListNoteDocuments(ctxb, own.ID, nil)
+	require.NoError(t, err)
+	require.Len(t, attached, 1)
+	assert.Equal(t, doc.ID, attached[0].ID)
+	attached, err = docs.ListNoteDocuments(ctxb, theirs.ID, &other.ID)
+	require.NoError(t, err)
+	assert.Empty(t, attached)
+
+	require.NoError(t, docs.DetachFromNote(ctxb, doc.ID, own.ID, owner.ID))
+	attached, err = docs.ListNoteDocuments(ctxb, own.ID, &owner.ID)
+	require.NoError(t, err)
+	assert.Empty(t, attached)
+
+	// Deleting a folder moves its contents up a level
+	require.NoError(t, docs.DeleteFolder(ctxb, course.ID, owner.ID))
+	folders, err := docs.ListFolders(ctxb, owner.ID)
+	require.NoError(t, err)
+	require.Len(t, folders, 1)
+	assert.Nil(t, folders[0].Edges.Parent)
+	list, err = docs.List(ctxb, owner.ID, DocumentFilter{FolderID: week.ID})
+	require.NoError(t, err)
+	assert.Len(t, list, 1)
+
+	// Deleting a document removes the file
+	require.NoError(t, docs.Delete(ctxb, doc.ID, owner.ID))
+	assert.Empty(t, storage.files)
+	_, err = docs.Get(ctxb, doc.ID, owner.ID)
+	assert.ErrorIs(t, err, ErrDocumentNotFound)
+}
@@ -0,0 +1,490 @@
+package services
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/ledongthuc/pdf"
+)
+
+// MaxExtractedText is the most text kept from a single file, in bytes. Anything beyond it is
+// dropped so huge documents don't bloat the note they're attached to.
+const MaxExtractedText = 1 << 20
+
+// maxExtractedXML caps how much of each XML part in an Office document is decompressed
+const maxExtractedXML = 64 << 20
+
+// ErrExtractionUnsupported is returned when text can't be extracted from a type of file
+var ErrExtractionUnsupported = errors.New("text extraction is not supported for this file type")
+
+// Text extraction formats
+const (
+	extractPDF      = "pdf"
+	extractDOCX     = "docx"
+	extractPPTX     = "pptx"
+	extractXLSX     = "xlsx"
+	extractMarkdown = "markdown"
+	extractText     = "text"
+)
+
+// CanExtractText reports whether text can be extracted from a file
+func CanExtractText(fileName, mimeType string) bool {
+	return extractionFormat(fileName, mimeType) != ""
+}
+
+// ExtractText pulls the plain text out of a PDF, Word, PowerPoint, Excel, Markdown or text file.
+// The result is valid UTF-8 and at most MaxExtractedText bytes.
+func ExtractText(r io.ReaderAt, size int64, fileName, mimeType string) (string, error) {
+	var text string
+	var err error
+
+	switch extractionFormat(fileName, mimeType) {
+	case extractPDF:
+		text, err = extractPDFText(r, size)
+	case extractDOCX:
+		text, err = extractDOCXText(r, size)
+	case extractPPTX:
+		text, err = extractPPTXText(r, size)
+	case extractXLSX:
+		text, err = extractXLSXText(r, size)
+	case extractMarkdown:
+		text, err = readAllText(r, size)
+		text = stripMarkdown(text)
+	case extractText:
+		text, err = readAllText(r, size)
+	default:
+		return "", ErrExtractionUnsupported
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return cleanExtractedText(text), nil
+}
+
+// extractionFormat determines how to extract text from a file, by extension and then MIME type
+func extractionFormat(fileName, mimeType string) string {
+	switch strings.ToLower(filepath.Ext(fileName)) {
+	case ".pdf":
+		return extractPDF
+	case ".docx":
+		return extractDOCX
+	case ".pptx":
+		return extractPPTX
+	case ".xlsx":
+		return extractXLSX
+	case ".md", ".markdown":
+		return extractMarkdown
+	case ".txt", ".text", ".csv":
+		return extractText
+	}
+
+	mimeType, _, _ = strings.Cut(strings.ToLower(mimeType), ";")
+	switch strings.TrimSpace(mimeType) {
+	case "application/pdf":
+		return extractPDF
+	case "application/vnd.openxmlformats-officedocument.wordprocessingml.document":
+		return extractDOCX
+	case "application/vnd.openxmlformats-officedocument.presentationml.presentation":
+		return extractPPTX
+	case "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet":
+		return extractXLSX
+	case "text/markdown", "text/x-markdown":
+		return extractMarkdown
+	case "text/plain", "text/csv":
+		return extractText
+	}
+
+	return ""
+}
+
+// extractPDFText extracts the text of each page of a PDF
+func extractPDFText(r io.ReaderAt, size int64) (text string, err error) {
+	// The PDF reader panics on some malformed files
+	defer func() {
+		if rec := recover(); rec != nil {
+			err = fmt.Errorf("failed to read PDF: %v", rec)
+		}
+	}()
+
+	doc, err := pdf.NewReader(r, size)
+	if err != nil {
+		return "", fmt.Errorf("failed to read PDF: %w", err)
+	}
+
+	var b strings.Builder
+	fonts := make(map[string]*pdf.Font)
+	for i := 1; i <= doc.NumPage(); i++ {
+		page := doc.Page(i)
+		if page.V.IsNull() {
+			continue
+		}
+		for _, name := range page.Fonts() {
+			if _, ok := fonts[name]; !ok {
+				f := page.Font(name)
+				fonts[name] = &f
+			}
+		}
+
+		pageText, err := page.GetPlainText(fonts)
+		if err != nil {
+			return "", fmt.Errorf("failed to read PDF page %d: %w", i, err)
+		}
+		b.WriteString(pageText)
+		b.WriteString("\n\n")
+
+		if b.Len() > MaxExtractedText {
+			break
+		}
+	}
+
+	return b.String(), nil
+}
+
+// extractDOCXText extracts the paragraphs of a Word document
+func extractDOCXText(r io.ReaderAt, size int64) (string, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return "", fmt.Errorf("failed to open Word document: %w", err)
+	}
+
+	return zipXMLText(zr, "word/document.xml", "t", "p")
+}
+
+// extractPPTXText extracts the text of each slide of a PowerPoint presentation, in order
+func extractPPTXText(r io.ReaderAt, size int64) (string, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return "", fmt.Errorf("failed to open PowerPoint presentation: %w", err)
+	}
+
+	slides := zipParts(zr, "ppt/slides/slide")
+
+	var b strings.Builder
+	for _, slide := range slides {
+		text, err := zipXMLText(zr, slide, "t", "p")
+		if err != nil {
+			return "", err
+		}
+		b.WriteString(text)
+		b.WriteString("\n")
+	}
+
+	return b.String(), nil
+}
+
+// extractXLSXText extracts the cells of each sheet of an Excel workbook, one row per line
+func extractXLSXText(r io.ReaderAt, size int64) (string, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return "", fmt.Errorf("failed to open Excel workbook: %w", err)
+	}
+
+	// Text cells refer to a table of shared strings
+	var shared []string
+	if f := zipFile(zr, "xl/sharedStrings.xml"); f != nil {
+		shared, err = xlsxSharedStrings(f)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	var b strings.Builder
+	for _, sheet := range zipParts(zr, "xl/worksheets/sheet") {
+		if err := xlsxSheetText(zipFile(zr, sheet), shared, &b); err != nil {
+			return "", err
+		}
+		b.WriteString("\n")
+	}
+
+	return b.String(), nil
+}
+
+// xlsxSharedStrings reads the shared strings table of a workbook
+func xlsxSharedStrings(f *zip.File) ([]string, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", f.Name, err)
+	}
+	defer rc.Close()
+
+	var shared []string
+	var current strings.Builder
+	inText := false
+	dec := xml.NewDecoder(io.LimitReader(rc, maxExtractedXML))
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			return shared, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", f.Name, err)
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "si":
+				current.Reset()
+			case "t":
+				inText = true
+			}
+		case xml.EndElement:
+			switch t.Name.Local {
+			case "si":
+				shared = append(shared, current.String())
+			case "t":
+				inText = false
+			}
+		case xml.CharData:
+			if inText {
+				current.Write(t)
+			}
+		}
+	}
+}
+
+// xlsxSheetText writes the cells of a worksheet, separating cells with tabs and rows with newlines
+func xlsxSheetText(f *zip.File, shared []string, b *strings.Builder) error {
+	rc, err := f.Open()
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", f.Name, err)
+	}
+	defer rc.Close()
+
+	var cellType string
+	var value strings.Builder
+	inValue := false
+	cells := 0
+	dec := xml.NewDecoder(io.LimitReader(rc, maxExtractedXML))
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to parse %s: %w", f.Name, err)
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "c":
+				cellType = ""
+				value.Reset()
+				for _, attr := range t.Attr {
+					if attr.Name.Local == "t" {
+						cellType = attr.Value
+					}
+				}
+			case "v", "t":
+				inValue = true
+			}
+		case xml.EndElement:
+			switch t.Name.Local {
+			case "v", "t":
+				inValue = false
+			case "c":
+				text := value.String()
+				if cellType == "s" {
+					i, err := strconv.Atoi(text)
+					if err != nil || i < 0 || i >= len(shared) {
+						continue
+					}
+					text = shared[i]
+				}
+				if text == "" {
+					continue
+				}
+				if cells > 0 {
+					b.WriteString("\t")
+				}
+				b.WriteString(text)
+				cells++
+			case "row":
+				if cells > 0 {
+					b.WriteString("\n")
+				}
+				cells = 0
+			}
+		case xml.CharData:
+			if inValue {
+				value.Write(t)
+			}
+		}
+	}
+}
+
+// zipXMLText extracts the character data of the text elements of an XML part, ending a line at
+// the end of each paragraph element
+func zipXMLText(zr *zip.Reader, name, textElement, paragraphElement string) (string, error) {
+	f := zipFile(zr, name)
+	if f == nil {
+		return "", fmt.Errorf("document has no %s", name)
+	}
+
+	rc, err := f.Open()
+	if err != nil {
+		return "", fmt.Errorf("failed to open %s: %w", name, err)
+	}
+	defer rc.Close()
+
+	var b strings.Builder
+	inText := false
+	dec := xml.NewDecoder(io.LimitReader(rc, maxExtractedXML))
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			return b.String(), nil
+		}
+		if err != nil {
+			return "", fmt.Errorf("failed to parse %s: %w", name, err)
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case textElement:
+				inText = true
+			case "tab":
+				b.WriteString("\t")
+			case "br":
+				b.WriteString("\n")
+			}
+		case xml.EndElement:
+			switch t.Name.Local {
+			case textElement:
+				inText = false
+			case paragraphElement:
+				b.WriteString("\n")
+			}
+		case xml.CharData:
+			if inText {
+				b.Write(t)
+			}
+		}
+
+		if b.Len() > MaxExtractedText {
+			return b.String(), nil
+		}
+	}
+}
+
+// zipFile finds a file in a zip archive
+func zipFile(zr *zip.Reader, name string) *zip.File {
+	for _, f := range zr.File {
+		if f.Name == name {
+			return f
+		}
+	}
+	return nil
+}
+
+// zipParts lists numbered XML parts, such as ppt/slides/slide1.xml, in numeric order
+func zipParts(zr *zip.Reader, prefix string) []string {
+	type part struct {
+		name string
+		num  int
+	}
+
+	var parts []part
+	for _, f := range zr.File {
+		if path.Dir(f.Name) != path.Dir(prefix) || !strings.HasPrefix(f.Name, prefix) || !strings.HasSuffix(f.Name, ".xml") {
+			continue
+		}
+		num, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(f.Name, prefix), ".xml"))
+		if err != nil {
+			continue
+		}
+		parts = append(parts, part{name: f.Name, num: num})
+	}
+
+	sort.Slice(parts, func(i, j int) bool {
+		return parts[i].num < parts[j].num
+	})
+
+	names := make([]string, len(parts))
+	for i, p := range parts {
+		names[i] = p.name
+	}
+	return names
+}
+
+// readAllText reads a text file, up to the extracted text limit
+func readAllText(r io.ReaderAt, size int64) (string, error) {
+	var b bytes.Buffer
+	if _, err := io.Copy(&b, io.NewSectionReader(r, 0, min(size, MaxExtractedText+utf8.UTFMax))); err != nil {
+		return "", fmt.Errorf("failed to read file: %w", err)
+	}
+	return b.String(), nil
+}
+
+var (
+	markdownFence    = regexp.MustCompile("(?m)^\\s*(```|~~~).*$")
+	markdownHeading  = regexp.MustCompile(`(?m)^\s{0,3}#{1,6}\s+`)
+	markdownQuote    = regexp.MustCompile(`(?m)^\s{0,3}>\s?`)
+	markdownList     = regexp.MustCompile(`(?m)^(\s*)([-*+]|\d+[.)])\s+`)
+	markdownRule     = regexp.MustCompile(`(?m)^\s{0,3}([-*_]\s*){3,}$`)
+	markdownImage    = regexp.MustCompile(`!\[([^\]]*)\]\([^)]*\)`)
+	markdownLink     = regexp.MustCompile(`\[([^\]]+)\]\([^)]*\)`)
+	markdownEmphasis = regexp.MustCompile("(\\*{1,3}|_{2,3}|~~|`)")
+	markdownHTML     = regexp.MustCompile(`</?[a-zA-Z][^>]*>`)
+)
+
+// stripMarkdown removes Markdown syntax, keeping the text it marks up
+func stripMarkdown(s string) string {
+	s = markdownFence.ReplaceAllString(s, "")
+	s = markdownRule.ReplaceAllString(s, "")
+	s = markdownHeading.ReplaceAllString(s, "")
+	s = markdownQuote.ReplaceAllString(s, "")
+	s = markdownList.ReplaceAllString(s, "$1")
+	s = markdownImage.ReplaceAllString(s, "$1")
+	s = markdownLink.ReplaceAllString(s, "$1")
+	s = markdownHTML.ReplaceAllString(s, "")
+	return markdownEmphasis.ReplaceAllString(s, "")
+}
+
+// cleanExtractedText makes extracted text valid UTF-8, trims trailing spaces from lines, collapses
+// runs of blank lines and truncates it to MaxExtractedText
+func cleanExtractedText(s string) string {
+	s = strings.ToValidUTF8(s, "")
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	s = strings.ReplaceAll(s, "\x00", "")
+
+	var b strings.Builder
+	blank := 0
+	for _, line := range strings.Split(s, "\n") {
+		line = strings.TrimRight(line, " \t\r")
+		if line == "" {
+			blank++
+			if blank > 1 {
+				continue
+			}
+		} else {
+			blank = 0
+		}
+		b.WriteString(line)
+		b.WriteString("\n")
+	}
+
+	s = strings.TrimSpace(b.String())
+	if len(s) > MaxExtractedText {
+		s = s[:MaxExtractedText]
+		// Don't cut a character in half
+		for !utf8.ValidString(s) {
+			s = s[:len(s)-1]
+		}
+	}
+	return s
+}
@@ -0,0 +1,99 @@
+package services
+
+import (
+	"archive/zip"
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// officeFile builds an Office Open XML file from its parts
+func officeFile(t *testing.T, parts map[string]string) []byte {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range parts {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+	return buf.Bytes()
+}
+
+func extract(t *testing.T, b []byte, name, mimeType string) string {
+	text, err := ExtractText(bytes.NewReader(b), int64(len(b)), name, mimeType)
+	require.NoError(t, err)
+	return text
+}
+
+func TestExtractText_PDF(t *testing.T) {
+	doc := newPDFDocument()
+	doc.Heading("Mitochondria", 18)
+	doc.Text("The powerhouse of the cell", 11)
+
+	var buf bytes.Buffer
+	_, err := doc.WriteTo(&buf)
+	require.NoError(t, err)
+
+	text := extract(t, buf.Bytes(), "cells.pdf", "")
+	assert.Contains(t, text, "Mitochondria")
+	assert.Contains(t, text, "powerhouse of the cell")
+
+	_, err = ExtractText(strings.NewReader("%PDF-1.4 broken"), 15, "broken.pdf", "")
+	assert.Error(t, err)
+}
+
+func TestExtractText_Office(t *testing.T) {
+	docx := officeFile(t, map[string]string{
+		"word/document.xml": `<?xml version="1.0"?>
+<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"><w:body>
+<w:p><w:r><w:t>Photosynthesis</w:t></w:r></w:p>
+<w:p><w:r><w:t xml:space="preserve">Light </w:t></w:r><w:r><w:t>reactions</w:t></w:r></w:p>
+</w:body></w:document>`,
+	})
+	assert.Equal(t, "Photosynthesis\nLight reactions", extract(t, docx, "notes.docx", ""))
+
+	slide := func(text string) string {
+		return `<p:sld xmlns:p="p" xmlns:a="a"><p:txBody><a:p><a:r><a:t>` + text + `</a:t></a:r></a:p></p:txBody></p:sld>`
+	}
+	pptx := officeFile(t, map[string]string{
+		"ppt/slides/slide10.xml":            slide("Last"),
+		"ppt/slides/slide2.xml":             slide("Second"),
+		"ppt/slides/slide1.xml":             slide("First"),
+		"ppt/slides/_rels/slide1.xml.rels":  "<Relationships/>",
+		"ppt/slideLayouts/slideLayout1.xml": slide("Layout"),
+	})
+	assert.Equal(t, "First\n\nSecond\n\nLast", extract(t, pptx, "deck", "application/vnd.openxmlformats-officedocument.presentationml.presentation"))
+
+	xlsx := officeFile(t, map[string]string{
+		"xl/sharedStrings.xml": `<sst><si><t>Term</t></si><si><r><t>Def</t></r><r><t>inition</t></r></si></sst>`,
+		"xl/worksheets/sheet1.xml": `<worksheet><sheetData>
+<row><c t="s"><v>0</v></c><c t="s"><v>1</v></c></row>
+<row><c t="inlineStr"><is><t>Osmosis</t></is></c><c><v>42</v></c></row>
+</sheetData></worksheet>`,
+	})
+	assert.Equal(t, "Term\tDefinition\nOsmosis\t42", extract(t, xlsx, "glossary.xlsx", ""))
+
+	_, err := ExtractText(bytes.NewReader([]byte("not a zip")), 9, "notes.docx", "")
+	assert.Error(t, err)
+}
+
+func TestExtractText_Text(t *testing.T) {
+	md := "# Cells\n\nThe **nucleus** holds [DNA](https://example.com).\n\n\n\n- ribosomes\n```go\ncode\n```\n"
+	assert.Equal(t, "Cells\n\nThe nucleus holds DNA.\n\nribosomes\n\ncode", extract(t, []byte(md), "cells.md", ""))
+
+	txt := "plain \xff text  \r\nline two"
+	assert.Equal(t, "plain  text\nline two", extract(t, []byte(txt), "", "text/plain; charset=utf-8"))
+
+	long := strings.Repeat("é", MaxExtractedText)
+	assert.Len(t, extract(t, []byte(long), "long.txt", ""), MaxExtractedText)
+
+	_, err := ExtractText(strings.NewReader("data"), 4, "photo.jpg", "image/jpeg")
+	assert.ErrorIs(t, err, ErrExtractionUnsupported)
+	assert.False(t, CanExtractText("photo.jpg", "image/jpeg"))
+	assert.True(t, CanExtractText("upload", "application/pdf"))
+}
@@ -75,6 +75,21 @@ func GetFileUploadLimits(cfg *config.Config) (maxFileSize, maxTotalSize int64, m
 	return maxFileSize, maxTotalSize, maxFiles, nil
 }
 
+// GetUserQuota returns the number of bytes each user may store from configuration, or zero if
+// there's no limit
+func GetUserQuota(cfg *config.Config) (int64, error) {
+	if strings.TrimSpace(cfg.FileUpload.UserQuota) == "" {
+		return 0, nil
+	}
+
+	quota, err := ParseFileSize(cfg.FileUpload.UserQuota)
+	if err != nil {
+		return 0, fmt.Errorf("invalid userQuota: %w", err)
+	}
+
+	return quota, nil
+}
+
 // FormatFileSize converts bytes to a human-readable string
 func FormatFileSize(bytes int64) string {
 	const unit = 1024
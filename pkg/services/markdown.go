@@ -0,0 +1,496 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"hash/fnv"
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+
+	chromahtml "github.com/alecthomas/chroma/v2/formatters/html"
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/r-scheele/zero/config"
+	"github.com/r-scheele/zero/ent"
+	"github.com/yuin/goldmark"
+	highlighting "github.com/yuin/goldmark-highlighting/v2"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	extast "github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	goldmarkhtml "github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// markdownClass matches the classes rendered markdown can keep, which are those used to highlight
+// code, typeset math and style wiki links
+var markdownClass = regexp.MustCompile(`(?i)^[a-z0-9 _-]+$`)
+
+// wikiLinkMarkdown parses markdown to find the wiki links in it. Links in code and math aren't
+// parsed, as they aren't rendered as links.
+var wikiLinkMarkdown = goldmark.New(
+	goldmark.WithExtensions(extension.GFM, mathExtension{}, wikiLinkExtension{}),
+)
+
+// WikiLink is a link to another note written as [[Note Title]] or [[note:123]]
+type WikiLink struct {
+	// Title is the title of the note linked to, matched ignoring case
+	Title string
+
+	// NoteID is the ID of the note linked to, for links written as note:ID
+	NoteID int
+}
+
+// Target is the text between the brackets of the link
+func (l WikiLink) Target() string {
+	if l.NoteID != 0 {
+		return fmt.Sprintf("note:%d", l.NoteID)
+	}
+	return l.Title
+}
+
+// WikiLinkResolver finds the URL and title of the note a wiki link points to, reporting false
+// when the link is broken
+type WikiLinkResolver func(link WikiLink) (url, title string, ok bool)
+
+// ParseWikiLinks returns the wiki links in markdown, once each
+func ParseWikiLinks(source string) []WikiLink {
+	doc := wikiLinkMarkdown.Parser().Parse(text.NewReader([]byte(source)))
+
+	var links []WikiLink
+	seen := make(map[string]bool)
+	_ = ast.Walk(doc, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if n, ok := node.(*wikiLink); ok && entering {
+			key := strings.ToLower(n.link.Target())
+			if !seen[key] {
+				seen[key] = true
+				links = append(links, n.link)
+			}
+		}
+		return ast.WalkContinue, nil
+	})
+	return links
+}
+
+// parseWikiLink parses the text between the brackets of a wiki link
+func parseWikiLink(target string) (WikiLink, bool) {
+	target = strings.TrimSpace(target)
+	if target == "" {
+		return WikiLink{}, false
+	}
+	if id, ok := strings.CutPrefix(target, "note:"); ok {
+		if noteID, err := strconv.Atoi(id); err == nil && noteID > 0 {
+			return WikiLink{NoteID: noteID}, true
+		}
+	}
+	return WikiLink{Title: target}, true
+}
+
+// MarkdownService renders note content written in markdown to sanitized HTML
+type MarkdownService struct {
+	md     goldmark.Markdown
+	policy *bluemonday.Policy
+	cache  *CacheClient
+	config *config.Config
+}
+
+// NewMarkdownService creates a new markdown service
+func NewMarkdownService(cache *CacheClient, config *config.Config) *MarkdownService {
+	policy := bluemonday.UGCPolicy()
+	policy.AllowAttrs("class").Matching(markdownClass).OnElements("a", "span", "div", "pre", "code")
+
+	return &MarkdownService{
+		md: goldmark.New(
+			goldmark.WithExtensions(
+				extension.GFM,
+				mathExtension{},
+				wikiLinkExtension{},
+				highlighting.NewHighlighting(
+					highlighting.WithFormatOptions(chromahtml.WithClasses(true)),
+				),
+			),
+			// Notes were shown as plain text before, so line breaks are kept
+			goldmark.WithRendererOptions(goldmarkhtml.WithHardWraps()),
+		),
+		policy: policy,
+		cache:  cache,
+		config: config,
+	}
+}
+
+// Render renders markdown to HTML. Code blocks are highlighted with classes, and math between
+// dollar signs is left for KaTeX to typeset in the browser. Wiki links become links to the notes
+// resolve finds, and the rest are flagged as broken. Raw HTML is dropped, and the output is
+// sanitized so it's safe to show to other users.
+func (s *MarkdownService) Render(source string, resolve WikiLinkResolver) (string, error) {
+	pc := parser.NewContext()
+	if resolve != nil {
+		pc.Set(wikiLinkResolverKey, resolve)
+	}
+
+	var buf bytes.Buffer
+	if err := s.md.Convert([]byte(source), &buf, parser.WithContext(pc)); err != nil {
+		return "", fmt.Errorf("failed to render markdown: %w", err)
+	}
+	return s.policy.Sanitize(buf.String()), nil
+}
+
+// RenderNote renders the content of a note, which must have its links loaded with the notes they
+// point to that the viewer can see, as ListLinks does. It's cached until the note is next updated
+// or its links point somewhere else, and separately for viewers who see different notes.
+func (s *MarkdownService) RenderNote(ctx context.Context, n *ent.Note, noteURL func(id int) string) (string, error) {
+	targets := make(map[string]*ent.Note, len(n.Edges.Links))
+	hash := fnv.New64a()
+	for _, l := range n.Edges.Links {
+		targets[strings.ToLower(l.Target)] = l.Edges.Note
+		if l.Edges.Note != nil {
+			fmt.Fprintf(hash, "%s=%d:%s;", l.Target, l.Edges.Note.ID, l.Edges.Note.Title)
+		}
+	}
+
+	cacheKey := fmt.Sprintf("note_markdown:%d:%d:%x", n.ID, n.UpdatedAt.UnixNano(), hash.Sum64())
+	if cached, err := s.cache.Get().Key(cacheKey).Fetch(ctx); err == nil {
+		if rendered, ok := cached.(string); ok {
+			return rendered, nil
+		}
+	}
+
+	rendered, err := s.Render(n.Content, func(link WikiLink) (string, string, bool) {
+		target := targets[strings.ToLower(link.Target())]
+		if target == nil {
+			return "", "", false
+		}
+		return noteURL(target.ID), target.Title, true
+	})
+	if err != nil {
+		return "", err
+	}
+
+	s.cache.Set().
+		Key(cacheKey).
+		Data(rendered).
+		Expiration(s.config.Cache.Expiration.NoteData).
+		Save(ctx)
+
+	return rendered, nil
+}
+
+var (
+	kindMathInline = ast.NewNodeKind("MathInline")
+	kindMathBlock  = ast.NewNodeKind("MathBlock")
+	kindWikiLink   = ast.NewNodeKind("WikiLink")
+
+	mathDelimiter = []byte("$$")
+
+	wikiLinkOpen  = []byte("[[")
+	wikiLinkClose = []byte("]]")
+
+	wikiLinkResolverKey = parser.NewContextKey()
+)
+
+// mathExtension parses LaTeX math, $inline$ or $$display$$, so it isn't treated as markdown
+type mathExtension struct{}
+
+func (mathExtension) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(
+		parser.WithBlockParsers(util.Prioritized(mathBlockParser{}, 650)),
+		parser.WithInlineParsers(util.Prioritized(mathInlineParser{}, 150)),
+	)
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(
+		util.Prioritized(mathRenderer{}, 500),
+		// Task list checkboxes would be removed by the sanitizer, so they're shown as text
+		util.Prioritized(taskCheckBoxRenderer{}, 100),
+	))
+}
+
+// mathInline is math within a line of text
+type mathInline struct {
+	ast.BaseInline
+	value   []byte
+	display bool
+}
+
+func (n *mathInline) Kind() ast.NodeKind {
+	return kindMathInline
+}
+
+func (n *mathInline) Dump(source []byte, level int) {
+	ast.DumpHelper(n, source, level, map[string]string{"Value": string(n.value)}, nil)
+}
+
+// mathBlock is display math on lines of its own, between lines starting with $$
+type mathBlock struct {
+	ast.BaseBlock
+	closed bool
+}
+
+func (n *mathBlock) Kind() ast.NodeKind {
+	return kindMathBlock
+}
+
+func (n *mathBlock) IsRaw() bool {
+	return true
+}
+
+func (n *mathBlock) Dump(source []byte, level int) {
+	ast.DumpHelper(n, source, level, nil, nil)
+}
+
+type mathInlineParser struct{}
+
+func (mathInlineParser) Trigger() []byte {
+	return []byte{'$'}
+}
+
+func (mathInlineParser) Parse(parent ast.Node, block text.Reader, pc parser.Context) ast.Node {
+	line, _ := block.PeekLine()
+	delim := 1
+	if len(line) > 1 && line[1] == '$' {
+		delim = 2
+	}
+
+	body := line[delim:]
+	end := -1
+	for i := 0; i < len(body) && end < 0; i++ {
+		switch {
+		case body[i] == '\\':
+			i++
+		case body[i] != '$':
+		case delim == 1:
+			end = i
+		case i+1 < len(body) && body[i+1] == '$':
+			end = i
+		}
+	}
+	if end <= 0 {
+		return nil
+	}
+
+	// Prices such as "$5 and $10" aren't math, so inline math can't start or end with a space or
+	// be followed by a digit
+	if delim == 1 {
+		after := end + 1
+		if util.IsSpace(body[0]) || util.IsSpace(body[end-1]) || (after < len(body) && body[after] >= '0' && body[after] <= '9') {
+			return nil
+		}
+	}
+
+	block.Advance(delim + end + delim)
+	return &mathInline{
+		value:   append([]byte(nil), body[:end]...),
+		display: delim == 2,
+	}
+}
+
+type mathBlockParser struct{}
+
+func (mathBlockParser) Trigger() []byte {
+	return []byte{'$'}
+}
+
+func (mathBlockParser) Open(parent ast.Node, reader text.Reader, pc parser.Context) (ast.Node, parser.State) {
+	line, segment := reader.PeekLine()
+	pos := pc.BlockOffset()
+	if pos < 0 || !bytes.HasPrefix(line[pos:], mathDelimiter) {
+		return nil, parser.NoChildren
+	}
+
+	node := &mathBlock{}
+	start := segment.Start - segment.Padding + pos + len(mathDelimiter)
+	rest := line[pos+len(mathDelimiter):]
+	if end := bytes.Index(rest, mathDelimiter); end >= 0 {
+		// Math that closes on the line it opens is only a block if nothing follows it
+		if !util.IsBlank(rest[end+len(mathDelimiter):]) {
+			return nil, parser.NoChildren
+		}
+		node.Lines().Append(text.NewSegment(start, start+end))
+		node.closed = true
+	} else if !util.IsBlank(rest) {
+		node.Lines().Append(text.NewSegment(start, segment.Stop))
+	}
+
+	reader.AdvanceToEOL()
+	return node, parser.NoChildren
+}
+
+func (mathBlockParser) Continue(node ast.Node, reader text.Reader, pc parser.Context) parser.State {
+	n := node.(*mathBlock)
+	if n.closed {
+		return parser.Close
+	}
+
+	line, segment := reader.PeekLine()
+	if end := bytes.Index(line, mathDelimiter); end >= 0 {
+		if !util.IsBlank(line[:end]) {
+			n.Lines().Append(text.NewSegment(segment.Start, segment.Start+end))
+		}
+		reader.AdvanceToEOL()
+		return parser.Close
+	}
+
+	n.Lines().Append(segment)
+	reader.AdvanceToEOL()
+	return parser.Continue | parser.NoChildren
+}
+
+func (mathBlockParser) Close(node ast.Node, reader text.Reader, pc parser.Context) {}
+
+func (mathBlockParser) CanInterruptParagraph() bool {
+	return true
+}
+
+func (mathBlockParser) CanAcceptIndentedLine() bool {
+	return false
+}
+
+// mathRenderer writes math escaped inside elements KaTeX looks for
+type mathRenderer struct{}
+
+func (r mathRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(kindMathInline, r.renderInline)
+	reg.Register(kindMathBlock, r.renderBlock)
+}
+
+func (r mathRenderer) renderInline(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {
+		return ast.WalkContinue, nil
+	}
+
+	n := node.(*mathInline)
+	class := "math math-inline"
+	if n.display {
+		class = "math math-display"
+	}
+	_, _ = w.WriteString(`<span class="` + class + `">`)
+	_, _ = w.Write(util.EscapeHTML(n.value))
+	_, _ = w.WriteString("</span>")
+	return ast.WalkSkipChildren, nil
+}
+
+func (r mathRenderer) renderBlock(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {
+		return ast.WalkContinue, nil
+	}
+
+	_, _ = w.WriteString(`<div class="math math-display">`)
+	lines := node.Lines()
+	for i := 0; i < lines.Len(); i++ {
+		segment := lines.At(i)
+		_, _ = w.Write(util.EscapeHTML(segment.Value(source)))
+	}
+	_, _ = w.WriteString("</div>\n")
+	return ast.WalkSkipChildren, nil
+}
+
+// taskCheckBoxRenderer shows the checkboxes of task lists as ☐ and ☑
+type taskCheckBoxRenderer struct{}
+
+func (r taskCheckBoxRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(extast.KindTaskCheckBox, r.render)
+}
+
+func (r taskCheckBoxRenderer) render(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {
+		return ast.WalkContinue, nil
+	}
+
+	if node.(*extast.TaskCheckBox).IsChecked {
+		_, _ = w.WriteString("☑ ")
+	} else {
+		_, _ = w.WriteString("☐ ")
+	}
+	return ast.WalkContinue, nil
+}
+
+// wikiLinkExtension parses links to other notes written as [[Note Title]] or [[note:123]]
+type wikiLinkExtension struct{}
+
+func (wikiLinkExtension) Extend(m goldmark.Markdown) {
+	// Wiki links are tried before regular links, which also start with [
+	m.Parser().AddOptions(parser.WithInlineParsers(util.Prioritized(wikiLinkParser{}, 199)))
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(util.Prioritized(wikiLinkRenderer{}, 500)))
+}
+
+// wikiLink is a link to another note, resolved while parsing when a resolver is given
+type wikiLink struct {
+	ast.BaseInline
+	link  WikiLink
+	url   string
+	title string
+}
+
+func (n *wikiLink) Kind() ast.NodeKind {
+	return kindWikiLink
+}
+
+func (n *wikiLink) Dump(source []byte, level int) {
+	ast.DumpHelper(n, source, level, map[string]string{"Target": n.link.Target(), "URL": n.url}, nil)
+}
+
+type wikiLinkParser struct{}
+
+func (wikiLinkParser) Trigger() []byte {
+	return []byte{'['}
+}
+
+func (wikiLinkParser) Parse(parent ast.Node, block text.Reader, pc parser.Context) ast.Node {
+	line, _ := block.PeekLine()
+	if !bytes.HasPrefix(line, wikiLinkOpen) {
+		return nil
+	}
+
+	body := line[len(wikiLinkOpen):]
+	end := bytes.Index(body, wikiLinkClose)
+	if end < 0 || bytes.ContainsAny(body[:end], "[]\n") {
+		return nil
+	}
+	link, ok := parseWikiLink(string(body[:end]))
+	if !ok {
+		return nil
+	}
+
+	block.Advance(len(wikiLinkOpen) + end + len(wikiLinkClose))
+	node := &wikiLink{link: link}
+	if resolve, ok := pc.Get(wikiLinkResolverKey).(WikiLinkResolver); ok {
+		if url, title, ok := resolve(link); ok {
+			node.url, node.title = url, title
+		}
+	}
+	return node
+}
+
+// wikiLinkRenderer writes wiki links as links to their notes, or flags them when they're broken
+type wikiLinkRenderer struct{}
+
+func (r wikiLinkRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(kindWikiLink, r.render)
+}
+
+func (r wikiLinkRenderer) render(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {
+		return ast.WalkContinue, nil
+	}
+
+	// Links to an ID show the title of the note they point to
+	n := node.(*wikiLink)
+	label := n.link.Target()
+	if n.link.NoteID != 0 && n.title != "" {
+		label = n.title
+	}
+
+	if n.url == "" {
+		_, _ = w.WriteString(`<span class="wikilink wikilink-broken text-red-600 underline decoration-dotted" title="No note matches this link">`)
+		_, _ = w.WriteString(html.EscapeString(label))
+		_, _ = w.WriteString("</span>")
+		return ast.WalkSkipChildren, nil
+	}
+
+	_, _ = w.WriteString(`<a class="wikilink" href="` + html.EscapeString(n.url) + `">`)
+	_, _ = w.WriteString(html.EscapeString(label))
+	_, _ = w.WriteString("</a>")
+	return ast.WalkSkipChildren, nil
+}
@@ -0,0 +1,134 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/r-scheele/zero/ent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarkdownServiceRender(t *testing.T) {
+	s := NewMarkdownService(c.Cache, c.Config)
+
+	resolve := func(link WikiLink) (string, string, bool) {
+		switch {
+		case link.Title == "Cells":
+			return "/notes/1", "Cells", true
+		case link.NoteID == 2:
+			return "/notes/2", "Mitosis & meiosis", true
+		}
+		return "", "", false
+	}
+
+	tests := map[string]struct {
+		source   string
+		contains []string
+		excludes []string
+	}{
+		"gfm": {
+			source:   "# Cells\nline one\nline two\n\n~~old~~\n\n- [x] done\n- [ ] todo\n\n| a | b |\n|---|---|\n| 1 | 2 |",
+			contains: []string{"<h1>Cells</h1>", "line one<br>", "<del>old</del>", "☑ done", "☐ todo", "<td>1</td>"},
+		},
+		"inline math": {
+			source:   "Energy is $E = mc^2$ and $a_1 * b_2$ isn't emphasis",
+			contains: []string{`<span class="math math-inline">E = mc^2</span>`, `<span class="math math-inline">a_1 * b_2</span>`},
+			excludes: []string{"<em>"},
+		},
+		"prices": {
+			source:   "It costs $5 and $10, or $ 3 $",
+			contains: []string{"It costs $5 and $10, or $ 3 $"},
+			excludes: []string{"math"},
+		},
+		"display math": {
+			source: "$$\n\\sum_{i=1}^n i\n$$\n\n$$x^2$$\n\ninline $$y$$ here",
+			contains: []string{
+				`<div class="math math-display">\sum_{i=1}^n i` + "\n</div>",
+				`<div class="math math-display">x^2</div>`,
+				`<span class="math math-display">y</span>`,
+			},
+		},
+		"escaped math": {
+			source:   `$<script>$`,
+			contains: []string{`<span class="math math-inline">&lt;script&gt;</span>`},
+		},
+		"code": {
+			source:   "```go\nfunc main() {}\n```",
+			contains: []string{`<pre class="chroma">`, `<span class="kd">func</span>`},
+		},
+		"unsafe html": {
+			source:   "<script>alert(1)</script>\n\n[link](javascript:alert(1)) <img src=x onerror=alert(1)>\n\n<div style=\"color:red\">hi</div>",
+			excludes: []string{"<script", "javascript:", "onerror", "style="},
+		},
+		"wiki links": {
+			source: "See [[Cells]], [[note:2]] and [[Missing <b>]]\n\n`[[Cells]]` [[ ]] [plain](/a)",
+			contains: []string{
+				`<a class="wikilink" href="/notes/1" rel="nofollow">Cells</a>`,
+				`<a class="wikilink" href="/notes/2" rel="nofollow">Mitosis &amp; meiosis</a>`,
+				`<span class="wikilink wikilink-broken text-red-600 underline decoration-dotted" title="No note matches this link">Missing &lt;b&gt;</span>`,
+				"<code>[[Cells]]</code>",
+				"[[ ]]",
+				`<a href="/a" rel="nofollow">plain</a>`,
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			rendered, err := s.Render(tc.source, resolve)
+			require.NoError(t, err)
+			for _, want := range tc.contains {
+				assert.Contains(t, rendered, want)
+			}
+			for _, unwanted := range tc.excludes {
+				assert.NotContains(t, rendered, unwanted)
+			}
+		})
+	}
+}
+
+func TestMarkdownServiceRenderNote(t *testing.T) {
+	ctx := context.Background()
+	s := NewMarkdownService(c.Cache, c.Config)
+
+	noteURL := func(id int) string {
+		return fmt.Sprintf("/notes/%d", id)
+	}
+
+	n := &ent.Note{ID: 1000, Content: "**first**", UpdatedAt: time.Now()}
+	rendered, err := s.RenderNote(ctx, n, noteURL)
+	require.NoError(t, err)
+	assert.Contains(t, rendered, "<strong>first</strong>")
+
+	// The rendered content is cached until the note is updated
+	n.Content = "**second**"
+	rendered, err = s.RenderNote(ctx, n, noteURL)
+	require.NoError(t, err)
+	assert.Contains(t, rendered, "<strong>first</strong>")
+
+	n.UpdatedAt = n.UpdatedAt.Add(time.Second)
+	rendered, err = s.RenderNote(ctx, n, noteURL)
+	require.NoError(t, err)
+	assert.Contains(t, rendered, "<strong>second</strong>")
+
+	// Or until its links point somewhere else
+	n.Content = "[[Cells]]"
+	n.UpdatedAt = n.UpdatedAt.Add(time.Second)
+	n.Edges.Links = []*ent.NoteLink{{Target: "Cells"}}
+	rendered, err = s.RenderNote(ctx, n, noteURL)
+	require.NoError(t, err)
+	assert.Contains(t, rendered, "wikilink-broken")
+
+	n.Edges.Links[0].Edges.Note = &ent.Note{ID: 7, Title: "Cells"}
+	rendered, err = s.RenderNote(ctx, n, noteURL)
+	require.NoError(t, err)
+	assert.Contains(t, rendered, `<a class="wikilink" href="/notes/7" rel="nofollow">Cells</a>`)
+}
+
+func TestParseWikiLinks(t *testing.T) {
+	links := ParseWikiLinks("[[Cells]] [[cells]] [[note:12]] [[note:x]]\n\n```\n[[In code]]\n```\n$[[math]]$")
+	assert.Equal(t, []WikiLink{{Title: "Cells"}, {NoteID: 12}, {Title: "note:x"}}, links)
+}
@@ -0,0 +1,165 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/r-scheele/zero/ent"
+	"github.com/r-scheele/zero/ent/note"
+	"github.com/r-scheele/zero/ent/notelink"
+	"github.com/r-scheele/zero/ent/user"
+)
+
+// ListLinks returns the wiki links written in a note, with the notes they point to. Links are
+// resolved for the note's owner, so the notes the user can't see are left out and their links
+// appear broken. Without a user, only public notes are included.
+func (s *NotesService) ListLinks(ctx context.Context, noteID int, userID *int) ([]*ent.NoteLink, error) {
+	links, err := s.orm.NoteLink.Query().
+		Where(notelink.HasSourceWith(note.ID(noteID))).
+		WithNote(func(q *ent.NoteQuery) {
+			q.Where(noteVisibleTo(userID))
+		}).
+		Order(ent.Asc(notelink.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch note links: %w", err)
+	}
+	return links, nil
+}
+
+// ListBacklinks returns the other notes that link to a note, leaving out those the user can't
+// see. Without a user, only public notes are returned.
+func (s *NotesService) ListBacklinks(ctx context.Context, noteID int, userID *int) ([]*ent.Note, error) {
+	notes, err := s.orm.Note.Query().
+		Where(
+			note.IDNEQ(noteID),
+			note.HasLinksWith(notelink.HasNoteWith(note.ID(noteID))),
+			noteVisibleTo(userID),
+		).
+		WithOwner().
+		Order(ent.Asc(note.FieldTitle)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch backlinks: %w", err)
+	}
+	return notes, nil
+}
+
+// ResolveWikiLink finds the note a wiki link in one of the owner's notes points to, or returns nil
+// when the link is broken
+func (s *NotesService) ResolveWikiLink(ctx context.Context, ownerID int, link WikiLink) (*ent.Note, error) {
+	return resolveWikiLink(ctx, s.orm, ownerID, link)
+}
+
+// resolveWikiLink finds the note a wiki link points to. Notes can link to the owner's notes and to
+// public notes, and links by title prefer the owner's own notes, then the most recently updated.
+func resolveWikiLink(ctx context.Context, client *ent.Client, ownerID int, link WikiLink) (*ent.Note, error) {
+	owned := note.HasOwnerWith(user.ID(ownerID))
+	public := note.VisibilityEQ(note.VisibilityPublic)
+
+	var (
+		n   *ent.Note
+		err error
+	)
+	if link.NoteID != 0 {
+		n, err = client.Note.Query().
+			Where(note.ID(link.NoteID), note.Or(owned, public)).
+			Only(ctx)
+	} else {
+		n, err = client.Note.Query().
+			Where(note.TitleEqualFold(link.Title), owned).
+			Order(ent.Desc(note.FieldUpdatedAt)).
+			First(ctx)
+		if ent.IsNotFound(err) {
+			n, err = client.Note.Query().
+				Where(note.TitleEqualFold(link.Title), public).
+				Order(ent.Desc(note.FieldUpdatedAt)).
+				First(ctx)
+		}
+	}
+
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve link: %w", err)
+	}
+	return n, nil
+}
+
+// syncLinks replaces the links stored for a note that was just saved with those in its content,
+// then resolves again the links in other notes that its new title or visibility may affect
+func (s *NotesService) syncLinks(ctx context.Context, client *ent.Client, n *ent.Note) error {
+	ownerID, err := client.Note.QueryOwner(n).OnlyID(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch note owner: %w", err)
+	}
+
+	if _, err := client.NoteLink.Delete().Where(notelink.HasSourceWith(note.ID(n.ID))).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to delete note links: %w", err)
+	}
+
+	for _, link := range ParseWikiLinks(n.Content) {
+		target, err := resolveWikiLink(ctx, client, ownerID, link)
+		if err != nil {
+			return err
+		}
+
+		create := client.NoteLink.Create().
+			SetSourceID(n.ID).
+			SetTarget(link.Target())
+		if target != nil {
+			create.SetNoteID(target.ID)
+		}
+		if err := create.Exec(ctx); err != nil {
+			return fmt.Errorf("failed to save note link: %w", err)
+		}
+	}
+
+	return relinkNote(ctx, client, n.ID, n.Title)
+}
+
+// relinkNote resolves again the links in other notes that point to a note, or are written with
+// its title or ID, after it was saved or deleted
+func relinkNote(ctx context.Context, client *ent.Client, noteID int, title string) error {
+	links, err := client.NoteLink.Query().
+		Where(
+			notelink.Not(notelink.HasSourceWith(note.ID(noteID))),
+			notelink.Or(
+				notelink.HasNoteWith(note.ID(noteID)),
+				notelink.TargetEqualFold(title),
+				notelink.Target(WikiLink{NoteID: noteID}.Target()),
+			),
+		).
+		WithSource(func(q *ent.NoteQuery) {
+			q.WithOwner()
+		}).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch links to note: %w", err)
+	}
+
+	for _, l := range links {
+		link, ok := parseWikiLink(l.Target)
+		if !ok || l.Edges.Source.Edges.Owner == nil {
+			continue
+		}
+
+		target, err := resolveWikiLink(ctx, client, l.Edges.Source.Edges.Owner.ID, link)
+		if err != nil {
+			return err
+		}
+
+		update := client.NoteLink.UpdateOne(l)
+		if target != nil {
+			update.SetNoteID(target.ID)
+		} else {
+			update.ClearNote()
+		}
+		if err := update.Exec(ctx); err != nil {
+			return fmt.Errorf("failed to update note link: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,151 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/r-scheele/zero/ent"
+	"github.com/r-scheele/zero/pkg/tests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// linkTargets maps the targets of a note's links to the IDs of the notes they point to for a
+// user, with zero for broken links and links to notes they can't see
+func linkTargets(t *testing.T, noteID int, userID *int) map[string]int {
+	links, err := c.Notes.ListLinks(context.Background(), noteID, userID)
+	require.NoError(t, err)
+
+	targets := make(map[string]int, len(links))
+	for _, l := range links {
+		targets[l.Target] = 0
+		if l.Edges.Note != nil {
+			targets[l.Target] = l.Edges.Note.ID
+		}
+	}
+	return targets
+}
+
+func TestNotesServiceSyncLinks(t *testing.T) {
+	ctx := context.Background()
+
+	owner, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	other, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+
+	cells, err := c.Notes.CreateNote(ctx, owner.ID, CreateNoteInput{Title: "Cells"})
+	require.NoError(t, err)
+	theirs, err := c.Notes.CreateNote(ctx, other.ID, CreateNoteInput{Title: "Secret", Visibility: "private"})
+	require.NoError(t, err)
+	public, err := c.Notes.CreateNote(ctx, other.ID, CreateNoteInput{Title: "Enzymes", Visibility: "public"})
+	require.NoError(t, err)
+
+	// Links can point to the owner's notes and to public notes, by title or ID
+	content := fmt.Sprintf("[[cells]] [[Enzymes]] [[Secret]] [[note:%d]] [[Mitosis]]", theirs.ID)
+	source, err := c.Notes.CreateNote(ctx, owner.ID, CreateNoteInput{Title: "Biology", Content: content})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{
+		"cells":                           cells.ID,
+		"Enzymes":                         public.ID,
+		"Secret":                          0,
+		fmt.Sprintf("note:%d", theirs.ID): 0,
+		"Mitosis":                         0,
+	}, linkTargets(t, source.ID, &owner.ID))
+
+	// Others only see the links to notes they can see
+	diary, err := c.Notes.CreateNote(ctx, owner.ID, CreateNoteInput{Title: "Diary", Visibility: "private"})
+	require.NoError(t, err)
+	linked, err := c.Notes.CreateNote(ctx, owner.ID, CreateNoteInput{
+		Title:      "Reading list",
+		Content:    fmt.Sprintf("[[Diary]] [[note:%d]] [[Enzymes]]", diary.ID),
+		Visibility: "public",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, diary.ID, linkTargets(t, linked.ID, &owner.ID)["Diary"])
+	for _, userID := range []*int{&other.ID, nil} {
+		assert.Equal(t, map[string]int{
+			"Diary":                          0,
+			fmt.Sprintf("note:%d", diary.ID): 0,
+			"Enzymes":                        public.ID,
+		}, linkTargets(t, linked.ID, userID))
+	}
+
+	// A note created with the title of a broken link fixes it
+	mitosis, err := c.Notes.CreateNote(ctx, owner.ID, CreateNoteInput{Title: "Mitosis"})
+	require.NoError(t, err)
+	assert.Equal(t, mitosis.ID, linkTargets(t, source.ID, &owner.ID)["Mitosis"])
+
+	// Links break when their note is renamed or made private, or is deleted
+	renamed := "Cell biology"
+	_, err = c.Notes.UpdateNote(ctx, cells.ID, owner.ID, UpdateNoteInput{Title: &renamed})
+	require.NoError(t, err)
+	private := "private"
+	_, err = c.Notes.UpdateNote(ctx, public.ID, other.ID, UpdateNoteInput{Visibility: &private})
+	require.NoError(t, err)
+	require.NoError(t, c.Notes.DeleteNote(ctx, mitosis.ID, owner.ID))
+
+	targets := linkTargets(t, source.ID, &owner.ID)
+	assert.Zero(t, targets["cells"])
+	assert.Zero(t, targets["Enzymes"])
+	assert.Zero(t, targets["Mitosis"])
+
+	// Saving the note replaces its links
+	updated := "Only [[Cell biology]] now"
+	_, err = c.Notes.UpdateNote(ctx, source.ID, owner.ID, UpdateNoteInput{Content: &updated})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"Cell biology": cells.ID}, linkTargets(t, source.ID, &owner.ID))
+
+	// Deleting a note takes its links with it
+	require.NoError(t, c.Notes.DeleteNote(ctx, source.ID, owner.ID))
+	links, err := c.Notes.ListLinks(ctx, source.ID, &owner.ID)
+	require.NoError(t, err)
+	assert.Empty(t, links)
+}
+
+func TestNotesServiceListBacklinks(t *testing.T) {
+	ctx := context.Background()
+
+	owner, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	other, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+
+	target, err := c.Notes.CreateNote(ctx, owner.ID, CreateNoteInput{Title: "Respiration", Visibility: "public"})
+	require.NoError(t, err)
+
+	link := fmt.Sprintf("See [[note:%d]]", target.ID)
+	ownPrivate, err := c.Notes.CreateNote(ctx, owner.ID, CreateNoteInput{Title: "Plants", Content: link})
+	require.NoError(t, err)
+	otherPublic, err := c.Notes.CreateNote(ctx, other.ID, CreateNoteInput{Title: "Stomata", Content: "[[Respiration]]", Visibility: "public"})
+	require.NoError(t, err)
+	_, err = c.Notes.CreateNote(ctx, other.ID, CreateNoteInput{Title: "Drafts", Content: link})
+	require.NoError(t, err)
+
+	// Self links aren't backlinks
+	self := fmt.Sprintf("[[note:%d]]", target.ID)
+	_, err = c.Notes.UpdateNote(ctx, target.ID, owner.ID, UpdateNoteInput{Content: &self})
+	require.NoError(t, err)
+
+	titles := func(notes []*ent.Note) []string {
+		out := make([]string, len(notes))
+		for i, n := range notes {
+			out[i] = n.Title
+		}
+		return out
+	}
+
+	// Users only see the notes linking here that they could open
+	backlinks, err := c.Notes.ListBacklinks(ctx, target.ID, &owner.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{ownPrivate.Title, otherPublic.Title}, titles(backlinks))
+
+	backlinks, err = c.Notes.ListBacklinks(ctx, target.ID, &other.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Drafts", otherPublic.Title}, titles(backlinks))
+
+	backlinks, err = c.Notes.ListBacklinks(ctx, target.ID, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{otherPublic.Title}, titles(backlinks))
+}
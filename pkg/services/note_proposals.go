@@ -0,0 +1,259 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/r-scheele/zero/ent"
+	"github.com/r-scheele/zero/ent/note"
+	"github.com/r-scheele/zero/ent/noteproposal"
+	"github.com/r-scheele/zero/ent/user"
+)
+
+var (
+	// ErrNoteEditDenied is returned when a user can't edit or propose changes to a note
+	ErrNoteEditDenied = errors.New("you don't have permission to edit this note")
+
+	// ErrNoteNeedsApproval is returned when a user's changes to a note must be proposed to its owner
+	ErrNoteNeedsApproval = errors.New("changes to this note need the owner's approval")
+
+	// ErrProposalNotFound is returned when a change proposal doesn't exist or can't be seen by the user
+	ErrProposalNotFound = errors.New("proposed change not found")
+
+	// ErrProposalReviewed is returned when a change proposal has already been accepted or rejected
+	ErrProposalReviewed = errors.New("this change has already been reviewed")
+
+	// ErrProposalEmpty is returned when a proposal doesn't change anything
+	ErrProposalEmpty = errors.New("no changes were made to the note")
+
+	// ErrProposalStale is returned when a proposal is accepted after the note changed since it was
+	// made, since accepting it would undo those changes
+	ErrProposalStale = errors.New("the note has changed since this change was proposed")
+)
+
+// NoteEditMode is how a user can change a note
+type NoteEditMode int
+
+const (
+	// NoteEditNone means the user can't change the note
+	NoteEditNone NoteEditMode = iota
+
+	// NoteEditOwner means the user owns the note and can change all of it
+	NoteEditOwner
+
+	// NoteEditDirect means the user can change the text of the note
+	NoteEditDirect
+
+	// NoteEditPropose means the user can propose changes to the text of the note to its owner
+	NoteEditPropose
+)
+
+// EditModeFor returns how a user can change a note, which must have its owner loaded. Other
+// users can edit public notes according to their permission level.
+func EditModeFor(n *ent.Note, userID int) NoteEditMode {
+	switch {
+	case n.Edges.Owner != nil && n.Edges.Owner.ID == userID:
+		return NoteEditOwner
+	case n.Visibility != note.VisibilityPublic:
+		return NoteEditNone
+	case n.PermissionLevel == note.PermissionLevelReadWrite:
+		return NoteEditDirect
+	case n.PermissionLevel == note.PermissionLevelReadWriteApproval:
+		return NoteEditPropose
+	default:
+		return NoteEditNone
+	}
+}
+
+// ProposalInput is the text of a note proposed by a user who isn't its owner
+type ProposalInput struct {
+	Title       string
+	Description string
+	Content     string
+}
+
+// ProposeChange records a change to a note that needs its owner's approval
+func (s *NotesService) ProposeChange(ctx context.Context, noteID, userID int, input ProposalInput) (*ent.NoteProposal, error) {
+	n, err := s.orm.Note.Query().
+		Where(note.ID(noteID)).
+		WithOwner().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrNoteEditDenied
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch note: %w", err)
+	}
+	if EditModeFor(n, userID) != NoteEditPropose {
+		return nil, ErrNoteEditDenied
+	}
+
+	input.Title = strings.TrimSpace(input.Title)
+	input.Description = strings.TrimSpace(input.Description)
+	input.Content = strings.TrimSpace(input.Content)
+	if input.Title == "" {
+		return nil, errors.New("title is required")
+	}
+	if input.Title == n.Title && input.Description == n.Description && input.Content == n.Content {
+		return nil, ErrProposalEmpty
+	}
+
+	proposal, err := s.orm.NoteProposal.Create().
+		SetNoteID(noteID).
+		SetProposerID(userID).
+		SetTitle(input.Title).
+		SetDescription(input.Description).
+		SetContent(input.Content).
+		SetBaseTitle(n.Title).
+		SetBaseDescription(n.Description).
+		SetBaseContent(n.Content).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save proposed change: %w", err)
+	}
+
+	return proposal, nil
+}
+
+// ListProposals lists the pending proposals for a note the user owns, oldest first
+func (s *NotesService) ListProposals(ctx context.Context, noteID, ownerID int) ([]*ent.NoteProposal, error) {
+	proposals, err := s.orm.NoteProposal.Query().
+		Where(
+			noteproposal.StatusEQ(noteproposal.StatusPending),
+			noteproposal.HasNoteWith(note.ID(noteID), note.HasOwnerWith(user.ID(ownerID))),
+		).
+		WithProposer().
+		Order(ent.Asc(noteproposal.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list proposed changes: %w", err)
+	}
+	return proposals, nil
+}
+
+// ListUserProposals lists the proposals a user has made to a note, most recent first
+func (s *NotesService) ListUserProposals(ctx context.Context, noteID, userID, limit int) ([]*ent.NoteProposal, error) {
+	proposals, err := s.orm.NoteProposal.Query().
+		Where(
+			noteproposal.HasNoteWith(note.ID(noteID)),
+			noteproposal.HasProposerWith(user.ID(userID)),
+		).
+		Order(ent.Desc(noteproposal.FieldCreatedAt)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list proposed changes: %w", err)
+	}
+	return proposals, nil
+}
+
+// GetProposal loads a proposal for a note, with the note and proposer, which can be seen by the
+// note's owner and the proposer
+func (s *NotesService) GetProposal(ctx context.Context, noteID, proposalID, userID int) (*ent.NoteProposal, error) {
+	proposal, err := s.orm.NoteProposal.Query().
+		Where(
+			noteproposal.ID(proposalID),
+			noteproposal.HasNoteWith(note.ID(noteID)),
+			noteproposal.Or(
+				noteproposal.HasProposerWith(user.ID(userID)),
+				noteproposal.HasNoteWith(note.HasOwnerWith(user.ID(userID))),
+			),
+		).
+		WithNote(func(q *ent.NoteQuery) {
+			q.WithOwner()
+		}).
+		WithProposer().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrProposalNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch proposed change: %w", err)
+	}
+	return proposal, nil
+}
+
+// ReviewProposal accepts or rejects a pending proposal for a note the user owns. Accepting it
+// replaces the text of the note with the proposed text.
+func (s *NotesService) ReviewProposal(ctx context.Context, noteID, proposalID, ownerID int, accept bool, now time.Time) (*ent.NoteProposal, error) {
+	tx, err := s.orm.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	proposal, err := tx.NoteProposal.Query().
+		Where(
+			noteproposal.ID(proposalID),
+			noteproposal.HasNoteWith(note.ID(noteID), note.HasOwnerWith(user.ID(ownerID))),
+		).
+		WithNote().
+		WithProposer().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, rollback(tx, ErrProposalNotFound)
+	}
+	if err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to fetch proposed change: %w", err))
+	}
+
+	status := noteproposal.StatusRejected
+	if accept {
+		status = noteproposal.StatusAccepted
+	}
+
+	// Only a pending proposal can be reviewed, even if two reviews race
+	updated, err := tx.NoteProposal.Update().
+		Where(noteproposal.ID(proposalID), noteproposal.StatusEQ(noteproposal.StatusPending)).
+		SetStatus(status).
+		SetReviewedAt(now).
+		Save(ctx)
+	if err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to review proposed change: %w", err))
+	}
+	if updated == 0 {
+		return nil, rollback(tx, ErrProposalReviewed)
+	}
+
+	// The change only applies to the note as it was when it was proposed, so later edits aren't
+	// lost. The proposer is the author of the revision the change makes.
+	if accept {
+		applied, err := tx.Note.Update().
+			Where(
+				note.ID(noteID),
+				note.Title(proposal.BaseTitle),
+				note.Description(proposal.BaseDescription),
+				note.Content(proposal.BaseContent),
+			).
+			SetTitle(proposal.Title).
+			SetDescription(proposal.Description).
+			SetContent(proposal.Content).
+			Save(ctx)
+		if err != nil {
+			return nil, rollback(tx, fmt.Errorf("failed to apply proposed change: %w", err))
+		}
+		if applied == 0 {
+			return nil, rollback(tx, ErrProposalStale)
+		}
+		n, err := tx.Note.Get(ctx, noteID)
+		if err != nil {
+			return nil, rollback(tx, fmt.Errorf("failed to fetch note: %w", err))
+		}
+		if err := s.recordRevision(ctx, tx, n, proposal.Edges.Proposer.ID); err != nil {
+			return nil, rollback(tx, err)
+		}
+		if err := s.syncLinks(ctx, tx.Client(), n); err != nil {
+			return nil, rollback(tx, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit review: %w", err)
+	}
+
+	proposal.Status = status
+	proposal.ReviewedAt = &now
+	return proposal, nil
+}
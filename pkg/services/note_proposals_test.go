@@ -0,0 +1,200 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/r-scheele/zero/ent"
+	"github.com/r-scheele/zero/ent/note"
+	"github.com/r-scheele/zero/ent/noteproposal"
+	"github.com/r-scheele/zero/pkg/tests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createSharedNote creates a public note with the given permission level, with its owner loaded,
+// and another user who can try to edit it
+func createSharedNote(t *testing.T, permissionLevel string) (*ent.Note, *ent.User) {
+	ctx := context.Background()
+
+	owner, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	other, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+
+	n, err := c.Notes.CreateNote(ctx, owner.ID, CreateNoteInput{
+		Title:           "Chemistry",
+		Description:     "Reactions",
+		Content:         "Acids\nBases",
+		Visibility:      "public",
+		PermissionLevel: permissionLevel,
+	})
+	require.NoError(t, err)
+
+	n, err = c.ORM.Note.Query().Where(note.ID(n.ID)).WithOwner().Only(ctx)
+	require.NoError(t, err)
+	return n, other
+}
+
+func TestEditModeFor(t *testing.T) {
+	owner := &ent.User{ID: 1}
+	n := &ent.Note{
+		Visibility:      note.VisibilityPublic,
+		PermissionLevel: note.PermissionLevelReadOnly,
+		Edges:           ent.NoteEdges{Owner: owner},
+	}
+	assert.Equal(t, NoteEditOwner, EditModeFor(n, owner.ID))
+	assert.Equal(t, NoteEditNone, EditModeFor(n, 2))
+
+	n.PermissionLevel = note.PermissionLevelReadWrite
+	assert.Equal(t, NoteEditDirect, EditModeFor(n, 2))
+
+	n.PermissionLevel = note.PermissionLevelReadWriteApproval
+	assert.Equal(t, NoteEditPropose, EditModeFor(n, 2))
+
+	// Private notes can only be changed by their owner
+	n.Visibility = note.VisibilityPrivate
+	assert.Equal(t, NoteEditNone, EditModeFor(n, 2))
+	assert.Equal(t, NoteEditOwner, EditModeFor(n, owner.ID))
+}
+
+func TestNotesServiceUpdateNoteCollaborator(t *testing.T) {
+	ctx := context.Background()
+	title := "Organic chemistry"
+	private := "private"
+
+	// Read-only notes can't be changed by other users
+	n, other := createSharedNote(t, "read_only")
+	_, err := c.Notes.UpdateNote(ctx, n.ID, other.ID, UpdateNoteInput{Title: &title})
+	assert.Error(t, err)
+
+	// Notes that need approval can't be changed directly
+	n, other = createSharedNote(t, "read_write_approval")
+	_, err = c.Notes.UpdateNote(ctx, n.ID, other.ID, UpdateNoteInput{Title: &title})
+	assert.ErrorIs(t, err, ErrNoteNeedsApproval)
+
+	// Other users can change the text of read-write notes, but not their settings
+	n, other = createSharedNote(t, "read_write")
+	updated, err := c.Notes.UpdateNote(ctx, n.ID, other.ID, UpdateNoteInput{
+		Title:      &title,
+		Visibility: &private,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, title, updated.Title)
+	assert.Equal(t, note.VisibilityPublic, updated.Visibility)
+}
+
+func TestNotesServiceProposeChange(t *testing.T) {
+	ctx := context.Background()
+	n, other := createSharedNote(t, "read_write_approval")
+	owner := n.Edges.Owner
+
+	// Owners edit their notes directly
+	_, err := c.Notes.ProposeChange(ctx, n.ID, owner.ID, ProposalInput{Title: "Mine"})
+	assert.ErrorIs(t, err, ErrNoteEditDenied)
+
+	_, err = c.Notes.ProposeChange(ctx, n.ID, other.ID, ProposalInput{
+		Title:       " Chemistry ",
+		Description: "Reactions",
+		Content:     "Acids\nBases\n",
+	})
+	assert.ErrorIs(t, err, ErrProposalEmpty)
+
+	proposal, err := c.Notes.ProposeChange(ctx, n.ID, other.ID, ProposalInput{
+		Title:       "Chemistry",
+		Description: "Reactions",
+		Content:     "Acids\nBases\nSalts",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, noteproposal.StatusPending, proposal.Status)
+	assert.Equal(t, "Acids\nBases", proposal.BaseContent)
+
+	// The note is unchanged until the owner accepts
+	n, err = c.ORM.Note.Get(ctx, n.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Acids\nBases", n.Content)
+
+	pending, err := c.Notes.ListProposals(ctx, n.ID, owner.ID)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	assert.Equal(t, other.ID, pending[0].Edges.Proposer.ID)
+
+	// Only the owner sees the note's pending proposals
+	pending, err = c.Notes.ListProposals(ctx, n.ID, other.ID)
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+
+	mine, err := c.Notes.ListUserProposals(ctx, n.ID, other.ID, 5)
+	require.NoError(t, err)
+	require.Len(t, mine, 1)
+
+	// The owner and the proposer can view the proposal, but nobody else
+	_, err = c.Notes.GetProposal(ctx, n.ID, proposal.ID, owner.ID)
+	require.NoError(t, err)
+	got, err := c.Notes.GetProposal(ctx, n.ID, proposal.ID, other.ID)
+	require.NoError(t, err)
+	assert.Equal(t, owner.ID, got.Edges.Note.Edges.Owner.ID)
+	stranger, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	_, err = c.Notes.GetProposal(ctx, n.ID, proposal.ID, stranger.ID)
+	assert.ErrorIs(t, err, ErrProposalNotFound)
+}
+
+func TestNotesServiceReviewProposal(t *testing.T) {
+	ctx := context.Background()
+	n, other := createSharedNote(t, "read_write_approval")
+	owner := n.Edges.Owner
+	now := time.Now()
+
+	propose := func(content string) *ent.NoteProposal {
+		proposal, err := c.Notes.ProposeChange(ctx, n.ID, other.ID, ProposalInput{
+			Title:       "Chemistry",
+			Description: "Reactions",
+			Content:     content,
+		})
+		require.NoError(t, err)
+		return proposal
+	}
+	accepted, rejected, stale := propose("Acids\nBases\nSalts"), propose("Nothing"), propose("Acids")
+
+	// Only the owner can review
+	_, err := c.Notes.ReviewProposal(ctx, n.ID, accepted.ID, other.ID, true, now)
+	assert.ErrorIs(t, err, ErrProposalNotFound)
+
+	reviewed, err := c.Notes.ReviewProposal(ctx, n.ID, rejected.ID, owner.ID, false, now)
+	require.NoError(t, err)
+	assert.Equal(t, noteproposal.StatusRejected, reviewed.Status)
+	n, err = c.ORM.Note.Get(ctx, n.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Acids\nBases", n.Content)
+
+	reviewed, err = c.Notes.ReviewProposal(ctx, n.ID, accepted.ID, owner.ID, true, now)
+	require.NoError(t, err)
+	assert.Equal(t, noteproposal.StatusAccepted, reviewed.Status)
+	assert.Equal(t, other.ID, reviewed.Edges.Proposer.ID)
+	n, err = c.ORM.Note.Get(ctx, n.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Acids\nBases\nSalts", n.Content)
+
+	// A proposal made before the note last changed can't be accepted, since that would undo the
+	// change, but it can still be rejected
+	_, err = c.Notes.ReviewProposal(ctx, n.ID, stale.ID, owner.ID, true, now)
+	assert.ErrorIs(t, err, ErrProposalStale)
+	n, err = c.ORM.Note.Get(ctx, n.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Acids\nBases\nSalts", n.Content)
+	_, err = c.Notes.ReviewProposal(ctx, n.ID, stale.ID, owner.ID, false, now)
+	require.NoError(t, err)
+
+	// Proposals can only be reviewed once
+	_, err = c.Notes.ReviewProposal(ctx, n.ID, accepted.ID, owner.ID, false, now)
+	assert.ErrorIs(t, err, ErrProposalReviewed)
+
+	pending, err := c.Notes.ListProposals(ctx, n.ID, owner.ID)
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+
+	// Notes with proposals can be deleted
+	require.NoError(t, c.Notes.DeleteNote(ctx, n.ID, owner.ID))
+}
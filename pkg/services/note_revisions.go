@@ -0,0 +1,157 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/r-scheele/zero/ent"
+	"github.com/r-scheele/zero/ent/note"
+	"github.com/r-scheele/zero/ent/noterevision"
+)
+
+// ErrRevisionNotFound is returned when a revision doesn't exist or belongs to another note
+var ErrRevisionNotFound = errors.New("revision not found")
+
+// ListRevisions lists the revisions of a note, most recent first, with their authors. The history
+// of a note can be seen by anyone who can edit it or propose changes to it.
+func (s *NotesService) ListRevisions(ctx context.Context, noteID, userID int) ([]*ent.NoteRevision, error) {
+	if _, err := s.historyEditMode(ctx, noteID, userID); err != nil {
+		return nil, err
+	}
+
+	revisions, err := s.orm.NoteRevision.Query().
+		Where(noterevision.HasNoteWith(note.ID(noteID))).
+		WithAuthor().
+		Order(ent.Desc(noterevision.FieldCreatedAt), ent.Desc(noterevision.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list revisions: %w", err)
+	}
+	return revisions, nil
+}
+
+// RestoreRevision sets the title, description and content of a note back to those of one of its
+// revisions, which is saved as a new revision. Attachments are left as they are, since the files
+// of any that were removed may have been deleted.
+func (s *NotesService) RestoreRevision(ctx context.Context, noteID, revisionID, userID int) (*ent.Note, error) {
+	mode, err := s.historyEditMode(ctx, noteID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if mode == NoteEditPropose {
+		return nil, ErrNoteNeedsApproval
+	}
+
+	revision, err := s.orm.NoteRevision.Query().
+		Where(noterevision.ID(revisionID), noterevision.HasNoteWith(note.ID(noteID))).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrRevisionNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch revision: %w", err)
+	}
+
+	tx, err := s.orm.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	restored, err := tx.Note.UpdateOneID(noteID).
+		SetTitle(revision.Title).
+		SetDescription(revision.Description).
+		SetContent(revision.Content).
+		Save(ctx)
+	if err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to restore revision: %w", err))
+	}
+
+	if err := s.recordRevision(ctx, tx, restored, userID); err != nil {
+		return nil, rollback(tx, err)
+	}
+	if err := s.syncLinks(ctx, tx.Client(), restored); err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit restored revision: %w", err)
+	}
+
+	return restored, nil
+}
+
+// historyEditMode returns how a user can change a note whose history they're looking at
+func (s *NotesService) historyEditMode(ctx context.Context, noteID, userID int) (NoteEditMode, error) {
+	n, err := s.orm.Note.Query().
+		Where(note.ID(noteID)).
+		WithOwner().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return NoteEditNone, ErrNoteEditDenied
+	}
+	if err != nil {
+		return NoteEditNone, fmt.Errorf("failed to fetch note: %w", err)
+	}
+
+	mode := EditModeFor(n, userID)
+	if mode == NoteEditNone {
+		return NoteEditNone, ErrNoteEditDenied
+	}
+	return mode, nil
+}
+
+// recordRevision saves a snapshot of a note that was just saved by a user, then prunes its old
+// revisions
+func (s *NotesService) recordRevision(ctx context.Context, tx *ent.Tx, n *ent.Note, authorID int) error {
+	err := tx.NoteRevision.Create().
+		SetNoteID(n.ID).
+		SetAuthorID(authorID).
+		SetTitle(n.Title).
+		SetDescription(n.Description).
+		SetContent(n.Content).
+		SetResources(n.Resources).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to save revision: %w", err)
+	}
+
+	return s.pruneRevisions(ctx, tx, n.ID, time.Now())
+}
+
+// pruneRevisions removes the revisions of a note beyond the configured number to keep, and those
+// older than the configured age. The latest revision is always kept.
+func (s *NotesService) pruneRevisions(ctx context.Context, tx *ent.Tx, noteID int, now time.Time) error {
+	retention := s.config.Notes.Revisions
+	if retention.Keep <= 0 && retention.MaxAge <= 0 {
+		return nil
+	}
+
+	revisions, err := tx.NoteRevision.Query().
+		Where(noterevision.HasNoteWith(note.ID(noteID))).
+		Order(ent.Desc(noterevision.FieldCreatedAt), ent.Desc(noterevision.FieldID)).
+		Select(noterevision.FieldCreatedAt).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list revisions: %w", err)
+	}
+
+	// The first revision is the latest
+	var expired []int
+	for i := 1; i < len(revisions); i++ {
+		tooMany := retention.Keep > 0 && i >= retention.Keep
+		tooOld := retention.MaxAge > 0 && revisions[i].CreatedAt.Before(now.Add(-retention.MaxAge))
+		if tooMany || tooOld {
+			expired = append(expired, revisions[i].ID)
+		}
+	}
+	if len(expired) == 0 {
+		return nil
+	}
+
+	if _, err := tx.NoteRevision.Delete().Where(noterevision.IDIn(expired...)).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to prune revisions: %w", err)
+	}
+	return nil
+}
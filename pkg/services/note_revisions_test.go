@@ -0,0 +1,125 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/r-scheele/zero/ent/note"
+	"github.com/r-scheele/zero/ent/noterevision"
+	"github.com/r-scheele/zero/pkg/tests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotesServiceRevisions(t *testing.T) {
+	ctx := context.Background()
+	n, collaborator := createSharedNote(t, "read_write")
+	owner := n.Edges.Owner
+
+	content := "Acids\nBases\nSalts"
+	_, err := c.Notes.UpdateNote(ctx, n.ID, collaborator.ID, UpdateNoteInput{Content: &content})
+	require.NoError(t, err)
+
+	// Revisions are listed newest first, with who saved them
+	revisions, err := c.Notes.ListRevisions(ctx, n.ID, owner.ID)
+	require.NoError(t, err)
+	require.Len(t, revisions, 2)
+	assert.Equal(t, content, revisions[0].Content)
+	assert.Equal(t, collaborator.ID, revisions[0].Edges.Author.ID)
+	assert.Equal(t, "Acids\nBases", revisions[1].Content)
+	assert.Equal(t, owner.ID, revisions[1].Edges.Author.ID)
+
+	stranger, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	private := "private"
+	_, err = c.Notes.UpdateNote(ctx, n.ID, owner.ID, UpdateNoteInput{Visibility: &private})
+	require.NoError(t, err)
+	_, err = c.Notes.ListRevisions(ctx, n.ID, stranger.ID)
+	assert.ErrorIs(t, err, ErrNoteEditDenied)
+
+	// Restoring saves a new revision
+	restored, err := c.Notes.RestoreRevision(ctx, n.ID, revisions[1].ID, owner.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Acids\nBases", restored.Content)
+	assert.Equal(t, note.VisibilityPrivate, restored.Visibility)
+	revisions, err = c.Notes.ListRevisions(ctx, n.ID, owner.ID)
+	require.NoError(t, err)
+	require.Len(t, revisions, 4)
+	assert.Equal(t, "Acids\nBases", revisions[0].Content)
+
+	// Revisions of other notes can't be restored
+	other, _ := createSharedNote(t, "read_write")
+	_, err = c.Notes.RestoreRevision(ctx, other.ID, revisions[1].ID, other.Edges.Owner.ID)
+	assert.ErrorIs(t, err, ErrRevisionNotFound)
+
+	// Revisions go with the note
+	require.NoError(t, c.Notes.DeleteNote(ctx, n.ID, owner.ID))
+	count, err := c.ORM.NoteRevision.Query().Where(noterevision.HasNoteWith(note.ID(n.ID))).Count(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, count)
+}
+
+func TestNotesServiceRestoreRevisionNeedsApproval(t *testing.T) {
+	ctx := context.Background()
+	n, collaborator := createSharedNote(t, "read_write_approval")
+	owner := n.Edges.Owner
+
+	proposal, err := c.Notes.ProposeChange(ctx, n.ID, collaborator.ID, ProposalInput{
+		Title:   "Chemistry",
+		Content: "Salts",
+	})
+	require.NoError(t, err)
+	_, err = c.Notes.ReviewProposal(ctx, n.ID, proposal.ID, owner.ID, true, time.Now())
+	require.NoError(t, err)
+
+	// Accepted changes are saved as revisions by the proposer
+	revisions, err := c.Notes.ListRevisions(ctx, n.ID, collaborator.ID)
+	require.NoError(t, err)
+	require.Len(t, revisions, 2)
+	assert.Equal(t, "Salts", revisions[0].Content)
+	assert.Equal(t, collaborator.ID, revisions[0].Edges.Author.ID)
+
+	_, err = c.Notes.RestoreRevision(ctx, n.ID, revisions[1].ID, collaborator.ID)
+	assert.ErrorIs(t, err, ErrNoteNeedsApproval)
+}
+
+func TestNotesServicePruneRevisions(t *testing.T) {
+	ctx := context.Background()
+
+	cfg := *c.Config
+	cfg.Notes.Revisions.Keep = 2
+	cfg.Notes.Revisions.MaxAge = time.Hour
+	notes := NewNotesService(c.ORM, c.Files, c.Cache, &cfg, c.Blobs)
+
+	owner, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	n, err := notes.CreateNote(ctx, owner.ID, CreateNoteInput{
+		Title:           "Physics",
+		Content:         "v1",
+		Visibility:      "private",
+		PermissionLevel: "read_only",
+	})
+	require.NoError(t, err)
+	for _, content := range []string{"v2", "v3"} {
+		_, err = notes.UpdateNote(ctx, n.ID, owner.ID, UpdateNoteInput{Content: &content})
+		require.NoError(t, err)
+	}
+
+	// Only the most recent revisions are kept
+	revisions, err := notes.ListRevisions(ctx, n.ID, owner.ID)
+	require.NoError(t, err)
+	require.Len(t, revisions, 2)
+	assert.Equal(t, "v3", revisions[0].Content)
+	assert.Equal(t, "v2", revisions[1].Content)
+
+	// Old revisions are removed, apart from the latest
+	tx, err := c.ORM.Tx(ctx)
+	require.NoError(t, err)
+	require.NoError(t, notes.pruneRevisions(ctx, tx, n.ID, time.Now().Add(2*time.Hour)))
+	require.NoError(t, tx.Commit())
+	revisions, err = notes.ListRevisions(ctx, n.ID, owner.ID)
+	require.NoError(t, err)
+	require.Len(t, revisions, 1)
+	assert.Equal(t, "v3", revisions[0].Content)
+}
@@ -0,0 +1,388 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/r-scheele/zero/ent"
+	"github.com/r-scheele/zero/ent/note"
+	"github.com/r-scheele/zero/ent/notebook"
+	"github.com/r-scheele/zero/ent/tag"
+	"github.com/r-scheele/zero/ent/user"
+)
+
+var (
+	// ErrNotebookNotFound is returned when a notebook does not exist or belongs to someone else
+	ErrNotebookNotFound = errors.New("notebook not found")
+
+	// ErrNotebookCycle is returned when a notebook would be moved inside itself
+	ErrNotebookCycle = errors.New("a notebook can't be moved inside itself")
+
+	// ErrNoNotesSelected is returned when a bulk action is given no notes the user owns
+	ErrNoNotesSelected = errors.New("no notes were selected")
+)
+
+// NotebookInput represents input for creating or updating a notebook
+type NotebookInput struct {
+	Name                   string `json:"name" validate:"required,max=100"`
+	ParentID               int    `json:"parent_id"` // Zero puts the notebook at the top level
+	DefaultVisibility      string `json:"default_visibility" validate:"omitempty,oneof=private public"`
+	DefaultPermissionLevel string `json:"default_permission_level" validate:"omitempty,oneof=read_only read_write read_write_approval"`
+}
+
+// NoteFilter narrows the notes listed by ListUserNotes
+type NoteFilter struct {
+	// NotebookID lists the notes in a notebook, or every note when zero
+	NotebookID int
+
+	// Tag lists the notes with a tag
+	Tag string
+}
+
+// CreateNotebook creates a notebook, inside another of the user's notebooks when the parent is set
+func (s *NotesService) CreateNotebook(ctx context.Context, ownerID int, input NotebookInput) (*ent.Notebook, error) {
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		return nil, fmt.Errorf("notebook name is required")
+	}
+
+	if err := s.checkNotebook(ctx, ownerID, input.ParentID); err != nil {
+		return nil, err
+	}
+
+	create := s.orm.Notebook.Create().
+		SetName(name).
+		SetOwnerID(ownerID)
+	if input.ParentID != 0 {
+		create.SetParentID(input.ParentID)
+	}
+	if input.DefaultVisibility != "" {
+		create.SetDefaultVisibility(notebook.DefaultVisibility(input.DefaultVisibility))
+	}
+	if input.DefaultPermissionLevel != "" {
+		create.SetDefaultPermissionLevel(notebook.DefaultPermissionLevel(input.DefaultPermissionLevel))
+	}
+
+	nb, err := create.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create notebook: %w", err)
+	}
+
+	return nb, nil
+}
+
+// UpdateNotebook renames a notebook, moves it and changes the defaults of notes created in it.
+// Notes already in the notebook keep their settings.
+func (s *NotesService) UpdateNotebook(ctx context.Context, notebookID, ownerID int, input NotebookInput) (*ent.Notebook, error) {
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		return nil, fmt.Errorf("notebook name is required")
+	}
+
+	if err := s.checkNotebook(ctx, ownerID, notebookID); err != nil {
+		return nil, err
+	}
+	if err := s.checkNotebook(ctx, ownerID, input.ParentID); err != nil {
+		return nil, err
+	}
+
+	// The new parent can't be the notebook or one of the notebooks inside it
+	for id := input.ParentID; id != 0; {
+		if id == notebookID {
+			return nil, ErrNotebookCycle
+		}
+		parent, err := s.orm.Notebook.Query().
+			Where(notebook.ID(id)).
+			QueryParent().
+			OnlyID(ctx)
+		switch {
+		case ent.IsNotFound(err):
+			id = 0
+		case err != nil:
+			return nil, fmt.Errorf("failed to fetch notebook: %w", err)
+		default:
+			id = parent
+		}
+	}
+
+	update := s.orm.Notebook.UpdateOneID(notebookID).
+		SetName(name)
+	if input.ParentID != 0 {
+		update.SetParentID(input.ParentID)
+	} else {
+		update.ClearParent()
+	}
+	if input.DefaultVisibility != "" {
+		update.SetDefaultVisibility(notebook.DefaultVisibility(input.DefaultVisibility))
+	}
+	if input.DefaultPermissionLevel != "" {
+		update.SetDefaultPermissionLevel(notebook.DefaultPermissionLevel(input.DefaultPermissionLevel))
+	}
+
+	nb, err := update.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update notebook: %w", err)
+	}
+
+	return nb, nil
+}
+
+// GetNotebook returns one of the user's notebooks
+func (s *NotesService) GetNotebook(ctx context.Context, notebookID, ownerID int) (*ent.Notebook, error) {
+	nb, err := s.orm.Notebook.Query().
+		Where(notebook.ID(notebookID), notebook.HasOwnerWith(user.ID(ownerID))).
+		WithParent().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrNotebookNotFound
+		}
+		return nil, fmt.Errorf("failed to fetch notebook: %w", err)
+	}
+	return nb, nil
+}
+
+// ListNotebooks returns all of the user's notebooks with their parents loaded, so the caller can
+// build the notebook tree
+func (s *NotesService) ListNotebooks(ctx context.Context, ownerID int) ([]*ent.Notebook, error) {
+	notebooks, err := s.orm.Notebook.Query().
+		Where(notebook.HasOwnerWith(user.ID(ownerID))).
+		WithParent().
+		Order(ent.Asc(notebook.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch notebooks: %w", err)
+	}
+
+	return notebooks, nil
+}
+
+// DeleteNotebook deletes a notebook, moving its notes and the notebooks inside it up into its parent
+func (s *NotesService) DeleteNotebook(ctx context.Context, notebookID, ownerID int) error {
+	nb, err := s.GetNotebook(ctx, notebookID, ownerID)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.orm.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	// Notes leave a notebook when it's deleted, so only those not already in the parent are added
+	children := tx.Notebook.Update().Where(notebook.HasParentWith(notebook.ID(notebookID)))
+	if nb.Edges.Parent != nil {
+		children.SetParentID(nb.Edges.Parent.ID)
+		noteIDs, err := tx.Note.Query().
+			Where(
+				note.HasNotebooksWith(notebook.ID(notebookID)),
+				note.Not(note.HasNotebooksWith(notebook.ID(nb.Edges.Parent.ID))),
+			).
+			IDs(ctx)
+		if err != nil {
+			return rollback(tx, fmt.Errorf("failed to fetch notebook notes: %w", err))
+		}
+		if len(noteIDs) > 0 {
+			if err := tx.Notebook.UpdateOneID(nb.Edges.Parent.ID).AddNoteIDs(noteIDs...).Exec(ctx); err != nil {
+				return rollback(tx, fmt.Errorf("failed to move notes: %w", err))
+			}
+		}
+	} else {
+		children.ClearParent()
+	}
+
+	if _, err := children.Save(ctx); err != nil {
+		return rollback(tx, fmt.Errorf("failed to move notebooks: %w", err))
+	}
+	if err := tx.Notebook.DeleteOneID(notebookID).Exec(ctx); err != nil {
+		return rollback(tx, fmt.Errorf("failed to delete notebook: %w", err))
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// MoveNotes files the user's notes in a notebook, taking them out of any other. Zero takes them
+// out of every notebook.
+func (s *NotesService) MoveNotes(ctx context.Context, ownerID int, noteIDs []int, notebookID int) error {
+	if err := s.checkNotebook(ctx, ownerID, notebookID); err != nil {
+		return err
+	}
+
+	noteIDs, err := s.ownedNoteIDs(ctx, ownerID, noteIDs)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.orm.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	for _, id := range noteIDs {
+		update := tx.Note.UpdateOneID(id).ClearNotebooks()
+		if notebookID != 0 {
+			update.AddNotebookIDs(notebookID)
+		}
+		if err := update.Exec(ctx); err != nil {
+			return rollback(tx, fmt.Errorf("failed to move note: %w", err))
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// ListTags returns the user's tags, sorted by name
+func (s *NotesService) ListTags(ctx context.Context, ownerID int) ([]*ent.Tag, error) {
+	tags, err := s.orm.Tag.Query().
+		Where(tag.HasOwnerWith(user.ID(ownerID))).
+		Order(ent.Asc(tag.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch tags: %w", err)
+	}
+
+	return tags, nil
+}
+
+// TagNotes adds tags to the user's notes, creating the tags the user doesn't have yet
+func (s *NotesService) TagNotes(ctx context.Context, ownerID int, noteIDs []int, names []string) error {
+	names = normalizeTags(names)
+	if len(names) == 0 {
+		return fmt.Errorf("at least one tag is required")
+	}
+
+	noteIDs, err := s.ownedNoteIDs(ctx, ownerID, noteIDs)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.orm.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	for _, name := range names {
+		t, err := tx.Tag.Query().
+			Where(tag.Name(name), tag.HasOwnerWith(user.ID(ownerID))).
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			t, err = tx.Tag.Create().
+				SetName(name).
+				SetOwnerID(ownerID).
+				Save(ctx)
+		}
+		if err != nil {
+			return rollback(tx, fmt.Errorf("failed to save tag: %w", err))
+		}
+
+		// Notes that already have the tag are skipped, as the join table rejects duplicates
+		untagged, err := tx.Note.Query().
+			Where(note.IDIn(noteIDs...), note.Not(note.HasTagsWith(tag.ID(t.ID)))).
+			IDs(ctx)
+		if err != nil {
+			return rollback(tx, fmt.Errorf("failed to fetch notes: %w", err))
+		}
+		if len(untagged) == 0 {
+			continue
+		}
+		if err := tx.Tag.UpdateOneID(t.ID).AddNoteIDs(untagged...).Exec(ctx); err != nil {
+			return rollback(tx, fmt.Errorf("failed to tag notes: %w", err))
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// UntagNotes removes tags from the user's notes. Tags left on no notes are deleted.
+func (s *NotesService) UntagNotes(ctx context.Context, ownerID int, noteIDs []int, names []string) error {
+	names = normalizeTags(names)
+	if len(names) == 0 {
+		return fmt.Errorf("at least one tag is required")
+	}
+
+	noteIDs, err := s.ownedNoteIDs(ctx, ownerID, noteIDs)
+	if err != nil {
+		return err
+	}
+
+	tags, err := s.orm.Tag.Query().
+		Where(tag.NameIn(names...), tag.HasOwnerWith(user.ID(ownerID))).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch tags: %w", err)
+	}
+
+	tx, err := s.orm.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	for _, t := range tags {
+		if err := tx.Tag.UpdateOneID(t.ID).RemoveNoteIDs(noteIDs...).Exec(ctx); err != nil {
+			return rollback(tx, fmt.Errorf("failed to untag notes: %w", err))
+		}
+	}
+
+	if _, err := tx.Tag.Delete().
+		Where(tag.HasOwnerWith(user.ID(ownerID)), tag.Not(tag.HasNotes())).
+		Exec(ctx); err != nil {
+		return rollback(tx, fmt.Errorf("failed to delete unused tags: %w", err))
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// ownedNoteIDs narrows a list of notes to those the user owns
+func (s *NotesService) ownedNoteIDs(ctx context.Context, ownerID int, noteIDs []int) ([]int, error) {
+	if len(noteIDs) == 0 {
+		return nil, ErrNoNotesSelected
+	}
+
+	ids, err := s.orm.Note.Query().
+		Where(note.IDIn(noteIDs...), note.HasOwnerWith(user.ID(ownerID))).
+		IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch notes: %w", err)
+	}
+	if len(ids) == 0 {
+		return nil, ErrNoNotesSelected
+	}
+	return ids, nil
+}
+
+// checkNotebook checks that a notebook belongs to the user. Zero means no notebook and is always
+// allowed.
+func (s *NotesService) checkNotebook(ctx context.Context, ownerID, notebookID int) error {
+	if notebookID == 0 {
+		return nil
+	}
+
+	exists, err := s.orm.Notebook.Query().
+		Where(notebook.ID(notebookID), notebook.HasOwnerWith(user.ID(ownerID))).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch notebook: %w", err)
+	}
+	if !exists {
+		return ErrNotebookNotFound
+	}
+	return nil
+}
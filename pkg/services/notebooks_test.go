@@ -0,0 +1,221 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/r-scheele/zero/ent"
+	"github.com/r-scheele/zero/ent/note"
+	"github.com/r-scheele/zero/pkg/tests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func noteIDs(notes []*ent.Note) []int {
+	ids := make([]int, len(notes))
+	for i, n := range notes {
+		ids[i] = n.ID
+	}
+	return ids
+}
+
+func TestNotesServiceCreateNoteInNotebook(t *testing.T) {
+	ctx := context.Background()
+
+	owner, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	other, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+
+	nb, err := c.Notes.CreateNotebook(ctx, owner.ID, NotebookInput{
+		Name:                   " Chemistry ",
+		DefaultVisibility:      "public",
+		DefaultPermissionLevel: "read_write_approval",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Chemistry", nb.Name)
+
+	// Blank settings are taken from the notebook
+	n, err := c.Notes.CreateNote(ctx, owner.ID, CreateNoteInput{Title: "Acids", NotebookID: nb.ID})
+	require.NoError(t, err)
+	assert.Equal(t, note.VisibilityPublic, n.Visibility)
+	assert.Equal(t, note.PermissionLevelReadWriteApproval, n.PermissionLevel)
+
+	// Settings that are given win over the notebook's
+	n, err = c.Notes.CreateNote(ctx, owner.ID, CreateNoteInput{Title: "Bases", Visibility: "private", NotebookID: nb.ID})
+	require.NoError(t, err)
+	assert.Equal(t, note.VisibilityPrivate, n.Visibility)
+	assert.Equal(t, note.PermissionLevelReadWriteApproval, n.PermissionLevel)
+
+	// Notes without a notebook keep the usual defaults
+	n, err = c.Notes.CreateNote(ctx, owner.ID, CreateNoteInput{Title: "Loose"})
+	require.NoError(t, err)
+	assert.Equal(t, note.VisibilityPrivate, n.Visibility)
+	assert.Equal(t, note.PermissionLevelReadOnly, n.PermissionLevel)
+
+	notes, err := c.Notes.ListUserNotes(ctx, owner.ID, NoteFilter{NotebookID: nb.ID}, 10, 0)
+	require.NoError(t, err)
+	assert.Len(t, notes, 2)
+
+	// Another user's notebook can't be used
+	_, err = c.Notes.CreateNote(ctx, other.ID, CreateNoteInput{Title: "Intruder", NotebookID: nb.ID})
+	assert.ErrorIs(t, err, ErrNotebookNotFound)
+	_, err = c.Notes.CreateNotebook(ctx, other.ID, NotebookInput{Name: "Inside", ParentID: nb.ID})
+	assert.ErrorIs(t, err, ErrNotebookNotFound)
+}
+
+func TestNotesServiceUpdateNotebook(t *testing.T) {
+	ctx := context.Background()
+
+	owner, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+
+	parent, err := c.Notes.CreateNotebook(ctx, owner.ID, NotebookInput{Name: "Science"})
+	require.NoError(t, err)
+	child, err := c.Notes.CreateNotebook(ctx, owner.ID, NotebookInput{Name: "Physics", ParentID: parent.ID})
+	require.NoError(t, err)
+
+	updated, err := c.Notes.UpdateNotebook(ctx, child.ID, owner.ID, NotebookInput{Name: "Mechanics", ParentID: parent.ID, DefaultVisibility: "public"})
+	require.NoError(t, err)
+	assert.Equal(t, "Mechanics", updated.Name)
+	assert.Equal(t, "public", updated.DefaultVisibility.String())
+
+	// A notebook can't be moved inside itself or a notebook inside it
+	_, err = c.Notes.UpdateNotebook(ctx, parent.ID, owner.ID, NotebookInput{Name: "Science", ParentID: parent.ID})
+	assert.ErrorIs(t, err, ErrNotebookCycle)
+	_, err = c.Notes.UpdateNotebook(ctx, parent.ID, owner.ID, NotebookInput{Name: "Science", ParentID: child.ID})
+	assert.ErrorIs(t, err, ErrNotebookCycle)
+
+	// Moving it to the top level clears its parent
+	_, err = c.Notes.UpdateNotebook(ctx, child.ID, owner.ID, NotebookInput{Name: "Mechanics"})
+	require.NoError(t, err)
+	nb, err := c.Notes.GetNotebook(ctx, child.ID, owner.ID)
+	require.NoError(t, err)
+	assert.Nil(t, nb.Edges.Parent)
+}
+
+func TestNotesServiceDeleteNotebook(t *testing.T) {
+	ctx := context.Background()
+
+	owner, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+
+	top, err := c.Notes.CreateNotebook(ctx, owner.ID, NotebookInput{Name: "Year 1"})
+	require.NoError(t, err)
+	middle, err := c.Notes.CreateNotebook(ctx, owner.ID, NotebookInput{Name: "Term 1", ParentID: top.ID})
+	require.NoError(t, err)
+	bottom, err := c.Notes.CreateNotebook(ctx, owner.ID, NotebookInput{Name: "Week 1", ParentID: middle.ID})
+	require.NoError(t, err)
+
+	n, err := c.Notes.CreateNote(ctx, owner.ID, CreateNoteInput{Title: "Intro", NotebookID: middle.ID})
+	require.NoError(t, err)
+
+	// The notes and notebooks inside it move up into its parent
+	require.NoError(t, c.Notes.DeleteNotebook(ctx, middle.ID, owner.ID))
+
+	_, err = c.Notes.GetNotebook(ctx, middle.ID, owner.ID)
+	assert.ErrorIs(t, err, ErrNotebookNotFound)
+
+	nb, err := c.Notes.GetNotebook(ctx, bottom.ID, owner.ID)
+	require.NoError(t, err)
+	require.NotNil(t, nb.Edges.Parent)
+	assert.Equal(t, top.ID, nb.Edges.Parent.ID)
+
+	notes, err := c.Notes.ListUserNotes(ctx, owner.ID, NoteFilter{NotebookID: top.ID}, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []int{n.ID}, noteIDs(notes))
+
+	// Notes in a top level notebook are kept without one
+	require.NoError(t, c.Notes.DeleteNotebook(ctx, top.ID, owner.ID))
+	notes, err = c.Notes.ListUserNotes(ctx, owner.ID, NoteFilter{}, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, notes, 1)
+	assert.Empty(t, notes[0].Edges.Notebooks)
+}
+
+func TestNotesServiceMoveNotes(t *testing.T) {
+	ctx := context.Background()
+
+	owner, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	other, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+
+	first, err := c.Notes.CreateNotebook(ctx, owner.ID, NotebookInput{Name: "First"})
+	require.NoError(t, err)
+	second, err := c.Notes.CreateNotebook(ctx, owner.ID, NotebookInput{Name: "Second"})
+	require.NoError(t, err)
+
+	a, err := c.Notes.CreateNote(ctx, owner.ID, CreateNoteInput{Title: "A", NotebookID: first.ID})
+	require.NoError(t, err)
+	b, err := c.Notes.CreateNote(ctx, owner.ID, CreateNoteInput{Title: "B"})
+	require.NoError(t, err)
+	theirs, err := c.Notes.CreateNote(ctx, other.ID, CreateNoteInput{Title: "Theirs"})
+	require.NoError(t, err)
+
+	// Notes leave the notebook they were in, and other users' notes are ignored
+	require.NoError(t, c.Notes.MoveNotes(ctx, owner.ID, []int{a.ID, b.ID, theirs.ID}, second.ID))
+
+	notes, err := c.Notes.ListUserNotes(ctx, owner.ID, NoteFilter{NotebookID: second.ID}, 10, 0)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []int{a.ID, b.ID}, noteIDs(notes))
+	notes, err = c.Notes.ListUserNotes(ctx, owner.ID, NoteFilter{NotebookID: first.ID}, 10, 0)
+	require.NoError(t, err)
+	assert.Empty(t, notes)
+	notes, err = c.Notes.ListUserNotes(ctx, other.ID, NoteFilter{}, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, notes, 1)
+	assert.Empty(t, notes[0].Edges.Notebooks)
+
+	// Zero takes notes out of every notebook
+	require.NoError(t, c.Notes.MoveNotes(ctx, owner.ID, []int{a.ID}, 0))
+	notes, err = c.Notes.ListUserNotes(ctx, owner.ID, NoteFilter{NotebookID: second.ID}, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []int{b.ID}, noteIDs(notes))
+
+	err = c.Notes.MoveNotes(ctx, other.ID, []int{theirs.ID}, first.ID)
+	assert.ErrorIs(t, err, ErrNotebookNotFound)
+	err = c.Notes.MoveNotes(ctx, owner.ID, []int{theirs.ID}, first.ID)
+	assert.ErrorIs(t, err, ErrNoNotesSelected)
+}
+
+func TestNotesServiceTagNotes(t *testing.T) {
+	ctx := context.Background()
+
+	owner, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+
+	a, err := c.Notes.CreateNote(ctx, owner.ID, CreateNoteInput{Title: "A"})
+	require.NoError(t, err)
+	b, err := c.Notes.CreateNote(ctx, owner.ID, CreateNoteInput{Title: "B"})
+	require.NoError(t, err)
+
+	require.NoError(t, c.Notes.TagNotes(ctx, owner.ID, []int{a.ID}, []string{"Exam", " revision "}))
+
+	// Tagging again reuses the tags and skips notes that already have them
+	require.NoError(t, c.Notes.TagNotes(ctx, owner.ID, []int{a.ID, b.ID}, []string{"exam"}))
+
+	tags, err := c.Notes.ListTags(ctx, owner.ID)
+	require.NoError(t, err)
+	require.Len(t, tags, 2)
+	assert.Equal(t, "exam", tags[0].Name)
+	assert.Equal(t, "revision", tags[1].Name)
+
+	notes, err := c.Notes.ListUserNotes(ctx, owner.ID, NoteFilter{Tag: "Exam"}, 10, 0)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []int{a.ID, b.ID}, noteIDs(notes))
+
+	notes, err = c.Notes.ListUserNotes(ctx, owner.ID, NoteFilter{Tag: "revision"}, 10, 0)
+	require.NoError(t, err)
+	require.Equal(t, []int{a.ID}, noteIDs(notes))
+	require.Len(t, notes[0].Edges.Tags, 2)
+
+	// Tags left on no notes are deleted
+	require.NoError(t, c.Notes.UntagNotes(ctx, owner.ID, []int{a.ID, b.ID}, []string{"revision", "exam"}))
+	tags, err = c.Notes.ListTags(ctx, owner.ID)
+	require.NoError(t, err)
+	assert.Empty(t, tags)
+
+	err = c.Notes.TagNotes(ctx, owner.ID, []int{a.ID}, []string{" "})
+	assert.Error(t, err)
+}
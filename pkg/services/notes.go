@@ -4,8 +4,8 @@ import (
 	"context"
 	"crypto/rand"
 	"encoding/hex"
+	"errors"
 	"fmt"
-	"io"
 	"mime/multipart"
 	"net/url"
 	"path/filepath"
@@ -15,29 +15,46 @@ import (
 
 	"github.com/r-scheele/zero/config"
 	"github.com/r-scheele/zero/ent"
+	"github.com/r-scheele/zero/ent/blobreference"
+	"github.com/r-scheele/zero/ent/groupmembership"
 	"github.com/r-scheele/zero/ent/note"
+	"github.com/r-scheele/zero/ent/notebook"
 	"github.com/r-scheele/zero/ent/notelike"
+	"github.com/r-scheele/zero/ent/notelink"
+	"github.com/r-scheele/zero/ent/noteproposal"
 	"github.com/r-scheele/zero/ent/noterepost"
+	"github.com/r-scheele/zero/ent/noterevision"
+	"github.com/r-scheele/zero/ent/predicate"
+	"github.com/r-scheele/zero/ent/studygroup"
+	"github.com/r-scheele/zero/ent/tag"
 	"github.com/r-scheele/zero/ent/user"
 	"github.com/r-scheele/zero/pkg/types"
 	"github.com/spf13/afero"
 )
 
+// ErrResourceNotFound is returned when a note resource has been removed from its note
+var ErrResourceNotFound = errors.New("resource not found")
+
+// ResourceLinkTTL is how long the signed links to uploaded note files last
+const ResourceLinkTTL = time.Hour
+
 // NotesService handles note-related operations
 type NotesService struct {
 	orm   *ent.Client
 	files afero.Fs
 	cache *CacheClient
 	config *config.Config
+	blobs  *BlobService
 }
 
 // NewNotesService creates a new notes service
-func NewNotesService(orm *ent.Client, files afero.Fs, cache *CacheClient, config *config.Config) *NotesService {
+func NewNotesService(orm *ent.Client, files afero.Fs, cache *CacheClient, config *config.Config, blobs *BlobService) *NotesService {
 	return &NotesService{
 		orm:    orm,
 		files:  files,
 		cache:  cache,
 		config: config,
+		blobs:  blobs,
 	}
 }
 
@@ -46,6 +63,7 @@ type Resource struct {
 	Type          string    `json:"type"`           // "file", "youtube", "url", "image", "pdf", "doc", "video"
 	Name          string    `json:"name"`           // Display name
 	URL           string    `json:"url"`            // File path or external URL
+	Key           string    `json:"key"`            // Storage key of an uploaded file already on the note
 	Size          int64     `json:"size"`           // File size in bytes (0 for external links)
 	MimeType      string    `json:"mime_type"`      // MIME type for files
 	Thumbnail     string    `json:"thumbnail"`      // Thumbnail path for videos/images
@@ -59,9 +77,10 @@ type CreateNoteInput struct {
 	Title           string     `json:"title" validate:"required,min=1,max=200"`
 	Description     string     `json:"description" validate:"max=500"`
 	Content         string     `json:"content"`
-	Visibility      string     `json:"visibility" validate:"oneof=private public"`
-	PermissionLevel string     `json:"permission_level" validate:"oneof=read_only read_write read_write_approval"`
+	Visibility      string     `json:"visibility" validate:"omitempty,oneof=private public"`
+	PermissionLevel string     `json:"permission_level" validate:"omitempty,oneof=read_only read_write read_write_approval"`
 	Resources       []Resource `json:"resources"`
+	NotebookID      int        `json:"notebook_id"` // Blank settings are taken from the notebook
 }
 
 // UpdateNoteInput represents input for updating a note
@@ -74,22 +93,48 @@ type UpdateNoteInput struct {
 	Resources       *[]Resource `json:"resources,omitempty"`
 }
 
-// CreateNote creates a new note
+// CreateNote creates a new note. A note created in a notebook takes the notebook's visibility and
+// permission level unless they're given.
 func (s *NotesService) CreateNote(ctx context.Context, userID int, input CreateNoteInput) (*ent.Note, error) {
+	visibility, permissionLevel := note.DefaultVisibility, note.DefaultPermissionLevel
+	if input.NotebookID != 0 {
+		nb, err := s.GetNotebook(ctx, input.NotebookID, userID)
+		if err != nil {
+			return nil, err
+		}
+		visibility = note.Visibility(nb.DefaultVisibility)
+		permissionLevel = note.PermissionLevel(nb.DefaultPermissionLevel)
+	}
+	if input.Visibility != "" {
+		visibility = note.Visibility(input.Visibility)
+	}
+	if input.PermissionLevel != "" {
+		permissionLevel = note.PermissionLevel(input.PermissionLevel)
+	}
+
 	// Generate share token
-	shareToken, err := s.generateShareToken()
+	shareToken, err := generateShareToken()
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate share token: %w", err)
 	}
 
+	tx, err := s.orm.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
 	// Create note
-	noteBuilder := s.orm.Note.Create().
+	noteBuilder := tx.Note.Create().
 		SetTitle(input.Title).
-		SetVisibility(note.Visibility(input.Visibility)).
-		SetPermissionLevel(note.PermissionLevel(input.PermissionLevel)).
+		SetVisibility(visibility).
+		SetPermissionLevel(permissionLevel).
 		SetShareToken(shareToken).
 		SetOwnerID(userID)
 
+	if input.NotebookID != 0 {
+		noteBuilder = noteBuilder.AddNotebookIDs(input.NotebookID)
+	}
+
 	if input.Description != "" {
 		noteBuilder = noteBuilder.SetDescription(input.Description)
 	}
@@ -119,30 +164,57 @@ func (s *NotesService) CreateNote(ctx context.Context, userID int, input CreateN
 
 	createdNote, err := noteBuilder.Save(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create note: %w", err)
+		return nil, rollback(tx, fmt.Errorf("failed to create note: %w", err))
 	}
 
-	// TODO: Add AI processing later
-	// For now, we'll set ai_processing to false since we don't have AI integration yet
+	if err := s.recordRevision(ctx, tx, createdNote, userID); err != nil {
+		return nil, rollback(tx, err)
+	}
+	if err := s.syncLinks(ctx, tx.Client(), createdNote); err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit note: %w", err)
+	}
 
 	return createdNote, nil
 }
 
-// UpdateNote updates an existing note
+// UpdateNote updates an existing note. Other users can edit the text of public notes whose
+// permission level allows it, but only the owner can change the rest. ErrNoteNeedsApproval is
+// returned if the user's changes must be proposed with ProposeChange instead.
 func (s *NotesService) UpdateNote(ctx context.Context, noteID, userID int, input UpdateNoteInput) (*ent.Note, error) {
-	// Check if user owns the note
-	exists, err := s.orm.Note.Query().
-		Where(note.ID(noteID), note.HasOwnerWith(user.ID(userID))).
-		Exist(ctx)
-	if err != nil {
+	// Check if user can edit the note
+	current, err := s.orm.Note.Query().
+		Where(note.ID(noteID)).
+		WithOwner().
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
 		return nil, fmt.Errorf("failed to check note ownership: %w", err)
 	}
-	if !exists {
+	mode := NoteEditNone
+	if current != nil {
+		mode = EditModeFor(current, userID)
+	}
+	switch mode {
+	case NoteEditNone:
 		return nil, fmt.Errorf("note not found or access denied")
+	case NoteEditPropose:
+		return nil, ErrNoteNeedsApproval
+	case NoteEditDirect:
+		input.Visibility = nil
+		input.PermissionLevel = nil
+		input.Resources = nil
+	}
+
+	tx, err := s.orm.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
 	}
 
 	// Build update query
-	updateBuilder := s.orm.Note.UpdateOneID(noteID)
+	updateBuilder := tx.Note.UpdateOneID(noteID)
 
 	if input.Title != nil {
 		updateBuilder = updateBuilder.SetTitle(*input.Title)
@@ -164,8 +236,18 @@ func (s *NotesService) UpdateNote(ctx context.Context, noteID, userID int, input
 		updateBuilder = updateBuilder.SetPermissionLevel(note.PermissionLevel(*input.PermissionLevel))
 	}
 
-	// Handle resources update
+	// Handle resources update. Uploaded files are matched to those already on the note by their
+	// storage keys, so clients can't add files by naming other keys, and the files of any that were
+	// removed are released.
+	var removed []types.Resource
 	if input.Resources != nil {
+		stored := make(map[string][]types.Resource)
+		for _, r := range current.Resources {
+			if r.Key != "" {
+				stored[r.Key] = append(stored[r.Key], r)
+			}
+		}
+
 		resourceData := make([]types.Resource, len(*input.Resources))
 		for i, r := range *input.Resources {
 			resourceData[i] = types.Resource{
@@ -179,38 +261,52 @@ func (s *NotesService) UpdateNote(ctx context.Context, noteID, userID int, input
 				ExtractedText: r.ExtractedText,
 				UploadedAt:    r.UploadedAt,
 			}
+			if matches := stored[r.Key]; r.Key != "" && len(matches) > 0 {
+				resourceData[i].Key = matches[0].Key
+				resourceData[i].URL = ""
+				resourceData[i].Size = matches[0].Size
+				resourceData[i].Thumbnails = matches[0].Thumbnails
+				resourceData[i].ScanStatus = matches[0].ScanStatus
+				stored[r.Key] = matches[1:]
+			}
+		}
+		for _, matches := range stored {
+			removed = append(removed, matches...)
 		}
 		updateBuilder = updateBuilder.SetResources(resourceData)
 	}
 
 	updatedNote, err := updateBuilder.Save(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to update note: %w", err)
+		return nil, rollback(tx, fmt.Errorf("failed to update note: %w", err))
+	}
+
+	if err := s.recordRevision(ctx, tx, updatedNote, userID); err != nil {
+		return nil, rollback(tx, err)
+	}
+	if err := s.syncLinks(ctx, tx.Client(), updatedNote); err != nil {
+		return nil, rollback(tx, err)
 	}
 
-	// TODO: Add AI reprocessing later
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit note: %w", err)
+	}
+
+	for _, r := range removed {
+		if err := s.blobs.Release(ctx, blobreference.KindNote, noteID, r.Key); err != nil {
+			return nil, fmt.Errorf("failed to release removed file: %w", err)
+		}
+	}
 
 	return updatedNote, nil
 }
 
 // GetNote retrieves a note by ID with permission checking
 func (s *NotesService) GetNote(ctx context.Context, noteID int, userID *int) (*ent.Note, error) {
-	noteQuery := s.orm.Note.Query().Where(note.ID(noteID))
-
-	// If user is not provided, only allow public notes
-	if userID == nil {
-		noteQuery = noteQuery.Where(note.VisibilityEQ(note.VisibilityPublic))
-	} else {
-		// Allow if user owns the note or if it's public
-		noteQuery = noteQuery.Where(
-			note.Or(
-				note.HasOwnerWith(user.ID(*userID)),
-				note.VisibilityEQ(note.VisibilityPublic),
-			),
-		)
-	}
-
-	fetchedNote, err := noteQuery.WithOwner().Only(ctx)
+	fetchedNote, err := s.orm.Note.Query().
+		Where(note.ID(noteID), noteVisibleTo(userID)).
+		WithOwner().
+		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return nil, fmt.Errorf("note not found or access denied")
@@ -221,6 +317,19 @@ func (s *NotesService) GetNote(ctx context.Context, noteID int, userID *int) (*e
 	return fetchedNote, nil
 }
 
+// noteVisibleTo matches the notes a user can see: their own, public notes, and notes shared into
+// one of their study groups. Without a user, only public notes match.
+func noteVisibleTo(userID *int) predicate.Note {
+	if userID == nil {
+		return note.VisibilityEQ(note.VisibilityPublic)
+	}
+	return note.Or(
+		note.HasOwnerWith(user.ID(*userID)),
+		note.VisibilityEQ(note.VisibilityPublic),
+		note.HasGroupsWith(studygroup.HasMembershipsWith(groupmembership.HasUserWith(user.ID(*userID)))),
+	)
+}
+
 // GetNoteByShareToken retrieves a note by its share token
 func (s *NotesService) GetNoteByShareToken(ctx context.Context, shareToken string) (*ent.Note, error) {
 	fetchedNote, err := s.orm.Note.Query().
@@ -237,10 +346,23 @@ func (s *NotesService) GetNoteByShareToken(ctx context.Context, shareToken strin
 	return fetchedNote, nil
 }
 
-// ListUserNotes lists notes owned by a user
-func (s *NotesService) ListUserNotes(ctx context.Context, userID int, limit, offset int) ([]*ent.Note, error) {
-	notes, err := s.orm.Note.Query().
-		Where(note.HasOwnerWith(user.ID(userID))).
+// ListUserNotes lists notes owned by a user, with their notebooks and tags
+func (s *NotesService) ListUserNotes(ctx context.Context, userID int, filter NoteFilter, limit, offset int) ([]*ent.Note, error) {
+	query := s.orm.Note.Query().
+		Where(note.HasOwnerWith(user.ID(userID)))
+
+	if filter.NotebookID != 0 {
+		query.Where(note.HasNotebooksWith(notebook.ID(filter.NotebookID)))
+	}
+	if filter.Tag != "" {
+		query.Where(note.HasTagsWith(tag.Name(strings.ToLower(filter.Tag))))
+	}
+
+	notes, err := query.
+		WithNotebooks().
+		WithTags(func(q *ent.TagQuery) {
+			q.Order(ent.Asc(tag.FieldName))
+		}).
 		Order(ent.Desc(note.FieldUpdatedAt)).
 		Limit(limit).
 		Offset(offset).
@@ -271,14 +393,25 @@ func (s *NotesService) ListPublicNotes(ctx context.Context, limit, offset int) (
 // DeleteNote deletes a note (only by owner)
 func (s *NotesService) DeleteNote(ctx context.Context, noteID, userID int) error {
 	// Check if user owns the note
-	exists, err := s.orm.Note.Query().
+	n, err := s.orm.Note.Query().
 		Where(note.ID(noteID), note.HasOwnerWith(user.ID(userID))).
-		Exist(ctx)
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return fmt.Errorf("note not found or access denied")
+	}
 	if err != nil {
 		return fmt.Errorf("failed to check note ownership: %w", err)
 	}
-	if !exists {
-		return fmt.Errorf("note not found or access denied")
+
+	// Proposed changes, history and links go with the note
+	if _, err := s.orm.NoteProposal.Delete().Where(noteproposal.HasNoteWith(note.ID(noteID))).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to delete proposed changes: %w", err)
+	}
+	if _, err := s.orm.NoteRevision.Delete().Where(noterevision.HasNoteWith(note.ID(noteID))).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to delete revisions: %w", err)
+	}
+	if _, err := s.orm.NoteLink.Delete().Where(notelink.HasSourceWith(note.ID(noteID))).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to delete note links: %w", err)
 	}
 
 	// Delete the note
@@ -287,10 +420,20 @@ func (s *NotesService) DeleteNote(ctx context.Context, noteID, userID int) error
 		return fmt.Errorf("failed to delete note: %w", err)
 	}
 
+	// Links to the note are broken, unless another note has its title
+	if err := relinkNote(ctx, s.orm, noteID, n.Title); err != nil {
+		return err
+	}
+
+	// Files that no other note, document or profile uses are deleted along with it
+	if err := s.blobs.ReleaseAll(ctx, blobreference.KindNote, noteID); err != nil {
+		return fmt.Errorf("failed to release note files: %w", err)
+	}
+
 	return nil
 }
 
-// UploadResource handles file upload for a note
+// UploadResource stores an uploaded file and adds it to a note
 func (s *NotesService) UploadResource(ctx context.Context, noteID, userID int, file *multipart.FileHeader) (*Resource, error) {
 	// Check if user owns the note
 	exists, err := s.orm.Note.Query().
@@ -303,22 +446,12 @@ func (s *NotesService) UploadResource(ctx context.Context, noteID, userID int, f
 		return nil, fmt.Errorf("note not found or access denied")
 	}
 
-	// Validate file size (40MB max per file)
-	if file.Size > 40*1024*1024 {
-		return nil, fmt.Errorf("file size exceeds 40MB limit")
-	}
-
-	// Create uploads directory
-	uploadsDir := fmt.Sprintf("uploads/notes/%d", noteID)
-	if err := s.files.MkdirAll(uploadsDir, 0755); err != nil {
-		return nil, fmt.Errorf("failed to create upload directory: %w", err)
+	// Validate file size
+	maxFileSize, _ := s.uploadLimits()
+	if file.Size > maxFileSize {
+		return nil, fmt.Errorf("file size exceeds %s limit", FormatFileSize(maxFileSize))
 	}
 
-	// Generate unique filename
-	ext := filepath.Ext(file.Filename)
-	filename := fmt.Sprintf("%d_%s%s", time.Now().Unix(), generateRandomString(8), ext)
-	filePath := filepath.Join(uploadsDir, filename)
-
 	// Open uploaded file
 	src, err := file.Open()
 	if err != nil {
@@ -326,36 +459,42 @@ func (s *NotesService) UploadResource(ctx context.Context, noteID, userID int, f
 	}
 	defer src.Close()
 
-	// Create destination file
-	dst, err := s.files.Create(filePath)
+	// The file's type is worked out from its content rather than trusted from the client
+	mimeType, err := VerifyContentType(s.config, file.Filename, file.Header.Get("Content-Type"), src)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create destination file: %w", err)
+		return nil, err
 	}
-	defer dst.Close()
 
-	// Copy file
-	if _, err = io.Copy(dst, src); err != nil {
-		return nil, fmt.Errorf("failed to save file: %w", err)
+	// Files are stored by their content, so a file uploaded to many notes is only stored once
+	ref := BlobRef{OwnerID: userID, Kind: blobreference.KindNote, TargetID: noteID}
+	stored, err := s.blobs.Store(ctx, ref, file.Filename, src, mimeType)
+	if err != nil {
+		return nil, err
 	}
 
-	// Determine resource type and extract text if applicable
-	resourceType := s.determineResourceType(file.Header.Get("Content-Type"), ext)
-	extractedText := ""
+	// Determine resource type
+	resourceType := s.determineResourceType(mimeType, filepath.Ext(file.Filename))
 
-	if resourceType == "pdf" || resourceType == "doc" {
-		// TODO: Implement text extraction from PDFs and docs
-		// This would require additional libraries like pdfcpu or unioffice
+	// Create resource object. Only the file's key is stored, since links to stored files are
+	// signed when the note is shown; see SignResources.
+	resource := &Resource{
+		Type:       resourceType,
+		Name:       file.Filename,
+		Size:       stored.Size,
+		MimeType:   mimeType,
+		UploadedAt: time.Now(),
 	}
 
-	// Create resource object
-	resource := &Resource{
-		Type:          resourceType,
-		Name:          file.Filename,
-		URL:           filePath,
-		Size:          file.Size,
-		MimeType:      file.Header.Get("Content-Type"),
-		ExtractedText: extractedText,
-		UploadedAt:    time.Now(),
+	err = s.AddResourceToNote(ctx, noteID, userID, types.Resource{
+		Type:       resource.Type,
+		Name:       resource.Name,
+		Key:        stored.StorageKey,
+		Size:       resource.Size,
+		MimeType:   resource.MimeType,
+		UploadedAt: resource.UploadedAt,
+	})
+	if err != nil {
+		return nil, errors.Join(err, s.blobs.Release(ctx, ref.Kind, noteID, stored.StorageKey))
 	}
 
 	return resource, nil
@@ -380,10 +519,12 @@ func (s *NotesService) AddURLResource(ctx context.Context, noteID, userID int, r
 		return nil, fmt.Errorf("invalid URL")
 	}
 
-	// Determine resource type
+	// Determine resource type; YouTube videos get the thumbnail YouTube publishes for them
 	resourceType := "url"
+	thumbnail := ""
 	if s.isYouTubeURL(resourceURL) {
 		resourceType = "youtube"
+		thumbnail = YouTubeThumbnail(resourceURL)
 	}
 
 	// Create resource object
@@ -392,9 +533,32 @@ func (s *NotesService) AddURLResource(ctx context.Context, noteID, userID int, r
 		Name:       name,
 		URL:        resourceURL,
 		Size:       0,
+		Thumbnail:  thumbnail,
 		UploadedAt: time.Now(),
 	}
 
+	// Links are resubmitted each time a note is edited, so only add ones the note doesn't have
+	n, err := s.orm.Note.Get(ctx, noteID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch note: %w", err)
+	}
+	for _, r := range n.Resources {
+		if r.URL == resourceURL {
+			return resource, nil
+		}
+	}
+
+	err = s.AddResourceToNote(ctx, noteID, userID, types.Resource{
+		Type:       resource.Type,
+		Name:       resource.Name,
+		URL:        resource.URL,
+		Thumbnail:  resource.Thumbnail,
+		UploadedAt: resource.UploadedAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return resource, nil
 }
 
@@ -422,9 +586,10 @@ func (s *NotesService) AddResourceToNote(ctx context.Context, noteID, userID int
 	// Calculate current total size
 	currentTotalSize := s.calculateTotalResourceSize(currentNote.Resources)
 	
-	// Check if adding this resource would exceed 400MB limit
-	if currentTotalSize+resource.Size > 400*1024*1024 {
-		return fmt.Errorf("adding this resource would exceed the 400MB total limit for this note")
+	// Check if adding this resource would exceed the total limit for a note
+	_, maxTotalSize := s.uploadLimits()
+	if currentTotalSize+resource.Size > maxTotalSize {
+		return fmt.Errorf("adding this resource would exceed the %s total limit for this note", FormatFileSize(maxTotalSize))
 	}
 
 	// Append new resource to existing resources
@@ -442,6 +607,144 @@ func (s *NotesService) AddResourceToNote(ctx context.Context, noteID, userID int
 	return nil
 }
 
+// SetResourceText stores the text extracted from the note resource with the given storage key.
+// Nothing is changed if the resource has since been removed from the note.
+func (s *NotesService) SetResourceText(ctx context.Context, noteID int, key, text string) error {
+	_, err := s.updateResource(ctx, noteID, withKey(key), func(r *types.Resource) {
+		r.ExtractedText = text
+	})
+	return err
+}
+
+// ResourceImage is an image resource after its metadata was stripped
+type ResourceImage struct {
+	Key        string            // Storage key of the stripped image
+	Size       int64             // Size of the stripped image in bytes
+	Thumbnails map[string]string // Storage keys of the thumbnails, by size
+}
+
+// SetResourceImage points the image resource with the given storage key at the image with its
+// metadata stripped, and stores its thumbnails
+func (s *NotesService) SetResourceImage(ctx context.Context, noteID int, key string, image ResourceImage) error {
+	_, err := s.updateResource(ctx, noteID, withKey(key), func(r *types.Resource) {
+		r.Key = image.Key
+		r.Size = image.Size
+		r.Thumbnails = image.Thumbnails
+	})
+	return err
+}
+
+// ScannedResource is a quarantined file after its virus scan
+type ScannedResource struct {
+	Status   string // One of the types.Scan states
+	Detail   string // Why the file was rejected, such as the threat found
+	Key      string // Storage key of the file, if it passed
+	Size     int64  // Size of the file in bytes
+	MimeType string // MIME type sniffed from the file
+}
+
+// SetResourceScan records the scan of the quarantined file at the given path on the resource it
+// was uploaded as, which is taken out of quarantine. ErrResourceNotFound is returned if the
+// resource has since been removed from the note, or the note deleted.
+func (s *NotesService) SetResourceScan(ctx context.Context, noteID int, quarantine string, scan ScannedResource) error {
+	found, err := s.updateResource(ctx, noteID, func(r *types.Resource) bool {
+		return quarantine != "" && r.Quarantine == quarantine
+	}, func(r *types.Resource) {
+		r.ScanStatus = scan.Status
+		r.ScanDetail = scan.Detail
+		r.Quarantine = ""
+		r.Key = scan.Key
+		if scan.Size > 0 {
+			r.Size = scan.Size
+		}
+		if scan.MimeType != "" {
+			r.MimeType = scan.MimeType
+		}
+	})
+	if err != nil {
+		return err
+	}
+	if !found {
+		return ErrResourceNotFound
+	}
+	return nil
+}
+
+// withKey matches the note resource with the given storage key
+func withKey(key string) func(*types.Resource) bool {
+	return func(r *types.Resource) bool {
+		return key != "" && r.Key == key
+	}
+}
+
+// updateResource changes the note resources that match, and reports whether there were any.
+// Nothing is changed if the resource has since been removed from the note, or the note deleted.
+func (s *NotesService) updateResource(ctx context.Context, noteID int, match func(*types.Resource) bool, update func(*types.Resource)) (bool, error) {
+	tx, err := s.orm.Tx(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	n, err := tx.Note.Get(ctx, noteID)
+	if ent.IsNotFound(err) {
+		return false, tx.Rollback()
+	}
+	if err != nil {
+		return false, rollback(tx, fmt.Errorf("failed to fetch note: %w", err))
+	}
+
+	found := false
+	resources := make([]types.Resource, len(n.Resources))
+	copy(resources, n.Resources)
+	for i := range resources {
+		if match(&resources[i]) {
+			update(&resources[i])
+			found = true
+		}
+	}
+	if !found {
+		return false, tx.Rollback()
+	}
+
+	// Saving the resources also re-indexes the note for search
+	if err := tx.Note.UpdateOneID(noteID).SetResources(resources).Exec(ctx); err != nil {
+		return false, rollback(tx, fmt.Errorf("failed to update note resources: %w", err))
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit resource update: %w", err)
+	}
+	return true, nil
+}
+
+// uploadLimits returns the configured limits on the size of each file and of all files in a
+// note, falling back to the defaults if the configuration is invalid
+func (s *NotesService) uploadLimits() (maxFileSize, maxTotalSize int64) {
+	maxFileSize, maxTotalSize, _, err := GetFileUploadLimits(s.config)
+	if err != nil {
+		return 40 * 1024 * 1024, 400 * 1024 * 1024
+	}
+	return maxFileSize, maxTotalSize
+}
+
+// SignResources points the notes' uploaded files at signed links, since only their storage keys
+// are kept and some storage backends don't serve files any other way. Files that haven't passed
+// their virus scan aren't linked. Only the loaded notes are changed, not the database.
+func SignResources(ctx context.Context, storage StorageService, notes ...*ent.Note) {
+	for _, n := range notes {
+		for i := range n.Resources {
+			r := &n.Resources[i]
+			if r.Key == "" || !r.Available() {
+				continue
+			}
+			r.URL = ""
+			if signed, err := storage.GetSignedURL(ctx, r.Key, int64(ResourceLinkTTL/time.Second)); err == nil {
+				r.URL = signed
+			}
+		}
+	}
+}
+
 // calculateTotalResourceSize calculates the total size of all resources
 func (s *NotesService) calculateTotalResourceSize(resources []types.Resource) int64 {
 	var totalSize int64
@@ -669,10 +972,8 @@ func (s *NotesService) IsNoteRepostedByUser(ctx context.Context, noteID, userID
 	return exists, nil
 }
 
-// TODO: Add AI processing functions later when AI service is implemented
-
-// generateShareToken generates a unique share token
-func (s *NotesService) generateShareToken() (string, error) {
+// generateShareToken generates a unique share token for notes and study group invites
+func generateShareToken() (string, error) {
 	bytes := make([]byte, 16)
 	if _, err := rand.Read(bytes); err != nil {
 		return "", err
@@ -682,6 +983,11 @@ func (s *NotesService) generateShareToken() (string, error) {
 
 // determineResourceType determines the type of resource based on MIME type and extension
 func (s *NotesService) determineResourceType(mimeType, ext string) string {
+	return resourceTypeFor(mimeType, ext)
+}
+
+// resourceTypeFor determines the type of an uploaded file based on MIME type and extension
+func resourceTypeFor(mimeType, ext string) string {
 	ext = strings.ToLower(ext)
 	mimeType = strings.ToLower(mimeType)
 
@@ -0,0 +1,80 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/r-scheele/zero/ent"
+	"github.com/r-scheele/zero/pkg/tests"
+	"github.com/r-scheele/zero/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotesServiceSetResourceScan(t *testing.T) {
+	ctx := context.Background()
+
+	owner, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	n, err := c.Notes.CreateNote(ctx, owner.ID, CreateNoteInput{Title: "Lab report", Visibility: "private", PermissionLevel: "read_only"})
+	require.NoError(t, err)
+
+	for _, quarantine := range []string{"quarantine/a", "quarantine/b"} {
+		err = c.Notes.AddResourceToNote(ctx, n.ID, owner.ID, types.Resource{
+			Type:       "pdf",
+			Name:       quarantine + ".pdf",
+			MimeType:   "application/pdf",
+			ScanStatus: types.ScanPending,
+			Quarantine: quarantine,
+			UploadedAt: time.Now(),
+		})
+		require.NoError(t, err)
+	}
+
+	// Files that pass are taken out of quarantine and linked
+	err = c.Notes.SetResourceScan(ctx, n.ID, "quarantine/a", ScannedResource{
+		Status: types.ScanClean,
+		Key:    "notes/a.pdf",
+		Size:   42,
+	})
+	require.NoError(t, err)
+	err = c.Notes.SetResourceScan(ctx, n.ID, "quarantine/b", ScannedResource{
+		Status: types.ScanInfected,
+		Detail: "Eicar-Signature",
+	})
+	require.NoError(t, err)
+
+	n, err = c.ORM.Note.Get(ctx, n.ID)
+	require.NoError(t, err)
+	require.Len(t, n.Resources, 2)
+	assert.True(t, n.Resources[0].Available())
+	assert.Empty(t, n.Resources[0].Quarantine)
+	assert.Equal(t, "notes/a.pdf", n.Resources[0].Key)
+	assert.Equal(t, int64(42), n.Resources[0].Size)
+	assert.False(t, n.Resources[1].Available())
+	assert.Equal(t, types.ScanInfected, n.Resources[1].ScanStatus)
+	assert.Equal(t, "Eicar-Signature", n.Resources[1].ScanDetail)
+	assert.Empty(t, n.Resources[1].URL)
+
+	// Files removed from the note, or whose note was deleted, are reported so they can be dropped
+	err = c.Notes.SetResourceScan(ctx, n.ID, "quarantine/a", ScannedResource{Status: types.ScanClean})
+	assert.ErrorIs(t, err, ErrResourceNotFound)
+	require.NoError(t, c.Notes.DeleteNote(ctx, n.ID, owner.ID))
+	err = c.Notes.SetResourceScan(ctx, n.ID, "quarantine/b", ScannedResource{Status: types.ScanClean})
+	assert.ErrorIs(t, err, ErrResourceNotFound)
+}
+
+func TestSignResources(t *testing.T) {
+	storage := &memoryStorage{files: make(map[string]string)}
+	n := &ent.Note{Resources: []types.Resource{
+		{Type: "pdf", Name: "slides.pdf", Key: "blobs/ab/abc.pdf", URL: "/storage/blobs/ab/abc.pdf"},
+		{Type: "pdf", Name: "held.pdf", Key: "blobs/cd/cde.pdf", ScanStatus: types.ScanPending},
+		{Type: "url", Name: "Link", URL: "https://example.com"},
+	}}
+
+	SignResources(context.Background(), storage, n)
+	assert.Equal(t, "/files/blobs/ab/abc.pdf?signed", n.Resources[0].URL)
+	assert.Empty(t, n.Resources[1].URL)
+	assert.Equal(t, "https://example.com", n.Resources[2].URL)
+}
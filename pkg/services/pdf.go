@@ -0,0 +1,180 @@
+package services
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// A4 page dimensions and margins, in points
+const (
+	pdfPageWidth  = 595.0
+	pdfPageHeight = 842.0
+	pdfMargin     = 50.0
+)
+
+// pdfDocument builds a simple text-only PDF using the standard Helvetica fonts, so reports
+// can be rendered on the server without any external tools or services
+type pdfDocument struct {
+	pages []*bytes.Buffer
+	y     float64
+}
+
+// newPDFDocument creates an empty PDF document
+func newPDFDocument() *pdfDocument {
+	return &pdfDocument{}
+}
+
+// Heading writes a bold line of text
+func (d *pdfDocument) Heading(text string, size float64) {
+	d.line(0, size, true, text)
+	d.y -= size * 0.4
+}
+
+// Text writes a paragraph, wrapping it to the page width
+func (d *pdfDocument) Text(text string, size float64) {
+	width := pdfPageWidth - 2*pdfMargin
+	for _, line := range pdfWrap(text, width, size) {
+		d.line(0, size, false, line)
+	}
+}
+
+// Row writes a line of table cells. Each cell starts at the given offset from the left margin
+// and is truncated so it doesn't run into the next cell.
+func (d *pdfDocument) Row(size float64, bold bool, offsets []float64, cells ...string) {
+	d.ensureSpace(size * 1.4)
+	for i, cell := range cells {
+		end := pdfPageWidth - 2*pdfMargin
+		if i+1 < len(offsets) {
+			end = offsets[i+1] - size/2
+		}
+		d.write(offsets[i], size, bold, pdfTruncate(cell, end-offsets[i], size))
+	}
+	d.y -= size * 1.4
+}
+
+// Space adds vertical space
+func (d *pdfDocument) Space(height float64) {
+	d.y -= height
+}
+
+// line writes a single line of text at the given offset from the left margin
+func (d *pdfDocument) line(x, size float64, bold bool, text string) {
+	d.ensureSpace(size * 1.4)
+	d.write(x, size, bold, text)
+	d.y -= size * 1.4
+}
+
+// write draws text at the current position without advancing it
+func (d *pdfDocument) write(x, size float64, bold bool, text string) {
+	font := "F1"
+	if bold {
+		font = "F2"
+	}
+	fmt.Fprintf(d.pages[len(d.pages)-1], "BT /%s %.1f Tf %.2f %.2f Td (%s) Tj ET\n",
+		font, size, pdfMargin+x, d.y-size, pdfEscape(text))
+}
+
+// ensureSpace starts a new page if the current one can't fit the given height
+func (d *pdfDocument) ensureSpace(height float64) {
+	if len(d.pages) == 0 || d.y-height < pdfMargin {
+		d.pages = append(d.pages, new(bytes.Buffer))
+		d.y = pdfPageHeight - pdfMargin
+	}
+}
+
+// WriteTo writes the document as a PDF file
+func (d *pdfDocument) WriteTo(w io.Writer) (int64, error) {
+	d.ensureSpace(0)
+
+	var buf bytes.Buffer
+	var offsets []int
+	object := func(body string) {
+		offsets = append(offsets, buf.Len())
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	// Objects 1-4 are the catalog, page tree and fonts, followed by a page and content stream per page
+	kids := make([]string, len(d.pages))
+	for i := range d.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", 5+i*2)
+	}
+
+	buf.WriteString("%PDF-1.4\n")
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(d.pages)))
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>")
+	for i, page := range d.pages {
+		object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.0f %.0f] "+
+			"/Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>",
+			pdfPageWidth, pdfPageHeight, 6+i*2))
+		object(fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", page.Len(), page.String()))
+	}
+
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+
+	return buf.WriteTo(w)
+}
+
+// pdfEscape encodes text for a PDF string literal. Characters outside Latin-1 can't be
+// shown with the standard fonts and are replaced.
+func pdfEscape(text string) string {
+	var b strings.Builder
+	for _, r := range text {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r == '\t':
+			b.WriteByte(' ')
+		case r < 0x20 || (r >= 0x7f && r < 0xa0) || r > 0xff:
+			b.WriteByte('?')
+		default:
+			b.WriteByte(byte(r))
+		}
+	}
+	return b.String()
+}
+
+// pdfTextWidth estimates the width of text in Helvetica at the given size
+func pdfTextWidth(text string, size float64) float64 {
+	return float64(len([]rune(text))) * size * 0.55
+}
+
+// pdfWrap splits text into lines that fit the given width
+func pdfWrap(text string, width, size float64) []string {
+	var lines []string
+	line := ""
+	for _, word := range strings.Fields(text) {
+		next := word
+		if line != "" {
+			next = line + " " + word
+		}
+		if line != "" && pdfTextWidth(next, size) > width {
+			lines = append(lines, line)
+			next = word
+		}
+		line = next
+	}
+	return append(lines, line)
+}
+
+// pdfTruncate shortens text to fit the given width
+func pdfTruncate(text string, width, size float64) string {
+	runes := []rune(text)
+	fit := int(width / (size * 0.55))
+	if len(runes) <= fit {
+		return text
+	}
+	if fit <= 3 {
+		return string(runes[:max(fit, 0)])
+	}
+	return string(runes[:fit-3]) + "..."
+}
@@ -0,0 +1,46 @@
+package services
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPDFDocument(t *testing.T) {
+	doc := newPDFDocument()
+	doc.Heading("Report (draft)", 18)
+	for i := 0; i < 100; i++ {
+		doc.Row(10, false, []float64{0, 100}, fmt.Sprintf("Row %d", i), "Ünïcode ✓")
+	}
+
+	var buf bytes.Buffer
+	_, err := doc.WriteTo(&buf)
+	require.NoError(t, err)
+	out := buf.String()
+
+	assert.True(t, strings.HasPrefix(out, "%PDF-1.4\n"))
+	assert.True(t, strings.HasSuffix(out, "%%EOF\n"))
+	assert.Contains(t, out, `(Report \(draft\))`)
+	assert.Contains(t, out, "/Count 2")
+	assert.Contains(t, out, "(\xdcn\xefcode ?)", "Latin-1 is kept and other characters are replaced")
+
+	// The cross-reference table points at each object
+	for i := 1; i <= 8; i++ {
+		header := fmt.Sprintf("%d 0 obj", i)
+		offset := strings.Index(out, header)
+		require.NotEqual(t, -1, offset, header)
+		assert.Contains(t, out, fmt.Sprintf("%010d 00000 n ", offset))
+	}
+}
+
+func TestPDFWrapAndTruncate(t *testing.T) {
+	lines := pdfWrap("one two three four five six", 60, 10)
+	assert.Equal(t, []string{"one two", "three four", "five six"}, lines)
+
+	assert.Equal(t, "short", pdfTruncate("short", 100, 10))
+	assert.Equal(t, "a long...", pdfTruncate("a long topic title", 50, 10))
+}
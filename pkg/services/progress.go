@@ -0,0 +1,368 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/r-scheele/zero/ent"
+	"github.com/r-scheele/zero/ent/quizattempt"
+	"github.com/r-scheele/zero/ent/studyevent"
+	"github.com/r-scheele/zero/ent/user"
+	"github.com/spf13/afero"
+)
+
+const (
+	// MaxViewDuration caps the time recorded for a single note view, so a tab left open overnight doesn't count
+	MaxViewDuration = 2 * time.Hour
+
+	// ActivityDays is the number of days shown in activity and score trend charts
+	ActivityDays = 30
+
+	// masteryWindow is the number of recent quiz attempts on a topic used to compute mastery
+	masteryWindow = 5
+)
+
+// ProgressService records study events, computes learning progress from them and exports them
+type ProgressService struct {
+	orm   *ent.Client
+	files afero.Fs
+}
+
+// NewProgressService creates a new progress service
+func NewProgressService(orm *ent.Client, files afero.Fs) *ProgressService {
+	return &ProgressService{
+		orm:   orm,
+		files: files,
+	}
+}
+
+// ProgressSummary holds a user's aggregated learning progress
+type ProgressSummary struct {
+	CurrentStreak int            `json:"current_streak"`
+	LongestStreak int            `json:"longest_streak"`
+	StudiedToday  bool           `json:"studied_today"`
+	NotesViewed   int            `json:"notes_viewed"`
+	QuizzesTaken  int            `json:"quizzes_taken"`
+	AverageScore  float64        `json:"average_score"`
+	TimeSpent     time.Duration  `json:"time_spent"`
+	Activity      []DayActivity  `json:"activity"`
+	ScoreTrend    []ScorePoint   `json:"score_trend"`
+	Topics        []TopicMastery `json:"topics"`
+}
+
+// DayActivity holds the study activity for a single day
+type DayActivity struct {
+	Date      time.Time     `json:"date"`
+	Events    int           `json:"events"`
+	TimeSpent time.Duration `json:"time_spent"`
+}
+
+// ScorePoint holds the average quiz score, as a percentage, for a day with quiz attempts
+type ScorePoint struct {
+	Date     time.Time `json:"date"`
+	Score    float64   `json:"score"`
+	Attempts int       `json:"attempts"`
+}
+
+// TopicMastery holds the progress on a single topic
+type TopicMastery struct {
+	Topic        string        `json:"topic"`
+	Views        int           `json:"views"`
+	Attempts     int           `json:"attempts"`
+	Mastery      float64       `json:"mastery"`
+	TimeSpent    time.Duration `json:"time_spent"`
+	LastStudied  time.Time     `json:"last_studied"`
+	recentScores []float64
+}
+
+// Level describes how well a topic has been mastered
+func (t TopicMastery) Level() string {
+	switch {
+	case t.Attempts == 0:
+		return "Not tested"
+	case t.Mastery >= 80:
+		return "Mastered"
+	case t.Mastery >= 50:
+		return "Practicing"
+	default:
+		return "Learning"
+	}
+}
+
+// RecordNoteView records that a user opened a note. The time spent is added later with AddTimeSpent.
+func (s *ProgressService) RecordNoteView(ctx context.Context, userID int, n *ent.Note) (*ent.StudyEvent, error) {
+	event, err := s.orm.StudyEvent.Create().
+		SetType(studyevent.TypeNoteViewed).
+		SetTopic(n.Title).
+		SetUserID(userID).
+		SetNoteID(n.ID).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to record note view: %w", err)
+	}
+
+	return event, nil
+}
+
+// AddTimeSpent records the time spent on a note view. The duration is the total time since
+// the note was opened, so repeated reports from the same page only ever extend it.
+func (s *ProgressService) AddTimeSpent(ctx context.Context, eventID, userID int, d time.Duration) error {
+	if d > MaxViewDuration {
+		d = MaxViewDuration
+	}
+	seconds := int(d.Seconds())
+
+	_, err := s.orm.StudyEvent.Update().
+		Where(
+			studyevent.ID(eventID),
+			studyevent.HasUserWith(user.ID(userID)),
+			studyevent.TypeEQ(studyevent.TypeNoteViewed),
+			studyevent.DurationSecondsLT(seconds),
+		).
+		SetDurationSeconds(seconds).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to record time spent: %w", err)
+	}
+
+	return nil
+}
+
+// RecordQuizAttempt records a graded quiz attempt. Attempts that are still in progress or
+// have already been recorded are ignored.
+func (s *ProgressService) RecordQuizAttempt(ctx context.Context, attemptID int) error {
+	attempt, err := s.orm.QuizAttempt.Query().
+		Where(
+			quizattempt.ID(attemptID),
+			quizattempt.StatusNEQ(quizattempt.StatusInProgress),
+			quizattempt.Not(quizattempt.HasStudyEvent()),
+		).
+		WithQuiz(func(q *ent.QuizQuery) {
+			q.WithNote()
+		}).
+		WithUser().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to fetch attempt: %w", err)
+	}
+
+	q := attempt.Edges.Quiz
+	occurred := time.Now()
+	duration := 0
+	if attempt.SubmittedAt != nil {
+		occurred = *attempt.SubmittedAt
+		duration = int(attempt.SubmittedAt.Sub(attempt.StartedAt).Seconds())
+	}
+
+	create := s.orm.StudyEvent.Create().
+		SetType(studyevent.TypeQuizAttempted).
+		SetTopic(quizTopic(q)).
+		SetScore(attempt.Score).
+		SetMaxScore(attempt.MaxScore).
+		SetDurationSeconds(max(duration, 0)).
+		SetOccurredAt(occurred).
+		SetUserID(attempt.Edges.User.ID).
+		SetQuiz(q).
+		SetAttempt(attempt)
+	if q.Edges.Note != nil {
+		create.SetNoteID(q.Edges.Note.ID)
+	}
+
+	if _, err := create.Save(ctx); err != nil {
+		// Another request recorded the attempt first
+		if ent.IsConstraintError(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to record quiz attempt: %w", err)
+	}
+
+	return nil
+}
+
+// ListEvents lists the user's study events, most recent first
+func (s *ProgressService) ListEvents(ctx context.Context, userID, limit, offset int) ([]*ent.StudyEvent, error) {
+	events, err := s.orm.StudyEvent.Query().
+		Where(studyevent.HasUserWith(user.ID(userID))).
+		Order(ent.Desc(studyevent.FieldOccurredAt), ent.Desc(studyevent.FieldID)).
+		Limit(limit).
+		Offset(offset).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch study events: %w", err)
+	}
+
+	return events, nil
+}
+
+// Summary computes the user's learning progress as of now. Days are counted in now's time zone.
+func (s *ProgressService) Summary(ctx context.Context, userID int, now time.Time) (*ProgressSummary, error) {
+	events, err := s.orm.StudyEvent.Query().
+		Where(studyevent.HasUserWith(user.ID(userID))).
+		Order(ent.Asc(studyevent.FieldOccurredAt), ent.Asc(studyevent.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch study events: %w", err)
+	}
+
+	return SummarizeProgress(events, now), nil
+}
+
+// SummarizeProgress aggregates study events, which must be ordered oldest first, into a progress summary
+func SummarizeProgress(events []*ent.StudyEvent, now time.Time) *ProgressSummary {
+	summary := &ProgressSummary{}
+	today := startOfDay(now)
+	firstDay := today.AddDate(0, 0, -(ActivityDays - 1))
+
+	activity := make(map[time.Time]*DayActivity)
+	scores := make(map[time.Time]*ScorePoint)
+	topics := make(map[string]*TopicMastery)
+	studied := make(map[time.Time]bool)
+	var scoreTotal float64
+
+	for _, e := range events {
+		day := startOfDay(e.OccurredAt.In(now.Location()))
+		spent := time.Duration(e.DurationSeconds) * time.Second
+		studied[day] = true
+		summary.TimeSpent += spent
+
+		if !day.Before(firstDay) {
+			a, ok := activity[day]
+			if !ok {
+				a = &DayActivity{Date: day}
+				activity[day] = a
+			}
+			a.Events++
+			a.TimeSpent += spent
+		}
+
+		t, ok := topics[e.Topic]
+		if !ok {
+			t = &TopicMastery{Topic: e.Topic}
+			topics[e.Topic] = t
+		}
+		t.TimeSpent += spent
+		t.LastStudied = e.OccurredAt
+
+		switch e.Type {
+		case studyevent.TypeNoteViewed:
+			summary.NotesViewed++
+			t.Views++
+		case studyevent.TypeQuizAttempted:
+			pct, ok := eventPercent(e)
+			if !ok {
+				continue
+			}
+			summary.QuizzesTaken++
+			scoreTotal += pct
+			t.Attempts++
+			t.recentScores = append(t.recentScores, pct)
+			if len(t.recentScores) > masteryWindow {
+				t.recentScores = t.recentScores[1:]
+			}
+
+			if !day.Before(firstDay) {
+				p, ok := scores[day]
+				if !ok {
+					p = &ScorePoint{Date: day}
+					scores[day] = p
+				}
+				// Keep a running average for the day
+				p.Score = (p.Score*float64(p.Attempts) + pct) / float64(p.Attempts+1)
+				p.Attempts++
+			}
+		}
+	}
+
+	if summary.QuizzesTaken > 0 {
+		summary.AverageScore = scoreTotal / float64(summary.QuizzesTaken)
+	}
+
+	// Fill in every day of the activity chart, including days without study
+	for d := firstDay; !d.After(today); d = d.AddDate(0, 0, 1) {
+		if a, ok := activity[d]; ok {
+			summary.Activity = append(summary.Activity, *a)
+		} else {
+			summary.Activity = append(summary.Activity, DayActivity{Date: d})
+		}
+		if p, ok := scores[d]; ok {
+			summary.ScoreTrend = append(summary.ScoreTrend, *p)
+		}
+	}
+
+	for _, t := range topics {
+		if len(t.recentScores) > 0 {
+			var total float64
+			for _, s := range t.recentScores {
+				total += s
+			}
+			t.Mastery = total / float64(len(t.recentScores))
+		}
+		summary.Topics = append(summary.Topics, *t)
+	}
+	sort.Slice(summary.Topics, func(i, j int) bool {
+		return summary.Topics[i].LastStudied.After(summary.Topics[j].LastStudied)
+	})
+
+	summary.StudiedToday = studied[today]
+	summary.CurrentStreak, summary.LongestStreak = studyStreaks(studied, today)
+
+	return summary
+}
+
+// studyStreaks returns the current and longest runs of consecutive study days.
+// The current streak stays alive until a full day passes without study.
+func studyStreaks(studied map[time.Time]bool, today time.Time) (current, longest int) {
+	days := make([]time.Time, 0, len(studied))
+	for d := range studied {
+		days = append(days, d)
+	}
+	sort.Slice(days, func(i, j int) bool { return days[i].Before(days[j]) })
+
+	run := 0
+	for i, d := range days {
+		if i > 0 && days[i-1].AddDate(0, 0, 1).Equal(d) {
+			run++
+		} else {
+			run = 1
+		}
+		longest = max(longest, run)
+	}
+
+	day := today
+	if !studied[day] {
+		day = day.AddDate(0, 0, -1)
+	}
+	for studied[day] {
+		current++
+		day = day.AddDate(0, 0, -1)
+	}
+
+	return current, longest
+}
+
+// eventPercent returns the score of a quiz event as a percentage
+func eventPercent(e *ent.StudyEvent) (float64, bool) {
+	if e.Score == nil || e.MaxScore == nil || *e.MaxScore <= 0 {
+		return 0, false
+	}
+	return float64(*e.Score) / float64(*e.MaxScore) * 100, true
+}
+
+// quizTopic returns the topic of a quiz: the note a practice set was generated from, or the quiz itself
+func quizTopic(q *ent.Quiz) string {
+	if q.Edges.Note != nil {
+		return q.Edges.Note.Title
+	}
+	return q.Title
+}
+
+// startOfDay returns midnight of t's day in t's time zone
+func startOfDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
@@ -0,0 +1,413 @@
+package services
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/r-scheele/zero/ent"
+	"github.com/r-scheele/zero/ent/studyevent"
+	"github.com/r-scheele/zero/ent/user"
+	"github.com/spf13/afero"
+)
+
+const (
+	// ExportSyncLimit is the largest study history exported while the user waits. Larger exports
+	// are built by a background task and the download link is emailed.
+	ExportSyncLimit = 2000
+
+	// ExportLinkTTL is how long a background export can be downloaded
+	ExportLinkTTL = 7 * 24 * time.Hour
+
+	// exportBatchSize is the number of study events loaded at a time while exporting
+	exportBatchSize = 500
+
+	// exportDirectory is where background exports are stored, per user
+	exportDirectory = "exports"
+)
+
+// ExportFormat is a file format a user's study history can be exported in
+type ExportFormat string
+
+const (
+	ExportCSV  ExportFormat = "csv"
+	ExportJSON ExportFormat = "json"
+	ExportPDF  ExportFormat = "pdf"
+)
+
+var (
+	ErrExportFormat   = errors.New("unsupported export format")
+	ErrExportNotFound = errors.New("export not found or expired")
+)
+
+// ParseExportFormat parses an export format name
+func ParseExportFormat(name string) (ExportFormat, error) {
+	switch f := ExportFormat(strings.ToLower(name)); f {
+	case ExportCSV, ExportJSON, ExportPDF:
+		return f, nil
+	default:
+		return "", ErrExportFormat
+	}
+}
+
+// ContentType returns the MIME type of the format
+func (f ExportFormat) ContentType() string {
+	switch f {
+	case ExportCSV:
+		return "text/csv; charset=utf-8"
+	case ExportJSON:
+		return "application/json; charset=utf-8"
+	default:
+		return "application/pdf"
+	}
+}
+
+// FileName returns the name an export made at the given time is downloaded as
+func (f ExportFormat) FileName(now time.Time) string {
+	return fmt.Sprintf("progress-%s.%s", now.Format("2006-01-02"), f)
+}
+
+// exportedUser identifies the student in JSON exports
+type exportedUser struct {
+	ID    int     `json:"id"`
+	Name  string  `json:"name"`
+	Email *string `json:"email,omitempty"`
+}
+
+// exportedEvent is a study event as it appears in JSON exports
+type exportedEvent struct {
+	Type            studyevent.Type `json:"type"`
+	Topic           string          `json:"topic"`
+	Score           *int            `json:"score,omitempty"`
+	MaxScore        *int            `json:"max_score,omitempty"`
+	Percent         *float64        `json:"percent,omitempty"`
+	DurationSeconds int             `json:"duration_seconds"`
+	OccurredAt      time.Time       `json:"occurred_at"`
+}
+
+// CountEvents returns the number of study events recorded for a user
+func (s *ProgressService) CountEvents(ctx context.Context, userID int) (int, error) {
+	count, err := s.orm.StudyEvent.Query().
+		Where(studyevent.HasUserWith(user.ID(userID))).
+		Count(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count study events: %w", err)
+	}
+
+	return count, nil
+}
+
+// Export writes the user's full study history to w in the given format. Events are loaded in
+// batches, so CSV and JSON exports are streamed rather than built in memory.
+func (s *ProgressService) Export(ctx context.Context, w io.Writer, u *ent.User, format ExportFormat, now time.Time) error {
+	switch format {
+	case ExportCSV:
+		return s.exportCSV(ctx, w, u)
+	case ExportJSON:
+		return s.exportJSON(ctx, w, u, now)
+	case ExportPDF:
+		return s.exportPDF(ctx, w, u, now)
+	default:
+		return ErrExportFormat
+	}
+}
+
+// CreateExport exports the user's study history to a file that can be downloaded with
+// OpenExport until it expires, and returns the file's name
+func (s *ProgressService) CreateExport(ctx context.Context, u *ent.User, format ExportFormat, now time.Time) (string, error) {
+	dir := path.Join(exportDirectory, strconv.Itoa(u.ID))
+	s.pruneExports(dir, now)
+
+	if err := s.files.MkdirAll(dir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create export directory: %w", err)
+	}
+
+	token, err := generateShareToken()
+	if err != nil {
+		return "", fmt.Errorf("failed to generate export token: %w", err)
+	}
+	name := fmt.Sprintf("%s.%s", token, format)
+	filePath := path.Join(dir, name)
+
+	file, err := s.files.Create(filePath)
+	if err != nil {
+		return "", fmt.Errorf("failed to create export file: %w", err)
+	}
+
+	err = s.Export(ctx, file, u, format, now)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = s.files.Remove(filePath)
+		return "", fmt.Errorf("failed to write export: %w", err)
+	}
+
+	return name, nil
+}
+
+// OpenExport opens one of the user's exports created with CreateExport, along with its format.
+// Expired exports are removed.
+func (s *ProgressService) OpenExport(userID int, name string, now time.Time) (afero.File, ExportFormat, error) {
+	if name != path.Base(name) || strings.HasPrefix(name, ".") {
+		return nil, "", ErrExportNotFound
+	}
+
+	format, err := ParseExportFormat(strings.TrimPrefix(path.Ext(name), "."))
+	if err != nil {
+		return nil, "", ErrExportNotFound
+	}
+
+	filePath := path.Join(exportDirectory, strconv.Itoa(userID), name)
+	info, err := s.files.Stat(filePath)
+	switch {
+	case os.IsNotExist(err):
+		return nil, "", ErrExportNotFound
+	case err != nil:
+		return nil, "", fmt.Errorf("failed to stat export: %w", err)
+	case now.Sub(info.ModTime()) > ExportLinkTTL:
+		_ = s.files.Remove(filePath)
+		return nil, "", ErrExportNotFound
+	}
+
+	file, err := s.files.Open(filePath)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to open export: %w", err)
+	}
+
+	return file, format, nil
+}
+
+// pruneExports removes expired exports from a user's export directory
+func (s *ProgressService) pruneExports(dir string, now time.Time) {
+	infos, err := afero.ReadDir(s.files, dir)
+	if err != nil {
+		return
+	}
+	for _, info := range infos {
+		if now.Sub(info.ModTime()) > ExportLinkTTL {
+			_ = s.files.Remove(path.Join(dir, info.Name()))
+		}
+	}
+}
+
+// eachEvent calls fn with each of the user's study events, oldest first
+func (s *ProgressService) eachEvent(ctx context.Context, userID int, fn func(*ent.StudyEvent) error) error {
+	for offset := 0; ; offset += exportBatchSize {
+		events, err := s.orm.StudyEvent.Query().
+			Where(studyevent.HasUserWith(user.ID(userID))).
+			Order(ent.Asc(studyevent.FieldOccurredAt), ent.Asc(studyevent.FieldID)).
+			Limit(exportBatchSize).
+			Offset(offset).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to fetch study events: %w", err)
+		}
+
+		for _, e := range events {
+			if err := fn(e); err != nil {
+				return err
+			}
+		}
+
+		if len(events) < exportBatchSize {
+			return nil
+		}
+	}
+}
+
+// exportCSV writes the study history as CSV, one row per event
+func (s *ProgressService) exportCSV(ctx context.Context, w io.Writer, u *ent.User) error {
+	cw := csv.NewWriter(w)
+	err := cw.Write([]string{"occurred_at", "type", "topic", "score", "max_score", "percent", "duration_seconds"})
+	if err != nil {
+		return err
+	}
+
+	err = s.eachEvent(ctx, u.ID, func(e *ent.StudyEvent) error {
+		var score, maxScore, percent string
+		if e.Score != nil {
+			score = strconv.Itoa(*e.Score)
+		}
+		if e.MaxScore != nil {
+			maxScore = strconv.Itoa(*e.MaxScore)
+		}
+		if pct, ok := eventPercent(e); ok {
+			percent = strconv.FormatFloat(pct, 'f', 1, 64)
+		}
+
+		return cw.Write([]string{
+			e.OccurredAt.Format(time.RFC3339),
+			string(e.Type),
+			e.Topic,
+			score,
+			maxScore,
+			percent,
+			strconv.Itoa(e.DurationSeconds),
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// exportJSON writes the progress summary and the study history as a JSON document
+func (s *ProgressService) exportJSON(ctx context.Context, w io.Writer, u *ent.User, now time.Time) error {
+	summary, err := s.Summary(ctx, u.ID, now)
+	if err != nil {
+		return err
+	}
+
+	header, err := json.Marshal(struct {
+		User        exportedUser     `json:"user"`
+		GeneratedAt time.Time        `json:"generated_at"`
+		Summary     *ProgressSummary `json:"summary"`
+	}{
+		User:        exportedUser{ID: u.ID, Name: u.Name, Email: u.Email},
+		GeneratedAt: now,
+		Summary:     summary,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode summary: %w", err)
+	}
+
+	// Leave the object open so the events can be streamed into it
+	if _, err := w.Write(header[:len(header)-1]); err != nil {
+		return err
+	}
+	if _, err := io.WriteString(w, `,"events":[`); err != nil {
+		return err
+	}
+
+	first := true
+	err = s.eachEvent(ctx, u.ID, func(e *ent.StudyEvent) error {
+		event := exportedEvent{
+			Type:            e.Type,
+			Topic:           e.Topic,
+			Score:           e.Score,
+			MaxScore:        e.MaxScore,
+			DurationSeconds: e.DurationSeconds,
+			OccurredAt:      e.OccurredAt,
+		}
+		if pct, ok := eventPercent(e); ok {
+			event.Percent = &pct
+		}
+
+		b, err := json.Marshal(event)
+		if err != nil {
+			return fmt.Errorf("failed to encode study event: %w", err)
+		}
+		if !first {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		first = false
+		_, err = w.Write(b)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(w, "]}\n")
+	return err
+}
+
+// exportPDF writes a printable report with the progress summary, topic mastery and every graded quiz attempt
+func (s *ProgressService) exportPDF(ctx context.Context, w io.Writer, u *ent.User, now time.Time) error {
+	summary, err := s.Summary(ctx, u.ID, now)
+	if err != nil {
+		return err
+	}
+
+	doc := newPDFDocument()
+	doc.Heading("Learning progress report", 18)
+	student := u.Name
+	if u.Email != nil && *u.Email != "" {
+		student = fmt.Sprintf("%s (%s)", u.Name, *u.Email)
+	}
+	doc.Text("Student: "+student, 10)
+	doc.Text("Generated: "+now.Format("January 2, 2006 15:04 MST"), 10)
+	doc.Space(12)
+
+	average := "-"
+	if summary.QuizzesTaken > 0 {
+		average = fmt.Sprintf("%.1f%%", summary.AverageScore)
+	}
+	stats := []float64{0, 180}
+	doc.Heading("Summary", 13)
+	doc.Row(10, false, stats, "Current streak", fmt.Sprintf("%d days", summary.CurrentStreak))
+	doc.Row(10, false, stats, "Longest streak", fmt.Sprintf("%d days", summary.LongestStreak))
+	doc.Row(10, false, stats, "Time studied", exportDuration(summary.TimeSpent))
+	doc.Row(10, false, stats, "Notes read", strconv.Itoa(summary.NotesViewed))
+	doc.Row(10, false, stats, "Quiz attempts", strconv.Itoa(summary.QuizzesTaken))
+	doc.Row(10, false, stats, "Average quiz score", average)
+	doc.Space(12)
+
+	topics := []float64{0, 220, 270, 330, 390, 460}
+	doc.Heading("Topics", 13)
+	if len(summary.Topics) == 0 {
+		doc.Text("No topics studied yet.", 10)
+	} else {
+		doc.Row(9, true, topics, "Topic", "Reads", "Quizzes", "Mastery", "Level", "Time")
+		for _, t := range summary.Topics {
+			mastery := "-"
+			if t.Attempts > 0 {
+				mastery = fmt.Sprintf("%.0f%%", t.Mastery)
+			}
+			doc.Row(9, false, topics, t.Topic, strconv.Itoa(t.Views), strconv.Itoa(t.Attempts), mastery, t.Level(), exportDuration(t.TimeSpent))
+		}
+	}
+	doc.Space(12)
+
+	attempts := []float64{0, 110, 330, 390, 445}
+	doc.Heading("Quiz attempts", 13)
+	doc.Row(9, true, attempts, "Date", "Topic", "Score", "Percent", "Duration")
+	count := 0
+	err = s.eachEvent(ctx, u.ID, func(e *ent.StudyEvent) error {
+		pct, ok := eventPercent(e)
+		if e.Type != studyevent.TypeQuizAttempted || !ok {
+			return nil
+		}
+		count++
+		doc.Row(9, false, attempts,
+			e.OccurredAt.In(now.Location()).Format("Jan 2, 2006 15:04"),
+			e.Topic,
+			fmt.Sprintf("%d / %d", *e.Score, *e.MaxScore),
+			fmt.Sprintf("%.1f%%", pct),
+			exportDuration(time.Duration(e.DurationSeconds)*time.Second),
+		)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		doc.Text("No quizzes taken yet.", 10)
+	}
+
+	_, err = doc.WriteTo(w)
+	return err
+}
+
+// exportDuration formats a duration in hours and minutes for reports
+func exportDuration(d time.Duration) string {
+	d = d.Round(time.Minute)
+	h, m := int(d.Hours()), int(d.Minutes())%60
+	if h > 0 {
+		return fmt.Sprintf("%dh %dm", h, m)
+	}
+	return fmt.Sprintf("%dm", m)
+}
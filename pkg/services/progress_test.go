@@ -0,0 +1,235 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/r-scheele/zero/ent"
+	"github.com/r-scheele/zero/ent/studyevent"
+	"github.com/r-scheele/zero/pkg/tests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSummarizeProgress(t *testing.T) {
+	now := time.Date(2024, 3, 10, 15, 0, 0, 0, time.UTC)
+	day := func(daysAgo int) time.Time {
+		return now.AddDate(0, 0, -daysAgo)
+	}
+	view := func(topic string, at time.Time, seconds int) *ent.StudyEvent {
+		return &ent.StudyEvent{Type: studyevent.TypeNoteViewed, Topic: topic, OccurredAt: at, DurationSeconds: seconds}
+	}
+	quiz := func(topic string, at time.Time, score, maxScore int) *ent.StudyEvent {
+		return &ent.StudyEvent{Type: studyevent.TypeQuizAttempted, Topic: topic, OccurredAt: at, Score: &score, MaxScore: &maxScore}
+	}
+
+	events := []*ent.StudyEvent{
+		// A four day run long ago
+		view("Algebra", day(40), 600),
+		view("Algebra", day(39), 600),
+		view("Algebra", day(38), 600),
+		view("Algebra", day(37), 600),
+		// A two day run ending yesterday
+		quiz("Algebra", day(2).Add(-time.Hour), 2, 10),
+		view("Biology", day(1), 300),
+		quiz("Biology", day(1), 5, 10),
+		quiz("Biology", day(1).Add(time.Hour), 10, 10),
+	}
+
+	s := SummarizeProgress(events, now)
+
+	assert.Equal(t, 2, s.CurrentStreak)
+	assert.Equal(t, 4, s.LongestStreak)
+	assert.False(t, s.StudiedToday)
+	assert.Equal(t, 5, s.NotesViewed)
+	assert.Equal(t, 3, s.QuizzesTaken)
+	assert.InDelta(t, 56.67, s.AverageScore, 0.01)
+	assert.Equal(t, 45*time.Minute, s.TimeSpent)
+
+	// Activity covers every day of the window, oldest first.
+	require.Len(t, s.Activity, ActivityDays)
+	assert.Equal(t, startOfDay(now), s.Activity[ActivityDays-1].Date)
+	assert.Equal(t, 3, s.Activity[ActivityDays-2].Events)
+	assert.Equal(t, 5*time.Minute, s.Activity[ActivityDays-2].TimeSpent)
+
+	// Scores are averaged per day.
+	require.Len(t, s.ScoreTrend, 2)
+	assert.Equal(t, 20.0, s.ScoreTrend[0].Score)
+	assert.Equal(t, 75.0, s.ScoreTrend[1].Score)
+	assert.Equal(t, 2, s.ScoreTrend[1].Attempts)
+
+	// Topics are ordered by when they were last studied.
+	require.Len(t, s.Topics, 2)
+	assert.Equal(t, "Biology", s.Topics[0].Topic)
+	assert.Equal(t, 75.0, s.Topics[0].Mastery)
+	assert.Equal(t, "Practicing", s.Topics[0].Level())
+	assert.Equal(t, "Algebra", s.Topics[1].Topic)
+	assert.Equal(t, 4, s.Topics[1].Views)
+	assert.Equal(t, "Learning", s.Topics[1].Level())
+
+	// Studying today extends the streak.
+	s = SummarizeProgress(append(events, view("Biology", now, 0)), now)
+	assert.Equal(t, 3, s.CurrentStreak)
+	assert.True(t, s.StudiedToday)
+
+	// A missed day breaks it.
+	s = SummarizeProgress(events[:4], now)
+	assert.Equal(t, 0, s.CurrentStreak)
+	assert.Equal(t, 4, s.LongestStreak)
+}
+
+func TestProgressService(t *testing.T) {
+	ctxb := context.Background()
+
+	u, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+
+	n, err := c.ORM.Note.Create().
+		SetTitle("Chemistry").
+		SetOwner(u).
+		Save(ctxb)
+	require.NoError(t, err)
+
+	// Note views record time spent, which only grows and is capped.
+	view, err := c.Progress.RecordNoteView(ctxb, u.ID, n)
+	require.NoError(t, err)
+	require.NoError(t, c.Progress.AddTimeSpent(ctxb, view.ID, u.ID, 90*time.Second))
+	require.NoError(t, c.Progress.AddTimeSpent(ctxb, view.ID, u.ID, 30*time.Second))
+	view, err = c.ORM.StudyEvent.Get(ctxb, view.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 90, view.DurationSeconds)
+
+	require.NoError(t, c.Progress.AddTimeSpent(ctxb, view.ID, u.ID, 10*time.Hour))
+	view, err = c.ORM.StudyEvent.Get(ctxb, view.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int(MaxViewDuration.Seconds()), view.DurationSeconds)
+
+	// Other users can't report time on someone else's events.
+	other, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	require.NoError(t, c.Progress.AddTimeSpent(ctxb, view.ID, other.ID, 5*time.Hour))
+	view, err = c.ORM.StudyEvent.Get(ctxb, view.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int(MaxViewDuration.Seconds()), view.DurationSeconds)
+
+	// Submitting a quiz records the attempt once.
+	q, err := c.Quiz.CreateQuiz(ctxb, u.ID, testQuizInput())
+	require.NoError(t, err)
+	attempt, _, err := c.Quiz.StartAttempt(ctxb, q.ID, u.ID)
+	require.NoError(t, err)
+	_, err = c.Quiz.SubmitAttempt(ctxb, attempt.ID, u.ID, map[int]string{})
+	require.NoError(t, err)
+	require.NoError(t, c.Progress.RecordQuizAttempt(ctxb, attempt.ID))
+
+	events, err := c.Progress.ListEvents(ctxb, u.ID, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, studyevent.TypeQuizAttempted, events[0].Type)
+	assert.Equal(t, "Geography", events[0].Topic)
+	require.NotNil(t, events[0].MaxScore)
+	assert.Equal(t, 4, *events[0].MaxScore)
+
+	s, err := c.Progress.Summary(ctxb, u.ID, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, 1, s.CurrentStreak)
+	assert.Equal(t, 1, s.NotesViewed)
+	assert.Equal(t, 1, s.QuizzesTaken)
+	assert.Equal(t, 0.0, s.AverageScore)
+}
+
+func TestProgressService_Export(t *testing.T) {
+	ctxb := context.Background()
+	now := time.Now()
+
+	u, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+
+	score, maxScore := 3, 4
+	_, err = c.ORM.StudyEvent.Create().
+		SetType(studyevent.TypeNoteViewed).
+		SetTopic("Photosynthesis, part 1").
+		SetDurationSeconds(600).
+		SetOccurredAt(now.Add(-2 * time.Hour)).
+		SetUser(u).
+		Save(ctxb)
+	require.NoError(t, err)
+	_, err = c.ORM.StudyEvent.Create().
+		SetType(studyevent.TypeQuizAttempted).
+		SetTopic("Photosynthesis (quiz)").
+		SetScore(score).
+		SetMaxScore(maxScore).
+		SetDurationSeconds(120).
+		SetOccurredAt(now.Add(-time.Hour)).
+		SetUser(u).
+		Save(ctxb)
+	require.NoError(t, err)
+
+	count, err := c.Progress.CountEvents(ctxb, u.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	// CSV has a row per event, oldest first
+	var buf bytes.Buffer
+	require.NoError(t, c.Progress.Export(ctxb, &buf, u, ExportCSV, now))
+	rows, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, rows, 3)
+	assert.Equal(t, "occurred_at", rows[0][0])
+	assert.Equal(t, []string{"note_viewed", "Photosynthesis, part 1", "", "", "", "600"}, rows[1][1:])
+	assert.Equal(t, []string{"quiz_attempted", "Photosynthesis (quiz)", "3", "4", "75.0", "120"}, rows[2][1:])
+
+	// JSON holds the summary and every event
+	buf.Reset()
+	require.NoError(t, c.Progress.Export(ctxb, &buf, u, ExportJSON, now))
+	var doc struct {
+		User struct {
+			ID int `json:"id"`
+		} `json:"user"`
+		Summary ProgressSummary `json:"summary"`
+		Events  []struct {
+			Type    string   `json:"type"`
+			Percent *float64 `json:"percent"`
+		} `json:"events"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &doc))
+	assert.Equal(t, u.ID, doc.User.ID)
+	assert.Equal(t, 1, doc.Summary.QuizzesTaken)
+	require.Len(t, doc.Events, 2)
+	assert.Nil(t, doc.Events[0].Percent)
+	require.NotNil(t, doc.Events[1].Percent)
+	assert.Equal(t, 75.0, *doc.Events[1].Percent)
+
+	// The PDF report is rendered on the server
+	buf.Reset()
+	require.NoError(t, c.Progress.Export(ctxb, &buf, u, ExportPDF, now))
+	assert.True(t, bytes.HasPrefix(buf.Bytes(), []byte("%PDF-1.4")))
+	assert.Contains(t, buf.String(), `(Photosynthesis \(quiz\))`)
+	assert.Contains(t, buf.String(), "(3 / 4)")
+
+	// Background exports can only be downloaded by their owner until they expire
+	name, err := c.Progress.CreateExport(ctxb, u, ExportCSV, now)
+	require.NoError(t, err)
+	file, format, err := c.Progress.OpenExport(u.ID, name, now)
+	require.NoError(t, err)
+	assert.Equal(t, ExportCSV, format)
+	rows, err = csv.NewReader(file).ReadAll()
+	require.NoError(t, err)
+	assert.Len(t, rows, 3)
+	require.NoError(t, file.Close())
+
+	_, _, err = c.Progress.OpenExport(usr.ID, name, now)
+	assert.ErrorIs(t, err, ErrExportNotFound)
+	_, _, err = c.Progress.OpenExport(u.ID, "../"+name, now)
+	assert.ErrorIs(t, err, ErrExportNotFound)
+	_, _, err = c.Progress.OpenExport(u.ID, name, now.Add(ExportLinkTTL+time.Hour))
+	assert.ErrorIs(t, err, ErrExportNotFound)
+	_, _, err = c.Progress.OpenExport(u.ID, name, now)
+	assert.ErrorIs(t, err, ErrExportNotFound, "expired exports are removed")
+
+	_, err = ParseExportFormat("xlsx")
+	assert.ErrorIs(t, err, ErrExportFormat)
+}
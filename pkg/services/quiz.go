@@ -0,0 +1,913 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/r-scheele/zero/ent"
+	"github.com/r-scheele/zero/ent/choice"
+	"github.com/r-scheele/zero/ent/note"
+	"github.com/r-scheele/zero/ent/question"
+	"github.com/r-scheele/zero/ent/quiz"
+	"github.com/r-scheele/zero/ent/quizattempt"
+	"github.com/r-scheele/zero/ent/user"
+	"github.com/r-scheele/zero/pkg/log"
+)
+
+// Question types supported by the quiz engine
+const (
+	QuestionTypeMultipleChoice = "multiple_choice"
+	QuestionTypeTrueFalse      = "true_false"
+	QuestionTypeShortAnswer    = "short_answer"
+)
+
+// maxPracticeQuestions is the number of questions generated for a practice set
+const maxPracticeQuestions = 10
+
+// AttemptGracePeriod is how long after its deadline a timed attempt still accepts answers, to allow for network latency
+const AttemptGracePeriod = 5 * time.Second
+
+var (
+	// ErrQuizNotFound is returned when a quiz does not exist or the user cannot access it
+	ErrQuizNotFound = errors.New("quiz not found or access denied")
+
+	// ErrAttemptLimitReached is returned when the user has used all attempts allowed for a quiz
+	ErrAttemptLimitReached = errors.New("you have used all attempts allowed for this quiz")
+
+	// ErrAttemptExpired is returned when answers arrive after an attempt's deadline
+	ErrAttemptExpired = errors.New("the time limit for this attempt has passed")
+
+	// ErrAttemptClosed is returned when an attempt has already been submitted or closed
+	ErrAttemptClosed = errors.New("this attempt has already been submitted")
+)
+
+// QuizService handles quiz authoring, taking and grading
+type QuizService struct {
+	orm       *ent.Client
+	generator QuestionGenerator
+	progress  *ProgressService
+}
+
+// NewQuizService creates a new quiz service. Graded attempts are recorded as study events if progress is set.
+func NewQuizService(orm *ent.Client, generator QuestionGenerator, progress *ProgressService) *QuizService {
+	return &QuizService{
+		orm:       orm,
+		generator: generator,
+		progress:  progress,
+	}
+}
+
+// ChoiceInput represents a single answer choice for a question
+type ChoiceInput struct {
+	Text    string `json:"text"`
+	Correct bool   `json:"correct"`
+}
+
+// QuestionInput represents input for a single quiz question
+type QuestionInput struct {
+	Type            string        `json:"type"`
+	Prompt          string        `json:"prompt"`
+	Choices         []ChoiceInput `json:"choices"`
+	AcceptedAnswers []string      `json:"accepted_answers"`
+	Explanation     string        `json:"explanation"`
+	Points          int           `json:"points"`
+}
+
+// QuizInput represents input for creating or updating a quiz
+type QuizInput struct {
+	Title       string          `json:"title"`
+	Description string          `json:"description"`
+	Visibility  string          `json:"visibility"`
+	TimeLimit   int             `json:"time_limit"`   // Minutes, 0 for no limit
+	MaxAttempts int             `json:"max_attempts"` // 0 for unlimited
+	Questions   []QuestionInput `json:"questions"`
+}
+
+// QuestionResult holds the grading outcome for a single question in an attempt
+type QuestionResult struct {
+	Question *ent.Question
+	Answer   string
+	Correct  bool
+	Points   int
+}
+
+// Validate checks that the quiz input is complete and that every question can be graded
+func (i QuizInput) Validate() error {
+	if strings.TrimSpace(i.Title) == "" {
+		return fmt.Errorf("title is required")
+	}
+	if i.TimeLimit < 0 {
+		return fmt.Errorf("time limit cannot be negative")
+	}
+	if i.MaxAttempts < 0 {
+		return fmt.Errorf("attempt limit cannot be negative")
+	}
+	if len(i.Questions) == 0 {
+		return fmt.Errorf("a quiz needs at least one question")
+	}
+
+	for n, q := range i.Questions {
+		if strings.TrimSpace(q.Prompt) == "" {
+			return fmt.Errorf("question %d: prompt is required", n+1)
+		}
+
+		switch q.Type {
+		case QuestionTypeMultipleChoice, QuestionTypeTrueFalse:
+			if len(q.Choices) < 2 {
+				return fmt.Errorf("question %d: at least two choices are required", n+1)
+			}
+			correct := 0
+			for _, c := range q.Choices {
+				if strings.TrimSpace(c.Text) == "" {
+					return fmt.Errorf("question %d: choices cannot be empty", n+1)
+				}
+				if c.Correct {
+					correct++
+				}
+			}
+			if correct != 1 {
+				return fmt.Errorf("question %d: exactly one choice must be marked correct", n+1)
+			}
+		case QuestionTypeShortAnswer:
+			if len(q.AcceptedAnswers) == 0 {
+				return fmt.Errorf("question %d: at least one accepted answer is required", n+1)
+			}
+		default:
+			return fmt.Errorf("question %d: unknown question type %q", n+1, q.Type)
+		}
+	}
+
+	return nil
+}
+
+// TrueFalseChoices builds the two choices of a true/false question
+func TrueFalseChoices(answer bool) []ChoiceInput {
+	return []ChoiceInput{
+		{Text: "True", Correct: answer},
+		{Text: "False", Correct: !answer},
+	}
+}
+
+// CreateQuiz creates a new quiz with its questions
+func (s *QuizService) CreateQuiz(ctx context.Context, userID int, input QuizInput) (*ent.Quiz, error) {
+	return s.createQuiz(ctx, userID, input, nil)
+}
+
+// GeneratePracticeSet builds a practice set from the text of a note and its resources
+// using the configured question generator, and links it to the note
+func (s *QuizService) GeneratePracticeSet(ctx context.Context, noteID, userID int) (*ent.Quiz, error) {
+	n, err := s.orm.Note.Query().
+		Where(
+			note.ID(noteID),
+			noteVisibleTo(&userID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("note not found or access denied")
+		}
+		return nil, fmt.Errorf("failed to fetch note: %w", err)
+	}
+
+	text := NoteStudyText(n)
+	if strings.TrimSpace(text) == "" {
+		return nil, fmt.Errorf("note has no text to generate questions from")
+	}
+
+	questions, err := s.generator.Generate(ctx, text, maxPracticeQuestions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate questions: %w", err)
+	}
+	if len(questions) == 0 {
+		return nil, fmt.Errorf("no questions could be generated from this note")
+	}
+
+	input := QuizInput{
+		Title:       "Practice: " + n.Title,
+		Description: fmt.Sprintf("Practice set generated from the note \"%s\".", n.Title),
+		Visibility:  string(quiz.VisibilityPrivate),
+		Questions:   questions,
+	}
+
+	return s.createQuiz(ctx, userID, input, func(qc *ent.QuizCreate) {
+		qc.SetNoteID(noteID).SetGenerator(s.generator.Name())
+	})
+}
+
+// ListPracticeSets lists the practice sets the user has generated from a note, most recent first
+func (s *QuizService) ListPracticeSets(ctx context.Context, noteID, userID int) ([]*ent.Quiz, error) {
+	quizzes, err := s.orm.Quiz.Query().
+		Where(
+			quiz.HasNoteWith(note.ID(noteID)),
+			quiz.HasOwnerWith(user.ID(userID)),
+		).
+		WithQuestions().
+		Order(ent.Desc(quiz.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch practice sets: %w", err)
+	}
+
+	return quizzes, nil
+}
+
+// NoteStudyText combines a note's content with the text extracted from its resources
+func NoteStudyText(n *ent.Note) string {
+	parts := []string{n.Content}
+	for _, r := range n.Resources {
+		if r.ExtractedText != "" {
+			parts = append(parts, r.ExtractedText)
+		}
+	}
+	return strings.TrimSpace(strings.Join(parts, "\n\n"))
+}
+
+// createQuiz creates a quiz with its questions, applying any extra settings to the quiz before saving
+func (s *QuizService) createQuiz(ctx context.Context, userID int, input QuizInput, apply func(*ent.QuizCreate)) (*ent.Quiz, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.orm.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	create := tx.Quiz.Create().
+		SetTitle(strings.TrimSpace(input.Title)).
+		SetDescription(strings.TrimSpace(input.Description)).
+		SetVisibility(quizVisibility(input.Visibility)).
+		SetTimeLimit(input.TimeLimit).
+		SetMaxAttempts(input.MaxAttempts).
+		SetOwnerID(userID)
+	if apply != nil {
+		apply(create)
+	}
+
+	q, err := create.Save(ctx)
+	if err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to create quiz: %w", err))
+	}
+
+	if err := createQuestions(ctx, tx, q.ID, 0, input.Questions); err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit quiz: %w", err)
+	}
+
+	return q, nil
+}
+
+// UpdateQuiz updates a quiz owned by the user. Questions and choices are updated in place by
+// position, so attempts in progress keep the answers they've saved.
+func (s *QuizService) UpdateQuiz(ctx context.Context, quizID, userID int, input QuizInput) (*ent.Quiz, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkOwner(ctx, quizID, userID); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.orm.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	q, err := tx.Quiz.UpdateOneID(quizID).
+		SetTitle(strings.TrimSpace(input.Title)).
+		SetDescription(strings.TrimSpace(input.Description)).
+		SetVisibility(quizVisibility(input.Visibility)).
+		SetTimeLimit(input.TimeLimit).
+		SetMaxAttempts(input.MaxAttempts).
+		Save(ctx)
+	if err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to update quiz: %w", err))
+	}
+
+	if err := updateQuestions(ctx, tx, quizID, input.Questions); err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit quiz: %w", err)
+	}
+
+	return q, nil
+}
+
+// DeleteQuiz deletes a quiz along with its questions and attempts (only by owner)
+func (s *QuizService) DeleteQuiz(ctx context.Context, quizID, userID int) error {
+	if err := s.checkOwner(ctx, quizID, userID); err != nil {
+		return err
+	}
+
+	tx, err := s.orm.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	if _, err = tx.Choice.Delete().
+		Where(choice.HasQuestionWith(question.HasQuizWith(quiz.ID(quizID)))).
+		Exec(ctx); err != nil {
+		return rollback(tx, fmt.Errorf("failed to delete choices: %w", err))
+	}
+	if _, err = tx.Question.Delete().
+		Where(question.HasQuizWith(quiz.ID(quizID))).
+		Exec(ctx); err != nil {
+		return rollback(tx, fmt.Errorf("failed to delete questions: %w", err))
+	}
+	if _, err = tx.QuizAttempt.Delete().
+		Where(quizattempt.HasQuizWith(quiz.ID(quizID))).
+		Exec(ctx); err != nil {
+		return rollback(tx, fmt.Errorf("failed to delete attempts: %w", err))
+	}
+	if err = tx.Quiz.DeleteOneID(quizID).Exec(ctx); err != nil {
+		return rollback(tx, fmt.Errorf("failed to delete quiz: %w", err))
+	}
+
+	return tx.Commit()
+}
+
+// GetQuiz retrieves a quiz with its ordered questions and choices, with permission checking
+func (s *QuizService) GetQuiz(ctx context.Context, quizID int, userID *int) (*ent.Quiz, error) {
+	quizQuery := s.orm.Quiz.Query().Where(quiz.ID(quizID))
+
+	// Allow if user owns the quiz or if it's public
+	if userID == nil {
+		quizQuery = quizQuery.Where(quiz.VisibilityEQ(quiz.VisibilityPublic))
+	} else {
+		quizQuery = quizQuery.Where(
+			quiz.Or(
+				quiz.HasOwnerWith(user.ID(*userID)),
+				quiz.VisibilityEQ(quiz.VisibilityPublic),
+			),
+		)
+	}
+
+	q, err := quizQuery.
+		WithOwner().
+		WithNote().
+		WithQuestions(func(qq *ent.QuestionQuery) {
+			qq.Order(ent.Asc(question.FieldPosition)).
+				WithChoices(func(cq *ent.ChoiceQuery) {
+					cq.Order(ent.Asc(choice.FieldPosition))
+				})
+		}).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrQuizNotFound
+		}
+		return nil, fmt.Errorf("failed to fetch quiz: %w", err)
+	}
+
+	return q, nil
+}
+
+// CanPractice reports whether a user can open a quiz in practice mode, which reveals its answers.
+// Generated practice sets can be practiced by anyone who can see them, but other quizzes may be
+// assessments, so only their owner can see the answers. The quiz must have its owner loaded.
+func CanPractice(q *ent.Quiz, userID int) bool {
+	if q.Generator != "" {
+		return true
+	}
+	return q.Edges.Owner != nil && q.Edges.Owner.ID == userID
+}
+
+// CanReviewAnswers reports whether a user who has made the given number of attempts at a quiz can
+// see its answers and explanations with their results. Those who can practice the quiz can always
+// see them, and others only once they have no attempts left, so the answers can't be used on a
+// later attempt. The quiz must have its owner loaded.
+func CanReviewAnswers(q *ent.Quiz, userID, attempts int) bool {
+	return CanPractice(q, userID) || (q.MaxAttempts > 0 && attempts >= q.MaxAttempts)
+}
+
+// ListQuizzes lists the user's own quizzes followed by public quizzes from others
+func (s *QuizService) ListQuizzes(ctx context.Context, userID int, limit, offset int) ([]*ent.Quiz, error) {
+	quizzes, err := s.orm.Quiz.Query().
+		Where(
+			quiz.Or(
+				quiz.HasOwnerWith(user.ID(userID)),
+				quiz.VisibilityEQ(quiz.VisibilityPublic),
+			),
+		).
+		WithOwner().
+		WithQuestions().
+		Order(ent.Desc(quiz.FieldUpdatedAt)).
+		Limit(limit).
+		Offset(offset).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch quizzes: %w", err)
+	}
+
+	return quizzes, nil
+}
+
+// StartAttempt returns the user's attempt in progress for a quiz, or starts a new one if the attempt limit allows.
+// Attempts that have run past their deadline are closed first. The returned flag reports whether a new attempt
+// was started, so the caller can schedule closing it at its deadline.
+func (s *QuizService) StartAttempt(ctx context.Context, quizID, userID int) (*ent.QuizAttempt, bool, error) {
+	q, err := s.GetQuiz(ctx, quizID, &userID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	current, err := s.orm.QuizAttempt.Query().
+		Where(
+			quizattempt.HasQuizWith(quiz.ID(quizID)),
+			quizattempt.HasUserWith(user.ID(userID)),
+			quizattempt.StatusEQ(quizattempt.StatusInProgress),
+		).
+		Order(ent.Desc(quizattempt.FieldStartedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to fetch attempts: %w", err)
+	}
+
+	for _, a := range current {
+		if !attemptExpired(a, time.Now()) {
+			return a, false, nil
+		}
+		if _, err := s.closeAttempt(ctx, a, q.Edges.Questions); err != nil {
+			return nil, false, err
+		}
+	}
+
+	if q.MaxAttempts > 0 {
+		count, err := s.orm.QuizAttempt.Query().
+			Where(
+				quizattempt.HasQuizWith(quiz.ID(quizID)),
+				quizattempt.HasUserWith(user.ID(userID)),
+			).
+			Count(ctx)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to count attempts: %w", err)
+		}
+		if count >= q.MaxAttempts {
+			return nil, false, ErrAttemptLimitReached
+		}
+	}
+
+	create := s.orm.QuizAttempt.Create().
+		SetQuizID(quizID).
+		SetUserID(userID).
+		SetAnswers(map[int]string{})
+	if q.TimeLimit > 0 {
+		create.SetDeadline(time.Now().Add(time.Duration(q.TimeLimit) * time.Minute))
+	}
+
+	attempt, err := create.Save(ctx)
+	if ent.IsConstraintError(err) {
+		// The attempt was started at the same time by another request, so that one is returned
+		attempt, err = s.orm.QuizAttempt.Query().
+			Where(
+				quizattempt.HasQuizWith(quiz.ID(quizID)),
+				quizattempt.HasUserWith(user.ID(userID)),
+				quizattempt.StatusEQ(quizattempt.StatusInProgress),
+			).
+			Only(ctx)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to fetch attempt: %w", err)
+		}
+		return attempt, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to start attempt: %w", err)
+	}
+
+	return attempt, true, nil
+}
+
+// SaveAnswers stores answers on an attempt in progress without grading it, merging them with those already saved
+func (s *QuizService) SaveAnswers(ctx context.Context, attemptID, userID int, answers map[int]string) error {
+	attempt, err := s.openAttempt(ctx, attemptID, userID)
+	if err != nil {
+		return err
+	}
+
+	saved := make(map[int]string, len(attempt.Answers)+len(answers))
+	for id, answer := range attempt.Answers {
+		saved[id] = answer
+	}
+	for id, answer := range answers {
+		saved[id] = answer
+	}
+
+	if err := s.orm.QuizAttempt.UpdateOne(attempt).SetAnswers(saved).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to save answers: %w", err)
+	}
+
+	return nil
+}
+
+// SubmitAttempt grades the answers for an attempt in progress and records the result.
+// Submissions after the deadline are rejected and the attempt is closed using the answers saved in time.
+func (s *QuizService) SubmitAttempt(ctx context.Context, attemptID, userID int, answers map[int]string) (*ent.QuizAttempt, error) {
+	attempt, err := s.openAttempt(ctx, attemptID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	q, err := s.GetQuiz(ctx, attempt.Edges.Quiz.ID, &userID)
+	if err != nil {
+		return nil, err
+	}
+
+	score, maxScore := GradeQuiz(q.Edges.Questions, answers)
+
+	// Only update the attempt if it is still in progress, in case it was closed concurrently
+	n, err := s.orm.QuizAttempt.Update().
+		Where(
+			quizattempt.ID(attempt.ID),
+			quizattempt.StatusEQ(quizattempt.StatusInProgress),
+		).
+		SetAnswers(answers).
+		SetScore(score).
+		SetMaxScore(maxScore).
+		SetSubmittedAt(time.Now()).
+		SetStatus(quizattempt.StatusSubmitted).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save attempt: %w", err)
+	}
+	if n == 0 {
+		return nil, ErrAttemptClosed
+	}
+
+	s.recordAttempt(ctx, attempt.ID)
+
+	return s.orm.QuizAttempt.Get(ctx, attempt.ID)
+}
+
+// CloseExpiredAttempt grades and closes an attempt that is still in progress after its deadline.
+// It returns false if the attempt was already closed or has not expired yet.
+func (s *QuizService) CloseExpiredAttempt(ctx context.Context, attemptID int) (bool, error) {
+	attempt, err := s.orm.QuizAttempt.Query().
+		Where(quizattempt.ID(attemptID)).
+		WithQuiz(func(qq *ent.QuizQuery) {
+			qq.WithQuestions(func(qq *ent.QuestionQuery) {
+				qq.WithChoices()
+			})
+		}).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to fetch attempt: %w", err)
+	}
+
+	if attempt.Status != quizattempt.StatusInProgress || !attemptExpired(attempt, time.Now()) {
+		return false, nil
+	}
+
+	return s.closeAttempt(ctx, attempt, attempt.Edges.Quiz.Edges.Questions)
+}
+
+// openAttempt loads an attempt belonging to the user and verifies that answers can still be accepted.
+// An attempt past its deadline is closed from its saved answers before ErrAttemptExpired is returned.
+func (s *QuizService) openAttempt(ctx context.Context, attemptID, userID int) (*ent.QuizAttempt, error) {
+	attempt, err := s.GetAttempt(ctx, attemptID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if attempt.Status != quizattempt.StatusInProgress {
+		return nil, ErrAttemptClosed
+	}
+
+	if attemptExpired(attempt, time.Now()) {
+		if _, err := s.CloseExpiredAttempt(ctx, attempt.ID); err != nil {
+			return nil, err
+		}
+		return nil, ErrAttemptExpired
+	}
+
+	return attempt, nil
+}
+
+// closeAttempt grades the saved answers of an attempt and marks it as expired
+func (s *QuizService) closeAttempt(ctx context.Context, attempt *ent.QuizAttempt, questions []*ent.Question) (bool, error) {
+	score, maxScore := GradeQuiz(questions, attempt.Answers)
+
+	n, err := s.orm.QuizAttempt.Update().
+		Where(
+			quizattempt.ID(attempt.ID),
+			quizattempt.StatusEQ(quizattempt.StatusInProgress),
+		).
+		SetScore(score).
+		SetMaxScore(maxScore).
+		SetSubmittedAt(*attempt.Deadline).
+		SetStatus(quizattempt.StatusExpired).
+		Save(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to close attempt: %w", err)
+	}
+
+	if n > 0 {
+		s.recordAttempt(ctx, attempt.ID)
+	}
+
+	return n > 0, nil
+}
+
+// recordAttempt records a graded attempt as a study event. Failures are logged rather than
+// returned, since the attempt itself has already been saved.
+func (s *QuizService) recordAttempt(ctx context.Context, attemptID int) {
+	if s.progress == nil {
+		return
+	}
+	if err := s.progress.RecordQuizAttempt(ctx, attemptID); err != nil {
+		log.Default().Error("failed to record quiz attempt", "attempt_id", attemptID, "error", err)
+	}
+}
+
+// attemptExpired reports whether an attempt's deadline, plus a grace period for network latency, has passed
+func attemptExpired(attempt *ent.QuizAttempt, now time.Time) bool {
+	return attempt.Deadline != nil && now.After(attempt.Deadline.Add(AttemptGracePeriod))
+}
+
+// GetAttempt retrieves an attempt belonging to the user along with its quiz
+func (s *QuizService) GetAttempt(ctx context.Context, attemptID, userID int) (*ent.QuizAttempt, error) {
+	attempt, err := s.orm.QuizAttempt.Query().
+		Where(
+			quizattempt.ID(attemptID),
+			quizattempt.HasUserWith(user.ID(userID)),
+		).
+		WithQuiz().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("attempt not found")
+		}
+		return nil, fmt.Errorf("failed to fetch attempt: %w", err)
+	}
+
+	return attempt, nil
+}
+
+// ListAttempts lists the user's attempts for a quiz, most recent first
+func (s *QuizService) ListAttempts(ctx context.Context, quizID, userID int) ([]*ent.QuizAttempt, error) {
+	attempts, err := s.orm.QuizAttempt.Query().
+		Where(
+			quizattempt.HasQuizWith(quiz.ID(quizID)),
+			quizattempt.HasUserWith(user.ID(userID)),
+		).
+		Order(ent.Desc(quizattempt.FieldStartedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch attempts: %w", err)
+	}
+
+	return attempts, nil
+}
+
+// GradeQuiz grades a set of answers against the given questions and returns the score and the maximum score
+func GradeQuiz(questions []*ent.Question, answers map[int]string) (score, maxScore int) {
+	for _, r := range GradeQuestions(questions, answers) {
+		score += r.Points
+		maxScore += r.Question.Points
+	}
+	return score, maxScore
+}
+
+// GradeQuestions grades each question individually
+func GradeQuestions(questions []*ent.Question, answers map[int]string) []QuestionResult {
+	results := make([]QuestionResult, len(questions))
+	for i, q := range questions {
+		answer := answers[q.ID]
+		correct := GradeAnswer(q, answer)
+		results[i] = QuestionResult{
+			Question: q,
+			Answer:   answer,
+			Correct:  correct,
+		}
+		if correct {
+			results[i].Points = q.Points
+		}
+	}
+	return results
+}
+
+// GradeAnswer determines if an answer to a question is correct.
+// Multiple choice and true/false answers are the ID of the selected choice, which requires
+// the question's choices to be loaded. Short answers are compared case- and whitespace-insensitively.
+func GradeAnswer(q *ent.Question, answer string) bool {
+	answer = strings.TrimSpace(answer)
+	if answer == "" {
+		return false
+	}
+
+	switch q.Type {
+	case question.TypeMultipleChoice, question.TypeTrueFalse:
+		choiceID, err := strconv.Atoi(answer)
+		if err != nil {
+			return false
+		}
+		for _, c := range q.Edges.Choices {
+			if c.ID == choiceID {
+				return c.Correct
+			}
+		}
+		return false
+	case question.TypeShortAnswer:
+		given := normalizeAnswer(answer)
+		for _, accepted := range q.AcceptedAnswers {
+			if normalizeAnswer(accepted) == given {
+				return true
+			}
+		}
+		return false
+	default:
+		return false
+	}
+}
+
+// checkOwner verifies that the quiz exists and is owned by the user
+func (s *QuizService) checkOwner(ctx context.Context, quizID, userID int) error {
+	exists, err := s.orm.Quiz.Query().
+		Where(quiz.ID(quizID), quiz.HasOwnerWith(user.ID(userID))).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check quiz ownership: %w", err)
+	}
+	if !exists {
+		return ErrQuizNotFound
+	}
+	return nil
+}
+
+// createQuestions creates the questions and choices for a quiz within a transaction, numbering them from start
+func createQuestions(ctx context.Context, tx *ent.Tx, quizID, start int, questions []QuestionInput) error {
+	for i, input := range questions {
+		i += start
+		builder := tx.Question.Create().
+			SetQuizID(quizID).
+			SetType(question.Type(input.Type)).
+			SetPrompt(strings.TrimSpace(input.Prompt)).
+			SetExplanation(strings.TrimSpace(input.Explanation)).
+			SetPoints(questionPoints(input)).
+			SetPosition(i)
+
+		if input.Type == QuestionTypeShortAnswer {
+			builder = builder.SetAcceptedAnswers(input.AcceptedAnswers)
+		}
+
+		q, err := builder.Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create question %d: %w", i+1, err)
+		}
+
+		if err := saveChoices(ctx, tx, i, q.ID, nil, input); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// updateQuestions updates a quiz's questions to match the input. The question and choice at each
+// position keep their IDs, which saved answers refer to, and any left over are deleted.
+func updateQuestions(ctx context.Context, tx *ent.Tx, quizID int, questions []QuestionInput) error {
+	existing, err := tx.Question.Query().
+		Where(question.HasQuizWith(quiz.ID(quizID))).
+		WithChoices(func(q *ent.ChoiceQuery) {
+			q.Order(ent.Asc(choice.FieldPosition), ent.Asc(choice.FieldID))
+		}).
+		Order(ent.Asc(question.FieldPosition), ent.Asc(question.FieldID)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch questions: %w", err)
+	}
+
+	if len(questions) > len(existing) {
+		if err := createQuestions(ctx, tx, quizID, len(existing), questions[len(existing):]); err != nil {
+			return err
+		}
+	}
+
+	var removed []int
+	for i, q := range existing {
+		if i >= len(questions) {
+			removed = append(removed, q.ID)
+			continue
+		}
+
+		input := questions[i]
+		update := tx.Question.UpdateOne(q).
+			SetType(question.Type(input.Type)).
+			SetPrompt(strings.TrimSpace(input.Prompt)).
+			SetExplanation(strings.TrimSpace(input.Explanation)).
+			SetPoints(questionPoints(input)).
+			SetPosition(i)
+		if input.Type == QuestionTypeShortAnswer {
+			update.SetAcceptedAnswers(input.AcceptedAnswers)
+		} else {
+			update.ClearAcceptedAnswers()
+		}
+		if err := update.Exec(ctx); err != nil {
+			return fmt.Errorf("failed to update question %d: %w", i+1, err)
+		}
+
+		if err := saveChoices(ctx, tx, i, q.ID, q.Edges.Choices, input); err != nil {
+			return err
+		}
+	}
+
+	if len(removed) > 0 {
+		if _, err := tx.Choice.Delete().
+			Where(choice.HasQuestionWith(question.IDIn(removed...))).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("failed to delete choices: %w", err)
+		}
+		if _, err := tx.Question.Delete().
+			Where(question.IDIn(removed...)).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("failed to delete questions: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// saveChoices updates a question's existing choices in place to match the input, creating and
+// deleting choices as needed. Short answer questions have no choices.
+func saveChoices(ctx context.Context, tx *ent.Tx, i, questionID int, existing []*ent.Choice, input QuestionInput) error {
+	var inputs []ChoiceInput
+	if input.Type != QuestionTypeShortAnswer {
+		inputs = input.Choices
+	}
+
+	var create []*ent.ChoiceCreate
+	for j, c := range inputs {
+		if j < len(existing) {
+			if err := tx.Choice.UpdateOne(existing[j]).
+				SetText(strings.TrimSpace(c.Text)).
+				SetCorrect(c.Correct).
+				SetPosition(j).
+				Exec(ctx); err != nil {
+				return fmt.Errorf("failed to update choices for question %d: %w", i+1, err)
+			}
+			continue
+		}
+		create = append(create, tx.Choice.Create().
+			SetQuestionID(questionID).
+			SetText(strings.TrimSpace(c.Text)).
+			SetCorrect(c.Correct).
+			SetPosition(j))
+	}
+	if len(create) > 0 {
+		if _, err := tx.Choice.CreateBulk(create...).Save(ctx); err != nil {
+			return fmt.Errorf("failed to create choices for question %d: %w", i+1, err)
+		}
+	}
+
+	if len(existing) > len(inputs) {
+		var removed []int
+		for _, c := range existing[len(inputs):] {
+			removed = append(removed, c.ID)
+		}
+		if _, err := tx.Choice.Delete().Where(choice.IDIn(removed...)).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to delete choices for question %d: %w", i+1, err)
+		}
+	}
+
+	return nil
+}
+
+// questionPoints returns the points a question is worth, defaulting to one
+func questionPoints(input QuestionInput) int {
+	if input.Points <= 0 {
+		return 1
+	}
+	return input.Points
+}
+
+// quizVisibility converts a visibility string, defaulting to private
+func quizVisibility(v string) quiz.Visibility {
+	if v == string(quiz.VisibilityPublic) {
+		return quiz.VisibilityPublic
+	}
+	return quiz.VisibilityPrivate
+}
+
+// normalizeAnswer lowercases an answer and collapses its whitespace for comparison
+func normalizeAnswer(s string) string {
+	return strings.Join(strings.Fields(strings.ToLower(s)), " ")
+}
+
+// rollback rolls back a transaction and returns the original error
+func rollback(tx *ent.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		return fmt.Errorf("%w: rollback failed: %v", err, rerr)
+	}
+	return err
+}
@@ -0,0 +1,278 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/r-scheele/zero/config"
+)
+
+const (
+	// clozeBlank replaces the removed term in a cloze question
+	clozeBlank = "_____"
+
+	// maxGeneratorInput caps the amount of text sent to an AI provider
+	maxGeneratorInput = 12000
+)
+
+// truncateText cuts text to at most max bytes without splitting a multi-byte rune
+func truncateText(text string, max int) string {
+	if len(text) <= max {
+		return text
+	}
+	for max > 0 && !utf8.RuneStart(text[max]) {
+		max--
+	}
+	return text[:max]
+}
+
+// QuestionGenerator builds quiz questions from a body of text
+type QuestionGenerator interface {
+	// Name returns the name of the generator, which is recorded on generated quizzes
+	Name() string
+
+	// Generate builds at most limit questions from the text
+	Generate(ctx context.Context, text string, limit int) ([]QuestionInput, error)
+}
+
+// NewQuestionGenerator returns the AI generator when a provider is enabled, or the offline cloze generator otherwise
+func NewQuestionGenerator(cfg *config.Config) QuestionGenerator {
+	if provider := NewAIProvider(cfg.AI); provider != nil {
+		return NewAIQuestionGenerator(provider)
+	}
+	return NewClozeGenerator()
+}
+
+// ClozeGenerator is a deterministic, offline generator which blanks out a key term
+// from the most informative sentences of the text
+type ClozeGenerator struct{}
+
+// NewClozeGenerator creates a new cloze generator
+func NewClozeGenerator() *ClozeGenerator {
+	return &ClozeGenerator{}
+}
+
+// Name returns the name of the generator
+func (g *ClozeGenerator) Name() string {
+	return "cloze"
+}
+
+// clozeCandidate is a sentence with the term selected to be blanked out
+type clozeCandidate struct {
+	position int
+	sentence string
+	term     string
+	score    int
+}
+
+var (
+	// sentenceBoundary splits text into sentences on terminal punctuation or line breaks
+	sentenceBoundary = regexp.MustCompile(`[.!?]+(\s+|$)|\n+`)
+
+	// clozeStopWords are common words that never make good blanks
+	clozeStopWords = map[string]bool{
+		"about": true, "above": true, "after": true, "again": true, "also": true, "although": true,
+		"because": true, "been": true, "before": true, "being": true, "below": true, "between": true,
+		"both": true, "could": true, "does": true, "doing": true, "down": true, "during": true,
+		"each": true, "either": true, "every": true, "from": true, "further": true, "have": true,
+		"having": true, "here": true, "however": true, "into": true, "itself": true, "just": true,
+		"many": true, "more": true, "most": true, "much": true, "must": true, "neither": true,
+		"only": true, "other": true, "over": true, "same": true, "should": true, "some": true,
+		"such": true, "than": true, "that": true, "their": true, "them": true, "then": true,
+		"there": true, "these": true, "they": true, "this": true, "those": true, "through": true,
+		"under": true, "until": true, "upon": true, "very": true, "were": true, "what": true,
+		"when": true, "where": true, "which": true, "while": true, "with": true, "within": true,
+		"without": true, "would": true, "your": true, "will": true, "shall": true, "like": true,
+	}
+)
+
+// Generate builds cloze questions from the highest scoring sentences, returned in the order they appear
+func (g *ClozeGenerator) Generate(ctx context.Context, text string, limit int) ([]QuestionInput, error) {
+	var candidates []clozeCandidate
+	seen := make(map[string]bool)
+
+	for i, raw := range sentenceBoundary.Split(text, -1) {
+		sentence := strings.Join(strings.Fields(raw), " ")
+		words := strings.Fields(sentence)
+		if len(words) < 6 || len(words) > 40 {
+			continue
+		}
+
+		term, score := clozeTerm(words)
+		if term == "" || seen[strings.ToLower(term)] {
+			continue
+		}
+		seen[strings.ToLower(term)] = true
+
+		candidates = append(candidates, clozeCandidate{
+			position: i,
+			sentence: sentence,
+			term:     term,
+			score:    score + min(len(words), 20)/5,
+		})
+	}
+
+	// Keep the best sentences, then restore reading order
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].score > candidates[j].score
+	})
+	if limit > 0 && len(candidates) > limit {
+		candidates = candidates[:limit]
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].position < candidates[j].position
+	})
+
+	questions := make([]QuestionInput, 0, len(candidates))
+	for _, c := range candidates {
+		questions = append(questions, QuestionInput{
+			Type:            QuestionTypeShortAnswer,
+			Prompt:          "Fill in the blank: " + clozeReplace(c.sentence, c.term),
+			AcceptedAnswers: []string{c.term},
+			Explanation:     c.sentence,
+		})
+	}
+
+	return questions, nil
+}
+
+// clozeTerm picks the most informative word of a sentence and returns it with its score.
+// Numbers and capitalized words that don't start the sentence are preferred, then longer words.
+func clozeTerm(words []string) (string, int) {
+	var best string
+	bestScore := 0
+
+	for i, w := range words {
+		w = strings.TrimFunc(w, func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		})
+		if w == "" || clozeStopWords[strings.ToLower(w)] {
+			continue
+		}
+
+		runes := []rune(w)
+		score := 0
+		switch {
+		case isNumeric(w):
+			score = 6
+		case len(runes) < 4:
+			continue
+		case i > 0 && unicode.IsUpper(runes[0]):
+			score = 4 + min(len(runes), 12)/4
+		default:
+			score = min(len(runes), 12) / 3
+		}
+
+		if score > bestScore {
+			best = w
+			bestScore = score
+		}
+	}
+
+	return best, bestScore
+}
+
+// clozeReplace blanks out the first whole-word occurrence of term in the sentence
+func clozeReplace(sentence, term string) string {
+	re := regexp.MustCompile(`\b` + regexp.QuoteMeta(term) + `\b`)
+	replaced := false
+	return re.ReplaceAllStringFunc(sentence, func(s string) string {
+		if replaced {
+			return s
+		}
+		replaced = true
+		return clozeBlank
+	})
+}
+
+// isNumeric reports whether the word consists only of digits
+func isNumeric(s string) bool {
+	for _, r := range s {
+		if !unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// AIQuestionGenerator generates question and answer pairs using an AI provider
+type AIQuestionGenerator struct {
+	provider AIProvider
+}
+
+// NewAIQuestionGenerator creates a new AI question generator
+func NewAIQuestionGenerator(provider AIProvider) *AIQuestionGenerator {
+	return &AIQuestionGenerator{
+		provider: provider,
+	}
+}
+
+// Name returns the name of the generator
+func (g *AIQuestionGenerator) Name() string {
+	return g.provider.Name()
+}
+
+// Generate asks the provider for question and answer pairs as JSON and converts them to short answer questions
+func (g *AIQuestionGenerator) Generate(ctx context.Context, text string, limit int) ([]QuestionInput, error) {
+	text = truncateText(text, maxGeneratorInput)
+
+	system := "You write study questions. Reply only with a JSON array of objects with \"question\" and \"answer\" keys. " +
+		"Answers must be short: a word, a number or a brief phrase."
+	prompt := fmt.Sprintf("Write up to %d questions that check understanding of the following notes.\n\n%s", limit, text)
+
+	reply, err := g.provider.Complete(ctx, system, prompt)
+	if err != nil {
+		return nil, err
+	}
+
+	pairs, err := parseQuestionPairs(reply)
+	if err != nil {
+		return nil, err
+	}
+
+	questions := make([]QuestionInput, 0, len(pairs))
+	for _, p := range pairs {
+		if strings.TrimSpace(p.Question) == "" || strings.TrimSpace(p.Answer) == "" {
+			continue
+		}
+		questions = append(questions, QuestionInput{
+			Type:            QuestionTypeShortAnswer,
+			Prompt:          strings.TrimSpace(p.Question),
+			AcceptedAnswers: []string{strings.TrimSpace(p.Answer)},
+		})
+		if limit > 0 && len(questions) == limit {
+			break
+		}
+	}
+
+	return questions, nil
+}
+
+// questionPair is a single question and answer returned by an AI provider
+type questionPair struct {
+	Question string `json:"question"`
+	Answer   string `json:"answer"`
+}
+
+// parseQuestionPairs extracts the JSON array of question pairs from a model reply,
+// ignoring any surrounding prose or code fences
+func parseQuestionPairs(reply string) ([]questionPair, error) {
+	start := strings.Index(reply, "[")
+	end := strings.LastIndex(reply, "]")
+	if start == -1 || end < start {
+		return nil, fmt.Errorf("no question list found in AI response")
+	}
+
+	var pairs []questionPair
+	if err := json.Unmarshal([]byte(reply[start:end+1]), &pairs); err != nil {
+		return nil, fmt.Errorf("failed to parse AI response: %w", err)
+	}
+
+	return pairs, nil
+}
@@ -0,0 +1,102 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testStudyText = `The mitochondria is the powerhouse of the cell and produces most of its energy.
+Photosynthesis takes place in the chloroplasts of plant cells using sunlight.
+The human heart has 4 chambers that pump blood through the body.
+Short line.
+Water boils at 100 degrees Celsius at sea level under normal pressure.`
+
+type stubAIProvider struct {
+	reply string
+	err   error
+}
+
+func (p *stubAIProvider) Name() string {
+	return "stub"
+}
+
+func (p *stubAIProvider) Complete(ctx context.Context, system, prompt string) (string, error) {
+	return p.reply, p.err
+}
+
+func TestClozeGenerator_Generate(t *testing.T) {
+	g := NewClozeGenerator()
+
+	questions, err := g.Generate(context.Background(), testStudyText, 10)
+	require.NoError(t, err)
+	require.Len(t, questions, 4)
+
+	for _, q := range questions {
+		assert.Equal(t, QuestionTypeShortAnswer, q.Type)
+		assert.Contains(t, q.Prompt, clozeBlank)
+		require.Len(t, q.AcceptedAnswers, 1)
+		assert.NotContains(t, strings.TrimPrefix(q.Prompt, "Fill in the blank: "), q.AcceptedAnswers[0]+" ")
+		assert.Contains(t, q.Explanation, q.AcceptedAnswers[0])
+	}
+
+	// Numbers are preferred as blanks, and questions keep the order of the text.
+	assert.Equal(t, "4", questions[2].AcceptedAnswers[0])
+	assert.Equal(t, "100", questions[3].AcceptedAnswers[0])
+
+	// The output is deterministic.
+	again, err := g.Generate(context.Background(), testStudyText, 10)
+	require.NoError(t, err)
+	assert.Equal(t, questions, again)
+
+	// The limit keeps the highest scoring sentences.
+	limited, err := g.Generate(context.Background(), testStudyText, 2)
+	require.NoError(t, err)
+	require.Len(t, limited, 2)
+	assert.Equal(t, "4", limited[0].AcceptedAnswers[0])
+	assert.Equal(t, "100", limited[1].AcceptedAnswers[0])
+
+	// The questions can be saved as a quiz.
+	input := QuizInput{Title: "Practice", Questions: questions}
+	assert.NoError(t, input.Validate())
+}
+
+func TestAIQuestionGenerator_Generate(t *testing.T) {
+	provider := &stubAIProvider{
+		reply: "Here you go:\n```json\n" +
+			`[{"question": "What is the powerhouse of the cell?", "answer": "Mitochondria"},` +
+			`{"question": "", "answer": "skipped"},` +
+			`{"question": "How many chambers does the heart have?", "answer": "4"}]` +
+			"\n```",
+	}
+	g := NewAIQuestionGenerator(provider)
+	assert.Equal(t, "stub", g.Name())
+
+	questions, err := g.Generate(context.Background(), testStudyText, 10)
+	require.NoError(t, err)
+	require.Len(t, questions, 2)
+	assert.Equal(t, "What is the powerhouse of the cell?", questions[0].Prompt)
+	assert.Equal(t, []string{"Mitochondria"}, questions[0].AcceptedAnswers)
+
+	questions, err = g.Generate(context.Background(), testStudyText, 1)
+	require.NoError(t, err)
+	assert.Len(t, questions, 1)
+
+	provider.reply = "I can't help with that."
+	_, err = g.Generate(context.Background(), testStudyText, 10)
+	assert.Error(t, err)
+
+	provider.err = errors.New("unavailable")
+	_, err = g.Generate(context.Background(), testStudyText, 10)
+	assert.Error(t, err)
+}
+
+func TestTruncateText(t *testing.T) {
+	assert.Equal(t, "short", truncateText("short", 10))
+	assert.Equal(t, "caf", truncateText("café", 4))
+	assert.Equal(t, "café", truncateText("café", 5))
+}
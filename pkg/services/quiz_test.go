@@ -0,0 +1,394 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/r-scheele/zero/ent"
+	"github.com/r-scheele/zero/ent/question"
+	"github.com/r-scheele/zero/ent/quizattempt"
+	"github.com/r-scheele/zero/pkg/tests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testQuizInput() QuizInput {
+	return QuizInput{
+		Title:      "Geography",
+		Visibility: "private",
+		Questions: []QuestionInput{
+			{
+				Type:   QuestionTypeMultipleChoice,
+				Prompt: "What is the capital of France?",
+				Choices: []ChoiceInput{
+					{Text: "Berlin"},
+					{Text: "Paris", Correct: true},
+					{Text: "Madrid"},
+				},
+			},
+			{
+				Type:    QuestionTypeTrueFalse,
+				Prompt:  "The Nile is in Africa.",
+				Choices: TrueFalseChoices(true),
+			},
+			{
+				Type:            QuestionTypeShortAnswer,
+				Prompt:          "Largest ocean?",
+				AcceptedAnswers: []string{"Pacific", "Pacific Ocean"},
+				Points:          2,
+			},
+		},
+	}
+}
+
+func TestQuizInput_Validate(t *testing.T) {
+	assert.NoError(t, testQuizInput().Validate())
+
+	input := testQuizInput()
+	input.Title = " "
+	assert.Error(t, input.Validate())
+
+	input = testQuizInput()
+	input.Questions = nil
+	assert.Error(t, input.Validate())
+
+	input = testQuizInput()
+	input.Questions[0].Choices[0].Correct = true
+	assert.Error(t, input.Validate())
+
+	input = testQuizInput()
+	input.Questions[1].Choices = input.Questions[1].Choices[:1]
+	assert.Error(t, input.Validate())
+
+	input = testQuizInput()
+	input.Questions[2].AcceptedAnswers = nil
+	assert.Error(t, input.Validate())
+
+	input = testQuizInput()
+	input.Questions[0].Type = "essay"
+	assert.Error(t, input.Validate())
+}
+
+func TestGradeAnswer(t *testing.T) {
+	q := &ent.Question{
+		Type: question.TypeMultipleChoice,
+		Edges: ent.QuestionEdges{
+			Choices: []*ent.Choice{
+				{ID: 1, Text: "A"},
+				{ID: 2, Text: "B", Correct: true},
+			},
+		},
+	}
+	assert.True(t, GradeAnswer(q, "2"))
+	assert.False(t, GradeAnswer(q, "1"))
+	assert.False(t, GradeAnswer(q, "3"))
+	assert.False(t, GradeAnswer(q, "B"))
+	assert.False(t, GradeAnswer(q, ""))
+
+	q = &ent.Question{
+		Type:            question.TypeShortAnswer,
+		AcceptedAnswers: []string{"Pacific Ocean"},
+	}
+	assert.True(t, GradeAnswer(q, "pacific   ocean"))
+	assert.True(t, GradeAnswer(q, " PACIFIC OCEAN "))
+	assert.False(t, GradeAnswer(q, "Atlantic"))
+}
+
+func TestCanPractice(t *testing.T) {
+	q := &ent.Quiz{Edges: ent.QuizEdges{Owner: &ent.User{ID: 1}}}
+
+	// Only the owner can see the answers of a quiz they wrote
+	assert.True(t, CanPractice(q, 1))
+	assert.False(t, CanPractice(q, 2))
+
+	// Anyone can practice a generated practice set
+	q.Generator = "local"
+	assert.True(t, CanPractice(q, 2))
+}
+
+func TestCanReviewAnswers(t *testing.T) {
+	q := &ent.Quiz{MaxAttempts: 2, Edges: ent.QuizEdges{Owner: &ent.User{ID: 1}}}
+
+	// Others only see the answers once they've used every attempt
+	assert.True(t, CanReviewAnswers(q, 1, 0))
+	assert.False(t, CanReviewAnswers(q, 2, 1))
+	assert.True(t, CanReviewAnswers(q, 2, 2))
+
+	// Without a limit there's always another attempt to use them on
+	q.MaxAttempts = 0
+	assert.False(t, CanReviewAnswers(q, 2, 5))
+
+	q.Generator = "local"
+	assert.True(t, CanReviewAnswers(q, 2, 1))
+}
+
+func TestQuizService(t *testing.T) {
+	ctxb := context.Background()
+
+	q, err := c.Quiz.CreateQuiz(ctxb, usr.ID, testQuizInput())
+	require.NoError(t, err)
+
+	// The owner can load the quiz with its questions in order.
+	got, err := c.Quiz.GetQuiz(ctxb, q.ID, &usr.ID)
+	require.NoError(t, err)
+	require.Len(t, got.Edges.Questions, 3)
+	assert.Equal(t, question.TypeMultipleChoice, got.Edges.Questions[0].Type)
+	assert.Len(t, got.Edges.Questions[0].Edges.Choices, 3)
+	assert.Equal(t, 2, got.Edges.Questions[2].Points)
+
+	// Private quizzes are hidden from others.
+	other, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	_, err = c.Quiz.GetQuiz(ctxb, q.ID, &other.ID)
+	assert.True(t, errors.Is(err, ErrQuizNotFound))
+	_, err = c.Quiz.GetQuiz(ctxb, q.ID, nil)
+	assert.True(t, errors.Is(err, ErrQuizNotFound))
+
+	// Build a fully correct set of answers.
+	answers := make(map[int]string)
+	for _, qq := range got.Edges.Questions {
+		switch qq.Type {
+		case question.TypeShortAnswer:
+			answers[qq.ID] = "pacific"
+		default:
+			for _, ch := range qq.Edges.Choices {
+				if ch.Correct {
+					answers[qq.ID] = strconv.Itoa(ch.ID)
+				}
+			}
+		}
+	}
+
+	attempt, started, err := c.Quiz.StartAttempt(ctxb, q.ID, usr.ID)
+	require.NoError(t, err)
+	assert.True(t, started)
+	assert.Nil(t, attempt.Deadline)
+
+	attempt, err = c.Quiz.SubmitAttempt(ctxb, attempt.ID, usr.ID, answers)
+	require.NoError(t, err)
+	assert.Equal(t, 4, attempt.Score)
+	assert.Equal(t, 4, attempt.MaxScore)
+	assert.NotNil(t, attempt.SubmittedAt)
+	assert.Equal(t, quizattempt.StatusSubmitted, attempt.Status)
+
+	// A submitted attempt can't be submitted again.
+	_, err = c.Quiz.SubmitAttempt(ctxb, attempt.ID, usr.ID, answers)
+	assert.True(t, errors.Is(err, ErrAttemptClosed))
+
+	// Leaving out the short answer loses its points.
+	delete(answers, got.Edges.Questions[2].ID)
+	attempt, _, err = c.Quiz.StartAttempt(ctxb, q.ID, usr.ID)
+	require.NoError(t, err)
+	attempt, err = c.Quiz.SubmitAttempt(ctxb, attempt.ID, usr.ID, answers)
+	require.NoError(t, err)
+	assert.Equal(t, 2, attempt.Score)
+
+	attempts, err := c.Quiz.ListAttempts(ctxb, q.ID, usr.ID)
+	require.NoError(t, err)
+	assert.Len(t, attempts, 2)
+
+	loaded, err := c.Quiz.GetAttempt(ctxb, attempt.ID, usr.ID)
+	require.NoError(t, err)
+	assert.Equal(t, q.ID, loaded.Edges.Quiz.ID)
+	_, err = c.Quiz.GetAttempt(ctxb, attempt.ID, other.ID)
+	assert.Error(t, err)
+
+	// Only the owner can update or delete.
+	input := testQuizInput()
+	input.Title = "World Geography"
+	input.Visibility = "public"
+	input.Questions = input.Questions[:1]
+	_, err = c.Quiz.UpdateQuiz(ctxb, q.ID, other.ID, input)
+	assert.True(t, errors.Is(err, ErrQuizNotFound))
+
+	_, err = c.Quiz.UpdateQuiz(ctxb, q.ID, usr.ID, input)
+	require.NoError(t, err)
+
+	got, err = c.Quiz.GetQuiz(ctxb, q.ID, &other.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "World Geography", got.Title)
+	assert.Len(t, got.Edges.Questions, 1)
+
+	list, err := c.Quiz.ListQuizzes(ctxb, other.ID, 10, 0)
+	require.NoError(t, err)
+	assert.Len(t, list, 1)
+
+	assert.True(t, errors.Is(c.Quiz.DeleteQuiz(ctxb, q.ID, other.ID), ErrQuizNotFound))
+	require.NoError(t, c.Quiz.DeleteQuiz(ctxb, q.ID, usr.ID))
+
+	_, err = c.Quiz.GetQuiz(ctxb, q.ID, &usr.ID)
+	assert.True(t, errors.Is(err, ErrQuizNotFound))
+}
+
+func TestQuizService_UpdateQuizKeepsAttempts(t *testing.T) {
+	ctxb := context.Background()
+
+	q, err := c.Quiz.CreateQuiz(ctxb, usr.ID, testQuizInput())
+	require.NoError(t, err)
+	got, err := c.Quiz.GetQuiz(ctxb, q.ID, &usr.ID)
+	require.NoError(t, err)
+
+	attempt, _, err := c.Quiz.StartAttempt(ctxb, q.ID, usr.ID)
+	require.NoError(t, err)
+	answers := map[int]string{
+		got.Edges.Questions[0].ID: strconv.Itoa(got.Edges.Questions[0].Edges.Choices[1].ID),
+		got.Edges.Questions[2].ID: "pacific",
+	}
+	require.NoError(t, c.Quiz.SaveAnswers(ctxb, attempt.ID, usr.ID, answers))
+
+	// Editing the quiz while it's being taken keeps the questions and choices answers refer to.
+	input := testQuizInput()
+	input.Questions[0].Prompt = "Which city is the capital of France?"
+	input.Questions[0].Choices = input.Questions[0].Choices[:2]
+	input.Questions = append(input.Questions, QuestionInput{
+		Type:            QuestionTypeShortAnswer,
+		Prompt:          "Longest river?",
+		AcceptedAnswers: []string{"Nile"},
+	})
+	_, err = c.Quiz.UpdateQuiz(ctxb, q.ID, usr.ID, input)
+	require.NoError(t, err)
+
+	updated, err := c.Quiz.GetQuiz(ctxb, q.ID, &usr.ID)
+	require.NoError(t, err)
+	require.Len(t, updated.Edges.Questions, 4)
+	assert.Equal(t, got.Edges.Questions[0].ID, updated.Edges.Questions[0].ID)
+	assert.Equal(t, "Which city is the capital of France?", updated.Edges.Questions[0].Prompt)
+	assert.Len(t, updated.Edges.Questions[0].Edges.Choices, 2)
+	assert.Equal(t, "Longest river?", updated.Edges.Questions[3].Prompt)
+
+	attempt, err = c.Quiz.SubmitAttempt(ctxb, attempt.ID, usr.ID, answers)
+	require.NoError(t, err)
+	assert.Equal(t, 3, attempt.Score)
+	assert.Equal(t, 5, attempt.MaxScore)
+}
+
+func TestQuizService_GeneratePracticeSet(t *testing.T) {
+	ctxb := context.Background()
+
+	n, err := c.ORM.Note.Create().
+		SetTitle("Biology").
+		SetContent(testStudyText).
+		SetOwner(usr).
+		Save(ctxb)
+	require.NoError(t, err)
+
+	q, err := c.Quiz.GeneratePracticeSet(ctxb, n.ID, usr.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "cloze", q.Generator)
+
+	sets, err := c.Quiz.ListPracticeSets(ctxb, n.ID, usr.ID)
+	require.NoError(t, err)
+	require.Len(t, sets, 1)
+	assert.Equal(t, q.ID, sets[0].ID)
+	assert.Len(t, sets[0].Edges.Questions, 4)
+
+	got, err := c.Quiz.GetQuiz(ctxb, q.ID, &usr.ID)
+	require.NoError(t, err)
+	require.NotNil(t, got.Edges.Note)
+	assert.Equal(t, n.ID, got.Edges.Note.ID)
+
+	// Private notes can't be used by others.
+	other, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	_, err = c.Quiz.GeneratePracticeSet(ctxb, n.ID, other.ID)
+	assert.Error(t, err)
+
+	// Notes without text can't produce questions.
+	empty, err := c.ORM.Note.Create().
+		SetTitle("Empty").
+		SetOwner(usr).
+		Save(ctxb)
+	require.NoError(t, err)
+	_, err = c.Quiz.GeneratePracticeSet(ctxb, empty.ID, usr.ID)
+	assert.Error(t, err)
+}
+
+func TestQuizService_TimedAttempts(t *testing.T) {
+	ctxb := context.Background()
+
+	input := testQuizInput()
+	input.TimeLimit = 10
+	input.MaxAttempts = 3
+	q, err := c.Quiz.CreateQuiz(ctxb, usr.ID, input)
+	require.NoError(t, err)
+
+	got, err := c.Quiz.GetQuiz(ctxb, q.ID, &usr.ID)
+	require.NoError(t, err)
+	mc := got.Edges.Questions[0]
+	var correct int
+	for _, ch := range mc.Edges.Choices {
+		if ch.Correct {
+			correct = ch.ID
+		}
+	}
+
+	// Starting records a server-side deadline, and starting again resumes the same attempt.
+	attempt, started, err := c.Quiz.StartAttempt(ctxb, q.ID, usr.ID)
+	require.NoError(t, err)
+	assert.True(t, started)
+	require.NotNil(t, attempt.Deadline)
+	assert.WithinDuration(t, attempt.StartedAt.Add(10*time.Minute), *attempt.Deadline, time.Second)
+
+	resumed, started, err := c.Quiz.StartAttempt(ctxb, q.ID, usr.ID)
+	require.NoError(t, err)
+	assert.False(t, started)
+	assert.Equal(t, attempt.ID, resumed.ID)
+
+	// A second attempt can't be in progress at once, even if it's started concurrently.
+	err = c.ORM.QuizAttempt.Create().SetQuizID(q.ID).SetUserID(usr.ID).SetAnswers(map[int]string{}).Exec(ctxb)
+	assert.True(t, ent.IsConstraintError(err))
+
+	// Saved answers are merged.
+	require.NoError(t, c.Quiz.SaveAnswers(ctxb, attempt.ID, usr.ID, map[int]string{mc.ID: "0"}))
+	require.NoError(t, c.Quiz.SaveAnswers(ctxb, attempt.ID, usr.ID, map[int]string{mc.ID: strconv.Itoa(correct)}))
+	resumed, err = c.Quiz.GetAttempt(ctxb, attempt.ID, usr.ID)
+	require.NoError(t, err)
+	assert.Equal(t, map[int]string{mc.ID: strconv.Itoa(correct)}, resumed.Answers)
+
+	attempt, err = c.Quiz.SubmitAttempt(ctxb, attempt.ID, usr.ID, resumed.Answers)
+	require.NoError(t, err)
+	assert.Equal(t, 1, attempt.Score)
+
+	// A late submission is rejected and the attempt is graded from the answers saved in time.
+	late, err := c.ORM.QuizAttempt.Create().
+		SetQuizID(q.ID).
+		SetUserID(usr.ID).
+		SetAnswers(map[int]string{mc.ID: strconv.Itoa(correct)}).
+		SetStartedAt(time.Now().Add(-11 * time.Minute)).
+		SetDeadline(time.Now().Add(-time.Minute)).
+		Save(ctxb)
+	require.NoError(t, err)
+
+	all := map[int]string{mc.ID: strconv.Itoa(correct), got.Edges.Questions[2].ID: "pacific"}
+	_, err = c.Quiz.SubmitAttempt(ctxb, late.ID, usr.ID, all)
+	assert.True(t, errors.Is(err, ErrAttemptExpired))
+	assert.True(t, errors.Is(c.Quiz.SaveAnswers(ctxb, late.ID, usr.ID, all), ErrAttemptClosed))
+
+	late, err = c.Quiz.GetAttempt(ctxb, late.ID, usr.ID)
+	require.NoError(t, err)
+	assert.Equal(t, quizattempt.StatusExpired, late.Status)
+	assert.Equal(t, 1, late.Score)
+	assert.Equal(t, 4, late.MaxScore)
+
+	// The deadline task closes abandoned attempts, but leaves running ones alone.
+	abandoned, err := c.ORM.QuizAttempt.Create().
+		SetQuizID(q.ID).
+		SetUserID(usr.ID).
+		SetDeadline(time.Now().Add(-time.Minute)).
+		Save(ctxb)
+	require.NoError(t, err)
+
+	closed, err := c.Quiz.CloseExpiredAttempt(ctxb, abandoned.ID)
+	require.NoError(t, err)
+	assert.True(t, closed)
+	closed, err = c.Quiz.CloseExpiredAttempt(ctxb, abandoned.ID)
+	require.NoError(t, err)
+	assert.False(t, closed)
+
+	// All attempts are used up.
+	_, _, err = c.Quiz.StartAttempt(ctxb, q.ID, usr.ID)
+	assert.True(t, errors.Is(err, ErrAttemptLimitReached))
+}
@@ -0,0 +1,183 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+
+	"github.com/r-scheele/zero/ent"
+	"github.com/r-scheele/zero/ent/blobreference"
+	"github.com/r-scheele/zero/ent/note"
+	"github.com/r-scheele/zero/ent/user"
+)
+
+// ErrQuotaExceeded is returned when an upload would take a user over their storage quota
+var ErrQuotaExceeded = errors.New("storage quota exceeded")
+
+// StorageUsage is a breakdown of the storage charged to a user
+type StorageUsage struct {
+	// Used is the number of bytes charged to the user
+	Used int64
+
+	// Quota is the number of bytes the user may store, or zero for no limit
+	Quota int64
+
+	// Notes is the storage used by each note the user uploaded files to, largest first
+	Notes []NoteStorage
+
+	// Types is the storage used by each type of file, largest first
+	Types []TypeStorage
+
+	// Documents is the storage used by the user's document library
+	Documents int64
+
+	// Profile is the storage used by the user's profile picture
+	Profile int64
+}
+
+// NoteStorage is the storage used by the files a user uploaded to a note
+type NoteStorage struct {
+	NoteID int
+	Title  string
+	Files  int
+	Bytes  int64
+}
+
+// TypeStorage is the storage used by a user's files of one resource type
+type TypeStorage struct {
+	Type  string
+	Files int
+	Bytes int64
+}
+
+// Remaining returns the number of bytes the user can still upload, or -1 if there's no limit
+func (u *StorageUsage) Remaining() int64 {
+	if u.Quota == 0 {
+		return -1
+	}
+	return max(u.Quota-u.Used, 0)
+}
+
+// Percent returns how much of the quota is used, from 0 to 100, or 0 if there's no limit
+func (u *StorageUsage) Percent() int {
+	if u.Quota == 0 {
+		return 0
+	}
+	return int(min(u.Used*100/u.Quota, 100))
+}
+
+// CheckQuota returns ErrQuotaExceeded if storing a file of the given size would take the user
+// over their quota
+func (s *BlobService) CheckQuota(ctx context.Context, userID int, size int64) error {
+	return s.checkQuota(ctx, s.orm, userID, size)
+}
+
+// checkQuota checks the user's quota using the given client, so it can be checked in a transaction
+func (s *BlobService) checkQuota(ctx context.Context, client *ent.Client, userID int, size int64) error {
+	u, err := client.User.Get(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to fetch user: %w", err)
+	}
+
+	quota := s.quotaFor(u)
+	if quota > 0 && u.StorageUsed+size > quota {
+		return fmt.Errorf("%w: %s of %s used", ErrQuotaExceeded, FormatFileSize(u.StorageUsed), FormatFileSize(quota))
+	}
+
+	return nil
+}
+
+// quotaFor returns the user's quota, which an admin may have set for them
+func (s *BlobService) quotaFor(u *ent.User) int64 {
+	if u.StorageQuota != nil {
+		return *u.StorageQuota
+	}
+	return s.quota
+}
+
+// Usage returns the storage charged to a user, broken down by note and by type of file. Each
+// upload is charged in full, even when the same content was already stored by someone else.
+func (s *BlobService) Usage(ctx context.Context, userID int) (*StorageUsage, error) {
+	u, err := s.orm.User.Get(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch user: %w", err)
+	}
+
+	refs, err := s.orm.BlobReference.Query().
+		Where(blobreference.HasOwnerWith(user.ID(userID))).
+		WithBlob().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch file references: %w", err)
+	}
+
+	usage := &StorageUsage{
+		Used:  u.StorageUsed,
+		Quota: s.quotaFor(u),
+	}
+	notes := make(map[int]*NoteStorage)
+	types := make(map[string]*TypeStorage)
+	for _, ref := range refs {
+		switch ref.Kind {
+		case blobreference.KindNote:
+			if notes[ref.TargetID] == nil {
+				notes[ref.TargetID] = &NoteStorage{NoteID: ref.TargetID}
+			}
+			notes[ref.TargetID].Files++
+			notes[ref.TargetID].Bytes += ref.Size
+		case blobreference.KindDocument:
+			usage.Documents += ref.Size
+		case blobreference.KindProfile:
+			usage.Profile += ref.Size
+		}
+
+		b := ref.Edges.Blob
+		t := resourceTypeFor(b.MimeType, path.Ext(b.StorageKey))
+		if types[t] == nil {
+			types[t] = &TypeStorage{Type: t}
+		}
+		types[t].Files++
+		types[t].Bytes += ref.Size
+	}
+
+	if len(notes) > 0 {
+		ids := make([]int, 0, len(notes))
+		for id := range notes {
+			ids = append(ids, id)
+		}
+		titled, err := s.orm.Note.Query().
+			Where(note.IDIn(ids...)).
+			Select(note.FieldTitle).
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch notes: %w", err)
+		}
+		for _, n := range titled {
+			notes[n.ID].Title = n.Title
+		}
+	}
+
+	for _, n := range notes {
+		usage.Notes = append(usage.Notes, *n)
+	}
+	sort.Slice(usage.Notes, func(i, j int) bool {
+		if usage.Notes[i].Bytes != usage.Notes[j].Bytes {
+			return usage.Notes[i].Bytes > usage.Notes[j].Bytes
+		}
+		return usage.Notes[i].NoteID < usage.Notes[j].NoteID
+	})
+
+	for _, t := range types {
+		usage.Types = append(usage.Types, *t)
+	}
+	sort.Slice(usage.Types, func(i, j int) bool {
+		if usage.Types[i].Bytes != usage.Types[j].Bytes {
+			return usage.Types[i].Bytes > usage.Types[j].Bytes
+		}
+		return usage.Types[i].Type < usage.Types[j].Type
+	})
+
+	return usage, nil
+}
@@ -0,0 +1,152 @@
+package services
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+)
+
+// ScanResult is the outcome of scanning a file for viruses
+type ScanResult struct {
+	// Clean is true when nothing was found
+	Clean bool
+
+	// Threat is the name of what was found, if the file isn't clean
+	Threat string
+}
+
+// Scanner scans uploaded files for viruses before they're stored
+type Scanner interface {
+	Scan(ctx context.Context, file io.Reader) (ScanResult, error)
+}
+
+// clamdChunkSize is the size of the chunks files are streamed to clamd in
+const clamdChunkSize = 64 * 1024
+
+// ClamdScanner scans files with a ClamAV daemon, streaming them with the INSTREAM command of the
+// clamd protocol
+type ClamdScanner struct {
+	network string
+	address string
+	timeout time.Duration
+}
+
+// NewClamdScanner creates a scanner for the clamd listening at the given address, either
+// "tcp://host:port", "unix:///path/to/clamd.sock" or "host:port". The timeout limits how long a
+// single scan can take.
+func NewClamdScanner(address string, timeout time.Duration) (*ClamdScanner, error) {
+	s := &ClamdScanner{
+		network: "tcp",
+		address: address,
+		timeout: timeout,
+	}
+
+	switch {
+	case strings.HasPrefix(address, "unix://"):
+		s.network, s.address = "unix", strings.TrimPrefix(address, "unix://")
+	case strings.HasPrefix(address, "unix:"):
+		s.network, s.address = "unix", strings.TrimPrefix(address, "unix:")
+	case strings.HasPrefix(address, "tcp://"):
+		s.address = strings.TrimPrefix(address, "tcp://")
+	}
+	if s.address == "" {
+		return nil, errors.New("no clamd address specified")
+	}
+	if s.timeout <= 0 {
+		s.timeout = 2 * time.Minute
+	}
+
+	return s, nil
+}
+
+// Ping checks clamd is up
+func (s *ClamdScanner) Ping(ctx context.Context) error {
+	reply, err := s.command(ctx, "zPING\x00", nil)
+	if err != nil {
+		return err
+	}
+	if reply != "PONG" {
+		return fmt.Errorf("unexpected clamd reply: %s", reply)
+	}
+	return nil
+}
+
+// Scan streams a file to clamd and reports whether it found a virus
+func (s *ClamdScanner) Scan(ctx context.Context, file io.Reader) (ScanResult, error) {
+	reply, err := s.command(ctx, "zINSTREAM\x00", file)
+	if err != nil {
+		return ScanResult{}, err
+	}
+
+	// Replies look like "stream: OK" or "stream: Eicar-Signature FOUND"
+	reply = strings.TrimPrefix(reply, "stream: ")
+	switch {
+	case reply == "OK":
+		return ScanResult{Clean: true}, nil
+	case strings.HasSuffix(reply, " FOUND"):
+		return ScanResult{Threat: strings.TrimSuffix(reply, " FOUND")}, nil
+	default:
+		return ScanResult{}, fmt.Errorf("clamd failed to scan file: %s", reply)
+	}
+}
+
+// command sends a command to clamd, followed by the file if there is one, and returns the reply
+func (s *ClamdScanner) command(ctx context.Context, cmd string, file io.Reader) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, s.network, s.address)
+	if err != nil {
+		return "", fmt.Errorf("failed to connect to clamd: %w", err)
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return "", fmt.Errorf("failed to set clamd deadline: %w", err)
+		}
+	}
+
+	if _, err := io.WriteString(conn, cmd); err != nil {
+		return "", fmt.Errorf("failed to send clamd command: %w", err)
+	}
+
+	// Files are sent as chunks, each prefixed by its length, and ended by an empty chunk
+	if file != nil {
+		buf := make([]byte, clamdChunkSize)
+		for {
+			n, err := file.Read(buf)
+			if n > 0 {
+				if err := binary.Write(conn, binary.BigEndian, uint32(n)); err != nil {
+					return "", fmt.Errorf("failed to stream file to clamd: %w", err)
+				}
+				if _, err := conn.Write(buf[:n]); err != nil {
+					return "", fmt.Errorf("failed to stream file to clamd: %w", err)
+				}
+			}
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				return "", fmt.Errorf("failed to read file: %w", err)
+			}
+		}
+		if err := binary.Write(conn, binary.BigEndian, uint32(0)); err != nil {
+			return "", fmt.Errorf("failed to stream file to clamd: %w", err)
+		}
+	}
+
+	reply, err := bufio.NewReader(conn).ReadString('\x00')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", fmt.Errorf("failed to read clamd reply: %w", err)
+	}
+
+	return strings.TrimSpace(strings.TrimSuffix(reply, "\x00")), nil
+}
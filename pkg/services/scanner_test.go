@@ -0,0 +1,121 @@
+package services
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// eicar is the standard antivirus test file
+const eicar = `X5O!P%@AP[4\PZX54(P^)7CC)7}$EICAR-STANDARD-ANTIVIRUS-TEST-FILE!$H+H*`
+
+// fakeClamd serves the parts of the clamd protocol used by ClamdScanner, finding the EICAR test
+// file in streamed files
+func fakeClamd(t *testing.T) string {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = ln.Close()
+	})
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go serveClamd(conn)
+		}
+	}()
+
+	return ln.Addr().String()
+}
+
+func serveClamd(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+
+	cmd, err := r.ReadString('\x00')
+	if err != nil {
+		return
+	}
+
+	switch cmd {
+	case "zPING\x00":
+		_, _ = io.WriteString(conn, "PONG\x00")
+	case "zINSTREAM\x00":
+		var file bytes.Buffer
+		for {
+			var size uint32
+			if err := binary.Read(r, binary.BigEndian, &size); err != nil {
+				return
+			}
+			if size == 0 {
+				break
+			}
+			if _, err := io.CopyN(&file, r, int64(size)); err != nil {
+				return
+			}
+		}
+		if strings.Contains(file.String(), eicar) {
+			_, _ = io.WriteString(conn, "stream: Eicar-Signature FOUND\x00")
+			return
+		}
+		_, _ = io.WriteString(conn, "stream: OK\x00")
+	default:
+		_, _ = io.WriteString(conn, "UNKNOWN COMMAND\x00")
+	}
+}
+
+func TestClamdScanner(t *testing.T) {
+	ctx := context.Background()
+	scanner, err := NewClamdScanner("tcp://"+fakeClamd(t), time.Second)
+	require.NoError(t, err)
+
+	require.NoError(t, scanner.Ping(ctx))
+
+	// Files larger than a chunk are streamed in pieces
+	result, err := scanner.Scan(ctx, strings.NewReader(strings.Repeat("lecture notes ", clamdChunkSize)))
+	require.NoError(t, err)
+	assert.True(t, result.Clean)
+
+	result, err = scanner.Scan(ctx, strings.NewReader(strings.Repeat("x", clamdChunkSize-10)+eicar))
+	require.NoError(t, err)
+	assert.False(t, result.Clean)
+	assert.Equal(t, "Eicar-Signature", result.Threat)
+}
+
+func TestNewClamdScanner(t *testing.T) {
+	s, err := NewClamdScanner("unix:///run/clamav/clamd.ctl", 0)
+	require.NoError(t, err)
+	assert.Equal(t, "unix", s.network)
+	assert.Equal(t, "/run/clamav/clamd.ctl", s.address)
+	assert.Equal(t, 2*time.Minute, s.timeout)
+
+	s, err = NewClamdScanner("localhost:3310", time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, "tcp", s.network)
+	assert.Equal(t, "localhost:3310", s.address)
+
+	_, err = NewClamdScanner("tcp://", time.Second)
+	assert.Error(t, err)
+
+	// Scans fail when clamd can't be reached, so they can be retried
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := ln.Addr().String()
+	require.NoError(t, ln.Close())
+	s, err = NewClamdScanner(addr, time.Second)
+	require.NoError(t, err)
+	_, err = s.Scan(context.Background(), strings.NewReader("notes"))
+	assert.Error(t, err)
+}
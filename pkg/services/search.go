@@ -0,0 +1,1003 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"html"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/r-scheele/zero/ent"
+	"github.com/r-scheele/zero/ent/hook"
+	"github.com/r-scheele/zero/ent/note"
+	"github.com/r-scheele/zero/ent/predicate"
+	"github.com/r-scheele/zero/ent/user"
+	"github.com/r-scheele/zero/pkg/log"
+	"github.com/r-scheele/zero/pkg/ui/models"
+)
+
+const (
+	// DefaultSearchLimit is the number of results returned when no limit is given
+	DefaultSearchLimit = 20
+
+	// maxUserResults is the most people a search returns
+	maxUserResults = 50
+
+	// Markers wrapped around matches in snippets before they are HTML escaped
+	snippetStart = "\x02"
+	snippetEnd   = "\x03"
+
+	// snippetRadius is the number of characters shown either side of the first match in fallback snippets
+	snippetRadius = 80
+
+	// searchIndexBatchSize is the number of rows loaded at a time while rebuilding the index
+	searchIndexBatchSize = 200
+)
+
+// searchSchema creates the FTS5 tables. The rowid of each row is the ID of the note or user it indexes.
+var searchSchema = []string{
+	`CREATE VIRTUAL TABLE IF NOT EXISTS notes_fts USING fts5(title, description, content, resources, tokenize = 'porter unicode61')`,
+	`CREATE VIRTUAL TABLE IF NOT EXISTS users_fts USING fts5(name, bio, tokenize = 'porter unicode61')`,
+}
+
+// SearchService provides full-text search over notes, their resources and users.
+// On SQLite builds with FTS5 (the sqlite_fts5 build tag), it searches an FTS5 index that is
+// kept in sync with ent hooks. Otherwise it falls back to matching with LIKE.
+type SearchService struct {
+	orm *ent.Client
+	db  *sql.DB
+	fts bool
+}
+
+// NewSearchService creates a new search service, creating and populating the search index
+// if the database supports it, and registers the hooks that keep the index in sync
+func NewSearchService(orm *ent.Client, db *sql.DB, driver string) (*SearchService, error) {
+	s := &SearchService{
+		orm: orm,
+		db:  db,
+	}
+
+	if driver == "sqlite3" {
+		if err := s.createIndex(context.Background()); err != nil {
+			return nil, err
+		}
+	}
+
+	orm.Note.Use(s.noteHook())
+	orm.User.Use(s.userHook())
+
+	return s, nil
+}
+
+// FullText reports whether searches use the FTS5 index
+func (s *SearchService) FullText() bool {
+	return s.fts
+}
+
+// createIndex creates the FTS5 tables, and populates them if they didn't exist yet
+func (s *SearchService) createIndex(ctx context.Context) error {
+	var existing int
+	err := s.db.QueryRowContext(ctx,
+		`SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name IN ('notes_fts', 'users_fts')`,
+	).Scan(&existing)
+	if err != nil {
+		return fmt.Errorf("failed to check search index: %w", err)
+	}
+
+	for _, stmt := range searchSchema {
+		if _, err := s.db.ExecContext(ctx, stmt); err != nil {
+			if strings.Contains(err.Error(), "no such module: fts5") {
+				log.Default().Warn("SQLite was built without FTS5, search will fall back to LIKE matching. Build with -tags sqlite_fts5 to enable full-text search.")
+				return nil
+			}
+			return fmt.Errorf("failed to create search index: %w", err)
+		}
+	}
+	s.fts = true
+
+	if existing < len(searchSchema) {
+		return s.Reindex(ctx)
+	}
+
+	return nil
+}
+
+// Reindex rebuilds the search index from scratch
+func (s *SearchService) Reindex(ctx context.Context) error {
+	if !s.fts {
+		return nil
+	}
+
+	if _, err := s.db.ExecContext(ctx, `DELETE FROM notes_fts`); err != nil {
+		return fmt.Errorf("failed to clear note index: %w", err)
+	}
+	if _, err := s.db.ExecContext(ctx, `DELETE FROM users_fts`); err != nil {
+		return fmt.Errorf("failed to clear user index: %w", err)
+	}
+
+	for offset := 0; ; offset += searchIndexBatchSize {
+		ids, err := s.orm.Note.Query().Order(ent.Asc(note.FieldID)).Limit(searchIndexBatchSize).Offset(offset).IDs(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to list notes: %w", err)
+		}
+		if err := s.indexNotes(ctx, ids); err != nil {
+			return err
+		}
+		if len(ids) < searchIndexBatchSize {
+			break
+		}
+	}
+
+	for offset := 0; ; offset += searchIndexBatchSize {
+		ids, err := s.orm.User.Query().Order(ent.Asc(user.FieldID)).Limit(searchIndexBatchSize).Offset(offset).IDs(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to list users: %w", err)
+		}
+		if err := s.indexUsers(ctx, ids); err != nil {
+			return err
+		}
+		if len(ids) < searchIndexBatchSize {
+			break
+		}
+	}
+
+	return nil
+}
+
+// SearchParams holds a search query and the filters applied to its results
+type SearchParams struct {
+	// Query holds the words to search for
+	Query string
+
+	// ViewerID is the ID of the user searching, or nil for anonymous searches. Only public notes
+	// and the viewer's own notes are searched.
+	ViewerID *int
+
+	// OwnerID limits results to notes owned by a user, when non-zero
+	OwnerID int
+
+	// Visibility limits results to public or private notes, when set
+	Visibility string
+
+	// ResourceType limits results to notes with an attached resource of a type, such as "pdf" or "youtube"
+	ResourceType string
+
+	// From and To limit results to notes created within a date range. Zero values leave the range open.
+	From, To time.Time
+
+	// LikedByMe limits results to notes the viewer has liked
+	LikedByMe bool
+}
+
+// Filtered reports whether any filters are set. People are only searched for when there are none,
+// since the filters only apply to notes.
+func (p SearchParams) Filtered() bool {
+	return p.OwnerID != 0 || p.Visibility != "" || p.ResourceType != "" ||
+		!p.From.IsZero() || !p.To.IsZero() || p.LikedByMe
+}
+
+// SearchFacet is a value a search can be filtered by, with the number of results it would leave
+type SearchFacet struct {
+	Value string `json:"value"`
+	Label string `json:"label"`
+	Count int    `json:"count"`
+}
+
+// SearchFacets holds the result counts for each filter. Each facet is counted with every other
+// filter applied, so the counts show what changing that one filter would return.
+type SearchFacets struct {
+	Owners        []SearchFacet `json:"owners"`
+	Visibility    []SearchFacet `json:"visibility"`
+	ResourceTypes []SearchFacet `json:"resource_types"`
+	// Dates counts notes created recently; the values are From dates
+	Dates []SearchFacet `json:"dates"`
+	Liked int           `json:"liked"`
+}
+
+// SearchResults holds a page of search results
+type SearchResults struct {
+	Results []*models.SearchResult `json:"results"`
+	Total   int                    `json:"total"`
+	Facets  SearchFacets           `json:"facets"`
+}
+
+// searchFilter identifies one of the search filters, so a facet can be counted without its own filter
+type searchFilter int
+
+const (
+	filterNone searchFilter = iota
+	filterOwner
+	filterVisibility
+	filterResourceType
+	filterDate
+	filterLiked
+)
+
+const (
+	// resourceTypeColumn is the type of a resource row from json_each, with untyped resources counted as ""
+	resourceTypeColumn = `COALESCE(json_extract(r.value, '$.type'), '')`
+
+	// likedColumn is true when the viewer has liked the note
+	likedColumn = `EXISTS (SELECT 1 FROM note_likes l WHERE l.note_likes = n.id AND l.user_note_likes = ?)`
+)
+
+// noteSearch builds queries over the notes matching a search. The match is a common table
+// expression of note IDs and ranks, joined to the notes table, so the filters, paging and facet
+// counts all run in the database.
+type noteSearch struct {
+	db     *sql.DB
+	match  string
+	args   []any
+	params SearchParams
+}
+
+// Search finds notes and people matching the query, most relevant first, and returns the page of
+// results at the given offset along with facet counts for the filters
+func (s *SearchService) Search(ctx context.Context, params SearchParams, limit, offset int) (*SearchResults, error) {
+	results := &SearchResults{Results: []*models.SearchResult{}}
+
+	terms := searchTerms(params.Query)
+	if len(terms) == 0 {
+		return results, nil
+	}
+	if limit <= 0 {
+		limit = DefaultSearchLimit
+	}
+
+	var q *noteSearch
+	var users []*models.SearchResult
+	var err error
+	if s.fts {
+		q = s.matchNotesFTS(terms, params)
+		if !params.Filtered() {
+			users, err = s.searchUsersFTS(ctx, terms)
+		}
+	} else {
+		q = s.matchNotesLike(terms, params)
+		if !params.Filtered() {
+			users, err = s.searchUsersLike(ctx, terms)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := q.count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// People are ranked alongside notes, so when there are any, every note up to the end of the
+	// page could be on it
+	noteLimit, noteOffset := limit, offset
+	if len(users) > 0 {
+		noteLimit, noteOffset = offset+limit, 0
+	}
+	all, err := q.page(ctx, noteLimit, noteOffset)
+	if err != nil {
+		return nil, err
+	}
+
+	all = append(all, users...)
+	sort.SliceStable(all, func(i, j int) bool {
+		return all[i].Rank > all[j].Rank
+	})
+
+	results.Total = total + len(users)
+	if start := offset - noteOffset; start < len(all) {
+		results.Results = all[start:min(start+limit, len(all))]
+	}
+
+	if results.Facets, err = q.facets(ctx, time.Now()); err != nil {
+		return nil, err
+	}
+
+	if err := s.noteSnippets(ctx, terms, results.Results); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// query builds a statement selecting from the matching notes, as n, with every filter applied
+// except skip. Arguments for placeholders in the columns come before those of the filters.
+func (q *noteSearch) query(columns, joins string, skip searchFilter, columnArgs ...any) (string, []any) {
+	args := append(slices.Clone(q.args), columnArgs...)
+	var where []string
+	filter := func(f searchFilter, cond string, arg any) {
+		if f != skip {
+			where = append(where, cond)
+			args = append(args, arg)
+		}
+	}
+
+	p := q.params
+	if p.OwnerID != 0 {
+		filter(filterOwner, "n.user_notes = ?", p.OwnerID)
+	}
+	if p.Visibility != "" {
+		filter(filterVisibility, "n.visibility = ?", p.Visibility)
+	}
+	if p.ResourceType != "" {
+		filter(filterResourceType, "EXISTS (SELECT 1 FROM json_each(n.resources) r WHERE "+resourceTypeColumn+" = ?)", p.ResourceType)
+	}
+	// Times are compared as julian days, since the stored strings can have different offsets
+	if !p.From.IsZero() {
+		filter(filterDate, "julianday(n.created_at) >= julianday(?)", p.From.UTC())
+	}
+	if !p.To.IsZero() {
+		filter(filterDate, "julianday(n.created_at) < julianday(?)", p.To.UTC())
+	}
+	if p.LikedByMe {
+		filter(filterLiked, likedColumn, searchViewer(p.ViewerID))
+	}
+
+	stmt := "WITH matches(id, rank) AS (" + q.match + ")\nSELECT " + columns + "\nFROM matches m\nJOIN notes n ON n.id = m.id" + joins
+	if len(where) > 0 {
+		stmt += "\nWHERE " + strings.Join(where, " AND ")
+	}
+	return stmt, args
+}
+
+// scan runs a query and calls fn with each row
+func (q *noteSearch) scan(ctx context.Context, stmt string, args []any, fn func(*sql.Rows) error) error {
+	rows, err := q.db.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		if err := fn(rows); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// count returns the number of matching notes that pass the filters
+func (q *noteSearch) count(ctx context.Context) (int, error) {
+	var total int
+	stmt, args := q.query("COUNT(*)", "", filterNone)
+	if err := q.db.QueryRowContext(ctx, stmt, args...).Scan(&total); err != nil {
+		return 0, fmt.Errorf("failed to count search results: %w", err)
+	}
+	return total, nil
+}
+
+// page returns the matching notes that pass the filters at an offset, most relevant first
+func (q *noteSearch) page(ctx context.Context, limit, offset int) ([]*models.SearchResult, error) {
+	var results []*models.SearchResult
+	stmt, args := q.query("n.id, n.title, m.rank", "", filterNone)
+	err := q.scan(ctx, stmt+"\nORDER BY m.rank DESC, n.id\nLIMIT ? OFFSET ?", append(args, limit, offset), func(rows *sql.Rows) error {
+		r := &models.SearchResult{Type: models.SearchResultNote}
+		results = append(results, r)
+		return rows.Scan(&r.ID, &r.Title, &r.Rank)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to search notes: %w", err)
+	}
+	return results, nil
+}
+
+// facets counts the matching notes for each filter value, with every other filter applied
+func (q *noteSearch) facets(ctx context.Context, now time.Time) (SearchFacets, error) {
+	var facets SearchFacets
+
+	stmt, args := q.query("n.user_notes, u.name, COUNT(*)", "\nJOIN users u ON u.id = n.user_notes", filterOwner)
+	err := q.scan(ctx, stmt+"\nGROUP BY n.user_notes, u.name", args, func(rows *sql.Rows) error {
+		var id int
+		f := SearchFacet{}
+		if err := rows.Scan(&id, &f.Label, &f.Count); err != nil {
+			return err
+		}
+		f.Value = strconv.Itoa(id)
+		facets.Owners = append(facets.Owners, f)
+		return nil
+	})
+	if err != nil {
+		return facets, fmt.Errorf("failed to count owners: %w", err)
+	}
+	sortFacets(facets.Owners)
+
+	visibility := make(map[string]int)
+	stmt, args = q.query("n.visibility, COUNT(*)", "", filterVisibility)
+	err = q.scan(ctx, stmt+"\nGROUP BY n.visibility", args, func(rows *sql.Rows) error {
+		var v string
+		var count int
+		err := rows.Scan(&v, &count)
+		visibility[v] = count
+		return err
+	})
+	if err != nil {
+		return facets, fmt.Errorf("failed to count visibility: %w", err)
+	}
+	for _, v := range []note.Visibility{note.VisibilityPublic, note.VisibilityPrivate} {
+		if count := visibility[string(v)]; count > 0 {
+			facets.Visibility = append(facets.Visibility, SearchFacet{
+				Value: string(v),
+				Label: strings.ToUpper(string(v)[:1]) + string(v)[1:],
+				Count: count,
+			})
+		}
+	}
+
+	stmt, args = q.query(resourceTypeColumn+", COUNT(DISTINCT n.id)", ",\njson_each(n.resources) r", filterResourceType)
+	err = q.scan(ctx, stmt+"\nGROUP BY 1", args, func(rows *sql.Rows) error {
+		f := SearchFacet{}
+		if err := rows.Scan(&f.Value, &f.Count); err != nil {
+			return err
+		}
+		f.Label = ResourceTypeLabel(f.Value)
+		facets.ResourceTypes = append(facets.ResourceTypes, f)
+		return nil
+	})
+	if err != nil {
+		return facets, fmt.Errorf("failed to count resource types: %w", err)
+	}
+	sortFacets(facets.ResourceTypes)
+
+	periods := []struct {
+		label string
+		from  time.Time
+	}{
+		{"Past week", now.AddDate(0, 0, -7)},
+		{"Past month", now.AddDate(0, -1, 0)},
+		{"Past year", now.AddDate(-1, 0, 0)},
+	}
+	columns := make([]string, len(periods))
+	columnArgs := make([]any, len(periods))
+	dates := make([]any, len(periods))
+	counts := make([]int, len(periods))
+	for i, period := range periods {
+		columns[i] = "COALESCE(SUM(julianday(n.created_at) >= julianday(?)), 0)"
+		columnArgs[i] = period.from.UTC()
+		dates[i] = &counts[i]
+	}
+	stmt, args = q.query(strings.Join(columns, ", "), "", filterDate, columnArgs...)
+	if err := q.db.QueryRowContext(ctx, stmt, args...).Scan(dates...); err != nil {
+		return facets, fmt.Errorf("failed to count dates: %w", err)
+	}
+	for i, period := range periods {
+		if counts[i] > 0 {
+			facets.Dates = append(facets.Dates, SearchFacet{
+				Value: period.from.Format(time.DateOnly),
+				Label: period.label,
+				Count: counts[i],
+			})
+		}
+	}
+
+	if q.params.ViewerID != nil {
+		stmt, args = q.query("COALESCE(SUM("+likedColumn+"), 0)", "", filterLiked, *q.params.ViewerID)
+		if err := q.db.QueryRowContext(ctx, stmt, args...).Scan(&facets.Liked); err != nil {
+			return facets, fmt.Errorf("failed to count liked notes: %w", err)
+		}
+	}
+
+	return facets, nil
+}
+
+// sortFacets orders facets by count, most first, then by label
+func sortFacets(facets []SearchFacet) {
+	sort.Slice(facets, func(i, j int) bool {
+		if facets[i].Count != facets[j].Count {
+			return facets[i].Count > facets[j].Count
+		}
+		return facets[i].Label < facets[j].Label
+	})
+}
+
+// ResourceTypeLabel returns a display name for a resource type
+func ResourceTypeLabel(resourceType string) string {
+	switch resourceType {
+	case "youtube":
+		return "YouTube"
+	case "pdf":
+		return "PDF"
+	case "doc":
+		return "Document"
+	case "url":
+		return "Link"
+	case "":
+		return "Other"
+	default:
+		return strings.ToUpper(resourceType[:1]) + resourceType[1:]
+	}
+}
+
+// searchViewer returns the ID of the user searching, or 0 for anonymous searches so owner checks match nothing
+func searchViewer(viewerID *int) int {
+	if viewerID == nil {
+		return 0
+	}
+	return *viewerID
+}
+
+// matchNotesFTS matches the visible notes in the note index. bm25 weights matches in titles
+// above descriptions, and those above content and resources.
+func (s *SearchService) matchNotesFTS(terms []string, params SearchParams) *noteSearch {
+	return &noteSearch{
+		db: s.db,
+		match: `
+			SELECT n.id, -bm25(notes_fts, 10.0, 4.0, 1.0, 1.0)
+			FROM notes_fts
+			JOIN notes n ON n.id = notes_fts.rowid
+			WHERE notes_fts MATCH ? AND (n.visibility = 'public' OR n.user_notes = ?)`,
+		args:   []any{ftsQuery(terms), searchViewer(params.ViewerID)},
+		params: params,
+	}
+}
+
+// searchUsersFTS searches the index of active users
+func (s *SearchService) searchUsersFTS(ctx context.Context, terms []string) ([]*models.SearchResult, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT u.id, u.name, snippet(users_fts, 1, ?, ?, '…', 16), -bm25(users_fts, 10.0, 1.0)
+		FROM users_fts
+		JOIN users u ON u.id = users_fts.rowid
+		WHERE users_fts MATCH ? AND u.is_active
+		ORDER BY bm25(users_fts, 10.0, 1.0)
+		LIMIT ?`,
+		snippetStart, snippetEnd, ftsQuery(terms), maxUserResults,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search users: %w", err)
+	}
+	defer rows.Close()
+
+	var results []*models.SearchResult
+	for rows.Next() {
+		r := &models.SearchResult{Type: models.SearchResultUser}
+		var snippet string
+		if err := rows.Scan(&r.ID, &r.Title, &snippet, &r.Rank); err != nil {
+			return nil, fmt.Errorf("failed to read search result: %w", err)
+		}
+		r.Snippet = highlightSnippet(snippet)
+		results = append(results, r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read search results: %w", err)
+	}
+
+	return results, nil
+}
+
+// noteSnippets adds highlighted snippets to the notes in a page of results
+func (s *SearchService) noteSnippets(ctx context.Context, terms []string, results []*models.SearchResult) error {
+	page := make(map[int]*models.SearchResult)
+	ids := make([]int, 0, len(results))
+	for _, r := range results {
+		if r.Type == models.SearchResultNote {
+			page[r.ID] = r
+			ids = append(ids, r.ID)
+		}
+	}
+	if len(page) == 0 {
+		return nil
+	}
+
+	if !s.fts {
+		notes, err := s.orm.Note.Query().
+			Where(note.IDIn(ids...)).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to load notes for snippets: %w", err)
+		}
+		for _, n := range notes {
+			page[n.ID].Snippet = likeSnippet(terms, n.Description, n.Content, noteResourceText(n))
+		}
+		return nil
+	}
+
+	args := []any{snippetStart, snippetEnd, ftsQuery(terms)}
+	placeholders := make([]string, 0, len(page))
+	for _, id := range ids {
+		args = append(args, id)
+		placeholders = append(placeholders, "?")
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT rowid, snippet(notes_fts, -1, ?, ?, '…', 16)
+		FROM notes_fts
+		WHERE notes_fts MATCH ? AND rowid IN (`+strings.Join(placeholders, ", ")+`)`,
+		args...,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to fetch snippets: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id int
+		var snippet string
+		if err := rows.Scan(&id, &snippet); err != nil {
+			return fmt.Errorf("failed to read snippet: %w", err)
+		}
+		page[id].Snippet = highlightSnippet(snippet)
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to read snippets: %w", err)
+	}
+
+	return nil
+}
+
+// matchNotesLike matches the visible notes without the index. Every term has to appear in the
+// title, description, content or the names and text of the resources, and like likeRank, the rank
+// counts how often each term appears, weighted by field.
+func (s *SearchService) matchNotesLike(terms []string, params SearchParams) *noteSearch {
+	fields := []struct {
+		column string
+		weight float64
+	}{{"title", 10}, {"description", 4}, {"content", 1}, {"resources", 1}}
+
+	var rank, all []string
+	var rankArgs, allArgs []any
+	for _, t := range terms {
+		either := make([]string, len(fields))
+		length := float64(utf8.RuneCountInString(t))
+		for i, f := range fields {
+			rank = append(rank, fmt.Sprintf("(length(%[1]s) - length(replace(%[1]s, ?, ''))) * %[2]g", f.column, f.weight/length))
+			rankArgs = append(rankArgs, t)
+			either[i] = fmt.Sprintf("instr(%s, ?) > 0", f.column)
+			allArgs = append(allArgs, t)
+		}
+		all = append(all, "("+strings.Join(either, " OR ")+")")
+	}
+
+	args := append(rankArgs, searchViewer(params.ViewerID))
+	return &noteSearch{
+		db: s.db,
+		match: `
+			SELECT id, ` + strings.Join(rank, " + ") + `
+			FROM (
+				SELECT n.id, lower(n.title) AS title,
+					lower(COALESCE(n.description, '')) AS description,
+					lower(COALESCE(n.content, '')) AS content,
+					lower(COALESCE((
+						SELECT group_concat(COALESCE(json_extract(r.value, '$.name'), '') || ' ' || COALESCE(json_extract(r.value, '$.extracted_text'), ''), ' ')
+						FROM json_each(n.resources) r
+					), '')) AS resources
+				FROM notes n
+				WHERE n.visibility = 'public' OR n.user_notes = ?
+			)
+			WHERE ` + strings.Join(all, " AND "),
+		args:   append(args, allArgs...),
+		params: params,
+	}
+}
+
+// searchUsersLike searches active users without the index
+func (s *SearchService) searchUsersLike(ctx context.Context, terms []string) ([]*models.SearchResult, error) {
+	preds := []predicate.User{user.IsActive(true)}
+	for _, t := range terms {
+		preds = append(preds, user.Or(user.NameContainsFold(t), user.BioContainsFold(t)))
+	}
+
+	users, err := s.orm.User.Query().
+		Where(preds...).
+		Limit(maxUserResults).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search users: %w", err)
+	}
+
+	results := make([]*models.SearchResult, 0, len(users))
+	for _, u := range users {
+		bio := ""
+		if u.Bio != nil {
+			bio = *u.Bio
+		}
+		results = append(results, &models.SearchResult{
+			Type:    models.SearchResultUser,
+			ID:      u.ID,
+			Title:   u.Name,
+			Rank:    likeRank(terms, []string{u.Name, bio}, []float64{10, 1}),
+			Snippet: likeSnippet(terms, bio),
+		})
+	}
+
+	return results, nil
+}
+
+// noteHook keeps the note index in sync with notes as they are saved and deleted
+func (s *SearchService) noteHook() ent.Hook {
+	indexed := []string{note.FieldTitle, note.FieldDescription, note.FieldContent, note.FieldResources}
+
+	return func(next ent.Mutator) ent.Mutator {
+		return hook.NoteFunc(func(ctx context.Context, m *ent.NoteMutation) (ent.Value, error) {
+			if !s.fts || (m.Op().Is(ent.OpUpdate|ent.OpUpdateOne) && !mutatesAny(m, indexed)) {
+				return next.Mutate(ctx, m)
+			}
+
+			var ids []int
+			if !m.Op().Is(ent.OpCreate) {
+				var err error
+				if ids, err = m.IDs(ctx); err != nil {
+					return nil, err
+				}
+			}
+
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return v, err
+			}
+
+			if n, ok := v.(*ent.Note); ok && m.Op().Is(ent.OpCreate) {
+				ids = []int{n.ID}
+			}
+
+			s.afterCommit(ctx, m, func(ctx context.Context) error {
+				if m.Op().Is(ent.OpDelete | ent.OpDeleteOne) {
+					return s.removeFromIndex(ctx, "notes_fts", ids)
+				}
+				return s.indexNotes(ctx, ids)
+			})
+
+			return v, nil
+		})
+	}
+}
+
+// userHook keeps the user index in sync with users as they are saved and deleted
+func (s *SearchService) userHook() ent.Hook {
+	indexed := []string{user.FieldName, user.FieldBio}
+
+	return func(next ent.Mutator) ent.Mutator {
+		return hook.UserFunc(func(ctx context.Context, m *ent.UserMutation) (ent.Value, error) {
+			if !s.fts || (m.Op().Is(ent.OpUpdate|ent.OpUpdateOne) && !mutatesAny(m, indexed)) {
+				return next.Mutate(ctx, m)
+			}
+
+			var ids []int
+			if !m.Op().Is(ent.OpCreate) {
+				var err error
+				if ids, err = m.IDs(ctx); err != nil {
+					return nil, err
+				}
+			}
+
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return v, err
+			}
+
+			if u, ok := v.(*ent.User); ok && m.Op().Is(ent.OpCreate) {
+				ids = []int{u.ID}
+			}
+
+			s.afterCommit(ctx, m, func(ctx context.Context) error {
+				if m.Op().Is(ent.OpDelete | ent.OpDeleteOne) {
+					return s.removeFromIndex(ctx, "users_fts", ids)
+				}
+				return s.indexUsers(ctx, ids)
+			})
+
+			return v, nil
+		})
+	}
+}
+
+// afterCommit runs an index update once the mutation's transaction commits, or right away if the
+// mutation isn't part of a transaction. The index is written through its own connection, which
+// would otherwise wait on the transaction's lock. Failures are logged rather than failing the save.
+func (s *SearchService) afterCommit(ctx context.Context, m interface{ Tx() (*ent.Tx, error) }, update func(context.Context) error) {
+	run := func(ctx context.Context) {
+		if err := update(ctx); err != nil {
+			log.Default().Error("failed to update search index", "error", err)
+		}
+	}
+
+	tx, err := m.Tx()
+	if err != nil {
+		run(ctx)
+		return
+	}
+
+	tx.OnCommit(func(next ent.Committer) ent.Committer {
+		return ent.CommitFunc(func(ctx context.Context, tx *ent.Tx) error {
+			if err := next.Commit(ctx, tx); err != nil {
+				return err
+			}
+			run(ctx)
+			return nil
+		})
+	})
+}
+
+// indexNotes writes the given notes to the index, replacing any existing entries
+func (s *SearchService) indexNotes(ctx context.Context, ids []int) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	notes, err := s.orm.Note.Query().Where(note.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load notes to index: %w", err)
+	}
+
+	if err := s.removeFromIndex(ctx, "notes_fts", ids); err != nil {
+		return err
+	}
+	for _, n := range notes {
+		_, err := s.db.ExecContext(ctx,
+			`INSERT INTO notes_fts (rowid, title, description, content, resources) VALUES (?, ?, ?, ?, ?)`,
+			n.ID, n.Title, n.Description, n.Content, noteResourceText(n),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to index note: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// indexUsers writes the given users to the index, replacing any existing entries
+func (s *SearchService) indexUsers(ctx context.Context, ids []int) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	users, err := s.orm.User.Query().Where(user.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load users to index: %w", err)
+	}
+
+	if err := s.removeFromIndex(ctx, "users_fts", ids); err != nil {
+		return err
+	}
+	for _, u := range users {
+		bio := ""
+		if u.Bio != nil {
+			bio = *u.Bio
+		}
+		_, err := s.db.ExecContext(ctx, `INSERT INTO users_fts (rowid, name, bio) VALUES (?, ?, ?)`, u.ID, u.Name, bio)
+		if err != nil {
+			return fmt.Errorf("failed to index user: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// removeFromIndex deletes the rows with the given IDs from an index table
+func (s *SearchService) removeFromIndex(ctx context.Context, table string, ids []int) error {
+	for _, id := range ids {
+		if _, err := s.db.ExecContext(ctx, `DELETE FROM `+table+` WHERE rowid = ?`, id); err != nil {
+			return fmt.Errorf("failed to remove from search index: %w", err)
+		}
+	}
+	return nil
+}
+
+// mutatesAny reports whether a mutation sets or clears any of the given fields
+func mutatesAny(m ent.Mutation, fields []string) bool {
+	for _, f := range append(m.Fields(), m.ClearedFields()...) {
+		for _, indexed := range fields {
+			if f == indexed {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// noteResourceText returns the searchable text of a note's resources: their names and any text
+// extracted from them
+func noteResourceText(n *ent.Note) string {
+	parts := make([]string, 0, len(n.Resources)*2)
+	for _, r := range n.Resources {
+		parts = append(parts, r.Name)
+		if r.ExtractedText != "" {
+			parts = append(parts, r.ExtractedText)
+		}
+	}
+	return strings.Join(parts, "\n")
+}
+
+// searchTerms splits a query into lowercase words, dropping punctuation so user input can't be
+// interpreted as FTS5 query syntax
+func searchTerms(query string) []string {
+	return strings.FieldsFunc(strings.ToLower(query), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// ftsQuery builds an FTS5 query matching documents that contain every term. The last term is
+// matched as a prefix, so results show up while the user is still typing.
+func ftsQuery(terms []string) string {
+	quoted := make([]string, len(terms))
+	for i, t := range terms {
+		quoted[i] = `"` + strings.ReplaceAll(t, `"`, `""`) + `"`
+	}
+	quoted[len(quoted)-1] += "*"
+	return strings.Join(quoted, " ")
+}
+
+// highlightSnippet escapes a snippet for HTML and turns the match markers into <mark> tags
+func highlightSnippet(snippet string) string {
+	snippet = html.EscapeString(snippet)
+	snippet = strings.ReplaceAll(snippet, snippetStart, "<mark>")
+	return strings.ReplaceAll(snippet, snippetEnd, "</mark>")
+}
+
+// likeRank scores how well the fields match the terms: the number of times each term appears,
+// weighted by field
+func likeRank(terms, fields []string, weights []float64) float64 {
+	var rank float64
+	for i, f := range fields {
+		f = strings.ToLower(f)
+		for _, t := range terms {
+			rank += float64(strings.Count(f, t)) * weights[i]
+		}
+	}
+	return rank
+}
+
+// likeSnippet returns a highlighted excerpt around the first match in the first field that
+// contains a term
+func likeSnippet(terms []string, fields ...string) string {
+	for _, f := range fields {
+		runes := []rune(f)
+		lower := strings.ToLower(f)
+		// Lowercasing can change the number of runes, in which case matching is case-sensitive
+		if utf8.RuneCountInString(lower) != len(runes) {
+			lower = f
+		}
+
+		first := -1
+		for _, t := range terms {
+			if i := strings.Index(lower, t); i >= 0 && (first < 0 || i < first) {
+				first = i
+			}
+		}
+		if first < 0 {
+			continue
+		}
+
+		center := utf8.RuneCountInString(lower[:first])
+		start, end := max(center-snippetRadius, 0), min(center+snippetRadius, len(runes))
+		excerpt := string(runes[start:end])
+		if start > 0 {
+			excerpt = "…" + excerpt
+		}
+		if end < len(runes) {
+			excerpt += "…"
+		}
+
+		return highlightSnippet(markTerms(excerpt, terms))
+	}
+	return ""
+}
+
+// markTerms wraps case-insensitive occurrences of the terms in match markers
+func markTerms(text string, terms []string) string {
+	lower := strings.ToLower(text)
+	if len(lower) != len(text) {
+		return text
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(text); {
+		matched := 0
+		for _, t := range terms {
+			if strings.HasPrefix(lower[i:], t) && len(t) > matched {
+				matched = len(t)
+			}
+		}
+		if matched > 0 {
+			b.WriteString(snippetStart + text[i:i+matched] + snippetEnd)
+			i += matched
+			continue
+		}
+		b.WriteByte(text[i])
+		i++
+	}
+	return b.String()
+}
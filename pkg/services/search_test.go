@@ -0,0 +1,253 @@
+package services
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/r-scheele/zero/ent"
+	"github.com/r-scheele/zero/ent/note"
+	"github.com/r-scheele/zero/pkg/tests"
+	"github.com/r-scheele/zero/pkg/types"
+	"github.com/r-scheele/zero/pkg/ui/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchService(t *testing.T) {
+	ctxb := context.Background()
+
+	owner, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	other, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+
+	private, err := c.ORM.Note.Create().
+		SetTitle("Mitochondria notes").
+		SetContent("The mitochondria is the powerhouse of the cell.").
+		SetOwner(owner).
+		Save(ctxb)
+	require.NoError(t, err)
+
+	public, err := c.ORM.Note.Create().
+		SetTitle("Plant biology").
+		SetDescription("How plants make food").
+		SetContent("<script>alert(1)</script> Leaves capture sunlight.").
+		SetVisibility(note.VisibilityPublic).
+		SetResources([]types.Resource{{
+			Name:          "lecture.pdf",
+			ExtractedText: "Chlorophyll absorbs light energy during photosynthesis.",
+		}}).
+		SetOwner(owner).
+		Save(ctxb)
+	require.NoError(t, err)
+
+	search := func(query string, viewerID *int) []*models.SearchResult {
+		res, err := c.Search.Search(ctxb, SearchParams{Query: query, ViewerID: viewerID}, 0, 0)
+		require.NoError(t, err)
+		return res.Results
+	}
+
+	noteIDs := func(results []*models.SearchResult) []int {
+		var ids []int
+		for _, r := range results {
+			if r.Type == models.SearchResultNote {
+				ids = append(ids, r.ID)
+			}
+		}
+		return ids
+	}
+
+	// Private notes are only found by their owner
+	results := search("mitochondria", &owner.ID)
+	assert.Equal(t, []int{private.ID}, noteIDs(results))
+
+	for _, viewer := range []*int{nil, &other.ID} {
+		results = search("mitochondria", viewer)
+		assert.Empty(t, noteIDs(results))
+	}
+
+	// Public notes are found by anyone, including through text extracted from their resources
+	results = search("chlorophyll", nil)
+	require.Equal(t, []int{public.ID}, noteIDs(results))
+	assert.Equal(t, "Plant biology", results[0].Title)
+	assert.Contains(t, results[0].Snippet, "<mark>Chlorophyll</mark>")
+
+	// Snippets are escaped
+	results = search("leaves", nil)
+	require.Equal(t, []int{public.ID}, noteIDs(results))
+	assert.NotContains(t, results[0].Snippet, "<script>")
+	assert.Contains(t, results[0].Snippet, "<mark>Leaves</mark>")
+
+	// Every term must match
+	results = search("leaves mitochondria", &owner.ID)
+	assert.Empty(t, noteIDs(results))
+
+	// Query syntax is ignored
+	results = search(`"chlorophyll* -(`, nil)
+	assert.Equal(t, []int{public.ID}, noteIDs(results))
+
+	// Edits and deletes are reflected, including those made in transactions
+	tx, err := c.ORM.Tx(ctxb)
+	require.NoError(t, err)
+	_, err = tx.Note.UpdateOne(public).SetTitle("Photosynthesis").Save(ctxb)
+	require.NoError(t, err)
+	require.NoError(t, tx.Commit())
+
+	results = search("photosynthesis", nil)
+	require.Equal(t, []int{public.ID}, noteIDs(results))
+	assert.Equal(t, "Photosynthesis", results[0].Title)
+
+	require.NoError(t, c.ORM.Note.DeleteOne(public).Exec(ctxb))
+	results = search("chlorophyll", nil)
+	assert.Empty(t, noteIDs(results))
+
+	// People are found by name
+	_, err = c.ORM.User.UpdateOne(other).SetName("Rosalind Franklin").Save(ctxb)
+	require.NoError(t, err)
+	results = search("rosalind", nil)
+	require.Len(t, results, 1)
+	assert.Equal(t, models.SearchResultUser, results[0].Type)
+	assert.Equal(t, other.ID, results[0].ID)
+
+	// Rebuilding the index finds the same results
+	require.NoError(t, c.Search.Reindex(ctxb))
+	results = search("mitochondria", &owner.ID)
+	assert.Equal(t, []int{private.ID}, noteIDs(results))
+
+	results = search("  !? ", nil)
+	assert.Empty(t, results)
+}
+
+func TestSearchService_Filters(t *testing.T) {
+	ctxb := context.Background()
+
+	alice, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	bob, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+
+	create := func(owner *ent.User, visibility note.Visibility, resourceType string, created time.Time) *ent.Note {
+		n, err := c.ORM.Note.Create().
+			SetTitle("Ribosome structure").
+			SetVisibility(visibility).
+			SetResources([]types.Resource{{Name: "ribosome", Type: resourceType}}).
+			SetCreatedAt(created).
+			SetOwner(owner).
+			Save(ctxb)
+		require.NoError(t, err)
+		return n
+	}
+
+	now := time.Now()
+	alicePDF := create(alice, note.VisibilityPublic, "pdf", now.AddDate(0, 0, -2))
+	alicePrivate := create(alice, note.VisibilityPrivate, "youtube", now.AddDate(0, 0, -20))
+	bobVideo := create(bob, note.VisibilityPublic, "youtube", now.AddDate(-2, 0, 0))
+	create(bob, note.VisibilityPrivate, "pdf", now)
+
+	_, err = c.ORM.NoteLike.Create().SetUser(alice).SetNote(bobVideo).Save(ctxb)
+	require.NoError(t, err)
+
+	search := func(params SearchParams, limit, offset int) *SearchResults {
+		params.Query = "ribosome"
+		params.ViewerID = &alice.ID
+		res, err := c.Search.Search(ctxb, params, limit, offset)
+		require.NoError(t, err)
+		return res
+	}
+
+	ids := func(res *SearchResults) []int {
+		var ids []int
+		for _, r := range res.Results {
+			ids = append(ids, r.ID)
+		}
+		sort.Ints(ids)
+		return ids
+	}
+
+	counts := func(facets []SearchFacet) map[string]int {
+		m := make(map[string]int)
+		for _, f := range facets {
+			m[f.Value] = f.Count
+		}
+		return m
+	}
+
+	// Bob's private note is never visible to Alice
+	res := search(SearchParams{}, 0, 0)
+	assert.Equal(t, []int{alicePDF.ID, alicePrivate.ID, bobVideo.ID}, ids(res))
+	assert.Equal(t, map[string]int{strconv.Itoa(alice.ID): 2, strconv.Itoa(bob.ID): 1}, counts(res.Facets.Owners))
+	assert.Equal(t, map[string]int{"public": 2, "private": 1}, counts(res.Facets.Visibility))
+	assert.Equal(t, map[string]int{"pdf": 1, "youtube": 2}, counts(res.Facets.ResourceTypes))
+	assert.Equal(t, 1, res.Facets.Liked)
+	assert.Len(t, res.Facets.Dates, 3)
+	assert.Equal(t, 1, res.Facets.Dates[0].Count)
+	assert.Equal(t, 2, res.Facets.Dates[2].Count)
+
+	// Filters combine, and each facet is counted with the other filters applied
+	res = search(SearchParams{ResourceType: "youtube"}, 0, 0)
+	assert.Equal(t, []int{alicePrivate.ID, bobVideo.ID}, ids(res))
+	assert.Equal(t, map[string]int{"pdf": 1, "youtube": 2}, counts(res.Facets.ResourceTypes))
+	assert.Equal(t, map[string]int{"public": 1, "private": 1}, counts(res.Facets.Visibility))
+
+	res = search(SearchParams{OwnerID: alice.ID, Visibility: "public"}, 0, 0)
+	assert.Equal(t, []int{alicePDF.ID}, ids(res))
+	assert.Equal(t, map[string]int{strconv.Itoa(alice.ID): 1, strconv.Itoa(bob.ID): 1}, counts(res.Facets.Owners))
+
+	res = search(SearchParams{From: now.AddDate(0, -1, 0), To: now.AddDate(0, 0, -1)}, 0, 0)
+	assert.Equal(t, []int{alicePDF.ID, alicePrivate.ID}, ids(res))
+
+	res = search(SearchParams{LikedByMe: true}, 0, 0)
+	assert.Equal(t, []int{bobVideo.ID}, ids(res))
+
+	// People aren't filtered, so they're left out of filtered searches
+	_, err = c.ORM.User.UpdateOne(bob).SetName("Ribosome Fan").Save(ctxb)
+	require.NoError(t, err)
+	res = search(SearchParams{}, 0, 0)
+	assert.Equal(t, 4, res.Total)
+	res = search(SearchParams{Visibility: "public"}, 0, 0)
+	assert.Equal(t, 2, res.Total)
+
+	// Results are paged
+	var paged []int
+	for offset := 0; offset < 4; offset += 3 {
+		res = search(SearchParams{}, 3, offset)
+		assert.Equal(t, 4, res.Total)
+		for _, r := range res.Results {
+			paged = append(paged, r.ID)
+		}
+	}
+	assert.Len(t, paged, 4)
+	assert.Empty(t, search(SearchParams{}, 3, 6).Results)
+
+	paged = nil
+	for offset := 0; offset < 2; offset++ {
+		res = search(SearchParams{Visibility: "public"}, 1, offset)
+		assert.Equal(t, 2, res.Total)
+		require.Len(t, res.Results, 1)
+		paged = append(paged, res.Results[0].ID)
+	}
+	sort.Ints(paged)
+	assert.Equal(t, []int{alicePDF.ID, bobVideo.ID}, paged)
+}
+
+func TestSearchTerms(t *testing.T) {
+	terms := searchTerms(`Cell "biology" OR (NEAR*) naïve`)
+	assert.Equal(t, []string{"cell", "biology", "or", "near", "naïve"}, terms)
+	assert.Equal(t, `"cell" "biology"*`, ftsQuery([]string{"cell", "biology"}))
+}
+
+func TestLikeSnippet(t *testing.T) {
+	assert.Equal(t, "The <mark>Cell</mark> &amp; its parts", likeSnippet([]string{"cell"}, "", "The Cell & its parts"))
+	assert.Empty(t, likeSnippet([]string{"cell"}, "nothing here"))
+
+	long := make([]byte, 300)
+	for i := range long {
+		long[i] = 'a'
+	}
+	snippet := likeSnippet([]string{"cell"}, string(long)+" cell "+string(long))
+	assert.Contains(t, snippet, "<mark>cell</mark>")
+	assert.True(t, len(snippet) < 250)
+}
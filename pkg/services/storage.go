@@ -2,16 +2,27 @@ package services
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"io"
+	"os"
+	"path"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 	"cloud.google.com/go/storage"
 	"github.com/Azure/azure-storage-blob-go/azblob"
+	"github.com/r-scheele/zero/config"
+	"github.com/spf13/afero"
 	"net/url"
 )
 
@@ -23,49 +34,222 @@ type StorageService interface {
 	// DeleteFile deletes a file from the storage backend
 	DeleteFile(ctx context.Context, key string) error
 	
-	// GetFileURL returns a public URL for accessing the file
+	// GetFileURL returns the URL of a file. Stored files are private, so use GetSignedURL to
+	// link to one.
 	GetFileURL(ctx context.Context, key string) (string, error)
 	
 	// GetSignedURL returns a signed URL for temporary access
 	GetSignedURL(ctx context.Context, key string, expiration int64) (string, error)
+
+	// OpenFile opens a file in the storage backend for reading
+	OpenFile(ctx context.Context, key string) (io.ReadCloser, error)
 }
 
-// LocalStorageService implements StorageService for local file system
+// Storage backends
+const (
+	StorageBackendLocal = "local"
+	StorageBackendS3    = "s3"
+	StorageBackendGCS   = "gcs"
+	StorageBackendAzure = "azure"
+)
+
+// NewStorageBackend creates the named storage backend from its configuration, whether or not it
+// is enabled. Local storage keeps its files in the given file system.
+func NewStorageBackend(cfg *config.Config, backend string, files afero.Fs) (StorageService, error) {
+	switch backend {
+	case StorageBackendLocal:
+		// Fallback to app host if local storage config is not set
+		baseURL := cfg.App.Host
+		if cfg.Storage.Local.Enabled && cfg.Storage.Local.BaseURL != "" {
+			baseURL = cfg.Storage.Local.BaseURL
+		}
+		return NewLocalStorageService(files, baseURL, cfg.App.EncryptionKey), nil
+	case StorageBackendS3:
+		return NewS3StorageService(cfg.Storage.AWS)
+	case StorageBackendGCS:
+		return NewGCSStorageService(cfg.Storage.GCS.Bucket, cfg.Storage.GCS.ProjectID)
+	case StorageBackendAzure:
+		return NewAzureBlobStorageService(cfg.Storage.Azure.Account, cfg.Storage.Azure.Key, cfg.Storage.Azure.Container)
+	default:
+		return nil, fmt.Errorf("unknown storage backend: %s", backend)
+	}
+}
+
+// LocalStorageDirectory is the directory, within the file system, that local storage keeps files in
+const LocalStorageDirectory = "storage"
+
+var (
+	// ErrInvalidStorageKey is returned when a storage key would escape the storage directory
+	ErrInvalidStorageKey = errors.New("invalid storage key")
+
+	// ErrInvalidSignature is returned when a signed URL's signature doesn't match its key and expiry
+	ErrInvalidSignature = errors.New("invalid signature")
+
+	// ErrSignatureExpired is returned when a signed URL has expired
+	ErrSignatureExpired = errors.New("signed URL has expired")
+)
+
+// LocalStorageService implements StorageService for local file system. Files are never served
+// directly; they can only be downloaded through signed URLs that expire.
 type LocalStorageService struct {
+	files   afero.Fs
 	baseURL string
+	secret  []byte
 }
 
-// NewLocalStorageService creates a new local storage service
-func NewLocalStorageService(baseURL string) *LocalStorageService {
+// NewLocalStorageService creates a new local storage service that keeps files in the
+// LocalStorageDirectory of the given file system and signs URLs with the given secret
+func NewLocalStorageService(files afero.Fs, baseURL, secret string) *LocalStorageService {
 	return &LocalStorageService{
-		baseURL: baseURL,
+		files:   afero.NewBasePathFs(files, LocalStorageDirectory),
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		secret:  []byte(secret),
 	}
 }
 
 // UploadFile uploads a file to local storage
 func (s *LocalStorageService) UploadFile(ctx context.Context, key string, reader io.Reader, contentType string) (string, error) {
-	// TODO: Implement local file upload
-	// For now, return a placeholder URL
-	return s.baseURL + "/files/" + key, nil
+	key, err := cleanStorageKey(key)
+	if err != nil {
+		return "", err
+	}
+
+	if err := s.files.MkdirAll(path.Dir(key), 0755); err != nil {
+		return "", fmt.Errorf("failed to create storage directory: %w", err)
+	}
+
+	// Write to a temporary file first so a failed upload never leaves a partial file behind
+	tmp := fmt.Sprintf("%s.%s.tmp", key, generateRandomString(8))
+	f, err := s.files.Create(tmp)
+	if err != nil {
+		return "", fmt.Errorf("failed to create file: %w", err)
+	}
+
+	_, err = io.Copy(f, reader)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = s.files.Rename(tmp, key)
+	}
+	if err != nil {
+		_ = s.files.Remove(tmp)
+		return "", fmt.Errorf("failed to write file: %w", err)
+	}
+
+	return s.GetFileURL(ctx, key)
 }
 
 // DeleteFile deletes a file from local storage
 func (s *LocalStorageService) DeleteFile(ctx context.Context, key string) error {
-	// TODO: Implement local file deletion
+	key, err := cleanStorageKey(key)
+	if err != nil {
+		return err
+	}
+
+	if err := s.files.Remove(key); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to delete file: %w", err)
+	}
+
 	return nil
 }
 
-// GetFileURL returns the public URL for a local file
+// GetFileURL returns the URL of a local file. It can't be downloaded without a signature; use
+// GetSignedURL to share a file.
 func (s *LocalStorageService) GetFileURL(ctx context.Context, key string) (string, error) {
-	return s.baseURL + "/files/" + key, nil
+	key, err := cleanStorageKey(key)
+	if err != nil {
+		return "", err
+	}
+
+	segments := strings.Split(key, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+
+	return s.baseURL + "/storage/" + strings.Join(segments, "/"), nil
 }
 
-// GetSignedURL returns a signed URL (same as public URL for local storage)
+// GetSignedURL returns a URL a local file can be downloaded from until it expires
 func (s *LocalStorageService) GetSignedURL(ctx context.Context, key string, expiration int64) (string, error) {
-	return s.GetFileURL(ctx, key)
+	fileURL, err := s.GetFileURL(ctx, key)
+	if err != nil {
+		return "", err
+	}
+
+	key, _ = cleanStorageKey(key)
+	expires := time.Now().Add(time.Duration(expiration) * time.Second).Unix()
+	q := url.Values{}
+	q.Set("expires", strconv.FormatInt(expires, 10))
+	q.Set("signature", s.sign(key, expires))
+
+	return fileURL + "?" + q.Encode(), nil
+}
+
+// OpenFile opens a file in local storage for reading
+func (s *LocalStorageService) OpenFile(ctx context.Context, key string) (io.ReadCloser, error) {
+	return s.Open(key)
+}
+
+// Open opens a file in local storage. Unlike OpenFile, the file can be seeked and stat'd.
+func (s *LocalStorageService) Open(key string) (afero.File, error) {
+	key, err := cleanStorageKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := s.files.Open(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file: %w", err)
+	}
+
+	return f, nil
+}
+
+// VerifySignature checks that a signed URL's expiry and signature, as given in its query
+// string, are valid for the key at the given time
+func (s *LocalStorageService) VerifySignature(key, expires, signature string, now time.Time) error {
+	key, err := cleanStorageKey(key)
+	if err != nil {
+		return err
+	}
+
+	exp, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+
+	if !hmac.Equal([]byte(signature), []byte(s.sign(key, exp))) {
+		return ErrInvalidSignature
+	}
+
+	if now.Unix() > exp {
+		return ErrSignatureExpired
+	}
+
+	return nil
+}
+
+// sign computes the signature of a key and expiry time
+func (s *LocalStorageService) sign(key string, expires int64) string {
+	mac := hmac.New(sha256.New, s.secret)
+	fmt.Fprintf(mac, "%s\n%d", key, expires)
+	return hex.EncodeToString(mac.Sum(nil))
 }
 
-// S3StorageService implements StorageService for AWS S3
+// cleanStorageKey normalizes a storage key, rejecting any that would point outside of storage
+func cleanStorageKey(key string) (string, error) {
+	cleaned := strings.TrimPrefix(path.Clean("/"+key), "/")
+	if cleaned == "" || cleaned != strings.TrimPrefix(key, "/") || strings.Contains(key, "\\") {
+		return "", ErrInvalidStorageKey
+	}
+	return cleaned, nil
+}
+
+// DefaultS3PartSize is the size of the parts large files are streamed to S3 in
+const DefaultS3PartSize = 8 * 1024 * 1024
+
+// S3StorageService implements StorageService for AWS S3 and S3-compatible services such as MinIO
 type S3StorageService struct {
 	session    *session.Session
 	uploader   *s3manager.Uploader
@@ -73,24 +257,63 @@ type S3StorageService struct {
 	s3Client   *s3.S3
 	bucket     string
 	region     string
+	endpoint   *url.URL
+	pathStyle  bool
 }
 
-// NewS3StorageService creates a new S3 storage service
-func NewS3StorageService(bucket, region string) (*S3StorageService, error) {
-	sess, err := session.NewSession(&aws.Config{
-		Region: aws.String(region),
-	})
+// NewS3StorageService creates a new S3 storage service. Files larger than the configured part size
+// are streamed in parts with a multipart upload, so they never have to fit in memory.
+func NewS3StorageService(cfg config.AWSStorageConfig) (*S3StorageService, error) {
+	region := cfg.Region
+	if region == "" {
+		region = "us-east-1"
+	}
+
+	awsCfg := &aws.Config{
+		Region:           aws.String(region),
+		S3ForcePathStyle: aws.Bool(cfg.PathStyle),
+	}
+
+	var endpoint *url.URL
+	if cfg.Endpoint != "" {
+		var err error
+		endpoint, err = url.Parse(strings.TrimSuffix(cfg.Endpoint, "/"))
+		if err != nil || endpoint.Scheme == "" || endpoint.Host == "" {
+			return nil, fmt.Errorf("invalid S3 endpoint: %s", cfg.Endpoint)
+		}
+		awsCfg.Endpoint = aws.String(endpoint.String())
+	}
+
+	// Fall back to the default credential chain (environment, shared config, instance role)
+	if cfg.AccessKeyID != "" {
+		awsCfg.Credentials = credentials.NewStaticCredentials(cfg.AccessKeyID, cfg.SecretAccessKey, "")
+	}
+
+	partSize := int64(DefaultS3PartSize)
+	if cfg.PartSize != "" {
+		size, err := ParseFileSize(cfg.PartSize)
+		if err != nil {
+			return nil, fmt.Errorf("invalid S3 part size: %w", err)
+		}
+		partSize = max(size, s3manager.MinUploadPartSize)
+	}
+
+	sess, err := session.NewSession(awsCfg)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create AWS session: %w", err)
 	}
 
 	return &S3StorageService{
-		session:    sess,
-		uploader:   s3manager.NewUploader(sess),
+		session: sess,
+		uploader: s3manager.NewUploader(sess, func(u *s3manager.Uploader) {
+			u.PartSize = partSize
+		}),
 		downloader: s3manager.NewDownloader(sess),
 		s3Client:   s3.New(sess),
-		bucket:     bucket,
+		bucket:     cfg.Bucket,
 		region:     region,
+		endpoint:   endpoint,
+		pathStyle:  cfg.PathStyle,
 	}, nil
 }
 
@@ -101,7 +324,6 @@ func (s *S3StorageService) UploadFile(ctx context.Context, key string, reader io
 		Key:         aws.String(key),
 		Body:        reader,
 		ContentType: aws.String(contentType),
-		ACL:         aws.String("public-read"), // Make files publicly accessible
 	}
 
 	result, err := s.uploader.UploadWithContext(ctx, uploadInput)
@@ -127,9 +349,22 @@ func (s *S3StorageService) DeleteFile(ctx context.Context, key string) error {
 	return nil
 }
 
-// GetFileURL returns the public URL for an S3 file
+// GetFileURL returns the URL of an S3 file. Objects are private, so it can't be downloaded
+// without a signature; use GetSignedURL to share a file.
 func (s *S3StorageService) GetFileURL(ctx context.Context, key string) (string, error) {
-	return fmt.Sprintf("https://%s.s3.%s.amazonaws.com/%s", s.bucket, s.region, key), nil
+	if s.endpoint == nil {
+		return fmt.Sprintf("https://%s.s3.%s.amazonaws.com/%s", s.bucket, s.region, key), nil
+	}
+
+	u := *s.endpoint
+	if s.pathStyle {
+		u.Path = path.Join(u.Path, s.bucket, key)
+	} else {
+		u.Host = s.bucket + "." + u.Host
+		u.Path = path.Join(u.Path, key)
+	}
+
+	return u.String(), nil
 }
 
 // GetSignedURL returns a signed URL for temporary access to S3 file
@@ -147,6 +382,19 @@ func (s *S3StorageService) GetSignedURL(ctx context.Context, key string, expirat
 	return urlStr, nil
 }
 
+// OpenFile opens an S3 file for reading
+func (s *S3StorageService) OpenFile(ctx context.Context, key string) (io.ReadCloser, error) {
+	result, err := s.s3Client.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read from S3: %w", err)
+	}
+
+	return result.Body, nil
+}
+
 // GCSStorageService implements StorageService for Google Cloud Storage
 type GCSStorageService struct {
 	client     *storage.Client
@@ -175,7 +423,6 @@ func (s *GCSStorageService) UploadFile(ctx context.Context, key string, reader i
 
 	w := obj.NewWriter(ctx)
 	w.ContentType = contentType
-	w.ACL = []storage.ACLRule{{Entity: storage.AllUsers, Role: storage.RoleReader}} // Make publicly readable
 
 	if _, err := io.Copy(w, reader); err != nil {
 		w.Close()
@@ -201,7 +448,8 @@ func (s *GCSStorageService) DeleteFile(ctx context.Context, key string) error {
 	return nil
 }
 
-// GetFileURL returns the public URL for a GCS file
+// GetFileURL returns the URL of a GCS file. Objects are private, so it can't be downloaded
+// without a signature; use GetSignedURL to share a file.
 func (s *GCSStorageService) GetFileURL(ctx context.Context, key string) (string, error) {
 	return fmt.Sprintf("https://storage.googleapis.com/%s/%s", s.bucket, key), nil
 }
@@ -222,6 +470,16 @@ func (s *GCSStorageService) GetSignedURL(ctx context.Context, key string, expira
 	return urlStr, nil
 }
 
+// OpenFile opens a GCS file for reading
+func (s *GCSStorageService) OpenFile(ctx context.Context, key string) (io.ReadCloser, error) {
+	r, err := s.client.Bucket(s.bucket).Object(key).NewReader(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read from GCS: %w", err)
+	}
+
+	return r, nil
+}
+
 // AzureBlobStorageService implements StorageService for Azure Blob Storage
 type AzureBlobStorageService struct {
 	serviceURL   azblob.ServiceURL
@@ -312,4 +570,16 @@ func (s *AzureBlobStorageService) GetSignedURL(ctx context.Context, key string,
 	}
 
 	return fmt.Sprintf("%s?%s", blobURL.String(), sasQueryParams.Encode()), nil
+}
+
+// OpenFile opens an Azure Blob file for reading
+func (s *AzureBlobStorageService) OpenFile(ctx context.Context, key string) (io.ReadCloser, error) {
+	blobURL := s.containerURL.NewBlobURL(key)
+
+	resp, err := blobURL.Download(ctx, 0, azblob.CountToEnd, azblob.BlobAccessConditions{}, false, azblob.ClientProvidedKeyOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read from Azure Blob: %w", err)
+	}
+
+	return resp.Body(azblob.RetryReaderOptions{}), nil
 }
\ No newline at end of file
@@ -0,0 +1,382 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"mime"
+	"net/url"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/r-scheele/zero/ent"
+	"github.com/r-scheele/zero/ent/blob"
+	"github.com/r-scheele/zero/ent/document"
+	"github.com/r-scheele/zero/ent/note"
+	"github.com/r-scheele/zero/ent/user"
+	"github.com/r-scheele/zero/pkg/types"
+	"github.com/spf13/afero"
+)
+
+// storageMigrationBatch is how many rows are loaded at a time while migrating storage
+const storageMigrationBatch = 100
+
+// StorageMigration copies the files referenced by the database from one storage backend to
+// another. Files are copied once however many notes, documents and profiles share them, and files
+// already in the target are skipped, so an interrupted migration can simply be run again. Rows
+// that still refer to files by URL or file name are updated to refer to them by storage key.
+type StorageMigration struct {
+	orm   *ent.Client
+	files afero.Fs
+	from  StorageService
+	to    StorageService
+
+	// DryRun reports what would be copied without copying anything or changing the database
+	DryRun bool
+
+	// Out receives a line for each file copied, or that would be copied, or that failed
+	Out io.Writer
+
+	fromPrefix string
+	toPrefix   string
+
+	// copied records, by source key, whether each file handled so far is in the target
+	copied map[string]bool
+}
+
+// StorageMigrationReport summarizes a storage migration
+type StorageMigrationReport struct {
+	Objects int
+	Bytes   int64
+	Skipped int
+	Failed  int
+}
+
+// storageObject is a file to be migrated
+type storageObject struct {
+	key         string
+	contentType string
+	size        int64
+
+	// legacy is set for files saved directly to the file system before storage backends existed,
+	// and key is then their path within it
+	legacy bool
+}
+
+// target is the key the file is stored under in the target storage
+func (o storageObject) target() string {
+	if o.legacy {
+		return "profiles/" + path.Base(o.key)
+	}
+	return o.key
+}
+
+// NewStorageMigration creates a migration between two storage backends. Files in the given file
+// system that predate storage backends, such as old profile pictures, are migrated too.
+func NewStorageMigration(orm *ent.Client, files afero.Fs, from, to StorageService) *StorageMigration {
+	return &StorageMigration{
+		orm:   orm,
+		files: files,
+		from:  from,
+		to:    to,
+		Out:   io.Discard,
+	}
+}
+
+// Run performs the migration. Files that fail to copy are reported and skipped rather than
+// stopping the migration.
+func (m *StorageMigration) Run(ctx context.Context) (*StorageMigrationReport, error) {
+	var err error
+	if m.fromPrefix, err = storageURLPrefix(ctx, m.from); err != nil {
+		return nil, err
+	}
+	if m.toPrefix, err = storageURLPrefix(ctx, m.to); err != nil {
+		return nil, err
+	}
+	if m.fromPrefix == m.toPrefix {
+		return nil, errors.New("the source and target storage are the same")
+	}
+
+	m.copied = make(map[string]bool)
+	report := &StorageMigrationReport{}
+	if err := m.migrateBlobs(ctx, report); err != nil {
+		return report, err
+	}
+	if err := m.migrateNotes(ctx, report); err != nil {
+		return report, err
+	}
+	if err := m.migrateDocuments(ctx, report); err != nil {
+		return report, err
+	}
+	if err := m.migrateUsers(ctx, report); err != nil {
+		return report, err
+	}
+
+	return report, nil
+}
+
+// migrateBlobs migrates the deduplicated files that uploads are stored as
+func (m *StorageMigration) migrateBlobs(ctx context.Context, report *StorageMigrationReport) error {
+	lastID := 0
+	for {
+		blobs, err := m.orm.Blob.Query().
+			Where(blob.IDGT(lastID)).
+			Order(ent.Asc(blob.FieldID)).
+			Limit(storageMigrationBatch).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to load blobs: %w", err)
+		}
+		if len(blobs) == 0 {
+			return nil
+		}
+
+		for _, b := range blobs {
+			lastID = b.ID
+			obj := storageObject{key: b.StorageKey, contentType: b.MimeType, size: b.Size}
+			m.copy(ctx, fmt.Sprintf("blob %d", b.ID), obj, report)
+		}
+	}
+}
+
+// migrateNotes migrates the thumbnails of note resources, and files uploaded to notes that aren't
+// stored as blobs. Files uploaded before storage keys were recorded are found from their URL.
+func (m *StorageMigration) migrateNotes(ctx context.Context, report *StorageMigrationReport) error {
+	lastID := 0
+	for {
+		notes, err := m.orm.Note.Query().
+			Where(note.IDGT(lastID)).
+			Order(ent.Asc(note.FieldID)).
+			Limit(storageMigrationBatch).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to load notes: %w", err)
+		}
+		if len(notes) == 0 {
+			return nil
+		}
+
+		for _, n := range notes {
+			lastID = n.ID
+			owner := fmt.Sprintf("note %d", n.ID)
+			resources := make([]types.Resource, len(n.Resources))
+			copy(resources, n.Resources)
+
+			changed := false
+			for i, r := range resources {
+				for _, size := range slices.Sorted(maps.Keys(r.Thumbnails)) {
+					m.copy(ctx, owner, storageObject{key: r.Thumbnails[size], contentType: "image/jpeg"}, report)
+				}
+
+				if r.Key != "" {
+					m.copy(ctx, owner, storageObject{key: r.Key, contentType: r.MimeType, size: r.Size}, report)
+					continue
+				}
+
+				obj, ok := m.locate(r.URL)
+				if !ok {
+					continue
+				}
+				obj.contentType = r.MimeType
+				obj.size = r.Size
+				if !m.copy(ctx, owner, obj, report) {
+					continue
+				}
+
+				// Links to files are signed when they're viewed, so only the key is kept
+				resources[i].Key = obj.target()
+				resources[i].URL = ""
+				changed = true
+			}
+
+			if changed {
+				if err := m.orm.Note.UpdateOneID(n.ID).SetResources(resources).Exec(ctx); err != nil {
+					return fmt.Errorf("failed to update note %d: %w", n.ID, err)
+				}
+			}
+		}
+	}
+}
+
+// migrateDocuments migrates the files in the document library that aren't stored as blobs
+func (m *StorageMigration) migrateDocuments(ctx context.Context, report *StorageMigrationReport) error {
+	lastID := 0
+	for {
+		docs, err := m.orm.Document.Query().
+			Where(document.IDGT(lastID)).
+			Order(ent.Asc(document.FieldID)).
+			Limit(storageMigrationBatch).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to load documents: %w", err)
+		}
+		if len(docs) == 0 {
+			return nil
+		}
+
+		for _, d := range docs {
+			lastID = d.ID
+			obj := storageObject{key: d.StorageKey, contentType: d.MimeType, size: d.Size}
+			m.copy(ctx, fmt.Sprintf("document %d", d.ID), obj, report)
+		}
+	}
+}
+
+// migrateUsers migrates profile pictures that aren't stored as blobs. Pictures saved before
+// storage backends existed are stored as file names in the file system, and move to the profiles
+// directory of the target.
+func (m *StorageMigration) migrateUsers(ctx context.Context, report *StorageMigrationReport) error {
+	lastID := 0
+	for {
+		users, err := m.orm.User.Query().
+			Where(user.IDGT(lastID), user.ProfilePictureNotNil(), user.ProfilePictureNEQ("")).
+			Order(ent.Asc(user.FieldID)).
+			Limit(storageMigrationBatch).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to load users: %w", err)
+		}
+		if len(users) == 0 {
+			return nil
+		}
+
+		for _, u := range users {
+			lastID = u.ID
+			owner := fmt.Sprintf("user %d", u.ID)
+			picture := *u.ProfilePicture
+
+			// A storage key
+			if strings.Contains(picture, "/") && !strings.HasPrefix(picture, "/") && !strings.Contains(picture, "://") {
+				m.copy(ctx, owner, storageObject{key: picture, contentType: mime.TypeByExtension(path.Ext(picture))}, report)
+				continue
+			}
+
+			obj, ok := m.locate(picture)
+			if !ok {
+				continue
+			}
+			obj.contentType = mime.TypeByExtension(path.Ext(obj.key))
+			if !m.copy(ctx, owner, obj, report) {
+				continue
+			}
+			if err := m.orm.User.UpdateOneID(u.ID).SetProfilePicture(obj.target()).Exec(ctx); err != nil {
+				return fmt.Errorf("failed to update user %d: %w", u.ID, err)
+			}
+		}
+	}
+}
+
+// locate works out where a file referenced by URL is stored. It returns false for links to
+// anything other than a stored file.
+func (m *StorageMigration) locate(fileURL string) (storageObject, bool) {
+	for _, prefix := range []string{m.fromPrefix, m.toPrefix} {
+		if strings.HasPrefix(fileURL, prefix) {
+			k, err := url.PathUnescape(strings.TrimPrefix(fileURL, prefix))
+			if err != nil || k == "" {
+				return storageObject{}, false
+			}
+			return storageObject{key: k}, true
+		}
+	}
+
+	if fileURL == "" || strings.Contains(fileURL, "://") || strings.HasPrefix(fileURL, "/") {
+		return storageObject{}, false
+	}
+
+	// A path in the file system from before storage backends existed
+	clean := path.Clean(fileURL)
+	if strings.HasPrefix(clean, "..") {
+		return storageObject{}, false
+	}
+	if _, err := m.files.Stat(clean); err != nil {
+		return storageObject{}, false
+	}
+	return storageObject{key: clean, legacy: true}, true
+}
+
+// copy copies a file to the target storage, unless it's already there. It returns whether the
+// file is now in the target, which is never the case for a dry run.
+func (m *StorageMigration) copy(ctx context.Context, owner string, obj storageObject, report *StorageMigrationReport) bool {
+	if done, ok := m.copied[obj.key]; ok {
+		return done
+	}
+	done := m.copyOnce(ctx, owner, obj, report)
+	m.copied[obj.key] = done
+	return done
+}
+
+// copyOnce copies a file that hasn't been seen yet during the migration
+func (m *StorageMigration) copyOnce(ctx context.Context, owner string, obj storageObject, report *StorageMigrationReport) bool {
+	target := obj.target()
+
+	// Copied by an earlier run
+	if existing, err := m.to.OpenFile(ctx, target); err == nil {
+		existing.Close()
+		report.Skipped++
+		return true
+	}
+
+	src, err := m.open(ctx, obj)
+	if err != nil {
+		report.Failed++
+		fmt.Fprintf(m.Out, "failed %s (%s): %v\n", obj.key, owner, err)
+		return false
+	}
+	defer src.Close()
+
+	if m.DryRun {
+		size := obj.size
+		if size == 0 {
+			size, _ = io.Copy(io.Discard, src)
+		}
+		report.Objects++
+		report.Bytes += size
+		fmt.Fprintf(m.Out, "would copy %s (%s): %s\n", obj.key, owner, FormatFileSize(size))
+		return false
+	}
+
+	counter := &countingReader{r: src}
+	if _, err := m.to.UploadFile(ctx, target, counter, obj.contentType); err != nil {
+		report.Failed++
+		fmt.Fprintf(m.Out, "failed %s (%s): %v\n", obj.key, owner, err)
+		return false
+	}
+
+	report.Objects++
+	report.Bytes += counter.n
+	fmt.Fprintf(m.Out, "copied %s (%s): %s\n", obj.key, owner, FormatFileSize(counter.n))
+	return true
+}
+
+// open opens a file in the source storage
+func (m *StorageMigration) open(ctx context.Context, obj storageObject) (io.ReadCloser, error) {
+	if obj.legacy {
+		return m.files.Open(obj.key)
+	}
+	return m.from.OpenFile(ctx, obj.key)
+}
+
+// storageURLPrefix works out the prefix a storage backend gives the URLs of its files
+func storageURLPrefix(ctx context.Context, storage StorageService) (string, error) {
+	const probe = "probe"
+	u, err := storage.GetFileURL(ctx, probe)
+	if err != nil {
+		return "", fmt.Errorf("failed to determine storage URLs: %w", err)
+	}
+	return strings.TrimSuffix(u, probe), nil
+}
+
+// countingReader counts the bytes read through it
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,113 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/r-scheele/zero/pkg/tests"
+	"github.com/r-scheele/zero/pkg/types"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorageMigration(t *testing.T) {
+	ctx := context.Background()
+	files := afero.NewMemMapFs()
+	from := &memoryStorage{files: make(map[string]string), baseURL: "http://localhost:8000/storage/"}
+	to := &memoryStorage{files: make(map[string]string), baseURL: "https://zero.s3.example.com/"}
+
+	owner, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+
+	// Other tests leave behind files in storage that isn't being migrated
+	baseline := NewStorageMigration(c.ORM, files, from, to)
+	baseline.DryRun = true
+	leftover, err := baseline.Run(ctx)
+	require.NoError(t, err)
+
+	// A note with files uploaded with and without storage keys, an image with a thumbnail, a
+	// link, and a file that's missing
+	from.files["notes/1/cells.pdf"] = "%PDF-"
+	from.files["notes/1/old notes.txt"] = "mitochondria"
+	from.files["notes/1/photo.png"] = "png"
+	from.files["notes/1/photo_small.jpg"] = "jpg"
+	n, err := c.ORM.Note.Create().
+		SetTitle("Cells").
+		SetOwner(owner).
+		SetResources([]types.Resource{
+			{Type: "pdf", Name: "cells.pdf", Key: "notes/1/cells.pdf", URL: from.fileURL("notes/1/cells.pdf"), Size: 5, MimeType: "application/pdf"},
+			{Type: "text", Name: "old notes.txt", URL: from.fileURL("notes/1/old%20notes.txt"), Size: 12},
+			{Type: "image", Name: "photo.png", Key: "notes/1/photo.png", Size: 3, MimeType: "image/png", Thumbnails: map[string]string{ThumbnailSmall: "notes/1/photo_small.jpg"}},
+			{Type: "youtube", Name: "Lecture", URL: "https://www.youtube.com/watch?v=abc"},
+			{Type: "file", Name: "missing.bin", Key: "notes/1/missing.bin", Size: 3},
+		}).
+		Save(ctx)
+	require.NoError(t, err)
+
+	// Two documents sharing a blob, and a profile picture saved to the file system before storage
+	// backends existed
+	docs := NewDocumentService(c.ORM, from, NewBlobService(c.ORM, from, 0))
+	var docIDs []int
+	for _, name := range []string{"slides.pdf", "slides copy.pdf"} {
+		doc, err := docs.Upload(ctx, owner.ID, DocumentUpload{
+			FileName: name,
+			MimeType: "application/pdf",
+			Size:     6,
+			Body:     strings.NewReader("slides"),
+		}, DocumentInput{})
+		require.NoError(t, err)
+		docIDs = append(docIDs, doc.ID)
+	}
+
+	require.NoError(t, afero.WriteFile(files, "profile_1.png", []byte("png"), 0644))
+	owner, err = owner.Update().SetProfilePicture("profile_1.png").Save(ctx)
+	require.NoError(t, err)
+
+	// A dry run reports what would be copied, and what's missing, without changing anything
+	migration := NewStorageMigration(c.ORM, files, from, to)
+	migration.DryRun = true
+	report, err := migration.Run(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, StorageMigrationReport{Objects: 6, Bytes: 6 + 5 + 12 + 3 + 3 + 3, Failed: leftover.Failed + 1}, *report)
+	assert.Empty(t, to.files)
+	unchanged, err := c.ORM.Note.Get(ctx, n.ID)
+	require.NoError(t, err)
+	assert.Equal(t, n.Resources, unchanged.Resources)
+
+	// Each file is copied once and the database refers to the copies; the missing file is reported
+	migration.DryRun = false
+	report, err = migration.Run(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, StorageMigrationReport{Objects: 6, Bytes: 6 + 5 + 12 + 3 + 3 + 3, Failed: leftover.Failed + 1}, *report)
+	assert.Equal(t, "mitochondria", to.files["notes/1/old notes.txt"])
+	assert.Equal(t, "jpg", to.files["notes/1/photo_small.jpg"])
+	assert.Equal(t, "png", to.files["profiles/profile_1.png"])
+
+	n, err = c.ORM.Note.Get(ctx, n.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "notes/1/old notes.txt", n.Resources[1].Key)
+	assert.Empty(t, n.Resources[1].URL)
+	assert.Equal(t, "https://www.youtube.com/watch?v=abc", n.Resources[3].URL)
+
+	for _, id := range docIDs {
+		doc, err := c.ORM.Document.Get(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "slides", to.files[doc.StorageKey])
+	}
+
+	owner, err = c.ORM.User.Get(ctx, owner.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "profiles/profile_1.png", *owner.ProfilePicture)
+
+	// Running again only retries what failed
+	from.files["notes/1/missing.bin"] = "bin"
+	report, err = migration.Run(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, StorageMigrationReport{Objects: 1, Bytes: 3, Skipped: 6, Failed: leftover.Failed}, *report)
+
+	// Migrating to the same storage is refused
+	_, err = NewStorageMigration(c.ORM, files, from, from).Run(ctx)
+	assert.Error(t, err)
+}
@@ -0,0 +1,234 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/r-scheele/zero/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeS3 is an in-memory S3 server supporting the path-style object and multipart upload calls
+// used by S3StorageService
+type fakeS3 struct {
+	mu        sync.Mutex
+	objects   map[string][]byte
+	uploads   map[string]map[int][]byte
+	multipart int
+}
+
+func newFakeS3() *fakeS3 {
+	return &fakeS3{
+		objects: make(map[string][]byte),
+		uploads: make(map[string]map[int][]byte),
+	}
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	// Requests must be signed with the configured credentials
+	if !strings.Contains(r.Header.Get("Authorization"), "Credential=minio/") && r.URL.Query().Get("X-Amz-Credential") == "" {
+		f.error(w, http.StatusForbidden, "AccessDenied")
+		return
+	}
+
+	path := strings.TrimPrefix(r.URL.Path, "/")
+	q := r.URL.Query()
+	body, _ := io.ReadAll(r.Body)
+
+	switch {
+	case r.Method == http.MethodPost && q.Has("uploads"):
+		id := fmt.Sprint(len(f.uploads) + 1)
+		f.uploads[id] = make(map[int][]byte)
+		f.multipart++
+		bucket, key, _ := strings.Cut(path, "/")
+		f.xml(w, struct {
+			XMLName  xml.Name `xml:"InitiateMultipartUploadResult"`
+			Bucket   string
+			Key      string
+			UploadId string
+		}{Bucket: bucket, Key: key, UploadId: id})
+
+	case r.Method == http.MethodPut && q.Has("uploadId"):
+		parts, ok := f.uploads[q.Get("uploadId")]
+		if !ok {
+			f.error(w, http.StatusNotFound, "NoSuchUpload")
+			return
+		}
+		n, _ := strconv.Atoi(q.Get("partNumber"))
+		parts[n] = body
+		w.Header().Set("ETag", fmt.Sprintf(`"part-%d"`, n))
+
+	case r.Method == http.MethodPost && q.Has("uploadId"):
+		parts, ok := f.uploads[q.Get("uploadId")]
+		if !ok {
+			f.error(w, http.StatusNotFound, "NoSuchUpload")
+			return
+		}
+		numbers := make([]int, 0, len(parts))
+		for n := range parts {
+			numbers = append(numbers, n)
+		}
+		sort.Ints(numbers)
+		var object []byte
+		for _, n := range numbers {
+			object = append(object, parts[n]...)
+		}
+		f.objects[path] = object
+		delete(f.uploads, q.Get("uploadId"))
+		bucket, key, _ := strings.Cut(path, "/")
+		f.xml(w, struct {
+			XMLName  xml.Name `xml:"CompleteMultipartUploadResult"`
+			Location string
+			Bucket   string
+			Key      string
+			ETag     string
+		}{Location: "http://" + r.Host + "/" + path, Bucket: bucket, Key: key, ETag: `"object"`})
+
+	case r.Method == http.MethodDelete && q.Has("uploadId"):
+		delete(f.uploads, q.Get("uploadId"))
+		w.WriteHeader(http.StatusNoContent)
+
+	case r.Method == http.MethodPut:
+		f.objects[path] = body
+		w.Header().Set("ETag", `"object"`)
+
+	case r.Method == http.MethodGet:
+		object, ok := f.objects[path]
+		if !ok {
+			f.error(w, http.StatusNotFound, "NoSuchKey")
+			return
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(object)))
+		_, _ = w.Write(object)
+
+	case r.Method == http.MethodDelete:
+		delete(f.objects, path)
+		w.WriteHeader(http.StatusNoContent)
+
+	default:
+		f.error(w, http.StatusNotImplemented, "NotImplemented")
+	}
+}
+
+func (f *fakeS3) xml(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/xml")
+	_ = xml.NewEncoder(w).Encode(v)
+}
+
+func (f *fakeS3) error(w http.ResponseWriter, status int, code string) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	fmt.Fprintf(w, "<Error><Code>%s</Code><Message>%s</Message></Error>", code, code)
+}
+
+func newTestS3Storage(t *testing.T) (*S3StorageService, *fakeS3, *httptest.Server) {
+	fake := newFakeS3()
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+
+	storage, err := NewS3StorageService(config.AWSStorageConfig{
+		Bucket:          "zero",
+		Endpoint:        srv.URL,
+		PathStyle:       true,
+		AccessKeyID:     "minio",
+		SecretAccessKey: "minio123",
+		PartSize:        "5MB",
+	})
+	require.NoError(t, err)
+
+	return storage, fake, srv
+}
+
+func TestS3StorageService(t *testing.T) {
+	ctx := context.Background()
+	storage, fake, srv := newTestS3Storage(t)
+
+	// Small files are uploaded in one request
+	fileURL, err := storage.UploadFile(ctx, "notes/1/cells.txt", strings.NewReader("mitochondria"), "text/plain")
+	require.NoError(t, err)
+	assert.Equal(t, srv.URL+"/zero/notes/1/cells.txt", fileURL)
+	assert.Equal(t, "mitochondria", string(fake.objects["zero/notes/1/cells.txt"]))
+	assert.Zero(t, fake.multipart)
+
+	publicURL, err := storage.GetFileURL(ctx, "notes/1/cells.txt")
+	require.NoError(t, err)
+	assert.Equal(t, fileURL, publicURL)
+
+	r, err := storage.OpenFile(ctx, "notes/1/cells.txt")
+	require.NoError(t, err)
+	b, err := io.ReadAll(r)
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+	assert.Equal(t, "mitochondria", string(b))
+
+	// Signed URLs point at the endpoint and can be downloaded without credentials
+	signed, err := storage.GetSignedURL(ctx, "notes/1/cells.txt", 60)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(signed, srv.URL+"/zero/notes/1/cells.txt?"))
+	assert.Contains(t, signed, "X-Amz-Signature=")
+	resp, err := http.Get(signed)
+	require.NoError(t, err)
+	b, err = io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, "mitochondria", string(b))
+
+	// Deleted files can't be opened
+	require.NoError(t, storage.DeleteFile(ctx, "notes/1/cells.txt"))
+	_, err = storage.OpenFile(ctx, "notes/1/cells.txt")
+	assert.Error(t, err)
+}
+
+func TestS3StorageService_Multipart(t *testing.T) {
+	ctx := context.Background()
+	storage, fake, _ := newTestS3Storage(t)
+
+	// Large files are streamed in parts, from a reader that can't be seeked or buffered whole
+	data := make([]byte, 12*1024*1024)
+	_, err := rand.Read(data)
+	require.NoError(t, err)
+
+	_, err = storage.UploadFile(ctx, "documents/lecture.mp4", io.MultiReader(bytes.NewReader(data)), "video/mp4")
+	require.NoError(t, err)
+	assert.Equal(t, 1, fake.multipart)
+	assert.Empty(t, fake.uploads, "the multipart upload is completed")
+	assert.True(t, bytes.Equal(data, fake.objects["zero/documents/lecture.mp4"]))
+}
+
+func TestS3StorageService_Config(t *testing.T) {
+	ctx := context.Background()
+
+	// Without an endpoint, URLs point at AWS
+	storage, err := NewS3StorageService(config.AWSStorageConfig{Bucket: "zero", Region: "eu-west-1"})
+	require.NoError(t, err)
+	fileURL, err := storage.GetFileURL(ctx, "notes/1/cells.txt")
+	require.NoError(t, err)
+	assert.Equal(t, "https://zero.s3.eu-west-1.amazonaws.com/notes/1/cells.txt", fileURL)
+
+	// Virtual-hosted endpoints put the bucket in the host name
+	storage, err = NewS3StorageService(config.AWSStorageConfig{Bucket: "zero", Endpoint: "https://s3.example.com/"})
+	require.NoError(t, err)
+	fileURL, err = storage.GetFileURL(ctx, "notes/1/cells.txt")
+	require.NoError(t, err)
+	assert.Equal(t, "https://zero.s3.example.com/notes/1/cells.txt", fileURL)
+
+	_, err = NewS3StorageService(config.AWSStorageConfig{Bucket: "zero", Endpoint: "s3.example.com"})
+	assert.Error(t, err)
+	_, err = NewS3StorageService(config.AWSStorageConfig{Bucket: "zero", PartSize: "lots"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,66 @@
+package services
+
+import (
+	"context"
+	"io"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalStorageService(t *testing.T) {
+	ctx := context.Background()
+	fs := afero.NewMemMapFs()
+	storage := NewLocalStorageService(fs, "http://localhost:8000/", "secret")
+
+	// Files are written under the storage directory
+	fileURL, err := storage.UploadFile(ctx, "notes/1/cell diagram.png", strings.NewReader("png"), "image/png")
+	require.NoError(t, err)
+	assert.Equal(t, "http://localhost:8000/storage/notes/1/cell%20diagram.png", fileURL)
+	b, err := afero.ReadFile(fs, "storage/notes/1/cell diagram.png")
+	require.NoError(t, err)
+	assert.Equal(t, "png", string(b))
+
+	r, err := storage.OpenFile(ctx, "notes/1/cell diagram.png")
+	require.NoError(t, err)
+	b, err = io.ReadAll(r)
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+	assert.Equal(t, "png", string(b))
+
+	// Keys can't escape the storage directory
+	for _, key := range []string{"../secret", "notes/../../secret", "", "notes//1"} {
+		_, err = storage.UploadFile(ctx, key, strings.NewReader("x"), "text/plain")
+		assert.ErrorIs(t, err, ErrInvalidStorageKey, key)
+	}
+
+	// Signed URLs are valid for their key until they expire
+	signed, err := storage.GetSignedURL(ctx, "notes/1/cell diagram.png", 60)
+	require.NoError(t, err)
+	u, err := url.Parse(signed)
+	require.NoError(t, err)
+	assert.Equal(t, "/storage/notes/1/cell diagram.png", u.Path)
+	expires, signature := u.Query().Get("expires"), u.Query().Get("signature")
+
+	key := "notes/1/cell diagram.png"
+	now := time.Now()
+	assert.NoError(t, storage.VerifySignature(key, expires, signature, now))
+	assert.ErrorIs(t, storage.VerifySignature(key, expires, signature, now.Add(2*time.Minute)), ErrSignatureExpired)
+	assert.ErrorIs(t, storage.VerifySignature("notes/1/other.png", expires, signature, now), ErrInvalidSignature)
+	assert.ErrorIs(t, storage.VerifySignature(key, "9999999999", signature, now), ErrInvalidSignature)
+	assert.ErrorIs(t, storage.VerifySignature(key, expires, "", now), ErrInvalidSignature)
+
+	other := NewLocalStorageService(fs, "http://localhost:8000", "other")
+	assert.ErrorIs(t, other.VerifySignature(key, expires, signature, now), ErrInvalidSignature)
+
+	// Deleting removes the file, and deleting it again is not an error
+	require.NoError(t, storage.DeleteFile(ctx, key))
+	require.NoError(t, storage.DeleteFile(ctx, key))
+	_, err = storage.OpenFile(ctx, key)
+	assert.Error(t, err)
+}
@@ -0,0 +1,288 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/r-scheele/zero/ent"
+	"github.com/r-scheele/zero/ent/groupinvite"
+	"github.com/r-scheele/zero/ent/groupjoinrequest"
+	"github.com/r-scheele/zero/ent/groupmembership"
+	"github.com/r-scheele/zero/ent/studygroup"
+	"github.com/r-scheele/zero/ent/user"
+)
+
+const (
+	// DefaultInviteTTL is how long an invite stays valid when no expiry is given
+	DefaultInviteTTL = 7 * 24 * time.Hour
+
+	// MaxInviteTTL is the longest an invite can stay valid
+	MaxInviteTTL = 30 * 24 * time.Hour
+
+	// GroupInviteJoinPayload prefixes the WhatsApp reply button ID for accepting an invite
+	GroupInviteJoinPayload = "group_join_"
+
+	// GroupInviteDeclinePayload prefixes the WhatsApp reply button ID for declining an invite
+	GroupInviteDeclinePayload = "group_decline_"
+)
+
+// ErrInviteInvalid is returned when an invite doesn't exist, has expired, was revoked or is used up
+var ErrInviteInvalid = errors.New("this invite is invalid or has expired")
+
+// GroupInviteInput represents input for creating a study group invite
+type GroupInviteInput struct {
+	MaxUses     int           `json:"max_uses"`
+	ExpiresIn   time.Duration `json:"expires_in"`
+	PhoneNumber string        `json:"phone_number"`
+}
+
+// CreateInvite creates an invite for a group (owners and moderators only).
+// Invites for a phone number can be used once, and only by that user.
+func (s *StudyGroupService) CreateInvite(ctx context.Context, groupID, actorID int, input GroupInviteInput) (*ent.GroupInvite, error) {
+	if _, err := s.requireRole(ctx, groupID, actorID, groupmembership.RoleOwner, groupmembership.RoleModerator); err != nil {
+		return nil, err
+	}
+
+	if input.MaxUses < 0 {
+		return nil, fmt.Errorf("max uses can't be negative")
+	}
+
+	ttl := input.ExpiresIn
+	if ttl <= 0 {
+		ttl = DefaultInviteTTL
+	}
+	if ttl > MaxInviteTTL {
+		ttl = MaxInviteTTL
+	}
+
+	token, err := generateShareToken()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate invite token: %w", err)
+	}
+
+	create := s.orm.GroupInvite.Create().
+		SetToken(token).
+		SetGroupID(groupID).
+		SetCreatorID(actorID).
+		SetMaxUses(input.MaxUses).
+		SetExpiresAt(time.Now().Add(ttl))
+
+	if phone := strings.TrimSpace(input.PhoneNumber); phone != "" {
+		create.SetPhoneNumber(phone).SetMaxUses(1)
+	}
+
+	invite, err := create.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create invite: %w", err)
+	}
+
+	return invite, nil
+}
+
+// ListInvites lists the group's invites that can still be used (owners and moderators only)
+func (s *StudyGroupService) ListInvites(ctx context.Context, groupID, actorID int) ([]*ent.GroupInvite, error) {
+	if _, err := s.requireRole(ctx, groupID, actorID, groupmembership.RoleOwner, groupmembership.RoleModerator); err != nil {
+		return nil, err
+	}
+
+	invites, err := s.orm.GroupInvite.Query().
+		Where(
+			groupinvite.HasGroupWith(studygroup.ID(groupID)),
+			groupinvite.RevokedAtIsNil(),
+			groupinvite.ExpiresAtGT(time.Now()),
+		).
+		Order(ent.Desc(groupinvite.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch invites: %w", err)
+	}
+
+	// Used-up invites are kept for the record but aren't listed
+	usable := invites[:0]
+	for _, inv := range invites {
+		if inv.MaxUses == 0 || inv.Uses < inv.MaxUses {
+			usable = append(usable, inv)
+		}
+	}
+
+	return usable, nil
+}
+
+// RevokeInvite revokes an invite so it can no longer be used (owners and moderators only)
+func (s *StudyGroupService) RevokeInvite(ctx context.Context, groupID, inviteID, actorID int) error {
+	if _, err := s.requireRole(ctx, groupID, actorID, groupmembership.RoleOwner, groupmembership.RoleModerator); err != nil {
+		return err
+	}
+
+	n, err := s.orm.GroupInvite.Update().
+		Where(
+			groupinvite.ID(inviteID),
+			groupinvite.HasGroupWith(studygroup.ID(groupID)),
+			groupinvite.RevokedAtIsNil(),
+		).
+		SetRevokedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to revoke invite: %w", err)
+	}
+	if n == 0 {
+		return fmt.Errorf("invite not found")
+	}
+
+	return nil
+}
+
+// GetInvite retrieves a usable invite by its token, with its group and creator loaded
+func (s *StudyGroupService) GetInvite(ctx context.Context, token string) (*ent.GroupInvite, error) {
+	invite, err := s.orm.GroupInvite.Query().
+		Where(groupinvite.Token(token)).
+		WithGroup().
+		WithCreator().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrInviteInvalid
+		}
+		return nil, fmt.Errorf("failed to fetch invite: %w", err)
+	}
+
+	if !inviteUsable(invite) {
+		return nil, ErrInviteInvalid
+	}
+
+	return invite, nil
+}
+
+// AcceptInvite adds the user to the invite's group, bypassing the join request
+// process for invite-only groups. It returns the group that was joined.
+func (s *StudyGroupService) AcceptInvite(ctx context.Context, token string, userID int) (*ent.StudyGroup, error) {
+	invite, err := s.GetInvite(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	group := invite.Edges.Group
+
+	if invite.PhoneNumber != "" {
+		u, err := s.orm.User.Get(ctx, userID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch user: %w", err)
+		}
+		if !samePhoneNumber(u.PhoneNumber, invite.PhoneNumber) {
+			return nil, ErrInviteInvalid
+		}
+	}
+
+	m, err := s.GetMembership(ctx, group.ID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if m != nil {
+		return group, ErrAlreadyMember
+	}
+
+	// Claim a use only if one is still available, so concurrent accepts can't exceed the limit
+	n, err := s.orm.GroupInvite.Update().
+		Where(
+			groupinvite.ID(invite.ID),
+			groupinvite.RevokedAtIsNil(),
+			groupinvite.Or(
+				groupinvite.MaxUses(0),
+				func(sel *sql.Selector) {
+					sel.Where(sql.ColumnsLT(sel.C(groupinvite.FieldUses), sel.C(groupinvite.FieldMaxUses)))
+				},
+			),
+		).
+		AddUses(1).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to use invite: %w", err)
+	}
+	if n == 0 {
+		return nil, ErrInviteInvalid
+	}
+
+	if err := s.addMember(ctx, group.ID, userID); err != nil {
+		return nil, err
+	}
+
+	// An invite supersedes any pending request to join
+	if _, err := s.orm.GroupJoinRequest.Delete().
+		Where(
+			groupjoinrequest.HasGroupWith(studygroup.ID(group.ID)),
+			groupjoinrequest.HasUserWith(user.ID(userID)),
+		).
+		Exec(ctx); err != nil {
+		return nil, fmt.Errorf("failed to clear join request: %w", err)
+	}
+
+	return group, nil
+}
+
+// AcceptInviteByPhone accepts an invite on behalf of the user with the given phone number,
+// as happens when the Join button of a WhatsApp invitation is pressed
+func (s *StudyGroupService) AcceptInviteByPhone(ctx context.Context, token, phoneNumber string) (*ent.StudyGroup, error) {
+	u, err := s.orm.User.Query().
+		Where(user.PhoneNumberIn(phoneNumberVariants(phoneNumber)...)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("no account found for this phone number")
+		}
+		return nil, fmt.Errorf("failed to fetch user: %w", err)
+	}
+
+	return s.AcceptInvite(ctx, token, u.ID)
+}
+
+// DeclineInvite revokes a phone invite at the request of its recipient
+func (s *StudyGroupService) DeclineInvite(ctx context.Context, token, phoneNumber string) (*ent.StudyGroup, error) {
+	invite, err := s.GetInvite(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	if invite.PhoneNumber == "" || !samePhoneNumber(invite.PhoneNumber, phoneNumber) {
+		return nil, ErrInviteInvalid
+	}
+
+	if err := s.orm.GroupInvite.UpdateOne(invite).SetRevokedAt(time.Now()).Exec(ctx); err != nil {
+		return nil, fmt.Errorf("failed to decline invite: %w", err)
+	}
+
+	return invite.Edges.Group, nil
+}
+
+// inviteUsable reports whether an invite is unrevoked, unexpired and has uses left
+func inviteUsable(invite *ent.GroupInvite) bool {
+	if invite.RevokedAt != nil || time.Now().After(invite.ExpiresAt) {
+		return false
+	}
+	return invite.MaxUses == 0 || invite.Uses < invite.MaxUses
+}
+
+// samePhoneNumber compares phone numbers by their digits, since WhatsApp
+// reports numbers without the leading + that users may have registered with
+func samePhoneNumber(a, b string) bool {
+	da, db := phoneDigits(a), phoneDigits(b)
+	return da != "" && da == db
+}
+
+// phoneNumberVariants returns the stored forms a phone number may have been registered with
+func phoneNumberVariants(phoneNumber string) []string {
+	digits := phoneDigits(phoneNumber)
+	return []string{strings.TrimSpace(phoneNumber), digits, "+" + digits}
+}
+
+// phoneDigits strips everything but digits from a phone number
+func phoneDigits(phoneNumber string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, phoneNumber)
+}
@@ -0,0 +1,533 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/r-scheele/zero/ent"
+	"github.com/r-scheele/zero/ent/groupinvite"
+	"github.com/r-scheele/zero/ent/groupjoinrequest"
+	"github.com/r-scheele/zero/ent/groupmembership"
+	"github.com/r-scheele/zero/ent/note"
+	"github.com/r-scheele/zero/ent/studygroup"
+	"github.com/r-scheele/zero/ent/user"
+)
+
+var (
+	// ErrGroupNotFound is returned when a study group does not exist
+	ErrGroupNotFound = errors.New("study group not found")
+
+	// ErrGroupPermission is returned when the user's role does not allow an action
+	ErrGroupPermission = errors.New("you don't have permission to do that in this group")
+
+	// ErrAlreadyMember is returned when a user tries to join a group they belong to
+	ErrAlreadyMember = errors.New("you are already a member of this group")
+
+	// ErrLastOwner is returned when the only owner of a group tries to leave or step down
+	ErrLastOwner = errors.New("a group must keep at least one owner")
+)
+
+// StudyGroupService handles study groups, their memberships and shared notes
+type StudyGroupService struct {
+	orm *ent.Client
+}
+
+// NewStudyGroupService creates a new study group service
+func NewStudyGroupService(orm *ent.Client) *StudyGroupService {
+	return &StudyGroupService{
+		orm: orm,
+	}
+}
+
+// StudyGroupInput represents input for creating a study group
+type StudyGroupInput struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Privacy     string `json:"privacy"`
+}
+
+// CreateGroup creates a study group with the user as its owner
+func (s *StudyGroupService) CreateGroup(ctx context.Context, userID int, input StudyGroupInput) (*ent.StudyGroup, error) {
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		return nil, fmt.Errorf("name is required")
+	}
+
+	privacy := studygroup.PrivacyPublic
+	if input.Privacy == string(studygroup.PrivacyInviteOnly) {
+		privacy = studygroup.PrivacyInviteOnly
+	}
+
+	tx, err := s.orm.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	group, err := tx.StudyGroup.Create().
+		SetName(name).
+		SetDescription(strings.TrimSpace(input.Description)).
+		SetPrivacy(privacy).
+		Save(ctx)
+	if err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to create study group: %w", err))
+	}
+
+	_, err = tx.GroupMembership.Create().
+		SetGroup(group).
+		SetUserID(userID).
+		SetRole(groupmembership.RoleOwner).
+		Save(ctx)
+	if err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to add owner: %w", err))
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit study group: %w", err)
+	}
+
+	return group, nil
+}
+
+// GetGroup retrieves a study group. Groups are discoverable by anyone, but their
+// members and notes should only be shown to members.
+func (s *StudyGroupService) GetGroup(ctx context.Context, groupID int) (*ent.StudyGroup, error) {
+	group, err := s.orm.StudyGroup.Get(ctx, groupID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrGroupNotFound
+		}
+		return nil, fmt.Errorf("failed to fetch study group: %w", err)
+	}
+
+	return group, nil
+}
+
+// ListGroups lists study groups, most recently active first, with their memberships loaded
+func (s *StudyGroupService) ListGroups(ctx context.Context, limit, offset int) ([]*ent.StudyGroup, error) {
+	groups, err := s.orm.StudyGroup.Query().
+		WithMemberships(func(q *ent.GroupMembershipQuery) {
+			q.WithUser()
+		}).
+		Order(ent.Desc(studygroup.FieldUpdatedAt)).
+		Limit(limit).
+		Offset(offset).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch study groups: %w", err)
+	}
+
+	return groups, nil
+}
+
+// ListUserGroups lists the study groups the user belongs to
+func (s *StudyGroupService) ListUserGroups(ctx context.Context, userID int) ([]*ent.StudyGroup, error) {
+	groups, err := s.orm.StudyGroup.Query().
+		Where(studygroup.HasMembershipsWith(groupmembership.HasUserWith(user.ID(userID)))).
+		WithMemberships().
+		Order(ent.Asc(studygroup.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch study groups: %w", err)
+	}
+
+	return groups, nil
+}
+
+// GetMembership returns the user's membership in a group, or nil if they are not a member
+func (s *StudyGroupService) GetMembership(ctx context.Context, groupID, userID int) (*ent.GroupMembership, error) {
+	m, err := s.orm.GroupMembership.Query().
+		Where(
+			groupmembership.HasGroupWith(studygroup.ID(groupID)),
+			groupmembership.HasUserWith(user.ID(userID)),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to fetch membership: %w", err)
+	}
+
+	return m, nil
+}
+
+// ListMembers lists the members of a group with their users, owners first
+func (s *StudyGroupService) ListMembers(ctx context.Context, groupID int) ([]*ent.GroupMembership, error) {
+	members, err := s.orm.GroupMembership.Query().
+		Where(groupmembership.HasGroupWith(studygroup.ID(groupID))).
+		WithUser().
+		Order(ent.Asc(groupmembership.FieldRole), ent.Asc(groupmembership.FieldJoinedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch members: %w", err)
+	}
+
+	return members, nil
+}
+
+// Join adds the user to a public group, or files a join request for an invite-only group.
+// It returns true if the user became a member immediately.
+func (s *StudyGroupService) Join(ctx context.Context, groupID, userID int, message string) (bool, error) {
+	group, err := s.GetGroup(ctx, groupID)
+	if err != nil {
+		return false, err
+	}
+
+	m, err := s.GetMembership(ctx, groupID, userID)
+	if err != nil {
+		return false, err
+	}
+	if m != nil {
+		return false, ErrAlreadyMember
+	}
+
+	if group.Privacy == studygroup.PrivacyPublic {
+		if err := s.addMember(ctx, groupID, userID); err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+
+	exists, err := s.orm.GroupJoinRequest.Query().
+		Where(
+			groupjoinrequest.HasGroupWith(studygroup.ID(groupID)),
+			groupjoinrequest.HasUserWith(user.ID(userID)),
+		).
+		Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to check join request: %w", err)
+	}
+	if exists {
+		return false, nil
+	}
+
+	_, err = s.orm.GroupJoinRequest.Create().
+		SetGroupID(groupID).
+		SetUserID(userID).
+		SetMessage(strings.TrimSpace(message)).
+		Save(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to create join request: %w", err)
+	}
+
+	return false, nil
+}
+
+// HasPendingRequest reports whether the user has a pending join request for a group
+func (s *StudyGroupService) HasPendingRequest(ctx context.Context, groupID, userID int) (bool, error) {
+	exists, err := s.orm.GroupJoinRequest.Query().
+		Where(
+			groupjoinrequest.HasGroupWith(studygroup.ID(groupID)),
+			groupjoinrequest.HasUserWith(user.ID(userID)),
+		).
+		Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to check join request: %w", err)
+	}
+
+	return exists, nil
+}
+
+// Leave removes the user from a group. The last owner cannot leave.
+func (s *StudyGroupService) Leave(ctx context.Context, groupID, userID int) error {
+	m, err := s.GetMembership(ctx, groupID, userID)
+	if err != nil {
+		return err
+	}
+	if m == nil {
+		return fmt.Errorf("you are not a member of this group")
+	}
+
+	if err := s.checkNotLastOwner(ctx, groupID, m); err != nil {
+		return err
+	}
+
+	if err := s.orm.GroupMembership.DeleteOne(m).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to leave group: %w", err)
+	}
+
+	return nil
+}
+
+// ListJoinRequests lists pending join requests for a group, oldest first (owners and moderators only)
+func (s *StudyGroupService) ListJoinRequests(ctx context.Context, groupID, actorID int) ([]*ent.GroupJoinRequest, error) {
+	if _, err := s.requireRole(ctx, groupID, actorID, groupmembership.RoleOwner, groupmembership.RoleModerator); err != nil {
+		return nil, err
+	}
+
+	requests, err := s.orm.GroupJoinRequest.Query().
+		Where(groupjoinrequest.HasGroupWith(studygroup.ID(groupID))).
+		WithUser().
+		Order(ent.Asc(groupjoinrequest.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch join requests: %w", err)
+	}
+
+	return requests, nil
+}
+
+// DecideJoinRequest approves or rejects a pending join request (owners and moderators only).
+// It returns the user who made the request.
+func (s *StudyGroupService) DecideJoinRequest(ctx context.Context, groupID, requestID, actorID int, approve bool) (*ent.User, error) {
+	if _, err := s.requireRole(ctx, groupID, actorID, groupmembership.RoleOwner, groupmembership.RoleModerator); err != nil {
+		return nil, err
+	}
+
+	req, err := s.orm.GroupJoinRequest.Query().
+		Where(
+			groupjoinrequest.ID(requestID),
+			groupjoinrequest.HasGroupWith(studygroup.ID(groupID)),
+		).
+		WithUser().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("join request not found")
+		}
+		return nil, fmt.Errorf("failed to fetch join request: %w", err)
+	}
+
+	if approve {
+		if err := s.addMember(ctx, groupID, req.Edges.User.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.orm.GroupJoinRequest.DeleteOne(req).Exec(ctx); err != nil {
+		return nil, fmt.Errorf("failed to delete join request: %w", err)
+	}
+
+	return req.Edges.User, nil
+}
+
+// SetRole changes the role of a member (owners only)
+func (s *StudyGroupService) SetRole(ctx context.Context, groupID, actorID, memberID int, role string) error {
+	if _, err := s.requireRole(ctx, groupID, actorID, groupmembership.RoleOwner); err != nil {
+		return err
+	}
+
+	r := groupmembership.Role(role)
+	if err := groupmembership.RoleValidator(r); err != nil {
+		return fmt.Errorf("invalid role: %s", role)
+	}
+
+	m, err := s.GetMembership(ctx, groupID, memberID)
+	if err != nil {
+		return err
+	}
+	if m == nil {
+		return fmt.Errorf("member not found")
+	}
+
+	if r != groupmembership.RoleOwner {
+		if err := s.checkNotLastOwner(ctx, groupID, m); err != nil {
+			return err
+		}
+	}
+
+	if err := s.orm.GroupMembership.UpdateOne(m).SetRole(r).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to update role: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveMember removes a member from a group. Owners can remove anyone but themselves;
+// moderators can only remove regular members.
+func (s *StudyGroupService) RemoveMember(ctx context.Context, groupID, actorID, memberID int) error {
+	actor, err := s.requireRole(ctx, groupID, actorID, groupmembership.RoleOwner, groupmembership.RoleModerator)
+	if err != nil {
+		return err
+	}
+
+	if actorID == memberID {
+		return fmt.Errorf("use leave to remove yourself from a group")
+	}
+
+	m, err := s.GetMembership(ctx, groupID, memberID)
+	if err != nil {
+		return err
+	}
+	if m == nil {
+		return fmt.Errorf("member not found")
+	}
+
+	if actor.Role == groupmembership.RoleModerator && m.Role != groupmembership.RoleMember {
+		return ErrGroupPermission
+	}
+
+	if err := s.orm.GroupMembership.DeleteOne(m).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to remove member: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteGroup deletes a group along with its memberships, join requests and invites (owners only).
+// Shared notes are unaffected apart from losing the group grant.
+func (s *StudyGroupService) DeleteGroup(ctx context.Context, groupID, actorID int) error {
+	if _, err := s.requireRole(ctx, groupID, actorID, groupmembership.RoleOwner); err != nil {
+		return err
+	}
+
+	tx, err := s.orm.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	if _, err = tx.GroupMembership.Delete().
+		Where(groupmembership.HasGroupWith(studygroup.ID(groupID))).
+		Exec(ctx); err != nil {
+		return rollback(tx, fmt.Errorf("failed to delete memberships: %w", err))
+	}
+	if _, err = tx.GroupJoinRequest.Delete().
+		Where(groupjoinrequest.HasGroupWith(studygroup.ID(groupID))).
+		Exec(ctx); err != nil {
+		return rollback(tx, fmt.Errorf("failed to delete join requests: %w", err))
+	}
+	if _, err = tx.GroupInvite.Delete().
+		Where(groupinvite.HasGroupWith(studygroup.ID(groupID))).
+		Exec(ctx); err != nil {
+		return rollback(tx, fmt.Errorf("failed to delete invites: %w", err))
+	}
+	if err = tx.StudyGroup.DeleteOneID(groupID).Exec(ctx); err != nil {
+		return rollback(tx, fmt.Errorf("failed to delete study group: %w", err))
+	}
+
+	return tx.Commit()
+}
+
+// ShareNote shares a note into a group so all members can read it.
+// Only group owners can share, and only notes they own.
+func (s *StudyGroupService) ShareNote(ctx context.Context, groupID, noteID, userID int) error {
+	if _, err := s.requireRole(ctx, groupID, userID, groupmembership.RoleOwner); err != nil {
+		return err
+	}
+
+	exists, err := s.orm.Note.Query().
+		Where(note.ID(noteID), note.HasOwnerWith(user.ID(userID))).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check note ownership: %w", err)
+	}
+	if !exists {
+		return fmt.Errorf("note not found or access denied")
+	}
+
+	if err := s.orm.StudyGroup.UpdateOneID(groupID).AddSharedNoteIDs(noteID).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to share note: %w", err)
+	}
+
+	return nil
+}
+
+// UnshareNote removes a note from a group (group owners, or the note's owner)
+func (s *StudyGroupService) UnshareNote(ctx context.Context, groupID, noteID, userID int) error {
+	isNoteOwner, err := s.orm.Note.Query().
+		Where(note.ID(noteID), note.HasOwnerWith(user.ID(userID))).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check note ownership: %w", err)
+	}
+
+	if !isNoteOwner {
+		if _, err := s.requireRole(ctx, groupID, userID, groupmembership.RoleOwner); err != nil {
+			return err
+		}
+	}
+
+	if err := s.orm.StudyGroup.UpdateOneID(groupID).RemoveSharedNoteIDs(noteID).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to unshare note: %w", err)
+	}
+
+	return nil
+}
+
+// ListSharedNotes lists the notes shared into a group (members only)
+func (s *StudyGroupService) ListSharedNotes(ctx context.Context, groupID, userID int) ([]*ent.Note, error) {
+	if _, err := s.requireRole(ctx, groupID, userID); err != nil {
+		return nil, err
+	}
+
+	notes, err := s.orm.Note.Query().
+		Where(note.HasGroupsWith(studygroup.ID(groupID))).
+		WithOwner().
+		Order(ent.Desc(note.FieldUpdatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch shared notes: %w", err)
+	}
+
+	return notes, nil
+}
+
+// requireRole returns the user's membership if they belong to the group with one of the given roles.
+// Any role is accepted when none are given.
+func (s *StudyGroupService) requireRole(ctx context.Context, groupID, userID int, roles ...groupmembership.Role) (*ent.GroupMembership, error) {
+	m, err := s.GetMembership(ctx, groupID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if m == nil {
+		return nil, ErrGroupPermission
+	}
+
+	if len(roles) == 0 {
+		return m, nil
+	}
+	for _, r := range roles {
+		if m.Role == r {
+			return m, nil
+		}
+	}
+
+	return nil, ErrGroupPermission
+}
+
+// addMember adds a user to a group as a regular member, returning ErrAlreadyMember if they
+// already belong to it
+func (s *StudyGroupService) addMember(ctx context.Context, groupID, userID int) error {
+	_, err := s.orm.GroupMembership.Create().
+		SetGroupID(groupID).
+		SetUserID(userID).
+		SetRole(groupmembership.RoleMember).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return ErrAlreadyMember
+		}
+		return fmt.Errorf("failed to add member: %w", err)
+	}
+
+	// Touch the group so it surfaces as recently active
+	if err := s.orm.StudyGroup.UpdateOneID(groupID).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to update study group: %w", err)
+	}
+
+	return nil
+}
+
+// checkNotLastOwner returns ErrLastOwner if the membership is the group's only owner
+func (s *StudyGroupService) checkNotLastOwner(ctx context.Context, groupID int, m *ent.GroupMembership) error {
+	if m.Role != groupmembership.RoleOwner {
+		return nil
+	}
+
+	owners, err := s.orm.GroupMembership.Query().
+		Where(
+			groupmembership.HasGroupWith(studygroup.ID(groupID)),
+			groupmembership.RoleEQ(groupmembership.RoleOwner),
+		).
+		Count(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to count owners: %w", err)
+	}
+	if owners <= 1 {
+		return ErrLastOwner
+	}
+
+	return nil
+}
@@ -0,0 +1,210 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/r-scheele/zero/ent/groupmembership"
+	"github.com/r-scheele/zero/pkg/tests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStudyGroupService(t *testing.T) {
+	ctxb := context.Background()
+
+	owner, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	member, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	outsider, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+
+	g, err := c.StudyGroups.CreateGroup(ctxb, owner.ID, StudyGroupInput{Name: "Chemistry", Privacy: "public"})
+	require.NoError(t, err)
+
+	m, err := c.StudyGroups.GetMembership(ctxb, g.ID, owner.ID)
+	require.NoError(t, err)
+	require.NotNil(t, m)
+	assert.Equal(t, groupmembership.RoleOwner, m.Role)
+
+	// Public groups can be joined directly.
+	joined, err := c.StudyGroups.Join(ctxb, g.ID, member.ID, "")
+	require.NoError(t, err)
+	assert.True(t, joined)
+	_, err = c.StudyGroups.Join(ctxb, g.ID, member.ID, "")
+	assert.ErrorIs(t, err, ErrAlreadyMember)
+
+	// Sharing a private note grants members read access, but not outsiders.
+	n, err := c.ORM.Note.Create().
+		SetTitle("Reactions").
+		SetOwner(owner).
+		Save(ctxb)
+	require.NoError(t, err)
+
+	_, err = c.Notes.GetNote(ctxb, n.ID, &member.ID)
+	assert.Error(t, err)
+
+	assert.ErrorIs(t, c.StudyGroups.ShareNote(ctxb, g.ID, n.ID, member.ID), ErrGroupPermission)
+	require.NoError(t, c.StudyGroups.ShareNote(ctxb, g.ID, n.ID, owner.ID))
+
+	got, err := c.Notes.GetNote(ctxb, n.ID, &member.ID)
+	require.NoError(t, err)
+	assert.Equal(t, n.ID, got.ID)
+
+	_, err = c.Notes.GetNote(ctxb, n.ID, &outsider.ID)
+	assert.Error(t, err)
+
+	notes, err := c.StudyGroups.ListSharedNotes(ctxb, g.ID, member.ID)
+	require.NoError(t, err)
+	assert.Len(t, notes, 1)
+	_, err = c.StudyGroups.ListSharedNotes(ctxb, g.ID, outsider.ID)
+	assert.ErrorIs(t, err, ErrGroupPermission)
+
+	// Leaving revokes access.
+	require.NoError(t, c.StudyGroups.Leave(ctxb, g.ID, member.ID))
+	_, err = c.Notes.GetNote(ctxb, n.ID, &member.ID)
+	assert.Error(t, err)
+
+	// The last owner can't leave or step down.
+	assert.ErrorIs(t, c.StudyGroups.Leave(ctxb, g.ID, owner.ID), ErrLastOwner)
+	assert.ErrorIs(t, c.StudyGroups.SetRole(ctxb, g.ID, owner.ID, owner.ID, "member"), ErrLastOwner)
+
+	require.NoError(t, c.StudyGroups.DeleteGroup(ctxb, g.ID, owner.ID))
+	_, err = c.StudyGroups.GetGroup(ctxb, g.ID)
+	assert.ErrorIs(t, err, ErrGroupNotFound)
+}
+
+func TestStudyGroupService_InviteOnly(t *testing.T) {
+	ctxb := context.Background()
+
+	owner, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	mod, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	applicant, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+
+	g, err := c.StudyGroups.CreateGroup(ctxb, owner.ID, StudyGroupInput{Name: "Physics", Privacy: "invite_only"})
+	require.NoError(t, err)
+
+	// Joining an invite-only group files a request.
+	joined, err := c.StudyGroups.Join(ctxb, g.ID, mod.ID, "")
+	require.NoError(t, err)
+	assert.False(t, joined)
+
+	pending, err := c.StudyGroups.HasPendingRequest(ctxb, g.ID, mod.ID)
+	require.NoError(t, err)
+	assert.True(t, pending)
+
+	requests, err := c.StudyGroups.ListJoinRequests(ctxb, g.ID, owner.ID)
+	require.NoError(t, err)
+	require.Len(t, requests, 1)
+
+	// Only owners and moderators can decide requests.
+	_, err = c.StudyGroups.ListJoinRequests(ctxb, g.ID, applicant.ID)
+	assert.ErrorIs(t, err, ErrGroupPermission)
+
+	u, err := c.StudyGroups.DecideJoinRequest(ctxb, g.ID, requests[0].ID, owner.ID, true)
+	require.NoError(t, err)
+	assert.Equal(t, mod.ID, u.ID)
+
+	require.NoError(t, c.StudyGroups.SetRole(ctxb, g.ID, owner.ID, mod.ID, "moderator"))
+
+	// Moderators can review requests but not remove owners.
+	_, err = c.StudyGroups.Join(ctxb, g.ID, applicant.ID, "Hi!")
+	require.NoError(t, err)
+	requests, err = c.StudyGroups.ListJoinRequests(ctxb, g.ID, mod.ID)
+	require.NoError(t, err)
+	require.Len(t, requests, 1)
+	assert.Equal(t, "Hi!", requests[0].Message)
+
+	_, err = c.StudyGroups.DecideJoinRequest(ctxb, g.ID, requests[0].ID, mod.ID, false)
+	require.NoError(t, err)
+	m, err := c.StudyGroups.GetMembership(ctxb, g.ID, applicant.ID)
+	require.NoError(t, err)
+	assert.Nil(t, m)
+
+	assert.ErrorIs(t, c.StudyGroups.RemoveMember(ctxb, g.ID, mod.ID, owner.ID), ErrGroupPermission)
+	require.NoError(t, c.StudyGroups.RemoveMember(ctxb, g.ID, owner.ID, mod.ID))
+
+	members, err := c.StudyGroups.ListMembers(ctxb, g.ID)
+	require.NoError(t, err)
+	assert.Len(t, members, 1)
+}
+
+func TestStudyGroupService_Invites(t *testing.T) {
+	ctxb := context.Background()
+
+	owner, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	a, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	b, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+
+	g, err := c.StudyGroups.CreateGroup(ctxb, owner.ID, StudyGroupInput{Name: "History", Privacy: "invite_only"})
+	require.NoError(t, err)
+
+	// Members can't create invites.
+	_, err = c.StudyGroups.CreateInvite(ctxb, g.ID, a.ID, GroupInviteInput{})
+	assert.ErrorIs(t, err, ErrGroupPermission)
+
+	inv, err := c.StudyGroups.CreateInvite(ctxb, g.ID, owner.ID, GroupInviteInput{MaxUses: 1})
+	require.NoError(t, err)
+	assert.NotEmpty(t, inv.Token)
+	assert.WithinDuration(t, time.Now().Add(DefaultInviteTTL), inv.ExpiresAt, time.Minute)
+
+	// Invites bypass approval for invite-only groups, up to their use limit.
+	joined, err := c.StudyGroups.AcceptInvite(ctxb, inv.Token, a.ID)
+	require.NoError(t, err)
+	assert.Equal(t, g.ID, joined.ID)
+	_, err = c.StudyGroups.AcceptInvite(ctxb, inv.Token, b.ID)
+	assert.ErrorIs(t, err, ErrInviteInvalid)
+
+	// Revoked invites can't be used.
+	inv, err = c.StudyGroups.CreateInvite(ctxb, g.ID, owner.ID, GroupInviteInput{})
+	require.NoError(t, err)
+	invites, err := c.StudyGroups.ListInvites(ctxb, g.ID, owner.ID)
+	require.NoError(t, err)
+	assert.Len(t, invites, 1)
+	require.NoError(t, c.StudyGroups.RevokeInvite(ctxb, g.ID, inv.ID, owner.ID))
+	_, err = c.StudyGroups.AcceptInvite(ctxb, inv.Token, b.ID)
+	assert.ErrorIs(t, err, ErrInviteInvalid)
+
+	// Expired invites can't be used.
+	inv, err = c.StudyGroups.CreateInvite(ctxb, g.ID, owner.ID, GroupInviteInput{})
+	require.NoError(t, err)
+	require.NoError(t, c.ORM.GroupInvite.UpdateOne(inv).SetExpiresAt(time.Now().Add(-time.Minute)).Exec(ctxb))
+	_, err = c.StudyGroups.AcceptInvite(ctxb, inv.Token, b.ID)
+	assert.ErrorIs(t, err, ErrInviteInvalid)
+
+	// Phone invites can only be used by that phone number, matched by digits.
+	b, err = b.Update().SetPhoneNumber("+15550001234").Save(ctxb)
+	require.NoError(t, err)
+	inv, err = c.StudyGroups.CreateInvite(ctxb, g.ID, owner.ID, GroupInviteInput{PhoneNumber: "+1 555 000 1234"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, inv.MaxUses)
+
+	_, err = c.StudyGroups.DeclineInvite(ctxb, inv.Token, "15559999999")
+	assert.ErrorIs(t, err, ErrInviteInvalid)
+
+	joined, err = c.StudyGroups.AcceptInviteByPhone(ctxb, inv.Token, "15550001234")
+	require.NoError(t, err)
+	assert.Equal(t, g.ID, joined.ID)
+
+	m, err := c.StudyGroups.GetMembership(ctxb, g.ID, b.ID)
+	require.NoError(t, err)
+	require.NotNil(t, m)
+	assert.Equal(t, groupmembership.RoleMember, m.Role)
+
+	// Declining revokes the phone invite.
+	inv, err = c.StudyGroups.CreateInvite(ctxb, g.ID, owner.ID, GroupInviteInput{PhoneNumber: "+15550005678"})
+	require.NoError(t, err)
+	declined, err := c.StudyGroups.DeclineInvite(ctxb, inv.Token, "15550005678")
+	require.NoError(t, err)
+	assert.Equal(t, g.ID, declined.ID)
+	_, err = c.StudyGroups.GetInvite(ctxb, inv.Token)
+	assert.ErrorIs(t, err, ErrInviteInvalid)
+}
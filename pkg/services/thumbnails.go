@@ -0,0 +1,196 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/disintegration/imaging"
+	"github.com/r-scheele/zero/ent"
+	"github.com/r-scheele/zero/pkg/types"
+
+	// Register the WebP decoder so WebP uploads get thumbnails too
+	_ "golang.org/x/image/webp"
+)
+
+// Thumbnail sizes, by name, as the longest side in pixels
+const (
+	ThumbnailSmall  = "small"
+	ThumbnailMedium = "medium"
+	ThumbnailLarge  = "large"
+)
+
+// ThumbnailSizes are the sizes thumbnails are generated in
+var ThumbnailSizes = map[string]int{
+	ThumbnailSmall:  160,
+	ThumbnailMedium: 480,
+	ThumbnailLarge:  960,
+}
+
+// ThumbnailLinkTTL is how long the signed links to thumbnails last
+const ThumbnailLinkTTL = 6 * time.Hour
+
+// maxImagePixels caps the size of images that thumbnails are generated for, so a small file
+// that decodes to a huge image can't exhaust memory
+const maxImagePixels = 50_000_000
+
+// ErrImageTooLarge is returned when an image has too many pixels to process
+var ErrImageTooLarge = errors.New("image is too large to process")
+
+// ProcessedImage is an uploaded image with its metadata removed, and its thumbnails
+type ProcessedImage struct {
+	// Original is the re-encoded original without its metadata, or nil if the format can't be
+	// re-encoded and the original should be left as it is
+	Original []byte
+
+	// Thumbnails are JPEG thumbnails, by size name
+	Thumbnails map[string][]byte
+}
+
+// ProcessImage decodes an image, applying its EXIF orientation, and re-encodes it without any
+// EXIF or other metadata. It also renders a JPEG thumbnail in each of ThumbnailSizes; images
+// smaller than a size are not enlarged.
+func ProcessImage(data []byte) (*ProcessedImage, error) {
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read image: %w", err)
+	}
+	if cfg.Width*cfg.Height > maxImagePixels {
+		return nil, ErrImageTooLarge
+	}
+
+	img, err := imaging.Decode(bytes.NewReader(data), imaging.AutoOrientation(true))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode image: %w", err)
+	}
+
+	processed := &ProcessedImage{
+		Thumbnails: make(map[string][]byte, len(ThumbnailSizes)),
+	}
+
+	// Only the first frame of a GIF is decoded, so animated GIFs are left alone
+	var buf bytes.Buffer
+	switch format {
+	case "jpeg":
+		err = jpeg.Encode(&buf, img, &jpeg.Options{Quality: 90})
+	case "png":
+		err = png.Encode(&buf, img)
+	case "gif":
+		g, gerr := gif.DecodeAll(bytes.NewReader(data))
+		if gerr == nil && len(g.Image) == 1 {
+			err = gif.Encode(&buf, img, nil)
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode image: %w", err)
+	}
+	if buf.Len() > 0 {
+		processed.Original = buf.Bytes()
+	}
+
+	// JPEG has no transparency, so thumbnails are flattened onto white
+	bounds := img.Bounds()
+	flat := imaging.New(bounds.Dx(), bounds.Dy(), color.White)
+	flat = imaging.Overlay(flat, img, image.Point{}, 1)
+
+	for name, size := range ThumbnailSizes {
+		thumb := image.Image(flat)
+		if bounds.Dx() > size || bounds.Dy() > size {
+			thumb = imaging.Fit(flat, size, size, imaging.Lanczos)
+		}
+
+		var b bytes.Buffer
+		if err := jpeg.Encode(&b, thumb, &jpeg.Options{Quality: 80}); err != nil {
+			return nil, fmt.Errorf("failed to encode thumbnail: %w", err)
+		}
+		processed.Thumbnails[name] = b.Bytes()
+	}
+
+	return processed, nil
+}
+
+// ThumbnailKey returns the storage key of a thumbnail of the stored image with the given key
+func ThumbnailKey(key, size string) string {
+	return fmt.Sprintf("thumbnails/%s-%s.jpg", strings.TrimSuffix(key, path.Ext(key)), size)
+}
+
+var youTubeID = regexp.MustCompile(`^[A-Za-z0-9_-]{11}$`)
+
+// YouTubeVideoID extracts the video ID from a YouTube link, or returns an empty string if it
+// isn't a link to a video
+func YouTubeVideoID(link string) string {
+	u, err := url.Parse(link)
+	if err != nil {
+		return ""
+	}
+
+	var id string
+	host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+	switch host {
+	case "youtu.be":
+		id = strings.Trim(u.Path, "/")
+	case "youtube.com", "m.youtube.com", "music.youtube.com", "youtube-nocookie.com":
+		if u.Path == "/watch" {
+			id = u.Query().Get("v")
+			break
+		}
+		parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+		if len(parts) == 2 && (parts[0] == "embed" || parts[0] == "shorts" || parts[0] == "live" || parts[0] == "v") {
+			id = parts[1]
+		}
+	}
+
+	if !youTubeID.MatchString(id) {
+		return ""
+	}
+	return id
+}
+
+// YouTubeThumbnail returns the URL of the thumbnail YouTube publishes for a video, or an empty
+// string if the link isn't to a YouTube video
+func YouTubeThumbnail(link string) string {
+	id := YouTubeVideoID(link)
+	if id == "" {
+		return ""
+	}
+	return "https://i.ytimg.com/vi/" + id + "/hqdefault.jpg"
+}
+
+// SignThumbnails points the thumbnails of the notes' stored resources at signed links of the
+// given size, so they can be displayed whatever the storage backend. YouTube resources without a
+// thumbnail get theirs from the video ID. Only the loaded notes are changed, not the database.
+func SignThumbnails(ctx context.Context, storage StorageService, size string, notes ...*ent.Note) {
+	for _, n := range notes {
+		for i := range n.Resources {
+			r := &n.Resources[i]
+			if key, ok := r.Thumbnails[size]; ok {
+				if signed, err := storage.GetSignedURL(ctx, key, int64(ThumbnailLinkTTL/time.Second)); err == nil {
+					r.Thumbnail = signed
+				}
+			} else if r.Type == "youtube" && r.Thumbnail == "" {
+				r.Thumbnail = YouTubeThumbnail(r.URL)
+			}
+		}
+	}
+}
+
+// NoteThumbnail returns the first resource of a note that has a thumbnail
+func NoteThumbnail(n *ent.Note) (types.Resource, bool) {
+	for _, r := range n.Resources {
+		if r.Thumbnail != "" {
+			return r, true
+		}
+	}
+	return types.Resource{}, false
+}
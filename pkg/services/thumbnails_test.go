@@ -0,0 +1,125 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/r-scheele/zero/ent"
+	"github.com/r-scheele/zero/pkg/tests"
+	"github.com/r-scheele/zero/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// exifJPEG encodes a JPEG with an EXIF segment saying it should be rotated 90° clockwise
+func exifJPEG(t *testing.T, width, height int) []byte {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for x := 0; x < width; x++ {
+		for y := 0; y < height; y++ {
+			img.Set(x, y, color.RGBA{R: 200, A: 255})
+		}
+	}
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, img, nil))
+
+	exif := []byte{
+		0xFF, 0xE1, 0x00, 0x22, 'E', 'x', 'i', 'f', 0, 0,
+		'M', 'M', 0x00, 0x2A, 0x00, 0x00, 0x00, 0x08,
+		0x00, 0x01, 0x01, 0x12, 0x00, 0x03, 0x00, 0x00, 0x00, 0x01, 0x00, 0x06, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00,
+	}
+	b := buf.Bytes()
+	return append(append([]byte{b[0], b[1]}, exif...), b[2:]...)
+}
+
+func TestProcessImage(t *testing.T) {
+	original := exifJPEG(t, 1200, 600)
+	require.True(t, bytes.Contains(original, []byte("Exif")))
+
+	processed, err := ProcessImage(original)
+	require.NoError(t, err)
+
+	// The original loses its metadata, and is rotated as the metadata said
+	require.NotNil(t, processed.Original)
+	assert.False(t, bytes.Contains(processed.Original, []byte("Exif")))
+	cfg, err := jpeg.DecodeConfig(bytes.NewReader(processed.Original))
+	require.NoError(t, err)
+	assert.Equal(t, 600, cfg.Width)
+	assert.Equal(t, 1200, cfg.Height)
+
+	// Thumbnails fit each size, and small images aren't enlarged
+	require.Len(t, processed.Thumbnails, len(ThumbnailSizes))
+	for name, size := range ThumbnailSizes {
+		cfg, err := jpeg.DecodeConfig(bytes.NewReader(processed.Thumbnails[name]))
+		require.NoError(t, err, name)
+		assert.Equal(t, min(size, 1200), cfg.Height, name)
+		assert.Equal(t, min(size, 1200)/2, cfg.Width, name)
+	}
+
+	// Transparent PNGs get thumbnails too
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, image.NewNRGBA(image.Rect(0, 0, 100, 50))))
+	processed, err = ProcessImage(buf.Bytes())
+	require.NoError(t, err)
+	assert.NotNil(t, processed.Original)
+	assert.Len(t, processed.Thumbnails, len(ThumbnailSizes))
+
+	_, err = ProcessImage([]byte("not an image"))
+	assert.Error(t, err)
+}
+
+func TestYouTubeThumbnails(t *testing.T) {
+	for link, id := range map[string]string{
+		"https://www.youtube.com/watch?v=dQw4w9WgXcQ&t=42": "dQw4w9WgXcQ",
+		"https://youtu.be/dQw4w9WgXcQ":                     "dQw4w9WgXcQ",
+		"https://m.youtube.com/shorts/dQw4w9WgXcQ":         "dQw4w9WgXcQ",
+		"https://www.youtube.com/embed/dQw4w9WgXcQ":        "dQw4w9WgXcQ",
+		"https://www.youtube.com/channel/UC123":            "",
+		"https://www.youtube.com/watch?v=short":            "",
+		"https://example.com/watch?v=dQw4w9WgXcQ":          "",
+	} {
+		assert.Equal(t, id, YouTubeVideoID(link), link)
+	}
+	assert.Equal(t, "https://i.ytimg.com/vi/dQw4w9WgXcQ/hqdefault.jpg", YouTubeThumbnail("https://youtu.be/dQw4w9WgXcQ"))
+
+	// YouTube links added to notes get a thumbnail, and aren't added twice
+	ctx := context.Background()
+	owner, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	n, err := c.ORM.Note.Create().SetTitle("Lectures").SetOwner(owner).Save(ctx)
+	require.NoError(t, err)
+	notes := NewNotesService(c.ORM, c.Files, c.Cache, c.Config, c.Blobs)
+	for range 2 {
+		_, err = notes.AddURLResource(ctx, n.ID, owner.ID, "https://youtu.be/dQw4w9WgXcQ", "Lecture 1")
+		require.NoError(t, err)
+	}
+	n, err = c.ORM.Note.Get(ctx, n.ID)
+	require.NoError(t, err)
+	require.Len(t, n.Resources, 1)
+	assert.Equal(t, "https://i.ytimg.com/vi/dQw4w9WgXcQ/hqdefault.jpg", n.Resources[0].Thumbnail)
+}
+
+func TestSignThumbnails(t *testing.T) {
+	storage := &memoryStorage{files: make(map[string]string)}
+	n := &ent.Note{Resources: []types.Resource{
+		{Type: "pdf", Name: "slides.pdf"},
+		{Type: "image", Name: "cell.png", Thumbnail: "/files/thumb", Thumbnails: map[string]string{
+			ThumbnailMedium: ThumbnailKey("notes/1/cell.png", ThumbnailMedium),
+		}},
+		{Type: "youtube", URL: "https://youtu.be/dQw4w9WgXcQ"},
+	}}
+
+	SignThumbnails(context.Background(), storage, ThumbnailMedium, n)
+	assert.Empty(t, n.Resources[0].Thumbnail)
+	assert.Equal(t, "/files/thumbnails/notes/1/cell-medium.jpg?signed", n.Resources[1].Thumbnail)
+	assert.Equal(t, "https://i.ytimg.com/vi/dQw4w9WgXcQ/hqdefault.jpg", n.Resources[2].Thumbnail)
+
+	r, ok := NoteThumbnail(n)
+	require.True(t, ok)
+	assert.Equal(t, "cell.png", r.Name)
+}
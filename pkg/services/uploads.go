@@ -0,0 +1,402 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/r-scheele/zero/config"
+	"github.com/r-scheele/zero/ent"
+	"github.com/r-scheele/zero/ent/note"
+	"github.com/r-scheele/zero/ent/resumableupload"
+	"github.com/r-scheele/zero/ent/user"
+	"github.com/spf13/afero"
+)
+
+const (
+	// ResumableUploadTTL is how long an upload can go without receiving a chunk before it's
+	// abandoned and removed
+	ResumableUploadTTL = 24 * time.Hour
+
+	// uploadDirectory is where the chunks of resumable uploads are kept until they're assembled
+	uploadDirectory = "uploads"
+)
+
+var (
+	// ErrUploadNotFound is returned for uploads that don't exist, belong to someone else or have
+	// expired
+	ErrUploadNotFound = errors.New("upload not found or expired")
+
+	// ErrUploadInvalid is returned when an upload is started without the details it needs
+	ErrUploadInvalid = errors.New("invalid upload")
+
+	// ErrUploadNoteNotFound is returned when an upload is started for a note the user doesn't own
+	ErrUploadNoteNotFound = errors.New("note not found or access denied")
+
+	// ErrUploadOffset is returned when a chunk doesn't start where the previous one ended
+	ErrUploadOffset = errors.New("chunk offset does not match the upload offset")
+
+	// ErrUploadTooLarge is returned when a file or chunk goes over the size allowed
+	ErrUploadTooLarge = errors.New("upload is too large")
+
+	// ErrUploadComplete is returned when a chunk is sent for an upload that already has every byte
+	ErrUploadComplete = errors.New("upload is already complete")
+)
+
+// UploadService handles resumable uploads of large files to notes. Chunks are kept in a
+// temporary area, tracked by the database, until the whole file has arrived and is assembled.
+type UploadService struct {
+	orm    *ent.Client
+	files  afero.Fs
+	config *config.Config
+	blobs  *BlobService
+}
+
+// NewUploadService creates a new resumable upload service
+func NewUploadService(orm *ent.Client, files afero.Fs, cfg *config.Config, blobs *BlobService) *UploadService {
+	return &UploadService{
+		orm:    orm,
+		files:  files,
+		config: cfg,
+		blobs:  blobs,
+	}
+}
+
+// ResumableUploadInput is a file a user wants to upload to a note
+type ResumableUploadInput struct {
+	NoteID   int
+	FileName string
+	MimeType string
+	Size     int64
+}
+
+// MaxSize returns the size of the largest file that can be uploaded, which is the most a note
+// can hold
+func (s *UploadService) MaxSize() int64 {
+	_, maxTotalSize, _, err := GetFileUploadLimits(s.config)
+	if err != nil {
+		return 400 * 1024 * 1024
+	}
+	return maxTotalSize
+}
+
+// Create starts an upload of a file to one of the user's notes. The file must fit in the note
+// and in the user's storage quota.
+func (s *UploadService) Create(ctx context.Context, userID int, input ResumableUploadInput, now time.Time) (*ent.ResumableUpload, error) {
+	name := filepath.Base(strings.ReplaceAll(strings.TrimSpace(input.FileName), "\\", "/"))
+	if name == "." || name == "/" || name == "" {
+		return nil, fmt.Errorf("%w: a file name is required", ErrUploadInvalid)
+	}
+	if input.Size <= 0 {
+		return nil, fmt.Errorf("%w: the file is empty", ErrUploadInvalid)
+	}
+
+	n, err := s.orm.Note.Query().
+		Where(note.ID(input.NoteID), note.HasOwnerWith(user.ID(userID))).
+		Only(ctx)
+	switch {
+	case ent.IsNotFound(err):
+		return nil, ErrUploadNoteNotFound
+	case err != nil:
+		return nil, fmt.Errorf("failed to check note ownership: %w", err)
+	}
+
+	var used int64
+	for _, r := range n.Resources {
+		used += r.Size
+	}
+	if maxSize := s.MaxSize(); used+input.Size > maxSize {
+		return nil, fmt.Errorf("%w: adding this file would exceed the %s total limit for this note", ErrUploadTooLarge, FormatFileSize(maxSize))
+	}
+
+	if err := s.blobs.CheckQuota(ctx, userID, input.Size); err != nil {
+		return nil, err
+	}
+
+	token, err := generateShareToken()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate upload token: %w", err)
+	}
+
+	up, err := s.orm.ResumableUpload.Create().
+		SetToken(token).
+		SetNoteID(input.NoteID).
+		SetFileName(name).
+		SetMimeType(input.MimeType).
+		SetSize(input.Size).
+		SetExpiresAt(now.Add(ResumableUploadTTL)).
+		SetOwnerID(userID).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create upload: %w", err)
+	}
+
+	return up, nil
+}
+
+// Get returns one of the user's uploads, unless it has expired
+func (s *UploadService) Get(ctx context.Context, userID int, token string, now time.Time) (*ent.ResumableUpload, error) {
+	up, err := s.orm.ResumableUpload.Query().
+		Where(
+			resumableupload.Token(token),
+			resumableupload.HasOwnerWith(user.ID(userID)),
+		).
+		Only(ctx)
+	switch {
+	case ent.IsNotFound(err):
+		return nil, ErrUploadNotFound
+	case err != nil:
+		return nil, fmt.Errorf("failed to fetch upload: %w", err)
+	case up.CompletedAt == nil && now.After(up.ExpiresAt):
+		return nil, ErrUploadNotFound
+	}
+
+	return up, nil
+}
+
+// Append stores the chunk of the file starting at the given offset, which must be where the
+// upload left off. Whatever part of the chunk arrives is kept if reading it fails, so the client
+// can resume from there, and the upload's expiry is pushed back.
+func (s *UploadService) Append(ctx context.Context, up *ent.ResumableUpload, offset int64, chunk io.Reader, now time.Time) (*ent.ResumableUpload, error) {
+	if offset != up.Offset {
+		return nil, ErrUploadOffset
+	}
+	if up.Offset == up.Size {
+		return nil, ErrUploadComplete
+	}
+
+	dir := s.chunkDirectory(up)
+	if err := s.files.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create upload directory: %w", err)
+	}
+
+	// The chunk is written under a temporary name, so a chunk sent twice at once can't
+	// overwrite one that was already counted
+	tmp, err := afero.TempFile(s.files, dir, "chunk_*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create chunk file: %w", err)
+	}
+
+	remaining := up.Size - up.Offset
+	n, readErr := io.Copy(tmp, io.LimitReader(chunk, remaining))
+	if readErr == nil && n == remaining {
+		// Anything past the end of the file means the chunk is wrong
+		if extra, _ := chunk.Read(make([]byte, 1)); extra > 0 {
+			readErr = ErrUploadTooLarge
+			n = 0
+		}
+	}
+	if err := tmp.Close(); err != nil && readErr == nil {
+		readErr = err
+		n = 0
+	}
+	if n == 0 {
+		_ = s.files.Remove(tmp.Name())
+		if readErr != nil {
+			return nil, fmt.Errorf("failed to store chunk: %w", readErr)
+		}
+		return up, nil
+	}
+
+	updated, err := s.orm.ResumableUpload.Update().
+		Where(
+			resumableupload.ID(up.ID),
+			resumableupload.Offset(up.Offset),
+		).
+		SetOffset(up.Offset + n).
+		SetExpiresAt(now.Add(ResumableUploadTTL)).
+		Save(ctx)
+	if err != nil || updated == 0 {
+		_ = s.files.Remove(tmp.Name())
+		if err != nil {
+			return nil, fmt.Errorf("failed to update upload offset: %w", err)
+		}
+		return nil, ErrUploadOffset
+	}
+
+	if err := s.files.Rename(tmp.Name(), path.Join(dir, chunkName(up.Offset))); err != nil {
+		_ = s.files.Remove(tmp.Name())
+
+		// The chunk was never stored, so the client has to send it again
+		_, rollbackErr := s.orm.ResumableUpload.Update().
+			Where(
+				resumableupload.ID(up.ID),
+				resumableupload.Offset(up.Offset+n),
+			).
+			SetOffset(up.Offset).
+			Save(ctx)
+		if rollbackErr != nil {
+			return nil, fmt.Errorf("failed to store chunk: %w", errors.Join(err, rollbackErr))
+		}
+		return nil, fmt.Errorf("failed to store chunk: %w", err)
+	}
+
+	up, err = s.orm.ResumableUpload.Get(ctx, up.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch upload: %w", err)
+	}
+	if readErr != nil {
+		return up, fmt.Errorf("failed to store chunk: %w", readErr)
+	}
+
+	return up, nil
+}
+
+// Assemble joins the chunks of a complete upload into a temporary file on disk and returns its
+// path. The caller takes ownership of the file, and should call Complete once it has been
+// handed off.
+func (s *UploadService) Assemble(up *ent.ResumableUpload) (string, error) {
+	if up.Offset != up.Size {
+		return "", fmt.Errorf("upload is missing %d bytes", up.Size-up.Offset)
+	}
+
+	dir := s.chunkDirectory(up)
+	infos, err := afero.ReadDir(s.files, dir)
+	if err != nil && !os.IsNotExist(err) {
+		return "", fmt.Errorf("failed to list chunks: %w", err)
+	}
+
+	type chunk struct {
+		name   string
+		offset int64
+	}
+	chunks := make([]chunk, 0, len(infos))
+	for _, info := range infos {
+		offset, err := strconv.ParseInt(strings.TrimSuffix(info.Name(), ".part"), 10, 64)
+		if err != nil || !strings.HasSuffix(info.Name(), ".part") {
+			continue
+		}
+		chunks = append(chunks, chunk{name: info.Name(), offset: offset})
+	}
+	sort.Slice(chunks, func(i, j int) bool {
+		return chunks[i].offset < chunks[j].offset
+	})
+
+	out, err := os.CreateTemp("", "upload_*_"+up.FileName)
+	if err != nil {
+		return "", fmt.Errorf("failed to create temporary file: %w", err)
+	}
+
+	assemble := func() error {
+		var written int64
+		for _, c := range chunks {
+			if c.offset != written {
+				return fmt.Errorf("chunk at offset %d is missing", written)
+			}
+			f, err := s.files.Open(path.Join(dir, c.name))
+			if err != nil {
+				return fmt.Errorf("failed to open chunk: %w", err)
+			}
+			n, err := io.Copy(out, f)
+			f.Close()
+			if err != nil {
+				return fmt.Errorf("failed to copy chunk: %w", err)
+			}
+			written += n
+		}
+		if written != up.Size {
+			return fmt.Errorf("assembled %d of %d bytes", written, up.Size)
+		}
+		return nil
+	}
+
+	err = assemble()
+	if closeErr := out.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(out.Name())
+		return "", fmt.Errorf("failed to assemble upload: %w", err)
+	}
+
+	return out.Name(), nil
+}
+
+// Complete marks an upload as complete before it's handed off, so that it's only handed off once.
+// ErrUploadComplete is returned if it was already marked complete.
+func (s *UploadService) Complete(ctx context.Context, up *ent.ResumableUpload, now time.Time) error {
+	updated, err := s.orm.ResumableUpload.Update().
+		Where(
+			resumableupload.ID(up.ID),
+			resumableupload.CompletedAtIsNil(),
+		).
+		SetCompletedAt(now).
+		Save(ctx)
+	switch {
+	case err != nil:
+		return fmt.Errorf("failed to complete upload: %w", err)
+	case updated == 0:
+		return ErrUploadComplete
+	}
+
+	return nil
+}
+
+// Reopen undoes Complete when an upload couldn't be handed off, so completing it can be retried
+func (s *UploadService) Reopen(ctx context.Context, up *ent.ResumableUpload) error {
+	err := s.orm.ResumableUpload.UpdateOneID(up.ID).
+		ClearCompletedAt().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to reopen upload: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveChunks removes the chunks of an upload once they've been assembled
+func (s *UploadService) RemoveChunks(up *ent.ResumableUpload) error {
+	if err := s.files.RemoveAll(s.chunkDirectory(up)); err != nil {
+		return fmt.Errorf("failed to remove chunks: %w", err)
+	}
+
+	return nil
+}
+
+// Delete removes an upload and any chunks received so far
+func (s *UploadService) Delete(ctx context.Context, up *ent.ResumableUpload) error {
+	if err := s.files.RemoveAll(s.chunkDirectory(up)); err != nil {
+		return fmt.Errorf("failed to remove chunks: %w", err)
+	}
+
+	err := s.orm.ResumableUpload.DeleteOne(up).Exec(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return fmt.Errorf("failed to delete upload: %w", err)
+	}
+
+	return nil
+}
+
+// Expire removes an upload once it has expired, or has been completed. If the upload is still
+// in progress, the time it now expires is returned so it can be checked again then.
+func (s *UploadService) Expire(ctx context.Context, id int, now time.Time) (*time.Time, error) {
+	up, err := s.orm.ResumableUpload.Get(ctx, id)
+	switch {
+	case ent.IsNotFound(err):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("failed to fetch upload: %w", err)
+	case up.CompletedAt == nil && now.Before(up.ExpiresAt):
+		return &up.ExpiresAt, nil
+	}
+
+	return nil, s.Delete(ctx, up)
+}
+
+// chunkDirectory returns the directory an upload's chunks are kept in
+func (s *UploadService) chunkDirectory(up *ent.ResumableUpload) string {
+	return path.Join(uploadDirectory, up.Token)
+}
+
+// chunkName returns the file name of the chunk starting at the given offset
+func chunkName(offset int64) string {
+	return fmt.Sprintf("%020d.part", offset)
+}
@@ -0,0 +1,150 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/r-scheele/zero/pkg/tests"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// flakyReader returns its content, then fails as if the connection dropped
+type flakyReader struct {
+	r io.Reader
+}
+
+func (f *flakyReader) Read(p []byte) (int, error) {
+	n, err := f.r.Read(p)
+	if err == io.EOF {
+		return n, errors.New("connection reset")
+	}
+	return n, err
+}
+
+func TestUploadService(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	files := afero.NewMemMapFs()
+	storage := &memoryStorage{files: make(map[string]string)}
+	uploads := NewUploadService(c.ORM, files, c.Config, NewBlobService(c.ORM, storage, 0))
+
+	owner, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	other, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	n, err := c.Notes.CreateNote(ctx, owner.ID, CreateNoteInput{Title: "Lecture 1", Visibility: "private", PermissionLevel: "read_only"})
+	require.NoError(t, err)
+
+	// Uploads need a file that fits in a note the user owns
+	const recording = "a lecture recording"
+	input := ResumableUploadInput{NoteID: n.ID, FileName: "../lecture.mp4", MimeType: "video/mp4", Size: int64(len(recording))}
+	_, err = uploads.Create(ctx, other.ID, input, now)
+	assert.ErrorIs(t, err, ErrUploadNoteNotFound)
+	_, err = uploads.Create(ctx, owner.ID, ResumableUploadInput{NoteID: n.ID, FileName: "empty.mp4"}, now)
+	assert.ErrorIs(t, err, ErrUploadInvalid)
+	_, err = uploads.Create(ctx, owner.ID, ResumableUploadInput{NoteID: n.ID, FileName: "huge.mp4", Size: uploads.MaxSize() + 1}, now)
+	assert.ErrorIs(t, err, ErrUploadTooLarge)
+
+	up, err := uploads.Create(ctx, owner.ID, input, now)
+	require.NoError(t, err)
+	assert.Equal(t, "lecture.mp4", up.FileName)
+	assert.Equal(t, now.Add(ResumableUploadTTL).Unix(), up.ExpiresAt.Unix())
+
+	// Only the owner can find the upload
+	_, err = uploads.Get(ctx, other.ID, up.Token, now)
+	assert.ErrorIs(t, err, ErrUploadNotFound)
+	up, err = uploads.Get(ctx, owner.ID, up.Token, now)
+	require.NoError(t, err)
+
+	// Chunks must continue where the upload left off, and can't run past the end of the file
+	later := now.Add(time.Hour)
+	up, err = uploads.Append(ctx, up, 0, strings.NewReader(recording[:2]), later)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), up.Offset)
+	assert.Equal(t, later.Add(ResumableUploadTTL).Unix(), up.ExpiresAt.Unix())
+	_, err = uploads.Append(ctx, up, 0, strings.NewReader(recording[:2]), later)
+	assert.ErrorIs(t, err, ErrUploadOffset)
+	_, err = uploads.Append(ctx, up, 2, strings.NewReader(recording[2:]+"!"), later)
+	assert.ErrorIs(t, err, ErrUploadTooLarge)
+
+	// What arrives before the connection drops is kept
+	up, err = uploads.Append(ctx, up, 2, &flakyReader{strings.NewReader(recording[2:10])}, later)
+	require.Error(t, err)
+	require.NotNil(t, up)
+	assert.Equal(t, int64(10), up.Offset)
+
+	_, err = uploads.Assemble(up)
+	assert.Error(t, err)
+
+	up, err = uploads.Append(ctx, up, 10, strings.NewReader(recording[10:]), later)
+	require.NoError(t, err)
+	assert.Equal(t, up.Size, up.Offset)
+	_, err = uploads.Append(ctx, up, up.Offset, strings.NewReader("more"), later)
+	assert.ErrorIs(t, err, ErrUploadComplete)
+
+	// The chunks are assembled into one file and removed once the upload is complete
+	tempPath, err := uploads.Assemble(up)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = os.Remove(tempPath)
+	})
+	assembled, err := os.ReadFile(tempPath)
+	require.NoError(t, err)
+	assert.Equal(t, recording, string(assembled))
+
+	require.NoError(t, uploads.Complete(ctx, up, later))
+	assert.ErrorIs(t, uploads.Complete(ctx, up, later), ErrUploadComplete)
+	require.NoError(t, uploads.RemoveChunks(up))
+	exists, err := afero.DirExists(files, uploads.chunkDirectory(up))
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	expiresAt, err := uploads.Expire(ctx, up.ID, later)
+	require.NoError(t, err)
+	assert.Nil(t, expiresAt)
+	_, err = uploads.Get(ctx, owner.ID, up.Token, later)
+	assert.ErrorIs(t, err, ErrUploadNotFound)
+}
+
+func TestUploadServiceExpire(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	files := afero.NewMemMapFs()
+	storage := &memoryStorage{files: make(map[string]string)}
+	uploads := NewUploadService(c.ORM, files, c.Config, NewBlobService(c.ORM, storage, 0))
+
+	owner, err := tests.CreateUser(c.ORM)
+	require.NoError(t, err)
+	n, err := c.Notes.CreateNote(ctx, owner.ID, CreateNoteInput{Title: "Lecture 2", Visibility: "private", PermissionLevel: "read_only"})
+	require.NoError(t, err)
+
+	up, err := uploads.Create(ctx, owner.ID, ResumableUploadInput{NoteID: n.ID, FileName: "lecture.mp4", Size: 10}, now)
+	require.NoError(t, err)
+	up, err = uploads.Append(ctx, up, 0, strings.NewReader("lect"), now.Add(time.Hour))
+	require.NoError(t, err)
+
+	// An upload that received a chunk since it was created is kept until its new expiry
+	expiresAt, err := uploads.Expire(ctx, up.ID, now.Add(ResumableUploadTTL))
+	require.NoError(t, err)
+	require.NotNil(t, expiresAt)
+	assert.Equal(t, now.Add(time.Hour+ResumableUploadTTL).Unix(), expiresAt.Unix())
+
+	// An abandoned upload can't be resumed, and is removed with its chunks
+	_, err = uploads.Get(ctx, owner.ID, up.Token, expiresAt.Add(time.Second))
+	assert.ErrorIs(t, err, ErrUploadNotFound)
+	expiresAt, err = uploads.Expire(ctx, up.ID, expiresAt.Add(time.Second))
+	require.NoError(t, err)
+	assert.Nil(t, expiresAt)
+	exists, err := afero.DirExists(files, uploads.chunkDirectory(up))
+	require.NoError(t, err)
+	assert.False(t, exists)
+	_, err = c.ORM.ResumableUpload.Get(ctx, up.ID)
+	assert.Error(t, err)
+}
@@ -279,6 +279,53 @@ func (s *APIService) SendPasswordResetMessage(ctx context.Context, phoneNumber,
 	return s.whatsapp.sendMessage(ctx, message)
 }
 
+// SendGroupInviteMessage sends a WhatsApp study group invitation with Join/Decline buttons
+func (s *APIService) SendGroupInviteMessage(ctx context.Context, phoneNumber, inviterName, groupName, inviteToken string) error {
+	if s.whatsapp.apiKey == "" {
+		// Fallback to console logging if no API key
+		fmt.Printf("📱 WhatsApp Group Invite to %s: %s invited you to join %s (invite: %s)\n", phoneNumber, inviterName, groupName, inviteToken)
+		return nil
+	}
+
+	message := WhatsAppMessage{
+		To:   phoneNumber,
+		Type: "interactive",
+		Interactive: &InteractiveMessage{
+			Type: "button",
+			Header: &Header{
+				Type: "text",
+				Text: "👥 Study Group Invitation",
+			},
+			Body: &Body{
+				Text: fmt.Sprintf("%s invited you to join the study group \"%s\" on Zero.\n\nTap Join to become a member right away.", inviterName, groupName),
+			},
+			Footer: &Footer{
+				Text: "This invitation can only be used by you.",
+			},
+			Action: &Action{
+				Buttons: []Button{
+					{
+						Type: "reply",
+						Reply: ReplyButton{
+							ID:    GroupInviteJoinPayload + inviteToken,
+							Title: "✅ Join",
+						},
+					},
+					{
+						Type: "reply",
+						Reply: ReplyButton{
+							ID:    GroupInviteDeclinePayload + inviteToken,
+							Title: "❌ Decline",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return s.whatsapp.sendMessage(ctx, message)
+}
+
 // sendMessage sends the actual HTTP request to 360dialog API
 func (w *WhatsAppAPI) sendMessage(ctx context.Context, message WhatsAppMessage) error {
 	jsonData, err := json.Marshal(message)
@@ -0,0 +1,91 @@
+package tasks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mikestefanello/backlite"
+	"github.com/r-scheele/zero/ent"
+	"github.com/r-scheele/zero/pkg/log"
+	"github.com/r-scheele/zero/pkg/services"
+)
+
+const (
+	// curriculumAttempts is the number of times building a curriculum is attempted before the
+	// note is marked as no longer processing
+	curriculumAttempts = 3
+
+	// curriculumBackoff is how long to wait before trying to build a curriculum again
+	curriculumBackoff = time.Minute
+)
+
+// CurriculumTask represents a task to build the AI curriculum of a note
+type CurriculumTask struct {
+	NoteID  int `json:"note_id"`
+	Attempt int `json:"attempt"`
+}
+
+// Config satisfies the backlite.Task interface by providing configuration for the queue. Tasks
+// retry themselves, so the note can be marked as no longer processing once they give up.
+func (t CurriculumTask) Config() backlite.QueueConfig {
+	return backlite.QueueConfig{
+		Name:        "CurriculumTask",
+		MaxAttempts: 1,
+		Timeout:     5 * time.Minute,
+		Retention: &backlite.Retention{
+			Duration:   7 * 24 * time.Hour,
+			OnlyFailed: true,
+			Data: &backlite.RetainData{
+				OnlyFailed: true,
+			},
+		},
+	}
+}
+
+// NewCurriculumTaskQueue provides a Queue that can process CurriculumTask tasks
+func NewCurriculumTaskQueue(c *services.Container) backlite.Queue {
+	return backlite.NewQueue[CurriculumTask](func(ctx context.Context, task CurriculumTask) error {
+		logger := log.Default()
+		logger.Info("Processing curriculum task",
+			"note_id", task.NoteID,
+			"attempt", task.Attempt+1,
+		)
+
+		curriculum, err := c.Curriculum.Generate(ctx, task.NoteID)
+		switch {
+		case ent.IsNotFound(err):
+			logger.Info("Dropping curriculum of deleted note", "note_id", task.NoteID)
+			return nil
+
+		case err == nil:
+			logger.Info("Curriculum generated successfully",
+				"note_id", task.NoteID,
+				"modules", len(curriculum.Modules),
+			)
+			return nil
+		}
+
+		logger.Error("Failed to generate curriculum",
+			"note_id", task.NoteID,
+			"attempt", task.Attempt+1,
+			"error", err,
+		)
+
+		// Provider errors and unusable replies may not happen again
+		retry := !errors.Is(err, services.ErrCurriculumDisabled) && !errors.Is(err, services.ErrCurriculumNoText)
+		if retry && task.Attempt+1 < curriculumAttempts {
+			task.Attempt++
+			return c.Tasks.
+				Add(task).
+				Wait(curriculumBackoff).
+				Save()
+		}
+
+		if failErr := c.Curriculum.Fail(ctx, task.NoteID); failErr != nil && !ent.IsNotFound(failErr) {
+			err = errors.Join(err, failErr)
+		}
+		return fmt.Errorf("failed to generate curriculum: %w", err)
+	})
+}
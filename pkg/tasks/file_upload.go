@@ -2,13 +2,17 @@ package tasks
 
 import (
 	"context"
+	"crypto/rand"
+	"errors"
 	"fmt"
+	"io"
 	"os"
-	"path/filepath"
+	"path"
 	"strings"
 	"time"
 
 	"github.com/mikestefanello/backlite"
+	"github.com/r-scheele/zero/ent/blobreference"
 	"github.com/r-scheele/zero/pkg/log"
 	"github.com/r-scheele/zero/pkg/services"
 	"github.com/r-scheele/zero/pkg/types"
@@ -52,12 +56,11 @@ func NewFileUploadTaskQueue(c *services.Container) backlite.Queue {
 			"file_size", task.FileSize,
 		)
 
-		// Get services from container
-		notesService := services.NewNotesService(c.ORM, c.Files, c.Cache, c.Config)
-
 		// Upload file to cloud storage
-		var fileURL string
-		var err error
+		var fileURL, cloudKey string
+		var size int64
+		mimeType := task.MimeType
+		ref := services.BlobRef{OwnerID: task.UserID, Kind: blobreference.KindNote, TargetID: task.NoteID}
 
 		// Check if we have a temporary file to upload
 		if task.TempPath != "" {
@@ -70,65 +73,188 @@ func NewFileUploadTaskQueue(c *services.Container) backlite.Queue {
 			defer file.Close()
 			defer os.Remove(task.TempPath) // Clean up temp file
 
-			// Generate unique filename for cloud storage
-			uniqueFileName := generateUniqueFileName(task.FileName)
-			cloudKey := fmt.Sprintf("notes/%d/%s", task.NoteID, uniqueFileName)
+			// The type the client sent is only trusted if the file's content matches it
+			sniffed, err := services.VerifyContentType(c.Config, task.FileName, task.MimeType, file)
+			if errors.Is(err, services.ErrContentTypeMismatch) || errors.Is(err, services.ErrFileTypeNotAllowed) {
+				logger.Warn("Rejecting file upload", "error", err, "note_id", task.NoteID, "file_name", task.FileName)
+				err = c.Notes.AddResourceToNote(ctx, task.NoteID, task.UserID, types.Resource{
+					Type:       determineResourceType(task.MimeType, task.FileName),
+					Name:       task.FileName,
+					Size:       task.FileSize,
+					MimeType:   task.MimeType,
+					ScanStatus: types.ScanRejected,
+					ScanDetail: err.Error(),
+					UploadedAt: time.Now(),
+				})
+				if err != nil {
+					return fmt.Errorf("failed to add resource to note: %w", err)
+				}
+				return nil
+			}
+			if err != nil {
+				return fmt.Errorf("failed to verify file type: %w", err)
+			}
+			mimeType = sniffed
+
+			// Files are kept in quarantine until they've been scanned for viruses
+			if c.Scanner != nil {
+				return quarantineUpload(ctx, c, task, mimeType, file)
+			}
 
-			// Upload to cloud storage
-			fileURL, err = c.Storage.UploadFile(ctx, cloudKey, file, task.MimeType)
+			// Store the file by its content, so a file uploaded by many users is only stored once
+			stored, err := c.Blobs.Store(ctx, ref, task.FileName, file, mimeType)
+			if errors.Is(err, services.ErrQuotaExceeded) {
+				// Retrying won't free up space, so the file is dropped
+				logger.Warn("Dropping file upload over the user's storage quota",
+					"error", err,
+					"user_id", task.UserID,
+					"file_name", task.FileName,
+				)
+				return nil
+			}
 			if err != nil {
 				logger.Error("Failed to upload file to cloud storage", "error", err, "file_name", task.FileName)
 				return fmt.Errorf("failed to upload file to cloud storage: %w", err)
 			}
+			// Only the key is kept, since links to stored files are signed when the note is shown
+			cloudKey = stored.StorageKey
+			size = stored.Size
 
-			logger.Info("File uploaded to cloud storage", "file_name", task.FileName, "url", fileURL)
+			logger.Info("File uploaded to cloud storage", "file_name", task.FileName, "key", cloudKey)
 		} else {
 			// Fallback: generate a placeholder URL (this shouldn't happen in normal operation)
-			uniqueFileName := generateUniqueFileName(task.FileName)
-			fileURL = "/files/" + uniqueFileName
+			fileURL = "/files/" + task.FileName
+			size = task.FileSize
 			logger.Warn("No temporary file path provided, using placeholder URL", "file_name", task.FileName)
 		}
-		
+
 		// Determine resource type
-		resourceType := determineResourceType(task.MimeType, task.FileName)
+		resourceType := determineResourceType(mimeType, task.FileName)
 
 		// Create resource record
 		resource := types.Resource{
 			Type:       resourceType,
 			Name:       task.FileName,
 			URL:        fileURL,
-			Size:       task.FileSize,
-			MimeType:   task.MimeType,
+			Key:        cloudKey,
+			Size:       size,
+			MimeType:   mimeType,
 			UploadedAt: time.Now(),
 		}
 
-		// Add resource to note
-		err = notesService.AddResourceToNote(ctx, task.NoteID, task.UserID, resource)
+		// Add resource to note, releasing the file if it can't be
+		err := c.Notes.AddResourceToNote(ctx, task.NoteID, task.UserID, resource)
 		if err != nil {
 			logger.Error("Failed to add resource to note",
 				"error", err,
 				"note_id", task.NoteID,
 				"file_name", task.FileName,
 			)
+			if cloudKey != "" {
+				err = errors.Join(err, c.Blobs.Release(ctx, ref.Kind, task.NoteID, cloudKey))
+			}
 			return fmt.Errorf("failed to add resource to note: %w", err)
 		}
 
+		if cloudKey != "" {
+			queueResourceProcessing(c, task.NoteID, resource)
+		}
+
 		logger.Info("File upload task completed successfully",
 			"note_id", task.NoteID,
 			"file_name", task.FileName,
-			"resource_url", resource.URL,
+			"resource_key", resource.Key,
 		)
 
 		return nil
 	})
 }
 
-// generateUniqueFileName generates a unique filename to prevent conflicts
-func generateUniqueFileName(originalName string) string {
-	ext := filepath.Ext(originalName)
-	name := strings.TrimSuffix(originalName, ext)
-	timestamp := time.Now().Unix()
-	return fmt.Sprintf("%s_%d%s", name, timestamp, ext)
+// quarantineUpload copies an uploaded file into quarantine and adds it to the note as pending,
+// to be stored once the VirusScanTask finds it's clean
+func quarantineUpload(ctx context.Context, c *services.Container, task FileUploadTask, mimeType string, file io.Reader) error {
+	quarantine := path.Join(quarantineDirectory, rand.Text())
+	dst, err := c.Files.Create(quarantine)
+	if err != nil {
+		return fmt.Errorf("failed to create quarantine file: %w", err)
+	}
+	size, err := io.Copy(dst, file)
+	if closeErr := dst.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return errors.Join(fmt.Errorf("failed to quarantine file: %w", err), c.Files.Remove(quarantine))
+	}
+
+	err = c.Notes.AddResourceToNote(ctx, task.NoteID, task.UserID, types.Resource{
+		Type:       determineResourceType(mimeType, task.FileName),
+		Name:       task.FileName,
+		Size:       size,
+		MimeType:   mimeType,
+		ScanStatus: types.ScanPending,
+		Quarantine: quarantine,
+		UploadedAt: time.Now(),
+	})
+	if err != nil {
+		return errors.Join(fmt.Errorf("failed to add resource to note: %w", err), c.Files.Remove(quarantine))
+	}
+
+	err = c.Tasks.
+		Add(VirusScanTask{
+			NoteID:     task.NoteID,
+			UserID:     task.UserID,
+			Quarantine: quarantine,
+			FileName:   task.FileName,
+			MimeType:   mimeType,
+		}).
+		Save()
+	if err != nil {
+		// The temporary file is gone once this returns, so retrying can't help
+		log.Default().Error("Failed to queue virus scan", "error", err, "note_id", task.NoteID)
+		return errors.Join(
+			c.Notes.SetResourceScan(ctx, task.NoteID, quarantine, services.ScannedResource{
+				Status: types.ScanRejected,
+				Detail: "the file could not be scanned",
+			}),
+			c.Files.Remove(quarantine),
+		)
+	}
+
+	return nil
+}
+
+// queueResourceProcessing queues the background processing of a file stored on a note
+func queueResourceProcessing(c *services.Container, noteID int, resource types.Resource) {
+	logger := log.Default()
+
+	// Extract the file's text in the background so the note can be searched by it
+	if services.CanExtractText(resource.Name, resource.MimeType) {
+		err := c.Tasks.
+			Add(TextExtractionTask{
+				NoteID:   noteID,
+				Key:      resource.Key,
+				FileName: resource.Name,
+				MimeType: resource.MimeType,
+			}).
+			Save()
+		if err != nil {
+			logger.Error("Failed to queue text extraction", "error", err, "note_id", noteID)
+		}
+	}
+
+	// Strip the metadata from images and generate their thumbnails in the background
+	if resource.Type == "image" {
+		err := c.Tasks.
+			Add(ThumbnailTask{
+				NoteID:   noteID,
+				Key:      resource.Key,
+				MimeType: resource.MimeType,
+			}).
+			Save()
+		if err != nil {
+			logger.Error("Failed to queue thumbnail generation", "error", err, "note_id", noteID)
+		}
+	}
 }
 
 // determineResourceType determines the resource type based on MIME type and filename
@@ -0,0 +1,86 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mikestefanello/backlite"
+	"github.com/r-scheele/zero/ent"
+	"github.com/r-scheele/zero/ent/noteproposal"
+	"github.com/r-scheele/zero/pkg/log"
+	"github.com/r-scheele/zero/pkg/routenames"
+	"github.com/r-scheele/zero/pkg/services"
+	"github.com/r-scheele/zero/pkg/ui/emails"
+)
+
+// NoteProposalReviewedTask represents a task to tell a user the owner of a note has accepted or
+// rejected the change they proposed to it
+type NoteProposalReviewedTask struct {
+	ProposalID int `json:"proposal_id"`
+}
+
+// Config satisfies the backlite.Task interface by providing configuration for the queue
+func (t NoteProposalReviewedTask) Config() backlite.QueueConfig {
+	return backlite.QueueConfig{
+		Name:        "NoteProposalReviewedTask",
+		MaxAttempts: 3,
+		Timeout:     time.Minute,
+		Backoff:     time.Minute,
+		Retention: &backlite.Retention{
+			Duration:   24 * time.Hour,
+			OnlyFailed: true,
+			Data: &backlite.RetainData{
+				OnlyFailed: true,
+			},
+		},
+	}
+}
+
+// NewNoteProposalReviewedTaskQueue provides a Queue that can process NoteProposalReviewedTask tasks
+func NewNoteProposalReviewedTaskQueue(c *services.Container) backlite.Queue {
+	return backlite.NewQueue[NoteProposalReviewedTask](func(ctx context.Context, task NoteProposalReviewedTask) error {
+		proposal, err := c.ORM.NoteProposal.Query().
+			Where(noteproposal.ID(task.ProposalID)).
+			WithNote().
+			WithProposer().
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			// The note was deleted since
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to fetch proposed change: %w", err)
+		}
+
+		u := proposal.Edges.Proposer
+		if u.Email == nil || *u.Email == "" || !u.EmailNotifications {
+			log.Default().Info("Not emailing review of proposed change",
+				"proposal_id", task.ProposalID,
+				"user_id", u.ID,
+			)
+			return nil
+		}
+
+		n := proposal.Edges.Note
+		url := c.Config.App.Host + c.Web.Reverse(routenames.Notes+".view", n.ID)
+
+		// The mail client logs through an echo context, which tasks don't have
+		err = c.Mail.
+			Compose().
+			To(*u.Email).
+			Subject("Your proposed change to " + n.Title).
+			Component(emails.NoteChangeReviewed(u.Name, n.Title, proposal.Status == noteproposal.StatusAccepted, url)).
+			Send(c.Web.NewContext(nil, nil))
+		if err != nil {
+			return fmt.Errorf("failed to email review of proposed change: %w", err)
+		}
+
+		log.Default().Info("Emailed review of proposed change",
+			"proposal_id", task.ProposalID,
+			"user_id", u.ID,
+		)
+
+		return nil
+	})
+}
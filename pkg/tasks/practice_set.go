@@ -0,0 +1,62 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mikestefanello/backlite"
+	"github.com/r-scheele/zero/pkg/log"
+	"github.com/r-scheele/zero/pkg/services"
+)
+
+// PracticeSetTask represents a task to generate a practice set from a note
+type PracticeSetTask struct {
+	NoteID int `json:"note_id"`
+	UserID int `json:"user_id"`
+}
+
+// Config satisfies the backlite.Task interface by providing configuration for the queue
+func (t PracticeSetTask) Config() backlite.QueueConfig {
+	return backlite.QueueConfig{
+		Name:        "PracticeSetTask",
+		MaxAttempts: 3,
+		Timeout:     3 * time.Minute,
+		Backoff:     30 * time.Second,
+		Retention: &backlite.Retention{
+			Duration:   24 * time.Hour,
+			OnlyFailed: false,
+			Data: &backlite.RetainData{
+				OnlyFailed: false,
+			},
+		},
+	}
+}
+
+// NewPracticeSetTaskQueue provides a Queue that can process PracticeSetTask tasks
+func NewPracticeSetTaskQueue(c *services.Container) backlite.Queue {
+	return backlite.NewQueue[PracticeSetTask](func(ctx context.Context, task PracticeSetTask) error {
+		log.Default().Info("Processing practice set task",
+			"note_id", task.NoteID,
+			"user_id", task.UserID,
+		)
+
+		quiz, err := c.Quiz.GeneratePracticeSet(ctx, task.NoteID, task.UserID)
+		if err != nil {
+			log.Default().Error("Failed to generate practice set",
+				"note_id", task.NoteID,
+				"user_id", task.UserID,
+				"error", err,
+			)
+			return fmt.Errorf("failed to generate practice set: %w", err)
+		}
+
+		log.Default().Info("Practice set generated successfully",
+			"note_id", task.NoteID,
+			"quiz_id", quiz.ID,
+			"generator", quiz.Generator,
+		)
+
+		return nil
+	})
+}
@@ -0,0 +1,89 @@
+package tasks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mikestefanello/backlite"
+	"github.com/r-scheele/zero/pkg/log"
+	"github.com/r-scheele/zero/pkg/services"
+	"github.com/r-scheele/zero/pkg/ui/emails"
+)
+
+// ProgressExportTask represents a task to export a user's study history that is too large to
+// download directly, and email them a link to it
+type ProgressExportTask struct {
+	UserID int    `json:"user_id"`
+	Format string `json:"format"`
+}
+
+// Config satisfies the backlite.Task interface by providing configuration for the queue
+func (t ProgressExportTask) Config() backlite.QueueConfig {
+	return backlite.QueueConfig{
+		Name:        "ProgressExportTask",
+		MaxAttempts: 3,
+		Timeout:     10 * time.Minute,
+		Backoff:     time.Minute,
+		Retention: &backlite.Retention{
+			Duration:   24 * time.Hour,
+			OnlyFailed: false,
+			Data: &backlite.RetainData{
+				OnlyFailed: false,
+			},
+		},
+	}
+}
+
+// NewProgressExportTaskQueue provides a Queue that can process ProgressExportTask tasks
+func NewProgressExportTaskQueue(c *services.Container) backlite.Queue {
+	return backlite.NewQueue[ProgressExportTask](func(ctx context.Context, task ProgressExportTask) error {
+		log.Default().Info("Processing progress export task",
+			"user_id", task.UserID,
+			"format", task.Format,
+		)
+
+		format, err := services.ParseExportFormat(task.Format)
+		if err != nil {
+			return err
+		}
+
+		u, err := c.ORM.User.Get(ctx, task.UserID)
+		if err != nil {
+			return fmt.Errorf("failed to load user: %w", err)
+		}
+		if u.Email == nil || *u.Email == "" {
+			return errors.New("user has no email address to send the export to")
+		}
+
+		name, err := c.Progress.CreateExport(ctx, u, format, time.Now())
+		if err != nil {
+			log.Default().Error("Failed to create progress export",
+				"user_id", task.UserID,
+				"error", err,
+			)
+			return err
+		}
+
+		url := c.Config.App.Host + c.Web.Reverse("progress.export.download", name)
+
+		// The mail client logs through an echo context, which tasks don't have
+		err = c.Mail.
+			Compose().
+			To(*u.Email).
+			Subject("Your progress export is ready").
+			Component(emails.ProgressExportReady(u.Name, string(format), url)).
+			Send(c.Web.NewContext(nil, nil))
+		if err != nil {
+			return fmt.Errorf("failed to email progress export: %w", err)
+		}
+
+		log.Default().Info("Progress export sent successfully",
+			"user_id", task.UserID,
+			"format", task.Format,
+		)
+
+		return nil
+	})
+}
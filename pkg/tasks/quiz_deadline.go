@@ -0,0 +1,56 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mikestefanello/backlite"
+	"github.com/r-scheele/zero/pkg/log"
+	"github.com/r-scheele/zero/pkg/services"
+)
+
+// QuizDeadlineTask closes a timed quiz attempt that was not submitted before its deadline.
+// It should be queued to run once the deadline and grace period have passed.
+type QuizDeadlineTask struct {
+	AttemptID int `json:"attempt_id"`
+}
+
+// Config satisfies the backlite.Task interface by providing configuration for the queue
+func (t QuizDeadlineTask) Config() backlite.QueueConfig {
+	return backlite.QueueConfig{
+		Name:        "QuizDeadlineTask",
+		MaxAttempts: 5,
+		Timeout:     30 * time.Second,
+		Backoff:     10 * time.Second,
+		Retention: &backlite.Retention{
+			Duration:   24 * time.Hour,
+			OnlyFailed: false,
+			Data: &backlite.RetainData{
+				OnlyFailed: false,
+			},
+		},
+	}
+}
+
+// NewQuizDeadlineTaskQueue provides a Queue that can process QuizDeadlineTask tasks
+func NewQuizDeadlineTaskQueue(c *services.Container) backlite.Queue {
+	return backlite.NewQueue[QuizDeadlineTask](func(ctx context.Context, task QuizDeadlineTask) error {
+		closed, err := c.Quiz.CloseExpiredAttempt(ctx, task.AttemptID)
+		if err != nil {
+			log.Default().Error("Failed to close expired quiz attempt",
+				"attempt_id", task.AttemptID,
+				"error", err,
+			)
+			return fmt.Errorf("failed to close expired quiz attempt: %w", err)
+		}
+
+		if closed {
+			log.Default().Info("Closed expired quiz attempt",
+				"attempt_id", task.AttemptID,
+			)
+		}
+
+		return nil
+	})
+}
@@ -10,4 +10,13 @@ func Register(c *services.Container) {
 	c.Tasks.Register(NewPhoneVerificationTaskQueue(c))
 	c.Tasks.Register(NewPasswordResetTaskQueue(c))
 	c.Tasks.Register(NewFileUploadTaskQueue(c))
+	c.Tasks.Register(NewPracticeSetTaskQueue(c))
+	c.Tasks.Register(NewCurriculumTaskQueue(c))
+	c.Tasks.Register(NewNoteProposalReviewedTaskQueue(c))
+	c.Tasks.Register(NewQuizDeadlineTaskQueue(c))
+	c.Tasks.Register(NewProgressExportTaskQueue(c))
+	c.Tasks.Register(NewTextExtractionTaskQueue(c))
+	c.Tasks.Register(NewThumbnailTaskQueue(c))
+	c.Tasks.Register(NewVirusScanTaskQueue(c))
+	c.Tasks.Register(NewUploadExpiryTaskQueue(c))
 }
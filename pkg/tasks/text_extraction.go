@@ -0,0 +1,97 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/mikestefanello/backlite"
+	"github.com/r-scheele/zero/pkg/log"
+	"github.com/r-scheele/zero/pkg/services"
+)
+
+// TextExtractionTask represents a task to extract the text from a file uploaded to a note, so
+// the note can be searched by it
+type TextExtractionTask struct {
+	NoteID   int    `json:"note_id"`
+	Key      string `json:"key"`
+	FileName string `json:"file_name"`
+	MimeType string `json:"mime_type"`
+}
+
+// Config satisfies the backlite.Task interface by providing configuration for the queue
+func (t TextExtractionTask) Config() backlite.QueueConfig {
+	return backlite.QueueConfig{
+		Name:        "TextExtractionTask",
+		MaxAttempts: 3,
+		Timeout:     5 * time.Minute,
+		Backoff:     time.Minute,
+		Retention: &backlite.Retention{
+			// Failed extractions are kept, with their data, so they can be inspected in the admin
+			// task pages
+			Duration:   7 * 24 * time.Hour,
+			OnlyFailed: true,
+			Data: &backlite.RetainData{
+				OnlyFailed: true,
+			},
+		},
+	}
+}
+
+// NewTextExtractionTaskQueue provides a Queue that can process TextExtractionTask tasks
+func NewTextExtractionTaskQueue(c *services.Container) backlite.Queue {
+	return backlite.NewQueue[TextExtractionTask](func(ctx context.Context, task TextExtractionTask) error {
+		logger := log.Default()
+		logger.Info("Processing text extraction task",
+			"note_id", task.NoteID,
+			"key", task.Key,
+		)
+
+		if !services.CanExtractText(task.FileName, task.MimeType) {
+			return services.ErrExtractionUnsupported
+		}
+
+		// Extraction needs random access to the file, so it is copied locally first
+		src, err := c.Storage.OpenFile(ctx, task.Key)
+		if err != nil {
+			return fmt.Errorf("failed to open file: %w", err)
+		}
+		defer src.Close()
+
+		tmp, err := os.CreateTemp("", "extract-*")
+		if err != nil {
+			return fmt.Errorf("failed to create temporary file: %w", err)
+		}
+		defer os.Remove(tmp.Name())
+		defer tmp.Close()
+
+		size, err := io.Copy(tmp, src)
+		if err != nil {
+			return fmt.Errorf("failed to download file: %w", err)
+		}
+
+		text, err := services.ExtractText(tmp, size, task.FileName, task.MimeType)
+		if err != nil {
+			logger.Error("Failed to extract text",
+				"note_id", task.NoteID,
+				"key", task.Key,
+				"error", err,
+			)
+			return err
+		}
+
+		if err := c.Notes.SetResourceText(ctx, task.NoteID, task.Key, text); err != nil {
+			return err
+		}
+
+		logger.Info("Text extraction task completed successfully",
+			"note_id", task.NoteID,
+			"key", task.Key,
+			"length", len(text),
+		)
+
+		return nil
+	})
+}
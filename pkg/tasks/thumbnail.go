@@ -0,0 +1,113 @@
+package tasks
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/mikestefanello/backlite"
+	"github.com/r-scheele/zero/ent/blobreference"
+	"github.com/r-scheele/zero/pkg/log"
+	"github.com/r-scheele/zero/pkg/services"
+)
+
+// ThumbnailTask represents a task to strip the metadata from an image uploaded to a note and
+// generate its thumbnails
+type ThumbnailTask struct {
+	NoteID   int    `json:"note_id"`
+	Key      string `json:"key"`
+	MimeType string `json:"mime_type"`
+}
+
+// Config satisfies the backlite.Task interface by providing configuration for the queue
+func (t ThumbnailTask) Config() backlite.QueueConfig {
+	return backlite.QueueConfig{
+		Name:        "ThumbnailTask",
+		MaxAttempts: 3,
+		Timeout:     5 * time.Minute,
+		Backoff:     time.Minute,
+		Retention: &backlite.Retention{
+			Duration:   7 * 24 * time.Hour,
+			OnlyFailed: true,
+			Data: &backlite.RetainData{
+				OnlyFailed: true,
+			},
+		},
+	}
+}
+
+// NewThumbnailTaskQueue provides a Queue that can process ThumbnailTask tasks
+func NewThumbnailTaskQueue(c *services.Container) backlite.Queue {
+	return backlite.NewQueue[ThumbnailTask](func(ctx context.Context, task ThumbnailTask) error {
+		logger := log.Default()
+		logger.Info("Processing thumbnail task",
+			"note_id", task.NoteID,
+			"key", task.Key,
+		)
+
+		src, err := c.Storage.OpenFile(ctx, task.Key)
+		if err != nil {
+			return fmt.Errorf("failed to open image: %w", err)
+		}
+		data, err := io.ReadAll(src)
+		src.Close()
+		if err != nil {
+			return fmt.Errorf("failed to download image: %w", err)
+		}
+
+		processed, err := services.ProcessImage(data)
+		if err != nil {
+			logger.Error("Failed to process image",
+				"note_id", task.NoteID,
+				"key", task.Key,
+				"error", err,
+			)
+			return err
+		}
+
+		// Replace the original with a copy that has no EXIF metadata, such as GPS coordinates. The
+		// copy is stored by its own content, so other notes using the original are unaffected.
+		image := services.ResourceImage{
+			Key:  task.Key,
+			Size: int64(len(data)),
+		}
+		if processed.Original != nil {
+			stripped, err := c.Blobs.Replace(ctx, blobreference.KindNote, task.NoteID, task.Key, task.Key, bytes.NewReader(processed.Original), task.MimeType)
+			switch {
+			case errors.Is(err, services.ErrBlobReferenceNotFound):
+				logger.Info("Image was removed from the note before processing",
+					"note_id", task.NoteID,
+					"key", task.Key,
+				)
+				return nil
+			case err != nil:
+				return fmt.Errorf("failed to replace image: %w", err)
+			}
+			image.Key = stripped.StorageKey
+			image.Size = stripped.Size
+		}
+
+		image.Thumbnails = make(map[string]string, len(processed.Thumbnails))
+		for name, thumb := range processed.Thumbnails {
+			key := services.ThumbnailKey(image.Key, name)
+			if _, err := c.Storage.UploadFile(ctx, key, bytes.NewReader(thumb), "image/jpeg"); err != nil {
+				return fmt.Errorf("failed to upload thumbnail: %w", err)
+			}
+			image.Thumbnails[name] = key
+		}
+
+		if err := c.Notes.SetResourceImage(ctx, task.NoteID, task.Key, image); err != nil {
+			return err
+		}
+
+		logger.Info("Thumbnail task completed successfully",
+			"note_id", task.NoteID,
+			"key", task.Key,
+		)
+
+		return nil
+	})
+}
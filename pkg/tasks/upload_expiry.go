@@ -0,0 +1,63 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mikestefanello/backlite"
+	"github.com/r-scheele/zero/pkg/log"
+	"github.com/r-scheele/zero/pkg/services"
+)
+
+// UploadExpiryTask removes a resumable upload once it has been abandoned or completed.
+// It should be queued to run when the upload expires, and queues itself again if chunks have
+// arrived since.
+type UploadExpiryTask struct {
+	UploadID int `json:"upload_id"`
+}
+
+// Config satisfies the backlite.Task interface by providing configuration for the queue
+func (t UploadExpiryTask) Config() backlite.QueueConfig {
+	return backlite.QueueConfig{
+		Name:        "UploadExpiryTask",
+		MaxAttempts: 5,
+		Timeout:     time.Minute,
+		Backoff:     time.Minute,
+		Retention: &backlite.Retention{
+			Duration:   24 * time.Hour,
+			OnlyFailed: false,
+			Data: &backlite.RetainData{
+				OnlyFailed: false,
+			},
+		},
+	}
+}
+
+// NewUploadExpiryTaskQueue provides a Queue that can process UploadExpiryTask tasks
+func NewUploadExpiryTaskQueue(c *services.Container) backlite.Queue {
+	return backlite.NewQueue[UploadExpiryTask](func(ctx context.Context, task UploadExpiryTask) error {
+		expiresAt, err := c.Uploads.Expire(ctx, task.UploadID, time.Now())
+		if err != nil {
+			log.Default().Error("Failed to expire resumable upload",
+				"upload_id", task.UploadID,
+				"error", err,
+			)
+			return fmt.Errorf("failed to expire resumable upload: %w", err)
+		}
+
+		// The upload is still in progress, so check it again when it next expires
+		if expiresAt != nil {
+			return c.Tasks.
+				Add(task).
+				Wait(time.Until(*expiresAt) + time.Second).
+				Save()
+		}
+
+		log.Default().Info("Removed resumable upload",
+			"upload_id", task.UploadID,
+		)
+
+		return nil
+	})
+}
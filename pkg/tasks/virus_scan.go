@@ -0,0 +1,196 @@
+package tasks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/mikestefanello/backlite"
+	"github.com/r-scheele/zero/ent/blobreference"
+	"github.com/r-scheele/zero/pkg/log"
+	"github.com/r-scheele/zero/pkg/services"
+	"github.com/r-scheele/zero/pkg/types"
+)
+
+const (
+	// quarantineDirectory is where uploaded files are kept until they've been scanned for viruses
+	quarantineDirectory = "quarantine"
+
+	// virusScanAttempts is the number of times a file is scanned before it's rejected, such as
+	// when the scanner stays unreachable
+	virusScanAttempts = 5
+
+	// virusScanBackoff is how long to wait before scanning a file again
+	virusScanBackoff = time.Minute
+)
+
+// VirusScanTask represents a task to scan a quarantined upload for viruses, then store it on its
+// note if it's clean
+type VirusScanTask struct {
+	NoteID     int    `json:"note_id"`
+	UserID     int    `json:"user_id"`
+	Quarantine string `json:"quarantine"`
+	FileName   string `json:"file_name"`
+	MimeType   string `json:"mime_type"`
+	Attempt    int    `json:"attempt"`
+}
+
+// Config satisfies the backlite.Task interface by providing configuration for the queue. Tasks
+// retry themselves, so the file can be rejected rather than left pending once they give up.
+func (t VirusScanTask) Config() backlite.QueueConfig {
+	return backlite.QueueConfig{
+		Name:        "VirusScanTask",
+		MaxAttempts: 1,
+		Timeout:     5 * time.Minute,
+		Retention: &backlite.Retention{
+			Duration:   7 * 24 * time.Hour,
+			OnlyFailed: true,
+			Data: &backlite.RetainData{
+				OnlyFailed: true,
+			},
+		},
+	}
+}
+
+// NewVirusScanTaskQueue provides a Queue that can process VirusScanTask tasks
+func NewVirusScanTaskQueue(c *services.Container) backlite.Queue {
+	return backlite.NewQueue[VirusScanTask](func(ctx context.Context, task VirusScanTask) error {
+		logger := log.Default()
+		logger.Info("Processing virus scan task",
+			"note_id", task.NoteID,
+			"file_name", task.FileName,
+			"attempt", task.Attempt+1,
+		)
+
+		err := scanUpload(ctx, c, task)
+		if err == nil {
+			return nil
+		}
+
+		logger.Error("Failed to scan uploaded file",
+			"note_id", task.NoteID,
+			"file_name", task.FileName,
+			"attempt", task.Attempt+1,
+			"error", err,
+		)
+
+		if task.Attempt+1 < virusScanAttempts {
+			task.Attempt++
+			return c.Tasks.
+				Add(task).
+				Wait(virusScanBackoff).
+				Save()
+		}
+
+		// The file couldn't be scanned, so it's rejected rather than left pending forever
+		if _, rejectErr := finishScan(ctx, c, task, services.ScannedResource{
+			Status: types.ScanRejected,
+			Detail: "The file couldn't be scanned for viruses",
+		}); rejectErr != nil {
+			err = errors.Join(err, rejectErr)
+		}
+		return fmt.Errorf("failed to scan uploaded file: %w", err)
+	})
+}
+
+// scanUpload scans a quarantined upload and stores it on its note if it's clean, recording the
+// scan on its resource either way
+func scanUpload(ctx context.Context, c *services.Container, task VirusScanTask) error {
+	logger := log.Default()
+
+	if c.Scanner == nil {
+		return errors.New("no virus scanner is configured")
+	}
+
+	file, err := c.Files.Open(task.Quarantine)
+	if err != nil {
+		return fmt.Errorf("failed to open quarantined file: %w", err)
+	}
+	defer file.Close()
+
+	// The scanner being unavailable fails the scan, so it's retried
+	result, err := c.Scanner.Scan(ctx, file)
+	if err != nil {
+		return fmt.Errorf("failed to scan file: %w", err)
+	}
+
+	if !result.Clean {
+		logger.Warn("Virus found in uploaded file",
+			"note_id", task.NoteID,
+			"user_id", task.UserID,
+			"file_name", task.FileName,
+			"threat", result.Threat,
+		)
+		_, err := finishScan(ctx, c, task, services.ScannedResource{
+			Status: types.ScanInfected,
+			Detail: result.Threat,
+		})
+		return err
+	}
+
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("failed to read quarantined file: %w", err)
+	}
+
+	ref := services.BlobRef{OwnerID: task.UserID, Kind: blobreference.KindNote, TargetID: task.NoteID}
+	stored, err := c.Blobs.Store(ctx, ref, task.FileName, file, task.MimeType)
+	if errors.Is(err, services.ErrQuotaExceeded) {
+		// Retrying won't free up space, so the file is dropped
+		_, err := finishScan(ctx, c, task, services.ScannedResource{
+			Status: types.ScanRejected,
+			Detail: err.Error(),
+		})
+		return err
+	}
+	if err != nil {
+		return fmt.Errorf("failed to upload file to cloud storage: %w", err)
+	}
+
+	kept, err := finishScan(ctx, c, task, services.ScannedResource{
+		Status:   types.ScanClean,
+		Key:      stored.StorageKey,
+		Size:     stored.Size,
+		MimeType: task.MimeType,
+	})
+	if err != nil || !kept {
+		return errors.Join(err, c.Blobs.Release(ctx, ref.Kind, task.NoteID, stored.StorageKey))
+	}
+
+	queueResourceProcessing(c, task.NoteID, types.Resource{
+		Type:     determineResourceType(task.MimeType, task.FileName),
+		Name:     task.FileName,
+		Key:      stored.StorageKey,
+		MimeType: task.MimeType,
+	})
+
+	logger.Info("Virus scan task completed successfully",
+		"note_id", task.NoteID,
+		"file_name", task.FileName,
+	)
+
+	return nil
+}
+
+// finishScan records the scan on the file's resource and removes it from quarantine. It reports
+// whether the resource is still on the note, as files removed while they were being scanned are
+// dropped.
+func finishScan(ctx context.Context, c *services.Container, task VirusScanTask, scan services.ScannedResource) (bool, error) {
+	err := c.Notes.SetResourceScan(ctx, task.NoteID, task.Quarantine, scan)
+	kept := !errors.Is(err, services.ErrResourceNotFound)
+	if err != nil && kept {
+		return false, fmt.Errorf("failed to record virus scan: %w", err)
+	}
+	if !kept {
+		log.Default().Info("Dropping scanned file removed from its note",
+			"note_id", task.NoteID,
+			"file_name", task.FileName,
+		)
+	}
+
+	if err := c.Files.Remove(task.Quarantine); err != nil {
+		log.Default().Error("Failed to remove quarantined file", "error", err, "quarantine", task.Quarantine)
+	}
+	return kept, nil
+}
@@ -4,13 +4,33 @@ import "time"
 
 // Resource represents a file or link attached to a note
 type Resource struct {
-	Type          string    `json:"type"`           // "file", "youtube", "url", "image", "pdf", "doc", "video"
-	Name          string    `json:"name"`           // Display name
-	URL           string    `json:"url"`            // File path or external URL
-	Size          int64     `json:"size"`           // File size in bytes (0 for external links)
-	MimeType      string    `json:"mime_type"`      // MIME type for files
-	Thumbnail     string    `json:"thumbnail"`      // Thumbnail path for videos/images
-	Duration      int       `json:"duration"`       // Duration in seconds for videos
-	ExtractedText string    `json:"extracted_text"` // Text extracted from PDFs, docs, etc.
-	UploadedAt    time.Time `json:"uploaded_at"`
-}
\ No newline at end of file
+	Type          string            `json:"type"`                  // "file", "youtube", "url", "image", "pdf", "doc", "video"
+	Name          string            `json:"name"`                  // Display name
+	URL           string            `json:"url"`                   // File path or external URL
+	Key           string            `json:"key,omitempty"`         // Storage key for uploaded files
+	Size          int64             `json:"size"`                  // File size in bytes (0 for external links)
+	MimeType      string            `json:"mime_type"`             // MIME type for files
+	Thumbnail     string            `json:"thumbnail"`             // Thumbnail path for videos/images
+	Thumbnails    map[string]string `json:"thumbnails,omitempty"`  // Storage keys of resized thumbnails, by size
+	Duration      int               `json:"duration"`              // Duration in seconds for videos
+	ExtractedText string            `json:"extracted_text"`        // Text extracted from PDFs, docs, etc.
+	ScanStatus    string            `json:"scan_status,omitempty"` // Virus scan state of an uploaded file, empty if it wasn't scanned
+	ScanDetail    string            `json:"scan_detail,omitempty"` // Why the file was rejected, such as the threat found
+	Quarantine    string            `json:"quarantine,omitempty"`  // Path of the file while it waits to be scanned
+	UploadedAt    time.Time         `json:"uploaded_at"`
+}
+
+// Virus scan states of uploaded files. Files are kept in quarantine, and aren't put in storage,
+// until their scan passes.
+const (
+	ScanPending  = "pending"
+	ScanClean    = "clean"
+	ScanInfected = "infected"
+	ScanRejected = "rejected"
+)
+
+// Available reports whether the resource can be opened, which files can't be until they pass
+// their virus scan
+func (r Resource) Available() bool {
+	return r.ScanStatus == "" || r.ScanStatus == ScanClean
+}
@@ -0,0 +1,42 @@
+package components
+
+import (
+	"github.com/r-scheele/zero/pkg/ui"
+	. "maragu.dev/gomponents"
+	. "maragu.dev/gomponents/html"
+)
+
+// Markdown shows markdown that was rendered to HTML and sanitized on the server
+func Markdown(id, html string) Node {
+	return Div(
+		If(id != "", ID(id)),
+		Class("prose prose-slate max-w-none break-words"),
+		Raw(html),
+	)
+}
+
+// MarkdownAssets loads the styles for highlighted code, and KaTeX to typeset the math in rendered
+// markdown, including markdown swapped in by HTMX. KaTeX is served from the static files (see
+// make katex-install), which the content security policy allows.
+func MarkdownAssets() Node {
+	const typeset = `
+		document.addEventListener('htmx:load', function(evt) {
+			if (!window.katex) return;
+			evt.detail.elt.querySelectorAll('.math').forEach(function(el) {
+				if (el.dataset.typeset) return;
+				el.dataset.typeset = 'true';
+				katex.render(el.textContent, el, {
+					displayMode: el.classList.contains('math-display'),
+					throwOnError: false
+				});
+			});
+		});
+	`
+
+	return Group{
+		Link(Rel("stylesheet"), Href(ui.StaticFile("highlight.css"))),
+		Link(Rel("stylesheet"), Href(ui.StaticFile("katex/katex.min.css"))),
+		Script(Src(ui.StaticFile("katex/katex.min.js")), Defer()),
+		Script(Raw(typeset)),
+	}
+}
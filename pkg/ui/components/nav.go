@@ -2,6 +2,7 @@ package components
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/r-scheele/zero/pkg/pager"
 	"github.com/r-scheele/zero/pkg/ui"
@@ -45,9 +46,15 @@ func MenuLink(r *ui.Request, icon Node, title, routeName string, routeParams ...
 }
 
 func Pager(page int, path string, hasNext bool, hxTarget string) Node {
+	// The path may already carry a query string, such as search filters
+	sep := "?"
+	if strings.Contains(path, "?") {
+		sep = "&"
+	}
 	href := func(page int) string {
-		return fmt.Sprintf("%s?%s=%d",
+		return fmt.Sprintf("%s%s%s=%d",
 			path,
+			sep,
 			pager.QueryKey,
 			page,
 		)
@@ -51,6 +51,18 @@ func ProfileNav(r *ui.Request) Node {
 					}()),
 					Text("Security"),
 				),
+				// Storage tab
+				A(
+					Href(r.Path(routenames.ProfileStorage)),
+					Class(func() string {
+						// Check if we're on the storage page
+						if r.CurrentPath == r.Path(routenames.ProfileStorage) || r.CurrentPath == "/profile/storage" {
+							return "py-4 px-1 border-b-2 border-blue-500 text-blue-600 font-medium"
+						}
+						return "py-4 px-1 border-b-2 border-transparent text-gray-500 hover:text-gray-700 hover:border-gray-300 font-medium"
+					}()),
+					Text("Storage"),
+				),
 				// Account tab
 				A(
 					Href(r.Path(routenames.ProfileDeactivate)),
@@ -0,0 +1,110 @@
+package forms
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/r-scheele/zero/pkg/form"
+	"github.com/r-scheele/zero/pkg/ui"
+	. "github.com/r-scheele/zero/pkg/ui/components"
+	. "maragu.dev/gomponents"
+	. "maragu.dev/gomponents/html"
+)
+
+// Document represents the form for uploading a document, or editing one when ID is set
+type Document struct {
+	ID          int
+	Title       string `form:"title" validate:"max=200"`
+	Description string `form:"description" validate:"max=1000"`
+	Tags        string `form:"tags" validate:"max=500"`
+	Visibility  string `form:"visibility" validate:"oneof=private public"`
+	FolderID    int    `form:"folder_id"`
+	Folders     []Choice
+	form.Submission
+}
+
+// Render renders the document form
+func (f *Document) Render(r *ui.Request) Node {
+	action := r.Path("documents.upload.submit")
+	button := "Upload Document"
+	if f.ID != 0 {
+		action = r.Path("documents.edit", f.ID)
+		button = "Save Changes"
+	}
+
+	folders := append([]Choice{{Value: "0", Label: "No folder"}}, f.Folders...)
+
+	return Form(
+		ID("document"),
+		Method(http.MethodPost),
+		Action(action),
+		If(f.ID == 0, EncType("multipart/form-data")),
+		Class("space-y-6"),
+		FlashMessages(r),
+
+		If(f.ID == 0,
+			FileField(FileFieldParams{
+				Name:  "file",
+				Label: "File",
+				Help:  "PDFs, images and videos can be previewed in the library.",
+			}),
+		),
+
+		InputField(InputFieldParams{
+			Form:      f,
+			FormField: "Title",
+			Name:      "title",
+			InputType: "text",
+			Label:     "Title",
+			Value:     f.Title,
+			Help:      "Leave blank to use the file name",
+		}),
+
+		TextareaField(TextareaFieldParams{
+			Form:      f,
+			FormField: "Description",
+			Name:      "description",
+			Label:     "Description (Optional)",
+			Value:     f.Description,
+		}),
+
+		InputField(InputFieldParams{
+			Form:        f,
+			FormField:   "Tags",
+			Name:        "tags",
+			InputType:   "text",
+			Label:       "Tags",
+			Value:       f.Tags,
+			Placeholder: "biology, exam prep",
+			Help:        "Separate tags with commas",
+		}),
+
+		SelectList(OptionsParams{
+			Form:      f,
+			FormField: "FolderID",
+			Name:      "folder_id",
+			Label:     "Folder",
+			Value:     fmt.Sprint(f.FolderID),
+			Options:   folders,
+		}),
+
+		SelectList(OptionsParams{
+			Form:      f,
+			FormField: "Visibility",
+			Name:      "visibility",
+			Label:     "Visibility",
+			Value:     f.Visibility,
+			Options: []Choice{
+				{Value: "private", Label: "Private"},
+				{Value: "public", Label: "Public"},
+			},
+			Help: "Anyone signed in can view public documents",
+		}),
+
+		ControlGroup(
+			FormButton(ColorPrimary, button),
+		),
+
+		CSRF(r),
+	)
+}
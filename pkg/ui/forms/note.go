@@ -32,15 +32,102 @@ type CreateNote struct {
 	Title           string   `form:"title" validate:"required,min=1,max=200"`
 	Description     string   `form:"description" validate:"max=500"`
 	Content         string   `form:"content"`
-	Visibility      string   `form:"visibility" validate:"oneof=private public"`
-	PermissionLevel string   `form:"permission_level" validate:"oneof=read_only read_write read_write_approval"`
+	Visibility      string   `form:"visibility" validate:"omitempty,oneof=private public"`
+	PermissionLevel string   `form:"permission_level" validate:"omitempty,oneof=read_only read_write read_write_approval"`
 	ResourceURLs    []string `form:"resource_urls"`
+	NotebookID      int      `form:"notebook_id"`
+	Notebooks       []NotebookChoice
 	MaxFileSize  int64
 	MaxTotalSize int64
 	MaxFiles     int
 	form.Submission
 }
 
+// NotebookChoice is a notebook a note can be created in, with the settings its notes start with
+type NotebookChoice struct {
+	Choice
+	Visibility      string
+	PermissionLevel string
+}
+
+// notebookField lets a note be created in a notebook. Choosing one applies its default visibility
+// and permission level to the form.
+func notebookField(f *CreateNote) Node {
+	if len(f.Notebooks) == 0 {
+		return nil
+	}
+
+	return Div(
+		Label(
+			For("notebook_id"),
+			Class("block text-sm font-medium text-gray-700 mb-2"),
+			Text("Notebook"),
+		),
+		Select(
+			ID("notebook_id"),
+			Name("notebook_id"),
+			Class("w-full px-3 py-2 border border-gray-300 rounded-md focus:outline-none focus:ring-2 focus:ring-blue-500"),
+			Option(Value("0"), Text("No notebook")),
+			Map(f.Notebooks, func(nb NotebookChoice) Node {
+				return Option(
+					Value(nb.Value),
+					Data("visibility", nb.Visibility),
+					Data("permission-level", nb.PermissionLevel),
+					If(nb.Value == fmt.Sprint(f.NotebookID), Selected()),
+					Text(nb.Label),
+				)
+			}),
+		),
+		P(
+			Class("text-sm text-gray-500 mt-1"),
+			Text("Notes start with the visibility and permission level of their notebook"),
+		),
+	)
+}
+
+// contentPreview shows the content field rendered as markdown, updated as it's typed
+func contentPreview(r *ui.Request) Node {
+	return Div(
+		Class("space-y-2"),
+		P(
+			Class("text-sm font-medium text-gray-700"),
+			Text("Preview"),
+		),
+		Div(
+			ID("content-preview"),
+			Class("min-h-16 p-4 bg-white border border-gray-200 rounded-xl"),
+			Attr("hx-post", r.Path(routenames.Notes+".preview")),
+			Attr("hx-trigger", "load, input changed delay:500ms from:#content"),
+			Attr("hx-params", "content"),
+			Attr("hx-include", "#content"),
+			Attr("hx-target", "this"),
+			Attr("hx-swap", "innerHTML"),
+		),
+		MarkdownAssets(),
+	)
+}
+
+// brokenLinks warns about wiki links that don't match a note the links can point to
+func brokenLinks(targets []string) Node {
+	if len(targets) == 0 {
+		return nil
+	}
+
+	return Div(
+		Class("p-4 bg-red-50 border border-red-200 rounded-lg text-sm text-red-800"),
+		P(
+			Class("font-medium mb-2"),
+			Text("These links don't match any of your notes or a public note:"),
+		),
+		Ul(
+			Class("list-disc list-inside"),
+			Map(targets, func(target string) Node {
+				return Li(Code(Text("[[" + target + "]]")))
+			}),
+		),
+	)
+}
+
 // Render renders the create note form
 func (f *CreateNote) Render(r *ui.Request) Node {
 	return Form(
@@ -78,8 +165,10 @@ func (f *CreateNote) Render(r *ui.Request) Node {
 			Name:      "content",
 			Label:     "Content",
 			Value:     f.Content,
-			Help:      "Main content of your note",
+			Help:      "Main content of your note. Markdown, math between $ signs, fenced code blocks and [[Note Title]] or [[note:123]] links to other notes are supported.",
 		}),
+		contentPreview(r),
+		notebookField(f),
 
 		// Visibility settings
 		Div(
@@ -407,6 +496,19 @@ func (f *CreateNote) Render(r *ui.Request) Node {
 						});
 					}
 					
+					// Apply the defaults of the chosen notebook
+					const notebookSelect = document.getElementById('notebook_id');
+					if (notebookSelect) {
+						notebookSelect.addEventListener('change', function() {
+							const chosen = notebookSelect.selectedOptions[0];
+							if (chosen && chosen.dataset.visibility) {
+								document.getElementById('visibility').value = chosen.dataset.visibility;
+								document.getElementById('permission_level').value = chosen.dataset.permissionLevel;
+								updatePermissionVisibility();
+							}
+						});
+					}
+					
 					// Initialize file validation
 					initializeFileValidation(%d, %d, %d);
 					
@@ -455,6 +557,16 @@ type EditNote struct {
 	MaxFileSize     int64
 	MaxTotalSize    int64
 	MaxFiles        int
+
+	// Collaborator is set when the user doesn't own the note, so can only change its text
+	Collaborator bool
+
+	// NeedsApproval is set when the user's changes are proposed to the owner
+	NeedsApproval bool
+
+	// BrokenLinks are the targets of the wiki links in the saved note that don't match a note
+	BrokenLinks []string
+
 	form.Submission
 }
 
@@ -474,6 +586,13 @@ func (f *EditNote) Render(r *ui.Request) Node {
 			Value(fmt.Sprintf("%d", f.ID)),
 		),
 
+		If(f.NeedsApproval,
+			Div(
+				Class("p-4 bg-yellow-50 border border-yellow-200 rounded-md text-sm text-yellow-800"),
+				Text("Your changes will be sent to the note's owner, who can accept or reject them."),
+			),
+		),
+
 		// Title field
 		InputField(InputFieldParams{
 			Form:      f,
@@ -500,10 +619,13 @@ func (f *EditNote) Render(r *ui.Request) Node {
 			Name:      "content",
 			Label:     "Content",
 			Value:     f.Content,
+			Help:      "Markdown, math between $ signs, fenced code blocks and [[Note Title]] or [[note:123]] links to other notes are supported.",
 		}),
+		brokenLinks(f.BrokenLinks),
+		contentPreview(r),
 
 		// Resource upload section
-		Div(
+		If(!f.Collaborator, Div(
 			Class("space-y-4"),
 			H3(
 				Class("text-lg font-medium text-gray-900 mb-4"),
@@ -559,6 +681,9 @@ func (f *EditNote) Render(r *ui.Request) Node {
 				),
 			),
 
+			// Resumable uploads for files too large to send with the form
+			resumableUpload(r, f.ID, f.MaxTotalSize),
+
 			// URL input section
 			Div(
 				Class("bg-green-50 border border-green-200 rounded-lg p-4"),
@@ -626,10 +751,16 @@ func (f *EditNote) Render(r *ui.Request) Node {
 				Class("text-xs text-green-600 mt-2"),
 				Text("Add YouTube videos, articles, or any web resources"),
 			),
-		),
+		)),
+
+		// Only the owner can change who can see and edit the note
+		If(f.Collaborator, Group{
+			Input(Type("hidden"), Name("visibility"), Value(f.Visibility)),
+			Input(Type("hidden"), Name("permission_level"), Value(f.PermissionLevel)),
+		}),
 
 		// Visibility and permission settings
-		Div(
+		If(!f.Collaborator, Div(
 			Class("grid grid-cols-1 md:grid-cols-2 gap-4"),
 
 			// Visibility field
@@ -691,11 +822,16 @@ func (f *EditNote) Render(r *ui.Request) Node {
 					),
 				),
 			),
-		),
+		)),
 
 		// Submit buttons
 		ControlGroup(
-			FormButton(ColorPrimary, "Update Note"),
+			FormButton(ColorPrimary, func() string {
+				if f.NeedsApproval {
+					return "Propose Changes"
+				}
+				return "Update Note"
+			}()),
 			A(
 				Href(r.Path(routenames.Notes+".view", f.ID)),
 				Class("ml-3 px-4 py-2 border border-gray-300 rounded-md text-gray-700 hover:bg-gray-50 transition-colors"),
@@ -0,0 +1,243 @@
+package forms
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/r-scheele/zero/pkg/form"
+	"github.com/r-scheele/zero/pkg/ui"
+	. "github.com/r-scheele/zero/pkg/ui/components"
+	. "maragu.dev/gomponents"
+	. "maragu.dev/gomponents/html"
+)
+
+// Quiz represents the form for creating or editing a quiz.
+// Questions are submitted as parallel slices, one entry per question block.
+type Quiz struct {
+	ID              int      `form:"id"`
+	Title           string   `form:"title" validate:"required,min=1,max=200"`
+	Description     string   `form:"description" validate:"max=1000"`
+	Visibility      string   `form:"visibility" validate:"oneof=private public"`
+	TimeLimit       int      `form:"time_limit" validate:"gte=0,lte=600"`
+	MaxAttempts     int      `form:"max_attempts" validate:"gte=0,lte=100"`
+	QuestionTypes   []string `form:"question_type"`
+	QuestionPrompts []string `form:"question_prompt"`
+	QuestionChoices []string `form:"question_choices"`
+	QuestionAnswers []string `form:"question_answer"`
+	form.Submission
+}
+
+// QuestionCount returns the number of question blocks in the form
+func (f *Quiz) QuestionCount() int {
+	return len(f.QuestionPrompts)
+}
+
+// questionValue safely returns the value at index i of a question slice
+func questionValue(values []string, i int) string {
+	if i < len(values) {
+		return values[i]
+	}
+	return ""
+}
+
+// Render renders the quiz form
+func (f *Quiz) Render(r *ui.Request) Node {
+	action := r.Path("quiz.create.submit")
+	submitLabel := "Create Quiz"
+	cancelHref := r.Path("quiz.list")
+	if f.ID != 0 {
+		action = r.Path("quiz.edit.submit", f.ID)
+		submitLabel = "Update Quiz"
+		cancelHref = r.Path("quiz.view", f.ID)
+	}
+
+	blocks := make(Group, 0, f.QuestionCount())
+	for i := 0; i < f.QuestionCount(); i++ {
+		blocks = append(blocks, quizQuestionBlock(
+			questionValue(f.QuestionTypes, i),
+			questionValue(f.QuestionPrompts, i),
+			questionValue(f.QuestionChoices, i),
+			questionValue(f.QuestionAnswers, i),
+		))
+	}
+
+	// Start new quizzes with a single empty question
+	if len(blocks) == 0 {
+		blocks = append(blocks, quizQuestionBlock("multiple_choice", "", "", ""))
+	}
+
+	return Form(
+		ID("quiz"),
+		Method(http.MethodPost),
+		Action(action),
+		Class("space-y-6"),
+		FlashMessages(r),
+
+		InputField(InputFieldParams{
+			Form:      f,
+			FormField: "Title",
+			Name:      "title",
+			InputType: "text",
+			Label:     "Title",
+			Value:     f.Title,
+		}),
+
+		TextareaField(TextareaFieldParams{
+			Form:      f,
+			FormField: "Description",
+			Name:      "description",
+			Label:     "Description (Optional)",
+			Value:     f.Description,
+			Help:      "What does this quiz cover?",
+		}),
+
+		SelectList(OptionsParams{
+			Form:      f,
+			FormField: "Visibility",
+			Name:      "visibility",
+			Label:     "Visibility",
+			Value:     f.Visibility,
+			Options: []Choice{
+				{Value: "private", Label: "Private"},
+				{Value: "public", Label: "Public"},
+			},
+			Help: "Public quizzes can be taken by anyone who is signed in",
+		}),
+
+		Div(
+			Class("grid grid-cols-1 sm:grid-cols-2 gap-4"),
+			InputField(InputFieldParams{
+				Form:      f,
+				FormField: "TimeLimit",
+				Name:      "time_limit",
+				InputType: "number",
+				Label:     "Time limit (minutes)",
+				Value:     strconv.Itoa(f.TimeLimit),
+				Help:      "Use 0 for no time limit. The deadline is enforced by the server.",
+			}),
+			InputField(InputFieldParams{
+				Form:      f,
+				FormField: "MaxAttempts",
+				Name:      "max_attempts",
+				InputType: "number",
+				Label:     "Attempts allowed",
+				Value:     strconv.Itoa(f.MaxAttempts),
+				Help:      "Use 0 for unlimited attempts",
+			}),
+		),
+
+		Div(
+			Class("space-y-4"),
+			H3(
+				Class("text-lg font-medium text-gray-900"),
+				Text("Questions"),
+			),
+			P(
+				Class("text-sm text-gray-600"),
+				Text("For multiple choice, enter one choice per line and start the correct choice with *. For true/false, enter true or false as the answer. For short answer, separate accepted answers with |."),
+			),
+			Div(
+				ID("quiz-questions"),
+				Class("space-y-4"),
+				blocks,
+			),
+			Button(
+				Type("button"),
+				ID("add-question-btn"),
+				Class("px-4 py-2 bg-green-600 text-white rounded-md hover:bg-green-700 transition-colors font-medium"),
+				Text("Add Question"),
+			),
+		),
+
+		ControlGroup(
+			FormButton(ColorPrimary, submitLabel),
+			A(
+				Href(cancelHref),
+				Class("ml-3 px-4 py-2 border border-gray-300 rounded-md text-gray-700 hover:bg-gray-50 transition-colors"),
+				Text("Cancel"),
+			),
+		),
+
+		CSRF(r),
+
+		Template(
+			ID("quiz-question-template"),
+			quizQuestionBlock("multiple_choice", "", "", ""),
+		),
+
+		Script(
+			Attr("type", "text/javascript"),
+			Raw(`
+				document.addEventListener('DOMContentLoaded', function() {
+					const container = document.getElementById('quiz-questions');
+					const template = document.getElementById('quiz-question-template');
+					const addBtn = document.getElementById('add-question-btn');
+
+					if (addBtn && container && template) {
+						addBtn.addEventListener('click', function() {
+							container.appendChild(template.content.cloneNode(true));
+						});
+					}
+
+					if (container) {
+						container.addEventListener('click', function(e) {
+							if (e.target.classList.contains('remove-question-btn') && container.children.length > 1) {
+								e.target.closest('.quiz-question').remove();
+							}
+						});
+					}
+				});
+			`),
+		),
+	)
+}
+
+// quizQuestionBlock renders the inputs for a single question
+func quizQuestionBlock(questionType, prompt, choices, answer string) Node {
+	option := func(value, label string) Node {
+		return Option(
+			Value(value),
+			If(questionType == value, Selected()),
+			Text(label),
+		)
+	}
+
+	return Div(
+		Class("quiz-question bg-white border border-gray-200 rounded-lg p-4 space-y-3"),
+		Div(
+			Class("flex justify-between items-center gap-2"),
+			Select(
+				Name("question_type"),
+				Class("px-3 py-2 border border-gray-300 rounded-md focus:outline-none focus:ring-2 focus:ring-blue-500"),
+				option("multiple_choice", "Multiple choice"),
+				option("true_false", "True / False"),
+				option("short_answer", "Short answer"),
+			),
+			Button(
+				Type("button"),
+				Class("remove-question-btn px-3 py-1.5 text-sm font-medium text-red-700 bg-red-50 border border-red-300 rounded-md hover:bg-red-100 transition-colors"),
+				Text("Remove"),
+			),
+		),
+		Textarea(
+			Name("question_prompt"),
+			Class("textarea w-full"),
+			Placeholder("Question"),
+			Text(prompt),
+		),
+		Textarea(
+			Name("question_choices"),
+			Class("textarea w-full"),
+			Placeholder(fmt.Sprintf("Choices (multiple choice only)\n%s\n%s", "*Correct choice", "Another choice")),
+			Text(choices),
+		),
+		Input(
+			Type("text"),
+			Name("question_answer"),
+			Class("input w-full"),
+			Placeholder("Answer (true/false or accepted short answers)"),
+			Value(answer),
+		),
+	)
+}
@@ -0,0 +1,137 @@
+package forms
+
+import (
+	"fmt"
+
+	"github.com/r-scheele/zero/pkg/routenames"
+	"github.com/r-scheele/zero/pkg/ui"
+	. "maragu.dev/gomponents"
+	. "maragu.dev/gomponents/html"
+)
+
+// resumableUpload renders a file picker that uploads large files to a note in chunks with the
+// tus protocol. Uploads pick up where they left off if the connection drops, or if the same file
+// is chosen again after the page is reloaded.
+func resumableUpload(r *ui.Request, noteID int, maxSize int64) Node {
+	const script = `
+		(function() {
+			const endpoint = '%s';
+			const csrf = '%s';
+			const maxSize = %d;
+			const chunkSize = 5 * 1024 * 1024;
+			const input = document.getElementById('resumable-upload');
+			const list = document.getElementById('resumable-upload-list');
+			if (!input || !list) return;
+
+			function headers(extra) {
+				return Object.assign({'Tus-Resumable': '1.0.0', 'X-CSRF-Token': csrf}, extra);
+			}
+
+			function sleep(ms) {
+				return new Promise(function(resolve) { setTimeout(resolve, ms); });
+			}
+
+			async function start(file, key) {
+				const saved = localStorage.getItem(key);
+				if (saved) {
+					const res = await fetch(saved, {method: 'HEAD', headers: headers()});
+					if (res.ok) {
+						return {url: saved, offset: parseInt(res.headers.get('Upload-Offset'), 10)};
+					}
+					localStorage.removeItem(key);
+				}
+
+				const meta = 'filename ' + btoa(unescape(encodeURIComponent(file.name))) + ',filetype ' + btoa(file.type);
+				const res = await fetch(endpoint, {
+					method: 'POST',
+					headers: headers({'Upload-Length': String(file.size), 'Upload-Metadata': meta}),
+				});
+				if (res.status !== 201) {
+					throw new Error(res.status === 413 ? 'Not enough space for this file' : 'The upload could not be started');
+				}
+				const url = res.headers.get('Location');
+				localStorage.setItem(key, url);
+				return {url: url, offset: 0};
+			}
+
+			async function upload(file, status) {
+				const key = 'tus:' + endpoint + ':' + file.name + ':' + file.size + ':' + file.lastModified;
+				let upload = await start(file, key);
+				let failures = 0;
+
+				while (upload.offset < file.size) {
+					status.textContent = Math.floor(upload.offset * 100 / file.size) + '%%';
+					try {
+						const res = await fetch(upload.url, {
+							method: 'PATCH',
+							headers: headers({'Upload-Offset': String(upload.offset), 'Content-Type': 'application/offset+octet-stream'}),
+							body: file.slice(upload.offset, upload.offset + chunkSize),
+						});
+						if (!res.ok) throw new Error('chunk rejected');
+						upload.offset = parseInt(res.headers.get('Upload-Offset'), 10);
+						failures = 0;
+					} catch (e) {
+						// Wait longer after each failure, then ask the server where to resume from
+						if (++failures > 8) throw new Error('The connection was lost, choose the file again to resume');
+						status.textContent = 'Reconnecting…';
+						await sleep(Math.min(30000, 1000 * Math.pow(2, failures)));
+						upload = await start(file, key);
+					}
+				}
+
+				localStorage.removeItem(key);
+				status.textContent = 'Uploaded, processing…';
+			}
+
+			input.addEventListener('change', function() {
+				Array.from(input.files).forEach(function(file) {
+					const row = document.createElement('li');
+					row.className = 'flex justify-between text-sm text-gray-700';
+					const name = document.createElement('span');
+					name.textContent = file.name;
+					const status = document.createElement('span');
+					row.appendChild(name);
+					row.appendChild(status);
+					list.appendChild(row);
+
+					if (file.size > maxSize) {
+						status.textContent = 'Too large';
+						return;
+					}
+					upload(file, status).catch(function(e) { status.textContent = e.message; });
+				});
+				input.value = '';
+			});
+		})();
+	`
+
+	return Div(
+		Class("bg-purple-50 border border-purple-200 rounded-lg p-4"),
+		Div(
+			Class("flex items-center mb-3"),
+			Div(
+				Class("w-8 h-8 bg-purple-100 rounded-full flex items-center justify-center mr-3"),
+				Text("🎬"),
+			),
+			H4(
+				Class("text-md font-medium text-purple-900"),
+				Text("Upload Large Files"),
+			),
+		),
+		P(
+			Class("text-sm text-purple-700 mb-3"),
+			Textf("For lecture recordings and other large files, up to %s. Uploads continue where they left off if your connection drops.", formatFileSize(maxSize)),
+		),
+		Input(
+			Type("file"),
+			ID("resumable-upload"),
+			Multiple(),
+			Class("block w-full text-sm text-purple-900"),
+		),
+		Ul(
+			ID("resumable-upload-list"),
+			Class("mt-3 space-y-1"),
+		),
+		Script(Raw(fmt.Sprintf(script, r.Path(routenames.Notes+".upload", noteID), r.CSRF, maxSize))),
+	)
+}
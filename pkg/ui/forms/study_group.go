@@ -0,0 +1,72 @@
+package forms
+
+import (
+	"net/http"
+
+	"github.com/r-scheele/zero/pkg/form"
+	"github.com/r-scheele/zero/pkg/ui"
+	. "github.com/r-scheele/zero/pkg/ui/components"
+	. "maragu.dev/gomponents"
+	. "maragu.dev/gomponents/html"
+)
+
+// StudyGroup represents the form for creating a study group
+type StudyGroup struct {
+	Name        string `form:"name" validate:"required,min=1,max=100"`
+	Description string `form:"description" validate:"max=1000"`
+	Privacy     string `form:"privacy" validate:"oneof=public invite_only"`
+	form.Submission
+}
+
+// Render renders the study group form
+func (f *StudyGroup) Render(r *ui.Request) Node {
+	return Form(
+		ID("study-group"),
+		Method(http.MethodPost),
+		Action(r.Path("study-groups.create.submit")),
+		Class("space-y-6"),
+		FlashMessages(r),
+
+		InputField(InputFieldParams{
+			Form:      f,
+			FormField: "Name",
+			Name:      "name",
+			InputType: "text",
+			Label:     "Name",
+			Value:     f.Name,
+		}),
+
+		TextareaField(TextareaFieldParams{
+			Form:      f,
+			FormField: "Description",
+			Name:      "description",
+			Label:     "Description (Optional)",
+			Value:     f.Description,
+			Help:      "What will this group study together?",
+		}),
+
+		SelectList(OptionsParams{
+			Form:      f,
+			FormField: "Privacy",
+			Name:      "privacy",
+			Label:     "Who can join",
+			Value:     f.Privacy,
+			Options: []Choice{
+				{Value: "public", Label: "Anyone"},
+				{Value: "invite_only", Label: "Invite only"},
+			},
+			Help: "Invite-only groups review join requests before adding members",
+		}),
+
+		ControlGroup(
+			FormButton(ColorPrimary, "Create Study Group"),
+			A(
+				Href(r.Path("study-groups.list")),
+				Class("ml-3 px-4 py-2 border border-gray-300 rounded-md text-gray-700 hover:bg-gray-50 transition-colors"),
+				Text("Cancel"),
+			),
+		),
+
+		CSRF(r),
+	)
+}
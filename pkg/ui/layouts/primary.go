@@ -715,7 +715,7 @@ func unifiedHeader(r *ui.Request) Node {
 								func() Node {
 									if r.AuthUser != nil && r.AuthUser.ProfilePicture != nil && *r.AuthUser.ProfilePicture != "" {
 										return Img(
-											Src("/files/"+*r.AuthUser.ProfilePicture),
+											Src(ui.ProfilePicture(r, *r.AuthUser.ProfilePicture)),
 											Alt("Profile Picture"),
 											Class("w-8 h-8 rounded-full object-cover border-2 border-gray-200 hover:border-blue-300 transition-colors cursor-pointer"),
 										)
@@ -5,17 +5,46 @@ import (
 	. "maragu.dev/gomponents/html"
 )
 
+// Search result types
+const (
+	SearchResultNote = "note"
+	SearchResultUser = "user"
+)
+
 type SearchResult struct {
-	Title string
-	URL   string
+	Type  string  `json:"type"`
+	ID    int     `json:"id"`
+	Title string  `json:"title"`
+	URL   string  `json:"url"`
+	Rank  float64 `json:"rank"` // Relevance, higher is better
+
+	// Snippet is an HTML excerpt of the matching text with the matches wrapped in <mark> tags.
+	// Everything else in it is escaped.
+	Snippet string `json:"snippet"`
 }
 
 func (s *SearchResult) Render() Node {
+	label := "Note"
+	if s.Type == SearchResultUser {
+		label = "Person"
+	}
+
+	title := Span(Class("font-medium text-gray-900"), Text(s.Title))
+	if s.URL != "" {
+		title = A(Href(s.URL), Class("font-medium text-blue-600 hover:underline"), Text(s.Title))
+	}
+
 	return Li(
 		Class("list-row"),
-		A(
-			Href(s.URL),
-			Text(s.Title),
+		Div(
+			Div(
+				Class("flex items-center gap-2"),
+				title,
+				Span(Class("text-xs px-2 py-0.5 rounded-full bg-gray-100 text-gray-600"), Text(label)),
+			),
+			If(s.Snippet != "",
+				P(Class("text-sm text-gray-600 mt-1"), Raw(s.Snippet)),
+			),
 		),
 	)
 }
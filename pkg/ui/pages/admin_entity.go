@@ -3,11 +3,13 @@ package pages
 import (
 	"fmt"
 	"net/url"
+	"strconv"
 	"strings"
 
 	"entgo.io/ent/entc/load"
 	"github.com/labstack/echo/v4"
 	"github.com/r-scheele/zero/pkg/routenames"
+	"github.com/r-scheele/zero/pkg/services"
 	"github.com/r-scheele/zero/pkg/ui"
 	. "github.com/r-scheele/zero/pkg/ui/components"
 	"github.com/r-scheele/zero/pkg/ui/forms"
@@ -504,6 +506,24 @@ func AdminUserView(ctx echo.Context, entity map[string][]string, id int) error {
 				),
 			),
 
+			// Storage
+			Div(
+				Class("bg-white rounded-xl shadow-sm border border-gray-200 p-6"),
+				H2(Class("text-lg font-semibold text-gray-900 mb-4"), Text("Storage")),
+				Div(
+					Class("grid grid-cols-1 md:grid-cols-2 gap-6"),
+					Div(Dt(Class("text-sm font-medium text-gray-500"), Text("Used")), Dd(Class("mt-1 text-sm text-gray-900"), Text(formatBytes(getValue("storage_used"))))),
+					Div(
+						Dt(Class("text-sm font-medium text-gray-500"), Text("Quota")),
+						Dd(
+							Class("mt-1 text-sm text-gray-900"),
+							If(getValue("storage_quota") == "", Text("Default")),
+							If(getValue("storage_quota") != "", Text(formatBytes(getValue("storage_quota")))),
+						),
+					),
+				),
+			),
+
 			// Actions
 			Div(
 				Class("bg-white rounded-xl shadow-sm border border-gray-200 p-6"),
@@ -739,3 +759,12 @@ func AdminEntityListTable(
 
 	return content.Render(r.Context.Response().Writer)
 }
+
+// formatBytes formats a number of bytes from an entity field for display
+func formatBytes(value string) string {
+	bytes, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return value
+	}
+	return services.FormatFileSize(bytes)
+}
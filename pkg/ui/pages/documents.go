@@ -1,66 +1,232 @@
 package pages
 
 import (
+	"fmt"
+	"net/url"
+
 	"github.com/labstack/echo/v4"
+	"github.com/r-scheele/zero/ent"
+	"github.com/r-scheele/zero/ent/document"
+	"github.com/r-scheele/zero/pkg/routenames"
+	"github.com/r-scheele/zero/pkg/services"
 	"github.com/r-scheele/zero/pkg/ui"
+	. "github.com/r-scheele/zero/pkg/ui/components"
+	"github.com/r-scheele/zero/pkg/ui/forms"
 	"github.com/r-scheele/zero/pkg/ui/layouts"
 	. "maragu.dev/gomponents"
 	. "maragu.dev/gomponents/html"
 )
 
-// DocumentsList displays the list of documents
-func DocumentsList(ctx echo.Context) error {
+// DocumentLibraryData holds everything shown in the document library
+type DocumentLibraryData struct {
+	Folders   []*ent.Folder
+	Folder    *ent.Folder // The open folder, or nil at the top level
+	Tag       string      // The tag being browsed, if any
+	Tags      []string
+	Documents []*ent.Document
+}
+
+// DocumentData holds everything shown on a document page
+type DocumentData struct {
+	Document *ent.Document
+	FileURL  string
+	IsOwner  bool
+	OwnNotes []*ent.Note // The owner's notes the document isn't attached to yet
+	Form     *forms.Document
+}
+
+// DocumentsList displays the user's document library
+func DocumentsList(ctx echo.Context, data DocumentLibraryData) error {
 	r := ui.NewRequest(ctx)
 	r.Title = "Documents"
 	r.Metatags.Description = "Browse and manage your documents"
 
+	heading := "Library"
+	switch {
+	case data.Tag != "":
+		heading = "Tagged “" + data.Tag + "”"
+	case data.Folder != nil:
+		heading = data.Folder.Name
+	}
+
+	parentID := 0
+	if data.Folder != nil {
+		parentID = data.Folder.ID
+	}
+
 	page := Div(
 		Class("container mx-auto px-4 py-8"),
 		Div(
 			Class("flex justify-between items-center mb-6"),
 			H1(Class("text-3xl font-bold text-gray-900"), Text("Documents")),
 			A(
-				Href("/documents/upload"),
+				Href(r.Path("documents.upload")+uploadFolderQuery(data.Folder)),
 				Class("bg-emerald-600 hover:bg-emerald-700 text-white px-4 py-2 rounded-lg font-medium"),
-				Text("Upload Documents"),
+				Text("Upload Document"),
 			),
 		),
 		Div(
-			Class("bg-white rounded-lg shadow p-6"),
-			P(Class("text-gray-600 text-center py-8"), Text("Document management functionality is coming soon! You'll be able to upload, organize, and share your documents.")),
+			Class("grid grid-cols-1 md:grid-cols-4 gap-6"),
+
+			// Folders and tags
+			Aside(
+				Class("bg-white rounded-lg shadow p-4 space-y-6 text-sm"),
+				Div(
+					H2(Class("font-semibold text-gray-900 mb-2"), Text("Folders")),
+					A(
+						Href(r.Path("documents.list")),
+						Class(documentNavClass(data.Folder == nil && data.Tag == "")),
+						Text("Library"),
+					),
+					documentFolderTree(r, data.Folders, data.Folder, 0),
+					Form(
+						Method("POST"),
+						Action(r.Path("documents.folder.create")),
+						Class("mt-3 flex gap-2"),
+						CSRF(r),
+						Input(Type("hidden"), Name("parent_id"), Value(fmt.Sprint(parentID))),
+						Input(
+							Type("text"),
+							Name("name"),
+							Placeholder("New folder"),
+							Required(),
+							Class("flex-1 min-w-0 px-2 py-1 border border-gray-300 rounded-md"),
+						),
+						Button(
+							Type("submit"),
+							Class("px-2 py-1 bg-gray-100 hover:bg-gray-200 rounded-md"),
+							Text("Add"),
+						),
+					),
+				),
+				If(len(data.Tags) > 0,
+					Div(
+						H2(Class("font-semibold text-gray-900 mb-2"), Text("Tags")),
+						Div(
+							Class("flex flex-wrap gap-2"),
+							Map(data.Tags, func(tag string) Node {
+								return A(
+									Href(r.Path("documents.list")+"?tag="+url.QueryEscape(tag)),
+									Class(documentTagClass(tag == data.Tag)),
+									Text(tag),
+								)
+							}),
+						),
+					),
+				),
+			),
+
+			// Documents
+			Div(
+				Class("md:col-span-3"),
+				Div(
+					Class("flex justify-between items-center mb-4"),
+					H2(Class("text-xl font-semibold text-gray-900"), Text(heading)),
+					If(data.Folder != nil,
+						Form(
+							Method("POST"),
+							Action(r.Path("documents.folder.delete", parentID)),
+							CSRF(r),
+							Button(
+								Type("submit"),
+								Class("text-sm text-red-600 hover:underline"),
+								Attr("onclick", "return confirm('Delete this folder? Its contents move up a level.')"),
+								Text("Delete folder"),
+							),
+						),
+					),
+				),
+				Iff(len(data.Documents) == 0, func() Node {
+					return Div(
+						Class("bg-white rounded-lg shadow p-6"),
+						P(Class("text-gray-600 text-center py-8"), Text("No documents here yet.")),
+					)
+				}),
+				Div(
+					Class("grid grid-cols-1 sm:grid-cols-2 lg:grid-cols-3 gap-4"),
+					Map(data.Documents, func(d *ent.Document) Node {
+						return documentCard(r, d)
+					}),
+				),
+			),
 		),
 	)
 
 	return r.Render(layouts.Primary, page)
 }
 
+// documentFolderTree renders the folders inside a parent folder, and recursively their subfolders
+func documentFolderTree(r *ui.Request, folders []*ent.Folder, current *ent.Folder, parentID int) Node {
+	var items Group
+	for _, f := range folders {
+		id := 0
+		if f.Edges.Parent != nil {
+			id = f.Edges.Parent.ID
+		}
+		if id != parentID {
+			continue
+		}
+		items = append(items, Li(
+			A(
+				Href(fmt.Sprintf("%s?folder=%d", r.Path("documents.list"), f.ID)),
+				Class(documentNavClass(current != nil && current.ID == f.ID)),
+				Text("📁 "+f.Name),
+			),
+			documentFolderTree(r, folders, current, f.ID),
+		))
+	}
+
+	if len(items) == 0 {
+		return nil
+	}
+	return Ul(Class("pl-3 space-y-1 mt-1"), items)
+}
+
+// documentCard renders a document in the library
+func documentCard(r *ui.Request, d *ent.Document) Node {
+	return A(
+		Href(r.Path("documents.view", d.ID)),
+		Class("block bg-white rounded-lg shadow hover:shadow-md transition-shadow p-4"),
+		Div(
+			Class("flex items-start gap-3"),
+			Span(Class("text-3xl"), Text(documentIcon(d.ResourceType))),
+			Div(
+				Class("min-w-0"),
+				H3(Class("font-medium text-gray-900 truncate"), Text(d.Title)),
+				P(Class("text-xs text-gray-500"), Textf("%s · %s", services.FormatFileSize(d.Size), d.CreatedAt.Format("Jan 2, 2006"))),
+				documentBadges(d),
+			),
+		),
+	)
+}
+
+// documentBadges renders a document's visibility and tags
+func documentBadges(d *ent.Document) Node {
+	return Div(
+		Class("flex flex-wrap gap-1 mt-2"),
+		If(d.Visibility == document.VisibilityPublic,
+			Span(Class("text-xs px-2 py-0.5 rounded-full bg-green-100 text-green-700"), Text("Public")),
+		),
+		Map(d.Tags, func(tag string) Node {
+			return Span(Class("text-xs px-2 py-0.5 rounded-full bg-gray-100 text-gray-600"), Text(tag))
+		}),
+	)
+}
+
 // UploadDocuments displays the document upload form
-func UploadDocuments(ctx echo.Context) error {
+func UploadDocuments(ctx echo.Context, form *forms.Document) error {
 	r := ui.NewRequest(ctx)
-	r.Title = "Upload Documents"
-	r.Metatags.Description = "Upload and manage your documents"
+	r.Title = "Upload Document"
+	r.Metatags.Description = "Upload a document to your library"
 
 	page := Div(
 		Class("container mx-auto px-4 py-8"),
 		Div(
 			Class("max-w-2xl mx-auto"),
-			H1(Class("text-3xl font-bold text-gray-900 mb-6"), Text("Upload Documents")),
+			H1(Class("text-3xl font-bold text-gray-900 mb-6"), Text("Upload Document")),
 			Div(
 				Class("bg-white rounded-lg shadow p-6"),
-				Div(
-					Class("text-center py-12"),
-					Div(
-						Class("text-6xl mb-4"),
-						Text("📄"),
-					),
-					H2(Class("text-2xl font-semibold text-gray-900 mb-4"), Text("Document Upload Coming Soon!")),
-					P(Class("text-gray-600 mb-6"), Text("We're building a comprehensive document management system that will allow you to upload, organize, and share files with advanced search and collaboration features.")),
-					A(
-						Href("/dashboard"),
-						Class("bg-emerald-600 hover:bg-emerald-700 text-white px-6 py-2 rounded-lg font-medium"),
-						Text("Back to Dashboard"),
-					),
-				),
+				form.Render(r),
 			),
 		),
 	)
@@ -68,19 +234,189 @@ func UploadDocuments(ctx echo.Context) error {
 	return r.Render(layouts.Primary, page)
 }
 
-// ViewDocument displays a specific document
-func ViewDocument(ctx echo.Context) error {
+// ViewDocument displays a document with an inline preview. The owner can also edit it and
+// attach it to their notes.
+func ViewDocument(ctx echo.Context, data DocumentData) error {
 	r := ui.NewRequest(ctx)
-	r.Title = "View Document"
+	d := data.Document
+	r.Title = d.Title
 	r.Metatags.Description = "View document details"
 
 	page := Div(
-		Class("container mx-auto px-4 py-8"),
+		Class("container mx-auto px-4 py-8 max-w-5xl"),
+		Div(
+			Class("mb-6"),
+			A(Href(r.Path("documents.list")), Class("text-sm text-blue-600 hover:underline"), Text("← Documents")),
+			H1(Class("text-3xl font-bold text-gray-900 mt-2"), Text(d.Title)),
+			P(
+				Class("text-sm text-gray-500 mt-1"),
+				Textf("%s · %s · uploaded %s", d.FileName, services.FormatFileSize(d.Size), d.CreatedAt.Format("Jan 2, 2006")),
+				If(d.Edges.Owner != nil && !data.IsOwner, Textf(" by %s", d.Edges.Owner.Name)),
+				If(d.Edges.Folder != nil, Textf(" · in %s", d.Edges.Folder.Name)),
+			),
+			documentBadges(d),
+			If(d.Description != "", P(Class("text-gray-700 mt-3"), Text(d.Description))),
+		),
+
+		Div(
+			Class("bg-white rounded-lg shadow p-4 mb-6"),
+			documentPreview(d, data.FileURL),
+			Div(
+				Class("mt-3 text-right"),
+				A(
+					Href(data.FileURL),
+					Target("_blank"),
+					Rel("noopener"),
+					Class("text-sm text-blue-600 hover:underline"),
+					Text("Open file"),
+				),
+			),
+		),
+
 		Div(
-			Class("bg-white rounded-lg shadow p-6"),
-			P(Class("text-gray-600 text-center py-8"), Text("Document viewing functionality is coming soon!")),
+			Class("bg-white rounded-lg shadow p-6 mb-6"),
+			H2(Class("text-xl font-semibold text-gray-900 mb-4"), Text("Attached to notes")),
+			Iff(len(d.Edges.Notes) == 0, func() Node {
+				return P(Class("text-gray-600 text-sm"), Text("This document isn't attached to any notes yet."))
+			}),
+			Ul(
+				Class("divide-y divide-gray-100"),
+				Map(d.Edges.Notes, func(n *ent.Note) Node {
+					return Li(
+						Class("flex justify-between items-center py-2"),
+						A(Href(r.Path(routenames.Notes+".view", n.ID)), Class("text-blue-600 hover:underline"), Text(n.Title)),
+						If(data.IsOwner,
+							Form(
+								Method("POST"),
+								Action(r.Path("documents.detach", d.ID, n.ID)),
+								CSRF(r),
+								Button(Type("submit"), Class("text-sm text-red-600 hover:underline"), Text("Detach")),
+							),
+						),
+					)
+				}),
+			),
+			If(data.IsOwner && len(data.OwnNotes) > 0,
+				Form(
+					Method("POST"),
+					Action(r.Path("documents.attach", d.ID)),
+					Class("mt-4 flex flex-col sm:flex-row gap-2"),
+					CSRF(r),
+					Select(
+						Name("note_ids"),
+						Multiple(),
+						Class("flex-1 px-3 py-2 border border-gray-300 rounded-md"),
+						Map(data.OwnNotes, func(n *ent.Note) Node {
+							return Option(Value(fmt.Sprint(n.ID)), Text(n.Title))
+						}),
+					),
+					Button(
+						Type("submit"),
+						Class("self-start px-4 py-2 bg-blue-600 hover:bg-blue-700 text-white rounded-md"),
+						Text("Attach to notes"),
+					),
+				),
+			),
+		),
+
+		If(data.IsOwner && data.Form != nil,
+			Div(
+				Class("bg-white rounded-lg shadow p-6 mb-6"),
+				H2(Class("text-xl font-semibold text-gray-900 mb-4"), Text("Edit details")),
+				data.Form.Render(r),
+				Form(
+					Method("POST"),
+					Action(r.Path("documents.delete", d.ID)),
+					Class("mt-6 pt-6 border-t border-gray-100"),
+					CSRF(r),
+					Button(
+						Type("submit"),
+						Class("text-red-600 hover:underline"),
+						Attr("onclick", "return confirm('Delete this document? It will be removed from every note it is attached to.')"),
+						Text("Delete document"),
+					),
+				),
+			),
 		),
 	)
 
 	return r.Render(layouts.Primary, page)
-}
\ No newline at end of file
+}
+
+// documentPreview renders a file inline when the browser can show it
+func documentPreview(d *ent.Document, url string) Node {
+	switch {
+	case d.ResourceType == "pdf":
+		return IFrame(Src(url), Class("w-full h-[75vh] rounded"), TitleAttr(d.Title))
+	case d.ResourceType == "image":
+		return Img(Src(url), Alt(d.Title), Class("max-w-full max-h-[75vh] mx-auto rounded"))
+	case d.ResourceType == "video":
+		return Video(
+			Controls(),
+			Class("w-full max-h-[75vh] rounded bg-black"),
+			Source(Src(url), If(d.MimeType != "", Type(d.MimeType))),
+		)
+	default:
+		return Div(
+			Class("text-center py-12"),
+			Div(Class("text-6xl mb-4"), Text(documentIcon(d.ResourceType))),
+			P(Class("text-gray-600"), Text("There's no preview for this type of file.")),
+		)
+	}
+}
+
+// NoteDocuments lists the library documents attached to a note
+func NoteDocuments(r *ui.Request, docs []*ent.Document) Node {
+	if len(docs) == 0 {
+		return nil
+	}
+
+	return Div(
+		Class("mb-6"),
+		H2(Class("text-lg font-semibold text-gray-900 mb-4"), Text("Documents")),
+		Div(
+			Class("grid grid-cols-1 sm:grid-cols-2 gap-3"),
+			Map(docs, func(d *ent.Document) Node {
+				return documentCard(r, d)
+			}),
+		),
+	)
+}
+
+// documentIcon returns an icon for a resource type
+func documentIcon(resourceType string) string {
+	switch resourceType {
+	case "pdf":
+		return "📕"
+	case "image":
+		return "🖼️"
+	case "video":
+		return "🎬"
+	case "doc":
+		return "📝"
+	default:
+		return "📄"
+	}
+}
+
+// uploadFolderQuery preselects the open folder on the upload form
+func uploadFolderQuery(f *ent.Folder) string {
+	if f == nil {
+		return ""
+	}
+	return fmt.Sprintf("?folder=%d", f.ID)
+}
+
+func documentNavClass(active bool) string {
+	if active {
+		return "block px-2 py-1 rounded bg-blue-50 text-blue-700 font-medium"
+	}
+	return "block px-2 py-1 rounded text-gray-700 hover:bg-gray-50"
+}
+
+func documentTagClass(active bool) string {
+	if active {
+		return "text-xs px-2 py-1 rounded-full bg-blue-600 text-white"
+	}
+	return "text-xs px-2 py-1 rounded-full bg-gray-100 text-gray-700 hover:bg-gray-200"
+}
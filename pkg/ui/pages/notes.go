@@ -2,10 +2,15 @@ package pages
 
 import (
 	"fmt"
+	"net/url"
+	"strings"
 
 	"github.com/labstack/echo/v4"
 	"github.com/r-scheele/zero/ent"
+	"github.com/r-scheele/zero/ent/noteproposal"
 	"github.com/r-scheele/zero/pkg/routenames"
+	"github.com/r-scheele/zero/pkg/services"
+	"github.com/r-scheele/zero/pkg/types"
 	"github.com/r-scheele/zero/pkg/ui"
 	. "github.com/r-scheele/zero/pkg/ui/components"
 	"github.com/r-scheele/zero/pkg/ui/forms"
@@ -14,52 +19,164 @@ import (
 	. "maragu.dev/gomponents/html"
 )
 
-// ListNotes displays a list of user's notes
-func ListNotes(ctx echo.Context, notes []*ent.Note, page int) error {
+// NoteListData holds everything shown on the list of the user's notes
+type NoteListData struct {
+	Notebooks []*ent.Notebook
+	Choices   []forms.NotebookChoice // The notebooks labelled with their paths
+	Notebook  *ent.Notebook          // The open notebook, or nil for every note
+	Tag       string                 // The tag being browsed, if any
+	Tags      []*ent.Tag
+	Notes     []*ent.Note
+}
+
+// ListNotes displays a list of user's notes, with the notebooks and tags they're organized by
+func ListNotes(ctx echo.Context, data NoteListData) error {
 	r := ui.NewRequest(ctx)
 
+	notebookID := 0
+	if data.Notebook != nil {
+		notebookID = data.Notebook.ID
+	}
+
+	heading := "All notes"
+	if data.Notebook != nil {
+		heading = data.Notebook.Name
+	}
+	if data.Tag != "" {
+		heading += " · tagged “" + data.Tag + "”"
+	}
+
+	filtered := data.Notebook != nil || data.Tag != ""
 
 	content := Div(
-		Class("space-y-6"),
+		Class("grid grid-cols-1 md:grid-cols-4 gap-6"),
 
-		// Header with create button (only when notes exist)
-		If(len(notes) > 0,
+		// Notebooks and tags
+		Aside(
+			Class("bg-white rounded-lg border border-gray-200 p-4 space-y-6 text-sm self-start"),
 			Div(
-				Class("flex justify-end items-center mb-6"),
+				H2(Class("font-semibold text-gray-900 mb-2"), Text("Notebooks")),
 				A(
-					Href(r.Path(routenames.Notes+".create")),
-					Class("inline-flex items-center px-4 py-2 bg-blue-600 text-white rounded-lg hover:bg-blue-700 transition-colors"),
-					Text("Create Note"),
+					Href(r.Path(routenames.Notes)),
+					Class(documentNavClass(!filtered)),
+					Text("All notes"),
+				),
+				noteNotebookTree(r, data.Notebooks, data.Notebook, 0),
+				Details(
+					Class("mt-3"),
+					Summary(Class("cursor-pointer text-blue-600 hover:underline"), Text("New notebook")),
+					Form(
+						Method("POST"),
+						Action(r.Path(routenames.Notes+".notebooks.create")),
+						Class("mt-2 space-y-2"),
+						CSRF(r),
+						Input(Type("hidden"), Name("parent_id"), Value(fmt.Sprint(notebookID))),
+						Input(
+							Type("text"),
+							Name("name"),
+							Placeholder("Notebook name"),
+							Required(),
+							MaxLength("100"),
+							Class("w-full px-2 py-1 border border-gray-300 rounded-md"),
+						),
+						notebookDefaultsFields("private", "read_only"),
+						If(data.Notebook != nil,
+							P(Class("text-xs text-gray-500"), Text("Created inside "+data.Notebook.Name)),
+						),
+						Button(
+							Type("submit"),
+							Class("w-full px-2 py-1 bg-gray-100 hover:bg-gray-200 rounded-md"),
+							Text("Add notebook"),
+						),
+					),
+				),
+			),
+			If(len(data.Tags) > 0,
+				Div(
+					H2(Class("font-semibold text-gray-900 mb-2"), Text("Tags")),
+					Div(
+						Class("flex flex-wrap gap-2"),
+						Map(data.Tags, func(t *ent.Tag) Node {
+							// Choosing the tag being browsed again stops filtering by it
+							target := t.Name
+							if t.Name == data.Tag {
+								target = ""
+							}
+							return A(
+								Href(notesURL(r, notebookID, target)),
+								Class(documentTagClass(t.Name == data.Tag)),
+								Text(t.Name),
+							)
+						}),
+					),
 				),
 			),
 		),
 
-		// Notes grid
-		If(len(notes) > 0,
+		// Notes
+		Div(
+			Class("md:col-span-3 space-y-6"),
 			Div(
-				Class("grid grid-cols-1 md:grid-cols-2 lg:grid-cols-3 gap-6"),
-				Group(func() []Node {
-					var noteCards []Node
-					for _, note := range notes {
-						noteCards = append(noteCards, noteCard(r, note))
-					}
-					return noteCards
-				}()),
+				Class("flex justify-between items-center"),
+				H2(Class("text-xl font-semibold text-gray-900"), Text(heading)),
+				If(len(data.Notes) > 0 || filtered,
+					A(
+						Href(r.Path(routenames.Notes+".create")+notebookQuery(data.Notebook)),
+						Class("inline-flex items-center px-4 py-2 bg-blue-600 text-white rounded-lg hover:bg-blue-700 transition-colors"),
+						Text("Create Note"),
+					),
+				),
 			),
-		),
 
-		// Empty state
-		If(len(notes) == 0,
-			Div(
-				Class("text-center py-12"),
-				P(
-					Class("text-gray-600 mb-6"),
-					Text("Create your first AI powered note to get started."),
+			Iff(data.Notebook != nil, func() Node {
+				return notebookSettings(r, data.Notebook, data.Choices)
+			}),
+
+			If(len(data.Notes) > 0,
+				bulkNotesForm(r, data),
+			),
+
+			// Notes grid
+			If(len(data.Notes) > 0,
+				Div(
+					Class("grid grid-cols-1 md:grid-cols-2 lg:grid-cols-3 gap-6"),
+					Map(data.Notes, func(note *ent.Note) Node {
+						return Div(
+							Class("relative"),
+							Input(
+								Type("checkbox"),
+								Name("note_ids"),
+								Value(fmt.Sprint(note.ID)),
+								FormAttr("bulk-notes"),
+								Title("Select note"),
+								Class("absolute top-3 left-3 z-10 h-4 w-4"),
+							),
+							noteCard(r, note),
+						)
+					}),
 				),
-				A(
-					Href(r.Path(routenames.Notes+".create")),
-					Class("inline-flex items-center px-6 py-3 bg-blue-600 text-white rounded-lg hover:bg-blue-700 transition-colors"),
-					Text("Create Your First Note"),
+			),
+
+			If(len(data.Notes) == 0 && filtered,
+				Div(
+					Class("bg-white rounded-lg border border-gray-200 p-6"),
+					P(Class("text-gray-600 text-center py-8"), Text("No notes here yet.")),
+				),
+			),
+
+			// Empty state
+			If(len(data.Notes) == 0 && !filtered,
+				Div(
+					Class("text-center py-12"),
+					P(
+						Class("text-gray-600 mb-6"),
+						Text("Create your first AI powered note to get started."),
+					),
+					A(
+						Href(r.Path(routenames.Notes+".create")),
+						Class("inline-flex items-center px-6 py-3 bg-blue-600 text-white rounded-lg hover:bg-blue-700 transition-colors"),
+						Text("Create Your First Note"),
+					),
 				),
 			),
 		),
@@ -68,6 +185,192 @@ func ListNotes(ctx echo.Context, notes []*ent.Note, page int) error {
 	return r.Render(layouts.Primary, content)
 }
 
+// noteNotebookTree renders the notebooks inside a parent notebook, and recursively the notebooks
+// inside them
+func noteNotebookTree(r *ui.Request, notebooks []*ent.Notebook, current *ent.Notebook, parentID int) Node {
+	var items Group
+	for _, nb := range notebooks {
+		id := 0
+		if nb.Edges.Parent != nil {
+			id = nb.Edges.Parent.ID
+		}
+		if id != parentID {
+			continue
+		}
+		items = append(items, Li(
+			A(
+				Href(notesURL(r, nb.ID, "")),
+				Class(documentNavClass(current != nil && current.ID == nb.ID)),
+				Text("📓 "+nb.Name),
+			),
+			noteNotebookTree(r, notebooks, current, nb.ID),
+		))
+	}
+
+	if len(items) == 0 {
+		return nil
+	}
+	return Ul(Class("pl-3 space-y-1 mt-1"), items)
+}
+
+// notebookSettings lets the open notebook be renamed, moved, given new defaults or deleted
+func notebookSettings(r *ui.Request, nb *ent.Notebook, choices []forms.NotebookChoice) Node {
+	parentID := "0"
+	if nb.Edges.Parent != nil {
+		parentID = fmt.Sprint(nb.Edges.Parent.ID)
+	}
+
+	return Details(
+		Class("bg-white rounded-lg border border-gray-200 p-4 text-sm"),
+		Summary(Class("cursor-pointer font-medium text-gray-900"), Text("Notebook settings")),
+		Form(
+			Method("POST"),
+			Action(r.Path(routenames.Notes+".notebooks.update", nb.ID)),
+			Class("mt-4 grid grid-cols-1 md:grid-cols-2 gap-3"),
+			CSRF(r),
+			Input(
+				Type("text"),
+				Name("name"),
+				Value(nb.Name),
+				Required(),
+				MaxLength("100"),
+				Class("px-2 py-1 border border-gray-300 rounded-md"),
+			),
+			Select(
+				Name("parent_id"),
+				Class("px-2 py-1 border border-gray-300 rounded-md"),
+				Option(Value("0"), Text("Top level")),
+				Map(choices, func(c forms.NotebookChoice) Node {
+					if c.Value == fmt.Sprint(nb.ID) {
+						return nil
+					}
+					return Option(Value(c.Value), If(c.Value == parentID, Selected()), Text(c.Label))
+				}),
+			),
+			notebookDefaultsFields(nb.DefaultVisibility.String(), nb.DefaultPermissionLevel.String()),
+			P(
+				Class("md:col-span-2 text-xs text-gray-500"),
+				Text("New notes in this notebook start with these settings. Notes already in it keep theirs."),
+			),
+			Div(
+				Class("md:col-span-2"),
+				Button(
+					Type("submit"),
+					Class("px-3 py-1 bg-blue-600 text-white rounded-md hover:bg-blue-700"),
+					Text("Save notebook"),
+				),
+			),
+		),
+		Form(
+			Method("POST"),
+			Action(r.Path(routenames.Notes+".notebooks.delete", nb.ID)),
+			Class("mt-3"),
+			CSRF(r),
+			Button(
+				Type("submit"),
+				Class("text-red-600 hover:underline"),
+				Attr("onclick", "return confirm('Delete this notebook? Its notes and notebooks move up a level.')"),
+				Text("Delete notebook"),
+			),
+		),
+	)
+}
+
+// notebookDefaultsFields picks the visibility and permission level new notes in a notebook get
+func notebookDefaultsFields(visibility, permissionLevel string) Node {
+	option := func(value, label, current string) Node {
+		return Option(Value(value), If(value == current, Selected()), Text(label))
+	}
+
+	return Group{
+		Select(
+			Name("default_visibility"),
+			Title("Visibility of new notes"),
+			Class("w-full px-2 py-1 border border-gray-300 rounded-md"),
+			option("private", "Private notes", visibility),
+			option("public", "Public notes", visibility),
+		),
+		Select(
+			Name("default_permission_level"),
+			Title("Permission level of new notes"),
+			Class("w-full px-2 py-1 border border-gray-300 rounded-md"),
+			option("read_only", "Read only", permissionLevel),
+			option("read_write", "Read & write", permissionLevel),
+			option("read_write_approval", "Read & write with approval", permissionLevel),
+		),
+	}
+}
+
+// bulkNotesForm moves the notes checked in the grid to a notebook, or tags or untags them
+func bulkNotesForm(r *ui.Request, data NoteListData) Node {
+	notebookID := 0
+	if data.Notebook != nil {
+		notebookID = data.Notebook.ID
+	}
+
+	return Form(
+		ID("bulk-notes"),
+		Method("POST"),
+		Action(r.Path(routenames.Notes+".bulk")),
+		Class("flex flex-wrap items-center gap-2 bg-gray-50 border border-gray-200 rounded-lg p-3 text-sm"),
+		CSRF(r),
+		Input(Type("hidden"), Name("notebook"), Value(fmt.Sprint(notebookID))),
+		Input(Type("hidden"), Name("tag"), Value(data.Tag)),
+		Span(Class("text-gray-600"), Text("With selected notes:")),
+		Select(
+			Name("action"),
+			Class("px-2 py-1 border border-gray-300 rounded-md"),
+			Option(Value("move"), Text("Move to")),
+			Option(Value("tag"), Text("Add tags")),
+			Option(Value("untag"), Text("Remove tags")),
+		),
+		Select(
+			Name("notebook_id"),
+			Title("Notebook to move to"),
+			Class("px-2 py-1 border border-gray-300 rounded-md"),
+			Option(Value("0"), Text("No notebook")),
+			Map(data.Choices, func(c forms.NotebookChoice) Node {
+				return Option(Value(c.Value), Text(c.Label))
+			}),
+		),
+		Input(
+			Type("text"),
+			Name("tags"),
+			Placeholder("tags, comma separated"),
+			Class("flex-1 min-w-32 px-2 py-1 border border-gray-300 rounded-md"),
+		),
+		Button(
+			Type("submit"),
+			Class("px-3 py-1 bg-gray-800 text-white rounded-md hover:bg-gray-900"),
+			Text("Apply"),
+		),
+	)
+}
+
+// notesURL links to the user's notes, in a notebook or with a tag when they're set
+func notesURL(r *ui.Request, notebookID int, tag string) string {
+	query := url.Values{}
+	if notebookID != 0 {
+		query.Set("notebook", fmt.Sprint(notebookID))
+	}
+	if tag != "" {
+		query.Set("tag", tag)
+	}
+
+	path := r.Path(routenames.Notes)
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+	return path
+}
+
+func notebookQuery(nb *ent.Notebook) string {
+	if nb == nil {
+		return ""
+	}
+	return fmt.Sprintf("?notebook=%d", nb.ID)
+}
+
 // CreateNote displays the create note form
 func CreateNote(ctx echo.Context, form *forms.CreateNote) error {
 	r := ui.NewRequest(ctx)
@@ -85,15 +388,23 @@ func CreateNote(ctx echo.Context, form *forms.CreateNote) error {
 	return r.Render(layouts.Primary, content)
 }
 
-// ViewNote displays a specific note
-func ViewNote(ctx echo.Context, note *ent.Note) error {
+// ViewNote displays a specific note along with the user's practice sets generated from it, and the
+// notes that link to it. If the view was recorded as a study event, the time spent reading is
+// reported back to it.
+func ViewNote(ctx echo.Context, note *ent.Note, rendered string, practiceSets []*ent.Quiz, proposals []*ent.NoteProposal, backlinks []*ent.Note, view *ent.StudyEvent) error {
 	r := ui.NewRequest(ctx)
 	r.Title = note.Title
 
 	// Check if user owns the note
 	isOwner := false
+	editMode := services.NoteEditNone
 	if r.AuthUser != nil && note.Edges.Owner != nil {
 		isOwner = r.AuthUser.ID == note.Edges.Owner.ID
+		editMode = services.EditModeFor(note, r.AuthUser.ID)
+	}
+	editLabel := "Edit"
+	if editMode == services.NoteEditPropose {
+		editLabel = "Suggest changes"
 	}
 
 	content := Div(
@@ -132,6 +443,22 @@ func ViewNote(ctx echo.Context, note *ent.Note) error {
 						),
 					),
 				),
+				// Other users can edit or propose changes, if the note allows it
+				If(editMode == services.NoteEditDirect || editMode == services.NoteEditPropose,
+					Div(
+						Class("flex items-center gap-2"),
+						A(
+							Href(r.Path(routenames.Notes+".history", note.ID)),
+							Class("px-3 py-1.5 text-sm font-medium text-gray-700 bg-white border border-gray-300 rounded-md hover:bg-gray-50 transition-colors"),
+							Text("History"),
+						),
+						A(
+							Href(r.Path(routenames.Notes+".edit", note.ID)),
+							Class("px-3 py-1.5 text-sm font-medium text-blue-700 bg-blue-50 border border-blue-300 rounded-md hover:bg-blue-100 transition-colors"),
+							Text(editLabel),
+						),
+					),
+				),
 				// Actions (only for owner)
 				If(isOwner,
 					Div(
@@ -149,6 +476,12 @@ func ViewNote(ctx echo.Context, note *ent.Note) error {
 							Class("px-3 py-1.5 text-sm font-medium text-blue-700 bg-blue-50 border border-blue-300 rounded-md hover:bg-blue-100 transition-colors"),
 							Text("Edit"),
 						),
+						// History button
+						A(
+							Href(r.Path(routenames.Notes+".history", note.ID)),
+							Class("px-3 py-1.5 text-sm font-medium text-gray-700 bg-white border border-gray-300 rounded-md hover:bg-gray-50 transition-colors"),
+							Text("History"),
+						),
 						// Delete button
 						Form(
 							Class("inline"),
@@ -167,6 +500,9 @@ func ViewNote(ctx echo.Context, note *ent.Note) error {
 			),
 		),
 
+		// Proposed changes
+		noteProposals(r, note, proposals, isOwner),
+
 		// Description
 		If(note.Description != "",
 			Div(
@@ -192,31 +528,23 @@ func ViewNote(ctx echo.Context, note *ent.Note) error {
 				),
 				Div(
 					Class("bg-white border border-gray-200 rounded-lg p-6"),
-					Pre(
-						Class("whitespace-pre-wrap text-gray-700 leading-relaxed break-words font-sans text-base"),
-						Text(note.Content),
-					),
+					Markdown("", rendered),
 				),
+				MarkdownAssets(),
 			),
 		),
 
+		// Attachments
+		noteAttachments(note.Resources),
+
+		// Library documents
+		NoteDocuments(r, note.Edges.Documents),
+
+		// Notes linking here
+		noteBacklinks(r, backlinks),
+
 		// AI Curriculum (if available)
-		If(note.AiCurriculum != "",
-			Div(
-				Class("mb-6"),
-				H2(
-					Class("text-lg font-semibold text-gray-900 mb-4"),
-					Text("AI-Generated Curriculum"),
-				),
-				Div(
-					Class("bg-purple-50 border border-purple-200 rounded-lg p-6"),
-					Pre(
-						Class("whitespace-pre-wrap text-purple-800 leading-relaxed font-sans text-base"),
-						Text(note.AiCurriculum),
-					),
-				),
-			),
-		),
+		noteCurriculum(r, note, isOwner),
 
 		// AI Processing indicator
 		If(note.AiProcessing,
@@ -232,6 +560,75 @@ func ViewNote(ctx echo.Context, note *ent.Note) error {
 			),
 		),
 
+		// Practice sets
+		If(r.IsAuth,
+			Div(
+				Class("mb-6"),
+				Div(
+					Class("flex items-center justify-between mb-4"),
+					H2(
+						Class("text-lg font-semibold text-gray-900"),
+						Text("Practice"),
+					),
+					Form(
+						Class("inline"),
+						Method("POST"),
+						Action(r.Path(routenames.Notes+".practice", note.ID)),
+						CSRF(r),
+						Button(
+							Type("submit"),
+							Class("px-3 py-1.5 text-sm font-medium text-green-700 bg-green-50 border border-green-300 rounded-md hover:bg-green-100 transition-colors"),
+							Text("Generate quiz from this note"),
+						),
+					),
+				),
+				If(len(practiceSets) == 0,
+					P(
+						Class("text-sm text-gray-600"),
+						Text("Generate a set of questions from this note and its attachments to check what you've learned."),
+					),
+				),
+				If(len(practiceSets) > 0,
+					Div(
+						Class("bg-white border border-gray-200 rounded-lg divide-y divide-gray-200"),
+						Group(func() []Node {
+							var rows []Node
+							for _, q := range practiceSets {
+								rows = append(rows, Div(
+									Class("flex justify-between items-center p-4"),
+									Div(
+										A(
+											Href(r.Path("quiz.view", q.ID)),
+											Class("font-medium text-gray-900 hover:text-blue-600"),
+											Text(q.Title),
+										),
+										P(
+											Class("text-xs text-gray-500"),
+											Text(fmt.Sprintf("%d questions · %s", len(q.Edges.Questions), q.CreatedAt.Format("Jan 2, 2006 15:04"))),
+										),
+									),
+									Div(
+										Class("flex items-center gap-2"),
+										A(
+											Href(r.Path("quiz.practice", q.ID)),
+											Class("px-3 py-1.5 text-sm font-medium text-blue-700 bg-blue-50 border border-blue-300 rounded-md hover:bg-blue-100 transition-colors"),
+											Text("Self-check"),
+										),
+										A(
+											Href(r.Path("quiz.take", q.ID)),
+											Class("px-3 py-1.5 text-sm font-medium text-white bg-blue-600 rounded-md hover:bg-blue-700 transition-colors"),
+											Text("Take Quiz"),
+										),
+									),
+								))
+							}
+							return rows
+						}()),
+					),
+				),
+			),
+		),
+
 		// JavaScript for share functionality
 		Script(
 			Raw(fmt.Sprintf(`
@@ -245,6 +642,8 @@ func ViewNote(ctx echo.Context, note *ent.Note) error {
 				}
 			`, r.Config.App.Host)),
 		),
+
+		If(view != nil, studyTimer(r, view)),
 	)
 
 	return r.Render(layouts.Primary, content)
@@ -265,68 +664,704 @@ func EditNote(ctx echo.Context, form *forms.EditNote) error {
 	return r.Render(layouts.Primary, content)
 }
 
+// NoteProposal displays the changes a user has proposed to a note, so the owner can accept or
+// reject them
+func NoteProposal(ctx echo.Context, proposal *ent.NoteProposal) error {
+	r := ui.NewRequest(ctx)
+	r.Title = "Proposed Changes"
+
+	note := proposal.Edges.Note
+	isOwner := r.AuthUser != nil && note.Edges.Owner != nil && r.AuthUser.ID == note.Edges.Owner.ID
+	pending := proposal.Status == noteproposal.StatusPending
+
+	proposer := "A collaborator"
+	if proposal.Edges.Proposer != nil {
+		proposer = proposal.Edges.Proposer.Name
+	}
+
+	// Pending changes are shown against the note as it is now, so the owner sees what accepting
+	// them would do
+	baseTitle, baseDescription, baseContent := note.Title, note.Description, note.Content
+	if !pending {
+		baseTitle, baseDescription, baseContent = proposal.BaseTitle, proposal.BaseDescription, proposal.BaseContent
+	}
+	outdated := pending && (proposal.BaseTitle != note.Title ||
+		proposal.BaseDescription != note.Description ||
+		proposal.BaseContent != note.Content)
+
+	content := Div(
+		Class("max-w-4xl mx-auto py-8 px-4 sm:px-6 lg:px-8 space-y-6"),
+		Div(
+			A(
+				Href(r.Path(routenames.Notes+".view", note.ID)),
+				Class("text-sm text-blue-600 hover:text-blue-800"),
+				Text("← Back to "+note.Title),
+			),
+			Div(
+				Class("flex flex-wrap items-center gap-3 mt-2"),
+				H1(
+					Class("text-2xl font-bold text-gray-900"),
+					Text("Proposed changes"),
+				),
+				proposalBadge(proposal.Status),
+			),
+			P(
+				Class("text-sm text-gray-600 mt-1"),
+				Text(fmt.Sprintf("%s proposed these changes on %s.", proposer, proposal.CreatedAt.Format("Jan 2, 2006 at 3:04 PM"))),
+			),
+		),
+		If(outdated,
+			Div(
+				Class("p-4 bg-yellow-50 border border-yellow-200 rounded-md text-sm text-yellow-800"),
+				Text("The note has changed since these changes were proposed. Accepting them will replace the note's current text, including anything changed since."),
+			),
+		),
+		diffSection("Title", services.LineDiff(baseTitle, proposal.Title)),
+		diffSection("Description", services.LineDiff(baseDescription, proposal.Description)),
+		diffSection("Content", services.LineDiff(baseContent, proposal.Content)),
+		If(isOwner && pending,
+			Div(
+				Class("flex items-center gap-3"),
+				Form(
+					Method("POST"),
+					Action(r.Path(routenames.Notes+".proposal.accept", note.ID, proposal.ID)),
+					CSRF(r),
+					Button(
+						Type("submit"),
+						Class("px-4 py-2 text-sm font-medium text-white bg-green-600 rounded-md hover:bg-green-700 transition-colors"),
+						Text("Accept changes"),
+					),
+				),
+				Form(
+					Method("POST"),
+					Action(r.Path(routenames.Notes+".proposal.reject", note.ID, proposal.ID)),
+					CSRF(r),
+					Button(
+						Type("submit"),
+						Class("px-4 py-2 text-sm font-medium text-red-700 bg-white border border-red-300 rounded-md hover:bg-red-50 transition-colors"),
+						Text("Reject"),
+					),
+				),
+			),
+		),
+	)
+
+	return r.Render(layouts.Primary, content)
+}
+
+// NoteContentPreview displays the rendered content of a note being edited
+func NoteContentPreview(ctx echo.Context, content string) error {
+	r := ui.NewRequest(ctx)
+
+	if content == "" {
+		return r.Render(layouts.Primary, P(
+			Class("text-sm text-gray-500"),
+			Text("Nothing to preview yet."),
+		))
+	}
+	return r.Render(layouts.Primary, Markdown("", content))
+}
+
+// NoteHistory displays the revisions of a note, the changes between two of them, and lets users
+// who can edit the note restore an earlier one
+func NoteHistory(ctx echo.Context, note *ent.Note, revisions []*ent.NoteRevision, from, to *ent.NoteRevision) error {
+	r := ui.NewRequest(ctx)
+	r.Title = "History of " + note.Title
+
+	canRestore := false
+	if r.AuthUser != nil {
+		mode := services.EditModeFor(note, r.AuthUser.ID)
+		canRestore = mode == services.NoteEditOwner || mode == services.NoteEditDirect
+	}
+
+	var comparison Node
+	switch {
+	case len(revisions) == 0:
+		comparison = P(
+			Class("text-sm text-gray-600"),
+			Text("No revisions yet. One is saved each time the note is."),
+		)
+	case from == nil || to == nil || from.ID == to.ID:
+		comparison = P(
+			Class("text-sm text-gray-600"),
+			Text("Choose two different revisions to see what changed between them."),
+		)
+	default:
+		comparison = Div(
+			Class("space-y-6"),
+			diffSection("Title", services.LineDiff(from.Title, to.Title)),
+			diffSection("Description", services.LineDiff(from.Description, to.Description)),
+			diffSection("Content", services.LineDiff(from.Content, to.Content)),
+			diffSection("Attachments", services.LineDiff(resourceNames(from.Resources), resourceNames(to.Resources))),
+		)
+	}
+
+	// Each revision links to the changes since the one before it
+	items := make([]Node, len(revisions))
+	for i, rev := range revisions {
+		var previous *ent.NoteRevision
+		if i+1 < len(revisions) {
+			previous = revisions[i+1]
+		}
+		items[i] = revisionItem(r, note.ID, rev, previous, i == 0, canRestore)
+	}
+
+	content := Div(
+		Class("max-w-4xl mx-auto py-8 px-4 sm:px-6 lg:px-8 space-y-6"),
+		Div(
+			A(
+				Href(r.Path(routenames.Notes+".view", note.ID)),
+				Class("text-sm text-blue-600 hover:text-blue-800"),
+				Text("← Back to "+note.Title),
+			),
+			H1(
+				Class("text-2xl font-bold text-gray-900 mt-2"),
+				Text("History"),
+			),
+		),
+		If(len(revisions) > 1,
+			Form(
+				Method("GET"),
+				Action(r.Path(routenames.Notes+".history", note.ID)),
+				Class("flex flex-wrap items-end gap-3"),
+				revisionSelect("from", "Compare", revisions, from),
+				revisionSelect("to", "With", revisions, to),
+				Button(
+					Type("submit"),
+					Class("px-3 py-2 text-sm font-medium text-blue-700 bg-blue-50 border border-blue-300 rounded-md hover:bg-blue-100 transition-colors"),
+					Text("Compare"),
+				),
+			),
+		),
+		comparison,
+		If(len(revisions) > 0,
+			Div(
+				H2(
+					Class("text-lg font-semibold text-gray-900 mb-3"),
+					Text("Revisions"),
+				),
+				Ul(
+					Class("divide-y divide-gray-200 border border-gray-200 rounded-md"),
+					Group(items),
+				),
+			),
+		),
+	)
+
+	return r.Render(layouts.Primary, content)
+}
+
 // noteCard creates a card component for displaying a note in the list
 func noteCard(r *ui.Request, note *ent.Note) Node {
 	return Div(
-		Class("bg-white rounded-lg border border-gray-200 hover:border-gray-300 hover:shadow-md transition-all duration-200"),
+		Class("bg-white rounded-lg border border-gray-200 hover:border-gray-300 hover:shadow-md transition-all duration-200 overflow-hidden"),
 		A(
 			Href(r.Path(routenames.Notes+".view", note.ID)),
-			Class("block p-6 h-full"),
+			Class("block h-full"),
+
+			noteCardCover(note),
 
-			// Header
 			Div(
-				Class("flex justify-between items-start mb-3"),
-				H3(
-					Class("text-lg font-semibold text-gray-900 line-clamp-2"),
-					Text(note.Title),
+				Class("p-6"),
+
+				// Header
+				Div(
+					Class("flex justify-between items-start mb-3"),
+					H3(
+						Class("text-lg font-semibold text-gray-900 line-clamp-2"),
+						Text(note.Title),
+					),
+					Div(
+						Class("flex-shrink-0 ml-2"),
+						If(string(note.Visibility) == "public",
+							Span(
+								Class("inline-flex items-center px-2 py-1 rounded-full text-xs bg-green-100 text-green-800"),
+								Text("Public"),
+							),
+						),
+						If(string(note.Visibility) == "private",
+							Span(
+								Class("inline-flex items-center px-2 py-1 rounded-full text-xs bg-gray-100 text-gray-800"),
+								Text("Private"),
+							),
+						),
+					),
+				),
+
+				// Description
+				If(note.Description != "",
+					P(
+						Class("text-gray-600 text-sm mb-4 line-clamp-3"),
+						Text(note.Description),
+					),
+				),
+
+				// Tags
+				If(len(note.Edges.Tags) > 0,
+					Div(
+						Class("flex flex-wrap gap-1 mb-4"),
+						Map(note.Edges.Tags, func(t *ent.Tag) Node {
+							return Span(
+								Class("px-2 py-0.5 rounded-full text-xs bg-blue-50 text-blue-700"),
+								Text("#"+t.Name),
+							)
+						}),
+					),
 				),
+
+				// Footer
 				Div(
-					Class("flex-shrink-0 ml-2"),
-					If(string(note.Visibility) == "public",
+					Class("flex justify-between items-center text-xs text-gray-500 mt-auto"),
+					Span(
+						Text(note.UpdatedAt.Format("Jan 2, 2006")),
+					),
+					If(note.AiProcessing,
 						Span(
-							Class("inline-flex items-center px-2 py-1 rounded-full text-xs bg-green-100 text-green-800"),
-							Text("Public"),
+							Class("inline-flex items-center text-yellow-600"),
+							Div(
+								Class("animate-spin rounded-full h-3 w-3 border-b border-yellow-600 mr-1"),
+							),
+							Text("AI Processing"),
 						),
 					),
-					If(string(note.Visibility) == "private",
+					If(!note.AiProcessing && note.AiCurriculum != "",
 						Span(
-							Class("inline-flex items-center px-2 py-1 rounded-full text-xs bg-gray-100 text-gray-800"),
-							Text("Private"),
+							Class("text-purple-600"),
+							Text("AI Ready"),
 						),
 					),
 				),
 			),
+		),
+	)
+}
 
-			// Description
-			If(note.Description != "",
-				P(
-					Class("text-gray-600 text-sm mb-4 line-clamp-3"),
-					Text(note.Description),
+// noteBacklinks lists the notes that link to a note with [[wiki links]]
+func noteBacklinks(r *ui.Request, backlinks []*ent.Note) Node {
+	if len(backlinks) == 0 {
+		return nil
+	}
+
+	return Div(
+		Class("mb-6"),
+		H2(
+			Class("text-lg font-semibold text-gray-900 mb-4"),
+			Text("Linked from"),
+		),
+		Ul(
+			Class("bg-white border border-gray-200 rounded-lg divide-y divide-gray-200"),
+			Map(backlinks, func(n *ent.Note) Node {
+				return Li(
+					A(
+						Href(r.Path(routenames.Notes+".view", n.ID)),
+						Class("flex items-center justify-between gap-4 p-4 hover:bg-gray-50"),
+						Span(Class("font-medium text-gray-900 truncate"), Text(n.Title)),
+						Iff(n.Edges.Owner != nil && (r.AuthUser == nil || n.Edges.Owner.ID != r.AuthUser.ID), func() Node {
+							return Span(Class("shrink-0 text-xs text-gray-500"), Text("by "+n.Edges.Owner.Name))
+						}),
+					),
+				)
+			}),
+		),
+	)
+}
+
+// noteCardCover shows the thumbnail of a note's first image or video, or a count of its
+// attachments when none of them have a thumbnail
+func noteCardCover(note *ent.Note) Node {
+	if resource, ok := services.NoteThumbnail(note); ok {
+		return Div(
+			Class("relative h-40 bg-gray-100"),
+			Img(
+				Src(resource.Thumbnail),
+				Alt(resource.Name),
+				Loading("lazy"),
+				Class("w-full h-full object-cover"),
+			),
+			If(resource.Type == "youtube",
+				Span(
+					Class("absolute inset-0 flex items-center justify-center"),
+					Span(
+						Class("w-12 h-12 rounded-full bg-black/60 text-white flex items-center justify-center text-lg"),
+						Text("▶"),
+					),
 				),
 			),
+		)
+	}
 
-			// Footer
-			Div(
-				Class("flex justify-between items-center text-xs text-gray-500 mt-auto"),
-				Span(
-					Text(note.UpdatedAt.Format("Jan 2, 2006")),
+	if len(note.Resources) == 0 {
+		return nil
+	}
+
+	label := "1 attachment"
+	if len(note.Resources) > 1 {
+		label = fmt.Sprintf("%d attachments", len(note.Resources))
+	}
+	return Div(
+		Class("px-6 pt-4 text-xs text-gray-500"),
+		Text("📎 "+label),
+	)
+}
+
+// noteAttachments lists the files and links attached to a note. Files are only linked once
+// they've passed their virus scan.
+func noteAttachments(resources []types.Resource) Node {
+	if len(resources) == 0 {
+		return nil
+	}
+
+	return Div(
+		Class("mb-6"),
+		H2(
+			Class("text-lg font-semibold text-gray-900 mb-4"),
+			Text("Attachments"),
+		),
+		Div(
+			Class("bg-white border border-gray-200 rounded-lg divide-y divide-gray-200"),
+			Map(resources, func(res types.Resource) Node {
+				return Div(
+					Class("flex items-center justify-between gap-4 p-4"),
+					Div(
+						Class("flex items-center gap-3 min-w-0"),
+						Span(Text(documentIcon(res.Type))),
+						Iff(res.Available() && res.URL != "", func() Node {
+							return A(
+								Href(res.URL),
+								Target("_blank"),
+								Rel("noopener"),
+								Class("font-medium text-gray-900 hover:text-blue-600 truncate"),
+								Text(res.Name),
+							)
+						}),
+						If(!res.Available() || res.URL == "",
+							Span(
+								Class("font-medium text-gray-500 truncate"),
+								Text(res.Name),
+							),
+						),
+					),
+					Div(
+						Class("flex items-center gap-3 shrink-0 text-xs text-gray-500"),
+						If(res.Size > 0, Span(Text(services.FormatFileSize(res.Size)))),
+						scanBadge(res),
+					),
+				)
+			}),
+		),
+	)
+}
+
+// scanBadge shows the virus scan state of a file that hasn't passed its scan
+func scanBadge(res types.Resource) Node {
+	switch res.ScanStatus {
+	case types.ScanPending:
+		return Span(
+			Class("inline-flex items-center px-2 py-0.5 rounded-full font-medium bg-yellow-100 text-yellow-800"),
+			Text("Scanning…"),
+		)
+	case types.ScanInfected:
+		return Span(
+			Class("inline-flex items-center px-2 py-0.5 rounded-full font-medium bg-red-100 text-red-800"),
+			Title(res.ScanDetail),
+			Text("Virus found: "+res.ScanDetail),
+		)
+	case types.ScanRejected:
+		return Span(
+			Class("inline-flex items-center px-2 py-0.5 rounded-full font-medium bg-red-100 text-red-800"),
+			Title(res.ScanDetail),
+			Text("Rejected"),
+		)
+	default:
+		return nil
+	}
+}
+
+// noteCurriculum shows the AI curriculum of a note, with a button for the owner to rebuild it
+func noteCurriculum(r *ui.Request, note *ent.Note, isOwner bool) Node {
+	canGenerate := isOwner && r.Config.AI.Enabled && !note.AiProcessing
+	if note.AiCurriculum == "" && !canGenerate {
+		return nil
+	}
+
+	var body Node
+	if curriculum, err := services.ParseCurriculum(note.AiCurriculum); err == nil {
+		body = curriculumOutline(curriculum)
+	} else if note.AiCurriculum != "" {
+		body = Pre(
+			Class("whitespace-pre-wrap text-purple-800 leading-relaxed font-sans text-base"),
+			Text(note.AiCurriculum),
+		)
+	} else {
+		body = P(
+			Class("text-sm text-purple-800"),
+			Text("Build a study plan of modules, objectives and a reading order from this note and its attachments."),
+		)
+	}
+
+	label := "Generate"
+	if note.AiCurriculum != "" {
+		label = "Regenerate"
+	}
+
+	return Div(
+		Class("mb-6"),
+		Div(
+			Class("flex items-center justify-between mb-4"),
+			H2(
+				Class("text-lg font-semibold text-gray-900"),
+				Text("AI-Generated Curriculum"),
+			),
+			If(canGenerate,
+				Form(
+					Class("inline"),
+					Method("POST"),
+					Action(r.Path(routenames.Notes+".curriculum", note.ID)),
+					CSRF(r),
+					Button(
+						Type("submit"),
+						Class("px-3 py-1.5 text-sm font-medium text-purple-700 bg-purple-50 border border-purple-300 rounded-md hover:bg-purple-100 transition-colors"),
+						Text(label),
+					),
 				),
-				If(note.AiProcessing,
-					Span(
-						Class("inline-flex items-center text-yellow-600"),
-						Div(
-							Class("animate-spin rounded-full h-3 w-3 border-b border-yellow-600 mr-1"),
+			),
+		),
+		Div(
+			Class("bg-purple-50 border border-purple-200 rounded-lg p-6"),
+			body,
+		),
+	)
+}
+
+// curriculumOutline lists the modules of a curriculum with their objectives, and the order to
+// read the note's material in
+func curriculumOutline(curriculum *services.Curriculum) Node {
+	return Div(
+		Class("space-y-6 text-purple-900"),
+		If(curriculum.Overview != "",
+			P(
+				Class("leading-relaxed"),
+				Text(curriculum.Overview),
+			),
+		),
+		Ol(
+			Class("space-y-4"),
+			Map(curriculum.Modules, func(m services.CurriculumModule) Node {
+				return Li(
+					H3(
+						Class("font-semibold"),
+						Text(m.Title),
+					),
+					If(m.Summary != "",
+						P(
+							Class("text-sm text-purple-800 mt-1"),
+							Text(m.Summary),
+						),
+					),
+					If(len(m.Objectives) > 0,
+						Ul(
+							Class("list-disc list-inside text-sm text-purple-800 mt-2 space-y-1"),
+							Map(m.Objectives, func(objective string) Node {
+								return Li(Text(objective))
+							}),
 						),
-						Text("AI Processing"),
 					),
+				)
+			}),
+		),
+		If(len(curriculum.ReadingOrder) > 0,
+			Div(
+				H3(
+					Class("font-semibold mb-2"),
+					Text("Reading order"),
 				),
-				If(!note.AiProcessing && note.AiCurriculum != "",
-					Span(
-						Class("text-purple-600"),
-						Text("AI Ready"),
+				Ol(
+					Class("list-decimal list-inside text-sm text-purple-800 space-y-1"),
+					Map(curriculum.ReadingOrder, func(item string) Node {
+						return Li(Text(item))
+					}),
+				),
+			),
+		),
+	)
+}
+
+// noteProposals lists the pending changes proposed to a note for its owner, or the changes the
+// user has proposed and what happened to them
+func noteProposals(r *ui.Request, note *ent.Note, proposals []*ent.NoteProposal, isOwner bool) Node {
+	if len(proposals) == 0 {
+		return nil
+	}
+
+	heading := "Your proposed changes"
+	if isOwner {
+		heading = fmt.Sprintf("Proposed changes awaiting your review (%d)", len(proposals))
+	}
+
+	return Div(
+		Class("mb-6 p-4 bg-yellow-50 border border-yellow-200 rounded-lg"),
+		H2(
+			Class("text-sm font-semibold text-yellow-900 mb-3"),
+			Text(heading),
+		),
+		Ul(
+			Class("space-y-2"),
+			Map(proposals, func(p *ent.NoteProposal) Node {
+				by := ""
+				if isOwner && p.Edges.Proposer != nil {
+					by = "By " + p.Edges.Proposer.Name + ", "
+				}
+				return Li(
+					Class("flex items-center justify-between gap-3 text-sm"),
+					A(
+						Href(r.Path(routenames.Notes+".proposal", note.ID, p.ID)),
+						Class("text-blue-700 hover:text-blue-900 hover:underline"),
+						Text(by+p.CreatedAt.Format("Jan 2, 2006 at 3:04 PM")),
+					),
+					proposalBadge(p.Status),
+				)
+			}),
+		),
+	)
+}
+
+// proposalBadge shows whether a proposed change is pending, accepted or rejected
+func proposalBadge(status noteproposal.Status) Node {
+	switch status {
+	case noteproposal.StatusAccepted:
+		return Span(
+			Class("inline-flex items-center px-2.5 py-0.5 rounded-full text-xs font-medium bg-green-100 text-green-800"),
+			Text("Accepted"),
+		)
+	case noteproposal.StatusRejected:
+		return Span(
+			Class("inline-flex items-center px-2.5 py-0.5 rounded-full text-xs font-medium bg-red-100 text-red-800"),
+			Text("Rejected"),
+		)
+	default:
+		return Span(
+			Class("inline-flex items-center px-2.5 py-0.5 rounded-full text-xs font-medium bg-yellow-100 text-yellow-800"),
+			Text("Pending review"),
+		)
+	}
+}
+
+// diffSection shows the lines of a field that were added and removed
+func diffSection(label string, diff []services.DiffLine) Node {
+	stats := services.CountDiff(diff)
+
+	summary := "No changes"
+	if stats.Changed() {
+		summary = fmt.Sprintf("+%d −%d", stats.Added, stats.Removed)
+	}
+
+	return Div(
+		Div(
+			Class("flex items-center justify-between mb-2"),
+			H2(
+				Class("text-sm font-semibold text-gray-900"),
+				Text(label),
+			),
+			Span(
+				Class("text-xs text-gray-500"),
+				Text(summary),
+			),
+		),
+		If(stats.Changed(),
+			Pre(
+				Class("border border-gray-200 rounded-md overflow-x-auto text-sm font-mono"),
+				Map(diff, func(line services.DiffLine) Node {
+					switch line.Op {
+					case services.DiffInsert:
+						return Div(Class("px-3 bg-green-50 text-green-800 whitespace-pre-wrap"), Text("+ "+line.Text))
+					case services.DiffDelete:
+						return Div(Class("px-3 bg-red-50 text-red-800 whitespace-pre-wrap line-through"), Text("- "+line.Text))
+					default:
+						return Div(Class("px-3 text-gray-600 whitespace-pre-wrap"), Text("  "+line.Text))
+					}
+				}),
+			),
+		),
+	)
+}
+
+// revisionSelect is a select input to pick one of a note's revisions
+func revisionSelect(name, label string, revisions []*ent.NoteRevision, selected *ent.NoteRevision) Node {
+	return Label(
+		Class("block text-sm text-gray-700"),
+		Span(
+			Class("block mb-1"),
+			Text(label),
+		),
+		Select(
+			Name(name),
+			Class("px-3 py-2 border border-gray-300 rounded-md text-sm"),
+			Map(revisions, func(rev *ent.NoteRevision) Node {
+				return Option(
+					Value(fmt.Sprint(rev.ID)),
+					If(selected != nil && selected.ID == rev.ID, Selected()),
+					Text(revisionLabel(rev)),
+				)
+			}),
+		),
+	)
+}
+
+// revisionLabel describes when a revision was saved and by whom
+func revisionLabel(rev *ent.NoteRevision) string {
+	label := rev.CreatedAt.Format("Jan 2, 2006 at 3:04 PM")
+	if rev.Edges.Author != nil {
+		label += " by " + rev.Edges.Author.Name
+	}
+	return label
+}
+
+// resourceNames lists the names of a note's resources, one per line
+func resourceNames(resources []types.Resource) string {
+	names := make([]string, len(resources))
+	for i, res := range resources {
+		names[i] = res.Name
+	}
+	return strings.Join(names, "\n")
+}
+
+// revisionItem shows a revision in a note's history, with a link to the changes it made and a
+// button to restore it
+func revisionItem(r *ui.Request, noteID int, rev, previous *ent.NoteRevision, latest, canRestore bool) Node {
+	return Li(
+		Class("flex flex-wrap items-center justify-between gap-3 px-4 py-3 text-sm"),
+		Div(
+			Class("flex items-center gap-2 text-gray-700"),
+			Span(Text(revisionLabel(rev))),
+			If(latest,
+				Span(
+					Class("inline-flex items-center px-2.5 py-0.5 rounded-full text-xs font-medium bg-green-100 text-green-800"),
+					Text("Current"),
+				),
+			),
+		),
+		Div(
+			Class("flex items-center gap-3"),
+			If(previous != nil,
+				A(
+					Href(fmt.Sprintf("%s?from=%d&to=%d", r.Path(routenames.Notes+".history", noteID), previous.ID, rev.ID)),
+					Class("text-blue-700 hover:text-blue-900 hover:underline"),
+					Text("Changes"),
+				),
+			),
+			If(canRestore && !latest,
+				Form(
+					Method("POST"),
+					Action(r.Path(routenames.Notes+".history.restore", noteID, rev.ID)),
+					Attr("onsubmit", "return confirm('Restore the note to this revision?')"),
+					CSRF(r),
+					Button(
+						Type("submit"),
+						Class("text-blue-700 hover:text-blue-900 hover:underline"),
+						Text("Restore"),
 					),
 				),
 			),
 		),
 	)
-}
\ No newline at end of file
+}
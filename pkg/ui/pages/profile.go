@@ -1,8 +1,12 @@
 package pages
 
 import (
+	"fmt"
+
 	"github.com/labstack/echo/v4"
 	"github.com/r-scheele/zero/ent"
+	"github.com/r-scheele/zero/pkg/routenames"
+	"github.com/r-scheele/zero/pkg/services"
 	"github.com/r-scheele/zero/pkg/ui"
 	"github.com/r-scheele/zero/pkg/ui/components"
 	"github.com/r-scheele/zero/pkg/ui/forms"
@@ -96,7 +100,7 @@ func ProfilePicture(ctx echo.Context, form *forms.ProfilePicture, user *ent.User
 				func() Node {
 					if user.ProfilePicture != nil && *user.ProfilePicture != "" {
 						return Img(
-							Src("/files/"+*user.ProfilePicture),
+							Src(ui.ProfilePicture(r, *user.ProfilePicture)),
 							Alt("Current Profile Picture"),
 							Class("w-32 h-32 rounded-full mx-auto border-4 border-gray-200 object-cover"),
 						)
@@ -183,3 +187,100 @@ func DeactivateAccount(ctx echo.Context, form *forms.DeactivateAccount) error {
 		),
 	})
 }
+
+func Storage(ctx echo.Context, usage *services.StorageUsage) error {
+	r := ui.NewRequest(ctx)
+
+	row := func(label Node, files int, bytes int64) Node {
+		return Li(
+			Class("flex items-center justify-between py-3"),
+			Div(
+				Class("min-w-0"),
+				Div(Class("text-sm font-medium text-gray-900 truncate"), label),
+				If(files > 0, P(Class("text-xs text-gray-500"), Text(pluralize(files, "file")))),
+			),
+			Span(Class("text-sm text-gray-700 ml-4 whitespace-nowrap"), Text(services.FormatFileSize(bytes))),
+		)
+	}
+
+	section := func(title string, rows ...Node) Node {
+		return Div(
+			Class("bg-white rounded-lg shadow-sm border border-gray-200 p-6 mb-6"),
+			H3(Class("text-lg font-semibold text-gray-900 mb-2"), Text(title)),
+			Ul(Class("divide-y divide-gray-100"), Group(rows)),
+		)
+	}
+
+	var notes []Node
+	for _, n := range usage.Notes {
+		label := Node(Text("Deleted note"))
+		if n.Title != "" {
+			label = A(Href(r.Path(routenames.Notes+".view", n.NoteID)), Class("hover:text-blue-600"), Text(n.Title))
+		}
+		notes = append(notes, row(label, n.Files, n.Bytes))
+	}
+
+	labels := map[string]string{
+		"image": "Images",
+		"video": "Videos",
+		"pdf":   "PDFs",
+		"doc":   "Documents",
+		"file":  "Other files",
+	}
+	var kinds []Node
+	for _, t := range usage.Types {
+		label, ok := labels[t.Type]
+		if !ok {
+			label = t.Type
+		}
+		kinds = append(kinds, row(Text(label), t.Files, t.Bytes))
+	}
+
+	return r.Render(layouts.Primary, Group{
+		Div(
+			Class("max-w-2xl mx-auto px-4 py-12"),
+
+			// Profile navigation
+			components.ProfileNav(r),
+
+			// Overall usage
+			Div(
+				Class("bg-white rounded-lg shadow-sm border border-gray-200 p-6 mb-6"),
+				Div(
+					Class("flex items-baseline justify-between mb-3"),
+					H2(Class("text-xl font-bold text-gray-900"), Text("Storage")),
+					Span(
+						Class("text-sm text-gray-600"),
+						If(usage.Quota > 0, Textf("%s of %s used", services.FormatFileSize(usage.Used), services.FormatFileSize(usage.Quota))),
+						If(usage.Quota == 0, Textf("%s used", services.FormatFileSize(usage.Used))),
+					),
+				),
+				If(usage.Quota > 0,
+					Div(
+						Class("w-full bg-gray-200 rounded-full h-2.5"),
+						Div(
+							Class(func() string {
+								if usage.Percent() >= 90 {
+									return "bg-red-500 h-2.5 rounded-full"
+								}
+								return "bg-blue-600 h-2.5 rounded-full"
+							}()),
+							Style(fmt.Sprintf("width: %d%%", usage.Percent())),
+						),
+					),
+				),
+				P(
+					Class("mt-3 text-xs text-gray-500"),
+					Text("Every file you upload counts towards your storage, even if someone else has uploaded the same file."),
+				),
+			),
+
+			If(len(notes) > 0, section("By note", notes...)),
+			If(len(kinds) > 0, section("By type", kinds...)),
+			section("Other",
+				row(A(Href(r.Path("documents.list")), Class("hover:text-blue-600"), Text("Document library")), 0, usage.Documents),
+				row(A(Href(r.Path(routenames.ProfilePicture)), Class("hover:text-blue-600"), Text("Profile picture")), 0, usage.Profile),
+			),
+		),
+	})
+}
@@ -1,7 +1,14 @@
 package pages
 
 import (
+	"fmt"
+	"strings"
+	"time"
+
 	"github.com/labstack/echo/v4"
+	"github.com/r-scheele/zero/ent"
+	"github.com/r-scheele/zero/ent/studyevent"
+	"github.com/r-scheele/zero/pkg/services"
 	"github.com/r-scheele/zero/pkg/ui"
 	"github.com/r-scheele/zero/pkg/ui/layouts"
 	. "maragu.dev/gomponents"
@@ -9,42 +16,60 @@ import (
 )
 
 // ViewProgress displays the progress dashboard
-func ViewProgress(ctx echo.Context) error {
+func ViewProgress(ctx echo.Context, summary *services.ProgressSummary) error {
 	r := ui.NewRequest(ctx)
 	r.Title = "View Progress"
 	r.Metatags.Description = "Track your learning progress and achievements"
 
+	topics := summary.Topics
+	if len(topics) > 5 {
+		topics = topics[:5]
+	}
+
 	page := Div(
 		Class("container mx-auto px-4 py-8"),
 		Div(
 			Class("flex justify-between items-center mb-6"),
 			H1(Class("text-3xl font-bold text-gray-900"), Text("Learning Progress")),
-			A(
-				Href("/progress/analytics"),
-				Class("bg-purple-600 hover:bg-purple-700 text-white px-4 py-2 rounded-lg font-medium"),
-				Text("View Analytics"),
+			Div(
+				Class("flex items-center gap-3"),
+				progressExportLinks(r),
+				A(
+					Href(r.Path("progress.analytics")),
+					Class("bg-purple-600 hover:bg-purple-700 text-white px-4 py-2 rounded-lg font-medium"),
+					Text("View Analytics"),
+				),
 			),
 		),
-		Div(
-			Class("bg-white rounded-lg shadow p-6"),
+
+		If(summary.NotesViewed == 0 && summary.QuizzesTaken == 0,
 			Div(
-				Class("text-center py-12"),
+				Class("bg-white rounded-lg shadow p-6 mb-6"),
 				Div(
-					Class("text-6xl mb-4"),
-					Text("📊"),
+					Class("text-center py-8"),
+					Div(Class("text-6xl mb-4"), Text("📊")),
+					H2(Class("text-2xl font-semibold text-gray-900 mb-4"), Text("No study history yet")),
+					P(Class("text-gray-600"), Text("Read your notes and take quizzes, and your streaks, scores and topic mastery will show up here.")),
 				),
-				H2(Class("text-2xl font-semibold text-gray-900 mb-4"), Text("Progress Tracking Coming Soon!")),
-				P(Class("text-gray-600 mb-6"), Text("We're building comprehensive progress tracking that will show your learning journey, achievements, quiz scores, and study patterns with detailed analytics and insights.")),
-
 			),
 		),
+
+		progressStats(summary),
+
+		Div(
+			Class("grid grid-cols-1 lg:grid-cols-2 gap-6 mb-6"),
+			progressPanel("Study time, last 30 days", activityChart(summary.Activity)),
+			progressPanel("Quiz scores, last 30 days", scoreTrendChart(summary.ScoreTrend)),
+		),
+
+		progressPanel("Recent topics", topicTable(topics)),
 	)
 
 	return r.Render(layouts.Primary, page)
 }
 
 // ViewAnalytics displays detailed analytics
-func ViewAnalytics(ctx echo.Context) error {
+func ViewAnalytics(ctx echo.Context, summary *services.ProgressSummary, events []*ent.StudyEvent) error {
 	r := ui.NewRequest(ctx)
 	r.Title = "Learning Analytics"
 	r.Metatags.Description = "Detailed analytics of your learning progress"
@@ -55,16 +80,309 @@ func ViewAnalytics(ctx echo.Context) error {
 			Class("flex justify-between items-center mb-6"),
 			H1(Class("text-3xl font-bold text-gray-900"), Text("Learning Analytics")),
 			A(
-				Href("/progress"),
+				Href(r.Path("progress.view")),
 				Class("bg-gray-600 hover:bg-gray-700 text-white px-4 py-2 rounded-lg font-medium"),
 				Text("Back to Progress"),
 			),
 		),
+
 		Div(
-			Class("bg-white rounded-lg shadow p-6"),
-			P(Class("text-gray-600 text-center py-8"), Text("Detailed analytics functionality is coming soon! You'll be able to view comprehensive reports on your learning patterns, strengths, and areas for improvement.")),
+			Class("grid grid-cols-1 lg:grid-cols-2 gap-6 mb-6"),
+			progressPanel("Study time, last 30 days", activityChart(summary.Activity)),
+			progressPanel("Quiz scores, last 30 days", scoreTrendChart(summary.ScoreTrend)),
 		),
+
+		Div(Class("mb-6"), progressPanel("Topic mastery", topicTable(summary.Topics))),
+
+		progressPanel("Study history", historyTable(events)),
 	)
 
 	return r.Render(layouts.Primary, page)
-}
\ No newline at end of file
+}
+
+// studyTimer reports the time a page has been visible to the study event it was recorded as.
+// Time spent in a background tab isn't counted.
+func studyTimer(r *ui.Request, event *ent.StudyEvent) Node {
+	return Script(
+		Raw(fmt.Sprintf(`
+			(function() {
+				const url = '%s';
+				const csrf = '%s';
+				let visible = 0;
+				let shownAt = document.visibilityState === 'visible' ? Date.now() : null;
+
+				function report() {
+					if (shownAt !== null) {
+						visible += Date.now() - shownAt;
+						shownAt = null;
+					}
+					const data = new FormData();
+					data.append('csrf', csrf);
+					data.append('seconds', Math.round(visible / 1000));
+					navigator.sendBeacon(url, data);
+				}
+
+				document.addEventListener('visibilitychange', function() {
+					if (document.visibilityState === 'hidden') {
+						report();
+					} else {
+						shownAt = Date.now();
+					}
+				});
+				window.addEventListener('pagehide', report);
+			})();
+		`, r.Path("progress.event.time", event.ID), r.CSRF)),
+	)
+}
+
+// progressExportLinks renders download links for each export format
+func progressExportLinks(r *ui.Request) Node {
+	link := func(format services.ExportFormat, label string) Node {
+		return A(
+			Href(r.Path("progress.export")+"?format="+string(format)),
+			Class("px-3 py-2 text-sm font-medium text-gray-700 hover:bg-gray-100"),
+			TitleAttr("Download your full study history as "+label),
+			Text(label),
+		)
+	}
+
+	return Div(
+		Class("flex items-center bg-white border border-gray-300 rounded-lg divide-x divide-gray-300 overflow-hidden"),
+		Span(Class("px-3 py-2 text-sm text-gray-500"), Text("Export")),
+		link(services.ExportCSV, "CSV"),
+		link(services.ExportJSON, "JSON"),
+		link(services.ExportPDF, "PDF"),
+	)
+}
+
+// progressStats renders the headline numbers
+func progressStats(summary *services.ProgressSummary) Node {
+	stat := func(label, value, hint string) Node {
+		return Div(
+			Class("bg-white rounded-lg shadow p-5"),
+			P(Class("text-sm text-gray-500"), Text(label)),
+			P(Class("text-2xl font-bold text-gray-900 mt-1"), Text(value)),
+			If(hint != "", P(Class("text-xs text-gray-500 mt-1"), Text(hint))),
+		)
+	}
+
+	streakHint := fmt.Sprintf("Longest: %s", pluralize(summary.LongestStreak, "day"))
+	if summary.CurrentStreak > 0 && !summary.StudiedToday {
+		streakHint = "Study today to keep it going!"
+	}
+
+	average := "–"
+	if summary.QuizzesTaken > 0 {
+		average = fmt.Sprintf("%.0f%%", summary.AverageScore)
+	}
+
+	return Div(
+		Class("grid grid-cols-2 lg:grid-cols-4 gap-4 mb-6"),
+		stat("Current streak", "🔥 "+pluralize(summary.CurrentStreak, "day"), streakHint),
+		stat("Time studied", formatStudyTime(summary.TimeSpent), ""),
+		stat("Notes read", fmt.Sprint(summary.NotesViewed), ""),
+		stat("Average quiz score", average, pluralize(summary.QuizzesTaken, "attempt")),
+	)
+}
+
+// progressPanel wraps a chart or table in a titled card
+func progressPanel(title string, body Node) Node {
+	return Div(
+		Class("bg-white rounded-lg shadow p-6"),
+		H2(Class("text-lg font-semibold text-gray-900 mb-4"), Text(title)),
+		body,
+	)
+}
+
+// activityChart renders a bar chart of study time per day, falling back to the
+// number of study sessions for days where no time was recorded
+func activityChart(days []services.DayActivity) Node {
+	var peak time.Duration
+	for _, d := range days {
+		peak = max(peak, d.TimeSpent)
+	}
+
+	bars := make(Group, 0, len(days))
+	for _, d := range days {
+		height := 0
+		switch {
+		case peak > 0:
+			height = int(float64(d.TimeSpent) / float64(peak) * 100)
+		case d.Events > 0:
+			height = 100
+		}
+		if d.Events > 0 && height < 4 {
+			height = 4
+		}
+
+		bars = append(bars, Div(
+			Class("flex-1 flex flex-col justify-end h-full"),
+			TitleAttr(fmt.Sprintf("%s: %s, %s", d.Date.Format("Jan 2"), formatStudyTime(d.TimeSpent), pluralize(d.Events, "session"))),
+			Div(
+				Class("bg-purple-500 rounded-t"),
+				Style(fmt.Sprintf("height: %d%%", height)),
+			),
+		))
+	}
+
+	var first, last string
+	if len(days) > 0 {
+		first, last = days[0].Date.Format("Jan 2"), days[len(days)-1].Date.Format("Jan 2")
+	}
+
+	return Div(
+		Div(Class("flex items-end gap-0.5 h-40 border-b border-gray-200"), bars),
+		Div(
+			Class("flex justify-between text-xs text-gray-500 mt-1"),
+			Span(Text(first)),
+			Span(Text(last)),
+		),
+	)
+}
+
+// scoreTrendChart renders a line chart of daily average quiz scores
+func scoreTrendChart(points []services.ScorePoint) Node {
+	if len(points) == 0 {
+		return P(Class("text-gray-600 py-12 text-center"), Text("Take a quiz to see your score trend."))
+	}
+
+	const width, height = 300.0, 100.0
+	first := points[0].Date
+	span := points[len(points)-1].Date.Sub(first).Hours() / 24
+
+	var line, dots strings.Builder
+	for _, p := range points {
+		x := width / 2
+		if span > 0 {
+			x = p.Date.Sub(first).Hours() / 24 / span * width
+		}
+		y := height - p.Score/100*height
+		fmt.Fprintf(&line, "%.1f,%.1f ", x, y)
+		fmt.Fprintf(&dots, `<circle cx="%.1f" cy="%.1f" r="2.5" fill="#7c3aed"><title>%s: %.0f%% (%d)</title></circle>`,
+			x, y, p.Date.Format("Jan 2"), p.Score, p.Attempts)
+	}
+
+	return Div(
+		Raw(fmt.Sprintf(`<svg viewBox="-5 -5 %.0f %.0f" class="w-full h-40" preserveAspectRatio="none" xmlns="http://www.w3.org/2000/svg">
+			<line x1="0" y1="%.0f" x2="%.0f" y2="%.0f" stroke="#e5e7eb" stroke-dasharray="4"/>
+			<polyline points="%s" fill="none" stroke="#7c3aed" stroke-width="2" vector-effect="non-scaling-stroke"/>
+			%s
+		</svg>`, width+10, height+10, height/2, width, height/2, line.String(), dots.String())),
+		Div(
+			Class("flex justify-between text-xs text-gray-500 mt-1"),
+			Span(Text(points[0].Date.Format("Jan 2"))),
+			Span(Text(points[len(points)-1].Date.Format("Jan 2"))),
+		),
+	)
+}
+
+// topicTable renders mastery per topic
+func topicTable(topics []services.TopicMastery) Node {
+	if len(topics) == 0 {
+		return P(Class("text-gray-600"), Text("Topics you study will appear here."))
+	}
+
+	rows := make(Group, 0, len(topics))
+	for _, t := range topics {
+		rows = append(rows, Tr(
+			Class("border-t border-gray-100"),
+			Td(Class("py-2 pr-4 font-medium text-gray-900"), Text(t.Topic)),
+			Td(
+				Class("py-2 pr-4 w-1/3"),
+				Div(
+					Class("flex items-center gap-2"),
+					Div(
+						Class("flex-1 h-2 bg-gray-100 rounded"),
+						Div(Class("h-2 bg-purple-500 rounded"), Style(fmt.Sprintf("width: %.0f%%", t.Mastery))),
+					),
+					Span(Class("text-xs text-gray-600 w-24"), Text(t.Level())),
+				),
+			),
+			Td(Class("py-2 pr-4 text-sm text-gray-600"), Text(pluralize(t.Views, "view"))),
+			Td(Class("py-2 pr-4 text-sm text-gray-600"), Text(pluralize(t.Attempts, "attempt"))),
+			Td(Class("py-2 text-sm text-gray-600"), Text(formatStudyTime(t.TimeSpent))),
+		))
+	}
+
+	return Div(
+		Class("overflow-x-auto"),
+		Table(
+			Class("w-full text-left"),
+			THead(Tr(
+				Class("text-xs uppercase text-gray-500"),
+				Th(Class("pb-2"), Text("Topic")),
+				Th(Class("pb-2"), Text("Mastery")),
+				Th(Class("pb-2"), Text("Read")),
+				Th(Class("pb-2"), Text("Quizzes")),
+				Th(Class("pb-2"), Text("Time")),
+			)),
+			TBody(rows),
+		),
+	)
+}
+
+// historyTable renders a list of study events
+func historyTable(events []*ent.StudyEvent) Node {
+	if len(events) == 0 {
+		return P(Class("text-gray-600"), Text("No study history yet."))
+	}
+
+	rows := make(Group, 0, len(events))
+	for _, e := range events {
+		activity := "Read note"
+		result := ""
+		if e.Type == studyevent.TypeQuizAttempted {
+			activity = "Took quiz"
+			if e.Score != nil && e.MaxScore != nil {
+				result = fmt.Sprintf("%d / %d", *e.Score, *e.MaxScore)
+			}
+		}
+
+		rows = append(rows, Tr(
+			Class("border-t border-gray-100 text-sm"),
+			Td(Class("py-2 pr-4 text-gray-600"), Text(e.OccurredAt.Format("Jan 2, 2006 15:04"))),
+			Td(Class("py-2 pr-4 text-gray-600"), Text(activity)),
+			Td(Class("py-2 pr-4 font-medium text-gray-900"), Text(e.Topic)),
+			Td(Class("py-2 pr-4 text-gray-600"), Text(result)),
+			Td(Class("py-2 text-gray-600"), Text(formatStudyTime(time.Duration(e.DurationSeconds)*time.Second))),
+		))
+	}
+
+	return Div(
+		Class("overflow-x-auto"),
+		Table(
+			Class("w-full text-left"),
+			THead(Tr(
+				Class("text-xs uppercase text-gray-500"),
+				Th(Class("pb-2"), Text("When")),
+				Th(Class("pb-2"), Text("Activity")),
+				Th(Class("pb-2"), Text("Topic")),
+				Th(Class("pb-2"), Text("Score")),
+				Th(Class("pb-2"), Text("Time")),
+			)),
+			TBody(rows),
+		),
+	)
+}
+
+// formatStudyTime formats a duration as hours and minutes
+func formatStudyTime(d time.Duration) string {
+	d = d.Round(time.Minute)
+	h, m := int(d.Hours()), int(d.Minutes())%60
+	switch {
+	case h > 0:
+		return fmt.Sprintf("%dh %dm", h, m)
+	case m > 0:
+		return fmt.Sprintf("%dm", m)
+	default:
+		return "0m"
+	}
+}
+
+// pluralize formats a count with a singular or plural noun
+func pluralize(n int, noun string) string {
+	if n == 1 {
+		return fmt.Sprintf("%d %s", n, noun)
+	}
+	return fmt.Sprintf("%d %ss", n, noun)
+}
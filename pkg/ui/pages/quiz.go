@@ -1,116 +1,656 @@
 package pages
 
 import (
+	"fmt"
+	"strconv"
+	"time"
+
 	"github.com/labstack/echo/v4"
+	"github.com/r-scheele/zero/ent"
+	"github.com/r-scheele/zero/ent/question"
+	"github.com/r-scheele/zero/ent/quizattempt"
+	"github.com/r-scheele/zero/pkg/routenames"
+	"github.com/r-scheele/zero/pkg/services"
 	"github.com/r-scheele/zero/pkg/ui"
+	. "github.com/r-scheele/zero/pkg/ui/components"
+	"github.com/r-scheele/zero/pkg/ui/forms"
 	"github.com/r-scheele/zero/pkg/ui/layouts"
 	. "maragu.dev/gomponents"
 	. "maragu.dev/gomponents/html"
 )
 
 // QuizList displays the list of quizzes
-func QuizList(ctx echo.Context) error {
+func QuizList(ctx echo.Context, quizzes []*ent.Quiz, page int) error {
 	r := ui.NewRequest(ctx)
 	r.Title = "Quizzes"
 	r.Metatags.Description = "Browse and manage your quizzes"
 
-	page := Div(
+	content := Div(
 		Class("container mx-auto px-4 py-8"),
 		Div(
 			Class("flex justify-between items-center mb-6"),
 			H1(Class("text-3xl font-bold text-gray-900"), Text("Quizzes")),
 			A(
-				Href("/quiz/create"),
+				Href(r.Path("quiz.create")),
 				Class("bg-blue-600 hover:bg-blue-700 text-white px-4 py-2 rounded-lg font-medium"),
 				Text("Create Quiz"),
 			),
 		),
-		Div(
-			Class("bg-white rounded-lg shadow p-6"),
-			P(Class("text-gray-600 text-center py-8"), Text("Quiz functionality is coming soon! You'll be able to create, manage, and take interactive quizzes.")),
+
+		If(len(quizzes) > 0,
+			Div(
+				Class("grid grid-cols-1 md:grid-cols-2 lg:grid-cols-3 gap-6"),
+				Group(func() []Node {
+					var cards []Node
+					for _, q := range quizzes {
+						cards = append(cards, quizCard(r, q))
+					}
+					return cards
+				}()),
+			),
+		),
+
+		If(len(quizzes) == 0,
+			Div(
+				Class("bg-white rounded-lg shadow p-6"),
+				P(Class("text-gray-600 text-center py-8"), Text("No quizzes yet. Create your first quiz to get started.")),
+			),
 		),
 	)
 
-	return r.Render(layouts.Primary, page)
+	return r.Render(layouts.Primary, content)
 }
 
 // CreateQuiz displays the quiz creation form
-func CreateQuiz(ctx echo.Context) error {
+func CreateQuiz(ctx echo.Context, form *forms.Quiz) error {
 	r := ui.NewRequest(ctx)
 	r.Title = "Create Quiz"
 	r.Metatags.Description = "Create a new interactive quiz"
 
-	page := Div(
-		Class("container mx-auto px-4 py-8"),
+	content := Div(
+		Class("max-w-4xl mx-auto"),
+		form.Render(r),
+	)
+
+	return r.Render(layouts.Primary, content)
+}
+
+// ViewQuiz displays a specific quiz
+func ViewQuiz(ctx echo.Context, quiz *ent.Quiz, attempts []*ent.QuizAttempt) error {
+	r := ui.NewRequest(ctx)
+	r.Title = quiz.Title
+	r.Metatags.Description = "View quiz details"
+
+	// Check if user owns the quiz
+	isOwner := false
+	if r.AuthUser != nil && quiz.Edges.Owner != nil {
+		isOwner = r.AuthUser.ID == quiz.Edges.Owner.ID
+	}
+
+	content := Div(
+		Class("max-w-4xl mx-auto py-8 px-4 sm:px-6 lg:px-8"),
+
+		// Header section
 		Div(
-			Class("max-w-2xl mx-auto"),
-			H1(Class("text-3xl font-bold text-gray-900 mb-6"), Text("Create Quiz")),
+			Class("mb-8"),
+			H1(
+				Class("text-2xl sm:text-3xl font-bold text-gray-900 mb-4 break-words"),
+				Text(quiz.Title),
+			),
 			Div(
-				Class("bg-white rounded-lg shadow p-6"),
+				Class("flex flex-col sm:flex-row sm:items-center sm:justify-between gap-4"),
+				Div(
+					Class("flex flex-wrap items-center gap-4 text-sm text-gray-600"),
+					If(quiz.Edges.Owner != nil,
+						Span(
+							Class("font-medium text-gray-900"),
+							Text("By "+quiz.Edges.Owner.Name),
+						),
+					),
+					Span(Text(fmt.Sprintf("%d questions", len(quiz.Edges.Questions)))),
+					If(quiz.TimeLimit > 0,
+						Span(Text(fmt.Sprintf("%d minute time limit", quiz.TimeLimit))),
+					),
+					If(quiz.MaxAttempts > 0,
+						Span(Text(fmt.Sprintf("%d of %d attempts used", len(attempts), quiz.MaxAttempts))),
+					),
+					quizVisibilityBadge(quiz),
+					quizSourceNote(r, quiz),
+				),
 				Div(
-					Class("text-center py-12"),
-					Div(
-						Class("text-6xl mb-4"),
-						Text("🧠"),
+					Class("flex items-center gap-2"),
+					If(r.AuthUser != nil && services.CanPractice(quiz, r.AuthUser.ID),
+						A(
+							Href(r.Path("quiz.practice", quiz.ID)),
+							Class("px-3 py-1.5 text-sm font-medium text-gray-700 bg-white border border-gray-300 rounded-md hover:bg-gray-50 transition-colors"),
+							Text("Self-check"),
+						),
+					),
+					A(
+						Href(r.Path("quiz.take", quiz.ID)),
+						Class("px-3 py-1.5 text-sm font-medium text-white bg-blue-600 rounded-md hover:bg-blue-700 transition-colors"),
+						Text("Take Quiz"),
+					),
+					// Actions (only for owner)
+					If(isOwner,
+						Group{
+							A(
+								Href(r.Path("quiz.edit", quiz.ID)),
+								Class("px-3 py-1.5 text-sm font-medium text-blue-700 bg-blue-50 border border-blue-300 rounded-md hover:bg-blue-100 transition-colors"),
+								Text("Edit"),
+							),
+							Form(
+								Class("inline"),
+								Method("POST"),
+								Action(r.Path("quiz.delete", quiz.ID)),
+								Attr("onsubmit", "return confirm('Are you sure you want to delete this quiz?')"),
+								CSRF(r),
+								Button(
+									Type("submit"),
+									Class("px-3 py-1.5 text-sm font-medium text-red-700 bg-red-50 border border-red-300 rounded-md hover:bg-red-100 transition-colors"),
+									Text("Delete"),
+								),
+							),
+						},
 					),
-					H2(Class("text-2xl font-semibold text-gray-900 mb-4"), Text("Quiz Creation Coming Soon!")),
-					P(Class("text-gray-600 mb-6"), Text("We're working on an amazing quiz creation tool that will allow you to build interactive quizzes with multiple question types, automatic grading, and detailed analytics.")),
+				),
+			),
+		),
 
+		// Description
+		If(quiz.Description != "",
+			Div(
+				Class("mb-6 p-4 bg-blue-50 border-l-4 border-blue-400 rounded-r-md"),
+				P(
+					Class("text-blue-800 leading-relaxed"),
+					Text(quiz.Description),
+				),
+			),
+		),
+
+		// Previous attempts
+		Div(
+			Class("mb-6"),
+			H2(
+				Class("text-lg font-semibold text-gray-900 mb-4"),
+				Text("Your Attempts"),
+			),
+			If(len(attempts) == 0,
+				P(Class("text-gray-600"), Text("You haven't taken this quiz yet.")),
+			),
+			If(len(attempts) > 0,
+				Div(
+					Class("bg-white border border-gray-200 rounded-lg divide-y divide-gray-200"),
+					Group(func() []Node {
+						var rows []Node
+						for _, a := range attempts {
+							rows = append(rows, A(
+								Href(r.Path("quiz.attempt", quiz.ID, a.ID)),
+								Class("flex justify-between items-center p-4 hover:bg-gray-50"),
+								Span(
+									Class("text-sm text-gray-600"),
+									Text(a.StartedAt.Format("Jan 2, 2006 15:04")),
+								),
+								attemptSummary(a),
+							))
+						}
+						return rows
+					}()),
 				),
 			),
 		),
 	)
 
-	return r.Render(layouts.Primary, page)
+	return r.Render(layouts.Primary, content)
 }
 
-// ViewQuiz displays a specific quiz
-func ViewQuiz(ctx echo.Context) error {
+// EditQuiz displays the quiz edit form
+func EditQuiz(ctx echo.Context, form *forms.Quiz) error {
 	r := ui.NewRequest(ctx)
-	r.Title = "View Quiz"
-	r.Metatags.Description = "View quiz details"
+	r.Title = "Edit Quiz"
+	r.Metatags.Description = "Edit quiz details"
 
-	page := Div(
-		Class("container mx-auto px-4 py-8"),
+	content := Div(
+		Class("max-w-4xl mx-auto"),
+		form.Render(r),
+	)
+
+	return r.Render(layouts.Primary, content)
+}
+
+// TakeQuiz displays the quiz taking interface for an attempt in progress.
+// Answers are saved as they change so that they count if the deadline passes before submission.
+func TakeQuiz(ctx echo.Context, quiz *ent.Quiz, attempt *ent.QuizAttempt) error {
+	r := ui.NewRequest(ctx)
+	r.Title = quiz.Title
+	r.Metatags.Description = "Take an interactive quiz"
+
+	questions := make(Group, 0, len(quiz.Edges.Questions))
+	for i, q := range quiz.Edges.Questions {
+		questions = append(questions, takeQuestion(i+1, q, attempt.Answers[q.ID]))
+	}
+
+	content := Div(
+		Class("max-w-4xl mx-auto py-8 px-4 sm:px-6 lg:px-8"),
 		Div(
-			Class("bg-white rounded-lg shadow p-6"),
-			P(Class("text-gray-600 text-center py-8"), Text("Quiz viewing functionality is coming soon!")),
+			Class("flex justify-between items-center mb-6 gap-4"),
+			H1(
+				Class("text-2xl sm:text-3xl font-bold text-gray-900 break-words"),
+				Text(quiz.Title),
+			),
+			If(attempt.Deadline != nil,
+				Div(
+					ID("quiz-timer"),
+					Class("flex-shrink-0 px-3 py-1.5 rounded-md bg-yellow-50 border border-yellow-300 text-yellow-800 font-mono"),
+					Text(""),
+				),
+			),
 		),
+		Form(
+			ID("quiz-attempt"),
+			Method("POST"),
+			Action(r.Path("quiz.submit", quiz.ID)),
+			Class("space-y-6"),
+			FlashMessages(r),
+			Input(Type("hidden"), Name("attempt_id"), Value(strconv.Itoa(attempt.ID))),
+			Div(
+				Class("space-y-6"),
+				Attr("hx-post", r.Path("quiz.attempt.save", quiz.ID, attempt.ID)),
+				Attr("hx-trigger", "change delay:500ms"),
+				Attr("hx-swap", "none"),
+				questions,
+			),
+			CSRF(r),
+			ControlGroup(
+				FormButton(ColorPrimary, "Submit Answers"),
+			),
+		),
+		quizTimer(attempt),
 	)
 
-	return r.Render(layouts.Primary, page)
+	return r.Render(layouts.Primary, content)
 }
 
-// EditQuiz displays the quiz edit form
-func EditQuiz(ctx echo.Context) error {
+// PracticeQuiz displays a self-check page where each answer can be revealed after attempting the question
+func PracticeQuiz(ctx echo.Context, quiz *ent.Quiz) error {
 	r := ui.NewRequest(ctx)
-	r.Title = "Edit Quiz"
-	r.Metatags.Description = "Edit quiz details"
+	r.Title = quiz.Title
+	r.Metatags.Description = "Check your understanding"
 
-	page := Div(
-		Class("container mx-auto px-4 py-8"),
+	cards := make(Group, 0, len(quiz.Edges.Questions))
+	for i, q := range quiz.Edges.Questions {
+		cards = append(cards, Div(
+			Class("bg-white border border-gray-200 rounded-lg p-6"),
+			P(
+				Class("font-medium text-gray-900 mb-4"),
+				Text(fmt.Sprintf("%d. %s", i+1, q.Prompt)),
+			),
+			If(q.Type != question.TypeShortAnswer,
+				Ul(
+					Class("list-disc list-inside text-sm text-gray-700 mb-4 space-y-1"),
+					Group(func() []Node {
+						var items []Node
+						for _, c := range q.Edges.Choices {
+							items = append(items, Li(Text(c.Text)))
+						}
+						return items
+					}()),
+				),
+			),
+			Details(
+				Class("text-sm"),
+				Summary(
+					Class("cursor-pointer text-blue-600 hover:underline"),
+					Text("Show answer"),
+				),
+				Div(
+					Class("mt-2 p-3 bg-green-50 border border-green-200 rounded-md"),
+					P(
+						Class("font-medium text-green-800"),
+						Text(correctAnswer(q)),
+					),
+					If(q.Explanation != "",
+						P(
+							Class("text-gray-600 mt-1"),
+							Text(q.Explanation),
+						),
+					),
+				),
+			),
+		))
+	}
+
+	content := Div(
+		Class("max-w-4xl mx-auto py-8 px-4 sm:px-6 lg:px-8"),
+		Div(
+			Class("mb-8"),
+			H1(
+				Class("text-2xl sm:text-3xl font-bold text-gray-900 mb-2 break-words"),
+				Text(quiz.Title),
+			),
+			P(
+				Class("text-gray-600"),
+				Text("Answer each question in your head, then reveal the answer to check yourself."),
+			),
+		),
+		Div(Class("space-y-4"), cards),
 		Div(
-			Class("bg-white rounded-lg shadow p-6"),
-			P(Class("text-gray-600 text-center py-8"), Text("Quiz editing functionality is coming soon!")),
+			Class("mt-8 flex gap-2"),
+			A(
+				Href(r.Path("quiz.take", quiz.ID)),
+				Class("px-4 py-2 bg-blue-600 text-white rounded-md hover:bg-blue-700 transition-colors"),
+				Text("Take as Quiz"),
+			),
+			A(
+				Href(r.Path("quiz.view", quiz.ID)),
+				Class("px-4 py-2 border border-gray-300 rounded-md text-gray-700 hover:bg-gray-50 transition-colors"),
+				Text("Back to Quiz"),
+			),
 		),
 	)
 
-	return r.Render(layouts.Primary, page)
+	return r.Render(layouts.Primary, content)
 }
 
-// TakeQuiz displays the quiz taking interface
-func TakeQuiz(ctx echo.Context) error {
+// QuizResult displays the graded results of a quiz attempt, with the correct answers and
+// explanations if showAnswers is set
+func QuizResult(ctx echo.Context, quiz *ent.Quiz, attempt *ent.QuizAttempt, results []services.QuestionResult, showAnswers bool) error {
 	r := ui.NewRequest(ctx)
-	r.Title = "Take Quiz"
-	r.Metatags.Description = "Take an interactive quiz"
+	r.Title = "Quiz Results"
+	r.Metatags.Description = "Review your quiz results"
 
-	page := Div(
-		Class("container mx-auto px-4 py-8"),
+	percent := 0
+	if attempt.MaxScore > 0 {
+		percent = attempt.Score * 100 / attempt.MaxScore
+	}
+
+	rows := make(Group, 0, len(results))
+	for i, res := range results {
+		rows = append(rows, resultQuestion(i+1, res, showAnswers))
+	}
+
+	content := Div(
+		Class("max-w-4xl mx-auto py-8 px-4 sm:px-6 lg:px-8"),
+		Div(
+			Class("mb-8"),
+			H1(
+				Class("text-2xl sm:text-3xl font-bold text-gray-900 mb-2 break-words"),
+				Text(quiz.Title),
+			),
+			P(
+				Class("text-lg text-gray-700"),
+				Text(fmt.Sprintf("Score: %d / %d (%d%%)", attempt.Score, attempt.MaxScore, percent)),
+			),
+			If(attempt.SubmittedAt != nil,
+				P(
+					Class("text-sm text-gray-600"),
+					Text("Time taken: "+attemptDuration(attempt)),
+				),
+			),
+			If(attempt.Status == quizattempt.StatusExpired,
+				P(
+					Class("mt-2 text-sm text-yellow-800"),
+					Text("The time limit passed before this attempt was submitted, so it was graded from the answers saved in time."),
+				),
+			),
+			If(!showAnswers,
+				P(
+					Class("mt-2 text-sm text-gray-600"),
+					If(quiz.MaxAttempts > 0, Text("The correct answers are shown once you've used all your attempts.")),
+					If(quiz.MaxAttempts == 0, Text("The correct answers aren't shown for this quiz.")),
+				),
+			),
+		),
+		Div(Class("space-y-4"), rows),
 		Div(
-			Class("bg-white rounded-lg shadow p-6"),
-			P(Class("text-gray-600 text-center py-8"), Text("Quiz taking functionality is coming soon!")),
+			Class("mt-8 flex gap-2"),
+			A(
+				Href(r.Path("quiz.take", quiz.ID)),
+				Class("px-4 py-2 bg-blue-600 text-white rounded-md hover:bg-blue-700 transition-colors"),
+				Text("Try Again"),
+			),
+			A(
+				Href(r.Path("quiz.view", quiz.ID)),
+				Class("px-4 py-2 border border-gray-300 rounded-md text-gray-700 hover:bg-gray-50 transition-colors"),
+				Text("Back to Quiz"),
+			),
 		),
 	)
 
-	return r.Render(layouts.Primary, page)
-}
\ No newline at end of file
+	return r.Render(layouts.Primary, content)
+}
+
+// quizCard creates a card component for displaying a quiz in the list
+func quizCard(r *ui.Request, quiz *ent.Quiz) Node {
+	return Div(
+		Class("bg-white rounded-lg border border-gray-200 hover:border-gray-300 hover:shadow-md transition-all duration-200"),
+		A(
+			Href(r.Path("quiz.view", quiz.ID)),
+			Class("block p-6 h-full"),
+			Div(
+				Class("flex justify-between items-start mb-3"),
+				H3(
+					Class("text-lg font-semibold text-gray-900 line-clamp-2"),
+					Text(quiz.Title),
+				),
+				Div(
+					Class("flex-shrink-0 ml-2"),
+					quizVisibilityBadge(quiz),
+				),
+			),
+			If(quiz.Description != "",
+				P(
+					Class("text-gray-600 text-sm mb-4 line-clamp-3"),
+					Text(quiz.Description),
+				),
+			),
+			Div(
+				Class("flex justify-between items-center text-xs text-gray-500 mt-auto"),
+				Span(Text(fmt.Sprintf("%d questions", len(quiz.Edges.Questions)))),
+				If(quiz.Edges.Owner != nil,
+					Span(Text(quiz.Edges.Owner.Name)),
+				),
+			),
+		),
+	)
+}
+
+// quizVisibilityBadge renders the visibility of a quiz
+func quizVisibilityBadge(quiz *ent.Quiz) Node {
+	if string(quiz.Visibility) == "public" {
+		return Span(
+			Class("inline-flex items-center px-2 py-1 rounded-full text-xs bg-green-100 text-green-800"),
+			Text("Public"),
+		)
+	}
+	return Span(
+		Class("inline-flex items-center px-2 py-1 rounded-full text-xs bg-gray-100 text-gray-800"),
+		Text("Private"),
+	)
+}
+
+// takeQuestion renders the answer inputs for a single question, selecting any saved answer
+func takeQuestion(n int, q *ent.Question, saved string) Node {
+	name := fmt.Sprintf("question_%d", q.ID)
+
+	var answer Node
+	switch q.Type {
+	case question.TypeShortAnswer:
+		answer = Input(
+			Type("text"),
+			Name(name),
+			Class("input w-full"),
+			Placeholder("Your answer"),
+			Value(saved),
+		)
+	default:
+		options := make(Group, 0, len(q.Edges.Choices))
+		for _, c := range q.Edges.Choices {
+			options = append(options, Label(
+				Class("flex items-center gap-2 cursor-pointer"),
+				Input(
+					Type("radio"),
+					Name(name),
+					Value(strconv.Itoa(c.ID)),
+					Class("radio"),
+					If(strconv.Itoa(c.ID) == saved, Checked()),
+				),
+				Span(Text(c.Text)),
+			))
+		}
+		answer = Div(Class("space-y-2"), options)
+	}
+
+	return Div(
+		Class("bg-white border border-gray-200 rounded-lg p-6"),
+		P(
+			Class("font-medium text-gray-900 mb-4"),
+			Text(fmt.Sprintf("%d. %s", n, q.Prompt)),
+		),
+		answer,
+	)
+}
+
+// resultQuestion renders the grading outcome for a single question, with its answer and
+// explanation if showAnswers is set
+func resultQuestion(n int, res services.QuestionResult, showAnswers bool) Node {
+	q := res.Question
+
+	// Resolve the text of the selected choice
+	given := res.Answer
+	if q.Type != question.TypeShortAnswer {
+		for _, c := range q.Edges.Choices {
+			if strconv.Itoa(c.ID) == res.Answer {
+				given = c.Text
+			}
+		}
+	}
+	correct := correctAnswer(q)
+
+	border := "border-red-200 bg-red-50"
+	if res.Correct {
+		border = "border-green-200 bg-green-50"
+	}
+
+	return Div(
+		Class("border rounded-lg p-6 "+border),
+		P(
+			Class("font-medium text-gray-900 mb-2"),
+			Text(fmt.Sprintf("%d. %s", n, q.Prompt)),
+		),
+		P(
+			Class("text-sm text-gray-700"),
+			Text("Your answer: "),
+			If(given == "", Em(Text("No answer"))),
+			If(given != "", Text(given)),
+		),
+		If(showAnswers && !res.Correct,
+			P(
+				Class("text-sm text-gray-700"),
+				Text("Correct answer: "+correct),
+			),
+		),
+		If(showAnswers && q.Explanation != "",
+			P(
+				Class("text-sm text-gray-600 mt-2"),
+				Text(q.Explanation),
+			),
+		),
+		P(
+			Class("text-xs text-gray-500 mt-2"),
+			Text(fmt.Sprintf("%d / %d points", res.Points, q.Points)),
+		),
+	)
+}
+
+// quizSourceNote links to the note a practice set was generated from, if any
+func quizSourceNote(r *ui.Request, quiz *ent.Quiz) Node {
+	if quiz.Edges.Note == nil {
+		return nil
+	}
+
+	return Span(
+		Text("Generated from "),
+		A(
+			Href(r.Path(routenames.Notes+".view", quiz.Edges.Note.ID)),
+			Class("text-blue-600 hover:underline"),
+			Text(quiz.Edges.Note.Title),
+		),
+	)
+}
+
+// correctAnswer returns the text of the correct answer to a question
+func correctAnswer(q *ent.Question) string {
+	if q.Type == question.TypeShortAnswer {
+		if len(q.AcceptedAnswers) > 0 {
+			return q.AcceptedAnswers[0]
+		}
+		return ""
+	}
+
+	for _, c := range q.Edges.Choices {
+		if c.Correct {
+			return c.Text
+		}
+	}
+	return ""
+}
+
+// attemptSummary renders the status and score of an attempt in a list
+func attemptSummary(a *ent.QuizAttempt) Node {
+	switch a.Status {
+	case quizattempt.StatusInProgress:
+		return Span(
+			Class("text-sm font-medium text-blue-700"),
+			Text("In progress"),
+		)
+	case quizattempt.StatusExpired:
+		return Span(
+			Class("font-medium text-gray-900"),
+			Text(fmt.Sprintf("%d / %d (timed out)", a.Score, a.MaxScore)),
+		)
+	default:
+		return Span(
+			Class("font-medium text-gray-900"),
+			Text(fmt.Sprintf("%d / %d", a.Score, a.MaxScore)),
+		)
+	}
+}
+
+// attemptDuration formats the time taken to complete an attempt
+func attemptDuration(a *ent.QuizAttempt) string {
+	if a.SubmittedAt == nil {
+		return ""
+	}
+	return a.SubmittedAt.Sub(a.StartedAt).Round(time.Second).String()
+}
+
+// quizTimer renders a countdown to the attempt's deadline which submits the quiz when time runs out.
+// This is only a convenience; the deadline is enforced by the server.
+func quizTimer(attempt *ent.QuizAttempt) Node {
+	if attempt.Deadline == nil {
+		return nil
+	}
+
+	return Script(
+		Raw(fmt.Sprintf(`
+			(function() {
+				const deadline = %d;
+				const timer = document.getElementById('quiz-timer');
+				const form = document.getElementById('quiz-attempt');
+				function tick() {
+					const remaining = Math.max(0, Math.floor((deadline - Date.now()) / 1000));
+					const minutes = Math.floor(remaining / 60);
+					const seconds = remaining %% 60;
+					timer.textContent = minutes + ':' + String(seconds).padStart(2, '0');
+					if (remaining === 0) {
+						form.submit();
+						return;
+					}
+					setTimeout(tick, 1000);
+				}
+				tick();
+			})();
+		`, attempt.Deadline.UnixMilli())),
+	)
+}
@@ -1,20 +1,212 @@
 package pages
 
 import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
 	"github.com/labstack/echo/v4"
+	"github.com/r-scheele/zero/pkg/pager"
+	"github.com/r-scheele/zero/pkg/routenames"
+	"github.com/r-scheele/zero/pkg/services"
 	"github.com/r-scheele/zero/pkg/ui"
+	. "github.com/r-scheele/zero/pkg/ui/components"
 	"github.com/r-scheele/zero/pkg/ui/layouts"
-	"github.com/r-scheele/zero/pkg/ui/models"
 	. "maragu.dev/gomponents"
+	. "maragu.dev/gomponents/html"
 )
 
-func SearchResults(ctx echo.Context, results []*models.SearchResult) error {
+// searchResultsID is the ID of the panel that live search swaps in as the user types
+const searchResultsID = "search-results"
+
+func SearchResults(ctx echo.Context, params services.SearchParams, results *services.SearchResults, pg pager.Pager) error {
 	r := ui.NewRequest(ctx)
+	r.Title = "Search"
 
-	g := make(Group, len(results))
-	for i, result := range results {
+	g := make(Group, len(results.Results))
+	for i, result := range results.Results {
 		g[i] = result.Render()
 	}
 
-	return r.Render(layouts.Primary, g)
+	switch {
+	case r.Htmx.Enabled && r.Htmx.Target == searchResultsID:
+		// Live search only replaces the results panel, so the search box keeps its focus
+		return r.Render(layouts.Primary, searchResultsPanel(r, params, results, pg, g))
+	case r.Htmx.Enabled && !r.Htmx.Boosted:
+		// The search modal swaps the results into its own list
+		return r.Render(layouts.Primary, g)
+	}
+
+	page := Div(
+		Class("container mx-auto px-4 py-8 max-w-5xl"),
+		H1(Class("text-3xl font-bold text-gray-900 mb-6"), Text("Search")),
+		Form(
+			Method("GET"),
+			Action(r.Path(routenames.Search)),
+			Attr("hx-get", r.Path(routenames.Search)),
+			Attr("hx-trigger", "input delay:300ms, submit"),
+			Attr("hx-target", "#"+searchResultsID),
+			Attr("hx-swap", "outerHTML"),
+			Attr("hx-push-url", "true"),
+			Input(
+				Type("search"),
+				Name("q"),
+				Value(params.Query),
+				Class("w-full bg-white border border-gray-300 rounded-lg px-4 py-2 mb-6 text-gray-700 focus:outline-none focus:ring-2 focus:ring-blue-500"),
+				Placeholder("Search notes, files and people..."),
+				AutoComplete("off"),
+				AutoFocus(),
+			),
+			searchResultsPanel(r, params, results, pg, g),
+		),
+	)
+
+	return r.Render(layouts.Primary, page)
+}
+
+// searchResultsPanel renders the filters, with their facet counts, next to a page of results
+func searchResultsPanel(r *ui.Request, params services.SearchParams, results *services.SearchResults, pg pager.Pager, g Group) Node {
+	if params.Query == "" {
+		return Div(ID(searchResultsID))
+	}
+
+	facets := results.Facets
+
+	// The owner filter comes from people results, so keep the selected owner listed even when
+	// nothing else matches
+	owners := facets.Owners
+	if params.OwnerID != 0 && !hasFacet(owners, strconv.Itoa(params.OwnerID)) {
+		owners = append(owners, services.SearchFacet{Value: strconv.Itoa(params.OwnerID), Label: "Selected person"})
+	}
+
+	to := ""
+	if !params.To.IsZero() {
+		to = params.To.AddDate(0, 0, -1).Format(time.DateOnly)
+	}
+	from := ""
+	if !params.From.IsZero() {
+		from = params.From.Format(time.DateOnly)
+	}
+
+	return Div(
+		ID(searchResultsID),
+		Class("grid grid-cols-1 md:grid-cols-4 gap-6"),
+		Aside(
+			Class("space-y-4 text-sm"),
+			searchFacetSelect("owner", "Owner", "Anyone", strconv.Itoa(params.OwnerID), owners),
+			searchFacetSelect("visibility", "Visibility", "Any", params.Visibility, facets.Visibility),
+			searchFacetSelect("type", "Resource type", "Any", params.ResourceType, facets.ResourceTypes),
+			Div(
+				Label(Class("block font-medium text-gray-700 mb-1"), Text("Created")),
+				Input(
+					Type("date"),
+					Name("from"),
+					Value(from),
+					Class("w-full border border-gray-300 rounded-md px-2 py-1 mb-2"),
+				),
+				Input(
+					Type("date"),
+					Name("to"),
+					Value(to),
+					Class("w-full border border-gray-300 rounded-md px-2 py-1"),
+				),
+				Ul(
+					Class("mt-2 space-y-1"),
+					Map(facets.Dates, func(f services.SearchFacet) Node {
+						href := searchURL(r, "from", f.Value)
+						return Li(
+							A(
+								Href(href),
+								Attr("hx-get", href),
+								Attr("hx-target", "#"+searchResultsID),
+								Attr("hx-swap", "outerHTML"),
+								Attr("hx-push-url", "true"),
+								Class(func() string {
+									if f.Value == from {
+										return "font-medium text-blue-700"
+									}
+									return "text-blue-600 hover:underline"
+								}()),
+								Textf("%s (%d)", f.Label, f.Count),
+							),
+						)
+					}),
+				),
+			),
+			If(r.IsAuth,
+				Label(
+					Class("flex items-center gap-2 cursor-pointer text-gray-700"),
+					Input(
+						Type("checkbox"),
+						Name("liked"),
+						Value("true"),
+						If(params.LikedByMe, Checked()),
+					),
+					Textf("Liked by me (%d)", facets.Liked),
+				),
+			),
+		),
+		Div(
+			Class("md:col-span-3"),
+			P(Class("text-sm text-gray-500 mb-3"), Textf("%d results", results.Total)),
+			Iff(len(results.Results) == 0, func() Node {
+				return P(Class("text-gray-600"), Textf("No results for “%s”.", params.Query))
+			}),
+			If(len(results.Results) > 0,
+				Ul(Class("list bg-white rounded-lg shadow"), g),
+			),
+			If(pg.Pages > 1,
+				Div(
+					Class("mt-4"),
+					Pager(pg.Page, searchURL(r, pager.QueryKey, ""), !pg.IsEnd(), "#"+searchResultsID),
+				),
+			),
+		),
+	)
+}
+
+// searchFacetSelect renders a filter as a select listing each value with its result count
+func searchFacetSelect(name, label, anyLabel, selected string, facets []services.SearchFacet) Node {
+	return Div(
+		Label(Class("block font-medium text-gray-700 mb-1"), For(name), Text(label)),
+		Select(
+			ID(name),
+			Name(name),
+			Class("w-full border border-gray-300 rounded-md px-2 py-1"),
+			Option(Value(""), Text(anyLabel)),
+			Map(facets, func(f services.SearchFacet) Node {
+				return Option(
+					Value(f.Value),
+					If(f.Value == selected, Selected()),
+					Textf("%s (%d)", f.Label, f.Count),
+				)
+			}),
+		),
+	)
+}
+
+// searchURL returns the current search URL with a query parameter replaced, or removed when the
+// value is empty. Any other change to the search starts again from the first page.
+func searchURL(r *ui.Request, key, value string) string {
+	query := make(url.Values)
+	for k, v := range r.Context.QueryParams() {
+		if k != key && k != pager.QueryKey {
+			query[k] = v
+		}
+	}
+	if value != "" {
+		query.Set(key, value)
+	}
+	return fmt.Sprintf("%s?%s", r.Path(routenames.Search), query.Encode())
+}
+
+// hasFacet reports whether a facet with the value is listed
+func hasFacet(facets []services.SearchFacet, value string) bool {
+	for _, f := range facets {
+		if f.Value == value {
+			return true
+		}
+	}
+	return false
 }
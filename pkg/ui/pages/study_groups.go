@@ -1,82 +1,551 @@
 package pages
 
 import (
+	"fmt"
+
 	"github.com/labstack/echo/v4"
+	"github.com/r-scheele/zero/ent"
+	"github.com/r-scheele/zero/ent/groupmembership"
+	"github.com/r-scheele/zero/ent/studygroup"
+	"github.com/r-scheele/zero/pkg/routenames"
 	"github.com/r-scheele/zero/pkg/ui"
+	. "github.com/r-scheele/zero/pkg/ui/components"
+	"github.com/r-scheele/zero/pkg/ui/forms"
 	"github.com/r-scheele/zero/pkg/ui/layouts"
 	. "maragu.dev/gomponents"
 	. "maragu.dev/gomponents/html"
 )
 
-// StudyGroupsList displays the list of study groups
-func StudyGroupsList(ctx echo.Context) error {
+// StudyGroupData holds everything shown on a study group page.
+// Members, notes and requests are only loaded for members of the group.
+type StudyGroupData struct {
+	Group      *ent.StudyGroup
+	Membership *ent.GroupMembership
+	Pending    bool
+	Members    []*ent.GroupMembership
+	Notes      []*ent.Note
+	OwnNotes   []*ent.Note
+	Requests   []*ent.GroupJoinRequest
+	Invites    []*ent.GroupInvite
+}
+
+// StudyGroupsList displays the user's study groups and the groups they can browse
+func StudyGroupsList(ctx echo.Context, mine, all []*ent.StudyGroup, page int) error {
 	r := ui.NewRequest(ctx)
 	r.Title = "Study Groups"
 	r.Metatags.Description = "Browse and join collaborative study groups"
 
-	page := Div(
+	cards := func(groups []*ent.StudyGroup) Node {
+		return Div(
+			Class("grid grid-cols-1 md:grid-cols-2 lg:grid-cols-3 gap-6"),
+			Group(func() []Node {
+				var nodes []Node
+				for _, g := range groups {
+					nodes = append(nodes, studyGroupCard(r, g))
+				}
+				return nodes
+			}()),
+		)
+	}
+
+	content := Div(
 		Class("container mx-auto px-4 py-8"),
 		Div(
 			Class("flex justify-between items-center mb-6"),
 			H1(Class("text-3xl font-bold text-gray-900"), Text("Study Groups")),
 			A(
-				Href("/study-groups/create"),
+				Href(r.Path("study-groups.create")),
 				Class("bg-pink-600 hover:bg-pink-700 text-white px-4 py-2 rounded-lg font-medium"),
 				Text("Create Study Group"),
 			),
 		),
-		Div(
-			Class("bg-white rounded-lg shadow p-6"),
-			P(Class("text-gray-600 text-center py-8"), Text("Study groups functionality is coming soon! You'll be able to create and join collaborative study sessions with other learners.")),
+
+		If(len(mine) > 0,
+			Div(
+				Class("mb-10"),
+				H2(Class("text-lg font-semibold text-gray-900 mb-4"), Text("Your Groups")),
+				cards(mine),
+			),
+		),
+
+		H2(Class("text-lg font-semibold text-gray-900 mb-4"), Text("All Groups")),
+		If(len(all) > 0, cards(all)),
+		If(len(all) == 0,
+			Div(
+				Class("bg-white rounded-lg shadow p-6"),
+				P(Class("text-gray-600 text-center py-8"), Text("No study groups yet. Create one and invite other learners to study with you.")),
+			),
 		),
 	)
 
-	return r.Render(layouts.Primary, page)
+	return r.Render(layouts.Primary, content)
 }
 
 // CreateStudyGroup displays the study group creation form
-func CreateStudyGroup(ctx echo.Context) error {
+func CreateStudyGroup(ctx echo.Context, form *forms.StudyGroup) error {
 	r := ui.NewRequest(ctx)
 	r.Title = "Create Study Group"
 	r.Metatags.Description = "Create a new collaborative study group"
 
-	page := Div(
-		Class("container mx-auto px-4 py-8"),
+	content := Div(
+		Class("max-w-2xl mx-auto"),
+		form.Render(r),
+	)
+
+	return r.Render(layouts.Primary, content)
+}
+
+// ViewStudyGroup displays a specific study group
+func ViewStudyGroup(ctx echo.Context, data StudyGroupData) error {
+	r := ui.NewRequest(ctx)
+	r.Title = data.Group.Name
+	r.Metatags.Description = "View study group details"
+
+	group := data.Group
+	isMember := data.Membership != nil
+	isOwner := isMember && data.Membership.Role == groupmembership.RoleOwner
+	canModerate := isOwner || (isMember && data.Membership.Role == groupmembership.RoleModerator)
+
+	content := Div(
+		Class("max-w-4xl mx-auto py-8 px-4 sm:px-6 lg:px-8"),
+
+		// Header section
 		Div(
-			Class("max-w-2xl mx-auto"),
-			H1(Class("text-3xl font-bold text-gray-900 mb-6"), Text("Create Study Group")),
+			Class("mb-8"),
+			H1(
+				Class("text-2xl sm:text-3xl font-bold text-gray-900 mb-4 break-words"),
+				Text(group.Name),
+			),
 			Div(
-				Class("bg-white rounded-lg shadow p-6"),
+				Class("flex flex-col sm:flex-row sm:items-center sm:justify-between gap-4"),
+				Div(
+					Class("flex flex-wrap items-center gap-4 text-sm text-gray-600"),
+					studyGroupPrivacyBadge(group),
+					If(isMember, Span(Text(fmt.Sprintf("%d members", len(data.Members))))),
+					If(isMember, studyGroupRoleBadge(data.Membership.Role)),
+				),
 				Div(
-					Class("text-center py-12"),
-					Div(
-						Class("text-6xl mb-4"),
-						Text("👥"),
+					Class("flex items-center gap-2"),
+					If(!isMember && !data.Pending, studyGroupJoinForm(r, group)),
+					If(data.Pending,
+						Span(
+							Class("px-3 py-1.5 text-sm text-gray-600 bg-gray-100 rounded-md"),
+							Text("Join request pending"),
+						),
 					),
-					H2(Class("text-2xl font-semibold text-gray-900 mb-4"), Text("Study Groups Coming Soon!")),
-					P(Class("text-gray-600 mb-6"), Text("We're developing collaborative study groups where you can connect with other learners, share resources, and study together in real-time.")),
+					If(isMember, studyGroupAction(r, r.Path("study-groups.leave", group.ID), "Leave", "Leave this study group?", false)),
+					If(isOwner, studyGroupAction(r, r.Path("study-groups.delete", group.ID), "Delete", "Are you sure you want to delete this study group?", true)),
+				),
+			),
+		),
 
+		// Description
+		If(group.Description != "",
+			Div(
+				Class("mb-6 p-4 bg-pink-50 border-l-4 border-pink-400 rounded-r-md"),
+				P(
+					Class("text-pink-800 leading-relaxed"),
+					Text(group.Description),
 				),
 			),
 		),
+
+		If(canModerate && len(data.Requests) > 0, studyGroupRequests(r, group, data.Requests)),
+		If(canModerate, studyGroupInvites(r, group, data.Invites)),
+		If(isMember, studyGroupNotes(r, data, isOwner)),
+		If(isMember, studyGroupMembers(r, data, isOwner, canModerate)),
 	)
 
-	return r.Render(layouts.Primary, page)
+	return r.Render(layouts.Primary, content)
 }
 
-// ViewStudyGroup displays a specific study group
-func ViewStudyGroup(ctx echo.Context) error {
+// studyGroupCard creates a card component for displaying a study group in the list
+func studyGroupCard(r *ui.Request, group *ent.StudyGroup) Node {
+	return Div(
+		Class("bg-white rounded-lg border border-gray-200 hover:border-gray-300 hover:shadow-md transition-all duration-200"),
+		A(
+			Href(r.Path("study-groups.view", group.ID)),
+			Class("block p-6 h-full"),
+			Div(
+				Class("flex justify-between items-start mb-3"),
+				H3(
+					Class("text-lg font-semibold text-gray-900 line-clamp-2"),
+					Text(group.Name),
+				),
+				Div(
+					Class("flex-shrink-0 ml-2"),
+					studyGroupPrivacyBadge(group),
+				),
+			),
+			If(group.Description != "",
+				P(
+					Class("text-gray-600 text-sm mb-4 line-clamp-3"),
+					Text(group.Description),
+				),
+			),
+			Div(
+				Class("text-xs text-gray-500 mt-auto"),
+				Text(fmt.Sprintf("%d members", len(group.Edges.Memberships))),
+			),
+		),
+	)
+}
+
+// studyGroupPrivacyBadge renders whether a group is open to anyone
+func studyGroupPrivacyBadge(group *ent.StudyGroup) Node {
+	if group.Privacy == studygroup.PrivacyPublic {
+		return Span(
+			Class("inline-flex items-center px-2 py-1 rounded-full text-xs bg-green-100 text-green-800"),
+			Text("Public"),
+		)
+	}
+	return Span(
+		Class("inline-flex items-center px-2 py-1 rounded-full text-xs bg-gray-100 text-gray-800"),
+		Text("Invite only"),
+	)
+}
+
+// studyGroupRoleBadge renders a member's role
+func studyGroupRoleBadge(role groupmembership.Role) Node {
+	color := "bg-gray-100 text-gray-800"
+	switch role {
+	case groupmembership.RoleOwner:
+		color = "bg-pink-100 text-pink-800"
+	case groupmembership.RoleModerator:
+		color = "bg-blue-100 text-blue-800"
+	}
+	return Span(
+		Class("inline-flex items-center px-2 py-1 rounded-full text-xs capitalize "+color),
+		Text(string(role)),
+	)
+}
+
+// studyGroupJoinForm renders the join button, with an optional message for invite-only groups
+func studyGroupJoinForm(r *ui.Request, group *ent.StudyGroup) Node {
+	label := "Join Group"
+	if group.Privacy == studygroup.PrivacyInviteOnly {
+		label = "Request to Join"
+	}
+
+	return Form(
+		Class("flex items-center gap-2"),
+		Method("POST"),
+		Action(r.Path("study-groups.join", group.ID)),
+		CSRF(r),
+		If(group.Privacy == studygroup.PrivacyInviteOnly,
+			Input(
+				Type("text"),
+				Name("message"),
+				Placeholder("Say hello (optional)"),
+				Class("px-3 py-1.5 text-sm border border-gray-300 rounded-md"),
+			),
+		),
+		Button(
+			Type("submit"),
+			Class("px-3 py-1.5 text-sm font-medium text-white bg-pink-600 rounded-md hover:bg-pink-700 transition-colors"),
+			Text(label),
+		),
+	)
+}
+
+// studyGroupAction renders a single-button POST form, optionally asking for confirmation
+func studyGroupAction(r *ui.Request, action, label, confirm string, danger bool) Node {
+	class := "px-3 py-1.5 text-sm font-medium text-gray-700 bg-white border border-gray-300 rounded-md hover:bg-gray-50 transition-colors"
+	if danger {
+		class = "px-3 py-1.5 text-sm font-medium text-red-700 bg-red-50 border border-red-300 rounded-md hover:bg-red-100 transition-colors"
+	}
+
+	return Form(
+		Class("inline"),
+		Method("POST"),
+		Action(action),
+		If(confirm != "", Attr("onsubmit", fmt.Sprintf("return confirm('%s')", confirm))),
+		CSRF(r),
+		Button(
+			Type("submit"),
+			Class(class),
+			Text(label),
+		),
+	)
+}
+
+// studyGroupRequests renders pending join requests for owners and moderators
+func studyGroupRequests(r *ui.Request, group *ent.StudyGroup, requests []*ent.GroupJoinRequest) Node {
+	return Div(
+		Class("mb-6"),
+		H2(
+			Class("text-lg font-semibold text-gray-900 mb-4"),
+			Text("Join Requests"),
+		),
+		Div(
+			Class("bg-white border border-gray-200 rounded-lg divide-y divide-gray-200"),
+			Group(func() []Node {
+				var rows []Node
+				for _, req := range requests {
+					rows = append(rows, Div(
+						Class("flex justify-between items-center p-4"),
+						Div(
+							P(Class("font-medium text-gray-900"), Text(req.Edges.User.Name)),
+							If(req.Message != "", P(Class("text-sm text-gray-600"), Text(req.Message))),
+						),
+						Div(
+							Class("flex items-center gap-2"),
+							studyGroupAction(r, r.Path("study-groups.request.approve", group.ID, req.ID), "Approve", "", false),
+							studyGroupAction(r, r.Path("study-groups.request.reject", group.ID, req.ID), "Reject", "", true),
+						),
+					))
+				}
+				return rows
+			}()),
+		),
+	)
+}
+
+// StudyGroupInvite displays an invitation to join a study group
+func StudyGroupInvite(ctx echo.Context, invite *ent.GroupInvite) error {
 	r := ui.NewRequest(ctx)
-	r.Title = "View Study Group"
-	r.Metatags.Description = "View study group details"
+	group := invite.Edges.Group
+	r.Title = "Join " + group.Name
+	r.Metatags.Description = "You've been invited to join a study group"
 
-	page := Div(
-		Class("container mx-auto px-4 py-8"),
+	content := Div(
+		Class("max-w-xl mx-auto py-12 px-4"),
+		Div(
+			Class("bg-white rounded-lg shadow p-8 text-center"),
+			Div(Class("text-6xl mb-4"), Text("👥")),
+			H1(
+				Class("text-2xl font-bold text-gray-900 mb-2"),
+				Text(group.Name),
+			),
+			If(invite.Edges.Creator != nil,
+				P(Class("text-gray-600 mb-4"), Text(invite.Edges.Creator.Name+" invited you to join this study group.")),
+			),
+			If(group.Description != "",
+				P(Class("text-gray-700 mb-6"), Text(group.Description)),
+			),
+			Form(
+				Method("POST"),
+				Action(r.Path("study-groups.invite.accept", invite.Token)),
+				CSRF(r),
+				Button(
+					Type("submit"),
+					Class("bg-pink-600 hover:bg-pink-700 text-white px-6 py-2 rounded-lg font-medium"),
+					Text("Join Group"),
+				),
+			),
+			P(
+				Class("text-xs text-gray-500 mt-4"),
+				Text("This invite expires "+invite.ExpiresAt.Format("Jan 2, 2006 15:04")+"."),
+			),
+		),
+	)
+
+	return r.Render(layouts.Primary, content)
+}
+
+// studyGroupInvites renders active invites along with forms to create links and send WhatsApp invitations
+func studyGroupInvites(r *ui.Request, group *ent.StudyGroup, invites []*ent.GroupInvite) Node {
+	inputClass := "px-3 py-1.5 text-sm border border-gray-300 rounded-md"
+	buttonClass := "px-3 py-1.5 text-sm font-medium text-white bg-pink-600 rounded-md hover:bg-pink-700 transition-colors"
+
+	return Div(
+		Class("mb-6"),
+		H2(
+			Class("text-lg font-semibold text-gray-900 mb-4"),
+			Text("Invites"),
+		),
+		Div(
+			Class("grid grid-cols-1 md:grid-cols-2 gap-4 mb-4"),
+			Form(
+				Class("bg-white border border-gray-200 rounded-lg p-4 space-y-2"),
+				Method("POST"),
+				Action(r.Path("study-groups.invite.create", group.ID)),
+				CSRF(r),
+				P(Class("text-sm font-medium text-gray-900"), Text("Create an invite link")),
+				Div(
+					Class("flex items-center gap-2"),
+					Input(Type("number"), Name("max_uses"), Min("0"), Value("0"), Class(inputClass+" w-24"), Attr("aria-label", "Maximum uses")),
+					Span(Class("text-sm text-gray-600"), Text("uses (0 = unlimited)")),
+				),
+				Div(
+					Class("flex items-center gap-2"),
+					Input(Type("number"), Name("expires_days"), Min("1"), Max("30"), Value("7"), Class(inputClass+" w-24"), Attr("aria-label", "Days valid")),
+					Span(Class("text-sm text-gray-600"), Text("days until it expires")),
+				),
+				Button(Type("submit"), Class(buttonClass), Text("Create Link")),
+			),
+			Form(
+				Class("bg-white border border-gray-200 rounded-lg p-4 space-y-2"),
+				Method("POST"),
+				Action(r.Path("study-groups.invite.create", group.ID)),
+				CSRF(r),
+				P(Class("text-sm font-medium text-gray-900"), Text("Invite on WhatsApp")),
+				Input(Type("tel"), Name("phone_number"), Required(), Placeholder("+2348012345678"), Class(inputClass+" w-full")),
+				P(Class("text-xs text-gray-500"), Text("They can join straight from WhatsApp using the Join button.")),
+				Button(Type("submit"), Class(buttonClass), Text("Send Invitation")),
+			),
+		),
+		If(len(invites) > 0,
+			Div(
+				Class("bg-white border border-gray-200 rounded-lg divide-y divide-gray-200"),
+				Group(func() []Node {
+					var rows []Node
+					for _, inv := range invites {
+						uses := fmt.Sprintf("%d uses", inv.Uses)
+						if inv.MaxUses > 0 {
+							uses = fmt.Sprintf("%d of %d uses", inv.Uses, inv.MaxUses)
+						}
+						rows = append(rows, Div(
+							Class("flex justify-between items-center gap-4 p-4"),
+							Div(
+								Class("min-w-0"),
+								If(inv.PhoneNumber != "",
+									P(Class("font-medium text-gray-900"), Text("WhatsApp: "+inv.PhoneNumber)),
+								),
+								If(inv.PhoneNumber == "",
+									Input(
+										Type("text"),
+										ReadOnly(),
+										Value(r.Url("study-groups.invite", inv.Token)),
+										Class(inputClass+" w-full font-mono"),
+										Attr("onclick", "this.select()"),
+									),
+								),
+								P(
+									Class("text-xs text-gray-500 mt-1"),
+									Text(uses+" · expires "+inv.ExpiresAt.Format("Jan 2, 2006 15:04")),
+								),
+							),
+							studyGroupAction(r, r.Path("study-groups.invite.revoke", group.ID, inv.ID), "Revoke", "", true),
+						))
+					}
+					return rows
+				}()),
+			),
+		),
+	)
+}
+
+// studyGroupNotes renders the notes shared into the group, with a share form for owners
+func studyGroupNotes(r *ui.Request, data StudyGroupData, isOwner bool) Node {
+	group := data.Group
+
+	return Div(
+		Class("mb-6"),
+		H2(
+			Class("text-lg font-semibold text-gray-900 mb-4"),
+			Text("Shared Notes"),
+		),
+		If(isOwner && len(data.OwnNotes) > 0,
+			Form(
+				Class("flex items-center gap-2 mb-4"),
+				Method("POST"),
+				Action(r.Path("study-groups.note.share", group.ID)),
+				CSRF(r),
+				Select(
+					Name("note_id"),
+					Class("flex-1 px-3 py-1.5 text-sm border border-gray-300 rounded-md"),
+					Group(func() []Node {
+						var options []Node
+						for _, n := range data.OwnNotes {
+							options = append(options, Option(Value(fmt.Sprint(n.ID)), Text(n.Title)))
+						}
+						return options
+					}()),
+				),
+				Button(
+					Type("submit"),
+					Class("px-3 py-1.5 text-sm font-medium text-white bg-pink-600 rounded-md hover:bg-pink-700 transition-colors"),
+					Text("Share Note"),
+				),
+			),
+		),
+		If(len(data.Notes) == 0,
+			P(Class("text-gray-600"), Text("No notes have been shared with this group yet.")),
+		),
+		If(len(data.Notes) > 0,
+			Div(
+				Class("bg-white border border-gray-200 rounded-lg divide-y divide-gray-200"),
+				Group(func() []Node {
+					var rows []Node
+					for _, n := range data.Notes {
+						canUnshare := isOwner || (n.Edges.Owner != nil && r.AuthUser != nil && n.Edges.Owner.ID == r.AuthUser.ID)
+						rows = append(rows, Div(
+							Class("flex justify-between items-center p-4"),
+							A(
+								Href(r.Path(routenames.Notes+".view", n.ID)),
+								Class("font-medium text-gray-900 hover:text-pink-700"),
+								Text(n.Title),
+							),
+							Div(
+								Class("flex items-center gap-4"),
+								If(n.Edges.Owner != nil, Span(Class("text-sm text-gray-500"), Text(n.Edges.Owner.Name))),
+								If(canUnshare, studyGroupAction(r, r.Path("study-groups.note.unshare", group.ID, n.ID), "Remove", "", false)),
+							),
+						))
+					}
+					return rows
+				}()),
+			),
+		),
+	)
+}
+
+// studyGroupMembers renders the member list with role controls for owners and moderators
+func studyGroupMembers(r *ui.Request, data StudyGroupData, isOwner, canModerate bool) Node {
+	group := data.Group
+
+	return Div(
+		Class("mb-6"),
+		H2(
+			Class("text-lg font-semibold text-gray-900 mb-4"),
+			Text("Members"),
+		),
 		Div(
-			Class("bg-white rounded-lg shadow p-6"),
-			P(Class("text-gray-600 text-center py-8"), Text("Study group viewing functionality is coming soon!")),
+			Class("bg-white border border-gray-200 rounded-lg divide-y divide-gray-200"),
+			Group(func() []Node {
+				var rows []Node
+				for _, m := range data.Members {
+					self := m.ID == data.Membership.ID
+					canRemove := !self && (isOwner || (canModerate && m.Role == groupmembership.RoleMember))
+					rows = append(rows, Div(
+						Class("flex justify-between items-center p-4"),
+						Span(Class("font-medium text-gray-900"), Text(m.Edges.User.Name)),
+						Div(
+							Class("flex items-center gap-2"),
+							If(!isOwner, studyGroupRoleBadge(m.Role)),
+							If(isOwner, studyGroupRoleForm(r, group, m)),
+							If(canRemove, studyGroupAction(r, r.Path("study-groups.member.remove", group.ID, m.Edges.User.ID), "Remove", "Remove this member from the group?", true)),
+						),
+					))
+				}
+				return rows
+			}()),
 		),
 	)
+}
+
+// studyGroupRoleForm renders a role selector that submits on change
+func studyGroupRoleForm(r *ui.Request, group *ent.StudyGroup, m *ent.GroupMembership) Node {
+	option := func(role groupmembership.Role, label string) Node {
+		return Option(
+			Value(string(role)),
+			If(m.Role == role, Selected()),
+			Text(label),
+		)
+	}
 
-	return r.Render(layouts.Primary, page)
-}
\ No newline at end of file
+	return Form(
+		Method("POST"),
+		Action(r.Path("study-groups.member.role", group.ID, m.Edges.User.ID)),
+		CSRF(r),
+		Select(
+			Name("role"),
+			Class("px-2 py-1 text-sm border border-gray-300 rounded-md"),
+			Attr("onchange", "this.form.submit()"),
+			option(groupmembership.RoleOwner, "Owner"),
+			option(groupmembership.RoleModerator, "Moderator"),
+			option(groupmembership.RoleMember, "Member"),
+		),
+	)
+}
@@ -2,7 +2,10 @@ package ui
 
 import (
 	"fmt"
+	"strings"
 	"time"
+
+	"github.com/r-scheele/zero/pkg/routenames"
 )
 
 var (
@@ -19,3 +22,20 @@ func PublicFile(filepath string) string {
 func StaticFile(filepath string) string {
 	return fmt.Sprintf("/%s/%s?v=%s", "static", filepath, cacheBuster)
 }
+
+// ProfilePicture generates the URL of a user's profile picture. Pictures in a storage backend are
+// linked through a route that signs a URL to them, while older ones are URLs or file names.
+func ProfilePicture(r *Request, picture string) string {
+	switch {
+	case StoredProfilePicture(picture):
+		return r.Path(routenames.ProfilePictureImage)
+	case strings.Contains(picture, "://") || strings.HasPrefix(picture, "/"):
+		return picture
+	}
+	return PublicFile(picture)
+}
+
+// StoredProfilePicture reports whether a profile picture is the key of a file in a storage backend
+func StoredProfilePicture(picture string) bool {
+	return strings.Contains(picture, "/") && !strings.HasPrefix(picture, "/") && !strings.Contains(picture, "://")
+}